@@ -0,0 +1,235 @@
+// Package autotune benchmarks sorting networks against the stdlib's sorts on the
+// current machine, to find the sizes at which the networks stop paying off.
+//
+// The results depend heavily on the CPU, the Go version and the element type, so
+// they are worth measuring on the machines the code will actually run on before
+// choosing which sizes to generate with `sortnetgen`, or where a hybrid sort should
+// switch over to a network.
+package autotune
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"math/rand"
+	"runtime"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/shabbyrobe/sortnet"
+)
+
+// Methods of sorting that are measured for each type and size.
+const (
+	// Network is the unrolled sorter returned by sortnet.Compile, which is equivalent to
+	// the code generated by `sortnetgen` for sizes 2 to 32.
+	Network = "network"
+
+	// NetworkLoop is a loop over the network's comparators, such as Network.SortInts.
+	// It is only measured for the types that have a Network.Sort* method.
+	NetworkLoop = "network-loop"
+
+	SortSlice  = "sort.Slice"
+	SlicesSort = "slices.Sort"
+)
+
+// Types returns the names of the element types that can be measured.
+func Types() []string {
+	return []string{"int", "int64", "uint64", "float64", "string"}
+}
+
+// Config controls what Run measures. The zero value measures every type in Types()
+// at sizes 2 to 64.
+type Config struct {
+	// Types to measure, from Types(). Defaults to all of them.
+	Types []string
+
+	// Sizes to measure. Defaults to 2 to 64.
+	Sizes []int
+
+	// Duration is the minimum time spent measuring each method at each size. Defaults
+	// to 20ms. Longer durations give more stable results.
+	Duration time.Duration
+}
+
+// Report contains the results of Run.
+type Report struct {
+	GOOS      string `json:"goos"`
+	GOARCH    string `json:"goarch"`
+	GoVersion string `json:"goVersion"`
+	NumCPU    int    `json:"numCPU"`
+
+	Results    []Result    `json:"results"`
+	Crossovers []Crossover `json:"crossovers"`
+}
+
+// Result holds the time taken by each method to sort a slice of Size items of Type.
+type Result struct {
+	Type string `json:"type"`
+	Size int    `json:"size"`
+
+	// NsPerOp maps each method to the average time it took to sort one slice, in
+	// nanoseconds, not counting the time taken to fill the slice with random values.
+	NsPerOp map[string]float64 `json:"nsPerOp"`
+
+	// Best is the fastest method.
+	Best string `json:"best"`
+}
+
+// Crossover holds the largest size at which a network method beats the stdlib.
+type Crossover struct {
+	Type   string `json:"type"`
+	Method string `json:"method"`
+
+	// MaxSize is the largest size for which Method was faster than both SortSlice and
+	// SlicesSort, at that size and at every smaller size measured. It is 0 if Method
+	// was slower at the smallest size measured.
+	MaxSize int `json:"maxSize"`
+}
+
+// Run measures each method for each type and size in cfg.
+func Run(cfg Config) (*Report, error) {
+	if len(cfg.Types) == 0 {
+		cfg.Types = Types()
+	}
+	if len(cfg.Sizes) == 0 {
+		for sz := 2; sz <= 64; sz++ {
+			cfg.Sizes = append(cfg.Sizes, sz)
+		}
+	}
+	if cfg.Duration <= 0 {
+		cfg.Duration = 20 * time.Millisecond
+	}
+
+	sizes := slices.Clone(cfg.Sizes)
+	slices.Sort(sizes)
+	sizes = slices.Compact(sizes)
+	for _, sz := range sizes {
+		if sz < 1 {
+			return nil, fmt.Errorf("autotune: sizes must be >= 1, found %d", sz)
+		}
+	}
+
+	report := &Report{
+		GOOS:      runtime.GOOS,
+		GOARCH:    runtime.GOARCH,
+		GoVersion: runtime.Version(),
+		NumCPU:    runtime.NumCPU(),
+	}
+
+	for _, typ := range cfg.Types {
+		var results []Result
+		switch typ {
+		case "int":
+			results = measure(typ, sizes, cfg.Duration, randInt[int], sortnet.Network.SortInts)
+		case "int64":
+			results = measure(typ, sizes, cfg.Duration, randInt[int64], sortnet.Network.SortInt64s)
+		case "uint64":
+			results = measure(typ, sizes, cfg.Duration, randInt[uint64], sortnet.Network.SortUint64s)
+		case "float64":
+			results = measure(typ, sizes, cfg.Duration, (*rand.Rand).Float64, sortnet.Network.SortFloat64s)
+		case "string":
+			results = measure(typ, sizes, cfg.Duration, randString, nil)
+		default:
+			return nil, fmt.Errorf("autotune: unknown type %q", typ)
+		}
+
+		report.Results = append(report.Results, results...)
+		for _, method := range []string{Network, NetworkLoop} {
+			if _, ok := results[0].NsPerOp[method]; ok {
+				report.Crossovers = append(report.Crossovers, crossover(typ, method, results))
+			}
+		}
+	}
+
+	return report, nil
+}
+
+func randInt[T int | int64 | uint64](rng *rand.Rand) T {
+	return T(rng.Int63())
+}
+
+func randString(rng *rand.Rand) string {
+	return strconv.FormatUint(rng.Uint64(), 36)
+}
+
+// measure times each method for each size. loop may be nil if there is no
+// Network.Sort* method for T.
+func measure[T cmp.Ordered](
+	typ string,
+	sizes []int,
+	d time.Duration,
+	gen func(*rand.Rand) T,
+	loop func(sortnet.Network, []T),
+) (results []Result) {
+	rng := rand.New(rand.NewSource(0))
+	pool := make([]T, 1<<16)
+	for i := range pool {
+		pool[i] = gen(rng)
+	}
+
+	for _, sz := range sizes {
+		net := sortnet.New(sz)
+		buf := make([]T, sz)
+
+		methods := map[string]func([]T){
+			Network: sortnet.Compile[T](net),
+			SortSlice: func(a []T) {
+				sort.Slice(a, func(i, j int) bool { return a[i] < a[j] })
+			},
+			SlicesSort: slices.Sort[[]T],
+		}
+		if loop != nil {
+			methods[NetworkLoop] = func(a []T) { loop(net, a) }
+		}
+
+		// Filling the slice to sort costs the same for every method, so it's measured
+		// on its own and subtracted:
+		baseline := nsPerOp(pool, buf, d, func([]T) {})
+
+		result := Result{Type: typ, Size: sz, NsPerOp: map[string]float64{}}
+		for name, sorter := range methods {
+			ns := max(nsPerOp(pool, buf, d, sorter)-baseline, 0)
+			ns = math.Round(ns*10) / 10
+			result.NsPerOp[name] = ns
+			if result.Best == "" || ns < result.NsPerOp[result.Best] {
+				result.Best = name
+			}
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// nsPerOp returns the average time taken to copy len(buf) items from pool into buf,
+// then sort them. The number of sorts is doubled until they take at least d.
+func nsPerOp[T any](pool, buf []T, d time.Duration, sorter func([]T)) float64 {
+	for n := 1; ; n *= 2 {
+		start := time.Now()
+		for i, off := 0, 0; i < n; i++ {
+			if off+len(buf) > len(pool) {
+				off = 0
+			}
+			copy(buf, pool[off:])
+			sorter(buf)
+			off += len(buf)
+		}
+		if taken := time.Since(start); taken >= d || n >= 1<<30 {
+			return float64(taken.Nanoseconds()) / float64(n)
+		}
+	}
+}
+
+func crossover(typ, method string, results []Result) Crossover {
+	c := Crossover{Type: typ, Method: method}
+	for _, r := range results {
+		ns := r.NsPerOp[method]
+		if ns >= r.NsPerOp[SortSlice] || ns >= r.NsPerOp[SlicesSort] {
+			break
+		}
+		c.MaxSize = r.Size
+	}
+	return c
+}
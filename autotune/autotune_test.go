@@ -0,0 +1,66 @@
+package autotune
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRun(t *testing.T) {
+	report, err := Run(Config{
+		Types:    Types(),
+		Sizes:    []int{8, 2},
+		Duration: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(report.Results) != len(Types())*2 {
+		t.Fatalf("unexpected results: %+v", report.Results)
+	}
+	for _, r := range report.Results {
+		if r.Size != 2 && r.Size != 8 {
+			t.Fatalf("unexpected size %d", r.Size)
+		}
+		expMethods := 4
+		if r.Type == "string" {
+			expMethods = 3
+		}
+		if len(r.NsPerOp) != expMethods {
+			t.Fatalf("unexpected methods for %s: %v", r.Type, r.NsPerOp)
+		}
+		if _, ok := r.NsPerOp[r.Best]; !ok {
+			t.Fatalf("unexpected best method %q", r.Best)
+		}
+	}
+
+	// Every type gets a crossover for the compiled network, and all but string get
+	// one for the loop:
+	if len(report.Crossovers) != len(Types())*2-1 {
+		t.Fatalf("unexpected crossovers: %+v", report.Crossovers)
+	}
+}
+
+func TestRunUnknownType(t *testing.T) {
+	if _, err := Run(Config{Types: []string{"complex128"}}); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestCrossover(t *testing.T) {
+	results := []Result{
+		{Size: 2, NsPerOp: map[string]float64{Network: 1, SortSlice: 5, SlicesSort: 3}},
+		{Size: 4, NsPerOp: map[string]float64{Network: 2, SortSlice: 5, SlicesSort: 3}},
+		{Size: 8, NsPerOp: map[string]float64{Network: 4, SortSlice: 5, SlicesSort: 3}},
+		{Size: 16, NsPerOp: map[string]float64{Network: 1, SortSlice: 5, SlicesSort: 3}},
+	}
+	c := crossover("int", Network, results)
+	if c.MaxSize != 4 {
+		t.Fatal(c)
+	}
+
+	results[0].NsPerOp[Network] = 10
+	if c := crossover("int", Network, results); c.MaxSize != 0 {
+		t.Fatal(c)
+	}
+}
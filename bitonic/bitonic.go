@@ -0,0 +1,180 @@
+// Package bitonic provides a parallel sort for large slices of ordered types.
+//
+// The slice is cut into small blocks, which are sorted with sorting networks, then
+// the blocks are combined with bitonic merges. The comparisons made by a bitonic merge
+// don't depend on the data being sorted, so each merge stage can be split evenly
+// across as many goroutines as are available.
+//
+// Bitonic sort makes O(n log² n) comparisons, compared to O(n log n) for the stdlib's
+// sorts, so it will be slower than slices.Sort unless there are enough cores to make
+// up the difference.
+//
+// This uses the generalisation of bitonic sort to arbitrary lengths described by
+// H. W. Lang: https://hwlang.de/algorithmen/sortieren/bitonic/oddn.htm
+package bitonic
+
+import (
+	"cmp"
+	"runtime"
+	"sync"
+
+	"github.com/shabbyrobe/sortnet"
+)
+
+const (
+	// blockSize is the largest block that will be sorted directly with a network.
+	blockSize = 16
+
+	// grainSize is the smallest run of comparisons that will be handed to another
+	// goroutine. Anything smaller isn't worth the overhead.
+	grainSize = 4096
+)
+
+// Sort sorts a in increasing order using up to 'workers' goroutines, including the
+// calling goroutine. If workers is <= 0, runtime.GOMAXPROCS(0) is used.
+//
+// For floating point types, not-a-number values are ordered before other values, as
+// they are by slices.Sort.
+func Sort[T cmp.Ordered](a []T, workers int) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	// NaNs aren't ordered with respect to anything, so they can't go through the
+	// merges; put them all up front. For types other than floats, 'v != v' is always
+	// false:
+	nans := 0
+	for i := range a {
+		if a[i] != a[i] {
+			a[nans], a[i] = a[i], a[nans]
+			nans++
+		}
+	}
+	a = a[nans:]
+	if len(a) < 2 {
+		return
+	}
+
+	s := sorter[T]{
+		pool: newPool(workers),
+	}
+	for sz := range s.nets {
+		s.nets[sz] = sortnet.Compile[T](sortnet.New(sz))
+	}
+	s.sort(a, true)
+}
+
+type sorter[T cmp.Ordered] struct {
+	nets [blockSize + 1]func([]T)
+	pool *pool
+}
+
+func (s *sorter[T]) sort(a []T, asc bool) {
+	if len(a) <= blockSize {
+		s.sortBlock(a, asc)
+		return
+	}
+
+	// The first half is sorted in the opposite direction to the second, so together
+	// they form a bitonic sequence:
+	m := len(a) / 2
+	s.pool.run(
+		func() { s.sort(a[:m], !asc) },
+		func() { s.sort(a[m:], asc) },
+	)
+	s.merge(a, asc)
+}
+
+func (s *sorter[T]) merge(a []T, asc bool) {
+	if len(a) <= blockSize {
+		// Sorting a bitonic block with a network is as good as merging it:
+		s.sortBlock(a, asc)
+		return
+	}
+
+	m := greatestPowerOfTwoBelow(len(a))
+	n := len(a) - m
+	if n < grainSize*2 {
+		compare(a, 0, n, m, asc)
+	} else {
+		grains := n / grainSize
+		fns := make([]func(), 0, grains)
+		for g := 0; g < grains; g++ {
+			start, end := n*g/grains, n*(g+1)/grains
+			fns = append(fns, func() { compare(a, start, end, m, asc) })
+		}
+		s.pool.run(fns...)
+	}
+
+	s.pool.run(
+		func() { s.merge(a[:m], asc) },
+		func() { s.merge(a[m:], asc) },
+	)
+}
+
+func (s *sorter[T]) sortBlock(a []T, asc bool) {
+	s.nets[len(a)](a)
+	if !asc {
+		for i, j := 0, len(a)-1; i < j; i, j = i+1, j-1 {
+			a[i], a[j] = a[j], a[i]
+		}
+	}
+}
+
+// compare applies the comparators (i, i+dist) for every i in [start, end).
+func compare[T cmp.Ordered](a []T, start, end, dist int, asc bool) {
+	lo, hi := a[start:end], a[start+dist:end+dist]
+	if asc {
+		for i := range lo {
+			if lo[i] > hi[i] {
+				lo[i], hi[i] = hi[i], lo[i]
+			}
+		}
+	} else {
+		for i := range lo {
+			if lo[i] < hi[i] {
+				lo[i], hi[i] = hi[i], lo[i]
+			}
+		}
+	}
+}
+
+func greatestPowerOfTwoBelow(n int) int {
+	k := 1
+	for k < n {
+		k <<= 1
+	}
+	return k >> 1
+}
+
+// pool limits the number of goroutines working on a sort. Work that can't get a slot
+// in the pool is done by the goroutine that submitted it.
+type pool struct {
+	slots chan struct{}
+}
+
+func newPool(workers int) *pool {
+	return &pool{slots: make(chan struct{}, workers-1)}
+}
+
+func (p *pool) run(fns ...func()) {
+	var wg sync.WaitGroup
+	for i, fn := range fns {
+		if i == len(fns)-1 {
+			fn()
+			break
+		}
+		select {
+		case p.slots <- struct{}{}:
+			wg.Add(1)
+			go func(fn func()) {
+				defer wg.Done()
+				defer func() { <-p.slots }()
+				fn()
+			}(fn)
+		default:
+			fn()
+		}
+	}
+	wg.Wait()
+}
@@ -0,0 +1,103 @@
+package bitonic
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"runtime"
+	"slices"
+	"sort"
+	"testing"
+)
+
+func TestSort(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+
+	var sizes []int
+	for i := 0; i <= 70; i++ {
+		sizes = append(sizes, i)
+	}
+	sizes = append(sizes, 127, 128, 129, 1000, 4097, 20000, 100003)
+
+	for _, n := range sizes {
+		for _, workers := range []int{1, 3, 8} {
+			t.Run(fmt.Sprintf("%d/%d", n, workers), func(t *testing.T) {
+				vs := make([]int, n)
+				for i := range vs {
+					vs[i] = rng.Intn(n + 1)
+				}
+				exp := slices.Clone(vs)
+				sort.Slice(exp, func(i, j int) bool { return exp[i] < exp[j] })
+
+				Sort(vs, workers)
+				if !reflect.DeepEqual(exp, vs) {
+					t.Fatal("ints not sorted")
+				}
+			})
+		}
+	}
+}
+
+func TestSortFloat64s(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+	vs := make([]float64, 10000)
+	for i := range vs {
+		vs[i] = rng.NormFloat64()
+		if rng.Intn(50) == 0 {
+			vs[i] = math.NaN()
+		}
+	}
+	exp := slices.Clone(vs)
+	slices.Sort(exp)
+
+	Sort(vs, 4)
+	for i := range exp {
+		if exp[i] != vs[i] && !(math.IsNaN(exp[i]) && math.IsNaN(vs[i])) {
+			t.Fatalf("float64s not sorted at index %d: %v != %v", i, exp[i], vs[i])
+		}
+	}
+}
+
+func TestSortStrings(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+	vs := make([]string, 5000)
+	for i := range vs {
+		vs[i] = fmt.Sprint(rng.Intn(1000))
+	}
+	exp := slices.Clone(vs)
+	sort.Slice(exp, func(i, j int) bool { return exp[i] < exp[j] })
+
+	Sort(vs, 4)
+	if !reflect.DeepEqual(exp, vs) {
+		t.Fatal("strings not sorted")
+	}
+}
+
+// Run with '-cpu 1,2,4,8' (or similar) to see how the sort scales with GOMAXPROCS.
+func BenchmarkSort(b *testing.B) {
+	rng := rand.New(rand.NewSource(0))
+	src := make([]int, 1<<20)
+	for i := range src {
+		src[i] = rng.Int()
+	}
+	vs := make([]int, len(src))
+
+	b.Run(fmt.Sprintf("bitonic-%d", len(src)), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			copy(vs, src)
+			b.StartTimer()
+			Sort(vs, runtime.GOMAXPROCS(0))
+		}
+	})
+
+	b.Run(fmt.Sprintf("slices-%d", len(src)), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			copy(vs, src)
+			b.StartTimer()
+			slices.Sort(vs)
+		}
+	})
+}
@@ -2,9 +2,17 @@ package sortnet
 
 func BoseNelson(n int) Network {
 	var builder = boseNelsonBuilder{
-		Network: Network{Kind: "Bose-Nelson", Size: n},
+		Network: Network{Kind: "Bose-Nelson", Size: n, Meta: Metadata{
+			Family:     "bosenelson",
+			Discoverer: "R. C. Bose and R. J. Nelson",
+			Year:       1962,
+			Reference:  refBoseNelson,
+		}},
 	}
 	builder.split(0, n)
+	builder.Depth = opsDepth(n, builder.Ops)
+	builder.Meta.SizeOptimality = checkedOptimality(optimalSizes, bestKnownSizes, n, len(builder.Ops))
+	builder.Meta.DepthOptimality = checkedOptimality(optimalDepths, bestKnownDepths, n, builder.Depth)
 	return builder.Network
 }
 
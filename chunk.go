@@ -0,0 +1,57 @@
+package sortnet
+
+import (
+	"cmp"
+	"sync"
+)
+
+// SortChunks sorts every consecutive net.Size chunk of vs in place, for example the
+// colour samples of every pixel in an image.
+//
+// len(vs) must be a multiple of net.Size, otherwise SortChunks will panic, as the
+// sorters generated by sortnetgen -chunks do.
+//
+// If workers is greater than 1, the chunks are split into that many contiguous
+// groups, each of which is sorted on its own goroutine. Otherwise, all chunks are
+// sorted on the calling goroutine.
+func SortChunks[T cmp.Ordered](net Network, vs []T, workers int) {
+	if net.Size <= 0 {
+		return
+	}
+	if len(vs)%net.Size != 0 {
+		panic("sortnet: len(vs) is not a multiple of the network size")
+	}
+
+	chunks := len(vs) / net.Size
+	if workers > chunks {
+		workers = chunks
+	}
+	if workers <= 1 {
+		sortChunks(net, vs)
+		return
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		start := (chunks * w / workers) * net.Size
+		end := (chunks * (w + 1) / workers) * net.Size
+		go func(vs []T) {
+			defer wg.Done()
+			sortChunks(net, vs)
+		}(vs[start:end])
+	}
+	wg.Wait()
+}
+
+func sortChunks[T cmp.Ordered](net Network, vs []T) {
+	sz := net.Size
+	for ; len(vs) >= sz; vs = vs[sz:] {
+		chunk := vs[:sz:sz]
+		for _, c := range net.Ops {
+			if chunk[c.From] > chunk[c.To] {
+				chunk[c.From], chunk[c.To] = chunk[c.To], chunk[c.From]
+			}
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package sortnet
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestSortChunks(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+
+	for _, sz := range []int{1, 2, 3, 9, 16, 25} {
+		for _, workers := range []int{0, 1, 3, 8} {
+			t.Run(fmt.Sprintf("%d/%d", sz, workers), func(t *testing.T) {
+				net := New(sz)
+				chunks := 101
+				vs := make([]int, sz*chunks)
+				for i := range vs {
+					vs[i] = rng.Intn(64)
+				}
+
+				exp := make([]int, len(vs))
+				copy(exp, vs)
+				for i := 0; i < len(exp); i += sz {
+					sort.Ints(exp[i : i+sz])
+				}
+
+				SortChunks(net, vs, workers)
+				if !reflect.DeepEqual(exp, vs) {
+					t.Fatal("chunks not sorted")
+				}
+			})
+		}
+	}
+}
+
+func TestSortChunksPanicsOnPartialChunk(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic")
+		}
+	}()
+	SortChunks(New(4), make([]int, 7), 0)
+}
+
+func BenchmarkSortChunks(b *testing.B) {
+	// A 3x3 neighbourhood for every pixel of a 1 megapixel image:
+	rng := rand.New(rand.NewSource(0))
+	src := make([]uint8, 9*1000*1000)
+	for i := range src {
+		src[i] = uint8(rng.Intn(256))
+	}
+	vs := make([]uint8, len(src))
+	net := New(9)
+
+	for _, workers := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("workers-%d", workers), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				copy(vs, src)
+				b.StartTimer()
+				SortChunks(net, vs, workers)
+			}
+		})
+	}
+}
@@ -1,6 +1,6 @@
 module github.com/shabbyrobe/sortnet/cmd/sortnet
 
-go 1.13
+go 1.21
 
 require github.com/shabbyrobe/sortnet v0.0.0-20191013053122-5df528280717
 
@@ -21,19 +21,29 @@ func main() {
 }
 
 func run() error {
+	if len(os.Args) > 1 && os.Args[1] == "tune" {
+		return runTune(os.Args[2:])
+	}
+
 	var alg string
 	var outFmt string
 	var outFile string
 	var n int
 	var showInfo bool
+	var list bool
 
 	flag.IntVar(&n, "n", 0, "Network size")
-	flag.StringVar(&alg, "alg", "best", "Algorithm (best, bosenelson)")
+	flag.StringVar(&alg, "alg", "best", "Algorithm (best, bosenelson, or the name of a network listed by -list)")
+	flag.BoolVar(&list, "list", false, "List the named networks and exit")
 	flag.BoolVar(&showInfo, "info", true, "Show extra info about network on stderr")
 	flag.StringVar(&outFmt, "fmt", "swaps", "Output format (swaps, png)")
 	flag.StringVar(&outFile, "o", "", "Output file (for png)")
 	flag.Parse()
 
+	if list {
+		return printList()
+	}
+
 	var net sortnet.Network
 	switch alg {
 	case "bosenelson":
@@ -41,7 +51,15 @@ func run() error {
 	case "best":
 		net = sortnet.New(int(n))
 	default:
-		return fmt.Errorf("unknown algo")
+		var ok bool
+		if net, ok = sortnet.Lookup(alg); !ok {
+			return fmt.Errorf("unknown algo")
+		}
+		if n == 0 {
+			n = net.Size
+		} else if n != net.Size {
+			return fmt.Errorf("network %q has %d inputs, but -n is %d", net.Kind, net.Size, n)
+		}
 	}
 
 	if n < 1 {
@@ -49,8 +67,7 @@ func run() error {
 	}
 
 	if showInfo {
-		fmt.Fprintln(os.Stderr, "kind:", net.Kind, "depth:", net.Depth, "size:", net.Size)
-		fmt.Fprintln(os.Stderr)
+		printInfo(os.Stderr, net)
 	}
 
 	switch outFmt {
@@ -63,6 +80,34 @@ func run() error {
 	}
 }
 
+func printList() error {
+	for _, net := range sortnet.All() {
+		fmt.Printf("%-16s inputs: %-3d comparators: %-4d depth: %d\n", net.Kind, net.Size, len(net.Ops), net.Depth)
+	}
+	return nil
+}
+
+func printInfo(w io.Writer, net sortnet.Network) {
+	meta := net.Meta
+	fmt.Fprintln(w, "kind:", net.Kind, "size:", net.Size)
+	fmt.Fprintf(w, "comparators: %d (%s)\n", len(net.Ops), meta.SizeOptimality)
+	fmt.Fprintf(w, "depth: %d (%s)\n", net.Depth, meta.DepthOptimality)
+	if meta.Family != "" {
+		fmt.Fprintln(w, "family:", meta.Family)
+	}
+	if meta.Discoverer != "" {
+		if meta.Year != 0 {
+			fmt.Fprintf(w, "discoverer: %s (%d)\n", meta.Discoverer, meta.Year)
+		} else {
+			fmt.Fprintln(w, "discoverer:", meta.Discoverer)
+		}
+	}
+	if meta.Reference != "" {
+		fmt.Fprintln(w, "reference:", meta.Reference)
+	}
+	fmt.Fprintln(w)
+}
+
 func printSwaps(net sortnet.Network) error {
 	for _, c := range net.Ops {
 		fmt.Printf("swap(%d, %d)\n", c.From, c.To)
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shabbyrobe/sortnet/autotune"
+)
+
+const tuneUsage = `Usage: sortnet tune [options]
+
+Benchmarks sorting networks against sort.Slice and slices.Sort on this machine, and
+writes the results and the crossover sizes (the largest size at which each network
+method beats the stdlib) as JSON.
+
+Options:
+`
+
+func runTune(args []string) error {
+	var types string
+	var sizes string
+	var duration time.Duration
+	var outFile string
+
+	fs := flag.NewFlagSet("tune", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), tuneUsage)
+		fs.PrintDefaults()
+	}
+	fs.StringVar(&types, "types", strings.Join(autotune.Types(), ","), "Comma separated list of types to measure")
+	fs.StringVar(&sizes, "sizes", "2-64", "Comma separated list of sizes or ranges of sizes to measure")
+	fs.DurationVar(&duration, "time", 20*time.Millisecond, "Minimum time to spend measuring each method at each size")
+	fs.StringVar(&outFile, "o", "-", "Output file ('-' for stdout)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	cfg := autotune.Config{
+		Types:    strings.Split(types, ","),
+		Duration: duration,
+	}
+	var err error
+	cfg.Sizes, err = parseSizes(sizes)
+	if err != nil {
+		return err
+	}
+
+	report, err := autotune.Run(cfg)
+	if err != nil {
+		return err
+	}
+
+	out, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	out = append(out, '\n')
+
+	if outFile == "-" {
+		_, err = os.Stdout.Write(out)
+		return err
+	}
+	return os.WriteFile(outFile, out, 0644)
+}
+
+// parseSizes parses a comma separated list of sizes or hyphen separated ranges of
+// sizes, like "2-16,24,32".
+func parseSizes(s string) (sizes []int, err error) {
+	for _, part := range strings.Split(s, ",") {
+		lo, hi, isRange := strings.Cut(strings.TrimSpace(part), "-")
+		from, err := strconv.Atoi(lo)
+		if err != nil {
+			return nil, fmt.Errorf("invalid size %q", part)
+		}
+		to := from
+		if isRange {
+			if to, err = strconv.Atoi(hi); err != nil || to < from {
+				return nil, fmt.Errorf("invalid size range %q", part)
+			}
+		}
+		for sz := from; sz <= to; sz++ {
+			sizes = append(sizes, sz)
+		}
+	}
+	return sizes, nil
+}
@@ -10,7 +10,6 @@ import (
 	"strings"
 	"text/template"
 
-	"github.com/shabbyrobe/sortnet"
 	"golang.org/x/tools/imports"
 )
 
@@ -26,6 +25,8 @@ sorters for int(2) and uint(2):
 Each '<input>' contains a fully qualified type name and a 'sizespec', where 'sizespec'
 is a comma-separated list of ints or hyphen-separated int ranges.
 
+An '<input>' may also be a pointer or a slice, for example '*math/big.Int' or '[]byte'.
+
 Generate forward and reverse sorting network of sizes 3-5 for float64:
     -fwd -rev -size 3-5 float64
 
@@ -36,8 +37,17 @@ Generate reverse sorting network of sizes 3, 4, 5 and 9 for string:
     -rev size 3-5, string
 
 The type will be the basis for the comparison. If <input> is a builtin primitive, '<' is
-used for comparisons, otherwise -greater and -less are used to determine how to compare
-and swap for -fwd and -rev sorts respectively.
+used for comparisons. For other types, -greater and -less are used to determine how to
+compare and swap for -fwd and -rev sorts respectively. If none of -greater, -less,
+-lessfunc or -key are passed, the type's package is loaded to find out how to compare
+it: '<' is used if its underlying type is ordered (like 'type Celsius float64'),
+otherwise it must have a Compare(T) int, Cmp(T) int, Less(T) bool or Before(T) bool
+method.
+
+This means common standard library types need no comparator. Generate sorters for
+time.Time, time.Duration, netip.Addr, *big.Int, *big.Float and []byte (which is
+compared with bytes.Compare):
+    -size 2-8 time.Time time.Duration net/netip.Addr *math/big.Int *math/big.Float []byte
 
 Generate forward sorting network of size 2 for example.com/foo.Yep, providing -greater:
     -size 2 -greater 'foo.YepCASGreater(&a[{{.From}}], &a[{{.To}}])' example.com/foo.Yep
@@ -46,11 +56,70 @@ If neither '{{.From}}' nor '{{.To}}' are present in the template, it is presumed
 be a function. The following is equivalent to the previous example:
     -size 2 -greater 'foo.YepCASGreater' example.com/foo.Yep
 
+Generate forward sorting network of size 2 for Yep, a struct in the current package,
+ordered by its Foo field, then by its Bar field's Baz field in descending order:
+    -size 2 -key 'Foo,-Bar.Baz' Yep
+
+Fields used by -key are compared with '<' if they can be, otherwise they must have a
+Compare, Cmp, Less or Before method that takes another value of the same type.
+
+Generate forward sorting networks of sizes 2-16 for int using the networks with the
+smallest depth, rather than the fewest comparators (-prefer size, the default):
+    -prefer depth -size 2-16 int
+
+Generate forward sorting networks using only the Bose-Nelson family of networks, or
+the network from the registry called VanVoorhis16 (-alg best, the default, allows any
+network):
+    -alg bosenelson -size 2-16 int
+    -alg VanVoorhis16 -size 16 int
+
+Generate forward sorting networks for int from the networks in a file, instead of
+choosing one for each -size. Files ending in '.json' hold a sortnet.Network or an array
+of them, and other files list each network's comparators as index pairs, like
+'[(0,1),(2,3)]', with blank lines between networks. Each network is checked to make
+sure it sorts:
+    -network networks.txt int
+
+Generate forward sorting networks of sizes 2-16 for int and float64 that compare and
+swap using the min and max builtins, which the compiler turns into conditional moves
+rather than branches (not-a-number values give unspecified results, and may be
+duplicated):
+    -branchless -size 2-16 int float64
+
+Generate sorters of sizes 2-16 with a type parameter, for any cmp.Ordered type and for
+any type with a 'less' function, instead of for specific types (no <input> is passed):
+    -generic -size 2-16
+
+Rather than a template that compares and swaps, -lessfunc takes the name of a
+'func(a, b T) bool' that reports whether a sorts before b, or an expression using
+'{{.A}}' and '{{.B}}'. The swap is generated, for both -fwd and -rev:
+    -size 2 -lessfunc 'foo.YepLess' example.com/foo.Yep
+    -size 2 -lessfunc '{{.A}}.Foo < {{.B}}.Foo' example.com/foo.Yep
+
+Write sortnet_gen_test.go as well as sortnet_gen.go, which checks every generated
+function with github.com/shabbyrobe/sortnet/sortnettest, and has a fuzz target and
+benchmarks for each type:
+    -tests -size 2-16 int Version
+
+Check that the files written by 'go generate' are up to date, without writing
+anything: any file that differs is printed as a diff, and sortnetgen fails. Generated
+files record the sortnetgen version and each network's fingerprint, so this also
+catches changes to the library's networks:
+    SORTNETGEN_CHECK=1 go generate ./...
+
 Only one of -less or -greater needs to be provided, regardless of whether -fwd and/or
 -rev are passed. If -less is passed but only -fwd is used, the generator knows how to
 call the function with the correct arguments.
 `
 
+// Version is recorded in the files sortnetgen generates, so that -check reports files
+// generated by another version as out of date. Change it whenever the generated code
+// changes.
+const Version = "0.1.0"
+
+const generatedPreamble = "// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.\n" +
+	"// sortnetgen version " + Version
+
 type usageError string
 
 func (u usageError) Error() string { return string(u) }
@@ -63,7 +132,17 @@ func IsUsageError(err error) bool {
 type inputFlags struct {
 	lessTemplate    string
 	greaterTemplate string
+	lessFunc        string
+	key             string
 	array           bool
+	chunks          bool
+	strided         bool
+	lanes           bool
+	merge           bool
+	branchless      bool
+	alg             string
+	prefer          string
+	network         string
 	slice           bool
 	wrap            bool
 	forward         bool
@@ -87,10 +166,20 @@ func (i *inputFlags) Flags(flags *flag.FlagSet) {
 	flags.BoolVar(&i.reverse, "rev", i.reverse, "Generate descending-order sorters")
 	flags.BoolVar(&i.array, "array", i.array, "Generate fixed-length array sorters")
 	flags.BoolVar(&i.slice, "slice", i.slice, "Generate slice sorters")
+	flags.BoolVar(&i.chunks, "chunks", i.chunks, "Generate sorters for every consecutive chunk of a slice")
+	flags.BoolVar(&i.strided, "strided", i.strided, "Generate sorters for items spaced 'stride' items apart, starting at 'off'")
+	flags.BoolVar(&i.lanes, "lanes", i.lanes, "Generate sorters for many arrays at once, stored in lane-major order")
+	flags.BoolVar(&i.merge, "merge", i.merge, "Generate functions that merge 'size' sorted runs (with a tournament, not a network)")
+	flags.BoolVar(&i.branchless, "branchless", i.branchless, "Compare and swap integers and floats with the min and max builtins instead of branching")
 	flags.BoolVar(&i.wrap, "wrap", i.wrap, "Generate wrapper sorter that chooses the right sort based on len(a)")
 	flags.Var(&i.export, "export", "Explicitly declare whether or not to export the following sorters. Defaults to 'true' for builtins and exported types")
 	flags.StringVar(&i.greaterTemplate, "greater", i.greaterTemplate, "Template for 'compare-and-swap' function")
 	flags.StringVar(&i.lessTemplate, "less", i.lessTemplate, "Like -greater, except used for reverse sorting")
+	flags.StringVar(&i.lessFunc, "lessfunc", i.lessFunc, "Name of a 'func(a, b T) bool' that reports whether a sorts before b, or an expression like '{{.A}} < {{.B}}'; used instead of -less and -greater")
+	flags.StringVar(&i.key, "key", i.key, "Order structs by comma separated field paths instead of using -less or -greater, for example 'Foo,-Bar.Baz' ('-' for descending)")
+	flags.StringVar(&i.alg, "alg", i.alg, "Networks to choose from: 'best', a family like 'bosenelson' or 'senso', or the name of a network like 'VanVoorhis16'")
+	flags.StringVar(&i.prefer, "prefer", i.prefer, "Choose the network with the fewest comparators ('size') or the smallest depth ('depth')")
+	flags.StringVar(&i.network, "network", i.network, "Generate sorters for the networks in this JSON or text file, instead of choosing one for each -size")
 	flags.Var(&i.sizes, "size", "Size set; comma separated list of individual sizes or ranges")
 }
 
@@ -106,6 +195,18 @@ func (i *inputFlags) BuildLessTemplate() (*template.Template, error) {
 	return lessTemplate, nil
 }
 
+func (i *inputFlags) BuildLessPredicate() (*template.Template, error) {
+	var err error
+	var lessPredicate *template.Template
+	if i.lessFunc != "" {
+		lessPredicate, err = BuildPredicateTemplate(i.lessFunc)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return lessPredicate, nil
+}
+
 func (i *inputFlags) BuildGreaterTemplate() (*template.Template, error) {
 	var err error
 	var greaterTemplate *template.Template
@@ -120,16 +221,22 @@ func (i *inputFlags) BuildGreaterTemplate() (*template.Template, error) {
 
 type Command struct {
 	inputFlags
-	pkg    string
-	prefix string
-	format bool
-	out    string
+	pkg     string
+	prefix  string
+	format  bool
+	out     string
+	generic bool
+	tests   bool
+	check   bool
 }
 
 func (cmd *Command) Flags(flags *flag.FlagSet) {
 	flags.StringVar(&cmd.pkg, "pkg", os.Getenv("GOPACKAGE"), "package name")
 	flags.StringVar(&cmd.out, "o", "sortnet_gen.go", "output file name ('-' for stdout)")
 	flags.BoolVar(&cmd.format, "format", true, "run gofmt on result")
+	flags.BoolVar(&cmd.generic, "generic", false, "Generate sorters with a type parameter instead of for each <input>; no inputs may be passed")
+	flags.BoolVar(&cmd.tests, "tests", false, "Also write a _test.go file next to the output, which tests, fuzzes and benchmarks every generated function")
+	flags.BoolVar(&cmd.check, "check", os.Getenv("SORTNETGEN_CHECK") != "", "Write nothing; print a diff and fail if the output file is out of date (defaults to true if SORTNETGEN_CHECK is set)")
 
 	cmd.inputFlags.slice = true
 	cmd.inputFlags.wrap = true
@@ -158,6 +265,9 @@ func (cmd *Command) readInputs(args []string) ([]Input, error) {
 		if len(args) == 0 {
 			break
 		}
+		if cmd.generic {
+			return nil, usageError("-generic does not accept any <input> types")
+		}
 
 		var arg string
 		arg, args = args[0], args[1:]
@@ -167,36 +277,118 @@ func (cmd *Command) readInputs(args []string) ([]Input, error) {
 		if err != nil {
 			return nil, err
 		}
-		input.Slice = curArgs.slice
-		input.Array = curArgs.array
-		input.Wrap = curArgs.wrap
-		input.Forward = curArgs.forward
-		input.Reverse = curArgs.reverse
-		input.Sizes = curArgs.sizes.items
-
-		if curArgs.export.IsSet {
-			exported := curArgs.export.Value
-			input.Export = &exported
+		input, err = curArgs.configure(input)
+		if err != nil {
+			return nil, fmt.Errorf("input %q failed at index %d: %w", arg, idx, err)
 		}
+		inputs = append(inputs, input)
+		idx++
+	}
+
+	if cmd.generic {
+		// One set of sorters for types that support '<', and one set that takes a
+		// 'less' function:
+		for _, lessParam := range []bool{false, true} {
+			input, err := curArgs.configure(Input{Type: "T", Generic: true, LessParam: lessParam})
+			if err != nil {
+				return nil, err
+			}
+			inputs = append(inputs, input)
+		}
+	}
+
+	return inputs, nil
+}
 
-		input.LessTemplate, err = curArgs.BuildLessTemplate()
+// configure applies the flags to input, then validates it.
+func (i *inputFlags) configure(input Input) (Input, error) {
+	var err error
+	input.Slice = i.slice
+	input.Array = i.array
+	input.Chunks = i.chunks
+	input.Strided = i.strided
+	input.Lanes = i.lanes
+	input.Merge = i.merge
+	input.Branchless = i.branchless
+	input.Alg = i.alg
+	input.Prefer = i.prefer
+	input.Wrap = i.wrap
+	input.Forward = i.forward
+	input.Reverse = i.reverse
+	input.Sizes = i.sizes.items
+
+	if input.Package != "" {
+		pkg, err := loadPackage(input.Package)
 		if err != nil {
-			return nil, err
+			return input, err
 		}
+		input.PackageName = pkg.Types.Name()
+	}
+
+	if i.export.IsSet {
+		exported := i.export.Value
+		input.Export = &exported
+	}
+
+	input.LessTemplate, err = i.BuildLessTemplate()
+	if err != nil {
+		return input, err
+	}
 
-		input.GreaterTemplate, err = curArgs.BuildGreaterTemplate()
+	input.GreaterTemplate, err = i.BuildGreaterTemplate()
+	if err != nil {
+		return input, err
+	}
+
+	if i.lessFunc != "" {
+		if input.Generic {
+			return input, fmt.Errorf("-lessfunc can't be used with -generic")
+		}
+		if input.LessTemplate != nil || input.GreaterTemplate != nil {
+			return input, fmt.Errorf("-lessfunc can't be used with -less or -greater")
+		}
+		input.LessPredicate, err = i.BuildLessPredicate()
 		if err != nil {
-			return nil, err
+			return input, err
 		}
-		if err := input.Validate(); err != nil {
-			return nil, fmt.Errorf("input %q failed at index %d: %w", arg, idx, err)
+	}
+
+	if i.key != "" {
+		if i.lessFunc != "" {
+			return input, fmt.Errorf("-key can't be used with -lessfunc")
+		}
+		if input.Generic {
+			return input, fmt.Errorf("-key can't be used with -generic")
+		}
+		if input.LessTemplate != nil || input.GreaterTemplate != nil {
+			return input, fmt.Errorf("-key can't be used with -less or -greater")
 		}
+		input.Key = i.key
+		if err := input.resolveKey(); err != nil {
+			return input, err
+		}
+	}
 
-		inputs = append(inputs, input)
-		idx++
+	if i.network != "" {
+		if err := input.useNetworks(i.network); err != nil {
+			return input, err
+		}
+	} else if input.sorts() {
+		if err := input.chooseNetworks(); err != nil {
+			return input, err
+		}
 	}
 
-	return inputs, nil
+	if input.needsOrdering() {
+		if err := input.discoverOrdering(); err != nil {
+			return input, err
+		}
+	}
+
+	if err := input.Validate(); err != nil {
+		return input, err
+	}
+	return input, nil
 }
 
 func (cmd *Command) Run(args ...string) (err error) {
@@ -206,6 +398,12 @@ func (cmd *Command) Run(args ...string) (err error) {
 	if cmd.pkg == "" {
 		return usageError("-pkg not set")
 	}
+	if cmd.tests && cmd.out == "-" {
+		return usageError("-tests can't be used with '-o -'")
+	}
+	if cmd.check && cmd.out == "-" {
+		return usageError("-check can't be used with '-o -'")
+	}
 
 	inputs, err := cmd.readInputs(args)
 	if err != nil {
@@ -215,14 +413,18 @@ func (cmd *Command) Run(args ...string) (err error) {
 	var buf bytes.Buffer
 
 	{ // File init
-		const preamble = "// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT."
-		buf.WriteString(preamble)
+		buf.WriteString(generatedPreamble)
 		buf.WriteString("\n\n")
 		buf.WriteString(fmt.Sprintf("package %s\n\n", cmd.pkg))
+		buf.WriteString(networksComment(inputs))
 
+		imported := map[string]bool{}
 		for _, input := range inputs {
-			if input.Package != "" {
-				buf.WriteString(fmt.Sprintf("import %q\n", input.Package))
+			for _, pkg := range input.imports() {
+				if !imported[pkg] {
+					imported[pkg] = true
+					buf.WriteString(fmt.Sprintf("import %q\n", pkg))
+				}
 			}
 		}
 	}
@@ -235,7 +437,7 @@ func (cmd *Command) Run(args ...string) (err error) {
 		var gens []gen
 		for inputIndex, input := range inputs {
 			for _, sz := range input.Sizes {
-				net := sortnet.New(sz)
+				net := input.network(sz)
 				g := gen{
 					Input:    input,
 					Exported: input.isExported(),
@@ -288,40 +490,98 @@ func (cmd *Command) Run(args ...string) (err error) {
 		}
 	}
 
+	{ // Key functions, once for each type
+		written := map[string]string{}
+		for _, input := range inputs {
+			if input.KeyFunc == "" {
+				continue
+			}
+			name := input.keyFuncName()
+			if existing, ok := written[name]; ok {
+				if existing != input.KeyFunc {
+					return fmt.Errorf("type %q may only be given one -key", input.Type)
+				}
+				continue
+			}
+			written[name] = input.KeyFunc
+			buf.WriteString("\n")
+			buf.WriteString(input.KeyFunc)
+		}
+	}
+
 	// Wrappers should go above individual functions:
 	buf.Write(genBuf.Bytes())
 
-	var out = buf.Bytes()
+	var stale []string
+	if ok, err := cmd.write(cmd.out, buf.Bytes()); err != nil {
+		return err
+	} else if !ok {
+		stale = append(stale, cmd.out)
+	}
 
-	{ // Gofmt
-		if cmd.format {
-			var err error
-			out, err = imports.Process(cmd.out, out, nil)
-			if err != nil {
-				return err
-			}
+	if cmd.tests {
+		name := testsFileName(cmd.out)
+		tests, err := generateTests(cmd.pkg, cmd.out, inputs)
+		if err != nil {
+			return err
+		}
+		if ok, err := cmd.write(name, tests); err != nil {
+			return err
+		} else if !ok {
+			stale = append(stale, name)
 		}
 	}
 
-	{ // Write output
-		if cmd.out == "-" {
-			os.Stdout.Write(out)
-		} else {
-			var write bool
-			existing, err := ioutil.ReadFile(cmd.out)
-			if os.IsNotExist(err) || err == nil {
-				write = true
-			} else if err != nil {
-				return err
-			} else if !bytes.Equal(out, existing) {
-				write = true
-			}
+	if len(stale) > 0 {
+		return fmt.Errorf("out of date: %s; rerun sortnetgen without -check", strings.Join(stale, ", "))
+	}
+	return nil
+}
 
-			if write {
-				return ioutil.WriteFile(cmd.out, out, 0644)
-			}
+// write formats the generated source in out if -format is set, then writes it to the
+// file called name ('-' for stdout), unless the file already holds it.
+//
+// If -check is set, nothing is written. Instead, if the file doesn't hold out, a diff
+// is printed and ok is false.
+func (cmd *Command) write(name string, out []byte) (ok bool, err error) {
+	if cmd.format {
+		out, err = imports.Process(name, out, nil)
+		if err != nil {
+			return false, err
 		}
 	}
 
-	return nil
+	if name == "-" {
+		_, err := os.Stdout.Write(out)
+		return err == nil, err
+	}
+
+	existing, same, err := compareFile(name, out)
+	if err != nil {
+		return false, err
+	}
+	if cmd.check {
+		if !same {
+			fmt.Print(unifiedDiff(name, existing, out))
+		}
+		return same, nil
+	}
+	if !same {
+		if err := ioutil.WriteFile(name, out, 0644); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// compareFile reports whether the file called name holds out, and returns what it
+// holds. A file that doesn't exist holds nothing.
+func compareFile(name string, out []byte) (existing []byte, same bool, err error) {
+	existing, err = ioutil.ReadFile(name)
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return existing, bytes.Equal(out, existing), nil
 }
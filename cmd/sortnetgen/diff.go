@@ -0,0 +1,159 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines shown around each change by
+// unifiedDiff.
+const diffContext = 3
+
+// diffMaxCells limits the size of the table used to find the longest common
+// subsequence of the changed lines in unifiedDiff. Past it, all of the old lines are
+// shown as removed and all of the new ones as added, which is still correct.
+const diffMaxCells = 1 << 22
+
+// diffLine is a line of a diff: ' ' if it is in both files, '-' if it was removed from
+// the old one and '+' if it was added in the new one.
+type diffLine struct {
+	op   byte
+	text string
+}
+
+// unifiedDiff returns a unified diff from old to new, the existing and generated
+// contents of the file called name, or an empty string if they are the same.
+func unifiedDiff(name string, old, new []byte) string {
+	lines := diffLines(splitLines(string(old)), splitLines(string(new)))
+
+	var buf strings.Builder
+	var oldLine, newLine int // Lines before lines[i] in each file
+	for i := 0; i < len(lines); {
+		if lines[i].op == ' ' {
+			oldLine, newLine = oldLine+1, newLine+1
+			i++
+			continue
+		}
+
+		// A hunk starts with up to diffContext unchanged lines, and runs until there
+		// are more than twice that many between changes:
+		start := max(0, i-diffContext)
+		end, unchanged := i, 0
+		for ; end < len(lines) && unchanged <= 2*diffContext; end++ {
+			if lines[end].op == ' ' {
+				unchanged++
+			} else {
+				unchanged = 0
+			}
+		}
+		end -= max(0, unchanged-diffContext)
+
+		oldStart, newStart := oldLine-(i-start), newLine-(i-start)
+		var oldCount, newCount int
+		for _, line := range lines[start:end] {
+			if line.op != '+' {
+				oldCount++
+			}
+			if line.op != '-' {
+				newCount++
+			}
+		}
+
+		if buf.Len() == 0 {
+			fmt.Fprintf(&buf, "--- %s\n+++ %s (generated)\n", name, name)
+		}
+		fmt.Fprintf(&buf, "@@ -%s +%s @@\n", hunkRange(oldStart, oldCount), hunkRange(newStart, newCount))
+		for _, line := range lines[start:end] {
+			buf.WriteByte(line.op)
+			buf.WriteString(line.text)
+			if !strings.HasSuffix(line.text, "\n") {
+				// Only the last line of a file can be missing its newline:
+				buf.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+
+		oldLine, newLine = oldStart+oldCount, newStart+newCount
+		i = end
+	}
+	return buf.String()
+}
+
+// hunkRange formats the start and number of lines of one side of a hunk. Lines are
+// numbered from 1, except that an empty range gives the line before it.
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+// splitLines splits s into lines, keeping their newlines.
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// diffLines returns the lines of a diff from a to b. Lines at the start and end that
+// are the same in both are matched first, so that the usual small change to a large
+// generated file only needs a small table.
+func diffLines(a, b []string) []diffLine {
+	var prefix, suffix int
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var out []diffLine
+	for _, line := range a[:prefix] {
+		out = append(out, diffLine{' ', line})
+	}
+
+	am, bm := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	if (len(am)+1)*(len(bm)+1) > diffMaxCells {
+		for _, line := range am {
+			out = append(out, diffLine{'-', line})
+		}
+		for _, line := range bm {
+			out = append(out, diffLine{'+', line})
+		}
+	} else {
+		// lcs[i][j] is the length of the longest common subsequence of am[i:] and bm[j:]:
+		lcs := make([][]int, len(am)+1)
+		for i := range lcs {
+			lcs[i] = make([]int, len(bm)+1)
+		}
+		for i := len(am) - 1; i >= 0; i-- {
+			for j := len(bm) - 1; j >= 0; j-- {
+				if am[i] == bm[j] {
+					lcs[i][j] = lcs[i+1][j+1] + 1
+				} else {
+					lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+				}
+			}
+		}
+		i, j := 0, 0
+		for i < len(am) || j < len(bm) {
+			switch {
+			case i < len(am) && j < len(bm) && am[i] == bm[j]:
+				out = append(out, diffLine{' ', am[i]})
+				i, j = i+1, j+1
+			case j == len(bm) || (i < len(am) && lcs[i+1][j] >= lcs[i][j+1]):
+				out = append(out, diffLine{'-', am[i]})
+				i++
+			default:
+				out = append(out, diffLine{'+', bm[j]})
+				j++
+			}
+		}
+	}
+
+	for _, line := range a[len(a)-suffix:] {
+		out = append(out, diffLine{' ', line})
+	}
+	return out
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// numbered returns the lines "1\n" to "n\n", with the ones in changed replaced by
+// their value in it.
+func numbered(n int, changed map[int]string) []byte {
+	var buf bytes.Buffer
+	for i := 1; i <= n; i++ {
+		if s, ok := changed[i]; ok {
+			buf.WriteString(s + "\n")
+		} else {
+			fmt.Fprintf(&buf, "%d\n", i)
+		}
+	}
+	return buf.Bytes()
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	const header = "--- f.go\n+++ f.go (generated)\n"
+
+	for _, tc := range []struct {
+		name     string
+		old, new []byte
+		diff     string
+	}{
+		{"identical", numbered(20, nil), numbered(20, nil), ""},
+
+		{"both-empty", nil, nil, ""},
+
+		{"missing", nil, []byte("a\nb\n"), header +
+			"@@ -0,0 +1,2 @@\n+a\n+b\n"},
+
+		{"emptied", []byte("a\nb\n"), nil, header +
+			"@@ -1,2 +0,0 @@\n-a\n-b\n"},
+
+		// The expected diffs are the ones from 'diff -u':
+		{"one-hunk", numbered(20, nil), numbered(20, map[int]string{5: "five", 10: "ten"}), header +
+			"@@ -2,12 +2,12 @@\n 2\n 3\n 4\n-5\n+five\n 6\n 7\n 8\n 9\n-10\n+ten\n 11\n 12\n 13\n"},
+
+		{"two-hunks", numbered(20, nil), numbered(20, map[int]string{3: "three", 15: "fifteen"}), header +
+			"@@ -1,6 +1,6 @@\n 1\n 2\n-3\n+three\n 4\n 5\n 6\n" +
+			"@@ -12,7 +12,7 @@\n 12\n 13\n 14\n-15\n+fifteen\n 16\n 17\n 18\n"},
+
+		{"insert", []byte("a\nc\n"), []byte("a\nb\nc\n"), header +
+			"@@ -1,2 +1,3 @@\n a\n+b\n c\n"},
+
+		{"no-newline", []byte("a\nb"), []byte("a\nc"), header +
+			"@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+c\n\\ No newline at end of file\n"},
+		{"newline-added", []byte("a\nb"), []byte("a\nb\n"), header +
+			"@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+b\n"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := unifiedDiff("f.go", tc.old, tc.new); diff != tc.diff {
+				t.Fatalf("expected:\n%s\nfound:\n%s", tc.diff, diff)
+			}
+		})
+	}
+}
+
+func TestUnifiedDiffTooBig(t *testing.T) {
+	// Too many changed lines for the table, so the common line in the middle is shown
+	// as removed and added again rather than kept:
+	var old, new bytes.Buffer
+	n := 2100
+	for i := 0; i < n; i++ {
+		if i == n/2 {
+			old.WriteString("common\n")
+			new.WriteString("common\n")
+		}
+		fmt.Fprintf(&old, "old %d\n", i)
+		fmt.Fprintf(&new, "new %d\n", i)
+	}
+	if (n+2)*(n+2) <= diffMaxCells {
+		t.Fatal("test needs more lines")
+	}
+
+	diff := unifiedDiff("f.go", old.Bytes(), new.Bytes())
+	lines := strings.Split(strings.TrimSuffix(diff, "\n"), "\n")
+	if hunk := fmt.Sprintf("@@ -1,%d +1,%[1]d @@", n+1); lines[2] != hunk {
+		t.Fatal(lines[2])
+	}
+	lines = lines[3:]
+	if len(lines) != 2*(n+1) {
+		t.Fatal(len(lines))
+	}
+	for i, line := range lines {
+		if op := "-+"[i/(n+1)]; line[0] != op {
+			t.Fatalf("line %d: expected %q, found %q", i, op, line)
+		}
+	}
+}
+
+// runCommand runs sortnetgen with args, returning what it printed to stdout.
+func runCommand(t *testing.T, args ...string) (stdout string, err error) {
+	t.Helper()
+
+	var cmd Command
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	cmd.Flags(fs)
+	if err := fs.Parse(args); err != nil {
+		t.Fatal(err)
+	}
+
+	r, w, perr := os.Pipe()
+	if perr != nil {
+		t.Fatal(perr)
+	}
+	orig := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = orig }()
+
+	done := make(chan string)
+	go func() {
+		out, _ := io.ReadAll(r)
+		done <- string(out)
+	}()
+	err = cmd.Run(fs.Args()...)
+	w.Close()
+	return <-done, err
+}
+
+func TestCheck(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "sortnet_gen.go")
+	args := []string{"-o", out, "-pkg", "checked", "-size", "2-3", "int"}
+
+	// A missing file is out of date:
+	stdout, err := runCommand(t, append([]string{"-check"}, args...)...)
+	if err == nil || err.Error() != fmt.Sprintf("out of date: %s; rerun sortnetgen without -check", out) {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(stdout, "--- "+out+"\n+++ "+out+" (generated)\n@@ -0,0 +1,") {
+		t.Fatal(stdout)
+	}
+	if _, err := os.Stat(out); !os.IsNotExist(err) {
+		t.Fatal("-check should not write anything", err)
+	}
+
+	if _, err := runCommand(t, args...); err != nil {
+		t.Fatal(err)
+	}
+	stdout, err = runCommand(t, append([]string{"-check"}, args...)...)
+	if err != nil || stdout != "" {
+		t.Fatal(err, stdout)
+	}
+
+	// SORTNETGEN_CHECK turns on -check, and a changed size makes the file out of date:
+	t.Setenv("SORTNETGEN_CHECK", "1")
+	before, _ := os.ReadFile(out)
+	stdout, err = runCommand(t, "-o", out, "-pkg", "checked", "-size", "2-4", "int")
+	if err == nil || !strings.Contains(stdout, "\n+func NetworkSort4xInt(a []int) {\n") {
+		t.Fatal(err, stdout)
+	}
+	if after, _ := os.ReadFile(out); !bytes.Equal(before, after) {
+		t.Fatal("-check should not write anything")
+	}
+}
+
+func TestCheckExitStatus(t *testing.T) {
+	if dir := os.Getenv("SORTNETGEN_TEST_CHECK_DIR"); dir != "" {
+		os.Args = []string{"sortnetgen", "-check", "-o", filepath.Join(dir, "sortnet_gen.go"), "-pkg", "checked", "-size", "2", "int"}
+		main()
+		return
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=^TestCheckExitStatus$")
+	cmd.Env = append(os.Environ(), "SORTNETGEN_TEST_CHECK_DIR="+t.TempDir())
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	if exit, ok := err.(*exec.ExitError); !ok || exit.ExitCode() != 1 {
+		t.Fatal("expected exit status 1:", err)
+	}
+	if !strings.Contains(stderr.String(), "out of date:") {
+		t.Fatal(stderr.String())
+	}
+}
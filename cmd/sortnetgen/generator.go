@@ -3,6 +3,7 @@ package main
 import (
 	"bytes"
 	"fmt"
+	"sort"
 	"strings"
 	"text/template"
 
@@ -21,7 +22,7 @@ func (g gen) SortKey() string {
 	if !g.Forwards {
 		dir = 2
 	}
-	return fmt.Sprintf("%s/%s/%012d/%d", g.Input.Package, g.Input.Type, g.Network.Size, dir)
+	return fmt.Sprintf("%s/%s%s/%012d/%d", g.Input.Package, g.Input.typeName(), g.Input.genericName(), g.Network.Size, dir)
 }
 
 func (g gen) Last() int {
@@ -36,47 +37,353 @@ func (g gen) ArrayName() string {
 	return g.Input.name(g.Input.isExported(), g.Network.Size, g.Forwards, "Array")
 }
 
-var genFuncs = template.FuncMap{
-	"cas": func(input Input, fwd bool, op sortnet.CompareAndSwap) string {
-		var buf bytes.Buffer
-		var tpl, other *template.Template
-
-		if fwd {
-			tpl, other = input.GreaterTemplate, input.LessTemplate
-		} else {
-			tpl, other = input.LessTemplate, input.GreaterTemplate
-		}
+func (g gen) ChunksName() string {
+	return g.Input.name(g.Input.isExported(), g.Network.Size, g.Forwards, "Chunks")
+}
+
+func (g gen) LanesName() string {
+	return g.Input.name(g.Input.isExported(), g.Network.Size, g.Forwards, "Lanes")
+}
+
+func (g gen) MergeName() string {
+	return g.Input.nameWith("Merge", g.Input.isExported(), g.Network.Size, g.Forwards, "")
+}
+
+// MergeParams returns the parameter list for the runs passed to a -merge function.
+func (g gen) MergeParams() string {
+	return joinf(g.Network.Size, "r%d")
+}
+
+func (g gen) StridedName() string {
+	return g.Input.name(g.Input.isExported(), g.Network.Size, g.Forwards, "Strided")
+}
+
+// Indexes returns the index of each item in the network, for use with templates that
+// range over the inputs rather than the comparators.
+func (g gen) Indexes() []int {
+	out := make([]int, g.Network.Size)
+	for i := range out {
+		out[i] = i
+	}
+	return out
+}
 
-		if tpl != nil {
-			if err := tpl.Execute(&buf, op); err != nil {
-				panic(err)
+// networksComment describes the networks behind the sorters generated for inputs,
+// for the top of the generated file.
+func networksComment(inputs []Input) string {
+	var nets []sortnet.Network
+	seen := map[string]bool{}
+	for _, input := range inputs {
+		if !input.sorts() {
+			continue
+		}
+		for _, sz := range input.Sizes {
+			net := input.network(sz)
+			if fp := net.Fingerprint(); !seen[fp] {
+				seen[fp] = true
+				nets = append(nets, net)
 			}
-		} else {
-			if err := other.Execute(&buf, op.Reverse()); err != nil {
-				panic(err)
+		}
+	}
+	if len(nets) == 0 {
+		return ""
+	}
+	sort.SliceStable(nets, func(i, j int) bool { return nets[i].Size < nets[j].Size })
+
+	var buf strings.Builder
+	buf.WriteString("// Sorting networks used in this file:\n//\n")
+	for _, net := range nets {
+		meta := net.Meta
+		comparators := "comparators"
+		if len(net.Ops) == 1 {
+			comparators = "comparator"
+		}
+		fmt.Fprintf(&buf, "//   - %s, %d inputs: %d %s (%s), depth %d (%s)\n",
+			net.Kind, net.Size, len(net.Ops), comparators, meta.SizeOptimality, net.Depth, meta.DepthOptimality)
+		fmt.Fprintf(&buf, "//     Fingerprint %s\n", net.Fingerprint())
+		if meta.Discoverer != "" {
+			fmt.Fprintf(&buf, "//     %s", meta.Discoverer)
+			if meta.Year != 0 {
+				fmt.Fprintf(&buf, " (%d)", meta.Year)
 			}
+			buf.WriteString("\n")
+		}
+		if meta.Reference != "" {
+			fmt.Fprintf(&buf, "//     %s\n", meta.Reference)
+		}
+	}
+	buf.WriteString("\n")
+	return buf.String()
+}
+
+// casIndex is passed to the -less and -greater templates. From and To are Go expressions
+// that index into the slice or array 'a'.
+type casIndex struct {
+	From string
+	To   string
+}
+
+func (c casIndex) Reverse() casIndex {
+	c.From, c.To = c.To, c.From
+	return c
+}
+
+// casf renders the compare-and-swap for op, using idxFmt to turn each of the op's
+// indexes into an expression that indexes into 'a' (for example "i%d" for a set of
+// precalculated indexes).
+func casf(g gen, idxFmt string, op sortnet.CompareAndSwap) string {
+	var buf bytes.Buffer
+	var tpl, other *template.Template
+
+	if g.Forwards {
+		tpl, other = g.Input.GreaterTemplate, g.Input.LessTemplate
+	} else {
+		tpl, other = g.Input.LessTemplate, g.Input.GreaterTemplate
+	}
+
+	idx := casIndex{From: fmt.Sprintf(idxFmt, op.From), To: fmt.Sprintf(idxFmt, op.To)}
+	if tpl != nil {
+		if err := tpl.Execute(&buf, idx); err != nil {
+			panic(err)
+		}
+	} else {
+		if err := other.Execute(&buf, idx.Reverse()); err != nil {
+			panic(err)
 		}
-		return strings.TrimSpace(buf.String()) + "\n"
+	}
+	return strings.TrimSpace(buf.String()) + "\n"
+}
+
+// minmax renders a branch-free compare-and-swap of the already loaded values 'x' and
+// 'y' using the min and max builtins, which only works for builtin ordered types.
+func minmax(g gen, from, to string) string {
+	lo, hi := "min", "max"
+	if !g.Forwards {
+		lo, hi = hi, lo
+	}
+	return fmt.Sprintf("%s, %s = %s(x, y), %s(x, y)\n", from, to, lo, hi)
+}
+
+// mergeBody renders the body of a -merge function. No sorting network is involved:
+// the network's Size is the number of runs, and their heads play a tournament of
+// single comparisons, where the winner is moved from its run to dst. Only
+// the matches between the winner's run and the final need to be replayed with the
+// run's new head, which the code for each run does without any loops. Ties go to the
+// earlier run, so the merge is stable.
+func mergeBody(g gen) string {
+	k := g.Network.Size
+	if k == 1 {
+		return "return append(dst, r0...)\n"
+	}
+
+	// before renders an expression that reports whether x should come before y:
+	before := func(x, y string) string {
+		switch {
+		case g.Input.LessPredicate != nil && g.Forwards:
+			return predicate(g.Input.LessPredicate, x, y)
+		case g.Input.LessPredicate != nil:
+			return predicate(g.Input.LessPredicate, y, x)
+		case g.Forwards:
+			return fmt.Sprintf("%s < %s", x, y)
+		default:
+			return fmt.Sprintf("%s > %s", x, y)
+		}
+	}
+
+	// A player is the suffix shared by its w (run index), v (value) and ok (run not
+	// empty) variables. The leaves' run indexes are constants:
+	type player struct {
+		w, id  string
+		parent int // index into matches
+	}
+	type match struct {
+		a, b, out *player
+	}
+
+	leaves := make([]*player, k)
+	for i := range leaves {
+		leaves[i] = &player{w: fmt.Sprint(i), id: fmt.Sprint(i)}
+	}
+
+	var matches []match
+	for players := leaves; len(players) > 1; {
+		var next []*player
+		for i := 0; i+1 < len(players); i += 2 {
+			n := len(matches)
+			out := &player{w: fmt.Sprintf("wm%d", n), id: fmt.Sprintf("m%d", n)}
+			players[i].parent, players[i+1].parent = n, n
+			matches = append(matches, match{players[i], players[i+1], out})
+			next = append(next, out)
+		}
+		if len(players)%2 == 1 {
+			next = append(next, players[len(players)-1])
+		}
+		players = next
+	}
+	final := matches[len(matches)-1].out
+	final.parent = -1
+
+	play := func(buf *bytes.Buffer, m match) {
+		a, b, c := m.a, m.b, m.out
+		fmt.Fprintf(buf, "%s, v%s, ok%s = %s, v%s, ok%s\n", c.w, c.id, c.id, a.w, a.id, a.id)
+		fmt.Fprintf(buf, "if ok%s && (!ok%s || %s) {\n", b.id, a.id, before("v"+b.id, "v"+a.id))
+		fmt.Fprintf(buf, "%s, v%s, ok%s = %s, v%s, true\n}\n", c.w, c.id, c.id, b.w, b.id)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "var %s, %s %s\n", joinf(k, "v%d"), joinf(len(matches), "vm%d"), g.Input.TypeExpr())
+	fmt.Fprintf(&buf, "var %s int\n", joinf(len(matches), "wm%d"))
+	fmt.Fprintf(&buf, "var %s bool\n", joinf(len(matches), "okm%d"))
+	fmt.Fprintf(&buf, "%s := %s\n", joinf(k, "ok%d"), joinf(k, "len(r%d) > 0"))
+	for i := 0; i < k; i++ {
+		fmt.Fprintf(&buf, "if ok%d {\nv%d = r%d[0]\n}\n", i, i, i)
+	}
+	for _, m := range matches {
+		play(&buf, m)
+	}
+
+	fmt.Fprintf(&buf, "\nfor ok%s {\n", final.id)
+	fmt.Fprintf(&buf, "dst = append(dst, v%s)\n", final.id)
+	fmt.Fprintf(&buf, "switch %s {\n", final.w)
+	for i, leaf := range leaves {
+		fmt.Fprintf(&buf, "case %d:\n", i)
+		fmt.Fprintf(&buf, "r%d = r%d[1:]\n", i, i)
+		fmt.Fprintf(&buf, "if ok%d = len(r%d) > 0; ok%d {\nv%d = r%d[0]\n}\n", i, i, i, i, i)
+		for p := leaf.parent; p >= 0; p = matches[p].out.parent {
+			play(&buf, matches[p])
+		}
+	}
+	buf.WriteString("}\n}\nreturn dst\n")
+	return buf.String()
+}
+
+// joinf formats each index from 0 to n-1 with format, then joins the results with
+// commas.
+func joinf(n int, format string) string {
+	out := make([]string, n)
+	for i := range out {
+		out[i] = fmt.Sprintf(format, i)
+	}
+	return strings.Join(out, ", ")
+}
+
+var genFuncs = template.FuncMap{
+	"cas": func(g gen, op sortnet.CompareAndSwap) string {
+		return casf(g, "%d", op)
 	},
+	"casf":      casf,
+	"minmax":    minmax,
+	"mergeBody": mergeBody,
 }
 
-var genTpl = template.Must(template.New("").Funcs(genFuncs).Parse(`
+// nanNoteTpl defines the note added to the docs of sorters that compare floats with the
+// min and max builtins. It is shared by genTpl and wrapperTpl.
+const nanNoteTpl = `
+{{- define "nanNote" }}
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+{{- end }}`
+
+var genTpl = template.Must(template.New("").Funcs(genFuncs).Parse(nanNoteTpl + `
 {{ if .Input.Slice }}
-func {{.SliceName}}(a []{{.Input.Type}}) {
+{{- if and .Input.Branchless .Input.Float }}
+// {{.SliceName}} sorts a using the min and max builtins.
+{{- template "nanNote" . }}
+{{- end }}
+func {{.SliceName}}{{.Input.TypeParams}}(a []{{.Input.TypeExpr}}{{.Input.Params}}) {
 	_ = a[{{.Last}}]
 	{{ range .Network.Ops }}
-	{{- cas $.Input $.Forwards . }}
+	{{- cas $ . }}
 	{{- end -}}
 }
 {{ end }}
 
 {{ if .Input.Array }}
-func {{.ArrayName}}(a *[{{.Network.Size}}]{{.Input.Type}}) {
+{{- if and .Input.Branchless .Input.Float }}
+// {{.ArrayName}} sorts a using the min and max builtins.
+{{- template "nanNote" . }}
+{{- end }}
+func {{.ArrayName}}{{.Input.TypeParams}}(a *[{{.Network.Size}}]{{.Input.TypeExpr}}{{.Input.Params}}) {
 	{{ range .Network.Ops }}
-	{{- cas $.Input $.Forwards . }}
+	{{- cas $ . }}
 	{{- end -}}
 }
 {{ end }}
+
+{{ if .Input.Chunks }}
+// {{.ChunksName}} sorts every consecutive chunk of {{.Network.Size}} items in a.
+//
+// len(a) must be a multiple of {{.Network.Size}}, otherwise {{.ChunksName}} will panic.
+{{- if and .Input.Branchless .Input.Float }}{{ template "nanNote" . }}{{ end }}
+func {{.ChunksName}}{{.Input.TypeParams}}(a []{{.Input.TypeExpr}}{{.Input.Params}}) {
+	if len(a)%{{.Network.Size}} != 0 {
+		panic("{{.ChunksName}}: len(a) is not a multiple of {{.Network.Size}}")
+	}
+	for ; len(a) >= {{.Network.Size}}; a = a[{{.Network.Size}}:] {
+		a := a[:{{.Network.Size}}:{{.Network.Size}}]
+		{{ range .Network.Ops }}
+		{{- cas $ . }}
+		{{- end -}}
+	}
+}
+{{ end }}
+
+{{ if .Input.Strided }}
+// {{.StridedName}} sorts the {{.Network.Size}} items of a that start at index 'off'
+// and are spaced 'stride' items apart, leaving the items in between untouched.
+{{- if and .Input.Branchless .Input.Float }}{{ template "nanNote" . }}{{ end }}
+func {{.StridedName}}{{.Input.TypeParams}}(a []{{.Input.TypeExpr}}, off, stride int{{.Input.Params}}) {
+	i0 := off
+	{{- range $i := .Indexes }}{{ if $i }}
+	i{{$i}} := off + {{$i}}*stride
+	{{- end }}{{ end }}
+	{{ range .Network.Ops }}
+	{{- casf $ "i%d" . }}
+	{{- end -}}
+}
+{{ end }}
+
+{{ if .Input.Lanes }}
+// {{.LanesName}} sorts 'lanes' independent arrays of {{.Network.Size}} items, which
+// are stored in lane-major order: item i of array l is at a[i*lanes+l].
+{{- if and .Input.Ordered .Input.Float }}{{ template "nanNote" . }}{{ end }}
+func {{.LanesName}}{{.Input.TypeParams}}(a []{{.Input.TypeExpr}}, lanes int{{.Input.Params}}) {
+	if lanes <= 0 {
+		return
+	}
+	_ = a[{{.Network.Size}}*lanes-1]
+	i0 := 0
+	{{- range $i := .Indexes }}{{ if $i }}
+	i{{$i}} := {{$i}} * lanes
+	{{- end }}{{ end }}
+	{{ range .Network.Ops }}
+	{{- if $.Input.Ordered }}
+	{
+		from, to := a[i{{.From}}:i{{.From}}+lanes], a[i{{.To}}:i{{.To}}+lanes]
+		for l, x := range from {
+			y := to[l]
+			{{ minmax $ "from[l]" "to[l]" -}}
+		}
+	}
+	{{- else }}
+	for l := 0; l < lanes; l++ {
+		{{ casf $ "i%d+l" . -}}
+	}
+	{{- end }}
+	{{- end }}
+}
+{{ end }}
+
+{{ if .Input.Merge }}
+// {{.MergeName}} merges {{.Network.Size}} runs, each of which must already be sorted
+// in {{ if .Forwards }}increasing{{ else }}decreasing{{ end }} order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func {{.MergeName}}{{.Input.TypeParams}}(dst []{{.Input.TypeExpr}}, {{.MergeParams}} []{{.Input.TypeExpr}}{{.Input.Params}}) []{{.Input.TypeExpr}} {
+	{{ mergeBody $ -}}
+}
+{{ end }}
 `))
 
 var defaultCASGreaterTpl = template.Must(template.New("").Parse(`
@@ -91,6 +398,47 @@ if a[{{.From}}] < a[{{.To}}] {
 }
 `))
 
+// branchlessCASGreaterTpl and branchlessCASLessTpl are the comparators for -branchless,
+// which the compiler turns into conditional moves. The right hand side is evaluated
+// before either item is assigned.
+var branchlessCASGreaterTpl = template.Must(template.New("").Parse(`
+a[{{.From}}], a[{{.To}}] = min(a[{{.From}}], a[{{.To}}]), max(a[{{.From}}], a[{{.To}}])
+`))
+
+var branchlessCASLessTpl = template.Must(template.New("").Parse(`
+a[{{.From}}], a[{{.To}}] = max(a[{{.From}}], a[{{.To}}]), min(a[{{.From}}], a[{{.To}}])
+`))
+
+// lessParamPredicate is the LessPredicate for -generic sorters that take a 'less'
+// function.
+var lessParamPredicate = template.Must(template.New("").Parse(`less({{.A}}, {{.B}})`))
+
+// predicate renders an Input's LessPredicate for the expressions a and b.
+func predicate(pred *template.Template, a, b string) string {
+	var buf bytes.Buffer
+	if err := pred.Execute(&buf, struct{ A, B string }{a, b}); err != nil {
+		panic(err)
+	}
+	return buf.String()
+}
+
+// predicateCASTemplates derives the compare-and-swap templates for both directions
+// from a LessPredicate.
+func predicateCASTemplates(pred *template.Template) (less, greater *template.Template) {
+	funcs := template.FuncMap{
+		"before": func(x, y string) string {
+			return predicate(pred, "a["+x+"]", "a["+y+"]")
+		},
+	}
+	const swap = `
+	a[{{.From}}], a[{{.To}}] = a[{{.To}}], a[{{.From}}]
+}
+`
+	less = template.Must(template.New("").Funcs(funcs).Parse("\nif {{before .From .To}} {" + swap))
+	greater = template.Must(template.New("").Funcs(funcs).Parse("\nif {{before .To .From}} {" + swap))
+	return less, greater
+}
+
 type wrapperKey struct {
 	Input    int
 	Forwards bool
@@ -108,7 +456,7 @@ func (w wrapperGen) SortKey() string {
 	if !w.Forwards {
 		dir = 2
 	}
-	return fmt.Sprintf("%s/%s/%d", w.Input.Package, w.Input.Type, dir)
+	return fmt.Sprintf("%s/%s%s/%d", w.Input.Package, w.Input.typeName(), w.Input.genericName(), dir)
 }
 
 func (w wrapperGen) Name() string {
@@ -120,20 +468,24 @@ func (w wrapperGen) Name() string {
 	if !w.Forwards {
 		suffix = "Reverse"
 	}
-	return fmt.Sprintf("%s%s%s", prefix, ucfirst(w.Input.Type), suffix)
+	if w.Input.Generic {
+		return fmt.Sprintf("%s%s%s", prefix, w.Input.genericName(), suffix)
+	}
+	return fmt.Sprintf("%s%s%s", prefix, w.Input.typeName(), suffix)
 }
 
-var wrapperTpl = template.Must(template.New("").Parse(`
+var wrapperTpl = template.Must(template.New("").Parse(nanNoteTpl + `
 {{ if .Input.Wrap }}
 // {{.Name}} sorts the input according to its length ('sz') using a sorting network, if
 // one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
 // you to perform your own sort as a fallback.
+{{- if and .Input.Branchless .Input.Float }}{{ template "nanNote" . }}{{ end }}
 //
-func {{.Name}}(a []{{.Input.Type}}, sz int) (ok bool) {
+func {{.Name}}{{.Input.TypeParams}}(a []{{.Input.TypeExpr}}, sz int{{.Input.Params}}) (ok bool) {
 	switch sz {
 	{{- range $sz, $name := .Methods }}
 	case {{$sz}}:
-		{{$name}}(a)
+		{{$name}}(a{{$.Input.Args}})
 	{{- end }}
 	default:
 		return false
@@ -1,11 +1,15 @@
 module github.com/shabbyrobe/sortnet/cmd/sortnetgen
 
-go 1.13
+go 1.25.0
 
 require (
 	github.com/shabbyrobe/sortnet v0.0.0-20191013053122-5df528280717
-	github.com/shabbyrobe/sortnet/cmd/sortnet v0.0.0-20191013134754-363fa52a7c87 // indirect
-	golang.org/x/tools v0.0.0-20191012152004-8de300cfc20a
+	golang.org/x/tools v0.47.0
+)
+
+require (
+	golang.org/x/mod v0.37.0 // indirect
+	golang.org/x/sync v0.21.0 // indirect
 )
 
 replace github.com/shabbyrobe/sortnet => ../../
@@ -8,6 +8,8 @@ import (
 	"text/template"
 	"unicode"
 	"unicode/utf8"
+
+	"github.com/shabbyrobe/sortnet"
 )
 
 type Input struct {
@@ -16,7 +18,13 @@ type Input struct {
 	Sizes   []int
 	Package string
 	Type    string
-	Export  *bool // leave nil to autodetect
+	Prefix  string // "*" or "[]" if the input is a pointer to or slice of Type
+	Export  *bool  // leave nil to autodetect
+
+	// PackageName is the name Package is declared with, which configure loads from the
+	// package, as it isn't always the last element of the import path: it is 'foo' for
+	// 'example.com/foo/v2', and 'yaml' for 'gopkg.in/yaml.v3'.
+	PackageName string
 
 	// Generate a sorting network that operates on a slice, for example:
 	// NetworkSort2xFloat64(a []float64)
@@ -26,6 +34,27 @@ type Input struct {
 	// NetworkSort2xFloat64Array(a *[2]float64)
 	Array bool
 
+	// Generate a sorting network that sorts every consecutive chunk of a slice, for
+	// example:
+	// NetworkSort2xFloat64Chunks(a []float64)
+	Chunks bool
+
+	// Generate a sorting network that sorts items spaced 'stride' items apart,
+	// starting at 'off', for example:
+	// NetworkSort2xFloat64Strided(a []float64, off, stride int)
+	Strided bool
+
+	// Generate a sorting network that sorts many arrays at once, stored in lane-major
+	// order (item i of every array is stored contiguously), for example:
+	// NetworkSort2xFloat64Lanes(a []float64, lanes int)
+	Lanes bool
+
+	// Generate a function that merges a fixed number of sorted runs with a tournament
+	// rather than a network (one function for each size, which is used as the number
+	// of runs), for example:
+	// NetworkMerge3xFloat64(dst []float64, r0, r1, r2 []float64) []float64
+	Merge bool
+
 	// Wrap the set of networks sorts produced for the different sizes of a given
 	// slice into a method that dispatches to the correct network by length, for example:
 	//
@@ -47,7 +76,8 @@ type Input struct {
 	// slice/array being sorted.
 	//
 	// The `{{.From}}` and `{{.To}}` values are made available to the template, for
-	// accessing the comparator's 'from index' and 'to index', respectively.
+	// accessing the comparator's 'from index' and 'to index', respectively. These are
+	// not always constants; -strided sorters use variables, for example.
 	//
 	// The LessTemplate for standard comparable primitives looks like this:
 	//
@@ -60,7 +90,8 @@ type Input struct {
 	// slice/array being sorted.
 	//
 	// The `{{.From}}` and `{{.To}}` values are made available to the template, for
-	// accessing the comparator's 'from index' and 'to index', respectively.
+	// accessing the comparator's 'from index' and 'to index', respectively. These are
+	// not always constants; -strided sorters use variables, for example.
 	//
 	// The GreaterTemplate for standard comparable primitives looks like this:
 	//
@@ -68,31 +99,175 @@ type Input struct {
 	//		a[{{.From}}], a[{{.To}}] = a[{{.To}}], a[{{.From}}]
 	//	}
 	GreaterTemplate *template.Template
+
+	// LessPredicate, if set, is used to derive LessTemplate and GreaterTemplate. It is
+	// an expression that reports whether `{{.A}}` sorts before `{{.B}}`, for example:
+	//
+	//	less({{.A}}, {{.B}})
+	//
+	// It is set by -lessfunc, -key, or for -generic sorters that take a 'less' function.
+	LessPredicate *template.Template
+
+	// Key holds the field paths passed to -key, for example 'Foo,-Bar.Baz'. KeyFunc is
+	// the source of the function that compares two items by those fields, which is
+	// written to the output once for the input's type.
+	Key     string
+	KeyFunc string
+
+	// Imports lists any packages the comparisons need, besides Package.
+	Imports []string
+
+	// Generic is set for the inputs created by -generic, which generate sorters with a
+	// type parameter T rather than for a single type.
+	Generic bool
+
+	// LessParam is set for the -generic input whose sorters take a 'less func(a, b T)
+	// bool' parameter, rather than comparing with '<' and '>'.
+	LessParam bool
+
+	// Alg and Prefer choose the network behind the sorters for each size (see
+	// chooseNetworks), which are stored in Networks.
+	Alg      string
+	Prefer   string
+	Networks map[int]sortnet.Network
+
+	// Branchless generates compare-and-swaps that use the min and max builtins, which
+	// the compiler turns into conditional moves rather than branches. Only integers
+	// and floats are supported.
+	Branchless bool
+
+	// Numeric is set along with Ordered if the input is an integer or float type.
+	Numeric bool
+
+	// Float is set along with Ordered if the input is, or for -generic sorters may be,
+	// a float type, so the sorters that use the min and max builtins can note that
+	// not-a-number values give unspecified results, and may be duplicated.
+	Float bool
+
+	// Ordered is set if the input can be compared with the builtin '<' and '>'
+	// operators (and therefore also with the min and max builtins). Validate sets it
+	// for builtin types, and discoverOrdering for named types with an ordered
+	// underlying type.
+	Ordered bool
 }
 
 func (in *Input) name(exported bool, sz int, fwd bool, suffix string) (out string) {
-	prefix := "NetworkSort"
+	return in.nameWith("Sort", exported, sz, fwd, suffix)
+}
+
+// nameWith builds the name of a generated function, for example NetworkSort2xInt or
+// NetworkMerge2xIntReverse, where verb is "Sort" or "Merge".
+func (in *Input) nameWith(verb string, exported bool, sz int, fwd bool, suffix string) (out string) {
+	prefix := "Network" + verb
 	if !exported {
-		prefix = "networkSort"
+		prefix = "network" + verb
+	}
+	if in.Generic {
+		out = fmt.Sprintf("%s%d%s%s", prefix, sz, in.genericName(), suffix)
+	} else {
+		out = fmt.Sprintf("%s%dx%s%s", prefix, sz, in.typeName(), suffix)
 	}
-	typ := ucfirst(in.Type)
-	out = fmt.Sprintf("%s%dx%s%s", prefix, sz, typ, suffix)
 	if !fwd {
 		out += "Reverse"
 	}
 	return out
 }
 
+// typeName is the name of the input's type used in the names of generated functions,
+// for example "Int" in NetworkSort2xInt. Pointers to types from other packages include
+// the package name, so that '*math/big.Int' gives "BigInt" rather than clashing with
+// 'int', and slices are plural, so '[]byte' gives "Bytes".
+func (in *Input) typeName() string {
+	switch {
+	case in.Prefix == "*" && in.Package != "":
+		return ucfirst(in.PackageName) + ucfirst(in.Type)
+	case in.Prefix == "*":
+		return ucfirst(in.Type) + "Ptr"
+	case in.Prefix == "[]":
+		return ucfirst(in.Type) + "s"
+	default:
+		return ucfirst(in.Type)
+	}
+}
+
+// TypeExpr returns the input's type as it is written in the generated code, for
+// example 'int', 'time.Time' or '*big.Int'.
+func (in Input) TypeExpr() string {
+	if in.Package == "" {
+		return in.Prefix + in.Type
+	}
+	return in.Prefix + in.PackageName + "." + in.Type
+}
+
+// genericName distinguishes the two kinds of -generic functions: it is "Func" for the
+// ones that take a 'less' function, and empty for the ones that use '<'.
+func (in *Input) genericName() string {
+	if in.LessParam {
+		return "Func"
+	}
+	return ""
+}
+
+// TypeParams returns the type parameter list for a generated function.
+func (in Input) TypeParams() string {
+	switch {
+	case !in.Generic:
+		return ""
+	case in.LessParam:
+		return "[T any]"
+	default:
+		return "[T cmp.Ordered]"
+	}
+}
+
+// Params returns the parameters that follow the slice or array in a generated
+// function's parameter list, including the leading comma.
+func (in Input) Params() string {
+	if in.LessParam {
+		return ", less func(a, b T) bool"
+	}
+	return ""
+}
+
+// Args returns the arguments that pass Params on to another generated function.
+func (in Input) Args() string {
+	if in.LessParam {
+		return ", less"
+	}
+	return ""
+}
+
+// imports returns the packages the generated code for the input needs.
+func (in *Input) imports() (out []string) {
+	if in.Package != "" {
+		out = append(out, in.Package)
+	}
+	if in.Generic && !in.LessParam {
+		out = append(out, "cmp")
+	}
+	return append(out, in.Imports...)
+}
+
+// sorts reports whether any sorters (rather than just merges) will be generated for
+// the input.
+func (in *Input) sorts() bool {
+	return in.Slice || in.Array || in.Chunks || in.Strided || in.Lanes
+}
+
 func (in *Input) isExported() bool {
 	if in.Export != nil {
 		return *in.Export
 	}
 	r, _ := utf8.DecodeRuneInString(in.Type)
-	return in.isComparableBuiltin() || unicode.IsUpper(r)
+	return in.isComparableBuiltin() || in.isBytes() || unicode.IsUpper(r)
+}
+
+func (in *Input) isBytes() bool {
+	return in.Package == "" && in.Prefix == "[]" && in.Type == "byte"
 }
 
 func (in *Input) isComparableBuiltin() bool {
-	return in.Package == "" && (in.Type == "string" ||
+	return in.Package == "" && in.Prefix == "" && (in.Type == "string" ||
 		in.Type == "int" ||
 		in.Type == "int8" ||
 		in.Type == "int16" ||
@@ -107,6 +282,17 @@ func (in *Input) isComparableBuiltin() bool {
 		in.Type == "float64")
 }
 
+// needsOrdering reports whether the input is a named type for which no way to compare
+// has been provided, so it must be discovered from the type.
+func (in *Input) needsOrdering() bool {
+	return !in.Generic && !in.isComparableBuiltin() && !in.Ordered &&
+		in.LessTemplate == nil && in.GreaterTemplate == nil && in.LessPredicate == nil
+}
+
+func (in Input) IsFloat() bool {
+	return in.Package == "" && in.Prefix == "" && (in.Type == "float32" || in.Type == "float64")
+}
+
 func (in *Input) Validate() error {
 	if len(in.Sizes) == 0 {
 		return fmt.Errorf("no sizes to generate")
@@ -118,7 +304,31 @@ func (in *Input) Validate() error {
 		}
 	}
 
-	if in.isComparableBuiltin() {
+	if in.LessParam {
+		in.LessPredicate = lessParamPredicate
+	}
+
+	if in.LessPredicate != nil {
+		if in.Branchless {
+			return fmt.Errorf("-branchless is only supported for integer and float types compared using '<' or '>'")
+		}
+		in.LessTemplate, in.GreaterTemplate = predicateCASTemplates(in.LessPredicate)
+
+	} else if in.Ordered || in.isComparableBuiltin() || in.Generic {
+		in.Ordered = true
+		if in.isComparableBuiltin() && in.Type != "string" {
+			in.Numeric = true
+		}
+		if in.IsFloat() || (in.Generic && !in.LessParam) {
+			in.Float = true
+		}
+		if in.Branchless {
+			if !in.Numeric || in.LessTemplate != nil || in.GreaterTemplate != nil {
+				return fmt.Errorf("-branchless is only supported for integer and float types compared using '<' or '>'")
+			}
+			in.LessTemplate = branchlessCASLessTpl
+			in.GreaterTemplate = branchlessCASGreaterTpl
+		}
 		if in.LessTemplate == nil {
 			in.LessTemplate = defaultCASLessTpl
 		}
@@ -127,8 +337,11 @@ func (in *Input) Validate() error {
 		}
 
 	} else {
+		if in.Merge {
+			return fmt.Errorf("-merge is only supported for builtin types that can be compared using '<' or '>', or with -lessfunc or -key")
+		}
 		if (in.Reverse || in.Forward) && (in.LessTemplate == nil && in.GreaterTemplate == nil) {
-			return fmt.Errorf("no -less or -greater provided for non-builtin input - only builtins can be compared using '<' or '>' so you have to provide a function")
+			return fmt.Errorf("no -less, -greater, -lessfunc or -key provided for non-builtin input - only builtins can be compared using '<' or '>' so you have to provide a function")
 		}
 	}
 
@@ -158,13 +371,41 @@ func BuildComparatorTemplate(raw string) (*template.Template, error) {
 	return tpl, nil
 }
 
+// BuildPredicateTemplate builds an Input's LessPredicate from the -lessfunc flag, which
+// is either an expression using '{{.A}}' and '{{.B}}', or the name of a 'func(a, b T)
+// bool'.
+func BuildPredicateTemplate(raw string) (*template.Template, error) {
+	tpl, err := template.New("").Parse(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, map[string]string{"A": "x", "B": "y"}); err != nil {
+		return nil, err
+	}
+
+	// If there are no substitutions, presume the input template contains a function
+	// name only.
+	if buf.String() == raw {
+		tpl, err = template.New("").Parse(raw + "({{.A}}, {{.B}})")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tpl, nil
+}
+
 const (
-	inputPkg = 1
-	inputTyp = 2
+	inputPrefix = 1
+	inputPkg    = 2
+	inputTyp    = 3
 )
 
 var inputPattern = regexp.MustCompile(`` +
 	`^` +
+	`(?P<prefix>\*|\[\])?` +
 	`(?:(?P<pkg>[\pL\d\/\.]+)\.)?` + // Greedy
 	`(?P<typ>\pL[\pL\d]*)` +
 	`$`,
@@ -176,6 +417,7 @@ func ParseInput(s string) (input Input, err error) {
 		return input, fmt.Errorf("invalid input %q", s)
 	}
 
+	input.Prefix = match[inputPrefix]
 	input.Package = match[inputPkg]
 	input.Type = match[inputTyp]
 
@@ -0,0 +1,10321 @@
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
+
+package gentest
+
+// Sorting networks used in this file:
+//
+//   - Bose-Nelson, 2 inputs: 1 comparator (proven optimal), depth 1 (proven optimal)
+//     Fingerprint 9463926d4640a53a
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 3 inputs: 3 comparators (proven optimal), depth 3 (proven optimal)
+//     Fingerprint f995edafd93d50ea
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 4 inputs: 5 comparators (proven optimal), depth 3 (proven optimal)
+//     Fingerprint 0119fc1a905673cd
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 5 inputs: 9 comparators (proven optimal), depth 6 (not optimal)
+//     Fingerprint d54da66c984b8b07
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 6 inputs: 13 comparators (not optimal), depth 7 (not optimal)
+//     Fingerprint 1cc32b1cf5a405a2
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 7 inputs: 16 comparators (proven optimal), depth 7 (not optimal)
+//     Fingerprint 4c56e9c432030c54
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 8 inputs: 19 comparators (proven optimal), depth 7 (not optimal)
+//     Fingerprint 72b14580972c6d18
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Senso9, 9 inputs: 25 comparators (proven optimal), depth 8 (not optimal)
+//     Fingerprint 5463bfb95d0dca98
+//     V. K. Valsalam and R. Miikkulainen (2013)
+//     V. K. Valsalam and R. Miikkulainen, Using Symmetry and Evolutionary Search to Minimize Sorting Networks, Journal of Machine Learning Research 14, 2013
+//   - Bose-Nelson, 9 inputs: 27 comparators (not optimal), depth 11 (not optimal)
+//     Fingerprint 002252a4c4bf0d30
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Senso10, 10 inputs: 29 comparators (proven optimal), depth 8 (not optimal)
+//     Fingerprint 9688ccf2613970b6
+//     V. K. Valsalam and R. Miikkulainen (2013)
+//     V. K. Valsalam and R. Miikkulainen, Using Symmetry and Evolutionary Search to Minimize Sorting Networks, Journal of Machine Learning Research 14, 2013
+//   - Bose-Nelson, 10 inputs: 35 comparators (not optimal), depth 12 (not optimal)
+//     Fingerprint df126f0ecf046219
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - ShapiroGreen11, 11 inputs: 35 comparators (proven optimal), depth 9 (not optimal)
+//     Fingerprint a0cd3d71b97545e1
+//     G. Shapiro and M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - Bose-Nelson, 11 inputs: 41 comparators (not optimal), depth 13 (not optimal)
+//     Fingerprint 55d2ea81e80196bb
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - ShapiroGreen12, 12 inputs: 39 comparators (proven optimal), depth 9 (not optimal)
+//     Fingerprint 4520ec02bac38318
+//     G. Shapiro and M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - Bose-Nelson, 12 inputs: 47 comparators (not optimal), depth 14 (not optimal)
+//     Fingerprint 8abb71db6c6b9eb0
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - End13, 13 inputs: 45 comparators (best known), depth 10 (not optimal)
+//     Fingerprint 8fb515590c6989d6
+//     H. Juillé (1995)
+//     H. Juillé, Evolution of Non-Deterministic Incremental Algorithms as a New Approach for Search in State Spaces, Proceedings of the 6th International Conference on Genetic Algorithms, 1995
+//   - Bose-Nelson, 13 inputs: 52 comparators (not optimal), depth 15 (not optimal)
+//     Fingerprint df54e40268dd8041
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Green14, 14 inputs: 51 comparators (best known), depth 10 (not optimal)
+//     Fingerprint b78dfa7f24d12c0c
+//     M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - Bose-Nelson, 14 inputs: 57 comparators (not optimal), depth 15 (not optimal)
+//     Fingerprint 4b86bb7a6e1058e7
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Green15, 15 inputs: 56 comparators (best known), depth 10 (not optimal)
+//     Fingerprint 360c873bdb44ac3e
+//     M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - Bose-Nelson, 15 inputs: 61 comparators (not optimal), depth 15 (not optimal)
+//     Fingerprint cd7f2a67f1441e6f
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - VanVoorhis16, 16 inputs: 61 comparators (not optimal), depth 9 (proven optimal)
+//     Fingerprint 47944ca1576bb7a8
+//     D. Van Voorhis (1972)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - Bose-Nelson, 16 inputs: 65 comparators (not optimal), depth 15 (not optimal)
+//     Fingerprint eb129ed66662020f
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Green16, 16 inputs: 60 comparators (best known), depth 10 (not optimal)
+//     Fingerprint 196d3d09b3f4a0e7
+//     M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - Morwenn24, 24 inputs: 123 comparators (not optimal), depth 15 (not optimal)
+//     Fingerprint a82fea0eb88f2605
+//     Morwenn
+//     https://github.com/Morwenn/cpp-sort
+//   - Bose-Nelson, 24 inputs: 157 comparators (not optimal), depth 27 (not optimal)
+//     Fingerprint 29b069fc85f295a8
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 32 inputs: 211 comparators (unknown), depth 31 (unknown)
+//     Fingerprint 947ea8bcb4cc1a7d
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+
+// NetworkSortInt16 sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortInt16(a []int16, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xInt16(a)
+	case 3:
+		NetworkSort3xInt16(a)
+	case 4:
+		NetworkSort4xInt16(a)
+	case 5:
+		NetworkSort5xInt16(a)
+	case 6:
+		NetworkSort6xInt16(a)
+	case 7:
+		NetworkSort7xInt16(a)
+	case 8:
+		NetworkSort8xInt16(a)
+	case 9:
+		NetworkSort9xInt16(a)
+	case 10:
+		NetworkSort10xInt16(a)
+	case 11:
+		NetworkSort11xInt16(a)
+	case 12:
+		NetworkSort12xInt16(a)
+	case 13:
+		NetworkSort13xInt16(a)
+	case 14:
+		NetworkSort14xInt16(a)
+	case 15:
+		NetworkSort15xInt16(a)
+	case 16:
+		NetworkSort16xInt16(a)
+	case 24:
+		NetworkSort24xInt16(a)
+	case 32:
+		NetworkSort32xInt16(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortInt16Reverse sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortInt16Reverse(a []int16, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xInt16Reverse(a)
+	case 3:
+		NetworkSort3xInt16Reverse(a)
+	case 4:
+		NetworkSort4xInt16Reverse(a)
+	case 5:
+		NetworkSort5xInt16Reverse(a)
+	case 6:
+		NetworkSort6xInt16Reverse(a)
+	case 7:
+		NetworkSort7xInt16Reverse(a)
+	case 8:
+		NetworkSort8xInt16Reverse(a)
+	case 9:
+		NetworkSort9xInt16Reverse(a)
+	case 10:
+		NetworkSort10xInt16Reverse(a)
+	case 11:
+		NetworkSort11xInt16Reverse(a)
+	case 12:
+		NetworkSort12xInt16Reverse(a)
+	case 13:
+		NetworkSort13xInt16Reverse(a)
+	case 14:
+		NetworkSort14xInt16Reverse(a)
+	case 15:
+		NetworkSort15xInt16Reverse(a)
+	case 16:
+		NetworkSort16xInt16Reverse(a)
+	case 24:
+		NetworkSort24xInt16Reverse(a)
+	case 32:
+		NetworkSort32xInt16Reverse(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortInt32 sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortInt32(a []int32, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xInt32(a)
+	case 3:
+		NetworkSort3xInt32(a)
+	case 4:
+		NetworkSort4xInt32(a)
+	case 5:
+		NetworkSort5xInt32(a)
+	case 6:
+		NetworkSort6xInt32(a)
+	case 7:
+		NetworkSort7xInt32(a)
+	case 8:
+		NetworkSort8xInt32(a)
+	case 9:
+		NetworkSort9xInt32(a)
+	case 10:
+		NetworkSort10xInt32(a)
+	case 11:
+		NetworkSort11xInt32(a)
+	case 12:
+		NetworkSort12xInt32(a)
+	case 13:
+		NetworkSort13xInt32(a)
+	case 14:
+		NetworkSort14xInt32(a)
+	case 15:
+		NetworkSort15xInt32(a)
+	case 16:
+		NetworkSort16xInt32(a)
+	case 24:
+		NetworkSort24xInt32(a)
+	case 32:
+		NetworkSort32xInt32(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortInt32Reverse sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortInt32Reverse(a []int32, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xInt32Reverse(a)
+	case 3:
+		NetworkSort3xInt32Reverse(a)
+	case 4:
+		NetworkSort4xInt32Reverse(a)
+	case 5:
+		NetworkSort5xInt32Reverse(a)
+	case 6:
+		NetworkSort6xInt32Reverse(a)
+	case 7:
+		NetworkSort7xInt32Reverse(a)
+	case 8:
+		NetworkSort8xInt32Reverse(a)
+	case 9:
+		NetworkSort9xInt32Reverse(a)
+	case 10:
+		NetworkSort10xInt32Reverse(a)
+	case 11:
+		NetworkSort11xInt32Reverse(a)
+	case 12:
+		NetworkSort12xInt32Reverse(a)
+	case 13:
+		NetworkSort13xInt32Reverse(a)
+	case 14:
+		NetworkSort14xInt32Reverse(a)
+	case 15:
+		NetworkSort15xInt32Reverse(a)
+	case 16:
+		NetworkSort16xInt32Reverse(a)
+	case 24:
+		NetworkSort24xInt32Reverse(a)
+	case 32:
+		NetworkSort32xInt32Reverse(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortUint16 sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortUint16(a []uint16, sz int) (ok bool) {
+	switch sz {
+	case 16:
+		NetworkSort16xUint16(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortUint16Reverse sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortUint16Reverse(a []uint16, sz int) (ok bool) {
+	switch sz {
+	case 16:
+		NetworkSort16xUint16Reverse(a)
+	default:
+		return false
+	}
+	return true
+}
+
+func NetworkSort2xInt16(a []int16) {
+	_ = a[1]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort2xInt16Reverse(a []int16) {
+	_ = a[1]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3xInt16(a []int16) {
+	_ = a[2]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3xInt16Reverse(a []int16) {
+	_ = a[2]
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort4xInt16(a []int16) {
+	_ = a[3]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort4xInt16Reverse(a []int16) {
+	_ = a[3]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5xInt16(a []int16) {
+	_ = a[4]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5xInt16Reverse(a []int16) {
+	_ = a[4]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] < a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort6xInt16(a []int16) {
+	_ = a[5]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort6xInt16Reverse(a []int16) {
+	_ = a[5]
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] < a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] < a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7xInt16(a []int16) {
+	_ = a[6]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7xInt16Reverse(a []int16) {
+	_ = a[6]
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] < a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2] < a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort8xInt16(a []int16) {
+	_ = a[7]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort8xInt16Reverse(a []int16) {
+	_ = a[7]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] < a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort9xInt16(a []int16) {
+	_ = a[8]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] > a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] > a[5] {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] > a[6] {
+		a[1], a[6] = a[6], a[1]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2] > a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort9xInt16Reverse(a []int16) {
+	_ = a[8]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] < a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] < a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] < a[5] {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] < a[6] {
+		a[1], a[6] = a[6], a[1]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2] < a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[3] < a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[2] < a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3] < a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort10xInt16(a []int16) {
+	_ = a[9]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[0] > a[6] {
+		a[0], a[6] = a[6], a[0]
+	}
+	if a[0] > a[5] {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[1] > a[7] {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[1] > a[6] {
+		a[1], a[6] = a[6], a[1]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[3] > a[9] {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[4] > a[9] {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] > a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort10xInt16Reverse(a []int16) {
+	_ = a[9]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] < a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[5] < a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[6] < a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[0] < a[6] {
+		a[0], a[6] = a[6], a[0]
+	}
+	if a[0] < a[5] {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[1] < a[7] {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[1] < a[6] {
+		a[1], a[6] = a[6], a[1]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] < a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[3] < a[9] {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[4] < a[9] {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[3] < a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[4] < a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2] < a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] < a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort11xInt16(a []int16) {
+	_ = a[10]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[0] > a[6] {
+		a[0], a[6] = a[6], a[0]
+	}
+	if a[0] > a[5] {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[1] > a[7] {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[1] > a[6] {
+		a[1], a[6] = a[6], a[1]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[9] {
+		a[2], a[9] = a[9], a[2]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[3] > a[10] {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[4] > a[10] {
+		a[4], a[10] = a[10], a[4]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[4] > a[9] {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] > a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort11xInt16Reverse(a []int16) {
+	_ = a[10]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] < a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[8] < a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[5] < a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[5] < a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[7] < a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[0] < a[6] {
+		a[0], a[6] = a[6], a[0]
+	}
+	if a[0] < a[5] {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[1] < a[7] {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[1] < a[6] {
+		a[1], a[6] = a[6], a[1]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] < a[9] {
+		a[2], a[9] = a[9], a[2]
+	}
+	if a[2] < a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[3] < a[10] {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[4] < a[10] {
+		a[4], a[10] = a[10], a[4]
+	}
+	if a[3] < a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[4] < a[9] {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[4] < a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2] < a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] < a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort12xInt16(a []int16) {
+	_ = a[11]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[8] > a[11] {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[0] > a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[0] > a[6] {
+		a[0], a[6] = a[6], a[0]
+	}
+	if a[1] > a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[1] > a[6] {
+		a[1], a[6] = a[6], a[1]
+	}
+	if a[2] > a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] > a[10] {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[3] > a[9] {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[4] > a[11] {
+		a[4], a[11] = a[11], a[4]
+	}
+	if a[5] > a[11] {
+		a[5], a[11] = a[11], a[5]
+	}
+	if a[4] > a[9] {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[5] > a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort12xInt16Reverse(a []int16) {
+	_ = a[11]
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] < a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] < a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[10] < a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[9] < a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[6] < a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[7] < a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[8] < a[11] {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[8] < a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[0] < a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[0] < a[6] {
+		a[0], a[6] = a[6], a[0]
+	}
+	if a[1] < a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[2] < a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[1] < a[6] {
+		a[1], a[6] = a[6], a[1]
+	}
+	if a[2] < a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] < a[10] {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[3] < a[9] {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[4] < a[11] {
+		a[4], a[11] = a[11], a[4]
+	}
+	if a[5] < a[11] {
+		a[5], a[11] = a[11], a[5]
+	}
+	if a[4] < a[9] {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[5] < a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[5] < a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] < a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4] < a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[5] < a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort13xInt16(a []int16) {
+	_ = a[12]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[8] > a[11] {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[0] > a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[0] > a[6] {
+		a[0], a[6] = a[6], a[0]
+	}
+	if a[1] > a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[2] > a[9] {
+		a[2], a[9] = a[9], a[2]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[1] > a[6] {
+		a[1], a[6] = a[6], a[1]
+	}
+	if a[2] > a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[3] > a[10] {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[12] {
+		a[5], a[12] = a[12], a[5]
+	}
+	if a[4] > a[10] {
+		a[4], a[10] = a[10], a[4]
+	}
+	if a[5] > a[11] {
+		a[5], a[11] = a[11], a[5]
+	}
+	if a[5] > a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort13xInt16Reverse(a []int16) {
+	_ = a[12]
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] < a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] < a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] < a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[9] < a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[10] < a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[10] < a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[6] < a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[7] < a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[8] < a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[8] < a[11] {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[8] < a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[0] < a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[0] < a[6] {
+		a[0], a[6] = a[6], a[0]
+	}
+	if a[1] < a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[2] < a[9] {
+		a[2], a[9] = a[9], a[2]
+	}
+	if a[2] < a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[1] < a[6] {
+		a[1], a[6] = a[6], a[1]
+	}
+	if a[2] < a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] < a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[3] < a[10] {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[4] < a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] < a[12] {
+		a[5], a[12] = a[12], a[5]
+	}
+	if a[4] < a[10] {
+		a[4], a[10] = a[10], a[4]
+	}
+	if a[5] < a[11] {
+		a[5], a[11] = a[11], a[5]
+	}
+	if a[5] < a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] < a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4] < a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[5] < a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[5] < a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort14xInt16(a []int16) {
+	_ = a[13]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[9] > a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[0] > a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[0] > a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[1] > a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2] > a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[2] > a[9] {
+		a[2], a[9] = a[9], a[2]
+	}
+	if a[1] > a[7] {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[2] > a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[4] > a[11] {
+		a[4], a[11] = a[11], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] > a[13] {
+		a[6], a[13] = a[13], a[6]
+	}
+	if a[5] > a[11] {
+		a[5], a[11] = a[11], a[5]
+	}
+	if a[6] > a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[6] > a[11] {
+		a[6], a[11] = a[11], a[6]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4] > a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+}
+
+func NetworkSort14xInt16Reverse(a []int16) {
+	_ = a[13]
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] < a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2] < a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[10] < a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] < a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[10] < a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[11] < a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[11] < a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[7] < a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[7] < a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[8] < a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9] < a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[9] < a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[8] < a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[9] < a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[0] < a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[0] < a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[1] < a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2] < a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[2] < a[9] {
+		a[2], a[9] = a[9], a[2]
+	}
+	if a[1] < a[7] {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[2] < a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[2] < a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[3] < a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4] < a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[4] < a[11] {
+		a[4], a[11] = a[11], a[4]
+	}
+	if a[5] < a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] < a[13] {
+		a[6], a[13] = a[13], a[6]
+	}
+	if a[5] < a[11] {
+		a[5], a[11] = a[11], a[5]
+	}
+	if a[6] < a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[6] < a[11] {
+		a[6], a[11] = a[11], a[6]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] < a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4] < a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[5] < a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[6] < a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+}
+
+func NetworkSort15xInt16(a []int16) {
+	_ = a[14]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[8] > a[11] {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[10] > a[13] {
+		a[10], a[13] = a[13], a[10]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[0] > a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[0] > a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[1] > a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2] > a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[2] > a[9] {
+		a[2], a[9] = a[9], a[2]
+	}
+	if a[1] > a[7] {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[2] > a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[4] > a[11] {
+		a[4], a[11] = a[11], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[6] > a[13] {
+		a[6], a[13] = a[13], a[6]
+	}
+	if a[5] > a[11] {
+		a[5], a[11] = a[11], a[5]
+	}
+	if a[6] > a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[6] > a[11] {
+		a[6], a[11] = a[11], a[6]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4] > a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+}
+
+func NetworkSort15xInt16Reverse(a []int16) {
+	_ = a[14]
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] < a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2] < a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[8] < a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11] < a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[13] < a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[11] < a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[12] < a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[12] < a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[7] < a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[8] < a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[8] < a[11] {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[9] < a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[10] < a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[10] < a[13] {
+		a[10], a[13] = a[13], a[10]
+	}
+	if a[9] < a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[10] < a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[10] < a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[0] < a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[0] < a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[1] < a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2] < a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[2] < a[9] {
+		a[2], a[9] = a[9], a[2]
+	}
+	if a[1] < a[7] {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[2] < a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[2] < a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[3] < a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4] < a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[4] < a[11] {
+		a[4], a[11] = a[11], a[4]
+	}
+	if a[5] < a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] < a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[6] < a[13] {
+		a[6], a[13] = a[13], a[6]
+	}
+	if a[5] < a[11] {
+		a[5], a[11] = a[11], a[5]
+	}
+	if a[6] < a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[6] < a[11] {
+		a[6], a[11] = a[11], a[6]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] < a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4] < a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[5] < a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[6] < a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+}
+
+func NetworkSort16xInt16(a []int16) {
+	_ = a[15]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[9] > a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[11] > a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[0] > a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] > a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[1] > a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[2] > a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[3] > a[10] {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[3] > a[9] {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[5] > a[12] {
+		a[5], a[12] = a[12], a[5]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7] > a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[7] > a[14] {
+		a[7], a[14] = a[14], a[7]
+	}
+	if a[6] > a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[7] > a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[7] > a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort16xInt16Reverse(a []int16) {
+	_ = a[15]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] < a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] < a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[8] < a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[9] < a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12] < a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14] < a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[12] < a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[13] < a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[13] < a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[8] < a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9] < a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[9] < a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[10] < a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11] < a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[11] < a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[10] < a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[11] < a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[11] < a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[0] < a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] < a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[1] < a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[2] < a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] < a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[3] < a[10] {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[2] < a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[3] < a[9] {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[3] < a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[4] < a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] < a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[5] < a[12] {
+		a[5], a[12] = a[12], a[5]
+	}
+	if a[6] < a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7] < a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[7] < a[14] {
+		a[7], a[14] = a[14], a[7]
+	}
+	if a[6] < a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[7] < a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[7] < a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[4] < a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[5] < a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[5] < a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[7] < a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[7] < a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort24xInt16(a []int16) {
+	_ = a[23]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[8] > a[11] {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[0] > a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[0] > a[6] {
+		a[0], a[6] = a[6], a[0]
+	}
+	if a[1] > a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[1] > a[6] {
+		a[1], a[6] = a[6], a[1]
+	}
+	if a[2] > a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] > a[10] {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[3] > a[9] {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[4] > a[11] {
+		a[4], a[11] = a[11], a[4]
+	}
+	if a[5] > a[11] {
+		a[5], a[11] = a[11], a[5]
+	}
+	if a[4] > a[9] {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[5] > a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[16] > a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[12] > a[15] {
+		a[12], a[15] = a[15], a[12]
+	}
+	if a[13] > a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[14] > a[17] {
+		a[14], a[17] = a[17], a[14]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[19] > a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[18] > a[20] {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[18] > a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[22] > a[23] {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[21] > a[23] {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[21] > a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[18] > a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[18] > a[21] {
+		a[18], a[21] = a[21], a[18]
+	}
+	if a[19] > a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[20] > a[23] {
+		a[20], a[23] = a[23], a[20]
+	}
+	if a[19] > a[21] {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[20] > a[22] {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[20] > a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[12] > a[19] {
+		a[12], a[19] = a[19], a[12]
+	}
+	if a[12] > a[18] {
+		a[12], a[18] = a[18], a[12]
+	}
+	if a[13] > a[20] {
+		a[13], a[20] = a[20], a[13]
+	}
+	if a[14] > a[20] {
+		a[14], a[20] = a[20], a[14]
+	}
+	if a[13] > a[18] {
+		a[13], a[18] = a[18], a[13]
+	}
+	if a[14] > a[19] {
+		a[14], a[19] = a[19], a[14]
+	}
+	if a[14] > a[18] {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[15] > a[22] {
+		a[15], a[22] = a[22], a[15]
+	}
+	if a[15] > a[21] {
+		a[15], a[21] = a[21], a[15]
+	}
+	if a[16] > a[23] {
+		a[16], a[23] = a[23], a[16]
+	}
+	if a[17] > a[23] {
+		a[17], a[23] = a[23], a[17]
+	}
+	if a[16] > a[21] {
+		a[16], a[21] = a[21], a[16]
+	}
+	if a[17] > a[22] {
+		a[17], a[22] = a[22], a[17]
+	}
+	if a[17] > a[21] {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[15] > a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[15] > a[18] {
+		a[15], a[18] = a[18], a[15]
+	}
+	if a[16] > a[20] {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[17] > a[20] {
+		a[17], a[20] = a[20], a[17]
+	}
+	if a[16] > a[18] {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[17] > a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[0] > a[13] {
+		a[0], a[13] = a[13], a[0]
+	}
+	if a[0] > a[12] {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[1] > a[14] {
+		a[1], a[14] = a[14], a[1]
+	}
+	if a[2] > a[14] {
+		a[2], a[14] = a[14], a[2]
+	}
+	if a[1] > a[12] {
+		a[1], a[12] = a[12], a[1]
+	}
+	if a[2] > a[13] {
+		a[2], a[13] = a[13], a[2]
+	}
+	if a[2] > a[12] {
+		a[2], a[12] = a[12], a[2]
+	}
+	if a[3] > a[16] {
+		a[3], a[16] = a[16], a[3]
+	}
+	if a[3] > a[15] {
+		a[3], a[15] = a[15], a[3]
+	}
+	if a[4] > a[17] {
+		a[4], a[17] = a[17], a[4]
+	}
+	if a[5] > a[17] {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[4] > a[15] {
+		a[4], a[15] = a[15], a[4]
+	}
+	if a[5] > a[16] {
+		a[5], a[16] = a[16], a[5]
+	}
+	if a[5] > a[15] {
+		a[5], a[15] = a[15], a[5]
+	}
+	if a[3] > a[13] {
+		a[3], a[13] = a[13], a[3]
+	}
+	if a[3] > a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[4] > a[14] {
+		a[4], a[14] = a[14], a[4]
+	}
+	if a[5] > a[14] {
+		a[5], a[14] = a[14], a[5]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[5] > a[12] {
+		a[5], a[12] = a[12], a[5]
+	}
+	if a[6] > a[19] {
+		a[6], a[19] = a[19], a[6]
+	}
+	if a[6] > a[18] {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[7] > a[20] {
+		a[7], a[20] = a[20], a[7]
+	}
+	if a[8] > a[20] {
+		a[8], a[20] = a[20], a[8]
+	}
+	if a[7] > a[18] {
+		a[7], a[18] = a[18], a[7]
+	}
+	if a[8] > a[19] {
+		a[8], a[19] = a[19], a[8]
+	}
+	if a[8] > a[18] {
+		a[8], a[18] = a[18], a[8]
+	}
+	if a[9] > a[22] {
+		a[9], a[22] = a[22], a[9]
+	}
+	if a[9] > a[21] {
+		a[9], a[21] = a[21], a[9]
+	}
+	if a[10] > a[23] {
+		a[10], a[23] = a[23], a[10]
+	}
+	if a[11] > a[23] {
+		a[11], a[23] = a[23], a[11]
+	}
+	if a[10] > a[21] {
+		a[10], a[21] = a[21], a[10]
+	}
+	if a[11] > a[22] {
+		a[11], a[22] = a[22], a[11]
+	}
+	if a[11] > a[21] {
+		a[11], a[21] = a[21], a[11]
+	}
+	if a[9] > a[19] {
+		a[9], a[19] = a[19], a[9]
+	}
+	if a[9] > a[18] {
+		a[9], a[18] = a[18], a[9]
+	}
+	if a[10] > a[20] {
+		a[10], a[20] = a[20], a[10]
+	}
+	if a[11] > a[20] {
+		a[11], a[20] = a[20], a[11]
+	}
+	if a[10] > a[18] {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[11] > a[19] {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[11] > a[18] {
+		a[11], a[18] = a[18], a[11]
+	}
+	if a[6] > a[13] {
+		a[6], a[13] = a[13], a[6]
+	}
+	if a[6] > a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[7] > a[14] {
+		a[7], a[14] = a[14], a[7]
+	}
+	if a[8] > a[14] {
+		a[8], a[14] = a[14], a[8]
+	}
+	if a[7] > a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[8] > a[13] {
+		a[8], a[13] = a[13], a[8]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9] > a[16] {
+		a[9], a[16] = a[16], a[9]
+	}
+	if a[9] > a[15] {
+		a[9], a[15] = a[15], a[9]
+	}
+	if a[10] > a[17] {
+		a[10], a[17] = a[17], a[10]
+	}
+	if a[11] > a[17] {
+		a[11], a[17] = a[17], a[11]
+	}
+	if a[10] > a[15] {
+		a[10], a[15] = a[15], a[10]
+	}
+	if a[11] > a[16] {
+		a[11], a[16] = a[16], a[11]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[9] > a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11] > a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+}
+
+func NetworkSort24xInt16Reverse(a []int16) {
+	_ = a[23]
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] < a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] < a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[10] < a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[9] < a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[6] < a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[7] < a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[8] < a[11] {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[8] < a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[0] < a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[0] < a[6] {
+		a[0], a[6] = a[6], a[0]
+	}
+	if a[1] < a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[2] < a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[1] < a[6] {
+		a[1], a[6] = a[6], a[1]
+	}
+	if a[2] < a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] < a[10] {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[3] < a[9] {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[4] < a[11] {
+		a[4], a[11] = a[11], a[4]
+	}
+	if a[5] < a[11] {
+		a[5], a[11] = a[11], a[5]
+	}
+	if a[4] < a[9] {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[5] < a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[5] < a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] < a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4] < a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[5] < a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[13] < a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[12] < a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[12] < a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[16] < a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[15] < a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[15] < a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[12] < a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[12] < a[15] {
+		a[12], a[15] = a[15], a[12]
+	}
+	if a[13] < a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[14] < a[17] {
+		a[14], a[17] = a[17], a[14]
+	}
+	if a[13] < a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[14] < a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[14] < a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[19] < a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[18] < a[20] {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[18] < a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[22] < a[23] {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[21] < a[23] {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[21] < a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[18] < a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[18] < a[21] {
+		a[18], a[21] = a[21], a[18]
+	}
+	if a[19] < a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[20] < a[23] {
+		a[20], a[23] = a[23], a[20]
+	}
+	if a[19] < a[21] {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[20] < a[22] {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[20] < a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[12] < a[19] {
+		a[12], a[19] = a[19], a[12]
+	}
+	if a[12] < a[18] {
+		a[12], a[18] = a[18], a[12]
+	}
+	if a[13] < a[20] {
+		a[13], a[20] = a[20], a[13]
+	}
+	if a[14] < a[20] {
+		a[14], a[20] = a[20], a[14]
+	}
+	if a[13] < a[18] {
+		a[13], a[18] = a[18], a[13]
+	}
+	if a[14] < a[19] {
+		a[14], a[19] = a[19], a[14]
+	}
+	if a[14] < a[18] {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[15] < a[22] {
+		a[15], a[22] = a[22], a[15]
+	}
+	if a[15] < a[21] {
+		a[15], a[21] = a[21], a[15]
+	}
+	if a[16] < a[23] {
+		a[16], a[23] = a[23], a[16]
+	}
+	if a[17] < a[23] {
+		a[17], a[23] = a[23], a[17]
+	}
+	if a[16] < a[21] {
+		a[16], a[21] = a[21], a[16]
+	}
+	if a[17] < a[22] {
+		a[17], a[22] = a[22], a[17]
+	}
+	if a[17] < a[21] {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[15] < a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[15] < a[18] {
+		a[15], a[18] = a[18], a[15]
+	}
+	if a[16] < a[20] {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[17] < a[20] {
+		a[17], a[20] = a[20], a[17]
+	}
+	if a[16] < a[18] {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[17] < a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[17] < a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[0] < a[13] {
+		a[0], a[13] = a[13], a[0]
+	}
+	if a[0] < a[12] {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[1] < a[14] {
+		a[1], a[14] = a[14], a[1]
+	}
+	if a[2] < a[14] {
+		a[2], a[14] = a[14], a[2]
+	}
+	if a[1] < a[12] {
+		a[1], a[12] = a[12], a[1]
+	}
+	if a[2] < a[13] {
+		a[2], a[13] = a[13], a[2]
+	}
+	if a[2] < a[12] {
+		a[2], a[12] = a[12], a[2]
+	}
+	if a[3] < a[16] {
+		a[3], a[16] = a[16], a[3]
+	}
+	if a[3] < a[15] {
+		a[3], a[15] = a[15], a[3]
+	}
+	if a[4] < a[17] {
+		a[4], a[17] = a[17], a[4]
+	}
+	if a[5] < a[17] {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[4] < a[15] {
+		a[4], a[15] = a[15], a[4]
+	}
+	if a[5] < a[16] {
+		a[5], a[16] = a[16], a[5]
+	}
+	if a[5] < a[15] {
+		a[5], a[15] = a[15], a[5]
+	}
+	if a[3] < a[13] {
+		a[3], a[13] = a[13], a[3]
+	}
+	if a[3] < a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[4] < a[14] {
+		a[4], a[14] = a[14], a[4]
+	}
+	if a[5] < a[14] {
+		a[5], a[14] = a[14], a[5]
+	}
+	if a[4] < a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] < a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[5] < a[12] {
+		a[5], a[12] = a[12], a[5]
+	}
+	if a[6] < a[19] {
+		a[6], a[19] = a[19], a[6]
+	}
+	if a[6] < a[18] {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[7] < a[20] {
+		a[7], a[20] = a[20], a[7]
+	}
+	if a[8] < a[20] {
+		a[8], a[20] = a[20], a[8]
+	}
+	if a[7] < a[18] {
+		a[7], a[18] = a[18], a[7]
+	}
+	if a[8] < a[19] {
+		a[8], a[19] = a[19], a[8]
+	}
+	if a[8] < a[18] {
+		a[8], a[18] = a[18], a[8]
+	}
+	if a[9] < a[22] {
+		a[9], a[22] = a[22], a[9]
+	}
+	if a[9] < a[21] {
+		a[9], a[21] = a[21], a[9]
+	}
+	if a[10] < a[23] {
+		a[10], a[23] = a[23], a[10]
+	}
+	if a[11] < a[23] {
+		a[11], a[23] = a[23], a[11]
+	}
+	if a[10] < a[21] {
+		a[10], a[21] = a[21], a[10]
+	}
+	if a[11] < a[22] {
+		a[11], a[22] = a[22], a[11]
+	}
+	if a[11] < a[21] {
+		a[11], a[21] = a[21], a[11]
+	}
+	if a[9] < a[19] {
+		a[9], a[19] = a[19], a[9]
+	}
+	if a[9] < a[18] {
+		a[9], a[18] = a[18], a[9]
+	}
+	if a[10] < a[20] {
+		a[10], a[20] = a[20], a[10]
+	}
+	if a[11] < a[20] {
+		a[11], a[20] = a[20], a[11]
+	}
+	if a[10] < a[18] {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[11] < a[19] {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[11] < a[18] {
+		a[11], a[18] = a[18], a[11]
+	}
+	if a[6] < a[13] {
+		a[6], a[13] = a[13], a[6]
+	}
+	if a[6] < a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[7] < a[14] {
+		a[7], a[14] = a[14], a[7]
+	}
+	if a[8] < a[14] {
+		a[8], a[14] = a[14], a[8]
+	}
+	if a[7] < a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[8] < a[13] {
+		a[8], a[13] = a[13], a[8]
+	}
+	if a[8] < a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9] < a[16] {
+		a[9], a[16] = a[16], a[9]
+	}
+	if a[9] < a[15] {
+		a[9], a[15] = a[15], a[9]
+	}
+	if a[10] < a[17] {
+		a[10], a[17] = a[17], a[10]
+	}
+	if a[11] < a[17] {
+		a[11], a[17] = a[17], a[11]
+	}
+	if a[10] < a[15] {
+		a[10], a[15] = a[15], a[10]
+	}
+	if a[11] < a[16] {
+		a[11], a[16] = a[16], a[11]
+	}
+	if a[11] < a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[9] < a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[9] < a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[10] < a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11] < a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[10] < a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[11] < a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[11] < a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+}
+
+func NetworkSort32xInt16(a []int16) {
+	_ = a[31]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[9] > a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[11] > a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[0] > a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] > a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[1] > a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[2] > a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[3] > a[10] {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[3] > a[9] {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[5] > a[12] {
+		a[5], a[12] = a[12], a[5]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7] > a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[7] > a[14] {
+		a[7], a[14] = a[14], a[7]
+	}
+	if a[6] > a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[7] > a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[7] > a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[16] > a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[18] > a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[16] > a[18] {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[17] > a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[20] > a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[22] > a[23] {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[20] > a[22] {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[21] > a[23] {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[21] > a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[16] > a[20] {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[17] > a[21] {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[17] > a[20] {
+		a[17], a[20] = a[20], a[17]
+	}
+	if a[18] > a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[19] > a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[19] > a[22] {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[18] > a[20] {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[19] > a[21] {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[19] > a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[24] > a[25] {
+		a[24], a[25] = a[25], a[24]
+	}
+	if a[26] > a[27] {
+		a[26], a[27] = a[27], a[26]
+	}
+	if a[24] > a[26] {
+		a[24], a[26] = a[26], a[24]
+	}
+	if a[25] > a[27] {
+		a[25], a[27] = a[27], a[25]
+	}
+	if a[25] > a[26] {
+		a[25], a[26] = a[26], a[25]
+	}
+	if a[28] > a[29] {
+		a[28], a[29] = a[29], a[28]
+	}
+	if a[30] > a[31] {
+		a[30], a[31] = a[31], a[30]
+	}
+	if a[28] > a[30] {
+		a[28], a[30] = a[30], a[28]
+	}
+	if a[29] > a[31] {
+		a[29], a[31] = a[31], a[29]
+	}
+	if a[29] > a[30] {
+		a[29], a[30] = a[30], a[29]
+	}
+	if a[24] > a[28] {
+		a[24], a[28] = a[28], a[24]
+	}
+	if a[25] > a[29] {
+		a[25], a[29] = a[29], a[25]
+	}
+	if a[25] > a[28] {
+		a[25], a[28] = a[28], a[25]
+	}
+	if a[26] > a[30] {
+		a[26], a[30] = a[30], a[26]
+	}
+	if a[27] > a[31] {
+		a[27], a[31] = a[31], a[27]
+	}
+	if a[27] > a[30] {
+		a[27], a[30] = a[30], a[27]
+	}
+	if a[26] > a[28] {
+		a[26], a[28] = a[28], a[26]
+	}
+	if a[27] > a[29] {
+		a[27], a[29] = a[29], a[27]
+	}
+	if a[27] > a[28] {
+		a[27], a[28] = a[28], a[27]
+	}
+	if a[16] > a[24] {
+		a[16], a[24] = a[24], a[16]
+	}
+	if a[17] > a[25] {
+		a[17], a[25] = a[25], a[17]
+	}
+	if a[17] > a[24] {
+		a[17], a[24] = a[24], a[17]
+	}
+	if a[18] > a[26] {
+		a[18], a[26] = a[26], a[18]
+	}
+	if a[19] > a[27] {
+		a[19], a[27] = a[27], a[19]
+	}
+	if a[19] > a[26] {
+		a[19], a[26] = a[26], a[19]
+	}
+	if a[18] > a[24] {
+		a[18], a[24] = a[24], a[18]
+	}
+	if a[19] > a[25] {
+		a[19], a[25] = a[25], a[19]
+	}
+	if a[19] > a[24] {
+		a[19], a[24] = a[24], a[19]
+	}
+	if a[20] > a[28] {
+		a[20], a[28] = a[28], a[20]
+	}
+	if a[21] > a[29] {
+		a[21], a[29] = a[29], a[21]
+	}
+	if a[21] > a[28] {
+		a[21], a[28] = a[28], a[21]
+	}
+	if a[22] > a[30] {
+		a[22], a[30] = a[30], a[22]
+	}
+	if a[23] > a[31] {
+		a[23], a[31] = a[31], a[23]
+	}
+	if a[23] > a[30] {
+		a[23], a[30] = a[30], a[23]
+	}
+	if a[22] > a[28] {
+		a[22], a[28] = a[28], a[22]
+	}
+	if a[23] > a[29] {
+		a[23], a[29] = a[29], a[23]
+	}
+	if a[23] > a[28] {
+		a[23], a[28] = a[28], a[23]
+	}
+	if a[20] > a[24] {
+		a[20], a[24] = a[24], a[20]
+	}
+	if a[21] > a[25] {
+		a[21], a[25] = a[25], a[21]
+	}
+	if a[21] > a[24] {
+		a[21], a[24] = a[24], a[21]
+	}
+	if a[22] > a[26] {
+		a[22], a[26] = a[26], a[22]
+	}
+	if a[23] > a[27] {
+		a[23], a[27] = a[27], a[23]
+	}
+	if a[23] > a[26] {
+		a[23], a[26] = a[26], a[23]
+	}
+	if a[22] > a[24] {
+		a[22], a[24] = a[24], a[22]
+	}
+	if a[23] > a[25] {
+		a[23], a[25] = a[25], a[23]
+	}
+	if a[23] > a[24] {
+		a[23], a[24] = a[24], a[23]
+	}
+	if a[0] > a[16] {
+		a[0], a[16] = a[16], a[0]
+	}
+	if a[1] > a[17] {
+		a[1], a[17] = a[17], a[1]
+	}
+	if a[1] > a[16] {
+		a[1], a[16] = a[16], a[1]
+	}
+	if a[2] > a[18] {
+		a[2], a[18] = a[18], a[2]
+	}
+	if a[3] > a[19] {
+		a[3], a[19] = a[19], a[3]
+	}
+	if a[3] > a[18] {
+		a[3], a[18] = a[18], a[3]
+	}
+	if a[2] > a[16] {
+		a[2], a[16] = a[16], a[2]
+	}
+	if a[3] > a[17] {
+		a[3], a[17] = a[17], a[3]
+	}
+	if a[3] > a[16] {
+		a[3], a[16] = a[16], a[3]
+	}
+	if a[4] > a[20] {
+		a[4], a[20] = a[20], a[4]
+	}
+	if a[5] > a[21] {
+		a[5], a[21] = a[21], a[5]
+	}
+	if a[5] > a[20] {
+		a[5], a[20] = a[20], a[5]
+	}
+	if a[6] > a[22] {
+		a[6], a[22] = a[22], a[6]
+	}
+	if a[7] > a[23] {
+		a[7], a[23] = a[23], a[7]
+	}
+	if a[7] > a[22] {
+		a[7], a[22] = a[22], a[7]
+	}
+	if a[6] > a[20] {
+		a[6], a[20] = a[20], a[6]
+	}
+	if a[7] > a[21] {
+		a[7], a[21] = a[21], a[7]
+	}
+	if a[7] > a[20] {
+		a[7], a[20] = a[20], a[7]
+	}
+	if a[4] > a[16] {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[5] > a[17] {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[5] > a[16] {
+		a[5], a[16] = a[16], a[5]
+	}
+	if a[6] > a[18] {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[7] > a[19] {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[7] > a[18] {
+		a[7], a[18] = a[18], a[7]
+	}
+	if a[6] > a[16] {
+		a[6], a[16] = a[16], a[6]
+	}
+	if a[7] > a[17] {
+		a[7], a[17] = a[17], a[7]
+	}
+	if a[7] > a[16] {
+		a[7], a[16] = a[16], a[7]
+	}
+	if a[8] > a[24] {
+		a[8], a[24] = a[24], a[8]
+	}
+	if a[9] > a[25] {
+		a[9], a[25] = a[25], a[9]
+	}
+	if a[9] > a[24] {
+		a[9], a[24] = a[24], a[9]
+	}
+	if a[10] > a[26] {
+		a[10], a[26] = a[26], a[10]
+	}
+	if a[11] > a[27] {
+		a[11], a[27] = a[27], a[11]
+	}
+	if a[11] > a[26] {
+		a[11], a[26] = a[26], a[11]
+	}
+	if a[10] > a[24] {
+		a[10], a[24] = a[24], a[10]
+	}
+	if a[11] > a[25] {
+		a[11], a[25] = a[25], a[11]
+	}
+	if a[11] > a[24] {
+		a[11], a[24] = a[24], a[11]
+	}
+	if a[12] > a[28] {
+		a[12], a[28] = a[28], a[12]
+	}
+	if a[13] > a[29] {
+		a[13], a[29] = a[29], a[13]
+	}
+	if a[13] > a[28] {
+		a[13], a[28] = a[28], a[13]
+	}
+	if a[14] > a[30] {
+		a[14], a[30] = a[30], a[14]
+	}
+	if a[15] > a[31] {
+		a[15], a[31] = a[31], a[15]
+	}
+	if a[15] > a[30] {
+		a[15], a[30] = a[30], a[15]
+	}
+	if a[14] > a[28] {
+		a[14], a[28] = a[28], a[14]
+	}
+	if a[15] > a[29] {
+		a[15], a[29] = a[29], a[15]
+	}
+	if a[15] > a[28] {
+		a[15], a[28] = a[28], a[15]
+	}
+	if a[12] > a[24] {
+		a[12], a[24] = a[24], a[12]
+	}
+	if a[13] > a[25] {
+		a[13], a[25] = a[25], a[13]
+	}
+	if a[13] > a[24] {
+		a[13], a[24] = a[24], a[13]
+	}
+	if a[14] > a[26] {
+		a[14], a[26] = a[26], a[14]
+	}
+	if a[15] > a[27] {
+		a[15], a[27] = a[27], a[15]
+	}
+	if a[15] > a[26] {
+		a[15], a[26] = a[26], a[15]
+	}
+	if a[14] > a[24] {
+		a[14], a[24] = a[24], a[14]
+	}
+	if a[15] > a[25] {
+		a[15], a[25] = a[25], a[15]
+	}
+	if a[15] > a[24] {
+		a[15], a[24] = a[24], a[15]
+	}
+	if a[8] > a[16] {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[9] > a[17] {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[9] > a[16] {
+		a[9], a[16] = a[16], a[9]
+	}
+	if a[10] > a[18] {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[11] > a[19] {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[11] > a[18] {
+		a[11], a[18] = a[18], a[11]
+	}
+	if a[10] > a[16] {
+		a[10], a[16] = a[16], a[10]
+	}
+	if a[11] > a[17] {
+		a[11], a[17] = a[17], a[11]
+	}
+	if a[11] > a[16] {
+		a[11], a[16] = a[16], a[11]
+	}
+	if a[12] > a[20] {
+		a[12], a[20] = a[20], a[12]
+	}
+	if a[13] > a[21] {
+		a[13], a[21] = a[21], a[13]
+	}
+	if a[13] > a[20] {
+		a[13], a[20] = a[20], a[13]
+	}
+	if a[14] > a[22] {
+		a[14], a[22] = a[22], a[14]
+	}
+	if a[15] > a[23] {
+		a[15], a[23] = a[23], a[15]
+	}
+	if a[15] > a[22] {
+		a[15], a[22] = a[22], a[15]
+	}
+	if a[14] > a[20] {
+		a[14], a[20] = a[20], a[14]
+	}
+	if a[15] > a[21] {
+		a[15], a[21] = a[21], a[15]
+	}
+	if a[15] > a[20] {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[13] > a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[13] > a[16] {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[14] > a[18] {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[15] > a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[15] > a[18] {
+		a[15], a[18] = a[18], a[15]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+}
+
+func NetworkSort32xInt16Reverse(a []int16) {
+	_ = a[31]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] < a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] < a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[8] < a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[9] < a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12] < a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14] < a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[12] < a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[13] < a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[13] < a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[8] < a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9] < a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[9] < a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[10] < a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11] < a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[11] < a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[10] < a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[11] < a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[11] < a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[0] < a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] < a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[1] < a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[2] < a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] < a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[3] < a[10] {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[2] < a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[3] < a[9] {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[3] < a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[4] < a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] < a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[5] < a[12] {
+		a[5], a[12] = a[12], a[5]
+	}
+	if a[6] < a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7] < a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[7] < a[14] {
+		a[7], a[14] = a[14], a[7]
+	}
+	if a[6] < a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[7] < a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[7] < a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[4] < a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[5] < a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[5] < a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[7] < a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[7] < a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[16] < a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[18] < a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[16] < a[18] {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[17] < a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[17] < a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[20] < a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[22] < a[23] {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[20] < a[22] {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[21] < a[23] {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[21] < a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[16] < a[20] {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[17] < a[21] {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[17] < a[20] {
+		a[17], a[20] = a[20], a[17]
+	}
+	if a[18] < a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[19] < a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[19] < a[22] {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[18] < a[20] {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[19] < a[21] {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[19] < a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[24] < a[25] {
+		a[24], a[25] = a[25], a[24]
+	}
+	if a[26] < a[27] {
+		a[26], a[27] = a[27], a[26]
+	}
+	if a[24] < a[26] {
+		a[24], a[26] = a[26], a[24]
+	}
+	if a[25] < a[27] {
+		a[25], a[27] = a[27], a[25]
+	}
+	if a[25] < a[26] {
+		a[25], a[26] = a[26], a[25]
+	}
+	if a[28] < a[29] {
+		a[28], a[29] = a[29], a[28]
+	}
+	if a[30] < a[31] {
+		a[30], a[31] = a[31], a[30]
+	}
+	if a[28] < a[30] {
+		a[28], a[30] = a[30], a[28]
+	}
+	if a[29] < a[31] {
+		a[29], a[31] = a[31], a[29]
+	}
+	if a[29] < a[30] {
+		a[29], a[30] = a[30], a[29]
+	}
+	if a[24] < a[28] {
+		a[24], a[28] = a[28], a[24]
+	}
+	if a[25] < a[29] {
+		a[25], a[29] = a[29], a[25]
+	}
+	if a[25] < a[28] {
+		a[25], a[28] = a[28], a[25]
+	}
+	if a[26] < a[30] {
+		a[26], a[30] = a[30], a[26]
+	}
+	if a[27] < a[31] {
+		a[27], a[31] = a[31], a[27]
+	}
+	if a[27] < a[30] {
+		a[27], a[30] = a[30], a[27]
+	}
+	if a[26] < a[28] {
+		a[26], a[28] = a[28], a[26]
+	}
+	if a[27] < a[29] {
+		a[27], a[29] = a[29], a[27]
+	}
+	if a[27] < a[28] {
+		a[27], a[28] = a[28], a[27]
+	}
+	if a[16] < a[24] {
+		a[16], a[24] = a[24], a[16]
+	}
+	if a[17] < a[25] {
+		a[17], a[25] = a[25], a[17]
+	}
+	if a[17] < a[24] {
+		a[17], a[24] = a[24], a[17]
+	}
+	if a[18] < a[26] {
+		a[18], a[26] = a[26], a[18]
+	}
+	if a[19] < a[27] {
+		a[19], a[27] = a[27], a[19]
+	}
+	if a[19] < a[26] {
+		a[19], a[26] = a[26], a[19]
+	}
+	if a[18] < a[24] {
+		a[18], a[24] = a[24], a[18]
+	}
+	if a[19] < a[25] {
+		a[19], a[25] = a[25], a[19]
+	}
+	if a[19] < a[24] {
+		a[19], a[24] = a[24], a[19]
+	}
+	if a[20] < a[28] {
+		a[20], a[28] = a[28], a[20]
+	}
+	if a[21] < a[29] {
+		a[21], a[29] = a[29], a[21]
+	}
+	if a[21] < a[28] {
+		a[21], a[28] = a[28], a[21]
+	}
+	if a[22] < a[30] {
+		a[22], a[30] = a[30], a[22]
+	}
+	if a[23] < a[31] {
+		a[23], a[31] = a[31], a[23]
+	}
+	if a[23] < a[30] {
+		a[23], a[30] = a[30], a[23]
+	}
+	if a[22] < a[28] {
+		a[22], a[28] = a[28], a[22]
+	}
+	if a[23] < a[29] {
+		a[23], a[29] = a[29], a[23]
+	}
+	if a[23] < a[28] {
+		a[23], a[28] = a[28], a[23]
+	}
+	if a[20] < a[24] {
+		a[20], a[24] = a[24], a[20]
+	}
+	if a[21] < a[25] {
+		a[21], a[25] = a[25], a[21]
+	}
+	if a[21] < a[24] {
+		a[21], a[24] = a[24], a[21]
+	}
+	if a[22] < a[26] {
+		a[22], a[26] = a[26], a[22]
+	}
+	if a[23] < a[27] {
+		a[23], a[27] = a[27], a[23]
+	}
+	if a[23] < a[26] {
+		a[23], a[26] = a[26], a[23]
+	}
+	if a[22] < a[24] {
+		a[22], a[24] = a[24], a[22]
+	}
+	if a[23] < a[25] {
+		a[23], a[25] = a[25], a[23]
+	}
+	if a[23] < a[24] {
+		a[23], a[24] = a[24], a[23]
+	}
+	if a[0] < a[16] {
+		a[0], a[16] = a[16], a[0]
+	}
+	if a[1] < a[17] {
+		a[1], a[17] = a[17], a[1]
+	}
+	if a[1] < a[16] {
+		a[1], a[16] = a[16], a[1]
+	}
+	if a[2] < a[18] {
+		a[2], a[18] = a[18], a[2]
+	}
+	if a[3] < a[19] {
+		a[3], a[19] = a[19], a[3]
+	}
+	if a[3] < a[18] {
+		a[3], a[18] = a[18], a[3]
+	}
+	if a[2] < a[16] {
+		a[2], a[16] = a[16], a[2]
+	}
+	if a[3] < a[17] {
+		a[3], a[17] = a[17], a[3]
+	}
+	if a[3] < a[16] {
+		a[3], a[16] = a[16], a[3]
+	}
+	if a[4] < a[20] {
+		a[4], a[20] = a[20], a[4]
+	}
+	if a[5] < a[21] {
+		a[5], a[21] = a[21], a[5]
+	}
+	if a[5] < a[20] {
+		a[5], a[20] = a[20], a[5]
+	}
+	if a[6] < a[22] {
+		a[6], a[22] = a[22], a[6]
+	}
+	if a[7] < a[23] {
+		a[7], a[23] = a[23], a[7]
+	}
+	if a[7] < a[22] {
+		a[7], a[22] = a[22], a[7]
+	}
+	if a[6] < a[20] {
+		a[6], a[20] = a[20], a[6]
+	}
+	if a[7] < a[21] {
+		a[7], a[21] = a[21], a[7]
+	}
+	if a[7] < a[20] {
+		a[7], a[20] = a[20], a[7]
+	}
+	if a[4] < a[16] {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[5] < a[17] {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[5] < a[16] {
+		a[5], a[16] = a[16], a[5]
+	}
+	if a[6] < a[18] {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[7] < a[19] {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[7] < a[18] {
+		a[7], a[18] = a[18], a[7]
+	}
+	if a[6] < a[16] {
+		a[6], a[16] = a[16], a[6]
+	}
+	if a[7] < a[17] {
+		a[7], a[17] = a[17], a[7]
+	}
+	if a[7] < a[16] {
+		a[7], a[16] = a[16], a[7]
+	}
+	if a[8] < a[24] {
+		a[8], a[24] = a[24], a[8]
+	}
+	if a[9] < a[25] {
+		a[9], a[25] = a[25], a[9]
+	}
+	if a[9] < a[24] {
+		a[9], a[24] = a[24], a[9]
+	}
+	if a[10] < a[26] {
+		a[10], a[26] = a[26], a[10]
+	}
+	if a[11] < a[27] {
+		a[11], a[27] = a[27], a[11]
+	}
+	if a[11] < a[26] {
+		a[11], a[26] = a[26], a[11]
+	}
+	if a[10] < a[24] {
+		a[10], a[24] = a[24], a[10]
+	}
+	if a[11] < a[25] {
+		a[11], a[25] = a[25], a[11]
+	}
+	if a[11] < a[24] {
+		a[11], a[24] = a[24], a[11]
+	}
+	if a[12] < a[28] {
+		a[12], a[28] = a[28], a[12]
+	}
+	if a[13] < a[29] {
+		a[13], a[29] = a[29], a[13]
+	}
+	if a[13] < a[28] {
+		a[13], a[28] = a[28], a[13]
+	}
+	if a[14] < a[30] {
+		a[14], a[30] = a[30], a[14]
+	}
+	if a[15] < a[31] {
+		a[15], a[31] = a[31], a[15]
+	}
+	if a[15] < a[30] {
+		a[15], a[30] = a[30], a[15]
+	}
+	if a[14] < a[28] {
+		a[14], a[28] = a[28], a[14]
+	}
+	if a[15] < a[29] {
+		a[15], a[29] = a[29], a[15]
+	}
+	if a[15] < a[28] {
+		a[15], a[28] = a[28], a[15]
+	}
+	if a[12] < a[24] {
+		a[12], a[24] = a[24], a[12]
+	}
+	if a[13] < a[25] {
+		a[13], a[25] = a[25], a[13]
+	}
+	if a[13] < a[24] {
+		a[13], a[24] = a[24], a[13]
+	}
+	if a[14] < a[26] {
+		a[14], a[26] = a[26], a[14]
+	}
+	if a[15] < a[27] {
+		a[15], a[27] = a[27], a[15]
+	}
+	if a[15] < a[26] {
+		a[15], a[26] = a[26], a[15]
+	}
+	if a[14] < a[24] {
+		a[14], a[24] = a[24], a[14]
+	}
+	if a[15] < a[25] {
+		a[15], a[25] = a[25], a[15]
+	}
+	if a[15] < a[24] {
+		a[15], a[24] = a[24], a[15]
+	}
+	if a[8] < a[16] {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[9] < a[17] {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[9] < a[16] {
+		a[9], a[16] = a[16], a[9]
+	}
+	if a[10] < a[18] {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[11] < a[19] {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[11] < a[18] {
+		a[11], a[18] = a[18], a[11]
+	}
+	if a[10] < a[16] {
+		a[10], a[16] = a[16], a[10]
+	}
+	if a[11] < a[17] {
+		a[11], a[17] = a[17], a[11]
+	}
+	if a[11] < a[16] {
+		a[11], a[16] = a[16], a[11]
+	}
+	if a[12] < a[20] {
+		a[12], a[20] = a[20], a[12]
+	}
+	if a[13] < a[21] {
+		a[13], a[21] = a[21], a[13]
+	}
+	if a[13] < a[20] {
+		a[13], a[20] = a[20], a[13]
+	}
+	if a[14] < a[22] {
+		a[14], a[22] = a[22], a[14]
+	}
+	if a[15] < a[23] {
+		a[15], a[23] = a[23], a[15]
+	}
+	if a[15] < a[22] {
+		a[15], a[22] = a[22], a[15]
+	}
+	if a[14] < a[20] {
+		a[14], a[20] = a[20], a[14]
+	}
+	if a[15] < a[21] {
+		a[15], a[21] = a[21], a[15]
+	}
+	if a[15] < a[20] {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[12] < a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[13] < a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[13] < a[16] {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[14] < a[18] {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[15] < a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[15] < a[18] {
+		a[15], a[18] = a[18], a[15]
+	}
+	if a[14] < a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[15] < a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[15] < a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+}
+
+func NetworkSort2xInt32(a []int32) {
+	_ = a[1]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort2xInt32Reverse(a []int32) {
+	_ = a[1]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3xInt32(a []int32) {
+	_ = a[2]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3xInt32Reverse(a []int32) {
+	_ = a[2]
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort4xInt32(a []int32) {
+	_ = a[3]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort4xInt32Reverse(a []int32) {
+	_ = a[3]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5xInt32(a []int32) {
+	_ = a[4]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5xInt32Reverse(a []int32) {
+	_ = a[4]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] < a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort6xInt32(a []int32) {
+	_ = a[5]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort6xInt32Reverse(a []int32) {
+	_ = a[5]
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] < a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] < a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7xInt32(a []int32) {
+	_ = a[6]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7xInt32Reverse(a []int32) {
+	_ = a[6]
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] < a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2] < a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort8xInt32(a []int32) {
+	_ = a[7]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort8xInt32Reverse(a []int32) {
+	_ = a[7]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] < a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort9xInt32(a []int32) {
+	_ = a[8]
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[0] > a[5] {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[0] > a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2] > a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort9xInt32Reverse(a []int32) {
+	_ = a[8]
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[0] < a[5] {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[0] < a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] < a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2] < a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort10xInt32(a []int32) {
+	_ = a[9]
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] > a[9] {
+		a[0], a[9] = a[9], a[0]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[0] > a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4] > a[9] {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] > a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[1] > a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort10xInt32Reverse(a []int32) {
+	_ = a[9]
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] < a[9] {
+		a[0], a[9] = a[9], a[0]
+	}
+	if a[2] < a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[0] < a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3] < a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4] < a[9] {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6] < a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] < a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[1] < a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] < a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort11xInt32(a []int32) {
+	_ = a[10]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort11xInt32Reverse(a []int32) {
+	_ = a[10]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] < a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5] < a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] < a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] < a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3] < a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort12xInt32(a []int32) {
+	_ = a[11]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort12xInt32Reverse(a []int32) {
+	_ = a[11]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] < a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] < a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] < a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5] < a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] < a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] < a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] < a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] < a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3] < a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort13xInt32(a []int32) {
+	_ = a[12]
+	if a[1] > a[7] {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[0] > a[12] {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[11] {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[7] > a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[6] > a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[4] > a[9] {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1] > a[7] {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[4] > a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[0] > a[5] {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort13xInt32Reverse(a []int32) {
+	_ = a[12]
+	if a[1] < a[7] {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[9] < a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[0] < a[12] {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] < a[11] {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[7] < a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[5] < a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[10] < a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[6] < a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[11] < a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[4] < a[9] {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] < a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1] < a[7] {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[9] < a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[4] < a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[8] < a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[0] < a[5] {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[2] < a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort14xInt32(a []int32) {
+	_ = a[13]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[0] > a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] > a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2] > a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[5] > a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3] > a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7] > a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort14xInt32Reverse(a []int32) {
+	_ = a[13]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] < a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] < a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] < a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] < a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8] < a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9] < a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[0] < a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] < a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2] < a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] < a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4] < a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] < a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[5] < a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6] < a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3] < a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[7] < a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] < a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] < a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2] < a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] < a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3] < a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7] < a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] < a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] < a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort15xInt32(a []int32) {
+	_ = a[14]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[0] > a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] > a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2] > a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[5] > a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3] > a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[11] > a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7] > a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort15xInt32Reverse(a []int32) {
+	_ = a[14]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] < a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] < a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] < a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[12] < a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] < a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8] < a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9] < a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[10] < a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[0] < a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] < a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2] < a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] < a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4] < a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] < a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] < a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[5] < a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6] < a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3] < a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[13] < a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[7] < a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] < a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] < a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2] < a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[11] < a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] < a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3] < a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7] < a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] < a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] < a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort16xInt32(a []int32) {
+	_ = a[15]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[7] > a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[2] > a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[1] > a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[0] > a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] > a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[5] > a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[7] > a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[3] > a[9] {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[5] > a[12] {
+		a[5], a[12] = a[12], a[5]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[11] > a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[9] > a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+}
+
+func NetworkSort16xInt32Reverse(a []int32) {
+	_ = a[15]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] < a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] < a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14] < a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] < a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[13] < a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] < a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[12] < a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[11] < a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[10] < a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9] < a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8] < a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[7] < a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[6] < a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[5] < a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[4] < a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[3] < a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[2] < a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[1] < a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[0] < a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] < a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[13] < a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[4] < a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[7] < a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[5] < a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6] < a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[2] < a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[7] < a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[3] < a[9] {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[5] < a[12] {
+		a[5], a[12] = a[12], a[5]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[11] < a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] < a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[11] < a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3] < a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[7] < a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[5] < a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[9] < a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] < a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+}
+
+func NetworkSort24xInt32(a []int32) {
+	_ = a[23]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[16] > a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[18] > a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[20] > a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[22] > a[23] {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[17] > a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[21] > a[23] {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[16] > a[18] {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[20] > a[22] {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[21] > a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[13] > a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[18] > a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[17] > a[21] {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[14] > a[18] {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[13] > a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[18] > a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[19] > a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[15] > a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[16] > a[20] {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[19] > a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[13] > a[16] {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[19] > a[22] {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[15] > a[20] {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[20] > a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[19] > a[21] {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[18] > a[20] {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[19] > a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[0] > a[12] {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[1] > a[13] {
+		a[1], a[13] = a[13], a[1]
+	}
+	if a[2] > a[14] {
+		a[2], a[14] = a[14], a[2]
+	}
+	if a[3] > a[15] {
+		a[3], a[15] = a[15], a[3]
+	}
+	if a[4] > a[16] {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[5] > a[17] {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[6] > a[18] {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[7] > a[19] {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[8] > a[20] {
+		a[8], a[20] = a[20], a[8]
+	}
+	if a[9] > a[21] {
+		a[9], a[21] = a[21], a[9]
+	}
+	if a[10] > a[22] {
+		a[10], a[22] = a[22], a[10]
+	}
+	if a[11] > a[23] {
+		a[11], a[23] = a[23], a[11]
+	}
+	if a[2] > a[12] {
+		a[2], a[12] = a[12], a[2]
+	}
+	if a[3] > a[13] {
+		a[3], a[13] = a[13], a[3]
+	}
+	if a[10] > a[20] {
+		a[10], a[20] = a[20], a[10]
+	}
+	if a[11] > a[21] {
+		a[11], a[21] = a[21], a[11]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7] > a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[8] > a[16] {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[9] > a[17] {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[10] > a[18] {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[11] > a[19] {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[19] > a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[21] > a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+}
+
+func NetworkSort24xInt32Reverse(a []int32) {
+	_ = a[23]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] < a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] < a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14] < a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[16] < a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[18] < a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[20] < a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[22] < a[23] {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] < a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] < a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[13] < a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[17] < a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[21] < a[23] {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[12] < a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[16] < a[18] {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[20] < a[22] {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[13] < a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[17] < a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[21] < a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[13] < a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[18] < a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[5] < a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[17] < a[21] {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[14] < a[18] {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] < a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[13] < a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[18] < a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[12] < a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[19] < a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] < a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[15] < a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[16] < a[20] {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] < a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[12] < a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[19] < a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] < a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3] < a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[13] < a[16] {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[19] < a[22] {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[15] < a[20] {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[14] < a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[20] < a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[14] < a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[19] < a[21] {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[15] < a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[18] < a[20] {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[15] < a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[17] < a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[19] < a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[0] < a[12] {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[1] < a[13] {
+		a[1], a[13] = a[13], a[1]
+	}
+	if a[2] < a[14] {
+		a[2], a[14] = a[14], a[2]
+	}
+	if a[3] < a[15] {
+		a[3], a[15] = a[15], a[3]
+	}
+	if a[4] < a[16] {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[5] < a[17] {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[6] < a[18] {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[7] < a[19] {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[8] < a[20] {
+		a[8], a[20] = a[20], a[8]
+	}
+	if a[9] < a[21] {
+		a[9], a[21] = a[21], a[9]
+	}
+	if a[10] < a[22] {
+		a[10], a[22] = a[22], a[10]
+	}
+	if a[11] < a[23] {
+		a[11], a[23] = a[23], a[11]
+	}
+	if a[2] < a[12] {
+		a[2], a[12] = a[12], a[2]
+	}
+	if a[3] < a[13] {
+		a[3], a[13] = a[13], a[3]
+	}
+	if a[10] < a[20] {
+		a[10], a[20] = a[20], a[10]
+	}
+	if a[11] < a[21] {
+		a[11], a[21] = a[21], a[11]
+	}
+	if a[4] < a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] < a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] < a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7] < a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[8] < a[16] {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[9] < a[17] {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[10] < a[18] {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[11] < a[19] {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[8] < a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9] < a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[10] < a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11] < a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] < a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[14] < a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[11] < a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[15] < a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] < a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[13] < a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[15] < a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[17] < a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[19] < a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[21] < a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+}
+
+func NetworkSort32xInt32(a []int32) {
+	_ = a[31]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[9] > a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[11] > a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[0] > a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] > a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[1] > a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[2] > a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[3] > a[10] {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[3] > a[9] {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[5] > a[12] {
+		a[5], a[12] = a[12], a[5]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7] > a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[7] > a[14] {
+		a[7], a[14] = a[14], a[7]
+	}
+	if a[6] > a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[7] > a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[7] > a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[16] > a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[18] > a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[16] > a[18] {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[17] > a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[20] > a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[22] > a[23] {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[20] > a[22] {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[21] > a[23] {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[21] > a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[16] > a[20] {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[17] > a[21] {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[17] > a[20] {
+		a[17], a[20] = a[20], a[17]
+	}
+	if a[18] > a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[19] > a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[19] > a[22] {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[18] > a[20] {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[19] > a[21] {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[19] > a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[24] > a[25] {
+		a[24], a[25] = a[25], a[24]
+	}
+	if a[26] > a[27] {
+		a[26], a[27] = a[27], a[26]
+	}
+	if a[24] > a[26] {
+		a[24], a[26] = a[26], a[24]
+	}
+	if a[25] > a[27] {
+		a[25], a[27] = a[27], a[25]
+	}
+	if a[25] > a[26] {
+		a[25], a[26] = a[26], a[25]
+	}
+	if a[28] > a[29] {
+		a[28], a[29] = a[29], a[28]
+	}
+	if a[30] > a[31] {
+		a[30], a[31] = a[31], a[30]
+	}
+	if a[28] > a[30] {
+		a[28], a[30] = a[30], a[28]
+	}
+	if a[29] > a[31] {
+		a[29], a[31] = a[31], a[29]
+	}
+	if a[29] > a[30] {
+		a[29], a[30] = a[30], a[29]
+	}
+	if a[24] > a[28] {
+		a[24], a[28] = a[28], a[24]
+	}
+	if a[25] > a[29] {
+		a[25], a[29] = a[29], a[25]
+	}
+	if a[25] > a[28] {
+		a[25], a[28] = a[28], a[25]
+	}
+	if a[26] > a[30] {
+		a[26], a[30] = a[30], a[26]
+	}
+	if a[27] > a[31] {
+		a[27], a[31] = a[31], a[27]
+	}
+	if a[27] > a[30] {
+		a[27], a[30] = a[30], a[27]
+	}
+	if a[26] > a[28] {
+		a[26], a[28] = a[28], a[26]
+	}
+	if a[27] > a[29] {
+		a[27], a[29] = a[29], a[27]
+	}
+	if a[27] > a[28] {
+		a[27], a[28] = a[28], a[27]
+	}
+	if a[16] > a[24] {
+		a[16], a[24] = a[24], a[16]
+	}
+	if a[17] > a[25] {
+		a[17], a[25] = a[25], a[17]
+	}
+	if a[17] > a[24] {
+		a[17], a[24] = a[24], a[17]
+	}
+	if a[18] > a[26] {
+		a[18], a[26] = a[26], a[18]
+	}
+	if a[19] > a[27] {
+		a[19], a[27] = a[27], a[19]
+	}
+	if a[19] > a[26] {
+		a[19], a[26] = a[26], a[19]
+	}
+	if a[18] > a[24] {
+		a[18], a[24] = a[24], a[18]
+	}
+	if a[19] > a[25] {
+		a[19], a[25] = a[25], a[19]
+	}
+	if a[19] > a[24] {
+		a[19], a[24] = a[24], a[19]
+	}
+	if a[20] > a[28] {
+		a[20], a[28] = a[28], a[20]
+	}
+	if a[21] > a[29] {
+		a[21], a[29] = a[29], a[21]
+	}
+	if a[21] > a[28] {
+		a[21], a[28] = a[28], a[21]
+	}
+	if a[22] > a[30] {
+		a[22], a[30] = a[30], a[22]
+	}
+	if a[23] > a[31] {
+		a[23], a[31] = a[31], a[23]
+	}
+	if a[23] > a[30] {
+		a[23], a[30] = a[30], a[23]
+	}
+	if a[22] > a[28] {
+		a[22], a[28] = a[28], a[22]
+	}
+	if a[23] > a[29] {
+		a[23], a[29] = a[29], a[23]
+	}
+	if a[23] > a[28] {
+		a[23], a[28] = a[28], a[23]
+	}
+	if a[20] > a[24] {
+		a[20], a[24] = a[24], a[20]
+	}
+	if a[21] > a[25] {
+		a[21], a[25] = a[25], a[21]
+	}
+	if a[21] > a[24] {
+		a[21], a[24] = a[24], a[21]
+	}
+	if a[22] > a[26] {
+		a[22], a[26] = a[26], a[22]
+	}
+	if a[23] > a[27] {
+		a[23], a[27] = a[27], a[23]
+	}
+	if a[23] > a[26] {
+		a[23], a[26] = a[26], a[23]
+	}
+	if a[22] > a[24] {
+		a[22], a[24] = a[24], a[22]
+	}
+	if a[23] > a[25] {
+		a[23], a[25] = a[25], a[23]
+	}
+	if a[23] > a[24] {
+		a[23], a[24] = a[24], a[23]
+	}
+	if a[0] > a[16] {
+		a[0], a[16] = a[16], a[0]
+	}
+	if a[1] > a[17] {
+		a[1], a[17] = a[17], a[1]
+	}
+	if a[1] > a[16] {
+		a[1], a[16] = a[16], a[1]
+	}
+	if a[2] > a[18] {
+		a[2], a[18] = a[18], a[2]
+	}
+	if a[3] > a[19] {
+		a[3], a[19] = a[19], a[3]
+	}
+	if a[3] > a[18] {
+		a[3], a[18] = a[18], a[3]
+	}
+	if a[2] > a[16] {
+		a[2], a[16] = a[16], a[2]
+	}
+	if a[3] > a[17] {
+		a[3], a[17] = a[17], a[3]
+	}
+	if a[3] > a[16] {
+		a[3], a[16] = a[16], a[3]
+	}
+	if a[4] > a[20] {
+		a[4], a[20] = a[20], a[4]
+	}
+	if a[5] > a[21] {
+		a[5], a[21] = a[21], a[5]
+	}
+	if a[5] > a[20] {
+		a[5], a[20] = a[20], a[5]
+	}
+	if a[6] > a[22] {
+		a[6], a[22] = a[22], a[6]
+	}
+	if a[7] > a[23] {
+		a[7], a[23] = a[23], a[7]
+	}
+	if a[7] > a[22] {
+		a[7], a[22] = a[22], a[7]
+	}
+	if a[6] > a[20] {
+		a[6], a[20] = a[20], a[6]
+	}
+	if a[7] > a[21] {
+		a[7], a[21] = a[21], a[7]
+	}
+	if a[7] > a[20] {
+		a[7], a[20] = a[20], a[7]
+	}
+	if a[4] > a[16] {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[5] > a[17] {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[5] > a[16] {
+		a[5], a[16] = a[16], a[5]
+	}
+	if a[6] > a[18] {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[7] > a[19] {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[7] > a[18] {
+		a[7], a[18] = a[18], a[7]
+	}
+	if a[6] > a[16] {
+		a[6], a[16] = a[16], a[6]
+	}
+	if a[7] > a[17] {
+		a[7], a[17] = a[17], a[7]
+	}
+	if a[7] > a[16] {
+		a[7], a[16] = a[16], a[7]
+	}
+	if a[8] > a[24] {
+		a[8], a[24] = a[24], a[8]
+	}
+	if a[9] > a[25] {
+		a[9], a[25] = a[25], a[9]
+	}
+	if a[9] > a[24] {
+		a[9], a[24] = a[24], a[9]
+	}
+	if a[10] > a[26] {
+		a[10], a[26] = a[26], a[10]
+	}
+	if a[11] > a[27] {
+		a[11], a[27] = a[27], a[11]
+	}
+	if a[11] > a[26] {
+		a[11], a[26] = a[26], a[11]
+	}
+	if a[10] > a[24] {
+		a[10], a[24] = a[24], a[10]
+	}
+	if a[11] > a[25] {
+		a[11], a[25] = a[25], a[11]
+	}
+	if a[11] > a[24] {
+		a[11], a[24] = a[24], a[11]
+	}
+	if a[12] > a[28] {
+		a[12], a[28] = a[28], a[12]
+	}
+	if a[13] > a[29] {
+		a[13], a[29] = a[29], a[13]
+	}
+	if a[13] > a[28] {
+		a[13], a[28] = a[28], a[13]
+	}
+	if a[14] > a[30] {
+		a[14], a[30] = a[30], a[14]
+	}
+	if a[15] > a[31] {
+		a[15], a[31] = a[31], a[15]
+	}
+	if a[15] > a[30] {
+		a[15], a[30] = a[30], a[15]
+	}
+	if a[14] > a[28] {
+		a[14], a[28] = a[28], a[14]
+	}
+	if a[15] > a[29] {
+		a[15], a[29] = a[29], a[15]
+	}
+	if a[15] > a[28] {
+		a[15], a[28] = a[28], a[15]
+	}
+	if a[12] > a[24] {
+		a[12], a[24] = a[24], a[12]
+	}
+	if a[13] > a[25] {
+		a[13], a[25] = a[25], a[13]
+	}
+	if a[13] > a[24] {
+		a[13], a[24] = a[24], a[13]
+	}
+	if a[14] > a[26] {
+		a[14], a[26] = a[26], a[14]
+	}
+	if a[15] > a[27] {
+		a[15], a[27] = a[27], a[15]
+	}
+	if a[15] > a[26] {
+		a[15], a[26] = a[26], a[15]
+	}
+	if a[14] > a[24] {
+		a[14], a[24] = a[24], a[14]
+	}
+	if a[15] > a[25] {
+		a[15], a[25] = a[25], a[15]
+	}
+	if a[15] > a[24] {
+		a[15], a[24] = a[24], a[15]
+	}
+	if a[8] > a[16] {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[9] > a[17] {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[9] > a[16] {
+		a[9], a[16] = a[16], a[9]
+	}
+	if a[10] > a[18] {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[11] > a[19] {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[11] > a[18] {
+		a[11], a[18] = a[18], a[11]
+	}
+	if a[10] > a[16] {
+		a[10], a[16] = a[16], a[10]
+	}
+	if a[11] > a[17] {
+		a[11], a[17] = a[17], a[11]
+	}
+	if a[11] > a[16] {
+		a[11], a[16] = a[16], a[11]
+	}
+	if a[12] > a[20] {
+		a[12], a[20] = a[20], a[12]
+	}
+	if a[13] > a[21] {
+		a[13], a[21] = a[21], a[13]
+	}
+	if a[13] > a[20] {
+		a[13], a[20] = a[20], a[13]
+	}
+	if a[14] > a[22] {
+		a[14], a[22] = a[22], a[14]
+	}
+	if a[15] > a[23] {
+		a[15], a[23] = a[23], a[15]
+	}
+	if a[15] > a[22] {
+		a[15], a[22] = a[22], a[15]
+	}
+	if a[14] > a[20] {
+		a[14], a[20] = a[20], a[14]
+	}
+	if a[15] > a[21] {
+		a[15], a[21] = a[21], a[15]
+	}
+	if a[15] > a[20] {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[13] > a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[13] > a[16] {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[14] > a[18] {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[15] > a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[15] > a[18] {
+		a[15], a[18] = a[18], a[15]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+}
+
+func NetworkSort32xInt32Reverse(a []int32) {
+	_ = a[31]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] < a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] < a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[8] < a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[9] < a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12] < a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14] < a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[12] < a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[13] < a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[13] < a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[8] < a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9] < a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[9] < a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[10] < a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11] < a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[11] < a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[10] < a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[11] < a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[11] < a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[0] < a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] < a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[1] < a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[2] < a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] < a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[3] < a[10] {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[2] < a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[3] < a[9] {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[3] < a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[4] < a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] < a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[5] < a[12] {
+		a[5], a[12] = a[12], a[5]
+	}
+	if a[6] < a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7] < a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[7] < a[14] {
+		a[7], a[14] = a[14], a[7]
+	}
+	if a[6] < a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[7] < a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[7] < a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[4] < a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[5] < a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[5] < a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[7] < a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[7] < a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[16] < a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[18] < a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[16] < a[18] {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[17] < a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[17] < a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[20] < a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[22] < a[23] {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[20] < a[22] {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[21] < a[23] {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[21] < a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[16] < a[20] {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[17] < a[21] {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[17] < a[20] {
+		a[17], a[20] = a[20], a[17]
+	}
+	if a[18] < a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[19] < a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[19] < a[22] {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[18] < a[20] {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[19] < a[21] {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[19] < a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[24] < a[25] {
+		a[24], a[25] = a[25], a[24]
+	}
+	if a[26] < a[27] {
+		a[26], a[27] = a[27], a[26]
+	}
+	if a[24] < a[26] {
+		a[24], a[26] = a[26], a[24]
+	}
+	if a[25] < a[27] {
+		a[25], a[27] = a[27], a[25]
+	}
+	if a[25] < a[26] {
+		a[25], a[26] = a[26], a[25]
+	}
+	if a[28] < a[29] {
+		a[28], a[29] = a[29], a[28]
+	}
+	if a[30] < a[31] {
+		a[30], a[31] = a[31], a[30]
+	}
+	if a[28] < a[30] {
+		a[28], a[30] = a[30], a[28]
+	}
+	if a[29] < a[31] {
+		a[29], a[31] = a[31], a[29]
+	}
+	if a[29] < a[30] {
+		a[29], a[30] = a[30], a[29]
+	}
+	if a[24] < a[28] {
+		a[24], a[28] = a[28], a[24]
+	}
+	if a[25] < a[29] {
+		a[25], a[29] = a[29], a[25]
+	}
+	if a[25] < a[28] {
+		a[25], a[28] = a[28], a[25]
+	}
+	if a[26] < a[30] {
+		a[26], a[30] = a[30], a[26]
+	}
+	if a[27] < a[31] {
+		a[27], a[31] = a[31], a[27]
+	}
+	if a[27] < a[30] {
+		a[27], a[30] = a[30], a[27]
+	}
+	if a[26] < a[28] {
+		a[26], a[28] = a[28], a[26]
+	}
+	if a[27] < a[29] {
+		a[27], a[29] = a[29], a[27]
+	}
+	if a[27] < a[28] {
+		a[27], a[28] = a[28], a[27]
+	}
+	if a[16] < a[24] {
+		a[16], a[24] = a[24], a[16]
+	}
+	if a[17] < a[25] {
+		a[17], a[25] = a[25], a[17]
+	}
+	if a[17] < a[24] {
+		a[17], a[24] = a[24], a[17]
+	}
+	if a[18] < a[26] {
+		a[18], a[26] = a[26], a[18]
+	}
+	if a[19] < a[27] {
+		a[19], a[27] = a[27], a[19]
+	}
+	if a[19] < a[26] {
+		a[19], a[26] = a[26], a[19]
+	}
+	if a[18] < a[24] {
+		a[18], a[24] = a[24], a[18]
+	}
+	if a[19] < a[25] {
+		a[19], a[25] = a[25], a[19]
+	}
+	if a[19] < a[24] {
+		a[19], a[24] = a[24], a[19]
+	}
+	if a[20] < a[28] {
+		a[20], a[28] = a[28], a[20]
+	}
+	if a[21] < a[29] {
+		a[21], a[29] = a[29], a[21]
+	}
+	if a[21] < a[28] {
+		a[21], a[28] = a[28], a[21]
+	}
+	if a[22] < a[30] {
+		a[22], a[30] = a[30], a[22]
+	}
+	if a[23] < a[31] {
+		a[23], a[31] = a[31], a[23]
+	}
+	if a[23] < a[30] {
+		a[23], a[30] = a[30], a[23]
+	}
+	if a[22] < a[28] {
+		a[22], a[28] = a[28], a[22]
+	}
+	if a[23] < a[29] {
+		a[23], a[29] = a[29], a[23]
+	}
+	if a[23] < a[28] {
+		a[23], a[28] = a[28], a[23]
+	}
+	if a[20] < a[24] {
+		a[20], a[24] = a[24], a[20]
+	}
+	if a[21] < a[25] {
+		a[21], a[25] = a[25], a[21]
+	}
+	if a[21] < a[24] {
+		a[21], a[24] = a[24], a[21]
+	}
+	if a[22] < a[26] {
+		a[22], a[26] = a[26], a[22]
+	}
+	if a[23] < a[27] {
+		a[23], a[27] = a[27], a[23]
+	}
+	if a[23] < a[26] {
+		a[23], a[26] = a[26], a[23]
+	}
+	if a[22] < a[24] {
+		a[22], a[24] = a[24], a[22]
+	}
+	if a[23] < a[25] {
+		a[23], a[25] = a[25], a[23]
+	}
+	if a[23] < a[24] {
+		a[23], a[24] = a[24], a[23]
+	}
+	if a[0] < a[16] {
+		a[0], a[16] = a[16], a[0]
+	}
+	if a[1] < a[17] {
+		a[1], a[17] = a[17], a[1]
+	}
+	if a[1] < a[16] {
+		a[1], a[16] = a[16], a[1]
+	}
+	if a[2] < a[18] {
+		a[2], a[18] = a[18], a[2]
+	}
+	if a[3] < a[19] {
+		a[3], a[19] = a[19], a[3]
+	}
+	if a[3] < a[18] {
+		a[3], a[18] = a[18], a[3]
+	}
+	if a[2] < a[16] {
+		a[2], a[16] = a[16], a[2]
+	}
+	if a[3] < a[17] {
+		a[3], a[17] = a[17], a[3]
+	}
+	if a[3] < a[16] {
+		a[3], a[16] = a[16], a[3]
+	}
+	if a[4] < a[20] {
+		a[4], a[20] = a[20], a[4]
+	}
+	if a[5] < a[21] {
+		a[5], a[21] = a[21], a[5]
+	}
+	if a[5] < a[20] {
+		a[5], a[20] = a[20], a[5]
+	}
+	if a[6] < a[22] {
+		a[6], a[22] = a[22], a[6]
+	}
+	if a[7] < a[23] {
+		a[7], a[23] = a[23], a[7]
+	}
+	if a[7] < a[22] {
+		a[7], a[22] = a[22], a[7]
+	}
+	if a[6] < a[20] {
+		a[6], a[20] = a[20], a[6]
+	}
+	if a[7] < a[21] {
+		a[7], a[21] = a[21], a[7]
+	}
+	if a[7] < a[20] {
+		a[7], a[20] = a[20], a[7]
+	}
+	if a[4] < a[16] {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[5] < a[17] {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[5] < a[16] {
+		a[5], a[16] = a[16], a[5]
+	}
+	if a[6] < a[18] {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[7] < a[19] {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[7] < a[18] {
+		a[7], a[18] = a[18], a[7]
+	}
+	if a[6] < a[16] {
+		a[6], a[16] = a[16], a[6]
+	}
+	if a[7] < a[17] {
+		a[7], a[17] = a[17], a[7]
+	}
+	if a[7] < a[16] {
+		a[7], a[16] = a[16], a[7]
+	}
+	if a[8] < a[24] {
+		a[8], a[24] = a[24], a[8]
+	}
+	if a[9] < a[25] {
+		a[9], a[25] = a[25], a[9]
+	}
+	if a[9] < a[24] {
+		a[9], a[24] = a[24], a[9]
+	}
+	if a[10] < a[26] {
+		a[10], a[26] = a[26], a[10]
+	}
+	if a[11] < a[27] {
+		a[11], a[27] = a[27], a[11]
+	}
+	if a[11] < a[26] {
+		a[11], a[26] = a[26], a[11]
+	}
+	if a[10] < a[24] {
+		a[10], a[24] = a[24], a[10]
+	}
+	if a[11] < a[25] {
+		a[11], a[25] = a[25], a[11]
+	}
+	if a[11] < a[24] {
+		a[11], a[24] = a[24], a[11]
+	}
+	if a[12] < a[28] {
+		a[12], a[28] = a[28], a[12]
+	}
+	if a[13] < a[29] {
+		a[13], a[29] = a[29], a[13]
+	}
+	if a[13] < a[28] {
+		a[13], a[28] = a[28], a[13]
+	}
+	if a[14] < a[30] {
+		a[14], a[30] = a[30], a[14]
+	}
+	if a[15] < a[31] {
+		a[15], a[31] = a[31], a[15]
+	}
+	if a[15] < a[30] {
+		a[15], a[30] = a[30], a[15]
+	}
+	if a[14] < a[28] {
+		a[14], a[28] = a[28], a[14]
+	}
+	if a[15] < a[29] {
+		a[15], a[29] = a[29], a[15]
+	}
+	if a[15] < a[28] {
+		a[15], a[28] = a[28], a[15]
+	}
+	if a[12] < a[24] {
+		a[12], a[24] = a[24], a[12]
+	}
+	if a[13] < a[25] {
+		a[13], a[25] = a[25], a[13]
+	}
+	if a[13] < a[24] {
+		a[13], a[24] = a[24], a[13]
+	}
+	if a[14] < a[26] {
+		a[14], a[26] = a[26], a[14]
+	}
+	if a[15] < a[27] {
+		a[15], a[27] = a[27], a[15]
+	}
+	if a[15] < a[26] {
+		a[15], a[26] = a[26], a[15]
+	}
+	if a[14] < a[24] {
+		a[14], a[24] = a[24], a[14]
+	}
+	if a[15] < a[25] {
+		a[15], a[25] = a[25], a[15]
+	}
+	if a[15] < a[24] {
+		a[15], a[24] = a[24], a[15]
+	}
+	if a[8] < a[16] {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[9] < a[17] {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[9] < a[16] {
+		a[9], a[16] = a[16], a[9]
+	}
+	if a[10] < a[18] {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[11] < a[19] {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[11] < a[18] {
+		a[11], a[18] = a[18], a[11]
+	}
+	if a[10] < a[16] {
+		a[10], a[16] = a[16], a[10]
+	}
+	if a[11] < a[17] {
+		a[11], a[17] = a[17], a[11]
+	}
+	if a[11] < a[16] {
+		a[11], a[16] = a[16], a[11]
+	}
+	if a[12] < a[20] {
+		a[12], a[20] = a[20], a[12]
+	}
+	if a[13] < a[21] {
+		a[13], a[21] = a[21], a[13]
+	}
+	if a[13] < a[20] {
+		a[13], a[20] = a[20], a[13]
+	}
+	if a[14] < a[22] {
+		a[14], a[22] = a[22], a[14]
+	}
+	if a[15] < a[23] {
+		a[15], a[23] = a[23], a[15]
+	}
+	if a[15] < a[22] {
+		a[15], a[22] = a[22], a[15]
+	}
+	if a[14] < a[20] {
+		a[14], a[20] = a[20], a[14]
+	}
+	if a[15] < a[21] {
+		a[15], a[21] = a[21], a[15]
+	}
+	if a[15] < a[20] {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[12] < a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[13] < a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[13] < a[16] {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[14] < a[18] {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[15] < a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[15] < a[18] {
+		a[15], a[18] = a[18], a[15]
+	}
+	if a[14] < a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[15] < a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[15] < a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+}
+
+func NetworkSort16xUint16(a []uint16) {
+	_ = a[15]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[0] > a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] > a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2] > a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7] > a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[5] > a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3] > a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[11] > a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7] > a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort16xUint16Reverse(a []uint16) {
+	_ = a[15]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] < a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] < a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14] < a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] < a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[12] < a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] < a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[13] < a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8] < a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9] < a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[10] < a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[11] < a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[0] < a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] < a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2] < a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] < a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4] < a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] < a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] < a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7] < a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[5] < a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6] < a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3] < a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[13] < a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[7] < a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] < a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] < a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2] < a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[11] < a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] < a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3] < a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7] < a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] < a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] < a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+}
@@ -0,0 +1,740 @@
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
+
+package gentest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/shabbyrobe/sortnet/sortnettest"
+)
+
+// testAlgGenCase is a function generated by sortnetgen, adapted to be checked by
+// sortnettest. Either sort or merge is set.
+type testAlgGenCase[T any] struct {
+	name  string
+	size  int
+	sort  func(a []T)
+	merge func(dst []T, runs [][]T) []T
+}
+
+// testAlgGenCasesNetworkSortInt32 returns the functions generated for int32
+// in increasing order, along with how to make random items and how they are ordered.
+func testAlgGenCasesNetworkSortInt32() (gen func(*rand.Rand) int32, before func(a, b int32) bool, cases []testAlgGenCase[int32]) {
+	gen = func(rng *rand.Rand) int32 { return int32(rng.Intn(32) - 16) }
+	less := func(a, b int32) bool { return a < b }
+	before = less
+	cases = []testAlgGenCase[int32]{
+		{name: "NetworkSort2xInt32", size: 2, sort: func(a []int32) { NetworkSort2xInt32(a) }},
+		{name: "NetworkSort3xInt32", size: 3, sort: func(a []int32) { NetworkSort3xInt32(a) }},
+		{name: "NetworkSort4xInt32", size: 4, sort: func(a []int32) { NetworkSort4xInt32(a) }},
+		{name: "NetworkSort5xInt32", size: 5, sort: func(a []int32) { NetworkSort5xInt32(a) }},
+		{name: "NetworkSort6xInt32", size: 6, sort: func(a []int32) { NetworkSort6xInt32(a) }},
+		{name: "NetworkSort7xInt32", size: 7, sort: func(a []int32) { NetworkSort7xInt32(a) }},
+		{name: "NetworkSort8xInt32", size: 8, sort: func(a []int32) { NetworkSort8xInt32(a) }},
+		{name: "NetworkSort9xInt32", size: 9, sort: func(a []int32) { NetworkSort9xInt32(a) }},
+		{name: "NetworkSort10xInt32", size: 10, sort: func(a []int32) { NetworkSort10xInt32(a) }},
+		{name: "NetworkSort11xInt32", size: 11, sort: func(a []int32) { NetworkSort11xInt32(a) }},
+		{name: "NetworkSort12xInt32", size: 12, sort: func(a []int32) { NetworkSort12xInt32(a) }},
+		{name: "NetworkSort13xInt32", size: 13, sort: func(a []int32) { NetworkSort13xInt32(a) }},
+		{name: "NetworkSort14xInt32", size: 14, sort: func(a []int32) { NetworkSort14xInt32(a) }},
+		{name: "NetworkSort15xInt32", size: 15, sort: func(a []int32) { NetworkSort15xInt32(a) }},
+		{name: "NetworkSort16xInt32", size: 16, sort: func(a []int32) { NetworkSort16xInt32(a) }},
+		{name: "NetworkSort24xInt32", size: 24, sort: func(a []int32) { NetworkSort24xInt32(a) }},
+		{name: "NetworkSort32xInt32", size: 32, sort: func(a []int32) { NetworkSort32xInt32(a) }},
+		{name: "NetworkSortInt32-2", size: 2, sort: func(a []int32) {
+			if !NetworkSortInt32(a, len(a)) {
+				panic("NetworkSortInt32: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortInt32-3", size: 3, sort: func(a []int32) {
+			if !NetworkSortInt32(a, len(a)) {
+				panic("NetworkSortInt32: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortInt32-4", size: 4, sort: func(a []int32) {
+			if !NetworkSortInt32(a, len(a)) {
+				panic("NetworkSortInt32: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortInt32-5", size: 5, sort: func(a []int32) {
+			if !NetworkSortInt32(a, len(a)) {
+				panic("NetworkSortInt32: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortInt32-6", size: 6, sort: func(a []int32) {
+			if !NetworkSortInt32(a, len(a)) {
+				panic("NetworkSortInt32: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortInt32-7", size: 7, sort: func(a []int32) {
+			if !NetworkSortInt32(a, len(a)) {
+				panic("NetworkSortInt32: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortInt32-8", size: 8, sort: func(a []int32) {
+			if !NetworkSortInt32(a, len(a)) {
+				panic("NetworkSortInt32: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortInt32-9", size: 9, sort: func(a []int32) {
+			if !NetworkSortInt32(a, len(a)) {
+				panic("NetworkSortInt32: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortInt32-10", size: 10, sort: func(a []int32) {
+			if !NetworkSortInt32(a, len(a)) {
+				panic("NetworkSortInt32: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortInt32-11", size: 11, sort: func(a []int32) {
+			if !NetworkSortInt32(a, len(a)) {
+				panic("NetworkSortInt32: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortInt32-12", size: 12, sort: func(a []int32) {
+			if !NetworkSortInt32(a, len(a)) {
+				panic("NetworkSortInt32: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortInt32-13", size: 13, sort: func(a []int32) {
+			if !NetworkSortInt32(a, len(a)) {
+				panic("NetworkSortInt32: no sorter for size 13")
+			}
+		}},
+		{name: "NetworkSortInt32-14", size: 14, sort: func(a []int32) {
+			if !NetworkSortInt32(a, len(a)) {
+				panic("NetworkSortInt32: no sorter for size 14")
+			}
+		}},
+		{name: "NetworkSortInt32-15", size: 15, sort: func(a []int32) {
+			if !NetworkSortInt32(a, len(a)) {
+				panic("NetworkSortInt32: no sorter for size 15")
+			}
+		}},
+		{name: "NetworkSortInt32-16", size: 16, sort: func(a []int32) {
+			if !NetworkSortInt32(a, len(a)) {
+				panic("NetworkSortInt32: no sorter for size 16")
+			}
+		}},
+		{name: "NetworkSortInt32-24", size: 24, sort: func(a []int32) {
+			if !NetworkSortInt32(a, len(a)) {
+				panic("NetworkSortInt32: no sorter for size 24")
+			}
+		}},
+		{name: "NetworkSortInt32-32", size: 32, sort: func(a []int32) {
+			if !NetworkSortInt32(a, len(a)) {
+				panic("NetworkSortInt32: no sorter for size 32")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestAlgGenNetworkSortInt32(t *testing.T) {
+	gen, before, cases := testAlgGenCasesNetworkSortInt32()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzAlgGenNetworkSortInt32(f *testing.F) {
+	gen, before, cases := testAlgGenCasesNetworkSortInt32()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]int32, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkAlgGenNetworkSortInt32(b *testing.B) {
+	gen, before, cases := testAlgGenCasesNetworkSortInt32()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testAlgGenCasesNetworkSortInt32Reverse returns the functions generated for int32
+// in decreasing order, along with how to make random items and how they are ordered.
+func testAlgGenCasesNetworkSortInt32Reverse() (gen func(*rand.Rand) int32, before func(a, b int32) bool, cases []testAlgGenCase[int32]) {
+	gen = func(rng *rand.Rand) int32 { return int32(rng.Intn(32) - 16) }
+	less := func(a, b int32) bool { return a < b }
+	before = func(a, b int32) bool { return less(b, a) }
+	cases = []testAlgGenCase[int32]{
+		{name: "NetworkSort2xInt32Reverse", size: 2, sort: func(a []int32) { NetworkSort2xInt32Reverse(a) }},
+		{name: "NetworkSort3xInt32Reverse", size: 3, sort: func(a []int32) { NetworkSort3xInt32Reverse(a) }},
+		{name: "NetworkSort4xInt32Reverse", size: 4, sort: func(a []int32) { NetworkSort4xInt32Reverse(a) }},
+		{name: "NetworkSort5xInt32Reverse", size: 5, sort: func(a []int32) { NetworkSort5xInt32Reverse(a) }},
+		{name: "NetworkSort6xInt32Reverse", size: 6, sort: func(a []int32) { NetworkSort6xInt32Reverse(a) }},
+		{name: "NetworkSort7xInt32Reverse", size: 7, sort: func(a []int32) { NetworkSort7xInt32Reverse(a) }},
+		{name: "NetworkSort8xInt32Reverse", size: 8, sort: func(a []int32) { NetworkSort8xInt32Reverse(a) }},
+		{name: "NetworkSort9xInt32Reverse", size: 9, sort: func(a []int32) { NetworkSort9xInt32Reverse(a) }},
+		{name: "NetworkSort10xInt32Reverse", size: 10, sort: func(a []int32) { NetworkSort10xInt32Reverse(a) }},
+		{name: "NetworkSort11xInt32Reverse", size: 11, sort: func(a []int32) { NetworkSort11xInt32Reverse(a) }},
+		{name: "NetworkSort12xInt32Reverse", size: 12, sort: func(a []int32) { NetworkSort12xInt32Reverse(a) }},
+		{name: "NetworkSort13xInt32Reverse", size: 13, sort: func(a []int32) { NetworkSort13xInt32Reverse(a) }},
+		{name: "NetworkSort14xInt32Reverse", size: 14, sort: func(a []int32) { NetworkSort14xInt32Reverse(a) }},
+		{name: "NetworkSort15xInt32Reverse", size: 15, sort: func(a []int32) { NetworkSort15xInt32Reverse(a) }},
+		{name: "NetworkSort16xInt32Reverse", size: 16, sort: func(a []int32) { NetworkSort16xInt32Reverse(a) }},
+		{name: "NetworkSort24xInt32Reverse", size: 24, sort: func(a []int32) { NetworkSort24xInt32Reverse(a) }},
+		{name: "NetworkSort32xInt32Reverse", size: 32, sort: func(a []int32) { NetworkSort32xInt32Reverse(a) }},
+		{name: "NetworkSortInt32Reverse-2", size: 2, sort: func(a []int32) {
+			if !NetworkSortInt32Reverse(a, len(a)) {
+				panic("NetworkSortInt32Reverse: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortInt32Reverse-3", size: 3, sort: func(a []int32) {
+			if !NetworkSortInt32Reverse(a, len(a)) {
+				panic("NetworkSortInt32Reverse: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortInt32Reverse-4", size: 4, sort: func(a []int32) {
+			if !NetworkSortInt32Reverse(a, len(a)) {
+				panic("NetworkSortInt32Reverse: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortInt32Reverse-5", size: 5, sort: func(a []int32) {
+			if !NetworkSortInt32Reverse(a, len(a)) {
+				panic("NetworkSortInt32Reverse: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortInt32Reverse-6", size: 6, sort: func(a []int32) {
+			if !NetworkSortInt32Reverse(a, len(a)) {
+				panic("NetworkSortInt32Reverse: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortInt32Reverse-7", size: 7, sort: func(a []int32) {
+			if !NetworkSortInt32Reverse(a, len(a)) {
+				panic("NetworkSortInt32Reverse: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortInt32Reverse-8", size: 8, sort: func(a []int32) {
+			if !NetworkSortInt32Reverse(a, len(a)) {
+				panic("NetworkSortInt32Reverse: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortInt32Reverse-9", size: 9, sort: func(a []int32) {
+			if !NetworkSortInt32Reverse(a, len(a)) {
+				panic("NetworkSortInt32Reverse: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortInt32Reverse-10", size: 10, sort: func(a []int32) {
+			if !NetworkSortInt32Reverse(a, len(a)) {
+				panic("NetworkSortInt32Reverse: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortInt32Reverse-11", size: 11, sort: func(a []int32) {
+			if !NetworkSortInt32Reverse(a, len(a)) {
+				panic("NetworkSortInt32Reverse: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortInt32Reverse-12", size: 12, sort: func(a []int32) {
+			if !NetworkSortInt32Reverse(a, len(a)) {
+				panic("NetworkSortInt32Reverse: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortInt32Reverse-13", size: 13, sort: func(a []int32) {
+			if !NetworkSortInt32Reverse(a, len(a)) {
+				panic("NetworkSortInt32Reverse: no sorter for size 13")
+			}
+		}},
+		{name: "NetworkSortInt32Reverse-14", size: 14, sort: func(a []int32) {
+			if !NetworkSortInt32Reverse(a, len(a)) {
+				panic("NetworkSortInt32Reverse: no sorter for size 14")
+			}
+		}},
+		{name: "NetworkSortInt32Reverse-15", size: 15, sort: func(a []int32) {
+			if !NetworkSortInt32Reverse(a, len(a)) {
+				panic("NetworkSortInt32Reverse: no sorter for size 15")
+			}
+		}},
+		{name: "NetworkSortInt32Reverse-16", size: 16, sort: func(a []int32) {
+			if !NetworkSortInt32Reverse(a, len(a)) {
+				panic("NetworkSortInt32Reverse: no sorter for size 16")
+			}
+		}},
+		{name: "NetworkSortInt32Reverse-24", size: 24, sort: func(a []int32) {
+			if !NetworkSortInt32Reverse(a, len(a)) {
+				panic("NetworkSortInt32Reverse: no sorter for size 24")
+			}
+		}},
+		{name: "NetworkSortInt32Reverse-32", size: 32, sort: func(a []int32) {
+			if !NetworkSortInt32Reverse(a, len(a)) {
+				panic("NetworkSortInt32Reverse: no sorter for size 32")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestAlgGenNetworkSortInt32Reverse(t *testing.T) {
+	gen, before, cases := testAlgGenCasesNetworkSortInt32Reverse()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzAlgGenNetworkSortInt32Reverse(f *testing.F) {
+	gen, before, cases := testAlgGenCasesNetworkSortInt32Reverse()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]int32, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkAlgGenNetworkSortInt32Reverse(b *testing.B) {
+	gen, before, cases := testAlgGenCasesNetworkSortInt32Reverse()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testAlgGenCasesNetworkSortInt16 returns the functions generated for int16
+// in increasing order, along with how to make random items and how they are ordered.
+func testAlgGenCasesNetworkSortInt16() (gen func(*rand.Rand) int16, before func(a, b int16) bool, cases []testAlgGenCase[int16]) {
+	gen = func(rng *rand.Rand) int16 { return int16(rng.Intn(32) - 16) }
+	less := func(a, b int16) bool { return a < b }
+	before = less
+	cases = []testAlgGenCase[int16]{
+		{name: "NetworkSort2xInt16", size: 2, sort: func(a []int16) { NetworkSort2xInt16(a) }},
+		{name: "NetworkSort3xInt16", size: 3, sort: func(a []int16) { NetworkSort3xInt16(a) }},
+		{name: "NetworkSort4xInt16", size: 4, sort: func(a []int16) { NetworkSort4xInt16(a) }},
+		{name: "NetworkSort5xInt16", size: 5, sort: func(a []int16) { NetworkSort5xInt16(a) }},
+		{name: "NetworkSort6xInt16", size: 6, sort: func(a []int16) { NetworkSort6xInt16(a) }},
+		{name: "NetworkSort7xInt16", size: 7, sort: func(a []int16) { NetworkSort7xInt16(a) }},
+		{name: "NetworkSort8xInt16", size: 8, sort: func(a []int16) { NetworkSort8xInt16(a) }},
+		{name: "NetworkSort9xInt16", size: 9, sort: func(a []int16) { NetworkSort9xInt16(a) }},
+		{name: "NetworkSort10xInt16", size: 10, sort: func(a []int16) { NetworkSort10xInt16(a) }},
+		{name: "NetworkSort11xInt16", size: 11, sort: func(a []int16) { NetworkSort11xInt16(a) }},
+		{name: "NetworkSort12xInt16", size: 12, sort: func(a []int16) { NetworkSort12xInt16(a) }},
+		{name: "NetworkSort13xInt16", size: 13, sort: func(a []int16) { NetworkSort13xInt16(a) }},
+		{name: "NetworkSort14xInt16", size: 14, sort: func(a []int16) { NetworkSort14xInt16(a) }},
+		{name: "NetworkSort15xInt16", size: 15, sort: func(a []int16) { NetworkSort15xInt16(a) }},
+		{name: "NetworkSort16xInt16", size: 16, sort: func(a []int16) { NetworkSort16xInt16(a) }},
+		{name: "NetworkSort24xInt16", size: 24, sort: func(a []int16) { NetworkSort24xInt16(a) }},
+		{name: "NetworkSort32xInt16", size: 32, sort: func(a []int16) { NetworkSort32xInt16(a) }},
+		{name: "NetworkSortInt16-2", size: 2, sort: func(a []int16) {
+			if !NetworkSortInt16(a, len(a)) {
+				panic("NetworkSortInt16: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortInt16-3", size: 3, sort: func(a []int16) {
+			if !NetworkSortInt16(a, len(a)) {
+				panic("NetworkSortInt16: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortInt16-4", size: 4, sort: func(a []int16) {
+			if !NetworkSortInt16(a, len(a)) {
+				panic("NetworkSortInt16: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortInt16-5", size: 5, sort: func(a []int16) {
+			if !NetworkSortInt16(a, len(a)) {
+				panic("NetworkSortInt16: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortInt16-6", size: 6, sort: func(a []int16) {
+			if !NetworkSortInt16(a, len(a)) {
+				panic("NetworkSortInt16: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortInt16-7", size: 7, sort: func(a []int16) {
+			if !NetworkSortInt16(a, len(a)) {
+				panic("NetworkSortInt16: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortInt16-8", size: 8, sort: func(a []int16) {
+			if !NetworkSortInt16(a, len(a)) {
+				panic("NetworkSortInt16: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortInt16-9", size: 9, sort: func(a []int16) {
+			if !NetworkSortInt16(a, len(a)) {
+				panic("NetworkSortInt16: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortInt16-10", size: 10, sort: func(a []int16) {
+			if !NetworkSortInt16(a, len(a)) {
+				panic("NetworkSortInt16: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortInt16-11", size: 11, sort: func(a []int16) {
+			if !NetworkSortInt16(a, len(a)) {
+				panic("NetworkSortInt16: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortInt16-12", size: 12, sort: func(a []int16) {
+			if !NetworkSortInt16(a, len(a)) {
+				panic("NetworkSortInt16: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortInt16-13", size: 13, sort: func(a []int16) {
+			if !NetworkSortInt16(a, len(a)) {
+				panic("NetworkSortInt16: no sorter for size 13")
+			}
+		}},
+		{name: "NetworkSortInt16-14", size: 14, sort: func(a []int16) {
+			if !NetworkSortInt16(a, len(a)) {
+				panic("NetworkSortInt16: no sorter for size 14")
+			}
+		}},
+		{name: "NetworkSortInt16-15", size: 15, sort: func(a []int16) {
+			if !NetworkSortInt16(a, len(a)) {
+				panic("NetworkSortInt16: no sorter for size 15")
+			}
+		}},
+		{name: "NetworkSortInt16-16", size: 16, sort: func(a []int16) {
+			if !NetworkSortInt16(a, len(a)) {
+				panic("NetworkSortInt16: no sorter for size 16")
+			}
+		}},
+		{name: "NetworkSortInt16-24", size: 24, sort: func(a []int16) {
+			if !NetworkSortInt16(a, len(a)) {
+				panic("NetworkSortInt16: no sorter for size 24")
+			}
+		}},
+		{name: "NetworkSortInt16-32", size: 32, sort: func(a []int16) {
+			if !NetworkSortInt16(a, len(a)) {
+				panic("NetworkSortInt16: no sorter for size 32")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestAlgGenNetworkSortInt16(t *testing.T) {
+	gen, before, cases := testAlgGenCasesNetworkSortInt16()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzAlgGenNetworkSortInt16(f *testing.F) {
+	gen, before, cases := testAlgGenCasesNetworkSortInt16()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]int16, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkAlgGenNetworkSortInt16(b *testing.B) {
+	gen, before, cases := testAlgGenCasesNetworkSortInt16()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testAlgGenCasesNetworkSortInt16Reverse returns the functions generated for int16
+// in decreasing order, along with how to make random items and how they are ordered.
+func testAlgGenCasesNetworkSortInt16Reverse() (gen func(*rand.Rand) int16, before func(a, b int16) bool, cases []testAlgGenCase[int16]) {
+	gen = func(rng *rand.Rand) int16 { return int16(rng.Intn(32) - 16) }
+	less := func(a, b int16) bool { return a < b }
+	before = func(a, b int16) bool { return less(b, a) }
+	cases = []testAlgGenCase[int16]{
+		{name: "NetworkSort2xInt16Reverse", size: 2, sort: func(a []int16) { NetworkSort2xInt16Reverse(a) }},
+		{name: "NetworkSort3xInt16Reverse", size: 3, sort: func(a []int16) { NetworkSort3xInt16Reverse(a) }},
+		{name: "NetworkSort4xInt16Reverse", size: 4, sort: func(a []int16) { NetworkSort4xInt16Reverse(a) }},
+		{name: "NetworkSort5xInt16Reverse", size: 5, sort: func(a []int16) { NetworkSort5xInt16Reverse(a) }},
+		{name: "NetworkSort6xInt16Reverse", size: 6, sort: func(a []int16) { NetworkSort6xInt16Reverse(a) }},
+		{name: "NetworkSort7xInt16Reverse", size: 7, sort: func(a []int16) { NetworkSort7xInt16Reverse(a) }},
+		{name: "NetworkSort8xInt16Reverse", size: 8, sort: func(a []int16) { NetworkSort8xInt16Reverse(a) }},
+		{name: "NetworkSort9xInt16Reverse", size: 9, sort: func(a []int16) { NetworkSort9xInt16Reverse(a) }},
+		{name: "NetworkSort10xInt16Reverse", size: 10, sort: func(a []int16) { NetworkSort10xInt16Reverse(a) }},
+		{name: "NetworkSort11xInt16Reverse", size: 11, sort: func(a []int16) { NetworkSort11xInt16Reverse(a) }},
+		{name: "NetworkSort12xInt16Reverse", size: 12, sort: func(a []int16) { NetworkSort12xInt16Reverse(a) }},
+		{name: "NetworkSort13xInt16Reverse", size: 13, sort: func(a []int16) { NetworkSort13xInt16Reverse(a) }},
+		{name: "NetworkSort14xInt16Reverse", size: 14, sort: func(a []int16) { NetworkSort14xInt16Reverse(a) }},
+		{name: "NetworkSort15xInt16Reverse", size: 15, sort: func(a []int16) { NetworkSort15xInt16Reverse(a) }},
+		{name: "NetworkSort16xInt16Reverse", size: 16, sort: func(a []int16) { NetworkSort16xInt16Reverse(a) }},
+		{name: "NetworkSort24xInt16Reverse", size: 24, sort: func(a []int16) { NetworkSort24xInt16Reverse(a) }},
+		{name: "NetworkSort32xInt16Reverse", size: 32, sort: func(a []int16) { NetworkSort32xInt16Reverse(a) }},
+		{name: "NetworkSortInt16Reverse-2", size: 2, sort: func(a []int16) {
+			if !NetworkSortInt16Reverse(a, len(a)) {
+				panic("NetworkSortInt16Reverse: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortInt16Reverse-3", size: 3, sort: func(a []int16) {
+			if !NetworkSortInt16Reverse(a, len(a)) {
+				panic("NetworkSortInt16Reverse: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortInt16Reverse-4", size: 4, sort: func(a []int16) {
+			if !NetworkSortInt16Reverse(a, len(a)) {
+				panic("NetworkSortInt16Reverse: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortInt16Reverse-5", size: 5, sort: func(a []int16) {
+			if !NetworkSortInt16Reverse(a, len(a)) {
+				panic("NetworkSortInt16Reverse: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortInt16Reverse-6", size: 6, sort: func(a []int16) {
+			if !NetworkSortInt16Reverse(a, len(a)) {
+				panic("NetworkSortInt16Reverse: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortInt16Reverse-7", size: 7, sort: func(a []int16) {
+			if !NetworkSortInt16Reverse(a, len(a)) {
+				panic("NetworkSortInt16Reverse: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortInt16Reverse-8", size: 8, sort: func(a []int16) {
+			if !NetworkSortInt16Reverse(a, len(a)) {
+				panic("NetworkSortInt16Reverse: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortInt16Reverse-9", size: 9, sort: func(a []int16) {
+			if !NetworkSortInt16Reverse(a, len(a)) {
+				panic("NetworkSortInt16Reverse: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortInt16Reverse-10", size: 10, sort: func(a []int16) {
+			if !NetworkSortInt16Reverse(a, len(a)) {
+				panic("NetworkSortInt16Reverse: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortInt16Reverse-11", size: 11, sort: func(a []int16) {
+			if !NetworkSortInt16Reverse(a, len(a)) {
+				panic("NetworkSortInt16Reverse: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortInt16Reverse-12", size: 12, sort: func(a []int16) {
+			if !NetworkSortInt16Reverse(a, len(a)) {
+				panic("NetworkSortInt16Reverse: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortInt16Reverse-13", size: 13, sort: func(a []int16) {
+			if !NetworkSortInt16Reverse(a, len(a)) {
+				panic("NetworkSortInt16Reverse: no sorter for size 13")
+			}
+		}},
+		{name: "NetworkSortInt16Reverse-14", size: 14, sort: func(a []int16) {
+			if !NetworkSortInt16Reverse(a, len(a)) {
+				panic("NetworkSortInt16Reverse: no sorter for size 14")
+			}
+		}},
+		{name: "NetworkSortInt16Reverse-15", size: 15, sort: func(a []int16) {
+			if !NetworkSortInt16Reverse(a, len(a)) {
+				panic("NetworkSortInt16Reverse: no sorter for size 15")
+			}
+		}},
+		{name: "NetworkSortInt16Reverse-16", size: 16, sort: func(a []int16) {
+			if !NetworkSortInt16Reverse(a, len(a)) {
+				panic("NetworkSortInt16Reverse: no sorter for size 16")
+			}
+		}},
+		{name: "NetworkSortInt16Reverse-24", size: 24, sort: func(a []int16) {
+			if !NetworkSortInt16Reverse(a, len(a)) {
+				panic("NetworkSortInt16Reverse: no sorter for size 24")
+			}
+		}},
+		{name: "NetworkSortInt16Reverse-32", size: 32, sort: func(a []int16) {
+			if !NetworkSortInt16Reverse(a, len(a)) {
+				panic("NetworkSortInt16Reverse: no sorter for size 32")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestAlgGenNetworkSortInt16Reverse(t *testing.T) {
+	gen, before, cases := testAlgGenCasesNetworkSortInt16Reverse()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzAlgGenNetworkSortInt16Reverse(f *testing.F) {
+	gen, before, cases := testAlgGenCasesNetworkSortInt16Reverse()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]int16, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkAlgGenNetworkSortInt16Reverse(b *testing.B) {
+	gen, before, cases := testAlgGenCasesNetworkSortInt16Reverse()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testAlgGenCasesNetworkSortUint16 returns the functions generated for uint16
+// in increasing order, along with how to make random items and how they are ordered.
+func testAlgGenCasesNetworkSortUint16() (gen func(*rand.Rand) uint16, before func(a, b uint16) bool, cases []testAlgGenCase[uint16]) {
+	gen = func(rng *rand.Rand) uint16 { return uint16(rng.Intn(32)) }
+	less := func(a, b uint16) bool { return a < b }
+	before = less
+	cases = []testAlgGenCase[uint16]{
+		{name: "NetworkSort16xUint16", size: 16, sort: func(a []uint16) { NetworkSort16xUint16(a) }},
+		{name: "NetworkSortUint16-16", size: 16, sort: func(a []uint16) {
+			if !NetworkSortUint16(a, len(a)) {
+				panic("NetworkSortUint16: no sorter for size 16")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestAlgGenNetworkSortUint16(t *testing.T) {
+	gen, before, cases := testAlgGenCasesNetworkSortUint16()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzAlgGenNetworkSortUint16(f *testing.F) {
+	gen, before, cases := testAlgGenCasesNetworkSortUint16()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]uint16, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkAlgGenNetworkSortUint16(b *testing.B) {
+	gen, before, cases := testAlgGenCasesNetworkSortUint16()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testAlgGenCasesNetworkSortUint16Reverse returns the functions generated for uint16
+// in decreasing order, along with how to make random items and how they are ordered.
+func testAlgGenCasesNetworkSortUint16Reverse() (gen func(*rand.Rand) uint16, before func(a, b uint16) bool, cases []testAlgGenCase[uint16]) {
+	gen = func(rng *rand.Rand) uint16 { return uint16(rng.Intn(32)) }
+	less := func(a, b uint16) bool { return a < b }
+	before = func(a, b uint16) bool { return less(b, a) }
+	cases = []testAlgGenCase[uint16]{
+		{name: "NetworkSort16xUint16Reverse", size: 16, sort: func(a []uint16) { NetworkSort16xUint16Reverse(a) }},
+		{name: "NetworkSortUint16Reverse-16", size: 16, sort: func(a []uint16) {
+			if !NetworkSortUint16Reverse(a, len(a)) {
+				panic("NetworkSortUint16Reverse: no sorter for size 16")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestAlgGenNetworkSortUint16Reverse(t *testing.T) {
+	gen, before, cases := testAlgGenCasesNetworkSortUint16Reverse()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzAlgGenNetworkSortUint16Reverse(f *testing.F) {
+	gen, before, cases := testAlgGenCasesNetworkSortUint16Reverse()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]uint16, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkAlgGenNetworkSortUint16Reverse(b *testing.B) {
+	gen, before, cases := testAlgGenCasesNetworkSortUint16Reverse()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
@@ -0,0 +1,8333 @@
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
+
+package branchless
+
+// Sorting networks used in this file:
+//
+//   - Bose-Nelson, 2 inputs: 1 comparator (proven optimal), depth 1 (proven optimal)
+//     Fingerprint 9463926d4640a53a
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 3 inputs: 3 comparators (proven optimal), depth 3 (proven optimal)
+//     Fingerprint f995edafd93d50ea
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 4 inputs: 5 comparators (proven optimal), depth 3 (proven optimal)
+//     Fingerprint 0119fc1a905673cd
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 5 inputs: 9 comparators (proven optimal), depth 6 (not optimal)
+//     Fingerprint d54da66c984b8b07
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 6 inputs: 13 comparators (not optimal), depth 7 (not optimal)
+//     Fingerprint 1cc32b1cf5a405a2
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 7 inputs: 16 comparators (proven optimal), depth 7 (not optimal)
+//     Fingerprint 4c56e9c432030c54
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 8 inputs: 19 comparators (proven optimal), depth 7 (not optimal)
+//     Fingerprint 72b14580972c6d18
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Senso9, 9 inputs: 25 comparators (proven optimal), depth 8 (not optimal)
+//     Fingerprint 5463bfb95d0dca98
+//     V. K. Valsalam and R. Miikkulainen (2013)
+//     V. K. Valsalam and R. Miikkulainen, Using Symmetry and Evolutionary Search to Minimize Sorting Networks, Journal of Machine Learning Research 14, 2013
+//   - Senso10, 10 inputs: 29 comparators (proven optimal), depth 8 (not optimal)
+//     Fingerprint 9688ccf2613970b6
+//     V. K. Valsalam and R. Miikkulainen (2013)
+//     V. K. Valsalam and R. Miikkulainen, Using Symmetry and Evolutionary Search to Minimize Sorting Networks, Journal of Machine Learning Research 14, 2013
+//   - ShapiroGreen11, 11 inputs: 35 comparators (proven optimal), depth 9 (not optimal)
+//     Fingerprint a0cd3d71b97545e1
+//     G. Shapiro and M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - ShapiroGreen12, 12 inputs: 39 comparators (proven optimal), depth 9 (not optimal)
+//     Fingerprint 4520ec02bac38318
+//     G. Shapiro and M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - End13, 13 inputs: 45 comparators (best known), depth 10 (not optimal)
+//     Fingerprint 8fb515590c6989d6
+//     H. Juillé (1995)
+//     H. Juillé, Evolution of Non-Deterministic Incremental Algorithms as a New Approach for Search in State Spaces, Proceedings of the 6th International Conference on Genetic Algorithms, 1995
+//   - Green14, 14 inputs: 51 comparators (best known), depth 10 (not optimal)
+//     Fingerprint b78dfa7f24d12c0c
+//     M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - Green15, 15 inputs: 56 comparators (best known), depth 10 (not optimal)
+//     Fingerprint 360c873bdb44ac3e
+//     M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - Green16, 16 inputs: 60 comparators (best known), depth 10 (not optimal)
+//     Fingerprint 196d3d09b3f4a0e7
+//     M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - Morwenn24, 24 inputs: 123 comparators (not optimal), depth 15 (not optimal)
+//     Fingerprint a82fea0eb88f2605
+//     Morwenn
+//     https://github.com/Morwenn/cpp-sort
+//   - Bose-Nelson, 32 inputs: 211 comparators (unknown), depth 31 (unknown)
+//     Fingerprint 947ea8bcb4cc1a7d
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 48 inputs: 503 comparators (unknown), depth 52 (unknown)
+//     Fingerprint b743d052c3bd5b72
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 64 inputs: 665 comparators (unknown), depth 63 (unknown)
+//     Fingerprint ae52e02f830de288
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+
+// NetworkSortFloat64 sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSortFloat64(a []float64, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xFloat64(a)
+	case 3:
+		NetworkSort3xFloat64(a)
+	case 4:
+		NetworkSort4xFloat64(a)
+	case 5:
+		NetworkSort5xFloat64(a)
+	case 6:
+		NetworkSort6xFloat64(a)
+	case 7:
+		NetworkSort7xFloat64(a)
+	case 8:
+		NetworkSort8xFloat64(a)
+	case 9:
+		NetworkSort9xFloat64(a)
+	case 10:
+		NetworkSort10xFloat64(a)
+	case 11:
+		NetworkSort11xFloat64(a)
+	case 12:
+		NetworkSort12xFloat64(a)
+	case 13:
+		NetworkSort13xFloat64(a)
+	case 14:
+		NetworkSort14xFloat64(a)
+	case 15:
+		NetworkSort15xFloat64(a)
+	case 16:
+		NetworkSort16xFloat64(a)
+	case 24:
+		NetworkSort24xFloat64(a)
+	case 32:
+		NetworkSort32xFloat64(a)
+	case 48:
+		NetworkSort48xFloat64(a)
+	case 64:
+		NetworkSort64xFloat64(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortFloat64Reverse sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSortFloat64Reverse(a []float64, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xFloat64Reverse(a)
+	case 3:
+		NetworkSort3xFloat64Reverse(a)
+	case 4:
+		NetworkSort4xFloat64Reverse(a)
+	case 5:
+		NetworkSort5xFloat64Reverse(a)
+	case 6:
+		NetworkSort6xFloat64Reverse(a)
+	case 7:
+		NetworkSort7xFloat64Reverse(a)
+	case 8:
+		NetworkSort8xFloat64Reverse(a)
+	case 9:
+		NetworkSort9xFloat64Reverse(a)
+	case 10:
+		NetworkSort10xFloat64Reverse(a)
+	case 11:
+		NetworkSort11xFloat64Reverse(a)
+	case 12:
+		NetworkSort12xFloat64Reverse(a)
+	case 13:
+		NetworkSort13xFloat64Reverse(a)
+	case 14:
+		NetworkSort14xFloat64Reverse(a)
+	case 15:
+		NetworkSort15xFloat64Reverse(a)
+	case 16:
+		NetworkSort16xFloat64Reverse(a)
+	case 24:
+		NetworkSort24xFloat64Reverse(a)
+	case 32:
+		NetworkSort32xFloat64Reverse(a)
+	case 48:
+		NetworkSort48xFloat64Reverse(a)
+	case 64:
+		NetworkSort64xFloat64Reverse(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortInt sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortInt(a []int, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xInt(a)
+	case 3:
+		NetworkSort3xInt(a)
+	case 4:
+		NetworkSort4xInt(a)
+	case 5:
+		NetworkSort5xInt(a)
+	case 6:
+		NetworkSort6xInt(a)
+	case 7:
+		NetworkSort7xInt(a)
+	case 8:
+		NetworkSort8xInt(a)
+	case 9:
+		NetworkSort9xInt(a)
+	case 10:
+		NetworkSort10xInt(a)
+	case 11:
+		NetworkSort11xInt(a)
+	case 12:
+		NetworkSort12xInt(a)
+	case 13:
+		NetworkSort13xInt(a)
+	case 14:
+		NetworkSort14xInt(a)
+	case 15:
+		NetworkSort15xInt(a)
+	case 16:
+		NetworkSort16xInt(a)
+	case 24:
+		NetworkSort24xInt(a)
+	case 32:
+		NetworkSort32xInt(a)
+	case 48:
+		NetworkSort48xInt(a)
+	case 64:
+		NetworkSort64xInt(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortIntReverse sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortIntReverse(a []int, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xIntReverse(a)
+	case 3:
+		NetworkSort3xIntReverse(a)
+	case 4:
+		NetworkSort4xIntReverse(a)
+	case 5:
+		NetworkSort5xIntReverse(a)
+	case 6:
+		NetworkSort6xIntReverse(a)
+	case 7:
+		NetworkSort7xIntReverse(a)
+	case 8:
+		NetworkSort8xIntReverse(a)
+	case 9:
+		NetworkSort9xIntReverse(a)
+	case 10:
+		NetworkSort10xIntReverse(a)
+	case 11:
+		NetworkSort11xIntReverse(a)
+	case 12:
+		NetworkSort12xIntReverse(a)
+	case 13:
+		NetworkSort13xIntReverse(a)
+	case 14:
+		NetworkSort14xIntReverse(a)
+	case 15:
+		NetworkSort15xIntReverse(a)
+	case 16:
+		NetworkSort16xIntReverse(a)
+	case 24:
+		NetworkSort24xIntReverse(a)
+	case 32:
+		NetworkSort32xIntReverse(a)
+	case 48:
+		NetworkSort48xIntReverse(a)
+	case 64:
+		NetworkSort64xIntReverse(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSort2xFloat64 sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort2xFloat64(a []float64) {
+	_ = a[1]
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+}
+
+// NetworkSort2xFloat64Reverse sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort2xFloat64Reverse(a []float64) {
+	_ = a[1]
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+}
+
+// NetworkSort3xFloat64 sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort3xFloat64(a []float64) {
+	_ = a[2]
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+}
+
+// NetworkSort3xFloat64Reverse sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort3xFloat64Reverse(a []float64) {
+	_ = a[2]
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+}
+
+// NetworkSort4xFloat64 sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort4xFloat64(a []float64) {
+	_ = a[3]
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+}
+
+// NetworkSort4xFloat64Reverse sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort4xFloat64Reverse(a []float64) {
+	_ = a[3]
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+}
+
+// NetworkSort5xFloat64 sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort5xFloat64(a []float64) {
+	_ = a[4]
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[2], a[4] = min(a[2], a[4]), max(a[2], a[4])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[0], a[3] = min(a[0], a[3]), max(a[0], a[3])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[1], a[4] = min(a[1], a[4]), max(a[1], a[4])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+}
+
+// NetworkSort5xFloat64Reverse sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort5xFloat64Reverse(a []float64) {
+	_ = a[4]
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[2], a[4] = max(a[2], a[4]), min(a[2], a[4])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[0], a[3] = max(a[0], a[3]), min(a[0], a[3])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[1], a[4] = max(a[1], a[4]), min(a[1], a[4])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+}
+
+// NetworkSort6xFloat64 sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort6xFloat64(a []float64) {
+	_ = a[5]
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[0], a[3] = min(a[0], a[3]), max(a[0], a[3])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[2], a[5] = min(a[2], a[5]), max(a[2], a[5])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[2], a[4] = min(a[2], a[4]), max(a[2], a[4])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+}
+
+// NetworkSort6xFloat64Reverse sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort6xFloat64Reverse(a []float64) {
+	_ = a[5]
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[0], a[3] = max(a[0], a[3]), min(a[0], a[3])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[2], a[5] = max(a[2], a[5]), min(a[2], a[5])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[2], a[4] = max(a[2], a[4]), min(a[2], a[4])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+}
+
+// NetworkSort7xFloat64 sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort7xFloat64(a []float64) {
+	_ = a[6]
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[0], a[3] = min(a[0], a[3]), max(a[0], a[3])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[2], a[6] = min(a[2], a[6]), max(a[2], a[6])
+	a[2], a[5] = min(a[2], a[5]), max(a[2], a[5])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[2], a[4] = min(a[2], a[4]), max(a[2], a[4])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+}
+
+// NetworkSort7xFloat64Reverse sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort7xFloat64Reverse(a []float64) {
+	_ = a[6]
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[0], a[3] = max(a[0], a[3]), min(a[0], a[3])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[2], a[6] = max(a[2], a[6]), min(a[2], a[6])
+	a[2], a[5] = max(a[2], a[5]), min(a[2], a[5])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[2], a[4] = max(a[2], a[4]), min(a[2], a[4])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+}
+
+// NetworkSort8xFloat64 sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort8xFloat64(a []float64) {
+	_ = a[7]
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[5], a[7] = min(a[5], a[7]), max(a[5], a[7])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[1], a[4] = min(a[1], a[4]), max(a[1], a[4])
+	a[2], a[6] = min(a[2], a[6]), max(a[2], a[6])
+	a[3], a[7] = min(a[3], a[7]), max(a[3], a[7])
+	a[3], a[6] = min(a[3], a[6]), max(a[3], a[6])
+	a[2], a[4] = min(a[2], a[4]), max(a[2], a[4])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+}
+
+// NetworkSort8xFloat64Reverse sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort8xFloat64Reverse(a []float64) {
+	_ = a[7]
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[5], a[7] = max(a[5], a[7]), min(a[5], a[7])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[1], a[4] = max(a[1], a[4]), min(a[1], a[4])
+	a[2], a[6] = max(a[2], a[6]), min(a[2], a[6])
+	a[3], a[7] = max(a[3], a[7]), min(a[3], a[7])
+	a[3], a[6] = max(a[3], a[6]), min(a[3], a[6])
+	a[2], a[4] = max(a[2], a[4]), min(a[2], a[4])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+}
+
+// NetworkSort9xFloat64 sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort9xFloat64(a []float64) {
+	_ = a[8]
+	a[2], a[6] = min(a[2], a[6]), max(a[2], a[6])
+	a[0], a[5] = min(a[0], a[5]), max(a[0], a[5])
+	a[1], a[4] = min(a[1], a[4]), max(a[1], a[4])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+	a[0], a[7] = min(a[0], a[7]), max(a[0], a[7])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[5], a[8] = min(a[5], a[8]), max(a[5], a[8])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[6], a[8] = min(a[6], a[8]), max(a[6], a[8])
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[2], a[7] = min(a[2], a[7]), max(a[2], a[7])
+	a[3], a[7] = min(a[3], a[7]), max(a[3], a[7])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[2], a[4] = min(a[2], a[4]), max(a[2], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+}
+
+// NetworkSort9xFloat64Reverse sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort9xFloat64Reverse(a []float64) {
+	_ = a[8]
+	a[2], a[6] = max(a[2], a[6]), min(a[2], a[6])
+	a[0], a[5] = max(a[0], a[5]), min(a[0], a[5])
+	a[1], a[4] = max(a[1], a[4]), min(a[1], a[4])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+	a[0], a[7] = max(a[0], a[7]), min(a[0], a[7])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[5], a[8] = max(a[5], a[8]), min(a[5], a[8])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[6], a[8] = max(a[6], a[8]), min(a[6], a[8])
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[2], a[7] = max(a[2], a[7]), min(a[2], a[7])
+	a[3], a[7] = max(a[3], a[7]), min(a[3], a[7])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[2], a[4] = max(a[2], a[4]), min(a[2], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+}
+
+// NetworkSort10xFloat64 sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort10xFloat64(a []float64) {
+	_ = a[9]
+	a[1], a[4] = min(a[1], a[4]), max(a[1], a[4])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[0], a[9] = min(a[0], a[9]), max(a[0], a[9])
+	a[2], a[5] = min(a[2], a[5]), max(a[2], a[5])
+	a[0], a[7] = min(a[0], a[7]), max(a[0], a[7])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[3], a[6] = min(a[3], a[6]), max(a[3], a[6])
+	a[4], a[9] = min(a[4], a[9]), max(a[4], a[9])
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[6], a[9] = min(a[6], a[9]), max(a[6], a[9])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[4], a[7] = min(a[4], a[7]), max(a[4], a[7])
+	a[1], a[8] = min(a[1], a[8]), max(a[1], a[8])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[5], a[8] = min(a[5], a[8]), max(a[5], a[8])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[2], a[5] = min(a[2], a[5]), max(a[2], a[5])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+}
+
+// NetworkSort10xFloat64Reverse sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort10xFloat64Reverse(a []float64) {
+	_ = a[9]
+	a[1], a[4] = max(a[1], a[4]), min(a[1], a[4])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[0], a[9] = max(a[0], a[9]), min(a[0], a[9])
+	a[2], a[5] = max(a[2], a[5]), min(a[2], a[5])
+	a[0], a[7] = max(a[0], a[7]), min(a[0], a[7])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[3], a[6] = max(a[3], a[6]), min(a[3], a[6])
+	a[4], a[9] = max(a[4], a[9]), min(a[4], a[9])
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[6], a[9] = max(a[6], a[9]), min(a[6], a[9])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[4], a[7] = max(a[4], a[7]), min(a[4], a[7])
+	a[1], a[8] = max(a[1], a[8]), min(a[1], a[8])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[5], a[8] = max(a[5], a[8]), min(a[5], a[8])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[2], a[5] = max(a[2], a[5]), min(a[2], a[5])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+}
+
+// NetworkSort11xFloat64 sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort11xFloat64(a []float64) {
+	_ = a[10]
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[5], a[7] = min(a[5], a[7]), max(a[5], a[7])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[8], a[10] = min(a[8], a[10]), max(a[8], a[10])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[9], a[10] = min(a[9], a[10]), max(a[9], a[10])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[6], a[10] = min(a[6], a[10]), max(a[6], a[10])
+	a[5], a[9] = min(a[5], a[9]), max(a[5], a[9])
+	a[2], a[6] = min(a[2], a[6]), max(a[2], a[6])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[6], a[10] = min(a[6], a[10]), max(a[6], a[10])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[3], a[7] = min(a[3], a[7]), max(a[3], a[7])
+	a[4], a[8] = min(a[4], a[8]), max(a[4], a[8])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[1], a[4] = min(a[1], a[4]), max(a[1], a[4])
+	a[7], a[10] = min(a[7], a[10]), max(a[7], a[10])
+	a[3], a[8] = min(a[3], a[8]), max(a[3], a[8])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[2], a[4] = min(a[2], a[4]), max(a[2], a[4])
+	a[7], a[9] = min(a[7], a[9]), max(a[7], a[9])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[6], a[8] = min(a[6], a[8]), max(a[6], a[8])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+}
+
+// NetworkSort11xFloat64Reverse sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort11xFloat64Reverse(a []float64) {
+	_ = a[10]
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[5], a[7] = max(a[5], a[7]), min(a[5], a[7])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[8], a[10] = max(a[8], a[10]), min(a[8], a[10])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[9], a[10] = max(a[9], a[10]), min(a[9], a[10])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[6], a[10] = max(a[6], a[10]), min(a[6], a[10])
+	a[5], a[9] = max(a[5], a[9]), min(a[5], a[9])
+	a[2], a[6] = max(a[2], a[6]), min(a[2], a[6])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[6], a[10] = max(a[6], a[10]), min(a[6], a[10])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[3], a[7] = max(a[3], a[7]), min(a[3], a[7])
+	a[4], a[8] = max(a[4], a[8]), min(a[4], a[8])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[1], a[4] = max(a[1], a[4]), min(a[1], a[4])
+	a[7], a[10] = max(a[7], a[10]), min(a[7], a[10])
+	a[3], a[8] = max(a[3], a[8]), min(a[3], a[8])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[2], a[4] = max(a[2], a[4]), min(a[2], a[4])
+	a[7], a[9] = max(a[7], a[9]), min(a[7], a[9])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[6], a[8] = max(a[6], a[8]), min(a[6], a[8])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+}
+
+// NetworkSort12xFloat64 sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort12xFloat64(a []float64) {
+	_ = a[11]
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[10], a[11] = min(a[10], a[11]), max(a[10], a[11])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[5], a[7] = min(a[5], a[7]), max(a[5], a[7])
+	a[9], a[11] = min(a[9], a[11]), max(a[9], a[11])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[8], a[10] = min(a[8], a[10]), max(a[8], a[10])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[9], a[10] = min(a[9], a[10]), max(a[9], a[10])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[6], a[10] = min(a[6], a[10]), max(a[6], a[10])
+	a[5], a[9] = min(a[5], a[9]), max(a[5], a[9])
+	a[2], a[6] = min(a[2], a[6]), max(a[2], a[6])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[6], a[10] = min(a[6], a[10]), max(a[6], a[10])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[7], a[11] = min(a[7], a[11]), max(a[7], a[11])
+	a[3], a[7] = min(a[3], a[7]), max(a[3], a[7])
+	a[4], a[8] = min(a[4], a[8]), max(a[4], a[8])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[7], a[11] = min(a[7], a[11]), max(a[7], a[11])
+	a[1], a[4] = min(a[1], a[4]), max(a[1], a[4])
+	a[7], a[10] = min(a[7], a[10]), max(a[7], a[10])
+	a[3], a[8] = min(a[3], a[8]), max(a[3], a[8])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[2], a[4] = min(a[2], a[4]), max(a[2], a[4])
+	a[7], a[9] = min(a[7], a[9]), max(a[7], a[9])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[6], a[8] = min(a[6], a[8]), max(a[6], a[8])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+}
+
+// NetworkSort12xFloat64Reverse sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort12xFloat64Reverse(a []float64) {
+	_ = a[11]
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[10], a[11] = max(a[10], a[11]), min(a[10], a[11])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[5], a[7] = max(a[5], a[7]), min(a[5], a[7])
+	a[9], a[11] = max(a[9], a[11]), min(a[9], a[11])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[8], a[10] = max(a[8], a[10]), min(a[8], a[10])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[9], a[10] = max(a[9], a[10]), min(a[9], a[10])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[6], a[10] = max(a[6], a[10]), min(a[6], a[10])
+	a[5], a[9] = max(a[5], a[9]), min(a[5], a[9])
+	a[2], a[6] = max(a[2], a[6]), min(a[2], a[6])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[6], a[10] = max(a[6], a[10]), min(a[6], a[10])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[7], a[11] = max(a[7], a[11]), min(a[7], a[11])
+	a[3], a[7] = max(a[3], a[7]), min(a[3], a[7])
+	a[4], a[8] = max(a[4], a[8]), min(a[4], a[8])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[7], a[11] = max(a[7], a[11]), min(a[7], a[11])
+	a[1], a[4] = max(a[1], a[4]), min(a[1], a[4])
+	a[7], a[10] = max(a[7], a[10]), min(a[7], a[10])
+	a[3], a[8] = max(a[3], a[8]), min(a[3], a[8])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[2], a[4] = max(a[2], a[4]), min(a[2], a[4])
+	a[7], a[9] = max(a[7], a[9]), min(a[7], a[9])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[6], a[8] = max(a[6], a[8]), min(a[6], a[8])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+}
+
+// NetworkSort13xFloat64 sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort13xFloat64(a []float64) {
+	_ = a[12]
+	a[1], a[7] = min(a[1], a[7]), max(a[1], a[7])
+	a[9], a[11] = min(a[9], a[11]), max(a[9], a[11])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[5], a[8] = min(a[5], a[8]), max(a[5], a[8])
+	a[0], a[12] = min(a[0], a[12]), max(a[0], a[12])
+	a[2], a[6] = min(a[2], a[6]), max(a[2], a[6])
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[8], a[11] = min(a[8], a[11]), max(a[8], a[11])
+	a[7], a[12] = min(a[7], a[12]), max(a[7], a[12])
+	a[5], a[9] = min(a[5], a[9]), max(a[5], a[9])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[3], a[7] = min(a[3], a[7]), max(a[3], a[7])
+	a[10], a[11] = min(a[10], a[11]), max(a[10], a[11])
+	a[1], a[4] = min(a[1], a[4]), max(a[1], a[4])
+	a[6], a[12] = min(a[6], a[12]), max(a[6], a[12])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+	a[11], a[12] = min(a[11], a[12]), max(a[11], a[12])
+	a[4], a[9] = min(a[4], a[9]), max(a[4], a[9])
+	a[6], a[10] = min(a[6], a[10]), max(a[6], a[10])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[10], a[11] = min(a[10], a[11]), max(a[10], a[11])
+	a[1], a[7] = min(a[1], a[7]), max(a[1], a[7])
+	a[2], a[6] = min(a[2], a[6]), max(a[2], a[6])
+	a[9], a[11] = min(a[9], a[11]), max(a[9], a[11])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[4], a[7] = min(a[4], a[7]), max(a[4], a[7])
+	a[8], a[10] = min(a[8], a[10]), max(a[8], a[10])
+	a[0], a[5] = min(a[0], a[5]), max(a[0], a[5])
+	a[2], a[5] = min(a[2], a[5]), max(a[2], a[5])
+	a[6], a[8] = min(a[6], a[8]), max(a[6], a[8])
+	a[9], a[10] = min(a[9], a[10]), max(a[9], a[10])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+}
+
+// NetworkSort13xFloat64Reverse sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort13xFloat64Reverse(a []float64) {
+	_ = a[12]
+	a[1], a[7] = max(a[1], a[7]), min(a[1], a[7])
+	a[9], a[11] = max(a[9], a[11]), min(a[9], a[11])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[5], a[8] = max(a[5], a[8]), min(a[5], a[8])
+	a[0], a[12] = max(a[0], a[12]), min(a[0], a[12])
+	a[2], a[6] = max(a[2], a[6]), min(a[2], a[6])
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[8], a[11] = max(a[8], a[11]), min(a[8], a[11])
+	a[7], a[12] = max(a[7], a[12]), min(a[7], a[12])
+	a[5], a[9] = max(a[5], a[9]), min(a[5], a[9])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[3], a[7] = max(a[3], a[7]), min(a[3], a[7])
+	a[10], a[11] = max(a[10], a[11]), min(a[10], a[11])
+	a[1], a[4] = max(a[1], a[4]), min(a[1], a[4])
+	a[6], a[12] = max(a[6], a[12]), min(a[6], a[12])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+	a[11], a[12] = max(a[11], a[12]), min(a[11], a[12])
+	a[4], a[9] = max(a[4], a[9]), min(a[4], a[9])
+	a[6], a[10] = max(a[6], a[10]), min(a[6], a[10])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[10], a[11] = max(a[10], a[11]), min(a[10], a[11])
+	a[1], a[7] = max(a[1], a[7]), min(a[1], a[7])
+	a[2], a[6] = max(a[2], a[6]), min(a[2], a[6])
+	a[9], a[11] = max(a[9], a[11]), min(a[9], a[11])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[4], a[7] = max(a[4], a[7]), min(a[4], a[7])
+	a[8], a[10] = max(a[8], a[10]), min(a[8], a[10])
+	a[0], a[5] = max(a[0], a[5]), min(a[0], a[5])
+	a[2], a[5] = max(a[2], a[5]), min(a[2], a[5])
+	a[6], a[8] = max(a[6], a[8]), min(a[6], a[8])
+	a[9], a[10] = max(a[9], a[10]), min(a[9], a[10])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+}
+
+// NetworkSort14xFloat64 sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort14xFloat64(a []float64) {
+	_ = a[13]
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[10], a[11] = min(a[10], a[11]), max(a[10], a[11])
+	a[12], a[13] = min(a[12], a[13]), max(a[12], a[13])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[8], a[10] = min(a[8], a[10]), max(a[8], a[10])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[5], a[7] = min(a[5], a[7]), max(a[5], a[7])
+	a[9], a[11] = min(a[9], a[11]), max(a[9], a[11])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[8], a[12] = min(a[8], a[12]), max(a[8], a[12])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[9], a[13] = min(a[9], a[13]), max(a[9], a[13])
+	a[2], a[6] = min(a[2], a[6]), max(a[2], a[6])
+	a[3], a[7] = min(a[3], a[7]), max(a[3], a[7])
+	a[0], a[8] = min(a[0], a[8]), max(a[0], a[8])
+	a[1], a[9] = min(a[1], a[9]), max(a[1], a[9])
+	a[2], a[10] = min(a[2], a[10]), max(a[2], a[10])
+	a[3], a[11] = min(a[3], a[11]), max(a[3], a[11])
+	a[4], a[12] = min(a[4], a[12]), max(a[4], a[12])
+	a[5], a[13] = min(a[5], a[13]), max(a[5], a[13])
+	a[5], a[10] = min(a[5], a[10]), max(a[5], a[10])
+	a[6], a[9] = min(a[6], a[9]), max(a[6], a[9])
+	a[3], a[12] = min(a[3], a[12]), max(a[3], a[12])
+	a[7], a[11] = min(a[7], a[11]), max(a[7], a[11])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[4], a[8] = min(a[4], a[8]), max(a[4], a[8])
+	a[1], a[4] = min(a[1], a[4]), max(a[1], a[4])
+	a[7], a[13] = min(a[7], a[13]), max(a[7], a[13])
+	a[2], a[8] = min(a[2], a[8]), max(a[2], a[8])
+	a[2], a[4] = min(a[2], a[4]), max(a[2], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[9], a[10] = min(a[9], a[10]), max(a[9], a[10])
+	a[11], a[13] = min(a[11], a[13]), max(a[11], a[13])
+	a[3], a[8] = min(a[3], a[8]), max(a[3], a[8])
+	a[7], a[12] = min(a[7], a[12]), max(a[7], a[12])
+	a[6], a[8] = min(a[6], a[8]), max(a[6], a[8])
+	a[10], a[12] = min(a[10], a[12]), max(a[10], a[12])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[7], a[9] = min(a[7], a[9]), max(a[7], a[9])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+	a[9], a[10] = min(a[9], a[10]), max(a[9], a[10])
+	a[11], a[12] = min(a[11], a[12]), max(a[11], a[12])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+}
+
+// NetworkSort14xFloat64Reverse sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort14xFloat64Reverse(a []float64) {
+	_ = a[13]
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[10], a[11] = max(a[10], a[11]), min(a[10], a[11])
+	a[12], a[13] = max(a[12], a[13]), min(a[12], a[13])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[8], a[10] = max(a[8], a[10]), min(a[8], a[10])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[5], a[7] = max(a[5], a[7]), min(a[5], a[7])
+	a[9], a[11] = max(a[9], a[11]), min(a[9], a[11])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[8], a[12] = max(a[8], a[12]), min(a[8], a[12])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[9], a[13] = max(a[9], a[13]), min(a[9], a[13])
+	a[2], a[6] = max(a[2], a[6]), min(a[2], a[6])
+	a[3], a[7] = max(a[3], a[7]), min(a[3], a[7])
+	a[0], a[8] = max(a[0], a[8]), min(a[0], a[8])
+	a[1], a[9] = max(a[1], a[9]), min(a[1], a[9])
+	a[2], a[10] = max(a[2], a[10]), min(a[2], a[10])
+	a[3], a[11] = max(a[3], a[11]), min(a[3], a[11])
+	a[4], a[12] = max(a[4], a[12]), min(a[4], a[12])
+	a[5], a[13] = max(a[5], a[13]), min(a[5], a[13])
+	a[5], a[10] = max(a[5], a[10]), min(a[5], a[10])
+	a[6], a[9] = max(a[6], a[9]), min(a[6], a[9])
+	a[3], a[12] = max(a[3], a[12]), min(a[3], a[12])
+	a[7], a[11] = max(a[7], a[11]), min(a[7], a[11])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[4], a[8] = max(a[4], a[8]), min(a[4], a[8])
+	a[1], a[4] = max(a[1], a[4]), min(a[1], a[4])
+	a[7], a[13] = max(a[7], a[13]), min(a[7], a[13])
+	a[2], a[8] = max(a[2], a[8]), min(a[2], a[8])
+	a[2], a[4] = max(a[2], a[4]), min(a[2], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[9], a[10] = max(a[9], a[10]), min(a[9], a[10])
+	a[11], a[13] = max(a[11], a[13]), min(a[11], a[13])
+	a[3], a[8] = max(a[3], a[8]), min(a[3], a[8])
+	a[7], a[12] = max(a[7], a[12]), min(a[7], a[12])
+	a[6], a[8] = max(a[6], a[8]), min(a[6], a[8])
+	a[10], a[12] = max(a[10], a[12]), min(a[10], a[12])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[7], a[9] = max(a[7], a[9]), min(a[7], a[9])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+	a[9], a[10] = max(a[9], a[10]), min(a[9], a[10])
+	a[11], a[12] = max(a[11], a[12]), min(a[11], a[12])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+}
+
+// NetworkSort15xFloat64 sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort15xFloat64(a []float64) {
+	_ = a[14]
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[10], a[11] = min(a[10], a[11]), max(a[10], a[11])
+	a[12], a[13] = min(a[12], a[13]), max(a[12], a[13])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[8], a[10] = min(a[8], a[10]), max(a[8], a[10])
+	a[12], a[14] = min(a[12], a[14]), max(a[12], a[14])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[5], a[7] = min(a[5], a[7]), max(a[5], a[7])
+	a[9], a[11] = min(a[9], a[11]), max(a[9], a[11])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[8], a[12] = min(a[8], a[12]), max(a[8], a[12])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[9], a[13] = min(a[9], a[13]), max(a[9], a[13])
+	a[2], a[6] = min(a[2], a[6]), max(a[2], a[6])
+	a[10], a[14] = min(a[10], a[14]), max(a[10], a[14])
+	a[3], a[7] = min(a[3], a[7]), max(a[3], a[7])
+	a[0], a[8] = min(a[0], a[8]), max(a[0], a[8])
+	a[1], a[9] = min(a[1], a[9]), max(a[1], a[9])
+	a[2], a[10] = min(a[2], a[10]), max(a[2], a[10])
+	a[3], a[11] = min(a[3], a[11]), max(a[3], a[11])
+	a[4], a[12] = min(a[4], a[12]), max(a[4], a[12])
+	a[5], a[13] = min(a[5], a[13]), max(a[5], a[13])
+	a[6], a[14] = min(a[6], a[14]), max(a[6], a[14])
+	a[5], a[10] = min(a[5], a[10]), max(a[5], a[10])
+	a[6], a[9] = min(a[6], a[9]), max(a[6], a[9])
+	a[3], a[12] = min(a[3], a[12]), max(a[3], a[12])
+	a[13], a[14] = min(a[13], a[14]), max(a[13], a[14])
+	a[7], a[11] = min(a[7], a[11]), max(a[7], a[11])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[4], a[8] = min(a[4], a[8]), max(a[4], a[8])
+	a[1], a[4] = min(a[1], a[4]), max(a[1], a[4])
+	a[7], a[13] = min(a[7], a[13]), max(a[7], a[13])
+	a[2], a[8] = min(a[2], a[8]), max(a[2], a[8])
+	a[11], a[14] = min(a[11], a[14]), max(a[11], a[14])
+	a[2], a[4] = min(a[2], a[4]), max(a[2], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[9], a[10] = min(a[9], a[10]), max(a[9], a[10])
+	a[11], a[13] = min(a[11], a[13]), max(a[11], a[13])
+	a[3], a[8] = min(a[3], a[8]), max(a[3], a[8])
+	a[7], a[12] = min(a[7], a[12]), max(a[7], a[12])
+	a[6], a[8] = min(a[6], a[8]), max(a[6], a[8])
+	a[10], a[12] = min(a[10], a[12]), max(a[10], a[12])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[7], a[9] = min(a[7], a[9]), max(a[7], a[9])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+	a[9], a[10] = min(a[9], a[10]), max(a[9], a[10])
+	a[11], a[12] = min(a[11], a[12]), max(a[11], a[12])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+}
+
+// NetworkSort15xFloat64Reverse sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort15xFloat64Reverse(a []float64) {
+	_ = a[14]
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[10], a[11] = max(a[10], a[11]), min(a[10], a[11])
+	a[12], a[13] = max(a[12], a[13]), min(a[12], a[13])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[8], a[10] = max(a[8], a[10]), min(a[8], a[10])
+	a[12], a[14] = max(a[12], a[14]), min(a[12], a[14])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[5], a[7] = max(a[5], a[7]), min(a[5], a[7])
+	a[9], a[11] = max(a[9], a[11]), min(a[9], a[11])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[8], a[12] = max(a[8], a[12]), min(a[8], a[12])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[9], a[13] = max(a[9], a[13]), min(a[9], a[13])
+	a[2], a[6] = max(a[2], a[6]), min(a[2], a[6])
+	a[10], a[14] = max(a[10], a[14]), min(a[10], a[14])
+	a[3], a[7] = max(a[3], a[7]), min(a[3], a[7])
+	a[0], a[8] = max(a[0], a[8]), min(a[0], a[8])
+	a[1], a[9] = max(a[1], a[9]), min(a[1], a[9])
+	a[2], a[10] = max(a[2], a[10]), min(a[2], a[10])
+	a[3], a[11] = max(a[3], a[11]), min(a[3], a[11])
+	a[4], a[12] = max(a[4], a[12]), min(a[4], a[12])
+	a[5], a[13] = max(a[5], a[13]), min(a[5], a[13])
+	a[6], a[14] = max(a[6], a[14]), min(a[6], a[14])
+	a[5], a[10] = max(a[5], a[10]), min(a[5], a[10])
+	a[6], a[9] = max(a[6], a[9]), min(a[6], a[9])
+	a[3], a[12] = max(a[3], a[12]), min(a[3], a[12])
+	a[13], a[14] = max(a[13], a[14]), min(a[13], a[14])
+	a[7], a[11] = max(a[7], a[11]), min(a[7], a[11])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[4], a[8] = max(a[4], a[8]), min(a[4], a[8])
+	a[1], a[4] = max(a[1], a[4]), min(a[1], a[4])
+	a[7], a[13] = max(a[7], a[13]), min(a[7], a[13])
+	a[2], a[8] = max(a[2], a[8]), min(a[2], a[8])
+	a[11], a[14] = max(a[11], a[14]), min(a[11], a[14])
+	a[2], a[4] = max(a[2], a[4]), min(a[2], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[9], a[10] = max(a[9], a[10]), min(a[9], a[10])
+	a[11], a[13] = max(a[11], a[13]), min(a[11], a[13])
+	a[3], a[8] = max(a[3], a[8]), min(a[3], a[8])
+	a[7], a[12] = max(a[7], a[12]), min(a[7], a[12])
+	a[6], a[8] = max(a[6], a[8]), min(a[6], a[8])
+	a[10], a[12] = max(a[10], a[12]), min(a[10], a[12])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[7], a[9] = max(a[7], a[9]), min(a[7], a[9])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+	a[9], a[10] = max(a[9], a[10]), min(a[9], a[10])
+	a[11], a[12] = max(a[11], a[12]), min(a[11], a[12])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+}
+
+// NetworkSort16xFloat64 sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort16xFloat64(a []float64) {
+	_ = a[15]
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[10], a[11] = min(a[10], a[11]), max(a[10], a[11])
+	a[12], a[13] = min(a[12], a[13]), max(a[12], a[13])
+	a[14], a[15] = min(a[14], a[15]), max(a[14], a[15])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[8], a[10] = min(a[8], a[10]), max(a[8], a[10])
+	a[12], a[14] = min(a[12], a[14]), max(a[12], a[14])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[5], a[7] = min(a[5], a[7]), max(a[5], a[7])
+	a[9], a[11] = min(a[9], a[11]), max(a[9], a[11])
+	a[13], a[15] = min(a[13], a[15]), max(a[13], a[15])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[8], a[12] = min(a[8], a[12]), max(a[8], a[12])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[9], a[13] = min(a[9], a[13]), max(a[9], a[13])
+	a[2], a[6] = min(a[2], a[6]), max(a[2], a[6])
+	a[10], a[14] = min(a[10], a[14]), max(a[10], a[14])
+	a[3], a[7] = min(a[3], a[7]), max(a[3], a[7])
+	a[11], a[15] = min(a[11], a[15]), max(a[11], a[15])
+	a[0], a[8] = min(a[0], a[8]), max(a[0], a[8])
+	a[1], a[9] = min(a[1], a[9]), max(a[1], a[9])
+	a[2], a[10] = min(a[2], a[10]), max(a[2], a[10])
+	a[3], a[11] = min(a[3], a[11]), max(a[3], a[11])
+	a[4], a[12] = min(a[4], a[12]), max(a[4], a[12])
+	a[5], a[13] = min(a[5], a[13]), max(a[5], a[13])
+	a[6], a[14] = min(a[6], a[14]), max(a[6], a[14])
+	a[7], a[15] = min(a[7], a[15]), max(a[7], a[15])
+	a[5], a[10] = min(a[5], a[10]), max(a[5], a[10])
+	a[6], a[9] = min(a[6], a[9]), max(a[6], a[9])
+	a[3], a[12] = min(a[3], a[12]), max(a[3], a[12])
+	a[13], a[14] = min(a[13], a[14]), max(a[13], a[14])
+	a[7], a[11] = min(a[7], a[11]), max(a[7], a[11])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[4], a[8] = min(a[4], a[8]), max(a[4], a[8])
+	a[1], a[4] = min(a[1], a[4]), max(a[1], a[4])
+	a[7], a[13] = min(a[7], a[13]), max(a[7], a[13])
+	a[2], a[8] = min(a[2], a[8]), max(a[2], a[8])
+	a[11], a[14] = min(a[11], a[14]), max(a[11], a[14])
+	a[2], a[4] = min(a[2], a[4]), max(a[2], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[9], a[10] = min(a[9], a[10]), max(a[9], a[10])
+	a[11], a[13] = min(a[11], a[13]), max(a[11], a[13])
+	a[3], a[8] = min(a[3], a[8]), max(a[3], a[8])
+	a[7], a[12] = min(a[7], a[12]), max(a[7], a[12])
+	a[6], a[8] = min(a[6], a[8]), max(a[6], a[8])
+	a[10], a[12] = min(a[10], a[12]), max(a[10], a[12])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[7], a[9] = min(a[7], a[9]), max(a[7], a[9])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+	a[9], a[10] = min(a[9], a[10]), max(a[9], a[10])
+	a[11], a[12] = min(a[11], a[12]), max(a[11], a[12])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+}
+
+// NetworkSort16xFloat64Reverse sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort16xFloat64Reverse(a []float64) {
+	_ = a[15]
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[10], a[11] = max(a[10], a[11]), min(a[10], a[11])
+	a[12], a[13] = max(a[12], a[13]), min(a[12], a[13])
+	a[14], a[15] = max(a[14], a[15]), min(a[14], a[15])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[8], a[10] = max(a[8], a[10]), min(a[8], a[10])
+	a[12], a[14] = max(a[12], a[14]), min(a[12], a[14])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[5], a[7] = max(a[5], a[7]), min(a[5], a[7])
+	a[9], a[11] = max(a[9], a[11]), min(a[9], a[11])
+	a[13], a[15] = max(a[13], a[15]), min(a[13], a[15])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[8], a[12] = max(a[8], a[12]), min(a[8], a[12])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[9], a[13] = max(a[9], a[13]), min(a[9], a[13])
+	a[2], a[6] = max(a[2], a[6]), min(a[2], a[6])
+	a[10], a[14] = max(a[10], a[14]), min(a[10], a[14])
+	a[3], a[7] = max(a[3], a[7]), min(a[3], a[7])
+	a[11], a[15] = max(a[11], a[15]), min(a[11], a[15])
+	a[0], a[8] = max(a[0], a[8]), min(a[0], a[8])
+	a[1], a[9] = max(a[1], a[9]), min(a[1], a[9])
+	a[2], a[10] = max(a[2], a[10]), min(a[2], a[10])
+	a[3], a[11] = max(a[3], a[11]), min(a[3], a[11])
+	a[4], a[12] = max(a[4], a[12]), min(a[4], a[12])
+	a[5], a[13] = max(a[5], a[13]), min(a[5], a[13])
+	a[6], a[14] = max(a[6], a[14]), min(a[6], a[14])
+	a[7], a[15] = max(a[7], a[15]), min(a[7], a[15])
+	a[5], a[10] = max(a[5], a[10]), min(a[5], a[10])
+	a[6], a[9] = max(a[6], a[9]), min(a[6], a[9])
+	a[3], a[12] = max(a[3], a[12]), min(a[3], a[12])
+	a[13], a[14] = max(a[13], a[14]), min(a[13], a[14])
+	a[7], a[11] = max(a[7], a[11]), min(a[7], a[11])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[4], a[8] = max(a[4], a[8]), min(a[4], a[8])
+	a[1], a[4] = max(a[1], a[4]), min(a[1], a[4])
+	a[7], a[13] = max(a[7], a[13]), min(a[7], a[13])
+	a[2], a[8] = max(a[2], a[8]), min(a[2], a[8])
+	a[11], a[14] = max(a[11], a[14]), min(a[11], a[14])
+	a[2], a[4] = max(a[2], a[4]), min(a[2], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[9], a[10] = max(a[9], a[10]), min(a[9], a[10])
+	a[11], a[13] = max(a[11], a[13]), min(a[11], a[13])
+	a[3], a[8] = max(a[3], a[8]), min(a[3], a[8])
+	a[7], a[12] = max(a[7], a[12]), min(a[7], a[12])
+	a[6], a[8] = max(a[6], a[8]), min(a[6], a[8])
+	a[10], a[12] = max(a[10], a[12]), min(a[10], a[12])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[7], a[9] = max(a[7], a[9]), min(a[7], a[9])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+	a[9], a[10] = max(a[9], a[10]), min(a[9], a[10])
+	a[11], a[12] = max(a[11], a[12]), min(a[11], a[12])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+}
+
+// NetworkSort24xFloat64 sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort24xFloat64(a []float64) {
+	_ = a[23]
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[10], a[11] = min(a[10], a[11]), max(a[10], a[11])
+	a[12], a[13] = min(a[12], a[13]), max(a[12], a[13])
+	a[14], a[15] = min(a[14], a[15]), max(a[14], a[15])
+	a[16], a[17] = min(a[16], a[17]), max(a[16], a[17])
+	a[18], a[19] = min(a[18], a[19]), max(a[18], a[19])
+	a[20], a[21] = min(a[20], a[21]), max(a[20], a[21])
+	a[22], a[23] = min(a[22], a[23]), max(a[22], a[23])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[5], a[7] = min(a[5], a[7]), max(a[5], a[7])
+	a[9], a[11] = min(a[9], a[11]), max(a[9], a[11])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[8], a[10] = min(a[8], a[10]), max(a[8], a[10])
+	a[13], a[15] = min(a[13], a[15]), max(a[13], a[15])
+	a[17], a[19] = min(a[17], a[19]), max(a[17], a[19])
+	a[21], a[23] = min(a[21], a[23]), max(a[21], a[23])
+	a[12], a[14] = min(a[12], a[14]), max(a[12], a[14])
+	a[16], a[18] = min(a[16], a[18]), max(a[16], a[18])
+	a[20], a[22] = min(a[20], a[22]), max(a[20], a[22])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[9], a[10] = min(a[9], a[10]), max(a[9], a[10])
+	a[13], a[14] = min(a[13], a[14]), max(a[13], a[14])
+	a[17], a[18] = min(a[17], a[18]), max(a[17], a[18])
+	a[21], a[22] = min(a[21], a[22]), max(a[21], a[22])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[6], a[10] = min(a[6], a[10]), max(a[6], a[10])
+	a[13], a[17] = min(a[13], a[17]), max(a[13], a[17])
+	a[18], a[22] = min(a[18], a[22]), max(a[18], a[22])
+	a[5], a[9] = min(a[5], a[9]), max(a[5], a[9])
+	a[2], a[6] = min(a[2], a[6]), max(a[2], a[6])
+	a[17], a[21] = min(a[17], a[21]), max(a[17], a[21])
+	a[14], a[18] = min(a[14], a[18]), max(a[14], a[18])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[6], a[10] = min(a[6], a[10]), max(a[6], a[10])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[7], a[11] = min(a[7], a[11]), max(a[7], a[11])
+	a[13], a[17] = min(a[13], a[17]), max(a[13], a[17])
+	a[18], a[22] = min(a[18], a[22]), max(a[18], a[22])
+	a[12], a[16] = min(a[12], a[16]), max(a[12], a[16])
+	a[19], a[23] = min(a[19], a[23]), max(a[19], a[23])
+	a[3], a[7] = min(a[3], a[7]), max(a[3], a[7])
+	a[4], a[8] = min(a[4], a[8]), max(a[4], a[8])
+	a[15], a[19] = min(a[15], a[19]), max(a[15], a[19])
+	a[16], a[20] = min(a[16], a[20]), max(a[16], a[20])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[7], a[11] = min(a[7], a[11]), max(a[7], a[11])
+	a[12], a[16] = min(a[12], a[16]), max(a[12], a[16])
+	a[19], a[23] = min(a[19], a[23]), max(a[19], a[23])
+	a[1], a[4] = min(a[1], a[4]), max(a[1], a[4])
+	a[7], a[10] = min(a[7], a[10]), max(a[7], a[10])
+	a[3], a[8] = min(a[3], a[8]), max(a[3], a[8])
+	a[13], a[16] = min(a[13], a[16]), max(a[13], a[16])
+	a[19], a[22] = min(a[19], a[22]), max(a[19], a[22])
+	a[15], a[20] = min(a[15], a[20]), max(a[15], a[20])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[14], a[15] = min(a[14], a[15]), max(a[14], a[15])
+	a[20], a[21] = min(a[20], a[21]), max(a[20], a[21])
+	a[2], a[4] = min(a[2], a[4]), max(a[2], a[4])
+	a[7], a[9] = min(a[7], a[9]), max(a[7], a[9])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[6], a[8] = min(a[6], a[8]), max(a[6], a[8])
+	a[14], a[16] = min(a[14], a[16]), max(a[14], a[16])
+	a[19], a[21] = min(a[19], a[21]), max(a[19], a[21])
+	a[15], a[17] = min(a[15], a[17]), max(a[15], a[17])
+	a[18], a[20] = min(a[18], a[20]), max(a[18], a[20])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+	a[15], a[16] = min(a[15], a[16]), max(a[15], a[16])
+	a[17], a[18] = min(a[17], a[18]), max(a[17], a[18])
+	a[19], a[20] = min(a[19], a[20]), max(a[19], a[20])
+	a[0], a[12] = min(a[0], a[12]), max(a[0], a[12])
+	a[1], a[13] = min(a[1], a[13]), max(a[1], a[13])
+	a[2], a[14] = min(a[2], a[14]), max(a[2], a[14])
+	a[3], a[15] = min(a[3], a[15]), max(a[3], a[15])
+	a[4], a[16] = min(a[4], a[16]), max(a[4], a[16])
+	a[5], a[17] = min(a[5], a[17]), max(a[5], a[17])
+	a[6], a[18] = min(a[6], a[18]), max(a[6], a[18])
+	a[7], a[19] = min(a[7], a[19]), max(a[7], a[19])
+	a[8], a[20] = min(a[8], a[20]), max(a[8], a[20])
+	a[9], a[21] = min(a[9], a[21]), max(a[9], a[21])
+	a[10], a[22] = min(a[10], a[22]), max(a[10], a[22])
+	a[11], a[23] = min(a[11], a[23]), max(a[11], a[23])
+	a[2], a[12] = min(a[2], a[12]), max(a[2], a[12])
+	a[3], a[13] = min(a[3], a[13]), max(a[3], a[13])
+	a[10], a[20] = min(a[10], a[20]), max(a[10], a[20])
+	a[11], a[21] = min(a[11], a[21]), max(a[11], a[21])
+	a[4], a[12] = min(a[4], a[12]), max(a[4], a[12])
+	a[5], a[13] = min(a[5], a[13]), max(a[5], a[13])
+	a[6], a[14] = min(a[6], a[14]), max(a[6], a[14])
+	a[7], a[15] = min(a[7], a[15]), max(a[7], a[15])
+	a[8], a[16] = min(a[8], a[16]), max(a[8], a[16])
+	a[9], a[17] = min(a[9], a[17]), max(a[9], a[17])
+	a[10], a[18] = min(a[10], a[18]), max(a[10], a[18])
+	a[11], a[19] = min(a[11], a[19]), max(a[11], a[19])
+	a[8], a[12] = min(a[8], a[12]), max(a[8], a[12])
+	a[9], a[13] = min(a[9], a[13]), max(a[9], a[13])
+	a[10], a[14] = min(a[10], a[14]), max(a[10], a[14])
+	a[11], a[15] = min(a[11], a[15]), max(a[11], a[15])
+	a[6], a[8] = min(a[6], a[8]), max(a[6], a[8])
+	a[10], a[12] = min(a[10], a[12]), max(a[10], a[12])
+	a[14], a[16] = min(a[14], a[16]), max(a[14], a[16])
+	a[7], a[9] = min(a[7], a[9]), max(a[7], a[9])
+	a[11], a[13] = min(a[11], a[13]), max(a[11], a[13])
+	a[15], a[17] = min(a[15], a[17]), max(a[15], a[17])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+	a[9], a[10] = min(a[9], a[10]), max(a[9], a[10])
+	a[11], a[12] = min(a[11], a[12]), max(a[11], a[12])
+	a[13], a[14] = min(a[13], a[14]), max(a[13], a[14])
+	a[15], a[16] = min(a[15], a[16]), max(a[15], a[16])
+	a[17], a[18] = min(a[17], a[18]), max(a[17], a[18])
+	a[19], a[20] = min(a[19], a[20]), max(a[19], a[20])
+	a[21], a[22] = min(a[21], a[22]), max(a[21], a[22])
+}
+
+// NetworkSort24xFloat64Reverse sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort24xFloat64Reverse(a []float64) {
+	_ = a[23]
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[10], a[11] = max(a[10], a[11]), min(a[10], a[11])
+	a[12], a[13] = max(a[12], a[13]), min(a[12], a[13])
+	a[14], a[15] = max(a[14], a[15]), min(a[14], a[15])
+	a[16], a[17] = max(a[16], a[17]), min(a[16], a[17])
+	a[18], a[19] = max(a[18], a[19]), min(a[18], a[19])
+	a[20], a[21] = max(a[20], a[21]), min(a[20], a[21])
+	a[22], a[23] = max(a[22], a[23]), min(a[22], a[23])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[5], a[7] = max(a[5], a[7]), min(a[5], a[7])
+	a[9], a[11] = max(a[9], a[11]), min(a[9], a[11])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[8], a[10] = max(a[8], a[10]), min(a[8], a[10])
+	a[13], a[15] = max(a[13], a[15]), min(a[13], a[15])
+	a[17], a[19] = max(a[17], a[19]), min(a[17], a[19])
+	a[21], a[23] = max(a[21], a[23]), min(a[21], a[23])
+	a[12], a[14] = max(a[12], a[14]), min(a[12], a[14])
+	a[16], a[18] = max(a[16], a[18]), min(a[16], a[18])
+	a[20], a[22] = max(a[20], a[22]), min(a[20], a[22])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[9], a[10] = max(a[9], a[10]), min(a[9], a[10])
+	a[13], a[14] = max(a[13], a[14]), min(a[13], a[14])
+	a[17], a[18] = max(a[17], a[18]), min(a[17], a[18])
+	a[21], a[22] = max(a[21], a[22]), min(a[21], a[22])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[6], a[10] = max(a[6], a[10]), min(a[6], a[10])
+	a[13], a[17] = max(a[13], a[17]), min(a[13], a[17])
+	a[18], a[22] = max(a[18], a[22]), min(a[18], a[22])
+	a[5], a[9] = max(a[5], a[9]), min(a[5], a[9])
+	a[2], a[6] = max(a[2], a[6]), min(a[2], a[6])
+	a[17], a[21] = max(a[17], a[21]), min(a[17], a[21])
+	a[14], a[18] = max(a[14], a[18]), min(a[14], a[18])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[6], a[10] = max(a[6], a[10]), min(a[6], a[10])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[7], a[11] = max(a[7], a[11]), min(a[7], a[11])
+	a[13], a[17] = max(a[13], a[17]), min(a[13], a[17])
+	a[18], a[22] = max(a[18], a[22]), min(a[18], a[22])
+	a[12], a[16] = max(a[12], a[16]), min(a[12], a[16])
+	a[19], a[23] = max(a[19], a[23]), min(a[19], a[23])
+	a[3], a[7] = max(a[3], a[7]), min(a[3], a[7])
+	a[4], a[8] = max(a[4], a[8]), min(a[4], a[8])
+	a[15], a[19] = max(a[15], a[19]), min(a[15], a[19])
+	a[16], a[20] = max(a[16], a[20]), min(a[16], a[20])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[7], a[11] = max(a[7], a[11]), min(a[7], a[11])
+	a[12], a[16] = max(a[12], a[16]), min(a[12], a[16])
+	a[19], a[23] = max(a[19], a[23]), min(a[19], a[23])
+	a[1], a[4] = max(a[1], a[4]), min(a[1], a[4])
+	a[7], a[10] = max(a[7], a[10]), min(a[7], a[10])
+	a[3], a[8] = max(a[3], a[8]), min(a[3], a[8])
+	a[13], a[16] = max(a[13], a[16]), min(a[13], a[16])
+	a[19], a[22] = max(a[19], a[22]), min(a[19], a[22])
+	a[15], a[20] = max(a[15], a[20]), min(a[15], a[20])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[14], a[15] = max(a[14], a[15]), min(a[14], a[15])
+	a[20], a[21] = max(a[20], a[21]), min(a[20], a[21])
+	a[2], a[4] = max(a[2], a[4]), min(a[2], a[4])
+	a[7], a[9] = max(a[7], a[9]), min(a[7], a[9])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[6], a[8] = max(a[6], a[8]), min(a[6], a[8])
+	a[14], a[16] = max(a[14], a[16]), min(a[14], a[16])
+	a[19], a[21] = max(a[19], a[21]), min(a[19], a[21])
+	a[15], a[17] = max(a[15], a[17]), min(a[15], a[17])
+	a[18], a[20] = max(a[18], a[20]), min(a[18], a[20])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+	a[15], a[16] = max(a[15], a[16]), min(a[15], a[16])
+	a[17], a[18] = max(a[17], a[18]), min(a[17], a[18])
+	a[19], a[20] = max(a[19], a[20]), min(a[19], a[20])
+	a[0], a[12] = max(a[0], a[12]), min(a[0], a[12])
+	a[1], a[13] = max(a[1], a[13]), min(a[1], a[13])
+	a[2], a[14] = max(a[2], a[14]), min(a[2], a[14])
+	a[3], a[15] = max(a[3], a[15]), min(a[3], a[15])
+	a[4], a[16] = max(a[4], a[16]), min(a[4], a[16])
+	a[5], a[17] = max(a[5], a[17]), min(a[5], a[17])
+	a[6], a[18] = max(a[6], a[18]), min(a[6], a[18])
+	a[7], a[19] = max(a[7], a[19]), min(a[7], a[19])
+	a[8], a[20] = max(a[8], a[20]), min(a[8], a[20])
+	a[9], a[21] = max(a[9], a[21]), min(a[9], a[21])
+	a[10], a[22] = max(a[10], a[22]), min(a[10], a[22])
+	a[11], a[23] = max(a[11], a[23]), min(a[11], a[23])
+	a[2], a[12] = max(a[2], a[12]), min(a[2], a[12])
+	a[3], a[13] = max(a[3], a[13]), min(a[3], a[13])
+	a[10], a[20] = max(a[10], a[20]), min(a[10], a[20])
+	a[11], a[21] = max(a[11], a[21]), min(a[11], a[21])
+	a[4], a[12] = max(a[4], a[12]), min(a[4], a[12])
+	a[5], a[13] = max(a[5], a[13]), min(a[5], a[13])
+	a[6], a[14] = max(a[6], a[14]), min(a[6], a[14])
+	a[7], a[15] = max(a[7], a[15]), min(a[7], a[15])
+	a[8], a[16] = max(a[8], a[16]), min(a[8], a[16])
+	a[9], a[17] = max(a[9], a[17]), min(a[9], a[17])
+	a[10], a[18] = max(a[10], a[18]), min(a[10], a[18])
+	a[11], a[19] = max(a[11], a[19]), min(a[11], a[19])
+	a[8], a[12] = max(a[8], a[12]), min(a[8], a[12])
+	a[9], a[13] = max(a[9], a[13]), min(a[9], a[13])
+	a[10], a[14] = max(a[10], a[14]), min(a[10], a[14])
+	a[11], a[15] = max(a[11], a[15]), min(a[11], a[15])
+	a[6], a[8] = max(a[6], a[8]), min(a[6], a[8])
+	a[10], a[12] = max(a[10], a[12]), min(a[10], a[12])
+	a[14], a[16] = max(a[14], a[16]), min(a[14], a[16])
+	a[7], a[9] = max(a[7], a[9]), min(a[7], a[9])
+	a[11], a[13] = max(a[11], a[13]), min(a[11], a[13])
+	a[15], a[17] = max(a[15], a[17]), min(a[15], a[17])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+	a[9], a[10] = max(a[9], a[10]), min(a[9], a[10])
+	a[11], a[12] = max(a[11], a[12]), min(a[11], a[12])
+	a[13], a[14] = max(a[13], a[14]), min(a[13], a[14])
+	a[15], a[16] = max(a[15], a[16]), min(a[15], a[16])
+	a[17], a[18] = max(a[17], a[18]), min(a[17], a[18])
+	a[19], a[20] = max(a[19], a[20]), min(a[19], a[20])
+	a[21], a[22] = max(a[21], a[22]), min(a[21], a[22])
+}
+
+// NetworkSort32xFloat64 sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort32xFloat64(a []float64) {
+	_ = a[31]
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[5], a[7] = min(a[5], a[7]), max(a[5], a[7])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[1], a[4] = min(a[1], a[4]), max(a[1], a[4])
+	a[2], a[6] = min(a[2], a[6]), max(a[2], a[6])
+	a[3], a[7] = min(a[3], a[7]), max(a[3], a[7])
+	a[3], a[6] = min(a[3], a[6]), max(a[3], a[6])
+	a[2], a[4] = min(a[2], a[4]), max(a[2], a[4])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[10], a[11] = min(a[10], a[11]), max(a[10], a[11])
+	a[8], a[10] = min(a[8], a[10]), max(a[8], a[10])
+	a[9], a[11] = min(a[9], a[11]), max(a[9], a[11])
+	a[9], a[10] = min(a[9], a[10]), max(a[9], a[10])
+	a[12], a[13] = min(a[12], a[13]), max(a[12], a[13])
+	a[14], a[15] = min(a[14], a[15]), max(a[14], a[15])
+	a[12], a[14] = min(a[12], a[14]), max(a[12], a[14])
+	a[13], a[15] = min(a[13], a[15]), max(a[13], a[15])
+	a[13], a[14] = min(a[13], a[14]), max(a[13], a[14])
+	a[8], a[12] = min(a[8], a[12]), max(a[8], a[12])
+	a[9], a[13] = min(a[9], a[13]), max(a[9], a[13])
+	a[9], a[12] = min(a[9], a[12]), max(a[9], a[12])
+	a[10], a[14] = min(a[10], a[14]), max(a[10], a[14])
+	a[11], a[15] = min(a[11], a[15]), max(a[11], a[15])
+	a[11], a[14] = min(a[11], a[14]), max(a[11], a[14])
+	a[10], a[12] = min(a[10], a[12]), max(a[10], a[12])
+	a[11], a[13] = min(a[11], a[13]), max(a[11], a[13])
+	a[11], a[12] = min(a[11], a[12]), max(a[11], a[12])
+	a[0], a[8] = min(a[0], a[8]), max(a[0], a[8])
+	a[1], a[9] = min(a[1], a[9]), max(a[1], a[9])
+	a[1], a[8] = min(a[1], a[8]), max(a[1], a[8])
+	a[2], a[10] = min(a[2], a[10]), max(a[2], a[10])
+	a[3], a[11] = min(a[3], a[11]), max(a[3], a[11])
+	a[3], a[10] = min(a[3], a[10]), max(a[3], a[10])
+	a[2], a[8] = min(a[2], a[8]), max(a[2], a[8])
+	a[3], a[9] = min(a[3], a[9]), max(a[3], a[9])
+	a[3], a[8] = min(a[3], a[8]), max(a[3], a[8])
+	a[4], a[12] = min(a[4], a[12]), max(a[4], a[12])
+	a[5], a[13] = min(a[5], a[13]), max(a[5], a[13])
+	a[5], a[12] = min(a[5], a[12]), max(a[5], a[12])
+	a[6], a[14] = min(a[6], a[14]), max(a[6], a[14])
+	a[7], a[15] = min(a[7], a[15]), max(a[7], a[15])
+	a[7], a[14] = min(a[7], a[14]), max(a[7], a[14])
+	a[6], a[12] = min(a[6], a[12]), max(a[6], a[12])
+	a[7], a[13] = min(a[7], a[13]), max(a[7], a[13])
+	a[7], a[12] = min(a[7], a[12]), max(a[7], a[12])
+	a[4], a[8] = min(a[4], a[8]), max(a[4], a[8])
+	a[5], a[9] = min(a[5], a[9]), max(a[5], a[9])
+	a[5], a[8] = min(a[5], a[8]), max(a[5], a[8])
+	a[6], a[10] = min(a[6], a[10]), max(a[6], a[10])
+	a[7], a[11] = min(a[7], a[11]), max(a[7], a[11])
+	a[7], a[10] = min(a[7], a[10]), max(a[7], a[10])
+	a[6], a[8] = min(a[6], a[8]), max(a[6], a[8])
+	a[7], a[9] = min(a[7], a[9]), max(a[7], a[9])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+	a[16], a[17] = min(a[16], a[17]), max(a[16], a[17])
+	a[18], a[19] = min(a[18], a[19]), max(a[18], a[19])
+	a[16], a[18] = min(a[16], a[18]), max(a[16], a[18])
+	a[17], a[19] = min(a[17], a[19]), max(a[17], a[19])
+	a[17], a[18] = min(a[17], a[18]), max(a[17], a[18])
+	a[20], a[21] = min(a[20], a[21]), max(a[20], a[21])
+	a[22], a[23] = min(a[22], a[23]), max(a[22], a[23])
+	a[20], a[22] = min(a[20], a[22]), max(a[20], a[22])
+	a[21], a[23] = min(a[21], a[23]), max(a[21], a[23])
+	a[21], a[22] = min(a[21], a[22]), max(a[21], a[22])
+	a[16], a[20] = min(a[16], a[20]), max(a[16], a[20])
+	a[17], a[21] = min(a[17], a[21]), max(a[17], a[21])
+	a[17], a[20] = min(a[17], a[20]), max(a[17], a[20])
+	a[18], a[22] = min(a[18], a[22]), max(a[18], a[22])
+	a[19], a[23] = min(a[19], a[23]), max(a[19], a[23])
+	a[19], a[22] = min(a[19], a[22]), max(a[19], a[22])
+	a[18], a[20] = min(a[18], a[20]), max(a[18], a[20])
+	a[19], a[21] = min(a[19], a[21]), max(a[19], a[21])
+	a[19], a[20] = min(a[19], a[20]), max(a[19], a[20])
+	a[24], a[25] = min(a[24], a[25]), max(a[24], a[25])
+	a[26], a[27] = min(a[26], a[27]), max(a[26], a[27])
+	a[24], a[26] = min(a[24], a[26]), max(a[24], a[26])
+	a[25], a[27] = min(a[25], a[27]), max(a[25], a[27])
+	a[25], a[26] = min(a[25], a[26]), max(a[25], a[26])
+	a[28], a[29] = min(a[28], a[29]), max(a[28], a[29])
+	a[30], a[31] = min(a[30], a[31]), max(a[30], a[31])
+	a[28], a[30] = min(a[28], a[30]), max(a[28], a[30])
+	a[29], a[31] = min(a[29], a[31]), max(a[29], a[31])
+	a[29], a[30] = min(a[29], a[30]), max(a[29], a[30])
+	a[24], a[28] = min(a[24], a[28]), max(a[24], a[28])
+	a[25], a[29] = min(a[25], a[29]), max(a[25], a[29])
+	a[25], a[28] = min(a[25], a[28]), max(a[25], a[28])
+	a[26], a[30] = min(a[26], a[30]), max(a[26], a[30])
+	a[27], a[31] = min(a[27], a[31]), max(a[27], a[31])
+	a[27], a[30] = min(a[27], a[30]), max(a[27], a[30])
+	a[26], a[28] = min(a[26], a[28]), max(a[26], a[28])
+	a[27], a[29] = min(a[27], a[29]), max(a[27], a[29])
+	a[27], a[28] = min(a[27], a[28]), max(a[27], a[28])
+	a[16], a[24] = min(a[16], a[24]), max(a[16], a[24])
+	a[17], a[25] = min(a[17], a[25]), max(a[17], a[25])
+	a[17], a[24] = min(a[17], a[24]), max(a[17], a[24])
+	a[18], a[26] = min(a[18], a[26]), max(a[18], a[26])
+	a[19], a[27] = min(a[19], a[27]), max(a[19], a[27])
+	a[19], a[26] = min(a[19], a[26]), max(a[19], a[26])
+	a[18], a[24] = min(a[18], a[24]), max(a[18], a[24])
+	a[19], a[25] = min(a[19], a[25]), max(a[19], a[25])
+	a[19], a[24] = min(a[19], a[24]), max(a[19], a[24])
+	a[20], a[28] = min(a[20], a[28]), max(a[20], a[28])
+	a[21], a[29] = min(a[21], a[29]), max(a[21], a[29])
+	a[21], a[28] = min(a[21], a[28]), max(a[21], a[28])
+	a[22], a[30] = min(a[22], a[30]), max(a[22], a[30])
+	a[23], a[31] = min(a[23], a[31]), max(a[23], a[31])
+	a[23], a[30] = min(a[23], a[30]), max(a[23], a[30])
+	a[22], a[28] = min(a[22], a[28]), max(a[22], a[28])
+	a[23], a[29] = min(a[23], a[29]), max(a[23], a[29])
+	a[23], a[28] = min(a[23], a[28]), max(a[23], a[28])
+	a[20], a[24] = min(a[20], a[24]), max(a[20], a[24])
+	a[21], a[25] = min(a[21], a[25]), max(a[21], a[25])
+	a[21], a[24] = min(a[21], a[24]), max(a[21], a[24])
+	a[22], a[26] = min(a[22], a[26]), max(a[22], a[26])
+	a[23], a[27] = min(a[23], a[27]), max(a[23], a[27])
+	a[23], a[26] = min(a[23], a[26]), max(a[23], a[26])
+	a[22], a[24] = min(a[22], a[24]), max(a[22], a[24])
+	a[23], a[25] = min(a[23], a[25]), max(a[23], a[25])
+	a[23], a[24] = min(a[23], a[24]), max(a[23], a[24])
+	a[0], a[16] = min(a[0], a[16]), max(a[0], a[16])
+	a[1], a[17] = min(a[1], a[17]), max(a[1], a[17])
+	a[1], a[16] = min(a[1], a[16]), max(a[1], a[16])
+	a[2], a[18] = min(a[2], a[18]), max(a[2], a[18])
+	a[3], a[19] = min(a[3], a[19]), max(a[3], a[19])
+	a[3], a[18] = min(a[3], a[18]), max(a[3], a[18])
+	a[2], a[16] = min(a[2], a[16]), max(a[2], a[16])
+	a[3], a[17] = min(a[3], a[17]), max(a[3], a[17])
+	a[3], a[16] = min(a[3], a[16]), max(a[3], a[16])
+	a[4], a[20] = min(a[4], a[20]), max(a[4], a[20])
+	a[5], a[21] = min(a[5], a[21]), max(a[5], a[21])
+	a[5], a[20] = min(a[5], a[20]), max(a[5], a[20])
+	a[6], a[22] = min(a[6], a[22]), max(a[6], a[22])
+	a[7], a[23] = min(a[7], a[23]), max(a[7], a[23])
+	a[7], a[22] = min(a[7], a[22]), max(a[7], a[22])
+	a[6], a[20] = min(a[6], a[20]), max(a[6], a[20])
+	a[7], a[21] = min(a[7], a[21]), max(a[7], a[21])
+	a[7], a[20] = min(a[7], a[20]), max(a[7], a[20])
+	a[4], a[16] = min(a[4], a[16]), max(a[4], a[16])
+	a[5], a[17] = min(a[5], a[17]), max(a[5], a[17])
+	a[5], a[16] = min(a[5], a[16]), max(a[5], a[16])
+	a[6], a[18] = min(a[6], a[18]), max(a[6], a[18])
+	a[7], a[19] = min(a[7], a[19]), max(a[7], a[19])
+	a[7], a[18] = min(a[7], a[18]), max(a[7], a[18])
+	a[6], a[16] = min(a[6], a[16]), max(a[6], a[16])
+	a[7], a[17] = min(a[7], a[17]), max(a[7], a[17])
+	a[7], a[16] = min(a[7], a[16]), max(a[7], a[16])
+	a[8], a[24] = min(a[8], a[24]), max(a[8], a[24])
+	a[9], a[25] = min(a[9], a[25]), max(a[9], a[25])
+	a[9], a[24] = min(a[9], a[24]), max(a[9], a[24])
+	a[10], a[26] = min(a[10], a[26]), max(a[10], a[26])
+	a[11], a[27] = min(a[11], a[27]), max(a[11], a[27])
+	a[11], a[26] = min(a[11], a[26]), max(a[11], a[26])
+	a[10], a[24] = min(a[10], a[24]), max(a[10], a[24])
+	a[11], a[25] = min(a[11], a[25]), max(a[11], a[25])
+	a[11], a[24] = min(a[11], a[24]), max(a[11], a[24])
+	a[12], a[28] = min(a[12], a[28]), max(a[12], a[28])
+	a[13], a[29] = min(a[13], a[29]), max(a[13], a[29])
+	a[13], a[28] = min(a[13], a[28]), max(a[13], a[28])
+	a[14], a[30] = min(a[14], a[30]), max(a[14], a[30])
+	a[15], a[31] = min(a[15], a[31]), max(a[15], a[31])
+	a[15], a[30] = min(a[15], a[30]), max(a[15], a[30])
+	a[14], a[28] = min(a[14], a[28]), max(a[14], a[28])
+	a[15], a[29] = min(a[15], a[29]), max(a[15], a[29])
+	a[15], a[28] = min(a[15], a[28]), max(a[15], a[28])
+	a[12], a[24] = min(a[12], a[24]), max(a[12], a[24])
+	a[13], a[25] = min(a[13], a[25]), max(a[13], a[25])
+	a[13], a[24] = min(a[13], a[24]), max(a[13], a[24])
+	a[14], a[26] = min(a[14], a[26]), max(a[14], a[26])
+	a[15], a[27] = min(a[15], a[27]), max(a[15], a[27])
+	a[15], a[26] = min(a[15], a[26]), max(a[15], a[26])
+	a[14], a[24] = min(a[14], a[24]), max(a[14], a[24])
+	a[15], a[25] = min(a[15], a[25]), max(a[15], a[25])
+	a[15], a[24] = min(a[15], a[24]), max(a[15], a[24])
+	a[8], a[16] = min(a[8], a[16]), max(a[8], a[16])
+	a[9], a[17] = min(a[9], a[17]), max(a[9], a[17])
+	a[9], a[16] = min(a[9], a[16]), max(a[9], a[16])
+	a[10], a[18] = min(a[10], a[18]), max(a[10], a[18])
+	a[11], a[19] = min(a[11], a[19]), max(a[11], a[19])
+	a[11], a[18] = min(a[11], a[18]), max(a[11], a[18])
+	a[10], a[16] = min(a[10], a[16]), max(a[10], a[16])
+	a[11], a[17] = min(a[11], a[17]), max(a[11], a[17])
+	a[11], a[16] = min(a[11], a[16]), max(a[11], a[16])
+	a[12], a[20] = min(a[12], a[20]), max(a[12], a[20])
+	a[13], a[21] = min(a[13], a[21]), max(a[13], a[21])
+	a[13], a[20] = min(a[13], a[20]), max(a[13], a[20])
+	a[14], a[22] = min(a[14], a[22]), max(a[14], a[22])
+	a[15], a[23] = min(a[15], a[23]), max(a[15], a[23])
+	a[15], a[22] = min(a[15], a[22]), max(a[15], a[22])
+	a[14], a[20] = min(a[14], a[20]), max(a[14], a[20])
+	a[15], a[21] = min(a[15], a[21]), max(a[15], a[21])
+	a[15], a[20] = min(a[15], a[20]), max(a[15], a[20])
+	a[12], a[16] = min(a[12], a[16]), max(a[12], a[16])
+	a[13], a[17] = min(a[13], a[17]), max(a[13], a[17])
+	a[13], a[16] = min(a[13], a[16]), max(a[13], a[16])
+	a[14], a[18] = min(a[14], a[18]), max(a[14], a[18])
+	a[15], a[19] = min(a[15], a[19]), max(a[15], a[19])
+	a[15], a[18] = min(a[15], a[18]), max(a[15], a[18])
+	a[14], a[16] = min(a[14], a[16]), max(a[14], a[16])
+	a[15], a[17] = min(a[15], a[17]), max(a[15], a[17])
+	a[15], a[16] = min(a[15], a[16]), max(a[15], a[16])
+}
+
+// NetworkSort32xFloat64Reverse sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort32xFloat64Reverse(a []float64) {
+	_ = a[31]
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[5], a[7] = max(a[5], a[7]), min(a[5], a[7])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[1], a[4] = max(a[1], a[4]), min(a[1], a[4])
+	a[2], a[6] = max(a[2], a[6]), min(a[2], a[6])
+	a[3], a[7] = max(a[3], a[7]), min(a[3], a[7])
+	a[3], a[6] = max(a[3], a[6]), min(a[3], a[6])
+	a[2], a[4] = max(a[2], a[4]), min(a[2], a[4])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[10], a[11] = max(a[10], a[11]), min(a[10], a[11])
+	a[8], a[10] = max(a[8], a[10]), min(a[8], a[10])
+	a[9], a[11] = max(a[9], a[11]), min(a[9], a[11])
+	a[9], a[10] = max(a[9], a[10]), min(a[9], a[10])
+	a[12], a[13] = max(a[12], a[13]), min(a[12], a[13])
+	a[14], a[15] = max(a[14], a[15]), min(a[14], a[15])
+	a[12], a[14] = max(a[12], a[14]), min(a[12], a[14])
+	a[13], a[15] = max(a[13], a[15]), min(a[13], a[15])
+	a[13], a[14] = max(a[13], a[14]), min(a[13], a[14])
+	a[8], a[12] = max(a[8], a[12]), min(a[8], a[12])
+	a[9], a[13] = max(a[9], a[13]), min(a[9], a[13])
+	a[9], a[12] = max(a[9], a[12]), min(a[9], a[12])
+	a[10], a[14] = max(a[10], a[14]), min(a[10], a[14])
+	a[11], a[15] = max(a[11], a[15]), min(a[11], a[15])
+	a[11], a[14] = max(a[11], a[14]), min(a[11], a[14])
+	a[10], a[12] = max(a[10], a[12]), min(a[10], a[12])
+	a[11], a[13] = max(a[11], a[13]), min(a[11], a[13])
+	a[11], a[12] = max(a[11], a[12]), min(a[11], a[12])
+	a[0], a[8] = max(a[0], a[8]), min(a[0], a[8])
+	a[1], a[9] = max(a[1], a[9]), min(a[1], a[9])
+	a[1], a[8] = max(a[1], a[8]), min(a[1], a[8])
+	a[2], a[10] = max(a[2], a[10]), min(a[2], a[10])
+	a[3], a[11] = max(a[3], a[11]), min(a[3], a[11])
+	a[3], a[10] = max(a[3], a[10]), min(a[3], a[10])
+	a[2], a[8] = max(a[2], a[8]), min(a[2], a[8])
+	a[3], a[9] = max(a[3], a[9]), min(a[3], a[9])
+	a[3], a[8] = max(a[3], a[8]), min(a[3], a[8])
+	a[4], a[12] = max(a[4], a[12]), min(a[4], a[12])
+	a[5], a[13] = max(a[5], a[13]), min(a[5], a[13])
+	a[5], a[12] = max(a[5], a[12]), min(a[5], a[12])
+	a[6], a[14] = max(a[6], a[14]), min(a[6], a[14])
+	a[7], a[15] = max(a[7], a[15]), min(a[7], a[15])
+	a[7], a[14] = max(a[7], a[14]), min(a[7], a[14])
+	a[6], a[12] = max(a[6], a[12]), min(a[6], a[12])
+	a[7], a[13] = max(a[7], a[13]), min(a[7], a[13])
+	a[7], a[12] = max(a[7], a[12]), min(a[7], a[12])
+	a[4], a[8] = max(a[4], a[8]), min(a[4], a[8])
+	a[5], a[9] = max(a[5], a[9]), min(a[5], a[9])
+	a[5], a[8] = max(a[5], a[8]), min(a[5], a[8])
+	a[6], a[10] = max(a[6], a[10]), min(a[6], a[10])
+	a[7], a[11] = max(a[7], a[11]), min(a[7], a[11])
+	a[7], a[10] = max(a[7], a[10]), min(a[7], a[10])
+	a[6], a[8] = max(a[6], a[8]), min(a[6], a[8])
+	a[7], a[9] = max(a[7], a[9]), min(a[7], a[9])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+	a[16], a[17] = max(a[16], a[17]), min(a[16], a[17])
+	a[18], a[19] = max(a[18], a[19]), min(a[18], a[19])
+	a[16], a[18] = max(a[16], a[18]), min(a[16], a[18])
+	a[17], a[19] = max(a[17], a[19]), min(a[17], a[19])
+	a[17], a[18] = max(a[17], a[18]), min(a[17], a[18])
+	a[20], a[21] = max(a[20], a[21]), min(a[20], a[21])
+	a[22], a[23] = max(a[22], a[23]), min(a[22], a[23])
+	a[20], a[22] = max(a[20], a[22]), min(a[20], a[22])
+	a[21], a[23] = max(a[21], a[23]), min(a[21], a[23])
+	a[21], a[22] = max(a[21], a[22]), min(a[21], a[22])
+	a[16], a[20] = max(a[16], a[20]), min(a[16], a[20])
+	a[17], a[21] = max(a[17], a[21]), min(a[17], a[21])
+	a[17], a[20] = max(a[17], a[20]), min(a[17], a[20])
+	a[18], a[22] = max(a[18], a[22]), min(a[18], a[22])
+	a[19], a[23] = max(a[19], a[23]), min(a[19], a[23])
+	a[19], a[22] = max(a[19], a[22]), min(a[19], a[22])
+	a[18], a[20] = max(a[18], a[20]), min(a[18], a[20])
+	a[19], a[21] = max(a[19], a[21]), min(a[19], a[21])
+	a[19], a[20] = max(a[19], a[20]), min(a[19], a[20])
+	a[24], a[25] = max(a[24], a[25]), min(a[24], a[25])
+	a[26], a[27] = max(a[26], a[27]), min(a[26], a[27])
+	a[24], a[26] = max(a[24], a[26]), min(a[24], a[26])
+	a[25], a[27] = max(a[25], a[27]), min(a[25], a[27])
+	a[25], a[26] = max(a[25], a[26]), min(a[25], a[26])
+	a[28], a[29] = max(a[28], a[29]), min(a[28], a[29])
+	a[30], a[31] = max(a[30], a[31]), min(a[30], a[31])
+	a[28], a[30] = max(a[28], a[30]), min(a[28], a[30])
+	a[29], a[31] = max(a[29], a[31]), min(a[29], a[31])
+	a[29], a[30] = max(a[29], a[30]), min(a[29], a[30])
+	a[24], a[28] = max(a[24], a[28]), min(a[24], a[28])
+	a[25], a[29] = max(a[25], a[29]), min(a[25], a[29])
+	a[25], a[28] = max(a[25], a[28]), min(a[25], a[28])
+	a[26], a[30] = max(a[26], a[30]), min(a[26], a[30])
+	a[27], a[31] = max(a[27], a[31]), min(a[27], a[31])
+	a[27], a[30] = max(a[27], a[30]), min(a[27], a[30])
+	a[26], a[28] = max(a[26], a[28]), min(a[26], a[28])
+	a[27], a[29] = max(a[27], a[29]), min(a[27], a[29])
+	a[27], a[28] = max(a[27], a[28]), min(a[27], a[28])
+	a[16], a[24] = max(a[16], a[24]), min(a[16], a[24])
+	a[17], a[25] = max(a[17], a[25]), min(a[17], a[25])
+	a[17], a[24] = max(a[17], a[24]), min(a[17], a[24])
+	a[18], a[26] = max(a[18], a[26]), min(a[18], a[26])
+	a[19], a[27] = max(a[19], a[27]), min(a[19], a[27])
+	a[19], a[26] = max(a[19], a[26]), min(a[19], a[26])
+	a[18], a[24] = max(a[18], a[24]), min(a[18], a[24])
+	a[19], a[25] = max(a[19], a[25]), min(a[19], a[25])
+	a[19], a[24] = max(a[19], a[24]), min(a[19], a[24])
+	a[20], a[28] = max(a[20], a[28]), min(a[20], a[28])
+	a[21], a[29] = max(a[21], a[29]), min(a[21], a[29])
+	a[21], a[28] = max(a[21], a[28]), min(a[21], a[28])
+	a[22], a[30] = max(a[22], a[30]), min(a[22], a[30])
+	a[23], a[31] = max(a[23], a[31]), min(a[23], a[31])
+	a[23], a[30] = max(a[23], a[30]), min(a[23], a[30])
+	a[22], a[28] = max(a[22], a[28]), min(a[22], a[28])
+	a[23], a[29] = max(a[23], a[29]), min(a[23], a[29])
+	a[23], a[28] = max(a[23], a[28]), min(a[23], a[28])
+	a[20], a[24] = max(a[20], a[24]), min(a[20], a[24])
+	a[21], a[25] = max(a[21], a[25]), min(a[21], a[25])
+	a[21], a[24] = max(a[21], a[24]), min(a[21], a[24])
+	a[22], a[26] = max(a[22], a[26]), min(a[22], a[26])
+	a[23], a[27] = max(a[23], a[27]), min(a[23], a[27])
+	a[23], a[26] = max(a[23], a[26]), min(a[23], a[26])
+	a[22], a[24] = max(a[22], a[24]), min(a[22], a[24])
+	a[23], a[25] = max(a[23], a[25]), min(a[23], a[25])
+	a[23], a[24] = max(a[23], a[24]), min(a[23], a[24])
+	a[0], a[16] = max(a[0], a[16]), min(a[0], a[16])
+	a[1], a[17] = max(a[1], a[17]), min(a[1], a[17])
+	a[1], a[16] = max(a[1], a[16]), min(a[1], a[16])
+	a[2], a[18] = max(a[2], a[18]), min(a[2], a[18])
+	a[3], a[19] = max(a[3], a[19]), min(a[3], a[19])
+	a[3], a[18] = max(a[3], a[18]), min(a[3], a[18])
+	a[2], a[16] = max(a[2], a[16]), min(a[2], a[16])
+	a[3], a[17] = max(a[3], a[17]), min(a[3], a[17])
+	a[3], a[16] = max(a[3], a[16]), min(a[3], a[16])
+	a[4], a[20] = max(a[4], a[20]), min(a[4], a[20])
+	a[5], a[21] = max(a[5], a[21]), min(a[5], a[21])
+	a[5], a[20] = max(a[5], a[20]), min(a[5], a[20])
+	a[6], a[22] = max(a[6], a[22]), min(a[6], a[22])
+	a[7], a[23] = max(a[7], a[23]), min(a[7], a[23])
+	a[7], a[22] = max(a[7], a[22]), min(a[7], a[22])
+	a[6], a[20] = max(a[6], a[20]), min(a[6], a[20])
+	a[7], a[21] = max(a[7], a[21]), min(a[7], a[21])
+	a[7], a[20] = max(a[7], a[20]), min(a[7], a[20])
+	a[4], a[16] = max(a[4], a[16]), min(a[4], a[16])
+	a[5], a[17] = max(a[5], a[17]), min(a[5], a[17])
+	a[5], a[16] = max(a[5], a[16]), min(a[5], a[16])
+	a[6], a[18] = max(a[6], a[18]), min(a[6], a[18])
+	a[7], a[19] = max(a[7], a[19]), min(a[7], a[19])
+	a[7], a[18] = max(a[7], a[18]), min(a[7], a[18])
+	a[6], a[16] = max(a[6], a[16]), min(a[6], a[16])
+	a[7], a[17] = max(a[7], a[17]), min(a[7], a[17])
+	a[7], a[16] = max(a[7], a[16]), min(a[7], a[16])
+	a[8], a[24] = max(a[8], a[24]), min(a[8], a[24])
+	a[9], a[25] = max(a[9], a[25]), min(a[9], a[25])
+	a[9], a[24] = max(a[9], a[24]), min(a[9], a[24])
+	a[10], a[26] = max(a[10], a[26]), min(a[10], a[26])
+	a[11], a[27] = max(a[11], a[27]), min(a[11], a[27])
+	a[11], a[26] = max(a[11], a[26]), min(a[11], a[26])
+	a[10], a[24] = max(a[10], a[24]), min(a[10], a[24])
+	a[11], a[25] = max(a[11], a[25]), min(a[11], a[25])
+	a[11], a[24] = max(a[11], a[24]), min(a[11], a[24])
+	a[12], a[28] = max(a[12], a[28]), min(a[12], a[28])
+	a[13], a[29] = max(a[13], a[29]), min(a[13], a[29])
+	a[13], a[28] = max(a[13], a[28]), min(a[13], a[28])
+	a[14], a[30] = max(a[14], a[30]), min(a[14], a[30])
+	a[15], a[31] = max(a[15], a[31]), min(a[15], a[31])
+	a[15], a[30] = max(a[15], a[30]), min(a[15], a[30])
+	a[14], a[28] = max(a[14], a[28]), min(a[14], a[28])
+	a[15], a[29] = max(a[15], a[29]), min(a[15], a[29])
+	a[15], a[28] = max(a[15], a[28]), min(a[15], a[28])
+	a[12], a[24] = max(a[12], a[24]), min(a[12], a[24])
+	a[13], a[25] = max(a[13], a[25]), min(a[13], a[25])
+	a[13], a[24] = max(a[13], a[24]), min(a[13], a[24])
+	a[14], a[26] = max(a[14], a[26]), min(a[14], a[26])
+	a[15], a[27] = max(a[15], a[27]), min(a[15], a[27])
+	a[15], a[26] = max(a[15], a[26]), min(a[15], a[26])
+	a[14], a[24] = max(a[14], a[24]), min(a[14], a[24])
+	a[15], a[25] = max(a[15], a[25]), min(a[15], a[25])
+	a[15], a[24] = max(a[15], a[24]), min(a[15], a[24])
+	a[8], a[16] = max(a[8], a[16]), min(a[8], a[16])
+	a[9], a[17] = max(a[9], a[17]), min(a[9], a[17])
+	a[9], a[16] = max(a[9], a[16]), min(a[9], a[16])
+	a[10], a[18] = max(a[10], a[18]), min(a[10], a[18])
+	a[11], a[19] = max(a[11], a[19]), min(a[11], a[19])
+	a[11], a[18] = max(a[11], a[18]), min(a[11], a[18])
+	a[10], a[16] = max(a[10], a[16]), min(a[10], a[16])
+	a[11], a[17] = max(a[11], a[17]), min(a[11], a[17])
+	a[11], a[16] = max(a[11], a[16]), min(a[11], a[16])
+	a[12], a[20] = max(a[12], a[20]), min(a[12], a[20])
+	a[13], a[21] = max(a[13], a[21]), min(a[13], a[21])
+	a[13], a[20] = max(a[13], a[20]), min(a[13], a[20])
+	a[14], a[22] = max(a[14], a[22]), min(a[14], a[22])
+	a[15], a[23] = max(a[15], a[23]), min(a[15], a[23])
+	a[15], a[22] = max(a[15], a[22]), min(a[15], a[22])
+	a[14], a[20] = max(a[14], a[20]), min(a[14], a[20])
+	a[15], a[21] = max(a[15], a[21]), min(a[15], a[21])
+	a[15], a[20] = max(a[15], a[20]), min(a[15], a[20])
+	a[12], a[16] = max(a[12], a[16]), min(a[12], a[16])
+	a[13], a[17] = max(a[13], a[17]), min(a[13], a[17])
+	a[13], a[16] = max(a[13], a[16]), min(a[13], a[16])
+	a[14], a[18] = max(a[14], a[18]), min(a[14], a[18])
+	a[15], a[19] = max(a[15], a[19]), min(a[15], a[19])
+	a[15], a[18] = max(a[15], a[18]), min(a[15], a[18])
+	a[14], a[16] = max(a[14], a[16]), min(a[14], a[16])
+	a[15], a[17] = max(a[15], a[17]), min(a[15], a[17])
+	a[15], a[16] = max(a[15], a[16]), min(a[15], a[16])
+}
+
+// NetworkSort48xFloat64 sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort48xFloat64(a []float64) {
+	_ = a[47]
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[0], a[3] = min(a[0], a[3]), max(a[0], a[3])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[2], a[5] = min(a[2], a[5]), max(a[2], a[5])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[2], a[4] = min(a[2], a[4]), max(a[2], a[4])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+	a[6], a[8] = min(a[6], a[8]), max(a[6], a[8])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[10], a[11] = min(a[10], a[11]), max(a[10], a[11])
+	a[9], a[11] = min(a[9], a[11]), max(a[9], a[11])
+	a[9], a[10] = min(a[9], a[10]), max(a[9], a[10])
+	a[6], a[10] = min(a[6], a[10]), max(a[6], a[10])
+	a[6], a[9] = min(a[6], a[9]), max(a[6], a[9])
+	a[7], a[11] = min(a[7], a[11]), max(a[7], a[11])
+	a[8], a[11] = min(a[8], a[11]), max(a[8], a[11])
+	a[7], a[9] = min(a[7], a[9]), max(a[7], a[9])
+	a[8], a[10] = min(a[8], a[10]), max(a[8], a[10])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[0], a[7] = min(a[0], a[7]), max(a[0], a[7])
+	a[0], a[6] = min(a[0], a[6]), max(a[0], a[6])
+	a[1], a[8] = min(a[1], a[8]), max(a[1], a[8])
+	a[2], a[8] = min(a[2], a[8]), max(a[2], a[8])
+	a[1], a[6] = min(a[1], a[6]), max(a[1], a[6])
+	a[2], a[7] = min(a[2], a[7]), max(a[2], a[7])
+	a[2], a[6] = min(a[2], a[6]), max(a[2], a[6])
+	a[3], a[10] = min(a[3], a[10]), max(a[3], a[10])
+	a[3], a[9] = min(a[3], a[9]), max(a[3], a[9])
+	a[4], a[11] = min(a[4], a[11]), max(a[4], a[11])
+	a[5], a[11] = min(a[5], a[11]), max(a[5], a[11])
+	a[4], a[9] = min(a[4], a[9]), max(a[4], a[9])
+	a[5], a[10] = min(a[5], a[10]), max(a[5], a[10])
+	a[5], a[9] = min(a[5], a[9]), max(a[5], a[9])
+	a[3], a[7] = min(a[3], a[7]), max(a[3], a[7])
+	a[3], a[6] = min(a[3], a[6]), max(a[3], a[6])
+	a[4], a[8] = min(a[4], a[8]), max(a[4], a[8])
+	a[5], a[8] = min(a[5], a[8]), max(a[5], a[8])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[5], a[7] = min(a[5], a[7]), max(a[5], a[7])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[13], a[14] = min(a[13], a[14]), max(a[13], a[14])
+	a[12], a[14] = min(a[12], a[14]), max(a[12], a[14])
+	a[12], a[13] = min(a[12], a[13]), max(a[12], a[13])
+	a[16], a[17] = min(a[16], a[17]), max(a[16], a[17])
+	a[15], a[17] = min(a[15], a[17]), max(a[15], a[17])
+	a[15], a[16] = min(a[15], a[16]), max(a[15], a[16])
+	a[12], a[16] = min(a[12], a[16]), max(a[12], a[16])
+	a[12], a[15] = min(a[12], a[15]), max(a[12], a[15])
+	a[13], a[17] = min(a[13], a[17]), max(a[13], a[17])
+	a[14], a[17] = min(a[14], a[17]), max(a[14], a[17])
+	a[13], a[15] = min(a[13], a[15]), max(a[13], a[15])
+	a[14], a[16] = min(a[14], a[16]), max(a[14], a[16])
+	a[14], a[15] = min(a[14], a[15]), max(a[14], a[15])
+	a[19], a[20] = min(a[19], a[20]), max(a[19], a[20])
+	a[18], a[20] = min(a[18], a[20]), max(a[18], a[20])
+	a[18], a[19] = min(a[18], a[19]), max(a[18], a[19])
+	a[22], a[23] = min(a[22], a[23]), max(a[22], a[23])
+	a[21], a[23] = min(a[21], a[23]), max(a[21], a[23])
+	a[21], a[22] = min(a[21], a[22]), max(a[21], a[22])
+	a[18], a[22] = min(a[18], a[22]), max(a[18], a[22])
+	a[18], a[21] = min(a[18], a[21]), max(a[18], a[21])
+	a[19], a[23] = min(a[19], a[23]), max(a[19], a[23])
+	a[20], a[23] = min(a[20], a[23]), max(a[20], a[23])
+	a[19], a[21] = min(a[19], a[21]), max(a[19], a[21])
+	a[20], a[22] = min(a[20], a[22]), max(a[20], a[22])
+	a[20], a[21] = min(a[20], a[21]), max(a[20], a[21])
+	a[12], a[19] = min(a[12], a[19]), max(a[12], a[19])
+	a[12], a[18] = min(a[12], a[18]), max(a[12], a[18])
+	a[13], a[20] = min(a[13], a[20]), max(a[13], a[20])
+	a[14], a[20] = min(a[14], a[20]), max(a[14], a[20])
+	a[13], a[18] = min(a[13], a[18]), max(a[13], a[18])
+	a[14], a[19] = min(a[14], a[19]), max(a[14], a[19])
+	a[14], a[18] = min(a[14], a[18]), max(a[14], a[18])
+	a[15], a[22] = min(a[15], a[22]), max(a[15], a[22])
+	a[15], a[21] = min(a[15], a[21]), max(a[15], a[21])
+	a[16], a[23] = min(a[16], a[23]), max(a[16], a[23])
+	a[17], a[23] = min(a[17], a[23]), max(a[17], a[23])
+	a[16], a[21] = min(a[16], a[21]), max(a[16], a[21])
+	a[17], a[22] = min(a[17], a[22]), max(a[17], a[22])
+	a[17], a[21] = min(a[17], a[21]), max(a[17], a[21])
+	a[15], a[19] = min(a[15], a[19]), max(a[15], a[19])
+	a[15], a[18] = min(a[15], a[18]), max(a[15], a[18])
+	a[16], a[20] = min(a[16], a[20]), max(a[16], a[20])
+	a[17], a[20] = min(a[17], a[20]), max(a[17], a[20])
+	a[16], a[18] = min(a[16], a[18]), max(a[16], a[18])
+	a[17], a[19] = min(a[17], a[19]), max(a[17], a[19])
+	a[17], a[18] = min(a[17], a[18]), max(a[17], a[18])
+	a[0], a[13] = min(a[0], a[13]), max(a[0], a[13])
+	a[0], a[12] = min(a[0], a[12]), max(a[0], a[12])
+	a[1], a[14] = min(a[1], a[14]), max(a[1], a[14])
+	a[2], a[14] = min(a[2], a[14]), max(a[2], a[14])
+	a[1], a[12] = min(a[1], a[12]), max(a[1], a[12])
+	a[2], a[13] = min(a[2], a[13]), max(a[2], a[13])
+	a[2], a[12] = min(a[2], a[12]), max(a[2], a[12])
+	a[3], a[16] = min(a[3], a[16]), max(a[3], a[16])
+	a[3], a[15] = min(a[3], a[15]), max(a[3], a[15])
+	a[4], a[17] = min(a[4], a[17]), max(a[4], a[17])
+	a[5], a[17] = min(a[5], a[17]), max(a[5], a[17])
+	a[4], a[15] = min(a[4], a[15]), max(a[4], a[15])
+	a[5], a[16] = min(a[5], a[16]), max(a[5], a[16])
+	a[5], a[15] = min(a[5], a[15]), max(a[5], a[15])
+	a[3], a[13] = min(a[3], a[13]), max(a[3], a[13])
+	a[3], a[12] = min(a[3], a[12]), max(a[3], a[12])
+	a[4], a[14] = min(a[4], a[14]), max(a[4], a[14])
+	a[5], a[14] = min(a[5], a[14]), max(a[5], a[14])
+	a[4], a[12] = min(a[4], a[12]), max(a[4], a[12])
+	a[5], a[13] = min(a[5], a[13]), max(a[5], a[13])
+	a[5], a[12] = min(a[5], a[12]), max(a[5], a[12])
+	a[6], a[19] = min(a[6], a[19]), max(a[6], a[19])
+	a[6], a[18] = min(a[6], a[18]), max(a[6], a[18])
+	a[7], a[20] = min(a[7], a[20]), max(a[7], a[20])
+	a[8], a[20] = min(a[8], a[20]), max(a[8], a[20])
+	a[7], a[18] = min(a[7], a[18]), max(a[7], a[18])
+	a[8], a[19] = min(a[8], a[19]), max(a[8], a[19])
+	a[8], a[18] = min(a[8], a[18]), max(a[8], a[18])
+	a[9], a[22] = min(a[9], a[22]), max(a[9], a[22])
+	a[9], a[21] = min(a[9], a[21]), max(a[9], a[21])
+	a[10], a[23] = min(a[10], a[23]), max(a[10], a[23])
+	a[11], a[23] = min(a[11], a[23]), max(a[11], a[23])
+	a[10], a[21] = min(a[10], a[21]), max(a[10], a[21])
+	a[11], a[22] = min(a[11], a[22]), max(a[11], a[22])
+	a[11], a[21] = min(a[11], a[21]), max(a[11], a[21])
+	a[9], a[19] = min(a[9], a[19]), max(a[9], a[19])
+	a[9], a[18] = min(a[9], a[18]), max(a[9], a[18])
+	a[10], a[20] = min(a[10], a[20]), max(a[10], a[20])
+	a[11], a[20] = min(a[11], a[20]), max(a[11], a[20])
+	a[10], a[18] = min(a[10], a[18]), max(a[10], a[18])
+	a[11], a[19] = min(a[11], a[19]), max(a[11], a[19])
+	a[11], a[18] = min(a[11], a[18]), max(a[11], a[18])
+	a[6], a[13] = min(a[6], a[13]), max(a[6], a[13])
+	a[6], a[12] = min(a[6], a[12]), max(a[6], a[12])
+	a[7], a[14] = min(a[7], a[14]), max(a[7], a[14])
+	a[8], a[14] = min(a[8], a[14]), max(a[8], a[14])
+	a[7], a[12] = min(a[7], a[12]), max(a[7], a[12])
+	a[8], a[13] = min(a[8], a[13]), max(a[8], a[13])
+	a[8], a[12] = min(a[8], a[12]), max(a[8], a[12])
+	a[9], a[16] = min(a[9], a[16]), max(a[9], a[16])
+	a[9], a[15] = min(a[9], a[15]), max(a[9], a[15])
+	a[10], a[17] = min(a[10], a[17]), max(a[10], a[17])
+	a[11], a[17] = min(a[11], a[17]), max(a[11], a[17])
+	a[10], a[15] = min(a[10], a[15]), max(a[10], a[15])
+	a[11], a[16] = min(a[11], a[16]), max(a[11], a[16])
+	a[11], a[15] = min(a[11], a[15]), max(a[11], a[15])
+	a[9], a[13] = min(a[9], a[13]), max(a[9], a[13])
+	a[9], a[12] = min(a[9], a[12]), max(a[9], a[12])
+	a[10], a[14] = min(a[10], a[14]), max(a[10], a[14])
+	a[11], a[14] = min(a[11], a[14]), max(a[11], a[14])
+	a[10], a[12] = min(a[10], a[12]), max(a[10], a[12])
+	a[11], a[13] = min(a[11], a[13]), max(a[11], a[13])
+	a[11], a[12] = min(a[11], a[12]), max(a[11], a[12])
+	a[25], a[26] = min(a[25], a[26]), max(a[25], a[26])
+	a[24], a[26] = min(a[24], a[26]), max(a[24], a[26])
+	a[24], a[25] = min(a[24], a[25]), max(a[24], a[25])
+	a[28], a[29] = min(a[28], a[29]), max(a[28], a[29])
+	a[27], a[29] = min(a[27], a[29]), max(a[27], a[29])
+	a[27], a[28] = min(a[27], a[28]), max(a[27], a[28])
+	a[24], a[28] = min(a[24], a[28]), max(a[24], a[28])
+	a[24], a[27] = min(a[24], a[27]), max(a[24], a[27])
+	a[25], a[29] = min(a[25], a[29]), max(a[25], a[29])
+	a[26], a[29] = min(a[26], a[29]), max(a[26], a[29])
+	a[25], a[27] = min(a[25], a[27]), max(a[25], a[27])
+	a[26], a[28] = min(a[26], a[28]), max(a[26], a[28])
+	a[26], a[27] = min(a[26], a[27]), max(a[26], a[27])
+	a[31], a[32] = min(a[31], a[32]), max(a[31], a[32])
+	a[30], a[32] = min(a[30], a[32]), max(a[30], a[32])
+	a[30], a[31] = min(a[30], a[31]), max(a[30], a[31])
+	a[34], a[35] = min(a[34], a[35]), max(a[34], a[35])
+	a[33], a[35] = min(a[33], a[35]), max(a[33], a[35])
+	a[33], a[34] = min(a[33], a[34]), max(a[33], a[34])
+	a[30], a[34] = min(a[30], a[34]), max(a[30], a[34])
+	a[30], a[33] = min(a[30], a[33]), max(a[30], a[33])
+	a[31], a[35] = min(a[31], a[35]), max(a[31], a[35])
+	a[32], a[35] = min(a[32], a[35]), max(a[32], a[35])
+	a[31], a[33] = min(a[31], a[33]), max(a[31], a[33])
+	a[32], a[34] = min(a[32], a[34]), max(a[32], a[34])
+	a[32], a[33] = min(a[32], a[33]), max(a[32], a[33])
+	a[24], a[31] = min(a[24], a[31]), max(a[24], a[31])
+	a[24], a[30] = min(a[24], a[30]), max(a[24], a[30])
+	a[25], a[32] = min(a[25], a[32]), max(a[25], a[32])
+	a[26], a[32] = min(a[26], a[32]), max(a[26], a[32])
+	a[25], a[30] = min(a[25], a[30]), max(a[25], a[30])
+	a[26], a[31] = min(a[26], a[31]), max(a[26], a[31])
+	a[26], a[30] = min(a[26], a[30]), max(a[26], a[30])
+	a[27], a[34] = min(a[27], a[34]), max(a[27], a[34])
+	a[27], a[33] = min(a[27], a[33]), max(a[27], a[33])
+	a[28], a[35] = min(a[28], a[35]), max(a[28], a[35])
+	a[29], a[35] = min(a[29], a[35]), max(a[29], a[35])
+	a[28], a[33] = min(a[28], a[33]), max(a[28], a[33])
+	a[29], a[34] = min(a[29], a[34]), max(a[29], a[34])
+	a[29], a[33] = min(a[29], a[33]), max(a[29], a[33])
+	a[27], a[31] = min(a[27], a[31]), max(a[27], a[31])
+	a[27], a[30] = min(a[27], a[30]), max(a[27], a[30])
+	a[28], a[32] = min(a[28], a[32]), max(a[28], a[32])
+	a[29], a[32] = min(a[29], a[32]), max(a[29], a[32])
+	a[28], a[30] = min(a[28], a[30]), max(a[28], a[30])
+	a[29], a[31] = min(a[29], a[31]), max(a[29], a[31])
+	a[29], a[30] = min(a[29], a[30]), max(a[29], a[30])
+	a[37], a[38] = min(a[37], a[38]), max(a[37], a[38])
+	a[36], a[38] = min(a[36], a[38]), max(a[36], a[38])
+	a[36], a[37] = min(a[36], a[37]), max(a[36], a[37])
+	a[40], a[41] = min(a[40], a[41]), max(a[40], a[41])
+	a[39], a[41] = min(a[39], a[41]), max(a[39], a[41])
+	a[39], a[40] = min(a[39], a[40]), max(a[39], a[40])
+	a[36], a[40] = min(a[36], a[40]), max(a[36], a[40])
+	a[36], a[39] = min(a[36], a[39]), max(a[36], a[39])
+	a[37], a[41] = min(a[37], a[41]), max(a[37], a[41])
+	a[38], a[41] = min(a[38], a[41]), max(a[38], a[41])
+	a[37], a[39] = min(a[37], a[39]), max(a[37], a[39])
+	a[38], a[40] = min(a[38], a[40]), max(a[38], a[40])
+	a[38], a[39] = min(a[38], a[39]), max(a[38], a[39])
+	a[43], a[44] = min(a[43], a[44]), max(a[43], a[44])
+	a[42], a[44] = min(a[42], a[44]), max(a[42], a[44])
+	a[42], a[43] = min(a[42], a[43]), max(a[42], a[43])
+	a[46], a[47] = min(a[46], a[47]), max(a[46], a[47])
+	a[45], a[47] = min(a[45], a[47]), max(a[45], a[47])
+	a[45], a[46] = min(a[45], a[46]), max(a[45], a[46])
+	a[42], a[46] = min(a[42], a[46]), max(a[42], a[46])
+	a[42], a[45] = min(a[42], a[45]), max(a[42], a[45])
+	a[43], a[47] = min(a[43], a[47]), max(a[43], a[47])
+	a[44], a[47] = min(a[44], a[47]), max(a[44], a[47])
+	a[43], a[45] = min(a[43], a[45]), max(a[43], a[45])
+	a[44], a[46] = min(a[44], a[46]), max(a[44], a[46])
+	a[44], a[45] = min(a[44], a[45]), max(a[44], a[45])
+	a[36], a[43] = min(a[36], a[43]), max(a[36], a[43])
+	a[36], a[42] = min(a[36], a[42]), max(a[36], a[42])
+	a[37], a[44] = min(a[37], a[44]), max(a[37], a[44])
+	a[38], a[44] = min(a[38], a[44]), max(a[38], a[44])
+	a[37], a[42] = min(a[37], a[42]), max(a[37], a[42])
+	a[38], a[43] = min(a[38], a[43]), max(a[38], a[43])
+	a[38], a[42] = min(a[38], a[42]), max(a[38], a[42])
+	a[39], a[46] = min(a[39], a[46]), max(a[39], a[46])
+	a[39], a[45] = min(a[39], a[45]), max(a[39], a[45])
+	a[40], a[47] = min(a[40], a[47]), max(a[40], a[47])
+	a[41], a[47] = min(a[41], a[47]), max(a[41], a[47])
+	a[40], a[45] = min(a[40], a[45]), max(a[40], a[45])
+	a[41], a[46] = min(a[41], a[46]), max(a[41], a[46])
+	a[41], a[45] = min(a[41], a[45]), max(a[41], a[45])
+	a[39], a[43] = min(a[39], a[43]), max(a[39], a[43])
+	a[39], a[42] = min(a[39], a[42]), max(a[39], a[42])
+	a[40], a[44] = min(a[40], a[44]), max(a[40], a[44])
+	a[41], a[44] = min(a[41], a[44]), max(a[41], a[44])
+	a[40], a[42] = min(a[40], a[42]), max(a[40], a[42])
+	a[41], a[43] = min(a[41], a[43]), max(a[41], a[43])
+	a[41], a[42] = min(a[41], a[42]), max(a[41], a[42])
+	a[24], a[37] = min(a[24], a[37]), max(a[24], a[37])
+	a[24], a[36] = min(a[24], a[36]), max(a[24], a[36])
+	a[25], a[38] = min(a[25], a[38]), max(a[25], a[38])
+	a[26], a[38] = min(a[26], a[38]), max(a[26], a[38])
+	a[25], a[36] = min(a[25], a[36]), max(a[25], a[36])
+	a[26], a[37] = min(a[26], a[37]), max(a[26], a[37])
+	a[26], a[36] = min(a[26], a[36]), max(a[26], a[36])
+	a[27], a[40] = min(a[27], a[40]), max(a[27], a[40])
+	a[27], a[39] = min(a[27], a[39]), max(a[27], a[39])
+	a[28], a[41] = min(a[28], a[41]), max(a[28], a[41])
+	a[29], a[41] = min(a[29], a[41]), max(a[29], a[41])
+	a[28], a[39] = min(a[28], a[39]), max(a[28], a[39])
+	a[29], a[40] = min(a[29], a[40]), max(a[29], a[40])
+	a[29], a[39] = min(a[29], a[39]), max(a[29], a[39])
+	a[27], a[37] = min(a[27], a[37]), max(a[27], a[37])
+	a[27], a[36] = min(a[27], a[36]), max(a[27], a[36])
+	a[28], a[38] = min(a[28], a[38]), max(a[28], a[38])
+	a[29], a[38] = min(a[29], a[38]), max(a[29], a[38])
+	a[28], a[36] = min(a[28], a[36]), max(a[28], a[36])
+	a[29], a[37] = min(a[29], a[37]), max(a[29], a[37])
+	a[29], a[36] = min(a[29], a[36]), max(a[29], a[36])
+	a[30], a[43] = min(a[30], a[43]), max(a[30], a[43])
+	a[30], a[42] = min(a[30], a[42]), max(a[30], a[42])
+	a[31], a[44] = min(a[31], a[44]), max(a[31], a[44])
+	a[32], a[44] = min(a[32], a[44]), max(a[32], a[44])
+	a[31], a[42] = min(a[31], a[42]), max(a[31], a[42])
+	a[32], a[43] = min(a[32], a[43]), max(a[32], a[43])
+	a[32], a[42] = min(a[32], a[42]), max(a[32], a[42])
+	a[33], a[46] = min(a[33], a[46]), max(a[33], a[46])
+	a[33], a[45] = min(a[33], a[45]), max(a[33], a[45])
+	a[34], a[47] = min(a[34], a[47]), max(a[34], a[47])
+	a[35], a[47] = min(a[35], a[47]), max(a[35], a[47])
+	a[34], a[45] = min(a[34], a[45]), max(a[34], a[45])
+	a[35], a[46] = min(a[35], a[46]), max(a[35], a[46])
+	a[35], a[45] = min(a[35], a[45]), max(a[35], a[45])
+	a[33], a[43] = min(a[33], a[43]), max(a[33], a[43])
+	a[33], a[42] = min(a[33], a[42]), max(a[33], a[42])
+	a[34], a[44] = min(a[34], a[44]), max(a[34], a[44])
+	a[35], a[44] = min(a[35], a[44]), max(a[35], a[44])
+	a[34], a[42] = min(a[34], a[42]), max(a[34], a[42])
+	a[35], a[43] = min(a[35], a[43]), max(a[35], a[43])
+	a[35], a[42] = min(a[35], a[42]), max(a[35], a[42])
+	a[30], a[37] = min(a[30], a[37]), max(a[30], a[37])
+	a[30], a[36] = min(a[30], a[36]), max(a[30], a[36])
+	a[31], a[38] = min(a[31], a[38]), max(a[31], a[38])
+	a[32], a[38] = min(a[32], a[38]), max(a[32], a[38])
+	a[31], a[36] = min(a[31], a[36]), max(a[31], a[36])
+	a[32], a[37] = min(a[32], a[37]), max(a[32], a[37])
+	a[32], a[36] = min(a[32], a[36]), max(a[32], a[36])
+	a[33], a[40] = min(a[33], a[40]), max(a[33], a[40])
+	a[33], a[39] = min(a[33], a[39]), max(a[33], a[39])
+	a[34], a[41] = min(a[34], a[41]), max(a[34], a[41])
+	a[35], a[41] = min(a[35], a[41]), max(a[35], a[41])
+	a[34], a[39] = min(a[34], a[39]), max(a[34], a[39])
+	a[35], a[40] = min(a[35], a[40]), max(a[35], a[40])
+	a[35], a[39] = min(a[35], a[39]), max(a[35], a[39])
+	a[33], a[37] = min(a[33], a[37]), max(a[33], a[37])
+	a[33], a[36] = min(a[33], a[36]), max(a[33], a[36])
+	a[34], a[38] = min(a[34], a[38]), max(a[34], a[38])
+	a[35], a[38] = min(a[35], a[38]), max(a[35], a[38])
+	a[34], a[36] = min(a[34], a[36]), max(a[34], a[36])
+	a[35], a[37] = min(a[35], a[37]), max(a[35], a[37])
+	a[35], a[36] = min(a[35], a[36]), max(a[35], a[36])
+	a[0], a[25] = min(a[0], a[25]), max(a[0], a[25])
+	a[0], a[24] = min(a[0], a[24]), max(a[0], a[24])
+	a[1], a[26] = min(a[1], a[26]), max(a[1], a[26])
+	a[2], a[26] = min(a[2], a[26]), max(a[2], a[26])
+	a[1], a[24] = min(a[1], a[24]), max(a[1], a[24])
+	a[2], a[25] = min(a[2], a[25]), max(a[2], a[25])
+	a[2], a[24] = min(a[2], a[24]), max(a[2], a[24])
+	a[3], a[28] = min(a[3], a[28]), max(a[3], a[28])
+	a[3], a[27] = min(a[3], a[27]), max(a[3], a[27])
+	a[4], a[29] = min(a[4], a[29]), max(a[4], a[29])
+	a[5], a[29] = min(a[5], a[29]), max(a[5], a[29])
+	a[4], a[27] = min(a[4], a[27]), max(a[4], a[27])
+	a[5], a[28] = min(a[5], a[28]), max(a[5], a[28])
+	a[5], a[27] = min(a[5], a[27]), max(a[5], a[27])
+	a[3], a[25] = min(a[3], a[25]), max(a[3], a[25])
+	a[3], a[24] = min(a[3], a[24]), max(a[3], a[24])
+	a[4], a[26] = min(a[4], a[26]), max(a[4], a[26])
+	a[5], a[26] = min(a[5], a[26]), max(a[5], a[26])
+	a[4], a[24] = min(a[4], a[24]), max(a[4], a[24])
+	a[5], a[25] = min(a[5], a[25]), max(a[5], a[25])
+	a[5], a[24] = min(a[5], a[24]), max(a[5], a[24])
+	a[6], a[31] = min(a[6], a[31]), max(a[6], a[31])
+	a[6], a[30] = min(a[6], a[30]), max(a[6], a[30])
+	a[7], a[32] = min(a[7], a[32]), max(a[7], a[32])
+	a[8], a[32] = min(a[8], a[32]), max(a[8], a[32])
+	a[7], a[30] = min(a[7], a[30]), max(a[7], a[30])
+	a[8], a[31] = min(a[8], a[31]), max(a[8], a[31])
+	a[8], a[30] = min(a[8], a[30]), max(a[8], a[30])
+	a[9], a[34] = min(a[9], a[34]), max(a[9], a[34])
+	a[9], a[33] = min(a[9], a[33]), max(a[9], a[33])
+	a[10], a[35] = min(a[10], a[35]), max(a[10], a[35])
+	a[11], a[35] = min(a[11], a[35]), max(a[11], a[35])
+	a[10], a[33] = min(a[10], a[33]), max(a[10], a[33])
+	a[11], a[34] = min(a[11], a[34]), max(a[11], a[34])
+	a[11], a[33] = min(a[11], a[33]), max(a[11], a[33])
+	a[9], a[31] = min(a[9], a[31]), max(a[9], a[31])
+	a[9], a[30] = min(a[9], a[30]), max(a[9], a[30])
+	a[10], a[32] = min(a[10], a[32]), max(a[10], a[32])
+	a[11], a[32] = min(a[11], a[32]), max(a[11], a[32])
+	a[10], a[30] = min(a[10], a[30]), max(a[10], a[30])
+	a[11], a[31] = min(a[11], a[31]), max(a[11], a[31])
+	a[11], a[30] = min(a[11], a[30]), max(a[11], a[30])
+	a[6], a[25] = min(a[6], a[25]), max(a[6], a[25])
+	a[6], a[24] = min(a[6], a[24]), max(a[6], a[24])
+	a[7], a[26] = min(a[7], a[26]), max(a[7], a[26])
+	a[8], a[26] = min(a[8], a[26]), max(a[8], a[26])
+	a[7], a[24] = min(a[7], a[24]), max(a[7], a[24])
+	a[8], a[25] = min(a[8], a[25]), max(a[8], a[25])
+	a[8], a[24] = min(a[8], a[24]), max(a[8], a[24])
+	a[9], a[28] = min(a[9], a[28]), max(a[9], a[28])
+	a[9], a[27] = min(a[9], a[27]), max(a[9], a[27])
+	a[10], a[29] = min(a[10], a[29]), max(a[10], a[29])
+	a[11], a[29] = min(a[11], a[29]), max(a[11], a[29])
+	a[10], a[27] = min(a[10], a[27]), max(a[10], a[27])
+	a[11], a[28] = min(a[11], a[28]), max(a[11], a[28])
+	a[11], a[27] = min(a[11], a[27]), max(a[11], a[27])
+	a[9], a[25] = min(a[9], a[25]), max(a[9], a[25])
+	a[9], a[24] = min(a[9], a[24]), max(a[9], a[24])
+	a[10], a[26] = min(a[10], a[26]), max(a[10], a[26])
+	a[11], a[26] = min(a[11], a[26]), max(a[11], a[26])
+	a[10], a[24] = min(a[10], a[24]), max(a[10], a[24])
+	a[11], a[25] = min(a[11], a[25]), max(a[11], a[25])
+	a[11], a[24] = min(a[11], a[24]), max(a[11], a[24])
+	a[12], a[37] = min(a[12], a[37]), max(a[12], a[37])
+	a[12], a[36] = min(a[12], a[36]), max(a[12], a[36])
+	a[13], a[38] = min(a[13], a[38]), max(a[13], a[38])
+	a[14], a[38] = min(a[14], a[38]), max(a[14], a[38])
+	a[13], a[36] = min(a[13], a[36]), max(a[13], a[36])
+	a[14], a[37] = min(a[14], a[37]), max(a[14], a[37])
+	a[14], a[36] = min(a[14], a[36]), max(a[14], a[36])
+	a[15], a[40] = min(a[15], a[40]), max(a[15], a[40])
+	a[15], a[39] = min(a[15], a[39]), max(a[15], a[39])
+	a[16], a[41] = min(a[16], a[41]), max(a[16], a[41])
+	a[17], a[41] = min(a[17], a[41]), max(a[17], a[41])
+	a[16], a[39] = min(a[16], a[39]), max(a[16], a[39])
+	a[17], a[40] = min(a[17], a[40]), max(a[17], a[40])
+	a[17], a[39] = min(a[17], a[39]), max(a[17], a[39])
+	a[15], a[37] = min(a[15], a[37]), max(a[15], a[37])
+	a[15], a[36] = min(a[15], a[36]), max(a[15], a[36])
+	a[16], a[38] = min(a[16], a[38]), max(a[16], a[38])
+	a[17], a[38] = min(a[17], a[38]), max(a[17], a[38])
+	a[16], a[36] = min(a[16], a[36]), max(a[16], a[36])
+	a[17], a[37] = min(a[17], a[37]), max(a[17], a[37])
+	a[17], a[36] = min(a[17], a[36]), max(a[17], a[36])
+	a[18], a[43] = min(a[18], a[43]), max(a[18], a[43])
+	a[18], a[42] = min(a[18], a[42]), max(a[18], a[42])
+	a[19], a[44] = min(a[19], a[44]), max(a[19], a[44])
+	a[20], a[44] = min(a[20], a[44]), max(a[20], a[44])
+	a[19], a[42] = min(a[19], a[42]), max(a[19], a[42])
+	a[20], a[43] = min(a[20], a[43]), max(a[20], a[43])
+	a[20], a[42] = min(a[20], a[42]), max(a[20], a[42])
+	a[21], a[46] = min(a[21], a[46]), max(a[21], a[46])
+	a[21], a[45] = min(a[21], a[45]), max(a[21], a[45])
+	a[22], a[47] = min(a[22], a[47]), max(a[22], a[47])
+	a[23], a[47] = min(a[23], a[47]), max(a[23], a[47])
+	a[22], a[45] = min(a[22], a[45]), max(a[22], a[45])
+	a[23], a[46] = min(a[23], a[46]), max(a[23], a[46])
+	a[23], a[45] = min(a[23], a[45]), max(a[23], a[45])
+	a[21], a[43] = min(a[21], a[43]), max(a[21], a[43])
+	a[21], a[42] = min(a[21], a[42]), max(a[21], a[42])
+	a[22], a[44] = min(a[22], a[44]), max(a[22], a[44])
+	a[23], a[44] = min(a[23], a[44]), max(a[23], a[44])
+	a[22], a[42] = min(a[22], a[42]), max(a[22], a[42])
+	a[23], a[43] = min(a[23], a[43]), max(a[23], a[43])
+	a[23], a[42] = min(a[23], a[42]), max(a[23], a[42])
+	a[18], a[37] = min(a[18], a[37]), max(a[18], a[37])
+	a[18], a[36] = min(a[18], a[36]), max(a[18], a[36])
+	a[19], a[38] = min(a[19], a[38]), max(a[19], a[38])
+	a[20], a[38] = min(a[20], a[38]), max(a[20], a[38])
+	a[19], a[36] = min(a[19], a[36]), max(a[19], a[36])
+	a[20], a[37] = min(a[20], a[37]), max(a[20], a[37])
+	a[20], a[36] = min(a[20], a[36]), max(a[20], a[36])
+	a[21], a[40] = min(a[21], a[40]), max(a[21], a[40])
+	a[21], a[39] = min(a[21], a[39]), max(a[21], a[39])
+	a[22], a[41] = min(a[22], a[41]), max(a[22], a[41])
+	a[23], a[41] = min(a[23], a[41]), max(a[23], a[41])
+	a[22], a[39] = min(a[22], a[39]), max(a[22], a[39])
+	a[23], a[40] = min(a[23], a[40]), max(a[23], a[40])
+	a[23], a[39] = min(a[23], a[39]), max(a[23], a[39])
+	a[21], a[37] = min(a[21], a[37]), max(a[21], a[37])
+	a[21], a[36] = min(a[21], a[36]), max(a[21], a[36])
+	a[22], a[38] = min(a[22], a[38]), max(a[22], a[38])
+	a[23], a[38] = min(a[23], a[38]), max(a[23], a[38])
+	a[22], a[36] = min(a[22], a[36]), max(a[22], a[36])
+	a[23], a[37] = min(a[23], a[37]), max(a[23], a[37])
+	a[23], a[36] = min(a[23], a[36]), max(a[23], a[36])
+	a[12], a[25] = min(a[12], a[25]), max(a[12], a[25])
+	a[12], a[24] = min(a[12], a[24]), max(a[12], a[24])
+	a[13], a[26] = min(a[13], a[26]), max(a[13], a[26])
+	a[14], a[26] = min(a[14], a[26]), max(a[14], a[26])
+	a[13], a[24] = min(a[13], a[24]), max(a[13], a[24])
+	a[14], a[25] = min(a[14], a[25]), max(a[14], a[25])
+	a[14], a[24] = min(a[14], a[24]), max(a[14], a[24])
+	a[15], a[28] = min(a[15], a[28]), max(a[15], a[28])
+	a[15], a[27] = min(a[15], a[27]), max(a[15], a[27])
+	a[16], a[29] = min(a[16], a[29]), max(a[16], a[29])
+	a[17], a[29] = min(a[17], a[29]), max(a[17], a[29])
+	a[16], a[27] = min(a[16], a[27]), max(a[16], a[27])
+	a[17], a[28] = min(a[17], a[28]), max(a[17], a[28])
+	a[17], a[27] = min(a[17], a[27]), max(a[17], a[27])
+	a[15], a[25] = min(a[15], a[25]), max(a[15], a[25])
+	a[15], a[24] = min(a[15], a[24]), max(a[15], a[24])
+	a[16], a[26] = min(a[16], a[26]), max(a[16], a[26])
+	a[17], a[26] = min(a[17], a[26]), max(a[17], a[26])
+	a[16], a[24] = min(a[16], a[24]), max(a[16], a[24])
+	a[17], a[25] = min(a[17], a[25]), max(a[17], a[25])
+	a[17], a[24] = min(a[17], a[24]), max(a[17], a[24])
+	a[18], a[31] = min(a[18], a[31]), max(a[18], a[31])
+	a[18], a[30] = min(a[18], a[30]), max(a[18], a[30])
+	a[19], a[32] = min(a[19], a[32]), max(a[19], a[32])
+	a[20], a[32] = min(a[20], a[32]), max(a[20], a[32])
+	a[19], a[30] = min(a[19], a[30]), max(a[19], a[30])
+	a[20], a[31] = min(a[20], a[31]), max(a[20], a[31])
+	a[20], a[30] = min(a[20], a[30]), max(a[20], a[30])
+	a[21], a[34] = min(a[21], a[34]), max(a[21], a[34])
+	a[21], a[33] = min(a[21], a[33]), max(a[21], a[33])
+	a[22], a[35] = min(a[22], a[35]), max(a[22], a[35])
+	a[23], a[35] = min(a[23], a[35]), max(a[23], a[35])
+	a[22], a[33] = min(a[22], a[33]), max(a[22], a[33])
+	a[23], a[34] = min(a[23], a[34]), max(a[23], a[34])
+	a[23], a[33] = min(a[23], a[33]), max(a[23], a[33])
+	a[21], a[31] = min(a[21], a[31]), max(a[21], a[31])
+	a[21], a[30] = min(a[21], a[30]), max(a[21], a[30])
+	a[22], a[32] = min(a[22], a[32]), max(a[22], a[32])
+	a[23], a[32] = min(a[23], a[32]), max(a[23], a[32])
+	a[22], a[30] = min(a[22], a[30]), max(a[22], a[30])
+	a[23], a[31] = min(a[23], a[31]), max(a[23], a[31])
+	a[23], a[30] = min(a[23], a[30]), max(a[23], a[30])
+	a[18], a[25] = min(a[18], a[25]), max(a[18], a[25])
+	a[18], a[24] = min(a[18], a[24]), max(a[18], a[24])
+	a[19], a[26] = min(a[19], a[26]), max(a[19], a[26])
+	a[20], a[26] = min(a[20], a[26]), max(a[20], a[26])
+	a[19], a[24] = min(a[19], a[24]), max(a[19], a[24])
+	a[20], a[25] = min(a[20], a[25]), max(a[20], a[25])
+	a[20], a[24] = min(a[20], a[24]), max(a[20], a[24])
+	a[21], a[28] = min(a[21], a[28]), max(a[21], a[28])
+	a[21], a[27] = min(a[21], a[27]), max(a[21], a[27])
+	a[22], a[29] = min(a[22], a[29]), max(a[22], a[29])
+	a[23], a[29] = min(a[23], a[29]), max(a[23], a[29])
+	a[22], a[27] = min(a[22], a[27]), max(a[22], a[27])
+	a[23], a[28] = min(a[23], a[28]), max(a[23], a[28])
+	a[23], a[27] = min(a[23], a[27]), max(a[23], a[27])
+	a[21], a[25] = min(a[21], a[25]), max(a[21], a[25])
+	a[21], a[24] = min(a[21], a[24]), max(a[21], a[24])
+	a[22], a[26] = min(a[22], a[26]), max(a[22], a[26])
+	a[23], a[26] = min(a[23], a[26]), max(a[23], a[26])
+	a[22], a[24] = min(a[22], a[24]), max(a[22], a[24])
+	a[23], a[25] = min(a[23], a[25]), max(a[23], a[25])
+	a[23], a[24] = min(a[23], a[24]), max(a[23], a[24])
+}
+
+// NetworkSort48xFloat64Reverse sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort48xFloat64Reverse(a []float64) {
+	_ = a[47]
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[0], a[3] = max(a[0], a[3]), min(a[0], a[3])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[2], a[5] = max(a[2], a[5]), min(a[2], a[5])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[2], a[4] = max(a[2], a[4]), min(a[2], a[4])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+	a[6], a[8] = max(a[6], a[8]), min(a[6], a[8])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[10], a[11] = max(a[10], a[11]), min(a[10], a[11])
+	a[9], a[11] = max(a[9], a[11]), min(a[9], a[11])
+	a[9], a[10] = max(a[9], a[10]), min(a[9], a[10])
+	a[6], a[10] = max(a[6], a[10]), min(a[6], a[10])
+	a[6], a[9] = max(a[6], a[9]), min(a[6], a[9])
+	a[7], a[11] = max(a[7], a[11]), min(a[7], a[11])
+	a[8], a[11] = max(a[8], a[11]), min(a[8], a[11])
+	a[7], a[9] = max(a[7], a[9]), min(a[7], a[9])
+	a[8], a[10] = max(a[8], a[10]), min(a[8], a[10])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[0], a[7] = max(a[0], a[7]), min(a[0], a[7])
+	a[0], a[6] = max(a[0], a[6]), min(a[0], a[6])
+	a[1], a[8] = max(a[1], a[8]), min(a[1], a[8])
+	a[2], a[8] = max(a[2], a[8]), min(a[2], a[8])
+	a[1], a[6] = max(a[1], a[6]), min(a[1], a[6])
+	a[2], a[7] = max(a[2], a[7]), min(a[2], a[7])
+	a[2], a[6] = max(a[2], a[6]), min(a[2], a[6])
+	a[3], a[10] = max(a[3], a[10]), min(a[3], a[10])
+	a[3], a[9] = max(a[3], a[9]), min(a[3], a[9])
+	a[4], a[11] = max(a[4], a[11]), min(a[4], a[11])
+	a[5], a[11] = max(a[5], a[11]), min(a[5], a[11])
+	a[4], a[9] = max(a[4], a[9]), min(a[4], a[9])
+	a[5], a[10] = max(a[5], a[10]), min(a[5], a[10])
+	a[5], a[9] = max(a[5], a[9]), min(a[5], a[9])
+	a[3], a[7] = max(a[3], a[7]), min(a[3], a[7])
+	a[3], a[6] = max(a[3], a[6]), min(a[3], a[6])
+	a[4], a[8] = max(a[4], a[8]), min(a[4], a[8])
+	a[5], a[8] = max(a[5], a[8]), min(a[5], a[8])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[5], a[7] = max(a[5], a[7]), min(a[5], a[7])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[13], a[14] = max(a[13], a[14]), min(a[13], a[14])
+	a[12], a[14] = max(a[12], a[14]), min(a[12], a[14])
+	a[12], a[13] = max(a[12], a[13]), min(a[12], a[13])
+	a[16], a[17] = max(a[16], a[17]), min(a[16], a[17])
+	a[15], a[17] = max(a[15], a[17]), min(a[15], a[17])
+	a[15], a[16] = max(a[15], a[16]), min(a[15], a[16])
+	a[12], a[16] = max(a[12], a[16]), min(a[12], a[16])
+	a[12], a[15] = max(a[12], a[15]), min(a[12], a[15])
+	a[13], a[17] = max(a[13], a[17]), min(a[13], a[17])
+	a[14], a[17] = max(a[14], a[17]), min(a[14], a[17])
+	a[13], a[15] = max(a[13], a[15]), min(a[13], a[15])
+	a[14], a[16] = max(a[14], a[16]), min(a[14], a[16])
+	a[14], a[15] = max(a[14], a[15]), min(a[14], a[15])
+	a[19], a[20] = max(a[19], a[20]), min(a[19], a[20])
+	a[18], a[20] = max(a[18], a[20]), min(a[18], a[20])
+	a[18], a[19] = max(a[18], a[19]), min(a[18], a[19])
+	a[22], a[23] = max(a[22], a[23]), min(a[22], a[23])
+	a[21], a[23] = max(a[21], a[23]), min(a[21], a[23])
+	a[21], a[22] = max(a[21], a[22]), min(a[21], a[22])
+	a[18], a[22] = max(a[18], a[22]), min(a[18], a[22])
+	a[18], a[21] = max(a[18], a[21]), min(a[18], a[21])
+	a[19], a[23] = max(a[19], a[23]), min(a[19], a[23])
+	a[20], a[23] = max(a[20], a[23]), min(a[20], a[23])
+	a[19], a[21] = max(a[19], a[21]), min(a[19], a[21])
+	a[20], a[22] = max(a[20], a[22]), min(a[20], a[22])
+	a[20], a[21] = max(a[20], a[21]), min(a[20], a[21])
+	a[12], a[19] = max(a[12], a[19]), min(a[12], a[19])
+	a[12], a[18] = max(a[12], a[18]), min(a[12], a[18])
+	a[13], a[20] = max(a[13], a[20]), min(a[13], a[20])
+	a[14], a[20] = max(a[14], a[20]), min(a[14], a[20])
+	a[13], a[18] = max(a[13], a[18]), min(a[13], a[18])
+	a[14], a[19] = max(a[14], a[19]), min(a[14], a[19])
+	a[14], a[18] = max(a[14], a[18]), min(a[14], a[18])
+	a[15], a[22] = max(a[15], a[22]), min(a[15], a[22])
+	a[15], a[21] = max(a[15], a[21]), min(a[15], a[21])
+	a[16], a[23] = max(a[16], a[23]), min(a[16], a[23])
+	a[17], a[23] = max(a[17], a[23]), min(a[17], a[23])
+	a[16], a[21] = max(a[16], a[21]), min(a[16], a[21])
+	a[17], a[22] = max(a[17], a[22]), min(a[17], a[22])
+	a[17], a[21] = max(a[17], a[21]), min(a[17], a[21])
+	a[15], a[19] = max(a[15], a[19]), min(a[15], a[19])
+	a[15], a[18] = max(a[15], a[18]), min(a[15], a[18])
+	a[16], a[20] = max(a[16], a[20]), min(a[16], a[20])
+	a[17], a[20] = max(a[17], a[20]), min(a[17], a[20])
+	a[16], a[18] = max(a[16], a[18]), min(a[16], a[18])
+	a[17], a[19] = max(a[17], a[19]), min(a[17], a[19])
+	a[17], a[18] = max(a[17], a[18]), min(a[17], a[18])
+	a[0], a[13] = max(a[0], a[13]), min(a[0], a[13])
+	a[0], a[12] = max(a[0], a[12]), min(a[0], a[12])
+	a[1], a[14] = max(a[1], a[14]), min(a[1], a[14])
+	a[2], a[14] = max(a[2], a[14]), min(a[2], a[14])
+	a[1], a[12] = max(a[1], a[12]), min(a[1], a[12])
+	a[2], a[13] = max(a[2], a[13]), min(a[2], a[13])
+	a[2], a[12] = max(a[2], a[12]), min(a[2], a[12])
+	a[3], a[16] = max(a[3], a[16]), min(a[3], a[16])
+	a[3], a[15] = max(a[3], a[15]), min(a[3], a[15])
+	a[4], a[17] = max(a[4], a[17]), min(a[4], a[17])
+	a[5], a[17] = max(a[5], a[17]), min(a[5], a[17])
+	a[4], a[15] = max(a[4], a[15]), min(a[4], a[15])
+	a[5], a[16] = max(a[5], a[16]), min(a[5], a[16])
+	a[5], a[15] = max(a[5], a[15]), min(a[5], a[15])
+	a[3], a[13] = max(a[3], a[13]), min(a[3], a[13])
+	a[3], a[12] = max(a[3], a[12]), min(a[3], a[12])
+	a[4], a[14] = max(a[4], a[14]), min(a[4], a[14])
+	a[5], a[14] = max(a[5], a[14]), min(a[5], a[14])
+	a[4], a[12] = max(a[4], a[12]), min(a[4], a[12])
+	a[5], a[13] = max(a[5], a[13]), min(a[5], a[13])
+	a[5], a[12] = max(a[5], a[12]), min(a[5], a[12])
+	a[6], a[19] = max(a[6], a[19]), min(a[6], a[19])
+	a[6], a[18] = max(a[6], a[18]), min(a[6], a[18])
+	a[7], a[20] = max(a[7], a[20]), min(a[7], a[20])
+	a[8], a[20] = max(a[8], a[20]), min(a[8], a[20])
+	a[7], a[18] = max(a[7], a[18]), min(a[7], a[18])
+	a[8], a[19] = max(a[8], a[19]), min(a[8], a[19])
+	a[8], a[18] = max(a[8], a[18]), min(a[8], a[18])
+	a[9], a[22] = max(a[9], a[22]), min(a[9], a[22])
+	a[9], a[21] = max(a[9], a[21]), min(a[9], a[21])
+	a[10], a[23] = max(a[10], a[23]), min(a[10], a[23])
+	a[11], a[23] = max(a[11], a[23]), min(a[11], a[23])
+	a[10], a[21] = max(a[10], a[21]), min(a[10], a[21])
+	a[11], a[22] = max(a[11], a[22]), min(a[11], a[22])
+	a[11], a[21] = max(a[11], a[21]), min(a[11], a[21])
+	a[9], a[19] = max(a[9], a[19]), min(a[9], a[19])
+	a[9], a[18] = max(a[9], a[18]), min(a[9], a[18])
+	a[10], a[20] = max(a[10], a[20]), min(a[10], a[20])
+	a[11], a[20] = max(a[11], a[20]), min(a[11], a[20])
+	a[10], a[18] = max(a[10], a[18]), min(a[10], a[18])
+	a[11], a[19] = max(a[11], a[19]), min(a[11], a[19])
+	a[11], a[18] = max(a[11], a[18]), min(a[11], a[18])
+	a[6], a[13] = max(a[6], a[13]), min(a[6], a[13])
+	a[6], a[12] = max(a[6], a[12]), min(a[6], a[12])
+	a[7], a[14] = max(a[7], a[14]), min(a[7], a[14])
+	a[8], a[14] = max(a[8], a[14]), min(a[8], a[14])
+	a[7], a[12] = max(a[7], a[12]), min(a[7], a[12])
+	a[8], a[13] = max(a[8], a[13]), min(a[8], a[13])
+	a[8], a[12] = max(a[8], a[12]), min(a[8], a[12])
+	a[9], a[16] = max(a[9], a[16]), min(a[9], a[16])
+	a[9], a[15] = max(a[9], a[15]), min(a[9], a[15])
+	a[10], a[17] = max(a[10], a[17]), min(a[10], a[17])
+	a[11], a[17] = max(a[11], a[17]), min(a[11], a[17])
+	a[10], a[15] = max(a[10], a[15]), min(a[10], a[15])
+	a[11], a[16] = max(a[11], a[16]), min(a[11], a[16])
+	a[11], a[15] = max(a[11], a[15]), min(a[11], a[15])
+	a[9], a[13] = max(a[9], a[13]), min(a[9], a[13])
+	a[9], a[12] = max(a[9], a[12]), min(a[9], a[12])
+	a[10], a[14] = max(a[10], a[14]), min(a[10], a[14])
+	a[11], a[14] = max(a[11], a[14]), min(a[11], a[14])
+	a[10], a[12] = max(a[10], a[12]), min(a[10], a[12])
+	a[11], a[13] = max(a[11], a[13]), min(a[11], a[13])
+	a[11], a[12] = max(a[11], a[12]), min(a[11], a[12])
+	a[25], a[26] = max(a[25], a[26]), min(a[25], a[26])
+	a[24], a[26] = max(a[24], a[26]), min(a[24], a[26])
+	a[24], a[25] = max(a[24], a[25]), min(a[24], a[25])
+	a[28], a[29] = max(a[28], a[29]), min(a[28], a[29])
+	a[27], a[29] = max(a[27], a[29]), min(a[27], a[29])
+	a[27], a[28] = max(a[27], a[28]), min(a[27], a[28])
+	a[24], a[28] = max(a[24], a[28]), min(a[24], a[28])
+	a[24], a[27] = max(a[24], a[27]), min(a[24], a[27])
+	a[25], a[29] = max(a[25], a[29]), min(a[25], a[29])
+	a[26], a[29] = max(a[26], a[29]), min(a[26], a[29])
+	a[25], a[27] = max(a[25], a[27]), min(a[25], a[27])
+	a[26], a[28] = max(a[26], a[28]), min(a[26], a[28])
+	a[26], a[27] = max(a[26], a[27]), min(a[26], a[27])
+	a[31], a[32] = max(a[31], a[32]), min(a[31], a[32])
+	a[30], a[32] = max(a[30], a[32]), min(a[30], a[32])
+	a[30], a[31] = max(a[30], a[31]), min(a[30], a[31])
+	a[34], a[35] = max(a[34], a[35]), min(a[34], a[35])
+	a[33], a[35] = max(a[33], a[35]), min(a[33], a[35])
+	a[33], a[34] = max(a[33], a[34]), min(a[33], a[34])
+	a[30], a[34] = max(a[30], a[34]), min(a[30], a[34])
+	a[30], a[33] = max(a[30], a[33]), min(a[30], a[33])
+	a[31], a[35] = max(a[31], a[35]), min(a[31], a[35])
+	a[32], a[35] = max(a[32], a[35]), min(a[32], a[35])
+	a[31], a[33] = max(a[31], a[33]), min(a[31], a[33])
+	a[32], a[34] = max(a[32], a[34]), min(a[32], a[34])
+	a[32], a[33] = max(a[32], a[33]), min(a[32], a[33])
+	a[24], a[31] = max(a[24], a[31]), min(a[24], a[31])
+	a[24], a[30] = max(a[24], a[30]), min(a[24], a[30])
+	a[25], a[32] = max(a[25], a[32]), min(a[25], a[32])
+	a[26], a[32] = max(a[26], a[32]), min(a[26], a[32])
+	a[25], a[30] = max(a[25], a[30]), min(a[25], a[30])
+	a[26], a[31] = max(a[26], a[31]), min(a[26], a[31])
+	a[26], a[30] = max(a[26], a[30]), min(a[26], a[30])
+	a[27], a[34] = max(a[27], a[34]), min(a[27], a[34])
+	a[27], a[33] = max(a[27], a[33]), min(a[27], a[33])
+	a[28], a[35] = max(a[28], a[35]), min(a[28], a[35])
+	a[29], a[35] = max(a[29], a[35]), min(a[29], a[35])
+	a[28], a[33] = max(a[28], a[33]), min(a[28], a[33])
+	a[29], a[34] = max(a[29], a[34]), min(a[29], a[34])
+	a[29], a[33] = max(a[29], a[33]), min(a[29], a[33])
+	a[27], a[31] = max(a[27], a[31]), min(a[27], a[31])
+	a[27], a[30] = max(a[27], a[30]), min(a[27], a[30])
+	a[28], a[32] = max(a[28], a[32]), min(a[28], a[32])
+	a[29], a[32] = max(a[29], a[32]), min(a[29], a[32])
+	a[28], a[30] = max(a[28], a[30]), min(a[28], a[30])
+	a[29], a[31] = max(a[29], a[31]), min(a[29], a[31])
+	a[29], a[30] = max(a[29], a[30]), min(a[29], a[30])
+	a[37], a[38] = max(a[37], a[38]), min(a[37], a[38])
+	a[36], a[38] = max(a[36], a[38]), min(a[36], a[38])
+	a[36], a[37] = max(a[36], a[37]), min(a[36], a[37])
+	a[40], a[41] = max(a[40], a[41]), min(a[40], a[41])
+	a[39], a[41] = max(a[39], a[41]), min(a[39], a[41])
+	a[39], a[40] = max(a[39], a[40]), min(a[39], a[40])
+	a[36], a[40] = max(a[36], a[40]), min(a[36], a[40])
+	a[36], a[39] = max(a[36], a[39]), min(a[36], a[39])
+	a[37], a[41] = max(a[37], a[41]), min(a[37], a[41])
+	a[38], a[41] = max(a[38], a[41]), min(a[38], a[41])
+	a[37], a[39] = max(a[37], a[39]), min(a[37], a[39])
+	a[38], a[40] = max(a[38], a[40]), min(a[38], a[40])
+	a[38], a[39] = max(a[38], a[39]), min(a[38], a[39])
+	a[43], a[44] = max(a[43], a[44]), min(a[43], a[44])
+	a[42], a[44] = max(a[42], a[44]), min(a[42], a[44])
+	a[42], a[43] = max(a[42], a[43]), min(a[42], a[43])
+	a[46], a[47] = max(a[46], a[47]), min(a[46], a[47])
+	a[45], a[47] = max(a[45], a[47]), min(a[45], a[47])
+	a[45], a[46] = max(a[45], a[46]), min(a[45], a[46])
+	a[42], a[46] = max(a[42], a[46]), min(a[42], a[46])
+	a[42], a[45] = max(a[42], a[45]), min(a[42], a[45])
+	a[43], a[47] = max(a[43], a[47]), min(a[43], a[47])
+	a[44], a[47] = max(a[44], a[47]), min(a[44], a[47])
+	a[43], a[45] = max(a[43], a[45]), min(a[43], a[45])
+	a[44], a[46] = max(a[44], a[46]), min(a[44], a[46])
+	a[44], a[45] = max(a[44], a[45]), min(a[44], a[45])
+	a[36], a[43] = max(a[36], a[43]), min(a[36], a[43])
+	a[36], a[42] = max(a[36], a[42]), min(a[36], a[42])
+	a[37], a[44] = max(a[37], a[44]), min(a[37], a[44])
+	a[38], a[44] = max(a[38], a[44]), min(a[38], a[44])
+	a[37], a[42] = max(a[37], a[42]), min(a[37], a[42])
+	a[38], a[43] = max(a[38], a[43]), min(a[38], a[43])
+	a[38], a[42] = max(a[38], a[42]), min(a[38], a[42])
+	a[39], a[46] = max(a[39], a[46]), min(a[39], a[46])
+	a[39], a[45] = max(a[39], a[45]), min(a[39], a[45])
+	a[40], a[47] = max(a[40], a[47]), min(a[40], a[47])
+	a[41], a[47] = max(a[41], a[47]), min(a[41], a[47])
+	a[40], a[45] = max(a[40], a[45]), min(a[40], a[45])
+	a[41], a[46] = max(a[41], a[46]), min(a[41], a[46])
+	a[41], a[45] = max(a[41], a[45]), min(a[41], a[45])
+	a[39], a[43] = max(a[39], a[43]), min(a[39], a[43])
+	a[39], a[42] = max(a[39], a[42]), min(a[39], a[42])
+	a[40], a[44] = max(a[40], a[44]), min(a[40], a[44])
+	a[41], a[44] = max(a[41], a[44]), min(a[41], a[44])
+	a[40], a[42] = max(a[40], a[42]), min(a[40], a[42])
+	a[41], a[43] = max(a[41], a[43]), min(a[41], a[43])
+	a[41], a[42] = max(a[41], a[42]), min(a[41], a[42])
+	a[24], a[37] = max(a[24], a[37]), min(a[24], a[37])
+	a[24], a[36] = max(a[24], a[36]), min(a[24], a[36])
+	a[25], a[38] = max(a[25], a[38]), min(a[25], a[38])
+	a[26], a[38] = max(a[26], a[38]), min(a[26], a[38])
+	a[25], a[36] = max(a[25], a[36]), min(a[25], a[36])
+	a[26], a[37] = max(a[26], a[37]), min(a[26], a[37])
+	a[26], a[36] = max(a[26], a[36]), min(a[26], a[36])
+	a[27], a[40] = max(a[27], a[40]), min(a[27], a[40])
+	a[27], a[39] = max(a[27], a[39]), min(a[27], a[39])
+	a[28], a[41] = max(a[28], a[41]), min(a[28], a[41])
+	a[29], a[41] = max(a[29], a[41]), min(a[29], a[41])
+	a[28], a[39] = max(a[28], a[39]), min(a[28], a[39])
+	a[29], a[40] = max(a[29], a[40]), min(a[29], a[40])
+	a[29], a[39] = max(a[29], a[39]), min(a[29], a[39])
+	a[27], a[37] = max(a[27], a[37]), min(a[27], a[37])
+	a[27], a[36] = max(a[27], a[36]), min(a[27], a[36])
+	a[28], a[38] = max(a[28], a[38]), min(a[28], a[38])
+	a[29], a[38] = max(a[29], a[38]), min(a[29], a[38])
+	a[28], a[36] = max(a[28], a[36]), min(a[28], a[36])
+	a[29], a[37] = max(a[29], a[37]), min(a[29], a[37])
+	a[29], a[36] = max(a[29], a[36]), min(a[29], a[36])
+	a[30], a[43] = max(a[30], a[43]), min(a[30], a[43])
+	a[30], a[42] = max(a[30], a[42]), min(a[30], a[42])
+	a[31], a[44] = max(a[31], a[44]), min(a[31], a[44])
+	a[32], a[44] = max(a[32], a[44]), min(a[32], a[44])
+	a[31], a[42] = max(a[31], a[42]), min(a[31], a[42])
+	a[32], a[43] = max(a[32], a[43]), min(a[32], a[43])
+	a[32], a[42] = max(a[32], a[42]), min(a[32], a[42])
+	a[33], a[46] = max(a[33], a[46]), min(a[33], a[46])
+	a[33], a[45] = max(a[33], a[45]), min(a[33], a[45])
+	a[34], a[47] = max(a[34], a[47]), min(a[34], a[47])
+	a[35], a[47] = max(a[35], a[47]), min(a[35], a[47])
+	a[34], a[45] = max(a[34], a[45]), min(a[34], a[45])
+	a[35], a[46] = max(a[35], a[46]), min(a[35], a[46])
+	a[35], a[45] = max(a[35], a[45]), min(a[35], a[45])
+	a[33], a[43] = max(a[33], a[43]), min(a[33], a[43])
+	a[33], a[42] = max(a[33], a[42]), min(a[33], a[42])
+	a[34], a[44] = max(a[34], a[44]), min(a[34], a[44])
+	a[35], a[44] = max(a[35], a[44]), min(a[35], a[44])
+	a[34], a[42] = max(a[34], a[42]), min(a[34], a[42])
+	a[35], a[43] = max(a[35], a[43]), min(a[35], a[43])
+	a[35], a[42] = max(a[35], a[42]), min(a[35], a[42])
+	a[30], a[37] = max(a[30], a[37]), min(a[30], a[37])
+	a[30], a[36] = max(a[30], a[36]), min(a[30], a[36])
+	a[31], a[38] = max(a[31], a[38]), min(a[31], a[38])
+	a[32], a[38] = max(a[32], a[38]), min(a[32], a[38])
+	a[31], a[36] = max(a[31], a[36]), min(a[31], a[36])
+	a[32], a[37] = max(a[32], a[37]), min(a[32], a[37])
+	a[32], a[36] = max(a[32], a[36]), min(a[32], a[36])
+	a[33], a[40] = max(a[33], a[40]), min(a[33], a[40])
+	a[33], a[39] = max(a[33], a[39]), min(a[33], a[39])
+	a[34], a[41] = max(a[34], a[41]), min(a[34], a[41])
+	a[35], a[41] = max(a[35], a[41]), min(a[35], a[41])
+	a[34], a[39] = max(a[34], a[39]), min(a[34], a[39])
+	a[35], a[40] = max(a[35], a[40]), min(a[35], a[40])
+	a[35], a[39] = max(a[35], a[39]), min(a[35], a[39])
+	a[33], a[37] = max(a[33], a[37]), min(a[33], a[37])
+	a[33], a[36] = max(a[33], a[36]), min(a[33], a[36])
+	a[34], a[38] = max(a[34], a[38]), min(a[34], a[38])
+	a[35], a[38] = max(a[35], a[38]), min(a[35], a[38])
+	a[34], a[36] = max(a[34], a[36]), min(a[34], a[36])
+	a[35], a[37] = max(a[35], a[37]), min(a[35], a[37])
+	a[35], a[36] = max(a[35], a[36]), min(a[35], a[36])
+	a[0], a[25] = max(a[0], a[25]), min(a[0], a[25])
+	a[0], a[24] = max(a[0], a[24]), min(a[0], a[24])
+	a[1], a[26] = max(a[1], a[26]), min(a[1], a[26])
+	a[2], a[26] = max(a[2], a[26]), min(a[2], a[26])
+	a[1], a[24] = max(a[1], a[24]), min(a[1], a[24])
+	a[2], a[25] = max(a[2], a[25]), min(a[2], a[25])
+	a[2], a[24] = max(a[2], a[24]), min(a[2], a[24])
+	a[3], a[28] = max(a[3], a[28]), min(a[3], a[28])
+	a[3], a[27] = max(a[3], a[27]), min(a[3], a[27])
+	a[4], a[29] = max(a[4], a[29]), min(a[4], a[29])
+	a[5], a[29] = max(a[5], a[29]), min(a[5], a[29])
+	a[4], a[27] = max(a[4], a[27]), min(a[4], a[27])
+	a[5], a[28] = max(a[5], a[28]), min(a[5], a[28])
+	a[5], a[27] = max(a[5], a[27]), min(a[5], a[27])
+	a[3], a[25] = max(a[3], a[25]), min(a[3], a[25])
+	a[3], a[24] = max(a[3], a[24]), min(a[3], a[24])
+	a[4], a[26] = max(a[4], a[26]), min(a[4], a[26])
+	a[5], a[26] = max(a[5], a[26]), min(a[5], a[26])
+	a[4], a[24] = max(a[4], a[24]), min(a[4], a[24])
+	a[5], a[25] = max(a[5], a[25]), min(a[5], a[25])
+	a[5], a[24] = max(a[5], a[24]), min(a[5], a[24])
+	a[6], a[31] = max(a[6], a[31]), min(a[6], a[31])
+	a[6], a[30] = max(a[6], a[30]), min(a[6], a[30])
+	a[7], a[32] = max(a[7], a[32]), min(a[7], a[32])
+	a[8], a[32] = max(a[8], a[32]), min(a[8], a[32])
+	a[7], a[30] = max(a[7], a[30]), min(a[7], a[30])
+	a[8], a[31] = max(a[8], a[31]), min(a[8], a[31])
+	a[8], a[30] = max(a[8], a[30]), min(a[8], a[30])
+	a[9], a[34] = max(a[9], a[34]), min(a[9], a[34])
+	a[9], a[33] = max(a[9], a[33]), min(a[9], a[33])
+	a[10], a[35] = max(a[10], a[35]), min(a[10], a[35])
+	a[11], a[35] = max(a[11], a[35]), min(a[11], a[35])
+	a[10], a[33] = max(a[10], a[33]), min(a[10], a[33])
+	a[11], a[34] = max(a[11], a[34]), min(a[11], a[34])
+	a[11], a[33] = max(a[11], a[33]), min(a[11], a[33])
+	a[9], a[31] = max(a[9], a[31]), min(a[9], a[31])
+	a[9], a[30] = max(a[9], a[30]), min(a[9], a[30])
+	a[10], a[32] = max(a[10], a[32]), min(a[10], a[32])
+	a[11], a[32] = max(a[11], a[32]), min(a[11], a[32])
+	a[10], a[30] = max(a[10], a[30]), min(a[10], a[30])
+	a[11], a[31] = max(a[11], a[31]), min(a[11], a[31])
+	a[11], a[30] = max(a[11], a[30]), min(a[11], a[30])
+	a[6], a[25] = max(a[6], a[25]), min(a[6], a[25])
+	a[6], a[24] = max(a[6], a[24]), min(a[6], a[24])
+	a[7], a[26] = max(a[7], a[26]), min(a[7], a[26])
+	a[8], a[26] = max(a[8], a[26]), min(a[8], a[26])
+	a[7], a[24] = max(a[7], a[24]), min(a[7], a[24])
+	a[8], a[25] = max(a[8], a[25]), min(a[8], a[25])
+	a[8], a[24] = max(a[8], a[24]), min(a[8], a[24])
+	a[9], a[28] = max(a[9], a[28]), min(a[9], a[28])
+	a[9], a[27] = max(a[9], a[27]), min(a[9], a[27])
+	a[10], a[29] = max(a[10], a[29]), min(a[10], a[29])
+	a[11], a[29] = max(a[11], a[29]), min(a[11], a[29])
+	a[10], a[27] = max(a[10], a[27]), min(a[10], a[27])
+	a[11], a[28] = max(a[11], a[28]), min(a[11], a[28])
+	a[11], a[27] = max(a[11], a[27]), min(a[11], a[27])
+	a[9], a[25] = max(a[9], a[25]), min(a[9], a[25])
+	a[9], a[24] = max(a[9], a[24]), min(a[9], a[24])
+	a[10], a[26] = max(a[10], a[26]), min(a[10], a[26])
+	a[11], a[26] = max(a[11], a[26]), min(a[11], a[26])
+	a[10], a[24] = max(a[10], a[24]), min(a[10], a[24])
+	a[11], a[25] = max(a[11], a[25]), min(a[11], a[25])
+	a[11], a[24] = max(a[11], a[24]), min(a[11], a[24])
+	a[12], a[37] = max(a[12], a[37]), min(a[12], a[37])
+	a[12], a[36] = max(a[12], a[36]), min(a[12], a[36])
+	a[13], a[38] = max(a[13], a[38]), min(a[13], a[38])
+	a[14], a[38] = max(a[14], a[38]), min(a[14], a[38])
+	a[13], a[36] = max(a[13], a[36]), min(a[13], a[36])
+	a[14], a[37] = max(a[14], a[37]), min(a[14], a[37])
+	a[14], a[36] = max(a[14], a[36]), min(a[14], a[36])
+	a[15], a[40] = max(a[15], a[40]), min(a[15], a[40])
+	a[15], a[39] = max(a[15], a[39]), min(a[15], a[39])
+	a[16], a[41] = max(a[16], a[41]), min(a[16], a[41])
+	a[17], a[41] = max(a[17], a[41]), min(a[17], a[41])
+	a[16], a[39] = max(a[16], a[39]), min(a[16], a[39])
+	a[17], a[40] = max(a[17], a[40]), min(a[17], a[40])
+	a[17], a[39] = max(a[17], a[39]), min(a[17], a[39])
+	a[15], a[37] = max(a[15], a[37]), min(a[15], a[37])
+	a[15], a[36] = max(a[15], a[36]), min(a[15], a[36])
+	a[16], a[38] = max(a[16], a[38]), min(a[16], a[38])
+	a[17], a[38] = max(a[17], a[38]), min(a[17], a[38])
+	a[16], a[36] = max(a[16], a[36]), min(a[16], a[36])
+	a[17], a[37] = max(a[17], a[37]), min(a[17], a[37])
+	a[17], a[36] = max(a[17], a[36]), min(a[17], a[36])
+	a[18], a[43] = max(a[18], a[43]), min(a[18], a[43])
+	a[18], a[42] = max(a[18], a[42]), min(a[18], a[42])
+	a[19], a[44] = max(a[19], a[44]), min(a[19], a[44])
+	a[20], a[44] = max(a[20], a[44]), min(a[20], a[44])
+	a[19], a[42] = max(a[19], a[42]), min(a[19], a[42])
+	a[20], a[43] = max(a[20], a[43]), min(a[20], a[43])
+	a[20], a[42] = max(a[20], a[42]), min(a[20], a[42])
+	a[21], a[46] = max(a[21], a[46]), min(a[21], a[46])
+	a[21], a[45] = max(a[21], a[45]), min(a[21], a[45])
+	a[22], a[47] = max(a[22], a[47]), min(a[22], a[47])
+	a[23], a[47] = max(a[23], a[47]), min(a[23], a[47])
+	a[22], a[45] = max(a[22], a[45]), min(a[22], a[45])
+	a[23], a[46] = max(a[23], a[46]), min(a[23], a[46])
+	a[23], a[45] = max(a[23], a[45]), min(a[23], a[45])
+	a[21], a[43] = max(a[21], a[43]), min(a[21], a[43])
+	a[21], a[42] = max(a[21], a[42]), min(a[21], a[42])
+	a[22], a[44] = max(a[22], a[44]), min(a[22], a[44])
+	a[23], a[44] = max(a[23], a[44]), min(a[23], a[44])
+	a[22], a[42] = max(a[22], a[42]), min(a[22], a[42])
+	a[23], a[43] = max(a[23], a[43]), min(a[23], a[43])
+	a[23], a[42] = max(a[23], a[42]), min(a[23], a[42])
+	a[18], a[37] = max(a[18], a[37]), min(a[18], a[37])
+	a[18], a[36] = max(a[18], a[36]), min(a[18], a[36])
+	a[19], a[38] = max(a[19], a[38]), min(a[19], a[38])
+	a[20], a[38] = max(a[20], a[38]), min(a[20], a[38])
+	a[19], a[36] = max(a[19], a[36]), min(a[19], a[36])
+	a[20], a[37] = max(a[20], a[37]), min(a[20], a[37])
+	a[20], a[36] = max(a[20], a[36]), min(a[20], a[36])
+	a[21], a[40] = max(a[21], a[40]), min(a[21], a[40])
+	a[21], a[39] = max(a[21], a[39]), min(a[21], a[39])
+	a[22], a[41] = max(a[22], a[41]), min(a[22], a[41])
+	a[23], a[41] = max(a[23], a[41]), min(a[23], a[41])
+	a[22], a[39] = max(a[22], a[39]), min(a[22], a[39])
+	a[23], a[40] = max(a[23], a[40]), min(a[23], a[40])
+	a[23], a[39] = max(a[23], a[39]), min(a[23], a[39])
+	a[21], a[37] = max(a[21], a[37]), min(a[21], a[37])
+	a[21], a[36] = max(a[21], a[36]), min(a[21], a[36])
+	a[22], a[38] = max(a[22], a[38]), min(a[22], a[38])
+	a[23], a[38] = max(a[23], a[38]), min(a[23], a[38])
+	a[22], a[36] = max(a[22], a[36]), min(a[22], a[36])
+	a[23], a[37] = max(a[23], a[37]), min(a[23], a[37])
+	a[23], a[36] = max(a[23], a[36]), min(a[23], a[36])
+	a[12], a[25] = max(a[12], a[25]), min(a[12], a[25])
+	a[12], a[24] = max(a[12], a[24]), min(a[12], a[24])
+	a[13], a[26] = max(a[13], a[26]), min(a[13], a[26])
+	a[14], a[26] = max(a[14], a[26]), min(a[14], a[26])
+	a[13], a[24] = max(a[13], a[24]), min(a[13], a[24])
+	a[14], a[25] = max(a[14], a[25]), min(a[14], a[25])
+	a[14], a[24] = max(a[14], a[24]), min(a[14], a[24])
+	a[15], a[28] = max(a[15], a[28]), min(a[15], a[28])
+	a[15], a[27] = max(a[15], a[27]), min(a[15], a[27])
+	a[16], a[29] = max(a[16], a[29]), min(a[16], a[29])
+	a[17], a[29] = max(a[17], a[29]), min(a[17], a[29])
+	a[16], a[27] = max(a[16], a[27]), min(a[16], a[27])
+	a[17], a[28] = max(a[17], a[28]), min(a[17], a[28])
+	a[17], a[27] = max(a[17], a[27]), min(a[17], a[27])
+	a[15], a[25] = max(a[15], a[25]), min(a[15], a[25])
+	a[15], a[24] = max(a[15], a[24]), min(a[15], a[24])
+	a[16], a[26] = max(a[16], a[26]), min(a[16], a[26])
+	a[17], a[26] = max(a[17], a[26]), min(a[17], a[26])
+	a[16], a[24] = max(a[16], a[24]), min(a[16], a[24])
+	a[17], a[25] = max(a[17], a[25]), min(a[17], a[25])
+	a[17], a[24] = max(a[17], a[24]), min(a[17], a[24])
+	a[18], a[31] = max(a[18], a[31]), min(a[18], a[31])
+	a[18], a[30] = max(a[18], a[30]), min(a[18], a[30])
+	a[19], a[32] = max(a[19], a[32]), min(a[19], a[32])
+	a[20], a[32] = max(a[20], a[32]), min(a[20], a[32])
+	a[19], a[30] = max(a[19], a[30]), min(a[19], a[30])
+	a[20], a[31] = max(a[20], a[31]), min(a[20], a[31])
+	a[20], a[30] = max(a[20], a[30]), min(a[20], a[30])
+	a[21], a[34] = max(a[21], a[34]), min(a[21], a[34])
+	a[21], a[33] = max(a[21], a[33]), min(a[21], a[33])
+	a[22], a[35] = max(a[22], a[35]), min(a[22], a[35])
+	a[23], a[35] = max(a[23], a[35]), min(a[23], a[35])
+	a[22], a[33] = max(a[22], a[33]), min(a[22], a[33])
+	a[23], a[34] = max(a[23], a[34]), min(a[23], a[34])
+	a[23], a[33] = max(a[23], a[33]), min(a[23], a[33])
+	a[21], a[31] = max(a[21], a[31]), min(a[21], a[31])
+	a[21], a[30] = max(a[21], a[30]), min(a[21], a[30])
+	a[22], a[32] = max(a[22], a[32]), min(a[22], a[32])
+	a[23], a[32] = max(a[23], a[32]), min(a[23], a[32])
+	a[22], a[30] = max(a[22], a[30]), min(a[22], a[30])
+	a[23], a[31] = max(a[23], a[31]), min(a[23], a[31])
+	a[23], a[30] = max(a[23], a[30]), min(a[23], a[30])
+	a[18], a[25] = max(a[18], a[25]), min(a[18], a[25])
+	a[18], a[24] = max(a[18], a[24]), min(a[18], a[24])
+	a[19], a[26] = max(a[19], a[26]), min(a[19], a[26])
+	a[20], a[26] = max(a[20], a[26]), min(a[20], a[26])
+	a[19], a[24] = max(a[19], a[24]), min(a[19], a[24])
+	a[20], a[25] = max(a[20], a[25]), min(a[20], a[25])
+	a[20], a[24] = max(a[20], a[24]), min(a[20], a[24])
+	a[21], a[28] = max(a[21], a[28]), min(a[21], a[28])
+	a[21], a[27] = max(a[21], a[27]), min(a[21], a[27])
+	a[22], a[29] = max(a[22], a[29]), min(a[22], a[29])
+	a[23], a[29] = max(a[23], a[29]), min(a[23], a[29])
+	a[22], a[27] = max(a[22], a[27]), min(a[22], a[27])
+	a[23], a[28] = max(a[23], a[28]), min(a[23], a[28])
+	a[23], a[27] = max(a[23], a[27]), min(a[23], a[27])
+	a[21], a[25] = max(a[21], a[25]), min(a[21], a[25])
+	a[21], a[24] = max(a[21], a[24]), min(a[21], a[24])
+	a[22], a[26] = max(a[22], a[26]), min(a[22], a[26])
+	a[23], a[26] = max(a[23], a[26]), min(a[23], a[26])
+	a[22], a[24] = max(a[22], a[24]), min(a[22], a[24])
+	a[23], a[25] = max(a[23], a[25]), min(a[23], a[25])
+	a[23], a[24] = max(a[23], a[24]), min(a[23], a[24])
+}
+
+// NetworkSort64xFloat64 sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort64xFloat64(a []float64) {
+	_ = a[63]
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[5], a[7] = min(a[5], a[7]), max(a[5], a[7])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[1], a[4] = min(a[1], a[4]), max(a[1], a[4])
+	a[2], a[6] = min(a[2], a[6]), max(a[2], a[6])
+	a[3], a[7] = min(a[3], a[7]), max(a[3], a[7])
+	a[3], a[6] = min(a[3], a[6]), max(a[3], a[6])
+	a[2], a[4] = min(a[2], a[4]), max(a[2], a[4])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[10], a[11] = min(a[10], a[11]), max(a[10], a[11])
+	a[8], a[10] = min(a[8], a[10]), max(a[8], a[10])
+	a[9], a[11] = min(a[9], a[11]), max(a[9], a[11])
+	a[9], a[10] = min(a[9], a[10]), max(a[9], a[10])
+	a[12], a[13] = min(a[12], a[13]), max(a[12], a[13])
+	a[14], a[15] = min(a[14], a[15]), max(a[14], a[15])
+	a[12], a[14] = min(a[12], a[14]), max(a[12], a[14])
+	a[13], a[15] = min(a[13], a[15]), max(a[13], a[15])
+	a[13], a[14] = min(a[13], a[14]), max(a[13], a[14])
+	a[8], a[12] = min(a[8], a[12]), max(a[8], a[12])
+	a[9], a[13] = min(a[9], a[13]), max(a[9], a[13])
+	a[9], a[12] = min(a[9], a[12]), max(a[9], a[12])
+	a[10], a[14] = min(a[10], a[14]), max(a[10], a[14])
+	a[11], a[15] = min(a[11], a[15]), max(a[11], a[15])
+	a[11], a[14] = min(a[11], a[14]), max(a[11], a[14])
+	a[10], a[12] = min(a[10], a[12]), max(a[10], a[12])
+	a[11], a[13] = min(a[11], a[13]), max(a[11], a[13])
+	a[11], a[12] = min(a[11], a[12]), max(a[11], a[12])
+	a[0], a[8] = min(a[0], a[8]), max(a[0], a[8])
+	a[1], a[9] = min(a[1], a[9]), max(a[1], a[9])
+	a[1], a[8] = min(a[1], a[8]), max(a[1], a[8])
+	a[2], a[10] = min(a[2], a[10]), max(a[2], a[10])
+	a[3], a[11] = min(a[3], a[11]), max(a[3], a[11])
+	a[3], a[10] = min(a[3], a[10]), max(a[3], a[10])
+	a[2], a[8] = min(a[2], a[8]), max(a[2], a[8])
+	a[3], a[9] = min(a[3], a[9]), max(a[3], a[9])
+	a[3], a[8] = min(a[3], a[8]), max(a[3], a[8])
+	a[4], a[12] = min(a[4], a[12]), max(a[4], a[12])
+	a[5], a[13] = min(a[5], a[13]), max(a[5], a[13])
+	a[5], a[12] = min(a[5], a[12]), max(a[5], a[12])
+	a[6], a[14] = min(a[6], a[14]), max(a[6], a[14])
+	a[7], a[15] = min(a[7], a[15]), max(a[7], a[15])
+	a[7], a[14] = min(a[7], a[14]), max(a[7], a[14])
+	a[6], a[12] = min(a[6], a[12]), max(a[6], a[12])
+	a[7], a[13] = min(a[7], a[13]), max(a[7], a[13])
+	a[7], a[12] = min(a[7], a[12]), max(a[7], a[12])
+	a[4], a[8] = min(a[4], a[8]), max(a[4], a[8])
+	a[5], a[9] = min(a[5], a[9]), max(a[5], a[9])
+	a[5], a[8] = min(a[5], a[8]), max(a[5], a[8])
+	a[6], a[10] = min(a[6], a[10]), max(a[6], a[10])
+	a[7], a[11] = min(a[7], a[11]), max(a[7], a[11])
+	a[7], a[10] = min(a[7], a[10]), max(a[7], a[10])
+	a[6], a[8] = min(a[6], a[8]), max(a[6], a[8])
+	a[7], a[9] = min(a[7], a[9]), max(a[7], a[9])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+	a[16], a[17] = min(a[16], a[17]), max(a[16], a[17])
+	a[18], a[19] = min(a[18], a[19]), max(a[18], a[19])
+	a[16], a[18] = min(a[16], a[18]), max(a[16], a[18])
+	a[17], a[19] = min(a[17], a[19]), max(a[17], a[19])
+	a[17], a[18] = min(a[17], a[18]), max(a[17], a[18])
+	a[20], a[21] = min(a[20], a[21]), max(a[20], a[21])
+	a[22], a[23] = min(a[22], a[23]), max(a[22], a[23])
+	a[20], a[22] = min(a[20], a[22]), max(a[20], a[22])
+	a[21], a[23] = min(a[21], a[23]), max(a[21], a[23])
+	a[21], a[22] = min(a[21], a[22]), max(a[21], a[22])
+	a[16], a[20] = min(a[16], a[20]), max(a[16], a[20])
+	a[17], a[21] = min(a[17], a[21]), max(a[17], a[21])
+	a[17], a[20] = min(a[17], a[20]), max(a[17], a[20])
+	a[18], a[22] = min(a[18], a[22]), max(a[18], a[22])
+	a[19], a[23] = min(a[19], a[23]), max(a[19], a[23])
+	a[19], a[22] = min(a[19], a[22]), max(a[19], a[22])
+	a[18], a[20] = min(a[18], a[20]), max(a[18], a[20])
+	a[19], a[21] = min(a[19], a[21]), max(a[19], a[21])
+	a[19], a[20] = min(a[19], a[20]), max(a[19], a[20])
+	a[24], a[25] = min(a[24], a[25]), max(a[24], a[25])
+	a[26], a[27] = min(a[26], a[27]), max(a[26], a[27])
+	a[24], a[26] = min(a[24], a[26]), max(a[24], a[26])
+	a[25], a[27] = min(a[25], a[27]), max(a[25], a[27])
+	a[25], a[26] = min(a[25], a[26]), max(a[25], a[26])
+	a[28], a[29] = min(a[28], a[29]), max(a[28], a[29])
+	a[30], a[31] = min(a[30], a[31]), max(a[30], a[31])
+	a[28], a[30] = min(a[28], a[30]), max(a[28], a[30])
+	a[29], a[31] = min(a[29], a[31]), max(a[29], a[31])
+	a[29], a[30] = min(a[29], a[30]), max(a[29], a[30])
+	a[24], a[28] = min(a[24], a[28]), max(a[24], a[28])
+	a[25], a[29] = min(a[25], a[29]), max(a[25], a[29])
+	a[25], a[28] = min(a[25], a[28]), max(a[25], a[28])
+	a[26], a[30] = min(a[26], a[30]), max(a[26], a[30])
+	a[27], a[31] = min(a[27], a[31]), max(a[27], a[31])
+	a[27], a[30] = min(a[27], a[30]), max(a[27], a[30])
+	a[26], a[28] = min(a[26], a[28]), max(a[26], a[28])
+	a[27], a[29] = min(a[27], a[29]), max(a[27], a[29])
+	a[27], a[28] = min(a[27], a[28]), max(a[27], a[28])
+	a[16], a[24] = min(a[16], a[24]), max(a[16], a[24])
+	a[17], a[25] = min(a[17], a[25]), max(a[17], a[25])
+	a[17], a[24] = min(a[17], a[24]), max(a[17], a[24])
+	a[18], a[26] = min(a[18], a[26]), max(a[18], a[26])
+	a[19], a[27] = min(a[19], a[27]), max(a[19], a[27])
+	a[19], a[26] = min(a[19], a[26]), max(a[19], a[26])
+	a[18], a[24] = min(a[18], a[24]), max(a[18], a[24])
+	a[19], a[25] = min(a[19], a[25]), max(a[19], a[25])
+	a[19], a[24] = min(a[19], a[24]), max(a[19], a[24])
+	a[20], a[28] = min(a[20], a[28]), max(a[20], a[28])
+	a[21], a[29] = min(a[21], a[29]), max(a[21], a[29])
+	a[21], a[28] = min(a[21], a[28]), max(a[21], a[28])
+	a[22], a[30] = min(a[22], a[30]), max(a[22], a[30])
+	a[23], a[31] = min(a[23], a[31]), max(a[23], a[31])
+	a[23], a[30] = min(a[23], a[30]), max(a[23], a[30])
+	a[22], a[28] = min(a[22], a[28]), max(a[22], a[28])
+	a[23], a[29] = min(a[23], a[29]), max(a[23], a[29])
+	a[23], a[28] = min(a[23], a[28]), max(a[23], a[28])
+	a[20], a[24] = min(a[20], a[24]), max(a[20], a[24])
+	a[21], a[25] = min(a[21], a[25]), max(a[21], a[25])
+	a[21], a[24] = min(a[21], a[24]), max(a[21], a[24])
+	a[22], a[26] = min(a[22], a[26]), max(a[22], a[26])
+	a[23], a[27] = min(a[23], a[27]), max(a[23], a[27])
+	a[23], a[26] = min(a[23], a[26]), max(a[23], a[26])
+	a[22], a[24] = min(a[22], a[24]), max(a[22], a[24])
+	a[23], a[25] = min(a[23], a[25]), max(a[23], a[25])
+	a[23], a[24] = min(a[23], a[24]), max(a[23], a[24])
+	a[0], a[16] = min(a[0], a[16]), max(a[0], a[16])
+	a[1], a[17] = min(a[1], a[17]), max(a[1], a[17])
+	a[1], a[16] = min(a[1], a[16]), max(a[1], a[16])
+	a[2], a[18] = min(a[2], a[18]), max(a[2], a[18])
+	a[3], a[19] = min(a[3], a[19]), max(a[3], a[19])
+	a[3], a[18] = min(a[3], a[18]), max(a[3], a[18])
+	a[2], a[16] = min(a[2], a[16]), max(a[2], a[16])
+	a[3], a[17] = min(a[3], a[17]), max(a[3], a[17])
+	a[3], a[16] = min(a[3], a[16]), max(a[3], a[16])
+	a[4], a[20] = min(a[4], a[20]), max(a[4], a[20])
+	a[5], a[21] = min(a[5], a[21]), max(a[5], a[21])
+	a[5], a[20] = min(a[5], a[20]), max(a[5], a[20])
+	a[6], a[22] = min(a[6], a[22]), max(a[6], a[22])
+	a[7], a[23] = min(a[7], a[23]), max(a[7], a[23])
+	a[7], a[22] = min(a[7], a[22]), max(a[7], a[22])
+	a[6], a[20] = min(a[6], a[20]), max(a[6], a[20])
+	a[7], a[21] = min(a[7], a[21]), max(a[7], a[21])
+	a[7], a[20] = min(a[7], a[20]), max(a[7], a[20])
+	a[4], a[16] = min(a[4], a[16]), max(a[4], a[16])
+	a[5], a[17] = min(a[5], a[17]), max(a[5], a[17])
+	a[5], a[16] = min(a[5], a[16]), max(a[5], a[16])
+	a[6], a[18] = min(a[6], a[18]), max(a[6], a[18])
+	a[7], a[19] = min(a[7], a[19]), max(a[7], a[19])
+	a[7], a[18] = min(a[7], a[18]), max(a[7], a[18])
+	a[6], a[16] = min(a[6], a[16]), max(a[6], a[16])
+	a[7], a[17] = min(a[7], a[17]), max(a[7], a[17])
+	a[7], a[16] = min(a[7], a[16]), max(a[7], a[16])
+	a[8], a[24] = min(a[8], a[24]), max(a[8], a[24])
+	a[9], a[25] = min(a[9], a[25]), max(a[9], a[25])
+	a[9], a[24] = min(a[9], a[24]), max(a[9], a[24])
+	a[10], a[26] = min(a[10], a[26]), max(a[10], a[26])
+	a[11], a[27] = min(a[11], a[27]), max(a[11], a[27])
+	a[11], a[26] = min(a[11], a[26]), max(a[11], a[26])
+	a[10], a[24] = min(a[10], a[24]), max(a[10], a[24])
+	a[11], a[25] = min(a[11], a[25]), max(a[11], a[25])
+	a[11], a[24] = min(a[11], a[24]), max(a[11], a[24])
+	a[12], a[28] = min(a[12], a[28]), max(a[12], a[28])
+	a[13], a[29] = min(a[13], a[29]), max(a[13], a[29])
+	a[13], a[28] = min(a[13], a[28]), max(a[13], a[28])
+	a[14], a[30] = min(a[14], a[30]), max(a[14], a[30])
+	a[15], a[31] = min(a[15], a[31]), max(a[15], a[31])
+	a[15], a[30] = min(a[15], a[30]), max(a[15], a[30])
+	a[14], a[28] = min(a[14], a[28]), max(a[14], a[28])
+	a[15], a[29] = min(a[15], a[29]), max(a[15], a[29])
+	a[15], a[28] = min(a[15], a[28]), max(a[15], a[28])
+	a[12], a[24] = min(a[12], a[24]), max(a[12], a[24])
+	a[13], a[25] = min(a[13], a[25]), max(a[13], a[25])
+	a[13], a[24] = min(a[13], a[24]), max(a[13], a[24])
+	a[14], a[26] = min(a[14], a[26]), max(a[14], a[26])
+	a[15], a[27] = min(a[15], a[27]), max(a[15], a[27])
+	a[15], a[26] = min(a[15], a[26]), max(a[15], a[26])
+	a[14], a[24] = min(a[14], a[24]), max(a[14], a[24])
+	a[15], a[25] = min(a[15], a[25]), max(a[15], a[25])
+	a[15], a[24] = min(a[15], a[24]), max(a[15], a[24])
+	a[8], a[16] = min(a[8], a[16]), max(a[8], a[16])
+	a[9], a[17] = min(a[9], a[17]), max(a[9], a[17])
+	a[9], a[16] = min(a[9], a[16]), max(a[9], a[16])
+	a[10], a[18] = min(a[10], a[18]), max(a[10], a[18])
+	a[11], a[19] = min(a[11], a[19]), max(a[11], a[19])
+	a[11], a[18] = min(a[11], a[18]), max(a[11], a[18])
+	a[10], a[16] = min(a[10], a[16]), max(a[10], a[16])
+	a[11], a[17] = min(a[11], a[17]), max(a[11], a[17])
+	a[11], a[16] = min(a[11], a[16]), max(a[11], a[16])
+	a[12], a[20] = min(a[12], a[20]), max(a[12], a[20])
+	a[13], a[21] = min(a[13], a[21]), max(a[13], a[21])
+	a[13], a[20] = min(a[13], a[20]), max(a[13], a[20])
+	a[14], a[22] = min(a[14], a[22]), max(a[14], a[22])
+	a[15], a[23] = min(a[15], a[23]), max(a[15], a[23])
+	a[15], a[22] = min(a[15], a[22]), max(a[15], a[22])
+	a[14], a[20] = min(a[14], a[20]), max(a[14], a[20])
+	a[15], a[21] = min(a[15], a[21]), max(a[15], a[21])
+	a[15], a[20] = min(a[15], a[20]), max(a[15], a[20])
+	a[12], a[16] = min(a[12], a[16]), max(a[12], a[16])
+	a[13], a[17] = min(a[13], a[17]), max(a[13], a[17])
+	a[13], a[16] = min(a[13], a[16]), max(a[13], a[16])
+	a[14], a[18] = min(a[14], a[18]), max(a[14], a[18])
+	a[15], a[19] = min(a[15], a[19]), max(a[15], a[19])
+	a[15], a[18] = min(a[15], a[18]), max(a[15], a[18])
+	a[14], a[16] = min(a[14], a[16]), max(a[14], a[16])
+	a[15], a[17] = min(a[15], a[17]), max(a[15], a[17])
+	a[15], a[16] = min(a[15], a[16]), max(a[15], a[16])
+	a[32], a[33] = min(a[32], a[33]), max(a[32], a[33])
+	a[34], a[35] = min(a[34], a[35]), max(a[34], a[35])
+	a[32], a[34] = min(a[32], a[34]), max(a[32], a[34])
+	a[33], a[35] = min(a[33], a[35]), max(a[33], a[35])
+	a[33], a[34] = min(a[33], a[34]), max(a[33], a[34])
+	a[36], a[37] = min(a[36], a[37]), max(a[36], a[37])
+	a[38], a[39] = min(a[38], a[39]), max(a[38], a[39])
+	a[36], a[38] = min(a[36], a[38]), max(a[36], a[38])
+	a[37], a[39] = min(a[37], a[39]), max(a[37], a[39])
+	a[37], a[38] = min(a[37], a[38]), max(a[37], a[38])
+	a[32], a[36] = min(a[32], a[36]), max(a[32], a[36])
+	a[33], a[37] = min(a[33], a[37]), max(a[33], a[37])
+	a[33], a[36] = min(a[33], a[36]), max(a[33], a[36])
+	a[34], a[38] = min(a[34], a[38]), max(a[34], a[38])
+	a[35], a[39] = min(a[35], a[39]), max(a[35], a[39])
+	a[35], a[38] = min(a[35], a[38]), max(a[35], a[38])
+	a[34], a[36] = min(a[34], a[36]), max(a[34], a[36])
+	a[35], a[37] = min(a[35], a[37]), max(a[35], a[37])
+	a[35], a[36] = min(a[35], a[36]), max(a[35], a[36])
+	a[40], a[41] = min(a[40], a[41]), max(a[40], a[41])
+	a[42], a[43] = min(a[42], a[43]), max(a[42], a[43])
+	a[40], a[42] = min(a[40], a[42]), max(a[40], a[42])
+	a[41], a[43] = min(a[41], a[43]), max(a[41], a[43])
+	a[41], a[42] = min(a[41], a[42]), max(a[41], a[42])
+	a[44], a[45] = min(a[44], a[45]), max(a[44], a[45])
+	a[46], a[47] = min(a[46], a[47]), max(a[46], a[47])
+	a[44], a[46] = min(a[44], a[46]), max(a[44], a[46])
+	a[45], a[47] = min(a[45], a[47]), max(a[45], a[47])
+	a[45], a[46] = min(a[45], a[46]), max(a[45], a[46])
+	a[40], a[44] = min(a[40], a[44]), max(a[40], a[44])
+	a[41], a[45] = min(a[41], a[45]), max(a[41], a[45])
+	a[41], a[44] = min(a[41], a[44]), max(a[41], a[44])
+	a[42], a[46] = min(a[42], a[46]), max(a[42], a[46])
+	a[43], a[47] = min(a[43], a[47]), max(a[43], a[47])
+	a[43], a[46] = min(a[43], a[46]), max(a[43], a[46])
+	a[42], a[44] = min(a[42], a[44]), max(a[42], a[44])
+	a[43], a[45] = min(a[43], a[45]), max(a[43], a[45])
+	a[43], a[44] = min(a[43], a[44]), max(a[43], a[44])
+	a[32], a[40] = min(a[32], a[40]), max(a[32], a[40])
+	a[33], a[41] = min(a[33], a[41]), max(a[33], a[41])
+	a[33], a[40] = min(a[33], a[40]), max(a[33], a[40])
+	a[34], a[42] = min(a[34], a[42]), max(a[34], a[42])
+	a[35], a[43] = min(a[35], a[43]), max(a[35], a[43])
+	a[35], a[42] = min(a[35], a[42]), max(a[35], a[42])
+	a[34], a[40] = min(a[34], a[40]), max(a[34], a[40])
+	a[35], a[41] = min(a[35], a[41]), max(a[35], a[41])
+	a[35], a[40] = min(a[35], a[40]), max(a[35], a[40])
+	a[36], a[44] = min(a[36], a[44]), max(a[36], a[44])
+	a[37], a[45] = min(a[37], a[45]), max(a[37], a[45])
+	a[37], a[44] = min(a[37], a[44]), max(a[37], a[44])
+	a[38], a[46] = min(a[38], a[46]), max(a[38], a[46])
+	a[39], a[47] = min(a[39], a[47]), max(a[39], a[47])
+	a[39], a[46] = min(a[39], a[46]), max(a[39], a[46])
+	a[38], a[44] = min(a[38], a[44]), max(a[38], a[44])
+	a[39], a[45] = min(a[39], a[45]), max(a[39], a[45])
+	a[39], a[44] = min(a[39], a[44]), max(a[39], a[44])
+	a[36], a[40] = min(a[36], a[40]), max(a[36], a[40])
+	a[37], a[41] = min(a[37], a[41]), max(a[37], a[41])
+	a[37], a[40] = min(a[37], a[40]), max(a[37], a[40])
+	a[38], a[42] = min(a[38], a[42]), max(a[38], a[42])
+	a[39], a[43] = min(a[39], a[43]), max(a[39], a[43])
+	a[39], a[42] = min(a[39], a[42]), max(a[39], a[42])
+	a[38], a[40] = min(a[38], a[40]), max(a[38], a[40])
+	a[39], a[41] = min(a[39], a[41]), max(a[39], a[41])
+	a[39], a[40] = min(a[39], a[40]), max(a[39], a[40])
+	a[48], a[49] = min(a[48], a[49]), max(a[48], a[49])
+	a[50], a[51] = min(a[50], a[51]), max(a[50], a[51])
+	a[48], a[50] = min(a[48], a[50]), max(a[48], a[50])
+	a[49], a[51] = min(a[49], a[51]), max(a[49], a[51])
+	a[49], a[50] = min(a[49], a[50]), max(a[49], a[50])
+	a[52], a[53] = min(a[52], a[53]), max(a[52], a[53])
+	a[54], a[55] = min(a[54], a[55]), max(a[54], a[55])
+	a[52], a[54] = min(a[52], a[54]), max(a[52], a[54])
+	a[53], a[55] = min(a[53], a[55]), max(a[53], a[55])
+	a[53], a[54] = min(a[53], a[54]), max(a[53], a[54])
+	a[48], a[52] = min(a[48], a[52]), max(a[48], a[52])
+	a[49], a[53] = min(a[49], a[53]), max(a[49], a[53])
+	a[49], a[52] = min(a[49], a[52]), max(a[49], a[52])
+	a[50], a[54] = min(a[50], a[54]), max(a[50], a[54])
+	a[51], a[55] = min(a[51], a[55]), max(a[51], a[55])
+	a[51], a[54] = min(a[51], a[54]), max(a[51], a[54])
+	a[50], a[52] = min(a[50], a[52]), max(a[50], a[52])
+	a[51], a[53] = min(a[51], a[53]), max(a[51], a[53])
+	a[51], a[52] = min(a[51], a[52]), max(a[51], a[52])
+	a[56], a[57] = min(a[56], a[57]), max(a[56], a[57])
+	a[58], a[59] = min(a[58], a[59]), max(a[58], a[59])
+	a[56], a[58] = min(a[56], a[58]), max(a[56], a[58])
+	a[57], a[59] = min(a[57], a[59]), max(a[57], a[59])
+	a[57], a[58] = min(a[57], a[58]), max(a[57], a[58])
+	a[60], a[61] = min(a[60], a[61]), max(a[60], a[61])
+	a[62], a[63] = min(a[62], a[63]), max(a[62], a[63])
+	a[60], a[62] = min(a[60], a[62]), max(a[60], a[62])
+	a[61], a[63] = min(a[61], a[63]), max(a[61], a[63])
+	a[61], a[62] = min(a[61], a[62]), max(a[61], a[62])
+	a[56], a[60] = min(a[56], a[60]), max(a[56], a[60])
+	a[57], a[61] = min(a[57], a[61]), max(a[57], a[61])
+	a[57], a[60] = min(a[57], a[60]), max(a[57], a[60])
+	a[58], a[62] = min(a[58], a[62]), max(a[58], a[62])
+	a[59], a[63] = min(a[59], a[63]), max(a[59], a[63])
+	a[59], a[62] = min(a[59], a[62]), max(a[59], a[62])
+	a[58], a[60] = min(a[58], a[60]), max(a[58], a[60])
+	a[59], a[61] = min(a[59], a[61]), max(a[59], a[61])
+	a[59], a[60] = min(a[59], a[60]), max(a[59], a[60])
+	a[48], a[56] = min(a[48], a[56]), max(a[48], a[56])
+	a[49], a[57] = min(a[49], a[57]), max(a[49], a[57])
+	a[49], a[56] = min(a[49], a[56]), max(a[49], a[56])
+	a[50], a[58] = min(a[50], a[58]), max(a[50], a[58])
+	a[51], a[59] = min(a[51], a[59]), max(a[51], a[59])
+	a[51], a[58] = min(a[51], a[58]), max(a[51], a[58])
+	a[50], a[56] = min(a[50], a[56]), max(a[50], a[56])
+	a[51], a[57] = min(a[51], a[57]), max(a[51], a[57])
+	a[51], a[56] = min(a[51], a[56]), max(a[51], a[56])
+	a[52], a[60] = min(a[52], a[60]), max(a[52], a[60])
+	a[53], a[61] = min(a[53], a[61]), max(a[53], a[61])
+	a[53], a[60] = min(a[53], a[60]), max(a[53], a[60])
+	a[54], a[62] = min(a[54], a[62]), max(a[54], a[62])
+	a[55], a[63] = min(a[55], a[63]), max(a[55], a[63])
+	a[55], a[62] = min(a[55], a[62]), max(a[55], a[62])
+	a[54], a[60] = min(a[54], a[60]), max(a[54], a[60])
+	a[55], a[61] = min(a[55], a[61]), max(a[55], a[61])
+	a[55], a[60] = min(a[55], a[60]), max(a[55], a[60])
+	a[52], a[56] = min(a[52], a[56]), max(a[52], a[56])
+	a[53], a[57] = min(a[53], a[57]), max(a[53], a[57])
+	a[53], a[56] = min(a[53], a[56]), max(a[53], a[56])
+	a[54], a[58] = min(a[54], a[58]), max(a[54], a[58])
+	a[55], a[59] = min(a[55], a[59]), max(a[55], a[59])
+	a[55], a[58] = min(a[55], a[58]), max(a[55], a[58])
+	a[54], a[56] = min(a[54], a[56]), max(a[54], a[56])
+	a[55], a[57] = min(a[55], a[57]), max(a[55], a[57])
+	a[55], a[56] = min(a[55], a[56]), max(a[55], a[56])
+	a[32], a[48] = min(a[32], a[48]), max(a[32], a[48])
+	a[33], a[49] = min(a[33], a[49]), max(a[33], a[49])
+	a[33], a[48] = min(a[33], a[48]), max(a[33], a[48])
+	a[34], a[50] = min(a[34], a[50]), max(a[34], a[50])
+	a[35], a[51] = min(a[35], a[51]), max(a[35], a[51])
+	a[35], a[50] = min(a[35], a[50]), max(a[35], a[50])
+	a[34], a[48] = min(a[34], a[48]), max(a[34], a[48])
+	a[35], a[49] = min(a[35], a[49]), max(a[35], a[49])
+	a[35], a[48] = min(a[35], a[48]), max(a[35], a[48])
+	a[36], a[52] = min(a[36], a[52]), max(a[36], a[52])
+	a[37], a[53] = min(a[37], a[53]), max(a[37], a[53])
+	a[37], a[52] = min(a[37], a[52]), max(a[37], a[52])
+	a[38], a[54] = min(a[38], a[54]), max(a[38], a[54])
+	a[39], a[55] = min(a[39], a[55]), max(a[39], a[55])
+	a[39], a[54] = min(a[39], a[54]), max(a[39], a[54])
+	a[38], a[52] = min(a[38], a[52]), max(a[38], a[52])
+	a[39], a[53] = min(a[39], a[53]), max(a[39], a[53])
+	a[39], a[52] = min(a[39], a[52]), max(a[39], a[52])
+	a[36], a[48] = min(a[36], a[48]), max(a[36], a[48])
+	a[37], a[49] = min(a[37], a[49]), max(a[37], a[49])
+	a[37], a[48] = min(a[37], a[48]), max(a[37], a[48])
+	a[38], a[50] = min(a[38], a[50]), max(a[38], a[50])
+	a[39], a[51] = min(a[39], a[51]), max(a[39], a[51])
+	a[39], a[50] = min(a[39], a[50]), max(a[39], a[50])
+	a[38], a[48] = min(a[38], a[48]), max(a[38], a[48])
+	a[39], a[49] = min(a[39], a[49]), max(a[39], a[49])
+	a[39], a[48] = min(a[39], a[48]), max(a[39], a[48])
+	a[40], a[56] = min(a[40], a[56]), max(a[40], a[56])
+	a[41], a[57] = min(a[41], a[57]), max(a[41], a[57])
+	a[41], a[56] = min(a[41], a[56]), max(a[41], a[56])
+	a[42], a[58] = min(a[42], a[58]), max(a[42], a[58])
+	a[43], a[59] = min(a[43], a[59]), max(a[43], a[59])
+	a[43], a[58] = min(a[43], a[58]), max(a[43], a[58])
+	a[42], a[56] = min(a[42], a[56]), max(a[42], a[56])
+	a[43], a[57] = min(a[43], a[57]), max(a[43], a[57])
+	a[43], a[56] = min(a[43], a[56]), max(a[43], a[56])
+	a[44], a[60] = min(a[44], a[60]), max(a[44], a[60])
+	a[45], a[61] = min(a[45], a[61]), max(a[45], a[61])
+	a[45], a[60] = min(a[45], a[60]), max(a[45], a[60])
+	a[46], a[62] = min(a[46], a[62]), max(a[46], a[62])
+	a[47], a[63] = min(a[47], a[63]), max(a[47], a[63])
+	a[47], a[62] = min(a[47], a[62]), max(a[47], a[62])
+	a[46], a[60] = min(a[46], a[60]), max(a[46], a[60])
+	a[47], a[61] = min(a[47], a[61]), max(a[47], a[61])
+	a[47], a[60] = min(a[47], a[60]), max(a[47], a[60])
+	a[44], a[56] = min(a[44], a[56]), max(a[44], a[56])
+	a[45], a[57] = min(a[45], a[57]), max(a[45], a[57])
+	a[45], a[56] = min(a[45], a[56]), max(a[45], a[56])
+	a[46], a[58] = min(a[46], a[58]), max(a[46], a[58])
+	a[47], a[59] = min(a[47], a[59]), max(a[47], a[59])
+	a[47], a[58] = min(a[47], a[58]), max(a[47], a[58])
+	a[46], a[56] = min(a[46], a[56]), max(a[46], a[56])
+	a[47], a[57] = min(a[47], a[57]), max(a[47], a[57])
+	a[47], a[56] = min(a[47], a[56]), max(a[47], a[56])
+	a[40], a[48] = min(a[40], a[48]), max(a[40], a[48])
+	a[41], a[49] = min(a[41], a[49]), max(a[41], a[49])
+	a[41], a[48] = min(a[41], a[48]), max(a[41], a[48])
+	a[42], a[50] = min(a[42], a[50]), max(a[42], a[50])
+	a[43], a[51] = min(a[43], a[51]), max(a[43], a[51])
+	a[43], a[50] = min(a[43], a[50]), max(a[43], a[50])
+	a[42], a[48] = min(a[42], a[48]), max(a[42], a[48])
+	a[43], a[49] = min(a[43], a[49]), max(a[43], a[49])
+	a[43], a[48] = min(a[43], a[48]), max(a[43], a[48])
+	a[44], a[52] = min(a[44], a[52]), max(a[44], a[52])
+	a[45], a[53] = min(a[45], a[53]), max(a[45], a[53])
+	a[45], a[52] = min(a[45], a[52]), max(a[45], a[52])
+	a[46], a[54] = min(a[46], a[54]), max(a[46], a[54])
+	a[47], a[55] = min(a[47], a[55]), max(a[47], a[55])
+	a[47], a[54] = min(a[47], a[54]), max(a[47], a[54])
+	a[46], a[52] = min(a[46], a[52]), max(a[46], a[52])
+	a[47], a[53] = min(a[47], a[53]), max(a[47], a[53])
+	a[47], a[52] = min(a[47], a[52]), max(a[47], a[52])
+	a[44], a[48] = min(a[44], a[48]), max(a[44], a[48])
+	a[45], a[49] = min(a[45], a[49]), max(a[45], a[49])
+	a[45], a[48] = min(a[45], a[48]), max(a[45], a[48])
+	a[46], a[50] = min(a[46], a[50]), max(a[46], a[50])
+	a[47], a[51] = min(a[47], a[51]), max(a[47], a[51])
+	a[47], a[50] = min(a[47], a[50]), max(a[47], a[50])
+	a[46], a[48] = min(a[46], a[48]), max(a[46], a[48])
+	a[47], a[49] = min(a[47], a[49]), max(a[47], a[49])
+	a[47], a[48] = min(a[47], a[48]), max(a[47], a[48])
+	a[0], a[32] = min(a[0], a[32]), max(a[0], a[32])
+	a[1], a[33] = min(a[1], a[33]), max(a[1], a[33])
+	a[1], a[32] = min(a[1], a[32]), max(a[1], a[32])
+	a[2], a[34] = min(a[2], a[34]), max(a[2], a[34])
+	a[3], a[35] = min(a[3], a[35]), max(a[3], a[35])
+	a[3], a[34] = min(a[3], a[34]), max(a[3], a[34])
+	a[2], a[32] = min(a[2], a[32]), max(a[2], a[32])
+	a[3], a[33] = min(a[3], a[33]), max(a[3], a[33])
+	a[3], a[32] = min(a[3], a[32]), max(a[3], a[32])
+	a[4], a[36] = min(a[4], a[36]), max(a[4], a[36])
+	a[5], a[37] = min(a[5], a[37]), max(a[5], a[37])
+	a[5], a[36] = min(a[5], a[36]), max(a[5], a[36])
+	a[6], a[38] = min(a[6], a[38]), max(a[6], a[38])
+	a[7], a[39] = min(a[7], a[39]), max(a[7], a[39])
+	a[7], a[38] = min(a[7], a[38]), max(a[7], a[38])
+	a[6], a[36] = min(a[6], a[36]), max(a[6], a[36])
+	a[7], a[37] = min(a[7], a[37]), max(a[7], a[37])
+	a[7], a[36] = min(a[7], a[36]), max(a[7], a[36])
+	a[4], a[32] = min(a[4], a[32]), max(a[4], a[32])
+	a[5], a[33] = min(a[5], a[33]), max(a[5], a[33])
+	a[5], a[32] = min(a[5], a[32]), max(a[5], a[32])
+	a[6], a[34] = min(a[6], a[34]), max(a[6], a[34])
+	a[7], a[35] = min(a[7], a[35]), max(a[7], a[35])
+	a[7], a[34] = min(a[7], a[34]), max(a[7], a[34])
+	a[6], a[32] = min(a[6], a[32]), max(a[6], a[32])
+	a[7], a[33] = min(a[7], a[33]), max(a[7], a[33])
+	a[7], a[32] = min(a[7], a[32]), max(a[7], a[32])
+	a[8], a[40] = min(a[8], a[40]), max(a[8], a[40])
+	a[9], a[41] = min(a[9], a[41]), max(a[9], a[41])
+	a[9], a[40] = min(a[9], a[40]), max(a[9], a[40])
+	a[10], a[42] = min(a[10], a[42]), max(a[10], a[42])
+	a[11], a[43] = min(a[11], a[43]), max(a[11], a[43])
+	a[11], a[42] = min(a[11], a[42]), max(a[11], a[42])
+	a[10], a[40] = min(a[10], a[40]), max(a[10], a[40])
+	a[11], a[41] = min(a[11], a[41]), max(a[11], a[41])
+	a[11], a[40] = min(a[11], a[40]), max(a[11], a[40])
+	a[12], a[44] = min(a[12], a[44]), max(a[12], a[44])
+	a[13], a[45] = min(a[13], a[45]), max(a[13], a[45])
+	a[13], a[44] = min(a[13], a[44]), max(a[13], a[44])
+	a[14], a[46] = min(a[14], a[46]), max(a[14], a[46])
+	a[15], a[47] = min(a[15], a[47]), max(a[15], a[47])
+	a[15], a[46] = min(a[15], a[46]), max(a[15], a[46])
+	a[14], a[44] = min(a[14], a[44]), max(a[14], a[44])
+	a[15], a[45] = min(a[15], a[45]), max(a[15], a[45])
+	a[15], a[44] = min(a[15], a[44]), max(a[15], a[44])
+	a[12], a[40] = min(a[12], a[40]), max(a[12], a[40])
+	a[13], a[41] = min(a[13], a[41]), max(a[13], a[41])
+	a[13], a[40] = min(a[13], a[40]), max(a[13], a[40])
+	a[14], a[42] = min(a[14], a[42]), max(a[14], a[42])
+	a[15], a[43] = min(a[15], a[43]), max(a[15], a[43])
+	a[15], a[42] = min(a[15], a[42]), max(a[15], a[42])
+	a[14], a[40] = min(a[14], a[40]), max(a[14], a[40])
+	a[15], a[41] = min(a[15], a[41]), max(a[15], a[41])
+	a[15], a[40] = min(a[15], a[40]), max(a[15], a[40])
+	a[8], a[32] = min(a[8], a[32]), max(a[8], a[32])
+	a[9], a[33] = min(a[9], a[33]), max(a[9], a[33])
+	a[9], a[32] = min(a[9], a[32]), max(a[9], a[32])
+	a[10], a[34] = min(a[10], a[34]), max(a[10], a[34])
+	a[11], a[35] = min(a[11], a[35]), max(a[11], a[35])
+	a[11], a[34] = min(a[11], a[34]), max(a[11], a[34])
+	a[10], a[32] = min(a[10], a[32]), max(a[10], a[32])
+	a[11], a[33] = min(a[11], a[33]), max(a[11], a[33])
+	a[11], a[32] = min(a[11], a[32]), max(a[11], a[32])
+	a[12], a[36] = min(a[12], a[36]), max(a[12], a[36])
+	a[13], a[37] = min(a[13], a[37]), max(a[13], a[37])
+	a[13], a[36] = min(a[13], a[36]), max(a[13], a[36])
+	a[14], a[38] = min(a[14], a[38]), max(a[14], a[38])
+	a[15], a[39] = min(a[15], a[39]), max(a[15], a[39])
+	a[15], a[38] = min(a[15], a[38]), max(a[15], a[38])
+	a[14], a[36] = min(a[14], a[36]), max(a[14], a[36])
+	a[15], a[37] = min(a[15], a[37]), max(a[15], a[37])
+	a[15], a[36] = min(a[15], a[36]), max(a[15], a[36])
+	a[12], a[32] = min(a[12], a[32]), max(a[12], a[32])
+	a[13], a[33] = min(a[13], a[33]), max(a[13], a[33])
+	a[13], a[32] = min(a[13], a[32]), max(a[13], a[32])
+	a[14], a[34] = min(a[14], a[34]), max(a[14], a[34])
+	a[15], a[35] = min(a[15], a[35]), max(a[15], a[35])
+	a[15], a[34] = min(a[15], a[34]), max(a[15], a[34])
+	a[14], a[32] = min(a[14], a[32]), max(a[14], a[32])
+	a[15], a[33] = min(a[15], a[33]), max(a[15], a[33])
+	a[15], a[32] = min(a[15], a[32]), max(a[15], a[32])
+	a[16], a[48] = min(a[16], a[48]), max(a[16], a[48])
+	a[17], a[49] = min(a[17], a[49]), max(a[17], a[49])
+	a[17], a[48] = min(a[17], a[48]), max(a[17], a[48])
+	a[18], a[50] = min(a[18], a[50]), max(a[18], a[50])
+	a[19], a[51] = min(a[19], a[51]), max(a[19], a[51])
+	a[19], a[50] = min(a[19], a[50]), max(a[19], a[50])
+	a[18], a[48] = min(a[18], a[48]), max(a[18], a[48])
+	a[19], a[49] = min(a[19], a[49]), max(a[19], a[49])
+	a[19], a[48] = min(a[19], a[48]), max(a[19], a[48])
+	a[20], a[52] = min(a[20], a[52]), max(a[20], a[52])
+	a[21], a[53] = min(a[21], a[53]), max(a[21], a[53])
+	a[21], a[52] = min(a[21], a[52]), max(a[21], a[52])
+	a[22], a[54] = min(a[22], a[54]), max(a[22], a[54])
+	a[23], a[55] = min(a[23], a[55]), max(a[23], a[55])
+	a[23], a[54] = min(a[23], a[54]), max(a[23], a[54])
+	a[22], a[52] = min(a[22], a[52]), max(a[22], a[52])
+	a[23], a[53] = min(a[23], a[53]), max(a[23], a[53])
+	a[23], a[52] = min(a[23], a[52]), max(a[23], a[52])
+	a[20], a[48] = min(a[20], a[48]), max(a[20], a[48])
+	a[21], a[49] = min(a[21], a[49]), max(a[21], a[49])
+	a[21], a[48] = min(a[21], a[48]), max(a[21], a[48])
+	a[22], a[50] = min(a[22], a[50]), max(a[22], a[50])
+	a[23], a[51] = min(a[23], a[51]), max(a[23], a[51])
+	a[23], a[50] = min(a[23], a[50]), max(a[23], a[50])
+	a[22], a[48] = min(a[22], a[48]), max(a[22], a[48])
+	a[23], a[49] = min(a[23], a[49]), max(a[23], a[49])
+	a[23], a[48] = min(a[23], a[48]), max(a[23], a[48])
+	a[24], a[56] = min(a[24], a[56]), max(a[24], a[56])
+	a[25], a[57] = min(a[25], a[57]), max(a[25], a[57])
+	a[25], a[56] = min(a[25], a[56]), max(a[25], a[56])
+	a[26], a[58] = min(a[26], a[58]), max(a[26], a[58])
+	a[27], a[59] = min(a[27], a[59]), max(a[27], a[59])
+	a[27], a[58] = min(a[27], a[58]), max(a[27], a[58])
+	a[26], a[56] = min(a[26], a[56]), max(a[26], a[56])
+	a[27], a[57] = min(a[27], a[57]), max(a[27], a[57])
+	a[27], a[56] = min(a[27], a[56]), max(a[27], a[56])
+	a[28], a[60] = min(a[28], a[60]), max(a[28], a[60])
+	a[29], a[61] = min(a[29], a[61]), max(a[29], a[61])
+	a[29], a[60] = min(a[29], a[60]), max(a[29], a[60])
+	a[30], a[62] = min(a[30], a[62]), max(a[30], a[62])
+	a[31], a[63] = min(a[31], a[63]), max(a[31], a[63])
+	a[31], a[62] = min(a[31], a[62]), max(a[31], a[62])
+	a[30], a[60] = min(a[30], a[60]), max(a[30], a[60])
+	a[31], a[61] = min(a[31], a[61]), max(a[31], a[61])
+	a[31], a[60] = min(a[31], a[60]), max(a[31], a[60])
+	a[28], a[56] = min(a[28], a[56]), max(a[28], a[56])
+	a[29], a[57] = min(a[29], a[57]), max(a[29], a[57])
+	a[29], a[56] = min(a[29], a[56]), max(a[29], a[56])
+	a[30], a[58] = min(a[30], a[58]), max(a[30], a[58])
+	a[31], a[59] = min(a[31], a[59]), max(a[31], a[59])
+	a[31], a[58] = min(a[31], a[58]), max(a[31], a[58])
+	a[30], a[56] = min(a[30], a[56]), max(a[30], a[56])
+	a[31], a[57] = min(a[31], a[57]), max(a[31], a[57])
+	a[31], a[56] = min(a[31], a[56]), max(a[31], a[56])
+	a[24], a[48] = min(a[24], a[48]), max(a[24], a[48])
+	a[25], a[49] = min(a[25], a[49]), max(a[25], a[49])
+	a[25], a[48] = min(a[25], a[48]), max(a[25], a[48])
+	a[26], a[50] = min(a[26], a[50]), max(a[26], a[50])
+	a[27], a[51] = min(a[27], a[51]), max(a[27], a[51])
+	a[27], a[50] = min(a[27], a[50]), max(a[27], a[50])
+	a[26], a[48] = min(a[26], a[48]), max(a[26], a[48])
+	a[27], a[49] = min(a[27], a[49]), max(a[27], a[49])
+	a[27], a[48] = min(a[27], a[48]), max(a[27], a[48])
+	a[28], a[52] = min(a[28], a[52]), max(a[28], a[52])
+	a[29], a[53] = min(a[29], a[53]), max(a[29], a[53])
+	a[29], a[52] = min(a[29], a[52]), max(a[29], a[52])
+	a[30], a[54] = min(a[30], a[54]), max(a[30], a[54])
+	a[31], a[55] = min(a[31], a[55]), max(a[31], a[55])
+	a[31], a[54] = min(a[31], a[54]), max(a[31], a[54])
+	a[30], a[52] = min(a[30], a[52]), max(a[30], a[52])
+	a[31], a[53] = min(a[31], a[53]), max(a[31], a[53])
+	a[31], a[52] = min(a[31], a[52]), max(a[31], a[52])
+	a[28], a[48] = min(a[28], a[48]), max(a[28], a[48])
+	a[29], a[49] = min(a[29], a[49]), max(a[29], a[49])
+	a[29], a[48] = min(a[29], a[48]), max(a[29], a[48])
+	a[30], a[50] = min(a[30], a[50]), max(a[30], a[50])
+	a[31], a[51] = min(a[31], a[51]), max(a[31], a[51])
+	a[31], a[50] = min(a[31], a[50]), max(a[31], a[50])
+	a[30], a[48] = min(a[30], a[48]), max(a[30], a[48])
+	a[31], a[49] = min(a[31], a[49]), max(a[31], a[49])
+	a[31], a[48] = min(a[31], a[48]), max(a[31], a[48])
+	a[16], a[32] = min(a[16], a[32]), max(a[16], a[32])
+	a[17], a[33] = min(a[17], a[33]), max(a[17], a[33])
+	a[17], a[32] = min(a[17], a[32]), max(a[17], a[32])
+	a[18], a[34] = min(a[18], a[34]), max(a[18], a[34])
+	a[19], a[35] = min(a[19], a[35]), max(a[19], a[35])
+	a[19], a[34] = min(a[19], a[34]), max(a[19], a[34])
+	a[18], a[32] = min(a[18], a[32]), max(a[18], a[32])
+	a[19], a[33] = min(a[19], a[33]), max(a[19], a[33])
+	a[19], a[32] = min(a[19], a[32]), max(a[19], a[32])
+	a[20], a[36] = min(a[20], a[36]), max(a[20], a[36])
+	a[21], a[37] = min(a[21], a[37]), max(a[21], a[37])
+	a[21], a[36] = min(a[21], a[36]), max(a[21], a[36])
+	a[22], a[38] = min(a[22], a[38]), max(a[22], a[38])
+	a[23], a[39] = min(a[23], a[39]), max(a[23], a[39])
+	a[23], a[38] = min(a[23], a[38]), max(a[23], a[38])
+	a[22], a[36] = min(a[22], a[36]), max(a[22], a[36])
+	a[23], a[37] = min(a[23], a[37]), max(a[23], a[37])
+	a[23], a[36] = min(a[23], a[36]), max(a[23], a[36])
+	a[20], a[32] = min(a[20], a[32]), max(a[20], a[32])
+	a[21], a[33] = min(a[21], a[33]), max(a[21], a[33])
+	a[21], a[32] = min(a[21], a[32]), max(a[21], a[32])
+	a[22], a[34] = min(a[22], a[34]), max(a[22], a[34])
+	a[23], a[35] = min(a[23], a[35]), max(a[23], a[35])
+	a[23], a[34] = min(a[23], a[34]), max(a[23], a[34])
+	a[22], a[32] = min(a[22], a[32]), max(a[22], a[32])
+	a[23], a[33] = min(a[23], a[33]), max(a[23], a[33])
+	a[23], a[32] = min(a[23], a[32]), max(a[23], a[32])
+	a[24], a[40] = min(a[24], a[40]), max(a[24], a[40])
+	a[25], a[41] = min(a[25], a[41]), max(a[25], a[41])
+	a[25], a[40] = min(a[25], a[40]), max(a[25], a[40])
+	a[26], a[42] = min(a[26], a[42]), max(a[26], a[42])
+	a[27], a[43] = min(a[27], a[43]), max(a[27], a[43])
+	a[27], a[42] = min(a[27], a[42]), max(a[27], a[42])
+	a[26], a[40] = min(a[26], a[40]), max(a[26], a[40])
+	a[27], a[41] = min(a[27], a[41]), max(a[27], a[41])
+	a[27], a[40] = min(a[27], a[40]), max(a[27], a[40])
+	a[28], a[44] = min(a[28], a[44]), max(a[28], a[44])
+	a[29], a[45] = min(a[29], a[45]), max(a[29], a[45])
+	a[29], a[44] = min(a[29], a[44]), max(a[29], a[44])
+	a[30], a[46] = min(a[30], a[46]), max(a[30], a[46])
+	a[31], a[47] = min(a[31], a[47]), max(a[31], a[47])
+	a[31], a[46] = min(a[31], a[46]), max(a[31], a[46])
+	a[30], a[44] = min(a[30], a[44]), max(a[30], a[44])
+	a[31], a[45] = min(a[31], a[45]), max(a[31], a[45])
+	a[31], a[44] = min(a[31], a[44]), max(a[31], a[44])
+	a[28], a[40] = min(a[28], a[40]), max(a[28], a[40])
+	a[29], a[41] = min(a[29], a[41]), max(a[29], a[41])
+	a[29], a[40] = min(a[29], a[40]), max(a[29], a[40])
+	a[30], a[42] = min(a[30], a[42]), max(a[30], a[42])
+	a[31], a[43] = min(a[31], a[43]), max(a[31], a[43])
+	a[31], a[42] = min(a[31], a[42]), max(a[31], a[42])
+	a[30], a[40] = min(a[30], a[40]), max(a[30], a[40])
+	a[31], a[41] = min(a[31], a[41]), max(a[31], a[41])
+	a[31], a[40] = min(a[31], a[40]), max(a[31], a[40])
+	a[24], a[32] = min(a[24], a[32]), max(a[24], a[32])
+	a[25], a[33] = min(a[25], a[33]), max(a[25], a[33])
+	a[25], a[32] = min(a[25], a[32]), max(a[25], a[32])
+	a[26], a[34] = min(a[26], a[34]), max(a[26], a[34])
+	a[27], a[35] = min(a[27], a[35]), max(a[27], a[35])
+	a[27], a[34] = min(a[27], a[34]), max(a[27], a[34])
+	a[26], a[32] = min(a[26], a[32]), max(a[26], a[32])
+	a[27], a[33] = min(a[27], a[33]), max(a[27], a[33])
+	a[27], a[32] = min(a[27], a[32]), max(a[27], a[32])
+	a[28], a[36] = min(a[28], a[36]), max(a[28], a[36])
+	a[29], a[37] = min(a[29], a[37]), max(a[29], a[37])
+	a[29], a[36] = min(a[29], a[36]), max(a[29], a[36])
+	a[30], a[38] = min(a[30], a[38]), max(a[30], a[38])
+	a[31], a[39] = min(a[31], a[39]), max(a[31], a[39])
+	a[31], a[38] = min(a[31], a[38]), max(a[31], a[38])
+	a[30], a[36] = min(a[30], a[36]), max(a[30], a[36])
+	a[31], a[37] = min(a[31], a[37]), max(a[31], a[37])
+	a[31], a[36] = min(a[31], a[36]), max(a[31], a[36])
+	a[28], a[32] = min(a[28], a[32]), max(a[28], a[32])
+	a[29], a[33] = min(a[29], a[33]), max(a[29], a[33])
+	a[29], a[32] = min(a[29], a[32]), max(a[29], a[32])
+	a[30], a[34] = min(a[30], a[34]), max(a[30], a[34])
+	a[31], a[35] = min(a[31], a[35]), max(a[31], a[35])
+	a[31], a[34] = min(a[31], a[34]), max(a[31], a[34])
+	a[30], a[32] = min(a[30], a[32]), max(a[30], a[32])
+	a[31], a[33] = min(a[31], a[33]), max(a[31], a[33])
+	a[31], a[32] = min(a[31], a[32]), max(a[31], a[32])
+}
+
+// NetworkSort64xFloat64Reverse sorts a using the min and max builtins.
+//
+// Not-a-number values give unspecified results, and may be duplicated.
+func NetworkSort64xFloat64Reverse(a []float64) {
+	_ = a[63]
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[5], a[7] = max(a[5], a[7]), min(a[5], a[7])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[1], a[4] = max(a[1], a[4]), min(a[1], a[4])
+	a[2], a[6] = max(a[2], a[6]), min(a[2], a[6])
+	a[3], a[7] = max(a[3], a[7]), min(a[3], a[7])
+	a[3], a[6] = max(a[3], a[6]), min(a[3], a[6])
+	a[2], a[4] = max(a[2], a[4]), min(a[2], a[4])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[10], a[11] = max(a[10], a[11]), min(a[10], a[11])
+	a[8], a[10] = max(a[8], a[10]), min(a[8], a[10])
+	a[9], a[11] = max(a[9], a[11]), min(a[9], a[11])
+	a[9], a[10] = max(a[9], a[10]), min(a[9], a[10])
+	a[12], a[13] = max(a[12], a[13]), min(a[12], a[13])
+	a[14], a[15] = max(a[14], a[15]), min(a[14], a[15])
+	a[12], a[14] = max(a[12], a[14]), min(a[12], a[14])
+	a[13], a[15] = max(a[13], a[15]), min(a[13], a[15])
+	a[13], a[14] = max(a[13], a[14]), min(a[13], a[14])
+	a[8], a[12] = max(a[8], a[12]), min(a[8], a[12])
+	a[9], a[13] = max(a[9], a[13]), min(a[9], a[13])
+	a[9], a[12] = max(a[9], a[12]), min(a[9], a[12])
+	a[10], a[14] = max(a[10], a[14]), min(a[10], a[14])
+	a[11], a[15] = max(a[11], a[15]), min(a[11], a[15])
+	a[11], a[14] = max(a[11], a[14]), min(a[11], a[14])
+	a[10], a[12] = max(a[10], a[12]), min(a[10], a[12])
+	a[11], a[13] = max(a[11], a[13]), min(a[11], a[13])
+	a[11], a[12] = max(a[11], a[12]), min(a[11], a[12])
+	a[0], a[8] = max(a[0], a[8]), min(a[0], a[8])
+	a[1], a[9] = max(a[1], a[9]), min(a[1], a[9])
+	a[1], a[8] = max(a[1], a[8]), min(a[1], a[8])
+	a[2], a[10] = max(a[2], a[10]), min(a[2], a[10])
+	a[3], a[11] = max(a[3], a[11]), min(a[3], a[11])
+	a[3], a[10] = max(a[3], a[10]), min(a[3], a[10])
+	a[2], a[8] = max(a[2], a[8]), min(a[2], a[8])
+	a[3], a[9] = max(a[3], a[9]), min(a[3], a[9])
+	a[3], a[8] = max(a[3], a[8]), min(a[3], a[8])
+	a[4], a[12] = max(a[4], a[12]), min(a[4], a[12])
+	a[5], a[13] = max(a[5], a[13]), min(a[5], a[13])
+	a[5], a[12] = max(a[5], a[12]), min(a[5], a[12])
+	a[6], a[14] = max(a[6], a[14]), min(a[6], a[14])
+	a[7], a[15] = max(a[7], a[15]), min(a[7], a[15])
+	a[7], a[14] = max(a[7], a[14]), min(a[7], a[14])
+	a[6], a[12] = max(a[6], a[12]), min(a[6], a[12])
+	a[7], a[13] = max(a[7], a[13]), min(a[7], a[13])
+	a[7], a[12] = max(a[7], a[12]), min(a[7], a[12])
+	a[4], a[8] = max(a[4], a[8]), min(a[4], a[8])
+	a[5], a[9] = max(a[5], a[9]), min(a[5], a[9])
+	a[5], a[8] = max(a[5], a[8]), min(a[5], a[8])
+	a[6], a[10] = max(a[6], a[10]), min(a[6], a[10])
+	a[7], a[11] = max(a[7], a[11]), min(a[7], a[11])
+	a[7], a[10] = max(a[7], a[10]), min(a[7], a[10])
+	a[6], a[8] = max(a[6], a[8]), min(a[6], a[8])
+	a[7], a[9] = max(a[7], a[9]), min(a[7], a[9])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+	a[16], a[17] = max(a[16], a[17]), min(a[16], a[17])
+	a[18], a[19] = max(a[18], a[19]), min(a[18], a[19])
+	a[16], a[18] = max(a[16], a[18]), min(a[16], a[18])
+	a[17], a[19] = max(a[17], a[19]), min(a[17], a[19])
+	a[17], a[18] = max(a[17], a[18]), min(a[17], a[18])
+	a[20], a[21] = max(a[20], a[21]), min(a[20], a[21])
+	a[22], a[23] = max(a[22], a[23]), min(a[22], a[23])
+	a[20], a[22] = max(a[20], a[22]), min(a[20], a[22])
+	a[21], a[23] = max(a[21], a[23]), min(a[21], a[23])
+	a[21], a[22] = max(a[21], a[22]), min(a[21], a[22])
+	a[16], a[20] = max(a[16], a[20]), min(a[16], a[20])
+	a[17], a[21] = max(a[17], a[21]), min(a[17], a[21])
+	a[17], a[20] = max(a[17], a[20]), min(a[17], a[20])
+	a[18], a[22] = max(a[18], a[22]), min(a[18], a[22])
+	a[19], a[23] = max(a[19], a[23]), min(a[19], a[23])
+	a[19], a[22] = max(a[19], a[22]), min(a[19], a[22])
+	a[18], a[20] = max(a[18], a[20]), min(a[18], a[20])
+	a[19], a[21] = max(a[19], a[21]), min(a[19], a[21])
+	a[19], a[20] = max(a[19], a[20]), min(a[19], a[20])
+	a[24], a[25] = max(a[24], a[25]), min(a[24], a[25])
+	a[26], a[27] = max(a[26], a[27]), min(a[26], a[27])
+	a[24], a[26] = max(a[24], a[26]), min(a[24], a[26])
+	a[25], a[27] = max(a[25], a[27]), min(a[25], a[27])
+	a[25], a[26] = max(a[25], a[26]), min(a[25], a[26])
+	a[28], a[29] = max(a[28], a[29]), min(a[28], a[29])
+	a[30], a[31] = max(a[30], a[31]), min(a[30], a[31])
+	a[28], a[30] = max(a[28], a[30]), min(a[28], a[30])
+	a[29], a[31] = max(a[29], a[31]), min(a[29], a[31])
+	a[29], a[30] = max(a[29], a[30]), min(a[29], a[30])
+	a[24], a[28] = max(a[24], a[28]), min(a[24], a[28])
+	a[25], a[29] = max(a[25], a[29]), min(a[25], a[29])
+	a[25], a[28] = max(a[25], a[28]), min(a[25], a[28])
+	a[26], a[30] = max(a[26], a[30]), min(a[26], a[30])
+	a[27], a[31] = max(a[27], a[31]), min(a[27], a[31])
+	a[27], a[30] = max(a[27], a[30]), min(a[27], a[30])
+	a[26], a[28] = max(a[26], a[28]), min(a[26], a[28])
+	a[27], a[29] = max(a[27], a[29]), min(a[27], a[29])
+	a[27], a[28] = max(a[27], a[28]), min(a[27], a[28])
+	a[16], a[24] = max(a[16], a[24]), min(a[16], a[24])
+	a[17], a[25] = max(a[17], a[25]), min(a[17], a[25])
+	a[17], a[24] = max(a[17], a[24]), min(a[17], a[24])
+	a[18], a[26] = max(a[18], a[26]), min(a[18], a[26])
+	a[19], a[27] = max(a[19], a[27]), min(a[19], a[27])
+	a[19], a[26] = max(a[19], a[26]), min(a[19], a[26])
+	a[18], a[24] = max(a[18], a[24]), min(a[18], a[24])
+	a[19], a[25] = max(a[19], a[25]), min(a[19], a[25])
+	a[19], a[24] = max(a[19], a[24]), min(a[19], a[24])
+	a[20], a[28] = max(a[20], a[28]), min(a[20], a[28])
+	a[21], a[29] = max(a[21], a[29]), min(a[21], a[29])
+	a[21], a[28] = max(a[21], a[28]), min(a[21], a[28])
+	a[22], a[30] = max(a[22], a[30]), min(a[22], a[30])
+	a[23], a[31] = max(a[23], a[31]), min(a[23], a[31])
+	a[23], a[30] = max(a[23], a[30]), min(a[23], a[30])
+	a[22], a[28] = max(a[22], a[28]), min(a[22], a[28])
+	a[23], a[29] = max(a[23], a[29]), min(a[23], a[29])
+	a[23], a[28] = max(a[23], a[28]), min(a[23], a[28])
+	a[20], a[24] = max(a[20], a[24]), min(a[20], a[24])
+	a[21], a[25] = max(a[21], a[25]), min(a[21], a[25])
+	a[21], a[24] = max(a[21], a[24]), min(a[21], a[24])
+	a[22], a[26] = max(a[22], a[26]), min(a[22], a[26])
+	a[23], a[27] = max(a[23], a[27]), min(a[23], a[27])
+	a[23], a[26] = max(a[23], a[26]), min(a[23], a[26])
+	a[22], a[24] = max(a[22], a[24]), min(a[22], a[24])
+	a[23], a[25] = max(a[23], a[25]), min(a[23], a[25])
+	a[23], a[24] = max(a[23], a[24]), min(a[23], a[24])
+	a[0], a[16] = max(a[0], a[16]), min(a[0], a[16])
+	a[1], a[17] = max(a[1], a[17]), min(a[1], a[17])
+	a[1], a[16] = max(a[1], a[16]), min(a[1], a[16])
+	a[2], a[18] = max(a[2], a[18]), min(a[2], a[18])
+	a[3], a[19] = max(a[3], a[19]), min(a[3], a[19])
+	a[3], a[18] = max(a[3], a[18]), min(a[3], a[18])
+	a[2], a[16] = max(a[2], a[16]), min(a[2], a[16])
+	a[3], a[17] = max(a[3], a[17]), min(a[3], a[17])
+	a[3], a[16] = max(a[3], a[16]), min(a[3], a[16])
+	a[4], a[20] = max(a[4], a[20]), min(a[4], a[20])
+	a[5], a[21] = max(a[5], a[21]), min(a[5], a[21])
+	a[5], a[20] = max(a[5], a[20]), min(a[5], a[20])
+	a[6], a[22] = max(a[6], a[22]), min(a[6], a[22])
+	a[7], a[23] = max(a[7], a[23]), min(a[7], a[23])
+	a[7], a[22] = max(a[7], a[22]), min(a[7], a[22])
+	a[6], a[20] = max(a[6], a[20]), min(a[6], a[20])
+	a[7], a[21] = max(a[7], a[21]), min(a[7], a[21])
+	a[7], a[20] = max(a[7], a[20]), min(a[7], a[20])
+	a[4], a[16] = max(a[4], a[16]), min(a[4], a[16])
+	a[5], a[17] = max(a[5], a[17]), min(a[5], a[17])
+	a[5], a[16] = max(a[5], a[16]), min(a[5], a[16])
+	a[6], a[18] = max(a[6], a[18]), min(a[6], a[18])
+	a[7], a[19] = max(a[7], a[19]), min(a[7], a[19])
+	a[7], a[18] = max(a[7], a[18]), min(a[7], a[18])
+	a[6], a[16] = max(a[6], a[16]), min(a[6], a[16])
+	a[7], a[17] = max(a[7], a[17]), min(a[7], a[17])
+	a[7], a[16] = max(a[7], a[16]), min(a[7], a[16])
+	a[8], a[24] = max(a[8], a[24]), min(a[8], a[24])
+	a[9], a[25] = max(a[9], a[25]), min(a[9], a[25])
+	a[9], a[24] = max(a[9], a[24]), min(a[9], a[24])
+	a[10], a[26] = max(a[10], a[26]), min(a[10], a[26])
+	a[11], a[27] = max(a[11], a[27]), min(a[11], a[27])
+	a[11], a[26] = max(a[11], a[26]), min(a[11], a[26])
+	a[10], a[24] = max(a[10], a[24]), min(a[10], a[24])
+	a[11], a[25] = max(a[11], a[25]), min(a[11], a[25])
+	a[11], a[24] = max(a[11], a[24]), min(a[11], a[24])
+	a[12], a[28] = max(a[12], a[28]), min(a[12], a[28])
+	a[13], a[29] = max(a[13], a[29]), min(a[13], a[29])
+	a[13], a[28] = max(a[13], a[28]), min(a[13], a[28])
+	a[14], a[30] = max(a[14], a[30]), min(a[14], a[30])
+	a[15], a[31] = max(a[15], a[31]), min(a[15], a[31])
+	a[15], a[30] = max(a[15], a[30]), min(a[15], a[30])
+	a[14], a[28] = max(a[14], a[28]), min(a[14], a[28])
+	a[15], a[29] = max(a[15], a[29]), min(a[15], a[29])
+	a[15], a[28] = max(a[15], a[28]), min(a[15], a[28])
+	a[12], a[24] = max(a[12], a[24]), min(a[12], a[24])
+	a[13], a[25] = max(a[13], a[25]), min(a[13], a[25])
+	a[13], a[24] = max(a[13], a[24]), min(a[13], a[24])
+	a[14], a[26] = max(a[14], a[26]), min(a[14], a[26])
+	a[15], a[27] = max(a[15], a[27]), min(a[15], a[27])
+	a[15], a[26] = max(a[15], a[26]), min(a[15], a[26])
+	a[14], a[24] = max(a[14], a[24]), min(a[14], a[24])
+	a[15], a[25] = max(a[15], a[25]), min(a[15], a[25])
+	a[15], a[24] = max(a[15], a[24]), min(a[15], a[24])
+	a[8], a[16] = max(a[8], a[16]), min(a[8], a[16])
+	a[9], a[17] = max(a[9], a[17]), min(a[9], a[17])
+	a[9], a[16] = max(a[9], a[16]), min(a[9], a[16])
+	a[10], a[18] = max(a[10], a[18]), min(a[10], a[18])
+	a[11], a[19] = max(a[11], a[19]), min(a[11], a[19])
+	a[11], a[18] = max(a[11], a[18]), min(a[11], a[18])
+	a[10], a[16] = max(a[10], a[16]), min(a[10], a[16])
+	a[11], a[17] = max(a[11], a[17]), min(a[11], a[17])
+	a[11], a[16] = max(a[11], a[16]), min(a[11], a[16])
+	a[12], a[20] = max(a[12], a[20]), min(a[12], a[20])
+	a[13], a[21] = max(a[13], a[21]), min(a[13], a[21])
+	a[13], a[20] = max(a[13], a[20]), min(a[13], a[20])
+	a[14], a[22] = max(a[14], a[22]), min(a[14], a[22])
+	a[15], a[23] = max(a[15], a[23]), min(a[15], a[23])
+	a[15], a[22] = max(a[15], a[22]), min(a[15], a[22])
+	a[14], a[20] = max(a[14], a[20]), min(a[14], a[20])
+	a[15], a[21] = max(a[15], a[21]), min(a[15], a[21])
+	a[15], a[20] = max(a[15], a[20]), min(a[15], a[20])
+	a[12], a[16] = max(a[12], a[16]), min(a[12], a[16])
+	a[13], a[17] = max(a[13], a[17]), min(a[13], a[17])
+	a[13], a[16] = max(a[13], a[16]), min(a[13], a[16])
+	a[14], a[18] = max(a[14], a[18]), min(a[14], a[18])
+	a[15], a[19] = max(a[15], a[19]), min(a[15], a[19])
+	a[15], a[18] = max(a[15], a[18]), min(a[15], a[18])
+	a[14], a[16] = max(a[14], a[16]), min(a[14], a[16])
+	a[15], a[17] = max(a[15], a[17]), min(a[15], a[17])
+	a[15], a[16] = max(a[15], a[16]), min(a[15], a[16])
+	a[32], a[33] = max(a[32], a[33]), min(a[32], a[33])
+	a[34], a[35] = max(a[34], a[35]), min(a[34], a[35])
+	a[32], a[34] = max(a[32], a[34]), min(a[32], a[34])
+	a[33], a[35] = max(a[33], a[35]), min(a[33], a[35])
+	a[33], a[34] = max(a[33], a[34]), min(a[33], a[34])
+	a[36], a[37] = max(a[36], a[37]), min(a[36], a[37])
+	a[38], a[39] = max(a[38], a[39]), min(a[38], a[39])
+	a[36], a[38] = max(a[36], a[38]), min(a[36], a[38])
+	a[37], a[39] = max(a[37], a[39]), min(a[37], a[39])
+	a[37], a[38] = max(a[37], a[38]), min(a[37], a[38])
+	a[32], a[36] = max(a[32], a[36]), min(a[32], a[36])
+	a[33], a[37] = max(a[33], a[37]), min(a[33], a[37])
+	a[33], a[36] = max(a[33], a[36]), min(a[33], a[36])
+	a[34], a[38] = max(a[34], a[38]), min(a[34], a[38])
+	a[35], a[39] = max(a[35], a[39]), min(a[35], a[39])
+	a[35], a[38] = max(a[35], a[38]), min(a[35], a[38])
+	a[34], a[36] = max(a[34], a[36]), min(a[34], a[36])
+	a[35], a[37] = max(a[35], a[37]), min(a[35], a[37])
+	a[35], a[36] = max(a[35], a[36]), min(a[35], a[36])
+	a[40], a[41] = max(a[40], a[41]), min(a[40], a[41])
+	a[42], a[43] = max(a[42], a[43]), min(a[42], a[43])
+	a[40], a[42] = max(a[40], a[42]), min(a[40], a[42])
+	a[41], a[43] = max(a[41], a[43]), min(a[41], a[43])
+	a[41], a[42] = max(a[41], a[42]), min(a[41], a[42])
+	a[44], a[45] = max(a[44], a[45]), min(a[44], a[45])
+	a[46], a[47] = max(a[46], a[47]), min(a[46], a[47])
+	a[44], a[46] = max(a[44], a[46]), min(a[44], a[46])
+	a[45], a[47] = max(a[45], a[47]), min(a[45], a[47])
+	a[45], a[46] = max(a[45], a[46]), min(a[45], a[46])
+	a[40], a[44] = max(a[40], a[44]), min(a[40], a[44])
+	a[41], a[45] = max(a[41], a[45]), min(a[41], a[45])
+	a[41], a[44] = max(a[41], a[44]), min(a[41], a[44])
+	a[42], a[46] = max(a[42], a[46]), min(a[42], a[46])
+	a[43], a[47] = max(a[43], a[47]), min(a[43], a[47])
+	a[43], a[46] = max(a[43], a[46]), min(a[43], a[46])
+	a[42], a[44] = max(a[42], a[44]), min(a[42], a[44])
+	a[43], a[45] = max(a[43], a[45]), min(a[43], a[45])
+	a[43], a[44] = max(a[43], a[44]), min(a[43], a[44])
+	a[32], a[40] = max(a[32], a[40]), min(a[32], a[40])
+	a[33], a[41] = max(a[33], a[41]), min(a[33], a[41])
+	a[33], a[40] = max(a[33], a[40]), min(a[33], a[40])
+	a[34], a[42] = max(a[34], a[42]), min(a[34], a[42])
+	a[35], a[43] = max(a[35], a[43]), min(a[35], a[43])
+	a[35], a[42] = max(a[35], a[42]), min(a[35], a[42])
+	a[34], a[40] = max(a[34], a[40]), min(a[34], a[40])
+	a[35], a[41] = max(a[35], a[41]), min(a[35], a[41])
+	a[35], a[40] = max(a[35], a[40]), min(a[35], a[40])
+	a[36], a[44] = max(a[36], a[44]), min(a[36], a[44])
+	a[37], a[45] = max(a[37], a[45]), min(a[37], a[45])
+	a[37], a[44] = max(a[37], a[44]), min(a[37], a[44])
+	a[38], a[46] = max(a[38], a[46]), min(a[38], a[46])
+	a[39], a[47] = max(a[39], a[47]), min(a[39], a[47])
+	a[39], a[46] = max(a[39], a[46]), min(a[39], a[46])
+	a[38], a[44] = max(a[38], a[44]), min(a[38], a[44])
+	a[39], a[45] = max(a[39], a[45]), min(a[39], a[45])
+	a[39], a[44] = max(a[39], a[44]), min(a[39], a[44])
+	a[36], a[40] = max(a[36], a[40]), min(a[36], a[40])
+	a[37], a[41] = max(a[37], a[41]), min(a[37], a[41])
+	a[37], a[40] = max(a[37], a[40]), min(a[37], a[40])
+	a[38], a[42] = max(a[38], a[42]), min(a[38], a[42])
+	a[39], a[43] = max(a[39], a[43]), min(a[39], a[43])
+	a[39], a[42] = max(a[39], a[42]), min(a[39], a[42])
+	a[38], a[40] = max(a[38], a[40]), min(a[38], a[40])
+	a[39], a[41] = max(a[39], a[41]), min(a[39], a[41])
+	a[39], a[40] = max(a[39], a[40]), min(a[39], a[40])
+	a[48], a[49] = max(a[48], a[49]), min(a[48], a[49])
+	a[50], a[51] = max(a[50], a[51]), min(a[50], a[51])
+	a[48], a[50] = max(a[48], a[50]), min(a[48], a[50])
+	a[49], a[51] = max(a[49], a[51]), min(a[49], a[51])
+	a[49], a[50] = max(a[49], a[50]), min(a[49], a[50])
+	a[52], a[53] = max(a[52], a[53]), min(a[52], a[53])
+	a[54], a[55] = max(a[54], a[55]), min(a[54], a[55])
+	a[52], a[54] = max(a[52], a[54]), min(a[52], a[54])
+	a[53], a[55] = max(a[53], a[55]), min(a[53], a[55])
+	a[53], a[54] = max(a[53], a[54]), min(a[53], a[54])
+	a[48], a[52] = max(a[48], a[52]), min(a[48], a[52])
+	a[49], a[53] = max(a[49], a[53]), min(a[49], a[53])
+	a[49], a[52] = max(a[49], a[52]), min(a[49], a[52])
+	a[50], a[54] = max(a[50], a[54]), min(a[50], a[54])
+	a[51], a[55] = max(a[51], a[55]), min(a[51], a[55])
+	a[51], a[54] = max(a[51], a[54]), min(a[51], a[54])
+	a[50], a[52] = max(a[50], a[52]), min(a[50], a[52])
+	a[51], a[53] = max(a[51], a[53]), min(a[51], a[53])
+	a[51], a[52] = max(a[51], a[52]), min(a[51], a[52])
+	a[56], a[57] = max(a[56], a[57]), min(a[56], a[57])
+	a[58], a[59] = max(a[58], a[59]), min(a[58], a[59])
+	a[56], a[58] = max(a[56], a[58]), min(a[56], a[58])
+	a[57], a[59] = max(a[57], a[59]), min(a[57], a[59])
+	a[57], a[58] = max(a[57], a[58]), min(a[57], a[58])
+	a[60], a[61] = max(a[60], a[61]), min(a[60], a[61])
+	a[62], a[63] = max(a[62], a[63]), min(a[62], a[63])
+	a[60], a[62] = max(a[60], a[62]), min(a[60], a[62])
+	a[61], a[63] = max(a[61], a[63]), min(a[61], a[63])
+	a[61], a[62] = max(a[61], a[62]), min(a[61], a[62])
+	a[56], a[60] = max(a[56], a[60]), min(a[56], a[60])
+	a[57], a[61] = max(a[57], a[61]), min(a[57], a[61])
+	a[57], a[60] = max(a[57], a[60]), min(a[57], a[60])
+	a[58], a[62] = max(a[58], a[62]), min(a[58], a[62])
+	a[59], a[63] = max(a[59], a[63]), min(a[59], a[63])
+	a[59], a[62] = max(a[59], a[62]), min(a[59], a[62])
+	a[58], a[60] = max(a[58], a[60]), min(a[58], a[60])
+	a[59], a[61] = max(a[59], a[61]), min(a[59], a[61])
+	a[59], a[60] = max(a[59], a[60]), min(a[59], a[60])
+	a[48], a[56] = max(a[48], a[56]), min(a[48], a[56])
+	a[49], a[57] = max(a[49], a[57]), min(a[49], a[57])
+	a[49], a[56] = max(a[49], a[56]), min(a[49], a[56])
+	a[50], a[58] = max(a[50], a[58]), min(a[50], a[58])
+	a[51], a[59] = max(a[51], a[59]), min(a[51], a[59])
+	a[51], a[58] = max(a[51], a[58]), min(a[51], a[58])
+	a[50], a[56] = max(a[50], a[56]), min(a[50], a[56])
+	a[51], a[57] = max(a[51], a[57]), min(a[51], a[57])
+	a[51], a[56] = max(a[51], a[56]), min(a[51], a[56])
+	a[52], a[60] = max(a[52], a[60]), min(a[52], a[60])
+	a[53], a[61] = max(a[53], a[61]), min(a[53], a[61])
+	a[53], a[60] = max(a[53], a[60]), min(a[53], a[60])
+	a[54], a[62] = max(a[54], a[62]), min(a[54], a[62])
+	a[55], a[63] = max(a[55], a[63]), min(a[55], a[63])
+	a[55], a[62] = max(a[55], a[62]), min(a[55], a[62])
+	a[54], a[60] = max(a[54], a[60]), min(a[54], a[60])
+	a[55], a[61] = max(a[55], a[61]), min(a[55], a[61])
+	a[55], a[60] = max(a[55], a[60]), min(a[55], a[60])
+	a[52], a[56] = max(a[52], a[56]), min(a[52], a[56])
+	a[53], a[57] = max(a[53], a[57]), min(a[53], a[57])
+	a[53], a[56] = max(a[53], a[56]), min(a[53], a[56])
+	a[54], a[58] = max(a[54], a[58]), min(a[54], a[58])
+	a[55], a[59] = max(a[55], a[59]), min(a[55], a[59])
+	a[55], a[58] = max(a[55], a[58]), min(a[55], a[58])
+	a[54], a[56] = max(a[54], a[56]), min(a[54], a[56])
+	a[55], a[57] = max(a[55], a[57]), min(a[55], a[57])
+	a[55], a[56] = max(a[55], a[56]), min(a[55], a[56])
+	a[32], a[48] = max(a[32], a[48]), min(a[32], a[48])
+	a[33], a[49] = max(a[33], a[49]), min(a[33], a[49])
+	a[33], a[48] = max(a[33], a[48]), min(a[33], a[48])
+	a[34], a[50] = max(a[34], a[50]), min(a[34], a[50])
+	a[35], a[51] = max(a[35], a[51]), min(a[35], a[51])
+	a[35], a[50] = max(a[35], a[50]), min(a[35], a[50])
+	a[34], a[48] = max(a[34], a[48]), min(a[34], a[48])
+	a[35], a[49] = max(a[35], a[49]), min(a[35], a[49])
+	a[35], a[48] = max(a[35], a[48]), min(a[35], a[48])
+	a[36], a[52] = max(a[36], a[52]), min(a[36], a[52])
+	a[37], a[53] = max(a[37], a[53]), min(a[37], a[53])
+	a[37], a[52] = max(a[37], a[52]), min(a[37], a[52])
+	a[38], a[54] = max(a[38], a[54]), min(a[38], a[54])
+	a[39], a[55] = max(a[39], a[55]), min(a[39], a[55])
+	a[39], a[54] = max(a[39], a[54]), min(a[39], a[54])
+	a[38], a[52] = max(a[38], a[52]), min(a[38], a[52])
+	a[39], a[53] = max(a[39], a[53]), min(a[39], a[53])
+	a[39], a[52] = max(a[39], a[52]), min(a[39], a[52])
+	a[36], a[48] = max(a[36], a[48]), min(a[36], a[48])
+	a[37], a[49] = max(a[37], a[49]), min(a[37], a[49])
+	a[37], a[48] = max(a[37], a[48]), min(a[37], a[48])
+	a[38], a[50] = max(a[38], a[50]), min(a[38], a[50])
+	a[39], a[51] = max(a[39], a[51]), min(a[39], a[51])
+	a[39], a[50] = max(a[39], a[50]), min(a[39], a[50])
+	a[38], a[48] = max(a[38], a[48]), min(a[38], a[48])
+	a[39], a[49] = max(a[39], a[49]), min(a[39], a[49])
+	a[39], a[48] = max(a[39], a[48]), min(a[39], a[48])
+	a[40], a[56] = max(a[40], a[56]), min(a[40], a[56])
+	a[41], a[57] = max(a[41], a[57]), min(a[41], a[57])
+	a[41], a[56] = max(a[41], a[56]), min(a[41], a[56])
+	a[42], a[58] = max(a[42], a[58]), min(a[42], a[58])
+	a[43], a[59] = max(a[43], a[59]), min(a[43], a[59])
+	a[43], a[58] = max(a[43], a[58]), min(a[43], a[58])
+	a[42], a[56] = max(a[42], a[56]), min(a[42], a[56])
+	a[43], a[57] = max(a[43], a[57]), min(a[43], a[57])
+	a[43], a[56] = max(a[43], a[56]), min(a[43], a[56])
+	a[44], a[60] = max(a[44], a[60]), min(a[44], a[60])
+	a[45], a[61] = max(a[45], a[61]), min(a[45], a[61])
+	a[45], a[60] = max(a[45], a[60]), min(a[45], a[60])
+	a[46], a[62] = max(a[46], a[62]), min(a[46], a[62])
+	a[47], a[63] = max(a[47], a[63]), min(a[47], a[63])
+	a[47], a[62] = max(a[47], a[62]), min(a[47], a[62])
+	a[46], a[60] = max(a[46], a[60]), min(a[46], a[60])
+	a[47], a[61] = max(a[47], a[61]), min(a[47], a[61])
+	a[47], a[60] = max(a[47], a[60]), min(a[47], a[60])
+	a[44], a[56] = max(a[44], a[56]), min(a[44], a[56])
+	a[45], a[57] = max(a[45], a[57]), min(a[45], a[57])
+	a[45], a[56] = max(a[45], a[56]), min(a[45], a[56])
+	a[46], a[58] = max(a[46], a[58]), min(a[46], a[58])
+	a[47], a[59] = max(a[47], a[59]), min(a[47], a[59])
+	a[47], a[58] = max(a[47], a[58]), min(a[47], a[58])
+	a[46], a[56] = max(a[46], a[56]), min(a[46], a[56])
+	a[47], a[57] = max(a[47], a[57]), min(a[47], a[57])
+	a[47], a[56] = max(a[47], a[56]), min(a[47], a[56])
+	a[40], a[48] = max(a[40], a[48]), min(a[40], a[48])
+	a[41], a[49] = max(a[41], a[49]), min(a[41], a[49])
+	a[41], a[48] = max(a[41], a[48]), min(a[41], a[48])
+	a[42], a[50] = max(a[42], a[50]), min(a[42], a[50])
+	a[43], a[51] = max(a[43], a[51]), min(a[43], a[51])
+	a[43], a[50] = max(a[43], a[50]), min(a[43], a[50])
+	a[42], a[48] = max(a[42], a[48]), min(a[42], a[48])
+	a[43], a[49] = max(a[43], a[49]), min(a[43], a[49])
+	a[43], a[48] = max(a[43], a[48]), min(a[43], a[48])
+	a[44], a[52] = max(a[44], a[52]), min(a[44], a[52])
+	a[45], a[53] = max(a[45], a[53]), min(a[45], a[53])
+	a[45], a[52] = max(a[45], a[52]), min(a[45], a[52])
+	a[46], a[54] = max(a[46], a[54]), min(a[46], a[54])
+	a[47], a[55] = max(a[47], a[55]), min(a[47], a[55])
+	a[47], a[54] = max(a[47], a[54]), min(a[47], a[54])
+	a[46], a[52] = max(a[46], a[52]), min(a[46], a[52])
+	a[47], a[53] = max(a[47], a[53]), min(a[47], a[53])
+	a[47], a[52] = max(a[47], a[52]), min(a[47], a[52])
+	a[44], a[48] = max(a[44], a[48]), min(a[44], a[48])
+	a[45], a[49] = max(a[45], a[49]), min(a[45], a[49])
+	a[45], a[48] = max(a[45], a[48]), min(a[45], a[48])
+	a[46], a[50] = max(a[46], a[50]), min(a[46], a[50])
+	a[47], a[51] = max(a[47], a[51]), min(a[47], a[51])
+	a[47], a[50] = max(a[47], a[50]), min(a[47], a[50])
+	a[46], a[48] = max(a[46], a[48]), min(a[46], a[48])
+	a[47], a[49] = max(a[47], a[49]), min(a[47], a[49])
+	a[47], a[48] = max(a[47], a[48]), min(a[47], a[48])
+	a[0], a[32] = max(a[0], a[32]), min(a[0], a[32])
+	a[1], a[33] = max(a[1], a[33]), min(a[1], a[33])
+	a[1], a[32] = max(a[1], a[32]), min(a[1], a[32])
+	a[2], a[34] = max(a[2], a[34]), min(a[2], a[34])
+	a[3], a[35] = max(a[3], a[35]), min(a[3], a[35])
+	a[3], a[34] = max(a[3], a[34]), min(a[3], a[34])
+	a[2], a[32] = max(a[2], a[32]), min(a[2], a[32])
+	a[3], a[33] = max(a[3], a[33]), min(a[3], a[33])
+	a[3], a[32] = max(a[3], a[32]), min(a[3], a[32])
+	a[4], a[36] = max(a[4], a[36]), min(a[4], a[36])
+	a[5], a[37] = max(a[5], a[37]), min(a[5], a[37])
+	a[5], a[36] = max(a[5], a[36]), min(a[5], a[36])
+	a[6], a[38] = max(a[6], a[38]), min(a[6], a[38])
+	a[7], a[39] = max(a[7], a[39]), min(a[7], a[39])
+	a[7], a[38] = max(a[7], a[38]), min(a[7], a[38])
+	a[6], a[36] = max(a[6], a[36]), min(a[6], a[36])
+	a[7], a[37] = max(a[7], a[37]), min(a[7], a[37])
+	a[7], a[36] = max(a[7], a[36]), min(a[7], a[36])
+	a[4], a[32] = max(a[4], a[32]), min(a[4], a[32])
+	a[5], a[33] = max(a[5], a[33]), min(a[5], a[33])
+	a[5], a[32] = max(a[5], a[32]), min(a[5], a[32])
+	a[6], a[34] = max(a[6], a[34]), min(a[6], a[34])
+	a[7], a[35] = max(a[7], a[35]), min(a[7], a[35])
+	a[7], a[34] = max(a[7], a[34]), min(a[7], a[34])
+	a[6], a[32] = max(a[6], a[32]), min(a[6], a[32])
+	a[7], a[33] = max(a[7], a[33]), min(a[7], a[33])
+	a[7], a[32] = max(a[7], a[32]), min(a[7], a[32])
+	a[8], a[40] = max(a[8], a[40]), min(a[8], a[40])
+	a[9], a[41] = max(a[9], a[41]), min(a[9], a[41])
+	a[9], a[40] = max(a[9], a[40]), min(a[9], a[40])
+	a[10], a[42] = max(a[10], a[42]), min(a[10], a[42])
+	a[11], a[43] = max(a[11], a[43]), min(a[11], a[43])
+	a[11], a[42] = max(a[11], a[42]), min(a[11], a[42])
+	a[10], a[40] = max(a[10], a[40]), min(a[10], a[40])
+	a[11], a[41] = max(a[11], a[41]), min(a[11], a[41])
+	a[11], a[40] = max(a[11], a[40]), min(a[11], a[40])
+	a[12], a[44] = max(a[12], a[44]), min(a[12], a[44])
+	a[13], a[45] = max(a[13], a[45]), min(a[13], a[45])
+	a[13], a[44] = max(a[13], a[44]), min(a[13], a[44])
+	a[14], a[46] = max(a[14], a[46]), min(a[14], a[46])
+	a[15], a[47] = max(a[15], a[47]), min(a[15], a[47])
+	a[15], a[46] = max(a[15], a[46]), min(a[15], a[46])
+	a[14], a[44] = max(a[14], a[44]), min(a[14], a[44])
+	a[15], a[45] = max(a[15], a[45]), min(a[15], a[45])
+	a[15], a[44] = max(a[15], a[44]), min(a[15], a[44])
+	a[12], a[40] = max(a[12], a[40]), min(a[12], a[40])
+	a[13], a[41] = max(a[13], a[41]), min(a[13], a[41])
+	a[13], a[40] = max(a[13], a[40]), min(a[13], a[40])
+	a[14], a[42] = max(a[14], a[42]), min(a[14], a[42])
+	a[15], a[43] = max(a[15], a[43]), min(a[15], a[43])
+	a[15], a[42] = max(a[15], a[42]), min(a[15], a[42])
+	a[14], a[40] = max(a[14], a[40]), min(a[14], a[40])
+	a[15], a[41] = max(a[15], a[41]), min(a[15], a[41])
+	a[15], a[40] = max(a[15], a[40]), min(a[15], a[40])
+	a[8], a[32] = max(a[8], a[32]), min(a[8], a[32])
+	a[9], a[33] = max(a[9], a[33]), min(a[9], a[33])
+	a[9], a[32] = max(a[9], a[32]), min(a[9], a[32])
+	a[10], a[34] = max(a[10], a[34]), min(a[10], a[34])
+	a[11], a[35] = max(a[11], a[35]), min(a[11], a[35])
+	a[11], a[34] = max(a[11], a[34]), min(a[11], a[34])
+	a[10], a[32] = max(a[10], a[32]), min(a[10], a[32])
+	a[11], a[33] = max(a[11], a[33]), min(a[11], a[33])
+	a[11], a[32] = max(a[11], a[32]), min(a[11], a[32])
+	a[12], a[36] = max(a[12], a[36]), min(a[12], a[36])
+	a[13], a[37] = max(a[13], a[37]), min(a[13], a[37])
+	a[13], a[36] = max(a[13], a[36]), min(a[13], a[36])
+	a[14], a[38] = max(a[14], a[38]), min(a[14], a[38])
+	a[15], a[39] = max(a[15], a[39]), min(a[15], a[39])
+	a[15], a[38] = max(a[15], a[38]), min(a[15], a[38])
+	a[14], a[36] = max(a[14], a[36]), min(a[14], a[36])
+	a[15], a[37] = max(a[15], a[37]), min(a[15], a[37])
+	a[15], a[36] = max(a[15], a[36]), min(a[15], a[36])
+	a[12], a[32] = max(a[12], a[32]), min(a[12], a[32])
+	a[13], a[33] = max(a[13], a[33]), min(a[13], a[33])
+	a[13], a[32] = max(a[13], a[32]), min(a[13], a[32])
+	a[14], a[34] = max(a[14], a[34]), min(a[14], a[34])
+	a[15], a[35] = max(a[15], a[35]), min(a[15], a[35])
+	a[15], a[34] = max(a[15], a[34]), min(a[15], a[34])
+	a[14], a[32] = max(a[14], a[32]), min(a[14], a[32])
+	a[15], a[33] = max(a[15], a[33]), min(a[15], a[33])
+	a[15], a[32] = max(a[15], a[32]), min(a[15], a[32])
+	a[16], a[48] = max(a[16], a[48]), min(a[16], a[48])
+	a[17], a[49] = max(a[17], a[49]), min(a[17], a[49])
+	a[17], a[48] = max(a[17], a[48]), min(a[17], a[48])
+	a[18], a[50] = max(a[18], a[50]), min(a[18], a[50])
+	a[19], a[51] = max(a[19], a[51]), min(a[19], a[51])
+	a[19], a[50] = max(a[19], a[50]), min(a[19], a[50])
+	a[18], a[48] = max(a[18], a[48]), min(a[18], a[48])
+	a[19], a[49] = max(a[19], a[49]), min(a[19], a[49])
+	a[19], a[48] = max(a[19], a[48]), min(a[19], a[48])
+	a[20], a[52] = max(a[20], a[52]), min(a[20], a[52])
+	a[21], a[53] = max(a[21], a[53]), min(a[21], a[53])
+	a[21], a[52] = max(a[21], a[52]), min(a[21], a[52])
+	a[22], a[54] = max(a[22], a[54]), min(a[22], a[54])
+	a[23], a[55] = max(a[23], a[55]), min(a[23], a[55])
+	a[23], a[54] = max(a[23], a[54]), min(a[23], a[54])
+	a[22], a[52] = max(a[22], a[52]), min(a[22], a[52])
+	a[23], a[53] = max(a[23], a[53]), min(a[23], a[53])
+	a[23], a[52] = max(a[23], a[52]), min(a[23], a[52])
+	a[20], a[48] = max(a[20], a[48]), min(a[20], a[48])
+	a[21], a[49] = max(a[21], a[49]), min(a[21], a[49])
+	a[21], a[48] = max(a[21], a[48]), min(a[21], a[48])
+	a[22], a[50] = max(a[22], a[50]), min(a[22], a[50])
+	a[23], a[51] = max(a[23], a[51]), min(a[23], a[51])
+	a[23], a[50] = max(a[23], a[50]), min(a[23], a[50])
+	a[22], a[48] = max(a[22], a[48]), min(a[22], a[48])
+	a[23], a[49] = max(a[23], a[49]), min(a[23], a[49])
+	a[23], a[48] = max(a[23], a[48]), min(a[23], a[48])
+	a[24], a[56] = max(a[24], a[56]), min(a[24], a[56])
+	a[25], a[57] = max(a[25], a[57]), min(a[25], a[57])
+	a[25], a[56] = max(a[25], a[56]), min(a[25], a[56])
+	a[26], a[58] = max(a[26], a[58]), min(a[26], a[58])
+	a[27], a[59] = max(a[27], a[59]), min(a[27], a[59])
+	a[27], a[58] = max(a[27], a[58]), min(a[27], a[58])
+	a[26], a[56] = max(a[26], a[56]), min(a[26], a[56])
+	a[27], a[57] = max(a[27], a[57]), min(a[27], a[57])
+	a[27], a[56] = max(a[27], a[56]), min(a[27], a[56])
+	a[28], a[60] = max(a[28], a[60]), min(a[28], a[60])
+	a[29], a[61] = max(a[29], a[61]), min(a[29], a[61])
+	a[29], a[60] = max(a[29], a[60]), min(a[29], a[60])
+	a[30], a[62] = max(a[30], a[62]), min(a[30], a[62])
+	a[31], a[63] = max(a[31], a[63]), min(a[31], a[63])
+	a[31], a[62] = max(a[31], a[62]), min(a[31], a[62])
+	a[30], a[60] = max(a[30], a[60]), min(a[30], a[60])
+	a[31], a[61] = max(a[31], a[61]), min(a[31], a[61])
+	a[31], a[60] = max(a[31], a[60]), min(a[31], a[60])
+	a[28], a[56] = max(a[28], a[56]), min(a[28], a[56])
+	a[29], a[57] = max(a[29], a[57]), min(a[29], a[57])
+	a[29], a[56] = max(a[29], a[56]), min(a[29], a[56])
+	a[30], a[58] = max(a[30], a[58]), min(a[30], a[58])
+	a[31], a[59] = max(a[31], a[59]), min(a[31], a[59])
+	a[31], a[58] = max(a[31], a[58]), min(a[31], a[58])
+	a[30], a[56] = max(a[30], a[56]), min(a[30], a[56])
+	a[31], a[57] = max(a[31], a[57]), min(a[31], a[57])
+	a[31], a[56] = max(a[31], a[56]), min(a[31], a[56])
+	a[24], a[48] = max(a[24], a[48]), min(a[24], a[48])
+	a[25], a[49] = max(a[25], a[49]), min(a[25], a[49])
+	a[25], a[48] = max(a[25], a[48]), min(a[25], a[48])
+	a[26], a[50] = max(a[26], a[50]), min(a[26], a[50])
+	a[27], a[51] = max(a[27], a[51]), min(a[27], a[51])
+	a[27], a[50] = max(a[27], a[50]), min(a[27], a[50])
+	a[26], a[48] = max(a[26], a[48]), min(a[26], a[48])
+	a[27], a[49] = max(a[27], a[49]), min(a[27], a[49])
+	a[27], a[48] = max(a[27], a[48]), min(a[27], a[48])
+	a[28], a[52] = max(a[28], a[52]), min(a[28], a[52])
+	a[29], a[53] = max(a[29], a[53]), min(a[29], a[53])
+	a[29], a[52] = max(a[29], a[52]), min(a[29], a[52])
+	a[30], a[54] = max(a[30], a[54]), min(a[30], a[54])
+	a[31], a[55] = max(a[31], a[55]), min(a[31], a[55])
+	a[31], a[54] = max(a[31], a[54]), min(a[31], a[54])
+	a[30], a[52] = max(a[30], a[52]), min(a[30], a[52])
+	a[31], a[53] = max(a[31], a[53]), min(a[31], a[53])
+	a[31], a[52] = max(a[31], a[52]), min(a[31], a[52])
+	a[28], a[48] = max(a[28], a[48]), min(a[28], a[48])
+	a[29], a[49] = max(a[29], a[49]), min(a[29], a[49])
+	a[29], a[48] = max(a[29], a[48]), min(a[29], a[48])
+	a[30], a[50] = max(a[30], a[50]), min(a[30], a[50])
+	a[31], a[51] = max(a[31], a[51]), min(a[31], a[51])
+	a[31], a[50] = max(a[31], a[50]), min(a[31], a[50])
+	a[30], a[48] = max(a[30], a[48]), min(a[30], a[48])
+	a[31], a[49] = max(a[31], a[49]), min(a[31], a[49])
+	a[31], a[48] = max(a[31], a[48]), min(a[31], a[48])
+	a[16], a[32] = max(a[16], a[32]), min(a[16], a[32])
+	a[17], a[33] = max(a[17], a[33]), min(a[17], a[33])
+	a[17], a[32] = max(a[17], a[32]), min(a[17], a[32])
+	a[18], a[34] = max(a[18], a[34]), min(a[18], a[34])
+	a[19], a[35] = max(a[19], a[35]), min(a[19], a[35])
+	a[19], a[34] = max(a[19], a[34]), min(a[19], a[34])
+	a[18], a[32] = max(a[18], a[32]), min(a[18], a[32])
+	a[19], a[33] = max(a[19], a[33]), min(a[19], a[33])
+	a[19], a[32] = max(a[19], a[32]), min(a[19], a[32])
+	a[20], a[36] = max(a[20], a[36]), min(a[20], a[36])
+	a[21], a[37] = max(a[21], a[37]), min(a[21], a[37])
+	a[21], a[36] = max(a[21], a[36]), min(a[21], a[36])
+	a[22], a[38] = max(a[22], a[38]), min(a[22], a[38])
+	a[23], a[39] = max(a[23], a[39]), min(a[23], a[39])
+	a[23], a[38] = max(a[23], a[38]), min(a[23], a[38])
+	a[22], a[36] = max(a[22], a[36]), min(a[22], a[36])
+	a[23], a[37] = max(a[23], a[37]), min(a[23], a[37])
+	a[23], a[36] = max(a[23], a[36]), min(a[23], a[36])
+	a[20], a[32] = max(a[20], a[32]), min(a[20], a[32])
+	a[21], a[33] = max(a[21], a[33]), min(a[21], a[33])
+	a[21], a[32] = max(a[21], a[32]), min(a[21], a[32])
+	a[22], a[34] = max(a[22], a[34]), min(a[22], a[34])
+	a[23], a[35] = max(a[23], a[35]), min(a[23], a[35])
+	a[23], a[34] = max(a[23], a[34]), min(a[23], a[34])
+	a[22], a[32] = max(a[22], a[32]), min(a[22], a[32])
+	a[23], a[33] = max(a[23], a[33]), min(a[23], a[33])
+	a[23], a[32] = max(a[23], a[32]), min(a[23], a[32])
+	a[24], a[40] = max(a[24], a[40]), min(a[24], a[40])
+	a[25], a[41] = max(a[25], a[41]), min(a[25], a[41])
+	a[25], a[40] = max(a[25], a[40]), min(a[25], a[40])
+	a[26], a[42] = max(a[26], a[42]), min(a[26], a[42])
+	a[27], a[43] = max(a[27], a[43]), min(a[27], a[43])
+	a[27], a[42] = max(a[27], a[42]), min(a[27], a[42])
+	a[26], a[40] = max(a[26], a[40]), min(a[26], a[40])
+	a[27], a[41] = max(a[27], a[41]), min(a[27], a[41])
+	a[27], a[40] = max(a[27], a[40]), min(a[27], a[40])
+	a[28], a[44] = max(a[28], a[44]), min(a[28], a[44])
+	a[29], a[45] = max(a[29], a[45]), min(a[29], a[45])
+	a[29], a[44] = max(a[29], a[44]), min(a[29], a[44])
+	a[30], a[46] = max(a[30], a[46]), min(a[30], a[46])
+	a[31], a[47] = max(a[31], a[47]), min(a[31], a[47])
+	a[31], a[46] = max(a[31], a[46]), min(a[31], a[46])
+	a[30], a[44] = max(a[30], a[44]), min(a[30], a[44])
+	a[31], a[45] = max(a[31], a[45]), min(a[31], a[45])
+	a[31], a[44] = max(a[31], a[44]), min(a[31], a[44])
+	a[28], a[40] = max(a[28], a[40]), min(a[28], a[40])
+	a[29], a[41] = max(a[29], a[41]), min(a[29], a[41])
+	a[29], a[40] = max(a[29], a[40]), min(a[29], a[40])
+	a[30], a[42] = max(a[30], a[42]), min(a[30], a[42])
+	a[31], a[43] = max(a[31], a[43]), min(a[31], a[43])
+	a[31], a[42] = max(a[31], a[42]), min(a[31], a[42])
+	a[30], a[40] = max(a[30], a[40]), min(a[30], a[40])
+	a[31], a[41] = max(a[31], a[41]), min(a[31], a[41])
+	a[31], a[40] = max(a[31], a[40]), min(a[31], a[40])
+	a[24], a[32] = max(a[24], a[32]), min(a[24], a[32])
+	a[25], a[33] = max(a[25], a[33]), min(a[25], a[33])
+	a[25], a[32] = max(a[25], a[32]), min(a[25], a[32])
+	a[26], a[34] = max(a[26], a[34]), min(a[26], a[34])
+	a[27], a[35] = max(a[27], a[35]), min(a[27], a[35])
+	a[27], a[34] = max(a[27], a[34]), min(a[27], a[34])
+	a[26], a[32] = max(a[26], a[32]), min(a[26], a[32])
+	a[27], a[33] = max(a[27], a[33]), min(a[27], a[33])
+	a[27], a[32] = max(a[27], a[32]), min(a[27], a[32])
+	a[28], a[36] = max(a[28], a[36]), min(a[28], a[36])
+	a[29], a[37] = max(a[29], a[37]), min(a[29], a[37])
+	a[29], a[36] = max(a[29], a[36]), min(a[29], a[36])
+	a[30], a[38] = max(a[30], a[38]), min(a[30], a[38])
+	a[31], a[39] = max(a[31], a[39]), min(a[31], a[39])
+	a[31], a[38] = max(a[31], a[38]), min(a[31], a[38])
+	a[30], a[36] = max(a[30], a[36]), min(a[30], a[36])
+	a[31], a[37] = max(a[31], a[37]), min(a[31], a[37])
+	a[31], a[36] = max(a[31], a[36]), min(a[31], a[36])
+	a[28], a[32] = max(a[28], a[32]), min(a[28], a[32])
+	a[29], a[33] = max(a[29], a[33]), min(a[29], a[33])
+	a[29], a[32] = max(a[29], a[32]), min(a[29], a[32])
+	a[30], a[34] = max(a[30], a[34]), min(a[30], a[34])
+	a[31], a[35] = max(a[31], a[35]), min(a[31], a[35])
+	a[31], a[34] = max(a[31], a[34]), min(a[31], a[34])
+	a[30], a[32] = max(a[30], a[32]), min(a[30], a[32])
+	a[31], a[33] = max(a[31], a[33]), min(a[31], a[33])
+	a[31], a[32] = max(a[31], a[32]), min(a[31], a[32])
+}
+
+func NetworkSort2xInt(a []int) {
+	_ = a[1]
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+}
+
+func NetworkSort2xIntReverse(a []int) {
+	_ = a[1]
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+}
+
+func NetworkSort3xInt(a []int) {
+	_ = a[2]
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+}
+
+func NetworkSort3xIntReverse(a []int) {
+	_ = a[2]
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+}
+
+func NetworkSort4xInt(a []int) {
+	_ = a[3]
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+}
+
+func NetworkSort4xIntReverse(a []int) {
+	_ = a[3]
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+}
+
+func NetworkSort5xInt(a []int) {
+	_ = a[4]
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[2], a[4] = min(a[2], a[4]), max(a[2], a[4])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[0], a[3] = min(a[0], a[3]), max(a[0], a[3])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[1], a[4] = min(a[1], a[4]), max(a[1], a[4])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+}
+
+func NetworkSort5xIntReverse(a []int) {
+	_ = a[4]
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[2], a[4] = max(a[2], a[4]), min(a[2], a[4])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[0], a[3] = max(a[0], a[3]), min(a[0], a[3])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[1], a[4] = max(a[1], a[4]), min(a[1], a[4])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+}
+
+func NetworkSort6xInt(a []int) {
+	_ = a[5]
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[0], a[3] = min(a[0], a[3]), max(a[0], a[3])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[2], a[5] = min(a[2], a[5]), max(a[2], a[5])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[2], a[4] = min(a[2], a[4]), max(a[2], a[4])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+}
+
+func NetworkSort6xIntReverse(a []int) {
+	_ = a[5]
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[0], a[3] = max(a[0], a[3]), min(a[0], a[3])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[2], a[5] = max(a[2], a[5]), min(a[2], a[5])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[2], a[4] = max(a[2], a[4]), min(a[2], a[4])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+}
+
+func NetworkSort7xInt(a []int) {
+	_ = a[6]
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[0], a[3] = min(a[0], a[3]), max(a[0], a[3])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[2], a[6] = min(a[2], a[6]), max(a[2], a[6])
+	a[2], a[5] = min(a[2], a[5]), max(a[2], a[5])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[2], a[4] = min(a[2], a[4]), max(a[2], a[4])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+}
+
+func NetworkSort7xIntReverse(a []int) {
+	_ = a[6]
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[0], a[3] = max(a[0], a[3]), min(a[0], a[3])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[2], a[6] = max(a[2], a[6]), min(a[2], a[6])
+	a[2], a[5] = max(a[2], a[5]), min(a[2], a[5])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[2], a[4] = max(a[2], a[4]), min(a[2], a[4])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+}
+
+func NetworkSort8xInt(a []int) {
+	_ = a[7]
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[5], a[7] = min(a[5], a[7]), max(a[5], a[7])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[1], a[4] = min(a[1], a[4]), max(a[1], a[4])
+	a[2], a[6] = min(a[2], a[6]), max(a[2], a[6])
+	a[3], a[7] = min(a[3], a[7]), max(a[3], a[7])
+	a[3], a[6] = min(a[3], a[6]), max(a[3], a[6])
+	a[2], a[4] = min(a[2], a[4]), max(a[2], a[4])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+}
+
+func NetworkSort8xIntReverse(a []int) {
+	_ = a[7]
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[5], a[7] = max(a[5], a[7]), min(a[5], a[7])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[1], a[4] = max(a[1], a[4]), min(a[1], a[4])
+	a[2], a[6] = max(a[2], a[6]), min(a[2], a[6])
+	a[3], a[7] = max(a[3], a[7]), min(a[3], a[7])
+	a[3], a[6] = max(a[3], a[6]), min(a[3], a[6])
+	a[2], a[4] = max(a[2], a[4]), min(a[2], a[4])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+}
+
+func NetworkSort9xInt(a []int) {
+	_ = a[8]
+	a[2], a[6] = min(a[2], a[6]), max(a[2], a[6])
+	a[0], a[5] = min(a[0], a[5]), max(a[0], a[5])
+	a[1], a[4] = min(a[1], a[4]), max(a[1], a[4])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+	a[0], a[7] = min(a[0], a[7]), max(a[0], a[7])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[5], a[8] = min(a[5], a[8]), max(a[5], a[8])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[6], a[8] = min(a[6], a[8]), max(a[6], a[8])
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[2], a[7] = min(a[2], a[7]), max(a[2], a[7])
+	a[3], a[7] = min(a[3], a[7]), max(a[3], a[7])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[2], a[4] = min(a[2], a[4]), max(a[2], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+}
+
+func NetworkSort9xIntReverse(a []int) {
+	_ = a[8]
+	a[2], a[6] = max(a[2], a[6]), min(a[2], a[6])
+	a[0], a[5] = max(a[0], a[5]), min(a[0], a[5])
+	a[1], a[4] = max(a[1], a[4]), min(a[1], a[4])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+	a[0], a[7] = max(a[0], a[7]), min(a[0], a[7])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[5], a[8] = max(a[5], a[8]), min(a[5], a[8])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[6], a[8] = max(a[6], a[8]), min(a[6], a[8])
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[2], a[7] = max(a[2], a[7]), min(a[2], a[7])
+	a[3], a[7] = max(a[3], a[7]), min(a[3], a[7])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[2], a[4] = max(a[2], a[4]), min(a[2], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+}
+
+func NetworkSort10xInt(a []int) {
+	_ = a[9]
+	a[1], a[4] = min(a[1], a[4]), max(a[1], a[4])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[0], a[9] = min(a[0], a[9]), max(a[0], a[9])
+	a[2], a[5] = min(a[2], a[5]), max(a[2], a[5])
+	a[0], a[7] = min(a[0], a[7]), max(a[0], a[7])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[3], a[6] = min(a[3], a[6]), max(a[3], a[6])
+	a[4], a[9] = min(a[4], a[9]), max(a[4], a[9])
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[6], a[9] = min(a[6], a[9]), max(a[6], a[9])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[4], a[7] = min(a[4], a[7]), max(a[4], a[7])
+	a[1], a[8] = min(a[1], a[8]), max(a[1], a[8])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[5], a[8] = min(a[5], a[8]), max(a[5], a[8])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[2], a[5] = min(a[2], a[5]), max(a[2], a[5])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+}
+
+func NetworkSort10xIntReverse(a []int) {
+	_ = a[9]
+	a[1], a[4] = max(a[1], a[4]), min(a[1], a[4])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[0], a[9] = max(a[0], a[9]), min(a[0], a[9])
+	a[2], a[5] = max(a[2], a[5]), min(a[2], a[5])
+	a[0], a[7] = max(a[0], a[7]), min(a[0], a[7])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[3], a[6] = max(a[3], a[6]), min(a[3], a[6])
+	a[4], a[9] = max(a[4], a[9]), min(a[4], a[9])
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[6], a[9] = max(a[6], a[9]), min(a[6], a[9])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[4], a[7] = max(a[4], a[7]), min(a[4], a[7])
+	a[1], a[8] = max(a[1], a[8]), min(a[1], a[8])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[5], a[8] = max(a[5], a[8]), min(a[5], a[8])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[2], a[5] = max(a[2], a[5]), min(a[2], a[5])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+}
+
+func NetworkSort11xInt(a []int) {
+	_ = a[10]
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[5], a[7] = min(a[5], a[7]), max(a[5], a[7])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[8], a[10] = min(a[8], a[10]), max(a[8], a[10])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[9], a[10] = min(a[9], a[10]), max(a[9], a[10])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[6], a[10] = min(a[6], a[10]), max(a[6], a[10])
+	a[5], a[9] = min(a[5], a[9]), max(a[5], a[9])
+	a[2], a[6] = min(a[2], a[6]), max(a[2], a[6])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[6], a[10] = min(a[6], a[10]), max(a[6], a[10])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[3], a[7] = min(a[3], a[7]), max(a[3], a[7])
+	a[4], a[8] = min(a[4], a[8]), max(a[4], a[8])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[1], a[4] = min(a[1], a[4]), max(a[1], a[4])
+	a[7], a[10] = min(a[7], a[10]), max(a[7], a[10])
+	a[3], a[8] = min(a[3], a[8]), max(a[3], a[8])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[2], a[4] = min(a[2], a[4]), max(a[2], a[4])
+	a[7], a[9] = min(a[7], a[9]), max(a[7], a[9])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[6], a[8] = min(a[6], a[8]), max(a[6], a[8])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+}
+
+func NetworkSort11xIntReverse(a []int) {
+	_ = a[10]
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[5], a[7] = max(a[5], a[7]), min(a[5], a[7])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[8], a[10] = max(a[8], a[10]), min(a[8], a[10])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[9], a[10] = max(a[9], a[10]), min(a[9], a[10])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[6], a[10] = max(a[6], a[10]), min(a[6], a[10])
+	a[5], a[9] = max(a[5], a[9]), min(a[5], a[9])
+	a[2], a[6] = max(a[2], a[6]), min(a[2], a[6])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[6], a[10] = max(a[6], a[10]), min(a[6], a[10])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[3], a[7] = max(a[3], a[7]), min(a[3], a[7])
+	a[4], a[8] = max(a[4], a[8]), min(a[4], a[8])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[1], a[4] = max(a[1], a[4]), min(a[1], a[4])
+	a[7], a[10] = max(a[7], a[10]), min(a[7], a[10])
+	a[3], a[8] = max(a[3], a[8]), min(a[3], a[8])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[2], a[4] = max(a[2], a[4]), min(a[2], a[4])
+	a[7], a[9] = max(a[7], a[9]), min(a[7], a[9])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[6], a[8] = max(a[6], a[8]), min(a[6], a[8])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+}
+
+func NetworkSort12xInt(a []int) {
+	_ = a[11]
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[10], a[11] = min(a[10], a[11]), max(a[10], a[11])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[5], a[7] = min(a[5], a[7]), max(a[5], a[7])
+	a[9], a[11] = min(a[9], a[11]), max(a[9], a[11])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[8], a[10] = min(a[8], a[10]), max(a[8], a[10])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[9], a[10] = min(a[9], a[10]), max(a[9], a[10])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[6], a[10] = min(a[6], a[10]), max(a[6], a[10])
+	a[5], a[9] = min(a[5], a[9]), max(a[5], a[9])
+	a[2], a[6] = min(a[2], a[6]), max(a[2], a[6])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[6], a[10] = min(a[6], a[10]), max(a[6], a[10])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[7], a[11] = min(a[7], a[11]), max(a[7], a[11])
+	a[3], a[7] = min(a[3], a[7]), max(a[3], a[7])
+	a[4], a[8] = min(a[4], a[8]), max(a[4], a[8])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[7], a[11] = min(a[7], a[11]), max(a[7], a[11])
+	a[1], a[4] = min(a[1], a[4]), max(a[1], a[4])
+	a[7], a[10] = min(a[7], a[10]), max(a[7], a[10])
+	a[3], a[8] = min(a[3], a[8]), max(a[3], a[8])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[2], a[4] = min(a[2], a[4]), max(a[2], a[4])
+	a[7], a[9] = min(a[7], a[9]), max(a[7], a[9])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[6], a[8] = min(a[6], a[8]), max(a[6], a[8])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+}
+
+func NetworkSort12xIntReverse(a []int) {
+	_ = a[11]
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[10], a[11] = max(a[10], a[11]), min(a[10], a[11])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[5], a[7] = max(a[5], a[7]), min(a[5], a[7])
+	a[9], a[11] = max(a[9], a[11]), min(a[9], a[11])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[8], a[10] = max(a[8], a[10]), min(a[8], a[10])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[9], a[10] = max(a[9], a[10]), min(a[9], a[10])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[6], a[10] = max(a[6], a[10]), min(a[6], a[10])
+	a[5], a[9] = max(a[5], a[9]), min(a[5], a[9])
+	a[2], a[6] = max(a[2], a[6]), min(a[2], a[6])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[6], a[10] = max(a[6], a[10]), min(a[6], a[10])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[7], a[11] = max(a[7], a[11]), min(a[7], a[11])
+	a[3], a[7] = max(a[3], a[7]), min(a[3], a[7])
+	a[4], a[8] = max(a[4], a[8]), min(a[4], a[8])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[7], a[11] = max(a[7], a[11]), min(a[7], a[11])
+	a[1], a[4] = max(a[1], a[4]), min(a[1], a[4])
+	a[7], a[10] = max(a[7], a[10]), min(a[7], a[10])
+	a[3], a[8] = max(a[3], a[8]), min(a[3], a[8])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[2], a[4] = max(a[2], a[4]), min(a[2], a[4])
+	a[7], a[9] = max(a[7], a[9]), min(a[7], a[9])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[6], a[8] = max(a[6], a[8]), min(a[6], a[8])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+}
+
+func NetworkSort13xInt(a []int) {
+	_ = a[12]
+	a[1], a[7] = min(a[1], a[7]), max(a[1], a[7])
+	a[9], a[11] = min(a[9], a[11]), max(a[9], a[11])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[5], a[8] = min(a[5], a[8]), max(a[5], a[8])
+	a[0], a[12] = min(a[0], a[12]), max(a[0], a[12])
+	a[2], a[6] = min(a[2], a[6]), max(a[2], a[6])
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[8], a[11] = min(a[8], a[11]), max(a[8], a[11])
+	a[7], a[12] = min(a[7], a[12]), max(a[7], a[12])
+	a[5], a[9] = min(a[5], a[9]), max(a[5], a[9])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[3], a[7] = min(a[3], a[7]), max(a[3], a[7])
+	a[10], a[11] = min(a[10], a[11]), max(a[10], a[11])
+	a[1], a[4] = min(a[1], a[4]), max(a[1], a[4])
+	a[6], a[12] = min(a[6], a[12]), max(a[6], a[12])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+	a[11], a[12] = min(a[11], a[12]), max(a[11], a[12])
+	a[4], a[9] = min(a[4], a[9]), max(a[4], a[9])
+	a[6], a[10] = min(a[6], a[10]), max(a[6], a[10])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[10], a[11] = min(a[10], a[11]), max(a[10], a[11])
+	a[1], a[7] = min(a[1], a[7]), max(a[1], a[7])
+	a[2], a[6] = min(a[2], a[6]), max(a[2], a[6])
+	a[9], a[11] = min(a[9], a[11]), max(a[9], a[11])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[4], a[7] = min(a[4], a[7]), max(a[4], a[7])
+	a[8], a[10] = min(a[8], a[10]), max(a[8], a[10])
+	a[0], a[5] = min(a[0], a[5]), max(a[0], a[5])
+	a[2], a[5] = min(a[2], a[5]), max(a[2], a[5])
+	a[6], a[8] = min(a[6], a[8]), max(a[6], a[8])
+	a[9], a[10] = min(a[9], a[10]), max(a[9], a[10])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+}
+
+func NetworkSort13xIntReverse(a []int) {
+	_ = a[12]
+	a[1], a[7] = max(a[1], a[7]), min(a[1], a[7])
+	a[9], a[11] = max(a[9], a[11]), min(a[9], a[11])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[5], a[8] = max(a[5], a[8]), min(a[5], a[8])
+	a[0], a[12] = max(a[0], a[12]), min(a[0], a[12])
+	a[2], a[6] = max(a[2], a[6]), min(a[2], a[6])
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[8], a[11] = max(a[8], a[11]), min(a[8], a[11])
+	a[7], a[12] = max(a[7], a[12]), min(a[7], a[12])
+	a[5], a[9] = max(a[5], a[9]), min(a[5], a[9])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[3], a[7] = max(a[3], a[7]), min(a[3], a[7])
+	a[10], a[11] = max(a[10], a[11]), min(a[10], a[11])
+	a[1], a[4] = max(a[1], a[4]), min(a[1], a[4])
+	a[6], a[12] = max(a[6], a[12]), min(a[6], a[12])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+	a[11], a[12] = max(a[11], a[12]), min(a[11], a[12])
+	a[4], a[9] = max(a[4], a[9]), min(a[4], a[9])
+	a[6], a[10] = max(a[6], a[10]), min(a[6], a[10])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[10], a[11] = max(a[10], a[11]), min(a[10], a[11])
+	a[1], a[7] = max(a[1], a[7]), min(a[1], a[7])
+	a[2], a[6] = max(a[2], a[6]), min(a[2], a[6])
+	a[9], a[11] = max(a[9], a[11]), min(a[9], a[11])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[4], a[7] = max(a[4], a[7]), min(a[4], a[7])
+	a[8], a[10] = max(a[8], a[10]), min(a[8], a[10])
+	a[0], a[5] = max(a[0], a[5]), min(a[0], a[5])
+	a[2], a[5] = max(a[2], a[5]), min(a[2], a[5])
+	a[6], a[8] = max(a[6], a[8]), min(a[6], a[8])
+	a[9], a[10] = max(a[9], a[10]), min(a[9], a[10])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+}
+
+func NetworkSort14xInt(a []int) {
+	_ = a[13]
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[10], a[11] = min(a[10], a[11]), max(a[10], a[11])
+	a[12], a[13] = min(a[12], a[13]), max(a[12], a[13])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[8], a[10] = min(a[8], a[10]), max(a[8], a[10])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[5], a[7] = min(a[5], a[7]), max(a[5], a[7])
+	a[9], a[11] = min(a[9], a[11]), max(a[9], a[11])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[8], a[12] = min(a[8], a[12]), max(a[8], a[12])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[9], a[13] = min(a[9], a[13]), max(a[9], a[13])
+	a[2], a[6] = min(a[2], a[6]), max(a[2], a[6])
+	a[3], a[7] = min(a[3], a[7]), max(a[3], a[7])
+	a[0], a[8] = min(a[0], a[8]), max(a[0], a[8])
+	a[1], a[9] = min(a[1], a[9]), max(a[1], a[9])
+	a[2], a[10] = min(a[2], a[10]), max(a[2], a[10])
+	a[3], a[11] = min(a[3], a[11]), max(a[3], a[11])
+	a[4], a[12] = min(a[4], a[12]), max(a[4], a[12])
+	a[5], a[13] = min(a[5], a[13]), max(a[5], a[13])
+	a[5], a[10] = min(a[5], a[10]), max(a[5], a[10])
+	a[6], a[9] = min(a[6], a[9]), max(a[6], a[9])
+	a[3], a[12] = min(a[3], a[12]), max(a[3], a[12])
+	a[7], a[11] = min(a[7], a[11]), max(a[7], a[11])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[4], a[8] = min(a[4], a[8]), max(a[4], a[8])
+	a[1], a[4] = min(a[1], a[4]), max(a[1], a[4])
+	a[7], a[13] = min(a[7], a[13]), max(a[7], a[13])
+	a[2], a[8] = min(a[2], a[8]), max(a[2], a[8])
+	a[2], a[4] = min(a[2], a[4]), max(a[2], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[9], a[10] = min(a[9], a[10]), max(a[9], a[10])
+	a[11], a[13] = min(a[11], a[13]), max(a[11], a[13])
+	a[3], a[8] = min(a[3], a[8]), max(a[3], a[8])
+	a[7], a[12] = min(a[7], a[12]), max(a[7], a[12])
+	a[6], a[8] = min(a[6], a[8]), max(a[6], a[8])
+	a[10], a[12] = min(a[10], a[12]), max(a[10], a[12])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[7], a[9] = min(a[7], a[9]), max(a[7], a[9])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+	a[9], a[10] = min(a[9], a[10]), max(a[9], a[10])
+	a[11], a[12] = min(a[11], a[12]), max(a[11], a[12])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+}
+
+func NetworkSort14xIntReverse(a []int) {
+	_ = a[13]
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[10], a[11] = max(a[10], a[11]), min(a[10], a[11])
+	a[12], a[13] = max(a[12], a[13]), min(a[12], a[13])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[8], a[10] = max(a[8], a[10]), min(a[8], a[10])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[5], a[7] = max(a[5], a[7]), min(a[5], a[7])
+	a[9], a[11] = max(a[9], a[11]), min(a[9], a[11])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[8], a[12] = max(a[8], a[12]), min(a[8], a[12])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[9], a[13] = max(a[9], a[13]), min(a[9], a[13])
+	a[2], a[6] = max(a[2], a[6]), min(a[2], a[6])
+	a[3], a[7] = max(a[3], a[7]), min(a[3], a[7])
+	a[0], a[8] = max(a[0], a[8]), min(a[0], a[8])
+	a[1], a[9] = max(a[1], a[9]), min(a[1], a[9])
+	a[2], a[10] = max(a[2], a[10]), min(a[2], a[10])
+	a[3], a[11] = max(a[3], a[11]), min(a[3], a[11])
+	a[4], a[12] = max(a[4], a[12]), min(a[4], a[12])
+	a[5], a[13] = max(a[5], a[13]), min(a[5], a[13])
+	a[5], a[10] = max(a[5], a[10]), min(a[5], a[10])
+	a[6], a[9] = max(a[6], a[9]), min(a[6], a[9])
+	a[3], a[12] = max(a[3], a[12]), min(a[3], a[12])
+	a[7], a[11] = max(a[7], a[11]), min(a[7], a[11])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[4], a[8] = max(a[4], a[8]), min(a[4], a[8])
+	a[1], a[4] = max(a[1], a[4]), min(a[1], a[4])
+	a[7], a[13] = max(a[7], a[13]), min(a[7], a[13])
+	a[2], a[8] = max(a[2], a[8]), min(a[2], a[8])
+	a[2], a[4] = max(a[2], a[4]), min(a[2], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[9], a[10] = max(a[9], a[10]), min(a[9], a[10])
+	a[11], a[13] = max(a[11], a[13]), min(a[11], a[13])
+	a[3], a[8] = max(a[3], a[8]), min(a[3], a[8])
+	a[7], a[12] = max(a[7], a[12]), min(a[7], a[12])
+	a[6], a[8] = max(a[6], a[8]), min(a[6], a[8])
+	a[10], a[12] = max(a[10], a[12]), min(a[10], a[12])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[7], a[9] = max(a[7], a[9]), min(a[7], a[9])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+	a[9], a[10] = max(a[9], a[10]), min(a[9], a[10])
+	a[11], a[12] = max(a[11], a[12]), min(a[11], a[12])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+}
+
+func NetworkSort15xInt(a []int) {
+	_ = a[14]
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[10], a[11] = min(a[10], a[11]), max(a[10], a[11])
+	a[12], a[13] = min(a[12], a[13]), max(a[12], a[13])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[8], a[10] = min(a[8], a[10]), max(a[8], a[10])
+	a[12], a[14] = min(a[12], a[14]), max(a[12], a[14])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[5], a[7] = min(a[5], a[7]), max(a[5], a[7])
+	a[9], a[11] = min(a[9], a[11]), max(a[9], a[11])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[8], a[12] = min(a[8], a[12]), max(a[8], a[12])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[9], a[13] = min(a[9], a[13]), max(a[9], a[13])
+	a[2], a[6] = min(a[2], a[6]), max(a[2], a[6])
+	a[10], a[14] = min(a[10], a[14]), max(a[10], a[14])
+	a[3], a[7] = min(a[3], a[7]), max(a[3], a[7])
+	a[0], a[8] = min(a[0], a[8]), max(a[0], a[8])
+	a[1], a[9] = min(a[1], a[9]), max(a[1], a[9])
+	a[2], a[10] = min(a[2], a[10]), max(a[2], a[10])
+	a[3], a[11] = min(a[3], a[11]), max(a[3], a[11])
+	a[4], a[12] = min(a[4], a[12]), max(a[4], a[12])
+	a[5], a[13] = min(a[5], a[13]), max(a[5], a[13])
+	a[6], a[14] = min(a[6], a[14]), max(a[6], a[14])
+	a[5], a[10] = min(a[5], a[10]), max(a[5], a[10])
+	a[6], a[9] = min(a[6], a[9]), max(a[6], a[9])
+	a[3], a[12] = min(a[3], a[12]), max(a[3], a[12])
+	a[13], a[14] = min(a[13], a[14]), max(a[13], a[14])
+	a[7], a[11] = min(a[7], a[11]), max(a[7], a[11])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[4], a[8] = min(a[4], a[8]), max(a[4], a[8])
+	a[1], a[4] = min(a[1], a[4]), max(a[1], a[4])
+	a[7], a[13] = min(a[7], a[13]), max(a[7], a[13])
+	a[2], a[8] = min(a[2], a[8]), max(a[2], a[8])
+	a[11], a[14] = min(a[11], a[14]), max(a[11], a[14])
+	a[2], a[4] = min(a[2], a[4]), max(a[2], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[9], a[10] = min(a[9], a[10]), max(a[9], a[10])
+	a[11], a[13] = min(a[11], a[13]), max(a[11], a[13])
+	a[3], a[8] = min(a[3], a[8]), max(a[3], a[8])
+	a[7], a[12] = min(a[7], a[12]), max(a[7], a[12])
+	a[6], a[8] = min(a[6], a[8]), max(a[6], a[8])
+	a[10], a[12] = min(a[10], a[12]), max(a[10], a[12])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[7], a[9] = min(a[7], a[9]), max(a[7], a[9])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+	a[9], a[10] = min(a[9], a[10]), max(a[9], a[10])
+	a[11], a[12] = min(a[11], a[12]), max(a[11], a[12])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+}
+
+func NetworkSort15xIntReverse(a []int) {
+	_ = a[14]
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[10], a[11] = max(a[10], a[11]), min(a[10], a[11])
+	a[12], a[13] = max(a[12], a[13]), min(a[12], a[13])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[8], a[10] = max(a[8], a[10]), min(a[8], a[10])
+	a[12], a[14] = max(a[12], a[14]), min(a[12], a[14])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[5], a[7] = max(a[5], a[7]), min(a[5], a[7])
+	a[9], a[11] = max(a[9], a[11]), min(a[9], a[11])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[8], a[12] = max(a[8], a[12]), min(a[8], a[12])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[9], a[13] = max(a[9], a[13]), min(a[9], a[13])
+	a[2], a[6] = max(a[2], a[6]), min(a[2], a[6])
+	a[10], a[14] = max(a[10], a[14]), min(a[10], a[14])
+	a[3], a[7] = max(a[3], a[7]), min(a[3], a[7])
+	a[0], a[8] = max(a[0], a[8]), min(a[0], a[8])
+	a[1], a[9] = max(a[1], a[9]), min(a[1], a[9])
+	a[2], a[10] = max(a[2], a[10]), min(a[2], a[10])
+	a[3], a[11] = max(a[3], a[11]), min(a[3], a[11])
+	a[4], a[12] = max(a[4], a[12]), min(a[4], a[12])
+	a[5], a[13] = max(a[5], a[13]), min(a[5], a[13])
+	a[6], a[14] = max(a[6], a[14]), min(a[6], a[14])
+	a[5], a[10] = max(a[5], a[10]), min(a[5], a[10])
+	a[6], a[9] = max(a[6], a[9]), min(a[6], a[9])
+	a[3], a[12] = max(a[3], a[12]), min(a[3], a[12])
+	a[13], a[14] = max(a[13], a[14]), min(a[13], a[14])
+	a[7], a[11] = max(a[7], a[11]), min(a[7], a[11])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[4], a[8] = max(a[4], a[8]), min(a[4], a[8])
+	a[1], a[4] = max(a[1], a[4]), min(a[1], a[4])
+	a[7], a[13] = max(a[7], a[13]), min(a[7], a[13])
+	a[2], a[8] = max(a[2], a[8]), min(a[2], a[8])
+	a[11], a[14] = max(a[11], a[14]), min(a[11], a[14])
+	a[2], a[4] = max(a[2], a[4]), min(a[2], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[9], a[10] = max(a[9], a[10]), min(a[9], a[10])
+	a[11], a[13] = max(a[11], a[13]), min(a[11], a[13])
+	a[3], a[8] = max(a[3], a[8]), min(a[3], a[8])
+	a[7], a[12] = max(a[7], a[12]), min(a[7], a[12])
+	a[6], a[8] = max(a[6], a[8]), min(a[6], a[8])
+	a[10], a[12] = max(a[10], a[12]), min(a[10], a[12])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[7], a[9] = max(a[7], a[9]), min(a[7], a[9])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+	a[9], a[10] = max(a[9], a[10]), min(a[9], a[10])
+	a[11], a[12] = max(a[11], a[12]), min(a[11], a[12])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+}
+
+func NetworkSort16xInt(a []int) {
+	_ = a[15]
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[10], a[11] = min(a[10], a[11]), max(a[10], a[11])
+	a[12], a[13] = min(a[12], a[13]), max(a[12], a[13])
+	a[14], a[15] = min(a[14], a[15]), max(a[14], a[15])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[8], a[10] = min(a[8], a[10]), max(a[8], a[10])
+	a[12], a[14] = min(a[12], a[14]), max(a[12], a[14])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[5], a[7] = min(a[5], a[7]), max(a[5], a[7])
+	a[9], a[11] = min(a[9], a[11]), max(a[9], a[11])
+	a[13], a[15] = min(a[13], a[15]), max(a[13], a[15])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[8], a[12] = min(a[8], a[12]), max(a[8], a[12])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[9], a[13] = min(a[9], a[13]), max(a[9], a[13])
+	a[2], a[6] = min(a[2], a[6]), max(a[2], a[6])
+	a[10], a[14] = min(a[10], a[14]), max(a[10], a[14])
+	a[3], a[7] = min(a[3], a[7]), max(a[3], a[7])
+	a[11], a[15] = min(a[11], a[15]), max(a[11], a[15])
+	a[0], a[8] = min(a[0], a[8]), max(a[0], a[8])
+	a[1], a[9] = min(a[1], a[9]), max(a[1], a[9])
+	a[2], a[10] = min(a[2], a[10]), max(a[2], a[10])
+	a[3], a[11] = min(a[3], a[11]), max(a[3], a[11])
+	a[4], a[12] = min(a[4], a[12]), max(a[4], a[12])
+	a[5], a[13] = min(a[5], a[13]), max(a[5], a[13])
+	a[6], a[14] = min(a[6], a[14]), max(a[6], a[14])
+	a[7], a[15] = min(a[7], a[15]), max(a[7], a[15])
+	a[5], a[10] = min(a[5], a[10]), max(a[5], a[10])
+	a[6], a[9] = min(a[6], a[9]), max(a[6], a[9])
+	a[3], a[12] = min(a[3], a[12]), max(a[3], a[12])
+	a[13], a[14] = min(a[13], a[14]), max(a[13], a[14])
+	a[7], a[11] = min(a[7], a[11]), max(a[7], a[11])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[4], a[8] = min(a[4], a[8]), max(a[4], a[8])
+	a[1], a[4] = min(a[1], a[4]), max(a[1], a[4])
+	a[7], a[13] = min(a[7], a[13]), max(a[7], a[13])
+	a[2], a[8] = min(a[2], a[8]), max(a[2], a[8])
+	a[11], a[14] = min(a[11], a[14]), max(a[11], a[14])
+	a[2], a[4] = min(a[2], a[4]), max(a[2], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[9], a[10] = min(a[9], a[10]), max(a[9], a[10])
+	a[11], a[13] = min(a[11], a[13]), max(a[11], a[13])
+	a[3], a[8] = min(a[3], a[8]), max(a[3], a[8])
+	a[7], a[12] = min(a[7], a[12]), max(a[7], a[12])
+	a[6], a[8] = min(a[6], a[8]), max(a[6], a[8])
+	a[10], a[12] = min(a[10], a[12]), max(a[10], a[12])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[7], a[9] = min(a[7], a[9]), max(a[7], a[9])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+	a[9], a[10] = min(a[9], a[10]), max(a[9], a[10])
+	a[11], a[12] = min(a[11], a[12]), max(a[11], a[12])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+}
+
+func NetworkSort16xIntReverse(a []int) {
+	_ = a[15]
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[10], a[11] = max(a[10], a[11]), min(a[10], a[11])
+	a[12], a[13] = max(a[12], a[13]), min(a[12], a[13])
+	a[14], a[15] = max(a[14], a[15]), min(a[14], a[15])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[8], a[10] = max(a[8], a[10]), min(a[8], a[10])
+	a[12], a[14] = max(a[12], a[14]), min(a[12], a[14])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[5], a[7] = max(a[5], a[7]), min(a[5], a[7])
+	a[9], a[11] = max(a[9], a[11]), min(a[9], a[11])
+	a[13], a[15] = max(a[13], a[15]), min(a[13], a[15])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[8], a[12] = max(a[8], a[12]), min(a[8], a[12])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[9], a[13] = max(a[9], a[13]), min(a[9], a[13])
+	a[2], a[6] = max(a[2], a[6]), min(a[2], a[6])
+	a[10], a[14] = max(a[10], a[14]), min(a[10], a[14])
+	a[3], a[7] = max(a[3], a[7]), min(a[3], a[7])
+	a[11], a[15] = max(a[11], a[15]), min(a[11], a[15])
+	a[0], a[8] = max(a[0], a[8]), min(a[0], a[8])
+	a[1], a[9] = max(a[1], a[9]), min(a[1], a[9])
+	a[2], a[10] = max(a[2], a[10]), min(a[2], a[10])
+	a[3], a[11] = max(a[3], a[11]), min(a[3], a[11])
+	a[4], a[12] = max(a[4], a[12]), min(a[4], a[12])
+	a[5], a[13] = max(a[5], a[13]), min(a[5], a[13])
+	a[6], a[14] = max(a[6], a[14]), min(a[6], a[14])
+	a[7], a[15] = max(a[7], a[15]), min(a[7], a[15])
+	a[5], a[10] = max(a[5], a[10]), min(a[5], a[10])
+	a[6], a[9] = max(a[6], a[9]), min(a[6], a[9])
+	a[3], a[12] = max(a[3], a[12]), min(a[3], a[12])
+	a[13], a[14] = max(a[13], a[14]), min(a[13], a[14])
+	a[7], a[11] = max(a[7], a[11]), min(a[7], a[11])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[4], a[8] = max(a[4], a[8]), min(a[4], a[8])
+	a[1], a[4] = max(a[1], a[4]), min(a[1], a[4])
+	a[7], a[13] = max(a[7], a[13]), min(a[7], a[13])
+	a[2], a[8] = max(a[2], a[8]), min(a[2], a[8])
+	a[11], a[14] = max(a[11], a[14]), min(a[11], a[14])
+	a[2], a[4] = max(a[2], a[4]), min(a[2], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[9], a[10] = max(a[9], a[10]), min(a[9], a[10])
+	a[11], a[13] = max(a[11], a[13]), min(a[11], a[13])
+	a[3], a[8] = max(a[3], a[8]), min(a[3], a[8])
+	a[7], a[12] = max(a[7], a[12]), min(a[7], a[12])
+	a[6], a[8] = max(a[6], a[8]), min(a[6], a[8])
+	a[10], a[12] = max(a[10], a[12]), min(a[10], a[12])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[7], a[9] = max(a[7], a[9]), min(a[7], a[9])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+	a[9], a[10] = max(a[9], a[10]), min(a[9], a[10])
+	a[11], a[12] = max(a[11], a[12]), min(a[11], a[12])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+}
+
+func NetworkSort24xInt(a []int) {
+	_ = a[23]
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[10], a[11] = min(a[10], a[11]), max(a[10], a[11])
+	a[12], a[13] = min(a[12], a[13]), max(a[12], a[13])
+	a[14], a[15] = min(a[14], a[15]), max(a[14], a[15])
+	a[16], a[17] = min(a[16], a[17]), max(a[16], a[17])
+	a[18], a[19] = min(a[18], a[19]), max(a[18], a[19])
+	a[20], a[21] = min(a[20], a[21]), max(a[20], a[21])
+	a[22], a[23] = min(a[22], a[23]), max(a[22], a[23])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[5], a[7] = min(a[5], a[7]), max(a[5], a[7])
+	a[9], a[11] = min(a[9], a[11]), max(a[9], a[11])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[8], a[10] = min(a[8], a[10]), max(a[8], a[10])
+	a[13], a[15] = min(a[13], a[15]), max(a[13], a[15])
+	a[17], a[19] = min(a[17], a[19]), max(a[17], a[19])
+	a[21], a[23] = min(a[21], a[23]), max(a[21], a[23])
+	a[12], a[14] = min(a[12], a[14]), max(a[12], a[14])
+	a[16], a[18] = min(a[16], a[18]), max(a[16], a[18])
+	a[20], a[22] = min(a[20], a[22]), max(a[20], a[22])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[9], a[10] = min(a[9], a[10]), max(a[9], a[10])
+	a[13], a[14] = min(a[13], a[14]), max(a[13], a[14])
+	a[17], a[18] = min(a[17], a[18]), max(a[17], a[18])
+	a[21], a[22] = min(a[21], a[22]), max(a[21], a[22])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[6], a[10] = min(a[6], a[10]), max(a[6], a[10])
+	a[13], a[17] = min(a[13], a[17]), max(a[13], a[17])
+	a[18], a[22] = min(a[18], a[22]), max(a[18], a[22])
+	a[5], a[9] = min(a[5], a[9]), max(a[5], a[9])
+	a[2], a[6] = min(a[2], a[6]), max(a[2], a[6])
+	a[17], a[21] = min(a[17], a[21]), max(a[17], a[21])
+	a[14], a[18] = min(a[14], a[18]), max(a[14], a[18])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[6], a[10] = min(a[6], a[10]), max(a[6], a[10])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[7], a[11] = min(a[7], a[11]), max(a[7], a[11])
+	a[13], a[17] = min(a[13], a[17]), max(a[13], a[17])
+	a[18], a[22] = min(a[18], a[22]), max(a[18], a[22])
+	a[12], a[16] = min(a[12], a[16]), max(a[12], a[16])
+	a[19], a[23] = min(a[19], a[23]), max(a[19], a[23])
+	a[3], a[7] = min(a[3], a[7]), max(a[3], a[7])
+	a[4], a[8] = min(a[4], a[8]), max(a[4], a[8])
+	a[15], a[19] = min(a[15], a[19]), max(a[15], a[19])
+	a[16], a[20] = min(a[16], a[20]), max(a[16], a[20])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[7], a[11] = min(a[7], a[11]), max(a[7], a[11])
+	a[12], a[16] = min(a[12], a[16]), max(a[12], a[16])
+	a[19], a[23] = min(a[19], a[23]), max(a[19], a[23])
+	a[1], a[4] = min(a[1], a[4]), max(a[1], a[4])
+	a[7], a[10] = min(a[7], a[10]), max(a[7], a[10])
+	a[3], a[8] = min(a[3], a[8]), max(a[3], a[8])
+	a[13], a[16] = min(a[13], a[16]), max(a[13], a[16])
+	a[19], a[22] = min(a[19], a[22]), max(a[19], a[22])
+	a[15], a[20] = min(a[15], a[20]), max(a[15], a[20])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[14], a[15] = min(a[14], a[15]), max(a[14], a[15])
+	a[20], a[21] = min(a[20], a[21]), max(a[20], a[21])
+	a[2], a[4] = min(a[2], a[4]), max(a[2], a[4])
+	a[7], a[9] = min(a[7], a[9]), max(a[7], a[9])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[6], a[8] = min(a[6], a[8]), max(a[6], a[8])
+	a[14], a[16] = min(a[14], a[16]), max(a[14], a[16])
+	a[19], a[21] = min(a[19], a[21]), max(a[19], a[21])
+	a[15], a[17] = min(a[15], a[17]), max(a[15], a[17])
+	a[18], a[20] = min(a[18], a[20]), max(a[18], a[20])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+	a[15], a[16] = min(a[15], a[16]), max(a[15], a[16])
+	a[17], a[18] = min(a[17], a[18]), max(a[17], a[18])
+	a[19], a[20] = min(a[19], a[20]), max(a[19], a[20])
+	a[0], a[12] = min(a[0], a[12]), max(a[0], a[12])
+	a[1], a[13] = min(a[1], a[13]), max(a[1], a[13])
+	a[2], a[14] = min(a[2], a[14]), max(a[2], a[14])
+	a[3], a[15] = min(a[3], a[15]), max(a[3], a[15])
+	a[4], a[16] = min(a[4], a[16]), max(a[4], a[16])
+	a[5], a[17] = min(a[5], a[17]), max(a[5], a[17])
+	a[6], a[18] = min(a[6], a[18]), max(a[6], a[18])
+	a[7], a[19] = min(a[7], a[19]), max(a[7], a[19])
+	a[8], a[20] = min(a[8], a[20]), max(a[8], a[20])
+	a[9], a[21] = min(a[9], a[21]), max(a[9], a[21])
+	a[10], a[22] = min(a[10], a[22]), max(a[10], a[22])
+	a[11], a[23] = min(a[11], a[23]), max(a[11], a[23])
+	a[2], a[12] = min(a[2], a[12]), max(a[2], a[12])
+	a[3], a[13] = min(a[3], a[13]), max(a[3], a[13])
+	a[10], a[20] = min(a[10], a[20]), max(a[10], a[20])
+	a[11], a[21] = min(a[11], a[21]), max(a[11], a[21])
+	a[4], a[12] = min(a[4], a[12]), max(a[4], a[12])
+	a[5], a[13] = min(a[5], a[13]), max(a[5], a[13])
+	a[6], a[14] = min(a[6], a[14]), max(a[6], a[14])
+	a[7], a[15] = min(a[7], a[15]), max(a[7], a[15])
+	a[8], a[16] = min(a[8], a[16]), max(a[8], a[16])
+	a[9], a[17] = min(a[9], a[17]), max(a[9], a[17])
+	a[10], a[18] = min(a[10], a[18]), max(a[10], a[18])
+	a[11], a[19] = min(a[11], a[19]), max(a[11], a[19])
+	a[8], a[12] = min(a[8], a[12]), max(a[8], a[12])
+	a[9], a[13] = min(a[9], a[13]), max(a[9], a[13])
+	a[10], a[14] = min(a[10], a[14]), max(a[10], a[14])
+	a[11], a[15] = min(a[11], a[15]), max(a[11], a[15])
+	a[6], a[8] = min(a[6], a[8]), max(a[6], a[8])
+	a[10], a[12] = min(a[10], a[12]), max(a[10], a[12])
+	a[14], a[16] = min(a[14], a[16]), max(a[14], a[16])
+	a[7], a[9] = min(a[7], a[9]), max(a[7], a[9])
+	a[11], a[13] = min(a[11], a[13]), max(a[11], a[13])
+	a[15], a[17] = min(a[15], a[17]), max(a[15], a[17])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+	a[9], a[10] = min(a[9], a[10]), max(a[9], a[10])
+	a[11], a[12] = min(a[11], a[12]), max(a[11], a[12])
+	a[13], a[14] = min(a[13], a[14]), max(a[13], a[14])
+	a[15], a[16] = min(a[15], a[16]), max(a[15], a[16])
+	a[17], a[18] = min(a[17], a[18]), max(a[17], a[18])
+	a[19], a[20] = min(a[19], a[20]), max(a[19], a[20])
+	a[21], a[22] = min(a[21], a[22]), max(a[21], a[22])
+}
+
+func NetworkSort24xIntReverse(a []int) {
+	_ = a[23]
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[10], a[11] = max(a[10], a[11]), min(a[10], a[11])
+	a[12], a[13] = max(a[12], a[13]), min(a[12], a[13])
+	a[14], a[15] = max(a[14], a[15]), min(a[14], a[15])
+	a[16], a[17] = max(a[16], a[17]), min(a[16], a[17])
+	a[18], a[19] = max(a[18], a[19]), min(a[18], a[19])
+	a[20], a[21] = max(a[20], a[21]), min(a[20], a[21])
+	a[22], a[23] = max(a[22], a[23]), min(a[22], a[23])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[5], a[7] = max(a[5], a[7]), min(a[5], a[7])
+	a[9], a[11] = max(a[9], a[11]), min(a[9], a[11])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[8], a[10] = max(a[8], a[10]), min(a[8], a[10])
+	a[13], a[15] = max(a[13], a[15]), min(a[13], a[15])
+	a[17], a[19] = max(a[17], a[19]), min(a[17], a[19])
+	a[21], a[23] = max(a[21], a[23]), min(a[21], a[23])
+	a[12], a[14] = max(a[12], a[14]), min(a[12], a[14])
+	a[16], a[18] = max(a[16], a[18]), min(a[16], a[18])
+	a[20], a[22] = max(a[20], a[22]), min(a[20], a[22])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[9], a[10] = max(a[9], a[10]), min(a[9], a[10])
+	a[13], a[14] = max(a[13], a[14]), min(a[13], a[14])
+	a[17], a[18] = max(a[17], a[18]), min(a[17], a[18])
+	a[21], a[22] = max(a[21], a[22]), min(a[21], a[22])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[6], a[10] = max(a[6], a[10]), min(a[6], a[10])
+	a[13], a[17] = max(a[13], a[17]), min(a[13], a[17])
+	a[18], a[22] = max(a[18], a[22]), min(a[18], a[22])
+	a[5], a[9] = max(a[5], a[9]), min(a[5], a[9])
+	a[2], a[6] = max(a[2], a[6]), min(a[2], a[6])
+	a[17], a[21] = max(a[17], a[21]), min(a[17], a[21])
+	a[14], a[18] = max(a[14], a[18]), min(a[14], a[18])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[6], a[10] = max(a[6], a[10]), min(a[6], a[10])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[7], a[11] = max(a[7], a[11]), min(a[7], a[11])
+	a[13], a[17] = max(a[13], a[17]), min(a[13], a[17])
+	a[18], a[22] = max(a[18], a[22]), min(a[18], a[22])
+	a[12], a[16] = max(a[12], a[16]), min(a[12], a[16])
+	a[19], a[23] = max(a[19], a[23]), min(a[19], a[23])
+	a[3], a[7] = max(a[3], a[7]), min(a[3], a[7])
+	a[4], a[8] = max(a[4], a[8]), min(a[4], a[8])
+	a[15], a[19] = max(a[15], a[19]), min(a[15], a[19])
+	a[16], a[20] = max(a[16], a[20]), min(a[16], a[20])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[7], a[11] = max(a[7], a[11]), min(a[7], a[11])
+	a[12], a[16] = max(a[12], a[16]), min(a[12], a[16])
+	a[19], a[23] = max(a[19], a[23]), min(a[19], a[23])
+	a[1], a[4] = max(a[1], a[4]), min(a[1], a[4])
+	a[7], a[10] = max(a[7], a[10]), min(a[7], a[10])
+	a[3], a[8] = max(a[3], a[8]), min(a[3], a[8])
+	a[13], a[16] = max(a[13], a[16]), min(a[13], a[16])
+	a[19], a[22] = max(a[19], a[22]), min(a[19], a[22])
+	a[15], a[20] = max(a[15], a[20]), min(a[15], a[20])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[14], a[15] = max(a[14], a[15]), min(a[14], a[15])
+	a[20], a[21] = max(a[20], a[21]), min(a[20], a[21])
+	a[2], a[4] = max(a[2], a[4]), min(a[2], a[4])
+	a[7], a[9] = max(a[7], a[9]), min(a[7], a[9])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[6], a[8] = max(a[6], a[8]), min(a[6], a[8])
+	a[14], a[16] = max(a[14], a[16]), min(a[14], a[16])
+	a[19], a[21] = max(a[19], a[21]), min(a[19], a[21])
+	a[15], a[17] = max(a[15], a[17]), min(a[15], a[17])
+	a[18], a[20] = max(a[18], a[20]), min(a[18], a[20])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+	a[15], a[16] = max(a[15], a[16]), min(a[15], a[16])
+	a[17], a[18] = max(a[17], a[18]), min(a[17], a[18])
+	a[19], a[20] = max(a[19], a[20]), min(a[19], a[20])
+	a[0], a[12] = max(a[0], a[12]), min(a[0], a[12])
+	a[1], a[13] = max(a[1], a[13]), min(a[1], a[13])
+	a[2], a[14] = max(a[2], a[14]), min(a[2], a[14])
+	a[3], a[15] = max(a[3], a[15]), min(a[3], a[15])
+	a[4], a[16] = max(a[4], a[16]), min(a[4], a[16])
+	a[5], a[17] = max(a[5], a[17]), min(a[5], a[17])
+	a[6], a[18] = max(a[6], a[18]), min(a[6], a[18])
+	a[7], a[19] = max(a[7], a[19]), min(a[7], a[19])
+	a[8], a[20] = max(a[8], a[20]), min(a[8], a[20])
+	a[9], a[21] = max(a[9], a[21]), min(a[9], a[21])
+	a[10], a[22] = max(a[10], a[22]), min(a[10], a[22])
+	a[11], a[23] = max(a[11], a[23]), min(a[11], a[23])
+	a[2], a[12] = max(a[2], a[12]), min(a[2], a[12])
+	a[3], a[13] = max(a[3], a[13]), min(a[3], a[13])
+	a[10], a[20] = max(a[10], a[20]), min(a[10], a[20])
+	a[11], a[21] = max(a[11], a[21]), min(a[11], a[21])
+	a[4], a[12] = max(a[4], a[12]), min(a[4], a[12])
+	a[5], a[13] = max(a[5], a[13]), min(a[5], a[13])
+	a[6], a[14] = max(a[6], a[14]), min(a[6], a[14])
+	a[7], a[15] = max(a[7], a[15]), min(a[7], a[15])
+	a[8], a[16] = max(a[8], a[16]), min(a[8], a[16])
+	a[9], a[17] = max(a[9], a[17]), min(a[9], a[17])
+	a[10], a[18] = max(a[10], a[18]), min(a[10], a[18])
+	a[11], a[19] = max(a[11], a[19]), min(a[11], a[19])
+	a[8], a[12] = max(a[8], a[12]), min(a[8], a[12])
+	a[9], a[13] = max(a[9], a[13]), min(a[9], a[13])
+	a[10], a[14] = max(a[10], a[14]), min(a[10], a[14])
+	a[11], a[15] = max(a[11], a[15]), min(a[11], a[15])
+	a[6], a[8] = max(a[6], a[8]), min(a[6], a[8])
+	a[10], a[12] = max(a[10], a[12]), min(a[10], a[12])
+	a[14], a[16] = max(a[14], a[16]), min(a[14], a[16])
+	a[7], a[9] = max(a[7], a[9]), min(a[7], a[9])
+	a[11], a[13] = max(a[11], a[13]), min(a[11], a[13])
+	a[15], a[17] = max(a[15], a[17]), min(a[15], a[17])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+	a[9], a[10] = max(a[9], a[10]), min(a[9], a[10])
+	a[11], a[12] = max(a[11], a[12]), min(a[11], a[12])
+	a[13], a[14] = max(a[13], a[14]), min(a[13], a[14])
+	a[15], a[16] = max(a[15], a[16]), min(a[15], a[16])
+	a[17], a[18] = max(a[17], a[18]), min(a[17], a[18])
+	a[19], a[20] = max(a[19], a[20]), min(a[19], a[20])
+	a[21], a[22] = max(a[21], a[22]), min(a[21], a[22])
+}
+
+func NetworkSort32xInt(a []int) {
+	_ = a[31]
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[5], a[7] = min(a[5], a[7]), max(a[5], a[7])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[1], a[4] = min(a[1], a[4]), max(a[1], a[4])
+	a[2], a[6] = min(a[2], a[6]), max(a[2], a[6])
+	a[3], a[7] = min(a[3], a[7]), max(a[3], a[7])
+	a[3], a[6] = min(a[3], a[6]), max(a[3], a[6])
+	a[2], a[4] = min(a[2], a[4]), max(a[2], a[4])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[10], a[11] = min(a[10], a[11]), max(a[10], a[11])
+	a[8], a[10] = min(a[8], a[10]), max(a[8], a[10])
+	a[9], a[11] = min(a[9], a[11]), max(a[9], a[11])
+	a[9], a[10] = min(a[9], a[10]), max(a[9], a[10])
+	a[12], a[13] = min(a[12], a[13]), max(a[12], a[13])
+	a[14], a[15] = min(a[14], a[15]), max(a[14], a[15])
+	a[12], a[14] = min(a[12], a[14]), max(a[12], a[14])
+	a[13], a[15] = min(a[13], a[15]), max(a[13], a[15])
+	a[13], a[14] = min(a[13], a[14]), max(a[13], a[14])
+	a[8], a[12] = min(a[8], a[12]), max(a[8], a[12])
+	a[9], a[13] = min(a[9], a[13]), max(a[9], a[13])
+	a[9], a[12] = min(a[9], a[12]), max(a[9], a[12])
+	a[10], a[14] = min(a[10], a[14]), max(a[10], a[14])
+	a[11], a[15] = min(a[11], a[15]), max(a[11], a[15])
+	a[11], a[14] = min(a[11], a[14]), max(a[11], a[14])
+	a[10], a[12] = min(a[10], a[12]), max(a[10], a[12])
+	a[11], a[13] = min(a[11], a[13]), max(a[11], a[13])
+	a[11], a[12] = min(a[11], a[12]), max(a[11], a[12])
+	a[0], a[8] = min(a[0], a[8]), max(a[0], a[8])
+	a[1], a[9] = min(a[1], a[9]), max(a[1], a[9])
+	a[1], a[8] = min(a[1], a[8]), max(a[1], a[8])
+	a[2], a[10] = min(a[2], a[10]), max(a[2], a[10])
+	a[3], a[11] = min(a[3], a[11]), max(a[3], a[11])
+	a[3], a[10] = min(a[3], a[10]), max(a[3], a[10])
+	a[2], a[8] = min(a[2], a[8]), max(a[2], a[8])
+	a[3], a[9] = min(a[3], a[9]), max(a[3], a[9])
+	a[3], a[8] = min(a[3], a[8]), max(a[3], a[8])
+	a[4], a[12] = min(a[4], a[12]), max(a[4], a[12])
+	a[5], a[13] = min(a[5], a[13]), max(a[5], a[13])
+	a[5], a[12] = min(a[5], a[12]), max(a[5], a[12])
+	a[6], a[14] = min(a[6], a[14]), max(a[6], a[14])
+	a[7], a[15] = min(a[7], a[15]), max(a[7], a[15])
+	a[7], a[14] = min(a[7], a[14]), max(a[7], a[14])
+	a[6], a[12] = min(a[6], a[12]), max(a[6], a[12])
+	a[7], a[13] = min(a[7], a[13]), max(a[7], a[13])
+	a[7], a[12] = min(a[7], a[12]), max(a[7], a[12])
+	a[4], a[8] = min(a[4], a[8]), max(a[4], a[8])
+	a[5], a[9] = min(a[5], a[9]), max(a[5], a[9])
+	a[5], a[8] = min(a[5], a[8]), max(a[5], a[8])
+	a[6], a[10] = min(a[6], a[10]), max(a[6], a[10])
+	a[7], a[11] = min(a[7], a[11]), max(a[7], a[11])
+	a[7], a[10] = min(a[7], a[10]), max(a[7], a[10])
+	a[6], a[8] = min(a[6], a[8]), max(a[6], a[8])
+	a[7], a[9] = min(a[7], a[9]), max(a[7], a[9])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+	a[16], a[17] = min(a[16], a[17]), max(a[16], a[17])
+	a[18], a[19] = min(a[18], a[19]), max(a[18], a[19])
+	a[16], a[18] = min(a[16], a[18]), max(a[16], a[18])
+	a[17], a[19] = min(a[17], a[19]), max(a[17], a[19])
+	a[17], a[18] = min(a[17], a[18]), max(a[17], a[18])
+	a[20], a[21] = min(a[20], a[21]), max(a[20], a[21])
+	a[22], a[23] = min(a[22], a[23]), max(a[22], a[23])
+	a[20], a[22] = min(a[20], a[22]), max(a[20], a[22])
+	a[21], a[23] = min(a[21], a[23]), max(a[21], a[23])
+	a[21], a[22] = min(a[21], a[22]), max(a[21], a[22])
+	a[16], a[20] = min(a[16], a[20]), max(a[16], a[20])
+	a[17], a[21] = min(a[17], a[21]), max(a[17], a[21])
+	a[17], a[20] = min(a[17], a[20]), max(a[17], a[20])
+	a[18], a[22] = min(a[18], a[22]), max(a[18], a[22])
+	a[19], a[23] = min(a[19], a[23]), max(a[19], a[23])
+	a[19], a[22] = min(a[19], a[22]), max(a[19], a[22])
+	a[18], a[20] = min(a[18], a[20]), max(a[18], a[20])
+	a[19], a[21] = min(a[19], a[21]), max(a[19], a[21])
+	a[19], a[20] = min(a[19], a[20]), max(a[19], a[20])
+	a[24], a[25] = min(a[24], a[25]), max(a[24], a[25])
+	a[26], a[27] = min(a[26], a[27]), max(a[26], a[27])
+	a[24], a[26] = min(a[24], a[26]), max(a[24], a[26])
+	a[25], a[27] = min(a[25], a[27]), max(a[25], a[27])
+	a[25], a[26] = min(a[25], a[26]), max(a[25], a[26])
+	a[28], a[29] = min(a[28], a[29]), max(a[28], a[29])
+	a[30], a[31] = min(a[30], a[31]), max(a[30], a[31])
+	a[28], a[30] = min(a[28], a[30]), max(a[28], a[30])
+	a[29], a[31] = min(a[29], a[31]), max(a[29], a[31])
+	a[29], a[30] = min(a[29], a[30]), max(a[29], a[30])
+	a[24], a[28] = min(a[24], a[28]), max(a[24], a[28])
+	a[25], a[29] = min(a[25], a[29]), max(a[25], a[29])
+	a[25], a[28] = min(a[25], a[28]), max(a[25], a[28])
+	a[26], a[30] = min(a[26], a[30]), max(a[26], a[30])
+	a[27], a[31] = min(a[27], a[31]), max(a[27], a[31])
+	a[27], a[30] = min(a[27], a[30]), max(a[27], a[30])
+	a[26], a[28] = min(a[26], a[28]), max(a[26], a[28])
+	a[27], a[29] = min(a[27], a[29]), max(a[27], a[29])
+	a[27], a[28] = min(a[27], a[28]), max(a[27], a[28])
+	a[16], a[24] = min(a[16], a[24]), max(a[16], a[24])
+	a[17], a[25] = min(a[17], a[25]), max(a[17], a[25])
+	a[17], a[24] = min(a[17], a[24]), max(a[17], a[24])
+	a[18], a[26] = min(a[18], a[26]), max(a[18], a[26])
+	a[19], a[27] = min(a[19], a[27]), max(a[19], a[27])
+	a[19], a[26] = min(a[19], a[26]), max(a[19], a[26])
+	a[18], a[24] = min(a[18], a[24]), max(a[18], a[24])
+	a[19], a[25] = min(a[19], a[25]), max(a[19], a[25])
+	a[19], a[24] = min(a[19], a[24]), max(a[19], a[24])
+	a[20], a[28] = min(a[20], a[28]), max(a[20], a[28])
+	a[21], a[29] = min(a[21], a[29]), max(a[21], a[29])
+	a[21], a[28] = min(a[21], a[28]), max(a[21], a[28])
+	a[22], a[30] = min(a[22], a[30]), max(a[22], a[30])
+	a[23], a[31] = min(a[23], a[31]), max(a[23], a[31])
+	a[23], a[30] = min(a[23], a[30]), max(a[23], a[30])
+	a[22], a[28] = min(a[22], a[28]), max(a[22], a[28])
+	a[23], a[29] = min(a[23], a[29]), max(a[23], a[29])
+	a[23], a[28] = min(a[23], a[28]), max(a[23], a[28])
+	a[20], a[24] = min(a[20], a[24]), max(a[20], a[24])
+	a[21], a[25] = min(a[21], a[25]), max(a[21], a[25])
+	a[21], a[24] = min(a[21], a[24]), max(a[21], a[24])
+	a[22], a[26] = min(a[22], a[26]), max(a[22], a[26])
+	a[23], a[27] = min(a[23], a[27]), max(a[23], a[27])
+	a[23], a[26] = min(a[23], a[26]), max(a[23], a[26])
+	a[22], a[24] = min(a[22], a[24]), max(a[22], a[24])
+	a[23], a[25] = min(a[23], a[25]), max(a[23], a[25])
+	a[23], a[24] = min(a[23], a[24]), max(a[23], a[24])
+	a[0], a[16] = min(a[0], a[16]), max(a[0], a[16])
+	a[1], a[17] = min(a[1], a[17]), max(a[1], a[17])
+	a[1], a[16] = min(a[1], a[16]), max(a[1], a[16])
+	a[2], a[18] = min(a[2], a[18]), max(a[2], a[18])
+	a[3], a[19] = min(a[3], a[19]), max(a[3], a[19])
+	a[3], a[18] = min(a[3], a[18]), max(a[3], a[18])
+	a[2], a[16] = min(a[2], a[16]), max(a[2], a[16])
+	a[3], a[17] = min(a[3], a[17]), max(a[3], a[17])
+	a[3], a[16] = min(a[3], a[16]), max(a[3], a[16])
+	a[4], a[20] = min(a[4], a[20]), max(a[4], a[20])
+	a[5], a[21] = min(a[5], a[21]), max(a[5], a[21])
+	a[5], a[20] = min(a[5], a[20]), max(a[5], a[20])
+	a[6], a[22] = min(a[6], a[22]), max(a[6], a[22])
+	a[7], a[23] = min(a[7], a[23]), max(a[7], a[23])
+	a[7], a[22] = min(a[7], a[22]), max(a[7], a[22])
+	a[6], a[20] = min(a[6], a[20]), max(a[6], a[20])
+	a[7], a[21] = min(a[7], a[21]), max(a[7], a[21])
+	a[7], a[20] = min(a[7], a[20]), max(a[7], a[20])
+	a[4], a[16] = min(a[4], a[16]), max(a[4], a[16])
+	a[5], a[17] = min(a[5], a[17]), max(a[5], a[17])
+	a[5], a[16] = min(a[5], a[16]), max(a[5], a[16])
+	a[6], a[18] = min(a[6], a[18]), max(a[6], a[18])
+	a[7], a[19] = min(a[7], a[19]), max(a[7], a[19])
+	a[7], a[18] = min(a[7], a[18]), max(a[7], a[18])
+	a[6], a[16] = min(a[6], a[16]), max(a[6], a[16])
+	a[7], a[17] = min(a[7], a[17]), max(a[7], a[17])
+	a[7], a[16] = min(a[7], a[16]), max(a[7], a[16])
+	a[8], a[24] = min(a[8], a[24]), max(a[8], a[24])
+	a[9], a[25] = min(a[9], a[25]), max(a[9], a[25])
+	a[9], a[24] = min(a[9], a[24]), max(a[9], a[24])
+	a[10], a[26] = min(a[10], a[26]), max(a[10], a[26])
+	a[11], a[27] = min(a[11], a[27]), max(a[11], a[27])
+	a[11], a[26] = min(a[11], a[26]), max(a[11], a[26])
+	a[10], a[24] = min(a[10], a[24]), max(a[10], a[24])
+	a[11], a[25] = min(a[11], a[25]), max(a[11], a[25])
+	a[11], a[24] = min(a[11], a[24]), max(a[11], a[24])
+	a[12], a[28] = min(a[12], a[28]), max(a[12], a[28])
+	a[13], a[29] = min(a[13], a[29]), max(a[13], a[29])
+	a[13], a[28] = min(a[13], a[28]), max(a[13], a[28])
+	a[14], a[30] = min(a[14], a[30]), max(a[14], a[30])
+	a[15], a[31] = min(a[15], a[31]), max(a[15], a[31])
+	a[15], a[30] = min(a[15], a[30]), max(a[15], a[30])
+	a[14], a[28] = min(a[14], a[28]), max(a[14], a[28])
+	a[15], a[29] = min(a[15], a[29]), max(a[15], a[29])
+	a[15], a[28] = min(a[15], a[28]), max(a[15], a[28])
+	a[12], a[24] = min(a[12], a[24]), max(a[12], a[24])
+	a[13], a[25] = min(a[13], a[25]), max(a[13], a[25])
+	a[13], a[24] = min(a[13], a[24]), max(a[13], a[24])
+	a[14], a[26] = min(a[14], a[26]), max(a[14], a[26])
+	a[15], a[27] = min(a[15], a[27]), max(a[15], a[27])
+	a[15], a[26] = min(a[15], a[26]), max(a[15], a[26])
+	a[14], a[24] = min(a[14], a[24]), max(a[14], a[24])
+	a[15], a[25] = min(a[15], a[25]), max(a[15], a[25])
+	a[15], a[24] = min(a[15], a[24]), max(a[15], a[24])
+	a[8], a[16] = min(a[8], a[16]), max(a[8], a[16])
+	a[9], a[17] = min(a[9], a[17]), max(a[9], a[17])
+	a[9], a[16] = min(a[9], a[16]), max(a[9], a[16])
+	a[10], a[18] = min(a[10], a[18]), max(a[10], a[18])
+	a[11], a[19] = min(a[11], a[19]), max(a[11], a[19])
+	a[11], a[18] = min(a[11], a[18]), max(a[11], a[18])
+	a[10], a[16] = min(a[10], a[16]), max(a[10], a[16])
+	a[11], a[17] = min(a[11], a[17]), max(a[11], a[17])
+	a[11], a[16] = min(a[11], a[16]), max(a[11], a[16])
+	a[12], a[20] = min(a[12], a[20]), max(a[12], a[20])
+	a[13], a[21] = min(a[13], a[21]), max(a[13], a[21])
+	a[13], a[20] = min(a[13], a[20]), max(a[13], a[20])
+	a[14], a[22] = min(a[14], a[22]), max(a[14], a[22])
+	a[15], a[23] = min(a[15], a[23]), max(a[15], a[23])
+	a[15], a[22] = min(a[15], a[22]), max(a[15], a[22])
+	a[14], a[20] = min(a[14], a[20]), max(a[14], a[20])
+	a[15], a[21] = min(a[15], a[21]), max(a[15], a[21])
+	a[15], a[20] = min(a[15], a[20]), max(a[15], a[20])
+	a[12], a[16] = min(a[12], a[16]), max(a[12], a[16])
+	a[13], a[17] = min(a[13], a[17]), max(a[13], a[17])
+	a[13], a[16] = min(a[13], a[16]), max(a[13], a[16])
+	a[14], a[18] = min(a[14], a[18]), max(a[14], a[18])
+	a[15], a[19] = min(a[15], a[19]), max(a[15], a[19])
+	a[15], a[18] = min(a[15], a[18]), max(a[15], a[18])
+	a[14], a[16] = min(a[14], a[16]), max(a[14], a[16])
+	a[15], a[17] = min(a[15], a[17]), max(a[15], a[17])
+	a[15], a[16] = min(a[15], a[16]), max(a[15], a[16])
+}
+
+func NetworkSort32xIntReverse(a []int) {
+	_ = a[31]
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[5], a[7] = max(a[5], a[7]), min(a[5], a[7])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[1], a[4] = max(a[1], a[4]), min(a[1], a[4])
+	a[2], a[6] = max(a[2], a[6]), min(a[2], a[6])
+	a[3], a[7] = max(a[3], a[7]), min(a[3], a[7])
+	a[3], a[6] = max(a[3], a[6]), min(a[3], a[6])
+	a[2], a[4] = max(a[2], a[4]), min(a[2], a[4])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[10], a[11] = max(a[10], a[11]), min(a[10], a[11])
+	a[8], a[10] = max(a[8], a[10]), min(a[8], a[10])
+	a[9], a[11] = max(a[9], a[11]), min(a[9], a[11])
+	a[9], a[10] = max(a[9], a[10]), min(a[9], a[10])
+	a[12], a[13] = max(a[12], a[13]), min(a[12], a[13])
+	a[14], a[15] = max(a[14], a[15]), min(a[14], a[15])
+	a[12], a[14] = max(a[12], a[14]), min(a[12], a[14])
+	a[13], a[15] = max(a[13], a[15]), min(a[13], a[15])
+	a[13], a[14] = max(a[13], a[14]), min(a[13], a[14])
+	a[8], a[12] = max(a[8], a[12]), min(a[8], a[12])
+	a[9], a[13] = max(a[9], a[13]), min(a[9], a[13])
+	a[9], a[12] = max(a[9], a[12]), min(a[9], a[12])
+	a[10], a[14] = max(a[10], a[14]), min(a[10], a[14])
+	a[11], a[15] = max(a[11], a[15]), min(a[11], a[15])
+	a[11], a[14] = max(a[11], a[14]), min(a[11], a[14])
+	a[10], a[12] = max(a[10], a[12]), min(a[10], a[12])
+	a[11], a[13] = max(a[11], a[13]), min(a[11], a[13])
+	a[11], a[12] = max(a[11], a[12]), min(a[11], a[12])
+	a[0], a[8] = max(a[0], a[8]), min(a[0], a[8])
+	a[1], a[9] = max(a[1], a[9]), min(a[1], a[9])
+	a[1], a[8] = max(a[1], a[8]), min(a[1], a[8])
+	a[2], a[10] = max(a[2], a[10]), min(a[2], a[10])
+	a[3], a[11] = max(a[3], a[11]), min(a[3], a[11])
+	a[3], a[10] = max(a[3], a[10]), min(a[3], a[10])
+	a[2], a[8] = max(a[2], a[8]), min(a[2], a[8])
+	a[3], a[9] = max(a[3], a[9]), min(a[3], a[9])
+	a[3], a[8] = max(a[3], a[8]), min(a[3], a[8])
+	a[4], a[12] = max(a[4], a[12]), min(a[4], a[12])
+	a[5], a[13] = max(a[5], a[13]), min(a[5], a[13])
+	a[5], a[12] = max(a[5], a[12]), min(a[5], a[12])
+	a[6], a[14] = max(a[6], a[14]), min(a[6], a[14])
+	a[7], a[15] = max(a[7], a[15]), min(a[7], a[15])
+	a[7], a[14] = max(a[7], a[14]), min(a[7], a[14])
+	a[6], a[12] = max(a[6], a[12]), min(a[6], a[12])
+	a[7], a[13] = max(a[7], a[13]), min(a[7], a[13])
+	a[7], a[12] = max(a[7], a[12]), min(a[7], a[12])
+	a[4], a[8] = max(a[4], a[8]), min(a[4], a[8])
+	a[5], a[9] = max(a[5], a[9]), min(a[5], a[9])
+	a[5], a[8] = max(a[5], a[8]), min(a[5], a[8])
+	a[6], a[10] = max(a[6], a[10]), min(a[6], a[10])
+	a[7], a[11] = max(a[7], a[11]), min(a[7], a[11])
+	a[7], a[10] = max(a[7], a[10]), min(a[7], a[10])
+	a[6], a[8] = max(a[6], a[8]), min(a[6], a[8])
+	a[7], a[9] = max(a[7], a[9]), min(a[7], a[9])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+	a[16], a[17] = max(a[16], a[17]), min(a[16], a[17])
+	a[18], a[19] = max(a[18], a[19]), min(a[18], a[19])
+	a[16], a[18] = max(a[16], a[18]), min(a[16], a[18])
+	a[17], a[19] = max(a[17], a[19]), min(a[17], a[19])
+	a[17], a[18] = max(a[17], a[18]), min(a[17], a[18])
+	a[20], a[21] = max(a[20], a[21]), min(a[20], a[21])
+	a[22], a[23] = max(a[22], a[23]), min(a[22], a[23])
+	a[20], a[22] = max(a[20], a[22]), min(a[20], a[22])
+	a[21], a[23] = max(a[21], a[23]), min(a[21], a[23])
+	a[21], a[22] = max(a[21], a[22]), min(a[21], a[22])
+	a[16], a[20] = max(a[16], a[20]), min(a[16], a[20])
+	a[17], a[21] = max(a[17], a[21]), min(a[17], a[21])
+	a[17], a[20] = max(a[17], a[20]), min(a[17], a[20])
+	a[18], a[22] = max(a[18], a[22]), min(a[18], a[22])
+	a[19], a[23] = max(a[19], a[23]), min(a[19], a[23])
+	a[19], a[22] = max(a[19], a[22]), min(a[19], a[22])
+	a[18], a[20] = max(a[18], a[20]), min(a[18], a[20])
+	a[19], a[21] = max(a[19], a[21]), min(a[19], a[21])
+	a[19], a[20] = max(a[19], a[20]), min(a[19], a[20])
+	a[24], a[25] = max(a[24], a[25]), min(a[24], a[25])
+	a[26], a[27] = max(a[26], a[27]), min(a[26], a[27])
+	a[24], a[26] = max(a[24], a[26]), min(a[24], a[26])
+	a[25], a[27] = max(a[25], a[27]), min(a[25], a[27])
+	a[25], a[26] = max(a[25], a[26]), min(a[25], a[26])
+	a[28], a[29] = max(a[28], a[29]), min(a[28], a[29])
+	a[30], a[31] = max(a[30], a[31]), min(a[30], a[31])
+	a[28], a[30] = max(a[28], a[30]), min(a[28], a[30])
+	a[29], a[31] = max(a[29], a[31]), min(a[29], a[31])
+	a[29], a[30] = max(a[29], a[30]), min(a[29], a[30])
+	a[24], a[28] = max(a[24], a[28]), min(a[24], a[28])
+	a[25], a[29] = max(a[25], a[29]), min(a[25], a[29])
+	a[25], a[28] = max(a[25], a[28]), min(a[25], a[28])
+	a[26], a[30] = max(a[26], a[30]), min(a[26], a[30])
+	a[27], a[31] = max(a[27], a[31]), min(a[27], a[31])
+	a[27], a[30] = max(a[27], a[30]), min(a[27], a[30])
+	a[26], a[28] = max(a[26], a[28]), min(a[26], a[28])
+	a[27], a[29] = max(a[27], a[29]), min(a[27], a[29])
+	a[27], a[28] = max(a[27], a[28]), min(a[27], a[28])
+	a[16], a[24] = max(a[16], a[24]), min(a[16], a[24])
+	a[17], a[25] = max(a[17], a[25]), min(a[17], a[25])
+	a[17], a[24] = max(a[17], a[24]), min(a[17], a[24])
+	a[18], a[26] = max(a[18], a[26]), min(a[18], a[26])
+	a[19], a[27] = max(a[19], a[27]), min(a[19], a[27])
+	a[19], a[26] = max(a[19], a[26]), min(a[19], a[26])
+	a[18], a[24] = max(a[18], a[24]), min(a[18], a[24])
+	a[19], a[25] = max(a[19], a[25]), min(a[19], a[25])
+	a[19], a[24] = max(a[19], a[24]), min(a[19], a[24])
+	a[20], a[28] = max(a[20], a[28]), min(a[20], a[28])
+	a[21], a[29] = max(a[21], a[29]), min(a[21], a[29])
+	a[21], a[28] = max(a[21], a[28]), min(a[21], a[28])
+	a[22], a[30] = max(a[22], a[30]), min(a[22], a[30])
+	a[23], a[31] = max(a[23], a[31]), min(a[23], a[31])
+	a[23], a[30] = max(a[23], a[30]), min(a[23], a[30])
+	a[22], a[28] = max(a[22], a[28]), min(a[22], a[28])
+	a[23], a[29] = max(a[23], a[29]), min(a[23], a[29])
+	a[23], a[28] = max(a[23], a[28]), min(a[23], a[28])
+	a[20], a[24] = max(a[20], a[24]), min(a[20], a[24])
+	a[21], a[25] = max(a[21], a[25]), min(a[21], a[25])
+	a[21], a[24] = max(a[21], a[24]), min(a[21], a[24])
+	a[22], a[26] = max(a[22], a[26]), min(a[22], a[26])
+	a[23], a[27] = max(a[23], a[27]), min(a[23], a[27])
+	a[23], a[26] = max(a[23], a[26]), min(a[23], a[26])
+	a[22], a[24] = max(a[22], a[24]), min(a[22], a[24])
+	a[23], a[25] = max(a[23], a[25]), min(a[23], a[25])
+	a[23], a[24] = max(a[23], a[24]), min(a[23], a[24])
+	a[0], a[16] = max(a[0], a[16]), min(a[0], a[16])
+	a[1], a[17] = max(a[1], a[17]), min(a[1], a[17])
+	a[1], a[16] = max(a[1], a[16]), min(a[1], a[16])
+	a[2], a[18] = max(a[2], a[18]), min(a[2], a[18])
+	a[3], a[19] = max(a[3], a[19]), min(a[3], a[19])
+	a[3], a[18] = max(a[3], a[18]), min(a[3], a[18])
+	a[2], a[16] = max(a[2], a[16]), min(a[2], a[16])
+	a[3], a[17] = max(a[3], a[17]), min(a[3], a[17])
+	a[3], a[16] = max(a[3], a[16]), min(a[3], a[16])
+	a[4], a[20] = max(a[4], a[20]), min(a[4], a[20])
+	a[5], a[21] = max(a[5], a[21]), min(a[5], a[21])
+	a[5], a[20] = max(a[5], a[20]), min(a[5], a[20])
+	a[6], a[22] = max(a[6], a[22]), min(a[6], a[22])
+	a[7], a[23] = max(a[7], a[23]), min(a[7], a[23])
+	a[7], a[22] = max(a[7], a[22]), min(a[7], a[22])
+	a[6], a[20] = max(a[6], a[20]), min(a[6], a[20])
+	a[7], a[21] = max(a[7], a[21]), min(a[7], a[21])
+	a[7], a[20] = max(a[7], a[20]), min(a[7], a[20])
+	a[4], a[16] = max(a[4], a[16]), min(a[4], a[16])
+	a[5], a[17] = max(a[5], a[17]), min(a[5], a[17])
+	a[5], a[16] = max(a[5], a[16]), min(a[5], a[16])
+	a[6], a[18] = max(a[6], a[18]), min(a[6], a[18])
+	a[7], a[19] = max(a[7], a[19]), min(a[7], a[19])
+	a[7], a[18] = max(a[7], a[18]), min(a[7], a[18])
+	a[6], a[16] = max(a[6], a[16]), min(a[6], a[16])
+	a[7], a[17] = max(a[7], a[17]), min(a[7], a[17])
+	a[7], a[16] = max(a[7], a[16]), min(a[7], a[16])
+	a[8], a[24] = max(a[8], a[24]), min(a[8], a[24])
+	a[9], a[25] = max(a[9], a[25]), min(a[9], a[25])
+	a[9], a[24] = max(a[9], a[24]), min(a[9], a[24])
+	a[10], a[26] = max(a[10], a[26]), min(a[10], a[26])
+	a[11], a[27] = max(a[11], a[27]), min(a[11], a[27])
+	a[11], a[26] = max(a[11], a[26]), min(a[11], a[26])
+	a[10], a[24] = max(a[10], a[24]), min(a[10], a[24])
+	a[11], a[25] = max(a[11], a[25]), min(a[11], a[25])
+	a[11], a[24] = max(a[11], a[24]), min(a[11], a[24])
+	a[12], a[28] = max(a[12], a[28]), min(a[12], a[28])
+	a[13], a[29] = max(a[13], a[29]), min(a[13], a[29])
+	a[13], a[28] = max(a[13], a[28]), min(a[13], a[28])
+	a[14], a[30] = max(a[14], a[30]), min(a[14], a[30])
+	a[15], a[31] = max(a[15], a[31]), min(a[15], a[31])
+	a[15], a[30] = max(a[15], a[30]), min(a[15], a[30])
+	a[14], a[28] = max(a[14], a[28]), min(a[14], a[28])
+	a[15], a[29] = max(a[15], a[29]), min(a[15], a[29])
+	a[15], a[28] = max(a[15], a[28]), min(a[15], a[28])
+	a[12], a[24] = max(a[12], a[24]), min(a[12], a[24])
+	a[13], a[25] = max(a[13], a[25]), min(a[13], a[25])
+	a[13], a[24] = max(a[13], a[24]), min(a[13], a[24])
+	a[14], a[26] = max(a[14], a[26]), min(a[14], a[26])
+	a[15], a[27] = max(a[15], a[27]), min(a[15], a[27])
+	a[15], a[26] = max(a[15], a[26]), min(a[15], a[26])
+	a[14], a[24] = max(a[14], a[24]), min(a[14], a[24])
+	a[15], a[25] = max(a[15], a[25]), min(a[15], a[25])
+	a[15], a[24] = max(a[15], a[24]), min(a[15], a[24])
+	a[8], a[16] = max(a[8], a[16]), min(a[8], a[16])
+	a[9], a[17] = max(a[9], a[17]), min(a[9], a[17])
+	a[9], a[16] = max(a[9], a[16]), min(a[9], a[16])
+	a[10], a[18] = max(a[10], a[18]), min(a[10], a[18])
+	a[11], a[19] = max(a[11], a[19]), min(a[11], a[19])
+	a[11], a[18] = max(a[11], a[18]), min(a[11], a[18])
+	a[10], a[16] = max(a[10], a[16]), min(a[10], a[16])
+	a[11], a[17] = max(a[11], a[17]), min(a[11], a[17])
+	a[11], a[16] = max(a[11], a[16]), min(a[11], a[16])
+	a[12], a[20] = max(a[12], a[20]), min(a[12], a[20])
+	a[13], a[21] = max(a[13], a[21]), min(a[13], a[21])
+	a[13], a[20] = max(a[13], a[20]), min(a[13], a[20])
+	a[14], a[22] = max(a[14], a[22]), min(a[14], a[22])
+	a[15], a[23] = max(a[15], a[23]), min(a[15], a[23])
+	a[15], a[22] = max(a[15], a[22]), min(a[15], a[22])
+	a[14], a[20] = max(a[14], a[20]), min(a[14], a[20])
+	a[15], a[21] = max(a[15], a[21]), min(a[15], a[21])
+	a[15], a[20] = max(a[15], a[20]), min(a[15], a[20])
+	a[12], a[16] = max(a[12], a[16]), min(a[12], a[16])
+	a[13], a[17] = max(a[13], a[17]), min(a[13], a[17])
+	a[13], a[16] = max(a[13], a[16]), min(a[13], a[16])
+	a[14], a[18] = max(a[14], a[18]), min(a[14], a[18])
+	a[15], a[19] = max(a[15], a[19]), min(a[15], a[19])
+	a[15], a[18] = max(a[15], a[18]), min(a[15], a[18])
+	a[14], a[16] = max(a[14], a[16]), min(a[14], a[16])
+	a[15], a[17] = max(a[15], a[17]), min(a[15], a[17])
+	a[15], a[16] = max(a[15], a[16]), min(a[15], a[16])
+}
+
+func NetworkSort48xInt(a []int) {
+	_ = a[47]
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[0], a[3] = min(a[0], a[3]), max(a[0], a[3])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[2], a[5] = min(a[2], a[5]), max(a[2], a[5])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[2], a[4] = min(a[2], a[4]), max(a[2], a[4])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+	a[6], a[8] = min(a[6], a[8]), max(a[6], a[8])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[10], a[11] = min(a[10], a[11]), max(a[10], a[11])
+	a[9], a[11] = min(a[9], a[11]), max(a[9], a[11])
+	a[9], a[10] = min(a[9], a[10]), max(a[9], a[10])
+	a[6], a[10] = min(a[6], a[10]), max(a[6], a[10])
+	a[6], a[9] = min(a[6], a[9]), max(a[6], a[9])
+	a[7], a[11] = min(a[7], a[11]), max(a[7], a[11])
+	a[8], a[11] = min(a[8], a[11]), max(a[8], a[11])
+	a[7], a[9] = min(a[7], a[9]), max(a[7], a[9])
+	a[8], a[10] = min(a[8], a[10]), max(a[8], a[10])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[0], a[7] = min(a[0], a[7]), max(a[0], a[7])
+	a[0], a[6] = min(a[0], a[6]), max(a[0], a[6])
+	a[1], a[8] = min(a[1], a[8]), max(a[1], a[8])
+	a[2], a[8] = min(a[2], a[8]), max(a[2], a[8])
+	a[1], a[6] = min(a[1], a[6]), max(a[1], a[6])
+	a[2], a[7] = min(a[2], a[7]), max(a[2], a[7])
+	a[2], a[6] = min(a[2], a[6]), max(a[2], a[6])
+	a[3], a[10] = min(a[3], a[10]), max(a[3], a[10])
+	a[3], a[9] = min(a[3], a[9]), max(a[3], a[9])
+	a[4], a[11] = min(a[4], a[11]), max(a[4], a[11])
+	a[5], a[11] = min(a[5], a[11]), max(a[5], a[11])
+	a[4], a[9] = min(a[4], a[9]), max(a[4], a[9])
+	a[5], a[10] = min(a[5], a[10]), max(a[5], a[10])
+	a[5], a[9] = min(a[5], a[9]), max(a[5], a[9])
+	a[3], a[7] = min(a[3], a[7]), max(a[3], a[7])
+	a[3], a[6] = min(a[3], a[6]), max(a[3], a[6])
+	a[4], a[8] = min(a[4], a[8]), max(a[4], a[8])
+	a[5], a[8] = min(a[5], a[8]), max(a[5], a[8])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[5], a[7] = min(a[5], a[7]), max(a[5], a[7])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[13], a[14] = min(a[13], a[14]), max(a[13], a[14])
+	a[12], a[14] = min(a[12], a[14]), max(a[12], a[14])
+	a[12], a[13] = min(a[12], a[13]), max(a[12], a[13])
+	a[16], a[17] = min(a[16], a[17]), max(a[16], a[17])
+	a[15], a[17] = min(a[15], a[17]), max(a[15], a[17])
+	a[15], a[16] = min(a[15], a[16]), max(a[15], a[16])
+	a[12], a[16] = min(a[12], a[16]), max(a[12], a[16])
+	a[12], a[15] = min(a[12], a[15]), max(a[12], a[15])
+	a[13], a[17] = min(a[13], a[17]), max(a[13], a[17])
+	a[14], a[17] = min(a[14], a[17]), max(a[14], a[17])
+	a[13], a[15] = min(a[13], a[15]), max(a[13], a[15])
+	a[14], a[16] = min(a[14], a[16]), max(a[14], a[16])
+	a[14], a[15] = min(a[14], a[15]), max(a[14], a[15])
+	a[19], a[20] = min(a[19], a[20]), max(a[19], a[20])
+	a[18], a[20] = min(a[18], a[20]), max(a[18], a[20])
+	a[18], a[19] = min(a[18], a[19]), max(a[18], a[19])
+	a[22], a[23] = min(a[22], a[23]), max(a[22], a[23])
+	a[21], a[23] = min(a[21], a[23]), max(a[21], a[23])
+	a[21], a[22] = min(a[21], a[22]), max(a[21], a[22])
+	a[18], a[22] = min(a[18], a[22]), max(a[18], a[22])
+	a[18], a[21] = min(a[18], a[21]), max(a[18], a[21])
+	a[19], a[23] = min(a[19], a[23]), max(a[19], a[23])
+	a[20], a[23] = min(a[20], a[23]), max(a[20], a[23])
+	a[19], a[21] = min(a[19], a[21]), max(a[19], a[21])
+	a[20], a[22] = min(a[20], a[22]), max(a[20], a[22])
+	a[20], a[21] = min(a[20], a[21]), max(a[20], a[21])
+	a[12], a[19] = min(a[12], a[19]), max(a[12], a[19])
+	a[12], a[18] = min(a[12], a[18]), max(a[12], a[18])
+	a[13], a[20] = min(a[13], a[20]), max(a[13], a[20])
+	a[14], a[20] = min(a[14], a[20]), max(a[14], a[20])
+	a[13], a[18] = min(a[13], a[18]), max(a[13], a[18])
+	a[14], a[19] = min(a[14], a[19]), max(a[14], a[19])
+	a[14], a[18] = min(a[14], a[18]), max(a[14], a[18])
+	a[15], a[22] = min(a[15], a[22]), max(a[15], a[22])
+	a[15], a[21] = min(a[15], a[21]), max(a[15], a[21])
+	a[16], a[23] = min(a[16], a[23]), max(a[16], a[23])
+	a[17], a[23] = min(a[17], a[23]), max(a[17], a[23])
+	a[16], a[21] = min(a[16], a[21]), max(a[16], a[21])
+	a[17], a[22] = min(a[17], a[22]), max(a[17], a[22])
+	a[17], a[21] = min(a[17], a[21]), max(a[17], a[21])
+	a[15], a[19] = min(a[15], a[19]), max(a[15], a[19])
+	a[15], a[18] = min(a[15], a[18]), max(a[15], a[18])
+	a[16], a[20] = min(a[16], a[20]), max(a[16], a[20])
+	a[17], a[20] = min(a[17], a[20]), max(a[17], a[20])
+	a[16], a[18] = min(a[16], a[18]), max(a[16], a[18])
+	a[17], a[19] = min(a[17], a[19]), max(a[17], a[19])
+	a[17], a[18] = min(a[17], a[18]), max(a[17], a[18])
+	a[0], a[13] = min(a[0], a[13]), max(a[0], a[13])
+	a[0], a[12] = min(a[0], a[12]), max(a[0], a[12])
+	a[1], a[14] = min(a[1], a[14]), max(a[1], a[14])
+	a[2], a[14] = min(a[2], a[14]), max(a[2], a[14])
+	a[1], a[12] = min(a[1], a[12]), max(a[1], a[12])
+	a[2], a[13] = min(a[2], a[13]), max(a[2], a[13])
+	a[2], a[12] = min(a[2], a[12]), max(a[2], a[12])
+	a[3], a[16] = min(a[3], a[16]), max(a[3], a[16])
+	a[3], a[15] = min(a[3], a[15]), max(a[3], a[15])
+	a[4], a[17] = min(a[4], a[17]), max(a[4], a[17])
+	a[5], a[17] = min(a[5], a[17]), max(a[5], a[17])
+	a[4], a[15] = min(a[4], a[15]), max(a[4], a[15])
+	a[5], a[16] = min(a[5], a[16]), max(a[5], a[16])
+	a[5], a[15] = min(a[5], a[15]), max(a[5], a[15])
+	a[3], a[13] = min(a[3], a[13]), max(a[3], a[13])
+	a[3], a[12] = min(a[3], a[12]), max(a[3], a[12])
+	a[4], a[14] = min(a[4], a[14]), max(a[4], a[14])
+	a[5], a[14] = min(a[5], a[14]), max(a[5], a[14])
+	a[4], a[12] = min(a[4], a[12]), max(a[4], a[12])
+	a[5], a[13] = min(a[5], a[13]), max(a[5], a[13])
+	a[5], a[12] = min(a[5], a[12]), max(a[5], a[12])
+	a[6], a[19] = min(a[6], a[19]), max(a[6], a[19])
+	a[6], a[18] = min(a[6], a[18]), max(a[6], a[18])
+	a[7], a[20] = min(a[7], a[20]), max(a[7], a[20])
+	a[8], a[20] = min(a[8], a[20]), max(a[8], a[20])
+	a[7], a[18] = min(a[7], a[18]), max(a[7], a[18])
+	a[8], a[19] = min(a[8], a[19]), max(a[8], a[19])
+	a[8], a[18] = min(a[8], a[18]), max(a[8], a[18])
+	a[9], a[22] = min(a[9], a[22]), max(a[9], a[22])
+	a[9], a[21] = min(a[9], a[21]), max(a[9], a[21])
+	a[10], a[23] = min(a[10], a[23]), max(a[10], a[23])
+	a[11], a[23] = min(a[11], a[23]), max(a[11], a[23])
+	a[10], a[21] = min(a[10], a[21]), max(a[10], a[21])
+	a[11], a[22] = min(a[11], a[22]), max(a[11], a[22])
+	a[11], a[21] = min(a[11], a[21]), max(a[11], a[21])
+	a[9], a[19] = min(a[9], a[19]), max(a[9], a[19])
+	a[9], a[18] = min(a[9], a[18]), max(a[9], a[18])
+	a[10], a[20] = min(a[10], a[20]), max(a[10], a[20])
+	a[11], a[20] = min(a[11], a[20]), max(a[11], a[20])
+	a[10], a[18] = min(a[10], a[18]), max(a[10], a[18])
+	a[11], a[19] = min(a[11], a[19]), max(a[11], a[19])
+	a[11], a[18] = min(a[11], a[18]), max(a[11], a[18])
+	a[6], a[13] = min(a[6], a[13]), max(a[6], a[13])
+	a[6], a[12] = min(a[6], a[12]), max(a[6], a[12])
+	a[7], a[14] = min(a[7], a[14]), max(a[7], a[14])
+	a[8], a[14] = min(a[8], a[14]), max(a[8], a[14])
+	a[7], a[12] = min(a[7], a[12]), max(a[7], a[12])
+	a[8], a[13] = min(a[8], a[13]), max(a[8], a[13])
+	a[8], a[12] = min(a[8], a[12]), max(a[8], a[12])
+	a[9], a[16] = min(a[9], a[16]), max(a[9], a[16])
+	a[9], a[15] = min(a[9], a[15]), max(a[9], a[15])
+	a[10], a[17] = min(a[10], a[17]), max(a[10], a[17])
+	a[11], a[17] = min(a[11], a[17]), max(a[11], a[17])
+	a[10], a[15] = min(a[10], a[15]), max(a[10], a[15])
+	a[11], a[16] = min(a[11], a[16]), max(a[11], a[16])
+	a[11], a[15] = min(a[11], a[15]), max(a[11], a[15])
+	a[9], a[13] = min(a[9], a[13]), max(a[9], a[13])
+	a[9], a[12] = min(a[9], a[12]), max(a[9], a[12])
+	a[10], a[14] = min(a[10], a[14]), max(a[10], a[14])
+	a[11], a[14] = min(a[11], a[14]), max(a[11], a[14])
+	a[10], a[12] = min(a[10], a[12]), max(a[10], a[12])
+	a[11], a[13] = min(a[11], a[13]), max(a[11], a[13])
+	a[11], a[12] = min(a[11], a[12]), max(a[11], a[12])
+	a[25], a[26] = min(a[25], a[26]), max(a[25], a[26])
+	a[24], a[26] = min(a[24], a[26]), max(a[24], a[26])
+	a[24], a[25] = min(a[24], a[25]), max(a[24], a[25])
+	a[28], a[29] = min(a[28], a[29]), max(a[28], a[29])
+	a[27], a[29] = min(a[27], a[29]), max(a[27], a[29])
+	a[27], a[28] = min(a[27], a[28]), max(a[27], a[28])
+	a[24], a[28] = min(a[24], a[28]), max(a[24], a[28])
+	a[24], a[27] = min(a[24], a[27]), max(a[24], a[27])
+	a[25], a[29] = min(a[25], a[29]), max(a[25], a[29])
+	a[26], a[29] = min(a[26], a[29]), max(a[26], a[29])
+	a[25], a[27] = min(a[25], a[27]), max(a[25], a[27])
+	a[26], a[28] = min(a[26], a[28]), max(a[26], a[28])
+	a[26], a[27] = min(a[26], a[27]), max(a[26], a[27])
+	a[31], a[32] = min(a[31], a[32]), max(a[31], a[32])
+	a[30], a[32] = min(a[30], a[32]), max(a[30], a[32])
+	a[30], a[31] = min(a[30], a[31]), max(a[30], a[31])
+	a[34], a[35] = min(a[34], a[35]), max(a[34], a[35])
+	a[33], a[35] = min(a[33], a[35]), max(a[33], a[35])
+	a[33], a[34] = min(a[33], a[34]), max(a[33], a[34])
+	a[30], a[34] = min(a[30], a[34]), max(a[30], a[34])
+	a[30], a[33] = min(a[30], a[33]), max(a[30], a[33])
+	a[31], a[35] = min(a[31], a[35]), max(a[31], a[35])
+	a[32], a[35] = min(a[32], a[35]), max(a[32], a[35])
+	a[31], a[33] = min(a[31], a[33]), max(a[31], a[33])
+	a[32], a[34] = min(a[32], a[34]), max(a[32], a[34])
+	a[32], a[33] = min(a[32], a[33]), max(a[32], a[33])
+	a[24], a[31] = min(a[24], a[31]), max(a[24], a[31])
+	a[24], a[30] = min(a[24], a[30]), max(a[24], a[30])
+	a[25], a[32] = min(a[25], a[32]), max(a[25], a[32])
+	a[26], a[32] = min(a[26], a[32]), max(a[26], a[32])
+	a[25], a[30] = min(a[25], a[30]), max(a[25], a[30])
+	a[26], a[31] = min(a[26], a[31]), max(a[26], a[31])
+	a[26], a[30] = min(a[26], a[30]), max(a[26], a[30])
+	a[27], a[34] = min(a[27], a[34]), max(a[27], a[34])
+	a[27], a[33] = min(a[27], a[33]), max(a[27], a[33])
+	a[28], a[35] = min(a[28], a[35]), max(a[28], a[35])
+	a[29], a[35] = min(a[29], a[35]), max(a[29], a[35])
+	a[28], a[33] = min(a[28], a[33]), max(a[28], a[33])
+	a[29], a[34] = min(a[29], a[34]), max(a[29], a[34])
+	a[29], a[33] = min(a[29], a[33]), max(a[29], a[33])
+	a[27], a[31] = min(a[27], a[31]), max(a[27], a[31])
+	a[27], a[30] = min(a[27], a[30]), max(a[27], a[30])
+	a[28], a[32] = min(a[28], a[32]), max(a[28], a[32])
+	a[29], a[32] = min(a[29], a[32]), max(a[29], a[32])
+	a[28], a[30] = min(a[28], a[30]), max(a[28], a[30])
+	a[29], a[31] = min(a[29], a[31]), max(a[29], a[31])
+	a[29], a[30] = min(a[29], a[30]), max(a[29], a[30])
+	a[37], a[38] = min(a[37], a[38]), max(a[37], a[38])
+	a[36], a[38] = min(a[36], a[38]), max(a[36], a[38])
+	a[36], a[37] = min(a[36], a[37]), max(a[36], a[37])
+	a[40], a[41] = min(a[40], a[41]), max(a[40], a[41])
+	a[39], a[41] = min(a[39], a[41]), max(a[39], a[41])
+	a[39], a[40] = min(a[39], a[40]), max(a[39], a[40])
+	a[36], a[40] = min(a[36], a[40]), max(a[36], a[40])
+	a[36], a[39] = min(a[36], a[39]), max(a[36], a[39])
+	a[37], a[41] = min(a[37], a[41]), max(a[37], a[41])
+	a[38], a[41] = min(a[38], a[41]), max(a[38], a[41])
+	a[37], a[39] = min(a[37], a[39]), max(a[37], a[39])
+	a[38], a[40] = min(a[38], a[40]), max(a[38], a[40])
+	a[38], a[39] = min(a[38], a[39]), max(a[38], a[39])
+	a[43], a[44] = min(a[43], a[44]), max(a[43], a[44])
+	a[42], a[44] = min(a[42], a[44]), max(a[42], a[44])
+	a[42], a[43] = min(a[42], a[43]), max(a[42], a[43])
+	a[46], a[47] = min(a[46], a[47]), max(a[46], a[47])
+	a[45], a[47] = min(a[45], a[47]), max(a[45], a[47])
+	a[45], a[46] = min(a[45], a[46]), max(a[45], a[46])
+	a[42], a[46] = min(a[42], a[46]), max(a[42], a[46])
+	a[42], a[45] = min(a[42], a[45]), max(a[42], a[45])
+	a[43], a[47] = min(a[43], a[47]), max(a[43], a[47])
+	a[44], a[47] = min(a[44], a[47]), max(a[44], a[47])
+	a[43], a[45] = min(a[43], a[45]), max(a[43], a[45])
+	a[44], a[46] = min(a[44], a[46]), max(a[44], a[46])
+	a[44], a[45] = min(a[44], a[45]), max(a[44], a[45])
+	a[36], a[43] = min(a[36], a[43]), max(a[36], a[43])
+	a[36], a[42] = min(a[36], a[42]), max(a[36], a[42])
+	a[37], a[44] = min(a[37], a[44]), max(a[37], a[44])
+	a[38], a[44] = min(a[38], a[44]), max(a[38], a[44])
+	a[37], a[42] = min(a[37], a[42]), max(a[37], a[42])
+	a[38], a[43] = min(a[38], a[43]), max(a[38], a[43])
+	a[38], a[42] = min(a[38], a[42]), max(a[38], a[42])
+	a[39], a[46] = min(a[39], a[46]), max(a[39], a[46])
+	a[39], a[45] = min(a[39], a[45]), max(a[39], a[45])
+	a[40], a[47] = min(a[40], a[47]), max(a[40], a[47])
+	a[41], a[47] = min(a[41], a[47]), max(a[41], a[47])
+	a[40], a[45] = min(a[40], a[45]), max(a[40], a[45])
+	a[41], a[46] = min(a[41], a[46]), max(a[41], a[46])
+	a[41], a[45] = min(a[41], a[45]), max(a[41], a[45])
+	a[39], a[43] = min(a[39], a[43]), max(a[39], a[43])
+	a[39], a[42] = min(a[39], a[42]), max(a[39], a[42])
+	a[40], a[44] = min(a[40], a[44]), max(a[40], a[44])
+	a[41], a[44] = min(a[41], a[44]), max(a[41], a[44])
+	a[40], a[42] = min(a[40], a[42]), max(a[40], a[42])
+	a[41], a[43] = min(a[41], a[43]), max(a[41], a[43])
+	a[41], a[42] = min(a[41], a[42]), max(a[41], a[42])
+	a[24], a[37] = min(a[24], a[37]), max(a[24], a[37])
+	a[24], a[36] = min(a[24], a[36]), max(a[24], a[36])
+	a[25], a[38] = min(a[25], a[38]), max(a[25], a[38])
+	a[26], a[38] = min(a[26], a[38]), max(a[26], a[38])
+	a[25], a[36] = min(a[25], a[36]), max(a[25], a[36])
+	a[26], a[37] = min(a[26], a[37]), max(a[26], a[37])
+	a[26], a[36] = min(a[26], a[36]), max(a[26], a[36])
+	a[27], a[40] = min(a[27], a[40]), max(a[27], a[40])
+	a[27], a[39] = min(a[27], a[39]), max(a[27], a[39])
+	a[28], a[41] = min(a[28], a[41]), max(a[28], a[41])
+	a[29], a[41] = min(a[29], a[41]), max(a[29], a[41])
+	a[28], a[39] = min(a[28], a[39]), max(a[28], a[39])
+	a[29], a[40] = min(a[29], a[40]), max(a[29], a[40])
+	a[29], a[39] = min(a[29], a[39]), max(a[29], a[39])
+	a[27], a[37] = min(a[27], a[37]), max(a[27], a[37])
+	a[27], a[36] = min(a[27], a[36]), max(a[27], a[36])
+	a[28], a[38] = min(a[28], a[38]), max(a[28], a[38])
+	a[29], a[38] = min(a[29], a[38]), max(a[29], a[38])
+	a[28], a[36] = min(a[28], a[36]), max(a[28], a[36])
+	a[29], a[37] = min(a[29], a[37]), max(a[29], a[37])
+	a[29], a[36] = min(a[29], a[36]), max(a[29], a[36])
+	a[30], a[43] = min(a[30], a[43]), max(a[30], a[43])
+	a[30], a[42] = min(a[30], a[42]), max(a[30], a[42])
+	a[31], a[44] = min(a[31], a[44]), max(a[31], a[44])
+	a[32], a[44] = min(a[32], a[44]), max(a[32], a[44])
+	a[31], a[42] = min(a[31], a[42]), max(a[31], a[42])
+	a[32], a[43] = min(a[32], a[43]), max(a[32], a[43])
+	a[32], a[42] = min(a[32], a[42]), max(a[32], a[42])
+	a[33], a[46] = min(a[33], a[46]), max(a[33], a[46])
+	a[33], a[45] = min(a[33], a[45]), max(a[33], a[45])
+	a[34], a[47] = min(a[34], a[47]), max(a[34], a[47])
+	a[35], a[47] = min(a[35], a[47]), max(a[35], a[47])
+	a[34], a[45] = min(a[34], a[45]), max(a[34], a[45])
+	a[35], a[46] = min(a[35], a[46]), max(a[35], a[46])
+	a[35], a[45] = min(a[35], a[45]), max(a[35], a[45])
+	a[33], a[43] = min(a[33], a[43]), max(a[33], a[43])
+	a[33], a[42] = min(a[33], a[42]), max(a[33], a[42])
+	a[34], a[44] = min(a[34], a[44]), max(a[34], a[44])
+	a[35], a[44] = min(a[35], a[44]), max(a[35], a[44])
+	a[34], a[42] = min(a[34], a[42]), max(a[34], a[42])
+	a[35], a[43] = min(a[35], a[43]), max(a[35], a[43])
+	a[35], a[42] = min(a[35], a[42]), max(a[35], a[42])
+	a[30], a[37] = min(a[30], a[37]), max(a[30], a[37])
+	a[30], a[36] = min(a[30], a[36]), max(a[30], a[36])
+	a[31], a[38] = min(a[31], a[38]), max(a[31], a[38])
+	a[32], a[38] = min(a[32], a[38]), max(a[32], a[38])
+	a[31], a[36] = min(a[31], a[36]), max(a[31], a[36])
+	a[32], a[37] = min(a[32], a[37]), max(a[32], a[37])
+	a[32], a[36] = min(a[32], a[36]), max(a[32], a[36])
+	a[33], a[40] = min(a[33], a[40]), max(a[33], a[40])
+	a[33], a[39] = min(a[33], a[39]), max(a[33], a[39])
+	a[34], a[41] = min(a[34], a[41]), max(a[34], a[41])
+	a[35], a[41] = min(a[35], a[41]), max(a[35], a[41])
+	a[34], a[39] = min(a[34], a[39]), max(a[34], a[39])
+	a[35], a[40] = min(a[35], a[40]), max(a[35], a[40])
+	a[35], a[39] = min(a[35], a[39]), max(a[35], a[39])
+	a[33], a[37] = min(a[33], a[37]), max(a[33], a[37])
+	a[33], a[36] = min(a[33], a[36]), max(a[33], a[36])
+	a[34], a[38] = min(a[34], a[38]), max(a[34], a[38])
+	a[35], a[38] = min(a[35], a[38]), max(a[35], a[38])
+	a[34], a[36] = min(a[34], a[36]), max(a[34], a[36])
+	a[35], a[37] = min(a[35], a[37]), max(a[35], a[37])
+	a[35], a[36] = min(a[35], a[36]), max(a[35], a[36])
+	a[0], a[25] = min(a[0], a[25]), max(a[0], a[25])
+	a[0], a[24] = min(a[0], a[24]), max(a[0], a[24])
+	a[1], a[26] = min(a[1], a[26]), max(a[1], a[26])
+	a[2], a[26] = min(a[2], a[26]), max(a[2], a[26])
+	a[1], a[24] = min(a[1], a[24]), max(a[1], a[24])
+	a[2], a[25] = min(a[2], a[25]), max(a[2], a[25])
+	a[2], a[24] = min(a[2], a[24]), max(a[2], a[24])
+	a[3], a[28] = min(a[3], a[28]), max(a[3], a[28])
+	a[3], a[27] = min(a[3], a[27]), max(a[3], a[27])
+	a[4], a[29] = min(a[4], a[29]), max(a[4], a[29])
+	a[5], a[29] = min(a[5], a[29]), max(a[5], a[29])
+	a[4], a[27] = min(a[4], a[27]), max(a[4], a[27])
+	a[5], a[28] = min(a[5], a[28]), max(a[5], a[28])
+	a[5], a[27] = min(a[5], a[27]), max(a[5], a[27])
+	a[3], a[25] = min(a[3], a[25]), max(a[3], a[25])
+	a[3], a[24] = min(a[3], a[24]), max(a[3], a[24])
+	a[4], a[26] = min(a[4], a[26]), max(a[4], a[26])
+	a[5], a[26] = min(a[5], a[26]), max(a[5], a[26])
+	a[4], a[24] = min(a[4], a[24]), max(a[4], a[24])
+	a[5], a[25] = min(a[5], a[25]), max(a[5], a[25])
+	a[5], a[24] = min(a[5], a[24]), max(a[5], a[24])
+	a[6], a[31] = min(a[6], a[31]), max(a[6], a[31])
+	a[6], a[30] = min(a[6], a[30]), max(a[6], a[30])
+	a[7], a[32] = min(a[7], a[32]), max(a[7], a[32])
+	a[8], a[32] = min(a[8], a[32]), max(a[8], a[32])
+	a[7], a[30] = min(a[7], a[30]), max(a[7], a[30])
+	a[8], a[31] = min(a[8], a[31]), max(a[8], a[31])
+	a[8], a[30] = min(a[8], a[30]), max(a[8], a[30])
+	a[9], a[34] = min(a[9], a[34]), max(a[9], a[34])
+	a[9], a[33] = min(a[9], a[33]), max(a[9], a[33])
+	a[10], a[35] = min(a[10], a[35]), max(a[10], a[35])
+	a[11], a[35] = min(a[11], a[35]), max(a[11], a[35])
+	a[10], a[33] = min(a[10], a[33]), max(a[10], a[33])
+	a[11], a[34] = min(a[11], a[34]), max(a[11], a[34])
+	a[11], a[33] = min(a[11], a[33]), max(a[11], a[33])
+	a[9], a[31] = min(a[9], a[31]), max(a[9], a[31])
+	a[9], a[30] = min(a[9], a[30]), max(a[9], a[30])
+	a[10], a[32] = min(a[10], a[32]), max(a[10], a[32])
+	a[11], a[32] = min(a[11], a[32]), max(a[11], a[32])
+	a[10], a[30] = min(a[10], a[30]), max(a[10], a[30])
+	a[11], a[31] = min(a[11], a[31]), max(a[11], a[31])
+	a[11], a[30] = min(a[11], a[30]), max(a[11], a[30])
+	a[6], a[25] = min(a[6], a[25]), max(a[6], a[25])
+	a[6], a[24] = min(a[6], a[24]), max(a[6], a[24])
+	a[7], a[26] = min(a[7], a[26]), max(a[7], a[26])
+	a[8], a[26] = min(a[8], a[26]), max(a[8], a[26])
+	a[7], a[24] = min(a[7], a[24]), max(a[7], a[24])
+	a[8], a[25] = min(a[8], a[25]), max(a[8], a[25])
+	a[8], a[24] = min(a[8], a[24]), max(a[8], a[24])
+	a[9], a[28] = min(a[9], a[28]), max(a[9], a[28])
+	a[9], a[27] = min(a[9], a[27]), max(a[9], a[27])
+	a[10], a[29] = min(a[10], a[29]), max(a[10], a[29])
+	a[11], a[29] = min(a[11], a[29]), max(a[11], a[29])
+	a[10], a[27] = min(a[10], a[27]), max(a[10], a[27])
+	a[11], a[28] = min(a[11], a[28]), max(a[11], a[28])
+	a[11], a[27] = min(a[11], a[27]), max(a[11], a[27])
+	a[9], a[25] = min(a[9], a[25]), max(a[9], a[25])
+	a[9], a[24] = min(a[9], a[24]), max(a[9], a[24])
+	a[10], a[26] = min(a[10], a[26]), max(a[10], a[26])
+	a[11], a[26] = min(a[11], a[26]), max(a[11], a[26])
+	a[10], a[24] = min(a[10], a[24]), max(a[10], a[24])
+	a[11], a[25] = min(a[11], a[25]), max(a[11], a[25])
+	a[11], a[24] = min(a[11], a[24]), max(a[11], a[24])
+	a[12], a[37] = min(a[12], a[37]), max(a[12], a[37])
+	a[12], a[36] = min(a[12], a[36]), max(a[12], a[36])
+	a[13], a[38] = min(a[13], a[38]), max(a[13], a[38])
+	a[14], a[38] = min(a[14], a[38]), max(a[14], a[38])
+	a[13], a[36] = min(a[13], a[36]), max(a[13], a[36])
+	a[14], a[37] = min(a[14], a[37]), max(a[14], a[37])
+	a[14], a[36] = min(a[14], a[36]), max(a[14], a[36])
+	a[15], a[40] = min(a[15], a[40]), max(a[15], a[40])
+	a[15], a[39] = min(a[15], a[39]), max(a[15], a[39])
+	a[16], a[41] = min(a[16], a[41]), max(a[16], a[41])
+	a[17], a[41] = min(a[17], a[41]), max(a[17], a[41])
+	a[16], a[39] = min(a[16], a[39]), max(a[16], a[39])
+	a[17], a[40] = min(a[17], a[40]), max(a[17], a[40])
+	a[17], a[39] = min(a[17], a[39]), max(a[17], a[39])
+	a[15], a[37] = min(a[15], a[37]), max(a[15], a[37])
+	a[15], a[36] = min(a[15], a[36]), max(a[15], a[36])
+	a[16], a[38] = min(a[16], a[38]), max(a[16], a[38])
+	a[17], a[38] = min(a[17], a[38]), max(a[17], a[38])
+	a[16], a[36] = min(a[16], a[36]), max(a[16], a[36])
+	a[17], a[37] = min(a[17], a[37]), max(a[17], a[37])
+	a[17], a[36] = min(a[17], a[36]), max(a[17], a[36])
+	a[18], a[43] = min(a[18], a[43]), max(a[18], a[43])
+	a[18], a[42] = min(a[18], a[42]), max(a[18], a[42])
+	a[19], a[44] = min(a[19], a[44]), max(a[19], a[44])
+	a[20], a[44] = min(a[20], a[44]), max(a[20], a[44])
+	a[19], a[42] = min(a[19], a[42]), max(a[19], a[42])
+	a[20], a[43] = min(a[20], a[43]), max(a[20], a[43])
+	a[20], a[42] = min(a[20], a[42]), max(a[20], a[42])
+	a[21], a[46] = min(a[21], a[46]), max(a[21], a[46])
+	a[21], a[45] = min(a[21], a[45]), max(a[21], a[45])
+	a[22], a[47] = min(a[22], a[47]), max(a[22], a[47])
+	a[23], a[47] = min(a[23], a[47]), max(a[23], a[47])
+	a[22], a[45] = min(a[22], a[45]), max(a[22], a[45])
+	a[23], a[46] = min(a[23], a[46]), max(a[23], a[46])
+	a[23], a[45] = min(a[23], a[45]), max(a[23], a[45])
+	a[21], a[43] = min(a[21], a[43]), max(a[21], a[43])
+	a[21], a[42] = min(a[21], a[42]), max(a[21], a[42])
+	a[22], a[44] = min(a[22], a[44]), max(a[22], a[44])
+	a[23], a[44] = min(a[23], a[44]), max(a[23], a[44])
+	a[22], a[42] = min(a[22], a[42]), max(a[22], a[42])
+	a[23], a[43] = min(a[23], a[43]), max(a[23], a[43])
+	a[23], a[42] = min(a[23], a[42]), max(a[23], a[42])
+	a[18], a[37] = min(a[18], a[37]), max(a[18], a[37])
+	a[18], a[36] = min(a[18], a[36]), max(a[18], a[36])
+	a[19], a[38] = min(a[19], a[38]), max(a[19], a[38])
+	a[20], a[38] = min(a[20], a[38]), max(a[20], a[38])
+	a[19], a[36] = min(a[19], a[36]), max(a[19], a[36])
+	a[20], a[37] = min(a[20], a[37]), max(a[20], a[37])
+	a[20], a[36] = min(a[20], a[36]), max(a[20], a[36])
+	a[21], a[40] = min(a[21], a[40]), max(a[21], a[40])
+	a[21], a[39] = min(a[21], a[39]), max(a[21], a[39])
+	a[22], a[41] = min(a[22], a[41]), max(a[22], a[41])
+	a[23], a[41] = min(a[23], a[41]), max(a[23], a[41])
+	a[22], a[39] = min(a[22], a[39]), max(a[22], a[39])
+	a[23], a[40] = min(a[23], a[40]), max(a[23], a[40])
+	a[23], a[39] = min(a[23], a[39]), max(a[23], a[39])
+	a[21], a[37] = min(a[21], a[37]), max(a[21], a[37])
+	a[21], a[36] = min(a[21], a[36]), max(a[21], a[36])
+	a[22], a[38] = min(a[22], a[38]), max(a[22], a[38])
+	a[23], a[38] = min(a[23], a[38]), max(a[23], a[38])
+	a[22], a[36] = min(a[22], a[36]), max(a[22], a[36])
+	a[23], a[37] = min(a[23], a[37]), max(a[23], a[37])
+	a[23], a[36] = min(a[23], a[36]), max(a[23], a[36])
+	a[12], a[25] = min(a[12], a[25]), max(a[12], a[25])
+	a[12], a[24] = min(a[12], a[24]), max(a[12], a[24])
+	a[13], a[26] = min(a[13], a[26]), max(a[13], a[26])
+	a[14], a[26] = min(a[14], a[26]), max(a[14], a[26])
+	a[13], a[24] = min(a[13], a[24]), max(a[13], a[24])
+	a[14], a[25] = min(a[14], a[25]), max(a[14], a[25])
+	a[14], a[24] = min(a[14], a[24]), max(a[14], a[24])
+	a[15], a[28] = min(a[15], a[28]), max(a[15], a[28])
+	a[15], a[27] = min(a[15], a[27]), max(a[15], a[27])
+	a[16], a[29] = min(a[16], a[29]), max(a[16], a[29])
+	a[17], a[29] = min(a[17], a[29]), max(a[17], a[29])
+	a[16], a[27] = min(a[16], a[27]), max(a[16], a[27])
+	a[17], a[28] = min(a[17], a[28]), max(a[17], a[28])
+	a[17], a[27] = min(a[17], a[27]), max(a[17], a[27])
+	a[15], a[25] = min(a[15], a[25]), max(a[15], a[25])
+	a[15], a[24] = min(a[15], a[24]), max(a[15], a[24])
+	a[16], a[26] = min(a[16], a[26]), max(a[16], a[26])
+	a[17], a[26] = min(a[17], a[26]), max(a[17], a[26])
+	a[16], a[24] = min(a[16], a[24]), max(a[16], a[24])
+	a[17], a[25] = min(a[17], a[25]), max(a[17], a[25])
+	a[17], a[24] = min(a[17], a[24]), max(a[17], a[24])
+	a[18], a[31] = min(a[18], a[31]), max(a[18], a[31])
+	a[18], a[30] = min(a[18], a[30]), max(a[18], a[30])
+	a[19], a[32] = min(a[19], a[32]), max(a[19], a[32])
+	a[20], a[32] = min(a[20], a[32]), max(a[20], a[32])
+	a[19], a[30] = min(a[19], a[30]), max(a[19], a[30])
+	a[20], a[31] = min(a[20], a[31]), max(a[20], a[31])
+	a[20], a[30] = min(a[20], a[30]), max(a[20], a[30])
+	a[21], a[34] = min(a[21], a[34]), max(a[21], a[34])
+	a[21], a[33] = min(a[21], a[33]), max(a[21], a[33])
+	a[22], a[35] = min(a[22], a[35]), max(a[22], a[35])
+	a[23], a[35] = min(a[23], a[35]), max(a[23], a[35])
+	a[22], a[33] = min(a[22], a[33]), max(a[22], a[33])
+	a[23], a[34] = min(a[23], a[34]), max(a[23], a[34])
+	a[23], a[33] = min(a[23], a[33]), max(a[23], a[33])
+	a[21], a[31] = min(a[21], a[31]), max(a[21], a[31])
+	a[21], a[30] = min(a[21], a[30]), max(a[21], a[30])
+	a[22], a[32] = min(a[22], a[32]), max(a[22], a[32])
+	a[23], a[32] = min(a[23], a[32]), max(a[23], a[32])
+	a[22], a[30] = min(a[22], a[30]), max(a[22], a[30])
+	a[23], a[31] = min(a[23], a[31]), max(a[23], a[31])
+	a[23], a[30] = min(a[23], a[30]), max(a[23], a[30])
+	a[18], a[25] = min(a[18], a[25]), max(a[18], a[25])
+	a[18], a[24] = min(a[18], a[24]), max(a[18], a[24])
+	a[19], a[26] = min(a[19], a[26]), max(a[19], a[26])
+	a[20], a[26] = min(a[20], a[26]), max(a[20], a[26])
+	a[19], a[24] = min(a[19], a[24]), max(a[19], a[24])
+	a[20], a[25] = min(a[20], a[25]), max(a[20], a[25])
+	a[20], a[24] = min(a[20], a[24]), max(a[20], a[24])
+	a[21], a[28] = min(a[21], a[28]), max(a[21], a[28])
+	a[21], a[27] = min(a[21], a[27]), max(a[21], a[27])
+	a[22], a[29] = min(a[22], a[29]), max(a[22], a[29])
+	a[23], a[29] = min(a[23], a[29]), max(a[23], a[29])
+	a[22], a[27] = min(a[22], a[27]), max(a[22], a[27])
+	a[23], a[28] = min(a[23], a[28]), max(a[23], a[28])
+	a[23], a[27] = min(a[23], a[27]), max(a[23], a[27])
+	a[21], a[25] = min(a[21], a[25]), max(a[21], a[25])
+	a[21], a[24] = min(a[21], a[24]), max(a[21], a[24])
+	a[22], a[26] = min(a[22], a[26]), max(a[22], a[26])
+	a[23], a[26] = min(a[23], a[26]), max(a[23], a[26])
+	a[22], a[24] = min(a[22], a[24]), max(a[22], a[24])
+	a[23], a[25] = min(a[23], a[25]), max(a[23], a[25])
+	a[23], a[24] = min(a[23], a[24]), max(a[23], a[24])
+}
+
+func NetworkSort48xIntReverse(a []int) {
+	_ = a[47]
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[0], a[3] = max(a[0], a[3]), min(a[0], a[3])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[2], a[5] = max(a[2], a[5]), min(a[2], a[5])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[2], a[4] = max(a[2], a[4]), min(a[2], a[4])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+	a[6], a[8] = max(a[6], a[8]), min(a[6], a[8])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[10], a[11] = max(a[10], a[11]), min(a[10], a[11])
+	a[9], a[11] = max(a[9], a[11]), min(a[9], a[11])
+	a[9], a[10] = max(a[9], a[10]), min(a[9], a[10])
+	a[6], a[10] = max(a[6], a[10]), min(a[6], a[10])
+	a[6], a[9] = max(a[6], a[9]), min(a[6], a[9])
+	a[7], a[11] = max(a[7], a[11]), min(a[7], a[11])
+	a[8], a[11] = max(a[8], a[11]), min(a[8], a[11])
+	a[7], a[9] = max(a[7], a[9]), min(a[7], a[9])
+	a[8], a[10] = max(a[8], a[10]), min(a[8], a[10])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[0], a[7] = max(a[0], a[7]), min(a[0], a[7])
+	a[0], a[6] = max(a[0], a[6]), min(a[0], a[6])
+	a[1], a[8] = max(a[1], a[8]), min(a[1], a[8])
+	a[2], a[8] = max(a[2], a[8]), min(a[2], a[8])
+	a[1], a[6] = max(a[1], a[6]), min(a[1], a[6])
+	a[2], a[7] = max(a[2], a[7]), min(a[2], a[7])
+	a[2], a[6] = max(a[2], a[6]), min(a[2], a[6])
+	a[3], a[10] = max(a[3], a[10]), min(a[3], a[10])
+	a[3], a[9] = max(a[3], a[9]), min(a[3], a[9])
+	a[4], a[11] = max(a[4], a[11]), min(a[4], a[11])
+	a[5], a[11] = max(a[5], a[11]), min(a[5], a[11])
+	a[4], a[9] = max(a[4], a[9]), min(a[4], a[9])
+	a[5], a[10] = max(a[5], a[10]), min(a[5], a[10])
+	a[5], a[9] = max(a[5], a[9]), min(a[5], a[9])
+	a[3], a[7] = max(a[3], a[7]), min(a[3], a[7])
+	a[3], a[6] = max(a[3], a[6]), min(a[3], a[6])
+	a[4], a[8] = max(a[4], a[8]), min(a[4], a[8])
+	a[5], a[8] = max(a[5], a[8]), min(a[5], a[8])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[5], a[7] = max(a[5], a[7]), min(a[5], a[7])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[13], a[14] = max(a[13], a[14]), min(a[13], a[14])
+	a[12], a[14] = max(a[12], a[14]), min(a[12], a[14])
+	a[12], a[13] = max(a[12], a[13]), min(a[12], a[13])
+	a[16], a[17] = max(a[16], a[17]), min(a[16], a[17])
+	a[15], a[17] = max(a[15], a[17]), min(a[15], a[17])
+	a[15], a[16] = max(a[15], a[16]), min(a[15], a[16])
+	a[12], a[16] = max(a[12], a[16]), min(a[12], a[16])
+	a[12], a[15] = max(a[12], a[15]), min(a[12], a[15])
+	a[13], a[17] = max(a[13], a[17]), min(a[13], a[17])
+	a[14], a[17] = max(a[14], a[17]), min(a[14], a[17])
+	a[13], a[15] = max(a[13], a[15]), min(a[13], a[15])
+	a[14], a[16] = max(a[14], a[16]), min(a[14], a[16])
+	a[14], a[15] = max(a[14], a[15]), min(a[14], a[15])
+	a[19], a[20] = max(a[19], a[20]), min(a[19], a[20])
+	a[18], a[20] = max(a[18], a[20]), min(a[18], a[20])
+	a[18], a[19] = max(a[18], a[19]), min(a[18], a[19])
+	a[22], a[23] = max(a[22], a[23]), min(a[22], a[23])
+	a[21], a[23] = max(a[21], a[23]), min(a[21], a[23])
+	a[21], a[22] = max(a[21], a[22]), min(a[21], a[22])
+	a[18], a[22] = max(a[18], a[22]), min(a[18], a[22])
+	a[18], a[21] = max(a[18], a[21]), min(a[18], a[21])
+	a[19], a[23] = max(a[19], a[23]), min(a[19], a[23])
+	a[20], a[23] = max(a[20], a[23]), min(a[20], a[23])
+	a[19], a[21] = max(a[19], a[21]), min(a[19], a[21])
+	a[20], a[22] = max(a[20], a[22]), min(a[20], a[22])
+	a[20], a[21] = max(a[20], a[21]), min(a[20], a[21])
+	a[12], a[19] = max(a[12], a[19]), min(a[12], a[19])
+	a[12], a[18] = max(a[12], a[18]), min(a[12], a[18])
+	a[13], a[20] = max(a[13], a[20]), min(a[13], a[20])
+	a[14], a[20] = max(a[14], a[20]), min(a[14], a[20])
+	a[13], a[18] = max(a[13], a[18]), min(a[13], a[18])
+	a[14], a[19] = max(a[14], a[19]), min(a[14], a[19])
+	a[14], a[18] = max(a[14], a[18]), min(a[14], a[18])
+	a[15], a[22] = max(a[15], a[22]), min(a[15], a[22])
+	a[15], a[21] = max(a[15], a[21]), min(a[15], a[21])
+	a[16], a[23] = max(a[16], a[23]), min(a[16], a[23])
+	a[17], a[23] = max(a[17], a[23]), min(a[17], a[23])
+	a[16], a[21] = max(a[16], a[21]), min(a[16], a[21])
+	a[17], a[22] = max(a[17], a[22]), min(a[17], a[22])
+	a[17], a[21] = max(a[17], a[21]), min(a[17], a[21])
+	a[15], a[19] = max(a[15], a[19]), min(a[15], a[19])
+	a[15], a[18] = max(a[15], a[18]), min(a[15], a[18])
+	a[16], a[20] = max(a[16], a[20]), min(a[16], a[20])
+	a[17], a[20] = max(a[17], a[20]), min(a[17], a[20])
+	a[16], a[18] = max(a[16], a[18]), min(a[16], a[18])
+	a[17], a[19] = max(a[17], a[19]), min(a[17], a[19])
+	a[17], a[18] = max(a[17], a[18]), min(a[17], a[18])
+	a[0], a[13] = max(a[0], a[13]), min(a[0], a[13])
+	a[0], a[12] = max(a[0], a[12]), min(a[0], a[12])
+	a[1], a[14] = max(a[1], a[14]), min(a[1], a[14])
+	a[2], a[14] = max(a[2], a[14]), min(a[2], a[14])
+	a[1], a[12] = max(a[1], a[12]), min(a[1], a[12])
+	a[2], a[13] = max(a[2], a[13]), min(a[2], a[13])
+	a[2], a[12] = max(a[2], a[12]), min(a[2], a[12])
+	a[3], a[16] = max(a[3], a[16]), min(a[3], a[16])
+	a[3], a[15] = max(a[3], a[15]), min(a[3], a[15])
+	a[4], a[17] = max(a[4], a[17]), min(a[4], a[17])
+	a[5], a[17] = max(a[5], a[17]), min(a[5], a[17])
+	a[4], a[15] = max(a[4], a[15]), min(a[4], a[15])
+	a[5], a[16] = max(a[5], a[16]), min(a[5], a[16])
+	a[5], a[15] = max(a[5], a[15]), min(a[5], a[15])
+	a[3], a[13] = max(a[3], a[13]), min(a[3], a[13])
+	a[3], a[12] = max(a[3], a[12]), min(a[3], a[12])
+	a[4], a[14] = max(a[4], a[14]), min(a[4], a[14])
+	a[5], a[14] = max(a[5], a[14]), min(a[5], a[14])
+	a[4], a[12] = max(a[4], a[12]), min(a[4], a[12])
+	a[5], a[13] = max(a[5], a[13]), min(a[5], a[13])
+	a[5], a[12] = max(a[5], a[12]), min(a[5], a[12])
+	a[6], a[19] = max(a[6], a[19]), min(a[6], a[19])
+	a[6], a[18] = max(a[6], a[18]), min(a[6], a[18])
+	a[7], a[20] = max(a[7], a[20]), min(a[7], a[20])
+	a[8], a[20] = max(a[8], a[20]), min(a[8], a[20])
+	a[7], a[18] = max(a[7], a[18]), min(a[7], a[18])
+	a[8], a[19] = max(a[8], a[19]), min(a[8], a[19])
+	a[8], a[18] = max(a[8], a[18]), min(a[8], a[18])
+	a[9], a[22] = max(a[9], a[22]), min(a[9], a[22])
+	a[9], a[21] = max(a[9], a[21]), min(a[9], a[21])
+	a[10], a[23] = max(a[10], a[23]), min(a[10], a[23])
+	a[11], a[23] = max(a[11], a[23]), min(a[11], a[23])
+	a[10], a[21] = max(a[10], a[21]), min(a[10], a[21])
+	a[11], a[22] = max(a[11], a[22]), min(a[11], a[22])
+	a[11], a[21] = max(a[11], a[21]), min(a[11], a[21])
+	a[9], a[19] = max(a[9], a[19]), min(a[9], a[19])
+	a[9], a[18] = max(a[9], a[18]), min(a[9], a[18])
+	a[10], a[20] = max(a[10], a[20]), min(a[10], a[20])
+	a[11], a[20] = max(a[11], a[20]), min(a[11], a[20])
+	a[10], a[18] = max(a[10], a[18]), min(a[10], a[18])
+	a[11], a[19] = max(a[11], a[19]), min(a[11], a[19])
+	a[11], a[18] = max(a[11], a[18]), min(a[11], a[18])
+	a[6], a[13] = max(a[6], a[13]), min(a[6], a[13])
+	a[6], a[12] = max(a[6], a[12]), min(a[6], a[12])
+	a[7], a[14] = max(a[7], a[14]), min(a[7], a[14])
+	a[8], a[14] = max(a[8], a[14]), min(a[8], a[14])
+	a[7], a[12] = max(a[7], a[12]), min(a[7], a[12])
+	a[8], a[13] = max(a[8], a[13]), min(a[8], a[13])
+	a[8], a[12] = max(a[8], a[12]), min(a[8], a[12])
+	a[9], a[16] = max(a[9], a[16]), min(a[9], a[16])
+	a[9], a[15] = max(a[9], a[15]), min(a[9], a[15])
+	a[10], a[17] = max(a[10], a[17]), min(a[10], a[17])
+	a[11], a[17] = max(a[11], a[17]), min(a[11], a[17])
+	a[10], a[15] = max(a[10], a[15]), min(a[10], a[15])
+	a[11], a[16] = max(a[11], a[16]), min(a[11], a[16])
+	a[11], a[15] = max(a[11], a[15]), min(a[11], a[15])
+	a[9], a[13] = max(a[9], a[13]), min(a[9], a[13])
+	a[9], a[12] = max(a[9], a[12]), min(a[9], a[12])
+	a[10], a[14] = max(a[10], a[14]), min(a[10], a[14])
+	a[11], a[14] = max(a[11], a[14]), min(a[11], a[14])
+	a[10], a[12] = max(a[10], a[12]), min(a[10], a[12])
+	a[11], a[13] = max(a[11], a[13]), min(a[11], a[13])
+	a[11], a[12] = max(a[11], a[12]), min(a[11], a[12])
+	a[25], a[26] = max(a[25], a[26]), min(a[25], a[26])
+	a[24], a[26] = max(a[24], a[26]), min(a[24], a[26])
+	a[24], a[25] = max(a[24], a[25]), min(a[24], a[25])
+	a[28], a[29] = max(a[28], a[29]), min(a[28], a[29])
+	a[27], a[29] = max(a[27], a[29]), min(a[27], a[29])
+	a[27], a[28] = max(a[27], a[28]), min(a[27], a[28])
+	a[24], a[28] = max(a[24], a[28]), min(a[24], a[28])
+	a[24], a[27] = max(a[24], a[27]), min(a[24], a[27])
+	a[25], a[29] = max(a[25], a[29]), min(a[25], a[29])
+	a[26], a[29] = max(a[26], a[29]), min(a[26], a[29])
+	a[25], a[27] = max(a[25], a[27]), min(a[25], a[27])
+	a[26], a[28] = max(a[26], a[28]), min(a[26], a[28])
+	a[26], a[27] = max(a[26], a[27]), min(a[26], a[27])
+	a[31], a[32] = max(a[31], a[32]), min(a[31], a[32])
+	a[30], a[32] = max(a[30], a[32]), min(a[30], a[32])
+	a[30], a[31] = max(a[30], a[31]), min(a[30], a[31])
+	a[34], a[35] = max(a[34], a[35]), min(a[34], a[35])
+	a[33], a[35] = max(a[33], a[35]), min(a[33], a[35])
+	a[33], a[34] = max(a[33], a[34]), min(a[33], a[34])
+	a[30], a[34] = max(a[30], a[34]), min(a[30], a[34])
+	a[30], a[33] = max(a[30], a[33]), min(a[30], a[33])
+	a[31], a[35] = max(a[31], a[35]), min(a[31], a[35])
+	a[32], a[35] = max(a[32], a[35]), min(a[32], a[35])
+	a[31], a[33] = max(a[31], a[33]), min(a[31], a[33])
+	a[32], a[34] = max(a[32], a[34]), min(a[32], a[34])
+	a[32], a[33] = max(a[32], a[33]), min(a[32], a[33])
+	a[24], a[31] = max(a[24], a[31]), min(a[24], a[31])
+	a[24], a[30] = max(a[24], a[30]), min(a[24], a[30])
+	a[25], a[32] = max(a[25], a[32]), min(a[25], a[32])
+	a[26], a[32] = max(a[26], a[32]), min(a[26], a[32])
+	a[25], a[30] = max(a[25], a[30]), min(a[25], a[30])
+	a[26], a[31] = max(a[26], a[31]), min(a[26], a[31])
+	a[26], a[30] = max(a[26], a[30]), min(a[26], a[30])
+	a[27], a[34] = max(a[27], a[34]), min(a[27], a[34])
+	a[27], a[33] = max(a[27], a[33]), min(a[27], a[33])
+	a[28], a[35] = max(a[28], a[35]), min(a[28], a[35])
+	a[29], a[35] = max(a[29], a[35]), min(a[29], a[35])
+	a[28], a[33] = max(a[28], a[33]), min(a[28], a[33])
+	a[29], a[34] = max(a[29], a[34]), min(a[29], a[34])
+	a[29], a[33] = max(a[29], a[33]), min(a[29], a[33])
+	a[27], a[31] = max(a[27], a[31]), min(a[27], a[31])
+	a[27], a[30] = max(a[27], a[30]), min(a[27], a[30])
+	a[28], a[32] = max(a[28], a[32]), min(a[28], a[32])
+	a[29], a[32] = max(a[29], a[32]), min(a[29], a[32])
+	a[28], a[30] = max(a[28], a[30]), min(a[28], a[30])
+	a[29], a[31] = max(a[29], a[31]), min(a[29], a[31])
+	a[29], a[30] = max(a[29], a[30]), min(a[29], a[30])
+	a[37], a[38] = max(a[37], a[38]), min(a[37], a[38])
+	a[36], a[38] = max(a[36], a[38]), min(a[36], a[38])
+	a[36], a[37] = max(a[36], a[37]), min(a[36], a[37])
+	a[40], a[41] = max(a[40], a[41]), min(a[40], a[41])
+	a[39], a[41] = max(a[39], a[41]), min(a[39], a[41])
+	a[39], a[40] = max(a[39], a[40]), min(a[39], a[40])
+	a[36], a[40] = max(a[36], a[40]), min(a[36], a[40])
+	a[36], a[39] = max(a[36], a[39]), min(a[36], a[39])
+	a[37], a[41] = max(a[37], a[41]), min(a[37], a[41])
+	a[38], a[41] = max(a[38], a[41]), min(a[38], a[41])
+	a[37], a[39] = max(a[37], a[39]), min(a[37], a[39])
+	a[38], a[40] = max(a[38], a[40]), min(a[38], a[40])
+	a[38], a[39] = max(a[38], a[39]), min(a[38], a[39])
+	a[43], a[44] = max(a[43], a[44]), min(a[43], a[44])
+	a[42], a[44] = max(a[42], a[44]), min(a[42], a[44])
+	a[42], a[43] = max(a[42], a[43]), min(a[42], a[43])
+	a[46], a[47] = max(a[46], a[47]), min(a[46], a[47])
+	a[45], a[47] = max(a[45], a[47]), min(a[45], a[47])
+	a[45], a[46] = max(a[45], a[46]), min(a[45], a[46])
+	a[42], a[46] = max(a[42], a[46]), min(a[42], a[46])
+	a[42], a[45] = max(a[42], a[45]), min(a[42], a[45])
+	a[43], a[47] = max(a[43], a[47]), min(a[43], a[47])
+	a[44], a[47] = max(a[44], a[47]), min(a[44], a[47])
+	a[43], a[45] = max(a[43], a[45]), min(a[43], a[45])
+	a[44], a[46] = max(a[44], a[46]), min(a[44], a[46])
+	a[44], a[45] = max(a[44], a[45]), min(a[44], a[45])
+	a[36], a[43] = max(a[36], a[43]), min(a[36], a[43])
+	a[36], a[42] = max(a[36], a[42]), min(a[36], a[42])
+	a[37], a[44] = max(a[37], a[44]), min(a[37], a[44])
+	a[38], a[44] = max(a[38], a[44]), min(a[38], a[44])
+	a[37], a[42] = max(a[37], a[42]), min(a[37], a[42])
+	a[38], a[43] = max(a[38], a[43]), min(a[38], a[43])
+	a[38], a[42] = max(a[38], a[42]), min(a[38], a[42])
+	a[39], a[46] = max(a[39], a[46]), min(a[39], a[46])
+	a[39], a[45] = max(a[39], a[45]), min(a[39], a[45])
+	a[40], a[47] = max(a[40], a[47]), min(a[40], a[47])
+	a[41], a[47] = max(a[41], a[47]), min(a[41], a[47])
+	a[40], a[45] = max(a[40], a[45]), min(a[40], a[45])
+	a[41], a[46] = max(a[41], a[46]), min(a[41], a[46])
+	a[41], a[45] = max(a[41], a[45]), min(a[41], a[45])
+	a[39], a[43] = max(a[39], a[43]), min(a[39], a[43])
+	a[39], a[42] = max(a[39], a[42]), min(a[39], a[42])
+	a[40], a[44] = max(a[40], a[44]), min(a[40], a[44])
+	a[41], a[44] = max(a[41], a[44]), min(a[41], a[44])
+	a[40], a[42] = max(a[40], a[42]), min(a[40], a[42])
+	a[41], a[43] = max(a[41], a[43]), min(a[41], a[43])
+	a[41], a[42] = max(a[41], a[42]), min(a[41], a[42])
+	a[24], a[37] = max(a[24], a[37]), min(a[24], a[37])
+	a[24], a[36] = max(a[24], a[36]), min(a[24], a[36])
+	a[25], a[38] = max(a[25], a[38]), min(a[25], a[38])
+	a[26], a[38] = max(a[26], a[38]), min(a[26], a[38])
+	a[25], a[36] = max(a[25], a[36]), min(a[25], a[36])
+	a[26], a[37] = max(a[26], a[37]), min(a[26], a[37])
+	a[26], a[36] = max(a[26], a[36]), min(a[26], a[36])
+	a[27], a[40] = max(a[27], a[40]), min(a[27], a[40])
+	a[27], a[39] = max(a[27], a[39]), min(a[27], a[39])
+	a[28], a[41] = max(a[28], a[41]), min(a[28], a[41])
+	a[29], a[41] = max(a[29], a[41]), min(a[29], a[41])
+	a[28], a[39] = max(a[28], a[39]), min(a[28], a[39])
+	a[29], a[40] = max(a[29], a[40]), min(a[29], a[40])
+	a[29], a[39] = max(a[29], a[39]), min(a[29], a[39])
+	a[27], a[37] = max(a[27], a[37]), min(a[27], a[37])
+	a[27], a[36] = max(a[27], a[36]), min(a[27], a[36])
+	a[28], a[38] = max(a[28], a[38]), min(a[28], a[38])
+	a[29], a[38] = max(a[29], a[38]), min(a[29], a[38])
+	a[28], a[36] = max(a[28], a[36]), min(a[28], a[36])
+	a[29], a[37] = max(a[29], a[37]), min(a[29], a[37])
+	a[29], a[36] = max(a[29], a[36]), min(a[29], a[36])
+	a[30], a[43] = max(a[30], a[43]), min(a[30], a[43])
+	a[30], a[42] = max(a[30], a[42]), min(a[30], a[42])
+	a[31], a[44] = max(a[31], a[44]), min(a[31], a[44])
+	a[32], a[44] = max(a[32], a[44]), min(a[32], a[44])
+	a[31], a[42] = max(a[31], a[42]), min(a[31], a[42])
+	a[32], a[43] = max(a[32], a[43]), min(a[32], a[43])
+	a[32], a[42] = max(a[32], a[42]), min(a[32], a[42])
+	a[33], a[46] = max(a[33], a[46]), min(a[33], a[46])
+	a[33], a[45] = max(a[33], a[45]), min(a[33], a[45])
+	a[34], a[47] = max(a[34], a[47]), min(a[34], a[47])
+	a[35], a[47] = max(a[35], a[47]), min(a[35], a[47])
+	a[34], a[45] = max(a[34], a[45]), min(a[34], a[45])
+	a[35], a[46] = max(a[35], a[46]), min(a[35], a[46])
+	a[35], a[45] = max(a[35], a[45]), min(a[35], a[45])
+	a[33], a[43] = max(a[33], a[43]), min(a[33], a[43])
+	a[33], a[42] = max(a[33], a[42]), min(a[33], a[42])
+	a[34], a[44] = max(a[34], a[44]), min(a[34], a[44])
+	a[35], a[44] = max(a[35], a[44]), min(a[35], a[44])
+	a[34], a[42] = max(a[34], a[42]), min(a[34], a[42])
+	a[35], a[43] = max(a[35], a[43]), min(a[35], a[43])
+	a[35], a[42] = max(a[35], a[42]), min(a[35], a[42])
+	a[30], a[37] = max(a[30], a[37]), min(a[30], a[37])
+	a[30], a[36] = max(a[30], a[36]), min(a[30], a[36])
+	a[31], a[38] = max(a[31], a[38]), min(a[31], a[38])
+	a[32], a[38] = max(a[32], a[38]), min(a[32], a[38])
+	a[31], a[36] = max(a[31], a[36]), min(a[31], a[36])
+	a[32], a[37] = max(a[32], a[37]), min(a[32], a[37])
+	a[32], a[36] = max(a[32], a[36]), min(a[32], a[36])
+	a[33], a[40] = max(a[33], a[40]), min(a[33], a[40])
+	a[33], a[39] = max(a[33], a[39]), min(a[33], a[39])
+	a[34], a[41] = max(a[34], a[41]), min(a[34], a[41])
+	a[35], a[41] = max(a[35], a[41]), min(a[35], a[41])
+	a[34], a[39] = max(a[34], a[39]), min(a[34], a[39])
+	a[35], a[40] = max(a[35], a[40]), min(a[35], a[40])
+	a[35], a[39] = max(a[35], a[39]), min(a[35], a[39])
+	a[33], a[37] = max(a[33], a[37]), min(a[33], a[37])
+	a[33], a[36] = max(a[33], a[36]), min(a[33], a[36])
+	a[34], a[38] = max(a[34], a[38]), min(a[34], a[38])
+	a[35], a[38] = max(a[35], a[38]), min(a[35], a[38])
+	a[34], a[36] = max(a[34], a[36]), min(a[34], a[36])
+	a[35], a[37] = max(a[35], a[37]), min(a[35], a[37])
+	a[35], a[36] = max(a[35], a[36]), min(a[35], a[36])
+	a[0], a[25] = max(a[0], a[25]), min(a[0], a[25])
+	a[0], a[24] = max(a[0], a[24]), min(a[0], a[24])
+	a[1], a[26] = max(a[1], a[26]), min(a[1], a[26])
+	a[2], a[26] = max(a[2], a[26]), min(a[2], a[26])
+	a[1], a[24] = max(a[1], a[24]), min(a[1], a[24])
+	a[2], a[25] = max(a[2], a[25]), min(a[2], a[25])
+	a[2], a[24] = max(a[2], a[24]), min(a[2], a[24])
+	a[3], a[28] = max(a[3], a[28]), min(a[3], a[28])
+	a[3], a[27] = max(a[3], a[27]), min(a[3], a[27])
+	a[4], a[29] = max(a[4], a[29]), min(a[4], a[29])
+	a[5], a[29] = max(a[5], a[29]), min(a[5], a[29])
+	a[4], a[27] = max(a[4], a[27]), min(a[4], a[27])
+	a[5], a[28] = max(a[5], a[28]), min(a[5], a[28])
+	a[5], a[27] = max(a[5], a[27]), min(a[5], a[27])
+	a[3], a[25] = max(a[3], a[25]), min(a[3], a[25])
+	a[3], a[24] = max(a[3], a[24]), min(a[3], a[24])
+	a[4], a[26] = max(a[4], a[26]), min(a[4], a[26])
+	a[5], a[26] = max(a[5], a[26]), min(a[5], a[26])
+	a[4], a[24] = max(a[4], a[24]), min(a[4], a[24])
+	a[5], a[25] = max(a[5], a[25]), min(a[5], a[25])
+	a[5], a[24] = max(a[5], a[24]), min(a[5], a[24])
+	a[6], a[31] = max(a[6], a[31]), min(a[6], a[31])
+	a[6], a[30] = max(a[6], a[30]), min(a[6], a[30])
+	a[7], a[32] = max(a[7], a[32]), min(a[7], a[32])
+	a[8], a[32] = max(a[8], a[32]), min(a[8], a[32])
+	a[7], a[30] = max(a[7], a[30]), min(a[7], a[30])
+	a[8], a[31] = max(a[8], a[31]), min(a[8], a[31])
+	a[8], a[30] = max(a[8], a[30]), min(a[8], a[30])
+	a[9], a[34] = max(a[9], a[34]), min(a[9], a[34])
+	a[9], a[33] = max(a[9], a[33]), min(a[9], a[33])
+	a[10], a[35] = max(a[10], a[35]), min(a[10], a[35])
+	a[11], a[35] = max(a[11], a[35]), min(a[11], a[35])
+	a[10], a[33] = max(a[10], a[33]), min(a[10], a[33])
+	a[11], a[34] = max(a[11], a[34]), min(a[11], a[34])
+	a[11], a[33] = max(a[11], a[33]), min(a[11], a[33])
+	a[9], a[31] = max(a[9], a[31]), min(a[9], a[31])
+	a[9], a[30] = max(a[9], a[30]), min(a[9], a[30])
+	a[10], a[32] = max(a[10], a[32]), min(a[10], a[32])
+	a[11], a[32] = max(a[11], a[32]), min(a[11], a[32])
+	a[10], a[30] = max(a[10], a[30]), min(a[10], a[30])
+	a[11], a[31] = max(a[11], a[31]), min(a[11], a[31])
+	a[11], a[30] = max(a[11], a[30]), min(a[11], a[30])
+	a[6], a[25] = max(a[6], a[25]), min(a[6], a[25])
+	a[6], a[24] = max(a[6], a[24]), min(a[6], a[24])
+	a[7], a[26] = max(a[7], a[26]), min(a[7], a[26])
+	a[8], a[26] = max(a[8], a[26]), min(a[8], a[26])
+	a[7], a[24] = max(a[7], a[24]), min(a[7], a[24])
+	a[8], a[25] = max(a[8], a[25]), min(a[8], a[25])
+	a[8], a[24] = max(a[8], a[24]), min(a[8], a[24])
+	a[9], a[28] = max(a[9], a[28]), min(a[9], a[28])
+	a[9], a[27] = max(a[9], a[27]), min(a[9], a[27])
+	a[10], a[29] = max(a[10], a[29]), min(a[10], a[29])
+	a[11], a[29] = max(a[11], a[29]), min(a[11], a[29])
+	a[10], a[27] = max(a[10], a[27]), min(a[10], a[27])
+	a[11], a[28] = max(a[11], a[28]), min(a[11], a[28])
+	a[11], a[27] = max(a[11], a[27]), min(a[11], a[27])
+	a[9], a[25] = max(a[9], a[25]), min(a[9], a[25])
+	a[9], a[24] = max(a[9], a[24]), min(a[9], a[24])
+	a[10], a[26] = max(a[10], a[26]), min(a[10], a[26])
+	a[11], a[26] = max(a[11], a[26]), min(a[11], a[26])
+	a[10], a[24] = max(a[10], a[24]), min(a[10], a[24])
+	a[11], a[25] = max(a[11], a[25]), min(a[11], a[25])
+	a[11], a[24] = max(a[11], a[24]), min(a[11], a[24])
+	a[12], a[37] = max(a[12], a[37]), min(a[12], a[37])
+	a[12], a[36] = max(a[12], a[36]), min(a[12], a[36])
+	a[13], a[38] = max(a[13], a[38]), min(a[13], a[38])
+	a[14], a[38] = max(a[14], a[38]), min(a[14], a[38])
+	a[13], a[36] = max(a[13], a[36]), min(a[13], a[36])
+	a[14], a[37] = max(a[14], a[37]), min(a[14], a[37])
+	a[14], a[36] = max(a[14], a[36]), min(a[14], a[36])
+	a[15], a[40] = max(a[15], a[40]), min(a[15], a[40])
+	a[15], a[39] = max(a[15], a[39]), min(a[15], a[39])
+	a[16], a[41] = max(a[16], a[41]), min(a[16], a[41])
+	a[17], a[41] = max(a[17], a[41]), min(a[17], a[41])
+	a[16], a[39] = max(a[16], a[39]), min(a[16], a[39])
+	a[17], a[40] = max(a[17], a[40]), min(a[17], a[40])
+	a[17], a[39] = max(a[17], a[39]), min(a[17], a[39])
+	a[15], a[37] = max(a[15], a[37]), min(a[15], a[37])
+	a[15], a[36] = max(a[15], a[36]), min(a[15], a[36])
+	a[16], a[38] = max(a[16], a[38]), min(a[16], a[38])
+	a[17], a[38] = max(a[17], a[38]), min(a[17], a[38])
+	a[16], a[36] = max(a[16], a[36]), min(a[16], a[36])
+	a[17], a[37] = max(a[17], a[37]), min(a[17], a[37])
+	a[17], a[36] = max(a[17], a[36]), min(a[17], a[36])
+	a[18], a[43] = max(a[18], a[43]), min(a[18], a[43])
+	a[18], a[42] = max(a[18], a[42]), min(a[18], a[42])
+	a[19], a[44] = max(a[19], a[44]), min(a[19], a[44])
+	a[20], a[44] = max(a[20], a[44]), min(a[20], a[44])
+	a[19], a[42] = max(a[19], a[42]), min(a[19], a[42])
+	a[20], a[43] = max(a[20], a[43]), min(a[20], a[43])
+	a[20], a[42] = max(a[20], a[42]), min(a[20], a[42])
+	a[21], a[46] = max(a[21], a[46]), min(a[21], a[46])
+	a[21], a[45] = max(a[21], a[45]), min(a[21], a[45])
+	a[22], a[47] = max(a[22], a[47]), min(a[22], a[47])
+	a[23], a[47] = max(a[23], a[47]), min(a[23], a[47])
+	a[22], a[45] = max(a[22], a[45]), min(a[22], a[45])
+	a[23], a[46] = max(a[23], a[46]), min(a[23], a[46])
+	a[23], a[45] = max(a[23], a[45]), min(a[23], a[45])
+	a[21], a[43] = max(a[21], a[43]), min(a[21], a[43])
+	a[21], a[42] = max(a[21], a[42]), min(a[21], a[42])
+	a[22], a[44] = max(a[22], a[44]), min(a[22], a[44])
+	a[23], a[44] = max(a[23], a[44]), min(a[23], a[44])
+	a[22], a[42] = max(a[22], a[42]), min(a[22], a[42])
+	a[23], a[43] = max(a[23], a[43]), min(a[23], a[43])
+	a[23], a[42] = max(a[23], a[42]), min(a[23], a[42])
+	a[18], a[37] = max(a[18], a[37]), min(a[18], a[37])
+	a[18], a[36] = max(a[18], a[36]), min(a[18], a[36])
+	a[19], a[38] = max(a[19], a[38]), min(a[19], a[38])
+	a[20], a[38] = max(a[20], a[38]), min(a[20], a[38])
+	a[19], a[36] = max(a[19], a[36]), min(a[19], a[36])
+	a[20], a[37] = max(a[20], a[37]), min(a[20], a[37])
+	a[20], a[36] = max(a[20], a[36]), min(a[20], a[36])
+	a[21], a[40] = max(a[21], a[40]), min(a[21], a[40])
+	a[21], a[39] = max(a[21], a[39]), min(a[21], a[39])
+	a[22], a[41] = max(a[22], a[41]), min(a[22], a[41])
+	a[23], a[41] = max(a[23], a[41]), min(a[23], a[41])
+	a[22], a[39] = max(a[22], a[39]), min(a[22], a[39])
+	a[23], a[40] = max(a[23], a[40]), min(a[23], a[40])
+	a[23], a[39] = max(a[23], a[39]), min(a[23], a[39])
+	a[21], a[37] = max(a[21], a[37]), min(a[21], a[37])
+	a[21], a[36] = max(a[21], a[36]), min(a[21], a[36])
+	a[22], a[38] = max(a[22], a[38]), min(a[22], a[38])
+	a[23], a[38] = max(a[23], a[38]), min(a[23], a[38])
+	a[22], a[36] = max(a[22], a[36]), min(a[22], a[36])
+	a[23], a[37] = max(a[23], a[37]), min(a[23], a[37])
+	a[23], a[36] = max(a[23], a[36]), min(a[23], a[36])
+	a[12], a[25] = max(a[12], a[25]), min(a[12], a[25])
+	a[12], a[24] = max(a[12], a[24]), min(a[12], a[24])
+	a[13], a[26] = max(a[13], a[26]), min(a[13], a[26])
+	a[14], a[26] = max(a[14], a[26]), min(a[14], a[26])
+	a[13], a[24] = max(a[13], a[24]), min(a[13], a[24])
+	a[14], a[25] = max(a[14], a[25]), min(a[14], a[25])
+	a[14], a[24] = max(a[14], a[24]), min(a[14], a[24])
+	a[15], a[28] = max(a[15], a[28]), min(a[15], a[28])
+	a[15], a[27] = max(a[15], a[27]), min(a[15], a[27])
+	a[16], a[29] = max(a[16], a[29]), min(a[16], a[29])
+	a[17], a[29] = max(a[17], a[29]), min(a[17], a[29])
+	a[16], a[27] = max(a[16], a[27]), min(a[16], a[27])
+	a[17], a[28] = max(a[17], a[28]), min(a[17], a[28])
+	a[17], a[27] = max(a[17], a[27]), min(a[17], a[27])
+	a[15], a[25] = max(a[15], a[25]), min(a[15], a[25])
+	a[15], a[24] = max(a[15], a[24]), min(a[15], a[24])
+	a[16], a[26] = max(a[16], a[26]), min(a[16], a[26])
+	a[17], a[26] = max(a[17], a[26]), min(a[17], a[26])
+	a[16], a[24] = max(a[16], a[24]), min(a[16], a[24])
+	a[17], a[25] = max(a[17], a[25]), min(a[17], a[25])
+	a[17], a[24] = max(a[17], a[24]), min(a[17], a[24])
+	a[18], a[31] = max(a[18], a[31]), min(a[18], a[31])
+	a[18], a[30] = max(a[18], a[30]), min(a[18], a[30])
+	a[19], a[32] = max(a[19], a[32]), min(a[19], a[32])
+	a[20], a[32] = max(a[20], a[32]), min(a[20], a[32])
+	a[19], a[30] = max(a[19], a[30]), min(a[19], a[30])
+	a[20], a[31] = max(a[20], a[31]), min(a[20], a[31])
+	a[20], a[30] = max(a[20], a[30]), min(a[20], a[30])
+	a[21], a[34] = max(a[21], a[34]), min(a[21], a[34])
+	a[21], a[33] = max(a[21], a[33]), min(a[21], a[33])
+	a[22], a[35] = max(a[22], a[35]), min(a[22], a[35])
+	a[23], a[35] = max(a[23], a[35]), min(a[23], a[35])
+	a[22], a[33] = max(a[22], a[33]), min(a[22], a[33])
+	a[23], a[34] = max(a[23], a[34]), min(a[23], a[34])
+	a[23], a[33] = max(a[23], a[33]), min(a[23], a[33])
+	a[21], a[31] = max(a[21], a[31]), min(a[21], a[31])
+	a[21], a[30] = max(a[21], a[30]), min(a[21], a[30])
+	a[22], a[32] = max(a[22], a[32]), min(a[22], a[32])
+	a[23], a[32] = max(a[23], a[32]), min(a[23], a[32])
+	a[22], a[30] = max(a[22], a[30]), min(a[22], a[30])
+	a[23], a[31] = max(a[23], a[31]), min(a[23], a[31])
+	a[23], a[30] = max(a[23], a[30]), min(a[23], a[30])
+	a[18], a[25] = max(a[18], a[25]), min(a[18], a[25])
+	a[18], a[24] = max(a[18], a[24]), min(a[18], a[24])
+	a[19], a[26] = max(a[19], a[26]), min(a[19], a[26])
+	a[20], a[26] = max(a[20], a[26]), min(a[20], a[26])
+	a[19], a[24] = max(a[19], a[24]), min(a[19], a[24])
+	a[20], a[25] = max(a[20], a[25]), min(a[20], a[25])
+	a[20], a[24] = max(a[20], a[24]), min(a[20], a[24])
+	a[21], a[28] = max(a[21], a[28]), min(a[21], a[28])
+	a[21], a[27] = max(a[21], a[27]), min(a[21], a[27])
+	a[22], a[29] = max(a[22], a[29]), min(a[22], a[29])
+	a[23], a[29] = max(a[23], a[29]), min(a[23], a[29])
+	a[22], a[27] = max(a[22], a[27]), min(a[22], a[27])
+	a[23], a[28] = max(a[23], a[28]), min(a[23], a[28])
+	a[23], a[27] = max(a[23], a[27]), min(a[23], a[27])
+	a[21], a[25] = max(a[21], a[25]), min(a[21], a[25])
+	a[21], a[24] = max(a[21], a[24]), min(a[21], a[24])
+	a[22], a[26] = max(a[22], a[26]), min(a[22], a[26])
+	a[23], a[26] = max(a[23], a[26]), min(a[23], a[26])
+	a[22], a[24] = max(a[22], a[24]), min(a[22], a[24])
+	a[23], a[25] = max(a[23], a[25]), min(a[23], a[25])
+	a[23], a[24] = max(a[23], a[24]), min(a[23], a[24])
+}
+
+func NetworkSort64xInt(a []int) {
+	_ = a[63]
+	a[0], a[1] = min(a[0], a[1]), max(a[0], a[1])
+	a[2], a[3] = min(a[2], a[3]), max(a[2], a[3])
+	a[0], a[2] = min(a[0], a[2]), max(a[0], a[2])
+	a[1], a[3] = min(a[1], a[3]), max(a[1], a[3])
+	a[1], a[2] = min(a[1], a[2]), max(a[1], a[2])
+	a[4], a[5] = min(a[4], a[5]), max(a[4], a[5])
+	a[6], a[7] = min(a[6], a[7]), max(a[6], a[7])
+	a[4], a[6] = min(a[4], a[6]), max(a[4], a[6])
+	a[5], a[7] = min(a[5], a[7]), max(a[5], a[7])
+	a[5], a[6] = min(a[5], a[6]), max(a[5], a[6])
+	a[0], a[4] = min(a[0], a[4]), max(a[0], a[4])
+	a[1], a[5] = min(a[1], a[5]), max(a[1], a[5])
+	a[1], a[4] = min(a[1], a[4]), max(a[1], a[4])
+	a[2], a[6] = min(a[2], a[6]), max(a[2], a[6])
+	a[3], a[7] = min(a[3], a[7]), max(a[3], a[7])
+	a[3], a[6] = min(a[3], a[6]), max(a[3], a[6])
+	a[2], a[4] = min(a[2], a[4]), max(a[2], a[4])
+	a[3], a[5] = min(a[3], a[5]), max(a[3], a[5])
+	a[3], a[4] = min(a[3], a[4]), max(a[3], a[4])
+	a[8], a[9] = min(a[8], a[9]), max(a[8], a[9])
+	a[10], a[11] = min(a[10], a[11]), max(a[10], a[11])
+	a[8], a[10] = min(a[8], a[10]), max(a[8], a[10])
+	a[9], a[11] = min(a[9], a[11]), max(a[9], a[11])
+	a[9], a[10] = min(a[9], a[10]), max(a[9], a[10])
+	a[12], a[13] = min(a[12], a[13]), max(a[12], a[13])
+	a[14], a[15] = min(a[14], a[15]), max(a[14], a[15])
+	a[12], a[14] = min(a[12], a[14]), max(a[12], a[14])
+	a[13], a[15] = min(a[13], a[15]), max(a[13], a[15])
+	a[13], a[14] = min(a[13], a[14]), max(a[13], a[14])
+	a[8], a[12] = min(a[8], a[12]), max(a[8], a[12])
+	a[9], a[13] = min(a[9], a[13]), max(a[9], a[13])
+	a[9], a[12] = min(a[9], a[12]), max(a[9], a[12])
+	a[10], a[14] = min(a[10], a[14]), max(a[10], a[14])
+	a[11], a[15] = min(a[11], a[15]), max(a[11], a[15])
+	a[11], a[14] = min(a[11], a[14]), max(a[11], a[14])
+	a[10], a[12] = min(a[10], a[12]), max(a[10], a[12])
+	a[11], a[13] = min(a[11], a[13]), max(a[11], a[13])
+	a[11], a[12] = min(a[11], a[12]), max(a[11], a[12])
+	a[0], a[8] = min(a[0], a[8]), max(a[0], a[8])
+	a[1], a[9] = min(a[1], a[9]), max(a[1], a[9])
+	a[1], a[8] = min(a[1], a[8]), max(a[1], a[8])
+	a[2], a[10] = min(a[2], a[10]), max(a[2], a[10])
+	a[3], a[11] = min(a[3], a[11]), max(a[3], a[11])
+	a[3], a[10] = min(a[3], a[10]), max(a[3], a[10])
+	a[2], a[8] = min(a[2], a[8]), max(a[2], a[8])
+	a[3], a[9] = min(a[3], a[9]), max(a[3], a[9])
+	a[3], a[8] = min(a[3], a[8]), max(a[3], a[8])
+	a[4], a[12] = min(a[4], a[12]), max(a[4], a[12])
+	a[5], a[13] = min(a[5], a[13]), max(a[5], a[13])
+	a[5], a[12] = min(a[5], a[12]), max(a[5], a[12])
+	a[6], a[14] = min(a[6], a[14]), max(a[6], a[14])
+	a[7], a[15] = min(a[7], a[15]), max(a[7], a[15])
+	a[7], a[14] = min(a[7], a[14]), max(a[7], a[14])
+	a[6], a[12] = min(a[6], a[12]), max(a[6], a[12])
+	a[7], a[13] = min(a[7], a[13]), max(a[7], a[13])
+	a[7], a[12] = min(a[7], a[12]), max(a[7], a[12])
+	a[4], a[8] = min(a[4], a[8]), max(a[4], a[8])
+	a[5], a[9] = min(a[5], a[9]), max(a[5], a[9])
+	a[5], a[8] = min(a[5], a[8]), max(a[5], a[8])
+	a[6], a[10] = min(a[6], a[10]), max(a[6], a[10])
+	a[7], a[11] = min(a[7], a[11]), max(a[7], a[11])
+	a[7], a[10] = min(a[7], a[10]), max(a[7], a[10])
+	a[6], a[8] = min(a[6], a[8]), max(a[6], a[8])
+	a[7], a[9] = min(a[7], a[9]), max(a[7], a[9])
+	a[7], a[8] = min(a[7], a[8]), max(a[7], a[8])
+	a[16], a[17] = min(a[16], a[17]), max(a[16], a[17])
+	a[18], a[19] = min(a[18], a[19]), max(a[18], a[19])
+	a[16], a[18] = min(a[16], a[18]), max(a[16], a[18])
+	a[17], a[19] = min(a[17], a[19]), max(a[17], a[19])
+	a[17], a[18] = min(a[17], a[18]), max(a[17], a[18])
+	a[20], a[21] = min(a[20], a[21]), max(a[20], a[21])
+	a[22], a[23] = min(a[22], a[23]), max(a[22], a[23])
+	a[20], a[22] = min(a[20], a[22]), max(a[20], a[22])
+	a[21], a[23] = min(a[21], a[23]), max(a[21], a[23])
+	a[21], a[22] = min(a[21], a[22]), max(a[21], a[22])
+	a[16], a[20] = min(a[16], a[20]), max(a[16], a[20])
+	a[17], a[21] = min(a[17], a[21]), max(a[17], a[21])
+	a[17], a[20] = min(a[17], a[20]), max(a[17], a[20])
+	a[18], a[22] = min(a[18], a[22]), max(a[18], a[22])
+	a[19], a[23] = min(a[19], a[23]), max(a[19], a[23])
+	a[19], a[22] = min(a[19], a[22]), max(a[19], a[22])
+	a[18], a[20] = min(a[18], a[20]), max(a[18], a[20])
+	a[19], a[21] = min(a[19], a[21]), max(a[19], a[21])
+	a[19], a[20] = min(a[19], a[20]), max(a[19], a[20])
+	a[24], a[25] = min(a[24], a[25]), max(a[24], a[25])
+	a[26], a[27] = min(a[26], a[27]), max(a[26], a[27])
+	a[24], a[26] = min(a[24], a[26]), max(a[24], a[26])
+	a[25], a[27] = min(a[25], a[27]), max(a[25], a[27])
+	a[25], a[26] = min(a[25], a[26]), max(a[25], a[26])
+	a[28], a[29] = min(a[28], a[29]), max(a[28], a[29])
+	a[30], a[31] = min(a[30], a[31]), max(a[30], a[31])
+	a[28], a[30] = min(a[28], a[30]), max(a[28], a[30])
+	a[29], a[31] = min(a[29], a[31]), max(a[29], a[31])
+	a[29], a[30] = min(a[29], a[30]), max(a[29], a[30])
+	a[24], a[28] = min(a[24], a[28]), max(a[24], a[28])
+	a[25], a[29] = min(a[25], a[29]), max(a[25], a[29])
+	a[25], a[28] = min(a[25], a[28]), max(a[25], a[28])
+	a[26], a[30] = min(a[26], a[30]), max(a[26], a[30])
+	a[27], a[31] = min(a[27], a[31]), max(a[27], a[31])
+	a[27], a[30] = min(a[27], a[30]), max(a[27], a[30])
+	a[26], a[28] = min(a[26], a[28]), max(a[26], a[28])
+	a[27], a[29] = min(a[27], a[29]), max(a[27], a[29])
+	a[27], a[28] = min(a[27], a[28]), max(a[27], a[28])
+	a[16], a[24] = min(a[16], a[24]), max(a[16], a[24])
+	a[17], a[25] = min(a[17], a[25]), max(a[17], a[25])
+	a[17], a[24] = min(a[17], a[24]), max(a[17], a[24])
+	a[18], a[26] = min(a[18], a[26]), max(a[18], a[26])
+	a[19], a[27] = min(a[19], a[27]), max(a[19], a[27])
+	a[19], a[26] = min(a[19], a[26]), max(a[19], a[26])
+	a[18], a[24] = min(a[18], a[24]), max(a[18], a[24])
+	a[19], a[25] = min(a[19], a[25]), max(a[19], a[25])
+	a[19], a[24] = min(a[19], a[24]), max(a[19], a[24])
+	a[20], a[28] = min(a[20], a[28]), max(a[20], a[28])
+	a[21], a[29] = min(a[21], a[29]), max(a[21], a[29])
+	a[21], a[28] = min(a[21], a[28]), max(a[21], a[28])
+	a[22], a[30] = min(a[22], a[30]), max(a[22], a[30])
+	a[23], a[31] = min(a[23], a[31]), max(a[23], a[31])
+	a[23], a[30] = min(a[23], a[30]), max(a[23], a[30])
+	a[22], a[28] = min(a[22], a[28]), max(a[22], a[28])
+	a[23], a[29] = min(a[23], a[29]), max(a[23], a[29])
+	a[23], a[28] = min(a[23], a[28]), max(a[23], a[28])
+	a[20], a[24] = min(a[20], a[24]), max(a[20], a[24])
+	a[21], a[25] = min(a[21], a[25]), max(a[21], a[25])
+	a[21], a[24] = min(a[21], a[24]), max(a[21], a[24])
+	a[22], a[26] = min(a[22], a[26]), max(a[22], a[26])
+	a[23], a[27] = min(a[23], a[27]), max(a[23], a[27])
+	a[23], a[26] = min(a[23], a[26]), max(a[23], a[26])
+	a[22], a[24] = min(a[22], a[24]), max(a[22], a[24])
+	a[23], a[25] = min(a[23], a[25]), max(a[23], a[25])
+	a[23], a[24] = min(a[23], a[24]), max(a[23], a[24])
+	a[0], a[16] = min(a[0], a[16]), max(a[0], a[16])
+	a[1], a[17] = min(a[1], a[17]), max(a[1], a[17])
+	a[1], a[16] = min(a[1], a[16]), max(a[1], a[16])
+	a[2], a[18] = min(a[2], a[18]), max(a[2], a[18])
+	a[3], a[19] = min(a[3], a[19]), max(a[3], a[19])
+	a[3], a[18] = min(a[3], a[18]), max(a[3], a[18])
+	a[2], a[16] = min(a[2], a[16]), max(a[2], a[16])
+	a[3], a[17] = min(a[3], a[17]), max(a[3], a[17])
+	a[3], a[16] = min(a[3], a[16]), max(a[3], a[16])
+	a[4], a[20] = min(a[4], a[20]), max(a[4], a[20])
+	a[5], a[21] = min(a[5], a[21]), max(a[5], a[21])
+	a[5], a[20] = min(a[5], a[20]), max(a[5], a[20])
+	a[6], a[22] = min(a[6], a[22]), max(a[6], a[22])
+	a[7], a[23] = min(a[7], a[23]), max(a[7], a[23])
+	a[7], a[22] = min(a[7], a[22]), max(a[7], a[22])
+	a[6], a[20] = min(a[6], a[20]), max(a[6], a[20])
+	a[7], a[21] = min(a[7], a[21]), max(a[7], a[21])
+	a[7], a[20] = min(a[7], a[20]), max(a[7], a[20])
+	a[4], a[16] = min(a[4], a[16]), max(a[4], a[16])
+	a[5], a[17] = min(a[5], a[17]), max(a[5], a[17])
+	a[5], a[16] = min(a[5], a[16]), max(a[5], a[16])
+	a[6], a[18] = min(a[6], a[18]), max(a[6], a[18])
+	a[7], a[19] = min(a[7], a[19]), max(a[7], a[19])
+	a[7], a[18] = min(a[7], a[18]), max(a[7], a[18])
+	a[6], a[16] = min(a[6], a[16]), max(a[6], a[16])
+	a[7], a[17] = min(a[7], a[17]), max(a[7], a[17])
+	a[7], a[16] = min(a[7], a[16]), max(a[7], a[16])
+	a[8], a[24] = min(a[8], a[24]), max(a[8], a[24])
+	a[9], a[25] = min(a[9], a[25]), max(a[9], a[25])
+	a[9], a[24] = min(a[9], a[24]), max(a[9], a[24])
+	a[10], a[26] = min(a[10], a[26]), max(a[10], a[26])
+	a[11], a[27] = min(a[11], a[27]), max(a[11], a[27])
+	a[11], a[26] = min(a[11], a[26]), max(a[11], a[26])
+	a[10], a[24] = min(a[10], a[24]), max(a[10], a[24])
+	a[11], a[25] = min(a[11], a[25]), max(a[11], a[25])
+	a[11], a[24] = min(a[11], a[24]), max(a[11], a[24])
+	a[12], a[28] = min(a[12], a[28]), max(a[12], a[28])
+	a[13], a[29] = min(a[13], a[29]), max(a[13], a[29])
+	a[13], a[28] = min(a[13], a[28]), max(a[13], a[28])
+	a[14], a[30] = min(a[14], a[30]), max(a[14], a[30])
+	a[15], a[31] = min(a[15], a[31]), max(a[15], a[31])
+	a[15], a[30] = min(a[15], a[30]), max(a[15], a[30])
+	a[14], a[28] = min(a[14], a[28]), max(a[14], a[28])
+	a[15], a[29] = min(a[15], a[29]), max(a[15], a[29])
+	a[15], a[28] = min(a[15], a[28]), max(a[15], a[28])
+	a[12], a[24] = min(a[12], a[24]), max(a[12], a[24])
+	a[13], a[25] = min(a[13], a[25]), max(a[13], a[25])
+	a[13], a[24] = min(a[13], a[24]), max(a[13], a[24])
+	a[14], a[26] = min(a[14], a[26]), max(a[14], a[26])
+	a[15], a[27] = min(a[15], a[27]), max(a[15], a[27])
+	a[15], a[26] = min(a[15], a[26]), max(a[15], a[26])
+	a[14], a[24] = min(a[14], a[24]), max(a[14], a[24])
+	a[15], a[25] = min(a[15], a[25]), max(a[15], a[25])
+	a[15], a[24] = min(a[15], a[24]), max(a[15], a[24])
+	a[8], a[16] = min(a[8], a[16]), max(a[8], a[16])
+	a[9], a[17] = min(a[9], a[17]), max(a[9], a[17])
+	a[9], a[16] = min(a[9], a[16]), max(a[9], a[16])
+	a[10], a[18] = min(a[10], a[18]), max(a[10], a[18])
+	a[11], a[19] = min(a[11], a[19]), max(a[11], a[19])
+	a[11], a[18] = min(a[11], a[18]), max(a[11], a[18])
+	a[10], a[16] = min(a[10], a[16]), max(a[10], a[16])
+	a[11], a[17] = min(a[11], a[17]), max(a[11], a[17])
+	a[11], a[16] = min(a[11], a[16]), max(a[11], a[16])
+	a[12], a[20] = min(a[12], a[20]), max(a[12], a[20])
+	a[13], a[21] = min(a[13], a[21]), max(a[13], a[21])
+	a[13], a[20] = min(a[13], a[20]), max(a[13], a[20])
+	a[14], a[22] = min(a[14], a[22]), max(a[14], a[22])
+	a[15], a[23] = min(a[15], a[23]), max(a[15], a[23])
+	a[15], a[22] = min(a[15], a[22]), max(a[15], a[22])
+	a[14], a[20] = min(a[14], a[20]), max(a[14], a[20])
+	a[15], a[21] = min(a[15], a[21]), max(a[15], a[21])
+	a[15], a[20] = min(a[15], a[20]), max(a[15], a[20])
+	a[12], a[16] = min(a[12], a[16]), max(a[12], a[16])
+	a[13], a[17] = min(a[13], a[17]), max(a[13], a[17])
+	a[13], a[16] = min(a[13], a[16]), max(a[13], a[16])
+	a[14], a[18] = min(a[14], a[18]), max(a[14], a[18])
+	a[15], a[19] = min(a[15], a[19]), max(a[15], a[19])
+	a[15], a[18] = min(a[15], a[18]), max(a[15], a[18])
+	a[14], a[16] = min(a[14], a[16]), max(a[14], a[16])
+	a[15], a[17] = min(a[15], a[17]), max(a[15], a[17])
+	a[15], a[16] = min(a[15], a[16]), max(a[15], a[16])
+	a[32], a[33] = min(a[32], a[33]), max(a[32], a[33])
+	a[34], a[35] = min(a[34], a[35]), max(a[34], a[35])
+	a[32], a[34] = min(a[32], a[34]), max(a[32], a[34])
+	a[33], a[35] = min(a[33], a[35]), max(a[33], a[35])
+	a[33], a[34] = min(a[33], a[34]), max(a[33], a[34])
+	a[36], a[37] = min(a[36], a[37]), max(a[36], a[37])
+	a[38], a[39] = min(a[38], a[39]), max(a[38], a[39])
+	a[36], a[38] = min(a[36], a[38]), max(a[36], a[38])
+	a[37], a[39] = min(a[37], a[39]), max(a[37], a[39])
+	a[37], a[38] = min(a[37], a[38]), max(a[37], a[38])
+	a[32], a[36] = min(a[32], a[36]), max(a[32], a[36])
+	a[33], a[37] = min(a[33], a[37]), max(a[33], a[37])
+	a[33], a[36] = min(a[33], a[36]), max(a[33], a[36])
+	a[34], a[38] = min(a[34], a[38]), max(a[34], a[38])
+	a[35], a[39] = min(a[35], a[39]), max(a[35], a[39])
+	a[35], a[38] = min(a[35], a[38]), max(a[35], a[38])
+	a[34], a[36] = min(a[34], a[36]), max(a[34], a[36])
+	a[35], a[37] = min(a[35], a[37]), max(a[35], a[37])
+	a[35], a[36] = min(a[35], a[36]), max(a[35], a[36])
+	a[40], a[41] = min(a[40], a[41]), max(a[40], a[41])
+	a[42], a[43] = min(a[42], a[43]), max(a[42], a[43])
+	a[40], a[42] = min(a[40], a[42]), max(a[40], a[42])
+	a[41], a[43] = min(a[41], a[43]), max(a[41], a[43])
+	a[41], a[42] = min(a[41], a[42]), max(a[41], a[42])
+	a[44], a[45] = min(a[44], a[45]), max(a[44], a[45])
+	a[46], a[47] = min(a[46], a[47]), max(a[46], a[47])
+	a[44], a[46] = min(a[44], a[46]), max(a[44], a[46])
+	a[45], a[47] = min(a[45], a[47]), max(a[45], a[47])
+	a[45], a[46] = min(a[45], a[46]), max(a[45], a[46])
+	a[40], a[44] = min(a[40], a[44]), max(a[40], a[44])
+	a[41], a[45] = min(a[41], a[45]), max(a[41], a[45])
+	a[41], a[44] = min(a[41], a[44]), max(a[41], a[44])
+	a[42], a[46] = min(a[42], a[46]), max(a[42], a[46])
+	a[43], a[47] = min(a[43], a[47]), max(a[43], a[47])
+	a[43], a[46] = min(a[43], a[46]), max(a[43], a[46])
+	a[42], a[44] = min(a[42], a[44]), max(a[42], a[44])
+	a[43], a[45] = min(a[43], a[45]), max(a[43], a[45])
+	a[43], a[44] = min(a[43], a[44]), max(a[43], a[44])
+	a[32], a[40] = min(a[32], a[40]), max(a[32], a[40])
+	a[33], a[41] = min(a[33], a[41]), max(a[33], a[41])
+	a[33], a[40] = min(a[33], a[40]), max(a[33], a[40])
+	a[34], a[42] = min(a[34], a[42]), max(a[34], a[42])
+	a[35], a[43] = min(a[35], a[43]), max(a[35], a[43])
+	a[35], a[42] = min(a[35], a[42]), max(a[35], a[42])
+	a[34], a[40] = min(a[34], a[40]), max(a[34], a[40])
+	a[35], a[41] = min(a[35], a[41]), max(a[35], a[41])
+	a[35], a[40] = min(a[35], a[40]), max(a[35], a[40])
+	a[36], a[44] = min(a[36], a[44]), max(a[36], a[44])
+	a[37], a[45] = min(a[37], a[45]), max(a[37], a[45])
+	a[37], a[44] = min(a[37], a[44]), max(a[37], a[44])
+	a[38], a[46] = min(a[38], a[46]), max(a[38], a[46])
+	a[39], a[47] = min(a[39], a[47]), max(a[39], a[47])
+	a[39], a[46] = min(a[39], a[46]), max(a[39], a[46])
+	a[38], a[44] = min(a[38], a[44]), max(a[38], a[44])
+	a[39], a[45] = min(a[39], a[45]), max(a[39], a[45])
+	a[39], a[44] = min(a[39], a[44]), max(a[39], a[44])
+	a[36], a[40] = min(a[36], a[40]), max(a[36], a[40])
+	a[37], a[41] = min(a[37], a[41]), max(a[37], a[41])
+	a[37], a[40] = min(a[37], a[40]), max(a[37], a[40])
+	a[38], a[42] = min(a[38], a[42]), max(a[38], a[42])
+	a[39], a[43] = min(a[39], a[43]), max(a[39], a[43])
+	a[39], a[42] = min(a[39], a[42]), max(a[39], a[42])
+	a[38], a[40] = min(a[38], a[40]), max(a[38], a[40])
+	a[39], a[41] = min(a[39], a[41]), max(a[39], a[41])
+	a[39], a[40] = min(a[39], a[40]), max(a[39], a[40])
+	a[48], a[49] = min(a[48], a[49]), max(a[48], a[49])
+	a[50], a[51] = min(a[50], a[51]), max(a[50], a[51])
+	a[48], a[50] = min(a[48], a[50]), max(a[48], a[50])
+	a[49], a[51] = min(a[49], a[51]), max(a[49], a[51])
+	a[49], a[50] = min(a[49], a[50]), max(a[49], a[50])
+	a[52], a[53] = min(a[52], a[53]), max(a[52], a[53])
+	a[54], a[55] = min(a[54], a[55]), max(a[54], a[55])
+	a[52], a[54] = min(a[52], a[54]), max(a[52], a[54])
+	a[53], a[55] = min(a[53], a[55]), max(a[53], a[55])
+	a[53], a[54] = min(a[53], a[54]), max(a[53], a[54])
+	a[48], a[52] = min(a[48], a[52]), max(a[48], a[52])
+	a[49], a[53] = min(a[49], a[53]), max(a[49], a[53])
+	a[49], a[52] = min(a[49], a[52]), max(a[49], a[52])
+	a[50], a[54] = min(a[50], a[54]), max(a[50], a[54])
+	a[51], a[55] = min(a[51], a[55]), max(a[51], a[55])
+	a[51], a[54] = min(a[51], a[54]), max(a[51], a[54])
+	a[50], a[52] = min(a[50], a[52]), max(a[50], a[52])
+	a[51], a[53] = min(a[51], a[53]), max(a[51], a[53])
+	a[51], a[52] = min(a[51], a[52]), max(a[51], a[52])
+	a[56], a[57] = min(a[56], a[57]), max(a[56], a[57])
+	a[58], a[59] = min(a[58], a[59]), max(a[58], a[59])
+	a[56], a[58] = min(a[56], a[58]), max(a[56], a[58])
+	a[57], a[59] = min(a[57], a[59]), max(a[57], a[59])
+	a[57], a[58] = min(a[57], a[58]), max(a[57], a[58])
+	a[60], a[61] = min(a[60], a[61]), max(a[60], a[61])
+	a[62], a[63] = min(a[62], a[63]), max(a[62], a[63])
+	a[60], a[62] = min(a[60], a[62]), max(a[60], a[62])
+	a[61], a[63] = min(a[61], a[63]), max(a[61], a[63])
+	a[61], a[62] = min(a[61], a[62]), max(a[61], a[62])
+	a[56], a[60] = min(a[56], a[60]), max(a[56], a[60])
+	a[57], a[61] = min(a[57], a[61]), max(a[57], a[61])
+	a[57], a[60] = min(a[57], a[60]), max(a[57], a[60])
+	a[58], a[62] = min(a[58], a[62]), max(a[58], a[62])
+	a[59], a[63] = min(a[59], a[63]), max(a[59], a[63])
+	a[59], a[62] = min(a[59], a[62]), max(a[59], a[62])
+	a[58], a[60] = min(a[58], a[60]), max(a[58], a[60])
+	a[59], a[61] = min(a[59], a[61]), max(a[59], a[61])
+	a[59], a[60] = min(a[59], a[60]), max(a[59], a[60])
+	a[48], a[56] = min(a[48], a[56]), max(a[48], a[56])
+	a[49], a[57] = min(a[49], a[57]), max(a[49], a[57])
+	a[49], a[56] = min(a[49], a[56]), max(a[49], a[56])
+	a[50], a[58] = min(a[50], a[58]), max(a[50], a[58])
+	a[51], a[59] = min(a[51], a[59]), max(a[51], a[59])
+	a[51], a[58] = min(a[51], a[58]), max(a[51], a[58])
+	a[50], a[56] = min(a[50], a[56]), max(a[50], a[56])
+	a[51], a[57] = min(a[51], a[57]), max(a[51], a[57])
+	a[51], a[56] = min(a[51], a[56]), max(a[51], a[56])
+	a[52], a[60] = min(a[52], a[60]), max(a[52], a[60])
+	a[53], a[61] = min(a[53], a[61]), max(a[53], a[61])
+	a[53], a[60] = min(a[53], a[60]), max(a[53], a[60])
+	a[54], a[62] = min(a[54], a[62]), max(a[54], a[62])
+	a[55], a[63] = min(a[55], a[63]), max(a[55], a[63])
+	a[55], a[62] = min(a[55], a[62]), max(a[55], a[62])
+	a[54], a[60] = min(a[54], a[60]), max(a[54], a[60])
+	a[55], a[61] = min(a[55], a[61]), max(a[55], a[61])
+	a[55], a[60] = min(a[55], a[60]), max(a[55], a[60])
+	a[52], a[56] = min(a[52], a[56]), max(a[52], a[56])
+	a[53], a[57] = min(a[53], a[57]), max(a[53], a[57])
+	a[53], a[56] = min(a[53], a[56]), max(a[53], a[56])
+	a[54], a[58] = min(a[54], a[58]), max(a[54], a[58])
+	a[55], a[59] = min(a[55], a[59]), max(a[55], a[59])
+	a[55], a[58] = min(a[55], a[58]), max(a[55], a[58])
+	a[54], a[56] = min(a[54], a[56]), max(a[54], a[56])
+	a[55], a[57] = min(a[55], a[57]), max(a[55], a[57])
+	a[55], a[56] = min(a[55], a[56]), max(a[55], a[56])
+	a[32], a[48] = min(a[32], a[48]), max(a[32], a[48])
+	a[33], a[49] = min(a[33], a[49]), max(a[33], a[49])
+	a[33], a[48] = min(a[33], a[48]), max(a[33], a[48])
+	a[34], a[50] = min(a[34], a[50]), max(a[34], a[50])
+	a[35], a[51] = min(a[35], a[51]), max(a[35], a[51])
+	a[35], a[50] = min(a[35], a[50]), max(a[35], a[50])
+	a[34], a[48] = min(a[34], a[48]), max(a[34], a[48])
+	a[35], a[49] = min(a[35], a[49]), max(a[35], a[49])
+	a[35], a[48] = min(a[35], a[48]), max(a[35], a[48])
+	a[36], a[52] = min(a[36], a[52]), max(a[36], a[52])
+	a[37], a[53] = min(a[37], a[53]), max(a[37], a[53])
+	a[37], a[52] = min(a[37], a[52]), max(a[37], a[52])
+	a[38], a[54] = min(a[38], a[54]), max(a[38], a[54])
+	a[39], a[55] = min(a[39], a[55]), max(a[39], a[55])
+	a[39], a[54] = min(a[39], a[54]), max(a[39], a[54])
+	a[38], a[52] = min(a[38], a[52]), max(a[38], a[52])
+	a[39], a[53] = min(a[39], a[53]), max(a[39], a[53])
+	a[39], a[52] = min(a[39], a[52]), max(a[39], a[52])
+	a[36], a[48] = min(a[36], a[48]), max(a[36], a[48])
+	a[37], a[49] = min(a[37], a[49]), max(a[37], a[49])
+	a[37], a[48] = min(a[37], a[48]), max(a[37], a[48])
+	a[38], a[50] = min(a[38], a[50]), max(a[38], a[50])
+	a[39], a[51] = min(a[39], a[51]), max(a[39], a[51])
+	a[39], a[50] = min(a[39], a[50]), max(a[39], a[50])
+	a[38], a[48] = min(a[38], a[48]), max(a[38], a[48])
+	a[39], a[49] = min(a[39], a[49]), max(a[39], a[49])
+	a[39], a[48] = min(a[39], a[48]), max(a[39], a[48])
+	a[40], a[56] = min(a[40], a[56]), max(a[40], a[56])
+	a[41], a[57] = min(a[41], a[57]), max(a[41], a[57])
+	a[41], a[56] = min(a[41], a[56]), max(a[41], a[56])
+	a[42], a[58] = min(a[42], a[58]), max(a[42], a[58])
+	a[43], a[59] = min(a[43], a[59]), max(a[43], a[59])
+	a[43], a[58] = min(a[43], a[58]), max(a[43], a[58])
+	a[42], a[56] = min(a[42], a[56]), max(a[42], a[56])
+	a[43], a[57] = min(a[43], a[57]), max(a[43], a[57])
+	a[43], a[56] = min(a[43], a[56]), max(a[43], a[56])
+	a[44], a[60] = min(a[44], a[60]), max(a[44], a[60])
+	a[45], a[61] = min(a[45], a[61]), max(a[45], a[61])
+	a[45], a[60] = min(a[45], a[60]), max(a[45], a[60])
+	a[46], a[62] = min(a[46], a[62]), max(a[46], a[62])
+	a[47], a[63] = min(a[47], a[63]), max(a[47], a[63])
+	a[47], a[62] = min(a[47], a[62]), max(a[47], a[62])
+	a[46], a[60] = min(a[46], a[60]), max(a[46], a[60])
+	a[47], a[61] = min(a[47], a[61]), max(a[47], a[61])
+	a[47], a[60] = min(a[47], a[60]), max(a[47], a[60])
+	a[44], a[56] = min(a[44], a[56]), max(a[44], a[56])
+	a[45], a[57] = min(a[45], a[57]), max(a[45], a[57])
+	a[45], a[56] = min(a[45], a[56]), max(a[45], a[56])
+	a[46], a[58] = min(a[46], a[58]), max(a[46], a[58])
+	a[47], a[59] = min(a[47], a[59]), max(a[47], a[59])
+	a[47], a[58] = min(a[47], a[58]), max(a[47], a[58])
+	a[46], a[56] = min(a[46], a[56]), max(a[46], a[56])
+	a[47], a[57] = min(a[47], a[57]), max(a[47], a[57])
+	a[47], a[56] = min(a[47], a[56]), max(a[47], a[56])
+	a[40], a[48] = min(a[40], a[48]), max(a[40], a[48])
+	a[41], a[49] = min(a[41], a[49]), max(a[41], a[49])
+	a[41], a[48] = min(a[41], a[48]), max(a[41], a[48])
+	a[42], a[50] = min(a[42], a[50]), max(a[42], a[50])
+	a[43], a[51] = min(a[43], a[51]), max(a[43], a[51])
+	a[43], a[50] = min(a[43], a[50]), max(a[43], a[50])
+	a[42], a[48] = min(a[42], a[48]), max(a[42], a[48])
+	a[43], a[49] = min(a[43], a[49]), max(a[43], a[49])
+	a[43], a[48] = min(a[43], a[48]), max(a[43], a[48])
+	a[44], a[52] = min(a[44], a[52]), max(a[44], a[52])
+	a[45], a[53] = min(a[45], a[53]), max(a[45], a[53])
+	a[45], a[52] = min(a[45], a[52]), max(a[45], a[52])
+	a[46], a[54] = min(a[46], a[54]), max(a[46], a[54])
+	a[47], a[55] = min(a[47], a[55]), max(a[47], a[55])
+	a[47], a[54] = min(a[47], a[54]), max(a[47], a[54])
+	a[46], a[52] = min(a[46], a[52]), max(a[46], a[52])
+	a[47], a[53] = min(a[47], a[53]), max(a[47], a[53])
+	a[47], a[52] = min(a[47], a[52]), max(a[47], a[52])
+	a[44], a[48] = min(a[44], a[48]), max(a[44], a[48])
+	a[45], a[49] = min(a[45], a[49]), max(a[45], a[49])
+	a[45], a[48] = min(a[45], a[48]), max(a[45], a[48])
+	a[46], a[50] = min(a[46], a[50]), max(a[46], a[50])
+	a[47], a[51] = min(a[47], a[51]), max(a[47], a[51])
+	a[47], a[50] = min(a[47], a[50]), max(a[47], a[50])
+	a[46], a[48] = min(a[46], a[48]), max(a[46], a[48])
+	a[47], a[49] = min(a[47], a[49]), max(a[47], a[49])
+	a[47], a[48] = min(a[47], a[48]), max(a[47], a[48])
+	a[0], a[32] = min(a[0], a[32]), max(a[0], a[32])
+	a[1], a[33] = min(a[1], a[33]), max(a[1], a[33])
+	a[1], a[32] = min(a[1], a[32]), max(a[1], a[32])
+	a[2], a[34] = min(a[2], a[34]), max(a[2], a[34])
+	a[3], a[35] = min(a[3], a[35]), max(a[3], a[35])
+	a[3], a[34] = min(a[3], a[34]), max(a[3], a[34])
+	a[2], a[32] = min(a[2], a[32]), max(a[2], a[32])
+	a[3], a[33] = min(a[3], a[33]), max(a[3], a[33])
+	a[3], a[32] = min(a[3], a[32]), max(a[3], a[32])
+	a[4], a[36] = min(a[4], a[36]), max(a[4], a[36])
+	a[5], a[37] = min(a[5], a[37]), max(a[5], a[37])
+	a[5], a[36] = min(a[5], a[36]), max(a[5], a[36])
+	a[6], a[38] = min(a[6], a[38]), max(a[6], a[38])
+	a[7], a[39] = min(a[7], a[39]), max(a[7], a[39])
+	a[7], a[38] = min(a[7], a[38]), max(a[7], a[38])
+	a[6], a[36] = min(a[6], a[36]), max(a[6], a[36])
+	a[7], a[37] = min(a[7], a[37]), max(a[7], a[37])
+	a[7], a[36] = min(a[7], a[36]), max(a[7], a[36])
+	a[4], a[32] = min(a[4], a[32]), max(a[4], a[32])
+	a[5], a[33] = min(a[5], a[33]), max(a[5], a[33])
+	a[5], a[32] = min(a[5], a[32]), max(a[5], a[32])
+	a[6], a[34] = min(a[6], a[34]), max(a[6], a[34])
+	a[7], a[35] = min(a[7], a[35]), max(a[7], a[35])
+	a[7], a[34] = min(a[7], a[34]), max(a[7], a[34])
+	a[6], a[32] = min(a[6], a[32]), max(a[6], a[32])
+	a[7], a[33] = min(a[7], a[33]), max(a[7], a[33])
+	a[7], a[32] = min(a[7], a[32]), max(a[7], a[32])
+	a[8], a[40] = min(a[8], a[40]), max(a[8], a[40])
+	a[9], a[41] = min(a[9], a[41]), max(a[9], a[41])
+	a[9], a[40] = min(a[9], a[40]), max(a[9], a[40])
+	a[10], a[42] = min(a[10], a[42]), max(a[10], a[42])
+	a[11], a[43] = min(a[11], a[43]), max(a[11], a[43])
+	a[11], a[42] = min(a[11], a[42]), max(a[11], a[42])
+	a[10], a[40] = min(a[10], a[40]), max(a[10], a[40])
+	a[11], a[41] = min(a[11], a[41]), max(a[11], a[41])
+	a[11], a[40] = min(a[11], a[40]), max(a[11], a[40])
+	a[12], a[44] = min(a[12], a[44]), max(a[12], a[44])
+	a[13], a[45] = min(a[13], a[45]), max(a[13], a[45])
+	a[13], a[44] = min(a[13], a[44]), max(a[13], a[44])
+	a[14], a[46] = min(a[14], a[46]), max(a[14], a[46])
+	a[15], a[47] = min(a[15], a[47]), max(a[15], a[47])
+	a[15], a[46] = min(a[15], a[46]), max(a[15], a[46])
+	a[14], a[44] = min(a[14], a[44]), max(a[14], a[44])
+	a[15], a[45] = min(a[15], a[45]), max(a[15], a[45])
+	a[15], a[44] = min(a[15], a[44]), max(a[15], a[44])
+	a[12], a[40] = min(a[12], a[40]), max(a[12], a[40])
+	a[13], a[41] = min(a[13], a[41]), max(a[13], a[41])
+	a[13], a[40] = min(a[13], a[40]), max(a[13], a[40])
+	a[14], a[42] = min(a[14], a[42]), max(a[14], a[42])
+	a[15], a[43] = min(a[15], a[43]), max(a[15], a[43])
+	a[15], a[42] = min(a[15], a[42]), max(a[15], a[42])
+	a[14], a[40] = min(a[14], a[40]), max(a[14], a[40])
+	a[15], a[41] = min(a[15], a[41]), max(a[15], a[41])
+	a[15], a[40] = min(a[15], a[40]), max(a[15], a[40])
+	a[8], a[32] = min(a[8], a[32]), max(a[8], a[32])
+	a[9], a[33] = min(a[9], a[33]), max(a[9], a[33])
+	a[9], a[32] = min(a[9], a[32]), max(a[9], a[32])
+	a[10], a[34] = min(a[10], a[34]), max(a[10], a[34])
+	a[11], a[35] = min(a[11], a[35]), max(a[11], a[35])
+	a[11], a[34] = min(a[11], a[34]), max(a[11], a[34])
+	a[10], a[32] = min(a[10], a[32]), max(a[10], a[32])
+	a[11], a[33] = min(a[11], a[33]), max(a[11], a[33])
+	a[11], a[32] = min(a[11], a[32]), max(a[11], a[32])
+	a[12], a[36] = min(a[12], a[36]), max(a[12], a[36])
+	a[13], a[37] = min(a[13], a[37]), max(a[13], a[37])
+	a[13], a[36] = min(a[13], a[36]), max(a[13], a[36])
+	a[14], a[38] = min(a[14], a[38]), max(a[14], a[38])
+	a[15], a[39] = min(a[15], a[39]), max(a[15], a[39])
+	a[15], a[38] = min(a[15], a[38]), max(a[15], a[38])
+	a[14], a[36] = min(a[14], a[36]), max(a[14], a[36])
+	a[15], a[37] = min(a[15], a[37]), max(a[15], a[37])
+	a[15], a[36] = min(a[15], a[36]), max(a[15], a[36])
+	a[12], a[32] = min(a[12], a[32]), max(a[12], a[32])
+	a[13], a[33] = min(a[13], a[33]), max(a[13], a[33])
+	a[13], a[32] = min(a[13], a[32]), max(a[13], a[32])
+	a[14], a[34] = min(a[14], a[34]), max(a[14], a[34])
+	a[15], a[35] = min(a[15], a[35]), max(a[15], a[35])
+	a[15], a[34] = min(a[15], a[34]), max(a[15], a[34])
+	a[14], a[32] = min(a[14], a[32]), max(a[14], a[32])
+	a[15], a[33] = min(a[15], a[33]), max(a[15], a[33])
+	a[15], a[32] = min(a[15], a[32]), max(a[15], a[32])
+	a[16], a[48] = min(a[16], a[48]), max(a[16], a[48])
+	a[17], a[49] = min(a[17], a[49]), max(a[17], a[49])
+	a[17], a[48] = min(a[17], a[48]), max(a[17], a[48])
+	a[18], a[50] = min(a[18], a[50]), max(a[18], a[50])
+	a[19], a[51] = min(a[19], a[51]), max(a[19], a[51])
+	a[19], a[50] = min(a[19], a[50]), max(a[19], a[50])
+	a[18], a[48] = min(a[18], a[48]), max(a[18], a[48])
+	a[19], a[49] = min(a[19], a[49]), max(a[19], a[49])
+	a[19], a[48] = min(a[19], a[48]), max(a[19], a[48])
+	a[20], a[52] = min(a[20], a[52]), max(a[20], a[52])
+	a[21], a[53] = min(a[21], a[53]), max(a[21], a[53])
+	a[21], a[52] = min(a[21], a[52]), max(a[21], a[52])
+	a[22], a[54] = min(a[22], a[54]), max(a[22], a[54])
+	a[23], a[55] = min(a[23], a[55]), max(a[23], a[55])
+	a[23], a[54] = min(a[23], a[54]), max(a[23], a[54])
+	a[22], a[52] = min(a[22], a[52]), max(a[22], a[52])
+	a[23], a[53] = min(a[23], a[53]), max(a[23], a[53])
+	a[23], a[52] = min(a[23], a[52]), max(a[23], a[52])
+	a[20], a[48] = min(a[20], a[48]), max(a[20], a[48])
+	a[21], a[49] = min(a[21], a[49]), max(a[21], a[49])
+	a[21], a[48] = min(a[21], a[48]), max(a[21], a[48])
+	a[22], a[50] = min(a[22], a[50]), max(a[22], a[50])
+	a[23], a[51] = min(a[23], a[51]), max(a[23], a[51])
+	a[23], a[50] = min(a[23], a[50]), max(a[23], a[50])
+	a[22], a[48] = min(a[22], a[48]), max(a[22], a[48])
+	a[23], a[49] = min(a[23], a[49]), max(a[23], a[49])
+	a[23], a[48] = min(a[23], a[48]), max(a[23], a[48])
+	a[24], a[56] = min(a[24], a[56]), max(a[24], a[56])
+	a[25], a[57] = min(a[25], a[57]), max(a[25], a[57])
+	a[25], a[56] = min(a[25], a[56]), max(a[25], a[56])
+	a[26], a[58] = min(a[26], a[58]), max(a[26], a[58])
+	a[27], a[59] = min(a[27], a[59]), max(a[27], a[59])
+	a[27], a[58] = min(a[27], a[58]), max(a[27], a[58])
+	a[26], a[56] = min(a[26], a[56]), max(a[26], a[56])
+	a[27], a[57] = min(a[27], a[57]), max(a[27], a[57])
+	a[27], a[56] = min(a[27], a[56]), max(a[27], a[56])
+	a[28], a[60] = min(a[28], a[60]), max(a[28], a[60])
+	a[29], a[61] = min(a[29], a[61]), max(a[29], a[61])
+	a[29], a[60] = min(a[29], a[60]), max(a[29], a[60])
+	a[30], a[62] = min(a[30], a[62]), max(a[30], a[62])
+	a[31], a[63] = min(a[31], a[63]), max(a[31], a[63])
+	a[31], a[62] = min(a[31], a[62]), max(a[31], a[62])
+	a[30], a[60] = min(a[30], a[60]), max(a[30], a[60])
+	a[31], a[61] = min(a[31], a[61]), max(a[31], a[61])
+	a[31], a[60] = min(a[31], a[60]), max(a[31], a[60])
+	a[28], a[56] = min(a[28], a[56]), max(a[28], a[56])
+	a[29], a[57] = min(a[29], a[57]), max(a[29], a[57])
+	a[29], a[56] = min(a[29], a[56]), max(a[29], a[56])
+	a[30], a[58] = min(a[30], a[58]), max(a[30], a[58])
+	a[31], a[59] = min(a[31], a[59]), max(a[31], a[59])
+	a[31], a[58] = min(a[31], a[58]), max(a[31], a[58])
+	a[30], a[56] = min(a[30], a[56]), max(a[30], a[56])
+	a[31], a[57] = min(a[31], a[57]), max(a[31], a[57])
+	a[31], a[56] = min(a[31], a[56]), max(a[31], a[56])
+	a[24], a[48] = min(a[24], a[48]), max(a[24], a[48])
+	a[25], a[49] = min(a[25], a[49]), max(a[25], a[49])
+	a[25], a[48] = min(a[25], a[48]), max(a[25], a[48])
+	a[26], a[50] = min(a[26], a[50]), max(a[26], a[50])
+	a[27], a[51] = min(a[27], a[51]), max(a[27], a[51])
+	a[27], a[50] = min(a[27], a[50]), max(a[27], a[50])
+	a[26], a[48] = min(a[26], a[48]), max(a[26], a[48])
+	a[27], a[49] = min(a[27], a[49]), max(a[27], a[49])
+	a[27], a[48] = min(a[27], a[48]), max(a[27], a[48])
+	a[28], a[52] = min(a[28], a[52]), max(a[28], a[52])
+	a[29], a[53] = min(a[29], a[53]), max(a[29], a[53])
+	a[29], a[52] = min(a[29], a[52]), max(a[29], a[52])
+	a[30], a[54] = min(a[30], a[54]), max(a[30], a[54])
+	a[31], a[55] = min(a[31], a[55]), max(a[31], a[55])
+	a[31], a[54] = min(a[31], a[54]), max(a[31], a[54])
+	a[30], a[52] = min(a[30], a[52]), max(a[30], a[52])
+	a[31], a[53] = min(a[31], a[53]), max(a[31], a[53])
+	a[31], a[52] = min(a[31], a[52]), max(a[31], a[52])
+	a[28], a[48] = min(a[28], a[48]), max(a[28], a[48])
+	a[29], a[49] = min(a[29], a[49]), max(a[29], a[49])
+	a[29], a[48] = min(a[29], a[48]), max(a[29], a[48])
+	a[30], a[50] = min(a[30], a[50]), max(a[30], a[50])
+	a[31], a[51] = min(a[31], a[51]), max(a[31], a[51])
+	a[31], a[50] = min(a[31], a[50]), max(a[31], a[50])
+	a[30], a[48] = min(a[30], a[48]), max(a[30], a[48])
+	a[31], a[49] = min(a[31], a[49]), max(a[31], a[49])
+	a[31], a[48] = min(a[31], a[48]), max(a[31], a[48])
+	a[16], a[32] = min(a[16], a[32]), max(a[16], a[32])
+	a[17], a[33] = min(a[17], a[33]), max(a[17], a[33])
+	a[17], a[32] = min(a[17], a[32]), max(a[17], a[32])
+	a[18], a[34] = min(a[18], a[34]), max(a[18], a[34])
+	a[19], a[35] = min(a[19], a[35]), max(a[19], a[35])
+	a[19], a[34] = min(a[19], a[34]), max(a[19], a[34])
+	a[18], a[32] = min(a[18], a[32]), max(a[18], a[32])
+	a[19], a[33] = min(a[19], a[33]), max(a[19], a[33])
+	a[19], a[32] = min(a[19], a[32]), max(a[19], a[32])
+	a[20], a[36] = min(a[20], a[36]), max(a[20], a[36])
+	a[21], a[37] = min(a[21], a[37]), max(a[21], a[37])
+	a[21], a[36] = min(a[21], a[36]), max(a[21], a[36])
+	a[22], a[38] = min(a[22], a[38]), max(a[22], a[38])
+	a[23], a[39] = min(a[23], a[39]), max(a[23], a[39])
+	a[23], a[38] = min(a[23], a[38]), max(a[23], a[38])
+	a[22], a[36] = min(a[22], a[36]), max(a[22], a[36])
+	a[23], a[37] = min(a[23], a[37]), max(a[23], a[37])
+	a[23], a[36] = min(a[23], a[36]), max(a[23], a[36])
+	a[20], a[32] = min(a[20], a[32]), max(a[20], a[32])
+	a[21], a[33] = min(a[21], a[33]), max(a[21], a[33])
+	a[21], a[32] = min(a[21], a[32]), max(a[21], a[32])
+	a[22], a[34] = min(a[22], a[34]), max(a[22], a[34])
+	a[23], a[35] = min(a[23], a[35]), max(a[23], a[35])
+	a[23], a[34] = min(a[23], a[34]), max(a[23], a[34])
+	a[22], a[32] = min(a[22], a[32]), max(a[22], a[32])
+	a[23], a[33] = min(a[23], a[33]), max(a[23], a[33])
+	a[23], a[32] = min(a[23], a[32]), max(a[23], a[32])
+	a[24], a[40] = min(a[24], a[40]), max(a[24], a[40])
+	a[25], a[41] = min(a[25], a[41]), max(a[25], a[41])
+	a[25], a[40] = min(a[25], a[40]), max(a[25], a[40])
+	a[26], a[42] = min(a[26], a[42]), max(a[26], a[42])
+	a[27], a[43] = min(a[27], a[43]), max(a[27], a[43])
+	a[27], a[42] = min(a[27], a[42]), max(a[27], a[42])
+	a[26], a[40] = min(a[26], a[40]), max(a[26], a[40])
+	a[27], a[41] = min(a[27], a[41]), max(a[27], a[41])
+	a[27], a[40] = min(a[27], a[40]), max(a[27], a[40])
+	a[28], a[44] = min(a[28], a[44]), max(a[28], a[44])
+	a[29], a[45] = min(a[29], a[45]), max(a[29], a[45])
+	a[29], a[44] = min(a[29], a[44]), max(a[29], a[44])
+	a[30], a[46] = min(a[30], a[46]), max(a[30], a[46])
+	a[31], a[47] = min(a[31], a[47]), max(a[31], a[47])
+	a[31], a[46] = min(a[31], a[46]), max(a[31], a[46])
+	a[30], a[44] = min(a[30], a[44]), max(a[30], a[44])
+	a[31], a[45] = min(a[31], a[45]), max(a[31], a[45])
+	a[31], a[44] = min(a[31], a[44]), max(a[31], a[44])
+	a[28], a[40] = min(a[28], a[40]), max(a[28], a[40])
+	a[29], a[41] = min(a[29], a[41]), max(a[29], a[41])
+	a[29], a[40] = min(a[29], a[40]), max(a[29], a[40])
+	a[30], a[42] = min(a[30], a[42]), max(a[30], a[42])
+	a[31], a[43] = min(a[31], a[43]), max(a[31], a[43])
+	a[31], a[42] = min(a[31], a[42]), max(a[31], a[42])
+	a[30], a[40] = min(a[30], a[40]), max(a[30], a[40])
+	a[31], a[41] = min(a[31], a[41]), max(a[31], a[41])
+	a[31], a[40] = min(a[31], a[40]), max(a[31], a[40])
+	a[24], a[32] = min(a[24], a[32]), max(a[24], a[32])
+	a[25], a[33] = min(a[25], a[33]), max(a[25], a[33])
+	a[25], a[32] = min(a[25], a[32]), max(a[25], a[32])
+	a[26], a[34] = min(a[26], a[34]), max(a[26], a[34])
+	a[27], a[35] = min(a[27], a[35]), max(a[27], a[35])
+	a[27], a[34] = min(a[27], a[34]), max(a[27], a[34])
+	a[26], a[32] = min(a[26], a[32]), max(a[26], a[32])
+	a[27], a[33] = min(a[27], a[33]), max(a[27], a[33])
+	a[27], a[32] = min(a[27], a[32]), max(a[27], a[32])
+	a[28], a[36] = min(a[28], a[36]), max(a[28], a[36])
+	a[29], a[37] = min(a[29], a[37]), max(a[29], a[37])
+	a[29], a[36] = min(a[29], a[36]), max(a[29], a[36])
+	a[30], a[38] = min(a[30], a[38]), max(a[30], a[38])
+	a[31], a[39] = min(a[31], a[39]), max(a[31], a[39])
+	a[31], a[38] = min(a[31], a[38]), max(a[31], a[38])
+	a[30], a[36] = min(a[30], a[36]), max(a[30], a[36])
+	a[31], a[37] = min(a[31], a[37]), max(a[31], a[37])
+	a[31], a[36] = min(a[31], a[36]), max(a[31], a[36])
+	a[28], a[32] = min(a[28], a[32]), max(a[28], a[32])
+	a[29], a[33] = min(a[29], a[33]), max(a[29], a[33])
+	a[29], a[32] = min(a[29], a[32]), max(a[29], a[32])
+	a[30], a[34] = min(a[30], a[34]), max(a[30], a[34])
+	a[31], a[35] = min(a[31], a[35]), max(a[31], a[35])
+	a[31], a[34] = min(a[31], a[34]), max(a[31], a[34])
+	a[30], a[32] = min(a[30], a[32]), max(a[30], a[32])
+	a[31], a[33] = min(a[31], a[33]), max(a[31], a[33])
+	a[31], a[32] = min(a[31], a[32]), max(a[31], a[32])
+}
+
+func NetworkSort64xIntReverse(a []int) {
+	_ = a[63]
+	a[0], a[1] = max(a[0], a[1]), min(a[0], a[1])
+	a[2], a[3] = max(a[2], a[3]), min(a[2], a[3])
+	a[0], a[2] = max(a[0], a[2]), min(a[0], a[2])
+	a[1], a[3] = max(a[1], a[3]), min(a[1], a[3])
+	a[1], a[2] = max(a[1], a[2]), min(a[1], a[2])
+	a[4], a[5] = max(a[4], a[5]), min(a[4], a[5])
+	a[6], a[7] = max(a[6], a[7]), min(a[6], a[7])
+	a[4], a[6] = max(a[4], a[6]), min(a[4], a[6])
+	a[5], a[7] = max(a[5], a[7]), min(a[5], a[7])
+	a[5], a[6] = max(a[5], a[6]), min(a[5], a[6])
+	a[0], a[4] = max(a[0], a[4]), min(a[0], a[4])
+	a[1], a[5] = max(a[1], a[5]), min(a[1], a[5])
+	a[1], a[4] = max(a[1], a[4]), min(a[1], a[4])
+	a[2], a[6] = max(a[2], a[6]), min(a[2], a[6])
+	a[3], a[7] = max(a[3], a[7]), min(a[3], a[7])
+	a[3], a[6] = max(a[3], a[6]), min(a[3], a[6])
+	a[2], a[4] = max(a[2], a[4]), min(a[2], a[4])
+	a[3], a[5] = max(a[3], a[5]), min(a[3], a[5])
+	a[3], a[4] = max(a[3], a[4]), min(a[3], a[4])
+	a[8], a[9] = max(a[8], a[9]), min(a[8], a[9])
+	a[10], a[11] = max(a[10], a[11]), min(a[10], a[11])
+	a[8], a[10] = max(a[8], a[10]), min(a[8], a[10])
+	a[9], a[11] = max(a[9], a[11]), min(a[9], a[11])
+	a[9], a[10] = max(a[9], a[10]), min(a[9], a[10])
+	a[12], a[13] = max(a[12], a[13]), min(a[12], a[13])
+	a[14], a[15] = max(a[14], a[15]), min(a[14], a[15])
+	a[12], a[14] = max(a[12], a[14]), min(a[12], a[14])
+	a[13], a[15] = max(a[13], a[15]), min(a[13], a[15])
+	a[13], a[14] = max(a[13], a[14]), min(a[13], a[14])
+	a[8], a[12] = max(a[8], a[12]), min(a[8], a[12])
+	a[9], a[13] = max(a[9], a[13]), min(a[9], a[13])
+	a[9], a[12] = max(a[9], a[12]), min(a[9], a[12])
+	a[10], a[14] = max(a[10], a[14]), min(a[10], a[14])
+	a[11], a[15] = max(a[11], a[15]), min(a[11], a[15])
+	a[11], a[14] = max(a[11], a[14]), min(a[11], a[14])
+	a[10], a[12] = max(a[10], a[12]), min(a[10], a[12])
+	a[11], a[13] = max(a[11], a[13]), min(a[11], a[13])
+	a[11], a[12] = max(a[11], a[12]), min(a[11], a[12])
+	a[0], a[8] = max(a[0], a[8]), min(a[0], a[8])
+	a[1], a[9] = max(a[1], a[9]), min(a[1], a[9])
+	a[1], a[8] = max(a[1], a[8]), min(a[1], a[8])
+	a[2], a[10] = max(a[2], a[10]), min(a[2], a[10])
+	a[3], a[11] = max(a[3], a[11]), min(a[3], a[11])
+	a[3], a[10] = max(a[3], a[10]), min(a[3], a[10])
+	a[2], a[8] = max(a[2], a[8]), min(a[2], a[8])
+	a[3], a[9] = max(a[3], a[9]), min(a[3], a[9])
+	a[3], a[8] = max(a[3], a[8]), min(a[3], a[8])
+	a[4], a[12] = max(a[4], a[12]), min(a[4], a[12])
+	a[5], a[13] = max(a[5], a[13]), min(a[5], a[13])
+	a[5], a[12] = max(a[5], a[12]), min(a[5], a[12])
+	a[6], a[14] = max(a[6], a[14]), min(a[6], a[14])
+	a[7], a[15] = max(a[7], a[15]), min(a[7], a[15])
+	a[7], a[14] = max(a[7], a[14]), min(a[7], a[14])
+	a[6], a[12] = max(a[6], a[12]), min(a[6], a[12])
+	a[7], a[13] = max(a[7], a[13]), min(a[7], a[13])
+	a[7], a[12] = max(a[7], a[12]), min(a[7], a[12])
+	a[4], a[8] = max(a[4], a[8]), min(a[4], a[8])
+	a[5], a[9] = max(a[5], a[9]), min(a[5], a[9])
+	a[5], a[8] = max(a[5], a[8]), min(a[5], a[8])
+	a[6], a[10] = max(a[6], a[10]), min(a[6], a[10])
+	a[7], a[11] = max(a[7], a[11]), min(a[7], a[11])
+	a[7], a[10] = max(a[7], a[10]), min(a[7], a[10])
+	a[6], a[8] = max(a[6], a[8]), min(a[6], a[8])
+	a[7], a[9] = max(a[7], a[9]), min(a[7], a[9])
+	a[7], a[8] = max(a[7], a[8]), min(a[7], a[8])
+	a[16], a[17] = max(a[16], a[17]), min(a[16], a[17])
+	a[18], a[19] = max(a[18], a[19]), min(a[18], a[19])
+	a[16], a[18] = max(a[16], a[18]), min(a[16], a[18])
+	a[17], a[19] = max(a[17], a[19]), min(a[17], a[19])
+	a[17], a[18] = max(a[17], a[18]), min(a[17], a[18])
+	a[20], a[21] = max(a[20], a[21]), min(a[20], a[21])
+	a[22], a[23] = max(a[22], a[23]), min(a[22], a[23])
+	a[20], a[22] = max(a[20], a[22]), min(a[20], a[22])
+	a[21], a[23] = max(a[21], a[23]), min(a[21], a[23])
+	a[21], a[22] = max(a[21], a[22]), min(a[21], a[22])
+	a[16], a[20] = max(a[16], a[20]), min(a[16], a[20])
+	a[17], a[21] = max(a[17], a[21]), min(a[17], a[21])
+	a[17], a[20] = max(a[17], a[20]), min(a[17], a[20])
+	a[18], a[22] = max(a[18], a[22]), min(a[18], a[22])
+	a[19], a[23] = max(a[19], a[23]), min(a[19], a[23])
+	a[19], a[22] = max(a[19], a[22]), min(a[19], a[22])
+	a[18], a[20] = max(a[18], a[20]), min(a[18], a[20])
+	a[19], a[21] = max(a[19], a[21]), min(a[19], a[21])
+	a[19], a[20] = max(a[19], a[20]), min(a[19], a[20])
+	a[24], a[25] = max(a[24], a[25]), min(a[24], a[25])
+	a[26], a[27] = max(a[26], a[27]), min(a[26], a[27])
+	a[24], a[26] = max(a[24], a[26]), min(a[24], a[26])
+	a[25], a[27] = max(a[25], a[27]), min(a[25], a[27])
+	a[25], a[26] = max(a[25], a[26]), min(a[25], a[26])
+	a[28], a[29] = max(a[28], a[29]), min(a[28], a[29])
+	a[30], a[31] = max(a[30], a[31]), min(a[30], a[31])
+	a[28], a[30] = max(a[28], a[30]), min(a[28], a[30])
+	a[29], a[31] = max(a[29], a[31]), min(a[29], a[31])
+	a[29], a[30] = max(a[29], a[30]), min(a[29], a[30])
+	a[24], a[28] = max(a[24], a[28]), min(a[24], a[28])
+	a[25], a[29] = max(a[25], a[29]), min(a[25], a[29])
+	a[25], a[28] = max(a[25], a[28]), min(a[25], a[28])
+	a[26], a[30] = max(a[26], a[30]), min(a[26], a[30])
+	a[27], a[31] = max(a[27], a[31]), min(a[27], a[31])
+	a[27], a[30] = max(a[27], a[30]), min(a[27], a[30])
+	a[26], a[28] = max(a[26], a[28]), min(a[26], a[28])
+	a[27], a[29] = max(a[27], a[29]), min(a[27], a[29])
+	a[27], a[28] = max(a[27], a[28]), min(a[27], a[28])
+	a[16], a[24] = max(a[16], a[24]), min(a[16], a[24])
+	a[17], a[25] = max(a[17], a[25]), min(a[17], a[25])
+	a[17], a[24] = max(a[17], a[24]), min(a[17], a[24])
+	a[18], a[26] = max(a[18], a[26]), min(a[18], a[26])
+	a[19], a[27] = max(a[19], a[27]), min(a[19], a[27])
+	a[19], a[26] = max(a[19], a[26]), min(a[19], a[26])
+	a[18], a[24] = max(a[18], a[24]), min(a[18], a[24])
+	a[19], a[25] = max(a[19], a[25]), min(a[19], a[25])
+	a[19], a[24] = max(a[19], a[24]), min(a[19], a[24])
+	a[20], a[28] = max(a[20], a[28]), min(a[20], a[28])
+	a[21], a[29] = max(a[21], a[29]), min(a[21], a[29])
+	a[21], a[28] = max(a[21], a[28]), min(a[21], a[28])
+	a[22], a[30] = max(a[22], a[30]), min(a[22], a[30])
+	a[23], a[31] = max(a[23], a[31]), min(a[23], a[31])
+	a[23], a[30] = max(a[23], a[30]), min(a[23], a[30])
+	a[22], a[28] = max(a[22], a[28]), min(a[22], a[28])
+	a[23], a[29] = max(a[23], a[29]), min(a[23], a[29])
+	a[23], a[28] = max(a[23], a[28]), min(a[23], a[28])
+	a[20], a[24] = max(a[20], a[24]), min(a[20], a[24])
+	a[21], a[25] = max(a[21], a[25]), min(a[21], a[25])
+	a[21], a[24] = max(a[21], a[24]), min(a[21], a[24])
+	a[22], a[26] = max(a[22], a[26]), min(a[22], a[26])
+	a[23], a[27] = max(a[23], a[27]), min(a[23], a[27])
+	a[23], a[26] = max(a[23], a[26]), min(a[23], a[26])
+	a[22], a[24] = max(a[22], a[24]), min(a[22], a[24])
+	a[23], a[25] = max(a[23], a[25]), min(a[23], a[25])
+	a[23], a[24] = max(a[23], a[24]), min(a[23], a[24])
+	a[0], a[16] = max(a[0], a[16]), min(a[0], a[16])
+	a[1], a[17] = max(a[1], a[17]), min(a[1], a[17])
+	a[1], a[16] = max(a[1], a[16]), min(a[1], a[16])
+	a[2], a[18] = max(a[2], a[18]), min(a[2], a[18])
+	a[3], a[19] = max(a[3], a[19]), min(a[3], a[19])
+	a[3], a[18] = max(a[3], a[18]), min(a[3], a[18])
+	a[2], a[16] = max(a[2], a[16]), min(a[2], a[16])
+	a[3], a[17] = max(a[3], a[17]), min(a[3], a[17])
+	a[3], a[16] = max(a[3], a[16]), min(a[3], a[16])
+	a[4], a[20] = max(a[4], a[20]), min(a[4], a[20])
+	a[5], a[21] = max(a[5], a[21]), min(a[5], a[21])
+	a[5], a[20] = max(a[5], a[20]), min(a[5], a[20])
+	a[6], a[22] = max(a[6], a[22]), min(a[6], a[22])
+	a[7], a[23] = max(a[7], a[23]), min(a[7], a[23])
+	a[7], a[22] = max(a[7], a[22]), min(a[7], a[22])
+	a[6], a[20] = max(a[6], a[20]), min(a[6], a[20])
+	a[7], a[21] = max(a[7], a[21]), min(a[7], a[21])
+	a[7], a[20] = max(a[7], a[20]), min(a[7], a[20])
+	a[4], a[16] = max(a[4], a[16]), min(a[4], a[16])
+	a[5], a[17] = max(a[5], a[17]), min(a[5], a[17])
+	a[5], a[16] = max(a[5], a[16]), min(a[5], a[16])
+	a[6], a[18] = max(a[6], a[18]), min(a[6], a[18])
+	a[7], a[19] = max(a[7], a[19]), min(a[7], a[19])
+	a[7], a[18] = max(a[7], a[18]), min(a[7], a[18])
+	a[6], a[16] = max(a[6], a[16]), min(a[6], a[16])
+	a[7], a[17] = max(a[7], a[17]), min(a[7], a[17])
+	a[7], a[16] = max(a[7], a[16]), min(a[7], a[16])
+	a[8], a[24] = max(a[8], a[24]), min(a[8], a[24])
+	a[9], a[25] = max(a[9], a[25]), min(a[9], a[25])
+	a[9], a[24] = max(a[9], a[24]), min(a[9], a[24])
+	a[10], a[26] = max(a[10], a[26]), min(a[10], a[26])
+	a[11], a[27] = max(a[11], a[27]), min(a[11], a[27])
+	a[11], a[26] = max(a[11], a[26]), min(a[11], a[26])
+	a[10], a[24] = max(a[10], a[24]), min(a[10], a[24])
+	a[11], a[25] = max(a[11], a[25]), min(a[11], a[25])
+	a[11], a[24] = max(a[11], a[24]), min(a[11], a[24])
+	a[12], a[28] = max(a[12], a[28]), min(a[12], a[28])
+	a[13], a[29] = max(a[13], a[29]), min(a[13], a[29])
+	a[13], a[28] = max(a[13], a[28]), min(a[13], a[28])
+	a[14], a[30] = max(a[14], a[30]), min(a[14], a[30])
+	a[15], a[31] = max(a[15], a[31]), min(a[15], a[31])
+	a[15], a[30] = max(a[15], a[30]), min(a[15], a[30])
+	a[14], a[28] = max(a[14], a[28]), min(a[14], a[28])
+	a[15], a[29] = max(a[15], a[29]), min(a[15], a[29])
+	a[15], a[28] = max(a[15], a[28]), min(a[15], a[28])
+	a[12], a[24] = max(a[12], a[24]), min(a[12], a[24])
+	a[13], a[25] = max(a[13], a[25]), min(a[13], a[25])
+	a[13], a[24] = max(a[13], a[24]), min(a[13], a[24])
+	a[14], a[26] = max(a[14], a[26]), min(a[14], a[26])
+	a[15], a[27] = max(a[15], a[27]), min(a[15], a[27])
+	a[15], a[26] = max(a[15], a[26]), min(a[15], a[26])
+	a[14], a[24] = max(a[14], a[24]), min(a[14], a[24])
+	a[15], a[25] = max(a[15], a[25]), min(a[15], a[25])
+	a[15], a[24] = max(a[15], a[24]), min(a[15], a[24])
+	a[8], a[16] = max(a[8], a[16]), min(a[8], a[16])
+	a[9], a[17] = max(a[9], a[17]), min(a[9], a[17])
+	a[9], a[16] = max(a[9], a[16]), min(a[9], a[16])
+	a[10], a[18] = max(a[10], a[18]), min(a[10], a[18])
+	a[11], a[19] = max(a[11], a[19]), min(a[11], a[19])
+	a[11], a[18] = max(a[11], a[18]), min(a[11], a[18])
+	a[10], a[16] = max(a[10], a[16]), min(a[10], a[16])
+	a[11], a[17] = max(a[11], a[17]), min(a[11], a[17])
+	a[11], a[16] = max(a[11], a[16]), min(a[11], a[16])
+	a[12], a[20] = max(a[12], a[20]), min(a[12], a[20])
+	a[13], a[21] = max(a[13], a[21]), min(a[13], a[21])
+	a[13], a[20] = max(a[13], a[20]), min(a[13], a[20])
+	a[14], a[22] = max(a[14], a[22]), min(a[14], a[22])
+	a[15], a[23] = max(a[15], a[23]), min(a[15], a[23])
+	a[15], a[22] = max(a[15], a[22]), min(a[15], a[22])
+	a[14], a[20] = max(a[14], a[20]), min(a[14], a[20])
+	a[15], a[21] = max(a[15], a[21]), min(a[15], a[21])
+	a[15], a[20] = max(a[15], a[20]), min(a[15], a[20])
+	a[12], a[16] = max(a[12], a[16]), min(a[12], a[16])
+	a[13], a[17] = max(a[13], a[17]), min(a[13], a[17])
+	a[13], a[16] = max(a[13], a[16]), min(a[13], a[16])
+	a[14], a[18] = max(a[14], a[18]), min(a[14], a[18])
+	a[15], a[19] = max(a[15], a[19]), min(a[15], a[19])
+	a[15], a[18] = max(a[15], a[18]), min(a[15], a[18])
+	a[14], a[16] = max(a[14], a[16]), min(a[14], a[16])
+	a[15], a[17] = max(a[15], a[17]), min(a[15], a[17])
+	a[15], a[16] = max(a[15], a[16]), min(a[15], a[16])
+	a[32], a[33] = max(a[32], a[33]), min(a[32], a[33])
+	a[34], a[35] = max(a[34], a[35]), min(a[34], a[35])
+	a[32], a[34] = max(a[32], a[34]), min(a[32], a[34])
+	a[33], a[35] = max(a[33], a[35]), min(a[33], a[35])
+	a[33], a[34] = max(a[33], a[34]), min(a[33], a[34])
+	a[36], a[37] = max(a[36], a[37]), min(a[36], a[37])
+	a[38], a[39] = max(a[38], a[39]), min(a[38], a[39])
+	a[36], a[38] = max(a[36], a[38]), min(a[36], a[38])
+	a[37], a[39] = max(a[37], a[39]), min(a[37], a[39])
+	a[37], a[38] = max(a[37], a[38]), min(a[37], a[38])
+	a[32], a[36] = max(a[32], a[36]), min(a[32], a[36])
+	a[33], a[37] = max(a[33], a[37]), min(a[33], a[37])
+	a[33], a[36] = max(a[33], a[36]), min(a[33], a[36])
+	a[34], a[38] = max(a[34], a[38]), min(a[34], a[38])
+	a[35], a[39] = max(a[35], a[39]), min(a[35], a[39])
+	a[35], a[38] = max(a[35], a[38]), min(a[35], a[38])
+	a[34], a[36] = max(a[34], a[36]), min(a[34], a[36])
+	a[35], a[37] = max(a[35], a[37]), min(a[35], a[37])
+	a[35], a[36] = max(a[35], a[36]), min(a[35], a[36])
+	a[40], a[41] = max(a[40], a[41]), min(a[40], a[41])
+	a[42], a[43] = max(a[42], a[43]), min(a[42], a[43])
+	a[40], a[42] = max(a[40], a[42]), min(a[40], a[42])
+	a[41], a[43] = max(a[41], a[43]), min(a[41], a[43])
+	a[41], a[42] = max(a[41], a[42]), min(a[41], a[42])
+	a[44], a[45] = max(a[44], a[45]), min(a[44], a[45])
+	a[46], a[47] = max(a[46], a[47]), min(a[46], a[47])
+	a[44], a[46] = max(a[44], a[46]), min(a[44], a[46])
+	a[45], a[47] = max(a[45], a[47]), min(a[45], a[47])
+	a[45], a[46] = max(a[45], a[46]), min(a[45], a[46])
+	a[40], a[44] = max(a[40], a[44]), min(a[40], a[44])
+	a[41], a[45] = max(a[41], a[45]), min(a[41], a[45])
+	a[41], a[44] = max(a[41], a[44]), min(a[41], a[44])
+	a[42], a[46] = max(a[42], a[46]), min(a[42], a[46])
+	a[43], a[47] = max(a[43], a[47]), min(a[43], a[47])
+	a[43], a[46] = max(a[43], a[46]), min(a[43], a[46])
+	a[42], a[44] = max(a[42], a[44]), min(a[42], a[44])
+	a[43], a[45] = max(a[43], a[45]), min(a[43], a[45])
+	a[43], a[44] = max(a[43], a[44]), min(a[43], a[44])
+	a[32], a[40] = max(a[32], a[40]), min(a[32], a[40])
+	a[33], a[41] = max(a[33], a[41]), min(a[33], a[41])
+	a[33], a[40] = max(a[33], a[40]), min(a[33], a[40])
+	a[34], a[42] = max(a[34], a[42]), min(a[34], a[42])
+	a[35], a[43] = max(a[35], a[43]), min(a[35], a[43])
+	a[35], a[42] = max(a[35], a[42]), min(a[35], a[42])
+	a[34], a[40] = max(a[34], a[40]), min(a[34], a[40])
+	a[35], a[41] = max(a[35], a[41]), min(a[35], a[41])
+	a[35], a[40] = max(a[35], a[40]), min(a[35], a[40])
+	a[36], a[44] = max(a[36], a[44]), min(a[36], a[44])
+	a[37], a[45] = max(a[37], a[45]), min(a[37], a[45])
+	a[37], a[44] = max(a[37], a[44]), min(a[37], a[44])
+	a[38], a[46] = max(a[38], a[46]), min(a[38], a[46])
+	a[39], a[47] = max(a[39], a[47]), min(a[39], a[47])
+	a[39], a[46] = max(a[39], a[46]), min(a[39], a[46])
+	a[38], a[44] = max(a[38], a[44]), min(a[38], a[44])
+	a[39], a[45] = max(a[39], a[45]), min(a[39], a[45])
+	a[39], a[44] = max(a[39], a[44]), min(a[39], a[44])
+	a[36], a[40] = max(a[36], a[40]), min(a[36], a[40])
+	a[37], a[41] = max(a[37], a[41]), min(a[37], a[41])
+	a[37], a[40] = max(a[37], a[40]), min(a[37], a[40])
+	a[38], a[42] = max(a[38], a[42]), min(a[38], a[42])
+	a[39], a[43] = max(a[39], a[43]), min(a[39], a[43])
+	a[39], a[42] = max(a[39], a[42]), min(a[39], a[42])
+	a[38], a[40] = max(a[38], a[40]), min(a[38], a[40])
+	a[39], a[41] = max(a[39], a[41]), min(a[39], a[41])
+	a[39], a[40] = max(a[39], a[40]), min(a[39], a[40])
+	a[48], a[49] = max(a[48], a[49]), min(a[48], a[49])
+	a[50], a[51] = max(a[50], a[51]), min(a[50], a[51])
+	a[48], a[50] = max(a[48], a[50]), min(a[48], a[50])
+	a[49], a[51] = max(a[49], a[51]), min(a[49], a[51])
+	a[49], a[50] = max(a[49], a[50]), min(a[49], a[50])
+	a[52], a[53] = max(a[52], a[53]), min(a[52], a[53])
+	a[54], a[55] = max(a[54], a[55]), min(a[54], a[55])
+	a[52], a[54] = max(a[52], a[54]), min(a[52], a[54])
+	a[53], a[55] = max(a[53], a[55]), min(a[53], a[55])
+	a[53], a[54] = max(a[53], a[54]), min(a[53], a[54])
+	a[48], a[52] = max(a[48], a[52]), min(a[48], a[52])
+	a[49], a[53] = max(a[49], a[53]), min(a[49], a[53])
+	a[49], a[52] = max(a[49], a[52]), min(a[49], a[52])
+	a[50], a[54] = max(a[50], a[54]), min(a[50], a[54])
+	a[51], a[55] = max(a[51], a[55]), min(a[51], a[55])
+	a[51], a[54] = max(a[51], a[54]), min(a[51], a[54])
+	a[50], a[52] = max(a[50], a[52]), min(a[50], a[52])
+	a[51], a[53] = max(a[51], a[53]), min(a[51], a[53])
+	a[51], a[52] = max(a[51], a[52]), min(a[51], a[52])
+	a[56], a[57] = max(a[56], a[57]), min(a[56], a[57])
+	a[58], a[59] = max(a[58], a[59]), min(a[58], a[59])
+	a[56], a[58] = max(a[56], a[58]), min(a[56], a[58])
+	a[57], a[59] = max(a[57], a[59]), min(a[57], a[59])
+	a[57], a[58] = max(a[57], a[58]), min(a[57], a[58])
+	a[60], a[61] = max(a[60], a[61]), min(a[60], a[61])
+	a[62], a[63] = max(a[62], a[63]), min(a[62], a[63])
+	a[60], a[62] = max(a[60], a[62]), min(a[60], a[62])
+	a[61], a[63] = max(a[61], a[63]), min(a[61], a[63])
+	a[61], a[62] = max(a[61], a[62]), min(a[61], a[62])
+	a[56], a[60] = max(a[56], a[60]), min(a[56], a[60])
+	a[57], a[61] = max(a[57], a[61]), min(a[57], a[61])
+	a[57], a[60] = max(a[57], a[60]), min(a[57], a[60])
+	a[58], a[62] = max(a[58], a[62]), min(a[58], a[62])
+	a[59], a[63] = max(a[59], a[63]), min(a[59], a[63])
+	a[59], a[62] = max(a[59], a[62]), min(a[59], a[62])
+	a[58], a[60] = max(a[58], a[60]), min(a[58], a[60])
+	a[59], a[61] = max(a[59], a[61]), min(a[59], a[61])
+	a[59], a[60] = max(a[59], a[60]), min(a[59], a[60])
+	a[48], a[56] = max(a[48], a[56]), min(a[48], a[56])
+	a[49], a[57] = max(a[49], a[57]), min(a[49], a[57])
+	a[49], a[56] = max(a[49], a[56]), min(a[49], a[56])
+	a[50], a[58] = max(a[50], a[58]), min(a[50], a[58])
+	a[51], a[59] = max(a[51], a[59]), min(a[51], a[59])
+	a[51], a[58] = max(a[51], a[58]), min(a[51], a[58])
+	a[50], a[56] = max(a[50], a[56]), min(a[50], a[56])
+	a[51], a[57] = max(a[51], a[57]), min(a[51], a[57])
+	a[51], a[56] = max(a[51], a[56]), min(a[51], a[56])
+	a[52], a[60] = max(a[52], a[60]), min(a[52], a[60])
+	a[53], a[61] = max(a[53], a[61]), min(a[53], a[61])
+	a[53], a[60] = max(a[53], a[60]), min(a[53], a[60])
+	a[54], a[62] = max(a[54], a[62]), min(a[54], a[62])
+	a[55], a[63] = max(a[55], a[63]), min(a[55], a[63])
+	a[55], a[62] = max(a[55], a[62]), min(a[55], a[62])
+	a[54], a[60] = max(a[54], a[60]), min(a[54], a[60])
+	a[55], a[61] = max(a[55], a[61]), min(a[55], a[61])
+	a[55], a[60] = max(a[55], a[60]), min(a[55], a[60])
+	a[52], a[56] = max(a[52], a[56]), min(a[52], a[56])
+	a[53], a[57] = max(a[53], a[57]), min(a[53], a[57])
+	a[53], a[56] = max(a[53], a[56]), min(a[53], a[56])
+	a[54], a[58] = max(a[54], a[58]), min(a[54], a[58])
+	a[55], a[59] = max(a[55], a[59]), min(a[55], a[59])
+	a[55], a[58] = max(a[55], a[58]), min(a[55], a[58])
+	a[54], a[56] = max(a[54], a[56]), min(a[54], a[56])
+	a[55], a[57] = max(a[55], a[57]), min(a[55], a[57])
+	a[55], a[56] = max(a[55], a[56]), min(a[55], a[56])
+	a[32], a[48] = max(a[32], a[48]), min(a[32], a[48])
+	a[33], a[49] = max(a[33], a[49]), min(a[33], a[49])
+	a[33], a[48] = max(a[33], a[48]), min(a[33], a[48])
+	a[34], a[50] = max(a[34], a[50]), min(a[34], a[50])
+	a[35], a[51] = max(a[35], a[51]), min(a[35], a[51])
+	a[35], a[50] = max(a[35], a[50]), min(a[35], a[50])
+	a[34], a[48] = max(a[34], a[48]), min(a[34], a[48])
+	a[35], a[49] = max(a[35], a[49]), min(a[35], a[49])
+	a[35], a[48] = max(a[35], a[48]), min(a[35], a[48])
+	a[36], a[52] = max(a[36], a[52]), min(a[36], a[52])
+	a[37], a[53] = max(a[37], a[53]), min(a[37], a[53])
+	a[37], a[52] = max(a[37], a[52]), min(a[37], a[52])
+	a[38], a[54] = max(a[38], a[54]), min(a[38], a[54])
+	a[39], a[55] = max(a[39], a[55]), min(a[39], a[55])
+	a[39], a[54] = max(a[39], a[54]), min(a[39], a[54])
+	a[38], a[52] = max(a[38], a[52]), min(a[38], a[52])
+	a[39], a[53] = max(a[39], a[53]), min(a[39], a[53])
+	a[39], a[52] = max(a[39], a[52]), min(a[39], a[52])
+	a[36], a[48] = max(a[36], a[48]), min(a[36], a[48])
+	a[37], a[49] = max(a[37], a[49]), min(a[37], a[49])
+	a[37], a[48] = max(a[37], a[48]), min(a[37], a[48])
+	a[38], a[50] = max(a[38], a[50]), min(a[38], a[50])
+	a[39], a[51] = max(a[39], a[51]), min(a[39], a[51])
+	a[39], a[50] = max(a[39], a[50]), min(a[39], a[50])
+	a[38], a[48] = max(a[38], a[48]), min(a[38], a[48])
+	a[39], a[49] = max(a[39], a[49]), min(a[39], a[49])
+	a[39], a[48] = max(a[39], a[48]), min(a[39], a[48])
+	a[40], a[56] = max(a[40], a[56]), min(a[40], a[56])
+	a[41], a[57] = max(a[41], a[57]), min(a[41], a[57])
+	a[41], a[56] = max(a[41], a[56]), min(a[41], a[56])
+	a[42], a[58] = max(a[42], a[58]), min(a[42], a[58])
+	a[43], a[59] = max(a[43], a[59]), min(a[43], a[59])
+	a[43], a[58] = max(a[43], a[58]), min(a[43], a[58])
+	a[42], a[56] = max(a[42], a[56]), min(a[42], a[56])
+	a[43], a[57] = max(a[43], a[57]), min(a[43], a[57])
+	a[43], a[56] = max(a[43], a[56]), min(a[43], a[56])
+	a[44], a[60] = max(a[44], a[60]), min(a[44], a[60])
+	a[45], a[61] = max(a[45], a[61]), min(a[45], a[61])
+	a[45], a[60] = max(a[45], a[60]), min(a[45], a[60])
+	a[46], a[62] = max(a[46], a[62]), min(a[46], a[62])
+	a[47], a[63] = max(a[47], a[63]), min(a[47], a[63])
+	a[47], a[62] = max(a[47], a[62]), min(a[47], a[62])
+	a[46], a[60] = max(a[46], a[60]), min(a[46], a[60])
+	a[47], a[61] = max(a[47], a[61]), min(a[47], a[61])
+	a[47], a[60] = max(a[47], a[60]), min(a[47], a[60])
+	a[44], a[56] = max(a[44], a[56]), min(a[44], a[56])
+	a[45], a[57] = max(a[45], a[57]), min(a[45], a[57])
+	a[45], a[56] = max(a[45], a[56]), min(a[45], a[56])
+	a[46], a[58] = max(a[46], a[58]), min(a[46], a[58])
+	a[47], a[59] = max(a[47], a[59]), min(a[47], a[59])
+	a[47], a[58] = max(a[47], a[58]), min(a[47], a[58])
+	a[46], a[56] = max(a[46], a[56]), min(a[46], a[56])
+	a[47], a[57] = max(a[47], a[57]), min(a[47], a[57])
+	a[47], a[56] = max(a[47], a[56]), min(a[47], a[56])
+	a[40], a[48] = max(a[40], a[48]), min(a[40], a[48])
+	a[41], a[49] = max(a[41], a[49]), min(a[41], a[49])
+	a[41], a[48] = max(a[41], a[48]), min(a[41], a[48])
+	a[42], a[50] = max(a[42], a[50]), min(a[42], a[50])
+	a[43], a[51] = max(a[43], a[51]), min(a[43], a[51])
+	a[43], a[50] = max(a[43], a[50]), min(a[43], a[50])
+	a[42], a[48] = max(a[42], a[48]), min(a[42], a[48])
+	a[43], a[49] = max(a[43], a[49]), min(a[43], a[49])
+	a[43], a[48] = max(a[43], a[48]), min(a[43], a[48])
+	a[44], a[52] = max(a[44], a[52]), min(a[44], a[52])
+	a[45], a[53] = max(a[45], a[53]), min(a[45], a[53])
+	a[45], a[52] = max(a[45], a[52]), min(a[45], a[52])
+	a[46], a[54] = max(a[46], a[54]), min(a[46], a[54])
+	a[47], a[55] = max(a[47], a[55]), min(a[47], a[55])
+	a[47], a[54] = max(a[47], a[54]), min(a[47], a[54])
+	a[46], a[52] = max(a[46], a[52]), min(a[46], a[52])
+	a[47], a[53] = max(a[47], a[53]), min(a[47], a[53])
+	a[47], a[52] = max(a[47], a[52]), min(a[47], a[52])
+	a[44], a[48] = max(a[44], a[48]), min(a[44], a[48])
+	a[45], a[49] = max(a[45], a[49]), min(a[45], a[49])
+	a[45], a[48] = max(a[45], a[48]), min(a[45], a[48])
+	a[46], a[50] = max(a[46], a[50]), min(a[46], a[50])
+	a[47], a[51] = max(a[47], a[51]), min(a[47], a[51])
+	a[47], a[50] = max(a[47], a[50]), min(a[47], a[50])
+	a[46], a[48] = max(a[46], a[48]), min(a[46], a[48])
+	a[47], a[49] = max(a[47], a[49]), min(a[47], a[49])
+	a[47], a[48] = max(a[47], a[48]), min(a[47], a[48])
+	a[0], a[32] = max(a[0], a[32]), min(a[0], a[32])
+	a[1], a[33] = max(a[1], a[33]), min(a[1], a[33])
+	a[1], a[32] = max(a[1], a[32]), min(a[1], a[32])
+	a[2], a[34] = max(a[2], a[34]), min(a[2], a[34])
+	a[3], a[35] = max(a[3], a[35]), min(a[3], a[35])
+	a[3], a[34] = max(a[3], a[34]), min(a[3], a[34])
+	a[2], a[32] = max(a[2], a[32]), min(a[2], a[32])
+	a[3], a[33] = max(a[3], a[33]), min(a[3], a[33])
+	a[3], a[32] = max(a[3], a[32]), min(a[3], a[32])
+	a[4], a[36] = max(a[4], a[36]), min(a[4], a[36])
+	a[5], a[37] = max(a[5], a[37]), min(a[5], a[37])
+	a[5], a[36] = max(a[5], a[36]), min(a[5], a[36])
+	a[6], a[38] = max(a[6], a[38]), min(a[6], a[38])
+	a[7], a[39] = max(a[7], a[39]), min(a[7], a[39])
+	a[7], a[38] = max(a[7], a[38]), min(a[7], a[38])
+	a[6], a[36] = max(a[6], a[36]), min(a[6], a[36])
+	a[7], a[37] = max(a[7], a[37]), min(a[7], a[37])
+	a[7], a[36] = max(a[7], a[36]), min(a[7], a[36])
+	a[4], a[32] = max(a[4], a[32]), min(a[4], a[32])
+	a[5], a[33] = max(a[5], a[33]), min(a[5], a[33])
+	a[5], a[32] = max(a[5], a[32]), min(a[5], a[32])
+	a[6], a[34] = max(a[6], a[34]), min(a[6], a[34])
+	a[7], a[35] = max(a[7], a[35]), min(a[7], a[35])
+	a[7], a[34] = max(a[7], a[34]), min(a[7], a[34])
+	a[6], a[32] = max(a[6], a[32]), min(a[6], a[32])
+	a[7], a[33] = max(a[7], a[33]), min(a[7], a[33])
+	a[7], a[32] = max(a[7], a[32]), min(a[7], a[32])
+	a[8], a[40] = max(a[8], a[40]), min(a[8], a[40])
+	a[9], a[41] = max(a[9], a[41]), min(a[9], a[41])
+	a[9], a[40] = max(a[9], a[40]), min(a[9], a[40])
+	a[10], a[42] = max(a[10], a[42]), min(a[10], a[42])
+	a[11], a[43] = max(a[11], a[43]), min(a[11], a[43])
+	a[11], a[42] = max(a[11], a[42]), min(a[11], a[42])
+	a[10], a[40] = max(a[10], a[40]), min(a[10], a[40])
+	a[11], a[41] = max(a[11], a[41]), min(a[11], a[41])
+	a[11], a[40] = max(a[11], a[40]), min(a[11], a[40])
+	a[12], a[44] = max(a[12], a[44]), min(a[12], a[44])
+	a[13], a[45] = max(a[13], a[45]), min(a[13], a[45])
+	a[13], a[44] = max(a[13], a[44]), min(a[13], a[44])
+	a[14], a[46] = max(a[14], a[46]), min(a[14], a[46])
+	a[15], a[47] = max(a[15], a[47]), min(a[15], a[47])
+	a[15], a[46] = max(a[15], a[46]), min(a[15], a[46])
+	a[14], a[44] = max(a[14], a[44]), min(a[14], a[44])
+	a[15], a[45] = max(a[15], a[45]), min(a[15], a[45])
+	a[15], a[44] = max(a[15], a[44]), min(a[15], a[44])
+	a[12], a[40] = max(a[12], a[40]), min(a[12], a[40])
+	a[13], a[41] = max(a[13], a[41]), min(a[13], a[41])
+	a[13], a[40] = max(a[13], a[40]), min(a[13], a[40])
+	a[14], a[42] = max(a[14], a[42]), min(a[14], a[42])
+	a[15], a[43] = max(a[15], a[43]), min(a[15], a[43])
+	a[15], a[42] = max(a[15], a[42]), min(a[15], a[42])
+	a[14], a[40] = max(a[14], a[40]), min(a[14], a[40])
+	a[15], a[41] = max(a[15], a[41]), min(a[15], a[41])
+	a[15], a[40] = max(a[15], a[40]), min(a[15], a[40])
+	a[8], a[32] = max(a[8], a[32]), min(a[8], a[32])
+	a[9], a[33] = max(a[9], a[33]), min(a[9], a[33])
+	a[9], a[32] = max(a[9], a[32]), min(a[9], a[32])
+	a[10], a[34] = max(a[10], a[34]), min(a[10], a[34])
+	a[11], a[35] = max(a[11], a[35]), min(a[11], a[35])
+	a[11], a[34] = max(a[11], a[34]), min(a[11], a[34])
+	a[10], a[32] = max(a[10], a[32]), min(a[10], a[32])
+	a[11], a[33] = max(a[11], a[33]), min(a[11], a[33])
+	a[11], a[32] = max(a[11], a[32]), min(a[11], a[32])
+	a[12], a[36] = max(a[12], a[36]), min(a[12], a[36])
+	a[13], a[37] = max(a[13], a[37]), min(a[13], a[37])
+	a[13], a[36] = max(a[13], a[36]), min(a[13], a[36])
+	a[14], a[38] = max(a[14], a[38]), min(a[14], a[38])
+	a[15], a[39] = max(a[15], a[39]), min(a[15], a[39])
+	a[15], a[38] = max(a[15], a[38]), min(a[15], a[38])
+	a[14], a[36] = max(a[14], a[36]), min(a[14], a[36])
+	a[15], a[37] = max(a[15], a[37]), min(a[15], a[37])
+	a[15], a[36] = max(a[15], a[36]), min(a[15], a[36])
+	a[12], a[32] = max(a[12], a[32]), min(a[12], a[32])
+	a[13], a[33] = max(a[13], a[33]), min(a[13], a[33])
+	a[13], a[32] = max(a[13], a[32]), min(a[13], a[32])
+	a[14], a[34] = max(a[14], a[34]), min(a[14], a[34])
+	a[15], a[35] = max(a[15], a[35]), min(a[15], a[35])
+	a[15], a[34] = max(a[15], a[34]), min(a[15], a[34])
+	a[14], a[32] = max(a[14], a[32]), min(a[14], a[32])
+	a[15], a[33] = max(a[15], a[33]), min(a[15], a[33])
+	a[15], a[32] = max(a[15], a[32]), min(a[15], a[32])
+	a[16], a[48] = max(a[16], a[48]), min(a[16], a[48])
+	a[17], a[49] = max(a[17], a[49]), min(a[17], a[49])
+	a[17], a[48] = max(a[17], a[48]), min(a[17], a[48])
+	a[18], a[50] = max(a[18], a[50]), min(a[18], a[50])
+	a[19], a[51] = max(a[19], a[51]), min(a[19], a[51])
+	a[19], a[50] = max(a[19], a[50]), min(a[19], a[50])
+	a[18], a[48] = max(a[18], a[48]), min(a[18], a[48])
+	a[19], a[49] = max(a[19], a[49]), min(a[19], a[49])
+	a[19], a[48] = max(a[19], a[48]), min(a[19], a[48])
+	a[20], a[52] = max(a[20], a[52]), min(a[20], a[52])
+	a[21], a[53] = max(a[21], a[53]), min(a[21], a[53])
+	a[21], a[52] = max(a[21], a[52]), min(a[21], a[52])
+	a[22], a[54] = max(a[22], a[54]), min(a[22], a[54])
+	a[23], a[55] = max(a[23], a[55]), min(a[23], a[55])
+	a[23], a[54] = max(a[23], a[54]), min(a[23], a[54])
+	a[22], a[52] = max(a[22], a[52]), min(a[22], a[52])
+	a[23], a[53] = max(a[23], a[53]), min(a[23], a[53])
+	a[23], a[52] = max(a[23], a[52]), min(a[23], a[52])
+	a[20], a[48] = max(a[20], a[48]), min(a[20], a[48])
+	a[21], a[49] = max(a[21], a[49]), min(a[21], a[49])
+	a[21], a[48] = max(a[21], a[48]), min(a[21], a[48])
+	a[22], a[50] = max(a[22], a[50]), min(a[22], a[50])
+	a[23], a[51] = max(a[23], a[51]), min(a[23], a[51])
+	a[23], a[50] = max(a[23], a[50]), min(a[23], a[50])
+	a[22], a[48] = max(a[22], a[48]), min(a[22], a[48])
+	a[23], a[49] = max(a[23], a[49]), min(a[23], a[49])
+	a[23], a[48] = max(a[23], a[48]), min(a[23], a[48])
+	a[24], a[56] = max(a[24], a[56]), min(a[24], a[56])
+	a[25], a[57] = max(a[25], a[57]), min(a[25], a[57])
+	a[25], a[56] = max(a[25], a[56]), min(a[25], a[56])
+	a[26], a[58] = max(a[26], a[58]), min(a[26], a[58])
+	a[27], a[59] = max(a[27], a[59]), min(a[27], a[59])
+	a[27], a[58] = max(a[27], a[58]), min(a[27], a[58])
+	a[26], a[56] = max(a[26], a[56]), min(a[26], a[56])
+	a[27], a[57] = max(a[27], a[57]), min(a[27], a[57])
+	a[27], a[56] = max(a[27], a[56]), min(a[27], a[56])
+	a[28], a[60] = max(a[28], a[60]), min(a[28], a[60])
+	a[29], a[61] = max(a[29], a[61]), min(a[29], a[61])
+	a[29], a[60] = max(a[29], a[60]), min(a[29], a[60])
+	a[30], a[62] = max(a[30], a[62]), min(a[30], a[62])
+	a[31], a[63] = max(a[31], a[63]), min(a[31], a[63])
+	a[31], a[62] = max(a[31], a[62]), min(a[31], a[62])
+	a[30], a[60] = max(a[30], a[60]), min(a[30], a[60])
+	a[31], a[61] = max(a[31], a[61]), min(a[31], a[61])
+	a[31], a[60] = max(a[31], a[60]), min(a[31], a[60])
+	a[28], a[56] = max(a[28], a[56]), min(a[28], a[56])
+	a[29], a[57] = max(a[29], a[57]), min(a[29], a[57])
+	a[29], a[56] = max(a[29], a[56]), min(a[29], a[56])
+	a[30], a[58] = max(a[30], a[58]), min(a[30], a[58])
+	a[31], a[59] = max(a[31], a[59]), min(a[31], a[59])
+	a[31], a[58] = max(a[31], a[58]), min(a[31], a[58])
+	a[30], a[56] = max(a[30], a[56]), min(a[30], a[56])
+	a[31], a[57] = max(a[31], a[57]), min(a[31], a[57])
+	a[31], a[56] = max(a[31], a[56]), min(a[31], a[56])
+	a[24], a[48] = max(a[24], a[48]), min(a[24], a[48])
+	a[25], a[49] = max(a[25], a[49]), min(a[25], a[49])
+	a[25], a[48] = max(a[25], a[48]), min(a[25], a[48])
+	a[26], a[50] = max(a[26], a[50]), min(a[26], a[50])
+	a[27], a[51] = max(a[27], a[51]), min(a[27], a[51])
+	a[27], a[50] = max(a[27], a[50]), min(a[27], a[50])
+	a[26], a[48] = max(a[26], a[48]), min(a[26], a[48])
+	a[27], a[49] = max(a[27], a[49]), min(a[27], a[49])
+	a[27], a[48] = max(a[27], a[48]), min(a[27], a[48])
+	a[28], a[52] = max(a[28], a[52]), min(a[28], a[52])
+	a[29], a[53] = max(a[29], a[53]), min(a[29], a[53])
+	a[29], a[52] = max(a[29], a[52]), min(a[29], a[52])
+	a[30], a[54] = max(a[30], a[54]), min(a[30], a[54])
+	a[31], a[55] = max(a[31], a[55]), min(a[31], a[55])
+	a[31], a[54] = max(a[31], a[54]), min(a[31], a[54])
+	a[30], a[52] = max(a[30], a[52]), min(a[30], a[52])
+	a[31], a[53] = max(a[31], a[53]), min(a[31], a[53])
+	a[31], a[52] = max(a[31], a[52]), min(a[31], a[52])
+	a[28], a[48] = max(a[28], a[48]), min(a[28], a[48])
+	a[29], a[49] = max(a[29], a[49]), min(a[29], a[49])
+	a[29], a[48] = max(a[29], a[48]), min(a[29], a[48])
+	a[30], a[50] = max(a[30], a[50]), min(a[30], a[50])
+	a[31], a[51] = max(a[31], a[51]), min(a[31], a[51])
+	a[31], a[50] = max(a[31], a[50]), min(a[31], a[50])
+	a[30], a[48] = max(a[30], a[48]), min(a[30], a[48])
+	a[31], a[49] = max(a[31], a[49]), min(a[31], a[49])
+	a[31], a[48] = max(a[31], a[48]), min(a[31], a[48])
+	a[16], a[32] = max(a[16], a[32]), min(a[16], a[32])
+	a[17], a[33] = max(a[17], a[33]), min(a[17], a[33])
+	a[17], a[32] = max(a[17], a[32]), min(a[17], a[32])
+	a[18], a[34] = max(a[18], a[34]), min(a[18], a[34])
+	a[19], a[35] = max(a[19], a[35]), min(a[19], a[35])
+	a[19], a[34] = max(a[19], a[34]), min(a[19], a[34])
+	a[18], a[32] = max(a[18], a[32]), min(a[18], a[32])
+	a[19], a[33] = max(a[19], a[33]), min(a[19], a[33])
+	a[19], a[32] = max(a[19], a[32]), min(a[19], a[32])
+	a[20], a[36] = max(a[20], a[36]), min(a[20], a[36])
+	a[21], a[37] = max(a[21], a[37]), min(a[21], a[37])
+	a[21], a[36] = max(a[21], a[36]), min(a[21], a[36])
+	a[22], a[38] = max(a[22], a[38]), min(a[22], a[38])
+	a[23], a[39] = max(a[23], a[39]), min(a[23], a[39])
+	a[23], a[38] = max(a[23], a[38]), min(a[23], a[38])
+	a[22], a[36] = max(a[22], a[36]), min(a[22], a[36])
+	a[23], a[37] = max(a[23], a[37]), min(a[23], a[37])
+	a[23], a[36] = max(a[23], a[36]), min(a[23], a[36])
+	a[20], a[32] = max(a[20], a[32]), min(a[20], a[32])
+	a[21], a[33] = max(a[21], a[33]), min(a[21], a[33])
+	a[21], a[32] = max(a[21], a[32]), min(a[21], a[32])
+	a[22], a[34] = max(a[22], a[34]), min(a[22], a[34])
+	a[23], a[35] = max(a[23], a[35]), min(a[23], a[35])
+	a[23], a[34] = max(a[23], a[34]), min(a[23], a[34])
+	a[22], a[32] = max(a[22], a[32]), min(a[22], a[32])
+	a[23], a[33] = max(a[23], a[33]), min(a[23], a[33])
+	a[23], a[32] = max(a[23], a[32]), min(a[23], a[32])
+	a[24], a[40] = max(a[24], a[40]), min(a[24], a[40])
+	a[25], a[41] = max(a[25], a[41]), min(a[25], a[41])
+	a[25], a[40] = max(a[25], a[40]), min(a[25], a[40])
+	a[26], a[42] = max(a[26], a[42]), min(a[26], a[42])
+	a[27], a[43] = max(a[27], a[43]), min(a[27], a[43])
+	a[27], a[42] = max(a[27], a[42]), min(a[27], a[42])
+	a[26], a[40] = max(a[26], a[40]), min(a[26], a[40])
+	a[27], a[41] = max(a[27], a[41]), min(a[27], a[41])
+	a[27], a[40] = max(a[27], a[40]), min(a[27], a[40])
+	a[28], a[44] = max(a[28], a[44]), min(a[28], a[44])
+	a[29], a[45] = max(a[29], a[45]), min(a[29], a[45])
+	a[29], a[44] = max(a[29], a[44]), min(a[29], a[44])
+	a[30], a[46] = max(a[30], a[46]), min(a[30], a[46])
+	a[31], a[47] = max(a[31], a[47]), min(a[31], a[47])
+	a[31], a[46] = max(a[31], a[46]), min(a[31], a[46])
+	a[30], a[44] = max(a[30], a[44]), min(a[30], a[44])
+	a[31], a[45] = max(a[31], a[45]), min(a[31], a[45])
+	a[31], a[44] = max(a[31], a[44]), min(a[31], a[44])
+	a[28], a[40] = max(a[28], a[40]), min(a[28], a[40])
+	a[29], a[41] = max(a[29], a[41]), min(a[29], a[41])
+	a[29], a[40] = max(a[29], a[40]), min(a[29], a[40])
+	a[30], a[42] = max(a[30], a[42]), min(a[30], a[42])
+	a[31], a[43] = max(a[31], a[43]), min(a[31], a[43])
+	a[31], a[42] = max(a[31], a[42]), min(a[31], a[42])
+	a[30], a[40] = max(a[30], a[40]), min(a[30], a[40])
+	a[31], a[41] = max(a[31], a[41]), min(a[31], a[41])
+	a[31], a[40] = max(a[31], a[40]), min(a[31], a[40])
+	a[24], a[32] = max(a[24], a[32]), min(a[24], a[32])
+	a[25], a[33] = max(a[25], a[33]), min(a[25], a[33])
+	a[25], a[32] = max(a[25], a[32]), min(a[25], a[32])
+	a[26], a[34] = max(a[26], a[34]), min(a[26], a[34])
+	a[27], a[35] = max(a[27], a[35]), min(a[27], a[35])
+	a[27], a[34] = max(a[27], a[34]), min(a[27], a[34])
+	a[26], a[32] = max(a[26], a[32]), min(a[26], a[32])
+	a[27], a[33] = max(a[27], a[33]), min(a[27], a[33])
+	a[27], a[32] = max(a[27], a[32]), min(a[27], a[32])
+	a[28], a[36] = max(a[28], a[36]), min(a[28], a[36])
+	a[29], a[37] = max(a[29], a[37]), min(a[29], a[37])
+	a[29], a[36] = max(a[29], a[36]), min(a[29], a[36])
+	a[30], a[38] = max(a[30], a[38]), min(a[30], a[38])
+	a[31], a[39] = max(a[31], a[39]), min(a[31], a[39])
+	a[31], a[38] = max(a[31], a[38]), min(a[31], a[38])
+	a[30], a[36] = max(a[30], a[36]), min(a[30], a[36])
+	a[31], a[37] = max(a[31], a[37]), min(a[31], a[37])
+	a[31], a[36] = max(a[31], a[36]), min(a[31], a[36])
+	a[28], a[32] = max(a[28], a[32]), min(a[28], a[32])
+	a[29], a[33] = max(a[29], a[33]), min(a[29], a[33])
+	a[29], a[32] = max(a[29], a[32]), min(a[29], a[32])
+	a[30], a[34] = max(a[30], a[34]), min(a[30], a[34])
+	a[31], a[35] = max(a[31], a[35]), min(a[31], a[35])
+	a[31], a[34] = max(a[31], a[34]), min(a[31], a[34])
+	a[30], a[32] = max(a[30], a[32]), min(a[30], a[32])
+	a[31], a[33] = max(a[31], a[33]), min(a[31], a[33])
+	a[31], a[32] = max(a[31], a[32]), min(a[31], a[32])
+}
@@ -0,0 +1,676 @@
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
+
+package branchless
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/shabbyrobe/sortnet/sortnettest"
+)
+
+// testBranchlessGenCase is a function generated by sortnetgen, adapted to be checked by
+// sortnettest. Either sort or merge is set.
+type testBranchlessGenCase[T any] struct {
+	name  string
+	size  int
+	sort  func(a []T)
+	merge func(dst []T, runs [][]T) []T
+}
+
+// testBranchlessGenCasesNetworkSortInt returns the functions generated for int
+// in increasing order, along with how to make random items and how they are ordered.
+func testBranchlessGenCasesNetworkSortInt() (gen func(*rand.Rand) int, before func(a, b int) bool, cases []testBranchlessGenCase[int]) {
+	gen = func(rng *rand.Rand) int { return rng.Intn(32) - 16 }
+	less := func(a, b int) bool { return a < b }
+	before = less
+	cases = []testBranchlessGenCase[int]{
+		{name: "NetworkSort2xInt", size: 2, sort: func(a []int) { NetworkSort2xInt(a) }},
+		{name: "NetworkSort3xInt", size: 3, sort: func(a []int) { NetworkSort3xInt(a) }},
+		{name: "NetworkSort4xInt", size: 4, sort: func(a []int) { NetworkSort4xInt(a) }},
+		{name: "NetworkSort5xInt", size: 5, sort: func(a []int) { NetworkSort5xInt(a) }},
+		{name: "NetworkSort6xInt", size: 6, sort: func(a []int) { NetworkSort6xInt(a) }},
+		{name: "NetworkSort7xInt", size: 7, sort: func(a []int) { NetworkSort7xInt(a) }},
+		{name: "NetworkSort8xInt", size: 8, sort: func(a []int) { NetworkSort8xInt(a) }},
+		{name: "NetworkSort9xInt", size: 9, sort: func(a []int) { NetworkSort9xInt(a) }},
+		{name: "NetworkSort10xInt", size: 10, sort: func(a []int) { NetworkSort10xInt(a) }},
+		{name: "NetworkSort11xInt", size: 11, sort: func(a []int) { NetworkSort11xInt(a) }},
+		{name: "NetworkSort12xInt", size: 12, sort: func(a []int) { NetworkSort12xInt(a) }},
+		{name: "NetworkSort13xInt", size: 13, sort: func(a []int) { NetworkSort13xInt(a) }},
+		{name: "NetworkSort14xInt", size: 14, sort: func(a []int) { NetworkSort14xInt(a) }},
+		{name: "NetworkSort15xInt", size: 15, sort: func(a []int) { NetworkSort15xInt(a) }},
+		{name: "NetworkSort16xInt", size: 16, sort: func(a []int) { NetworkSort16xInt(a) }},
+		{name: "NetworkSort24xInt", size: 24, sort: func(a []int) { NetworkSort24xInt(a) }},
+		{name: "NetworkSort32xInt", size: 32, sort: func(a []int) { NetworkSort32xInt(a) }},
+		{name: "NetworkSort48xInt", size: 48, sort: func(a []int) { NetworkSort48xInt(a) }},
+		{name: "NetworkSort64xInt", size: 64, sort: func(a []int) { NetworkSort64xInt(a) }},
+		{name: "NetworkSortInt-2", size: 2, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortInt-3", size: 3, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortInt-4", size: 4, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortInt-5", size: 5, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortInt-6", size: 6, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortInt-7", size: 7, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortInt-8", size: 8, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortInt-9", size: 9, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortInt-10", size: 10, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortInt-11", size: 11, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortInt-12", size: 12, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortInt-13", size: 13, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 13")
+			}
+		}},
+		{name: "NetworkSortInt-14", size: 14, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 14")
+			}
+		}},
+		{name: "NetworkSortInt-15", size: 15, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 15")
+			}
+		}},
+		{name: "NetworkSortInt-16", size: 16, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 16")
+			}
+		}},
+		{name: "NetworkSortInt-24", size: 24, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 24")
+			}
+		}},
+		{name: "NetworkSortInt-32", size: 32, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 32")
+			}
+		}},
+		{name: "NetworkSortInt-48", size: 48, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 48")
+			}
+		}},
+		{name: "NetworkSortInt-64", size: 64, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 64")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestBranchlessGenNetworkSortInt(t *testing.T) {
+	gen, before, cases := testBranchlessGenCasesNetworkSortInt()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzBranchlessGenNetworkSortInt(f *testing.F) {
+	gen, before, cases := testBranchlessGenCasesNetworkSortInt()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]int, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkBranchlessGenNetworkSortInt(b *testing.B) {
+	gen, before, cases := testBranchlessGenCasesNetworkSortInt()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testBranchlessGenCasesNetworkSortIntReverse returns the functions generated for int
+// in decreasing order, along with how to make random items and how they are ordered.
+func testBranchlessGenCasesNetworkSortIntReverse() (gen func(*rand.Rand) int, before func(a, b int) bool, cases []testBranchlessGenCase[int]) {
+	gen = func(rng *rand.Rand) int { return rng.Intn(32) - 16 }
+	less := func(a, b int) bool { return a < b }
+	before = func(a, b int) bool { return less(b, a) }
+	cases = []testBranchlessGenCase[int]{
+		{name: "NetworkSort2xIntReverse", size: 2, sort: func(a []int) { NetworkSort2xIntReverse(a) }},
+		{name: "NetworkSort3xIntReverse", size: 3, sort: func(a []int) { NetworkSort3xIntReverse(a) }},
+		{name: "NetworkSort4xIntReverse", size: 4, sort: func(a []int) { NetworkSort4xIntReverse(a) }},
+		{name: "NetworkSort5xIntReverse", size: 5, sort: func(a []int) { NetworkSort5xIntReverse(a) }},
+		{name: "NetworkSort6xIntReverse", size: 6, sort: func(a []int) { NetworkSort6xIntReverse(a) }},
+		{name: "NetworkSort7xIntReverse", size: 7, sort: func(a []int) { NetworkSort7xIntReverse(a) }},
+		{name: "NetworkSort8xIntReverse", size: 8, sort: func(a []int) { NetworkSort8xIntReverse(a) }},
+		{name: "NetworkSort9xIntReverse", size: 9, sort: func(a []int) { NetworkSort9xIntReverse(a) }},
+		{name: "NetworkSort10xIntReverse", size: 10, sort: func(a []int) { NetworkSort10xIntReverse(a) }},
+		{name: "NetworkSort11xIntReverse", size: 11, sort: func(a []int) { NetworkSort11xIntReverse(a) }},
+		{name: "NetworkSort12xIntReverse", size: 12, sort: func(a []int) { NetworkSort12xIntReverse(a) }},
+		{name: "NetworkSort13xIntReverse", size: 13, sort: func(a []int) { NetworkSort13xIntReverse(a) }},
+		{name: "NetworkSort14xIntReverse", size: 14, sort: func(a []int) { NetworkSort14xIntReverse(a) }},
+		{name: "NetworkSort15xIntReverse", size: 15, sort: func(a []int) { NetworkSort15xIntReverse(a) }},
+		{name: "NetworkSort16xIntReverse", size: 16, sort: func(a []int) { NetworkSort16xIntReverse(a) }},
+		{name: "NetworkSort24xIntReverse", size: 24, sort: func(a []int) { NetworkSort24xIntReverse(a) }},
+		{name: "NetworkSort32xIntReverse", size: 32, sort: func(a []int) { NetworkSort32xIntReverse(a) }},
+		{name: "NetworkSort48xIntReverse", size: 48, sort: func(a []int) { NetworkSort48xIntReverse(a) }},
+		{name: "NetworkSort64xIntReverse", size: 64, sort: func(a []int) { NetworkSort64xIntReverse(a) }},
+		{name: "NetworkSortIntReverse-2", size: 2, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortIntReverse-3", size: 3, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortIntReverse-4", size: 4, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortIntReverse-5", size: 5, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortIntReverse-6", size: 6, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortIntReverse-7", size: 7, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortIntReverse-8", size: 8, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortIntReverse-9", size: 9, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortIntReverse-10", size: 10, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortIntReverse-11", size: 11, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortIntReverse-12", size: 12, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortIntReverse-13", size: 13, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 13")
+			}
+		}},
+		{name: "NetworkSortIntReverse-14", size: 14, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 14")
+			}
+		}},
+		{name: "NetworkSortIntReverse-15", size: 15, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 15")
+			}
+		}},
+		{name: "NetworkSortIntReverse-16", size: 16, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 16")
+			}
+		}},
+		{name: "NetworkSortIntReverse-24", size: 24, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 24")
+			}
+		}},
+		{name: "NetworkSortIntReverse-32", size: 32, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 32")
+			}
+		}},
+		{name: "NetworkSortIntReverse-48", size: 48, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 48")
+			}
+		}},
+		{name: "NetworkSortIntReverse-64", size: 64, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 64")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestBranchlessGenNetworkSortIntReverse(t *testing.T) {
+	gen, before, cases := testBranchlessGenCasesNetworkSortIntReverse()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzBranchlessGenNetworkSortIntReverse(f *testing.F) {
+	gen, before, cases := testBranchlessGenCasesNetworkSortIntReverse()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]int, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkBranchlessGenNetworkSortIntReverse(b *testing.B) {
+	gen, before, cases := testBranchlessGenCasesNetworkSortIntReverse()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testBranchlessGenCasesNetworkSortFloat64 returns the functions generated for float64
+// in increasing order, along with how to make random items and how they are ordered.
+func testBranchlessGenCasesNetworkSortFloat64() (gen func(*rand.Rand) float64, before func(a, b float64) bool, cases []testBranchlessGenCase[float64]) {
+	gen = func(rng *rand.Rand) float64 { return float64(rng.Intn(32)-16) / 4 }
+	less := func(a, b float64) bool { return a < b }
+	before = less
+	cases = []testBranchlessGenCase[float64]{
+		{name: "NetworkSort2xFloat64", size: 2, sort: func(a []float64) { NetworkSort2xFloat64(a) }},
+		{name: "NetworkSort3xFloat64", size: 3, sort: func(a []float64) { NetworkSort3xFloat64(a) }},
+		{name: "NetworkSort4xFloat64", size: 4, sort: func(a []float64) { NetworkSort4xFloat64(a) }},
+		{name: "NetworkSort5xFloat64", size: 5, sort: func(a []float64) { NetworkSort5xFloat64(a) }},
+		{name: "NetworkSort6xFloat64", size: 6, sort: func(a []float64) { NetworkSort6xFloat64(a) }},
+		{name: "NetworkSort7xFloat64", size: 7, sort: func(a []float64) { NetworkSort7xFloat64(a) }},
+		{name: "NetworkSort8xFloat64", size: 8, sort: func(a []float64) { NetworkSort8xFloat64(a) }},
+		{name: "NetworkSort9xFloat64", size: 9, sort: func(a []float64) { NetworkSort9xFloat64(a) }},
+		{name: "NetworkSort10xFloat64", size: 10, sort: func(a []float64) { NetworkSort10xFloat64(a) }},
+		{name: "NetworkSort11xFloat64", size: 11, sort: func(a []float64) { NetworkSort11xFloat64(a) }},
+		{name: "NetworkSort12xFloat64", size: 12, sort: func(a []float64) { NetworkSort12xFloat64(a) }},
+		{name: "NetworkSort13xFloat64", size: 13, sort: func(a []float64) { NetworkSort13xFloat64(a) }},
+		{name: "NetworkSort14xFloat64", size: 14, sort: func(a []float64) { NetworkSort14xFloat64(a) }},
+		{name: "NetworkSort15xFloat64", size: 15, sort: func(a []float64) { NetworkSort15xFloat64(a) }},
+		{name: "NetworkSort16xFloat64", size: 16, sort: func(a []float64) { NetworkSort16xFloat64(a) }},
+		{name: "NetworkSort24xFloat64", size: 24, sort: func(a []float64) { NetworkSort24xFloat64(a) }},
+		{name: "NetworkSort32xFloat64", size: 32, sort: func(a []float64) { NetworkSort32xFloat64(a) }},
+		{name: "NetworkSort48xFloat64", size: 48, sort: func(a []float64) { NetworkSort48xFloat64(a) }},
+		{name: "NetworkSort64xFloat64", size: 64, sort: func(a []float64) { NetworkSort64xFloat64(a) }},
+		{name: "NetworkSortFloat64-2", size: 2, sort: func(a []float64) {
+			if !NetworkSortFloat64(a, len(a)) {
+				panic("NetworkSortFloat64: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortFloat64-3", size: 3, sort: func(a []float64) {
+			if !NetworkSortFloat64(a, len(a)) {
+				panic("NetworkSortFloat64: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortFloat64-4", size: 4, sort: func(a []float64) {
+			if !NetworkSortFloat64(a, len(a)) {
+				panic("NetworkSortFloat64: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortFloat64-5", size: 5, sort: func(a []float64) {
+			if !NetworkSortFloat64(a, len(a)) {
+				panic("NetworkSortFloat64: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortFloat64-6", size: 6, sort: func(a []float64) {
+			if !NetworkSortFloat64(a, len(a)) {
+				panic("NetworkSortFloat64: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortFloat64-7", size: 7, sort: func(a []float64) {
+			if !NetworkSortFloat64(a, len(a)) {
+				panic("NetworkSortFloat64: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortFloat64-8", size: 8, sort: func(a []float64) {
+			if !NetworkSortFloat64(a, len(a)) {
+				panic("NetworkSortFloat64: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortFloat64-9", size: 9, sort: func(a []float64) {
+			if !NetworkSortFloat64(a, len(a)) {
+				panic("NetworkSortFloat64: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortFloat64-10", size: 10, sort: func(a []float64) {
+			if !NetworkSortFloat64(a, len(a)) {
+				panic("NetworkSortFloat64: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortFloat64-11", size: 11, sort: func(a []float64) {
+			if !NetworkSortFloat64(a, len(a)) {
+				panic("NetworkSortFloat64: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortFloat64-12", size: 12, sort: func(a []float64) {
+			if !NetworkSortFloat64(a, len(a)) {
+				panic("NetworkSortFloat64: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortFloat64-13", size: 13, sort: func(a []float64) {
+			if !NetworkSortFloat64(a, len(a)) {
+				panic("NetworkSortFloat64: no sorter for size 13")
+			}
+		}},
+		{name: "NetworkSortFloat64-14", size: 14, sort: func(a []float64) {
+			if !NetworkSortFloat64(a, len(a)) {
+				panic("NetworkSortFloat64: no sorter for size 14")
+			}
+		}},
+		{name: "NetworkSortFloat64-15", size: 15, sort: func(a []float64) {
+			if !NetworkSortFloat64(a, len(a)) {
+				panic("NetworkSortFloat64: no sorter for size 15")
+			}
+		}},
+		{name: "NetworkSortFloat64-16", size: 16, sort: func(a []float64) {
+			if !NetworkSortFloat64(a, len(a)) {
+				panic("NetworkSortFloat64: no sorter for size 16")
+			}
+		}},
+		{name: "NetworkSortFloat64-24", size: 24, sort: func(a []float64) {
+			if !NetworkSortFloat64(a, len(a)) {
+				panic("NetworkSortFloat64: no sorter for size 24")
+			}
+		}},
+		{name: "NetworkSortFloat64-32", size: 32, sort: func(a []float64) {
+			if !NetworkSortFloat64(a, len(a)) {
+				panic("NetworkSortFloat64: no sorter for size 32")
+			}
+		}},
+		{name: "NetworkSortFloat64-48", size: 48, sort: func(a []float64) {
+			if !NetworkSortFloat64(a, len(a)) {
+				panic("NetworkSortFloat64: no sorter for size 48")
+			}
+		}},
+		{name: "NetworkSortFloat64-64", size: 64, sort: func(a []float64) {
+			if !NetworkSortFloat64(a, len(a)) {
+				panic("NetworkSortFloat64: no sorter for size 64")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestBranchlessGenNetworkSortFloat64(t *testing.T) {
+	gen, before, cases := testBranchlessGenCasesNetworkSortFloat64()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzBranchlessGenNetworkSortFloat64(f *testing.F) {
+	gen, before, cases := testBranchlessGenCasesNetworkSortFloat64()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]float64, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkBranchlessGenNetworkSortFloat64(b *testing.B) {
+	gen, before, cases := testBranchlessGenCasesNetworkSortFloat64()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testBranchlessGenCasesNetworkSortFloat64Reverse returns the functions generated for float64
+// in decreasing order, along with how to make random items and how they are ordered.
+func testBranchlessGenCasesNetworkSortFloat64Reverse() (gen func(*rand.Rand) float64, before func(a, b float64) bool, cases []testBranchlessGenCase[float64]) {
+	gen = func(rng *rand.Rand) float64 { return float64(rng.Intn(32)-16) / 4 }
+	less := func(a, b float64) bool { return a < b }
+	before = func(a, b float64) bool { return less(b, a) }
+	cases = []testBranchlessGenCase[float64]{
+		{name: "NetworkSort2xFloat64Reverse", size: 2, sort: func(a []float64) { NetworkSort2xFloat64Reverse(a) }},
+		{name: "NetworkSort3xFloat64Reverse", size: 3, sort: func(a []float64) { NetworkSort3xFloat64Reverse(a) }},
+		{name: "NetworkSort4xFloat64Reverse", size: 4, sort: func(a []float64) { NetworkSort4xFloat64Reverse(a) }},
+		{name: "NetworkSort5xFloat64Reverse", size: 5, sort: func(a []float64) { NetworkSort5xFloat64Reverse(a) }},
+		{name: "NetworkSort6xFloat64Reverse", size: 6, sort: func(a []float64) { NetworkSort6xFloat64Reverse(a) }},
+		{name: "NetworkSort7xFloat64Reverse", size: 7, sort: func(a []float64) { NetworkSort7xFloat64Reverse(a) }},
+		{name: "NetworkSort8xFloat64Reverse", size: 8, sort: func(a []float64) { NetworkSort8xFloat64Reverse(a) }},
+		{name: "NetworkSort9xFloat64Reverse", size: 9, sort: func(a []float64) { NetworkSort9xFloat64Reverse(a) }},
+		{name: "NetworkSort10xFloat64Reverse", size: 10, sort: func(a []float64) { NetworkSort10xFloat64Reverse(a) }},
+		{name: "NetworkSort11xFloat64Reverse", size: 11, sort: func(a []float64) { NetworkSort11xFloat64Reverse(a) }},
+		{name: "NetworkSort12xFloat64Reverse", size: 12, sort: func(a []float64) { NetworkSort12xFloat64Reverse(a) }},
+		{name: "NetworkSort13xFloat64Reverse", size: 13, sort: func(a []float64) { NetworkSort13xFloat64Reverse(a) }},
+		{name: "NetworkSort14xFloat64Reverse", size: 14, sort: func(a []float64) { NetworkSort14xFloat64Reverse(a) }},
+		{name: "NetworkSort15xFloat64Reverse", size: 15, sort: func(a []float64) { NetworkSort15xFloat64Reverse(a) }},
+		{name: "NetworkSort16xFloat64Reverse", size: 16, sort: func(a []float64) { NetworkSort16xFloat64Reverse(a) }},
+		{name: "NetworkSort24xFloat64Reverse", size: 24, sort: func(a []float64) { NetworkSort24xFloat64Reverse(a) }},
+		{name: "NetworkSort32xFloat64Reverse", size: 32, sort: func(a []float64) { NetworkSort32xFloat64Reverse(a) }},
+		{name: "NetworkSort48xFloat64Reverse", size: 48, sort: func(a []float64) { NetworkSort48xFloat64Reverse(a) }},
+		{name: "NetworkSort64xFloat64Reverse", size: 64, sort: func(a []float64) { NetworkSort64xFloat64Reverse(a) }},
+		{name: "NetworkSortFloat64Reverse-2", size: 2, sort: func(a []float64) {
+			if !NetworkSortFloat64Reverse(a, len(a)) {
+				panic("NetworkSortFloat64Reverse: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortFloat64Reverse-3", size: 3, sort: func(a []float64) {
+			if !NetworkSortFloat64Reverse(a, len(a)) {
+				panic("NetworkSortFloat64Reverse: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortFloat64Reverse-4", size: 4, sort: func(a []float64) {
+			if !NetworkSortFloat64Reverse(a, len(a)) {
+				panic("NetworkSortFloat64Reverse: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortFloat64Reverse-5", size: 5, sort: func(a []float64) {
+			if !NetworkSortFloat64Reverse(a, len(a)) {
+				panic("NetworkSortFloat64Reverse: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortFloat64Reverse-6", size: 6, sort: func(a []float64) {
+			if !NetworkSortFloat64Reverse(a, len(a)) {
+				panic("NetworkSortFloat64Reverse: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortFloat64Reverse-7", size: 7, sort: func(a []float64) {
+			if !NetworkSortFloat64Reverse(a, len(a)) {
+				panic("NetworkSortFloat64Reverse: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortFloat64Reverse-8", size: 8, sort: func(a []float64) {
+			if !NetworkSortFloat64Reverse(a, len(a)) {
+				panic("NetworkSortFloat64Reverse: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortFloat64Reverse-9", size: 9, sort: func(a []float64) {
+			if !NetworkSortFloat64Reverse(a, len(a)) {
+				panic("NetworkSortFloat64Reverse: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortFloat64Reverse-10", size: 10, sort: func(a []float64) {
+			if !NetworkSortFloat64Reverse(a, len(a)) {
+				panic("NetworkSortFloat64Reverse: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortFloat64Reverse-11", size: 11, sort: func(a []float64) {
+			if !NetworkSortFloat64Reverse(a, len(a)) {
+				panic("NetworkSortFloat64Reverse: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortFloat64Reverse-12", size: 12, sort: func(a []float64) {
+			if !NetworkSortFloat64Reverse(a, len(a)) {
+				panic("NetworkSortFloat64Reverse: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortFloat64Reverse-13", size: 13, sort: func(a []float64) {
+			if !NetworkSortFloat64Reverse(a, len(a)) {
+				panic("NetworkSortFloat64Reverse: no sorter for size 13")
+			}
+		}},
+		{name: "NetworkSortFloat64Reverse-14", size: 14, sort: func(a []float64) {
+			if !NetworkSortFloat64Reverse(a, len(a)) {
+				panic("NetworkSortFloat64Reverse: no sorter for size 14")
+			}
+		}},
+		{name: "NetworkSortFloat64Reverse-15", size: 15, sort: func(a []float64) {
+			if !NetworkSortFloat64Reverse(a, len(a)) {
+				panic("NetworkSortFloat64Reverse: no sorter for size 15")
+			}
+		}},
+		{name: "NetworkSortFloat64Reverse-16", size: 16, sort: func(a []float64) {
+			if !NetworkSortFloat64Reverse(a, len(a)) {
+				panic("NetworkSortFloat64Reverse: no sorter for size 16")
+			}
+		}},
+		{name: "NetworkSortFloat64Reverse-24", size: 24, sort: func(a []float64) {
+			if !NetworkSortFloat64Reverse(a, len(a)) {
+				panic("NetworkSortFloat64Reverse: no sorter for size 24")
+			}
+		}},
+		{name: "NetworkSortFloat64Reverse-32", size: 32, sort: func(a []float64) {
+			if !NetworkSortFloat64Reverse(a, len(a)) {
+				panic("NetworkSortFloat64Reverse: no sorter for size 32")
+			}
+		}},
+		{name: "NetworkSortFloat64Reverse-48", size: 48, sort: func(a []float64) {
+			if !NetworkSortFloat64Reverse(a, len(a)) {
+				panic("NetworkSortFloat64Reverse: no sorter for size 48")
+			}
+		}},
+		{name: "NetworkSortFloat64Reverse-64", size: 64, sort: func(a []float64) {
+			if !NetworkSortFloat64Reverse(a, len(a)) {
+				panic("NetworkSortFloat64Reverse: no sorter for size 64")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestBranchlessGenNetworkSortFloat64Reverse(t *testing.T) {
+	gen, before, cases := testBranchlessGenCasesNetworkSortFloat64Reverse()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzBranchlessGenNetworkSortFloat64Reverse(f *testing.F) {
+	gen, before, cases := testBranchlessGenCasesNetworkSortFloat64Reverse()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]float64, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkBranchlessGenNetworkSortFloat64Reverse(b *testing.B) {
+	gen, before, cases := testBranchlessGenCasesNetworkSortFloat64Reverse()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package branchless
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/shabbyrobe/sortnet/cmd/sortnetgen/internal/gentest"
+)
+
+// BenchmarkSortNetFloat64s compares the branchless sorters with gentest's generic
+// sorters, which branch. Both are called through their wrappers, which switch on the
+// size. BenchmarkSortNetInts in gentest compares the int sorters directly.
+func BenchmarkSortNetFloat64s(b *testing.B) {
+	rng := rand.New(rand.NewSource(0))
+	floats := make([]float64, 10000000)
+	for i := range floats {
+		floats[i] = rng.Float64()
+	}
+
+	for _, sz := range []int{2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 24, 32, 48, 64} {
+		b.Run(fmt.Sprintf("network-%d", sz), func(b *testing.B) {
+			cur := make([]float64, sz)
+			for i, next := 0, 0; i < b.N; i, next = i+1, next+sz {
+				if next+sz > len(floats) {
+					next = 0
+				}
+				copy(cur, floats[next:])
+				gentest.NetworkSort(cur, sz)
+			}
+		})
+
+		b.Run(fmt.Sprintf("network-branchless-%d", sz), func(b *testing.B) {
+			cur := make([]float64, sz)
+			for i, next := 0, 0; i < b.N; i, next = i+1, next+sz {
+				if next+sz > len(floats) {
+					next = 0
+				}
+				copy(cur, floats[next:])
+				NetworkSortFloat64(cur, sz)
+			}
+		})
+	}
+}
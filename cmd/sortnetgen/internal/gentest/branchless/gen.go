@@ -0,0 +1,5 @@
+// Package branchless holds sorters generated with -branchless, which would clash with
+// the names of the sorters in gentest that branch.
+package branchless
+
+//go:generate sortnetgen -o branchless_gen.go -tests -branchless -fwd -rev -size 2-16,24,32,48,64 int float64
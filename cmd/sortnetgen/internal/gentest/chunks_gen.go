@@ -0,0 +1,289 @@
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
+
+package gentest
+
+// Sorting networks used in this file:
+//
+//   - Bose-Nelson, 3 inputs: 3 comparators (proven optimal), depth 3 (proven optimal)
+//     Fingerprint f995edafd93d50ea
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 4 inputs: 5 comparators (proven optimal), depth 3 (proven optimal)
+//     Fingerprint 0119fc1a905673cd
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Senso9, 9 inputs: 25 comparators (proven optimal), depth 8 (not optimal)
+//     Fingerprint 5463bfb95d0dca98
+//     V. K. Valsalam and R. Miikkulainen (2013)
+//     V. K. Valsalam and R. Miikkulainen, Using Symmetry and Evolutionary Search to Minimize Sorting Networks, Journal of Machine Learning Research 14, 2013
+
+// NetworkSort3xIntChunks sorts every consecutive chunk of 3 items in a.
+//
+// len(a) must be a multiple of 3, otherwise NetworkSort3xIntChunks will panic.
+func NetworkSort3xIntChunks(a []int) {
+	if len(a)%3 != 0 {
+		panic("NetworkSort3xIntChunks: len(a) is not a multiple of 3")
+	}
+	for ; len(a) >= 3; a = a[3:] {
+		a := a[:3:3]
+		if a[1] > a[2] {
+			a[1], a[2] = a[2], a[1]
+		}
+		if a[0] > a[2] {
+			a[0], a[2] = a[2], a[0]
+		}
+		if a[0] > a[1] {
+			a[0], a[1] = a[1], a[0]
+		}
+	}
+}
+
+// NetworkSort3xIntChunksReverse sorts every consecutive chunk of 3 items in a.
+//
+// len(a) must be a multiple of 3, otherwise NetworkSort3xIntChunksReverse will panic.
+func NetworkSort3xIntChunksReverse(a []int) {
+	if len(a)%3 != 0 {
+		panic("NetworkSort3xIntChunksReverse: len(a) is not a multiple of 3")
+	}
+	for ; len(a) >= 3; a = a[3:] {
+		a := a[:3:3]
+		if a[1] < a[2] {
+			a[1], a[2] = a[2], a[1]
+		}
+		if a[0] < a[2] {
+			a[0], a[2] = a[2], a[0]
+		}
+		if a[0] < a[1] {
+			a[0], a[1] = a[1], a[0]
+		}
+	}
+}
+
+// NetworkSort4xIntChunks sorts every consecutive chunk of 4 items in a.
+//
+// len(a) must be a multiple of 4, otherwise NetworkSort4xIntChunks will panic.
+func NetworkSort4xIntChunks(a []int) {
+	if len(a)%4 != 0 {
+		panic("NetworkSort4xIntChunks: len(a) is not a multiple of 4")
+	}
+	for ; len(a) >= 4; a = a[4:] {
+		a := a[:4:4]
+		if a[0] > a[1] {
+			a[0], a[1] = a[1], a[0]
+		}
+		if a[2] > a[3] {
+			a[2], a[3] = a[3], a[2]
+		}
+		if a[0] > a[2] {
+			a[0], a[2] = a[2], a[0]
+		}
+		if a[1] > a[3] {
+			a[1], a[3] = a[3], a[1]
+		}
+		if a[1] > a[2] {
+			a[1], a[2] = a[2], a[1]
+		}
+	}
+}
+
+// NetworkSort4xIntChunksReverse sorts every consecutive chunk of 4 items in a.
+//
+// len(a) must be a multiple of 4, otherwise NetworkSort4xIntChunksReverse will panic.
+func NetworkSort4xIntChunksReverse(a []int) {
+	if len(a)%4 != 0 {
+		panic("NetworkSort4xIntChunksReverse: len(a) is not a multiple of 4")
+	}
+	for ; len(a) >= 4; a = a[4:] {
+		a := a[:4:4]
+		if a[0] < a[1] {
+			a[0], a[1] = a[1], a[0]
+		}
+		if a[2] < a[3] {
+			a[2], a[3] = a[3], a[2]
+		}
+		if a[0] < a[2] {
+			a[0], a[2] = a[2], a[0]
+		}
+		if a[1] < a[3] {
+			a[1], a[3] = a[3], a[1]
+		}
+		if a[1] < a[2] {
+			a[1], a[2] = a[2], a[1]
+		}
+	}
+}
+
+// NetworkSort9xIntChunks sorts every consecutive chunk of 9 items in a.
+//
+// len(a) must be a multiple of 9, otherwise NetworkSort9xIntChunks will panic.
+func NetworkSort9xIntChunks(a []int) {
+	if len(a)%9 != 0 {
+		panic("NetworkSort9xIntChunks: len(a) is not a multiple of 9")
+	}
+	for ; len(a) >= 9; a = a[9:] {
+		a := a[:9:9]
+		if a[2] > a[6] {
+			a[2], a[6] = a[6], a[2]
+		}
+		if a[0] > a[5] {
+			a[0], a[5] = a[5], a[0]
+		}
+		if a[1] > a[4] {
+			a[1], a[4] = a[4], a[1]
+		}
+		if a[7] > a[8] {
+			a[7], a[8] = a[8], a[7]
+		}
+		if a[0] > a[7] {
+			a[0], a[7] = a[7], a[0]
+		}
+		if a[1] > a[2] {
+			a[1], a[2] = a[2], a[1]
+		}
+		if a[3] > a[5] {
+			a[3], a[5] = a[5], a[3]
+		}
+		if a[4] > a[6] {
+			a[4], a[6] = a[6], a[4]
+		}
+		if a[5] > a[8] {
+			a[5], a[8] = a[8], a[5]
+		}
+		if a[1] > a[3] {
+			a[1], a[3] = a[3], a[1]
+		}
+		if a[6] > a[8] {
+			a[6], a[8] = a[8], a[6]
+		}
+		if a[0] > a[1] {
+			a[0], a[1] = a[1], a[0]
+		}
+		if a[4] > a[5] {
+			a[4], a[5] = a[5], a[4]
+		}
+		if a[2] > a[7] {
+			a[2], a[7] = a[7], a[2]
+		}
+		if a[3] > a[7] {
+			a[3], a[7] = a[7], a[3]
+		}
+		if a[3] > a[4] {
+			a[3], a[4] = a[4], a[3]
+		}
+		if a[5] > a[6] {
+			a[5], a[6] = a[6], a[5]
+		}
+		if a[1] > a[2] {
+			a[1], a[2] = a[2], a[1]
+		}
+		if a[1] > a[3] {
+			a[1], a[3] = a[3], a[1]
+		}
+		if a[6] > a[7] {
+			a[6], a[7] = a[7], a[6]
+		}
+		if a[4] > a[5] {
+			a[4], a[5] = a[5], a[4]
+		}
+		if a[2] > a[4] {
+			a[2], a[4] = a[4], a[2]
+		}
+		if a[5] > a[6] {
+			a[5], a[6] = a[6], a[5]
+		}
+		if a[2] > a[3] {
+			a[2], a[3] = a[3], a[2]
+		}
+		if a[4] > a[5] {
+			a[4], a[5] = a[5], a[4]
+		}
+	}
+}
+
+// NetworkSort9xIntChunksReverse sorts every consecutive chunk of 9 items in a.
+//
+// len(a) must be a multiple of 9, otherwise NetworkSort9xIntChunksReverse will panic.
+func NetworkSort9xIntChunksReverse(a []int) {
+	if len(a)%9 != 0 {
+		panic("NetworkSort9xIntChunksReverse: len(a) is not a multiple of 9")
+	}
+	for ; len(a) >= 9; a = a[9:] {
+		a := a[:9:9]
+		if a[2] < a[6] {
+			a[2], a[6] = a[6], a[2]
+		}
+		if a[0] < a[5] {
+			a[0], a[5] = a[5], a[0]
+		}
+		if a[1] < a[4] {
+			a[1], a[4] = a[4], a[1]
+		}
+		if a[7] < a[8] {
+			a[7], a[8] = a[8], a[7]
+		}
+		if a[0] < a[7] {
+			a[0], a[7] = a[7], a[0]
+		}
+		if a[1] < a[2] {
+			a[1], a[2] = a[2], a[1]
+		}
+		if a[3] < a[5] {
+			a[3], a[5] = a[5], a[3]
+		}
+		if a[4] < a[6] {
+			a[4], a[6] = a[6], a[4]
+		}
+		if a[5] < a[8] {
+			a[5], a[8] = a[8], a[5]
+		}
+		if a[1] < a[3] {
+			a[1], a[3] = a[3], a[1]
+		}
+		if a[6] < a[8] {
+			a[6], a[8] = a[8], a[6]
+		}
+		if a[0] < a[1] {
+			a[0], a[1] = a[1], a[0]
+		}
+		if a[4] < a[5] {
+			a[4], a[5] = a[5], a[4]
+		}
+		if a[2] < a[7] {
+			a[2], a[7] = a[7], a[2]
+		}
+		if a[3] < a[7] {
+			a[3], a[7] = a[7], a[3]
+		}
+		if a[3] < a[4] {
+			a[3], a[4] = a[4], a[3]
+		}
+		if a[5] < a[6] {
+			a[5], a[6] = a[6], a[5]
+		}
+		if a[1] < a[2] {
+			a[1], a[2] = a[2], a[1]
+		}
+		if a[1] < a[3] {
+			a[1], a[3] = a[3], a[1]
+		}
+		if a[6] < a[7] {
+			a[6], a[7] = a[7], a[6]
+		}
+		if a[4] < a[5] {
+			a[4], a[5] = a[5], a[4]
+		}
+		if a[2] < a[4] {
+			a[2], a[4] = a[4], a[2]
+		}
+		if a[5] < a[6] {
+			a[5], a[6] = a[6], a[5]
+		}
+		if a[2] < a[3] {
+			a[2], a[3] = a[3], a[2]
+		}
+		if a[4] < a[5] {
+			a[4], a[5] = a[5], a[4]
+		}
+	}
+}
@@ -0,0 +1,108 @@
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
+
+package gentest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/shabbyrobe/sortnet/sortnettest"
+)
+
+// testChunksGenCase is a function generated by sortnetgen, adapted to be checked by
+// sortnettest. Either sort or merge is set.
+type testChunksGenCase[T any] struct {
+	name  string
+	size  int
+	sort  func(a []T)
+	merge func(dst []T, runs [][]T) []T
+}
+
+// testChunksGenCasesNetworkSortInt returns the functions generated for int
+// in increasing order, along with how to make random items and how they are ordered.
+func testChunksGenCasesNetworkSortInt() (gen func(*rand.Rand) int, before func(a, b int) bool, cases []testChunksGenCase[int]) {
+	gen = func(rng *rand.Rand) int { return rng.Intn(32) - 16 }
+	less := func(a, b int) bool { return a < b }
+	before = less
+	cases = []testChunksGenCase[int]{
+		{name: "NetworkSort3xIntChunks", size: 3, sort: func(a []int) { NetworkSort3xIntChunks(a) }},
+		{name: "NetworkSort4xIntChunks", size: 4, sort: func(a []int) { NetworkSort4xIntChunks(a) }},
+		{name: "NetworkSort9xIntChunks", size: 9, sort: func(a []int) { NetworkSort9xIntChunks(a) }},
+	}
+	return gen, before, cases
+}
+
+func TestChunksGenNetworkSortInt(t *testing.T) {
+	gen, before, cases := testChunksGenCasesNetworkSortInt()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzChunksGenNetworkSortInt(f *testing.F) {
+	gen, before, cases := testChunksGenCasesNetworkSortInt()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]int, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+// testChunksGenCasesNetworkSortIntReverse returns the functions generated for int
+// in decreasing order, along with how to make random items and how they are ordered.
+func testChunksGenCasesNetworkSortIntReverse() (gen func(*rand.Rand) int, before func(a, b int) bool, cases []testChunksGenCase[int]) {
+	gen = func(rng *rand.Rand) int { return rng.Intn(32) - 16 }
+	less := func(a, b int) bool { return a < b }
+	before = func(a, b int) bool { return less(b, a) }
+	cases = []testChunksGenCase[int]{
+		{name: "NetworkSort3xIntChunksReverse", size: 3, sort: func(a []int) { NetworkSort3xIntChunksReverse(a) }},
+		{name: "NetworkSort4xIntChunksReverse", size: 4, sort: func(a []int) { NetworkSort4xIntChunksReverse(a) }},
+		{name: "NetworkSort9xIntChunksReverse", size: 9, sort: func(a []int) { NetworkSort9xIntChunksReverse(a) }},
+	}
+	return gen, before, cases
+}
+
+func TestChunksGenNetworkSortIntReverse(t *testing.T) {
+	gen, before, cases := testChunksGenCasesNetworkSortIntReverse()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzChunksGenNetworkSortIntReverse(f *testing.F) {
+	gen, before, cases := testChunksGenCasesNetworkSortIntReverse()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]int, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
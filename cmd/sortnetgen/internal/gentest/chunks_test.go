@@ -0,0 +1,107 @@
+package gentest
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/shabbyrobe/sortnet"
+)
+
+func TestSortNetIntChunks(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+
+	for _, tc := range []struct {
+		sz     int
+		fwd    bool
+		sorter func([]int)
+	}{
+		{3, true, NetworkSort3xIntChunks},
+		{4, true, NetworkSort4xIntChunks},
+		{9, true, NetworkSort9xIntChunks},
+		{3, false, NetworkSort3xIntChunksReverse},
+		{4, false, NetworkSort4xIntChunksReverse},
+		{9, false, NetworkSort9xIntChunksReverse},
+	} {
+		t.Run(fmt.Sprintf("%d/%v", tc.sz, tc.fwd), func(t *testing.T) {
+			vs := make([]int, tc.sz*100)
+			for i := range vs {
+				vs[i] = rng.Intn(64)
+			}
+			exp := make([]int, len(vs))
+			copy(exp, vs)
+			for i := 0; i < len(exp); i += tc.sz {
+				chunk := exp[i : i+tc.sz]
+				if tc.fwd {
+					sort.Ints(chunk)
+				} else {
+					sort.Sort(sort.Reverse(sort.IntSlice(chunk)))
+				}
+			}
+
+			tc.sorter(vs)
+			if !reflect.DeepEqual(exp, vs) {
+				t.Fatal("chunks not sorted")
+			}
+		})
+	}
+}
+
+func TestSortNetIntChunksPanicsOnPartialChunk(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic")
+		}
+	}()
+	NetworkSort4xIntChunks(make([]int, 7))
+}
+
+func BenchmarkSortNetIntChunks(b *testing.B) {
+	rng := rand.New(rand.NewSource(0))
+	src := make([]int, 9*100000)
+	for i := range src {
+		src[i] = rng.Intn(1024)
+	}
+	vs := make([]int, len(src))
+
+	for _, tc := range []struct {
+		sz     int
+		sorter func([]int)
+	}{
+		{3, NetworkSort3xIntChunks},
+		{9, NetworkSort9xIntChunks},
+	} {
+		b.Run(fmt.Sprintf("network-%d", tc.sz), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				copy(vs, src)
+				b.StartTimer()
+				tc.sorter(vs)
+			}
+		})
+
+		b.Run(fmt.Sprintf("network-direct-%d", tc.sz), func(b *testing.B) {
+			net := sortnet.New(tc.sz)
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				copy(vs, src)
+				b.StartTimer()
+				sortnet.SortChunks(net, vs, 0)
+			}
+		})
+
+		b.Run(fmt.Sprintf("network-loop-%d", tc.sz), func(b *testing.B) {
+			net := sortnet.New(tc.sz)
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				copy(vs, src)
+				b.StartTimer()
+				for j := 0; j < len(vs); j += tc.sz {
+					net.SortInts(vs[j : j+tc.sz])
+				}
+			}
+		})
+	}
+}
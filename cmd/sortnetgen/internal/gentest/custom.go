@@ -1,5 +1,7 @@
 package gentest
 
+import "time"
+
 type Custom struct {
 	Foo int
 }
@@ -15,3 +17,52 @@ func CustomCASGreater(a *Custom, b *Custom) {
 		*a, *b = *b, *a
 	}
 }
+
+// Keyed is sorted using -key, which generates the comparisons from its fields.
+type Keyed struct {
+	Name string
+	Info KeyedInfo
+}
+
+type KeyedInfo struct {
+	Score float64
+	When  time.Time
+}
+
+// Point is sorted using -lessfunc with the name of a function, PointLess.
+type Point struct {
+	X, Y int
+}
+
+// PointLess orders points by their distance from the origin.
+func PointLess(a, b Point) bool {
+	return a.X*a.X+a.Y*a.Y < b.X*b.X+b.Y*b.Y
+}
+
+// Named is sorted using -lessfunc with an expression.
+type Named struct {
+	Name string
+}
+
+// Celsius, Version and Priority have no comparator passed to sortnetgen, which finds
+// how to order them from their types.
+type Celsius float64
+
+type Version struct {
+	Major, Minor int
+}
+
+func (v Version) Compare(o Version) int {
+	if v.Major != o.Major {
+		return v.Major - o.Major
+	}
+	return v.Minor - o.Minor
+}
+
+type Priority struct {
+	Level int
+}
+
+func (p *Priority) Less(o Priority) bool {
+	return p.Level < o.Level
+}
@@ -1,11 +1,90 @@
-// Code generated by 'github.com/shabbyrobe/enumgen'. DO NOT EDIT.
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
 
 package gentest
 
+// Sorting networks used in this file:
+//
+//   - Bose-Nelson, 2 inputs: 1 comparator (proven optimal), depth 1 (proven optimal)
+//     Fingerprint 9463926d4640a53a
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 3 inputs: 3 comparators (proven optimal), depth 3 (proven optimal)
+//     Fingerprint f995edafd93d50ea
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 4 inputs: 5 comparators (proven optimal), depth 3 (proven optimal)
+//     Fingerprint 0119fc1a905673cd
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 5 inputs: 9 comparators (proven optimal), depth 6 (not optimal)
+//     Fingerprint d54da66c984b8b07
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 6 inputs: 13 comparators (not optimal), depth 7 (not optimal)
+//     Fingerprint 1cc32b1cf5a405a2
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 7 inputs: 16 comparators (proven optimal), depth 7 (not optimal)
+//     Fingerprint 4c56e9c432030c54
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 8 inputs: 19 comparators (proven optimal), depth 7 (not optimal)
+//     Fingerprint 72b14580972c6d18
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Senso9, 9 inputs: 25 comparators (proven optimal), depth 8 (not optimal)
+//     Fingerprint 5463bfb95d0dca98
+//     V. K. Valsalam and R. Miikkulainen (2013)
+//     V. K. Valsalam and R. Miikkulainen, Using Symmetry and Evolutionary Search to Minimize Sorting Networks, Journal of Machine Learning Research 14, 2013
+//   - Senso10, 10 inputs: 29 comparators (proven optimal), depth 8 (not optimal)
+//     Fingerprint 9688ccf2613970b6
+//     V. K. Valsalam and R. Miikkulainen (2013)
+//     V. K. Valsalam and R. Miikkulainen, Using Symmetry and Evolutionary Search to Minimize Sorting Networks, Journal of Machine Learning Research 14, 2013
+//   - ShapiroGreen11, 11 inputs: 35 comparators (proven optimal), depth 9 (not optimal)
+//     Fingerprint a0cd3d71b97545e1
+//     G. Shapiro and M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - ShapiroGreen12, 12 inputs: 39 comparators (proven optimal), depth 9 (not optimal)
+//     Fingerprint 4520ec02bac38318
+//     G. Shapiro and M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - End13, 13 inputs: 45 comparators (best known), depth 10 (not optimal)
+//     Fingerprint 8fb515590c6989d6
+//     H. Juillé (1995)
+//     H. Juillé, Evolution of Non-Deterministic Incremental Algorithms as a New Approach for Search in State Spaces, Proceedings of the 6th International Conference on Genetic Algorithms, 1995
+//   - Green14, 14 inputs: 51 comparators (best known), depth 10 (not optimal)
+//     Fingerprint b78dfa7f24d12c0c
+//     M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - Green15, 15 inputs: 56 comparators (best known), depth 10 (not optimal)
+//     Fingerprint 360c873bdb44ac3e
+//     M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - Green16, 16 inputs: 60 comparators (best known), depth 10 (not optimal)
+//     Fingerprint 196d3d09b3f4a0e7
+//     M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - Morwenn24, 24 inputs: 123 comparators (not optimal), depth 15 (not optimal)
+//     Fingerprint a82fea0eb88f2605
+//     Morwenn
+//     https://github.com/Morwenn/cpp-sort
+//   - Bose-Nelson, 32 inputs: 211 comparators (unknown), depth 31 (unknown)
+//     Fingerprint 947ea8bcb4cc1a7d
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 48 inputs: 503 comparators (unknown), depth 52 (unknown)
+//     Fingerprint b743d052c3bd5b72
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 64 inputs: 665 comparators (unknown), depth 63 (unknown)
+//     Fingerprint ae52e02f830de288
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+
 // NetworkSortCustom sorts the input according to its length ('sz') using a sorting network, if
 // one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
 // you to perform your own sort as a fallback.
-//
 func NetworkSortCustom(a []Custom, sz int) (ok bool) {
 	switch sz {
 	case 2:
@@ -55,7 +134,6 @@ func NetworkSortCustom(a []Custom, sz int) (ok bool) {
 // NetworkSortCustomReverse sorts the input according to its length ('sz') using a sorting network, if
 // one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
 // you to perform your own sort as a fallback.
-//
 func NetworkSortCustomReverse(a []Custom, sz int) (ok bool) {
 	switch sz {
 	case 2:
@@ -0,0 +1,357 @@
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
+
+package gentest
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/shabbyrobe/sortnet/sortnettest"
+)
+
+// testCustomGenCase is a function generated by sortnetgen, adapted to be checked by
+// sortnettest. Either sort or merge is set.
+type testCustomGenCase[T any] struct {
+	name  string
+	size  int
+	sort  func(a []T)
+	merge func(dst []T, runs [][]T) []T
+}
+
+// testCustomGenCasesNetworkSortCustom returns the functions generated for Custom
+// in increasing order, along with how to make random items and how they are ordered.
+func testCustomGenCasesNetworkSortCustom() (gen func(*rand.Rand) Custom, before func(a, b Custom) bool, cases []testCustomGenCase[Custom]) {
+	gen = func(rng *rand.Rand) Custom { return Custom{Foo: rng.Intn(32) - 16} }
+	less := func(x, y Custom) bool {
+		a := [2]Custom{y, x}
+		CustomCASGreater(&a[0], &a[1])
+		return !reflect.DeepEqual(a[0], y)
+	}
+	before = less
+	cases = []testCustomGenCase[Custom]{
+		{name: "NetworkSort2xCustom", size: 2, sort: func(a []Custom) { NetworkSort2xCustom(a) }},
+		{name: "NetworkSort3xCustom", size: 3, sort: func(a []Custom) { NetworkSort3xCustom(a) }},
+		{name: "NetworkSort4xCustom", size: 4, sort: func(a []Custom) { NetworkSort4xCustom(a) }},
+		{name: "NetworkSort5xCustom", size: 5, sort: func(a []Custom) { NetworkSort5xCustom(a) }},
+		{name: "NetworkSort6xCustom", size: 6, sort: func(a []Custom) { NetworkSort6xCustom(a) }},
+		{name: "NetworkSort7xCustom", size: 7, sort: func(a []Custom) { NetworkSort7xCustom(a) }},
+		{name: "NetworkSort8xCustom", size: 8, sort: func(a []Custom) { NetworkSort8xCustom(a) }},
+		{name: "NetworkSort9xCustom", size: 9, sort: func(a []Custom) { NetworkSort9xCustom(a) }},
+		{name: "NetworkSort10xCustom", size: 10, sort: func(a []Custom) { NetworkSort10xCustom(a) }},
+		{name: "NetworkSort11xCustom", size: 11, sort: func(a []Custom) { NetworkSort11xCustom(a) }},
+		{name: "NetworkSort12xCustom", size: 12, sort: func(a []Custom) { NetworkSort12xCustom(a) }},
+		{name: "NetworkSort13xCustom", size: 13, sort: func(a []Custom) { NetworkSort13xCustom(a) }},
+		{name: "NetworkSort14xCustom", size: 14, sort: func(a []Custom) { NetworkSort14xCustom(a) }},
+		{name: "NetworkSort15xCustom", size: 15, sort: func(a []Custom) { NetworkSort15xCustom(a) }},
+		{name: "NetworkSort16xCustom", size: 16, sort: func(a []Custom) { NetworkSort16xCustom(a) }},
+		{name: "NetworkSort24xCustom", size: 24, sort: func(a []Custom) { NetworkSort24xCustom(a) }},
+		{name: "NetworkSort32xCustom", size: 32, sort: func(a []Custom) { NetworkSort32xCustom(a) }},
+		{name: "NetworkSort48xCustom", size: 48, sort: func(a []Custom) { NetworkSort48xCustom(a) }},
+		{name: "NetworkSort64xCustom", size: 64, sort: func(a []Custom) { NetworkSort64xCustom(a) }},
+		{name: "NetworkSortCustom-2", size: 2, sort: func(a []Custom) {
+			if !NetworkSortCustom(a, len(a)) {
+				panic("NetworkSortCustom: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortCustom-3", size: 3, sort: func(a []Custom) {
+			if !NetworkSortCustom(a, len(a)) {
+				panic("NetworkSortCustom: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortCustom-4", size: 4, sort: func(a []Custom) {
+			if !NetworkSortCustom(a, len(a)) {
+				panic("NetworkSortCustom: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortCustom-5", size: 5, sort: func(a []Custom) {
+			if !NetworkSortCustom(a, len(a)) {
+				panic("NetworkSortCustom: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortCustom-6", size: 6, sort: func(a []Custom) {
+			if !NetworkSortCustom(a, len(a)) {
+				panic("NetworkSortCustom: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortCustom-7", size: 7, sort: func(a []Custom) {
+			if !NetworkSortCustom(a, len(a)) {
+				panic("NetworkSortCustom: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortCustom-8", size: 8, sort: func(a []Custom) {
+			if !NetworkSortCustom(a, len(a)) {
+				panic("NetworkSortCustom: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortCustom-9", size: 9, sort: func(a []Custom) {
+			if !NetworkSortCustom(a, len(a)) {
+				panic("NetworkSortCustom: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortCustom-10", size: 10, sort: func(a []Custom) {
+			if !NetworkSortCustom(a, len(a)) {
+				panic("NetworkSortCustom: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortCustom-11", size: 11, sort: func(a []Custom) {
+			if !NetworkSortCustom(a, len(a)) {
+				panic("NetworkSortCustom: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortCustom-12", size: 12, sort: func(a []Custom) {
+			if !NetworkSortCustom(a, len(a)) {
+				panic("NetworkSortCustom: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortCustom-13", size: 13, sort: func(a []Custom) {
+			if !NetworkSortCustom(a, len(a)) {
+				panic("NetworkSortCustom: no sorter for size 13")
+			}
+		}},
+		{name: "NetworkSortCustom-14", size: 14, sort: func(a []Custom) {
+			if !NetworkSortCustom(a, len(a)) {
+				panic("NetworkSortCustom: no sorter for size 14")
+			}
+		}},
+		{name: "NetworkSortCustom-15", size: 15, sort: func(a []Custom) {
+			if !NetworkSortCustom(a, len(a)) {
+				panic("NetworkSortCustom: no sorter for size 15")
+			}
+		}},
+		{name: "NetworkSortCustom-16", size: 16, sort: func(a []Custom) {
+			if !NetworkSortCustom(a, len(a)) {
+				panic("NetworkSortCustom: no sorter for size 16")
+			}
+		}},
+		{name: "NetworkSortCustom-24", size: 24, sort: func(a []Custom) {
+			if !NetworkSortCustom(a, len(a)) {
+				panic("NetworkSortCustom: no sorter for size 24")
+			}
+		}},
+		{name: "NetworkSortCustom-32", size: 32, sort: func(a []Custom) {
+			if !NetworkSortCustom(a, len(a)) {
+				panic("NetworkSortCustom: no sorter for size 32")
+			}
+		}},
+		{name: "NetworkSortCustom-48", size: 48, sort: func(a []Custom) {
+			if !NetworkSortCustom(a, len(a)) {
+				panic("NetworkSortCustom: no sorter for size 48")
+			}
+		}},
+		{name: "NetworkSortCustom-64", size: 64, sort: func(a []Custom) {
+			if !NetworkSortCustom(a, len(a)) {
+				panic("NetworkSortCustom: no sorter for size 64")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestCustomGenNetworkSortCustom(t *testing.T) {
+	gen, before, cases := testCustomGenCasesNetworkSortCustom()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzCustomGenNetworkSortCustom(f *testing.F) {
+	gen, before, cases := testCustomGenCasesNetworkSortCustom()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]Custom, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkCustomGenNetworkSortCustom(b *testing.B) {
+	gen, before, cases := testCustomGenCasesNetworkSortCustom()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testCustomGenCasesNetworkSortCustomReverse returns the functions generated for Custom
+// in decreasing order, along with how to make random items and how they are ordered.
+func testCustomGenCasesNetworkSortCustomReverse() (gen func(*rand.Rand) Custom, before func(a, b Custom) bool, cases []testCustomGenCase[Custom]) {
+	gen = func(rng *rand.Rand) Custom { return Custom{Foo: rng.Intn(32) - 16} }
+	less := func(x, y Custom) bool {
+		a := [2]Custom{y, x}
+		CustomCASGreater(&a[0], &a[1])
+		return !reflect.DeepEqual(a[0], y)
+	}
+	before = func(a, b Custom) bool { return less(b, a) }
+	cases = []testCustomGenCase[Custom]{
+		{name: "NetworkSort2xCustomReverse", size: 2, sort: func(a []Custom) { NetworkSort2xCustomReverse(a) }},
+		{name: "NetworkSort3xCustomReverse", size: 3, sort: func(a []Custom) { NetworkSort3xCustomReverse(a) }},
+		{name: "NetworkSort4xCustomReverse", size: 4, sort: func(a []Custom) { NetworkSort4xCustomReverse(a) }},
+		{name: "NetworkSort5xCustomReverse", size: 5, sort: func(a []Custom) { NetworkSort5xCustomReverse(a) }},
+		{name: "NetworkSort6xCustomReverse", size: 6, sort: func(a []Custom) { NetworkSort6xCustomReverse(a) }},
+		{name: "NetworkSort7xCustomReverse", size: 7, sort: func(a []Custom) { NetworkSort7xCustomReverse(a) }},
+		{name: "NetworkSort8xCustomReverse", size: 8, sort: func(a []Custom) { NetworkSort8xCustomReverse(a) }},
+		{name: "NetworkSort9xCustomReverse", size: 9, sort: func(a []Custom) { NetworkSort9xCustomReverse(a) }},
+		{name: "NetworkSort10xCustomReverse", size: 10, sort: func(a []Custom) { NetworkSort10xCustomReverse(a) }},
+		{name: "NetworkSort11xCustomReverse", size: 11, sort: func(a []Custom) { NetworkSort11xCustomReverse(a) }},
+		{name: "NetworkSort12xCustomReverse", size: 12, sort: func(a []Custom) { NetworkSort12xCustomReverse(a) }},
+		{name: "NetworkSort13xCustomReverse", size: 13, sort: func(a []Custom) { NetworkSort13xCustomReverse(a) }},
+		{name: "NetworkSort14xCustomReverse", size: 14, sort: func(a []Custom) { NetworkSort14xCustomReverse(a) }},
+		{name: "NetworkSort15xCustomReverse", size: 15, sort: func(a []Custom) { NetworkSort15xCustomReverse(a) }},
+		{name: "NetworkSort16xCustomReverse", size: 16, sort: func(a []Custom) { NetworkSort16xCustomReverse(a) }},
+		{name: "NetworkSort24xCustomReverse", size: 24, sort: func(a []Custom) { NetworkSort24xCustomReverse(a) }},
+		{name: "NetworkSort32xCustomReverse", size: 32, sort: func(a []Custom) { NetworkSort32xCustomReverse(a) }},
+		{name: "NetworkSort48xCustomReverse", size: 48, sort: func(a []Custom) { NetworkSort48xCustomReverse(a) }},
+		{name: "NetworkSort64xCustomReverse", size: 64, sort: func(a []Custom) { NetworkSort64xCustomReverse(a) }},
+		{name: "NetworkSortCustomReverse-2", size: 2, sort: func(a []Custom) {
+			if !NetworkSortCustomReverse(a, len(a)) {
+				panic("NetworkSortCustomReverse: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortCustomReverse-3", size: 3, sort: func(a []Custom) {
+			if !NetworkSortCustomReverse(a, len(a)) {
+				panic("NetworkSortCustomReverse: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortCustomReverse-4", size: 4, sort: func(a []Custom) {
+			if !NetworkSortCustomReverse(a, len(a)) {
+				panic("NetworkSortCustomReverse: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortCustomReverse-5", size: 5, sort: func(a []Custom) {
+			if !NetworkSortCustomReverse(a, len(a)) {
+				panic("NetworkSortCustomReverse: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortCustomReverse-6", size: 6, sort: func(a []Custom) {
+			if !NetworkSortCustomReverse(a, len(a)) {
+				panic("NetworkSortCustomReverse: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortCustomReverse-7", size: 7, sort: func(a []Custom) {
+			if !NetworkSortCustomReverse(a, len(a)) {
+				panic("NetworkSortCustomReverse: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortCustomReverse-8", size: 8, sort: func(a []Custom) {
+			if !NetworkSortCustomReverse(a, len(a)) {
+				panic("NetworkSortCustomReverse: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortCustomReverse-9", size: 9, sort: func(a []Custom) {
+			if !NetworkSortCustomReverse(a, len(a)) {
+				panic("NetworkSortCustomReverse: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortCustomReverse-10", size: 10, sort: func(a []Custom) {
+			if !NetworkSortCustomReverse(a, len(a)) {
+				panic("NetworkSortCustomReverse: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortCustomReverse-11", size: 11, sort: func(a []Custom) {
+			if !NetworkSortCustomReverse(a, len(a)) {
+				panic("NetworkSortCustomReverse: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortCustomReverse-12", size: 12, sort: func(a []Custom) {
+			if !NetworkSortCustomReverse(a, len(a)) {
+				panic("NetworkSortCustomReverse: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortCustomReverse-13", size: 13, sort: func(a []Custom) {
+			if !NetworkSortCustomReverse(a, len(a)) {
+				panic("NetworkSortCustomReverse: no sorter for size 13")
+			}
+		}},
+		{name: "NetworkSortCustomReverse-14", size: 14, sort: func(a []Custom) {
+			if !NetworkSortCustomReverse(a, len(a)) {
+				panic("NetworkSortCustomReverse: no sorter for size 14")
+			}
+		}},
+		{name: "NetworkSortCustomReverse-15", size: 15, sort: func(a []Custom) {
+			if !NetworkSortCustomReverse(a, len(a)) {
+				panic("NetworkSortCustomReverse: no sorter for size 15")
+			}
+		}},
+		{name: "NetworkSortCustomReverse-16", size: 16, sort: func(a []Custom) {
+			if !NetworkSortCustomReverse(a, len(a)) {
+				panic("NetworkSortCustomReverse: no sorter for size 16")
+			}
+		}},
+		{name: "NetworkSortCustomReverse-24", size: 24, sort: func(a []Custom) {
+			if !NetworkSortCustomReverse(a, len(a)) {
+				panic("NetworkSortCustomReverse: no sorter for size 24")
+			}
+		}},
+		{name: "NetworkSortCustomReverse-32", size: 32, sort: func(a []Custom) {
+			if !NetworkSortCustomReverse(a, len(a)) {
+				panic("NetworkSortCustomReverse: no sorter for size 32")
+			}
+		}},
+		{name: "NetworkSortCustomReverse-48", size: 48, sort: func(a []Custom) {
+			if !NetworkSortCustomReverse(a, len(a)) {
+				panic("NetworkSortCustomReverse: no sorter for size 48")
+			}
+		}},
+		{name: "NetworkSortCustomReverse-64", size: 64, sort: func(a []Custom) {
+			if !NetworkSortCustomReverse(a, len(a)) {
+				panic("NetworkSortCustomReverse: no sorter for size 64")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestCustomGenNetworkSortCustomReverse(t *testing.T) {
+	gen, before, cases := testCustomGenCasesNetworkSortCustomReverse()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzCustomGenNetworkSortCustomReverse(f *testing.F) {
+	gen, before, cases := testCustomGenCasesNetworkSortCustomReverse()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]Custom, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkCustomGenNetworkSortCustomReverse(b *testing.B) {
+	gen, before, cases := testCustomGenCasesNetworkSortCustomReverse()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
@@ -0,0 +1,14073 @@
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
+
+package gentest
+
+// Sorting networks used in this file:
+//
+//   - Bose-Nelson, 2 inputs: 1 comparator (proven optimal), depth 1 (proven optimal)
+//     Fingerprint 9463926d4640a53a
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 3 inputs: 3 comparators (proven optimal), depth 3 (proven optimal)
+//     Fingerprint f995edafd93d50ea
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 4 inputs: 5 comparators (proven optimal), depth 3 (proven optimal)
+//     Fingerprint 0119fc1a905673cd
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 5 inputs: 9 comparators (proven optimal), depth 6 (not optimal)
+//     Fingerprint d54da66c984b8b07
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 6 inputs: 13 comparators (not optimal), depth 7 (not optimal)
+//     Fingerprint 1cc32b1cf5a405a2
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 7 inputs: 16 comparators (proven optimal), depth 7 (not optimal)
+//     Fingerprint 4c56e9c432030c54
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 8 inputs: 19 comparators (proven optimal), depth 7 (not optimal)
+//     Fingerprint 72b14580972c6d18
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Senso9, 9 inputs: 25 comparators (proven optimal), depth 8 (not optimal)
+//     Fingerprint 5463bfb95d0dca98
+//     V. K. Valsalam and R. Miikkulainen (2013)
+//     V. K. Valsalam and R. Miikkulainen, Using Symmetry and Evolutionary Search to Minimize Sorting Networks, Journal of Machine Learning Research 14, 2013
+//   - Senso10, 10 inputs: 29 comparators (proven optimal), depth 8 (not optimal)
+//     Fingerprint 9688ccf2613970b6
+//     V. K. Valsalam and R. Miikkulainen (2013)
+//     V. K. Valsalam and R. Miikkulainen, Using Symmetry and Evolutionary Search to Minimize Sorting Networks, Journal of Machine Learning Research 14, 2013
+//   - ShapiroGreen11, 11 inputs: 35 comparators (proven optimal), depth 9 (not optimal)
+//     Fingerprint a0cd3d71b97545e1
+//     G. Shapiro and M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - ShapiroGreen12, 12 inputs: 39 comparators (proven optimal), depth 9 (not optimal)
+//     Fingerprint 4520ec02bac38318
+//     G. Shapiro and M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - End13, 13 inputs: 45 comparators (best known), depth 10 (not optimal)
+//     Fingerprint 8fb515590c6989d6
+//     H. Juillé (1995)
+//     H. Juillé, Evolution of Non-Deterministic Incremental Algorithms as a New Approach for Search in State Spaces, Proceedings of the 6th International Conference on Genetic Algorithms, 1995
+//   - Green14, 14 inputs: 51 comparators (best known), depth 10 (not optimal)
+//     Fingerprint b78dfa7f24d12c0c
+//     M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - Green15, 15 inputs: 56 comparators (best known), depth 10 (not optimal)
+//     Fingerprint 360c873bdb44ac3e
+//     M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - Green16, 16 inputs: 60 comparators (best known), depth 10 (not optimal)
+//     Fingerprint 196d3d09b3f4a0e7
+//     M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - Morwenn24, 24 inputs: 123 comparators (not optimal), depth 15 (not optimal)
+//     Fingerprint a82fea0eb88f2605
+//     Morwenn
+//     https://github.com/Morwenn/cpp-sort
+//   - Bose-Nelson, 32 inputs: 211 comparators (unknown), depth 31 (unknown)
+//     Fingerprint 947ea8bcb4cc1a7d
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+
+// NetworkSortCelsius sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortCelsius(a []Celsius, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xCelsius(a)
+	case 3:
+		NetworkSort3xCelsius(a)
+	case 4:
+		NetworkSort4xCelsius(a)
+	case 5:
+		NetworkSort5xCelsius(a)
+	case 6:
+		NetworkSort6xCelsius(a)
+	case 7:
+		NetworkSort7xCelsius(a)
+	case 8:
+		NetworkSort8xCelsius(a)
+	case 9:
+		NetworkSort9xCelsius(a)
+	case 10:
+		NetworkSort10xCelsius(a)
+	case 11:
+		NetworkSort11xCelsius(a)
+	case 12:
+		NetworkSort12xCelsius(a)
+	case 13:
+		NetworkSort13xCelsius(a)
+	case 14:
+		NetworkSort14xCelsius(a)
+	case 15:
+		NetworkSort15xCelsius(a)
+	case 16:
+		NetworkSort16xCelsius(a)
+	case 24:
+		NetworkSort24xCelsius(a)
+	case 32:
+		NetworkSort32xCelsius(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortCelsiusReverse sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortCelsiusReverse(a []Celsius, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xCelsiusReverse(a)
+	case 3:
+		NetworkSort3xCelsiusReverse(a)
+	case 4:
+		NetworkSort4xCelsiusReverse(a)
+	case 5:
+		NetworkSort5xCelsiusReverse(a)
+	case 6:
+		NetworkSort6xCelsiusReverse(a)
+	case 7:
+		NetworkSort7xCelsiusReverse(a)
+	case 8:
+		NetworkSort8xCelsiusReverse(a)
+	case 9:
+		NetworkSort9xCelsiusReverse(a)
+	case 10:
+		NetworkSort10xCelsiusReverse(a)
+	case 11:
+		NetworkSort11xCelsiusReverse(a)
+	case 12:
+		NetworkSort12xCelsiusReverse(a)
+	case 13:
+		NetworkSort13xCelsiusReverse(a)
+	case 14:
+		NetworkSort14xCelsiusReverse(a)
+	case 15:
+		NetworkSort15xCelsiusReverse(a)
+	case 16:
+		NetworkSort16xCelsiusReverse(a)
+	case 24:
+		NetworkSort24xCelsiusReverse(a)
+	case 32:
+		NetworkSort32xCelsiusReverse(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortPriority sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortPriority(a []Priority, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xPriority(a)
+	case 3:
+		NetworkSort3xPriority(a)
+	case 4:
+		NetworkSort4xPriority(a)
+	case 5:
+		NetworkSort5xPriority(a)
+	case 6:
+		NetworkSort6xPriority(a)
+	case 7:
+		NetworkSort7xPriority(a)
+	case 8:
+		NetworkSort8xPriority(a)
+	case 9:
+		NetworkSort9xPriority(a)
+	case 10:
+		NetworkSort10xPriority(a)
+	case 11:
+		NetworkSort11xPriority(a)
+	case 12:
+		NetworkSort12xPriority(a)
+	case 13:
+		NetworkSort13xPriority(a)
+	case 14:
+		NetworkSort14xPriority(a)
+	case 15:
+		NetworkSort15xPriority(a)
+	case 16:
+		NetworkSort16xPriority(a)
+	case 24:
+		NetworkSort24xPriority(a)
+	case 32:
+		NetworkSort32xPriority(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortPriorityReverse sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortPriorityReverse(a []Priority, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xPriorityReverse(a)
+	case 3:
+		NetworkSort3xPriorityReverse(a)
+	case 4:
+		NetworkSort4xPriorityReverse(a)
+	case 5:
+		NetworkSort5xPriorityReverse(a)
+	case 6:
+		NetworkSort6xPriorityReverse(a)
+	case 7:
+		NetworkSort7xPriorityReverse(a)
+	case 8:
+		NetworkSort8xPriorityReverse(a)
+	case 9:
+		NetworkSort9xPriorityReverse(a)
+	case 10:
+		NetworkSort10xPriorityReverse(a)
+	case 11:
+		NetworkSort11xPriorityReverse(a)
+	case 12:
+		NetworkSort12xPriorityReverse(a)
+	case 13:
+		NetworkSort13xPriorityReverse(a)
+	case 14:
+		NetworkSort14xPriorityReverse(a)
+	case 15:
+		NetworkSort15xPriorityReverse(a)
+	case 16:
+		NetworkSort16xPriorityReverse(a)
+	case 24:
+		NetworkSort24xPriorityReverse(a)
+	case 32:
+		NetworkSort32xPriorityReverse(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortVersion sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortVersion(a []Version, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xVersion(a)
+	case 3:
+		NetworkSort3xVersion(a)
+	case 4:
+		NetworkSort4xVersion(a)
+	case 5:
+		NetworkSort5xVersion(a)
+	case 6:
+		NetworkSort6xVersion(a)
+	case 7:
+		NetworkSort7xVersion(a)
+	case 8:
+		NetworkSort8xVersion(a)
+	case 9:
+		NetworkSort9xVersion(a)
+	case 10:
+		NetworkSort10xVersion(a)
+	case 11:
+		NetworkSort11xVersion(a)
+	case 12:
+		NetworkSort12xVersion(a)
+	case 13:
+		NetworkSort13xVersion(a)
+	case 14:
+		NetworkSort14xVersion(a)
+	case 15:
+		NetworkSort15xVersion(a)
+	case 16:
+		NetworkSort16xVersion(a)
+	case 24:
+		NetworkSort24xVersion(a)
+	case 32:
+		NetworkSort32xVersion(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortVersionReverse sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortVersionReverse(a []Version, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xVersionReverse(a)
+	case 3:
+		NetworkSort3xVersionReverse(a)
+	case 4:
+		NetworkSort4xVersionReverse(a)
+	case 5:
+		NetworkSort5xVersionReverse(a)
+	case 6:
+		NetworkSort6xVersionReverse(a)
+	case 7:
+		NetworkSort7xVersionReverse(a)
+	case 8:
+		NetworkSort8xVersionReverse(a)
+	case 9:
+		NetworkSort9xVersionReverse(a)
+	case 10:
+		NetworkSort10xVersionReverse(a)
+	case 11:
+		NetworkSort11xVersionReverse(a)
+	case 12:
+		NetworkSort12xVersionReverse(a)
+	case 13:
+		NetworkSort13xVersionReverse(a)
+	case 14:
+		NetworkSort14xVersionReverse(a)
+	case 15:
+		NetworkSort15xVersionReverse(a)
+	case 16:
+		NetworkSort16xVersionReverse(a)
+	case 24:
+		NetworkSort24xVersionReverse(a)
+	case 32:
+		NetworkSort32xVersionReverse(a)
+	default:
+		return false
+	}
+	return true
+}
+
+func NetworkSort2xCelsius(a []Celsius) {
+	_ = a[1]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort2xCelsiusReverse(a []Celsius) {
+	_ = a[1]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3xCelsius(a []Celsius) {
+	_ = a[2]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3xCelsiusReverse(a []Celsius) {
+	_ = a[2]
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort4xCelsius(a []Celsius) {
+	_ = a[3]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort4xCelsiusReverse(a []Celsius) {
+	_ = a[3]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5xCelsius(a []Celsius) {
+	_ = a[4]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5xCelsiusReverse(a []Celsius) {
+	_ = a[4]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] < a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort6xCelsius(a []Celsius) {
+	_ = a[5]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort6xCelsiusReverse(a []Celsius) {
+	_ = a[5]
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] < a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] < a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7xCelsius(a []Celsius) {
+	_ = a[6]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7xCelsiusReverse(a []Celsius) {
+	_ = a[6]
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] < a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2] < a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort8xCelsius(a []Celsius) {
+	_ = a[7]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort8xCelsiusReverse(a []Celsius) {
+	_ = a[7]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] < a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort9xCelsius(a []Celsius) {
+	_ = a[8]
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[0] > a[5] {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[0] > a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2] > a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort9xCelsiusReverse(a []Celsius) {
+	_ = a[8]
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[0] < a[5] {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[0] < a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] < a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2] < a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort10xCelsius(a []Celsius) {
+	_ = a[9]
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] > a[9] {
+		a[0], a[9] = a[9], a[0]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[0] > a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4] > a[9] {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] > a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[1] > a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort10xCelsiusReverse(a []Celsius) {
+	_ = a[9]
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] < a[9] {
+		a[0], a[9] = a[9], a[0]
+	}
+	if a[2] < a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[0] < a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3] < a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4] < a[9] {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6] < a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] < a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[1] < a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] < a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort11xCelsius(a []Celsius) {
+	_ = a[10]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort11xCelsiusReverse(a []Celsius) {
+	_ = a[10]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] < a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5] < a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] < a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] < a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3] < a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort12xCelsius(a []Celsius) {
+	_ = a[11]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort12xCelsiusReverse(a []Celsius) {
+	_ = a[11]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] < a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] < a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] < a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5] < a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] < a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] < a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] < a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] < a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3] < a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort13xCelsius(a []Celsius) {
+	_ = a[12]
+	if a[1] > a[7] {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[0] > a[12] {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[11] {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[7] > a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[6] > a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[4] > a[9] {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1] > a[7] {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[4] > a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[0] > a[5] {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort13xCelsiusReverse(a []Celsius) {
+	_ = a[12]
+	if a[1] < a[7] {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[9] < a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[0] < a[12] {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] < a[11] {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[7] < a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[5] < a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[10] < a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[6] < a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[11] < a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[4] < a[9] {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] < a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1] < a[7] {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[9] < a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[4] < a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[8] < a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[0] < a[5] {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[2] < a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort14xCelsius(a []Celsius) {
+	_ = a[13]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[0] > a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] > a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2] > a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[5] > a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3] > a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7] > a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort14xCelsiusReverse(a []Celsius) {
+	_ = a[13]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] < a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] < a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] < a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] < a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8] < a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9] < a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[0] < a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] < a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2] < a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] < a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4] < a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] < a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[5] < a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6] < a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3] < a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[7] < a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] < a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] < a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2] < a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] < a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3] < a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7] < a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] < a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] < a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort15xCelsius(a []Celsius) {
+	_ = a[14]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[0] > a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] > a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2] > a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[5] > a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3] > a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[11] > a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7] > a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort15xCelsiusReverse(a []Celsius) {
+	_ = a[14]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] < a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] < a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] < a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[12] < a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] < a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8] < a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9] < a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[10] < a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[0] < a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] < a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2] < a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] < a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4] < a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] < a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] < a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[5] < a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6] < a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3] < a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[13] < a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[7] < a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] < a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] < a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2] < a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[11] < a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] < a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3] < a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7] < a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] < a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] < a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort16xCelsius(a []Celsius) {
+	_ = a[15]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[0] > a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] > a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2] > a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7] > a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[5] > a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3] > a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[11] > a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7] > a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort16xCelsiusReverse(a []Celsius) {
+	_ = a[15]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] < a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] < a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14] < a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] < a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[12] < a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] < a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[13] < a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8] < a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9] < a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[10] < a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[11] < a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[0] < a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] < a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2] < a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] < a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4] < a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] < a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] < a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7] < a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[5] < a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6] < a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3] < a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[13] < a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[7] < a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] < a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] < a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2] < a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[11] < a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] < a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3] < a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7] < a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] < a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] < a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort24xCelsius(a []Celsius) {
+	_ = a[23]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[16] > a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[18] > a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[20] > a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[22] > a[23] {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[17] > a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[21] > a[23] {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[16] > a[18] {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[20] > a[22] {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[21] > a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[13] > a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[18] > a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[17] > a[21] {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[14] > a[18] {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[13] > a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[18] > a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[19] > a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[15] > a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[16] > a[20] {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[19] > a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[13] > a[16] {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[19] > a[22] {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[15] > a[20] {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[20] > a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[19] > a[21] {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[18] > a[20] {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[19] > a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[0] > a[12] {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[1] > a[13] {
+		a[1], a[13] = a[13], a[1]
+	}
+	if a[2] > a[14] {
+		a[2], a[14] = a[14], a[2]
+	}
+	if a[3] > a[15] {
+		a[3], a[15] = a[15], a[3]
+	}
+	if a[4] > a[16] {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[5] > a[17] {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[6] > a[18] {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[7] > a[19] {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[8] > a[20] {
+		a[8], a[20] = a[20], a[8]
+	}
+	if a[9] > a[21] {
+		a[9], a[21] = a[21], a[9]
+	}
+	if a[10] > a[22] {
+		a[10], a[22] = a[22], a[10]
+	}
+	if a[11] > a[23] {
+		a[11], a[23] = a[23], a[11]
+	}
+	if a[2] > a[12] {
+		a[2], a[12] = a[12], a[2]
+	}
+	if a[3] > a[13] {
+		a[3], a[13] = a[13], a[3]
+	}
+	if a[10] > a[20] {
+		a[10], a[20] = a[20], a[10]
+	}
+	if a[11] > a[21] {
+		a[11], a[21] = a[21], a[11]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7] > a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[8] > a[16] {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[9] > a[17] {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[10] > a[18] {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[11] > a[19] {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[19] > a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[21] > a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+}
+
+func NetworkSort24xCelsiusReverse(a []Celsius) {
+	_ = a[23]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] < a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] < a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14] < a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[16] < a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[18] < a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[20] < a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[22] < a[23] {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] < a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] < a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[13] < a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[17] < a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[21] < a[23] {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[12] < a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[16] < a[18] {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[20] < a[22] {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[13] < a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[17] < a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[21] < a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[13] < a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[18] < a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[5] < a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[17] < a[21] {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[14] < a[18] {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] < a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[13] < a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[18] < a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[12] < a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[19] < a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] < a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[15] < a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[16] < a[20] {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] < a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[12] < a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[19] < a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] < a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3] < a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[13] < a[16] {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[19] < a[22] {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[15] < a[20] {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[14] < a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[20] < a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[14] < a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[19] < a[21] {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[15] < a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[18] < a[20] {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[15] < a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[17] < a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[19] < a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[0] < a[12] {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[1] < a[13] {
+		a[1], a[13] = a[13], a[1]
+	}
+	if a[2] < a[14] {
+		a[2], a[14] = a[14], a[2]
+	}
+	if a[3] < a[15] {
+		a[3], a[15] = a[15], a[3]
+	}
+	if a[4] < a[16] {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[5] < a[17] {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[6] < a[18] {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[7] < a[19] {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[8] < a[20] {
+		a[8], a[20] = a[20], a[8]
+	}
+	if a[9] < a[21] {
+		a[9], a[21] = a[21], a[9]
+	}
+	if a[10] < a[22] {
+		a[10], a[22] = a[22], a[10]
+	}
+	if a[11] < a[23] {
+		a[11], a[23] = a[23], a[11]
+	}
+	if a[2] < a[12] {
+		a[2], a[12] = a[12], a[2]
+	}
+	if a[3] < a[13] {
+		a[3], a[13] = a[13], a[3]
+	}
+	if a[10] < a[20] {
+		a[10], a[20] = a[20], a[10]
+	}
+	if a[11] < a[21] {
+		a[11], a[21] = a[21], a[11]
+	}
+	if a[4] < a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] < a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] < a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7] < a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[8] < a[16] {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[9] < a[17] {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[10] < a[18] {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[11] < a[19] {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[8] < a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9] < a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[10] < a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11] < a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] < a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[14] < a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[11] < a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[15] < a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] < a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[13] < a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[15] < a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[17] < a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[19] < a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[21] < a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+}
+
+func NetworkSort32xCelsius(a []Celsius) {
+	_ = a[31]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[9] > a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[11] > a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[0] > a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] > a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[1] > a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[2] > a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[3] > a[10] {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[3] > a[9] {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[5] > a[12] {
+		a[5], a[12] = a[12], a[5]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7] > a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[7] > a[14] {
+		a[7], a[14] = a[14], a[7]
+	}
+	if a[6] > a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[7] > a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[7] > a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[16] > a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[18] > a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[16] > a[18] {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[17] > a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[20] > a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[22] > a[23] {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[20] > a[22] {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[21] > a[23] {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[21] > a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[16] > a[20] {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[17] > a[21] {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[17] > a[20] {
+		a[17], a[20] = a[20], a[17]
+	}
+	if a[18] > a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[19] > a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[19] > a[22] {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[18] > a[20] {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[19] > a[21] {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[19] > a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[24] > a[25] {
+		a[24], a[25] = a[25], a[24]
+	}
+	if a[26] > a[27] {
+		a[26], a[27] = a[27], a[26]
+	}
+	if a[24] > a[26] {
+		a[24], a[26] = a[26], a[24]
+	}
+	if a[25] > a[27] {
+		a[25], a[27] = a[27], a[25]
+	}
+	if a[25] > a[26] {
+		a[25], a[26] = a[26], a[25]
+	}
+	if a[28] > a[29] {
+		a[28], a[29] = a[29], a[28]
+	}
+	if a[30] > a[31] {
+		a[30], a[31] = a[31], a[30]
+	}
+	if a[28] > a[30] {
+		a[28], a[30] = a[30], a[28]
+	}
+	if a[29] > a[31] {
+		a[29], a[31] = a[31], a[29]
+	}
+	if a[29] > a[30] {
+		a[29], a[30] = a[30], a[29]
+	}
+	if a[24] > a[28] {
+		a[24], a[28] = a[28], a[24]
+	}
+	if a[25] > a[29] {
+		a[25], a[29] = a[29], a[25]
+	}
+	if a[25] > a[28] {
+		a[25], a[28] = a[28], a[25]
+	}
+	if a[26] > a[30] {
+		a[26], a[30] = a[30], a[26]
+	}
+	if a[27] > a[31] {
+		a[27], a[31] = a[31], a[27]
+	}
+	if a[27] > a[30] {
+		a[27], a[30] = a[30], a[27]
+	}
+	if a[26] > a[28] {
+		a[26], a[28] = a[28], a[26]
+	}
+	if a[27] > a[29] {
+		a[27], a[29] = a[29], a[27]
+	}
+	if a[27] > a[28] {
+		a[27], a[28] = a[28], a[27]
+	}
+	if a[16] > a[24] {
+		a[16], a[24] = a[24], a[16]
+	}
+	if a[17] > a[25] {
+		a[17], a[25] = a[25], a[17]
+	}
+	if a[17] > a[24] {
+		a[17], a[24] = a[24], a[17]
+	}
+	if a[18] > a[26] {
+		a[18], a[26] = a[26], a[18]
+	}
+	if a[19] > a[27] {
+		a[19], a[27] = a[27], a[19]
+	}
+	if a[19] > a[26] {
+		a[19], a[26] = a[26], a[19]
+	}
+	if a[18] > a[24] {
+		a[18], a[24] = a[24], a[18]
+	}
+	if a[19] > a[25] {
+		a[19], a[25] = a[25], a[19]
+	}
+	if a[19] > a[24] {
+		a[19], a[24] = a[24], a[19]
+	}
+	if a[20] > a[28] {
+		a[20], a[28] = a[28], a[20]
+	}
+	if a[21] > a[29] {
+		a[21], a[29] = a[29], a[21]
+	}
+	if a[21] > a[28] {
+		a[21], a[28] = a[28], a[21]
+	}
+	if a[22] > a[30] {
+		a[22], a[30] = a[30], a[22]
+	}
+	if a[23] > a[31] {
+		a[23], a[31] = a[31], a[23]
+	}
+	if a[23] > a[30] {
+		a[23], a[30] = a[30], a[23]
+	}
+	if a[22] > a[28] {
+		a[22], a[28] = a[28], a[22]
+	}
+	if a[23] > a[29] {
+		a[23], a[29] = a[29], a[23]
+	}
+	if a[23] > a[28] {
+		a[23], a[28] = a[28], a[23]
+	}
+	if a[20] > a[24] {
+		a[20], a[24] = a[24], a[20]
+	}
+	if a[21] > a[25] {
+		a[21], a[25] = a[25], a[21]
+	}
+	if a[21] > a[24] {
+		a[21], a[24] = a[24], a[21]
+	}
+	if a[22] > a[26] {
+		a[22], a[26] = a[26], a[22]
+	}
+	if a[23] > a[27] {
+		a[23], a[27] = a[27], a[23]
+	}
+	if a[23] > a[26] {
+		a[23], a[26] = a[26], a[23]
+	}
+	if a[22] > a[24] {
+		a[22], a[24] = a[24], a[22]
+	}
+	if a[23] > a[25] {
+		a[23], a[25] = a[25], a[23]
+	}
+	if a[23] > a[24] {
+		a[23], a[24] = a[24], a[23]
+	}
+	if a[0] > a[16] {
+		a[0], a[16] = a[16], a[0]
+	}
+	if a[1] > a[17] {
+		a[1], a[17] = a[17], a[1]
+	}
+	if a[1] > a[16] {
+		a[1], a[16] = a[16], a[1]
+	}
+	if a[2] > a[18] {
+		a[2], a[18] = a[18], a[2]
+	}
+	if a[3] > a[19] {
+		a[3], a[19] = a[19], a[3]
+	}
+	if a[3] > a[18] {
+		a[3], a[18] = a[18], a[3]
+	}
+	if a[2] > a[16] {
+		a[2], a[16] = a[16], a[2]
+	}
+	if a[3] > a[17] {
+		a[3], a[17] = a[17], a[3]
+	}
+	if a[3] > a[16] {
+		a[3], a[16] = a[16], a[3]
+	}
+	if a[4] > a[20] {
+		a[4], a[20] = a[20], a[4]
+	}
+	if a[5] > a[21] {
+		a[5], a[21] = a[21], a[5]
+	}
+	if a[5] > a[20] {
+		a[5], a[20] = a[20], a[5]
+	}
+	if a[6] > a[22] {
+		a[6], a[22] = a[22], a[6]
+	}
+	if a[7] > a[23] {
+		a[7], a[23] = a[23], a[7]
+	}
+	if a[7] > a[22] {
+		a[7], a[22] = a[22], a[7]
+	}
+	if a[6] > a[20] {
+		a[6], a[20] = a[20], a[6]
+	}
+	if a[7] > a[21] {
+		a[7], a[21] = a[21], a[7]
+	}
+	if a[7] > a[20] {
+		a[7], a[20] = a[20], a[7]
+	}
+	if a[4] > a[16] {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[5] > a[17] {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[5] > a[16] {
+		a[5], a[16] = a[16], a[5]
+	}
+	if a[6] > a[18] {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[7] > a[19] {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[7] > a[18] {
+		a[7], a[18] = a[18], a[7]
+	}
+	if a[6] > a[16] {
+		a[6], a[16] = a[16], a[6]
+	}
+	if a[7] > a[17] {
+		a[7], a[17] = a[17], a[7]
+	}
+	if a[7] > a[16] {
+		a[7], a[16] = a[16], a[7]
+	}
+	if a[8] > a[24] {
+		a[8], a[24] = a[24], a[8]
+	}
+	if a[9] > a[25] {
+		a[9], a[25] = a[25], a[9]
+	}
+	if a[9] > a[24] {
+		a[9], a[24] = a[24], a[9]
+	}
+	if a[10] > a[26] {
+		a[10], a[26] = a[26], a[10]
+	}
+	if a[11] > a[27] {
+		a[11], a[27] = a[27], a[11]
+	}
+	if a[11] > a[26] {
+		a[11], a[26] = a[26], a[11]
+	}
+	if a[10] > a[24] {
+		a[10], a[24] = a[24], a[10]
+	}
+	if a[11] > a[25] {
+		a[11], a[25] = a[25], a[11]
+	}
+	if a[11] > a[24] {
+		a[11], a[24] = a[24], a[11]
+	}
+	if a[12] > a[28] {
+		a[12], a[28] = a[28], a[12]
+	}
+	if a[13] > a[29] {
+		a[13], a[29] = a[29], a[13]
+	}
+	if a[13] > a[28] {
+		a[13], a[28] = a[28], a[13]
+	}
+	if a[14] > a[30] {
+		a[14], a[30] = a[30], a[14]
+	}
+	if a[15] > a[31] {
+		a[15], a[31] = a[31], a[15]
+	}
+	if a[15] > a[30] {
+		a[15], a[30] = a[30], a[15]
+	}
+	if a[14] > a[28] {
+		a[14], a[28] = a[28], a[14]
+	}
+	if a[15] > a[29] {
+		a[15], a[29] = a[29], a[15]
+	}
+	if a[15] > a[28] {
+		a[15], a[28] = a[28], a[15]
+	}
+	if a[12] > a[24] {
+		a[12], a[24] = a[24], a[12]
+	}
+	if a[13] > a[25] {
+		a[13], a[25] = a[25], a[13]
+	}
+	if a[13] > a[24] {
+		a[13], a[24] = a[24], a[13]
+	}
+	if a[14] > a[26] {
+		a[14], a[26] = a[26], a[14]
+	}
+	if a[15] > a[27] {
+		a[15], a[27] = a[27], a[15]
+	}
+	if a[15] > a[26] {
+		a[15], a[26] = a[26], a[15]
+	}
+	if a[14] > a[24] {
+		a[14], a[24] = a[24], a[14]
+	}
+	if a[15] > a[25] {
+		a[15], a[25] = a[25], a[15]
+	}
+	if a[15] > a[24] {
+		a[15], a[24] = a[24], a[15]
+	}
+	if a[8] > a[16] {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[9] > a[17] {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[9] > a[16] {
+		a[9], a[16] = a[16], a[9]
+	}
+	if a[10] > a[18] {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[11] > a[19] {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[11] > a[18] {
+		a[11], a[18] = a[18], a[11]
+	}
+	if a[10] > a[16] {
+		a[10], a[16] = a[16], a[10]
+	}
+	if a[11] > a[17] {
+		a[11], a[17] = a[17], a[11]
+	}
+	if a[11] > a[16] {
+		a[11], a[16] = a[16], a[11]
+	}
+	if a[12] > a[20] {
+		a[12], a[20] = a[20], a[12]
+	}
+	if a[13] > a[21] {
+		a[13], a[21] = a[21], a[13]
+	}
+	if a[13] > a[20] {
+		a[13], a[20] = a[20], a[13]
+	}
+	if a[14] > a[22] {
+		a[14], a[22] = a[22], a[14]
+	}
+	if a[15] > a[23] {
+		a[15], a[23] = a[23], a[15]
+	}
+	if a[15] > a[22] {
+		a[15], a[22] = a[22], a[15]
+	}
+	if a[14] > a[20] {
+		a[14], a[20] = a[20], a[14]
+	}
+	if a[15] > a[21] {
+		a[15], a[21] = a[21], a[15]
+	}
+	if a[15] > a[20] {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[13] > a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[13] > a[16] {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[14] > a[18] {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[15] > a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[15] > a[18] {
+		a[15], a[18] = a[18], a[15]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+}
+
+func NetworkSort32xCelsiusReverse(a []Celsius) {
+	_ = a[31]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] < a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] < a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[8] < a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[9] < a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12] < a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14] < a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[12] < a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[13] < a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[13] < a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[8] < a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9] < a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[9] < a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[10] < a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11] < a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[11] < a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[10] < a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[11] < a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[11] < a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[0] < a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] < a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[1] < a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[2] < a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] < a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[3] < a[10] {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[2] < a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[3] < a[9] {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[3] < a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[4] < a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] < a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[5] < a[12] {
+		a[5], a[12] = a[12], a[5]
+	}
+	if a[6] < a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7] < a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[7] < a[14] {
+		a[7], a[14] = a[14], a[7]
+	}
+	if a[6] < a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[7] < a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[7] < a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[4] < a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[5] < a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[5] < a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[7] < a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[7] < a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[16] < a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[18] < a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[16] < a[18] {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[17] < a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[17] < a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[20] < a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[22] < a[23] {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[20] < a[22] {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[21] < a[23] {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[21] < a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[16] < a[20] {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[17] < a[21] {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[17] < a[20] {
+		a[17], a[20] = a[20], a[17]
+	}
+	if a[18] < a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[19] < a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[19] < a[22] {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[18] < a[20] {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[19] < a[21] {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[19] < a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[24] < a[25] {
+		a[24], a[25] = a[25], a[24]
+	}
+	if a[26] < a[27] {
+		a[26], a[27] = a[27], a[26]
+	}
+	if a[24] < a[26] {
+		a[24], a[26] = a[26], a[24]
+	}
+	if a[25] < a[27] {
+		a[25], a[27] = a[27], a[25]
+	}
+	if a[25] < a[26] {
+		a[25], a[26] = a[26], a[25]
+	}
+	if a[28] < a[29] {
+		a[28], a[29] = a[29], a[28]
+	}
+	if a[30] < a[31] {
+		a[30], a[31] = a[31], a[30]
+	}
+	if a[28] < a[30] {
+		a[28], a[30] = a[30], a[28]
+	}
+	if a[29] < a[31] {
+		a[29], a[31] = a[31], a[29]
+	}
+	if a[29] < a[30] {
+		a[29], a[30] = a[30], a[29]
+	}
+	if a[24] < a[28] {
+		a[24], a[28] = a[28], a[24]
+	}
+	if a[25] < a[29] {
+		a[25], a[29] = a[29], a[25]
+	}
+	if a[25] < a[28] {
+		a[25], a[28] = a[28], a[25]
+	}
+	if a[26] < a[30] {
+		a[26], a[30] = a[30], a[26]
+	}
+	if a[27] < a[31] {
+		a[27], a[31] = a[31], a[27]
+	}
+	if a[27] < a[30] {
+		a[27], a[30] = a[30], a[27]
+	}
+	if a[26] < a[28] {
+		a[26], a[28] = a[28], a[26]
+	}
+	if a[27] < a[29] {
+		a[27], a[29] = a[29], a[27]
+	}
+	if a[27] < a[28] {
+		a[27], a[28] = a[28], a[27]
+	}
+	if a[16] < a[24] {
+		a[16], a[24] = a[24], a[16]
+	}
+	if a[17] < a[25] {
+		a[17], a[25] = a[25], a[17]
+	}
+	if a[17] < a[24] {
+		a[17], a[24] = a[24], a[17]
+	}
+	if a[18] < a[26] {
+		a[18], a[26] = a[26], a[18]
+	}
+	if a[19] < a[27] {
+		a[19], a[27] = a[27], a[19]
+	}
+	if a[19] < a[26] {
+		a[19], a[26] = a[26], a[19]
+	}
+	if a[18] < a[24] {
+		a[18], a[24] = a[24], a[18]
+	}
+	if a[19] < a[25] {
+		a[19], a[25] = a[25], a[19]
+	}
+	if a[19] < a[24] {
+		a[19], a[24] = a[24], a[19]
+	}
+	if a[20] < a[28] {
+		a[20], a[28] = a[28], a[20]
+	}
+	if a[21] < a[29] {
+		a[21], a[29] = a[29], a[21]
+	}
+	if a[21] < a[28] {
+		a[21], a[28] = a[28], a[21]
+	}
+	if a[22] < a[30] {
+		a[22], a[30] = a[30], a[22]
+	}
+	if a[23] < a[31] {
+		a[23], a[31] = a[31], a[23]
+	}
+	if a[23] < a[30] {
+		a[23], a[30] = a[30], a[23]
+	}
+	if a[22] < a[28] {
+		a[22], a[28] = a[28], a[22]
+	}
+	if a[23] < a[29] {
+		a[23], a[29] = a[29], a[23]
+	}
+	if a[23] < a[28] {
+		a[23], a[28] = a[28], a[23]
+	}
+	if a[20] < a[24] {
+		a[20], a[24] = a[24], a[20]
+	}
+	if a[21] < a[25] {
+		a[21], a[25] = a[25], a[21]
+	}
+	if a[21] < a[24] {
+		a[21], a[24] = a[24], a[21]
+	}
+	if a[22] < a[26] {
+		a[22], a[26] = a[26], a[22]
+	}
+	if a[23] < a[27] {
+		a[23], a[27] = a[27], a[23]
+	}
+	if a[23] < a[26] {
+		a[23], a[26] = a[26], a[23]
+	}
+	if a[22] < a[24] {
+		a[22], a[24] = a[24], a[22]
+	}
+	if a[23] < a[25] {
+		a[23], a[25] = a[25], a[23]
+	}
+	if a[23] < a[24] {
+		a[23], a[24] = a[24], a[23]
+	}
+	if a[0] < a[16] {
+		a[0], a[16] = a[16], a[0]
+	}
+	if a[1] < a[17] {
+		a[1], a[17] = a[17], a[1]
+	}
+	if a[1] < a[16] {
+		a[1], a[16] = a[16], a[1]
+	}
+	if a[2] < a[18] {
+		a[2], a[18] = a[18], a[2]
+	}
+	if a[3] < a[19] {
+		a[3], a[19] = a[19], a[3]
+	}
+	if a[3] < a[18] {
+		a[3], a[18] = a[18], a[3]
+	}
+	if a[2] < a[16] {
+		a[2], a[16] = a[16], a[2]
+	}
+	if a[3] < a[17] {
+		a[3], a[17] = a[17], a[3]
+	}
+	if a[3] < a[16] {
+		a[3], a[16] = a[16], a[3]
+	}
+	if a[4] < a[20] {
+		a[4], a[20] = a[20], a[4]
+	}
+	if a[5] < a[21] {
+		a[5], a[21] = a[21], a[5]
+	}
+	if a[5] < a[20] {
+		a[5], a[20] = a[20], a[5]
+	}
+	if a[6] < a[22] {
+		a[6], a[22] = a[22], a[6]
+	}
+	if a[7] < a[23] {
+		a[7], a[23] = a[23], a[7]
+	}
+	if a[7] < a[22] {
+		a[7], a[22] = a[22], a[7]
+	}
+	if a[6] < a[20] {
+		a[6], a[20] = a[20], a[6]
+	}
+	if a[7] < a[21] {
+		a[7], a[21] = a[21], a[7]
+	}
+	if a[7] < a[20] {
+		a[7], a[20] = a[20], a[7]
+	}
+	if a[4] < a[16] {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[5] < a[17] {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[5] < a[16] {
+		a[5], a[16] = a[16], a[5]
+	}
+	if a[6] < a[18] {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[7] < a[19] {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[7] < a[18] {
+		a[7], a[18] = a[18], a[7]
+	}
+	if a[6] < a[16] {
+		a[6], a[16] = a[16], a[6]
+	}
+	if a[7] < a[17] {
+		a[7], a[17] = a[17], a[7]
+	}
+	if a[7] < a[16] {
+		a[7], a[16] = a[16], a[7]
+	}
+	if a[8] < a[24] {
+		a[8], a[24] = a[24], a[8]
+	}
+	if a[9] < a[25] {
+		a[9], a[25] = a[25], a[9]
+	}
+	if a[9] < a[24] {
+		a[9], a[24] = a[24], a[9]
+	}
+	if a[10] < a[26] {
+		a[10], a[26] = a[26], a[10]
+	}
+	if a[11] < a[27] {
+		a[11], a[27] = a[27], a[11]
+	}
+	if a[11] < a[26] {
+		a[11], a[26] = a[26], a[11]
+	}
+	if a[10] < a[24] {
+		a[10], a[24] = a[24], a[10]
+	}
+	if a[11] < a[25] {
+		a[11], a[25] = a[25], a[11]
+	}
+	if a[11] < a[24] {
+		a[11], a[24] = a[24], a[11]
+	}
+	if a[12] < a[28] {
+		a[12], a[28] = a[28], a[12]
+	}
+	if a[13] < a[29] {
+		a[13], a[29] = a[29], a[13]
+	}
+	if a[13] < a[28] {
+		a[13], a[28] = a[28], a[13]
+	}
+	if a[14] < a[30] {
+		a[14], a[30] = a[30], a[14]
+	}
+	if a[15] < a[31] {
+		a[15], a[31] = a[31], a[15]
+	}
+	if a[15] < a[30] {
+		a[15], a[30] = a[30], a[15]
+	}
+	if a[14] < a[28] {
+		a[14], a[28] = a[28], a[14]
+	}
+	if a[15] < a[29] {
+		a[15], a[29] = a[29], a[15]
+	}
+	if a[15] < a[28] {
+		a[15], a[28] = a[28], a[15]
+	}
+	if a[12] < a[24] {
+		a[12], a[24] = a[24], a[12]
+	}
+	if a[13] < a[25] {
+		a[13], a[25] = a[25], a[13]
+	}
+	if a[13] < a[24] {
+		a[13], a[24] = a[24], a[13]
+	}
+	if a[14] < a[26] {
+		a[14], a[26] = a[26], a[14]
+	}
+	if a[15] < a[27] {
+		a[15], a[27] = a[27], a[15]
+	}
+	if a[15] < a[26] {
+		a[15], a[26] = a[26], a[15]
+	}
+	if a[14] < a[24] {
+		a[14], a[24] = a[24], a[14]
+	}
+	if a[15] < a[25] {
+		a[15], a[25] = a[25], a[15]
+	}
+	if a[15] < a[24] {
+		a[15], a[24] = a[24], a[15]
+	}
+	if a[8] < a[16] {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[9] < a[17] {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[9] < a[16] {
+		a[9], a[16] = a[16], a[9]
+	}
+	if a[10] < a[18] {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[11] < a[19] {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[11] < a[18] {
+		a[11], a[18] = a[18], a[11]
+	}
+	if a[10] < a[16] {
+		a[10], a[16] = a[16], a[10]
+	}
+	if a[11] < a[17] {
+		a[11], a[17] = a[17], a[11]
+	}
+	if a[11] < a[16] {
+		a[11], a[16] = a[16], a[11]
+	}
+	if a[12] < a[20] {
+		a[12], a[20] = a[20], a[12]
+	}
+	if a[13] < a[21] {
+		a[13], a[21] = a[21], a[13]
+	}
+	if a[13] < a[20] {
+		a[13], a[20] = a[20], a[13]
+	}
+	if a[14] < a[22] {
+		a[14], a[22] = a[22], a[14]
+	}
+	if a[15] < a[23] {
+		a[15], a[23] = a[23], a[15]
+	}
+	if a[15] < a[22] {
+		a[15], a[22] = a[22], a[15]
+	}
+	if a[14] < a[20] {
+		a[14], a[20] = a[20], a[14]
+	}
+	if a[15] < a[21] {
+		a[15], a[21] = a[21], a[15]
+	}
+	if a[15] < a[20] {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[12] < a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[13] < a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[13] < a[16] {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[14] < a[18] {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[15] < a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[15] < a[18] {
+		a[15], a[18] = a[18], a[15]
+	}
+	if a[14] < a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[15] < a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[15] < a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+}
+
+func NetworkSort2xPriority(a []Priority) {
+	_ = a[1]
+	if a[1].Less(a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort2xPriorityReverse(a []Priority) {
+	_ = a[1]
+	if a[0].Less(a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3xPriority(a []Priority) {
+	_ = a[2]
+	if a[2].Less(a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[2].Less(a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Less(a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3xPriorityReverse(a []Priority) {
+	_ = a[2]
+	if a[1].Less(a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0].Less(a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0].Less(a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort4xPriority(a []Priority) {
+	_ = a[3]
+	if a[1].Less(a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Less(a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[2].Less(a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[3].Less(a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Less(a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort4xPriorityReverse(a []Priority) {
+	_ = a[3]
+	if a[0].Less(a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Less(a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0].Less(a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Less(a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1].Less(a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5xPriority(a []Priority) {
+	_ = a[4]
+	if a[1].Less(a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4].Less(a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[4].Less(a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Less(a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[3].Less(a[0]) {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[2].Less(a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Less(a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[3].Less(a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Less(a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5xPriorityReverse(a []Priority) {
+	_ = a[4]
+	if a[0].Less(a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Less(a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[2].Less(a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2].Less(a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0].Less(a[3]) {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[0].Less(a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Less(a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[1].Less(a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1].Less(a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort6xPriority(a []Priority) {
+	_ = a[5]
+	if a[2].Less(a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[2].Less(a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Less(a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[5].Less(a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[5].Less(a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Less(a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[4].Less(a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3].Less(a[0]) {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[5].Less(a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[5].Less(a[2]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[3].Less(a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[4].Less(a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Less(a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort6xPriorityReverse(a []Priority) {
+	_ = a[5]
+	if a[1].Less(a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0].Less(a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0].Less(a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4].Less(a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3].Less(a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3].Less(a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0].Less(a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0].Less(a[3]) {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1].Less(a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2].Less(a[5]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1].Less(a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Less(a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2].Less(a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7xPriority(a []Priority) {
+	_ = a[6]
+	if a[2].Less(a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[2].Less(a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Less(a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4].Less(a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Less(a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[5].Less(a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Less(a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5].Less(a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[4].Less(a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3].Less(a[0]) {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[5].Less(a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Less(a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[5].Less(a[2]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[3].Less(a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[4].Less(a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Less(a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7xPriorityReverse(a []Priority) {
+	_ = a[6]
+	if a[1].Less(a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0].Less(a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0].Less(a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Less(a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Less(a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3].Less(a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Less(a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4].Less(a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[0].Less(a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0].Less(a[3]) {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1].Less(a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2].Less(a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2].Less(a[5]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1].Less(a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Less(a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2].Less(a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort8xPriority(a []Priority) {
+	_ = a[7]
+	if a[1].Less(a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Less(a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[2].Less(a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[3].Less(a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Less(a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Less(a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Less(a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[6].Less(a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[7].Less(a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[6].Less(a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[4].Less(a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[5].Less(a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[4].Less(a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[6].Less(a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[7].Less(a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[6].Less(a[3]) {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4].Less(a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Less(a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Less(a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort8xPriorityReverse(a []Priority) {
+	_ = a[7]
+	if a[0].Less(a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Less(a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0].Less(a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Less(a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1].Less(a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4].Less(a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Less(a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4].Less(a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5].Less(a[7]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5].Less(a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0].Less(a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1].Less(a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1].Less(a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2].Less(a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3].Less(a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3].Less(a[6]) {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2].Less(a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Less(a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3].Less(a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort9xPriority(a []Priority) {
+	_ = a[8]
+	if a[6].Less(a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[5].Less(a[0]) {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[4].Less(a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[8].Less(a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[7].Less(a[0]) {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[2].Less(a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Less(a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Less(a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Less(a[5]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[3].Less(a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[8].Less(a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[1].Less(a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[5].Less(a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Less(a[2]) {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[7].Less(a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4].Less(a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Less(a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[2].Less(a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3].Less(a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Less(a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[5].Less(a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[4].Less(a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[6].Less(a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3].Less(a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Less(a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort9xPriorityReverse(a []Priority) {
+	_ = a[8]
+	if a[2].Less(a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[0].Less(a[5]) {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[1].Less(a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Less(a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[0].Less(a[7]) {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[1].Less(a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3].Less(a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Less(a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5].Less(a[8]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[1].Less(a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6].Less(a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[0].Less(a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4].Less(a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2].Less(a[7]) {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[3].Less(a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3].Less(a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Less(a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[1].Less(a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[1].Less(a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6].Less(a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4].Less(a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2].Less(a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Less(a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[2].Less(a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Less(a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort10xPriority(a []Priority) {
+	_ = a[9]
+	if a[4].Less(a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[8].Less(a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[3].Less(a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[6].Less(a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Less(a[0]) {
+		a[0], a[9] = a[9], a[0]
+	}
+	if a[5].Less(a[2]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[7].Less(a[0]) {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[9].Less(a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[6].Less(a[3]) {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[9].Less(a[4]) {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[1].Less(a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Less(a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[9].Less(a[6]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[5].Less(a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7].Less(a[4]) {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[8].Less(a[1]) {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[4].Less(a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[8].Less(a[5]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[7].Less(a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[2].Less(a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[8].Less(a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[3].Less(a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Less(a[2]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[6].Less(a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[3].Less(a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[7].Less(a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[5].Less(a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[4].Less(a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Less(a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort10xPriorityReverse(a []Priority) {
+	_ = a[9]
+	if a[1].Less(a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Less(a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[2].Less(a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Less(a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0].Less(a[9]) {
+		a[0], a[9] = a[9], a[0]
+	}
+	if a[2].Less(a[5]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[0].Less(a[7]) {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[8].Less(a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3].Less(a[6]) {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4].Less(a[9]) {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[0].Less(a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[0].Less(a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Less(a[9]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3].Less(a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Less(a[7]) {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[1].Less(a[8]) {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[3].Less(a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Less(a[8]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6].Less(a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[1].Less(a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[7].Less(a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[1].Less(a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Less(a[5]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[4].Less(a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[2].Less(a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[6].Less(a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4].Less(a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3].Less(a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Less(a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort11xPriority(a []Priority) {
+	_ = a[10]
+	if a[1].Less(a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Less(a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Less(a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Less(a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Less(a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3].Less(a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Less(a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[2].Less(a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Less(a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Less(a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[2].Less(a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[6].Less(a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Less(a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[5].Less(a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Less(a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[9].Less(a[5]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[6].Less(a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[5].Less(a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Less(a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[4].Less(a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7].Less(a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[8].Less(a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4].Less(a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[4].Less(a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[10].Less(a[7]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[8].Less(a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[3].Less(a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[9].Less(a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[4].Less(a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[9].Less(a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[5].Less(a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[8].Less(a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[4].Less(a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Less(a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Less(a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort11xPriorityReverse(a []Priority) {
+	_ = a[10]
+	if a[0].Less(a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Less(a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Less(a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Less(a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Less(a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[1].Less(a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Less(a[7]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[0].Less(a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Less(a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Less(a[10]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1].Less(a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Less(a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Less(a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1].Less(a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Less(a[10]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5].Less(a[9]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2].Less(a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1].Less(a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Less(a[10]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0].Less(a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3].Less(a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4].Less(a[8]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0].Less(a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1].Less(a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Less(a[10]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3].Less(a[8]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2].Less(a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8].Less(a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2].Less(a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7].Less(a[9]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Less(a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Less(a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3].Less(a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Less(a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Less(a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort12xPriority(a []Priority) {
+	_ = a[11]
+	if a[1].Less(a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Less(a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Less(a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Less(a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Less(a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Less(a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[3].Less(a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Less(a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[11].Less(a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[2].Less(a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Less(a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Less(a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[2].Less(a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[6].Less(a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Less(a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[5].Less(a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Less(a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[9].Less(a[5]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[6].Less(a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[5].Less(a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Less(a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[4].Less(a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[11].Less(a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[7].Less(a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[8].Less(a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4].Less(a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[11].Less(a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[4].Less(a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[10].Less(a[7]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[8].Less(a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[3].Less(a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[9].Less(a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[4].Less(a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[9].Less(a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[5].Less(a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[8].Less(a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[4].Less(a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Less(a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Less(a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort12xPriorityReverse(a []Priority) {
+	_ = a[11]
+	if a[0].Less(a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Less(a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Less(a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Less(a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Less(a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Less(a[11]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1].Less(a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Less(a[7]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9].Less(a[11]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0].Less(a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Less(a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Less(a[10]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1].Less(a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Less(a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Less(a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1].Less(a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Less(a[10]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5].Less(a[9]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2].Less(a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1].Less(a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Less(a[10]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0].Less(a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7].Less(a[11]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[3].Less(a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4].Less(a[8]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0].Less(a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7].Less(a[11]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1].Less(a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Less(a[10]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3].Less(a[8]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2].Less(a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8].Less(a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2].Less(a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7].Less(a[9]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Less(a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Less(a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3].Less(a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Less(a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Less(a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort13xPriority(a []Priority) {
+	_ = a[12]
+	if a[7].Less(a[1]) {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[11].Less(a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[4].Less(a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[8].Less(a[5]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[12].Less(a[0]) {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[6].Less(a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1].Less(a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Less(a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[6].Less(a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[11].Less(a[8]) {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[12].Less(a[7]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[9].Less(a[5]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2].Less(a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[7].Less(a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[11].Less(a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[4].Less(a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[12].Less(a[6]) {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[8].Less(a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[12].Less(a[11]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[9].Less(a[4]) {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[10].Less(a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[4].Less(a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Less(a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Less(a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Less(a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[7].Less(a[1]) {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[6].Less(a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[11].Less(a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[3].Less(a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Less(a[4]) {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[10].Less(a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[5].Less(a[0]) {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[5].Less(a[2]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[8].Less(a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10].Less(a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[2].Less(a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Less(a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[8].Less(a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[6].Less(a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[3].Less(a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Less(a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Less(a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Less(a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[4].Less(a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Less(a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort13xPriorityReverse(a []Priority) {
+	_ = a[12]
+	if a[1].Less(a[7]) {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[9].Less(a[11]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[3].Less(a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Less(a[8]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[0].Less(a[12]) {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[2].Less(a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[0].Less(a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Less(a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Less(a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Less(a[11]) {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[7].Less(a[12]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[5].Less(a[9]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[0].Less(a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[3].Less(a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[10].Less(a[11]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1].Less(a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[6].Less(a[12]) {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[7].Less(a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[11].Less(a[12]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[4].Less(a[9]) {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[6].Less(a[10]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[3].Less(a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Less(a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Less(a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Less(a[11]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1].Less(a[7]) {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[2].Less(a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[9].Less(a[11]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[1].Less(a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[4].Less(a[7]) {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[8].Less(a[10]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[0].Less(a[5]) {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[2].Less(a[5]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[6].Less(a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[9].Less(a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1].Less(a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3].Less(a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7].Less(a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[4].Less(a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[2].Less(a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Less(a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Less(a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Less(a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3].Less(a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Less(a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort14xPriority(a []Priority) {
+	_ = a[13]
+	if a[1].Less(a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Less(a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Less(a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Less(a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Less(a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Less(a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[13].Less(a[12]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[2].Less(a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Less(a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Less(a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[3].Less(a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Less(a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[11].Less(a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[4].Less(a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[12].Less(a[8]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[5].Less(a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[13].Less(a[9]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[6].Less(a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[7].Less(a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[8].Less(a[0]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[9].Less(a[1]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[10].Less(a[2]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[11].Less(a[3]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[12].Less(a[4]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[13].Less(a[5]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[10].Less(a[5]) {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[9].Less(a[6]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[12].Less(a[3]) {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[11].Less(a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[2].Less(a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[8].Less(a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4].Less(a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[13].Less(a[7]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[8].Less(a[2]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[4].Less(a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[6].Less(a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Less(a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[13].Less(a[11]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[8].Less(a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[12].Less(a[7]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[8].Less(a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[12].Less(a[10]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[5].Less(a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[9].Less(a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[4].Less(a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Less(a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Less(a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[10].Less(a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12].Less(a[11]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[7].Less(a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Less(a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort14xPriorityReverse(a []Priority) {
+	_ = a[13]
+	if a[0].Less(a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Less(a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Less(a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Less(a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Less(a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Less(a[11]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12].Less(a[13]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[0].Less(a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Less(a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Less(a[10]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1].Less(a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Less(a[7]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9].Less(a[11]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0].Less(a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8].Less(a[12]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1].Less(a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9].Less(a[13]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2].Less(a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3].Less(a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[0].Less(a[8]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1].Less(a[9]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2].Less(a[10]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3].Less(a[11]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4].Less(a[12]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5].Less(a[13]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[5].Less(a[10]) {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6].Less(a[9]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3].Less(a[12]) {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[7].Less(a[11]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1].Less(a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4].Less(a[8]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1].Less(a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Less(a[13]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2].Less(a[8]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[2].Less(a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Less(a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Less(a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Less(a[13]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3].Less(a[8]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7].Less(a[12]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6].Less(a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10].Less(a[12]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3].Less(a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7].Less(a[9]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Less(a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Less(a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Less(a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9].Less(a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Less(a[12]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6].Less(a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Less(a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort15xPriority(a []Priority) {
+	_ = a[14]
+	if a[1].Less(a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Less(a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Less(a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Less(a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Less(a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Less(a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[13].Less(a[12]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[2].Less(a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Less(a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Less(a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[14].Less(a[12]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[3].Less(a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Less(a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[11].Less(a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[4].Less(a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[12].Less(a[8]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[5].Less(a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[13].Less(a[9]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[6].Less(a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[14].Less(a[10]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[7].Less(a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[8].Less(a[0]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[9].Less(a[1]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[10].Less(a[2]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[11].Less(a[3]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[12].Less(a[4]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[13].Less(a[5]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[14].Less(a[6]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[10].Less(a[5]) {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[9].Less(a[6]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[12].Less(a[3]) {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[14].Less(a[13]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[11].Less(a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[2].Less(a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[8].Less(a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4].Less(a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[13].Less(a[7]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[8].Less(a[2]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[14].Less(a[11]) {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[4].Less(a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[6].Less(a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Less(a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[13].Less(a[11]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[8].Less(a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[12].Less(a[7]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[8].Less(a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[12].Less(a[10]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[5].Less(a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[9].Less(a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[4].Less(a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Less(a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Less(a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[10].Less(a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12].Less(a[11]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[7].Less(a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Less(a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort15xPriorityReverse(a []Priority) {
+	_ = a[14]
+	if a[0].Less(a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Less(a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Less(a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Less(a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Less(a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Less(a[11]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12].Less(a[13]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[0].Less(a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Less(a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Less(a[10]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[12].Less(a[14]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[1].Less(a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Less(a[7]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9].Less(a[11]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0].Less(a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8].Less(a[12]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1].Less(a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9].Less(a[13]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2].Less(a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[10].Less(a[14]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[3].Less(a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[0].Less(a[8]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1].Less(a[9]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2].Less(a[10]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3].Less(a[11]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4].Less(a[12]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5].Less(a[13]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6].Less(a[14]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[5].Less(a[10]) {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6].Less(a[9]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3].Less(a[12]) {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[13].Less(a[14]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[7].Less(a[11]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1].Less(a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4].Less(a[8]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1].Less(a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Less(a[13]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2].Less(a[8]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[11].Less(a[14]) {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[2].Less(a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Less(a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Less(a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Less(a[13]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3].Less(a[8]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7].Less(a[12]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6].Less(a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10].Less(a[12]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3].Less(a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7].Less(a[9]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Less(a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Less(a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Less(a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9].Less(a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Less(a[12]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6].Less(a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Less(a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort16xPriority(a []Priority) {
+	_ = a[15]
+	if a[1].Less(a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Less(a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Less(a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Less(a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Less(a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Less(a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[13].Less(a[12]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[15].Less(a[14]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[2].Less(a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Less(a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Less(a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[14].Less(a[12]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[3].Less(a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Less(a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[11].Less(a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[15].Less(a[13]) {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[4].Less(a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[12].Less(a[8]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[5].Less(a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[13].Less(a[9]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[6].Less(a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[14].Less(a[10]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[7].Less(a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[15].Less(a[11]) {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[8].Less(a[0]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[9].Less(a[1]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[10].Less(a[2]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[11].Less(a[3]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[12].Less(a[4]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[13].Less(a[5]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[14].Less(a[6]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[15].Less(a[7]) {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[10].Less(a[5]) {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[9].Less(a[6]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[12].Less(a[3]) {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[14].Less(a[13]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[11].Less(a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[2].Less(a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[8].Less(a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4].Less(a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[13].Less(a[7]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[8].Less(a[2]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[14].Less(a[11]) {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[4].Less(a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[6].Less(a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Less(a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[13].Less(a[11]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[8].Less(a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[12].Less(a[7]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[8].Less(a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[12].Less(a[10]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[5].Less(a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[9].Less(a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[4].Less(a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Less(a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Less(a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[10].Less(a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12].Less(a[11]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[7].Less(a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Less(a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort16xPriorityReverse(a []Priority) {
+	_ = a[15]
+	if a[0].Less(a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Less(a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Less(a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Less(a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Less(a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Less(a[11]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12].Less(a[13]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14].Less(a[15]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[0].Less(a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Less(a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Less(a[10]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[12].Less(a[14]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[1].Less(a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Less(a[7]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9].Less(a[11]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[13].Less(a[15]) {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[0].Less(a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8].Less(a[12]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1].Less(a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9].Less(a[13]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2].Less(a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[10].Less(a[14]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[3].Less(a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[11].Less(a[15]) {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[0].Less(a[8]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1].Less(a[9]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2].Less(a[10]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3].Less(a[11]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4].Less(a[12]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5].Less(a[13]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6].Less(a[14]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7].Less(a[15]) {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[5].Less(a[10]) {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6].Less(a[9]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3].Less(a[12]) {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[13].Less(a[14]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[7].Less(a[11]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1].Less(a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4].Less(a[8]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1].Less(a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Less(a[13]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2].Less(a[8]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[11].Less(a[14]) {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[2].Less(a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Less(a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Less(a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Less(a[13]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3].Less(a[8]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7].Less(a[12]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6].Less(a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10].Less(a[12]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3].Less(a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7].Less(a[9]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Less(a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Less(a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Less(a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9].Less(a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Less(a[12]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6].Less(a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Less(a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort24xPriority(a []Priority) {
+	_ = a[23]
+	if a[1].Less(a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Less(a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Less(a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Less(a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Less(a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Less(a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[13].Less(a[12]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[15].Less(a[14]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[17].Less(a[16]) {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[19].Less(a[18]) {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[21].Less(a[20]) {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[23].Less(a[22]) {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[3].Less(a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Less(a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[11].Less(a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[2].Less(a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Less(a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Less(a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[15].Less(a[13]) {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[19].Less(a[17]) {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[23].Less(a[21]) {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[14].Less(a[12]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[18].Less(a[16]) {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[22].Less(a[20]) {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[2].Less(a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[6].Less(a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Less(a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[14].Less(a[13]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[18].Less(a[17]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[22].Less(a[21]) {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[5].Less(a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Less(a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[17].Less(a[13]) {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[22].Less(a[18]) {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[9].Less(a[5]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[6].Less(a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[21].Less(a[17]) {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[18].Less(a[14]) {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[5].Less(a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Less(a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[4].Less(a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[11].Less(a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[17].Less(a[13]) {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[22].Less(a[18]) {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[16].Less(a[12]) {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[23].Less(a[19]) {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[7].Less(a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[8].Less(a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[19].Less(a[15]) {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[20].Less(a[16]) {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[4].Less(a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[11].Less(a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[16].Less(a[12]) {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[23].Less(a[19]) {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[4].Less(a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[10].Less(a[7]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[8].Less(a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[16].Less(a[13]) {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[22].Less(a[19]) {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[20].Less(a[15]) {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[3].Less(a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[9].Less(a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[15].Less(a[14]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[21].Less(a[20]) {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[4].Less(a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[9].Less(a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[5].Less(a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[8].Less(a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[16].Less(a[14]) {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[21].Less(a[19]) {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[17].Less(a[15]) {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[20].Less(a[18]) {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[4].Less(a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Less(a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Less(a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[16].Less(a[15]) {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[18].Less(a[17]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[20].Less(a[19]) {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[12].Less(a[0]) {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[13].Less(a[1]) {
+		a[1], a[13] = a[13], a[1]
+	}
+	if a[14].Less(a[2]) {
+		a[2], a[14] = a[14], a[2]
+	}
+	if a[15].Less(a[3]) {
+		a[3], a[15] = a[15], a[3]
+	}
+	if a[16].Less(a[4]) {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[17].Less(a[5]) {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[18].Less(a[6]) {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[19].Less(a[7]) {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[20].Less(a[8]) {
+		a[8], a[20] = a[20], a[8]
+	}
+	if a[21].Less(a[9]) {
+		a[9], a[21] = a[21], a[9]
+	}
+	if a[22].Less(a[10]) {
+		a[10], a[22] = a[22], a[10]
+	}
+	if a[23].Less(a[11]) {
+		a[11], a[23] = a[23], a[11]
+	}
+	if a[12].Less(a[2]) {
+		a[2], a[12] = a[12], a[2]
+	}
+	if a[13].Less(a[3]) {
+		a[3], a[13] = a[13], a[3]
+	}
+	if a[20].Less(a[10]) {
+		a[10], a[20] = a[20], a[10]
+	}
+	if a[21].Less(a[11]) {
+		a[11], a[21] = a[21], a[11]
+	}
+	if a[12].Less(a[4]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[13].Less(a[5]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[14].Less(a[6]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[15].Less(a[7]) {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[16].Less(a[8]) {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[17].Less(a[9]) {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[18].Less(a[10]) {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[19].Less(a[11]) {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[12].Less(a[8]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[13].Less(a[9]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[14].Less(a[10]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[15].Less(a[11]) {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[8].Less(a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[12].Less(a[10]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[16].Less(a[14]) {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[9].Less(a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[13].Less(a[11]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[17].Less(a[15]) {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[2].Less(a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4].Less(a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Less(a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Less(a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[10].Less(a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12].Less(a[11]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[14].Less(a[13]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[16].Less(a[15]) {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[18].Less(a[17]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[20].Less(a[19]) {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[22].Less(a[21]) {
+		a[21], a[22] = a[22], a[21]
+	}
+}
+
+func NetworkSort24xPriorityReverse(a []Priority) {
+	_ = a[23]
+	if a[0].Less(a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Less(a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Less(a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Less(a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Less(a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Less(a[11]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12].Less(a[13]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14].Less(a[15]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[16].Less(a[17]) {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[18].Less(a[19]) {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[20].Less(a[21]) {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[22].Less(a[23]) {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[1].Less(a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Less(a[7]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9].Less(a[11]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0].Less(a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Less(a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Less(a[10]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[13].Less(a[15]) {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[17].Less(a[19]) {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[21].Less(a[23]) {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[12].Less(a[14]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[16].Less(a[18]) {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[20].Less(a[22]) {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[1].Less(a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Less(a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Less(a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[13].Less(a[14]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[17].Less(a[18]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[21].Less(a[22]) {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[1].Less(a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Less(a[10]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[13].Less(a[17]) {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[18].Less(a[22]) {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[5].Less(a[9]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2].Less(a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[17].Less(a[21]) {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[14].Less(a[18]) {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[1].Less(a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Less(a[10]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0].Less(a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7].Less(a[11]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[13].Less(a[17]) {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[18].Less(a[22]) {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[12].Less(a[16]) {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[19].Less(a[23]) {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[3].Less(a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4].Less(a[8]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[15].Less(a[19]) {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[16].Less(a[20]) {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[0].Less(a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7].Less(a[11]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[12].Less(a[16]) {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[19].Less(a[23]) {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[1].Less(a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Less(a[10]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3].Less(a[8]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[13].Less(a[16]) {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[19].Less(a[22]) {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[15].Less(a[20]) {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[2].Less(a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8].Less(a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[14].Less(a[15]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[20].Less(a[21]) {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[2].Less(a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7].Less(a[9]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Less(a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Less(a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[14].Less(a[16]) {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[19].Less(a[21]) {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[15].Less(a[17]) {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[18].Less(a[20]) {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[3].Less(a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Less(a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Less(a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[15].Less(a[16]) {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[17].Less(a[18]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[19].Less(a[20]) {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[0].Less(a[12]) {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[1].Less(a[13]) {
+		a[1], a[13] = a[13], a[1]
+	}
+	if a[2].Less(a[14]) {
+		a[2], a[14] = a[14], a[2]
+	}
+	if a[3].Less(a[15]) {
+		a[3], a[15] = a[15], a[3]
+	}
+	if a[4].Less(a[16]) {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[5].Less(a[17]) {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[6].Less(a[18]) {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[7].Less(a[19]) {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[8].Less(a[20]) {
+		a[8], a[20] = a[20], a[8]
+	}
+	if a[9].Less(a[21]) {
+		a[9], a[21] = a[21], a[9]
+	}
+	if a[10].Less(a[22]) {
+		a[10], a[22] = a[22], a[10]
+	}
+	if a[11].Less(a[23]) {
+		a[11], a[23] = a[23], a[11]
+	}
+	if a[2].Less(a[12]) {
+		a[2], a[12] = a[12], a[2]
+	}
+	if a[3].Less(a[13]) {
+		a[3], a[13] = a[13], a[3]
+	}
+	if a[10].Less(a[20]) {
+		a[10], a[20] = a[20], a[10]
+	}
+	if a[11].Less(a[21]) {
+		a[11], a[21] = a[21], a[11]
+	}
+	if a[4].Less(a[12]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5].Less(a[13]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6].Less(a[14]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7].Less(a[15]) {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[8].Less(a[16]) {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[9].Less(a[17]) {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[10].Less(a[18]) {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[11].Less(a[19]) {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[8].Less(a[12]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9].Less(a[13]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[10].Less(a[14]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11].Less(a[15]) {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[6].Less(a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10].Less(a[12]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[14].Less(a[16]) {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[7].Less(a[9]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[11].Less(a[13]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[15].Less(a[17]) {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[1].Less(a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3].Less(a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Less(a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Less(a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9].Less(a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Less(a[12]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[13].Less(a[14]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[15].Less(a[16]) {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[17].Less(a[18]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[19].Less(a[20]) {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[21].Less(a[22]) {
+		a[21], a[22] = a[22], a[21]
+	}
+}
+
+func NetworkSort32xPriority(a []Priority) {
+	_ = a[31]
+	if a[1].Less(a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Less(a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[2].Less(a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[3].Less(a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Less(a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Less(a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Less(a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[6].Less(a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[7].Less(a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[6].Less(a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[4].Less(a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[5].Less(a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[4].Less(a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[6].Less(a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[7].Less(a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[6].Less(a[3]) {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4].Less(a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Less(a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Less(a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[9].Less(a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Less(a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[10].Less(a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[11].Less(a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[10].Less(a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[13].Less(a[12]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[15].Less(a[14]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[14].Less(a[12]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[15].Less(a[13]) {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[14].Less(a[13]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[12].Less(a[8]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[13].Less(a[9]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[12].Less(a[9]) {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[14].Less(a[10]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[15].Less(a[11]) {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[14].Less(a[11]) {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[12].Less(a[10]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[13].Less(a[11]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[12].Less(a[11]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[8].Less(a[0]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[9].Less(a[1]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[8].Less(a[1]) {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[10].Less(a[2]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[11].Less(a[3]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[10].Less(a[3]) {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[8].Less(a[2]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[9].Less(a[3]) {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[8].Less(a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[12].Less(a[4]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[13].Less(a[5]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[12].Less(a[5]) {
+		a[5], a[12] = a[12], a[5]
+	}
+	if a[14].Less(a[6]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[15].Less(a[7]) {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[14].Less(a[7]) {
+		a[7], a[14] = a[14], a[7]
+	}
+	if a[12].Less(a[6]) {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[13].Less(a[7]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[12].Less(a[7]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[8].Less(a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[9].Less(a[5]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[8].Less(a[5]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[10].Less(a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[11].Less(a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[10].Less(a[7]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[8].Less(a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[9].Less(a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[8].Less(a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[17].Less(a[16]) {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[19].Less(a[18]) {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[18].Less(a[16]) {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[19].Less(a[17]) {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[18].Less(a[17]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[21].Less(a[20]) {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[23].Less(a[22]) {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[22].Less(a[20]) {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[23].Less(a[21]) {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[22].Less(a[21]) {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[20].Less(a[16]) {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[21].Less(a[17]) {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[20].Less(a[17]) {
+		a[17], a[20] = a[20], a[17]
+	}
+	if a[22].Less(a[18]) {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[23].Less(a[19]) {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[22].Less(a[19]) {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[20].Less(a[18]) {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[21].Less(a[19]) {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[20].Less(a[19]) {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[25].Less(a[24]) {
+		a[24], a[25] = a[25], a[24]
+	}
+	if a[27].Less(a[26]) {
+		a[26], a[27] = a[27], a[26]
+	}
+	if a[26].Less(a[24]) {
+		a[24], a[26] = a[26], a[24]
+	}
+	if a[27].Less(a[25]) {
+		a[25], a[27] = a[27], a[25]
+	}
+	if a[26].Less(a[25]) {
+		a[25], a[26] = a[26], a[25]
+	}
+	if a[29].Less(a[28]) {
+		a[28], a[29] = a[29], a[28]
+	}
+	if a[31].Less(a[30]) {
+		a[30], a[31] = a[31], a[30]
+	}
+	if a[30].Less(a[28]) {
+		a[28], a[30] = a[30], a[28]
+	}
+	if a[31].Less(a[29]) {
+		a[29], a[31] = a[31], a[29]
+	}
+	if a[30].Less(a[29]) {
+		a[29], a[30] = a[30], a[29]
+	}
+	if a[28].Less(a[24]) {
+		a[24], a[28] = a[28], a[24]
+	}
+	if a[29].Less(a[25]) {
+		a[25], a[29] = a[29], a[25]
+	}
+	if a[28].Less(a[25]) {
+		a[25], a[28] = a[28], a[25]
+	}
+	if a[30].Less(a[26]) {
+		a[26], a[30] = a[30], a[26]
+	}
+	if a[31].Less(a[27]) {
+		a[27], a[31] = a[31], a[27]
+	}
+	if a[30].Less(a[27]) {
+		a[27], a[30] = a[30], a[27]
+	}
+	if a[28].Less(a[26]) {
+		a[26], a[28] = a[28], a[26]
+	}
+	if a[29].Less(a[27]) {
+		a[27], a[29] = a[29], a[27]
+	}
+	if a[28].Less(a[27]) {
+		a[27], a[28] = a[28], a[27]
+	}
+	if a[24].Less(a[16]) {
+		a[16], a[24] = a[24], a[16]
+	}
+	if a[25].Less(a[17]) {
+		a[17], a[25] = a[25], a[17]
+	}
+	if a[24].Less(a[17]) {
+		a[17], a[24] = a[24], a[17]
+	}
+	if a[26].Less(a[18]) {
+		a[18], a[26] = a[26], a[18]
+	}
+	if a[27].Less(a[19]) {
+		a[19], a[27] = a[27], a[19]
+	}
+	if a[26].Less(a[19]) {
+		a[19], a[26] = a[26], a[19]
+	}
+	if a[24].Less(a[18]) {
+		a[18], a[24] = a[24], a[18]
+	}
+	if a[25].Less(a[19]) {
+		a[19], a[25] = a[25], a[19]
+	}
+	if a[24].Less(a[19]) {
+		a[19], a[24] = a[24], a[19]
+	}
+	if a[28].Less(a[20]) {
+		a[20], a[28] = a[28], a[20]
+	}
+	if a[29].Less(a[21]) {
+		a[21], a[29] = a[29], a[21]
+	}
+	if a[28].Less(a[21]) {
+		a[21], a[28] = a[28], a[21]
+	}
+	if a[30].Less(a[22]) {
+		a[22], a[30] = a[30], a[22]
+	}
+	if a[31].Less(a[23]) {
+		a[23], a[31] = a[31], a[23]
+	}
+	if a[30].Less(a[23]) {
+		a[23], a[30] = a[30], a[23]
+	}
+	if a[28].Less(a[22]) {
+		a[22], a[28] = a[28], a[22]
+	}
+	if a[29].Less(a[23]) {
+		a[23], a[29] = a[29], a[23]
+	}
+	if a[28].Less(a[23]) {
+		a[23], a[28] = a[28], a[23]
+	}
+	if a[24].Less(a[20]) {
+		a[20], a[24] = a[24], a[20]
+	}
+	if a[25].Less(a[21]) {
+		a[21], a[25] = a[25], a[21]
+	}
+	if a[24].Less(a[21]) {
+		a[21], a[24] = a[24], a[21]
+	}
+	if a[26].Less(a[22]) {
+		a[22], a[26] = a[26], a[22]
+	}
+	if a[27].Less(a[23]) {
+		a[23], a[27] = a[27], a[23]
+	}
+	if a[26].Less(a[23]) {
+		a[23], a[26] = a[26], a[23]
+	}
+	if a[24].Less(a[22]) {
+		a[22], a[24] = a[24], a[22]
+	}
+	if a[25].Less(a[23]) {
+		a[23], a[25] = a[25], a[23]
+	}
+	if a[24].Less(a[23]) {
+		a[23], a[24] = a[24], a[23]
+	}
+	if a[16].Less(a[0]) {
+		a[0], a[16] = a[16], a[0]
+	}
+	if a[17].Less(a[1]) {
+		a[1], a[17] = a[17], a[1]
+	}
+	if a[16].Less(a[1]) {
+		a[1], a[16] = a[16], a[1]
+	}
+	if a[18].Less(a[2]) {
+		a[2], a[18] = a[18], a[2]
+	}
+	if a[19].Less(a[3]) {
+		a[3], a[19] = a[19], a[3]
+	}
+	if a[18].Less(a[3]) {
+		a[3], a[18] = a[18], a[3]
+	}
+	if a[16].Less(a[2]) {
+		a[2], a[16] = a[16], a[2]
+	}
+	if a[17].Less(a[3]) {
+		a[3], a[17] = a[17], a[3]
+	}
+	if a[16].Less(a[3]) {
+		a[3], a[16] = a[16], a[3]
+	}
+	if a[20].Less(a[4]) {
+		a[4], a[20] = a[20], a[4]
+	}
+	if a[21].Less(a[5]) {
+		a[5], a[21] = a[21], a[5]
+	}
+	if a[20].Less(a[5]) {
+		a[5], a[20] = a[20], a[5]
+	}
+	if a[22].Less(a[6]) {
+		a[6], a[22] = a[22], a[6]
+	}
+	if a[23].Less(a[7]) {
+		a[7], a[23] = a[23], a[7]
+	}
+	if a[22].Less(a[7]) {
+		a[7], a[22] = a[22], a[7]
+	}
+	if a[20].Less(a[6]) {
+		a[6], a[20] = a[20], a[6]
+	}
+	if a[21].Less(a[7]) {
+		a[7], a[21] = a[21], a[7]
+	}
+	if a[20].Less(a[7]) {
+		a[7], a[20] = a[20], a[7]
+	}
+	if a[16].Less(a[4]) {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[17].Less(a[5]) {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[16].Less(a[5]) {
+		a[5], a[16] = a[16], a[5]
+	}
+	if a[18].Less(a[6]) {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[19].Less(a[7]) {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[18].Less(a[7]) {
+		a[7], a[18] = a[18], a[7]
+	}
+	if a[16].Less(a[6]) {
+		a[6], a[16] = a[16], a[6]
+	}
+	if a[17].Less(a[7]) {
+		a[7], a[17] = a[17], a[7]
+	}
+	if a[16].Less(a[7]) {
+		a[7], a[16] = a[16], a[7]
+	}
+	if a[24].Less(a[8]) {
+		a[8], a[24] = a[24], a[8]
+	}
+	if a[25].Less(a[9]) {
+		a[9], a[25] = a[25], a[9]
+	}
+	if a[24].Less(a[9]) {
+		a[9], a[24] = a[24], a[9]
+	}
+	if a[26].Less(a[10]) {
+		a[10], a[26] = a[26], a[10]
+	}
+	if a[27].Less(a[11]) {
+		a[11], a[27] = a[27], a[11]
+	}
+	if a[26].Less(a[11]) {
+		a[11], a[26] = a[26], a[11]
+	}
+	if a[24].Less(a[10]) {
+		a[10], a[24] = a[24], a[10]
+	}
+	if a[25].Less(a[11]) {
+		a[11], a[25] = a[25], a[11]
+	}
+	if a[24].Less(a[11]) {
+		a[11], a[24] = a[24], a[11]
+	}
+	if a[28].Less(a[12]) {
+		a[12], a[28] = a[28], a[12]
+	}
+	if a[29].Less(a[13]) {
+		a[13], a[29] = a[29], a[13]
+	}
+	if a[28].Less(a[13]) {
+		a[13], a[28] = a[28], a[13]
+	}
+	if a[30].Less(a[14]) {
+		a[14], a[30] = a[30], a[14]
+	}
+	if a[31].Less(a[15]) {
+		a[15], a[31] = a[31], a[15]
+	}
+	if a[30].Less(a[15]) {
+		a[15], a[30] = a[30], a[15]
+	}
+	if a[28].Less(a[14]) {
+		a[14], a[28] = a[28], a[14]
+	}
+	if a[29].Less(a[15]) {
+		a[15], a[29] = a[29], a[15]
+	}
+	if a[28].Less(a[15]) {
+		a[15], a[28] = a[28], a[15]
+	}
+	if a[24].Less(a[12]) {
+		a[12], a[24] = a[24], a[12]
+	}
+	if a[25].Less(a[13]) {
+		a[13], a[25] = a[25], a[13]
+	}
+	if a[24].Less(a[13]) {
+		a[13], a[24] = a[24], a[13]
+	}
+	if a[26].Less(a[14]) {
+		a[14], a[26] = a[26], a[14]
+	}
+	if a[27].Less(a[15]) {
+		a[15], a[27] = a[27], a[15]
+	}
+	if a[26].Less(a[15]) {
+		a[15], a[26] = a[26], a[15]
+	}
+	if a[24].Less(a[14]) {
+		a[14], a[24] = a[24], a[14]
+	}
+	if a[25].Less(a[15]) {
+		a[15], a[25] = a[25], a[15]
+	}
+	if a[24].Less(a[15]) {
+		a[15], a[24] = a[24], a[15]
+	}
+	if a[16].Less(a[8]) {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[17].Less(a[9]) {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[16].Less(a[9]) {
+		a[9], a[16] = a[16], a[9]
+	}
+	if a[18].Less(a[10]) {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[19].Less(a[11]) {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[18].Less(a[11]) {
+		a[11], a[18] = a[18], a[11]
+	}
+	if a[16].Less(a[10]) {
+		a[10], a[16] = a[16], a[10]
+	}
+	if a[17].Less(a[11]) {
+		a[11], a[17] = a[17], a[11]
+	}
+	if a[16].Less(a[11]) {
+		a[11], a[16] = a[16], a[11]
+	}
+	if a[20].Less(a[12]) {
+		a[12], a[20] = a[20], a[12]
+	}
+	if a[21].Less(a[13]) {
+		a[13], a[21] = a[21], a[13]
+	}
+	if a[20].Less(a[13]) {
+		a[13], a[20] = a[20], a[13]
+	}
+	if a[22].Less(a[14]) {
+		a[14], a[22] = a[22], a[14]
+	}
+	if a[23].Less(a[15]) {
+		a[15], a[23] = a[23], a[15]
+	}
+	if a[22].Less(a[15]) {
+		a[15], a[22] = a[22], a[15]
+	}
+	if a[20].Less(a[14]) {
+		a[14], a[20] = a[20], a[14]
+	}
+	if a[21].Less(a[15]) {
+		a[15], a[21] = a[21], a[15]
+	}
+	if a[20].Less(a[15]) {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[16].Less(a[12]) {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[17].Less(a[13]) {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[16].Less(a[13]) {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[18].Less(a[14]) {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[19].Less(a[15]) {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[18].Less(a[15]) {
+		a[15], a[18] = a[18], a[15]
+	}
+	if a[16].Less(a[14]) {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[17].Less(a[15]) {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[16].Less(a[15]) {
+		a[15], a[16] = a[16], a[15]
+	}
+}
+
+func NetworkSort32xPriorityReverse(a []Priority) {
+	_ = a[31]
+	if a[0].Less(a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Less(a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0].Less(a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Less(a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1].Less(a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4].Less(a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Less(a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4].Less(a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5].Less(a[7]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5].Less(a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0].Less(a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1].Less(a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1].Less(a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2].Less(a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3].Less(a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3].Less(a[6]) {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2].Less(a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Less(a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3].Less(a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[8].Less(a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Less(a[11]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[8].Less(a[10]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[9].Less(a[11]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[9].Less(a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12].Less(a[13]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14].Less(a[15]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[12].Less(a[14]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[13].Less(a[15]) {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[13].Less(a[14]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[8].Less(a[12]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9].Less(a[13]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[9].Less(a[12]) {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[10].Less(a[14]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11].Less(a[15]) {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[11].Less(a[14]) {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[10].Less(a[12]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[11].Less(a[13]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[11].Less(a[12]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[0].Less(a[8]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1].Less(a[9]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[1].Less(a[8]) {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[2].Less(a[10]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3].Less(a[11]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[3].Less(a[10]) {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[2].Less(a[8]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[3].Less(a[9]) {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[3].Less(a[8]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[4].Less(a[12]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5].Less(a[13]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[5].Less(a[12]) {
+		a[5], a[12] = a[12], a[5]
+	}
+	if a[6].Less(a[14]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7].Less(a[15]) {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[7].Less(a[14]) {
+		a[7], a[14] = a[14], a[7]
+	}
+	if a[6].Less(a[12]) {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[7].Less(a[13]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[7].Less(a[12]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[4].Less(a[8]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[5].Less(a[9]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[5].Less(a[8]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6].Less(a[10]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[7].Less(a[11]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[7].Less(a[10]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[6].Less(a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[7].Less(a[9]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[7].Less(a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[16].Less(a[17]) {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[18].Less(a[19]) {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[16].Less(a[18]) {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[17].Less(a[19]) {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[17].Less(a[18]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[20].Less(a[21]) {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[22].Less(a[23]) {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[20].Less(a[22]) {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[21].Less(a[23]) {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[21].Less(a[22]) {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[16].Less(a[20]) {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[17].Less(a[21]) {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[17].Less(a[20]) {
+		a[17], a[20] = a[20], a[17]
+	}
+	if a[18].Less(a[22]) {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[19].Less(a[23]) {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[19].Less(a[22]) {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[18].Less(a[20]) {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[19].Less(a[21]) {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[19].Less(a[20]) {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[24].Less(a[25]) {
+		a[24], a[25] = a[25], a[24]
+	}
+	if a[26].Less(a[27]) {
+		a[26], a[27] = a[27], a[26]
+	}
+	if a[24].Less(a[26]) {
+		a[24], a[26] = a[26], a[24]
+	}
+	if a[25].Less(a[27]) {
+		a[25], a[27] = a[27], a[25]
+	}
+	if a[25].Less(a[26]) {
+		a[25], a[26] = a[26], a[25]
+	}
+	if a[28].Less(a[29]) {
+		a[28], a[29] = a[29], a[28]
+	}
+	if a[30].Less(a[31]) {
+		a[30], a[31] = a[31], a[30]
+	}
+	if a[28].Less(a[30]) {
+		a[28], a[30] = a[30], a[28]
+	}
+	if a[29].Less(a[31]) {
+		a[29], a[31] = a[31], a[29]
+	}
+	if a[29].Less(a[30]) {
+		a[29], a[30] = a[30], a[29]
+	}
+	if a[24].Less(a[28]) {
+		a[24], a[28] = a[28], a[24]
+	}
+	if a[25].Less(a[29]) {
+		a[25], a[29] = a[29], a[25]
+	}
+	if a[25].Less(a[28]) {
+		a[25], a[28] = a[28], a[25]
+	}
+	if a[26].Less(a[30]) {
+		a[26], a[30] = a[30], a[26]
+	}
+	if a[27].Less(a[31]) {
+		a[27], a[31] = a[31], a[27]
+	}
+	if a[27].Less(a[30]) {
+		a[27], a[30] = a[30], a[27]
+	}
+	if a[26].Less(a[28]) {
+		a[26], a[28] = a[28], a[26]
+	}
+	if a[27].Less(a[29]) {
+		a[27], a[29] = a[29], a[27]
+	}
+	if a[27].Less(a[28]) {
+		a[27], a[28] = a[28], a[27]
+	}
+	if a[16].Less(a[24]) {
+		a[16], a[24] = a[24], a[16]
+	}
+	if a[17].Less(a[25]) {
+		a[17], a[25] = a[25], a[17]
+	}
+	if a[17].Less(a[24]) {
+		a[17], a[24] = a[24], a[17]
+	}
+	if a[18].Less(a[26]) {
+		a[18], a[26] = a[26], a[18]
+	}
+	if a[19].Less(a[27]) {
+		a[19], a[27] = a[27], a[19]
+	}
+	if a[19].Less(a[26]) {
+		a[19], a[26] = a[26], a[19]
+	}
+	if a[18].Less(a[24]) {
+		a[18], a[24] = a[24], a[18]
+	}
+	if a[19].Less(a[25]) {
+		a[19], a[25] = a[25], a[19]
+	}
+	if a[19].Less(a[24]) {
+		a[19], a[24] = a[24], a[19]
+	}
+	if a[20].Less(a[28]) {
+		a[20], a[28] = a[28], a[20]
+	}
+	if a[21].Less(a[29]) {
+		a[21], a[29] = a[29], a[21]
+	}
+	if a[21].Less(a[28]) {
+		a[21], a[28] = a[28], a[21]
+	}
+	if a[22].Less(a[30]) {
+		a[22], a[30] = a[30], a[22]
+	}
+	if a[23].Less(a[31]) {
+		a[23], a[31] = a[31], a[23]
+	}
+	if a[23].Less(a[30]) {
+		a[23], a[30] = a[30], a[23]
+	}
+	if a[22].Less(a[28]) {
+		a[22], a[28] = a[28], a[22]
+	}
+	if a[23].Less(a[29]) {
+		a[23], a[29] = a[29], a[23]
+	}
+	if a[23].Less(a[28]) {
+		a[23], a[28] = a[28], a[23]
+	}
+	if a[20].Less(a[24]) {
+		a[20], a[24] = a[24], a[20]
+	}
+	if a[21].Less(a[25]) {
+		a[21], a[25] = a[25], a[21]
+	}
+	if a[21].Less(a[24]) {
+		a[21], a[24] = a[24], a[21]
+	}
+	if a[22].Less(a[26]) {
+		a[22], a[26] = a[26], a[22]
+	}
+	if a[23].Less(a[27]) {
+		a[23], a[27] = a[27], a[23]
+	}
+	if a[23].Less(a[26]) {
+		a[23], a[26] = a[26], a[23]
+	}
+	if a[22].Less(a[24]) {
+		a[22], a[24] = a[24], a[22]
+	}
+	if a[23].Less(a[25]) {
+		a[23], a[25] = a[25], a[23]
+	}
+	if a[23].Less(a[24]) {
+		a[23], a[24] = a[24], a[23]
+	}
+	if a[0].Less(a[16]) {
+		a[0], a[16] = a[16], a[0]
+	}
+	if a[1].Less(a[17]) {
+		a[1], a[17] = a[17], a[1]
+	}
+	if a[1].Less(a[16]) {
+		a[1], a[16] = a[16], a[1]
+	}
+	if a[2].Less(a[18]) {
+		a[2], a[18] = a[18], a[2]
+	}
+	if a[3].Less(a[19]) {
+		a[3], a[19] = a[19], a[3]
+	}
+	if a[3].Less(a[18]) {
+		a[3], a[18] = a[18], a[3]
+	}
+	if a[2].Less(a[16]) {
+		a[2], a[16] = a[16], a[2]
+	}
+	if a[3].Less(a[17]) {
+		a[3], a[17] = a[17], a[3]
+	}
+	if a[3].Less(a[16]) {
+		a[3], a[16] = a[16], a[3]
+	}
+	if a[4].Less(a[20]) {
+		a[4], a[20] = a[20], a[4]
+	}
+	if a[5].Less(a[21]) {
+		a[5], a[21] = a[21], a[5]
+	}
+	if a[5].Less(a[20]) {
+		a[5], a[20] = a[20], a[5]
+	}
+	if a[6].Less(a[22]) {
+		a[6], a[22] = a[22], a[6]
+	}
+	if a[7].Less(a[23]) {
+		a[7], a[23] = a[23], a[7]
+	}
+	if a[7].Less(a[22]) {
+		a[7], a[22] = a[22], a[7]
+	}
+	if a[6].Less(a[20]) {
+		a[6], a[20] = a[20], a[6]
+	}
+	if a[7].Less(a[21]) {
+		a[7], a[21] = a[21], a[7]
+	}
+	if a[7].Less(a[20]) {
+		a[7], a[20] = a[20], a[7]
+	}
+	if a[4].Less(a[16]) {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[5].Less(a[17]) {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[5].Less(a[16]) {
+		a[5], a[16] = a[16], a[5]
+	}
+	if a[6].Less(a[18]) {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[7].Less(a[19]) {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[7].Less(a[18]) {
+		a[7], a[18] = a[18], a[7]
+	}
+	if a[6].Less(a[16]) {
+		a[6], a[16] = a[16], a[6]
+	}
+	if a[7].Less(a[17]) {
+		a[7], a[17] = a[17], a[7]
+	}
+	if a[7].Less(a[16]) {
+		a[7], a[16] = a[16], a[7]
+	}
+	if a[8].Less(a[24]) {
+		a[8], a[24] = a[24], a[8]
+	}
+	if a[9].Less(a[25]) {
+		a[9], a[25] = a[25], a[9]
+	}
+	if a[9].Less(a[24]) {
+		a[9], a[24] = a[24], a[9]
+	}
+	if a[10].Less(a[26]) {
+		a[10], a[26] = a[26], a[10]
+	}
+	if a[11].Less(a[27]) {
+		a[11], a[27] = a[27], a[11]
+	}
+	if a[11].Less(a[26]) {
+		a[11], a[26] = a[26], a[11]
+	}
+	if a[10].Less(a[24]) {
+		a[10], a[24] = a[24], a[10]
+	}
+	if a[11].Less(a[25]) {
+		a[11], a[25] = a[25], a[11]
+	}
+	if a[11].Less(a[24]) {
+		a[11], a[24] = a[24], a[11]
+	}
+	if a[12].Less(a[28]) {
+		a[12], a[28] = a[28], a[12]
+	}
+	if a[13].Less(a[29]) {
+		a[13], a[29] = a[29], a[13]
+	}
+	if a[13].Less(a[28]) {
+		a[13], a[28] = a[28], a[13]
+	}
+	if a[14].Less(a[30]) {
+		a[14], a[30] = a[30], a[14]
+	}
+	if a[15].Less(a[31]) {
+		a[15], a[31] = a[31], a[15]
+	}
+	if a[15].Less(a[30]) {
+		a[15], a[30] = a[30], a[15]
+	}
+	if a[14].Less(a[28]) {
+		a[14], a[28] = a[28], a[14]
+	}
+	if a[15].Less(a[29]) {
+		a[15], a[29] = a[29], a[15]
+	}
+	if a[15].Less(a[28]) {
+		a[15], a[28] = a[28], a[15]
+	}
+	if a[12].Less(a[24]) {
+		a[12], a[24] = a[24], a[12]
+	}
+	if a[13].Less(a[25]) {
+		a[13], a[25] = a[25], a[13]
+	}
+	if a[13].Less(a[24]) {
+		a[13], a[24] = a[24], a[13]
+	}
+	if a[14].Less(a[26]) {
+		a[14], a[26] = a[26], a[14]
+	}
+	if a[15].Less(a[27]) {
+		a[15], a[27] = a[27], a[15]
+	}
+	if a[15].Less(a[26]) {
+		a[15], a[26] = a[26], a[15]
+	}
+	if a[14].Less(a[24]) {
+		a[14], a[24] = a[24], a[14]
+	}
+	if a[15].Less(a[25]) {
+		a[15], a[25] = a[25], a[15]
+	}
+	if a[15].Less(a[24]) {
+		a[15], a[24] = a[24], a[15]
+	}
+	if a[8].Less(a[16]) {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[9].Less(a[17]) {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[9].Less(a[16]) {
+		a[9], a[16] = a[16], a[9]
+	}
+	if a[10].Less(a[18]) {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[11].Less(a[19]) {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[11].Less(a[18]) {
+		a[11], a[18] = a[18], a[11]
+	}
+	if a[10].Less(a[16]) {
+		a[10], a[16] = a[16], a[10]
+	}
+	if a[11].Less(a[17]) {
+		a[11], a[17] = a[17], a[11]
+	}
+	if a[11].Less(a[16]) {
+		a[11], a[16] = a[16], a[11]
+	}
+	if a[12].Less(a[20]) {
+		a[12], a[20] = a[20], a[12]
+	}
+	if a[13].Less(a[21]) {
+		a[13], a[21] = a[21], a[13]
+	}
+	if a[13].Less(a[20]) {
+		a[13], a[20] = a[20], a[13]
+	}
+	if a[14].Less(a[22]) {
+		a[14], a[22] = a[22], a[14]
+	}
+	if a[15].Less(a[23]) {
+		a[15], a[23] = a[23], a[15]
+	}
+	if a[15].Less(a[22]) {
+		a[15], a[22] = a[22], a[15]
+	}
+	if a[14].Less(a[20]) {
+		a[14], a[20] = a[20], a[14]
+	}
+	if a[15].Less(a[21]) {
+		a[15], a[21] = a[21], a[15]
+	}
+	if a[15].Less(a[20]) {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[12].Less(a[16]) {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[13].Less(a[17]) {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[13].Less(a[16]) {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[14].Less(a[18]) {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[15].Less(a[19]) {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[15].Less(a[18]) {
+		a[15], a[18] = a[18], a[15]
+	}
+	if a[14].Less(a[16]) {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[15].Less(a[17]) {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[15].Less(a[16]) {
+		a[15], a[16] = a[16], a[15]
+	}
+}
+
+func NetworkSort2xVersion(a []Version) {
+	_ = a[1]
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort2xVersionReverse(a []Version) {
+	_ = a[1]
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3xVersion(a []Version) {
+	_ = a[2]
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3xVersionReverse(a []Version) {
+	_ = a[2]
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort4xVersion(a []Version) {
+	_ = a[3]
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort4xVersionReverse(a []Version) {
+	_ = a[3]
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5xVersion(a []Version) {
+	_ = a[4]
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[4].Compare(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[3].Compare(a[0]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Compare(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5xVersionReverse(a []Version) {
+	_ = a[4]
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[2].Compare(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0].Compare(a[3]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Compare(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort6xVersion(a []Version) {
+	_ = a[5]
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3].Compare(a[0]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[5].Compare(a[2]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[4].Compare(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort6xVersionReverse(a []Version) {
+	_ = a[5]
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0].Compare(a[3]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2].Compare(a[5]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Compare(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7xVersion(a []Version) {
+	_ = a[6]
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Compare(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3].Compare(a[0]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Compare(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[5].Compare(a[2]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[4].Compare(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7xVersionReverse(a []Version) {
+	_ = a[6]
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Compare(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0].Compare(a[3]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2].Compare(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2].Compare(a[5]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Compare(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort8xVersion(a []Version) {
+	_ = a[7]
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[6].Compare(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[7].Compare(a[5]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[4].Compare(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[6].Compare(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[7].Compare(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[6].Compare(a[3]) < 0 {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4].Compare(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort8xVersionReverse(a []Version) {
+	_ = a[7]
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4].Compare(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5].Compare(a[7]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1].Compare(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2].Compare(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3].Compare(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3].Compare(a[6]) < 0 {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2].Compare(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort9xVersion(a []Version) {
+	_ = a[8]
+	if a[6].Compare(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[5].Compare(a[0]) < 0 {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[4].Compare(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[8].Compare(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[7].Compare(a[0]) < 0 {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Compare(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Compare(a[5]) < 0 {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[8].Compare(a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Compare(a[2]) < 0 {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[7].Compare(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[4].Compare(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort9xVersionReverse(a []Version) {
+	_ = a[8]
+	if a[2].Compare(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[0].Compare(a[5]) < 0 {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[1].Compare(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Compare(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[0].Compare(a[7]) < 0 {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Compare(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5].Compare(a[8]) < 0 {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6].Compare(a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2].Compare(a[7]) < 0 {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[3].Compare(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2].Compare(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort10xVersion(a []Version) {
+	_ = a[9]
+	if a[4].Compare(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[8].Compare(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Compare(a[0]) < 0 {
+		a[0], a[9] = a[9], a[0]
+	}
+	if a[5].Compare(a[2]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[7].Compare(a[0]) < 0 {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[6].Compare(a[3]) < 0 {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[9].Compare(a[4]) < 0 {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[9].Compare(a[6]) < 0 {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7].Compare(a[4]) < 0 {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[8].Compare(a[1]) < 0 {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[8].Compare(a[5]) < 0 {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[8].Compare(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Compare(a[2]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[6].Compare(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort10xVersionReverse(a []Version) {
+	_ = a[9]
+	if a[1].Compare(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Compare(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0].Compare(a[9]) < 0 {
+		a[0], a[9] = a[9], a[0]
+	}
+	if a[2].Compare(a[5]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[0].Compare(a[7]) < 0 {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3].Compare(a[6]) < 0 {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4].Compare(a[9]) < 0 {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Compare(a[9]) < 0 {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Compare(a[7]) < 0 {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[1].Compare(a[8]) < 0 {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Compare(a[8]) < 0 {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[7].Compare(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Compare(a[5]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[4].Compare(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort11xVersion(a []Version) {
+	_ = a[10]
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Compare(a[5]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Compare(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Compare(a[8]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Compare(a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Compare(a[6]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[9].Compare(a[5]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[6].Compare(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Compare(a[6]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7].Compare(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[8].Compare(a[4]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[4].Compare(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[10].Compare(a[7]) < 0 {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[8].Compare(a[3]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[4].Compare(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[9].Compare(a[7]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[8].Compare(a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Compare(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort11xVersionReverse(a []Version) {
+	_ = a[10]
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Compare(a[7]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Compare(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Compare(a[10]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Compare(a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Compare(a[10]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5].Compare(a[9]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2].Compare(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Compare(a[10]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3].Compare(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4].Compare(a[8]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1].Compare(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Compare(a[10]) < 0 {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3].Compare(a[8]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2].Compare(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7].Compare(a[9]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Compare(a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Compare(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort12xVersion(a []Version) {
+	_ = a[11]
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Compare(a[10]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Compare(a[5]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[11].Compare(a[9]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Compare(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Compare(a[8]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Compare(a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Compare(a[6]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[9].Compare(a[5]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[6].Compare(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Compare(a[6]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[11].Compare(a[7]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[7].Compare(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[8].Compare(a[4]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[11].Compare(a[7]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[4].Compare(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[10].Compare(a[7]) < 0 {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[8].Compare(a[3]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[4].Compare(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[9].Compare(a[7]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[8].Compare(a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Compare(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort12xVersionReverse(a []Version) {
+	_ = a[11]
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Compare(a[11]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Compare(a[7]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9].Compare(a[11]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Compare(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Compare(a[10]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Compare(a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Compare(a[10]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5].Compare(a[9]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2].Compare(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Compare(a[10]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7].Compare(a[11]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[3].Compare(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4].Compare(a[8]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7].Compare(a[11]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1].Compare(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Compare(a[10]) < 0 {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3].Compare(a[8]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2].Compare(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7].Compare(a[9]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Compare(a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Compare(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort13xVersion(a []Version) {
+	_ = a[12]
+	if a[7].Compare(a[1]) < 0 {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[11].Compare(a[9]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[8].Compare(a[5]) < 0 {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[12].Compare(a[0]) < 0 {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[6].Compare(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[6].Compare(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[11].Compare(a[8]) < 0 {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[12].Compare(a[7]) < 0 {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[9].Compare(a[5]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[7].Compare(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[11].Compare(a[10]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[4].Compare(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[12].Compare(a[6]) < 0 {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[8].Compare(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[12].Compare(a[11]) < 0 {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[9].Compare(a[4]) < 0 {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[10].Compare(a[6]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Compare(a[10]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[7].Compare(a[1]) < 0 {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[6].Compare(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[11].Compare(a[9]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Compare(a[4]) < 0 {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[10].Compare(a[8]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[5].Compare(a[0]) < 0 {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[5].Compare(a[2]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[8].Compare(a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10].Compare(a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[8].Compare(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[6].Compare(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort13xVersionReverse(a []Version) {
+	_ = a[12]
+	if a[1].Compare(a[7]) < 0 {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[9].Compare(a[11]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Compare(a[8]) < 0 {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[0].Compare(a[12]) < 0 {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[2].Compare(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Compare(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Compare(a[11]) < 0 {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[7].Compare(a[12]) < 0 {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[5].Compare(a[9]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[3].Compare(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[10].Compare(a[11]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1].Compare(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[6].Compare(a[12]) < 0 {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[7].Compare(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[11].Compare(a[12]) < 0 {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[4].Compare(a[9]) < 0 {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[6].Compare(a[10]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Compare(a[11]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1].Compare(a[7]) < 0 {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[2].Compare(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[9].Compare(a[11]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[4].Compare(a[7]) < 0 {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[8].Compare(a[10]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[0].Compare(a[5]) < 0 {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[2].Compare(a[5]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[6].Compare(a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[9].Compare(a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7].Compare(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[4].Compare(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort14xVersion(a []Version) {
+	_ = a[13]
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Compare(a[10]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[13].Compare(a[12]) < 0 {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Compare(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Compare(a[8]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Compare(a[5]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[11].Compare(a[9]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[12].Compare(a[8]) < 0 {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[13].Compare(a[9]) < 0 {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[6].Compare(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[7].Compare(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[8].Compare(a[0]) < 0 {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[9].Compare(a[1]) < 0 {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[10].Compare(a[2]) < 0 {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[11].Compare(a[3]) < 0 {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[12].Compare(a[4]) < 0 {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[13].Compare(a[5]) < 0 {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[10].Compare(a[5]) < 0 {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[9].Compare(a[6]) < 0 {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[12].Compare(a[3]) < 0 {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[11].Compare(a[7]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[8].Compare(a[4]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4].Compare(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[13].Compare(a[7]) < 0 {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[8].Compare(a[2]) < 0 {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[4].Compare(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Compare(a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[13].Compare(a[11]) < 0 {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[8].Compare(a[3]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[12].Compare(a[7]) < 0 {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[8].Compare(a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[12].Compare(a[10]) < 0 {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[9].Compare(a[7]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Compare(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[10].Compare(a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12].Compare(a[11]) < 0 {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort14xVersionReverse(a []Version) {
+	_ = a[13]
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Compare(a[11]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12].Compare(a[13]) < 0 {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Compare(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Compare(a[10]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Compare(a[7]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9].Compare(a[11]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8].Compare(a[12]) < 0 {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9].Compare(a[13]) < 0 {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2].Compare(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3].Compare(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[0].Compare(a[8]) < 0 {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1].Compare(a[9]) < 0 {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2].Compare(a[10]) < 0 {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3].Compare(a[11]) < 0 {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4].Compare(a[12]) < 0 {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5].Compare(a[13]) < 0 {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[5].Compare(a[10]) < 0 {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6].Compare(a[9]) < 0 {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3].Compare(a[12]) < 0 {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[7].Compare(a[11]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4].Compare(a[8]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1].Compare(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Compare(a[13]) < 0 {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2].Compare(a[8]) < 0 {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[2].Compare(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Compare(a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Compare(a[13]) < 0 {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3].Compare(a[8]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7].Compare(a[12]) < 0 {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6].Compare(a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10].Compare(a[12]) < 0 {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7].Compare(a[9]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Compare(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9].Compare(a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Compare(a[12]) < 0 {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort15xVersion(a []Version) {
+	_ = a[14]
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Compare(a[10]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[13].Compare(a[12]) < 0 {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Compare(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Compare(a[8]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[14].Compare(a[12]) < 0 {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Compare(a[5]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[11].Compare(a[9]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[12].Compare(a[8]) < 0 {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[13].Compare(a[9]) < 0 {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[6].Compare(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[14].Compare(a[10]) < 0 {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[7].Compare(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[8].Compare(a[0]) < 0 {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[9].Compare(a[1]) < 0 {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[10].Compare(a[2]) < 0 {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[11].Compare(a[3]) < 0 {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[12].Compare(a[4]) < 0 {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[13].Compare(a[5]) < 0 {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[14].Compare(a[6]) < 0 {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[10].Compare(a[5]) < 0 {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[9].Compare(a[6]) < 0 {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[12].Compare(a[3]) < 0 {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[14].Compare(a[13]) < 0 {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[11].Compare(a[7]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[8].Compare(a[4]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4].Compare(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[13].Compare(a[7]) < 0 {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[8].Compare(a[2]) < 0 {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[14].Compare(a[11]) < 0 {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[4].Compare(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Compare(a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[13].Compare(a[11]) < 0 {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[8].Compare(a[3]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[12].Compare(a[7]) < 0 {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[8].Compare(a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[12].Compare(a[10]) < 0 {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[9].Compare(a[7]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Compare(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[10].Compare(a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12].Compare(a[11]) < 0 {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort15xVersionReverse(a []Version) {
+	_ = a[14]
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Compare(a[11]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12].Compare(a[13]) < 0 {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Compare(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Compare(a[10]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[12].Compare(a[14]) < 0 {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Compare(a[7]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9].Compare(a[11]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8].Compare(a[12]) < 0 {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9].Compare(a[13]) < 0 {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2].Compare(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[10].Compare(a[14]) < 0 {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[3].Compare(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[0].Compare(a[8]) < 0 {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1].Compare(a[9]) < 0 {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2].Compare(a[10]) < 0 {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3].Compare(a[11]) < 0 {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4].Compare(a[12]) < 0 {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5].Compare(a[13]) < 0 {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6].Compare(a[14]) < 0 {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[5].Compare(a[10]) < 0 {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6].Compare(a[9]) < 0 {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3].Compare(a[12]) < 0 {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[13].Compare(a[14]) < 0 {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[7].Compare(a[11]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4].Compare(a[8]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1].Compare(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Compare(a[13]) < 0 {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2].Compare(a[8]) < 0 {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[11].Compare(a[14]) < 0 {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[2].Compare(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Compare(a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Compare(a[13]) < 0 {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3].Compare(a[8]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7].Compare(a[12]) < 0 {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6].Compare(a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10].Compare(a[12]) < 0 {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7].Compare(a[9]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Compare(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9].Compare(a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Compare(a[12]) < 0 {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort16xVersion(a []Version) {
+	_ = a[15]
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Compare(a[10]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[13].Compare(a[12]) < 0 {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[15].Compare(a[14]) < 0 {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Compare(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Compare(a[8]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[14].Compare(a[12]) < 0 {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Compare(a[5]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[11].Compare(a[9]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[15].Compare(a[13]) < 0 {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[12].Compare(a[8]) < 0 {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[13].Compare(a[9]) < 0 {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[6].Compare(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[14].Compare(a[10]) < 0 {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[7].Compare(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[15].Compare(a[11]) < 0 {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[8].Compare(a[0]) < 0 {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[9].Compare(a[1]) < 0 {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[10].Compare(a[2]) < 0 {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[11].Compare(a[3]) < 0 {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[12].Compare(a[4]) < 0 {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[13].Compare(a[5]) < 0 {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[14].Compare(a[6]) < 0 {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[15].Compare(a[7]) < 0 {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[10].Compare(a[5]) < 0 {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[9].Compare(a[6]) < 0 {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[12].Compare(a[3]) < 0 {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[14].Compare(a[13]) < 0 {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[11].Compare(a[7]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[8].Compare(a[4]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4].Compare(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[13].Compare(a[7]) < 0 {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[8].Compare(a[2]) < 0 {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[14].Compare(a[11]) < 0 {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[4].Compare(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Compare(a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[13].Compare(a[11]) < 0 {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[8].Compare(a[3]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[12].Compare(a[7]) < 0 {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[8].Compare(a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[12].Compare(a[10]) < 0 {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[9].Compare(a[7]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Compare(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[10].Compare(a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12].Compare(a[11]) < 0 {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort16xVersionReverse(a []Version) {
+	_ = a[15]
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Compare(a[11]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12].Compare(a[13]) < 0 {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14].Compare(a[15]) < 0 {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Compare(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Compare(a[10]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[12].Compare(a[14]) < 0 {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Compare(a[7]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9].Compare(a[11]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[13].Compare(a[15]) < 0 {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8].Compare(a[12]) < 0 {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9].Compare(a[13]) < 0 {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2].Compare(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[10].Compare(a[14]) < 0 {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[3].Compare(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[11].Compare(a[15]) < 0 {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[0].Compare(a[8]) < 0 {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1].Compare(a[9]) < 0 {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2].Compare(a[10]) < 0 {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3].Compare(a[11]) < 0 {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4].Compare(a[12]) < 0 {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5].Compare(a[13]) < 0 {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6].Compare(a[14]) < 0 {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7].Compare(a[15]) < 0 {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[5].Compare(a[10]) < 0 {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6].Compare(a[9]) < 0 {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3].Compare(a[12]) < 0 {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[13].Compare(a[14]) < 0 {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[7].Compare(a[11]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4].Compare(a[8]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1].Compare(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Compare(a[13]) < 0 {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2].Compare(a[8]) < 0 {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[11].Compare(a[14]) < 0 {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[2].Compare(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Compare(a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Compare(a[13]) < 0 {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3].Compare(a[8]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7].Compare(a[12]) < 0 {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6].Compare(a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10].Compare(a[12]) < 0 {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7].Compare(a[9]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Compare(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9].Compare(a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Compare(a[12]) < 0 {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort24xVersion(a []Version) {
+	_ = a[23]
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Compare(a[10]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[13].Compare(a[12]) < 0 {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[15].Compare(a[14]) < 0 {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[17].Compare(a[16]) < 0 {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[19].Compare(a[18]) < 0 {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[21].Compare(a[20]) < 0 {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[23].Compare(a[22]) < 0 {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Compare(a[5]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[11].Compare(a[9]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Compare(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Compare(a[8]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[15].Compare(a[13]) < 0 {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[19].Compare(a[17]) < 0 {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[23].Compare(a[21]) < 0 {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[14].Compare(a[12]) < 0 {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[18].Compare(a[16]) < 0 {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[22].Compare(a[20]) < 0 {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Compare(a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[14].Compare(a[13]) < 0 {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[18].Compare(a[17]) < 0 {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[22].Compare(a[21]) < 0 {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Compare(a[6]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[17].Compare(a[13]) < 0 {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[22].Compare(a[18]) < 0 {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[9].Compare(a[5]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[6].Compare(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[21].Compare(a[17]) < 0 {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[18].Compare(a[14]) < 0 {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Compare(a[6]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[11].Compare(a[7]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[17].Compare(a[13]) < 0 {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[22].Compare(a[18]) < 0 {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[16].Compare(a[12]) < 0 {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[23].Compare(a[19]) < 0 {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[7].Compare(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[8].Compare(a[4]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[19].Compare(a[15]) < 0 {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[20].Compare(a[16]) < 0 {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[11].Compare(a[7]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[16].Compare(a[12]) < 0 {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[23].Compare(a[19]) < 0 {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[4].Compare(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[10].Compare(a[7]) < 0 {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[8].Compare(a[3]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[16].Compare(a[13]) < 0 {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[22].Compare(a[19]) < 0 {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[20].Compare(a[15]) < 0 {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[15].Compare(a[14]) < 0 {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[21].Compare(a[20]) < 0 {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[4].Compare(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[9].Compare(a[7]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[8].Compare(a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[16].Compare(a[14]) < 0 {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[21].Compare(a[19]) < 0 {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[17].Compare(a[15]) < 0 {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[20].Compare(a[18]) < 0 {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Compare(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[16].Compare(a[15]) < 0 {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[18].Compare(a[17]) < 0 {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[20].Compare(a[19]) < 0 {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[12].Compare(a[0]) < 0 {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[13].Compare(a[1]) < 0 {
+		a[1], a[13] = a[13], a[1]
+	}
+	if a[14].Compare(a[2]) < 0 {
+		a[2], a[14] = a[14], a[2]
+	}
+	if a[15].Compare(a[3]) < 0 {
+		a[3], a[15] = a[15], a[3]
+	}
+	if a[16].Compare(a[4]) < 0 {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[17].Compare(a[5]) < 0 {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[18].Compare(a[6]) < 0 {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[19].Compare(a[7]) < 0 {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[20].Compare(a[8]) < 0 {
+		a[8], a[20] = a[20], a[8]
+	}
+	if a[21].Compare(a[9]) < 0 {
+		a[9], a[21] = a[21], a[9]
+	}
+	if a[22].Compare(a[10]) < 0 {
+		a[10], a[22] = a[22], a[10]
+	}
+	if a[23].Compare(a[11]) < 0 {
+		a[11], a[23] = a[23], a[11]
+	}
+	if a[12].Compare(a[2]) < 0 {
+		a[2], a[12] = a[12], a[2]
+	}
+	if a[13].Compare(a[3]) < 0 {
+		a[3], a[13] = a[13], a[3]
+	}
+	if a[20].Compare(a[10]) < 0 {
+		a[10], a[20] = a[20], a[10]
+	}
+	if a[21].Compare(a[11]) < 0 {
+		a[11], a[21] = a[21], a[11]
+	}
+	if a[12].Compare(a[4]) < 0 {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[13].Compare(a[5]) < 0 {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[14].Compare(a[6]) < 0 {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[15].Compare(a[7]) < 0 {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[16].Compare(a[8]) < 0 {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[17].Compare(a[9]) < 0 {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[18].Compare(a[10]) < 0 {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[19].Compare(a[11]) < 0 {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[12].Compare(a[8]) < 0 {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[13].Compare(a[9]) < 0 {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[14].Compare(a[10]) < 0 {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[15].Compare(a[11]) < 0 {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[8].Compare(a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[12].Compare(a[10]) < 0 {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[16].Compare(a[14]) < 0 {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[9].Compare(a[7]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[13].Compare(a[11]) < 0 {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[17].Compare(a[15]) < 0 {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Compare(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[10].Compare(a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12].Compare(a[11]) < 0 {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[14].Compare(a[13]) < 0 {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[16].Compare(a[15]) < 0 {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[18].Compare(a[17]) < 0 {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[20].Compare(a[19]) < 0 {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[22].Compare(a[21]) < 0 {
+		a[21], a[22] = a[22], a[21]
+	}
+}
+
+func NetworkSort24xVersionReverse(a []Version) {
+	_ = a[23]
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Compare(a[11]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12].Compare(a[13]) < 0 {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14].Compare(a[15]) < 0 {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[16].Compare(a[17]) < 0 {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[18].Compare(a[19]) < 0 {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[20].Compare(a[21]) < 0 {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[22].Compare(a[23]) < 0 {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Compare(a[7]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9].Compare(a[11]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Compare(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Compare(a[10]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[13].Compare(a[15]) < 0 {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[17].Compare(a[19]) < 0 {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[21].Compare(a[23]) < 0 {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[12].Compare(a[14]) < 0 {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[16].Compare(a[18]) < 0 {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[20].Compare(a[22]) < 0 {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Compare(a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[13].Compare(a[14]) < 0 {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[17].Compare(a[18]) < 0 {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[21].Compare(a[22]) < 0 {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Compare(a[10]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[13].Compare(a[17]) < 0 {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[18].Compare(a[22]) < 0 {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[5].Compare(a[9]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2].Compare(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[17].Compare(a[21]) < 0 {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[14].Compare(a[18]) < 0 {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Compare(a[10]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7].Compare(a[11]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[13].Compare(a[17]) < 0 {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[18].Compare(a[22]) < 0 {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[12].Compare(a[16]) < 0 {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[19].Compare(a[23]) < 0 {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[3].Compare(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4].Compare(a[8]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[15].Compare(a[19]) < 0 {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[16].Compare(a[20]) < 0 {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7].Compare(a[11]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[12].Compare(a[16]) < 0 {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[19].Compare(a[23]) < 0 {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[1].Compare(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Compare(a[10]) < 0 {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3].Compare(a[8]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[13].Compare(a[16]) < 0 {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[19].Compare(a[22]) < 0 {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[15].Compare(a[20]) < 0 {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[14].Compare(a[15]) < 0 {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[20].Compare(a[21]) < 0 {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[2].Compare(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7].Compare(a[9]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Compare(a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[14].Compare(a[16]) < 0 {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[19].Compare(a[21]) < 0 {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[15].Compare(a[17]) < 0 {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[18].Compare(a[20]) < 0 {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Compare(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[15].Compare(a[16]) < 0 {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[17].Compare(a[18]) < 0 {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[19].Compare(a[20]) < 0 {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[0].Compare(a[12]) < 0 {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[1].Compare(a[13]) < 0 {
+		a[1], a[13] = a[13], a[1]
+	}
+	if a[2].Compare(a[14]) < 0 {
+		a[2], a[14] = a[14], a[2]
+	}
+	if a[3].Compare(a[15]) < 0 {
+		a[3], a[15] = a[15], a[3]
+	}
+	if a[4].Compare(a[16]) < 0 {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[5].Compare(a[17]) < 0 {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[6].Compare(a[18]) < 0 {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[7].Compare(a[19]) < 0 {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[8].Compare(a[20]) < 0 {
+		a[8], a[20] = a[20], a[8]
+	}
+	if a[9].Compare(a[21]) < 0 {
+		a[9], a[21] = a[21], a[9]
+	}
+	if a[10].Compare(a[22]) < 0 {
+		a[10], a[22] = a[22], a[10]
+	}
+	if a[11].Compare(a[23]) < 0 {
+		a[11], a[23] = a[23], a[11]
+	}
+	if a[2].Compare(a[12]) < 0 {
+		a[2], a[12] = a[12], a[2]
+	}
+	if a[3].Compare(a[13]) < 0 {
+		a[3], a[13] = a[13], a[3]
+	}
+	if a[10].Compare(a[20]) < 0 {
+		a[10], a[20] = a[20], a[10]
+	}
+	if a[11].Compare(a[21]) < 0 {
+		a[11], a[21] = a[21], a[11]
+	}
+	if a[4].Compare(a[12]) < 0 {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5].Compare(a[13]) < 0 {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6].Compare(a[14]) < 0 {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7].Compare(a[15]) < 0 {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[8].Compare(a[16]) < 0 {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[9].Compare(a[17]) < 0 {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[10].Compare(a[18]) < 0 {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[11].Compare(a[19]) < 0 {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[8].Compare(a[12]) < 0 {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9].Compare(a[13]) < 0 {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[10].Compare(a[14]) < 0 {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11].Compare(a[15]) < 0 {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[6].Compare(a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10].Compare(a[12]) < 0 {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[14].Compare(a[16]) < 0 {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[7].Compare(a[9]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[11].Compare(a[13]) < 0 {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[15].Compare(a[17]) < 0 {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Compare(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9].Compare(a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Compare(a[12]) < 0 {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[13].Compare(a[14]) < 0 {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[15].Compare(a[16]) < 0 {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[17].Compare(a[18]) < 0 {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[19].Compare(a[20]) < 0 {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[21].Compare(a[22]) < 0 {
+		a[21], a[22] = a[22], a[21]
+	}
+}
+
+func NetworkSort32xVersion(a []Version) {
+	_ = a[31]
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[6].Compare(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[7].Compare(a[5]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[4].Compare(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[6].Compare(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[7].Compare(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[6].Compare(a[3]) < 0 {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4].Compare(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Compare(a[10]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[10].Compare(a[8]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[11].Compare(a[9]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[10].Compare(a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[13].Compare(a[12]) < 0 {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[15].Compare(a[14]) < 0 {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[14].Compare(a[12]) < 0 {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[15].Compare(a[13]) < 0 {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[14].Compare(a[13]) < 0 {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[12].Compare(a[8]) < 0 {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[13].Compare(a[9]) < 0 {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[12].Compare(a[9]) < 0 {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[14].Compare(a[10]) < 0 {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[15].Compare(a[11]) < 0 {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[14].Compare(a[11]) < 0 {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[12].Compare(a[10]) < 0 {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[13].Compare(a[11]) < 0 {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[12].Compare(a[11]) < 0 {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[8].Compare(a[0]) < 0 {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[9].Compare(a[1]) < 0 {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[8].Compare(a[1]) < 0 {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[10].Compare(a[2]) < 0 {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[11].Compare(a[3]) < 0 {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[10].Compare(a[3]) < 0 {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[8].Compare(a[2]) < 0 {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[9].Compare(a[3]) < 0 {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[8].Compare(a[3]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[12].Compare(a[4]) < 0 {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[13].Compare(a[5]) < 0 {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[12].Compare(a[5]) < 0 {
+		a[5], a[12] = a[12], a[5]
+	}
+	if a[14].Compare(a[6]) < 0 {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[15].Compare(a[7]) < 0 {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[14].Compare(a[7]) < 0 {
+		a[7], a[14] = a[14], a[7]
+	}
+	if a[12].Compare(a[6]) < 0 {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[13].Compare(a[7]) < 0 {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[12].Compare(a[7]) < 0 {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[8].Compare(a[4]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[9].Compare(a[5]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[8].Compare(a[5]) < 0 {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[10].Compare(a[6]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[11].Compare(a[7]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[10].Compare(a[7]) < 0 {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[8].Compare(a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[9].Compare(a[7]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[8].Compare(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[17].Compare(a[16]) < 0 {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[19].Compare(a[18]) < 0 {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[18].Compare(a[16]) < 0 {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[19].Compare(a[17]) < 0 {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[18].Compare(a[17]) < 0 {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[21].Compare(a[20]) < 0 {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[23].Compare(a[22]) < 0 {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[22].Compare(a[20]) < 0 {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[23].Compare(a[21]) < 0 {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[22].Compare(a[21]) < 0 {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[20].Compare(a[16]) < 0 {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[21].Compare(a[17]) < 0 {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[20].Compare(a[17]) < 0 {
+		a[17], a[20] = a[20], a[17]
+	}
+	if a[22].Compare(a[18]) < 0 {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[23].Compare(a[19]) < 0 {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[22].Compare(a[19]) < 0 {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[20].Compare(a[18]) < 0 {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[21].Compare(a[19]) < 0 {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[20].Compare(a[19]) < 0 {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[25].Compare(a[24]) < 0 {
+		a[24], a[25] = a[25], a[24]
+	}
+	if a[27].Compare(a[26]) < 0 {
+		a[26], a[27] = a[27], a[26]
+	}
+	if a[26].Compare(a[24]) < 0 {
+		a[24], a[26] = a[26], a[24]
+	}
+	if a[27].Compare(a[25]) < 0 {
+		a[25], a[27] = a[27], a[25]
+	}
+	if a[26].Compare(a[25]) < 0 {
+		a[25], a[26] = a[26], a[25]
+	}
+	if a[29].Compare(a[28]) < 0 {
+		a[28], a[29] = a[29], a[28]
+	}
+	if a[31].Compare(a[30]) < 0 {
+		a[30], a[31] = a[31], a[30]
+	}
+	if a[30].Compare(a[28]) < 0 {
+		a[28], a[30] = a[30], a[28]
+	}
+	if a[31].Compare(a[29]) < 0 {
+		a[29], a[31] = a[31], a[29]
+	}
+	if a[30].Compare(a[29]) < 0 {
+		a[29], a[30] = a[30], a[29]
+	}
+	if a[28].Compare(a[24]) < 0 {
+		a[24], a[28] = a[28], a[24]
+	}
+	if a[29].Compare(a[25]) < 0 {
+		a[25], a[29] = a[29], a[25]
+	}
+	if a[28].Compare(a[25]) < 0 {
+		a[25], a[28] = a[28], a[25]
+	}
+	if a[30].Compare(a[26]) < 0 {
+		a[26], a[30] = a[30], a[26]
+	}
+	if a[31].Compare(a[27]) < 0 {
+		a[27], a[31] = a[31], a[27]
+	}
+	if a[30].Compare(a[27]) < 0 {
+		a[27], a[30] = a[30], a[27]
+	}
+	if a[28].Compare(a[26]) < 0 {
+		a[26], a[28] = a[28], a[26]
+	}
+	if a[29].Compare(a[27]) < 0 {
+		a[27], a[29] = a[29], a[27]
+	}
+	if a[28].Compare(a[27]) < 0 {
+		a[27], a[28] = a[28], a[27]
+	}
+	if a[24].Compare(a[16]) < 0 {
+		a[16], a[24] = a[24], a[16]
+	}
+	if a[25].Compare(a[17]) < 0 {
+		a[17], a[25] = a[25], a[17]
+	}
+	if a[24].Compare(a[17]) < 0 {
+		a[17], a[24] = a[24], a[17]
+	}
+	if a[26].Compare(a[18]) < 0 {
+		a[18], a[26] = a[26], a[18]
+	}
+	if a[27].Compare(a[19]) < 0 {
+		a[19], a[27] = a[27], a[19]
+	}
+	if a[26].Compare(a[19]) < 0 {
+		a[19], a[26] = a[26], a[19]
+	}
+	if a[24].Compare(a[18]) < 0 {
+		a[18], a[24] = a[24], a[18]
+	}
+	if a[25].Compare(a[19]) < 0 {
+		a[19], a[25] = a[25], a[19]
+	}
+	if a[24].Compare(a[19]) < 0 {
+		a[19], a[24] = a[24], a[19]
+	}
+	if a[28].Compare(a[20]) < 0 {
+		a[20], a[28] = a[28], a[20]
+	}
+	if a[29].Compare(a[21]) < 0 {
+		a[21], a[29] = a[29], a[21]
+	}
+	if a[28].Compare(a[21]) < 0 {
+		a[21], a[28] = a[28], a[21]
+	}
+	if a[30].Compare(a[22]) < 0 {
+		a[22], a[30] = a[30], a[22]
+	}
+	if a[31].Compare(a[23]) < 0 {
+		a[23], a[31] = a[31], a[23]
+	}
+	if a[30].Compare(a[23]) < 0 {
+		a[23], a[30] = a[30], a[23]
+	}
+	if a[28].Compare(a[22]) < 0 {
+		a[22], a[28] = a[28], a[22]
+	}
+	if a[29].Compare(a[23]) < 0 {
+		a[23], a[29] = a[29], a[23]
+	}
+	if a[28].Compare(a[23]) < 0 {
+		a[23], a[28] = a[28], a[23]
+	}
+	if a[24].Compare(a[20]) < 0 {
+		a[20], a[24] = a[24], a[20]
+	}
+	if a[25].Compare(a[21]) < 0 {
+		a[21], a[25] = a[25], a[21]
+	}
+	if a[24].Compare(a[21]) < 0 {
+		a[21], a[24] = a[24], a[21]
+	}
+	if a[26].Compare(a[22]) < 0 {
+		a[22], a[26] = a[26], a[22]
+	}
+	if a[27].Compare(a[23]) < 0 {
+		a[23], a[27] = a[27], a[23]
+	}
+	if a[26].Compare(a[23]) < 0 {
+		a[23], a[26] = a[26], a[23]
+	}
+	if a[24].Compare(a[22]) < 0 {
+		a[22], a[24] = a[24], a[22]
+	}
+	if a[25].Compare(a[23]) < 0 {
+		a[23], a[25] = a[25], a[23]
+	}
+	if a[24].Compare(a[23]) < 0 {
+		a[23], a[24] = a[24], a[23]
+	}
+	if a[16].Compare(a[0]) < 0 {
+		a[0], a[16] = a[16], a[0]
+	}
+	if a[17].Compare(a[1]) < 0 {
+		a[1], a[17] = a[17], a[1]
+	}
+	if a[16].Compare(a[1]) < 0 {
+		a[1], a[16] = a[16], a[1]
+	}
+	if a[18].Compare(a[2]) < 0 {
+		a[2], a[18] = a[18], a[2]
+	}
+	if a[19].Compare(a[3]) < 0 {
+		a[3], a[19] = a[19], a[3]
+	}
+	if a[18].Compare(a[3]) < 0 {
+		a[3], a[18] = a[18], a[3]
+	}
+	if a[16].Compare(a[2]) < 0 {
+		a[2], a[16] = a[16], a[2]
+	}
+	if a[17].Compare(a[3]) < 0 {
+		a[3], a[17] = a[17], a[3]
+	}
+	if a[16].Compare(a[3]) < 0 {
+		a[3], a[16] = a[16], a[3]
+	}
+	if a[20].Compare(a[4]) < 0 {
+		a[4], a[20] = a[20], a[4]
+	}
+	if a[21].Compare(a[5]) < 0 {
+		a[5], a[21] = a[21], a[5]
+	}
+	if a[20].Compare(a[5]) < 0 {
+		a[5], a[20] = a[20], a[5]
+	}
+	if a[22].Compare(a[6]) < 0 {
+		a[6], a[22] = a[22], a[6]
+	}
+	if a[23].Compare(a[7]) < 0 {
+		a[7], a[23] = a[23], a[7]
+	}
+	if a[22].Compare(a[7]) < 0 {
+		a[7], a[22] = a[22], a[7]
+	}
+	if a[20].Compare(a[6]) < 0 {
+		a[6], a[20] = a[20], a[6]
+	}
+	if a[21].Compare(a[7]) < 0 {
+		a[7], a[21] = a[21], a[7]
+	}
+	if a[20].Compare(a[7]) < 0 {
+		a[7], a[20] = a[20], a[7]
+	}
+	if a[16].Compare(a[4]) < 0 {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[17].Compare(a[5]) < 0 {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[16].Compare(a[5]) < 0 {
+		a[5], a[16] = a[16], a[5]
+	}
+	if a[18].Compare(a[6]) < 0 {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[19].Compare(a[7]) < 0 {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[18].Compare(a[7]) < 0 {
+		a[7], a[18] = a[18], a[7]
+	}
+	if a[16].Compare(a[6]) < 0 {
+		a[6], a[16] = a[16], a[6]
+	}
+	if a[17].Compare(a[7]) < 0 {
+		a[7], a[17] = a[17], a[7]
+	}
+	if a[16].Compare(a[7]) < 0 {
+		a[7], a[16] = a[16], a[7]
+	}
+	if a[24].Compare(a[8]) < 0 {
+		a[8], a[24] = a[24], a[8]
+	}
+	if a[25].Compare(a[9]) < 0 {
+		a[9], a[25] = a[25], a[9]
+	}
+	if a[24].Compare(a[9]) < 0 {
+		a[9], a[24] = a[24], a[9]
+	}
+	if a[26].Compare(a[10]) < 0 {
+		a[10], a[26] = a[26], a[10]
+	}
+	if a[27].Compare(a[11]) < 0 {
+		a[11], a[27] = a[27], a[11]
+	}
+	if a[26].Compare(a[11]) < 0 {
+		a[11], a[26] = a[26], a[11]
+	}
+	if a[24].Compare(a[10]) < 0 {
+		a[10], a[24] = a[24], a[10]
+	}
+	if a[25].Compare(a[11]) < 0 {
+		a[11], a[25] = a[25], a[11]
+	}
+	if a[24].Compare(a[11]) < 0 {
+		a[11], a[24] = a[24], a[11]
+	}
+	if a[28].Compare(a[12]) < 0 {
+		a[12], a[28] = a[28], a[12]
+	}
+	if a[29].Compare(a[13]) < 0 {
+		a[13], a[29] = a[29], a[13]
+	}
+	if a[28].Compare(a[13]) < 0 {
+		a[13], a[28] = a[28], a[13]
+	}
+	if a[30].Compare(a[14]) < 0 {
+		a[14], a[30] = a[30], a[14]
+	}
+	if a[31].Compare(a[15]) < 0 {
+		a[15], a[31] = a[31], a[15]
+	}
+	if a[30].Compare(a[15]) < 0 {
+		a[15], a[30] = a[30], a[15]
+	}
+	if a[28].Compare(a[14]) < 0 {
+		a[14], a[28] = a[28], a[14]
+	}
+	if a[29].Compare(a[15]) < 0 {
+		a[15], a[29] = a[29], a[15]
+	}
+	if a[28].Compare(a[15]) < 0 {
+		a[15], a[28] = a[28], a[15]
+	}
+	if a[24].Compare(a[12]) < 0 {
+		a[12], a[24] = a[24], a[12]
+	}
+	if a[25].Compare(a[13]) < 0 {
+		a[13], a[25] = a[25], a[13]
+	}
+	if a[24].Compare(a[13]) < 0 {
+		a[13], a[24] = a[24], a[13]
+	}
+	if a[26].Compare(a[14]) < 0 {
+		a[14], a[26] = a[26], a[14]
+	}
+	if a[27].Compare(a[15]) < 0 {
+		a[15], a[27] = a[27], a[15]
+	}
+	if a[26].Compare(a[15]) < 0 {
+		a[15], a[26] = a[26], a[15]
+	}
+	if a[24].Compare(a[14]) < 0 {
+		a[14], a[24] = a[24], a[14]
+	}
+	if a[25].Compare(a[15]) < 0 {
+		a[15], a[25] = a[25], a[15]
+	}
+	if a[24].Compare(a[15]) < 0 {
+		a[15], a[24] = a[24], a[15]
+	}
+	if a[16].Compare(a[8]) < 0 {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[17].Compare(a[9]) < 0 {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[16].Compare(a[9]) < 0 {
+		a[9], a[16] = a[16], a[9]
+	}
+	if a[18].Compare(a[10]) < 0 {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[19].Compare(a[11]) < 0 {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[18].Compare(a[11]) < 0 {
+		a[11], a[18] = a[18], a[11]
+	}
+	if a[16].Compare(a[10]) < 0 {
+		a[10], a[16] = a[16], a[10]
+	}
+	if a[17].Compare(a[11]) < 0 {
+		a[11], a[17] = a[17], a[11]
+	}
+	if a[16].Compare(a[11]) < 0 {
+		a[11], a[16] = a[16], a[11]
+	}
+	if a[20].Compare(a[12]) < 0 {
+		a[12], a[20] = a[20], a[12]
+	}
+	if a[21].Compare(a[13]) < 0 {
+		a[13], a[21] = a[21], a[13]
+	}
+	if a[20].Compare(a[13]) < 0 {
+		a[13], a[20] = a[20], a[13]
+	}
+	if a[22].Compare(a[14]) < 0 {
+		a[14], a[22] = a[22], a[14]
+	}
+	if a[23].Compare(a[15]) < 0 {
+		a[15], a[23] = a[23], a[15]
+	}
+	if a[22].Compare(a[15]) < 0 {
+		a[15], a[22] = a[22], a[15]
+	}
+	if a[20].Compare(a[14]) < 0 {
+		a[14], a[20] = a[20], a[14]
+	}
+	if a[21].Compare(a[15]) < 0 {
+		a[15], a[21] = a[21], a[15]
+	}
+	if a[20].Compare(a[15]) < 0 {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[16].Compare(a[12]) < 0 {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[17].Compare(a[13]) < 0 {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[16].Compare(a[13]) < 0 {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[18].Compare(a[14]) < 0 {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[19].Compare(a[15]) < 0 {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[18].Compare(a[15]) < 0 {
+		a[15], a[18] = a[18], a[15]
+	}
+	if a[16].Compare(a[14]) < 0 {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[17].Compare(a[15]) < 0 {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[16].Compare(a[15]) < 0 {
+		a[15], a[16] = a[16], a[15]
+	}
+}
+
+func NetworkSort32xVersionReverse(a []Version) {
+	_ = a[31]
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4].Compare(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5].Compare(a[7]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1].Compare(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2].Compare(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3].Compare(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3].Compare(a[6]) < 0 {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2].Compare(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Compare(a[11]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[8].Compare(a[10]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[9].Compare(a[11]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[9].Compare(a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12].Compare(a[13]) < 0 {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14].Compare(a[15]) < 0 {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[12].Compare(a[14]) < 0 {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[13].Compare(a[15]) < 0 {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[13].Compare(a[14]) < 0 {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[8].Compare(a[12]) < 0 {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9].Compare(a[13]) < 0 {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[9].Compare(a[12]) < 0 {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[10].Compare(a[14]) < 0 {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11].Compare(a[15]) < 0 {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[11].Compare(a[14]) < 0 {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[10].Compare(a[12]) < 0 {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[11].Compare(a[13]) < 0 {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[11].Compare(a[12]) < 0 {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[0].Compare(a[8]) < 0 {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1].Compare(a[9]) < 0 {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[1].Compare(a[8]) < 0 {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[2].Compare(a[10]) < 0 {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3].Compare(a[11]) < 0 {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[3].Compare(a[10]) < 0 {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[2].Compare(a[8]) < 0 {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[3].Compare(a[9]) < 0 {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[3].Compare(a[8]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[4].Compare(a[12]) < 0 {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5].Compare(a[13]) < 0 {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[5].Compare(a[12]) < 0 {
+		a[5], a[12] = a[12], a[5]
+	}
+	if a[6].Compare(a[14]) < 0 {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7].Compare(a[15]) < 0 {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[7].Compare(a[14]) < 0 {
+		a[7], a[14] = a[14], a[7]
+	}
+	if a[6].Compare(a[12]) < 0 {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[7].Compare(a[13]) < 0 {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[7].Compare(a[12]) < 0 {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[4].Compare(a[8]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[5].Compare(a[9]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[5].Compare(a[8]) < 0 {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6].Compare(a[10]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[7].Compare(a[11]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[7].Compare(a[10]) < 0 {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[6].Compare(a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[7].Compare(a[9]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[7].Compare(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[16].Compare(a[17]) < 0 {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[18].Compare(a[19]) < 0 {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[16].Compare(a[18]) < 0 {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[17].Compare(a[19]) < 0 {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[17].Compare(a[18]) < 0 {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[20].Compare(a[21]) < 0 {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[22].Compare(a[23]) < 0 {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[20].Compare(a[22]) < 0 {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[21].Compare(a[23]) < 0 {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[21].Compare(a[22]) < 0 {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[16].Compare(a[20]) < 0 {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[17].Compare(a[21]) < 0 {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[17].Compare(a[20]) < 0 {
+		a[17], a[20] = a[20], a[17]
+	}
+	if a[18].Compare(a[22]) < 0 {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[19].Compare(a[23]) < 0 {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[19].Compare(a[22]) < 0 {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[18].Compare(a[20]) < 0 {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[19].Compare(a[21]) < 0 {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[19].Compare(a[20]) < 0 {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[24].Compare(a[25]) < 0 {
+		a[24], a[25] = a[25], a[24]
+	}
+	if a[26].Compare(a[27]) < 0 {
+		a[26], a[27] = a[27], a[26]
+	}
+	if a[24].Compare(a[26]) < 0 {
+		a[24], a[26] = a[26], a[24]
+	}
+	if a[25].Compare(a[27]) < 0 {
+		a[25], a[27] = a[27], a[25]
+	}
+	if a[25].Compare(a[26]) < 0 {
+		a[25], a[26] = a[26], a[25]
+	}
+	if a[28].Compare(a[29]) < 0 {
+		a[28], a[29] = a[29], a[28]
+	}
+	if a[30].Compare(a[31]) < 0 {
+		a[30], a[31] = a[31], a[30]
+	}
+	if a[28].Compare(a[30]) < 0 {
+		a[28], a[30] = a[30], a[28]
+	}
+	if a[29].Compare(a[31]) < 0 {
+		a[29], a[31] = a[31], a[29]
+	}
+	if a[29].Compare(a[30]) < 0 {
+		a[29], a[30] = a[30], a[29]
+	}
+	if a[24].Compare(a[28]) < 0 {
+		a[24], a[28] = a[28], a[24]
+	}
+	if a[25].Compare(a[29]) < 0 {
+		a[25], a[29] = a[29], a[25]
+	}
+	if a[25].Compare(a[28]) < 0 {
+		a[25], a[28] = a[28], a[25]
+	}
+	if a[26].Compare(a[30]) < 0 {
+		a[26], a[30] = a[30], a[26]
+	}
+	if a[27].Compare(a[31]) < 0 {
+		a[27], a[31] = a[31], a[27]
+	}
+	if a[27].Compare(a[30]) < 0 {
+		a[27], a[30] = a[30], a[27]
+	}
+	if a[26].Compare(a[28]) < 0 {
+		a[26], a[28] = a[28], a[26]
+	}
+	if a[27].Compare(a[29]) < 0 {
+		a[27], a[29] = a[29], a[27]
+	}
+	if a[27].Compare(a[28]) < 0 {
+		a[27], a[28] = a[28], a[27]
+	}
+	if a[16].Compare(a[24]) < 0 {
+		a[16], a[24] = a[24], a[16]
+	}
+	if a[17].Compare(a[25]) < 0 {
+		a[17], a[25] = a[25], a[17]
+	}
+	if a[17].Compare(a[24]) < 0 {
+		a[17], a[24] = a[24], a[17]
+	}
+	if a[18].Compare(a[26]) < 0 {
+		a[18], a[26] = a[26], a[18]
+	}
+	if a[19].Compare(a[27]) < 0 {
+		a[19], a[27] = a[27], a[19]
+	}
+	if a[19].Compare(a[26]) < 0 {
+		a[19], a[26] = a[26], a[19]
+	}
+	if a[18].Compare(a[24]) < 0 {
+		a[18], a[24] = a[24], a[18]
+	}
+	if a[19].Compare(a[25]) < 0 {
+		a[19], a[25] = a[25], a[19]
+	}
+	if a[19].Compare(a[24]) < 0 {
+		a[19], a[24] = a[24], a[19]
+	}
+	if a[20].Compare(a[28]) < 0 {
+		a[20], a[28] = a[28], a[20]
+	}
+	if a[21].Compare(a[29]) < 0 {
+		a[21], a[29] = a[29], a[21]
+	}
+	if a[21].Compare(a[28]) < 0 {
+		a[21], a[28] = a[28], a[21]
+	}
+	if a[22].Compare(a[30]) < 0 {
+		a[22], a[30] = a[30], a[22]
+	}
+	if a[23].Compare(a[31]) < 0 {
+		a[23], a[31] = a[31], a[23]
+	}
+	if a[23].Compare(a[30]) < 0 {
+		a[23], a[30] = a[30], a[23]
+	}
+	if a[22].Compare(a[28]) < 0 {
+		a[22], a[28] = a[28], a[22]
+	}
+	if a[23].Compare(a[29]) < 0 {
+		a[23], a[29] = a[29], a[23]
+	}
+	if a[23].Compare(a[28]) < 0 {
+		a[23], a[28] = a[28], a[23]
+	}
+	if a[20].Compare(a[24]) < 0 {
+		a[20], a[24] = a[24], a[20]
+	}
+	if a[21].Compare(a[25]) < 0 {
+		a[21], a[25] = a[25], a[21]
+	}
+	if a[21].Compare(a[24]) < 0 {
+		a[21], a[24] = a[24], a[21]
+	}
+	if a[22].Compare(a[26]) < 0 {
+		a[22], a[26] = a[26], a[22]
+	}
+	if a[23].Compare(a[27]) < 0 {
+		a[23], a[27] = a[27], a[23]
+	}
+	if a[23].Compare(a[26]) < 0 {
+		a[23], a[26] = a[26], a[23]
+	}
+	if a[22].Compare(a[24]) < 0 {
+		a[22], a[24] = a[24], a[22]
+	}
+	if a[23].Compare(a[25]) < 0 {
+		a[23], a[25] = a[25], a[23]
+	}
+	if a[23].Compare(a[24]) < 0 {
+		a[23], a[24] = a[24], a[23]
+	}
+	if a[0].Compare(a[16]) < 0 {
+		a[0], a[16] = a[16], a[0]
+	}
+	if a[1].Compare(a[17]) < 0 {
+		a[1], a[17] = a[17], a[1]
+	}
+	if a[1].Compare(a[16]) < 0 {
+		a[1], a[16] = a[16], a[1]
+	}
+	if a[2].Compare(a[18]) < 0 {
+		a[2], a[18] = a[18], a[2]
+	}
+	if a[3].Compare(a[19]) < 0 {
+		a[3], a[19] = a[19], a[3]
+	}
+	if a[3].Compare(a[18]) < 0 {
+		a[3], a[18] = a[18], a[3]
+	}
+	if a[2].Compare(a[16]) < 0 {
+		a[2], a[16] = a[16], a[2]
+	}
+	if a[3].Compare(a[17]) < 0 {
+		a[3], a[17] = a[17], a[3]
+	}
+	if a[3].Compare(a[16]) < 0 {
+		a[3], a[16] = a[16], a[3]
+	}
+	if a[4].Compare(a[20]) < 0 {
+		a[4], a[20] = a[20], a[4]
+	}
+	if a[5].Compare(a[21]) < 0 {
+		a[5], a[21] = a[21], a[5]
+	}
+	if a[5].Compare(a[20]) < 0 {
+		a[5], a[20] = a[20], a[5]
+	}
+	if a[6].Compare(a[22]) < 0 {
+		a[6], a[22] = a[22], a[6]
+	}
+	if a[7].Compare(a[23]) < 0 {
+		a[7], a[23] = a[23], a[7]
+	}
+	if a[7].Compare(a[22]) < 0 {
+		a[7], a[22] = a[22], a[7]
+	}
+	if a[6].Compare(a[20]) < 0 {
+		a[6], a[20] = a[20], a[6]
+	}
+	if a[7].Compare(a[21]) < 0 {
+		a[7], a[21] = a[21], a[7]
+	}
+	if a[7].Compare(a[20]) < 0 {
+		a[7], a[20] = a[20], a[7]
+	}
+	if a[4].Compare(a[16]) < 0 {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[5].Compare(a[17]) < 0 {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[5].Compare(a[16]) < 0 {
+		a[5], a[16] = a[16], a[5]
+	}
+	if a[6].Compare(a[18]) < 0 {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[7].Compare(a[19]) < 0 {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[7].Compare(a[18]) < 0 {
+		a[7], a[18] = a[18], a[7]
+	}
+	if a[6].Compare(a[16]) < 0 {
+		a[6], a[16] = a[16], a[6]
+	}
+	if a[7].Compare(a[17]) < 0 {
+		a[7], a[17] = a[17], a[7]
+	}
+	if a[7].Compare(a[16]) < 0 {
+		a[7], a[16] = a[16], a[7]
+	}
+	if a[8].Compare(a[24]) < 0 {
+		a[8], a[24] = a[24], a[8]
+	}
+	if a[9].Compare(a[25]) < 0 {
+		a[9], a[25] = a[25], a[9]
+	}
+	if a[9].Compare(a[24]) < 0 {
+		a[9], a[24] = a[24], a[9]
+	}
+	if a[10].Compare(a[26]) < 0 {
+		a[10], a[26] = a[26], a[10]
+	}
+	if a[11].Compare(a[27]) < 0 {
+		a[11], a[27] = a[27], a[11]
+	}
+	if a[11].Compare(a[26]) < 0 {
+		a[11], a[26] = a[26], a[11]
+	}
+	if a[10].Compare(a[24]) < 0 {
+		a[10], a[24] = a[24], a[10]
+	}
+	if a[11].Compare(a[25]) < 0 {
+		a[11], a[25] = a[25], a[11]
+	}
+	if a[11].Compare(a[24]) < 0 {
+		a[11], a[24] = a[24], a[11]
+	}
+	if a[12].Compare(a[28]) < 0 {
+		a[12], a[28] = a[28], a[12]
+	}
+	if a[13].Compare(a[29]) < 0 {
+		a[13], a[29] = a[29], a[13]
+	}
+	if a[13].Compare(a[28]) < 0 {
+		a[13], a[28] = a[28], a[13]
+	}
+	if a[14].Compare(a[30]) < 0 {
+		a[14], a[30] = a[30], a[14]
+	}
+	if a[15].Compare(a[31]) < 0 {
+		a[15], a[31] = a[31], a[15]
+	}
+	if a[15].Compare(a[30]) < 0 {
+		a[15], a[30] = a[30], a[15]
+	}
+	if a[14].Compare(a[28]) < 0 {
+		a[14], a[28] = a[28], a[14]
+	}
+	if a[15].Compare(a[29]) < 0 {
+		a[15], a[29] = a[29], a[15]
+	}
+	if a[15].Compare(a[28]) < 0 {
+		a[15], a[28] = a[28], a[15]
+	}
+	if a[12].Compare(a[24]) < 0 {
+		a[12], a[24] = a[24], a[12]
+	}
+	if a[13].Compare(a[25]) < 0 {
+		a[13], a[25] = a[25], a[13]
+	}
+	if a[13].Compare(a[24]) < 0 {
+		a[13], a[24] = a[24], a[13]
+	}
+	if a[14].Compare(a[26]) < 0 {
+		a[14], a[26] = a[26], a[14]
+	}
+	if a[15].Compare(a[27]) < 0 {
+		a[15], a[27] = a[27], a[15]
+	}
+	if a[15].Compare(a[26]) < 0 {
+		a[15], a[26] = a[26], a[15]
+	}
+	if a[14].Compare(a[24]) < 0 {
+		a[14], a[24] = a[24], a[14]
+	}
+	if a[15].Compare(a[25]) < 0 {
+		a[15], a[25] = a[25], a[15]
+	}
+	if a[15].Compare(a[24]) < 0 {
+		a[15], a[24] = a[24], a[15]
+	}
+	if a[8].Compare(a[16]) < 0 {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[9].Compare(a[17]) < 0 {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[9].Compare(a[16]) < 0 {
+		a[9], a[16] = a[16], a[9]
+	}
+	if a[10].Compare(a[18]) < 0 {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[11].Compare(a[19]) < 0 {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[11].Compare(a[18]) < 0 {
+		a[11], a[18] = a[18], a[11]
+	}
+	if a[10].Compare(a[16]) < 0 {
+		a[10], a[16] = a[16], a[10]
+	}
+	if a[11].Compare(a[17]) < 0 {
+		a[11], a[17] = a[17], a[11]
+	}
+	if a[11].Compare(a[16]) < 0 {
+		a[11], a[16] = a[16], a[11]
+	}
+	if a[12].Compare(a[20]) < 0 {
+		a[12], a[20] = a[20], a[12]
+	}
+	if a[13].Compare(a[21]) < 0 {
+		a[13], a[21] = a[21], a[13]
+	}
+	if a[13].Compare(a[20]) < 0 {
+		a[13], a[20] = a[20], a[13]
+	}
+	if a[14].Compare(a[22]) < 0 {
+		a[14], a[22] = a[22], a[14]
+	}
+	if a[15].Compare(a[23]) < 0 {
+		a[15], a[23] = a[23], a[15]
+	}
+	if a[15].Compare(a[22]) < 0 {
+		a[15], a[22] = a[22], a[15]
+	}
+	if a[14].Compare(a[20]) < 0 {
+		a[14], a[20] = a[20], a[14]
+	}
+	if a[15].Compare(a[21]) < 0 {
+		a[15], a[21] = a[21], a[15]
+	}
+	if a[15].Compare(a[20]) < 0 {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[12].Compare(a[16]) < 0 {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[13].Compare(a[17]) < 0 {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[13].Compare(a[16]) < 0 {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[14].Compare(a[18]) < 0 {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[15].Compare(a[19]) < 0 {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[15].Compare(a[18]) < 0 {
+		a[15], a[18] = a[18], a[15]
+	}
+	if a[14].Compare(a[16]) < 0 {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[15].Compare(a[17]) < 0 {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[15].Compare(a[16]) < 0 {
+		a[15], a[16] = a[16], a[15]
+	}
+}
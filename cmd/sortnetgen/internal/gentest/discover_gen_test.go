@@ -0,0 +1,932 @@
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
+
+package gentest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/shabbyrobe/sortnet/sortnettest"
+)
+
+// testDiscoverGenCase is a function generated by sortnetgen, adapted to be checked by
+// sortnettest. Either sort or merge is set.
+type testDiscoverGenCase[T any] struct {
+	name  string
+	size  int
+	sort  func(a []T)
+	merge func(dst []T, runs [][]T) []T
+}
+
+// testDiscoverGenCasesNetworkSortCelsius returns the functions generated for Celsius
+// in increasing order, along with how to make random items and how they are ordered.
+func testDiscoverGenCasesNetworkSortCelsius() (gen func(*rand.Rand) Celsius, before func(a, b Celsius) bool, cases []testDiscoverGenCase[Celsius]) {
+	gen = func(rng *rand.Rand) Celsius { return Celsius(float64(rng.Intn(32)-16) / 4) }
+	less := func(a, b Celsius) bool { return a < b }
+	before = less
+	cases = []testDiscoverGenCase[Celsius]{
+		{name: "NetworkSort2xCelsius", size: 2, sort: func(a []Celsius) { NetworkSort2xCelsius(a) }},
+		{name: "NetworkSort3xCelsius", size: 3, sort: func(a []Celsius) { NetworkSort3xCelsius(a) }},
+		{name: "NetworkSort4xCelsius", size: 4, sort: func(a []Celsius) { NetworkSort4xCelsius(a) }},
+		{name: "NetworkSort5xCelsius", size: 5, sort: func(a []Celsius) { NetworkSort5xCelsius(a) }},
+		{name: "NetworkSort6xCelsius", size: 6, sort: func(a []Celsius) { NetworkSort6xCelsius(a) }},
+		{name: "NetworkSort7xCelsius", size: 7, sort: func(a []Celsius) { NetworkSort7xCelsius(a) }},
+		{name: "NetworkSort8xCelsius", size: 8, sort: func(a []Celsius) { NetworkSort8xCelsius(a) }},
+		{name: "NetworkSort9xCelsius", size: 9, sort: func(a []Celsius) { NetworkSort9xCelsius(a) }},
+		{name: "NetworkSort10xCelsius", size: 10, sort: func(a []Celsius) { NetworkSort10xCelsius(a) }},
+		{name: "NetworkSort11xCelsius", size: 11, sort: func(a []Celsius) { NetworkSort11xCelsius(a) }},
+		{name: "NetworkSort12xCelsius", size: 12, sort: func(a []Celsius) { NetworkSort12xCelsius(a) }},
+		{name: "NetworkSort13xCelsius", size: 13, sort: func(a []Celsius) { NetworkSort13xCelsius(a) }},
+		{name: "NetworkSort14xCelsius", size: 14, sort: func(a []Celsius) { NetworkSort14xCelsius(a) }},
+		{name: "NetworkSort15xCelsius", size: 15, sort: func(a []Celsius) { NetworkSort15xCelsius(a) }},
+		{name: "NetworkSort16xCelsius", size: 16, sort: func(a []Celsius) { NetworkSort16xCelsius(a) }},
+		{name: "NetworkSort24xCelsius", size: 24, sort: func(a []Celsius) { NetworkSort24xCelsius(a) }},
+		{name: "NetworkSort32xCelsius", size: 32, sort: func(a []Celsius) { NetworkSort32xCelsius(a) }},
+		{name: "NetworkSortCelsius-2", size: 2, sort: func(a []Celsius) {
+			if !NetworkSortCelsius(a, len(a)) {
+				panic("NetworkSortCelsius: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortCelsius-3", size: 3, sort: func(a []Celsius) {
+			if !NetworkSortCelsius(a, len(a)) {
+				panic("NetworkSortCelsius: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortCelsius-4", size: 4, sort: func(a []Celsius) {
+			if !NetworkSortCelsius(a, len(a)) {
+				panic("NetworkSortCelsius: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortCelsius-5", size: 5, sort: func(a []Celsius) {
+			if !NetworkSortCelsius(a, len(a)) {
+				panic("NetworkSortCelsius: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortCelsius-6", size: 6, sort: func(a []Celsius) {
+			if !NetworkSortCelsius(a, len(a)) {
+				panic("NetworkSortCelsius: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortCelsius-7", size: 7, sort: func(a []Celsius) {
+			if !NetworkSortCelsius(a, len(a)) {
+				panic("NetworkSortCelsius: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortCelsius-8", size: 8, sort: func(a []Celsius) {
+			if !NetworkSortCelsius(a, len(a)) {
+				panic("NetworkSortCelsius: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortCelsius-9", size: 9, sort: func(a []Celsius) {
+			if !NetworkSortCelsius(a, len(a)) {
+				panic("NetworkSortCelsius: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortCelsius-10", size: 10, sort: func(a []Celsius) {
+			if !NetworkSortCelsius(a, len(a)) {
+				panic("NetworkSortCelsius: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortCelsius-11", size: 11, sort: func(a []Celsius) {
+			if !NetworkSortCelsius(a, len(a)) {
+				panic("NetworkSortCelsius: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortCelsius-12", size: 12, sort: func(a []Celsius) {
+			if !NetworkSortCelsius(a, len(a)) {
+				panic("NetworkSortCelsius: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortCelsius-13", size: 13, sort: func(a []Celsius) {
+			if !NetworkSortCelsius(a, len(a)) {
+				panic("NetworkSortCelsius: no sorter for size 13")
+			}
+		}},
+		{name: "NetworkSortCelsius-14", size: 14, sort: func(a []Celsius) {
+			if !NetworkSortCelsius(a, len(a)) {
+				panic("NetworkSortCelsius: no sorter for size 14")
+			}
+		}},
+		{name: "NetworkSortCelsius-15", size: 15, sort: func(a []Celsius) {
+			if !NetworkSortCelsius(a, len(a)) {
+				panic("NetworkSortCelsius: no sorter for size 15")
+			}
+		}},
+		{name: "NetworkSortCelsius-16", size: 16, sort: func(a []Celsius) {
+			if !NetworkSortCelsius(a, len(a)) {
+				panic("NetworkSortCelsius: no sorter for size 16")
+			}
+		}},
+		{name: "NetworkSortCelsius-24", size: 24, sort: func(a []Celsius) {
+			if !NetworkSortCelsius(a, len(a)) {
+				panic("NetworkSortCelsius: no sorter for size 24")
+			}
+		}},
+		{name: "NetworkSortCelsius-32", size: 32, sort: func(a []Celsius) {
+			if !NetworkSortCelsius(a, len(a)) {
+				panic("NetworkSortCelsius: no sorter for size 32")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestDiscoverGenNetworkSortCelsius(t *testing.T) {
+	gen, before, cases := testDiscoverGenCasesNetworkSortCelsius()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzDiscoverGenNetworkSortCelsius(f *testing.F) {
+	gen, before, cases := testDiscoverGenCasesNetworkSortCelsius()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]Celsius, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkDiscoverGenNetworkSortCelsius(b *testing.B) {
+	gen, before, cases := testDiscoverGenCasesNetworkSortCelsius()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testDiscoverGenCasesNetworkSortCelsiusReverse returns the functions generated for Celsius
+// in decreasing order, along with how to make random items and how they are ordered.
+func testDiscoverGenCasesNetworkSortCelsiusReverse() (gen func(*rand.Rand) Celsius, before func(a, b Celsius) bool, cases []testDiscoverGenCase[Celsius]) {
+	gen = func(rng *rand.Rand) Celsius { return Celsius(float64(rng.Intn(32)-16) / 4) }
+	less := func(a, b Celsius) bool { return a < b }
+	before = func(a, b Celsius) bool { return less(b, a) }
+	cases = []testDiscoverGenCase[Celsius]{
+		{name: "NetworkSort2xCelsiusReverse", size: 2, sort: func(a []Celsius) { NetworkSort2xCelsiusReverse(a) }},
+		{name: "NetworkSort3xCelsiusReverse", size: 3, sort: func(a []Celsius) { NetworkSort3xCelsiusReverse(a) }},
+		{name: "NetworkSort4xCelsiusReverse", size: 4, sort: func(a []Celsius) { NetworkSort4xCelsiusReverse(a) }},
+		{name: "NetworkSort5xCelsiusReverse", size: 5, sort: func(a []Celsius) { NetworkSort5xCelsiusReverse(a) }},
+		{name: "NetworkSort6xCelsiusReverse", size: 6, sort: func(a []Celsius) { NetworkSort6xCelsiusReverse(a) }},
+		{name: "NetworkSort7xCelsiusReverse", size: 7, sort: func(a []Celsius) { NetworkSort7xCelsiusReverse(a) }},
+		{name: "NetworkSort8xCelsiusReverse", size: 8, sort: func(a []Celsius) { NetworkSort8xCelsiusReverse(a) }},
+		{name: "NetworkSort9xCelsiusReverse", size: 9, sort: func(a []Celsius) { NetworkSort9xCelsiusReverse(a) }},
+		{name: "NetworkSort10xCelsiusReverse", size: 10, sort: func(a []Celsius) { NetworkSort10xCelsiusReverse(a) }},
+		{name: "NetworkSort11xCelsiusReverse", size: 11, sort: func(a []Celsius) { NetworkSort11xCelsiusReverse(a) }},
+		{name: "NetworkSort12xCelsiusReverse", size: 12, sort: func(a []Celsius) { NetworkSort12xCelsiusReverse(a) }},
+		{name: "NetworkSort13xCelsiusReverse", size: 13, sort: func(a []Celsius) { NetworkSort13xCelsiusReverse(a) }},
+		{name: "NetworkSort14xCelsiusReverse", size: 14, sort: func(a []Celsius) { NetworkSort14xCelsiusReverse(a) }},
+		{name: "NetworkSort15xCelsiusReverse", size: 15, sort: func(a []Celsius) { NetworkSort15xCelsiusReverse(a) }},
+		{name: "NetworkSort16xCelsiusReverse", size: 16, sort: func(a []Celsius) { NetworkSort16xCelsiusReverse(a) }},
+		{name: "NetworkSort24xCelsiusReverse", size: 24, sort: func(a []Celsius) { NetworkSort24xCelsiusReverse(a) }},
+		{name: "NetworkSort32xCelsiusReverse", size: 32, sort: func(a []Celsius) { NetworkSort32xCelsiusReverse(a) }},
+		{name: "NetworkSortCelsiusReverse-2", size: 2, sort: func(a []Celsius) {
+			if !NetworkSortCelsiusReverse(a, len(a)) {
+				panic("NetworkSortCelsiusReverse: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortCelsiusReverse-3", size: 3, sort: func(a []Celsius) {
+			if !NetworkSortCelsiusReverse(a, len(a)) {
+				panic("NetworkSortCelsiusReverse: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortCelsiusReverse-4", size: 4, sort: func(a []Celsius) {
+			if !NetworkSortCelsiusReverse(a, len(a)) {
+				panic("NetworkSortCelsiusReverse: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortCelsiusReverse-5", size: 5, sort: func(a []Celsius) {
+			if !NetworkSortCelsiusReverse(a, len(a)) {
+				panic("NetworkSortCelsiusReverse: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortCelsiusReverse-6", size: 6, sort: func(a []Celsius) {
+			if !NetworkSortCelsiusReverse(a, len(a)) {
+				panic("NetworkSortCelsiusReverse: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortCelsiusReverse-7", size: 7, sort: func(a []Celsius) {
+			if !NetworkSortCelsiusReverse(a, len(a)) {
+				panic("NetworkSortCelsiusReverse: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortCelsiusReverse-8", size: 8, sort: func(a []Celsius) {
+			if !NetworkSortCelsiusReverse(a, len(a)) {
+				panic("NetworkSortCelsiusReverse: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortCelsiusReverse-9", size: 9, sort: func(a []Celsius) {
+			if !NetworkSortCelsiusReverse(a, len(a)) {
+				panic("NetworkSortCelsiusReverse: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortCelsiusReverse-10", size: 10, sort: func(a []Celsius) {
+			if !NetworkSortCelsiusReverse(a, len(a)) {
+				panic("NetworkSortCelsiusReverse: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortCelsiusReverse-11", size: 11, sort: func(a []Celsius) {
+			if !NetworkSortCelsiusReverse(a, len(a)) {
+				panic("NetworkSortCelsiusReverse: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortCelsiusReverse-12", size: 12, sort: func(a []Celsius) {
+			if !NetworkSortCelsiusReverse(a, len(a)) {
+				panic("NetworkSortCelsiusReverse: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortCelsiusReverse-13", size: 13, sort: func(a []Celsius) {
+			if !NetworkSortCelsiusReverse(a, len(a)) {
+				panic("NetworkSortCelsiusReverse: no sorter for size 13")
+			}
+		}},
+		{name: "NetworkSortCelsiusReverse-14", size: 14, sort: func(a []Celsius) {
+			if !NetworkSortCelsiusReverse(a, len(a)) {
+				panic("NetworkSortCelsiusReverse: no sorter for size 14")
+			}
+		}},
+		{name: "NetworkSortCelsiusReverse-15", size: 15, sort: func(a []Celsius) {
+			if !NetworkSortCelsiusReverse(a, len(a)) {
+				panic("NetworkSortCelsiusReverse: no sorter for size 15")
+			}
+		}},
+		{name: "NetworkSortCelsiusReverse-16", size: 16, sort: func(a []Celsius) {
+			if !NetworkSortCelsiusReverse(a, len(a)) {
+				panic("NetworkSortCelsiusReverse: no sorter for size 16")
+			}
+		}},
+		{name: "NetworkSortCelsiusReverse-24", size: 24, sort: func(a []Celsius) {
+			if !NetworkSortCelsiusReverse(a, len(a)) {
+				panic("NetworkSortCelsiusReverse: no sorter for size 24")
+			}
+		}},
+		{name: "NetworkSortCelsiusReverse-32", size: 32, sort: func(a []Celsius) {
+			if !NetworkSortCelsiusReverse(a, len(a)) {
+				panic("NetworkSortCelsiusReverse: no sorter for size 32")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestDiscoverGenNetworkSortCelsiusReverse(t *testing.T) {
+	gen, before, cases := testDiscoverGenCasesNetworkSortCelsiusReverse()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzDiscoverGenNetworkSortCelsiusReverse(f *testing.F) {
+	gen, before, cases := testDiscoverGenCasesNetworkSortCelsiusReverse()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]Celsius, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkDiscoverGenNetworkSortCelsiusReverse(b *testing.B) {
+	gen, before, cases := testDiscoverGenCasesNetworkSortCelsiusReverse()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testDiscoverGenCasesNetworkSortVersion returns the functions generated for Version
+// in increasing order, along with how to make random items and how they are ordered.
+func testDiscoverGenCasesNetworkSortVersion() (gen func(*rand.Rand) Version, before func(a, b Version) bool, cases []testDiscoverGenCase[Version]) {
+	gen = func(rng *rand.Rand) Version { return Version{Major: rng.Intn(32) - 16, Minor: rng.Intn(32) - 16} }
+	less := func(a, b Version) bool { return a.Compare(b) < 0 }
+	before = less
+	cases = []testDiscoverGenCase[Version]{
+		{name: "NetworkSort2xVersion", size: 2, sort: func(a []Version) { NetworkSort2xVersion(a) }},
+		{name: "NetworkSort3xVersion", size: 3, sort: func(a []Version) { NetworkSort3xVersion(a) }},
+		{name: "NetworkSort4xVersion", size: 4, sort: func(a []Version) { NetworkSort4xVersion(a) }},
+		{name: "NetworkSort5xVersion", size: 5, sort: func(a []Version) { NetworkSort5xVersion(a) }},
+		{name: "NetworkSort6xVersion", size: 6, sort: func(a []Version) { NetworkSort6xVersion(a) }},
+		{name: "NetworkSort7xVersion", size: 7, sort: func(a []Version) { NetworkSort7xVersion(a) }},
+		{name: "NetworkSort8xVersion", size: 8, sort: func(a []Version) { NetworkSort8xVersion(a) }},
+		{name: "NetworkSort9xVersion", size: 9, sort: func(a []Version) { NetworkSort9xVersion(a) }},
+		{name: "NetworkSort10xVersion", size: 10, sort: func(a []Version) { NetworkSort10xVersion(a) }},
+		{name: "NetworkSort11xVersion", size: 11, sort: func(a []Version) { NetworkSort11xVersion(a) }},
+		{name: "NetworkSort12xVersion", size: 12, sort: func(a []Version) { NetworkSort12xVersion(a) }},
+		{name: "NetworkSort13xVersion", size: 13, sort: func(a []Version) { NetworkSort13xVersion(a) }},
+		{name: "NetworkSort14xVersion", size: 14, sort: func(a []Version) { NetworkSort14xVersion(a) }},
+		{name: "NetworkSort15xVersion", size: 15, sort: func(a []Version) { NetworkSort15xVersion(a) }},
+		{name: "NetworkSort16xVersion", size: 16, sort: func(a []Version) { NetworkSort16xVersion(a) }},
+		{name: "NetworkSort24xVersion", size: 24, sort: func(a []Version) { NetworkSort24xVersion(a) }},
+		{name: "NetworkSort32xVersion", size: 32, sort: func(a []Version) { NetworkSort32xVersion(a) }},
+		{name: "NetworkSortVersion-2", size: 2, sort: func(a []Version) {
+			if !NetworkSortVersion(a, len(a)) {
+				panic("NetworkSortVersion: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortVersion-3", size: 3, sort: func(a []Version) {
+			if !NetworkSortVersion(a, len(a)) {
+				panic("NetworkSortVersion: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortVersion-4", size: 4, sort: func(a []Version) {
+			if !NetworkSortVersion(a, len(a)) {
+				panic("NetworkSortVersion: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortVersion-5", size: 5, sort: func(a []Version) {
+			if !NetworkSortVersion(a, len(a)) {
+				panic("NetworkSortVersion: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortVersion-6", size: 6, sort: func(a []Version) {
+			if !NetworkSortVersion(a, len(a)) {
+				panic("NetworkSortVersion: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortVersion-7", size: 7, sort: func(a []Version) {
+			if !NetworkSortVersion(a, len(a)) {
+				panic("NetworkSortVersion: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortVersion-8", size: 8, sort: func(a []Version) {
+			if !NetworkSortVersion(a, len(a)) {
+				panic("NetworkSortVersion: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortVersion-9", size: 9, sort: func(a []Version) {
+			if !NetworkSortVersion(a, len(a)) {
+				panic("NetworkSortVersion: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortVersion-10", size: 10, sort: func(a []Version) {
+			if !NetworkSortVersion(a, len(a)) {
+				panic("NetworkSortVersion: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortVersion-11", size: 11, sort: func(a []Version) {
+			if !NetworkSortVersion(a, len(a)) {
+				panic("NetworkSortVersion: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortVersion-12", size: 12, sort: func(a []Version) {
+			if !NetworkSortVersion(a, len(a)) {
+				panic("NetworkSortVersion: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortVersion-13", size: 13, sort: func(a []Version) {
+			if !NetworkSortVersion(a, len(a)) {
+				panic("NetworkSortVersion: no sorter for size 13")
+			}
+		}},
+		{name: "NetworkSortVersion-14", size: 14, sort: func(a []Version) {
+			if !NetworkSortVersion(a, len(a)) {
+				panic("NetworkSortVersion: no sorter for size 14")
+			}
+		}},
+		{name: "NetworkSortVersion-15", size: 15, sort: func(a []Version) {
+			if !NetworkSortVersion(a, len(a)) {
+				panic("NetworkSortVersion: no sorter for size 15")
+			}
+		}},
+		{name: "NetworkSortVersion-16", size: 16, sort: func(a []Version) {
+			if !NetworkSortVersion(a, len(a)) {
+				panic("NetworkSortVersion: no sorter for size 16")
+			}
+		}},
+		{name: "NetworkSortVersion-24", size: 24, sort: func(a []Version) {
+			if !NetworkSortVersion(a, len(a)) {
+				panic("NetworkSortVersion: no sorter for size 24")
+			}
+		}},
+		{name: "NetworkSortVersion-32", size: 32, sort: func(a []Version) {
+			if !NetworkSortVersion(a, len(a)) {
+				panic("NetworkSortVersion: no sorter for size 32")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestDiscoverGenNetworkSortVersion(t *testing.T) {
+	gen, before, cases := testDiscoverGenCasesNetworkSortVersion()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzDiscoverGenNetworkSortVersion(f *testing.F) {
+	gen, before, cases := testDiscoverGenCasesNetworkSortVersion()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]Version, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkDiscoverGenNetworkSortVersion(b *testing.B) {
+	gen, before, cases := testDiscoverGenCasesNetworkSortVersion()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testDiscoverGenCasesNetworkSortVersionReverse returns the functions generated for Version
+// in decreasing order, along with how to make random items and how they are ordered.
+func testDiscoverGenCasesNetworkSortVersionReverse() (gen func(*rand.Rand) Version, before func(a, b Version) bool, cases []testDiscoverGenCase[Version]) {
+	gen = func(rng *rand.Rand) Version { return Version{Major: rng.Intn(32) - 16, Minor: rng.Intn(32) - 16} }
+	less := func(a, b Version) bool { return a.Compare(b) < 0 }
+	before = func(a, b Version) bool { return less(b, a) }
+	cases = []testDiscoverGenCase[Version]{
+		{name: "NetworkSort2xVersionReverse", size: 2, sort: func(a []Version) { NetworkSort2xVersionReverse(a) }},
+		{name: "NetworkSort3xVersionReverse", size: 3, sort: func(a []Version) { NetworkSort3xVersionReverse(a) }},
+		{name: "NetworkSort4xVersionReverse", size: 4, sort: func(a []Version) { NetworkSort4xVersionReverse(a) }},
+		{name: "NetworkSort5xVersionReverse", size: 5, sort: func(a []Version) { NetworkSort5xVersionReverse(a) }},
+		{name: "NetworkSort6xVersionReverse", size: 6, sort: func(a []Version) { NetworkSort6xVersionReverse(a) }},
+		{name: "NetworkSort7xVersionReverse", size: 7, sort: func(a []Version) { NetworkSort7xVersionReverse(a) }},
+		{name: "NetworkSort8xVersionReverse", size: 8, sort: func(a []Version) { NetworkSort8xVersionReverse(a) }},
+		{name: "NetworkSort9xVersionReverse", size: 9, sort: func(a []Version) { NetworkSort9xVersionReverse(a) }},
+		{name: "NetworkSort10xVersionReverse", size: 10, sort: func(a []Version) { NetworkSort10xVersionReverse(a) }},
+		{name: "NetworkSort11xVersionReverse", size: 11, sort: func(a []Version) { NetworkSort11xVersionReverse(a) }},
+		{name: "NetworkSort12xVersionReverse", size: 12, sort: func(a []Version) { NetworkSort12xVersionReverse(a) }},
+		{name: "NetworkSort13xVersionReverse", size: 13, sort: func(a []Version) { NetworkSort13xVersionReverse(a) }},
+		{name: "NetworkSort14xVersionReverse", size: 14, sort: func(a []Version) { NetworkSort14xVersionReverse(a) }},
+		{name: "NetworkSort15xVersionReverse", size: 15, sort: func(a []Version) { NetworkSort15xVersionReverse(a) }},
+		{name: "NetworkSort16xVersionReverse", size: 16, sort: func(a []Version) { NetworkSort16xVersionReverse(a) }},
+		{name: "NetworkSort24xVersionReverse", size: 24, sort: func(a []Version) { NetworkSort24xVersionReverse(a) }},
+		{name: "NetworkSort32xVersionReverse", size: 32, sort: func(a []Version) { NetworkSort32xVersionReverse(a) }},
+		{name: "NetworkSortVersionReverse-2", size: 2, sort: func(a []Version) {
+			if !NetworkSortVersionReverse(a, len(a)) {
+				panic("NetworkSortVersionReverse: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortVersionReverse-3", size: 3, sort: func(a []Version) {
+			if !NetworkSortVersionReverse(a, len(a)) {
+				panic("NetworkSortVersionReverse: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortVersionReverse-4", size: 4, sort: func(a []Version) {
+			if !NetworkSortVersionReverse(a, len(a)) {
+				panic("NetworkSortVersionReverse: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortVersionReverse-5", size: 5, sort: func(a []Version) {
+			if !NetworkSortVersionReverse(a, len(a)) {
+				panic("NetworkSortVersionReverse: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortVersionReverse-6", size: 6, sort: func(a []Version) {
+			if !NetworkSortVersionReverse(a, len(a)) {
+				panic("NetworkSortVersionReverse: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortVersionReverse-7", size: 7, sort: func(a []Version) {
+			if !NetworkSortVersionReverse(a, len(a)) {
+				panic("NetworkSortVersionReverse: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortVersionReverse-8", size: 8, sort: func(a []Version) {
+			if !NetworkSortVersionReverse(a, len(a)) {
+				panic("NetworkSortVersionReverse: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortVersionReverse-9", size: 9, sort: func(a []Version) {
+			if !NetworkSortVersionReverse(a, len(a)) {
+				panic("NetworkSortVersionReverse: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortVersionReverse-10", size: 10, sort: func(a []Version) {
+			if !NetworkSortVersionReverse(a, len(a)) {
+				panic("NetworkSortVersionReverse: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortVersionReverse-11", size: 11, sort: func(a []Version) {
+			if !NetworkSortVersionReverse(a, len(a)) {
+				panic("NetworkSortVersionReverse: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortVersionReverse-12", size: 12, sort: func(a []Version) {
+			if !NetworkSortVersionReverse(a, len(a)) {
+				panic("NetworkSortVersionReverse: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortVersionReverse-13", size: 13, sort: func(a []Version) {
+			if !NetworkSortVersionReverse(a, len(a)) {
+				panic("NetworkSortVersionReverse: no sorter for size 13")
+			}
+		}},
+		{name: "NetworkSortVersionReverse-14", size: 14, sort: func(a []Version) {
+			if !NetworkSortVersionReverse(a, len(a)) {
+				panic("NetworkSortVersionReverse: no sorter for size 14")
+			}
+		}},
+		{name: "NetworkSortVersionReverse-15", size: 15, sort: func(a []Version) {
+			if !NetworkSortVersionReverse(a, len(a)) {
+				panic("NetworkSortVersionReverse: no sorter for size 15")
+			}
+		}},
+		{name: "NetworkSortVersionReverse-16", size: 16, sort: func(a []Version) {
+			if !NetworkSortVersionReverse(a, len(a)) {
+				panic("NetworkSortVersionReverse: no sorter for size 16")
+			}
+		}},
+		{name: "NetworkSortVersionReverse-24", size: 24, sort: func(a []Version) {
+			if !NetworkSortVersionReverse(a, len(a)) {
+				panic("NetworkSortVersionReverse: no sorter for size 24")
+			}
+		}},
+		{name: "NetworkSortVersionReverse-32", size: 32, sort: func(a []Version) {
+			if !NetworkSortVersionReverse(a, len(a)) {
+				panic("NetworkSortVersionReverse: no sorter for size 32")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestDiscoverGenNetworkSortVersionReverse(t *testing.T) {
+	gen, before, cases := testDiscoverGenCasesNetworkSortVersionReverse()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzDiscoverGenNetworkSortVersionReverse(f *testing.F) {
+	gen, before, cases := testDiscoverGenCasesNetworkSortVersionReverse()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]Version, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkDiscoverGenNetworkSortVersionReverse(b *testing.B) {
+	gen, before, cases := testDiscoverGenCasesNetworkSortVersionReverse()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testDiscoverGenCasesNetworkSortPriority returns the functions generated for Priority
+// in increasing order, along with how to make random items and how they are ordered.
+func testDiscoverGenCasesNetworkSortPriority() (gen func(*rand.Rand) Priority, before func(a, b Priority) bool, cases []testDiscoverGenCase[Priority]) {
+	gen = func(rng *rand.Rand) Priority { return Priority{Level: rng.Intn(32) - 16} }
+	less := func(a, b Priority) bool { return a.Less(b) }
+	before = less
+	cases = []testDiscoverGenCase[Priority]{
+		{name: "NetworkSort2xPriority", size: 2, sort: func(a []Priority) { NetworkSort2xPriority(a) }},
+		{name: "NetworkSort3xPriority", size: 3, sort: func(a []Priority) { NetworkSort3xPriority(a) }},
+		{name: "NetworkSort4xPriority", size: 4, sort: func(a []Priority) { NetworkSort4xPriority(a) }},
+		{name: "NetworkSort5xPriority", size: 5, sort: func(a []Priority) { NetworkSort5xPriority(a) }},
+		{name: "NetworkSort6xPriority", size: 6, sort: func(a []Priority) { NetworkSort6xPriority(a) }},
+		{name: "NetworkSort7xPriority", size: 7, sort: func(a []Priority) { NetworkSort7xPriority(a) }},
+		{name: "NetworkSort8xPriority", size: 8, sort: func(a []Priority) { NetworkSort8xPriority(a) }},
+		{name: "NetworkSort9xPriority", size: 9, sort: func(a []Priority) { NetworkSort9xPriority(a) }},
+		{name: "NetworkSort10xPriority", size: 10, sort: func(a []Priority) { NetworkSort10xPriority(a) }},
+		{name: "NetworkSort11xPriority", size: 11, sort: func(a []Priority) { NetworkSort11xPriority(a) }},
+		{name: "NetworkSort12xPriority", size: 12, sort: func(a []Priority) { NetworkSort12xPriority(a) }},
+		{name: "NetworkSort13xPriority", size: 13, sort: func(a []Priority) { NetworkSort13xPriority(a) }},
+		{name: "NetworkSort14xPriority", size: 14, sort: func(a []Priority) { NetworkSort14xPriority(a) }},
+		{name: "NetworkSort15xPriority", size: 15, sort: func(a []Priority) { NetworkSort15xPriority(a) }},
+		{name: "NetworkSort16xPriority", size: 16, sort: func(a []Priority) { NetworkSort16xPriority(a) }},
+		{name: "NetworkSort24xPriority", size: 24, sort: func(a []Priority) { NetworkSort24xPriority(a) }},
+		{name: "NetworkSort32xPriority", size: 32, sort: func(a []Priority) { NetworkSort32xPriority(a) }},
+		{name: "NetworkSortPriority-2", size: 2, sort: func(a []Priority) {
+			if !NetworkSortPriority(a, len(a)) {
+				panic("NetworkSortPriority: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortPriority-3", size: 3, sort: func(a []Priority) {
+			if !NetworkSortPriority(a, len(a)) {
+				panic("NetworkSortPriority: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortPriority-4", size: 4, sort: func(a []Priority) {
+			if !NetworkSortPriority(a, len(a)) {
+				panic("NetworkSortPriority: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortPriority-5", size: 5, sort: func(a []Priority) {
+			if !NetworkSortPriority(a, len(a)) {
+				panic("NetworkSortPriority: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortPriority-6", size: 6, sort: func(a []Priority) {
+			if !NetworkSortPriority(a, len(a)) {
+				panic("NetworkSortPriority: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortPriority-7", size: 7, sort: func(a []Priority) {
+			if !NetworkSortPriority(a, len(a)) {
+				panic("NetworkSortPriority: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortPriority-8", size: 8, sort: func(a []Priority) {
+			if !NetworkSortPriority(a, len(a)) {
+				panic("NetworkSortPriority: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortPriority-9", size: 9, sort: func(a []Priority) {
+			if !NetworkSortPriority(a, len(a)) {
+				panic("NetworkSortPriority: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortPriority-10", size: 10, sort: func(a []Priority) {
+			if !NetworkSortPriority(a, len(a)) {
+				panic("NetworkSortPriority: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortPriority-11", size: 11, sort: func(a []Priority) {
+			if !NetworkSortPriority(a, len(a)) {
+				panic("NetworkSortPriority: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortPriority-12", size: 12, sort: func(a []Priority) {
+			if !NetworkSortPriority(a, len(a)) {
+				panic("NetworkSortPriority: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortPriority-13", size: 13, sort: func(a []Priority) {
+			if !NetworkSortPriority(a, len(a)) {
+				panic("NetworkSortPriority: no sorter for size 13")
+			}
+		}},
+		{name: "NetworkSortPriority-14", size: 14, sort: func(a []Priority) {
+			if !NetworkSortPriority(a, len(a)) {
+				panic("NetworkSortPriority: no sorter for size 14")
+			}
+		}},
+		{name: "NetworkSortPriority-15", size: 15, sort: func(a []Priority) {
+			if !NetworkSortPriority(a, len(a)) {
+				panic("NetworkSortPriority: no sorter for size 15")
+			}
+		}},
+		{name: "NetworkSortPriority-16", size: 16, sort: func(a []Priority) {
+			if !NetworkSortPriority(a, len(a)) {
+				panic("NetworkSortPriority: no sorter for size 16")
+			}
+		}},
+		{name: "NetworkSortPriority-24", size: 24, sort: func(a []Priority) {
+			if !NetworkSortPriority(a, len(a)) {
+				panic("NetworkSortPriority: no sorter for size 24")
+			}
+		}},
+		{name: "NetworkSortPriority-32", size: 32, sort: func(a []Priority) {
+			if !NetworkSortPriority(a, len(a)) {
+				panic("NetworkSortPriority: no sorter for size 32")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestDiscoverGenNetworkSortPriority(t *testing.T) {
+	gen, before, cases := testDiscoverGenCasesNetworkSortPriority()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzDiscoverGenNetworkSortPriority(f *testing.F) {
+	gen, before, cases := testDiscoverGenCasesNetworkSortPriority()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]Priority, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkDiscoverGenNetworkSortPriority(b *testing.B) {
+	gen, before, cases := testDiscoverGenCasesNetworkSortPriority()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testDiscoverGenCasesNetworkSortPriorityReverse returns the functions generated for Priority
+// in decreasing order, along with how to make random items and how they are ordered.
+func testDiscoverGenCasesNetworkSortPriorityReverse() (gen func(*rand.Rand) Priority, before func(a, b Priority) bool, cases []testDiscoverGenCase[Priority]) {
+	gen = func(rng *rand.Rand) Priority { return Priority{Level: rng.Intn(32) - 16} }
+	less := func(a, b Priority) bool { return a.Less(b) }
+	before = func(a, b Priority) bool { return less(b, a) }
+	cases = []testDiscoverGenCase[Priority]{
+		{name: "NetworkSort2xPriorityReverse", size: 2, sort: func(a []Priority) { NetworkSort2xPriorityReverse(a) }},
+		{name: "NetworkSort3xPriorityReverse", size: 3, sort: func(a []Priority) { NetworkSort3xPriorityReverse(a) }},
+		{name: "NetworkSort4xPriorityReverse", size: 4, sort: func(a []Priority) { NetworkSort4xPriorityReverse(a) }},
+		{name: "NetworkSort5xPriorityReverse", size: 5, sort: func(a []Priority) { NetworkSort5xPriorityReverse(a) }},
+		{name: "NetworkSort6xPriorityReverse", size: 6, sort: func(a []Priority) { NetworkSort6xPriorityReverse(a) }},
+		{name: "NetworkSort7xPriorityReverse", size: 7, sort: func(a []Priority) { NetworkSort7xPriorityReverse(a) }},
+		{name: "NetworkSort8xPriorityReverse", size: 8, sort: func(a []Priority) { NetworkSort8xPriorityReverse(a) }},
+		{name: "NetworkSort9xPriorityReverse", size: 9, sort: func(a []Priority) { NetworkSort9xPriorityReverse(a) }},
+		{name: "NetworkSort10xPriorityReverse", size: 10, sort: func(a []Priority) { NetworkSort10xPriorityReverse(a) }},
+		{name: "NetworkSort11xPriorityReverse", size: 11, sort: func(a []Priority) { NetworkSort11xPriorityReverse(a) }},
+		{name: "NetworkSort12xPriorityReverse", size: 12, sort: func(a []Priority) { NetworkSort12xPriorityReverse(a) }},
+		{name: "NetworkSort13xPriorityReverse", size: 13, sort: func(a []Priority) { NetworkSort13xPriorityReverse(a) }},
+		{name: "NetworkSort14xPriorityReverse", size: 14, sort: func(a []Priority) { NetworkSort14xPriorityReverse(a) }},
+		{name: "NetworkSort15xPriorityReverse", size: 15, sort: func(a []Priority) { NetworkSort15xPriorityReverse(a) }},
+		{name: "NetworkSort16xPriorityReverse", size: 16, sort: func(a []Priority) { NetworkSort16xPriorityReverse(a) }},
+		{name: "NetworkSort24xPriorityReverse", size: 24, sort: func(a []Priority) { NetworkSort24xPriorityReverse(a) }},
+		{name: "NetworkSort32xPriorityReverse", size: 32, sort: func(a []Priority) { NetworkSort32xPriorityReverse(a) }},
+		{name: "NetworkSortPriorityReverse-2", size: 2, sort: func(a []Priority) {
+			if !NetworkSortPriorityReverse(a, len(a)) {
+				panic("NetworkSortPriorityReverse: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortPriorityReverse-3", size: 3, sort: func(a []Priority) {
+			if !NetworkSortPriorityReverse(a, len(a)) {
+				panic("NetworkSortPriorityReverse: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortPriorityReverse-4", size: 4, sort: func(a []Priority) {
+			if !NetworkSortPriorityReverse(a, len(a)) {
+				panic("NetworkSortPriorityReverse: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortPriorityReverse-5", size: 5, sort: func(a []Priority) {
+			if !NetworkSortPriorityReverse(a, len(a)) {
+				panic("NetworkSortPriorityReverse: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortPriorityReverse-6", size: 6, sort: func(a []Priority) {
+			if !NetworkSortPriorityReverse(a, len(a)) {
+				panic("NetworkSortPriorityReverse: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortPriorityReverse-7", size: 7, sort: func(a []Priority) {
+			if !NetworkSortPriorityReverse(a, len(a)) {
+				panic("NetworkSortPriorityReverse: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortPriorityReverse-8", size: 8, sort: func(a []Priority) {
+			if !NetworkSortPriorityReverse(a, len(a)) {
+				panic("NetworkSortPriorityReverse: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortPriorityReverse-9", size: 9, sort: func(a []Priority) {
+			if !NetworkSortPriorityReverse(a, len(a)) {
+				panic("NetworkSortPriorityReverse: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortPriorityReverse-10", size: 10, sort: func(a []Priority) {
+			if !NetworkSortPriorityReverse(a, len(a)) {
+				panic("NetworkSortPriorityReverse: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortPriorityReverse-11", size: 11, sort: func(a []Priority) {
+			if !NetworkSortPriorityReverse(a, len(a)) {
+				panic("NetworkSortPriorityReverse: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortPriorityReverse-12", size: 12, sort: func(a []Priority) {
+			if !NetworkSortPriorityReverse(a, len(a)) {
+				panic("NetworkSortPriorityReverse: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortPriorityReverse-13", size: 13, sort: func(a []Priority) {
+			if !NetworkSortPriorityReverse(a, len(a)) {
+				panic("NetworkSortPriorityReverse: no sorter for size 13")
+			}
+		}},
+		{name: "NetworkSortPriorityReverse-14", size: 14, sort: func(a []Priority) {
+			if !NetworkSortPriorityReverse(a, len(a)) {
+				panic("NetworkSortPriorityReverse: no sorter for size 14")
+			}
+		}},
+		{name: "NetworkSortPriorityReverse-15", size: 15, sort: func(a []Priority) {
+			if !NetworkSortPriorityReverse(a, len(a)) {
+				panic("NetworkSortPriorityReverse: no sorter for size 15")
+			}
+		}},
+		{name: "NetworkSortPriorityReverse-16", size: 16, sort: func(a []Priority) {
+			if !NetworkSortPriorityReverse(a, len(a)) {
+				panic("NetworkSortPriorityReverse: no sorter for size 16")
+			}
+		}},
+		{name: "NetworkSortPriorityReverse-24", size: 24, sort: func(a []Priority) {
+			if !NetworkSortPriorityReverse(a, len(a)) {
+				panic("NetworkSortPriorityReverse: no sorter for size 24")
+			}
+		}},
+		{name: "NetworkSortPriorityReverse-32", size: 32, sort: func(a []Priority) {
+			if !NetworkSortPriorityReverse(a, len(a)) {
+				panic("NetworkSortPriorityReverse: no sorter for size 32")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestDiscoverGenNetworkSortPriorityReverse(t *testing.T) {
+	gen, before, cases := testDiscoverGenCasesNetworkSortPriorityReverse()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzDiscoverGenNetworkSortPriorityReverse(f *testing.F) {
+	gen, before, cases := testDiscoverGenCasesNetworkSortPriorityReverse()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]Priority, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkDiscoverGenNetworkSortPriorityReverse(b *testing.B) {
+	gen, before, cases := testDiscoverGenCasesNetworkSortPriorityReverse()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
@@ -1,4 +1,16 @@
 package gentest
 
-//go:generate sortnetgen -o primitive_gen.go -fwd -rev -size 2-16,24,32,48,64 string int
-//go:generate sortnetgen -o custom_gen.go -fwd -rev -size 2-16,24,32,48,64 -less CustomCASLess -greater CustomCASGreater Custom
+//go:generate sortnetgen -o primitive_gen.go -tests -fwd -rev -size 2-16,24,32,48,64 string int
+//go:generate sortnetgen -o generic_gen.go -tests -generic -size 2-16,24,32,48,64
+//go:generate sortnetgen -o custom_gen.go -tests -fwd -rev -size 2-16,24,32,48,64 -less CustomCASLess -greater CustomCASGreater Custom
+//go:generate sortnetgen -o keyed_gen.go -tests -fwd -rev -merge -size 2-16,24,32 -key -Info.Score,Info.When,Name Keyed
+//go:generate sortnetgen -o lessfunc_gen.go -tests -fwd -rev -size 2-16,24,32 -lessfunc PointLess Point -lessfunc "{{.A}}.Name < {{.B}}.Name" Named
+//go:generate sortnetgen -o discover_gen.go -tests -fwd -rev -size 2-16,24,32 Celsius Version Priority
+//go:generate sortnetgen -o stdlib_gen.go -tests -fwd -rev -size 2-12,16 time.Time time.Duration net/netip.Addr *math/big.Int *math/big.Float "[]byte"
+//go:generate sortnetgen -o versioned_gen.go -tests -fwd -rev -size 2-8 github.com/shabbyrobe/sortnet/cmd/sortnetgen/internal/gentest/versioned/v2.Level
+//go:generate sortnetgen -o alg_gen.go -tests -fwd -rev -prefer depth -size 2-16,24,32 int32 -prefer size -alg bosenelson int16 -alg Green16 -size 16 uint16
+//go:generate sortnetgen -o network_gen.go -tests -fwd -rev -network networks.txt int64 -network network.json uint32
+//go:generate sortnetgen -o chunks_gen.go -tests -slice=false -wrap=false -chunks -fwd -rev -size 3,4,9 int
+//go:generate sortnetgen -o strided_gen.go -tests -slice=false -wrap=false -strided -fwd -rev -size 3,4,9 uint8
+//go:generate sortnetgen -o lanes_gen.go -tests -slice=false -wrap=false -lanes -fwd -rev -size 3,9 int
+//go:generate sortnetgen -o merge_gen.go -tests -slice=false -wrap=false -merge -fwd -rev -size 2,3,4,8 int
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/shabbyrobe/sortnet"
+	"github.com/shabbyrobe/sortnet/cmd/sortnetgen/internal/gentest/branchless"
 )
 
 func BenchmarkSortNetInts(b *testing.B) {
@@ -14,29 +15,30 @@ func BenchmarkSortNetInts(b *testing.B) {
 	ints := newRandInts(rng, 10000000, 1024)
 
 	for idx, tc := range []struct {
-		name   string
-		sz     int
-		sorter func([]int)
+		name       string
+		sz         int
+		sorter     func([]int)
+		branchless func([]int)
 	}{
-		{"", 2, NetworkSort2xInt},
-		{"", 3, NetworkSort3xInt},
-		{"", 4, NetworkSort4xInt},
-		{"", 5, NetworkSort5xInt},
-		{"", 6, NetworkSort6xInt},
-		{"", 7, NetworkSort7xInt},
-		{"", 8, NetworkSort8xInt},
-		{"", 9, NetworkSort9xInt},
-		{"", 10, NetworkSort10xInt},
-		{"", 11, NetworkSort11xInt},
-		{"", 12, NetworkSort12xInt},
-		{"", 13, NetworkSort13xInt},
-		{"", 14, NetworkSort14xInt},
-		{"", 15, NetworkSort15xInt},
-		{"", 16, NetworkSort16xInt},
-		{"", 24, NetworkSort24xInt},
-		{"", 32, NetworkSort32xInt},
-		{"", 48, NetworkSort48xInt},
-		{"", 64, NetworkSort64xInt},
+		{"", 2, NetworkSort2xInt, branchless.NetworkSort2xInt},
+		{"", 3, NetworkSort3xInt, branchless.NetworkSort3xInt},
+		{"", 4, NetworkSort4xInt, branchless.NetworkSort4xInt},
+		{"", 5, NetworkSort5xInt, branchless.NetworkSort5xInt},
+		{"", 6, NetworkSort6xInt, branchless.NetworkSort6xInt},
+		{"", 7, NetworkSort7xInt, branchless.NetworkSort7xInt},
+		{"", 8, NetworkSort8xInt, branchless.NetworkSort8xInt},
+		{"", 9, NetworkSort9xInt, branchless.NetworkSort9xInt},
+		{"", 10, NetworkSort10xInt, branchless.NetworkSort10xInt},
+		{"", 11, NetworkSort11xInt, branchless.NetworkSort11xInt},
+		{"", 12, NetworkSort12xInt, branchless.NetworkSort12xInt},
+		{"", 13, NetworkSort13xInt, branchless.NetworkSort13xInt},
+		{"", 14, NetworkSort14xInt, branchless.NetworkSort14xInt},
+		{"", 15, NetworkSort15xInt, branchless.NetworkSort15xInt},
+		{"", 16, NetworkSort16xInt, branchless.NetworkSort16xInt},
+		{"", 24, NetworkSort24xInt, branchless.NetworkSort24xInt},
+		{"", 32, NetworkSort32xInt, branchless.NetworkSort32xInt},
+		{"", 48, NetworkSort48xInt, branchless.NetworkSort48xInt},
+		{"", 64, NetworkSort64xInt, branchless.NetworkSort64xInt},
 	} {
 		_ = idx
 		b.Run(fmt.Sprintf("network-%d", tc.sz), func(b *testing.B) {
@@ -47,6 +49,14 @@ func BenchmarkSortNetInts(b *testing.B) {
 			}
 		})
 
+		b.Run(fmt.Sprintf("network-branchless-%d", tc.sz), func(b *testing.B) {
+			ints.Reset(b)
+			for i := 0; i < b.N; i++ {
+				cur := ints.Take(b, tc.sz)
+				tc.branchless(cur)
+			}
+		})
+
 		b.Run(fmt.Sprintf("network-direct-%d", tc.sz), func(b *testing.B) {
 			ints.Reset(b)
 			net := sortnet.New(tc.sz)
@@ -56,6 +66,34 @@ func BenchmarkSortNetInts(b *testing.B) {
 			}
 		})
 
+		// The generic sorters are called through their wrappers, which adds a switch
+		// on the size to each call:
+		b.Run(fmt.Sprintf("network-generic-%d", tc.sz), func(b *testing.B) {
+			ints.Reset(b)
+			for i := 0; i < b.N; i++ {
+				cur := ints.Take(b, tc.sz)
+				NetworkSort(cur, tc.sz)
+			}
+		})
+
+		b.Run(fmt.Sprintf("network-genericfunc-%d", tc.sz), func(b *testing.B) {
+			ints.Reset(b)
+			less := func(a, b int) bool { return a < b }
+			for i := 0; i < b.N; i++ {
+				cur := ints.Take(b, tc.sz)
+				NetworkSortFunc(cur, tc.sz, less)
+			}
+		})
+
+		b.Run(fmt.Sprintf("network-compiled-%d", tc.sz), func(b *testing.B) {
+			ints.Reset(b)
+			sorter := sortnet.Compile[int](sortnet.New(tc.sz))
+			for i := 0; i < b.N; i++ {
+				cur := ints.Take(b, tc.sz)
+				sorter(cur)
+			}
+		})
+
 		b.Run(fmt.Sprintf("std-%d", tc.sz), func(b *testing.B) {
 			ints.Reset(b)
 			for i := 0; i < b.N; i++ {
@@ -114,6 +152,15 @@ func BenchmarkSortNetCustom(b *testing.B) {
 			}
 		})
 
+		b.Run(fmt.Sprintf("network-genericfunc-%d", tc.sz), func(b *testing.B) {
+			customs.Reset(b)
+			less := func(a, b Custom) bool { return a.Foo < b.Foo }
+			for i := 0; i < b.N; i++ {
+				cur := customs.Take(b, tc.sz)
+				NetworkSortFunc(cur, tc.sz, less)
+			}
+		})
+
 		b.Run(fmt.Sprintf("network-direct-%d", tc.sz), func(b *testing.B) {
 			customs.Reset(b)
 			net := sortnet.New(tc.sz)
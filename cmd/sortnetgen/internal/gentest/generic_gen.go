@@ -0,0 +1,11783 @@
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
+
+package gentest
+
+// Sorting networks used in this file:
+//
+//   - Bose-Nelson, 2 inputs: 1 comparator (proven optimal), depth 1 (proven optimal)
+//     Fingerprint 9463926d4640a53a
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 3 inputs: 3 comparators (proven optimal), depth 3 (proven optimal)
+//     Fingerprint f995edafd93d50ea
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 4 inputs: 5 comparators (proven optimal), depth 3 (proven optimal)
+//     Fingerprint 0119fc1a905673cd
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 5 inputs: 9 comparators (proven optimal), depth 6 (not optimal)
+//     Fingerprint d54da66c984b8b07
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 6 inputs: 13 comparators (not optimal), depth 7 (not optimal)
+//     Fingerprint 1cc32b1cf5a405a2
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 7 inputs: 16 comparators (proven optimal), depth 7 (not optimal)
+//     Fingerprint 4c56e9c432030c54
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 8 inputs: 19 comparators (proven optimal), depth 7 (not optimal)
+//     Fingerprint 72b14580972c6d18
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Senso9, 9 inputs: 25 comparators (proven optimal), depth 8 (not optimal)
+//     Fingerprint 5463bfb95d0dca98
+//     V. K. Valsalam and R. Miikkulainen (2013)
+//     V. K. Valsalam and R. Miikkulainen, Using Symmetry and Evolutionary Search to Minimize Sorting Networks, Journal of Machine Learning Research 14, 2013
+//   - Senso10, 10 inputs: 29 comparators (proven optimal), depth 8 (not optimal)
+//     Fingerprint 9688ccf2613970b6
+//     V. K. Valsalam and R. Miikkulainen (2013)
+//     V. K. Valsalam and R. Miikkulainen, Using Symmetry and Evolutionary Search to Minimize Sorting Networks, Journal of Machine Learning Research 14, 2013
+//   - ShapiroGreen11, 11 inputs: 35 comparators (proven optimal), depth 9 (not optimal)
+//     Fingerprint a0cd3d71b97545e1
+//     G. Shapiro and M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - ShapiroGreen12, 12 inputs: 39 comparators (proven optimal), depth 9 (not optimal)
+//     Fingerprint 4520ec02bac38318
+//     G. Shapiro and M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - End13, 13 inputs: 45 comparators (best known), depth 10 (not optimal)
+//     Fingerprint 8fb515590c6989d6
+//     H. Juillé (1995)
+//     H. Juillé, Evolution of Non-Deterministic Incremental Algorithms as a New Approach for Search in State Spaces, Proceedings of the 6th International Conference on Genetic Algorithms, 1995
+//   - Green14, 14 inputs: 51 comparators (best known), depth 10 (not optimal)
+//     Fingerprint b78dfa7f24d12c0c
+//     M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - Green15, 15 inputs: 56 comparators (best known), depth 10 (not optimal)
+//     Fingerprint 360c873bdb44ac3e
+//     M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - Green16, 16 inputs: 60 comparators (best known), depth 10 (not optimal)
+//     Fingerprint 196d3d09b3f4a0e7
+//     M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - Morwenn24, 24 inputs: 123 comparators (not optimal), depth 15 (not optimal)
+//     Fingerprint a82fea0eb88f2605
+//     Morwenn
+//     https://github.com/Morwenn/cpp-sort
+//   - Bose-Nelson, 32 inputs: 211 comparators (unknown), depth 31 (unknown)
+//     Fingerprint 947ea8bcb4cc1a7d
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 48 inputs: 503 comparators (unknown), depth 52 (unknown)
+//     Fingerprint b743d052c3bd5b72
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 64 inputs: 665 comparators (unknown), depth 63 (unknown)
+//     Fingerprint ae52e02f830de288
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+
+import "cmp"
+
+// NetworkSort sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSort[T cmp.Ordered](a []T, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2(a)
+	case 3:
+		NetworkSort3(a)
+	case 4:
+		NetworkSort4(a)
+	case 5:
+		NetworkSort5(a)
+	case 6:
+		NetworkSort6(a)
+	case 7:
+		NetworkSort7(a)
+	case 8:
+		NetworkSort8(a)
+	case 9:
+		NetworkSort9(a)
+	case 10:
+		NetworkSort10(a)
+	case 11:
+		NetworkSort11(a)
+	case 12:
+		NetworkSort12(a)
+	case 13:
+		NetworkSort13(a)
+	case 14:
+		NetworkSort14(a)
+	case 15:
+		NetworkSort15(a)
+	case 16:
+		NetworkSort16(a)
+	case 24:
+		NetworkSort24(a)
+	case 32:
+		NetworkSort32(a)
+	case 48:
+		NetworkSort48(a)
+	case 64:
+		NetworkSort64(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortFunc sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortFunc[T any](a []T, sz int, less func(a, b T) bool) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2Func(a, less)
+	case 3:
+		NetworkSort3Func(a, less)
+	case 4:
+		NetworkSort4Func(a, less)
+	case 5:
+		NetworkSort5Func(a, less)
+	case 6:
+		NetworkSort6Func(a, less)
+	case 7:
+		NetworkSort7Func(a, less)
+	case 8:
+		NetworkSort8Func(a, less)
+	case 9:
+		NetworkSort9Func(a, less)
+	case 10:
+		NetworkSort10Func(a, less)
+	case 11:
+		NetworkSort11Func(a, less)
+	case 12:
+		NetworkSort12Func(a, less)
+	case 13:
+		NetworkSort13Func(a, less)
+	case 14:
+		NetworkSort14Func(a, less)
+	case 15:
+		NetworkSort15Func(a, less)
+	case 16:
+		NetworkSort16Func(a, less)
+	case 24:
+		NetworkSort24Func(a, less)
+	case 32:
+		NetworkSort32Func(a, less)
+	case 48:
+		NetworkSort48Func(a, less)
+	case 64:
+		NetworkSort64Func(a, less)
+	default:
+		return false
+	}
+	return true
+}
+
+func NetworkSort2[T cmp.Ordered](a []T) {
+	_ = a[1]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3[T cmp.Ordered](a []T) {
+	_ = a[2]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort4[T cmp.Ordered](a []T) {
+	_ = a[3]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5[T cmp.Ordered](a []T) {
+	_ = a[4]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort6[T cmp.Ordered](a []T) {
+	_ = a[5]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7[T cmp.Ordered](a []T) {
+	_ = a[6]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort8[T cmp.Ordered](a []T) {
+	_ = a[7]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort9[T cmp.Ordered](a []T) {
+	_ = a[8]
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[0] > a[5] {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[0] > a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2] > a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort10[T cmp.Ordered](a []T) {
+	_ = a[9]
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] > a[9] {
+		a[0], a[9] = a[9], a[0]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[0] > a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4] > a[9] {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] > a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[1] > a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort11[T cmp.Ordered](a []T) {
+	_ = a[10]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort12[T cmp.Ordered](a []T) {
+	_ = a[11]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort13[T cmp.Ordered](a []T) {
+	_ = a[12]
+	if a[1] > a[7] {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[0] > a[12] {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[11] {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[7] > a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[6] > a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[4] > a[9] {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1] > a[7] {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[4] > a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[0] > a[5] {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort14[T cmp.Ordered](a []T) {
+	_ = a[13]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[0] > a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] > a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2] > a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[5] > a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3] > a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7] > a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort15[T cmp.Ordered](a []T) {
+	_ = a[14]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[0] > a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] > a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2] > a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[5] > a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3] > a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[11] > a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7] > a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort16[T cmp.Ordered](a []T) {
+	_ = a[15]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[0] > a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] > a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2] > a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7] > a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[5] > a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3] > a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[11] > a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7] > a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort24[T cmp.Ordered](a []T) {
+	_ = a[23]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[16] > a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[18] > a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[20] > a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[22] > a[23] {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[17] > a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[21] > a[23] {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[16] > a[18] {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[20] > a[22] {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[21] > a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[13] > a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[18] > a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[17] > a[21] {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[14] > a[18] {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[13] > a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[18] > a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[19] > a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[15] > a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[16] > a[20] {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[19] > a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[13] > a[16] {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[19] > a[22] {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[15] > a[20] {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[20] > a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[19] > a[21] {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[18] > a[20] {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[19] > a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[0] > a[12] {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[1] > a[13] {
+		a[1], a[13] = a[13], a[1]
+	}
+	if a[2] > a[14] {
+		a[2], a[14] = a[14], a[2]
+	}
+	if a[3] > a[15] {
+		a[3], a[15] = a[15], a[3]
+	}
+	if a[4] > a[16] {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[5] > a[17] {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[6] > a[18] {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[7] > a[19] {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[8] > a[20] {
+		a[8], a[20] = a[20], a[8]
+	}
+	if a[9] > a[21] {
+		a[9], a[21] = a[21], a[9]
+	}
+	if a[10] > a[22] {
+		a[10], a[22] = a[22], a[10]
+	}
+	if a[11] > a[23] {
+		a[11], a[23] = a[23], a[11]
+	}
+	if a[2] > a[12] {
+		a[2], a[12] = a[12], a[2]
+	}
+	if a[3] > a[13] {
+		a[3], a[13] = a[13], a[3]
+	}
+	if a[10] > a[20] {
+		a[10], a[20] = a[20], a[10]
+	}
+	if a[11] > a[21] {
+		a[11], a[21] = a[21], a[11]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7] > a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[8] > a[16] {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[9] > a[17] {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[10] > a[18] {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[11] > a[19] {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[19] > a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[21] > a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+}
+
+func NetworkSort32[T cmp.Ordered](a []T) {
+	_ = a[31]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[9] > a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[11] > a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[0] > a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] > a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[1] > a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[2] > a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[3] > a[10] {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[3] > a[9] {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[5] > a[12] {
+		a[5], a[12] = a[12], a[5]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7] > a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[7] > a[14] {
+		a[7], a[14] = a[14], a[7]
+	}
+	if a[6] > a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[7] > a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[7] > a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[16] > a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[18] > a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[16] > a[18] {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[17] > a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[20] > a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[22] > a[23] {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[20] > a[22] {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[21] > a[23] {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[21] > a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[16] > a[20] {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[17] > a[21] {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[17] > a[20] {
+		a[17], a[20] = a[20], a[17]
+	}
+	if a[18] > a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[19] > a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[19] > a[22] {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[18] > a[20] {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[19] > a[21] {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[19] > a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[24] > a[25] {
+		a[24], a[25] = a[25], a[24]
+	}
+	if a[26] > a[27] {
+		a[26], a[27] = a[27], a[26]
+	}
+	if a[24] > a[26] {
+		a[24], a[26] = a[26], a[24]
+	}
+	if a[25] > a[27] {
+		a[25], a[27] = a[27], a[25]
+	}
+	if a[25] > a[26] {
+		a[25], a[26] = a[26], a[25]
+	}
+	if a[28] > a[29] {
+		a[28], a[29] = a[29], a[28]
+	}
+	if a[30] > a[31] {
+		a[30], a[31] = a[31], a[30]
+	}
+	if a[28] > a[30] {
+		a[28], a[30] = a[30], a[28]
+	}
+	if a[29] > a[31] {
+		a[29], a[31] = a[31], a[29]
+	}
+	if a[29] > a[30] {
+		a[29], a[30] = a[30], a[29]
+	}
+	if a[24] > a[28] {
+		a[24], a[28] = a[28], a[24]
+	}
+	if a[25] > a[29] {
+		a[25], a[29] = a[29], a[25]
+	}
+	if a[25] > a[28] {
+		a[25], a[28] = a[28], a[25]
+	}
+	if a[26] > a[30] {
+		a[26], a[30] = a[30], a[26]
+	}
+	if a[27] > a[31] {
+		a[27], a[31] = a[31], a[27]
+	}
+	if a[27] > a[30] {
+		a[27], a[30] = a[30], a[27]
+	}
+	if a[26] > a[28] {
+		a[26], a[28] = a[28], a[26]
+	}
+	if a[27] > a[29] {
+		a[27], a[29] = a[29], a[27]
+	}
+	if a[27] > a[28] {
+		a[27], a[28] = a[28], a[27]
+	}
+	if a[16] > a[24] {
+		a[16], a[24] = a[24], a[16]
+	}
+	if a[17] > a[25] {
+		a[17], a[25] = a[25], a[17]
+	}
+	if a[17] > a[24] {
+		a[17], a[24] = a[24], a[17]
+	}
+	if a[18] > a[26] {
+		a[18], a[26] = a[26], a[18]
+	}
+	if a[19] > a[27] {
+		a[19], a[27] = a[27], a[19]
+	}
+	if a[19] > a[26] {
+		a[19], a[26] = a[26], a[19]
+	}
+	if a[18] > a[24] {
+		a[18], a[24] = a[24], a[18]
+	}
+	if a[19] > a[25] {
+		a[19], a[25] = a[25], a[19]
+	}
+	if a[19] > a[24] {
+		a[19], a[24] = a[24], a[19]
+	}
+	if a[20] > a[28] {
+		a[20], a[28] = a[28], a[20]
+	}
+	if a[21] > a[29] {
+		a[21], a[29] = a[29], a[21]
+	}
+	if a[21] > a[28] {
+		a[21], a[28] = a[28], a[21]
+	}
+	if a[22] > a[30] {
+		a[22], a[30] = a[30], a[22]
+	}
+	if a[23] > a[31] {
+		a[23], a[31] = a[31], a[23]
+	}
+	if a[23] > a[30] {
+		a[23], a[30] = a[30], a[23]
+	}
+	if a[22] > a[28] {
+		a[22], a[28] = a[28], a[22]
+	}
+	if a[23] > a[29] {
+		a[23], a[29] = a[29], a[23]
+	}
+	if a[23] > a[28] {
+		a[23], a[28] = a[28], a[23]
+	}
+	if a[20] > a[24] {
+		a[20], a[24] = a[24], a[20]
+	}
+	if a[21] > a[25] {
+		a[21], a[25] = a[25], a[21]
+	}
+	if a[21] > a[24] {
+		a[21], a[24] = a[24], a[21]
+	}
+	if a[22] > a[26] {
+		a[22], a[26] = a[26], a[22]
+	}
+	if a[23] > a[27] {
+		a[23], a[27] = a[27], a[23]
+	}
+	if a[23] > a[26] {
+		a[23], a[26] = a[26], a[23]
+	}
+	if a[22] > a[24] {
+		a[22], a[24] = a[24], a[22]
+	}
+	if a[23] > a[25] {
+		a[23], a[25] = a[25], a[23]
+	}
+	if a[23] > a[24] {
+		a[23], a[24] = a[24], a[23]
+	}
+	if a[0] > a[16] {
+		a[0], a[16] = a[16], a[0]
+	}
+	if a[1] > a[17] {
+		a[1], a[17] = a[17], a[1]
+	}
+	if a[1] > a[16] {
+		a[1], a[16] = a[16], a[1]
+	}
+	if a[2] > a[18] {
+		a[2], a[18] = a[18], a[2]
+	}
+	if a[3] > a[19] {
+		a[3], a[19] = a[19], a[3]
+	}
+	if a[3] > a[18] {
+		a[3], a[18] = a[18], a[3]
+	}
+	if a[2] > a[16] {
+		a[2], a[16] = a[16], a[2]
+	}
+	if a[3] > a[17] {
+		a[3], a[17] = a[17], a[3]
+	}
+	if a[3] > a[16] {
+		a[3], a[16] = a[16], a[3]
+	}
+	if a[4] > a[20] {
+		a[4], a[20] = a[20], a[4]
+	}
+	if a[5] > a[21] {
+		a[5], a[21] = a[21], a[5]
+	}
+	if a[5] > a[20] {
+		a[5], a[20] = a[20], a[5]
+	}
+	if a[6] > a[22] {
+		a[6], a[22] = a[22], a[6]
+	}
+	if a[7] > a[23] {
+		a[7], a[23] = a[23], a[7]
+	}
+	if a[7] > a[22] {
+		a[7], a[22] = a[22], a[7]
+	}
+	if a[6] > a[20] {
+		a[6], a[20] = a[20], a[6]
+	}
+	if a[7] > a[21] {
+		a[7], a[21] = a[21], a[7]
+	}
+	if a[7] > a[20] {
+		a[7], a[20] = a[20], a[7]
+	}
+	if a[4] > a[16] {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[5] > a[17] {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[5] > a[16] {
+		a[5], a[16] = a[16], a[5]
+	}
+	if a[6] > a[18] {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[7] > a[19] {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[7] > a[18] {
+		a[7], a[18] = a[18], a[7]
+	}
+	if a[6] > a[16] {
+		a[6], a[16] = a[16], a[6]
+	}
+	if a[7] > a[17] {
+		a[7], a[17] = a[17], a[7]
+	}
+	if a[7] > a[16] {
+		a[7], a[16] = a[16], a[7]
+	}
+	if a[8] > a[24] {
+		a[8], a[24] = a[24], a[8]
+	}
+	if a[9] > a[25] {
+		a[9], a[25] = a[25], a[9]
+	}
+	if a[9] > a[24] {
+		a[9], a[24] = a[24], a[9]
+	}
+	if a[10] > a[26] {
+		a[10], a[26] = a[26], a[10]
+	}
+	if a[11] > a[27] {
+		a[11], a[27] = a[27], a[11]
+	}
+	if a[11] > a[26] {
+		a[11], a[26] = a[26], a[11]
+	}
+	if a[10] > a[24] {
+		a[10], a[24] = a[24], a[10]
+	}
+	if a[11] > a[25] {
+		a[11], a[25] = a[25], a[11]
+	}
+	if a[11] > a[24] {
+		a[11], a[24] = a[24], a[11]
+	}
+	if a[12] > a[28] {
+		a[12], a[28] = a[28], a[12]
+	}
+	if a[13] > a[29] {
+		a[13], a[29] = a[29], a[13]
+	}
+	if a[13] > a[28] {
+		a[13], a[28] = a[28], a[13]
+	}
+	if a[14] > a[30] {
+		a[14], a[30] = a[30], a[14]
+	}
+	if a[15] > a[31] {
+		a[15], a[31] = a[31], a[15]
+	}
+	if a[15] > a[30] {
+		a[15], a[30] = a[30], a[15]
+	}
+	if a[14] > a[28] {
+		a[14], a[28] = a[28], a[14]
+	}
+	if a[15] > a[29] {
+		a[15], a[29] = a[29], a[15]
+	}
+	if a[15] > a[28] {
+		a[15], a[28] = a[28], a[15]
+	}
+	if a[12] > a[24] {
+		a[12], a[24] = a[24], a[12]
+	}
+	if a[13] > a[25] {
+		a[13], a[25] = a[25], a[13]
+	}
+	if a[13] > a[24] {
+		a[13], a[24] = a[24], a[13]
+	}
+	if a[14] > a[26] {
+		a[14], a[26] = a[26], a[14]
+	}
+	if a[15] > a[27] {
+		a[15], a[27] = a[27], a[15]
+	}
+	if a[15] > a[26] {
+		a[15], a[26] = a[26], a[15]
+	}
+	if a[14] > a[24] {
+		a[14], a[24] = a[24], a[14]
+	}
+	if a[15] > a[25] {
+		a[15], a[25] = a[25], a[15]
+	}
+	if a[15] > a[24] {
+		a[15], a[24] = a[24], a[15]
+	}
+	if a[8] > a[16] {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[9] > a[17] {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[9] > a[16] {
+		a[9], a[16] = a[16], a[9]
+	}
+	if a[10] > a[18] {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[11] > a[19] {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[11] > a[18] {
+		a[11], a[18] = a[18], a[11]
+	}
+	if a[10] > a[16] {
+		a[10], a[16] = a[16], a[10]
+	}
+	if a[11] > a[17] {
+		a[11], a[17] = a[17], a[11]
+	}
+	if a[11] > a[16] {
+		a[11], a[16] = a[16], a[11]
+	}
+	if a[12] > a[20] {
+		a[12], a[20] = a[20], a[12]
+	}
+	if a[13] > a[21] {
+		a[13], a[21] = a[21], a[13]
+	}
+	if a[13] > a[20] {
+		a[13], a[20] = a[20], a[13]
+	}
+	if a[14] > a[22] {
+		a[14], a[22] = a[22], a[14]
+	}
+	if a[15] > a[23] {
+		a[15], a[23] = a[23], a[15]
+	}
+	if a[15] > a[22] {
+		a[15], a[22] = a[22], a[15]
+	}
+	if a[14] > a[20] {
+		a[14], a[20] = a[20], a[14]
+	}
+	if a[15] > a[21] {
+		a[15], a[21] = a[21], a[15]
+	}
+	if a[15] > a[20] {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[13] > a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[13] > a[16] {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[14] > a[18] {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[15] > a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[15] > a[18] {
+		a[15], a[18] = a[18], a[15]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+}
+
+func NetworkSort48[T cmp.Ordered](a []T) {
+	_ = a[47]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[8] > a[11] {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[0] > a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[0] > a[6] {
+		a[0], a[6] = a[6], a[0]
+	}
+	if a[1] > a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[1] > a[6] {
+		a[1], a[6] = a[6], a[1]
+	}
+	if a[2] > a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] > a[10] {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[3] > a[9] {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[4] > a[11] {
+		a[4], a[11] = a[11], a[4]
+	}
+	if a[5] > a[11] {
+		a[5], a[11] = a[11], a[5]
+	}
+	if a[4] > a[9] {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[5] > a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[16] > a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[12] > a[15] {
+		a[12], a[15] = a[15], a[12]
+	}
+	if a[13] > a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[14] > a[17] {
+		a[14], a[17] = a[17], a[14]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[19] > a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[18] > a[20] {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[18] > a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[22] > a[23] {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[21] > a[23] {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[21] > a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[18] > a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[18] > a[21] {
+		a[18], a[21] = a[21], a[18]
+	}
+	if a[19] > a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[20] > a[23] {
+		a[20], a[23] = a[23], a[20]
+	}
+	if a[19] > a[21] {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[20] > a[22] {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[20] > a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[12] > a[19] {
+		a[12], a[19] = a[19], a[12]
+	}
+	if a[12] > a[18] {
+		a[12], a[18] = a[18], a[12]
+	}
+	if a[13] > a[20] {
+		a[13], a[20] = a[20], a[13]
+	}
+	if a[14] > a[20] {
+		a[14], a[20] = a[20], a[14]
+	}
+	if a[13] > a[18] {
+		a[13], a[18] = a[18], a[13]
+	}
+	if a[14] > a[19] {
+		a[14], a[19] = a[19], a[14]
+	}
+	if a[14] > a[18] {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[15] > a[22] {
+		a[15], a[22] = a[22], a[15]
+	}
+	if a[15] > a[21] {
+		a[15], a[21] = a[21], a[15]
+	}
+	if a[16] > a[23] {
+		a[16], a[23] = a[23], a[16]
+	}
+	if a[17] > a[23] {
+		a[17], a[23] = a[23], a[17]
+	}
+	if a[16] > a[21] {
+		a[16], a[21] = a[21], a[16]
+	}
+	if a[17] > a[22] {
+		a[17], a[22] = a[22], a[17]
+	}
+	if a[17] > a[21] {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[15] > a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[15] > a[18] {
+		a[15], a[18] = a[18], a[15]
+	}
+	if a[16] > a[20] {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[17] > a[20] {
+		a[17], a[20] = a[20], a[17]
+	}
+	if a[16] > a[18] {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[17] > a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[0] > a[13] {
+		a[0], a[13] = a[13], a[0]
+	}
+	if a[0] > a[12] {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[1] > a[14] {
+		a[1], a[14] = a[14], a[1]
+	}
+	if a[2] > a[14] {
+		a[2], a[14] = a[14], a[2]
+	}
+	if a[1] > a[12] {
+		a[1], a[12] = a[12], a[1]
+	}
+	if a[2] > a[13] {
+		a[2], a[13] = a[13], a[2]
+	}
+	if a[2] > a[12] {
+		a[2], a[12] = a[12], a[2]
+	}
+	if a[3] > a[16] {
+		a[3], a[16] = a[16], a[3]
+	}
+	if a[3] > a[15] {
+		a[3], a[15] = a[15], a[3]
+	}
+	if a[4] > a[17] {
+		a[4], a[17] = a[17], a[4]
+	}
+	if a[5] > a[17] {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[4] > a[15] {
+		a[4], a[15] = a[15], a[4]
+	}
+	if a[5] > a[16] {
+		a[5], a[16] = a[16], a[5]
+	}
+	if a[5] > a[15] {
+		a[5], a[15] = a[15], a[5]
+	}
+	if a[3] > a[13] {
+		a[3], a[13] = a[13], a[3]
+	}
+	if a[3] > a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[4] > a[14] {
+		a[4], a[14] = a[14], a[4]
+	}
+	if a[5] > a[14] {
+		a[5], a[14] = a[14], a[5]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[5] > a[12] {
+		a[5], a[12] = a[12], a[5]
+	}
+	if a[6] > a[19] {
+		a[6], a[19] = a[19], a[6]
+	}
+	if a[6] > a[18] {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[7] > a[20] {
+		a[7], a[20] = a[20], a[7]
+	}
+	if a[8] > a[20] {
+		a[8], a[20] = a[20], a[8]
+	}
+	if a[7] > a[18] {
+		a[7], a[18] = a[18], a[7]
+	}
+	if a[8] > a[19] {
+		a[8], a[19] = a[19], a[8]
+	}
+	if a[8] > a[18] {
+		a[8], a[18] = a[18], a[8]
+	}
+	if a[9] > a[22] {
+		a[9], a[22] = a[22], a[9]
+	}
+	if a[9] > a[21] {
+		a[9], a[21] = a[21], a[9]
+	}
+	if a[10] > a[23] {
+		a[10], a[23] = a[23], a[10]
+	}
+	if a[11] > a[23] {
+		a[11], a[23] = a[23], a[11]
+	}
+	if a[10] > a[21] {
+		a[10], a[21] = a[21], a[10]
+	}
+	if a[11] > a[22] {
+		a[11], a[22] = a[22], a[11]
+	}
+	if a[11] > a[21] {
+		a[11], a[21] = a[21], a[11]
+	}
+	if a[9] > a[19] {
+		a[9], a[19] = a[19], a[9]
+	}
+	if a[9] > a[18] {
+		a[9], a[18] = a[18], a[9]
+	}
+	if a[10] > a[20] {
+		a[10], a[20] = a[20], a[10]
+	}
+	if a[11] > a[20] {
+		a[11], a[20] = a[20], a[11]
+	}
+	if a[10] > a[18] {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[11] > a[19] {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[11] > a[18] {
+		a[11], a[18] = a[18], a[11]
+	}
+	if a[6] > a[13] {
+		a[6], a[13] = a[13], a[6]
+	}
+	if a[6] > a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[7] > a[14] {
+		a[7], a[14] = a[14], a[7]
+	}
+	if a[8] > a[14] {
+		a[8], a[14] = a[14], a[8]
+	}
+	if a[7] > a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[8] > a[13] {
+		a[8], a[13] = a[13], a[8]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9] > a[16] {
+		a[9], a[16] = a[16], a[9]
+	}
+	if a[9] > a[15] {
+		a[9], a[15] = a[15], a[9]
+	}
+	if a[10] > a[17] {
+		a[10], a[17] = a[17], a[10]
+	}
+	if a[11] > a[17] {
+		a[11], a[17] = a[17], a[11]
+	}
+	if a[10] > a[15] {
+		a[10], a[15] = a[15], a[10]
+	}
+	if a[11] > a[16] {
+		a[11], a[16] = a[16], a[11]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[9] > a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11] > a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[25] > a[26] {
+		a[25], a[26] = a[26], a[25]
+	}
+	if a[24] > a[26] {
+		a[24], a[26] = a[26], a[24]
+	}
+	if a[24] > a[25] {
+		a[24], a[25] = a[25], a[24]
+	}
+	if a[28] > a[29] {
+		a[28], a[29] = a[29], a[28]
+	}
+	if a[27] > a[29] {
+		a[27], a[29] = a[29], a[27]
+	}
+	if a[27] > a[28] {
+		a[27], a[28] = a[28], a[27]
+	}
+	if a[24] > a[28] {
+		a[24], a[28] = a[28], a[24]
+	}
+	if a[24] > a[27] {
+		a[24], a[27] = a[27], a[24]
+	}
+	if a[25] > a[29] {
+		a[25], a[29] = a[29], a[25]
+	}
+	if a[26] > a[29] {
+		a[26], a[29] = a[29], a[26]
+	}
+	if a[25] > a[27] {
+		a[25], a[27] = a[27], a[25]
+	}
+	if a[26] > a[28] {
+		a[26], a[28] = a[28], a[26]
+	}
+	if a[26] > a[27] {
+		a[26], a[27] = a[27], a[26]
+	}
+	if a[31] > a[32] {
+		a[31], a[32] = a[32], a[31]
+	}
+	if a[30] > a[32] {
+		a[30], a[32] = a[32], a[30]
+	}
+	if a[30] > a[31] {
+		a[30], a[31] = a[31], a[30]
+	}
+	if a[34] > a[35] {
+		a[34], a[35] = a[35], a[34]
+	}
+	if a[33] > a[35] {
+		a[33], a[35] = a[35], a[33]
+	}
+	if a[33] > a[34] {
+		a[33], a[34] = a[34], a[33]
+	}
+	if a[30] > a[34] {
+		a[30], a[34] = a[34], a[30]
+	}
+	if a[30] > a[33] {
+		a[30], a[33] = a[33], a[30]
+	}
+	if a[31] > a[35] {
+		a[31], a[35] = a[35], a[31]
+	}
+	if a[32] > a[35] {
+		a[32], a[35] = a[35], a[32]
+	}
+	if a[31] > a[33] {
+		a[31], a[33] = a[33], a[31]
+	}
+	if a[32] > a[34] {
+		a[32], a[34] = a[34], a[32]
+	}
+	if a[32] > a[33] {
+		a[32], a[33] = a[33], a[32]
+	}
+	if a[24] > a[31] {
+		a[24], a[31] = a[31], a[24]
+	}
+	if a[24] > a[30] {
+		a[24], a[30] = a[30], a[24]
+	}
+	if a[25] > a[32] {
+		a[25], a[32] = a[32], a[25]
+	}
+	if a[26] > a[32] {
+		a[26], a[32] = a[32], a[26]
+	}
+	if a[25] > a[30] {
+		a[25], a[30] = a[30], a[25]
+	}
+	if a[26] > a[31] {
+		a[26], a[31] = a[31], a[26]
+	}
+	if a[26] > a[30] {
+		a[26], a[30] = a[30], a[26]
+	}
+	if a[27] > a[34] {
+		a[27], a[34] = a[34], a[27]
+	}
+	if a[27] > a[33] {
+		a[27], a[33] = a[33], a[27]
+	}
+	if a[28] > a[35] {
+		a[28], a[35] = a[35], a[28]
+	}
+	if a[29] > a[35] {
+		a[29], a[35] = a[35], a[29]
+	}
+	if a[28] > a[33] {
+		a[28], a[33] = a[33], a[28]
+	}
+	if a[29] > a[34] {
+		a[29], a[34] = a[34], a[29]
+	}
+	if a[29] > a[33] {
+		a[29], a[33] = a[33], a[29]
+	}
+	if a[27] > a[31] {
+		a[27], a[31] = a[31], a[27]
+	}
+	if a[27] > a[30] {
+		a[27], a[30] = a[30], a[27]
+	}
+	if a[28] > a[32] {
+		a[28], a[32] = a[32], a[28]
+	}
+	if a[29] > a[32] {
+		a[29], a[32] = a[32], a[29]
+	}
+	if a[28] > a[30] {
+		a[28], a[30] = a[30], a[28]
+	}
+	if a[29] > a[31] {
+		a[29], a[31] = a[31], a[29]
+	}
+	if a[29] > a[30] {
+		a[29], a[30] = a[30], a[29]
+	}
+	if a[37] > a[38] {
+		a[37], a[38] = a[38], a[37]
+	}
+	if a[36] > a[38] {
+		a[36], a[38] = a[38], a[36]
+	}
+	if a[36] > a[37] {
+		a[36], a[37] = a[37], a[36]
+	}
+	if a[40] > a[41] {
+		a[40], a[41] = a[41], a[40]
+	}
+	if a[39] > a[41] {
+		a[39], a[41] = a[41], a[39]
+	}
+	if a[39] > a[40] {
+		a[39], a[40] = a[40], a[39]
+	}
+	if a[36] > a[40] {
+		a[36], a[40] = a[40], a[36]
+	}
+	if a[36] > a[39] {
+		a[36], a[39] = a[39], a[36]
+	}
+	if a[37] > a[41] {
+		a[37], a[41] = a[41], a[37]
+	}
+	if a[38] > a[41] {
+		a[38], a[41] = a[41], a[38]
+	}
+	if a[37] > a[39] {
+		a[37], a[39] = a[39], a[37]
+	}
+	if a[38] > a[40] {
+		a[38], a[40] = a[40], a[38]
+	}
+	if a[38] > a[39] {
+		a[38], a[39] = a[39], a[38]
+	}
+	if a[43] > a[44] {
+		a[43], a[44] = a[44], a[43]
+	}
+	if a[42] > a[44] {
+		a[42], a[44] = a[44], a[42]
+	}
+	if a[42] > a[43] {
+		a[42], a[43] = a[43], a[42]
+	}
+	if a[46] > a[47] {
+		a[46], a[47] = a[47], a[46]
+	}
+	if a[45] > a[47] {
+		a[45], a[47] = a[47], a[45]
+	}
+	if a[45] > a[46] {
+		a[45], a[46] = a[46], a[45]
+	}
+	if a[42] > a[46] {
+		a[42], a[46] = a[46], a[42]
+	}
+	if a[42] > a[45] {
+		a[42], a[45] = a[45], a[42]
+	}
+	if a[43] > a[47] {
+		a[43], a[47] = a[47], a[43]
+	}
+	if a[44] > a[47] {
+		a[44], a[47] = a[47], a[44]
+	}
+	if a[43] > a[45] {
+		a[43], a[45] = a[45], a[43]
+	}
+	if a[44] > a[46] {
+		a[44], a[46] = a[46], a[44]
+	}
+	if a[44] > a[45] {
+		a[44], a[45] = a[45], a[44]
+	}
+	if a[36] > a[43] {
+		a[36], a[43] = a[43], a[36]
+	}
+	if a[36] > a[42] {
+		a[36], a[42] = a[42], a[36]
+	}
+	if a[37] > a[44] {
+		a[37], a[44] = a[44], a[37]
+	}
+	if a[38] > a[44] {
+		a[38], a[44] = a[44], a[38]
+	}
+	if a[37] > a[42] {
+		a[37], a[42] = a[42], a[37]
+	}
+	if a[38] > a[43] {
+		a[38], a[43] = a[43], a[38]
+	}
+	if a[38] > a[42] {
+		a[38], a[42] = a[42], a[38]
+	}
+	if a[39] > a[46] {
+		a[39], a[46] = a[46], a[39]
+	}
+	if a[39] > a[45] {
+		a[39], a[45] = a[45], a[39]
+	}
+	if a[40] > a[47] {
+		a[40], a[47] = a[47], a[40]
+	}
+	if a[41] > a[47] {
+		a[41], a[47] = a[47], a[41]
+	}
+	if a[40] > a[45] {
+		a[40], a[45] = a[45], a[40]
+	}
+	if a[41] > a[46] {
+		a[41], a[46] = a[46], a[41]
+	}
+	if a[41] > a[45] {
+		a[41], a[45] = a[45], a[41]
+	}
+	if a[39] > a[43] {
+		a[39], a[43] = a[43], a[39]
+	}
+	if a[39] > a[42] {
+		a[39], a[42] = a[42], a[39]
+	}
+	if a[40] > a[44] {
+		a[40], a[44] = a[44], a[40]
+	}
+	if a[41] > a[44] {
+		a[41], a[44] = a[44], a[41]
+	}
+	if a[40] > a[42] {
+		a[40], a[42] = a[42], a[40]
+	}
+	if a[41] > a[43] {
+		a[41], a[43] = a[43], a[41]
+	}
+	if a[41] > a[42] {
+		a[41], a[42] = a[42], a[41]
+	}
+	if a[24] > a[37] {
+		a[24], a[37] = a[37], a[24]
+	}
+	if a[24] > a[36] {
+		a[24], a[36] = a[36], a[24]
+	}
+	if a[25] > a[38] {
+		a[25], a[38] = a[38], a[25]
+	}
+	if a[26] > a[38] {
+		a[26], a[38] = a[38], a[26]
+	}
+	if a[25] > a[36] {
+		a[25], a[36] = a[36], a[25]
+	}
+	if a[26] > a[37] {
+		a[26], a[37] = a[37], a[26]
+	}
+	if a[26] > a[36] {
+		a[26], a[36] = a[36], a[26]
+	}
+	if a[27] > a[40] {
+		a[27], a[40] = a[40], a[27]
+	}
+	if a[27] > a[39] {
+		a[27], a[39] = a[39], a[27]
+	}
+	if a[28] > a[41] {
+		a[28], a[41] = a[41], a[28]
+	}
+	if a[29] > a[41] {
+		a[29], a[41] = a[41], a[29]
+	}
+	if a[28] > a[39] {
+		a[28], a[39] = a[39], a[28]
+	}
+	if a[29] > a[40] {
+		a[29], a[40] = a[40], a[29]
+	}
+	if a[29] > a[39] {
+		a[29], a[39] = a[39], a[29]
+	}
+	if a[27] > a[37] {
+		a[27], a[37] = a[37], a[27]
+	}
+	if a[27] > a[36] {
+		a[27], a[36] = a[36], a[27]
+	}
+	if a[28] > a[38] {
+		a[28], a[38] = a[38], a[28]
+	}
+	if a[29] > a[38] {
+		a[29], a[38] = a[38], a[29]
+	}
+	if a[28] > a[36] {
+		a[28], a[36] = a[36], a[28]
+	}
+	if a[29] > a[37] {
+		a[29], a[37] = a[37], a[29]
+	}
+	if a[29] > a[36] {
+		a[29], a[36] = a[36], a[29]
+	}
+	if a[30] > a[43] {
+		a[30], a[43] = a[43], a[30]
+	}
+	if a[30] > a[42] {
+		a[30], a[42] = a[42], a[30]
+	}
+	if a[31] > a[44] {
+		a[31], a[44] = a[44], a[31]
+	}
+	if a[32] > a[44] {
+		a[32], a[44] = a[44], a[32]
+	}
+	if a[31] > a[42] {
+		a[31], a[42] = a[42], a[31]
+	}
+	if a[32] > a[43] {
+		a[32], a[43] = a[43], a[32]
+	}
+	if a[32] > a[42] {
+		a[32], a[42] = a[42], a[32]
+	}
+	if a[33] > a[46] {
+		a[33], a[46] = a[46], a[33]
+	}
+	if a[33] > a[45] {
+		a[33], a[45] = a[45], a[33]
+	}
+	if a[34] > a[47] {
+		a[34], a[47] = a[47], a[34]
+	}
+	if a[35] > a[47] {
+		a[35], a[47] = a[47], a[35]
+	}
+	if a[34] > a[45] {
+		a[34], a[45] = a[45], a[34]
+	}
+	if a[35] > a[46] {
+		a[35], a[46] = a[46], a[35]
+	}
+	if a[35] > a[45] {
+		a[35], a[45] = a[45], a[35]
+	}
+	if a[33] > a[43] {
+		a[33], a[43] = a[43], a[33]
+	}
+	if a[33] > a[42] {
+		a[33], a[42] = a[42], a[33]
+	}
+	if a[34] > a[44] {
+		a[34], a[44] = a[44], a[34]
+	}
+	if a[35] > a[44] {
+		a[35], a[44] = a[44], a[35]
+	}
+	if a[34] > a[42] {
+		a[34], a[42] = a[42], a[34]
+	}
+	if a[35] > a[43] {
+		a[35], a[43] = a[43], a[35]
+	}
+	if a[35] > a[42] {
+		a[35], a[42] = a[42], a[35]
+	}
+	if a[30] > a[37] {
+		a[30], a[37] = a[37], a[30]
+	}
+	if a[30] > a[36] {
+		a[30], a[36] = a[36], a[30]
+	}
+	if a[31] > a[38] {
+		a[31], a[38] = a[38], a[31]
+	}
+	if a[32] > a[38] {
+		a[32], a[38] = a[38], a[32]
+	}
+	if a[31] > a[36] {
+		a[31], a[36] = a[36], a[31]
+	}
+	if a[32] > a[37] {
+		a[32], a[37] = a[37], a[32]
+	}
+	if a[32] > a[36] {
+		a[32], a[36] = a[36], a[32]
+	}
+	if a[33] > a[40] {
+		a[33], a[40] = a[40], a[33]
+	}
+	if a[33] > a[39] {
+		a[33], a[39] = a[39], a[33]
+	}
+	if a[34] > a[41] {
+		a[34], a[41] = a[41], a[34]
+	}
+	if a[35] > a[41] {
+		a[35], a[41] = a[41], a[35]
+	}
+	if a[34] > a[39] {
+		a[34], a[39] = a[39], a[34]
+	}
+	if a[35] > a[40] {
+		a[35], a[40] = a[40], a[35]
+	}
+	if a[35] > a[39] {
+		a[35], a[39] = a[39], a[35]
+	}
+	if a[33] > a[37] {
+		a[33], a[37] = a[37], a[33]
+	}
+	if a[33] > a[36] {
+		a[33], a[36] = a[36], a[33]
+	}
+	if a[34] > a[38] {
+		a[34], a[38] = a[38], a[34]
+	}
+	if a[35] > a[38] {
+		a[35], a[38] = a[38], a[35]
+	}
+	if a[34] > a[36] {
+		a[34], a[36] = a[36], a[34]
+	}
+	if a[35] > a[37] {
+		a[35], a[37] = a[37], a[35]
+	}
+	if a[35] > a[36] {
+		a[35], a[36] = a[36], a[35]
+	}
+	if a[0] > a[25] {
+		a[0], a[25] = a[25], a[0]
+	}
+	if a[0] > a[24] {
+		a[0], a[24] = a[24], a[0]
+	}
+	if a[1] > a[26] {
+		a[1], a[26] = a[26], a[1]
+	}
+	if a[2] > a[26] {
+		a[2], a[26] = a[26], a[2]
+	}
+	if a[1] > a[24] {
+		a[1], a[24] = a[24], a[1]
+	}
+	if a[2] > a[25] {
+		a[2], a[25] = a[25], a[2]
+	}
+	if a[2] > a[24] {
+		a[2], a[24] = a[24], a[2]
+	}
+	if a[3] > a[28] {
+		a[3], a[28] = a[28], a[3]
+	}
+	if a[3] > a[27] {
+		a[3], a[27] = a[27], a[3]
+	}
+	if a[4] > a[29] {
+		a[4], a[29] = a[29], a[4]
+	}
+	if a[5] > a[29] {
+		a[5], a[29] = a[29], a[5]
+	}
+	if a[4] > a[27] {
+		a[4], a[27] = a[27], a[4]
+	}
+	if a[5] > a[28] {
+		a[5], a[28] = a[28], a[5]
+	}
+	if a[5] > a[27] {
+		a[5], a[27] = a[27], a[5]
+	}
+	if a[3] > a[25] {
+		a[3], a[25] = a[25], a[3]
+	}
+	if a[3] > a[24] {
+		a[3], a[24] = a[24], a[3]
+	}
+	if a[4] > a[26] {
+		a[4], a[26] = a[26], a[4]
+	}
+	if a[5] > a[26] {
+		a[5], a[26] = a[26], a[5]
+	}
+	if a[4] > a[24] {
+		a[4], a[24] = a[24], a[4]
+	}
+	if a[5] > a[25] {
+		a[5], a[25] = a[25], a[5]
+	}
+	if a[5] > a[24] {
+		a[5], a[24] = a[24], a[5]
+	}
+	if a[6] > a[31] {
+		a[6], a[31] = a[31], a[6]
+	}
+	if a[6] > a[30] {
+		a[6], a[30] = a[30], a[6]
+	}
+	if a[7] > a[32] {
+		a[7], a[32] = a[32], a[7]
+	}
+	if a[8] > a[32] {
+		a[8], a[32] = a[32], a[8]
+	}
+	if a[7] > a[30] {
+		a[7], a[30] = a[30], a[7]
+	}
+	if a[8] > a[31] {
+		a[8], a[31] = a[31], a[8]
+	}
+	if a[8] > a[30] {
+		a[8], a[30] = a[30], a[8]
+	}
+	if a[9] > a[34] {
+		a[9], a[34] = a[34], a[9]
+	}
+	if a[9] > a[33] {
+		a[9], a[33] = a[33], a[9]
+	}
+	if a[10] > a[35] {
+		a[10], a[35] = a[35], a[10]
+	}
+	if a[11] > a[35] {
+		a[11], a[35] = a[35], a[11]
+	}
+	if a[10] > a[33] {
+		a[10], a[33] = a[33], a[10]
+	}
+	if a[11] > a[34] {
+		a[11], a[34] = a[34], a[11]
+	}
+	if a[11] > a[33] {
+		a[11], a[33] = a[33], a[11]
+	}
+	if a[9] > a[31] {
+		a[9], a[31] = a[31], a[9]
+	}
+	if a[9] > a[30] {
+		a[9], a[30] = a[30], a[9]
+	}
+	if a[10] > a[32] {
+		a[10], a[32] = a[32], a[10]
+	}
+	if a[11] > a[32] {
+		a[11], a[32] = a[32], a[11]
+	}
+	if a[10] > a[30] {
+		a[10], a[30] = a[30], a[10]
+	}
+	if a[11] > a[31] {
+		a[11], a[31] = a[31], a[11]
+	}
+	if a[11] > a[30] {
+		a[11], a[30] = a[30], a[11]
+	}
+	if a[6] > a[25] {
+		a[6], a[25] = a[25], a[6]
+	}
+	if a[6] > a[24] {
+		a[6], a[24] = a[24], a[6]
+	}
+	if a[7] > a[26] {
+		a[7], a[26] = a[26], a[7]
+	}
+	if a[8] > a[26] {
+		a[8], a[26] = a[26], a[8]
+	}
+	if a[7] > a[24] {
+		a[7], a[24] = a[24], a[7]
+	}
+	if a[8] > a[25] {
+		a[8], a[25] = a[25], a[8]
+	}
+	if a[8] > a[24] {
+		a[8], a[24] = a[24], a[8]
+	}
+	if a[9] > a[28] {
+		a[9], a[28] = a[28], a[9]
+	}
+	if a[9] > a[27] {
+		a[9], a[27] = a[27], a[9]
+	}
+	if a[10] > a[29] {
+		a[10], a[29] = a[29], a[10]
+	}
+	if a[11] > a[29] {
+		a[11], a[29] = a[29], a[11]
+	}
+	if a[10] > a[27] {
+		a[10], a[27] = a[27], a[10]
+	}
+	if a[11] > a[28] {
+		a[11], a[28] = a[28], a[11]
+	}
+	if a[11] > a[27] {
+		a[11], a[27] = a[27], a[11]
+	}
+	if a[9] > a[25] {
+		a[9], a[25] = a[25], a[9]
+	}
+	if a[9] > a[24] {
+		a[9], a[24] = a[24], a[9]
+	}
+	if a[10] > a[26] {
+		a[10], a[26] = a[26], a[10]
+	}
+	if a[11] > a[26] {
+		a[11], a[26] = a[26], a[11]
+	}
+	if a[10] > a[24] {
+		a[10], a[24] = a[24], a[10]
+	}
+	if a[11] > a[25] {
+		a[11], a[25] = a[25], a[11]
+	}
+	if a[11] > a[24] {
+		a[11], a[24] = a[24], a[11]
+	}
+	if a[12] > a[37] {
+		a[12], a[37] = a[37], a[12]
+	}
+	if a[12] > a[36] {
+		a[12], a[36] = a[36], a[12]
+	}
+	if a[13] > a[38] {
+		a[13], a[38] = a[38], a[13]
+	}
+	if a[14] > a[38] {
+		a[14], a[38] = a[38], a[14]
+	}
+	if a[13] > a[36] {
+		a[13], a[36] = a[36], a[13]
+	}
+	if a[14] > a[37] {
+		a[14], a[37] = a[37], a[14]
+	}
+	if a[14] > a[36] {
+		a[14], a[36] = a[36], a[14]
+	}
+	if a[15] > a[40] {
+		a[15], a[40] = a[40], a[15]
+	}
+	if a[15] > a[39] {
+		a[15], a[39] = a[39], a[15]
+	}
+	if a[16] > a[41] {
+		a[16], a[41] = a[41], a[16]
+	}
+	if a[17] > a[41] {
+		a[17], a[41] = a[41], a[17]
+	}
+	if a[16] > a[39] {
+		a[16], a[39] = a[39], a[16]
+	}
+	if a[17] > a[40] {
+		a[17], a[40] = a[40], a[17]
+	}
+	if a[17] > a[39] {
+		a[17], a[39] = a[39], a[17]
+	}
+	if a[15] > a[37] {
+		a[15], a[37] = a[37], a[15]
+	}
+	if a[15] > a[36] {
+		a[15], a[36] = a[36], a[15]
+	}
+	if a[16] > a[38] {
+		a[16], a[38] = a[38], a[16]
+	}
+	if a[17] > a[38] {
+		a[17], a[38] = a[38], a[17]
+	}
+	if a[16] > a[36] {
+		a[16], a[36] = a[36], a[16]
+	}
+	if a[17] > a[37] {
+		a[17], a[37] = a[37], a[17]
+	}
+	if a[17] > a[36] {
+		a[17], a[36] = a[36], a[17]
+	}
+	if a[18] > a[43] {
+		a[18], a[43] = a[43], a[18]
+	}
+	if a[18] > a[42] {
+		a[18], a[42] = a[42], a[18]
+	}
+	if a[19] > a[44] {
+		a[19], a[44] = a[44], a[19]
+	}
+	if a[20] > a[44] {
+		a[20], a[44] = a[44], a[20]
+	}
+	if a[19] > a[42] {
+		a[19], a[42] = a[42], a[19]
+	}
+	if a[20] > a[43] {
+		a[20], a[43] = a[43], a[20]
+	}
+	if a[20] > a[42] {
+		a[20], a[42] = a[42], a[20]
+	}
+	if a[21] > a[46] {
+		a[21], a[46] = a[46], a[21]
+	}
+	if a[21] > a[45] {
+		a[21], a[45] = a[45], a[21]
+	}
+	if a[22] > a[47] {
+		a[22], a[47] = a[47], a[22]
+	}
+	if a[23] > a[47] {
+		a[23], a[47] = a[47], a[23]
+	}
+	if a[22] > a[45] {
+		a[22], a[45] = a[45], a[22]
+	}
+	if a[23] > a[46] {
+		a[23], a[46] = a[46], a[23]
+	}
+	if a[23] > a[45] {
+		a[23], a[45] = a[45], a[23]
+	}
+	if a[21] > a[43] {
+		a[21], a[43] = a[43], a[21]
+	}
+	if a[21] > a[42] {
+		a[21], a[42] = a[42], a[21]
+	}
+	if a[22] > a[44] {
+		a[22], a[44] = a[44], a[22]
+	}
+	if a[23] > a[44] {
+		a[23], a[44] = a[44], a[23]
+	}
+	if a[22] > a[42] {
+		a[22], a[42] = a[42], a[22]
+	}
+	if a[23] > a[43] {
+		a[23], a[43] = a[43], a[23]
+	}
+	if a[23] > a[42] {
+		a[23], a[42] = a[42], a[23]
+	}
+	if a[18] > a[37] {
+		a[18], a[37] = a[37], a[18]
+	}
+	if a[18] > a[36] {
+		a[18], a[36] = a[36], a[18]
+	}
+	if a[19] > a[38] {
+		a[19], a[38] = a[38], a[19]
+	}
+	if a[20] > a[38] {
+		a[20], a[38] = a[38], a[20]
+	}
+	if a[19] > a[36] {
+		a[19], a[36] = a[36], a[19]
+	}
+	if a[20] > a[37] {
+		a[20], a[37] = a[37], a[20]
+	}
+	if a[20] > a[36] {
+		a[20], a[36] = a[36], a[20]
+	}
+	if a[21] > a[40] {
+		a[21], a[40] = a[40], a[21]
+	}
+	if a[21] > a[39] {
+		a[21], a[39] = a[39], a[21]
+	}
+	if a[22] > a[41] {
+		a[22], a[41] = a[41], a[22]
+	}
+	if a[23] > a[41] {
+		a[23], a[41] = a[41], a[23]
+	}
+	if a[22] > a[39] {
+		a[22], a[39] = a[39], a[22]
+	}
+	if a[23] > a[40] {
+		a[23], a[40] = a[40], a[23]
+	}
+	if a[23] > a[39] {
+		a[23], a[39] = a[39], a[23]
+	}
+	if a[21] > a[37] {
+		a[21], a[37] = a[37], a[21]
+	}
+	if a[21] > a[36] {
+		a[21], a[36] = a[36], a[21]
+	}
+	if a[22] > a[38] {
+		a[22], a[38] = a[38], a[22]
+	}
+	if a[23] > a[38] {
+		a[23], a[38] = a[38], a[23]
+	}
+	if a[22] > a[36] {
+		a[22], a[36] = a[36], a[22]
+	}
+	if a[23] > a[37] {
+		a[23], a[37] = a[37], a[23]
+	}
+	if a[23] > a[36] {
+		a[23], a[36] = a[36], a[23]
+	}
+	if a[12] > a[25] {
+		a[12], a[25] = a[25], a[12]
+	}
+	if a[12] > a[24] {
+		a[12], a[24] = a[24], a[12]
+	}
+	if a[13] > a[26] {
+		a[13], a[26] = a[26], a[13]
+	}
+	if a[14] > a[26] {
+		a[14], a[26] = a[26], a[14]
+	}
+	if a[13] > a[24] {
+		a[13], a[24] = a[24], a[13]
+	}
+	if a[14] > a[25] {
+		a[14], a[25] = a[25], a[14]
+	}
+	if a[14] > a[24] {
+		a[14], a[24] = a[24], a[14]
+	}
+	if a[15] > a[28] {
+		a[15], a[28] = a[28], a[15]
+	}
+	if a[15] > a[27] {
+		a[15], a[27] = a[27], a[15]
+	}
+	if a[16] > a[29] {
+		a[16], a[29] = a[29], a[16]
+	}
+	if a[17] > a[29] {
+		a[17], a[29] = a[29], a[17]
+	}
+	if a[16] > a[27] {
+		a[16], a[27] = a[27], a[16]
+	}
+	if a[17] > a[28] {
+		a[17], a[28] = a[28], a[17]
+	}
+	if a[17] > a[27] {
+		a[17], a[27] = a[27], a[17]
+	}
+	if a[15] > a[25] {
+		a[15], a[25] = a[25], a[15]
+	}
+	if a[15] > a[24] {
+		a[15], a[24] = a[24], a[15]
+	}
+	if a[16] > a[26] {
+		a[16], a[26] = a[26], a[16]
+	}
+	if a[17] > a[26] {
+		a[17], a[26] = a[26], a[17]
+	}
+	if a[16] > a[24] {
+		a[16], a[24] = a[24], a[16]
+	}
+	if a[17] > a[25] {
+		a[17], a[25] = a[25], a[17]
+	}
+	if a[17] > a[24] {
+		a[17], a[24] = a[24], a[17]
+	}
+	if a[18] > a[31] {
+		a[18], a[31] = a[31], a[18]
+	}
+	if a[18] > a[30] {
+		a[18], a[30] = a[30], a[18]
+	}
+	if a[19] > a[32] {
+		a[19], a[32] = a[32], a[19]
+	}
+	if a[20] > a[32] {
+		a[20], a[32] = a[32], a[20]
+	}
+	if a[19] > a[30] {
+		a[19], a[30] = a[30], a[19]
+	}
+	if a[20] > a[31] {
+		a[20], a[31] = a[31], a[20]
+	}
+	if a[20] > a[30] {
+		a[20], a[30] = a[30], a[20]
+	}
+	if a[21] > a[34] {
+		a[21], a[34] = a[34], a[21]
+	}
+	if a[21] > a[33] {
+		a[21], a[33] = a[33], a[21]
+	}
+	if a[22] > a[35] {
+		a[22], a[35] = a[35], a[22]
+	}
+	if a[23] > a[35] {
+		a[23], a[35] = a[35], a[23]
+	}
+	if a[22] > a[33] {
+		a[22], a[33] = a[33], a[22]
+	}
+	if a[23] > a[34] {
+		a[23], a[34] = a[34], a[23]
+	}
+	if a[23] > a[33] {
+		a[23], a[33] = a[33], a[23]
+	}
+	if a[21] > a[31] {
+		a[21], a[31] = a[31], a[21]
+	}
+	if a[21] > a[30] {
+		a[21], a[30] = a[30], a[21]
+	}
+	if a[22] > a[32] {
+		a[22], a[32] = a[32], a[22]
+	}
+	if a[23] > a[32] {
+		a[23], a[32] = a[32], a[23]
+	}
+	if a[22] > a[30] {
+		a[22], a[30] = a[30], a[22]
+	}
+	if a[23] > a[31] {
+		a[23], a[31] = a[31], a[23]
+	}
+	if a[23] > a[30] {
+		a[23], a[30] = a[30], a[23]
+	}
+	if a[18] > a[25] {
+		a[18], a[25] = a[25], a[18]
+	}
+	if a[18] > a[24] {
+		a[18], a[24] = a[24], a[18]
+	}
+	if a[19] > a[26] {
+		a[19], a[26] = a[26], a[19]
+	}
+	if a[20] > a[26] {
+		a[20], a[26] = a[26], a[20]
+	}
+	if a[19] > a[24] {
+		a[19], a[24] = a[24], a[19]
+	}
+	if a[20] > a[25] {
+		a[20], a[25] = a[25], a[20]
+	}
+	if a[20] > a[24] {
+		a[20], a[24] = a[24], a[20]
+	}
+	if a[21] > a[28] {
+		a[21], a[28] = a[28], a[21]
+	}
+	if a[21] > a[27] {
+		a[21], a[27] = a[27], a[21]
+	}
+	if a[22] > a[29] {
+		a[22], a[29] = a[29], a[22]
+	}
+	if a[23] > a[29] {
+		a[23], a[29] = a[29], a[23]
+	}
+	if a[22] > a[27] {
+		a[22], a[27] = a[27], a[22]
+	}
+	if a[23] > a[28] {
+		a[23], a[28] = a[28], a[23]
+	}
+	if a[23] > a[27] {
+		a[23], a[27] = a[27], a[23]
+	}
+	if a[21] > a[25] {
+		a[21], a[25] = a[25], a[21]
+	}
+	if a[21] > a[24] {
+		a[21], a[24] = a[24], a[21]
+	}
+	if a[22] > a[26] {
+		a[22], a[26] = a[26], a[22]
+	}
+	if a[23] > a[26] {
+		a[23], a[26] = a[26], a[23]
+	}
+	if a[22] > a[24] {
+		a[22], a[24] = a[24], a[22]
+	}
+	if a[23] > a[25] {
+		a[23], a[25] = a[25], a[23]
+	}
+	if a[23] > a[24] {
+		a[23], a[24] = a[24], a[23]
+	}
+}
+
+func NetworkSort64[T cmp.Ordered](a []T) {
+	_ = a[63]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[9] > a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[11] > a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[0] > a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] > a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[1] > a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[2] > a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[3] > a[10] {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[3] > a[9] {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[5] > a[12] {
+		a[5], a[12] = a[12], a[5]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7] > a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[7] > a[14] {
+		a[7], a[14] = a[14], a[7]
+	}
+	if a[6] > a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[7] > a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[7] > a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[16] > a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[18] > a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[16] > a[18] {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[17] > a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[20] > a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[22] > a[23] {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[20] > a[22] {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[21] > a[23] {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[21] > a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[16] > a[20] {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[17] > a[21] {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[17] > a[20] {
+		a[17], a[20] = a[20], a[17]
+	}
+	if a[18] > a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[19] > a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[19] > a[22] {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[18] > a[20] {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[19] > a[21] {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[19] > a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[24] > a[25] {
+		a[24], a[25] = a[25], a[24]
+	}
+	if a[26] > a[27] {
+		a[26], a[27] = a[27], a[26]
+	}
+	if a[24] > a[26] {
+		a[24], a[26] = a[26], a[24]
+	}
+	if a[25] > a[27] {
+		a[25], a[27] = a[27], a[25]
+	}
+	if a[25] > a[26] {
+		a[25], a[26] = a[26], a[25]
+	}
+	if a[28] > a[29] {
+		a[28], a[29] = a[29], a[28]
+	}
+	if a[30] > a[31] {
+		a[30], a[31] = a[31], a[30]
+	}
+	if a[28] > a[30] {
+		a[28], a[30] = a[30], a[28]
+	}
+	if a[29] > a[31] {
+		a[29], a[31] = a[31], a[29]
+	}
+	if a[29] > a[30] {
+		a[29], a[30] = a[30], a[29]
+	}
+	if a[24] > a[28] {
+		a[24], a[28] = a[28], a[24]
+	}
+	if a[25] > a[29] {
+		a[25], a[29] = a[29], a[25]
+	}
+	if a[25] > a[28] {
+		a[25], a[28] = a[28], a[25]
+	}
+	if a[26] > a[30] {
+		a[26], a[30] = a[30], a[26]
+	}
+	if a[27] > a[31] {
+		a[27], a[31] = a[31], a[27]
+	}
+	if a[27] > a[30] {
+		a[27], a[30] = a[30], a[27]
+	}
+	if a[26] > a[28] {
+		a[26], a[28] = a[28], a[26]
+	}
+	if a[27] > a[29] {
+		a[27], a[29] = a[29], a[27]
+	}
+	if a[27] > a[28] {
+		a[27], a[28] = a[28], a[27]
+	}
+	if a[16] > a[24] {
+		a[16], a[24] = a[24], a[16]
+	}
+	if a[17] > a[25] {
+		a[17], a[25] = a[25], a[17]
+	}
+	if a[17] > a[24] {
+		a[17], a[24] = a[24], a[17]
+	}
+	if a[18] > a[26] {
+		a[18], a[26] = a[26], a[18]
+	}
+	if a[19] > a[27] {
+		a[19], a[27] = a[27], a[19]
+	}
+	if a[19] > a[26] {
+		a[19], a[26] = a[26], a[19]
+	}
+	if a[18] > a[24] {
+		a[18], a[24] = a[24], a[18]
+	}
+	if a[19] > a[25] {
+		a[19], a[25] = a[25], a[19]
+	}
+	if a[19] > a[24] {
+		a[19], a[24] = a[24], a[19]
+	}
+	if a[20] > a[28] {
+		a[20], a[28] = a[28], a[20]
+	}
+	if a[21] > a[29] {
+		a[21], a[29] = a[29], a[21]
+	}
+	if a[21] > a[28] {
+		a[21], a[28] = a[28], a[21]
+	}
+	if a[22] > a[30] {
+		a[22], a[30] = a[30], a[22]
+	}
+	if a[23] > a[31] {
+		a[23], a[31] = a[31], a[23]
+	}
+	if a[23] > a[30] {
+		a[23], a[30] = a[30], a[23]
+	}
+	if a[22] > a[28] {
+		a[22], a[28] = a[28], a[22]
+	}
+	if a[23] > a[29] {
+		a[23], a[29] = a[29], a[23]
+	}
+	if a[23] > a[28] {
+		a[23], a[28] = a[28], a[23]
+	}
+	if a[20] > a[24] {
+		a[20], a[24] = a[24], a[20]
+	}
+	if a[21] > a[25] {
+		a[21], a[25] = a[25], a[21]
+	}
+	if a[21] > a[24] {
+		a[21], a[24] = a[24], a[21]
+	}
+	if a[22] > a[26] {
+		a[22], a[26] = a[26], a[22]
+	}
+	if a[23] > a[27] {
+		a[23], a[27] = a[27], a[23]
+	}
+	if a[23] > a[26] {
+		a[23], a[26] = a[26], a[23]
+	}
+	if a[22] > a[24] {
+		a[22], a[24] = a[24], a[22]
+	}
+	if a[23] > a[25] {
+		a[23], a[25] = a[25], a[23]
+	}
+	if a[23] > a[24] {
+		a[23], a[24] = a[24], a[23]
+	}
+	if a[0] > a[16] {
+		a[0], a[16] = a[16], a[0]
+	}
+	if a[1] > a[17] {
+		a[1], a[17] = a[17], a[1]
+	}
+	if a[1] > a[16] {
+		a[1], a[16] = a[16], a[1]
+	}
+	if a[2] > a[18] {
+		a[2], a[18] = a[18], a[2]
+	}
+	if a[3] > a[19] {
+		a[3], a[19] = a[19], a[3]
+	}
+	if a[3] > a[18] {
+		a[3], a[18] = a[18], a[3]
+	}
+	if a[2] > a[16] {
+		a[2], a[16] = a[16], a[2]
+	}
+	if a[3] > a[17] {
+		a[3], a[17] = a[17], a[3]
+	}
+	if a[3] > a[16] {
+		a[3], a[16] = a[16], a[3]
+	}
+	if a[4] > a[20] {
+		a[4], a[20] = a[20], a[4]
+	}
+	if a[5] > a[21] {
+		a[5], a[21] = a[21], a[5]
+	}
+	if a[5] > a[20] {
+		a[5], a[20] = a[20], a[5]
+	}
+	if a[6] > a[22] {
+		a[6], a[22] = a[22], a[6]
+	}
+	if a[7] > a[23] {
+		a[7], a[23] = a[23], a[7]
+	}
+	if a[7] > a[22] {
+		a[7], a[22] = a[22], a[7]
+	}
+	if a[6] > a[20] {
+		a[6], a[20] = a[20], a[6]
+	}
+	if a[7] > a[21] {
+		a[7], a[21] = a[21], a[7]
+	}
+	if a[7] > a[20] {
+		a[7], a[20] = a[20], a[7]
+	}
+	if a[4] > a[16] {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[5] > a[17] {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[5] > a[16] {
+		a[5], a[16] = a[16], a[5]
+	}
+	if a[6] > a[18] {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[7] > a[19] {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[7] > a[18] {
+		a[7], a[18] = a[18], a[7]
+	}
+	if a[6] > a[16] {
+		a[6], a[16] = a[16], a[6]
+	}
+	if a[7] > a[17] {
+		a[7], a[17] = a[17], a[7]
+	}
+	if a[7] > a[16] {
+		a[7], a[16] = a[16], a[7]
+	}
+	if a[8] > a[24] {
+		a[8], a[24] = a[24], a[8]
+	}
+	if a[9] > a[25] {
+		a[9], a[25] = a[25], a[9]
+	}
+	if a[9] > a[24] {
+		a[9], a[24] = a[24], a[9]
+	}
+	if a[10] > a[26] {
+		a[10], a[26] = a[26], a[10]
+	}
+	if a[11] > a[27] {
+		a[11], a[27] = a[27], a[11]
+	}
+	if a[11] > a[26] {
+		a[11], a[26] = a[26], a[11]
+	}
+	if a[10] > a[24] {
+		a[10], a[24] = a[24], a[10]
+	}
+	if a[11] > a[25] {
+		a[11], a[25] = a[25], a[11]
+	}
+	if a[11] > a[24] {
+		a[11], a[24] = a[24], a[11]
+	}
+	if a[12] > a[28] {
+		a[12], a[28] = a[28], a[12]
+	}
+	if a[13] > a[29] {
+		a[13], a[29] = a[29], a[13]
+	}
+	if a[13] > a[28] {
+		a[13], a[28] = a[28], a[13]
+	}
+	if a[14] > a[30] {
+		a[14], a[30] = a[30], a[14]
+	}
+	if a[15] > a[31] {
+		a[15], a[31] = a[31], a[15]
+	}
+	if a[15] > a[30] {
+		a[15], a[30] = a[30], a[15]
+	}
+	if a[14] > a[28] {
+		a[14], a[28] = a[28], a[14]
+	}
+	if a[15] > a[29] {
+		a[15], a[29] = a[29], a[15]
+	}
+	if a[15] > a[28] {
+		a[15], a[28] = a[28], a[15]
+	}
+	if a[12] > a[24] {
+		a[12], a[24] = a[24], a[12]
+	}
+	if a[13] > a[25] {
+		a[13], a[25] = a[25], a[13]
+	}
+	if a[13] > a[24] {
+		a[13], a[24] = a[24], a[13]
+	}
+	if a[14] > a[26] {
+		a[14], a[26] = a[26], a[14]
+	}
+	if a[15] > a[27] {
+		a[15], a[27] = a[27], a[15]
+	}
+	if a[15] > a[26] {
+		a[15], a[26] = a[26], a[15]
+	}
+	if a[14] > a[24] {
+		a[14], a[24] = a[24], a[14]
+	}
+	if a[15] > a[25] {
+		a[15], a[25] = a[25], a[15]
+	}
+	if a[15] > a[24] {
+		a[15], a[24] = a[24], a[15]
+	}
+	if a[8] > a[16] {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[9] > a[17] {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[9] > a[16] {
+		a[9], a[16] = a[16], a[9]
+	}
+	if a[10] > a[18] {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[11] > a[19] {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[11] > a[18] {
+		a[11], a[18] = a[18], a[11]
+	}
+	if a[10] > a[16] {
+		a[10], a[16] = a[16], a[10]
+	}
+	if a[11] > a[17] {
+		a[11], a[17] = a[17], a[11]
+	}
+	if a[11] > a[16] {
+		a[11], a[16] = a[16], a[11]
+	}
+	if a[12] > a[20] {
+		a[12], a[20] = a[20], a[12]
+	}
+	if a[13] > a[21] {
+		a[13], a[21] = a[21], a[13]
+	}
+	if a[13] > a[20] {
+		a[13], a[20] = a[20], a[13]
+	}
+	if a[14] > a[22] {
+		a[14], a[22] = a[22], a[14]
+	}
+	if a[15] > a[23] {
+		a[15], a[23] = a[23], a[15]
+	}
+	if a[15] > a[22] {
+		a[15], a[22] = a[22], a[15]
+	}
+	if a[14] > a[20] {
+		a[14], a[20] = a[20], a[14]
+	}
+	if a[15] > a[21] {
+		a[15], a[21] = a[21], a[15]
+	}
+	if a[15] > a[20] {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[13] > a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[13] > a[16] {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[14] > a[18] {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[15] > a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[15] > a[18] {
+		a[15], a[18] = a[18], a[15]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[32] > a[33] {
+		a[32], a[33] = a[33], a[32]
+	}
+	if a[34] > a[35] {
+		a[34], a[35] = a[35], a[34]
+	}
+	if a[32] > a[34] {
+		a[32], a[34] = a[34], a[32]
+	}
+	if a[33] > a[35] {
+		a[33], a[35] = a[35], a[33]
+	}
+	if a[33] > a[34] {
+		a[33], a[34] = a[34], a[33]
+	}
+	if a[36] > a[37] {
+		a[36], a[37] = a[37], a[36]
+	}
+	if a[38] > a[39] {
+		a[38], a[39] = a[39], a[38]
+	}
+	if a[36] > a[38] {
+		a[36], a[38] = a[38], a[36]
+	}
+	if a[37] > a[39] {
+		a[37], a[39] = a[39], a[37]
+	}
+	if a[37] > a[38] {
+		a[37], a[38] = a[38], a[37]
+	}
+	if a[32] > a[36] {
+		a[32], a[36] = a[36], a[32]
+	}
+	if a[33] > a[37] {
+		a[33], a[37] = a[37], a[33]
+	}
+	if a[33] > a[36] {
+		a[33], a[36] = a[36], a[33]
+	}
+	if a[34] > a[38] {
+		a[34], a[38] = a[38], a[34]
+	}
+	if a[35] > a[39] {
+		a[35], a[39] = a[39], a[35]
+	}
+	if a[35] > a[38] {
+		a[35], a[38] = a[38], a[35]
+	}
+	if a[34] > a[36] {
+		a[34], a[36] = a[36], a[34]
+	}
+	if a[35] > a[37] {
+		a[35], a[37] = a[37], a[35]
+	}
+	if a[35] > a[36] {
+		a[35], a[36] = a[36], a[35]
+	}
+	if a[40] > a[41] {
+		a[40], a[41] = a[41], a[40]
+	}
+	if a[42] > a[43] {
+		a[42], a[43] = a[43], a[42]
+	}
+	if a[40] > a[42] {
+		a[40], a[42] = a[42], a[40]
+	}
+	if a[41] > a[43] {
+		a[41], a[43] = a[43], a[41]
+	}
+	if a[41] > a[42] {
+		a[41], a[42] = a[42], a[41]
+	}
+	if a[44] > a[45] {
+		a[44], a[45] = a[45], a[44]
+	}
+	if a[46] > a[47] {
+		a[46], a[47] = a[47], a[46]
+	}
+	if a[44] > a[46] {
+		a[44], a[46] = a[46], a[44]
+	}
+	if a[45] > a[47] {
+		a[45], a[47] = a[47], a[45]
+	}
+	if a[45] > a[46] {
+		a[45], a[46] = a[46], a[45]
+	}
+	if a[40] > a[44] {
+		a[40], a[44] = a[44], a[40]
+	}
+	if a[41] > a[45] {
+		a[41], a[45] = a[45], a[41]
+	}
+	if a[41] > a[44] {
+		a[41], a[44] = a[44], a[41]
+	}
+	if a[42] > a[46] {
+		a[42], a[46] = a[46], a[42]
+	}
+	if a[43] > a[47] {
+		a[43], a[47] = a[47], a[43]
+	}
+	if a[43] > a[46] {
+		a[43], a[46] = a[46], a[43]
+	}
+	if a[42] > a[44] {
+		a[42], a[44] = a[44], a[42]
+	}
+	if a[43] > a[45] {
+		a[43], a[45] = a[45], a[43]
+	}
+	if a[43] > a[44] {
+		a[43], a[44] = a[44], a[43]
+	}
+	if a[32] > a[40] {
+		a[32], a[40] = a[40], a[32]
+	}
+	if a[33] > a[41] {
+		a[33], a[41] = a[41], a[33]
+	}
+	if a[33] > a[40] {
+		a[33], a[40] = a[40], a[33]
+	}
+	if a[34] > a[42] {
+		a[34], a[42] = a[42], a[34]
+	}
+	if a[35] > a[43] {
+		a[35], a[43] = a[43], a[35]
+	}
+	if a[35] > a[42] {
+		a[35], a[42] = a[42], a[35]
+	}
+	if a[34] > a[40] {
+		a[34], a[40] = a[40], a[34]
+	}
+	if a[35] > a[41] {
+		a[35], a[41] = a[41], a[35]
+	}
+	if a[35] > a[40] {
+		a[35], a[40] = a[40], a[35]
+	}
+	if a[36] > a[44] {
+		a[36], a[44] = a[44], a[36]
+	}
+	if a[37] > a[45] {
+		a[37], a[45] = a[45], a[37]
+	}
+	if a[37] > a[44] {
+		a[37], a[44] = a[44], a[37]
+	}
+	if a[38] > a[46] {
+		a[38], a[46] = a[46], a[38]
+	}
+	if a[39] > a[47] {
+		a[39], a[47] = a[47], a[39]
+	}
+	if a[39] > a[46] {
+		a[39], a[46] = a[46], a[39]
+	}
+	if a[38] > a[44] {
+		a[38], a[44] = a[44], a[38]
+	}
+	if a[39] > a[45] {
+		a[39], a[45] = a[45], a[39]
+	}
+	if a[39] > a[44] {
+		a[39], a[44] = a[44], a[39]
+	}
+	if a[36] > a[40] {
+		a[36], a[40] = a[40], a[36]
+	}
+	if a[37] > a[41] {
+		a[37], a[41] = a[41], a[37]
+	}
+	if a[37] > a[40] {
+		a[37], a[40] = a[40], a[37]
+	}
+	if a[38] > a[42] {
+		a[38], a[42] = a[42], a[38]
+	}
+	if a[39] > a[43] {
+		a[39], a[43] = a[43], a[39]
+	}
+	if a[39] > a[42] {
+		a[39], a[42] = a[42], a[39]
+	}
+	if a[38] > a[40] {
+		a[38], a[40] = a[40], a[38]
+	}
+	if a[39] > a[41] {
+		a[39], a[41] = a[41], a[39]
+	}
+	if a[39] > a[40] {
+		a[39], a[40] = a[40], a[39]
+	}
+	if a[48] > a[49] {
+		a[48], a[49] = a[49], a[48]
+	}
+	if a[50] > a[51] {
+		a[50], a[51] = a[51], a[50]
+	}
+	if a[48] > a[50] {
+		a[48], a[50] = a[50], a[48]
+	}
+	if a[49] > a[51] {
+		a[49], a[51] = a[51], a[49]
+	}
+	if a[49] > a[50] {
+		a[49], a[50] = a[50], a[49]
+	}
+	if a[52] > a[53] {
+		a[52], a[53] = a[53], a[52]
+	}
+	if a[54] > a[55] {
+		a[54], a[55] = a[55], a[54]
+	}
+	if a[52] > a[54] {
+		a[52], a[54] = a[54], a[52]
+	}
+	if a[53] > a[55] {
+		a[53], a[55] = a[55], a[53]
+	}
+	if a[53] > a[54] {
+		a[53], a[54] = a[54], a[53]
+	}
+	if a[48] > a[52] {
+		a[48], a[52] = a[52], a[48]
+	}
+	if a[49] > a[53] {
+		a[49], a[53] = a[53], a[49]
+	}
+	if a[49] > a[52] {
+		a[49], a[52] = a[52], a[49]
+	}
+	if a[50] > a[54] {
+		a[50], a[54] = a[54], a[50]
+	}
+	if a[51] > a[55] {
+		a[51], a[55] = a[55], a[51]
+	}
+	if a[51] > a[54] {
+		a[51], a[54] = a[54], a[51]
+	}
+	if a[50] > a[52] {
+		a[50], a[52] = a[52], a[50]
+	}
+	if a[51] > a[53] {
+		a[51], a[53] = a[53], a[51]
+	}
+	if a[51] > a[52] {
+		a[51], a[52] = a[52], a[51]
+	}
+	if a[56] > a[57] {
+		a[56], a[57] = a[57], a[56]
+	}
+	if a[58] > a[59] {
+		a[58], a[59] = a[59], a[58]
+	}
+	if a[56] > a[58] {
+		a[56], a[58] = a[58], a[56]
+	}
+	if a[57] > a[59] {
+		a[57], a[59] = a[59], a[57]
+	}
+	if a[57] > a[58] {
+		a[57], a[58] = a[58], a[57]
+	}
+	if a[60] > a[61] {
+		a[60], a[61] = a[61], a[60]
+	}
+	if a[62] > a[63] {
+		a[62], a[63] = a[63], a[62]
+	}
+	if a[60] > a[62] {
+		a[60], a[62] = a[62], a[60]
+	}
+	if a[61] > a[63] {
+		a[61], a[63] = a[63], a[61]
+	}
+	if a[61] > a[62] {
+		a[61], a[62] = a[62], a[61]
+	}
+	if a[56] > a[60] {
+		a[56], a[60] = a[60], a[56]
+	}
+	if a[57] > a[61] {
+		a[57], a[61] = a[61], a[57]
+	}
+	if a[57] > a[60] {
+		a[57], a[60] = a[60], a[57]
+	}
+	if a[58] > a[62] {
+		a[58], a[62] = a[62], a[58]
+	}
+	if a[59] > a[63] {
+		a[59], a[63] = a[63], a[59]
+	}
+	if a[59] > a[62] {
+		a[59], a[62] = a[62], a[59]
+	}
+	if a[58] > a[60] {
+		a[58], a[60] = a[60], a[58]
+	}
+	if a[59] > a[61] {
+		a[59], a[61] = a[61], a[59]
+	}
+	if a[59] > a[60] {
+		a[59], a[60] = a[60], a[59]
+	}
+	if a[48] > a[56] {
+		a[48], a[56] = a[56], a[48]
+	}
+	if a[49] > a[57] {
+		a[49], a[57] = a[57], a[49]
+	}
+	if a[49] > a[56] {
+		a[49], a[56] = a[56], a[49]
+	}
+	if a[50] > a[58] {
+		a[50], a[58] = a[58], a[50]
+	}
+	if a[51] > a[59] {
+		a[51], a[59] = a[59], a[51]
+	}
+	if a[51] > a[58] {
+		a[51], a[58] = a[58], a[51]
+	}
+	if a[50] > a[56] {
+		a[50], a[56] = a[56], a[50]
+	}
+	if a[51] > a[57] {
+		a[51], a[57] = a[57], a[51]
+	}
+	if a[51] > a[56] {
+		a[51], a[56] = a[56], a[51]
+	}
+	if a[52] > a[60] {
+		a[52], a[60] = a[60], a[52]
+	}
+	if a[53] > a[61] {
+		a[53], a[61] = a[61], a[53]
+	}
+	if a[53] > a[60] {
+		a[53], a[60] = a[60], a[53]
+	}
+	if a[54] > a[62] {
+		a[54], a[62] = a[62], a[54]
+	}
+	if a[55] > a[63] {
+		a[55], a[63] = a[63], a[55]
+	}
+	if a[55] > a[62] {
+		a[55], a[62] = a[62], a[55]
+	}
+	if a[54] > a[60] {
+		a[54], a[60] = a[60], a[54]
+	}
+	if a[55] > a[61] {
+		a[55], a[61] = a[61], a[55]
+	}
+	if a[55] > a[60] {
+		a[55], a[60] = a[60], a[55]
+	}
+	if a[52] > a[56] {
+		a[52], a[56] = a[56], a[52]
+	}
+	if a[53] > a[57] {
+		a[53], a[57] = a[57], a[53]
+	}
+	if a[53] > a[56] {
+		a[53], a[56] = a[56], a[53]
+	}
+	if a[54] > a[58] {
+		a[54], a[58] = a[58], a[54]
+	}
+	if a[55] > a[59] {
+		a[55], a[59] = a[59], a[55]
+	}
+	if a[55] > a[58] {
+		a[55], a[58] = a[58], a[55]
+	}
+	if a[54] > a[56] {
+		a[54], a[56] = a[56], a[54]
+	}
+	if a[55] > a[57] {
+		a[55], a[57] = a[57], a[55]
+	}
+	if a[55] > a[56] {
+		a[55], a[56] = a[56], a[55]
+	}
+	if a[32] > a[48] {
+		a[32], a[48] = a[48], a[32]
+	}
+	if a[33] > a[49] {
+		a[33], a[49] = a[49], a[33]
+	}
+	if a[33] > a[48] {
+		a[33], a[48] = a[48], a[33]
+	}
+	if a[34] > a[50] {
+		a[34], a[50] = a[50], a[34]
+	}
+	if a[35] > a[51] {
+		a[35], a[51] = a[51], a[35]
+	}
+	if a[35] > a[50] {
+		a[35], a[50] = a[50], a[35]
+	}
+	if a[34] > a[48] {
+		a[34], a[48] = a[48], a[34]
+	}
+	if a[35] > a[49] {
+		a[35], a[49] = a[49], a[35]
+	}
+	if a[35] > a[48] {
+		a[35], a[48] = a[48], a[35]
+	}
+	if a[36] > a[52] {
+		a[36], a[52] = a[52], a[36]
+	}
+	if a[37] > a[53] {
+		a[37], a[53] = a[53], a[37]
+	}
+	if a[37] > a[52] {
+		a[37], a[52] = a[52], a[37]
+	}
+	if a[38] > a[54] {
+		a[38], a[54] = a[54], a[38]
+	}
+	if a[39] > a[55] {
+		a[39], a[55] = a[55], a[39]
+	}
+	if a[39] > a[54] {
+		a[39], a[54] = a[54], a[39]
+	}
+	if a[38] > a[52] {
+		a[38], a[52] = a[52], a[38]
+	}
+	if a[39] > a[53] {
+		a[39], a[53] = a[53], a[39]
+	}
+	if a[39] > a[52] {
+		a[39], a[52] = a[52], a[39]
+	}
+	if a[36] > a[48] {
+		a[36], a[48] = a[48], a[36]
+	}
+	if a[37] > a[49] {
+		a[37], a[49] = a[49], a[37]
+	}
+	if a[37] > a[48] {
+		a[37], a[48] = a[48], a[37]
+	}
+	if a[38] > a[50] {
+		a[38], a[50] = a[50], a[38]
+	}
+	if a[39] > a[51] {
+		a[39], a[51] = a[51], a[39]
+	}
+	if a[39] > a[50] {
+		a[39], a[50] = a[50], a[39]
+	}
+	if a[38] > a[48] {
+		a[38], a[48] = a[48], a[38]
+	}
+	if a[39] > a[49] {
+		a[39], a[49] = a[49], a[39]
+	}
+	if a[39] > a[48] {
+		a[39], a[48] = a[48], a[39]
+	}
+	if a[40] > a[56] {
+		a[40], a[56] = a[56], a[40]
+	}
+	if a[41] > a[57] {
+		a[41], a[57] = a[57], a[41]
+	}
+	if a[41] > a[56] {
+		a[41], a[56] = a[56], a[41]
+	}
+	if a[42] > a[58] {
+		a[42], a[58] = a[58], a[42]
+	}
+	if a[43] > a[59] {
+		a[43], a[59] = a[59], a[43]
+	}
+	if a[43] > a[58] {
+		a[43], a[58] = a[58], a[43]
+	}
+	if a[42] > a[56] {
+		a[42], a[56] = a[56], a[42]
+	}
+	if a[43] > a[57] {
+		a[43], a[57] = a[57], a[43]
+	}
+	if a[43] > a[56] {
+		a[43], a[56] = a[56], a[43]
+	}
+	if a[44] > a[60] {
+		a[44], a[60] = a[60], a[44]
+	}
+	if a[45] > a[61] {
+		a[45], a[61] = a[61], a[45]
+	}
+	if a[45] > a[60] {
+		a[45], a[60] = a[60], a[45]
+	}
+	if a[46] > a[62] {
+		a[46], a[62] = a[62], a[46]
+	}
+	if a[47] > a[63] {
+		a[47], a[63] = a[63], a[47]
+	}
+	if a[47] > a[62] {
+		a[47], a[62] = a[62], a[47]
+	}
+	if a[46] > a[60] {
+		a[46], a[60] = a[60], a[46]
+	}
+	if a[47] > a[61] {
+		a[47], a[61] = a[61], a[47]
+	}
+	if a[47] > a[60] {
+		a[47], a[60] = a[60], a[47]
+	}
+	if a[44] > a[56] {
+		a[44], a[56] = a[56], a[44]
+	}
+	if a[45] > a[57] {
+		a[45], a[57] = a[57], a[45]
+	}
+	if a[45] > a[56] {
+		a[45], a[56] = a[56], a[45]
+	}
+	if a[46] > a[58] {
+		a[46], a[58] = a[58], a[46]
+	}
+	if a[47] > a[59] {
+		a[47], a[59] = a[59], a[47]
+	}
+	if a[47] > a[58] {
+		a[47], a[58] = a[58], a[47]
+	}
+	if a[46] > a[56] {
+		a[46], a[56] = a[56], a[46]
+	}
+	if a[47] > a[57] {
+		a[47], a[57] = a[57], a[47]
+	}
+	if a[47] > a[56] {
+		a[47], a[56] = a[56], a[47]
+	}
+	if a[40] > a[48] {
+		a[40], a[48] = a[48], a[40]
+	}
+	if a[41] > a[49] {
+		a[41], a[49] = a[49], a[41]
+	}
+	if a[41] > a[48] {
+		a[41], a[48] = a[48], a[41]
+	}
+	if a[42] > a[50] {
+		a[42], a[50] = a[50], a[42]
+	}
+	if a[43] > a[51] {
+		a[43], a[51] = a[51], a[43]
+	}
+	if a[43] > a[50] {
+		a[43], a[50] = a[50], a[43]
+	}
+	if a[42] > a[48] {
+		a[42], a[48] = a[48], a[42]
+	}
+	if a[43] > a[49] {
+		a[43], a[49] = a[49], a[43]
+	}
+	if a[43] > a[48] {
+		a[43], a[48] = a[48], a[43]
+	}
+	if a[44] > a[52] {
+		a[44], a[52] = a[52], a[44]
+	}
+	if a[45] > a[53] {
+		a[45], a[53] = a[53], a[45]
+	}
+	if a[45] > a[52] {
+		a[45], a[52] = a[52], a[45]
+	}
+	if a[46] > a[54] {
+		a[46], a[54] = a[54], a[46]
+	}
+	if a[47] > a[55] {
+		a[47], a[55] = a[55], a[47]
+	}
+	if a[47] > a[54] {
+		a[47], a[54] = a[54], a[47]
+	}
+	if a[46] > a[52] {
+		a[46], a[52] = a[52], a[46]
+	}
+	if a[47] > a[53] {
+		a[47], a[53] = a[53], a[47]
+	}
+	if a[47] > a[52] {
+		a[47], a[52] = a[52], a[47]
+	}
+	if a[44] > a[48] {
+		a[44], a[48] = a[48], a[44]
+	}
+	if a[45] > a[49] {
+		a[45], a[49] = a[49], a[45]
+	}
+	if a[45] > a[48] {
+		a[45], a[48] = a[48], a[45]
+	}
+	if a[46] > a[50] {
+		a[46], a[50] = a[50], a[46]
+	}
+	if a[47] > a[51] {
+		a[47], a[51] = a[51], a[47]
+	}
+	if a[47] > a[50] {
+		a[47], a[50] = a[50], a[47]
+	}
+	if a[46] > a[48] {
+		a[46], a[48] = a[48], a[46]
+	}
+	if a[47] > a[49] {
+		a[47], a[49] = a[49], a[47]
+	}
+	if a[47] > a[48] {
+		a[47], a[48] = a[48], a[47]
+	}
+	if a[0] > a[32] {
+		a[0], a[32] = a[32], a[0]
+	}
+	if a[1] > a[33] {
+		a[1], a[33] = a[33], a[1]
+	}
+	if a[1] > a[32] {
+		a[1], a[32] = a[32], a[1]
+	}
+	if a[2] > a[34] {
+		a[2], a[34] = a[34], a[2]
+	}
+	if a[3] > a[35] {
+		a[3], a[35] = a[35], a[3]
+	}
+	if a[3] > a[34] {
+		a[3], a[34] = a[34], a[3]
+	}
+	if a[2] > a[32] {
+		a[2], a[32] = a[32], a[2]
+	}
+	if a[3] > a[33] {
+		a[3], a[33] = a[33], a[3]
+	}
+	if a[3] > a[32] {
+		a[3], a[32] = a[32], a[3]
+	}
+	if a[4] > a[36] {
+		a[4], a[36] = a[36], a[4]
+	}
+	if a[5] > a[37] {
+		a[5], a[37] = a[37], a[5]
+	}
+	if a[5] > a[36] {
+		a[5], a[36] = a[36], a[5]
+	}
+	if a[6] > a[38] {
+		a[6], a[38] = a[38], a[6]
+	}
+	if a[7] > a[39] {
+		a[7], a[39] = a[39], a[7]
+	}
+	if a[7] > a[38] {
+		a[7], a[38] = a[38], a[7]
+	}
+	if a[6] > a[36] {
+		a[6], a[36] = a[36], a[6]
+	}
+	if a[7] > a[37] {
+		a[7], a[37] = a[37], a[7]
+	}
+	if a[7] > a[36] {
+		a[7], a[36] = a[36], a[7]
+	}
+	if a[4] > a[32] {
+		a[4], a[32] = a[32], a[4]
+	}
+	if a[5] > a[33] {
+		a[5], a[33] = a[33], a[5]
+	}
+	if a[5] > a[32] {
+		a[5], a[32] = a[32], a[5]
+	}
+	if a[6] > a[34] {
+		a[6], a[34] = a[34], a[6]
+	}
+	if a[7] > a[35] {
+		a[7], a[35] = a[35], a[7]
+	}
+	if a[7] > a[34] {
+		a[7], a[34] = a[34], a[7]
+	}
+	if a[6] > a[32] {
+		a[6], a[32] = a[32], a[6]
+	}
+	if a[7] > a[33] {
+		a[7], a[33] = a[33], a[7]
+	}
+	if a[7] > a[32] {
+		a[7], a[32] = a[32], a[7]
+	}
+	if a[8] > a[40] {
+		a[8], a[40] = a[40], a[8]
+	}
+	if a[9] > a[41] {
+		a[9], a[41] = a[41], a[9]
+	}
+	if a[9] > a[40] {
+		a[9], a[40] = a[40], a[9]
+	}
+	if a[10] > a[42] {
+		a[10], a[42] = a[42], a[10]
+	}
+	if a[11] > a[43] {
+		a[11], a[43] = a[43], a[11]
+	}
+	if a[11] > a[42] {
+		a[11], a[42] = a[42], a[11]
+	}
+	if a[10] > a[40] {
+		a[10], a[40] = a[40], a[10]
+	}
+	if a[11] > a[41] {
+		a[11], a[41] = a[41], a[11]
+	}
+	if a[11] > a[40] {
+		a[11], a[40] = a[40], a[11]
+	}
+	if a[12] > a[44] {
+		a[12], a[44] = a[44], a[12]
+	}
+	if a[13] > a[45] {
+		a[13], a[45] = a[45], a[13]
+	}
+	if a[13] > a[44] {
+		a[13], a[44] = a[44], a[13]
+	}
+	if a[14] > a[46] {
+		a[14], a[46] = a[46], a[14]
+	}
+	if a[15] > a[47] {
+		a[15], a[47] = a[47], a[15]
+	}
+	if a[15] > a[46] {
+		a[15], a[46] = a[46], a[15]
+	}
+	if a[14] > a[44] {
+		a[14], a[44] = a[44], a[14]
+	}
+	if a[15] > a[45] {
+		a[15], a[45] = a[45], a[15]
+	}
+	if a[15] > a[44] {
+		a[15], a[44] = a[44], a[15]
+	}
+	if a[12] > a[40] {
+		a[12], a[40] = a[40], a[12]
+	}
+	if a[13] > a[41] {
+		a[13], a[41] = a[41], a[13]
+	}
+	if a[13] > a[40] {
+		a[13], a[40] = a[40], a[13]
+	}
+	if a[14] > a[42] {
+		a[14], a[42] = a[42], a[14]
+	}
+	if a[15] > a[43] {
+		a[15], a[43] = a[43], a[15]
+	}
+	if a[15] > a[42] {
+		a[15], a[42] = a[42], a[15]
+	}
+	if a[14] > a[40] {
+		a[14], a[40] = a[40], a[14]
+	}
+	if a[15] > a[41] {
+		a[15], a[41] = a[41], a[15]
+	}
+	if a[15] > a[40] {
+		a[15], a[40] = a[40], a[15]
+	}
+	if a[8] > a[32] {
+		a[8], a[32] = a[32], a[8]
+	}
+	if a[9] > a[33] {
+		a[9], a[33] = a[33], a[9]
+	}
+	if a[9] > a[32] {
+		a[9], a[32] = a[32], a[9]
+	}
+	if a[10] > a[34] {
+		a[10], a[34] = a[34], a[10]
+	}
+	if a[11] > a[35] {
+		a[11], a[35] = a[35], a[11]
+	}
+	if a[11] > a[34] {
+		a[11], a[34] = a[34], a[11]
+	}
+	if a[10] > a[32] {
+		a[10], a[32] = a[32], a[10]
+	}
+	if a[11] > a[33] {
+		a[11], a[33] = a[33], a[11]
+	}
+	if a[11] > a[32] {
+		a[11], a[32] = a[32], a[11]
+	}
+	if a[12] > a[36] {
+		a[12], a[36] = a[36], a[12]
+	}
+	if a[13] > a[37] {
+		a[13], a[37] = a[37], a[13]
+	}
+	if a[13] > a[36] {
+		a[13], a[36] = a[36], a[13]
+	}
+	if a[14] > a[38] {
+		a[14], a[38] = a[38], a[14]
+	}
+	if a[15] > a[39] {
+		a[15], a[39] = a[39], a[15]
+	}
+	if a[15] > a[38] {
+		a[15], a[38] = a[38], a[15]
+	}
+	if a[14] > a[36] {
+		a[14], a[36] = a[36], a[14]
+	}
+	if a[15] > a[37] {
+		a[15], a[37] = a[37], a[15]
+	}
+	if a[15] > a[36] {
+		a[15], a[36] = a[36], a[15]
+	}
+	if a[12] > a[32] {
+		a[12], a[32] = a[32], a[12]
+	}
+	if a[13] > a[33] {
+		a[13], a[33] = a[33], a[13]
+	}
+	if a[13] > a[32] {
+		a[13], a[32] = a[32], a[13]
+	}
+	if a[14] > a[34] {
+		a[14], a[34] = a[34], a[14]
+	}
+	if a[15] > a[35] {
+		a[15], a[35] = a[35], a[15]
+	}
+	if a[15] > a[34] {
+		a[15], a[34] = a[34], a[15]
+	}
+	if a[14] > a[32] {
+		a[14], a[32] = a[32], a[14]
+	}
+	if a[15] > a[33] {
+		a[15], a[33] = a[33], a[15]
+	}
+	if a[15] > a[32] {
+		a[15], a[32] = a[32], a[15]
+	}
+	if a[16] > a[48] {
+		a[16], a[48] = a[48], a[16]
+	}
+	if a[17] > a[49] {
+		a[17], a[49] = a[49], a[17]
+	}
+	if a[17] > a[48] {
+		a[17], a[48] = a[48], a[17]
+	}
+	if a[18] > a[50] {
+		a[18], a[50] = a[50], a[18]
+	}
+	if a[19] > a[51] {
+		a[19], a[51] = a[51], a[19]
+	}
+	if a[19] > a[50] {
+		a[19], a[50] = a[50], a[19]
+	}
+	if a[18] > a[48] {
+		a[18], a[48] = a[48], a[18]
+	}
+	if a[19] > a[49] {
+		a[19], a[49] = a[49], a[19]
+	}
+	if a[19] > a[48] {
+		a[19], a[48] = a[48], a[19]
+	}
+	if a[20] > a[52] {
+		a[20], a[52] = a[52], a[20]
+	}
+	if a[21] > a[53] {
+		a[21], a[53] = a[53], a[21]
+	}
+	if a[21] > a[52] {
+		a[21], a[52] = a[52], a[21]
+	}
+	if a[22] > a[54] {
+		a[22], a[54] = a[54], a[22]
+	}
+	if a[23] > a[55] {
+		a[23], a[55] = a[55], a[23]
+	}
+	if a[23] > a[54] {
+		a[23], a[54] = a[54], a[23]
+	}
+	if a[22] > a[52] {
+		a[22], a[52] = a[52], a[22]
+	}
+	if a[23] > a[53] {
+		a[23], a[53] = a[53], a[23]
+	}
+	if a[23] > a[52] {
+		a[23], a[52] = a[52], a[23]
+	}
+	if a[20] > a[48] {
+		a[20], a[48] = a[48], a[20]
+	}
+	if a[21] > a[49] {
+		a[21], a[49] = a[49], a[21]
+	}
+	if a[21] > a[48] {
+		a[21], a[48] = a[48], a[21]
+	}
+	if a[22] > a[50] {
+		a[22], a[50] = a[50], a[22]
+	}
+	if a[23] > a[51] {
+		a[23], a[51] = a[51], a[23]
+	}
+	if a[23] > a[50] {
+		a[23], a[50] = a[50], a[23]
+	}
+	if a[22] > a[48] {
+		a[22], a[48] = a[48], a[22]
+	}
+	if a[23] > a[49] {
+		a[23], a[49] = a[49], a[23]
+	}
+	if a[23] > a[48] {
+		a[23], a[48] = a[48], a[23]
+	}
+	if a[24] > a[56] {
+		a[24], a[56] = a[56], a[24]
+	}
+	if a[25] > a[57] {
+		a[25], a[57] = a[57], a[25]
+	}
+	if a[25] > a[56] {
+		a[25], a[56] = a[56], a[25]
+	}
+	if a[26] > a[58] {
+		a[26], a[58] = a[58], a[26]
+	}
+	if a[27] > a[59] {
+		a[27], a[59] = a[59], a[27]
+	}
+	if a[27] > a[58] {
+		a[27], a[58] = a[58], a[27]
+	}
+	if a[26] > a[56] {
+		a[26], a[56] = a[56], a[26]
+	}
+	if a[27] > a[57] {
+		a[27], a[57] = a[57], a[27]
+	}
+	if a[27] > a[56] {
+		a[27], a[56] = a[56], a[27]
+	}
+	if a[28] > a[60] {
+		a[28], a[60] = a[60], a[28]
+	}
+	if a[29] > a[61] {
+		a[29], a[61] = a[61], a[29]
+	}
+	if a[29] > a[60] {
+		a[29], a[60] = a[60], a[29]
+	}
+	if a[30] > a[62] {
+		a[30], a[62] = a[62], a[30]
+	}
+	if a[31] > a[63] {
+		a[31], a[63] = a[63], a[31]
+	}
+	if a[31] > a[62] {
+		a[31], a[62] = a[62], a[31]
+	}
+	if a[30] > a[60] {
+		a[30], a[60] = a[60], a[30]
+	}
+	if a[31] > a[61] {
+		a[31], a[61] = a[61], a[31]
+	}
+	if a[31] > a[60] {
+		a[31], a[60] = a[60], a[31]
+	}
+	if a[28] > a[56] {
+		a[28], a[56] = a[56], a[28]
+	}
+	if a[29] > a[57] {
+		a[29], a[57] = a[57], a[29]
+	}
+	if a[29] > a[56] {
+		a[29], a[56] = a[56], a[29]
+	}
+	if a[30] > a[58] {
+		a[30], a[58] = a[58], a[30]
+	}
+	if a[31] > a[59] {
+		a[31], a[59] = a[59], a[31]
+	}
+	if a[31] > a[58] {
+		a[31], a[58] = a[58], a[31]
+	}
+	if a[30] > a[56] {
+		a[30], a[56] = a[56], a[30]
+	}
+	if a[31] > a[57] {
+		a[31], a[57] = a[57], a[31]
+	}
+	if a[31] > a[56] {
+		a[31], a[56] = a[56], a[31]
+	}
+	if a[24] > a[48] {
+		a[24], a[48] = a[48], a[24]
+	}
+	if a[25] > a[49] {
+		a[25], a[49] = a[49], a[25]
+	}
+	if a[25] > a[48] {
+		a[25], a[48] = a[48], a[25]
+	}
+	if a[26] > a[50] {
+		a[26], a[50] = a[50], a[26]
+	}
+	if a[27] > a[51] {
+		a[27], a[51] = a[51], a[27]
+	}
+	if a[27] > a[50] {
+		a[27], a[50] = a[50], a[27]
+	}
+	if a[26] > a[48] {
+		a[26], a[48] = a[48], a[26]
+	}
+	if a[27] > a[49] {
+		a[27], a[49] = a[49], a[27]
+	}
+	if a[27] > a[48] {
+		a[27], a[48] = a[48], a[27]
+	}
+	if a[28] > a[52] {
+		a[28], a[52] = a[52], a[28]
+	}
+	if a[29] > a[53] {
+		a[29], a[53] = a[53], a[29]
+	}
+	if a[29] > a[52] {
+		a[29], a[52] = a[52], a[29]
+	}
+	if a[30] > a[54] {
+		a[30], a[54] = a[54], a[30]
+	}
+	if a[31] > a[55] {
+		a[31], a[55] = a[55], a[31]
+	}
+	if a[31] > a[54] {
+		a[31], a[54] = a[54], a[31]
+	}
+	if a[30] > a[52] {
+		a[30], a[52] = a[52], a[30]
+	}
+	if a[31] > a[53] {
+		a[31], a[53] = a[53], a[31]
+	}
+	if a[31] > a[52] {
+		a[31], a[52] = a[52], a[31]
+	}
+	if a[28] > a[48] {
+		a[28], a[48] = a[48], a[28]
+	}
+	if a[29] > a[49] {
+		a[29], a[49] = a[49], a[29]
+	}
+	if a[29] > a[48] {
+		a[29], a[48] = a[48], a[29]
+	}
+	if a[30] > a[50] {
+		a[30], a[50] = a[50], a[30]
+	}
+	if a[31] > a[51] {
+		a[31], a[51] = a[51], a[31]
+	}
+	if a[31] > a[50] {
+		a[31], a[50] = a[50], a[31]
+	}
+	if a[30] > a[48] {
+		a[30], a[48] = a[48], a[30]
+	}
+	if a[31] > a[49] {
+		a[31], a[49] = a[49], a[31]
+	}
+	if a[31] > a[48] {
+		a[31], a[48] = a[48], a[31]
+	}
+	if a[16] > a[32] {
+		a[16], a[32] = a[32], a[16]
+	}
+	if a[17] > a[33] {
+		a[17], a[33] = a[33], a[17]
+	}
+	if a[17] > a[32] {
+		a[17], a[32] = a[32], a[17]
+	}
+	if a[18] > a[34] {
+		a[18], a[34] = a[34], a[18]
+	}
+	if a[19] > a[35] {
+		a[19], a[35] = a[35], a[19]
+	}
+	if a[19] > a[34] {
+		a[19], a[34] = a[34], a[19]
+	}
+	if a[18] > a[32] {
+		a[18], a[32] = a[32], a[18]
+	}
+	if a[19] > a[33] {
+		a[19], a[33] = a[33], a[19]
+	}
+	if a[19] > a[32] {
+		a[19], a[32] = a[32], a[19]
+	}
+	if a[20] > a[36] {
+		a[20], a[36] = a[36], a[20]
+	}
+	if a[21] > a[37] {
+		a[21], a[37] = a[37], a[21]
+	}
+	if a[21] > a[36] {
+		a[21], a[36] = a[36], a[21]
+	}
+	if a[22] > a[38] {
+		a[22], a[38] = a[38], a[22]
+	}
+	if a[23] > a[39] {
+		a[23], a[39] = a[39], a[23]
+	}
+	if a[23] > a[38] {
+		a[23], a[38] = a[38], a[23]
+	}
+	if a[22] > a[36] {
+		a[22], a[36] = a[36], a[22]
+	}
+	if a[23] > a[37] {
+		a[23], a[37] = a[37], a[23]
+	}
+	if a[23] > a[36] {
+		a[23], a[36] = a[36], a[23]
+	}
+	if a[20] > a[32] {
+		a[20], a[32] = a[32], a[20]
+	}
+	if a[21] > a[33] {
+		a[21], a[33] = a[33], a[21]
+	}
+	if a[21] > a[32] {
+		a[21], a[32] = a[32], a[21]
+	}
+	if a[22] > a[34] {
+		a[22], a[34] = a[34], a[22]
+	}
+	if a[23] > a[35] {
+		a[23], a[35] = a[35], a[23]
+	}
+	if a[23] > a[34] {
+		a[23], a[34] = a[34], a[23]
+	}
+	if a[22] > a[32] {
+		a[22], a[32] = a[32], a[22]
+	}
+	if a[23] > a[33] {
+		a[23], a[33] = a[33], a[23]
+	}
+	if a[23] > a[32] {
+		a[23], a[32] = a[32], a[23]
+	}
+	if a[24] > a[40] {
+		a[24], a[40] = a[40], a[24]
+	}
+	if a[25] > a[41] {
+		a[25], a[41] = a[41], a[25]
+	}
+	if a[25] > a[40] {
+		a[25], a[40] = a[40], a[25]
+	}
+	if a[26] > a[42] {
+		a[26], a[42] = a[42], a[26]
+	}
+	if a[27] > a[43] {
+		a[27], a[43] = a[43], a[27]
+	}
+	if a[27] > a[42] {
+		a[27], a[42] = a[42], a[27]
+	}
+	if a[26] > a[40] {
+		a[26], a[40] = a[40], a[26]
+	}
+	if a[27] > a[41] {
+		a[27], a[41] = a[41], a[27]
+	}
+	if a[27] > a[40] {
+		a[27], a[40] = a[40], a[27]
+	}
+	if a[28] > a[44] {
+		a[28], a[44] = a[44], a[28]
+	}
+	if a[29] > a[45] {
+		a[29], a[45] = a[45], a[29]
+	}
+	if a[29] > a[44] {
+		a[29], a[44] = a[44], a[29]
+	}
+	if a[30] > a[46] {
+		a[30], a[46] = a[46], a[30]
+	}
+	if a[31] > a[47] {
+		a[31], a[47] = a[47], a[31]
+	}
+	if a[31] > a[46] {
+		a[31], a[46] = a[46], a[31]
+	}
+	if a[30] > a[44] {
+		a[30], a[44] = a[44], a[30]
+	}
+	if a[31] > a[45] {
+		a[31], a[45] = a[45], a[31]
+	}
+	if a[31] > a[44] {
+		a[31], a[44] = a[44], a[31]
+	}
+	if a[28] > a[40] {
+		a[28], a[40] = a[40], a[28]
+	}
+	if a[29] > a[41] {
+		a[29], a[41] = a[41], a[29]
+	}
+	if a[29] > a[40] {
+		a[29], a[40] = a[40], a[29]
+	}
+	if a[30] > a[42] {
+		a[30], a[42] = a[42], a[30]
+	}
+	if a[31] > a[43] {
+		a[31], a[43] = a[43], a[31]
+	}
+	if a[31] > a[42] {
+		a[31], a[42] = a[42], a[31]
+	}
+	if a[30] > a[40] {
+		a[30], a[40] = a[40], a[30]
+	}
+	if a[31] > a[41] {
+		a[31], a[41] = a[41], a[31]
+	}
+	if a[31] > a[40] {
+		a[31], a[40] = a[40], a[31]
+	}
+	if a[24] > a[32] {
+		a[24], a[32] = a[32], a[24]
+	}
+	if a[25] > a[33] {
+		a[25], a[33] = a[33], a[25]
+	}
+	if a[25] > a[32] {
+		a[25], a[32] = a[32], a[25]
+	}
+	if a[26] > a[34] {
+		a[26], a[34] = a[34], a[26]
+	}
+	if a[27] > a[35] {
+		a[27], a[35] = a[35], a[27]
+	}
+	if a[27] > a[34] {
+		a[27], a[34] = a[34], a[27]
+	}
+	if a[26] > a[32] {
+		a[26], a[32] = a[32], a[26]
+	}
+	if a[27] > a[33] {
+		a[27], a[33] = a[33], a[27]
+	}
+	if a[27] > a[32] {
+		a[27], a[32] = a[32], a[27]
+	}
+	if a[28] > a[36] {
+		a[28], a[36] = a[36], a[28]
+	}
+	if a[29] > a[37] {
+		a[29], a[37] = a[37], a[29]
+	}
+	if a[29] > a[36] {
+		a[29], a[36] = a[36], a[29]
+	}
+	if a[30] > a[38] {
+		a[30], a[38] = a[38], a[30]
+	}
+	if a[31] > a[39] {
+		a[31], a[39] = a[39], a[31]
+	}
+	if a[31] > a[38] {
+		a[31], a[38] = a[38], a[31]
+	}
+	if a[30] > a[36] {
+		a[30], a[36] = a[36], a[30]
+	}
+	if a[31] > a[37] {
+		a[31], a[37] = a[37], a[31]
+	}
+	if a[31] > a[36] {
+		a[31], a[36] = a[36], a[31]
+	}
+	if a[28] > a[32] {
+		a[28], a[32] = a[32], a[28]
+	}
+	if a[29] > a[33] {
+		a[29], a[33] = a[33], a[29]
+	}
+	if a[29] > a[32] {
+		a[29], a[32] = a[32], a[29]
+	}
+	if a[30] > a[34] {
+		a[30], a[34] = a[34], a[30]
+	}
+	if a[31] > a[35] {
+		a[31], a[35] = a[35], a[31]
+	}
+	if a[31] > a[34] {
+		a[31], a[34] = a[34], a[31]
+	}
+	if a[30] > a[32] {
+		a[30], a[32] = a[32], a[30]
+	}
+	if a[31] > a[33] {
+		a[31], a[33] = a[33], a[31]
+	}
+	if a[31] > a[32] {
+		a[31], a[32] = a[32], a[31]
+	}
+}
+
+func NetworkSort2Func[T any](a []T, less func(a, b T) bool) {
+	_ = a[1]
+	if less(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3Func[T any](a []T, less func(a, b T) bool) {
+	_ = a[2]
+	if less(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if less(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if less(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort4Func[T any](a []T, less func(a, b T) bool) {
+	_ = a[3]
+	if less(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if less(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if less(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if less(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if less(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5Func[T any](a []T, less func(a, b T) bool) {
+	_ = a[4]
+	if less(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if less(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if less(a[4], a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if less(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if less(a[3], a[0]) {
+		a[0], a[3] = a[3], a[0]
+	}
+	if less(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if less(a[4], a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if less(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if less(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort6Func[T any](a []T, less func(a, b T) bool) {
+	_ = a[5]
+	if less(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if less(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if less(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if less(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if less(a[5], a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if less(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if less(a[4], a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if less(a[3], a[0]) {
+		a[0], a[3] = a[3], a[0]
+	}
+	if less(a[5], a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if less(a[5], a[2]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if less(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if less(a[4], a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if less(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7Func[T any](a []T, less func(a, b T) bool) {
+	_ = a[6]
+	if less(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if less(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if less(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if less(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if less(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if less(a[5], a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if less(a[6], a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if less(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if less(a[4], a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if less(a[3], a[0]) {
+		a[0], a[3] = a[3], a[0]
+	}
+	if less(a[5], a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if less(a[6], a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if less(a[5], a[2]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if less(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if less(a[4], a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if less(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort8Func[T any](a []T, less func(a, b T) bool) {
+	_ = a[7]
+	if less(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if less(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if less(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if less(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if less(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if less(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if less(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if less(a[6], a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if less(a[7], a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if less(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if less(a[4], a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if less(a[5], a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if less(a[4], a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if less(a[6], a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if less(a[7], a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if less(a[6], a[3]) {
+		a[3], a[6] = a[6], a[3]
+	}
+	if less(a[4], a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if less(a[5], a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if less(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort9Func[T any](a []T, less func(a, b T) bool) {
+	_ = a[8]
+	if less(a[6], a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if less(a[5], a[0]) {
+		a[0], a[5] = a[5], a[0]
+	}
+	if less(a[4], a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if less(a[8], a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if less(a[7], a[0]) {
+		a[0], a[7] = a[7], a[0]
+	}
+	if less(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if less(a[5], a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if less(a[6], a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if less(a[8], a[5]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if less(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if less(a[8], a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if less(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if less(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if less(a[7], a[2]) {
+		a[2], a[7] = a[7], a[2]
+	}
+	if less(a[7], a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if less(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if less(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if less(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if less(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if less(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if less(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if less(a[4], a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if less(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if less(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if less(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort10Func[T any](a []T, less func(a, b T) bool) {
+	_ = a[9]
+	if less(a[4], a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if less(a[8], a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if less(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if less(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if less(a[9], a[0]) {
+		a[0], a[9] = a[9], a[0]
+	}
+	if less(a[5], a[2]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if less(a[7], a[0]) {
+		a[0], a[7] = a[7], a[0]
+	}
+	if less(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if less(a[6], a[3]) {
+		a[3], a[6] = a[6], a[3]
+	}
+	if less(a[9], a[4]) {
+		a[4], a[9] = a[9], a[4]
+	}
+	if less(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if less(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if less(a[9], a[6]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if less(a[5], a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if less(a[7], a[4]) {
+		a[4], a[7] = a[7], a[4]
+	}
+	if less(a[8], a[1]) {
+		a[1], a[8] = a[8], a[1]
+	}
+	if less(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if less(a[8], a[5]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if less(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if less(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if less(a[8], a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if less(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if less(a[5], a[2]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if less(a[6], a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if less(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if less(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if less(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if less(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if less(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort11Func[T any](a []T, less func(a, b T) bool) {
+	_ = a[10]
+	if less(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if less(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if less(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if less(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if less(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if less(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if less(a[7], a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if less(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if less(a[6], a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if less(a[10], a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if less(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if less(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if less(a[10], a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if less(a[5], a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if less(a[10], a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if less(a[9], a[5]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if less(a[6], a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if less(a[5], a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if less(a[10], a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if less(a[4], a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if less(a[7], a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if less(a[8], a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if less(a[4], a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if less(a[4], a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if less(a[10], a[7]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if less(a[8], a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if less(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if less(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if less(a[4], a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if less(a[9], a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if less(a[5], a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if less(a[8], a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if less(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if less(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if less(a[8], a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort12Func[T any](a []T, less func(a, b T) bool) {
+	_ = a[11]
+	if less(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if less(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if less(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if less(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if less(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if less(a[11], a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if less(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if less(a[7], a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if less(a[11], a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if less(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if less(a[6], a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if less(a[10], a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if less(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if less(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if less(a[10], a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if less(a[5], a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if less(a[10], a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if less(a[9], a[5]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if less(a[6], a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if less(a[5], a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if less(a[10], a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if less(a[4], a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if less(a[11], a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if less(a[7], a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if less(a[8], a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if less(a[4], a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if less(a[11], a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if less(a[4], a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if less(a[10], a[7]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if less(a[8], a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if less(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if less(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if less(a[4], a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if less(a[9], a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if less(a[5], a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if less(a[8], a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if less(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if less(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if less(a[8], a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort13Func[T any](a []T, less func(a, b T) bool) {
+	_ = a[12]
+	if less(a[7], a[1]) {
+		a[1], a[7] = a[7], a[1]
+	}
+	if less(a[11], a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if less(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if less(a[8], a[5]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if less(a[12], a[0]) {
+		a[0], a[12] = a[12], a[0]
+	}
+	if less(a[6], a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if less(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if less(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if less(a[6], a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if less(a[11], a[8]) {
+		a[8], a[11] = a[11], a[8]
+	}
+	if less(a[12], a[7]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if less(a[9], a[5]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if less(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if less(a[7], a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if less(a[11], a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if less(a[4], a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if less(a[12], a[6]) {
+		a[6], a[12] = a[12], a[6]
+	}
+	if less(a[8], a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if less(a[12], a[11]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if less(a[9], a[4]) {
+		a[4], a[9] = a[9], a[4]
+	}
+	if less(a[10], a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if less(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if less(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if less(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if less(a[11], a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if less(a[7], a[1]) {
+		a[1], a[7] = a[7], a[1]
+	}
+	if less(a[6], a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if less(a[11], a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if less(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if less(a[7], a[4]) {
+		a[4], a[7] = a[7], a[4]
+	}
+	if less(a[10], a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if less(a[5], a[0]) {
+		a[0], a[5] = a[5], a[0]
+	}
+	if less(a[5], a[2]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if less(a[8], a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if less(a[10], a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if less(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if less(a[5], a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if less(a[8], a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if less(a[6], a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if less(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if less(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if less(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if less(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if less(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if less(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort14Func[T any](a []T, less func(a, b T) bool) {
+	_ = a[13]
+	if less(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if less(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if less(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if less(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if less(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if less(a[11], a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if less(a[13], a[12]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if less(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if less(a[6], a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if less(a[10], a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if less(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if less(a[7], a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if less(a[11], a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if less(a[4], a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if less(a[12], a[8]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if less(a[5], a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if less(a[13], a[9]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if less(a[6], a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if less(a[7], a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if less(a[8], a[0]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if less(a[9], a[1]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if less(a[10], a[2]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if less(a[11], a[3]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if less(a[12], a[4]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if less(a[13], a[5]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if less(a[10], a[5]) {
+		a[5], a[10] = a[10], a[5]
+	}
+	if less(a[9], a[6]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if less(a[12], a[3]) {
+		a[3], a[12] = a[12], a[3]
+	}
+	if less(a[11], a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if less(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if less(a[8], a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if less(a[4], a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if less(a[13], a[7]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if less(a[8], a[2]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if less(a[4], a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if less(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if less(a[10], a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if less(a[13], a[11]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if less(a[8], a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if less(a[12], a[7]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if less(a[8], a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if less(a[12], a[10]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if less(a[5], a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if less(a[9], a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if less(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if less(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if less(a[8], a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if less(a[10], a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if less(a[12], a[11]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if less(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if less(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort15Func[T any](a []T, less func(a, b T) bool) {
+	_ = a[14]
+	if less(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if less(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if less(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if less(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if less(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if less(a[11], a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if less(a[13], a[12]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if less(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if less(a[6], a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if less(a[10], a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if less(a[14], a[12]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if less(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if less(a[7], a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if less(a[11], a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if less(a[4], a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if less(a[12], a[8]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if less(a[5], a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if less(a[13], a[9]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if less(a[6], a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if less(a[14], a[10]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if less(a[7], a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if less(a[8], a[0]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if less(a[9], a[1]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if less(a[10], a[2]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if less(a[11], a[3]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if less(a[12], a[4]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if less(a[13], a[5]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if less(a[14], a[6]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if less(a[10], a[5]) {
+		a[5], a[10] = a[10], a[5]
+	}
+	if less(a[9], a[6]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if less(a[12], a[3]) {
+		a[3], a[12] = a[12], a[3]
+	}
+	if less(a[14], a[13]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if less(a[11], a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if less(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if less(a[8], a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if less(a[4], a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if less(a[13], a[7]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if less(a[8], a[2]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if less(a[14], a[11]) {
+		a[11], a[14] = a[14], a[11]
+	}
+	if less(a[4], a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if less(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if less(a[10], a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if less(a[13], a[11]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if less(a[8], a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if less(a[12], a[7]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if less(a[8], a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if less(a[12], a[10]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if less(a[5], a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if less(a[9], a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if less(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if less(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if less(a[8], a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if less(a[10], a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if less(a[12], a[11]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if less(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if less(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort16Func[T any](a []T, less func(a, b T) bool) {
+	_ = a[15]
+	if less(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if less(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if less(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if less(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if less(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if less(a[11], a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if less(a[13], a[12]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if less(a[15], a[14]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if less(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if less(a[6], a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if less(a[10], a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if less(a[14], a[12]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if less(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if less(a[7], a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if less(a[11], a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if less(a[15], a[13]) {
+		a[13], a[15] = a[15], a[13]
+	}
+	if less(a[4], a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if less(a[12], a[8]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if less(a[5], a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if less(a[13], a[9]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if less(a[6], a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if less(a[14], a[10]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if less(a[7], a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if less(a[15], a[11]) {
+		a[11], a[15] = a[15], a[11]
+	}
+	if less(a[8], a[0]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if less(a[9], a[1]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if less(a[10], a[2]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if less(a[11], a[3]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if less(a[12], a[4]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if less(a[13], a[5]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if less(a[14], a[6]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if less(a[15], a[7]) {
+		a[7], a[15] = a[15], a[7]
+	}
+	if less(a[10], a[5]) {
+		a[5], a[10] = a[10], a[5]
+	}
+	if less(a[9], a[6]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if less(a[12], a[3]) {
+		a[3], a[12] = a[12], a[3]
+	}
+	if less(a[14], a[13]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if less(a[11], a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if less(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if less(a[8], a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if less(a[4], a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if less(a[13], a[7]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if less(a[8], a[2]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if less(a[14], a[11]) {
+		a[11], a[14] = a[14], a[11]
+	}
+	if less(a[4], a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if less(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if less(a[10], a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if less(a[13], a[11]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if less(a[8], a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if less(a[12], a[7]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if less(a[8], a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if less(a[12], a[10]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if less(a[5], a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if less(a[9], a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if less(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if less(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if less(a[8], a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if less(a[10], a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if less(a[12], a[11]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if less(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if less(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort24Func[T any](a []T, less func(a, b T) bool) {
+	_ = a[23]
+	if less(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if less(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if less(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if less(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if less(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if less(a[11], a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if less(a[13], a[12]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if less(a[15], a[14]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if less(a[17], a[16]) {
+		a[16], a[17] = a[17], a[16]
+	}
+	if less(a[19], a[18]) {
+		a[18], a[19] = a[19], a[18]
+	}
+	if less(a[21], a[20]) {
+		a[20], a[21] = a[21], a[20]
+	}
+	if less(a[23], a[22]) {
+		a[22], a[23] = a[23], a[22]
+	}
+	if less(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if less(a[7], a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if less(a[11], a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if less(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if less(a[6], a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if less(a[10], a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if less(a[15], a[13]) {
+		a[13], a[15] = a[15], a[13]
+	}
+	if less(a[19], a[17]) {
+		a[17], a[19] = a[19], a[17]
+	}
+	if less(a[23], a[21]) {
+		a[21], a[23] = a[23], a[21]
+	}
+	if less(a[14], a[12]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if less(a[18], a[16]) {
+		a[16], a[18] = a[18], a[16]
+	}
+	if less(a[22], a[20]) {
+		a[20], a[22] = a[22], a[20]
+	}
+	if less(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if less(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if less(a[10], a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if less(a[14], a[13]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if less(a[18], a[17]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if less(a[22], a[21]) {
+		a[21], a[22] = a[22], a[21]
+	}
+	if less(a[5], a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if less(a[10], a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if less(a[17], a[13]) {
+		a[13], a[17] = a[17], a[13]
+	}
+	if less(a[22], a[18]) {
+		a[18], a[22] = a[22], a[18]
+	}
+	if less(a[9], a[5]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if less(a[6], a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if less(a[21], a[17]) {
+		a[17], a[21] = a[21], a[17]
+	}
+	if less(a[18], a[14]) {
+		a[14], a[18] = a[18], a[14]
+	}
+	if less(a[5], a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if less(a[10], a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if less(a[4], a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if less(a[11], a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if less(a[17], a[13]) {
+		a[13], a[17] = a[17], a[13]
+	}
+	if less(a[22], a[18]) {
+		a[18], a[22] = a[22], a[18]
+	}
+	if less(a[16], a[12]) {
+		a[12], a[16] = a[16], a[12]
+	}
+	if less(a[23], a[19]) {
+		a[19], a[23] = a[23], a[19]
+	}
+	if less(a[7], a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if less(a[8], a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if less(a[19], a[15]) {
+		a[15], a[19] = a[19], a[15]
+	}
+	if less(a[20], a[16]) {
+		a[16], a[20] = a[20], a[16]
+	}
+	if less(a[4], a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if less(a[11], a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if less(a[16], a[12]) {
+		a[12], a[16] = a[16], a[12]
+	}
+	if less(a[23], a[19]) {
+		a[19], a[23] = a[23], a[19]
+	}
+	if less(a[4], a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if less(a[10], a[7]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if less(a[8], a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if less(a[16], a[13]) {
+		a[13], a[16] = a[16], a[13]
+	}
+	if less(a[22], a[19]) {
+		a[19], a[22] = a[22], a[19]
+	}
+	if less(a[20], a[15]) {
+		a[15], a[20] = a[20], a[15]
+	}
+	if less(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if less(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if less(a[15], a[14]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if less(a[21], a[20]) {
+		a[20], a[21] = a[21], a[20]
+	}
+	if less(a[4], a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if less(a[9], a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if less(a[5], a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if less(a[8], a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if less(a[16], a[14]) {
+		a[14], a[16] = a[16], a[14]
+	}
+	if less(a[21], a[19]) {
+		a[19], a[21] = a[21], a[19]
+	}
+	if less(a[17], a[15]) {
+		a[15], a[17] = a[17], a[15]
+	}
+	if less(a[20], a[18]) {
+		a[18], a[20] = a[20], a[18]
+	}
+	if less(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if less(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if less(a[8], a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if less(a[16], a[15]) {
+		a[15], a[16] = a[16], a[15]
+	}
+	if less(a[18], a[17]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if less(a[20], a[19]) {
+		a[19], a[20] = a[20], a[19]
+	}
+	if less(a[12], a[0]) {
+		a[0], a[12] = a[12], a[0]
+	}
+	if less(a[13], a[1]) {
+		a[1], a[13] = a[13], a[1]
+	}
+	if less(a[14], a[2]) {
+		a[2], a[14] = a[14], a[2]
+	}
+	if less(a[15], a[3]) {
+		a[3], a[15] = a[15], a[3]
+	}
+	if less(a[16], a[4]) {
+		a[4], a[16] = a[16], a[4]
+	}
+	if less(a[17], a[5]) {
+		a[5], a[17] = a[17], a[5]
+	}
+	if less(a[18], a[6]) {
+		a[6], a[18] = a[18], a[6]
+	}
+	if less(a[19], a[7]) {
+		a[7], a[19] = a[19], a[7]
+	}
+	if less(a[20], a[8]) {
+		a[8], a[20] = a[20], a[8]
+	}
+	if less(a[21], a[9]) {
+		a[9], a[21] = a[21], a[9]
+	}
+	if less(a[22], a[10]) {
+		a[10], a[22] = a[22], a[10]
+	}
+	if less(a[23], a[11]) {
+		a[11], a[23] = a[23], a[11]
+	}
+	if less(a[12], a[2]) {
+		a[2], a[12] = a[12], a[2]
+	}
+	if less(a[13], a[3]) {
+		a[3], a[13] = a[13], a[3]
+	}
+	if less(a[20], a[10]) {
+		a[10], a[20] = a[20], a[10]
+	}
+	if less(a[21], a[11]) {
+		a[11], a[21] = a[21], a[11]
+	}
+	if less(a[12], a[4]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if less(a[13], a[5]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if less(a[14], a[6]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if less(a[15], a[7]) {
+		a[7], a[15] = a[15], a[7]
+	}
+	if less(a[16], a[8]) {
+		a[8], a[16] = a[16], a[8]
+	}
+	if less(a[17], a[9]) {
+		a[9], a[17] = a[17], a[9]
+	}
+	if less(a[18], a[10]) {
+		a[10], a[18] = a[18], a[10]
+	}
+	if less(a[19], a[11]) {
+		a[11], a[19] = a[19], a[11]
+	}
+	if less(a[12], a[8]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if less(a[13], a[9]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if less(a[14], a[10]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if less(a[15], a[11]) {
+		a[11], a[15] = a[15], a[11]
+	}
+	if less(a[8], a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if less(a[12], a[10]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if less(a[16], a[14]) {
+		a[14], a[16] = a[16], a[14]
+	}
+	if less(a[9], a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if less(a[13], a[11]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if less(a[17], a[15]) {
+		a[15], a[17] = a[17], a[15]
+	}
+	if less(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if less(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if less(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if less(a[8], a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if less(a[10], a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if less(a[12], a[11]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if less(a[14], a[13]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if less(a[16], a[15]) {
+		a[15], a[16] = a[16], a[15]
+	}
+	if less(a[18], a[17]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if less(a[20], a[19]) {
+		a[19], a[20] = a[20], a[19]
+	}
+	if less(a[22], a[21]) {
+		a[21], a[22] = a[22], a[21]
+	}
+}
+
+func NetworkSort32Func[T any](a []T, less func(a, b T) bool) {
+	_ = a[31]
+	if less(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if less(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if less(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if less(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if less(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if less(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if less(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if less(a[6], a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if less(a[7], a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if less(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if less(a[4], a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if less(a[5], a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if less(a[4], a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if less(a[6], a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if less(a[7], a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if less(a[6], a[3]) {
+		a[3], a[6] = a[6], a[3]
+	}
+	if less(a[4], a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if less(a[5], a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if less(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if less(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if less(a[11], a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if less(a[10], a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if less(a[11], a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if less(a[10], a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if less(a[13], a[12]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if less(a[15], a[14]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if less(a[14], a[12]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if less(a[15], a[13]) {
+		a[13], a[15] = a[15], a[13]
+	}
+	if less(a[14], a[13]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if less(a[12], a[8]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if less(a[13], a[9]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if less(a[12], a[9]) {
+		a[9], a[12] = a[12], a[9]
+	}
+	if less(a[14], a[10]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if less(a[15], a[11]) {
+		a[11], a[15] = a[15], a[11]
+	}
+	if less(a[14], a[11]) {
+		a[11], a[14] = a[14], a[11]
+	}
+	if less(a[12], a[10]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if less(a[13], a[11]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if less(a[12], a[11]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if less(a[8], a[0]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if less(a[9], a[1]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if less(a[8], a[1]) {
+		a[1], a[8] = a[8], a[1]
+	}
+	if less(a[10], a[2]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if less(a[11], a[3]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if less(a[10], a[3]) {
+		a[3], a[10] = a[10], a[3]
+	}
+	if less(a[8], a[2]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if less(a[9], a[3]) {
+		a[3], a[9] = a[9], a[3]
+	}
+	if less(a[8], a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if less(a[12], a[4]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if less(a[13], a[5]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if less(a[12], a[5]) {
+		a[5], a[12] = a[12], a[5]
+	}
+	if less(a[14], a[6]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if less(a[15], a[7]) {
+		a[7], a[15] = a[15], a[7]
+	}
+	if less(a[14], a[7]) {
+		a[7], a[14] = a[14], a[7]
+	}
+	if less(a[12], a[6]) {
+		a[6], a[12] = a[12], a[6]
+	}
+	if less(a[13], a[7]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if less(a[12], a[7]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if less(a[8], a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if less(a[9], a[5]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if less(a[8], a[5]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if less(a[10], a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if less(a[11], a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if less(a[10], a[7]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if less(a[8], a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if less(a[9], a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if less(a[8], a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if less(a[17], a[16]) {
+		a[16], a[17] = a[17], a[16]
+	}
+	if less(a[19], a[18]) {
+		a[18], a[19] = a[19], a[18]
+	}
+	if less(a[18], a[16]) {
+		a[16], a[18] = a[18], a[16]
+	}
+	if less(a[19], a[17]) {
+		a[17], a[19] = a[19], a[17]
+	}
+	if less(a[18], a[17]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if less(a[21], a[20]) {
+		a[20], a[21] = a[21], a[20]
+	}
+	if less(a[23], a[22]) {
+		a[22], a[23] = a[23], a[22]
+	}
+	if less(a[22], a[20]) {
+		a[20], a[22] = a[22], a[20]
+	}
+	if less(a[23], a[21]) {
+		a[21], a[23] = a[23], a[21]
+	}
+	if less(a[22], a[21]) {
+		a[21], a[22] = a[22], a[21]
+	}
+	if less(a[20], a[16]) {
+		a[16], a[20] = a[20], a[16]
+	}
+	if less(a[21], a[17]) {
+		a[17], a[21] = a[21], a[17]
+	}
+	if less(a[20], a[17]) {
+		a[17], a[20] = a[20], a[17]
+	}
+	if less(a[22], a[18]) {
+		a[18], a[22] = a[22], a[18]
+	}
+	if less(a[23], a[19]) {
+		a[19], a[23] = a[23], a[19]
+	}
+	if less(a[22], a[19]) {
+		a[19], a[22] = a[22], a[19]
+	}
+	if less(a[20], a[18]) {
+		a[18], a[20] = a[20], a[18]
+	}
+	if less(a[21], a[19]) {
+		a[19], a[21] = a[21], a[19]
+	}
+	if less(a[20], a[19]) {
+		a[19], a[20] = a[20], a[19]
+	}
+	if less(a[25], a[24]) {
+		a[24], a[25] = a[25], a[24]
+	}
+	if less(a[27], a[26]) {
+		a[26], a[27] = a[27], a[26]
+	}
+	if less(a[26], a[24]) {
+		a[24], a[26] = a[26], a[24]
+	}
+	if less(a[27], a[25]) {
+		a[25], a[27] = a[27], a[25]
+	}
+	if less(a[26], a[25]) {
+		a[25], a[26] = a[26], a[25]
+	}
+	if less(a[29], a[28]) {
+		a[28], a[29] = a[29], a[28]
+	}
+	if less(a[31], a[30]) {
+		a[30], a[31] = a[31], a[30]
+	}
+	if less(a[30], a[28]) {
+		a[28], a[30] = a[30], a[28]
+	}
+	if less(a[31], a[29]) {
+		a[29], a[31] = a[31], a[29]
+	}
+	if less(a[30], a[29]) {
+		a[29], a[30] = a[30], a[29]
+	}
+	if less(a[28], a[24]) {
+		a[24], a[28] = a[28], a[24]
+	}
+	if less(a[29], a[25]) {
+		a[25], a[29] = a[29], a[25]
+	}
+	if less(a[28], a[25]) {
+		a[25], a[28] = a[28], a[25]
+	}
+	if less(a[30], a[26]) {
+		a[26], a[30] = a[30], a[26]
+	}
+	if less(a[31], a[27]) {
+		a[27], a[31] = a[31], a[27]
+	}
+	if less(a[30], a[27]) {
+		a[27], a[30] = a[30], a[27]
+	}
+	if less(a[28], a[26]) {
+		a[26], a[28] = a[28], a[26]
+	}
+	if less(a[29], a[27]) {
+		a[27], a[29] = a[29], a[27]
+	}
+	if less(a[28], a[27]) {
+		a[27], a[28] = a[28], a[27]
+	}
+	if less(a[24], a[16]) {
+		a[16], a[24] = a[24], a[16]
+	}
+	if less(a[25], a[17]) {
+		a[17], a[25] = a[25], a[17]
+	}
+	if less(a[24], a[17]) {
+		a[17], a[24] = a[24], a[17]
+	}
+	if less(a[26], a[18]) {
+		a[18], a[26] = a[26], a[18]
+	}
+	if less(a[27], a[19]) {
+		a[19], a[27] = a[27], a[19]
+	}
+	if less(a[26], a[19]) {
+		a[19], a[26] = a[26], a[19]
+	}
+	if less(a[24], a[18]) {
+		a[18], a[24] = a[24], a[18]
+	}
+	if less(a[25], a[19]) {
+		a[19], a[25] = a[25], a[19]
+	}
+	if less(a[24], a[19]) {
+		a[19], a[24] = a[24], a[19]
+	}
+	if less(a[28], a[20]) {
+		a[20], a[28] = a[28], a[20]
+	}
+	if less(a[29], a[21]) {
+		a[21], a[29] = a[29], a[21]
+	}
+	if less(a[28], a[21]) {
+		a[21], a[28] = a[28], a[21]
+	}
+	if less(a[30], a[22]) {
+		a[22], a[30] = a[30], a[22]
+	}
+	if less(a[31], a[23]) {
+		a[23], a[31] = a[31], a[23]
+	}
+	if less(a[30], a[23]) {
+		a[23], a[30] = a[30], a[23]
+	}
+	if less(a[28], a[22]) {
+		a[22], a[28] = a[28], a[22]
+	}
+	if less(a[29], a[23]) {
+		a[23], a[29] = a[29], a[23]
+	}
+	if less(a[28], a[23]) {
+		a[23], a[28] = a[28], a[23]
+	}
+	if less(a[24], a[20]) {
+		a[20], a[24] = a[24], a[20]
+	}
+	if less(a[25], a[21]) {
+		a[21], a[25] = a[25], a[21]
+	}
+	if less(a[24], a[21]) {
+		a[21], a[24] = a[24], a[21]
+	}
+	if less(a[26], a[22]) {
+		a[22], a[26] = a[26], a[22]
+	}
+	if less(a[27], a[23]) {
+		a[23], a[27] = a[27], a[23]
+	}
+	if less(a[26], a[23]) {
+		a[23], a[26] = a[26], a[23]
+	}
+	if less(a[24], a[22]) {
+		a[22], a[24] = a[24], a[22]
+	}
+	if less(a[25], a[23]) {
+		a[23], a[25] = a[25], a[23]
+	}
+	if less(a[24], a[23]) {
+		a[23], a[24] = a[24], a[23]
+	}
+	if less(a[16], a[0]) {
+		a[0], a[16] = a[16], a[0]
+	}
+	if less(a[17], a[1]) {
+		a[1], a[17] = a[17], a[1]
+	}
+	if less(a[16], a[1]) {
+		a[1], a[16] = a[16], a[1]
+	}
+	if less(a[18], a[2]) {
+		a[2], a[18] = a[18], a[2]
+	}
+	if less(a[19], a[3]) {
+		a[3], a[19] = a[19], a[3]
+	}
+	if less(a[18], a[3]) {
+		a[3], a[18] = a[18], a[3]
+	}
+	if less(a[16], a[2]) {
+		a[2], a[16] = a[16], a[2]
+	}
+	if less(a[17], a[3]) {
+		a[3], a[17] = a[17], a[3]
+	}
+	if less(a[16], a[3]) {
+		a[3], a[16] = a[16], a[3]
+	}
+	if less(a[20], a[4]) {
+		a[4], a[20] = a[20], a[4]
+	}
+	if less(a[21], a[5]) {
+		a[5], a[21] = a[21], a[5]
+	}
+	if less(a[20], a[5]) {
+		a[5], a[20] = a[20], a[5]
+	}
+	if less(a[22], a[6]) {
+		a[6], a[22] = a[22], a[6]
+	}
+	if less(a[23], a[7]) {
+		a[7], a[23] = a[23], a[7]
+	}
+	if less(a[22], a[7]) {
+		a[7], a[22] = a[22], a[7]
+	}
+	if less(a[20], a[6]) {
+		a[6], a[20] = a[20], a[6]
+	}
+	if less(a[21], a[7]) {
+		a[7], a[21] = a[21], a[7]
+	}
+	if less(a[20], a[7]) {
+		a[7], a[20] = a[20], a[7]
+	}
+	if less(a[16], a[4]) {
+		a[4], a[16] = a[16], a[4]
+	}
+	if less(a[17], a[5]) {
+		a[5], a[17] = a[17], a[5]
+	}
+	if less(a[16], a[5]) {
+		a[5], a[16] = a[16], a[5]
+	}
+	if less(a[18], a[6]) {
+		a[6], a[18] = a[18], a[6]
+	}
+	if less(a[19], a[7]) {
+		a[7], a[19] = a[19], a[7]
+	}
+	if less(a[18], a[7]) {
+		a[7], a[18] = a[18], a[7]
+	}
+	if less(a[16], a[6]) {
+		a[6], a[16] = a[16], a[6]
+	}
+	if less(a[17], a[7]) {
+		a[7], a[17] = a[17], a[7]
+	}
+	if less(a[16], a[7]) {
+		a[7], a[16] = a[16], a[7]
+	}
+	if less(a[24], a[8]) {
+		a[8], a[24] = a[24], a[8]
+	}
+	if less(a[25], a[9]) {
+		a[9], a[25] = a[25], a[9]
+	}
+	if less(a[24], a[9]) {
+		a[9], a[24] = a[24], a[9]
+	}
+	if less(a[26], a[10]) {
+		a[10], a[26] = a[26], a[10]
+	}
+	if less(a[27], a[11]) {
+		a[11], a[27] = a[27], a[11]
+	}
+	if less(a[26], a[11]) {
+		a[11], a[26] = a[26], a[11]
+	}
+	if less(a[24], a[10]) {
+		a[10], a[24] = a[24], a[10]
+	}
+	if less(a[25], a[11]) {
+		a[11], a[25] = a[25], a[11]
+	}
+	if less(a[24], a[11]) {
+		a[11], a[24] = a[24], a[11]
+	}
+	if less(a[28], a[12]) {
+		a[12], a[28] = a[28], a[12]
+	}
+	if less(a[29], a[13]) {
+		a[13], a[29] = a[29], a[13]
+	}
+	if less(a[28], a[13]) {
+		a[13], a[28] = a[28], a[13]
+	}
+	if less(a[30], a[14]) {
+		a[14], a[30] = a[30], a[14]
+	}
+	if less(a[31], a[15]) {
+		a[15], a[31] = a[31], a[15]
+	}
+	if less(a[30], a[15]) {
+		a[15], a[30] = a[30], a[15]
+	}
+	if less(a[28], a[14]) {
+		a[14], a[28] = a[28], a[14]
+	}
+	if less(a[29], a[15]) {
+		a[15], a[29] = a[29], a[15]
+	}
+	if less(a[28], a[15]) {
+		a[15], a[28] = a[28], a[15]
+	}
+	if less(a[24], a[12]) {
+		a[12], a[24] = a[24], a[12]
+	}
+	if less(a[25], a[13]) {
+		a[13], a[25] = a[25], a[13]
+	}
+	if less(a[24], a[13]) {
+		a[13], a[24] = a[24], a[13]
+	}
+	if less(a[26], a[14]) {
+		a[14], a[26] = a[26], a[14]
+	}
+	if less(a[27], a[15]) {
+		a[15], a[27] = a[27], a[15]
+	}
+	if less(a[26], a[15]) {
+		a[15], a[26] = a[26], a[15]
+	}
+	if less(a[24], a[14]) {
+		a[14], a[24] = a[24], a[14]
+	}
+	if less(a[25], a[15]) {
+		a[15], a[25] = a[25], a[15]
+	}
+	if less(a[24], a[15]) {
+		a[15], a[24] = a[24], a[15]
+	}
+	if less(a[16], a[8]) {
+		a[8], a[16] = a[16], a[8]
+	}
+	if less(a[17], a[9]) {
+		a[9], a[17] = a[17], a[9]
+	}
+	if less(a[16], a[9]) {
+		a[9], a[16] = a[16], a[9]
+	}
+	if less(a[18], a[10]) {
+		a[10], a[18] = a[18], a[10]
+	}
+	if less(a[19], a[11]) {
+		a[11], a[19] = a[19], a[11]
+	}
+	if less(a[18], a[11]) {
+		a[11], a[18] = a[18], a[11]
+	}
+	if less(a[16], a[10]) {
+		a[10], a[16] = a[16], a[10]
+	}
+	if less(a[17], a[11]) {
+		a[11], a[17] = a[17], a[11]
+	}
+	if less(a[16], a[11]) {
+		a[11], a[16] = a[16], a[11]
+	}
+	if less(a[20], a[12]) {
+		a[12], a[20] = a[20], a[12]
+	}
+	if less(a[21], a[13]) {
+		a[13], a[21] = a[21], a[13]
+	}
+	if less(a[20], a[13]) {
+		a[13], a[20] = a[20], a[13]
+	}
+	if less(a[22], a[14]) {
+		a[14], a[22] = a[22], a[14]
+	}
+	if less(a[23], a[15]) {
+		a[15], a[23] = a[23], a[15]
+	}
+	if less(a[22], a[15]) {
+		a[15], a[22] = a[22], a[15]
+	}
+	if less(a[20], a[14]) {
+		a[14], a[20] = a[20], a[14]
+	}
+	if less(a[21], a[15]) {
+		a[15], a[21] = a[21], a[15]
+	}
+	if less(a[20], a[15]) {
+		a[15], a[20] = a[20], a[15]
+	}
+	if less(a[16], a[12]) {
+		a[12], a[16] = a[16], a[12]
+	}
+	if less(a[17], a[13]) {
+		a[13], a[17] = a[17], a[13]
+	}
+	if less(a[16], a[13]) {
+		a[13], a[16] = a[16], a[13]
+	}
+	if less(a[18], a[14]) {
+		a[14], a[18] = a[18], a[14]
+	}
+	if less(a[19], a[15]) {
+		a[15], a[19] = a[19], a[15]
+	}
+	if less(a[18], a[15]) {
+		a[15], a[18] = a[18], a[15]
+	}
+	if less(a[16], a[14]) {
+		a[14], a[16] = a[16], a[14]
+	}
+	if less(a[17], a[15]) {
+		a[15], a[17] = a[17], a[15]
+	}
+	if less(a[16], a[15]) {
+		a[15], a[16] = a[16], a[15]
+	}
+}
+
+func NetworkSort48Func[T any](a []T, less func(a, b T) bool) {
+	_ = a[47]
+	if less(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if less(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if less(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if less(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if less(a[5], a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if less(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if less(a[4], a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if less(a[3], a[0]) {
+		a[0], a[3] = a[3], a[0]
+	}
+	if less(a[5], a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if less(a[5], a[2]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if less(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if less(a[4], a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if less(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if less(a[8], a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if less(a[8], a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if less(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if less(a[11], a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if less(a[11], a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if less(a[10], a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if less(a[10], a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if less(a[9], a[6]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if less(a[11], a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if less(a[11], a[8]) {
+		a[8], a[11] = a[11], a[8]
+	}
+	if less(a[9], a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if less(a[10], a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if less(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if less(a[7], a[0]) {
+		a[0], a[7] = a[7], a[0]
+	}
+	if less(a[6], a[0]) {
+		a[0], a[6] = a[6], a[0]
+	}
+	if less(a[8], a[1]) {
+		a[1], a[8] = a[8], a[1]
+	}
+	if less(a[8], a[2]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if less(a[6], a[1]) {
+		a[1], a[6] = a[6], a[1]
+	}
+	if less(a[7], a[2]) {
+		a[2], a[7] = a[7], a[2]
+	}
+	if less(a[6], a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if less(a[10], a[3]) {
+		a[3], a[10] = a[10], a[3]
+	}
+	if less(a[9], a[3]) {
+		a[3], a[9] = a[9], a[3]
+	}
+	if less(a[11], a[4]) {
+		a[4], a[11] = a[11], a[4]
+	}
+	if less(a[11], a[5]) {
+		a[5], a[11] = a[11], a[5]
+	}
+	if less(a[9], a[4]) {
+		a[4], a[9] = a[9], a[4]
+	}
+	if less(a[10], a[5]) {
+		a[5], a[10] = a[10], a[5]
+	}
+	if less(a[9], a[5]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if less(a[7], a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if less(a[6], a[3]) {
+		a[3], a[6] = a[6], a[3]
+	}
+	if less(a[8], a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if less(a[8], a[5]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if less(a[6], a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if less(a[7], a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if less(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if less(a[14], a[13]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if less(a[14], a[12]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if less(a[13], a[12]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if less(a[17], a[16]) {
+		a[16], a[17] = a[17], a[16]
+	}
+	if less(a[17], a[15]) {
+		a[15], a[17] = a[17], a[15]
+	}
+	if less(a[16], a[15]) {
+		a[15], a[16] = a[16], a[15]
+	}
+	if less(a[16], a[12]) {
+		a[12], a[16] = a[16], a[12]
+	}
+	if less(a[15], a[12]) {
+		a[12], a[15] = a[15], a[12]
+	}
+	if less(a[17], a[13]) {
+		a[13], a[17] = a[17], a[13]
+	}
+	if less(a[17], a[14]) {
+		a[14], a[17] = a[17], a[14]
+	}
+	if less(a[15], a[13]) {
+		a[13], a[15] = a[15], a[13]
+	}
+	if less(a[16], a[14]) {
+		a[14], a[16] = a[16], a[14]
+	}
+	if less(a[15], a[14]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if less(a[20], a[19]) {
+		a[19], a[20] = a[20], a[19]
+	}
+	if less(a[20], a[18]) {
+		a[18], a[20] = a[20], a[18]
+	}
+	if less(a[19], a[18]) {
+		a[18], a[19] = a[19], a[18]
+	}
+	if less(a[23], a[22]) {
+		a[22], a[23] = a[23], a[22]
+	}
+	if less(a[23], a[21]) {
+		a[21], a[23] = a[23], a[21]
+	}
+	if less(a[22], a[21]) {
+		a[21], a[22] = a[22], a[21]
+	}
+	if less(a[22], a[18]) {
+		a[18], a[22] = a[22], a[18]
+	}
+	if less(a[21], a[18]) {
+		a[18], a[21] = a[21], a[18]
+	}
+	if less(a[23], a[19]) {
+		a[19], a[23] = a[23], a[19]
+	}
+	if less(a[23], a[20]) {
+		a[20], a[23] = a[23], a[20]
+	}
+	if less(a[21], a[19]) {
+		a[19], a[21] = a[21], a[19]
+	}
+	if less(a[22], a[20]) {
+		a[20], a[22] = a[22], a[20]
+	}
+	if less(a[21], a[20]) {
+		a[20], a[21] = a[21], a[20]
+	}
+	if less(a[19], a[12]) {
+		a[12], a[19] = a[19], a[12]
+	}
+	if less(a[18], a[12]) {
+		a[12], a[18] = a[18], a[12]
+	}
+	if less(a[20], a[13]) {
+		a[13], a[20] = a[20], a[13]
+	}
+	if less(a[20], a[14]) {
+		a[14], a[20] = a[20], a[14]
+	}
+	if less(a[18], a[13]) {
+		a[13], a[18] = a[18], a[13]
+	}
+	if less(a[19], a[14]) {
+		a[14], a[19] = a[19], a[14]
+	}
+	if less(a[18], a[14]) {
+		a[14], a[18] = a[18], a[14]
+	}
+	if less(a[22], a[15]) {
+		a[15], a[22] = a[22], a[15]
+	}
+	if less(a[21], a[15]) {
+		a[15], a[21] = a[21], a[15]
+	}
+	if less(a[23], a[16]) {
+		a[16], a[23] = a[23], a[16]
+	}
+	if less(a[23], a[17]) {
+		a[17], a[23] = a[23], a[17]
+	}
+	if less(a[21], a[16]) {
+		a[16], a[21] = a[21], a[16]
+	}
+	if less(a[22], a[17]) {
+		a[17], a[22] = a[22], a[17]
+	}
+	if less(a[21], a[17]) {
+		a[17], a[21] = a[21], a[17]
+	}
+	if less(a[19], a[15]) {
+		a[15], a[19] = a[19], a[15]
+	}
+	if less(a[18], a[15]) {
+		a[15], a[18] = a[18], a[15]
+	}
+	if less(a[20], a[16]) {
+		a[16], a[20] = a[20], a[16]
+	}
+	if less(a[20], a[17]) {
+		a[17], a[20] = a[20], a[17]
+	}
+	if less(a[18], a[16]) {
+		a[16], a[18] = a[18], a[16]
+	}
+	if less(a[19], a[17]) {
+		a[17], a[19] = a[19], a[17]
+	}
+	if less(a[18], a[17]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if less(a[13], a[0]) {
+		a[0], a[13] = a[13], a[0]
+	}
+	if less(a[12], a[0]) {
+		a[0], a[12] = a[12], a[0]
+	}
+	if less(a[14], a[1]) {
+		a[1], a[14] = a[14], a[1]
+	}
+	if less(a[14], a[2]) {
+		a[2], a[14] = a[14], a[2]
+	}
+	if less(a[12], a[1]) {
+		a[1], a[12] = a[12], a[1]
+	}
+	if less(a[13], a[2]) {
+		a[2], a[13] = a[13], a[2]
+	}
+	if less(a[12], a[2]) {
+		a[2], a[12] = a[12], a[2]
+	}
+	if less(a[16], a[3]) {
+		a[3], a[16] = a[16], a[3]
+	}
+	if less(a[15], a[3]) {
+		a[3], a[15] = a[15], a[3]
+	}
+	if less(a[17], a[4]) {
+		a[4], a[17] = a[17], a[4]
+	}
+	if less(a[17], a[5]) {
+		a[5], a[17] = a[17], a[5]
+	}
+	if less(a[15], a[4]) {
+		a[4], a[15] = a[15], a[4]
+	}
+	if less(a[16], a[5]) {
+		a[5], a[16] = a[16], a[5]
+	}
+	if less(a[15], a[5]) {
+		a[5], a[15] = a[15], a[5]
+	}
+	if less(a[13], a[3]) {
+		a[3], a[13] = a[13], a[3]
+	}
+	if less(a[12], a[3]) {
+		a[3], a[12] = a[12], a[3]
+	}
+	if less(a[14], a[4]) {
+		a[4], a[14] = a[14], a[4]
+	}
+	if less(a[14], a[5]) {
+		a[5], a[14] = a[14], a[5]
+	}
+	if less(a[12], a[4]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if less(a[13], a[5]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if less(a[12], a[5]) {
+		a[5], a[12] = a[12], a[5]
+	}
+	if less(a[19], a[6]) {
+		a[6], a[19] = a[19], a[6]
+	}
+	if less(a[18], a[6]) {
+		a[6], a[18] = a[18], a[6]
+	}
+	if less(a[20], a[7]) {
+		a[7], a[20] = a[20], a[7]
+	}
+	if less(a[20], a[8]) {
+		a[8], a[20] = a[20], a[8]
+	}
+	if less(a[18], a[7]) {
+		a[7], a[18] = a[18], a[7]
+	}
+	if less(a[19], a[8]) {
+		a[8], a[19] = a[19], a[8]
+	}
+	if less(a[18], a[8]) {
+		a[8], a[18] = a[18], a[8]
+	}
+	if less(a[22], a[9]) {
+		a[9], a[22] = a[22], a[9]
+	}
+	if less(a[21], a[9]) {
+		a[9], a[21] = a[21], a[9]
+	}
+	if less(a[23], a[10]) {
+		a[10], a[23] = a[23], a[10]
+	}
+	if less(a[23], a[11]) {
+		a[11], a[23] = a[23], a[11]
+	}
+	if less(a[21], a[10]) {
+		a[10], a[21] = a[21], a[10]
+	}
+	if less(a[22], a[11]) {
+		a[11], a[22] = a[22], a[11]
+	}
+	if less(a[21], a[11]) {
+		a[11], a[21] = a[21], a[11]
+	}
+	if less(a[19], a[9]) {
+		a[9], a[19] = a[19], a[9]
+	}
+	if less(a[18], a[9]) {
+		a[9], a[18] = a[18], a[9]
+	}
+	if less(a[20], a[10]) {
+		a[10], a[20] = a[20], a[10]
+	}
+	if less(a[20], a[11]) {
+		a[11], a[20] = a[20], a[11]
+	}
+	if less(a[18], a[10]) {
+		a[10], a[18] = a[18], a[10]
+	}
+	if less(a[19], a[11]) {
+		a[11], a[19] = a[19], a[11]
+	}
+	if less(a[18], a[11]) {
+		a[11], a[18] = a[18], a[11]
+	}
+	if less(a[13], a[6]) {
+		a[6], a[13] = a[13], a[6]
+	}
+	if less(a[12], a[6]) {
+		a[6], a[12] = a[12], a[6]
+	}
+	if less(a[14], a[7]) {
+		a[7], a[14] = a[14], a[7]
+	}
+	if less(a[14], a[8]) {
+		a[8], a[14] = a[14], a[8]
+	}
+	if less(a[12], a[7]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if less(a[13], a[8]) {
+		a[8], a[13] = a[13], a[8]
+	}
+	if less(a[12], a[8]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if less(a[16], a[9]) {
+		a[9], a[16] = a[16], a[9]
+	}
+	if less(a[15], a[9]) {
+		a[9], a[15] = a[15], a[9]
+	}
+	if less(a[17], a[10]) {
+		a[10], a[17] = a[17], a[10]
+	}
+	if less(a[17], a[11]) {
+		a[11], a[17] = a[17], a[11]
+	}
+	if less(a[15], a[10]) {
+		a[10], a[15] = a[15], a[10]
+	}
+	if less(a[16], a[11]) {
+		a[11], a[16] = a[16], a[11]
+	}
+	if less(a[15], a[11]) {
+		a[11], a[15] = a[15], a[11]
+	}
+	if less(a[13], a[9]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if less(a[12], a[9]) {
+		a[9], a[12] = a[12], a[9]
+	}
+	if less(a[14], a[10]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if less(a[14], a[11]) {
+		a[11], a[14] = a[14], a[11]
+	}
+	if less(a[12], a[10]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if less(a[13], a[11]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if less(a[12], a[11]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if less(a[26], a[25]) {
+		a[25], a[26] = a[26], a[25]
+	}
+	if less(a[26], a[24]) {
+		a[24], a[26] = a[26], a[24]
+	}
+	if less(a[25], a[24]) {
+		a[24], a[25] = a[25], a[24]
+	}
+	if less(a[29], a[28]) {
+		a[28], a[29] = a[29], a[28]
+	}
+	if less(a[29], a[27]) {
+		a[27], a[29] = a[29], a[27]
+	}
+	if less(a[28], a[27]) {
+		a[27], a[28] = a[28], a[27]
+	}
+	if less(a[28], a[24]) {
+		a[24], a[28] = a[28], a[24]
+	}
+	if less(a[27], a[24]) {
+		a[24], a[27] = a[27], a[24]
+	}
+	if less(a[29], a[25]) {
+		a[25], a[29] = a[29], a[25]
+	}
+	if less(a[29], a[26]) {
+		a[26], a[29] = a[29], a[26]
+	}
+	if less(a[27], a[25]) {
+		a[25], a[27] = a[27], a[25]
+	}
+	if less(a[28], a[26]) {
+		a[26], a[28] = a[28], a[26]
+	}
+	if less(a[27], a[26]) {
+		a[26], a[27] = a[27], a[26]
+	}
+	if less(a[32], a[31]) {
+		a[31], a[32] = a[32], a[31]
+	}
+	if less(a[32], a[30]) {
+		a[30], a[32] = a[32], a[30]
+	}
+	if less(a[31], a[30]) {
+		a[30], a[31] = a[31], a[30]
+	}
+	if less(a[35], a[34]) {
+		a[34], a[35] = a[35], a[34]
+	}
+	if less(a[35], a[33]) {
+		a[33], a[35] = a[35], a[33]
+	}
+	if less(a[34], a[33]) {
+		a[33], a[34] = a[34], a[33]
+	}
+	if less(a[34], a[30]) {
+		a[30], a[34] = a[34], a[30]
+	}
+	if less(a[33], a[30]) {
+		a[30], a[33] = a[33], a[30]
+	}
+	if less(a[35], a[31]) {
+		a[31], a[35] = a[35], a[31]
+	}
+	if less(a[35], a[32]) {
+		a[32], a[35] = a[35], a[32]
+	}
+	if less(a[33], a[31]) {
+		a[31], a[33] = a[33], a[31]
+	}
+	if less(a[34], a[32]) {
+		a[32], a[34] = a[34], a[32]
+	}
+	if less(a[33], a[32]) {
+		a[32], a[33] = a[33], a[32]
+	}
+	if less(a[31], a[24]) {
+		a[24], a[31] = a[31], a[24]
+	}
+	if less(a[30], a[24]) {
+		a[24], a[30] = a[30], a[24]
+	}
+	if less(a[32], a[25]) {
+		a[25], a[32] = a[32], a[25]
+	}
+	if less(a[32], a[26]) {
+		a[26], a[32] = a[32], a[26]
+	}
+	if less(a[30], a[25]) {
+		a[25], a[30] = a[30], a[25]
+	}
+	if less(a[31], a[26]) {
+		a[26], a[31] = a[31], a[26]
+	}
+	if less(a[30], a[26]) {
+		a[26], a[30] = a[30], a[26]
+	}
+	if less(a[34], a[27]) {
+		a[27], a[34] = a[34], a[27]
+	}
+	if less(a[33], a[27]) {
+		a[27], a[33] = a[33], a[27]
+	}
+	if less(a[35], a[28]) {
+		a[28], a[35] = a[35], a[28]
+	}
+	if less(a[35], a[29]) {
+		a[29], a[35] = a[35], a[29]
+	}
+	if less(a[33], a[28]) {
+		a[28], a[33] = a[33], a[28]
+	}
+	if less(a[34], a[29]) {
+		a[29], a[34] = a[34], a[29]
+	}
+	if less(a[33], a[29]) {
+		a[29], a[33] = a[33], a[29]
+	}
+	if less(a[31], a[27]) {
+		a[27], a[31] = a[31], a[27]
+	}
+	if less(a[30], a[27]) {
+		a[27], a[30] = a[30], a[27]
+	}
+	if less(a[32], a[28]) {
+		a[28], a[32] = a[32], a[28]
+	}
+	if less(a[32], a[29]) {
+		a[29], a[32] = a[32], a[29]
+	}
+	if less(a[30], a[28]) {
+		a[28], a[30] = a[30], a[28]
+	}
+	if less(a[31], a[29]) {
+		a[29], a[31] = a[31], a[29]
+	}
+	if less(a[30], a[29]) {
+		a[29], a[30] = a[30], a[29]
+	}
+	if less(a[38], a[37]) {
+		a[37], a[38] = a[38], a[37]
+	}
+	if less(a[38], a[36]) {
+		a[36], a[38] = a[38], a[36]
+	}
+	if less(a[37], a[36]) {
+		a[36], a[37] = a[37], a[36]
+	}
+	if less(a[41], a[40]) {
+		a[40], a[41] = a[41], a[40]
+	}
+	if less(a[41], a[39]) {
+		a[39], a[41] = a[41], a[39]
+	}
+	if less(a[40], a[39]) {
+		a[39], a[40] = a[40], a[39]
+	}
+	if less(a[40], a[36]) {
+		a[36], a[40] = a[40], a[36]
+	}
+	if less(a[39], a[36]) {
+		a[36], a[39] = a[39], a[36]
+	}
+	if less(a[41], a[37]) {
+		a[37], a[41] = a[41], a[37]
+	}
+	if less(a[41], a[38]) {
+		a[38], a[41] = a[41], a[38]
+	}
+	if less(a[39], a[37]) {
+		a[37], a[39] = a[39], a[37]
+	}
+	if less(a[40], a[38]) {
+		a[38], a[40] = a[40], a[38]
+	}
+	if less(a[39], a[38]) {
+		a[38], a[39] = a[39], a[38]
+	}
+	if less(a[44], a[43]) {
+		a[43], a[44] = a[44], a[43]
+	}
+	if less(a[44], a[42]) {
+		a[42], a[44] = a[44], a[42]
+	}
+	if less(a[43], a[42]) {
+		a[42], a[43] = a[43], a[42]
+	}
+	if less(a[47], a[46]) {
+		a[46], a[47] = a[47], a[46]
+	}
+	if less(a[47], a[45]) {
+		a[45], a[47] = a[47], a[45]
+	}
+	if less(a[46], a[45]) {
+		a[45], a[46] = a[46], a[45]
+	}
+	if less(a[46], a[42]) {
+		a[42], a[46] = a[46], a[42]
+	}
+	if less(a[45], a[42]) {
+		a[42], a[45] = a[45], a[42]
+	}
+	if less(a[47], a[43]) {
+		a[43], a[47] = a[47], a[43]
+	}
+	if less(a[47], a[44]) {
+		a[44], a[47] = a[47], a[44]
+	}
+	if less(a[45], a[43]) {
+		a[43], a[45] = a[45], a[43]
+	}
+	if less(a[46], a[44]) {
+		a[44], a[46] = a[46], a[44]
+	}
+	if less(a[45], a[44]) {
+		a[44], a[45] = a[45], a[44]
+	}
+	if less(a[43], a[36]) {
+		a[36], a[43] = a[43], a[36]
+	}
+	if less(a[42], a[36]) {
+		a[36], a[42] = a[42], a[36]
+	}
+	if less(a[44], a[37]) {
+		a[37], a[44] = a[44], a[37]
+	}
+	if less(a[44], a[38]) {
+		a[38], a[44] = a[44], a[38]
+	}
+	if less(a[42], a[37]) {
+		a[37], a[42] = a[42], a[37]
+	}
+	if less(a[43], a[38]) {
+		a[38], a[43] = a[43], a[38]
+	}
+	if less(a[42], a[38]) {
+		a[38], a[42] = a[42], a[38]
+	}
+	if less(a[46], a[39]) {
+		a[39], a[46] = a[46], a[39]
+	}
+	if less(a[45], a[39]) {
+		a[39], a[45] = a[45], a[39]
+	}
+	if less(a[47], a[40]) {
+		a[40], a[47] = a[47], a[40]
+	}
+	if less(a[47], a[41]) {
+		a[41], a[47] = a[47], a[41]
+	}
+	if less(a[45], a[40]) {
+		a[40], a[45] = a[45], a[40]
+	}
+	if less(a[46], a[41]) {
+		a[41], a[46] = a[46], a[41]
+	}
+	if less(a[45], a[41]) {
+		a[41], a[45] = a[45], a[41]
+	}
+	if less(a[43], a[39]) {
+		a[39], a[43] = a[43], a[39]
+	}
+	if less(a[42], a[39]) {
+		a[39], a[42] = a[42], a[39]
+	}
+	if less(a[44], a[40]) {
+		a[40], a[44] = a[44], a[40]
+	}
+	if less(a[44], a[41]) {
+		a[41], a[44] = a[44], a[41]
+	}
+	if less(a[42], a[40]) {
+		a[40], a[42] = a[42], a[40]
+	}
+	if less(a[43], a[41]) {
+		a[41], a[43] = a[43], a[41]
+	}
+	if less(a[42], a[41]) {
+		a[41], a[42] = a[42], a[41]
+	}
+	if less(a[37], a[24]) {
+		a[24], a[37] = a[37], a[24]
+	}
+	if less(a[36], a[24]) {
+		a[24], a[36] = a[36], a[24]
+	}
+	if less(a[38], a[25]) {
+		a[25], a[38] = a[38], a[25]
+	}
+	if less(a[38], a[26]) {
+		a[26], a[38] = a[38], a[26]
+	}
+	if less(a[36], a[25]) {
+		a[25], a[36] = a[36], a[25]
+	}
+	if less(a[37], a[26]) {
+		a[26], a[37] = a[37], a[26]
+	}
+	if less(a[36], a[26]) {
+		a[26], a[36] = a[36], a[26]
+	}
+	if less(a[40], a[27]) {
+		a[27], a[40] = a[40], a[27]
+	}
+	if less(a[39], a[27]) {
+		a[27], a[39] = a[39], a[27]
+	}
+	if less(a[41], a[28]) {
+		a[28], a[41] = a[41], a[28]
+	}
+	if less(a[41], a[29]) {
+		a[29], a[41] = a[41], a[29]
+	}
+	if less(a[39], a[28]) {
+		a[28], a[39] = a[39], a[28]
+	}
+	if less(a[40], a[29]) {
+		a[29], a[40] = a[40], a[29]
+	}
+	if less(a[39], a[29]) {
+		a[29], a[39] = a[39], a[29]
+	}
+	if less(a[37], a[27]) {
+		a[27], a[37] = a[37], a[27]
+	}
+	if less(a[36], a[27]) {
+		a[27], a[36] = a[36], a[27]
+	}
+	if less(a[38], a[28]) {
+		a[28], a[38] = a[38], a[28]
+	}
+	if less(a[38], a[29]) {
+		a[29], a[38] = a[38], a[29]
+	}
+	if less(a[36], a[28]) {
+		a[28], a[36] = a[36], a[28]
+	}
+	if less(a[37], a[29]) {
+		a[29], a[37] = a[37], a[29]
+	}
+	if less(a[36], a[29]) {
+		a[29], a[36] = a[36], a[29]
+	}
+	if less(a[43], a[30]) {
+		a[30], a[43] = a[43], a[30]
+	}
+	if less(a[42], a[30]) {
+		a[30], a[42] = a[42], a[30]
+	}
+	if less(a[44], a[31]) {
+		a[31], a[44] = a[44], a[31]
+	}
+	if less(a[44], a[32]) {
+		a[32], a[44] = a[44], a[32]
+	}
+	if less(a[42], a[31]) {
+		a[31], a[42] = a[42], a[31]
+	}
+	if less(a[43], a[32]) {
+		a[32], a[43] = a[43], a[32]
+	}
+	if less(a[42], a[32]) {
+		a[32], a[42] = a[42], a[32]
+	}
+	if less(a[46], a[33]) {
+		a[33], a[46] = a[46], a[33]
+	}
+	if less(a[45], a[33]) {
+		a[33], a[45] = a[45], a[33]
+	}
+	if less(a[47], a[34]) {
+		a[34], a[47] = a[47], a[34]
+	}
+	if less(a[47], a[35]) {
+		a[35], a[47] = a[47], a[35]
+	}
+	if less(a[45], a[34]) {
+		a[34], a[45] = a[45], a[34]
+	}
+	if less(a[46], a[35]) {
+		a[35], a[46] = a[46], a[35]
+	}
+	if less(a[45], a[35]) {
+		a[35], a[45] = a[45], a[35]
+	}
+	if less(a[43], a[33]) {
+		a[33], a[43] = a[43], a[33]
+	}
+	if less(a[42], a[33]) {
+		a[33], a[42] = a[42], a[33]
+	}
+	if less(a[44], a[34]) {
+		a[34], a[44] = a[44], a[34]
+	}
+	if less(a[44], a[35]) {
+		a[35], a[44] = a[44], a[35]
+	}
+	if less(a[42], a[34]) {
+		a[34], a[42] = a[42], a[34]
+	}
+	if less(a[43], a[35]) {
+		a[35], a[43] = a[43], a[35]
+	}
+	if less(a[42], a[35]) {
+		a[35], a[42] = a[42], a[35]
+	}
+	if less(a[37], a[30]) {
+		a[30], a[37] = a[37], a[30]
+	}
+	if less(a[36], a[30]) {
+		a[30], a[36] = a[36], a[30]
+	}
+	if less(a[38], a[31]) {
+		a[31], a[38] = a[38], a[31]
+	}
+	if less(a[38], a[32]) {
+		a[32], a[38] = a[38], a[32]
+	}
+	if less(a[36], a[31]) {
+		a[31], a[36] = a[36], a[31]
+	}
+	if less(a[37], a[32]) {
+		a[32], a[37] = a[37], a[32]
+	}
+	if less(a[36], a[32]) {
+		a[32], a[36] = a[36], a[32]
+	}
+	if less(a[40], a[33]) {
+		a[33], a[40] = a[40], a[33]
+	}
+	if less(a[39], a[33]) {
+		a[33], a[39] = a[39], a[33]
+	}
+	if less(a[41], a[34]) {
+		a[34], a[41] = a[41], a[34]
+	}
+	if less(a[41], a[35]) {
+		a[35], a[41] = a[41], a[35]
+	}
+	if less(a[39], a[34]) {
+		a[34], a[39] = a[39], a[34]
+	}
+	if less(a[40], a[35]) {
+		a[35], a[40] = a[40], a[35]
+	}
+	if less(a[39], a[35]) {
+		a[35], a[39] = a[39], a[35]
+	}
+	if less(a[37], a[33]) {
+		a[33], a[37] = a[37], a[33]
+	}
+	if less(a[36], a[33]) {
+		a[33], a[36] = a[36], a[33]
+	}
+	if less(a[38], a[34]) {
+		a[34], a[38] = a[38], a[34]
+	}
+	if less(a[38], a[35]) {
+		a[35], a[38] = a[38], a[35]
+	}
+	if less(a[36], a[34]) {
+		a[34], a[36] = a[36], a[34]
+	}
+	if less(a[37], a[35]) {
+		a[35], a[37] = a[37], a[35]
+	}
+	if less(a[36], a[35]) {
+		a[35], a[36] = a[36], a[35]
+	}
+	if less(a[25], a[0]) {
+		a[0], a[25] = a[25], a[0]
+	}
+	if less(a[24], a[0]) {
+		a[0], a[24] = a[24], a[0]
+	}
+	if less(a[26], a[1]) {
+		a[1], a[26] = a[26], a[1]
+	}
+	if less(a[26], a[2]) {
+		a[2], a[26] = a[26], a[2]
+	}
+	if less(a[24], a[1]) {
+		a[1], a[24] = a[24], a[1]
+	}
+	if less(a[25], a[2]) {
+		a[2], a[25] = a[25], a[2]
+	}
+	if less(a[24], a[2]) {
+		a[2], a[24] = a[24], a[2]
+	}
+	if less(a[28], a[3]) {
+		a[3], a[28] = a[28], a[3]
+	}
+	if less(a[27], a[3]) {
+		a[3], a[27] = a[27], a[3]
+	}
+	if less(a[29], a[4]) {
+		a[4], a[29] = a[29], a[4]
+	}
+	if less(a[29], a[5]) {
+		a[5], a[29] = a[29], a[5]
+	}
+	if less(a[27], a[4]) {
+		a[4], a[27] = a[27], a[4]
+	}
+	if less(a[28], a[5]) {
+		a[5], a[28] = a[28], a[5]
+	}
+	if less(a[27], a[5]) {
+		a[5], a[27] = a[27], a[5]
+	}
+	if less(a[25], a[3]) {
+		a[3], a[25] = a[25], a[3]
+	}
+	if less(a[24], a[3]) {
+		a[3], a[24] = a[24], a[3]
+	}
+	if less(a[26], a[4]) {
+		a[4], a[26] = a[26], a[4]
+	}
+	if less(a[26], a[5]) {
+		a[5], a[26] = a[26], a[5]
+	}
+	if less(a[24], a[4]) {
+		a[4], a[24] = a[24], a[4]
+	}
+	if less(a[25], a[5]) {
+		a[5], a[25] = a[25], a[5]
+	}
+	if less(a[24], a[5]) {
+		a[5], a[24] = a[24], a[5]
+	}
+	if less(a[31], a[6]) {
+		a[6], a[31] = a[31], a[6]
+	}
+	if less(a[30], a[6]) {
+		a[6], a[30] = a[30], a[6]
+	}
+	if less(a[32], a[7]) {
+		a[7], a[32] = a[32], a[7]
+	}
+	if less(a[32], a[8]) {
+		a[8], a[32] = a[32], a[8]
+	}
+	if less(a[30], a[7]) {
+		a[7], a[30] = a[30], a[7]
+	}
+	if less(a[31], a[8]) {
+		a[8], a[31] = a[31], a[8]
+	}
+	if less(a[30], a[8]) {
+		a[8], a[30] = a[30], a[8]
+	}
+	if less(a[34], a[9]) {
+		a[9], a[34] = a[34], a[9]
+	}
+	if less(a[33], a[9]) {
+		a[9], a[33] = a[33], a[9]
+	}
+	if less(a[35], a[10]) {
+		a[10], a[35] = a[35], a[10]
+	}
+	if less(a[35], a[11]) {
+		a[11], a[35] = a[35], a[11]
+	}
+	if less(a[33], a[10]) {
+		a[10], a[33] = a[33], a[10]
+	}
+	if less(a[34], a[11]) {
+		a[11], a[34] = a[34], a[11]
+	}
+	if less(a[33], a[11]) {
+		a[11], a[33] = a[33], a[11]
+	}
+	if less(a[31], a[9]) {
+		a[9], a[31] = a[31], a[9]
+	}
+	if less(a[30], a[9]) {
+		a[9], a[30] = a[30], a[9]
+	}
+	if less(a[32], a[10]) {
+		a[10], a[32] = a[32], a[10]
+	}
+	if less(a[32], a[11]) {
+		a[11], a[32] = a[32], a[11]
+	}
+	if less(a[30], a[10]) {
+		a[10], a[30] = a[30], a[10]
+	}
+	if less(a[31], a[11]) {
+		a[11], a[31] = a[31], a[11]
+	}
+	if less(a[30], a[11]) {
+		a[11], a[30] = a[30], a[11]
+	}
+	if less(a[25], a[6]) {
+		a[6], a[25] = a[25], a[6]
+	}
+	if less(a[24], a[6]) {
+		a[6], a[24] = a[24], a[6]
+	}
+	if less(a[26], a[7]) {
+		a[7], a[26] = a[26], a[7]
+	}
+	if less(a[26], a[8]) {
+		a[8], a[26] = a[26], a[8]
+	}
+	if less(a[24], a[7]) {
+		a[7], a[24] = a[24], a[7]
+	}
+	if less(a[25], a[8]) {
+		a[8], a[25] = a[25], a[8]
+	}
+	if less(a[24], a[8]) {
+		a[8], a[24] = a[24], a[8]
+	}
+	if less(a[28], a[9]) {
+		a[9], a[28] = a[28], a[9]
+	}
+	if less(a[27], a[9]) {
+		a[9], a[27] = a[27], a[9]
+	}
+	if less(a[29], a[10]) {
+		a[10], a[29] = a[29], a[10]
+	}
+	if less(a[29], a[11]) {
+		a[11], a[29] = a[29], a[11]
+	}
+	if less(a[27], a[10]) {
+		a[10], a[27] = a[27], a[10]
+	}
+	if less(a[28], a[11]) {
+		a[11], a[28] = a[28], a[11]
+	}
+	if less(a[27], a[11]) {
+		a[11], a[27] = a[27], a[11]
+	}
+	if less(a[25], a[9]) {
+		a[9], a[25] = a[25], a[9]
+	}
+	if less(a[24], a[9]) {
+		a[9], a[24] = a[24], a[9]
+	}
+	if less(a[26], a[10]) {
+		a[10], a[26] = a[26], a[10]
+	}
+	if less(a[26], a[11]) {
+		a[11], a[26] = a[26], a[11]
+	}
+	if less(a[24], a[10]) {
+		a[10], a[24] = a[24], a[10]
+	}
+	if less(a[25], a[11]) {
+		a[11], a[25] = a[25], a[11]
+	}
+	if less(a[24], a[11]) {
+		a[11], a[24] = a[24], a[11]
+	}
+	if less(a[37], a[12]) {
+		a[12], a[37] = a[37], a[12]
+	}
+	if less(a[36], a[12]) {
+		a[12], a[36] = a[36], a[12]
+	}
+	if less(a[38], a[13]) {
+		a[13], a[38] = a[38], a[13]
+	}
+	if less(a[38], a[14]) {
+		a[14], a[38] = a[38], a[14]
+	}
+	if less(a[36], a[13]) {
+		a[13], a[36] = a[36], a[13]
+	}
+	if less(a[37], a[14]) {
+		a[14], a[37] = a[37], a[14]
+	}
+	if less(a[36], a[14]) {
+		a[14], a[36] = a[36], a[14]
+	}
+	if less(a[40], a[15]) {
+		a[15], a[40] = a[40], a[15]
+	}
+	if less(a[39], a[15]) {
+		a[15], a[39] = a[39], a[15]
+	}
+	if less(a[41], a[16]) {
+		a[16], a[41] = a[41], a[16]
+	}
+	if less(a[41], a[17]) {
+		a[17], a[41] = a[41], a[17]
+	}
+	if less(a[39], a[16]) {
+		a[16], a[39] = a[39], a[16]
+	}
+	if less(a[40], a[17]) {
+		a[17], a[40] = a[40], a[17]
+	}
+	if less(a[39], a[17]) {
+		a[17], a[39] = a[39], a[17]
+	}
+	if less(a[37], a[15]) {
+		a[15], a[37] = a[37], a[15]
+	}
+	if less(a[36], a[15]) {
+		a[15], a[36] = a[36], a[15]
+	}
+	if less(a[38], a[16]) {
+		a[16], a[38] = a[38], a[16]
+	}
+	if less(a[38], a[17]) {
+		a[17], a[38] = a[38], a[17]
+	}
+	if less(a[36], a[16]) {
+		a[16], a[36] = a[36], a[16]
+	}
+	if less(a[37], a[17]) {
+		a[17], a[37] = a[37], a[17]
+	}
+	if less(a[36], a[17]) {
+		a[17], a[36] = a[36], a[17]
+	}
+	if less(a[43], a[18]) {
+		a[18], a[43] = a[43], a[18]
+	}
+	if less(a[42], a[18]) {
+		a[18], a[42] = a[42], a[18]
+	}
+	if less(a[44], a[19]) {
+		a[19], a[44] = a[44], a[19]
+	}
+	if less(a[44], a[20]) {
+		a[20], a[44] = a[44], a[20]
+	}
+	if less(a[42], a[19]) {
+		a[19], a[42] = a[42], a[19]
+	}
+	if less(a[43], a[20]) {
+		a[20], a[43] = a[43], a[20]
+	}
+	if less(a[42], a[20]) {
+		a[20], a[42] = a[42], a[20]
+	}
+	if less(a[46], a[21]) {
+		a[21], a[46] = a[46], a[21]
+	}
+	if less(a[45], a[21]) {
+		a[21], a[45] = a[45], a[21]
+	}
+	if less(a[47], a[22]) {
+		a[22], a[47] = a[47], a[22]
+	}
+	if less(a[47], a[23]) {
+		a[23], a[47] = a[47], a[23]
+	}
+	if less(a[45], a[22]) {
+		a[22], a[45] = a[45], a[22]
+	}
+	if less(a[46], a[23]) {
+		a[23], a[46] = a[46], a[23]
+	}
+	if less(a[45], a[23]) {
+		a[23], a[45] = a[45], a[23]
+	}
+	if less(a[43], a[21]) {
+		a[21], a[43] = a[43], a[21]
+	}
+	if less(a[42], a[21]) {
+		a[21], a[42] = a[42], a[21]
+	}
+	if less(a[44], a[22]) {
+		a[22], a[44] = a[44], a[22]
+	}
+	if less(a[44], a[23]) {
+		a[23], a[44] = a[44], a[23]
+	}
+	if less(a[42], a[22]) {
+		a[22], a[42] = a[42], a[22]
+	}
+	if less(a[43], a[23]) {
+		a[23], a[43] = a[43], a[23]
+	}
+	if less(a[42], a[23]) {
+		a[23], a[42] = a[42], a[23]
+	}
+	if less(a[37], a[18]) {
+		a[18], a[37] = a[37], a[18]
+	}
+	if less(a[36], a[18]) {
+		a[18], a[36] = a[36], a[18]
+	}
+	if less(a[38], a[19]) {
+		a[19], a[38] = a[38], a[19]
+	}
+	if less(a[38], a[20]) {
+		a[20], a[38] = a[38], a[20]
+	}
+	if less(a[36], a[19]) {
+		a[19], a[36] = a[36], a[19]
+	}
+	if less(a[37], a[20]) {
+		a[20], a[37] = a[37], a[20]
+	}
+	if less(a[36], a[20]) {
+		a[20], a[36] = a[36], a[20]
+	}
+	if less(a[40], a[21]) {
+		a[21], a[40] = a[40], a[21]
+	}
+	if less(a[39], a[21]) {
+		a[21], a[39] = a[39], a[21]
+	}
+	if less(a[41], a[22]) {
+		a[22], a[41] = a[41], a[22]
+	}
+	if less(a[41], a[23]) {
+		a[23], a[41] = a[41], a[23]
+	}
+	if less(a[39], a[22]) {
+		a[22], a[39] = a[39], a[22]
+	}
+	if less(a[40], a[23]) {
+		a[23], a[40] = a[40], a[23]
+	}
+	if less(a[39], a[23]) {
+		a[23], a[39] = a[39], a[23]
+	}
+	if less(a[37], a[21]) {
+		a[21], a[37] = a[37], a[21]
+	}
+	if less(a[36], a[21]) {
+		a[21], a[36] = a[36], a[21]
+	}
+	if less(a[38], a[22]) {
+		a[22], a[38] = a[38], a[22]
+	}
+	if less(a[38], a[23]) {
+		a[23], a[38] = a[38], a[23]
+	}
+	if less(a[36], a[22]) {
+		a[22], a[36] = a[36], a[22]
+	}
+	if less(a[37], a[23]) {
+		a[23], a[37] = a[37], a[23]
+	}
+	if less(a[36], a[23]) {
+		a[23], a[36] = a[36], a[23]
+	}
+	if less(a[25], a[12]) {
+		a[12], a[25] = a[25], a[12]
+	}
+	if less(a[24], a[12]) {
+		a[12], a[24] = a[24], a[12]
+	}
+	if less(a[26], a[13]) {
+		a[13], a[26] = a[26], a[13]
+	}
+	if less(a[26], a[14]) {
+		a[14], a[26] = a[26], a[14]
+	}
+	if less(a[24], a[13]) {
+		a[13], a[24] = a[24], a[13]
+	}
+	if less(a[25], a[14]) {
+		a[14], a[25] = a[25], a[14]
+	}
+	if less(a[24], a[14]) {
+		a[14], a[24] = a[24], a[14]
+	}
+	if less(a[28], a[15]) {
+		a[15], a[28] = a[28], a[15]
+	}
+	if less(a[27], a[15]) {
+		a[15], a[27] = a[27], a[15]
+	}
+	if less(a[29], a[16]) {
+		a[16], a[29] = a[29], a[16]
+	}
+	if less(a[29], a[17]) {
+		a[17], a[29] = a[29], a[17]
+	}
+	if less(a[27], a[16]) {
+		a[16], a[27] = a[27], a[16]
+	}
+	if less(a[28], a[17]) {
+		a[17], a[28] = a[28], a[17]
+	}
+	if less(a[27], a[17]) {
+		a[17], a[27] = a[27], a[17]
+	}
+	if less(a[25], a[15]) {
+		a[15], a[25] = a[25], a[15]
+	}
+	if less(a[24], a[15]) {
+		a[15], a[24] = a[24], a[15]
+	}
+	if less(a[26], a[16]) {
+		a[16], a[26] = a[26], a[16]
+	}
+	if less(a[26], a[17]) {
+		a[17], a[26] = a[26], a[17]
+	}
+	if less(a[24], a[16]) {
+		a[16], a[24] = a[24], a[16]
+	}
+	if less(a[25], a[17]) {
+		a[17], a[25] = a[25], a[17]
+	}
+	if less(a[24], a[17]) {
+		a[17], a[24] = a[24], a[17]
+	}
+	if less(a[31], a[18]) {
+		a[18], a[31] = a[31], a[18]
+	}
+	if less(a[30], a[18]) {
+		a[18], a[30] = a[30], a[18]
+	}
+	if less(a[32], a[19]) {
+		a[19], a[32] = a[32], a[19]
+	}
+	if less(a[32], a[20]) {
+		a[20], a[32] = a[32], a[20]
+	}
+	if less(a[30], a[19]) {
+		a[19], a[30] = a[30], a[19]
+	}
+	if less(a[31], a[20]) {
+		a[20], a[31] = a[31], a[20]
+	}
+	if less(a[30], a[20]) {
+		a[20], a[30] = a[30], a[20]
+	}
+	if less(a[34], a[21]) {
+		a[21], a[34] = a[34], a[21]
+	}
+	if less(a[33], a[21]) {
+		a[21], a[33] = a[33], a[21]
+	}
+	if less(a[35], a[22]) {
+		a[22], a[35] = a[35], a[22]
+	}
+	if less(a[35], a[23]) {
+		a[23], a[35] = a[35], a[23]
+	}
+	if less(a[33], a[22]) {
+		a[22], a[33] = a[33], a[22]
+	}
+	if less(a[34], a[23]) {
+		a[23], a[34] = a[34], a[23]
+	}
+	if less(a[33], a[23]) {
+		a[23], a[33] = a[33], a[23]
+	}
+	if less(a[31], a[21]) {
+		a[21], a[31] = a[31], a[21]
+	}
+	if less(a[30], a[21]) {
+		a[21], a[30] = a[30], a[21]
+	}
+	if less(a[32], a[22]) {
+		a[22], a[32] = a[32], a[22]
+	}
+	if less(a[32], a[23]) {
+		a[23], a[32] = a[32], a[23]
+	}
+	if less(a[30], a[22]) {
+		a[22], a[30] = a[30], a[22]
+	}
+	if less(a[31], a[23]) {
+		a[23], a[31] = a[31], a[23]
+	}
+	if less(a[30], a[23]) {
+		a[23], a[30] = a[30], a[23]
+	}
+	if less(a[25], a[18]) {
+		a[18], a[25] = a[25], a[18]
+	}
+	if less(a[24], a[18]) {
+		a[18], a[24] = a[24], a[18]
+	}
+	if less(a[26], a[19]) {
+		a[19], a[26] = a[26], a[19]
+	}
+	if less(a[26], a[20]) {
+		a[20], a[26] = a[26], a[20]
+	}
+	if less(a[24], a[19]) {
+		a[19], a[24] = a[24], a[19]
+	}
+	if less(a[25], a[20]) {
+		a[20], a[25] = a[25], a[20]
+	}
+	if less(a[24], a[20]) {
+		a[20], a[24] = a[24], a[20]
+	}
+	if less(a[28], a[21]) {
+		a[21], a[28] = a[28], a[21]
+	}
+	if less(a[27], a[21]) {
+		a[21], a[27] = a[27], a[21]
+	}
+	if less(a[29], a[22]) {
+		a[22], a[29] = a[29], a[22]
+	}
+	if less(a[29], a[23]) {
+		a[23], a[29] = a[29], a[23]
+	}
+	if less(a[27], a[22]) {
+		a[22], a[27] = a[27], a[22]
+	}
+	if less(a[28], a[23]) {
+		a[23], a[28] = a[28], a[23]
+	}
+	if less(a[27], a[23]) {
+		a[23], a[27] = a[27], a[23]
+	}
+	if less(a[25], a[21]) {
+		a[21], a[25] = a[25], a[21]
+	}
+	if less(a[24], a[21]) {
+		a[21], a[24] = a[24], a[21]
+	}
+	if less(a[26], a[22]) {
+		a[22], a[26] = a[26], a[22]
+	}
+	if less(a[26], a[23]) {
+		a[23], a[26] = a[26], a[23]
+	}
+	if less(a[24], a[22]) {
+		a[22], a[24] = a[24], a[22]
+	}
+	if less(a[25], a[23]) {
+		a[23], a[25] = a[25], a[23]
+	}
+	if less(a[24], a[23]) {
+		a[23], a[24] = a[24], a[23]
+	}
+}
+
+func NetworkSort64Func[T any](a []T, less func(a, b T) bool) {
+	_ = a[63]
+	if less(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if less(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if less(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if less(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if less(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if less(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if less(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if less(a[6], a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if less(a[7], a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if less(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if less(a[4], a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if less(a[5], a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if less(a[4], a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if less(a[6], a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if less(a[7], a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if less(a[6], a[3]) {
+		a[3], a[6] = a[6], a[3]
+	}
+	if less(a[4], a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if less(a[5], a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if less(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if less(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if less(a[11], a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if less(a[10], a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if less(a[11], a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if less(a[10], a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if less(a[13], a[12]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if less(a[15], a[14]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if less(a[14], a[12]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if less(a[15], a[13]) {
+		a[13], a[15] = a[15], a[13]
+	}
+	if less(a[14], a[13]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if less(a[12], a[8]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if less(a[13], a[9]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if less(a[12], a[9]) {
+		a[9], a[12] = a[12], a[9]
+	}
+	if less(a[14], a[10]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if less(a[15], a[11]) {
+		a[11], a[15] = a[15], a[11]
+	}
+	if less(a[14], a[11]) {
+		a[11], a[14] = a[14], a[11]
+	}
+	if less(a[12], a[10]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if less(a[13], a[11]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if less(a[12], a[11]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if less(a[8], a[0]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if less(a[9], a[1]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if less(a[8], a[1]) {
+		a[1], a[8] = a[8], a[1]
+	}
+	if less(a[10], a[2]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if less(a[11], a[3]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if less(a[10], a[3]) {
+		a[3], a[10] = a[10], a[3]
+	}
+	if less(a[8], a[2]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if less(a[9], a[3]) {
+		a[3], a[9] = a[9], a[3]
+	}
+	if less(a[8], a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if less(a[12], a[4]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if less(a[13], a[5]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if less(a[12], a[5]) {
+		a[5], a[12] = a[12], a[5]
+	}
+	if less(a[14], a[6]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if less(a[15], a[7]) {
+		a[7], a[15] = a[15], a[7]
+	}
+	if less(a[14], a[7]) {
+		a[7], a[14] = a[14], a[7]
+	}
+	if less(a[12], a[6]) {
+		a[6], a[12] = a[12], a[6]
+	}
+	if less(a[13], a[7]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if less(a[12], a[7]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if less(a[8], a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if less(a[9], a[5]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if less(a[8], a[5]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if less(a[10], a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if less(a[11], a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if less(a[10], a[7]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if less(a[8], a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if less(a[9], a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if less(a[8], a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if less(a[17], a[16]) {
+		a[16], a[17] = a[17], a[16]
+	}
+	if less(a[19], a[18]) {
+		a[18], a[19] = a[19], a[18]
+	}
+	if less(a[18], a[16]) {
+		a[16], a[18] = a[18], a[16]
+	}
+	if less(a[19], a[17]) {
+		a[17], a[19] = a[19], a[17]
+	}
+	if less(a[18], a[17]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if less(a[21], a[20]) {
+		a[20], a[21] = a[21], a[20]
+	}
+	if less(a[23], a[22]) {
+		a[22], a[23] = a[23], a[22]
+	}
+	if less(a[22], a[20]) {
+		a[20], a[22] = a[22], a[20]
+	}
+	if less(a[23], a[21]) {
+		a[21], a[23] = a[23], a[21]
+	}
+	if less(a[22], a[21]) {
+		a[21], a[22] = a[22], a[21]
+	}
+	if less(a[20], a[16]) {
+		a[16], a[20] = a[20], a[16]
+	}
+	if less(a[21], a[17]) {
+		a[17], a[21] = a[21], a[17]
+	}
+	if less(a[20], a[17]) {
+		a[17], a[20] = a[20], a[17]
+	}
+	if less(a[22], a[18]) {
+		a[18], a[22] = a[22], a[18]
+	}
+	if less(a[23], a[19]) {
+		a[19], a[23] = a[23], a[19]
+	}
+	if less(a[22], a[19]) {
+		a[19], a[22] = a[22], a[19]
+	}
+	if less(a[20], a[18]) {
+		a[18], a[20] = a[20], a[18]
+	}
+	if less(a[21], a[19]) {
+		a[19], a[21] = a[21], a[19]
+	}
+	if less(a[20], a[19]) {
+		a[19], a[20] = a[20], a[19]
+	}
+	if less(a[25], a[24]) {
+		a[24], a[25] = a[25], a[24]
+	}
+	if less(a[27], a[26]) {
+		a[26], a[27] = a[27], a[26]
+	}
+	if less(a[26], a[24]) {
+		a[24], a[26] = a[26], a[24]
+	}
+	if less(a[27], a[25]) {
+		a[25], a[27] = a[27], a[25]
+	}
+	if less(a[26], a[25]) {
+		a[25], a[26] = a[26], a[25]
+	}
+	if less(a[29], a[28]) {
+		a[28], a[29] = a[29], a[28]
+	}
+	if less(a[31], a[30]) {
+		a[30], a[31] = a[31], a[30]
+	}
+	if less(a[30], a[28]) {
+		a[28], a[30] = a[30], a[28]
+	}
+	if less(a[31], a[29]) {
+		a[29], a[31] = a[31], a[29]
+	}
+	if less(a[30], a[29]) {
+		a[29], a[30] = a[30], a[29]
+	}
+	if less(a[28], a[24]) {
+		a[24], a[28] = a[28], a[24]
+	}
+	if less(a[29], a[25]) {
+		a[25], a[29] = a[29], a[25]
+	}
+	if less(a[28], a[25]) {
+		a[25], a[28] = a[28], a[25]
+	}
+	if less(a[30], a[26]) {
+		a[26], a[30] = a[30], a[26]
+	}
+	if less(a[31], a[27]) {
+		a[27], a[31] = a[31], a[27]
+	}
+	if less(a[30], a[27]) {
+		a[27], a[30] = a[30], a[27]
+	}
+	if less(a[28], a[26]) {
+		a[26], a[28] = a[28], a[26]
+	}
+	if less(a[29], a[27]) {
+		a[27], a[29] = a[29], a[27]
+	}
+	if less(a[28], a[27]) {
+		a[27], a[28] = a[28], a[27]
+	}
+	if less(a[24], a[16]) {
+		a[16], a[24] = a[24], a[16]
+	}
+	if less(a[25], a[17]) {
+		a[17], a[25] = a[25], a[17]
+	}
+	if less(a[24], a[17]) {
+		a[17], a[24] = a[24], a[17]
+	}
+	if less(a[26], a[18]) {
+		a[18], a[26] = a[26], a[18]
+	}
+	if less(a[27], a[19]) {
+		a[19], a[27] = a[27], a[19]
+	}
+	if less(a[26], a[19]) {
+		a[19], a[26] = a[26], a[19]
+	}
+	if less(a[24], a[18]) {
+		a[18], a[24] = a[24], a[18]
+	}
+	if less(a[25], a[19]) {
+		a[19], a[25] = a[25], a[19]
+	}
+	if less(a[24], a[19]) {
+		a[19], a[24] = a[24], a[19]
+	}
+	if less(a[28], a[20]) {
+		a[20], a[28] = a[28], a[20]
+	}
+	if less(a[29], a[21]) {
+		a[21], a[29] = a[29], a[21]
+	}
+	if less(a[28], a[21]) {
+		a[21], a[28] = a[28], a[21]
+	}
+	if less(a[30], a[22]) {
+		a[22], a[30] = a[30], a[22]
+	}
+	if less(a[31], a[23]) {
+		a[23], a[31] = a[31], a[23]
+	}
+	if less(a[30], a[23]) {
+		a[23], a[30] = a[30], a[23]
+	}
+	if less(a[28], a[22]) {
+		a[22], a[28] = a[28], a[22]
+	}
+	if less(a[29], a[23]) {
+		a[23], a[29] = a[29], a[23]
+	}
+	if less(a[28], a[23]) {
+		a[23], a[28] = a[28], a[23]
+	}
+	if less(a[24], a[20]) {
+		a[20], a[24] = a[24], a[20]
+	}
+	if less(a[25], a[21]) {
+		a[21], a[25] = a[25], a[21]
+	}
+	if less(a[24], a[21]) {
+		a[21], a[24] = a[24], a[21]
+	}
+	if less(a[26], a[22]) {
+		a[22], a[26] = a[26], a[22]
+	}
+	if less(a[27], a[23]) {
+		a[23], a[27] = a[27], a[23]
+	}
+	if less(a[26], a[23]) {
+		a[23], a[26] = a[26], a[23]
+	}
+	if less(a[24], a[22]) {
+		a[22], a[24] = a[24], a[22]
+	}
+	if less(a[25], a[23]) {
+		a[23], a[25] = a[25], a[23]
+	}
+	if less(a[24], a[23]) {
+		a[23], a[24] = a[24], a[23]
+	}
+	if less(a[16], a[0]) {
+		a[0], a[16] = a[16], a[0]
+	}
+	if less(a[17], a[1]) {
+		a[1], a[17] = a[17], a[1]
+	}
+	if less(a[16], a[1]) {
+		a[1], a[16] = a[16], a[1]
+	}
+	if less(a[18], a[2]) {
+		a[2], a[18] = a[18], a[2]
+	}
+	if less(a[19], a[3]) {
+		a[3], a[19] = a[19], a[3]
+	}
+	if less(a[18], a[3]) {
+		a[3], a[18] = a[18], a[3]
+	}
+	if less(a[16], a[2]) {
+		a[2], a[16] = a[16], a[2]
+	}
+	if less(a[17], a[3]) {
+		a[3], a[17] = a[17], a[3]
+	}
+	if less(a[16], a[3]) {
+		a[3], a[16] = a[16], a[3]
+	}
+	if less(a[20], a[4]) {
+		a[4], a[20] = a[20], a[4]
+	}
+	if less(a[21], a[5]) {
+		a[5], a[21] = a[21], a[5]
+	}
+	if less(a[20], a[5]) {
+		a[5], a[20] = a[20], a[5]
+	}
+	if less(a[22], a[6]) {
+		a[6], a[22] = a[22], a[6]
+	}
+	if less(a[23], a[7]) {
+		a[7], a[23] = a[23], a[7]
+	}
+	if less(a[22], a[7]) {
+		a[7], a[22] = a[22], a[7]
+	}
+	if less(a[20], a[6]) {
+		a[6], a[20] = a[20], a[6]
+	}
+	if less(a[21], a[7]) {
+		a[7], a[21] = a[21], a[7]
+	}
+	if less(a[20], a[7]) {
+		a[7], a[20] = a[20], a[7]
+	}
+	if less(a[16], a[4]) {
+		a[4], a[16] = a[16], a[4]
+	}
+	if less(a[17], a[5]) {
+		a[5], a[17] = a[17], a[5]
+	}
+	if less(a[16], a[5]) {
+		a[5], a[16] = a[16], a[5]
+	}
+	if less(a[18], a[6]) {
+		a[6], a[18] = a[18], a[6]
+	}
+	if less(a[19], a[7]) {
+		a[7], a[19] = a[19], a[7]
+	}
+	if less(a[18], a[7]) {
+		a[7], a[18] = a[18], a[7]
+	}
+	if less(a[16], a[6]) {
+		a[6], a[16] = a[16], a[6]
+	}
+	if less(a[17], a[7]) {
+		a[7], a[17] = a[17], a[7]
+	}
+	if less(a[16], a[7]) {
+		a[7], a[16] = a[16], a[7]
+	}
+	if less(a[24], a[8]) {
+		a[8], a[24] = a[24], a[8]
+	}
+	if less(a[25], a[9]) {
+		a[9], a[25] = a[25], a[9]
+	}
+	if less(a[24], a[9]) {
+		a[9], a[24] = a[24], a[9]
+	}
+	if less(a[26], a[10]) {
+		a[10], a[26] = a[26], a[10]
+	}
+	if less(a[27], a[11]) {
+		a[11], a[27] = a[27], a[11]
+	}
+	if less(a[26], a[11]) {
+		a[11], a[26] = a[26], a[11]
+	}
+	if less(a[24], a[10]) {
+		a[10], a[24] = a[24], a[10]
+	}
+	if less(a[25], a[11]) {
+		a[11], a[25] = a[25], a[11]
+	}
+	if less(a[24], a[11]) {
+		a[11], a[24] = a[24], a[11]
+	}
+	if less(a[28], a[12]) {
+		a[12], a[28] = a[28], a[12]
+	}
+	if less(a[29], a[13]) {
+		a[13], a[29] = a[29], a[13]
+	}
+	if less(a[28], a[13]) {
+		a[13], a[28] = a[28], a[13]
+	}
+	if less(a[30], a[14]) {
+		a[14], a[30] = a[30], a[14]
+	}
+	if less(a[31], a[15]) {
+		a[15], a[31] = a[31], a[15]
+	}
+	if less(a[30], a[15]) {
+		a[15], a[30] = a[30], a[15]
+	}
+	if less(a[28], a[14]) {
+		a[14], a[28] = a[28], a[14]
+	}
+	if less(a[29], a[15]) {
+		a[15], a[29] = a[29], a[15]
+	}
+	if less(a[28], a[15]) {
+		a[15], a[28] = a[28], a[15]
+	}
+	if less(a[24], a[12]) {
+		a[12], a[24] = a[24], a[12]
+	}
+	if less(a[25], a[13]) {
+		a[13], a[25] = a[25], a[13]
+	}
+	if less(a[24], a[13]) {
+		a[13], a[24] = a[24], a[13]
+	}
+	if less(a[26], a[14]) {
+		a[14], a[26] = a[26], a[14]
+	}
+	if less(a[27], a[15]) {
+		a[15], a[27] = a[27], a[15]
+	}
+	if less(a[26], a[15]) {
+		a[15], a[26] = a[26], a[15]
+	}
+	if less(a[24], a[14]) {
+		a[14], a[24] = a[24], a[14]
+	}
+	if less(a[25], a[15]) {
+		a[15], a[25] = a[25], a[15]
+	}
+	if less(a[24], a[15]) {
+		a[15], a[24] = a[24], a[15]
+	}
+	if less(a[16], a[8]) {
+		a[8], a[16] = a[16], a[8]
+	}
+	if less(a[17], a[9]) {
+		a[9], a[17] = a[17], a[9]
+	}
+	if less(a[16], a[9]) {
+		a[9], a[16] = a[16], a[9]
+	}
+	if less(a[18], a[10]) {
+		a[10], a[18] = a[18], a[10]
+	}
+	if less(a[19], a[11]) {
+		a[11], a[19] = a[19], a[11]
+	}
+	if less(a[18], a[11]) {
+		a[11], a[18] = a[18], a[11]
+	}
+	if less(a[16], a[10]) {
+		a[10], a[16] = a[16], a[10]
+	}
+	if less(a[17], a[11]) {
+		a[11], a[17] = a[17], a[11]
+	}
+	if less(a[16], a[11]) {
+		a[11], a[16] = a[16], a[11]
+	}
+	if less(a[20], a[12]) {
+		a[12], a[20] = a[20], a[12]
+	}
+	if less(a[21], a[13]) {
+		a[13], a[21] = a[21], a[13]
+	}
+	if less(a[20], a[13]) {
+		a[13], a[20] = a[20], a[13]
+	}
+	if less(a[22], a[14]) {
+		a[14], a[22] = a[22], a[14]
+	}
+	if less(a[23], a[15]) {
+		a[15], a[23] = a[23], a[15]
+	}
+	if less(a[22], a[15]) {
+		a[15], a[22] = a[22], a[15]
+	}
+	if less(a[20], a[14]) {
+		a[14], a[20] = a[20], a[14]
+	}
+	if less(a[21], a[15]) {
+		a[15], a[21] = a[21], a[15]
+	}
+	if less(a[20], a[15]) {
+		a[15], a[20] = a[20], a[15]
+	}
+	if less(a[16], a[12]) {
+		a[12], a[16] = a[16], a[12]
+	}
+	if less(a[17], a[13]) {
+		a[13], a[17] = a[17], a[13]
+	}
+	if less(a[16], a[13]) {
+		a[13], a[16] = a[16], a[13]
+	}
+	if less(a[18], a[14]) {
+		a[14], a[18] = a[18], a[14]
+	}
+	if less(a[19], a[15]) {
+		a[15], a[19] = a[19], a[15]
+	}
+	if less(a[18], a[15]) {
+		a[15], a[18] = a[18], a[15]
+	}
+	if less(a[16], a[14]) {
+		a[14], a[16] = a[16], a[14]
+	}
+	if less(a[17], a[15]) {
+		a[15], a[17] = a[17], a[15]
+	}
+	if less(a[16], a[15]) {
+		a[15], a[16] = a[16], a[15]
+	}
+	if less(a[33], a[32]) {
+		a[32], a[33] = a[33], a[32]
+	}
+	if less(a[35], a[34]) {
+		a[34], a[35] = a[35], a[34]
+	}
+	if less(a[34], a[32]) {
+		a[32], a[34] = a[34], a[32]
+	}
+	if less(a[35], a[33]) {
+		a[33], a[35] = a[35], a[33]
+	}
+	if less(a[34], a[33]) {
+		a[33], a[34] = a[34], a[33]
+	}
+	if less(a[37], a[36]) {
+		a[36], a[37] = a[37], a[36]
+	}
+	if less(a[39], a[38]) {
+		a[38], a[39] = a[39], a[38]
+	}
+	if less(a[38], a[36]) {
+		a[36], a[38] = a[38], a[36]
+	}
+	if less(a[39], a[37]) {
+		a[37], a[39] = a[39], a[37]
+	}
+	if less(a[38], a[37]) {
+		a[37], a[38] = a[38], a[37]
+	}
+	if less(a[36], a[32]) {
+		a[32], a[36] = a[36], a[32]
+	}
+	if less(a[37], a[33]) {
+		a[33], a[37] = a[37], a[33]
+	}
+	if less(a[36], a[33]) {
+		a[33], a[36] = a[36], a[33]
+	}
+	if less(a[38], a[34]) {
+		a[34], a[38] = a[38], a[34]
+	}
+	if less(a[39], a[35]) {
+		a[35], a[39] = a[39], a[35]
+	}
+	if less(a[38], a[35]) {
+		a[35], a[38] = a[38], a[35]
+	}
+	if less(a[36], a[34]) {
+		a[34], a[36] = a[36], a[34]
+	}
+	if less(a[37], a[35]) {
+		a[35], a[37] = a[37], a[35]
+	}
+	if less(a[36], a[35]) {
+		a[35], a[36] = a[36], a[35]
+	}
+	if less(a[41], a[40]) {
+		a[40], a[41] = a[41], a[40]
+	}
+	if less(a[43], a[42]) {
+		a[42], a[43] = a[43], a[42]
+	}
+	if less(a[42], a[40]) {
+		a[40], a[42] = a[42], a[40]
+	}
+	if less(a[43], a[41]) {
+		a[41], a[43] = a[43], a[41]
+	}
+	if less(a[42], a[41]) {
+		a[41], a[42] = a[42], a[41]
+	}
+	if less(a[45], a[44]) {
+		a[44], a[45] = a[45], a[44]
+	}
+	if less(a[47], a[46]) {
+		a[46], a[47] = a[47], a[46]
+	}
+	if less(a[46], a[44]) {
+		a[44], a[46] = a[46], a[44]
+	}
+	if less(a[47], a[45]) {
+		a[45], a[47] = a[47], a[45]
+	}
+	if less(a[46], a[45]) {
+		a[45], a[46] = a[46], a[45]
+	}
+	if less(a[44], a[40]) {
+		a[40], a[44] = a[44], a[40]
+	}
+	if less(a[45], a[41]) {
+		a[41], a[45] = a[45], a[41]
+	}
+	if less(a[44], a[41]) {
+		a[41], a[44] = a[44], a[41]
+	}
+	if less(a[46], a[42]) {
+		a[42], a[46] = a[46], a[42]
+	}
+	if less(a[47], a[43]) {
+		a[43], a[47] = a[47], a[43]
+	}
+	if less(a[46], a[43]) {
+		a[43], a[46] = a[46], a[43]
+	}
+	if less(a[44], a[42]) {
+		a[42], a[44] = a[44], a[42]
+	}
+	if less(a[45], a[43]) {
+		a[43], a[45] = a[45], a[43]
+	}
+	if less(a[44], a[43]) {
+		a[43], a[44] = a[44], a[43]
+	}
+	if less(a[40], a[32]) {
+		a[32], a[40] = a[40], a[32]
+	}
+	if less(a[41], a[33]) {
+		a[33], a[41] = a[41], a[33]
+	}
+	if less(a[40], a[33]) {
+		a[33], a[40] = a[40], a[33]
+	}
+	if less(a[42], a[34]) {
+		a[34], a[42] = a[42], a[34]
+	}
+	if less(a[43], a[35]) {
+		a[35], a[43] = a[43], a[35]
+	}
+	if less(a[42], a[35]) {
+		a[35], a[42] = a[42], a[35]
+	}
+	if less(a[40], a[34]) {
+		a[34], a[40] = a[40], a[34]
+	}
+	if less(a[41], a[35]) {
+		a[35], a[41] = a[41], a[35]
+	}
+	if less(a[40], a[35]) {
+		a[35], a[40] = a[40], a[35]
+	}
+	if less(a[44], a[36]) {
+		a[36], a[44] = a[44], a[36]
+	}
+	if less(a[45], a[37]) {
+		a[37], a[45] = a[45], a[37]
+	}
+	if less(a[44], a[37]) {
+		a[37], a[44] = a[44], a[37]
+	}
+	if less(a[46], a[38]) {
+		a[38], a[46] = a[46], a[38]
+	}
+	if less(a[47], a[39]) {
+		a[39], a[47] = a[47], a[39]
+	}
+	if less(a[46], a[39]) {
+		a[39], a[46] = a[46], a[39]
+	}
+	if less(a[44], a[38]) {
+		a[38], a[44] = a[44], a[38]
+	}
+	if less(a[45], a[39]) {
+		a[39], a[45] = a[45], a[39]
+	}
+	if less(a[44], a[39]) {
+		a[39], a[44] = a[44], a[39]
+	}
+	if less(a[40], a[36]) {
+		a[36], a[40] = a[40], a[36]
+	}
+	if less(a[41], a[37]) {
+		a[37], a[41] = a[41], a[37]
+	}
+	if less(a[40], a[37]) {
+		a[37], a[40] = a[40], a[37]
+	}
+	if less(a[42], a[38]) {
+		a[38], a[42] = a[42], a[38]
+	}
+	if less(a[43], a[39]) {
+		a[39], a[43] = a[43], a[39]
+	}
+	if less(a[42], a[39]) {
+		a[39], a[42] = a[42], a[39]
+	}
+	if less(a[40], a[38]) {
+		a[38], a[40] = a[40], a[38]
+	}
+	if less(a[41], a[39]) {
+		a[39], a[41] = a[41], a[39]
+	}
+	if less(a[40], a[39]) {
+		a[39], a[40] = a[40], a[39]
+	}
+	if less(a[49], a[48]) {
+		a[48], a[49] = a[49], a[48]
+	}
+	if less(a[51], a[50]) {
+		a[50], a[51] = a[51], a[50]
+	}
+	if less(a[50], a[48]) {
+		a[48], a[50] = a[50], a[48]
+	}
+	if less(a[51], a[49]) {
+		a[49], a[51] = a[51], a[49]
+	}
+	if less(a[50], a[49]) {
+		a[49], a[50] = a[50], a[49]
+	}
+	if less(a[53], a[52]) {
+		a[52], a[53] = a[53], a[52]
+	}
+	if less(a[55], a[54]) {
+		a[54], a[55] = a[55], a[54]
+	}
+	if less(a[54], a[52]) {
+		a[52], a[54] = a[54], a[52]
+	}
+	if less(a[55], a[53]) {
+		a[53], a[55] = a[55], a[53]
+	}
+	if less(a[54], a[53]) {
+		a[53], a[54] = a[54], a[53]
+	}
+	if less(a[52], a[48]) {
+		a[48], a[52] = a[52], a[48]
+	}
+	if less(a[53], a[49]) {
+		a[49], a[53] = a[53], a[49]
+	}
+	if less(a[52], a[49]) {
+		a[49], a[52] = a[52], a[49]
+	}
+	if less(a[54], a[50]) {
+		a[50], a[54] = a[54], a[50]
+	}
+	if less(a[55], a[51]) {
+		a[51], a[55] = a[55], a[51]
+	}
+	if less(a[54], a[51]) {
+		a[51], a[54] = a[54], a[51]
+	}
+	if less(a[52], a[50]) {
+		a[50], a[52] = a[52], a[50]
+	}
+	if less(a[53], a[51]) {
+		a[51], a[53] = a[53], a[51]
+	}
+	if less(a[52], a[51]) {
+		a[51], a[52] = a[52], a[51]
+	}
+	if less(a[57], a[56]) {
+		a[56], a[57] = a[57], a[56]
+	}
+	if less(a[59], a[58]) {
+		a[58], a[59] = a[59], a[58]
+	}
+	if less(a[58], a[56]) {
+		a[56], a[58] = a[58], a[56]
+	}
+	if less(a[59], a[57]) {
+		a[57], a[59] = a[59], a[57]
+	}
+	if less(a[58], a[57]) {
+		a[57], a[58] = a[58], a[57]
+	}
+	if less(a[61], a[60]) {
+		a[60], a[61] = a[61], a[60]
+	}
+	if less(a[63], a[62]) {
+		a[62], a[63] = a[63], a[62]
+	}
+	if less(a[62], a[60]) {
+		a[60], a[62] = a[62], a[60]
+	}
+	if less(a[63], a[61]) {
+		a[61], a[63] = a[63], a[61]
+	}
+	if less(a[62], a[61]) {
+		a[61], a[62] = a[62], a[61]
+	}
+	if less(a[60], a[56]) {
+		a[56], a[60] = a[60], a[56]
+	}
+	if less(a[61], a[57]) {
+		a[57], a[61] = a[61], a[57]
+	}
+	if less(a[60], a[57]) {
+		a[57], a[60] = a[60], a[57]
+	}
+	if less(a[62], a[58]) {
+		a[58], a[62] = a[62], a[58]
+	}
+	if less(a[63], a[59]) {
+		a[59], a[63] = a[63], a[59]
+	}
+	if less(a[62], a[59]) {
+		a[59], a[62] = a[62], a[59]
+	}
+	if less(a[60], a[58]) {
+		a[58], a[60] = a[60], a[58]
+	}
+	if less(a[61], a[59]) {
+		a[59], a[61] = a[61], a[59]
+	}
+	if less(a[60], a[59]) {
+		a[59], a[60] = a[60], a[59]
+	}
+	if less(a[56], a[48]) {
+		a[48], a[56] = a[56], a[48]
+	}
+	if less(a[57], a[49]) {
+		a[49], a[57] = a[57], a[49]
+	}
+	if less(a[56], a[49]) {
+		a[49], a[56] = a[56], a[49]
+	}
+	if less(a[58], a[50]) {
+		a[50], a[58] = a[58], a[50]
+	}
+	if less(a[59], a[51]) {
+		a[51], a[59] = a[59], a[51]
+	}
+	if less(a[58], a[51]) {
+		a[51], a[58] = a[58], a[51]
+	}
+	if less(a[56], a[50]) {
+		a[50], a[56] = a[56], a[50]
+	}
+	if less(a[57], a[51]) {
+		a[51], a[57] = a[57], a[51]
+	}
+	if less(a[56], a[51]) {
+		a[51], a[56] = a[56], a[51]
+	}
+	if less(a[60], a[52]) {
+		a[52], a[60] = a[60], a[52]
+	}
+	if less(a[61], a[53]) {
+		a[53], a[61] = a[61], a[53]
+	}
+	if less(a[60], a[53]) {
+		a[53], a[60] = a[60], a[53]
+	}
+	if less(a[62], a[54]) {
+		a[54], a[62] = a[62], a[54]
+	}
+	if less(a[63], a[55]) {
+		a[55], a[63] = a[63], a[55]
+	}
+	if less(a[62], a[55]) {
+		a[55], a[62] = a[62], a[55]
+	}
+	if less(a[60], a[54]) {
+		a[54], a[60] = a[60], a[54]
+	}
+	if less(a[61], a[55]) {
+		a[55], a[61] = a[61], a[55]
+	}
+	if less(a[60], a[55]) {
+		a[55], a[60] = a[60], a[55]
+	}
+	if less(a[56], a[52]) {
+		a[52], a[56] = a[56], a[52]
+	}
+	if less(a[57], a[53]) {
+		a[53], a[57] = a[57], a[53]
+	}
+	if less(a[56], a[53]) {
+		a[53], a[56] = a[56], a[53]
+	}
+	if less(a[58], a[54]) {
+		a[54], a[58] = a[58], a[54]
+	}
+	if less(a[59], a[55]) {
+		a[55], a[59] = a[59], a[55]
+	}
+	if less(a[58], a[55]) {
+		a[55], a[58] = a[58], a[55]
+	}
+	if less(a[56], a[54]) {
+		a[54], a[56] = a[56], a[54]
+	}
+	if less(a[57], a[55]) {
+		a[55], a[57] = a[57], a[55]
+	}
+	if less(a[56], a[55]) {
+		a[55], a[56] = a[56], a[55]
+	}
+	if less(a[48], a[32]) {
+		a[32], a[48] = a[48], a[32]
+	}
+	if less(a[49], a[33]) {
+		a[33], a[49] = a[49], a[33]
+	}
+	if less(a[48], a[33]) {
+		a[33], a[48] = a[48], a[33]
+	}
+	if less(a[50], a[34]) {
+		a[34], a[50] = a[50], a[34]
+	}
+	if less(a[51], a[35]) {
+		a[35], a[51] = a[51], a[35]
+	}
+	if less(a[50], a[35]) {
+		a[35], a[50] = a[50], a[35]
+	}
+	if less(a[48], a[34]) {
+		a[34], a[48] = a[48], a[34]
+	}
+	if less(a[49], a[35]) {
+		a[35], a[49] = a[49], a[35]
+	}
+	if less(a[48], a[35]) {
+		a[35], a[48] = a[48], a[35]
+	}
+	if less(a[52], a[36]) {
+		a[36], a[52] = a[52], a[36]
+	}
+	if less(a[53], a[37]) {
+		a[37], a[53] = a[53], a[37]
+	}
+	if less(a[52], a[37]) {
+		a[37], a[52] = a[52], a[37]
+	}
+	if less(a[54], a[38]) {
+		a[38], a[54] = a[54], a[38]
+	}
+	if less(a[55], a[39]) {
+		a[39], a[55] = a[55], a[39]
+	}
+	if less(a[54], a[39]) {
+		a[39], a[54] = a[54], a[39]
+	}
+	if less(a[52], a[38]) {
+		a[38], a[52] = a[52], a[38]
+	}
+	if less(a[53], a[39]) {
+		a[39], a[53] = a[53], a[39]
+	}
+	if less(a[52], a[39]) {
+		a[39], a[52] = a[52], a[39]
+	}
+	if less(a[48], a[36]) {
+		a[36], a[48] = a[48], a[36]
+	}
+	if less(a[49], a[37]) {
+		a[37], a[49] = a[49], a[37]
+	}
+	if less(a[48], a[37]) {
+		a[37], a[48] = a[48], a[37]
+	}
+	if less(a[50], a[38]) {
+		a[38], a[50] = a[50], a[38]
+	}
+	if less(a[51], a[39]) {
+		a[39], a[51] = a[51], a[39]
+	}
+	if less(a[50], a[39]) {
+		a[39], a[50] = a[50], a[39]
+	}
+	if less(a[48], a[38]) {
+		a[38], a[48] = a[48], a[38]
+	}
+	if less(a[49], a[39]) {
+		a[39], a[49] = a[49], a[39]
+	}
+	if less(a[48], a[39]) {
+		a[39], a[48] = a[48], a[39]
+	}
+	if less(a[56], a[40]) {
+		a[40], a[56] = a[56], a[40]
+	}
+	if less(a[57], a[41]) {
+		a[41], a[57] = a[57], a[41]
+	}
+	if less(a[56], a[41]) {
+		a[41], a[56] = a[56], a[41]
+	}
+	if less(a[58], a[42]) {
+		a[42], a[58] = a[58], a[42]
+	}
+	if less(a[59], a[43]) {
+		a[43], a[59] = a[59], a[43]
+	}
+	if less(a[58], a[43]) {
+		a[43], a[58] = a[58], a[43]
+	}
+	if less(a[56], a[42]) {
+		a[42], a[56] = a[56], a[42]
+	}
+	if less(a[57], a[43]) {
+		a[43], a[57] = a[57], a[43]
+	}
+	if less(a[56], a[43]) {
+		a[43], a[56] = a[56], a[43]
+	}
+	if less(a[60], a[44]) {
+		a[44], a[60] = a[60], a[44]
+	}
+	if less(a[61], a[45]) {
+		a[45], a[61] = a[61], a[45]
+	}
+	if less(a[60], a[45]) {
+		a[45], a[60] = a[60], a[45]
+	}
+	if less(a[62], a[46]) {
+		a[46], a[62] = a[62], a[46]
+	}
+	if less(a[63], a[47]) {
+		a[47], a[63] = a[63], a[47]
+	}
+	if less(a[62], a[47]) {
+		a[47], a[62] = a[62], a[47]
+	}
+	if less(a[60], a[46]) {
+		a[46], a[60] = a[60], a[46]
+	}
+	if less(a[61], a[47]) {
+		a[47], a[61] = a[61], a[47]
+	}
+	if less(a[60], a[47]) {
+		a[47], a[60] = a[60], a[47]
+	}
+	if less(a[56], a[44]) {
+		a[44], a[56] = a[56], a[44]
+	}
+	if less(a[57], a[45]) {
+		a[45], a[57] = a[57], a[45]
+	}
+	if less(a[56], a[45]) {
+		a[45], a[56] = a[56], a[45]
+	}
+	if less(a[58], a[46]) {
+		a[46], a[58] = a[58], a[46]
+	}
+	if less(a[59], a[47]) {
+		a[47], a[59] = a[59], a[47]
+	}
+	if less(a[58], a[47]) {
+		a[47], a[58] = a[58], a[47]
+	}
+	if less(a[56], a[46]) {
+		a[46], a[56] = a[56], a[46]
+	}
+	if less(a[57], a[47]) {
+		a[47], a[57] = a[57], a[47]
+	}
+	if less(a[56], a[47]) {
+		a[47], a[56] = a[56], a[47]
+	}
+	if less(a[48], a[40]) {
+		a[40], a[48] = a[48], a[40]
+	}
+	if less(a[49], a[41]) {
+		a[41], a[49] = a[49], a[41]
+	}
+	if less(a[48], a[41]) {
+		a[41], a[48] = a[48], a[41]
+	}
+	if less(a[50], a[42]) {
+		a[42], a[50] = a[50], a[42]
+	}
+	if less(a[51], a[43]) {
+		a[43], a[51] = a[51], a[43]
+	}
+	if less(a[50], a[43]) {
+		a[43], a[50] = a[50], a[43]
+	}
+	if less(a[48], a[42]) {
+		a[42], a[48] = a[48], a[42]
+	}
+	if less(a[49], a[43]) {
+		a[43], a[49] = a[49], a[43]
+	}
+	if less(a[48], a[43]) {
+		a[43], a[48] = a[48], a[43]
+	}
+	if less(a[52], a[44]) {
+		a[44], a[52] = a[52], a[44]
+	}
+	if less(a[53], a[45]) {
+		a[45], a[53] = a[53], a[45]
+	}
+	if less(a[52], a[45]) {
+		a[45], a[52] = a[52], a[45]
+	}
+	if less(a[54], a[46]) {
+		a[46], a[54] = a[54], a[46]
+	}
+	if less(a[55], a[47]) {
+		a[47], a[55] = a[55], a[47]
+	}
+	if less(a[54], a[47]) {
+		a[47], a[54] = a[54], a[47]
+	}
+	if less(a[52], a[46]) {
+		a[46], a[52] = a[52], a[46]
+	}
+	if less(a[53], a[47]) {
+		a[47], a[53] = a[53], a[47]
+	}
+	if less(a[52], a[47]) {
+		a[47], a[52] = a[52], a[47]
+	}
+	if less(a[48], a[44]) {
+		a[44], a[48] = a[48], a[44]
+	}
+	if less(a[49], a[45]) {
+		a[45], a[49] = a[49], a[45]
+	}
+	if less(a[48], a[45]) {
+		a[45], a[48] = a[48], a[45]
+	}
+	if less(a[50], a[46]) {
+		a[46], a[50] = a[50], a[46]
+	}
+	if less(a[51], a[47]) {
+		a[47], a[51] = a[51], a[47]
+	}
+	if less(a[50], a[47]) {
+		a[47], a[50] = a[50], a[47]
+	}
+	if less(a[48], a[46]) {
+		a[46], a[48] = a[48], a[46]
+	}
+	if less(a[49], a[47]) {
+		a[47], a[49] = a[49], a[47]
+	}
+	if less(a[48], a[47]) {
+		a[47], a[48] = a[48], a[47]
+	}
+	if less(a[32], a[0]) {
+		a[0], a[32] = a[32], a[0]
+	}
+	if less(a[33], a[1]) {
+		a[1], a[33] = a[33], a[1]
+	}
+	if less(a[32], a[1]) {
+		a[1], a[32] = a[32], a[1]
+	}
+	if less(a[34], a[2]) {
+		a[2], a[34] = a[34], a[2]
+	}
+	if less(a[35], a[3]) {
+		a[3], a[35] = a[35], a[3]
+	}
+	if less(a[34], a[3]) {
+		a[3], a[34] = a[34], a[3]
+	}
+	if less(a[32], a[2]) {
+		a[2], a[32] = a[32], a[2]
+	}
+	if less(a[33], a[3]) {
+		a[3], a[33] = a[33], a[3]
+	}
+	if less(a[32], a[3]) {
+		a[3], a[32] = a[32], a[3]
+	}
+	if less(a[36], a[4]) {
+		a[4], a[36] = a[36], a[4]
+	}
+	if less(a[37], a[5]) {
+		a[5], a[37] = a[37], a[5]
+	}
+	if less(a[36], a[5]) {
+		a[5], a[36] = a[36], a[5]
+	}
+	if less(a[38], a[6]) {
+		a[6], a[38] = a[38], a[6]
+	}
+	if less(a[39], a[7]) {
+		a[7], a[39] = a[39], a[7]
+	}
+	if less(a[38], a[7]) {
+		a[7], a[38] = a[38], a[7]
+	}
+	if less(a[36], a[6]) {
+		a[6], a[36] = a[36], a[6]
+	}
+	if less(a[37], a[7]) {
+		a[7], a[37] = a[37], a[7]
+	}
+	if less(a[36], a[7]) {
+		a[7], a[36] = a[36], a[7]
+	}
+	if less(a[32], a[4]) {
+		a[4], a[32] = a[32], a[4]
+	}
+	if less(a[33], a[5]) {
+		a[5], a[33] = a[33], a[5]
+	}
+	if less(a[32], a[5]) {
+		a[5], a[32] = a[32], a[5]
+	}
+	if less(a[34], a[6]) {
+		a[6], a[34] = a[34], a[6]
+	}
+	if less(a[35], a[7]) {
+		a[7], a[35] = a[35], a[7]
+	}
+	if less(a[34], a[7]) {
+		a[7], a[34] = a[34], a[7]
+	}
+	if less(a[32], a[6]) {
+		a[6], a[32] = a[32], a[6]
+	}
+	if less(a[33], a[7]) {
+		a[7], a[33] = a[33], a[7]
+	}
+	if less(a[32], a[7]) {
+		a[7], a[32] = a[32], a[7]
+	}
+	if less(a[40], a[8]) {
+		a[8], a[40] = a[40], a[8]
+	}
+	if less(a[41], a[9]) {
+		a[9], a[41] = a[41], a[9]
+	}
+	if less(a[40], a[9]) {
+		a[9], a[40] = a[40], a[9]
+	}
+	if less(a[42], a[10]) {
+		a[10], a[42] = a[42], a[10]
+	}
+	if less(a[43], a[11]) {
+		a[11], a[43] = a[43], a[11]
+	}
+	if less(a[42], a[11]) {
+		a[11], a[42] = a[42], a[11]
+	}
+	if less(a[40], a[10]) {
+		a[10], a[40] = a[40], a[10]
+	}
+	if less(a[41], a[11]) {
+		a[11], a[41] = a[41], a[11]
+	}
+	if less(a[40], a[11]) {
+		a[11], a[40] = a[40], a[11]
+	}
+	if less(a[44], a[12]) {
+		a[12], a[44] = a[44], a[12]
+	}
+	if less(a[45], a[13]) {
+		a[13], a[45] = a[45], a[13]
+	}
+	if less(a[44], a[13]) {
+		a[13], a[44] = a[44], a[13]
+	}
+	if less(a[46], a[14]) {
+		a[14], a[46] = a[46], a[14]
+	}
+	if less(a[47], a[15]) {
+		a[15], a[47] = a[47], a[15]
+	}
+	if less(a[46], a[15]) {
+		a[15], a[46] = a[46], a[15]
+	}
+	if less(a[44], a[14]) {
+		a[14], a[44] = a[44], a[14]
+	}
+	if less(a[45], a[15]) {
+		a[15], a[45] = a[45], a[15]
+	}
+	if less(a[44], a[15]) {
+		a[15], a[44] = a[44], a[15]
+	}
+	if less(a[40], a[12]) {
+		a[12], a[40] = a[40], a[12]
+	}
+	if less(a[41], a[13]) {
+		a[13], a[41] = a[41], a[13]
+	}
+	if less(a[40], a[13]) {
+		a[13], a[40] = a[40], a[13]
+	}
+	if less(a[42], a[14]) {
+		a[14], a[42] = a[42], a[14]
+	}
+	if less(a[43], a[15]) {
+		a[15], a[43] = a[43], a[15]
+	}
+	if less(a[42], a[15]) {
+		a[15], a[42] = a[42], a[15]
+	}
+	if less(a[40], a[14]) {
+		a[14], a[40] = a[40], a[14]
+	}
+	if less(a[41], a[15]) {
+		a[15], a[41] = a[41], a[15]
+	}
+	if less(a[40], a[15]) {
+		a[15], a[40] = a[40], a[15]
+	}
+	if less(a[32], a[8]) {
+		a[8], a[32] = a[32], a[8]
+	}
+	if less(a[33], a[9]) {
+		a[9], a[33] = a[33], a[9]
+	}
+	if less(a[32], a[9]) {
+		a[9], a[32] = a[32], a[9]
+	}
+	if less(a[34], a[10]) {
+		a[10], a[34] = a[34], a[10]
+	}
+	if less(a[35], a[11]) {
+		a[11], a[35] = a[35], a[11]
+	}
+	if less(a[34], a[11]) {
+		a[11], a[34] = a[34], a[11]
+	}
+	if less(a[32], a[10]) {
+		a[10], a[32] = a[32], a[10]
+	}
+	if less(a[33], a[11]) {
+		a[11], a[33] = a[33], a[11]
+	}
+	if less(a[32], a[11]) {
+		a[11], a[32] = a[32], a[11]
+	}
+	if less(a[36], a[12]) {
+		a[12], a[36] = a[36], a[12]
+	}
+	if less(a[37], a[13]) {
+		a[13], a[37] = a[37], a[13]
+	}
+	if less(a[36], a[13]) {
+		a[13], a[36] = a[36], a[13]
+	}
+	if less(a[38], a[14]) {
+		a[14], a[38] = a[38], a[14]
+	}
+	if less(a[39], a[15]) {
+		a[15], a[39] = a[39], a[15]
+	}
+	if less(a[38], a[15]) {
+		a[15], a[38] = a[38], a[15]
+	}
+	if less(a[36], a[14]) {
+		a[14], a[36] = a[36], a[14]
+	}
+	if less(a[37], a[15]) {
+		a[15], a[37] = a[37], a[15]
+	}
+	if less(a[36], a[15]) {
+		a[15], a[36] = a[36], a[15]
+	}
+	if less(a[32], a[12]) {
+		a[12], a[32] = a[32], a[12]
+	}
+	if less(a[33], a[13]) {
+		a[13], a[33] = a[33], a[13]
+	}
+	if less(a[32], a[13]) {
+		a[13], a[32] = a[32], a[13]
+	}
+	if less(a[34], a[14]) {
+		a[14], a[34] = a[34], a[14]
+	}
+	if less(a[35], a[15]) {
+		a[15], a[35] = a[35], a[15]
+	}
+	if less(a[34], a[15]) {
+		a[15], a[34] = a[34], a[15]
+	}
+	if less(a[32], a[14]) {
+		a[14], a[32] = a[32], a[14]
+	}
+	if less(a[33], a[15]) {
+		a[15], a[33] = a[33], a[15]
+	}
+	if less(a[32], a[15]) {
+		a[15], a[32] = a[32], a[15]
+	}
+	if less(a[48], a[16]) {
+		a[16], a[48] = a[48], a[16]
+	}
+	if less(a[49], a[17]) {
+		a[17], a[49] = a[49], a[17]
+	}
+	if less(a[48], a[17]) {
+		a[17], a[48] = a[48], a[17]
+	}
+	if less(a[50], a[18]) {
+		a[18], a[50] = a[50], a[18]
+	}
+	if less(a[51], a[19]) {
+		a[19], a[51] = a[51], a[19]
+	}
+	if less(a[50], a[19]) {
+		a[19], a[50] = a[50], a[19]
+	}
+	if less(a[48], a[18]) {
+		a[18], a[48] = a[48], a[18]
+	}
+	if less(a[49], a[19]) {
+		a[19], a[49] = a[49], a[19]
+	}
+	if less(a[48], a[19]) {
+		a[19], a[48] = a[48], a[19]
+	}
+	if less(a[52], a[20]) {
+		a[20], a[52] = a[52], a[20]
+	}
+	if less(a[53], a[21]) {
+		a[21], a[53] = a[53], a[21]
+	}
+	if less(a[52], a[21]) {
+		a[21], a[52] = a[52], a[21]
+	}
+	if less(a[54], a[22]) {
+		a[22], a[54] = a[54], a[22]
+	}
+	if less(a[55], a[23]) {
+		a[23], a[55] = a[55], a[23]
+	}
+	if less(a[54], a[23]) {
+		a[23], a[54] = a[54], a[23]
+	}
+	if less(a[52], a[22]) {
+		a[22], a[52] = a[52], a[22]
+	}
+	if less(a[53], a[23]) {
+		a[23], a[53] = a[53], a[23]
+	}
+	if less(a[52], a[23]) {
+		a[23], a[52] = a[52], a[23]
+	}
+	if less(a[48], a[20]) {
+		a[20], a[48] = a[48], a[20]
+	}
+	if less(a[49], a[21]) {
+		a[21], a[49] = a[49], a[21]
+	}
+	if less(a[48], a[21]) {
+		a[21], a[48] = a[48], a[21]
+	}
+	if less(a[50], a[22]) {
+		a[22], a[50] = a[50], a[22]
+	}
+	if less(a[51], a[23]) {
+		a[23], a[51] = a[51], a[23]
+	}
+	if less(a[50], a[23]) {
+		a[23], a[50] = a[50], a[23]
+	}
+	if less(a[48], a[22]) {
+		a[22], a[48] = a[48], a[22]
+	}
+	if less(a[49], a[23]) {
+		a[23], a[49] = a[49], a[23]
+	}
+	if less(a[48], a[23]) {
+		a[23], a[48] = a[48], a[23]
+	}
+	if less(a[56], a[24]) {
+		a[24], a[56] = a[56], a[24]
+	}
+	if less(a[57], a[25]) {
+		a[25], a[57] = a[57], a[25]
+	}
+	if less(a[56], a[25]) {
+		a[25], a[56] = a[56], a[25]
+	}
+	if less(a[58], a[26]) {
+		a[26], a[58] = a[58], a[26]
+	}
+	if less(a[59], a[27]) {
+		a[27], a[59] = a[59], a[27]
+	}
+	if less(a[58], a[27]) {
+		a[27], a[58] = a[58], a[27]
+	}
+	if less(a[56], a[26]) {
+		a[26], a[56] = a[56], a[26]
+	}
+	if less(a[57], a[27]) {
+		a[27], a[57] = a[57], a[27]
+	}
+	if less(a[56], a[27]) {
+		a[27], a[56] = a[56], a[27]
+	}
+	if less(a[60], a[28]) {
+		a[28], a[60] = a[60], a[28]
+	}
+	if less(a[61], a[29]) {
+		a[29], a[61] = a[61], a[29]
+	}
+	if less(a[60], a[29]) {
+		a[29], a[60] = a[60], a[29]
+	}
+	if less(a[62], a[30]) {
+		a[30], a[62] = a[62], a[30]
+	}
+	if less(a[63], a[31]) {
+		a[31], a[63] = a[63], a[31]
+	}
+	if less(a[62], a[31]) {
+		a[31], a[62] = a[62], a[31]
+	}
+	if less(a[60], a[30]) {
+		a[30], a[60] = a[60], a[30]
+	}
+	if less(a[61], a[31]) {
+		a[31], a[61] = a[61], a[31]
+	}
+	if less(a[60], a[31]) {
+		a[31], a[60] = a[60], a[31]
+	}
+	if less(a[56], a[28]) {
+		a[28], a[56] = a[56], a[28]
+	}
+	if less(a[57], a[29]) {
+		a[29], a[57] = a[57], a[29]
+	}
+	if less(a[56], a[29]) {
+		a[29], a[56] = a[56], a[29]
+	}
+	if less(a[58], a[30]) {
+		a[30], a[58] = a[58], a[30]
+	}
+	if less(a[59], a[31]) {
+		a[31], a[59] = a[59], a[31]
+	}
+	if less(a[58], a[31]) {
+		a[31], a[58] = a[58], a[31]
+	}
+	if less(a[56], a[30]) {
+		a[30], a[56] = a[56], a[30]
+	}
+	if less(a[57], a[31]) {
+		a[31], a[57] = a[57], a[31]
+	}
+	if less(a[56], a[31]) {
+		a[31], a[56] = a[56], a[31]
+	}
+	if less(a[48], a[24]) {
+		a[24], a[48] = a[48], a[24]
+	}
+	if less(a[49], a[25]) {
+		a[25], a[49] = a[49], a[25]
+	}
+	if less(a[48], a[25]) {
+		a[25], a[48] = a[48], a[25]
+	}
+	if less(a[50], a[26]) {
+		a[26], a[50] = a[50], a[26]
+	}
+	if less(a[51], a[27]) {
+		a[27], a[51] = a[51], a[27]
+	}
+	if less(a[50], a[27]) {
+		a[27], a[50] = a[50], a[27]
+	}
+	if less(a[48], a[26]) {
+		a[26], a[48] = a[48], a[26]
+	}
+	if less(a[49], a[27]) {
+		a[27], a[49] = a[49], a[27]
+	}
+	if less(a[48], a[27]) {
+		a[27], a[48] = a[48], a[27]
+	}
+	if less(a[52], a[28]) {
+		a[28], a[52] = a[52], a[28]
+	}
+	if less(a[53], a[29]) {
+		a[29], a[53] = a[53], a[29]
+	}
+	if less(a[52], a[29]) {
+		a[29], a[52] = a[52], a[29]
+	}
+	if less(a[54], a[30]) {
+		a[30], a[54] = a[54], a[30]
+	}
+	if less(a[55], a[31]) {
+		a[31], a[55] = a[55], a[31]
+	}
+	if less(a[54], a[31]) {
+		a[31], a[54] = a[54], a[31]
+	}
+	if less(a[52], a[30]) {
+		a[30], a[52] = a[52], a[30]
+	}
+	if less(a[53], a[31]) {
+		a[31], a[53] = a[53], a[31]
+	}
+	if less(a[52], a[31]) {
+		a[31], a[52] = a[52], a[31]
+	}
+	if less(a[48], a[28]) {
+		a[28], a[48] = a[48], a[28]
+	}
+	if less(a[49], a[29]) {
+		a[29], a[49] = a[49], a[29]
+	}
+	if less(a[48], a[29]) {
+		a[29], a[48] = a[48], a[29]
+	}
+	if less(a[50], a[30]) {
+		a[30], a[50] = a[50], a[30]
+	}
+	if less(a[51], a[31]) {
+		a[31], a[51] = a[51], a[31]
+	}
+	if less(a[50], a[31]) {
+		a[31], a[50] = a[50], a[31]
+	}
+	if less(a[48], a[30]) {
+		a[30], a[48] = a[48], a[30]
+	}
+	if less(a[49], a[31]) {
+		a[31], a[49] = a[49], a[31]
+	}
+	if less(a[48], a[31]) {
+		a[31], a[48] = a[48], a[31]
+	}
+	if less(a[32], a[16]) {
+		a[16], a[32] = a[32], a[16]
+	}
+	if less(a[33], a[17]) {
+		a[17], a[33] = a[33], a[17]
+	}
+	if less(a[32], a[17]) {
+		a[17], a[32] = a[32], a[17]
+	}
+	if less(a[34], a[18]) {
+		a[18], a[34] = a[34], a[18]
+	}
+	if less(a[35], a[19]) {
+		a[19], a[35] = a[35], a[19]
+	}
+	if less(a[34], a[19]) {
+		a[19], a[34] = a[34], a[19]
+	}
+	if less(a[32], a[18]) {
+		a[18], a[32] = a[32], a[18]
+	}
+	if less(a[33], a[19]) {
+		a[19], a[33] = a[33], a[19]
+	}
+	if less(a[32], a[19]) {
+		a[19], a[32] = a[32], a[19]
+	}
+	if less(a[36], a[20]) {
+		a[20], a[36] = a[36], a[20]
+	}
+	if less(a[37], a[21]) {
+		a[21], a[37] = a[37], a[21]
+	}
+	if less(a[36], a[21]) {
+		a[21], a[36] = a[36], a[21]
+	}
+	if less(a[38], a[22]) {
+		a[22], a[38] = a[38], a[22]
+	}
+	if less(a[39], a[23]) {
+		a[23], a[39] = a[39], a[23]
+	}
+	if less(a[38], a[23]) {
+		a[23], a[38] = a[38], a[23]
+	}
+	if less(a[36], a[22]) {
+		a[22], a[36] = a[36], a[22]
+	}
+	if less(a[37], a[23]) {
+		a[23], a[37] = a[37], a[23]
+	}
+	if less(a[36], a[23]) {
+		a[23], a[36] = a[36], a[23]
+	}
+	if less(a[32], a[20]) {
+		a[20], a[32] = a[32], a[20]
+	}
+	if less(a[33], a[21]) {
+		a[21], a[33] = a[33], a[21]
+	}
+	if less(a[32], a[21]) {
+		a[21], a[32] = a[32], a[21]
+	}
+	if less(a[34], a[22]) {
+		a[22], a[34] = a[34], a[22]
+	}
+	if less(a[35], a[23]) {
+		a[23], a[35] = a[35], a[23]
+	}
+	if less(a[34], a[23]) {
+		a[23], a[34] = a[34], a[23]
+	}
+	if less(a[32], a[22]) {
+		a[22], a[32] = a[32], a[22]
+	}
+	if less(a[33], a[23]) {
+		a[23], a[33] = a[33], a[23]
+	}
+	if less(a[32], a[23]) {
+		a[23], a[32] = a[32], a[23]
+	}
+	if less(a[40], a[24]) {
+		a[24], a[40] = a[40], a[24]
+	}
+	if less(a[41], a[25]) {
+		a[25], a[41] = a[41], a[25]
+	}
+	if less(a[40], a[25]) {
+		a[25], a[40] = a[40], a[25]
+	}
+	if less(a[42], a[26]) {
+		a[26], a[42] = a[42], a[26]
+	}
+	if less(a[43], a[27]) {
+		a[27], a[43] = a[43], a[27]
+	}
+	if less(a[42], a[27]) {
+		a[27], a[42] = a[42], a[27]
+	}
+	if less(a[40], a[26]) {
+		a[26], a[40] = a[40], a[26]
+	}
+	if less(a[41], a[27]) {
+		a[27], a[41] = a[41], a[27]
+	}
+	if less(a[40], a[27]) {
+		a[27], a[40] = a[40], a[27]
+	}
+	if less(a[44], a[28]) {
+		a[28], a[44] = a[44], a[28]
+	}
+	if less(a[45], a[29]) {
+		a[29], a[45] = a[45], a[29]
+	}
+	if less(a[44], a[29]) {
+		a[29], a[44] = a[44], a[29]
+	}
+	if less(a[46], a[30]) {
+		a[30], a[46] = a[46], a[30]
+	}
+	if less(a[47], a[31]) {
+		a[31], a[47] = a[47], a[31]
+	}
+	if less(a[46], a[31]) {
+		a[31], a[46] = a[46], a[31]
+	}
+	if less(a[44], a[30]) {
+		a[30], a[44] = a[44], a[30]
+	}
+	if less(a[45], a[31]) {
+		a[31], a[45] = a[45], a[31]
+	}
+	if less(a[44], a[31]) {
+		a[31], a[44] = a[44], a[31]
+	}
+	if less(a[40], a[28]) {
+		a[28], a[40] = a[40], a[28]
+	}
+	if less(a[41], a[29]) {
+		a[29], a[41] = a[41], a[29]
+	}
+	if less(a[40], a[29]) {
+		a[29], a[40] = a[40], a[29]
+	}
+	if less(a[42], a[30]) {
+		a[30], a[42] = a[42], a[30]
+	}
+	if less(a[43], a[31]) {
+		a[31], a[43] = a[43], a[31]
+	}
+	if less(a[42], a[31]) {
+		a[31], a[42] = a[42], a[31]
+	}
+	if less(a[40], a[30]) {
+		a[30], a[40] = a[40], a[30]
+	}
+	if less(a[41], a[31]) {
+		a[31], a[41] = a[41], a[31]
+	}
+	if less(a[40], a[31]) {
+		a[31], a[40] = a[40], a[31]
+	}
+	if less(a[32], a[24]) {
+		a[24], a[32] = a[32], a[24]
+	}
+	if less(a[33], a[25]) {
+		a[25], a[33] = a[33], a[25]
+	}
+	if less(a[32], a[25]) {
+		a[25], a[32] = a[32], a[25]
+	}
+	if less(a[34], a[26]) {
+		a[26], a[34] = a[34], a[26]
+	}
+	if less(a[35], a[27]) {
+		a[27], a[35] = a[35], a[27]
+	}
+	if less(a[34], a[27]) {
+		a[27], a[34] = a[34], a[27]
+	}
+	if less(a[32], a[26]) {
+		a[26], a[32] = a[32], a[26]
+	}
+	if less(a[33], a[27]) {
+		a[27], a[33] = a[33], a[27]
+	}
+	if less(a[32], a[27]) {
+		a[27], a[32] = a[32], a[27]
+	}
+	if less(a[36], a[28]) {
+		a[28], a[36] = a[36], a[28]
+	}
+	if less(a[37], a[29]) {
+		a[29], a[37] = a[37], a[29]
+	}
+	if less(a[36], a[29]) {
+		a[29], a[36] = a[36], a[29]
+	}
+	if less(a[38], a[30]) {
+		a[30], a[38] = a[38], a[30]
+	}
+	if less(a[39], a[31]) {
+		a[31], a[39] = a[39], a[31]
+	}
+	if less(a[38], a[31]) {
+		a[31], a[38] = a[38], a[31]
+	}
+	if less(a[36], a[30]) {
+		a[30], a[36] = a[36], a[30]
+	}
+	if less(a[37], a[31]) {
+		a[31], a[37] = a[37], a[31]
+	}
+	if less(a[36], a[31]) {
+		a[31], a[36] = a[36], a[31]
+	}
+	if less(a[32], a[28]) {
+		a[28], a[32] = a[32], a[28]
+	}
+	if less(a[33], a[29]) {
+		a[29], a[33] = a[33], a[29]
+	}
+	if less(a[32], a[29]) {
+		a[29], a[32] = a[32], a[29]
+	}
+	if less(a[34], a[30]) {
+		a[30], a[34] = a[34], a[30]
+	}
+	if less(a[35], a[31]) {
+		a[31], a[35] = a[35], a[31]
+	}
+	if less(a[34], a[31]) {
+		a[31], a[34] = a[34], a[31]
+	}
+	if less(a[32], a[30]) {
+		a[30], a[32] = a[32], a[30]
+	}
+	if less(a[33], a[31]) {
+		a[31], a[33] = a[33], a[31]
+	}
+	if less(a[32], a[31]) {
+		a[31], a[32] = a[32], a[31]
+	}
+}
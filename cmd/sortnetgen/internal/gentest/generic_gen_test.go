@@ -0,0 +1,348 @@
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
+
+package gentest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/shabbyrobe/sortnet/sortnettest"
+)
+
+// testGenericGenCase is a function generated by sortnetgen, adapted to be checked by
+// sortnettest. Either sort or merge is set.
+type testGenericGenCase[T any] struct {
+	name  string
+	size  int
+	sort  func(a []T)
+	merge func(dst []T, runs [][]T) []T
+}
+
+// testGenericGenCasesNetworkSort returns the functions generated for int
+// in increasing order, along with how to make random items and how they are ordered.
+func testGenericGenCasesNetworkSort() (gen func(*rand.Rand) int, before func(a, b int) bool, cases []testGenericGenCase[int]) {
+	gen = func(rng *rand.Rand) int { return rng.Intn(32) - 16 }
+	less := func(a, b int) bool { return a < b }
+	before = less
+	cases = []testGenericGenCase[int]{
+		{name: "NetworkSort2", size: 2, sort: func(a []int) { NetworkSort2(a) }},
+		{name: "NetworkSort3", size: 3, sort: func(a []int) { NetworkSort3(a) }},
+		{name: "NetworkSort4", size: 4, sort: func(a []int) { NetworkSort4(a) }},
+		{name: "NetworkSort5", size: 5, sort: func(a []int) { NetworkSort5(a) }},
+		{name: "NetworkSort6", size: 6, sort: func(a []int) { NetworkSort6(a) }},
+		{name: "NetworkSort7", size: 7, sort: func(a []int) { NetworkSort7(a) }},
+		{name: "NetworkSort8", size: 8, sort: func(a []int) { NetworkSort8(a) }},
+		{name: "NetworkSort9", size: 9, sort: func(a []int) { NetworkSort9(a) }},
+		{name: "NetworkSort10", size: 10, sort: func(a []int) { NetworkSort10(a) }},
+		{name: "NetworkSort11", size: 11, sort: func(a []int) { NetworkSort11(a) }},
+		{name: "NetworkSort12", size: 12, sort: func(a []int) { NetworkSort12(a) }},
+		{name: "NetworkSort13", size: 13, sort: func(a []int) { NetworkSort13(a) }},
+		{name: "NetworkSort14", size: 14, sort: func(a []int) { NetworkSort14(a) }},
+		{name: "NetworkSort15", size: 15, sort: func(a []int) { NetworkSort15(a) }},
+		{name: "NetworkSort16", size: 16, sort: func(a []int) { NetworkSort16(a) }},
+		{name: "NetworkSort24", size: 24, sort: func(a []int) { NetworkSort24(a) }},
+		{name: "NetworkSort32", size: 32, sort: func(a []int) { NetworkSort32(a) }},
+		{name: "NetworkSort48", size: 48, sort: func(a []int) { NetworkSort48(a) }},
+		{name: "NetworkSort64", size: 64, sort: func(a []int) { NetworkSort64(a) }},
+		{name: "NetworkSort-2", size: 2, sort: func(a []int) {
+			if !NetworkSort(a, len(a)) {
+				panic("NetworkSort: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSort-3", size: 3, sort: func(a []int) {
+			if !NetworkSort(a, len(a)) {
+				panic("NetworkSort: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSort-4", size: 4, sort: func(a []int) {
+			if !NetworkSort(a, len(a)) {
+				panic("NetworkSort: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSort-5", size: 5, sort: func(a []int) {
+			if !NetworkSort(a, len(a)) {
+				panic("NetworkSort: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSort-6", size: 6, sort: func(a []int) {
+			if !NetworkSort(a, len(a)) {
+				panic("NetworkSort: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSort-7", size: 7, sort: func(a []int) {
+			if !NetworkSort(a, len(a)) {
+				panic("NetworkSort: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSort-8", size: 8, sort: func(a []int) {
+			if !NetworkSort(a, len(a)) {
+				panic("NetworkSort: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSort-9", size: 9, sort: func(a []int) {
+			if !NetworkSort(a, len(a)) {
+				panic("NetworkSort: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSort-10", size: 10, sort: func(a []int) {
+			if !NetworkSort(a, len(a)) {
+				panic("NetworkSort: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSort-11", size: 11, sort: func(a []int) {
+			if !NetworkSort(a, len(a)) {
+				panic("NetworkSort: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSort-12", size: 12, sort: func(a []int) {
+			if !NetworkSort(a, len(a)) {
+				panic("NetworkSort: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSort-13", size: 13, sort: func(a []int) {
+			if !NetworkSort(a, len(a)) {
+				panic("NetworkSort: no sorter for size 13")
+			}
+		}},
+		{name: "NetworkSort-14", size: 14, sort: func(a []int) {
+			if !NetworkSort(a, len(a)) {
+				panic("NetworkSort: no sorter for size 14")
+			}
+		}},
+		{name: "NetworkSort-15", size: 15, sort: func(a []int) {
+			if !NetworkSort(a, len(a)) {
+				panic("NetworkSort: no sorter for size 15")
+			}
+		}},
+		{name: "NetworkSort-16", size: 16, sort: func(a []int) {
+			if !NetworkSort(a, len(a)) {
+				panic("NetworkSort: no sorter for size 16")
+			}
+		}},
+		{name: "NetworkSort-24", size: 24, sort: func(a []int) {
+			if !NetworkSort(a, len(a)) {
+				panic("NetworkSort: no sorter for size 24")
+			}
+		}},
+		{name: "NetworkSort-32", size: 32, sort: func(a []int) {
+			if !NetworkSort(a, len(a)) {
+				panic("NetworkSort: no sorter for size 32")
+			}
+		}},
+		{name: "NetworkSort-48", size: 48, sort: func(a []int) {
+			if !NetworkSort(a, len(a)) {
+				panic("NetworkSort: no sorter for size 48")
+			}
+		}},
+		{name: "NetworkSort-64", size: 64, sort: func(a []int) {
+			if !NetworkSort(a, len(a)) {
+				panic("NetworkSort: no sorter for size 64")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestGenericGenNetworkSort(t *testing.T) {
+	gen, before, cases := testGenericGenCasesNetworkSort()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzGenericGenNetworkSort(f *testing.F) {
+	gen, before, cases := testGenericGenCasesNetworkSort()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]int, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkGenericGenNetworkSort(b *testing.B) {
+	gen, before, cases := testGenericGenCasesNetworkSort()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testGenericGenCasesNetworkSortFunc returns the functions generated for int
+// in increasing order, along with how to make random items and how they are ordered.
+func testGenericGenCasesNetworkSortFunc() (gen func(*rand.Rand) int, before func(a, b int) bool, cases []testGenericGenCase[int]) {
+	gen = func(rng *rand.Rand) int { return rng.Intn(32) - 16 }
+	less := func(a, b int) bool { return a < b }
+	before = less
+	cases = []testGenericGenCase[int]{
+		{name: "NetworkSort2Func", size: 2, sort: func(a []int) { NetworkSort2Func(a, less) }},
+		{name: "NetworkSort3Func", size: 3, sort: func(a []int) { NetworkSort3Func(a, less) }},
+		{name: "NetworkSort4Func", size: 4, sort: func(a []int) { NetworkSort4Func(a, less) }},
+		{name: "NetworkSort5Func", size: 5, sort: func(a []int) { NetworkSort5Func(a, less) }},
+		{name: "NetworkSort6Func", size: 6, sort: func(a []int) { NetworkSort6Func(a, less) }},
+		{name: "NetworkSort7Func", size: 7, sort: func(a []int) { NetworkSort7Func(a, less) }},
+		{name: "NetworkSort8Func", size: 8, sort: func(a []int) { NetworkSort8Func(a, less) }},
+		{name: "NetworkSort9Func", size: 9, sort: func(a []int) { NetworkSort9Func(a, less) }},
+		{name: "NetworkSort10Func", size: 10, sort: func(a []int) { NetworkSort10Func(a, less) }},
+		{name: "NetworkSort11Func", size: 11, sort: func(a []int) { NetworkSort11Func(a, less) }},
+		{name: "NetworkSort12Func", size: 12, sort: func(a []int) { NetworkSort12Func(a, less) }},
+		{name: "NetworkSort13Func", size: 13, sort: func(a []int) { NetworkSort13Func(a, less) }},
+		{name: "NetworkSort14Func", size: 14, sort: func(a []int) { NetworkSort14Func(a, less) }},
+		{name: "NetworkSort15Func", size: 15, sort: func(a []int) { NetworkSort15Func(a, less) }},
+		{name: "NetworkSort16Func", size: 16, sort: func(a []int) { NetworkSort16Func(a, less) }},
+		{name: "NetworkSort24Func", size: 24, sort: func(a []int) { NetworkSort24Func(a, less) }},
+		{name: "NetworkSort32Func", size: 32, sort: func(a []int) { NetworkSort32Func(a, less) }},
+		{name: "NetworkSort48Func", size: 48, sort: func(a []int) { NetworkSort48Func(a, less) }},
+		{name: "NetworkSort64Func", size: 64, sort: func(a []int) { NetworkSort64Func(a, less) }},
+		{name: "NetworkSortFunc-2", size: 2, sort: func(a []int) {
+			if !NetworkSortFunc(a, len(a), less) {
+				panic("NetworkSortFunc: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortFunc-3", size: 3, sort: func(a []int) {
+			if !NetworkSortFunc(a, len(a), less) {
+				panic("NetworkSortFunc: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortFunc-4", size: 4, sort: func(a []int) {
+			if !NetworkSortFunc(a, len(a), less) {
+				panic("NetworkSortFunc: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortFunc-5", size: 5, sort: func(a []int) {
+			if !NetworkSortFunc(a, len(a), less) {
+				panic("NetworkSortFunc: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortFunc-6", size: 6, sort: func(a []int) {
+			if !NetworkSortFunc(a, len(a), less) {
+				panic("NetworkSortFunc: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortFunc-7", size: 7, sort: func(a []int) {
+			if !NetworkSortFunc(a, len(a), less) {
+				panic("NetworkSortFunc: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortFunc-8", size: 8, sort: func(a []int) {
+			if !NetworkSortFunc(a, len(a), less) {
+				panic("NetworkSortFunc: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortFunc-9", size: 9, sort: func(a []int) {
+			if !NetworkSortFunc(a, len(a), less) {
+				panic("NetworkSortFunc: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortFunc-10", size: 10, sort: func(a []int) {
+			if !NetworkSortFunc(a, len(a), less) {
+				panic("NetworkSortFunc: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortFunc-11", size: 11, sort: func(a []int) {
+			if !NetworkSortFunc(a, len(a), less) {
+				panic("NetworkSortFunc: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortFunc-12", size: 12, sort: func(a []int) {
+			if !NetworkSortFunc(a, len(a), less) {
+				panic("NetworkSortFunc: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortFunc-13", size: 13, sort: func(a []int) {
+			if !NetworkSortFunc(a, len(a), less) {
+				panic("NetworkSortFunc: no sorter for size 13")
+			}
+		}},
+		{name: "NetworkSortFunc-14", size: 14, sort: func(a []int) {
+			if !NetworkSortFunc(a, len(a), less) {
+				panic("NetworkSortFunc: no sorter for size 14")
+			}
+		}},
+		{name: "NetworkSortFunc-15", size: 15, sort: func(a []int) {
+			if !NetworkSortFunc(a, len(a), less) {
+				panic("NetworkSortFunc: no sorter for size 15")
+			}
+		}},
+		{name: "NetworkSortFunc-16", size: 16, sort: func(a []int) {
+			if !NetworkSortFunc(a, len(a), less) {
+				panic("NetworkSortFunc: no sorter for size 16")
+			}
+		}},
+		{name: "NetworkSortFunc-24", size: 24, sort: func(a []int) {
+			if !NetworkSortFunc(a, len(a), less) {
+				panic("NetworkSortFunc: no sorter for size 24")
+			}
+		}},
+		{name: "NetworkSortFunc-32", size: 32, sort: func(a []int) {
+			if !NetworkSortFunc(a, len(a), less) {
+				panic("NetworkSortFunc: no sorter for size 32")
+			}
+		}},
+		{name: "NetworkSortFunc-48", size: 48, sort: func(a []int) {
+			if !NetworkSortFunc(a, len(a), less) {
+				panic("NetworkSortFunc: no sorter for size 48")
+			}
+		}},
+		{name: "NetworkSortFunc-64", size: 64, sort: func(a []int) {
+			if !NetworkSortFunc(a, len(a), less) {
+				panic("NetworkSortFunc: no sorter for size 64")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestGenericGenNetworkSortFunc(t *testing.T) {
+	gen, before, cases := testGenericGenCasesNetworkSortFunc()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzGenericGenNetworkSortFunc(f *testing.F) {
+	gen, before, cases := testGenericGenCasesNetworkSortFunc()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]int, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkGenericGenNetworkSortFunc(b *testing.B) {
+	gen, before, cases := testGenericGenCasesNetworkSortFunc()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
@@ -0,0 +1,15769 @@
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
+
+package gentest
+
+// Sorting networks used in this file:
+//
+//   - Bose-Nelson, 2 inputs: 1 comparator (proven optimal), depth 1 (proven optimal)
+//     Fingerprint 9463926d4640a53a
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 3 inputs: 3 comparators (proven optimal), depth 3 (proven optimal)
+//     Fingerprint f995edafd93d50ea
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 4 inputs: 5 comparators (proven optimal), depth 3 (proven optimal)
+//     Fingerprint 0119fc1a905673cd
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 5 inputs: 9 comparators (proven optimal), depth 6 (not optimal)
+//     Fingerprint d54da66c984b8b07
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 6 inputs: 13 comparators (not optimal), depth 7 (not optimal)
+//     Fingerprint 1cc32b1cf5a405a2
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 7 inputs: 16 comparators (proven optimal), depth 7 (not optimal)
+//     Fingerprint 4c56e9c432030c54
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 8 inputs: 19 comparators (proven optimal), depth 7 (not optimal)
+//     Fingerprint 72b14580972c6d18
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Senso9, 9 inputs: 25 comparators (proven optimal), depth 8 (not optimal)
+//     Fingerprint 5463bfb95d0dca98
+//     V. K. Valsalam and R. Miikkulainen (2013)
+//     V. K. Valsalam and R. Miikkulainen, Using Symmetry and Evolutionary Search to Minimize Sorting Networks, Journal of Machine Learning Research 14, 2013
+//   - Senso10, 10 inputs: 29 comparators (proven optimal), depth 8 (not optimal)
+//     Fingerprint 9688ccf2613970b6
+//     V. K. Valsalam and R. Miikkulainen (2013)
+//     V. K. Valsalam and R. Miikkulainen, Using Symmetry and Evolutionary Search to Minimize Sorting Networks, Journal of Machine Learning Research 14, 2013
+//   - ShapiroGreen11, 11 inputs: 35 comparators (proven optimal), depth 9 (not optimal)
+//     Fingerprint a0cd3d71b97545e1
+//     G. Shapiro and M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - ShapiroGreen12, 12 inputs: 39 comparators (proven optimal), depth 9 (not optimal)
+//     Fingerprint 4520ec02bac38318
+//     G. Shapiro and M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - End13, 13 inputs: 45 comparators (best known), depth 10 (not optimal)
+//     Fingerprint 8fb515590c6989d6
+//     H. Juillé (1995)
+//     H. Juillé, Evolution of Non-Deterministic Incremental Algorithms as a New Approach for Search in State Spaces, Proceedings of the 6th International Conference on Genetic Algorithms, 1995
+//   - Green14, 14 inputs: 51 comparators (best known), depth 10 (not optimal)
+//     Fingerprint b78dfa7f24d12c0c
+//     M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - Green15, 15 inputs: 56 comparators (best known), depth 10 (not optimal)
+//     Fingerprint 360c873bdb44ac3e
+//     M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - Green16, 16 inputs: 60 comparators (best known), depth 10 (not optimal)
+//     Fingerprint 196d3d09b3f4a0e7
+//     M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - Morwenn24, 24 inputs: 123 comparators (not optimal), depth 15 (not optimal)
+//     Fingerprint a82fea0eb88f2605
+//     Morwenn
+//     https://github.com/Morwenn/cpp-sort
+//   - Bose-Nelson, 32 inputs: 211 comparators (unknown), depth 31 (unknown)
+//     Fingerprint 947ea8bcb4cc1a7d
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+
+// NetworkSortKeyed sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortKeyed(a []Keyed, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xKeyed(a)
+	case 3:
+		NetworkSort3xKeyed(a)
+	case 4:
+		NetworkSort4xKeyed(a)
+	case 5:
+		NetworkSort5xKeyed(a)
+	case 6:
+		NetworkSort6xKeyed(a)
+	case 7:
+		NetworkSort7xKeyed(a)
+	case 8:
+		NetworkSort8xKeyed(a)
+	case 9:
+		NetworkSort9xKeyed(a)
+	case 10:
+		NetworkSort10xKeyed(a)
+	case 11:
+		NetworkSort11xKeyed(a)
+	case 12:
+		NetworkSort12xKeyed(a)
+	case 13:
+		NetworkSort13xKeyed(a)
+	case 14:
+		NetworkSort14xKeyed(a)
+	case 15:
+		NetworkSort15xKeyed(a)
+	case 16:
+		NetworkSort16xKeyed(a)
+	case 24:
+		NetworkSort24xKeyed(a)
+	case 32:
+		NetworkSort32xKeyed(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortKeyedReverse sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortKeyedReverse(a []Keyed, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xKeyedReverse(a)
+	case 3:
+		NetworkSort3xKeyedReverse(a)
+	case 4:
+		NetworkSort4xKeyedReverse(a)
+	case 5:
+		NetworkSort5xKeyedReverse(a)
+	case 6:
+		NetworkSort6xKeyedReverse(a)
+	case 7:
+		NetworkSort7xKeyedReverse(a)
+	case 8:
+		NetworkSort8xKeyedReverse(a)
+	case 9:
+		NetworkSort9xKeyedReverse(a)
+	case 10:
+		NetworkSort10xKeyedReverse(a)
+	case 11:
+		NetworkSort11xKeyedReverse(a)
+	case 12:
+		NetworkSort12xKeyedReverse(a)
+	case 13:
+		NetworkSort13xKeyedReverse(a)
+	case 14:
+		NetworkSort14xKeyedReverse(a)
+	case 15:
+		NetworkSort15xKeyedReverse(a)
+	case 16:
+		NetworkSort16xKeyedReverse(a)
+	case 24:
+		NetworkSort24xKeyedReverse(a)
+	case 32:
+		NetworkSort32xKeyedReverse(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// networkLessKeyed reports whether a sorts before b, ordering by -key '-Info.Score,Info.When,Name'.
+func networkLessKeyed(a, b *Keyed) bool {
+	if a.Info.Score != b.Info.Score {
+		return a.Info.Score > b.Info.Score
+	}
+	if c := a.Info.When.Compare(b.Info.When); c != 0 {
+		return c < 0
+	}
+	if a.Name != b.Name {
+		return a.Name < b.Name
+	}
+	return false
+}
+
+func NetworkSort2xKeyed(a []Keyed) {
+	_ = a[1]
+	if networkLessKeyed(&a[1], &a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+// NetworkMerge2xKeyed merges 2 runs, each of which must already be sorted
+// in increasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge2xKeyed(dst []Keyed, r0, r1 []Keyed) []Keyed {
+	var v0, v1, vm0 Keyed
+	var wm0 int
+	var okm0 bool
+	ok0, ok1 := len(r0) > 0, len(r1) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+
+	for okm0 {
+		dst = append(dst, vm0)
+		switch wm0 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort2xKeyedReverse(a []Keyed) {
+	_ = a[1]
+	if networkLessKeyed(&a[0], &a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+// NetworkMerge2xKeyedReverse merges 2 runs, each of which must already be sorted
+// in decreasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge2xKeyedReverse(dst []Keyed, r0, r1 []Keyed) []Keyed {
+	var v0, v1, vm0 Keyed
+	var wm0 int
+	var okm0 bool
+	ok0, ok1 := len(r0) > 0, len(r1) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+
+	for okm0 {
+		dst = append(dst, vm0)
+		switch wm0 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort3xKeyed(a []Keyed) {
+	_ = a[2]
+	if networkLessKeyed(&a[2], &a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[2], &a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[1], &a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+// NetworkMerge3xKeyed merges 3 runs, each of which must already be sorted
+// in increasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge3xKeyed(dst []Keyed, r0, r1, r2 []Keyed) []Keyed {
+	var v0, v1, v2, vm0, vm1 Keyed
+	var wm0, wm1 int
+	var okm0, okm1 bool
+	ok0, ok1, ok2 := len(r0) > 0, len(r1) > 0, len(r2) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = wm0, vm0, okm0
+	if ok2 && (!okm0 || networkLessKeyed(&v2, &vm0)) {
+		wm1, vm1, okm1 = 2, v2, true
+	}
+
+	for okm1 {
+		dst = append(dst, vm1)
+		switch wm1 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm1, vm1, okm1 = wm0, vm0, okm0
+			if ok2 && (!okm0 || networkLessKeyed(&v2, &vm0)) {
+				wm1, vm1, okm1 = 2, v2, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm1, vm1, okm1 = wm0, vm0, okm0
+			if ok2 && (!okm0 || networkLessKeyed(&v2, &vm0)) {
+				wm1, vm1, okm1 = 2, v2, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = wm0, vm0, okm0
+			if ok2 && (!okm0 || networkLessKeyed(&v2, &vm0)) {
+				wm1, vm1, okm1 = 2, v2, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort3xKeyedReverse(a []Keyed) {
+	_ = a[2]
+	if networkLessKeyed(&a[1], &a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[0], &a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[0], &a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+// NetworkMerge3xKeyedReverse merges 3 runs, each of which must already be sorted
+// in decreasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge3xKeyedReverse(dst []Keyed, r0, r1, r2 []Keyed) []Keyed {
+	var v0, v1, v2, vm0, vm1 Keyed
+	var wm0, wm1 int
+	var okm0, okm1 bool
+	ok0, ok1, ok2 := len(r0) > 0, len(r1) > 0, len(r2) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = wm0, vm0, okm0
+	if ok2 && (!okm0 || networkLessKeyed(&vm0, &v2)) {
+		wm1, vm1, okm1 = 2, v2, true
+	}
+
+	for okm1 {
+		dst = append(dst, vm1)
+		switch wm1 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm1, vm1, okm1 = wm0, vm0, okm0
+			if ok2 && (!okm0 || networkLessKeyed(&vm0, &v2)) {
+				wm1, vm1, okm1 = 2, v2, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm1, vm1, okm1 = wm0, vm0, okm0
+			if ok2 && (!okm0 || networkLessKeyed(&vm0, &v2)) {
+				wm1, vm1, okm1 = 2, v2, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = wm0, vm0, okm0
+			if ok2 && (!okm0 || networkLessKeyed(&vm0, &v2)) {
+				wm1, vm1, okm1 = 2, v2, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort4xKeyed(a []Keyed) {
+	_ = a[3]
+	if networkLessKeyed(&a[1], &a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[3], &a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[2], &a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[3], &a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[2], &a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+// NetworkMerge4xKeyed merges 4 runs, each of which must already be sorted
+// in increasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge4xKeyed(dst []Keyed, r0, r1, r2, r3 []Keyed) []Keyed {
+	var v0, v1, v2, v3, vm0, vm1, vm2 Keyed
+	var wm0, wm1, wm2 int
+	var okm0, okm1, okm2 bool
+	ok0, ok1, ok2, ok3 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+		wm2, vm2, okm2 = wm1, vm1, true
+	}
+
+	for okm2 {
+		dst = append(dst, vm2)
+		switch wm2 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm2, vm2, okm2 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm2, vm2, okm2 = wm1, vm1, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm2, vm2, okm2 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm2, vm2, okm2 = wm1, vm1, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm2, vm2, okm2 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm2, vm2, okm2 = wm1, vm1, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm2, vm2, okm2 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm2, vm2, okm2 = wm1, vm1, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort4xKeyedReverse(a []Keyed) {
+	_ = a[3]
+	if networkLessKeyed(&a[0], &a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[2], &a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[0], &a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[1], &a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[1], &a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+// NetworkMerge4xKeyedReverse merges 4 runs, each of which must already be sorted
+// in decreasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge4xKeyedReverse(dst []Keyed, r0, r1, r2, r3 []Keyed) []Keyed {
+	var v0, v1, v2, v3, vm0, vm1, vm2 Keyed
+	var wm0, wm1, wm2 int
+	var okm0, okm1, okm2 bool
+	ok0, ok1, ok2, ok3 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+		wm2, vm2, okm2 = wm1, vm1, true
+	}
+
+	for okm2 {
+		dst = append(dst, vm2)
+		switch wm2 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm2, vm2, okm2 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm2, vm2, okm2 = wm1, vm1, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm2, vm2, okm2 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm2, vm2, okm2 = wm1, vm1, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm2, vm2, okm2 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm2, vm2, okm2 = wm1, vm1, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm2, vm2, okm2 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm2, vm2, okm2 = wm1, vm1, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort5xKeyed(a []Keyed) {
+	_ = a[4]
+	if networkLessKeyed(&a[1], &a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[4], &a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[4], &a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if networkLessKeyed(&a[3], &a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[3], &a[0]) {
+		a[0], a[3] = a[3], a[0]
+	}
+	if networkLessKeyed(&a[2], &a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[4], &a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if networkLessKeyed(&a[3], &a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[2], &a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+// NetworkMerge5xKeyed merges 5 runs, each of which must already be sorted
+// in increasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge5xKeyed(dst []Keyed, r0, r1, r2, r3, r4 []Keyed) []Keyed {
+	var v0, v1, v2, v3, v4, vm0, vm1, vm2, vm3 Keyed
+	var wm0, wm1, wm2, wm3 int
+	var okm0, okm1, okm2, okm3 bool
+	ok0, ok1, ok2, ok3, ok4 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+		wm2, vm2, okm2 = wm1, vm1, true
+	}
+	wm3, vm3, okm3 = wm2, vm2, okm2
+	if ok4 && (!okm2 || networkLessKeyed(&v4, &vm2)) {
+		wm3, vm3, okm3 = 4, v4, true
+	}
+
+	for okm3 {
+		dst = append(dst, vm3)
+		switch wm3 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm2, vm2, okm2 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm2, vm2, okm2 = wm1, vm1, true
+			}
+			wm3, vm3, okm3 = wm2, vm2, okm2
+			if ok4 && (!okm2 || networkLessKeyed(&v4, &vm2)) {
+				wm3, vm3, okm3 = 4, v4, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm2, vm2, okm2 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm2, vm2, okm2 = wm1, vm1, true
+			}
+			wm3, vm3, okm3 = wm2, vm2, okm2
+			if ok4 && (!okm2 || networkLessKeyed(&v4, &vm2)) {
+				wm3, vm3, okm3 = 4, v4, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm2, vm2, okm2 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm2, vm2, okm2 = wm1, vm1, true
+			}
+			wm3, vm3, okm3 = wm2, vm2, okm2
+			if ok4 && (!okm2 || networkLessKeyed(&v4, &vm2)) {
+				wm3, vm3, okm3 = 4, v4, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm2, vm2, okm2 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm2, vm2, okm2 = wm1, vm1, true
+			}
+			wm3, vm3, okm3 = wm2, vm2, okm2
+			if ok4 && (!okm2 || networkLessKeyed(&v4, &vm2)) {
+				wm3, vm3, okm3 = 4, v4, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm3, vm3, okm3 = wm2, vm2, okm2
+			if ok4 && (!okm2 || networkLessKeyed(&v4, &vm2)) {
+				wm3, vm3, okm3 = 4, v4, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort5xKeyedReverse(a []Keyed) {
+	_ = a[4]
+	if networkLessKeyed(&a[0], &a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[3], &a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[2], &a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if networkLessKeyed(&a[2], &a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[0], &a[3]) {
+		a[0], a[3] = a[3], a[0]
+	}
+	if networkLessKeyed(&a[0], &a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[1], &a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if networkLessKeyed(&a[1], &a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[1], &a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+// NetworkMerge5xKeyedReverse merges 5 runs, each of which must already be sorted
+// in decreasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge5xKeyedReverse(dst []Keyed, r0, r1, r2, r3, r4 []Keyed) []Keyed {
+	var v0, v1, v2, v3, v4, vm0, vm1, vm2, vm3 Keyed
+	var wm0, wm1, wm2, wm3 int
+	var okm0, okm1, okm2, okm3 bool
+	ok0, ok1, ok2, ok3, ok4 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+		wm2, vm2, okm2 = wm1, vm1, true
+	}
+	wm3, vm3, okm3 = wm2, vm2, okm2
+	if ok4 && (!okm2 || networkLessKeyed(&vm2, &v4)) {
+		wm3, vm3, okm3 = 4, v4, true
+	}
+
+	for okm3 {
+		dst = append(dst, vm3)
+		switch wm3 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm2, vm2, okm2 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm2, vm2, okm2 = wm1, vm1, true
+			}
+			wm3, vm3, okm3 = wm2, vm2, okm2
+			if ok4 && (!okm2 || networkLessKeyed(&vm2, &v4)) {
+				wm3, vm3, okm3 = 4, v4, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm2, vm2, okm2 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm2, vm2, okm2 = wm1, vm1, true
+			}
+			wm3, vm3, okm3 = wm2, vm2, okm2
+			if ok4 && (!okm2 || networkLessKeyed(&vm2, &v4)) {
+				wm3, vm3, okm3 = 4, v4, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm2, vm2, okm2 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm2, vm2, okm2 = wm1, vm1, true
+			}
+			wm3, vm3, okm3 = wm2, vm2, okm2
+			if ok4 && (!okm2 || networkLessKeyed(&vm2, &v4)) {
+				wm3, vm3, okm3 = 4, v4, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm2, vm2, okm2 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm2, vm2, okm2 = wm1, vm1, true
+			}
+			wm3, vm3, okm3 = wm2, vm2, okm2
+			if ok4 && (!okm2 || networkLessKeyed(&vm2, &v4)) {
+				wm3, vm3, okm3 = 4, v4, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm3, vm3, okm3 = wm2, vm2, okm2
+			if ok4 && (!okm2 || networkLessKeyed(&vm2, &v4)) {
+				wm3, vm3, okm3 = 4, v4, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort6xKeyed(a []Keyed) {
+	_ = a[5]
+	if networkLessKeyed(&a[2], &a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[2], &a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[1], &a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[5], &a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if networkLessKeyed(&a[5], &a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if networkLessKeyed(&a[4], &a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[4], &a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if networkLessKeyed(&a[3], &a[0]) {
+		a[0], a[3] = a[3], a[0]
+	}
+	if networkLessKeyed(&a[5], &a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if networkLessKeyed(&a[5], &a[2]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if networkLessKeyed(&a[3], &a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[4], &a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if networkLessKeyed(&a[3], &a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+// NetworkMerge6xKeyed merges 6 runs, each of which must already be sorted
+// in increasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge6xKeyed(dst []Keyed, r0, r1, r2, r3, r4, r5 []Keyed) []Keyed {
+	var v0, v1, v2, v3, v4, v5, vm0, vm1, vm2, vm3, vm4 Keyed
+	var wm0, wm1, wm2, wm3, wm4 int
+	var okm0, okm1, okm2, okm3, okm4 bool
+	ok0, ok1, ok2, ok3, ok4, ok5 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0, len(r5) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	if ok5 {
+		v5 = r5[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = 4, v4, ok4
+	if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+		wm2, vm2, okm2 = 5, v5, true
+	}
+	wm3, vm3, okm3 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+		wm3, vm3, okm3 = wm1, vm1, true
+	}
+	wm4, vm4, okm4 = wm3, vm3, okm3
+	if okm2 && (!okm3 || networkLessKeyed(&vm2, &vm3)) {
+		wm4, vm4, okm4 = wm2, vm2, true
+	}
+
+	for okm4 {
+		dst = append(dst, vm4)
+		switch wm4 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm3, vm3, okm3 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm3, vm3, okm3 = wm1, vm1, true
+			}
+			wm4, vm4, okm4 = wm3, vm3, okm3
+			if okm2 && (!okm3 || networkLessKeyed(&vm2, &vm3)) {
+				wm4, vm4, okm4 = wm2, vm2, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm3, vm3, okm3 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm3, vm3, okm3 = wm1, vm1, true
+			}
+			wm4, vm4, okm4 = wm3, vm3, okm3
+			if okm2 && (!okm3 || networkLessKeyed(&vm2, &vm3)) {
+				wm4, vm4, okm4 = wm2, vm2, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm3, vm3, okm3 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm3, vm3, okm3 = wm1, vm1, true
+			}
+			wm4, vm4, okm4 = wm3, vm3, okm3
+			if okm2 && (!okm3 || networkLessKeyed(&vm2, &vm3)) {
+				wm4, vm4, okm4 = wm2, vm2, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm3, vm3, okm3 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm3, vm3, okm3 = wm1, vm1, true
+			}
+			wm4, vm4, okm4 = wm3, vm3, okm3
+			if okm2 && (!okm3 || networkLessKeyed(&vm2, &vm3)) {
+				wm4, vm4, okm4 = wm2, vm2, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm4, vm4, okm4 = wm3, vm3, okm3
+			if okm2 && (!okm3 || networkLessKeyed(&vm2, &vm3)) {
+				wm4, vm4, okm4 = wm2, vm2, true
+			}
+		case 5:
+			r5 = r5[1:]
+			if ok5 = len(r5) > 0; ok5 {
+				v5 = r5[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm4, vm4, okm4 = wm3, vm3, okm3
+			if okm2 && (!okm3 || networkLessKeyed(&vm2, &vm3)) {
+				wm4, vm4, okm4 = wm2, vm2, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort6xKeyedReverse(a []Keyed) {
+	_ = a[5]
+	if networkLessKeyed(&a[1], &a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[0], &a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[0], &a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[4], &a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if networkLessKeyed(&a[3], &a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if networkLessKeyed(&a[3], &a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[0], &a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if networkLessKeyed(&a[0], &a[3]) {
+		a[0], a[3] = a[3], a[0]
+	}
+	if networkLessKeyed(&a[1], &a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if networkLessKeyed(&a[2], &a[5]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if networkLessKeyed(&a[1], &a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[2], &a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if networkLessKeyed(&a[2], &a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+// NetworkMerge6xKeyedReverse merges 6 runs, each of which must already be sorted
+// in decreasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge6xKeyedReverse(dst []Keyed, r0, r1, r2, r3, r4, r5 []Keyed) []Keyed {
+	var v0, v1, v2, v3, v4, v5, vm0, vm1, vm2, vm3, vm4 Keyed
+	var wm0, wm1, wm2, wm3, wm4 int
+	var okm0, okm1, okm2, okm3, okm4 bool
+	ok0, ok1, ok2, ok3, ok4, ok5 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0, len(r5) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	if ok5 {
+		v5 = r5[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = 4, v4, ok4
+	if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+		wm2, vm2, okm2 = 5, v5, true
+	}
+	wm3, vm3, okm3 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+		wm3, vm3, okm3 = wm1, vm1, true
+	}
+	wm4, vm4, okm4 = wm3, vm3, okm3
+	if okm2 && (!okm3 || networkLessKeyed(&vm3, &vm2)) {
+		wm4, vm4, okm4 = wm2, vm2, true
+	}
+
+	for okm4 {
+		dst = append(dst, vm4)
+		switch wm4 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm3, vm3, okm3 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm3, vm3, okm3 = wm1, vm1, true
+			}
+			wm4, vm4, okm4 = wm3, vm3, okm3
+			if okm2 && (!okm3 || networkLessKeyed(&vm3, &vm2)) {
+				wm4, vm4, okm4 = wm2, vm2, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm3, vm3, okm3 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm3, vm3, okm3 = wm1, vm1, true
+			}
+			wm4, vm4, okm4 = wm3, vm3, okm3
+			if okm2 && (!okm3 || networkLessKeyed(&vm3, &vm2)) {
+				wm4, vm4, okm4 = wm2, vm2, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm3, vm3, okm3 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm3, vm3, okm3 = wm1, vm1, true
+			}
+			wm4, vm4, okm4 = wm3, vm3, okm3
+			if okm2 && (!okm3 || networkLessKeyed(&vm3, &vm2)) {
+				wm4, vm4, okm4 = wm2, vm2, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm3, vm3, okm3 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm3, vm3, okm3 = wm1, vm1, true
+			}
+			wm4, vm4, okm4 = wm3, vm3, okm3
+			if okm2 && (!okm3 || networkLessKeyed(&vm3, &vm2)) {
+				wm4, vm4, okm4 = wm2, vm2, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm4, vm4, okm4 = wm3, vm3, okm3
+			if okm2 && (!okm3 || networkLessKeyed(&vm3, &vm2)) {
+				wm4, vm4, okm4 = wm2, vm2, true
+			}
+		case 5:
+			r5 = r5[1:]
+			if ok5 = len(r5) > 0; ok5 {
+				v5 = r5[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm4, vm4, okm4 = wm3, vm3, okm3
+			if okm2 && (!okm3 || networkLessKeyed(&vm3, &vm2)) {
+				wm4, vm4, okm4 = wm2, vm2, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort7xKeyed(a []Keyed) {
+	_ = a[6]
+	if networkLessKeyed(&a[2], &a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[2], &a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[1], &a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[4], &a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[6], &a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[5], &a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if networkLessKeyed(&a[6], &a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if networkLessKeyed(&a[5], &a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if networkLessKeyed(&a[4], &a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if networkLessKeyed(&a[3], &a[0]) {
+		a[0], a[3] = a[3], a[0]
+	}
+	if networkLessKeyed(&a[5], &a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if networkLessKeyed(&a[6], &a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if networkLessKeyed(&a[5], &a[2]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if networkLessKeyed(&a[3], &a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[4], &a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if networkLessKeyed(&a[3], &a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+// NetworkMerge7xKeyed merges 7 runs, each of which must already be sorted
+// in increasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge7xKeyed(dst []Keyed, r0, r1, r2, r3, r4, r5, r6 []Keyed) []Keyed {
+	var v0, v1, v2, v3, v4, v5, v6, vm0, vm1, vm2, vm3, vm4, vm5 Keyed
+	var wm0, wm1, wm2, wm3, wm4, wm5 int
+	var okm0, okm1, okm2, okm3, okm4, okm5 bool
+	ok0, ok1, ok2, ok3, ok4, ok5, ok6 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0, len(r5) > 0, len(r6) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	if ok5 {
+		v5 = r5[0]
+	}
+	if ok6 {
+		v6 = r6[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = 4, v4, ok4
+	if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+		wm2, vm2, okm2 = 5, v5, true
+	}
+	wm3, vm3, okm3 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+		wm3, vm3, okm3 = wm1, vm1, true
+	}
+	wm4, vm4, okm4 = wm2, vm2, okm2
+	if ok6 && (!okm2 || networkLessKeyed(&v6, &vm2)) {
+		wm4, vm4, okm4 = 6, v6, true
+	}
+	wm5, vm5, okm5 = wm3, vm3, okm3
+	if okm4 && (!okm3 || networkLessKeyed(&vm4, &vm3)) {
+		wm5, vm5, okm5 = wm4, vm4, true
+	}
+
+	for okm5 {
+		dst = append(dst, vm5)
+		switch wm5 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm3, vm3, okm3 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm3, vm3, okm3 = wm1, vm1, true
+			}
+			wm5, vm5, okm5 = wm3, vm3, okm3
+			if okm4 && (!okm3 || networkLessKeyed(&vm4, &vm3)) {
+				wm5, vm5, okm5 = wm4, vm4, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm3, vm3, okm3 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm3, vm3, okm3 = wm1, vm1, true
+			}
+			wm5, vm5, okm5 = wm3, vm3, okm3
+			if okm4 && (!okm3 || networkLessKeyed(&vm4, &vm3)) {
+				wm5, vm5, okm5 = wm4, vm4, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm3, vm3, okm3 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm3, vm3, okm3 = wm1, vm1, true
+			}
+			wm5, vm5, okm5 = wm3, vm3, okm3
+			if okm4 && (!okm3 || networkLessKeyed(&vm4, &vm3)) {
+				wm5, vm5, okm5 = wm4, vm4, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm3, vm3, okm3 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm3, vm3, okm3 = wm1, vm1, true
+			}
+			wm5, vm5, okm5 = wm3, vm3, okm3
+			if okm4 && (!okm3 || networkLessKeyed(&vm4, &vm3)) {
+				wm5, vm5, okm5 = wm4, vm4, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm4, vm4, okm4 = wm2, vm2, okm2
+			if ok6 && (!okm2 || networkLessKeyed(&v6, &vm2)) {
+				wm4, vm4, okm4 = 6, v6, true
+			}
+			wm5, vm5, okm5 = wm3, vm3, okm3
+			if okm4 && (!okm3 || networkLessKeyed(&vm4, &vm3)) {
+				wm5, vm5, okm5 = wm4, vm4, true
+			}
+		case 5:
+			r5 = r5[1:]
+			if ok5 = len(r5) > 0; ok5 {
+				v5 = r5[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm4, vm4, okm4 = wm2, vm2, okm2
+			if ok6 && (!okm2 || networkLessKeyed(&v6, &vm2)) {
+				wm4, vm4, okm4 = 6, v6, true
+			}
+			wm5, vm5, okm5 = wm3, vm3, okm3
+			if okm4 && (!okm3 || networkLessKeyed(&vm4, &vm3)) {
+				wm5, vm5, okm5 = wm4, vm4, true
+			}
+		case 6:
+			r6 = r6[1:]
+			if ok6 = len(r6) > 0; ok6 {
+				v6 = r6[0]
+			}
+			wm4, vm4, okm4 = wm2, vm2, okm2
+			if ok6 && (!okm2 || networkLessKeyed(&v6, &vm2)) {
+				wm4, vm4, okm4 = 6, v6, true
+			}
+			wm5, vm5, okm5 = wm3, vm3, okm3
+			if okm4 && (!okm3 || networkLessKeyed(&vm4, &vm3)) {
+				wm5, vm5, okm5 = wm4, vm4, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort7xKeyedReverse(a []Keyed) {
+	_ = a[6]
+	if networkLessKeyed(&a[1], &a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[0], &a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[0], &a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[3], &a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[5], &a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[3], &a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if networkLessKeyed(&a[4], &a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if networkLessKeyed(&a[4], &a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if networkLessKeyed(&a[0], &a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if networkLessKeyed(&a[0], &a[3]) {
+		a[0], a[3] = a[3], a[0]
+	}
+	if networkLessKeyed(&a[1], &a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if networkLessKeyed(&a[2], &a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if networkLessKeyed(&a[2], &a[5]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if networkLessKeyed(&a[1], &a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[2], &a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if networkLessKeyed(&a[2], &a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+// NetworkMerge7xKeyedReverse merges 7 runs, each of which must already be sorted
+// in decreasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge7xKeyedReverse(dst []Keyed, r0, r1, r2, r3, r4, r5, r6 []Keyed) []Keyed {
+	var v0, v1, v2, v3, v4, v5, v6, vm0, vm1, vm2, vm3, vm4, vm5 Keyed
+	var wm0, wm1, wm2, wm3, wm4, wm5 int
+	var okm0, okm1, okm2, okm3, okm4, okm5 bool
+	ok0, ok1, ok2, ok3, ok4, ok5, ok6 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0, len(r5) > 0, len(r6) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	if ok5 {
+		v5 = r5[0]
+	}
+	if ok6 {
+		v6 = r6[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = 4, v4, ok4
+	if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+		wm2, vm2, okm2 = 5, v5, true
+	}
+	wm3, vm3, okm3 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+		wm3, vm3, okm3 = wm1, vm1, true
+	}
+	wm4, vm4, okm4 = wm2, vm2, okm2
+	if ok6 && (!okm2 || networkLessKeyed(&vm2, &v6)) {
+		wm4, vm4, okm4 = 6, v6, true
+	}
+	wm5, vm5, okm5 = wm3, vm3, okm3
+	if okm4 && (!okm3 || networkLessKeyed(&vm3, &vm4)) {
+		wm5, vm5, okm5 = wm4, vm4, true
+	}
+
+	for okm5 {
+		dst = append(dst, vm5)
+		switch wm5 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm3, vm3, okm3 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm3, vm3, okm3 = wm1, vm1, true
+			}
+			wm5, vm5, okm5 = wm3, vm3, okm3
+			if okm4 && (!okm3 || networkLessKeyed(&vm3, &vm4)) {
+				wm5, vm5, okm5 = wm4, vm4, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm3, vm3, okm3 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm3, vm3, okm3 = wm1, vm1, true
+			}
+			wm5, vm5, okm5 = wm3, vm3, okm3
+			if okm4 && (!okm3 || networkLessKeyed(&vm3, &vm4)) {
+				wm5, vm5, okm5 = wm4, vm4, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm3, vm3, okm3 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm3, vm3, okm3 = wm1, vm1, true
+			}
+			wm5, vm5, okm5 = wm3, vm3, okm3
+			if okm4 && (!okm3 || networkLessKeyed(&vm3, &vm4)) {
+				wm5, vm5, okm5 = wm4, vm4, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm3, vm3, okm3 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm3, vm3, okm3 = wm1, vm1, true
+			}
+			wm5, vm5, okm5 = wm3, vm3, okm3
+			if okm4 && (!okm3 || networkLessKeyed(&vm3, &vm4)) {
+				wm5, vm5, okm5 = wm4, vm4, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm4, vm4, okm4 = wm2, vm2, okm2
+			if ok6 && (!okm2 || networkLessKeyed(&vm2, &v6)) {
+				wm4, vm4, okm4 = 6, v6, true
+			}
+			wm5, vm5, okm5 = wm3, vm3, okm3
+			if okm4 && (!okm3 || networkLessKeyed(&vm3, &vm4)) {
+				wm5, vm5, okm5 = wm4, vm4, true
+			}
+		case 5:
+			r5 = r5[1:]
+			if ok5 = len(r5) > 0; ok5 {
+				v5 = r5[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm4, vm4, okm4 = wm2, vm2, okm2
+			if ok6 && (!okm2 || networkLessKeyed(&vm2, &v6)) {
+				wm4, vm4, okm4 = 6, v6, true
+			}
+			wm5, vm5, okm5 = wm3, vm3, okm3
+			if okm4 && (!okm3 || networkLessKeyed(&vm3, &vm4)) {
+				wm5, vm5, okm5 = wm4, vm4, true
+			}
+		case 6:
+			r6 = r6[1:]
+			if ok6 = len(r6) > 0; ok6 {
+				v6 = r6[0]
+			}
+			wm4, vm4, okm4 = wm2, vm2, okm2
+			if ok6 && (!okm2 || networkLessKeyed(&vm2, &v6)) {
+				wm4, vm4, okm4 = 6, v6, true
+			}
+			wm5, vm5, okm5 = wm3, vm3, okm3
+			if okm4 && (!okm3 || networkLessKeyed(&vm3, &vm4)) {
+				wm5, vm5, okm5 = wm4, vm4, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort8xKeyed(a []Keyed) {
+	_ = a[7]
+	if networkLessKeyed(&a[1], &a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[3], &a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[2], &a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[3], &a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[2], &a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[5], &a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if networkLessKeyed(&a[7], &a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[6], &a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if networkLessKeyed(&a[7], &a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if networkLessKeyed(&a[6], &a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[4], &a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if networkLessKeyed(&a[5], &a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if networkLessKeyed(&a[4], &a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if networkLessKeyed(&a[6], &a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if networkLessKeyed(&a[7], &a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if networkLessKeyed(&a[6], &a[3]) {
+		a[3], a[6] = a[6], a[3]
+	}
+	if networkLessKeyed(&a[4], &a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if networkLessKeyed(&a[5], &a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if networkLessKeyed(&a[4], &a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+// NetworkMerge8xKeyed merges 8 runs, each of which must already be sorted
+// in increasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge8xKeyed(dst []Keyed, r0, r1, r2, r3, r4, r5, r6, r7 []Keyed) []Keyed {
+	var v0, v1, v2, v3, v4, v5, v6, v7, vm0, vm1, vm2, vm3, vm4, vm5, vm6 Keyed
+	var wm0, wm1, wm2, wm3, wm4, wm5, wm6 int
+	var okm0, okm1, okm2, okm3, okm4, okm5, okm6 bool
+	ok0, ok1, ok2, ok3, ok4, ok5, ok6, ok7 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0, len(r5) > 0, len(r6) > 0, len(r7) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	if ok5 {
+		v5 = r5[0]
+	}
+	if ok6 {
+		v6 = r6[0]
+	}
+	if ok7 {
+		v7 = r7[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = 4, v4, ok4
+	if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+		wm2, vm2, okm2 = 5, v5, true
+	}
+	wm3, vm3, okm3 = 6, v6, ok6
+	if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+		wm3, vm3, okm3 = 7, v7, true
+	}
+	wm4, vm4, okm4 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+		wm4, vm4, okm4 = wm1, vm1, true
+	}
+	wm5, vm5, okm5 = wm2, vm2, okm2
+	if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+		wm5, vm5, okm5 = wm3, vm3, true
+	}
+	wm6, vm6, okm6 = wm4, vm4, okm4
+	if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+		wm6, vm6, okm6 = wm5, vm5, true
+	}
+
+	for okm6 {
+		dst = append(dst, vm6)
+		switch wm6 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm4, vm4, okm4 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm4, vm4, okm4 = wm1, vm1, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm4, vm4, okm4 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm4, vm4, okm4 = wm1, vm1, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm4, vm4, okm4 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm4, vm4, okm4 = wm1, vm1, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm4, vm4, okm4 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm4, vm4, okm4 = wm1, vm1, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm5, vm5, okm5 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm5, vm5, okm5 = wm3, vm3, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		case 5:
+			r5 = r5[1:]
+			if ok5 = len(r5) > 0; ok5 {
+				v5 = r5[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm5, vm5, okm5 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm5, vm5, okm5 = wm3, vm3, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		case 6:
+			r6 = r6[1:]
+			if ok6 = len(r6) > 0; ok6 {
+				v6 = r6[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm5, vm5, okm5 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm5, vm5, okm5 = wm3, vm3, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		case 7:
+			r7 = r7[1:]
+			if ok7 = len(r7) > 0; ok7 {
+				v7 = r7[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm5, vm5, okm5 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm5, vm5, okm5 = wm3, vm3, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort8xKeyedReverse(a []Keyed) {
+	_ = a[7]
+	if networkLessKeyed(&a[0], &a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[2], &a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[0], &a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[1], &a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[1], &a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[4], &a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if networkLessKeyed(&a[6], &a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[4], &a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if networkLessKeyed(&a[5], &a[7]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if networkLessKeyed(&a[5], &a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[0], &a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if networkLessKeyed(&a[1], &a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if networkLessKeyed(&a[1], &a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if networkLessKeyed(&a[2], &a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if networkLessKeyed(&a[3], &a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if networkLessKeyed(&a[3], &a[6]) {
+		a[3], a[6] = a[6], a[3]
+	}
+	if networkLessKeyed(&a[2], &a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if networkLessKeyed(&a[3], &a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if networkLessKeyed(&a[3], &a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+// NetworkMerge8xKeyedReverse merges 8 runs, each of which must already be sorted
+// in decreasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge8xKeyedReverse(dst []Keyed, r0, r1, r2, r3, r4, r5, r6, r7 []Keyed) []Keyed {
+	var v0, v1, v2, v3, v4, v5, v6, v7, vm0, vm1, vm2, vm3, vm4, vm5, vm6 Keyed
+	var wm0, wm1, wm2, wm3, wm4, wm5, wm6 int
+	var okm0, okm1, okm2, okm3, okm4, okm5, okm6 bool
+	ok0, ok1, ok2, ok3, ok4, ok5, ok6, ok7 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0, len(r5) > 0, len(r6) > 0, len(r7) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	if ok5 {
+		v5 = r5[0]
+	}
+	if ok6 {
+		v6 = r6[0]
+	}
+	if ok7 {
+		v7 = r7[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = 4, v4, ok4
+	if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+		wm2, vm2, okm2 = 5, v5, true
+	}
+	wm3, vm3, okm3 = 6, v6, ok6
+	if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+		wm3, vm3, okm3 = 7, v7, true
+	}
+	wm4, vm4, okm4 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+		wm4, vm4, okm4 = wm1, vm1, true
+	}
+	wm5, vm5, okm5 = wm2, vm2, okm2
+	if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+		wm5, vm5, okm5 = wm3, vm3, true
+	}
+	wm6, vm6, okm6 = wm4, vm4, okm4
+	if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+		wm6, vm6, okm6 = wm5, vm5, true
+	}
+
+	for okm6 {
+		dst = append(dst, vm6)
+		switch wm6 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm4, vm4, okm4 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm4, vm4, okm4 = wm1, vm1, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm4, vm4, okm4 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm4, vm4, okm4 = wm1, vm1, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm4, vm4, okm4 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm4, vm4, okm4 = wm1, vm1, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm4, vm4, okm4 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm4, vm4, okm4 = wm1, vm1, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm5, vm5, okm5 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm5, vm5, okm5 = wm3, vm3, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		case 5:
+			r5 = r5[1:]
+			if ok5 = len(r5) > 0; ok5 {
+				v5 = r5[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm5, vm5, okm5 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm5, vm5, okm5 = wm3, vm3, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		case 6:
+			r6 = r6[1:]
+			if ok6 = len(r6) > 0; ok6 {
+				v6 = r6[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm5, vm5, okm5 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm5, vm5, okm5 = wm3, vm3, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		case 7:
+			r7 = r7[1:]
+			if ok7 = len(r7) > 0; ok7 {
+				v7 = r7[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm5, vm5, okm5 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm5, vm5, okm5 = wm3, vm3, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort9xKeyed(a []Keyed) {
+	_ = a[8]
+	if networkLessKeyed(&a[6], &a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if networkLessKeyed(&a[5], &a[0]) {
+		a[0], a[5] = a[5], a[0]
+	}
+	if networkLessKeyed(&a[4], &a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if networkLessKeyed(&a[8], &a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if networkLessKeyed(&a[7], &a[0]) {
+		a[0], a[7] = a[7], a[0]
+	}
+	if networkLessKeyed(&a[2], &a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[5], &a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if networkLessKeyed(&a[6], &a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if networkLessKeyed(&a[8], &a[5]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if networkLessKeyed(&a[3], &a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[8], &a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if networkLessKeyed(&a[1], &a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[5], &a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if networkLessKeyed(&a[7], &a[2]) {
+		a[2], a[7] = a[7], a[2]
+	}
+	if networkLessKeyed(&a[7], &a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if networkLessKeyed(&a[4], &a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[6], &a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[2], &a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[3], &a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[7], &a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[5], &a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if networkLessKeyed(&a[4], &a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if networkLessKeyed(&a[6], &a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[3], &a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[5], &a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+// NetworkMerge9xKeyed merges 9 runs, each of which must already be sorted
+// in increasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge9xKeyed(dst []Keyed, r0, r1, r2, r3, r4, r5, r6, r7, r8 []Keyed) []Keyed {
+	var v0, v1, v2, v3, v4, v5, v6, v7, v8, vm0, vm1, vm2, vm3, vm4, vm5, vm6, vm7 Keyed
+	var wm0, wm1, wm2, wm3, wm4, wm5, wm6, wm7 int
+	var okm0, okm1, okm2, okm3, okm4, okm5, okm6, okm7 bool
+	ok0, ok1, ok2, ok3, ok4, ok5, ok6, ok7, ok8 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0, len(r5) > 0, len(r6) > 0, len(r7) > 0, len(r8) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	if ok5 {
+		v5 = r5[0]
+	}
+	if ok6 {
+		v6 = r6[0]
+	}
+	if ok7 {
+		v7 = r7[0]
+	}
+	if ok8 {
+		v8 = r8[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = 4, v4, ok4
+	if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+		wm2, vm2, okm2 = 5, v5, true
+	}
+	wm3, vm3, okm3 = 6, v6, ok6
+	if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+		wm3, vm3, okm3 = 7, v7, true
+	}
+	wm4, vm4, okm4 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+		wm4, vm4, okm4 = wm1, vm1, true
+	}
+	wm5, vm5, okm5 = wm2, vm2, okm2
+	if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+		wm5, vm5, okm5 = wm3, vm3, true
+	}
+	wm6, vm6, okm6 = wm4, vm4, okm4
+	if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+		wm6, vm6, okm6 = wm5, vm5, true
+	}
+	wm7, vm7, okm7 = wm6, vm6, okm6
+	if ok8 && (!okm6 || networkLessKeyed(&v8, &vm6)) {
+		wm7, vm7, okm7 = 8, v8, true
+	}
+
+	for okm7 {
+		dst = append(dst, vm7)
+		switch wm7 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm4, vm4, okm4 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm4, vm4, okm4 = wm1, vm1, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+			wm7, vm7, okm7 = wm6, vm6, okm6
+			if ok8 && (!okm6 || networkLessKeyed(&v8, &vm6)) {
+				wm7, vm7, okm7 = 8, v8, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm4, vm4, okm4 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm4, vm4, okm4 = wm1, vm1, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+			wm7, vm7, okm7 = wm6, vm6, okm6
+			if ok8 && (!okm6 || networkLessKeyed(&v8, &vm6)) {
+				wm7, vm7, okm7 = 8, v8, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm4, vm4, okm4 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm4, vm4, okm4 = wm1, vm1, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+			wm7, vm7, okm7 = wm6, vm6, okm6
+			if ok8 && (!okm6 || networkLessKeyed(&v8, &vm6)) {
+				wm7, vm7, okm7 = 8, v8, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm4, vm4, okm4 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm4, vm4, okm4 = wm1, vm1, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+			wm7, vm7, okm7 = wm6, vm6, okm6
+			if ok8 && (!okm6 || networkLessKeyed(&v8, &vm6)) {
+				wm7, vm7, okm7 = 8, v8, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm5, vm5, okm5 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm5, vm5, okm5 = wm3, vm3, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+			wm7, vm7, okm7 = wm6, vm6, okm6
+			if ok8 && (!okm6 || networkLessKeyed(&v8, &vm6)) {
+				wm7, vm7, okm7 = 8, v8, true
+			}
+		case 5:
+			r5 = r5[1:]
+			if ok5 = len(r5) > 0; ok5 {
+				v5 = r5[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm5, vm5, okm5 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm5, vm5, okm5 = wm3, vm3, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+			wm7, vm7, okm7 = wm6, vm6, okm6
+			if ok8 && (!okm6 || networkLessKeyed(&v8, &vm6)) {
+				wm7, vm7, okm7 = 8, v8, true
+			}
+		case 6:
+			r6 = r6[1:]
+			if ok6 = len(r6) > 0; ok6 {
+				v6 = r6[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm5, vm5, okm5 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm5, vm5, okm5 = wm3, vm3, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+			wm7, vm7, okm7 = wm6, vm6, okm6
+			if ok8 && (!okm6 || networkLessKeyed(&v8, &vm6)) {
+				wm7, vm7, okm7 = 8, v8, true
+			}
+		case 7:
+			r7 = r7[1:]
+			if ok7 = len(r7) > 0; ok7 {
+				v7 = r7[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm5, vm5, okm5 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm5, vm5, okm5 = wm3, vm3, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+			wm7, vm7, okm7 = wm6, vm6, okm6
+			if ok8 && (!okm6 || networkLessKeyed(&v8, &vm6)) {
+				wm7, vm7, okm7 = 8, v8, true
+			}
+		case 8:
+			r8 = r8[1:]
+			if ok8 = len(r8) > 0; ok8 {
+				v8 = r8[0]
+			}
+			wm7, vm7, okm7 = wm6, vm6, okm6
+			if ok8 && (!okm6 || networkLessKeyed(&v8, &vm6)) {
+				wm7, vm7, okm7 = 8, v8, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort9xKeyedReverse(a []Keyed) {
+	_ = a[8]
+	if networkLessKeyed(&a[2], &a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if networkLessKeyed(&a[0], &a[5]) {
+		a[0], a[5] = a[5], a[0]
+	}
+	if networkLessKeyed(&a[1], &a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if networkLessKeyed(&a[7], &a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if networkLessKeyed(&a[0], &a[7]) {
+		a[0], a[7] = a[7], a[0]
+	}
+	if networkLessKeyed(&a[1], &a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[3], &a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if networkLessKeyed(&a[4], &a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if networkLessKeyed(&a[5], &a[8]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if networkLessKeyed(&a[1], &a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[6], &a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if networkLessKeyed(&a[0], &a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[4], &a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if networkLessKeyed(&a[2], &a[7]) {
+		a[2], a[7] = a[7], a[2]
+	}
+	if networkLessKeyed(&a[3], &a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if networkLessKeyed(&a[3], &a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[5], &a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[1], &a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[1], &a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[6], &a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[4], &a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if networkLessKeyed(&a[2], &a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if networkLessKeyed(&a[5], &a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[2], &a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[4], &a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+// NetworkMerge9xKeyedReverse merges 9 runs, each of which must already be sorted
+// in decreasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge9xKeyedReverse(dst []Keyed, r0, r1, r2, r3, r4, r5, r6, r7, r8 []Keyed) []Keyed {
+	var v0, v1, v2, v3, v4, v5, v6, v7, v8, vm0, vm1, vm2, vm3, vm4, vm5, vm6, vm7 Keyed
+	var wm0, wm1, wm2, wm3, wm4, wm5, wm6, wm7 int
+	var okm0, okm1, okm2, okm3, okm4, okm5, okm6, okm7 bool
+	ok0, ok1, ok2, ok3, ok4, ok5, ok6, ok7, ok8 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0, len(r5) > 0, len(r6) > 0, len(r7) > 0, len(r8) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	if ok5 {
+		v5 = r5[0]
+	}
+	if ok6 {
+		v6 = r6[0]
+	}
+	if ok7 {
+		v7 = r7[0]
+	}
+	if ok8 {
+		v8 = r8[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = 4, v4, ok4
+	if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+		wm2, vm2, okm2 = 5, v5, true
+	}
+	wm3, vm3, okm3 = 6, v6, ok6
+	if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+		wm3, vm3, okm3 = 7, v7, true
+	}
+	wm4, vm4, okm4 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+		wm4, vm4, okm4 = wm1, vm1, true
+	}
+	wm5, vm5, okm5 = wm2, vm2, okm2
+	if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+		wm5, vm5, okm5 = wm3, vm3, true
+	}
+	wm6, vm6, okm6 = wm4, vm4, okm4
+	if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+		wm6, vm6, okm6 = wm5, vm5, true
+	}
+	wm7, vm7, okm7 = wm6, vm6, okm6
+	if ok8 && (!okm6 || networkLessKeyed(&vm6, &v8)) {
+		wm7, vm7, okm7 = 8, v8, true
+	}
+
+	for okm7 {
+		dst = append(dst, vm7)
+		switch wm7 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm4, vm4, okm4 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm4, vm4, okm4 = wm1, vm1, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+			wm7, vm7, okm7 = wm6, vm6, okm6
+			if ok8 && (!okm6 || networkLessKeyed(&vm6, &v8)) {
+				wm7, vm7, okm7 = 8, v8, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm4, vm4, okm4 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm4, vm4, okm4 = wm1, vm1, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+			wm7, vm7, okm7 = wm6, vm6, okm6
+			if ok8 && (!okm6 || networkLessKeyed(&vm6, &v8)) {
+				wm7, vm7, okm7 = 8, v8, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm4, vm4, okm4 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm4, vm4, okm4 = wm1, vm1, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+			wm7, vm7, okm7 = wm6, vm6, okm6
+			if ok8 && (!okm6 || networkLessKeyed(&vm6, &v8)) {
+				wm7, vm7, okm7 = 8, v8, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm4, vm4, okm4 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm4, vm4, okm4 = wm1, vm1, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+			wm7, vm7, okm7 = wm6, vm6, okm6
+			if ok8 && (!okm6 || networkLessKeyed(&vm6, &v8)) {
+				wm7, vm7, okm7 = 8, v8, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm5, vm5, okm5 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm5, vm5, okm5 = wm3, vm3, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+			wm7, vm7, okm7 = wm6, vm6, okm6
+			if ok8 && (!okm6 || networkLessKeyed(&vm6, &v8)) {
+				wm7, vm7, okm7 = 8, v8, true
+			}
+		case 5:
+			r5 = r5[1:]
+			if ok5 = len(r5) > 0; ok5 {
+				v5 = r5[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm5, vm5, okm5 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm5, vm5, okm5 = wm3, vm3, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+			wm7, vm7, okm7 = wm6, vm6, okm6
+			if ok8 && (!okm6 || networkLessKeyed(&vm6, &v8)) {
+				wm7, vm7, okm7 = 8, v8, true
+			}
+		case 6:
+			r6 = r6[1:]
+			if ok6 = len(r6) > 0; ok6 {
+				v6 = r6[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm5, vm5, okm5 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm5, vm5, okm5 = wm3, vm3, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+			wm7, vm7, okm7 = wm6, vm6, okm6
+			if ok8 && (!okm6 || networkLessKeyed(&vm6, &v8)) {
+				wm7, vm7, okm7 = 8, v8, true
+			}
+		case 7:
+			r7 = r7[1:]
+			if ok7 = len(r7) > 0; ok7 {
+				v7 = r7[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm5, vm5, okm5 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm5, vm5, okm5 = wm3, vm3, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+			wm7, vm7, okm7 = wm6, vm6, okm6
+			if ok8 && (!okm6 || networkLessKeyed(&vm6, &v8)) {
+				wm7, vm7, okm7 = 8, v8, true
+			}
+		case 8:
+			r8 = r8[1:]
+			if ok8 = len(r8) > 0; ok8 {
+				v8 = r8[0]
+			}
+			wm7, vm7, okm7 = wm6, vm6, okm6
+			if ok8 && (!okm6 || networkLessKeyed(&vm6, &v8)) {
+				wm7, vm7, okm7 = 8, v8, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort10xKeyed(a []Keyed) {
+	_ = a[9]
+	if networkLessKeyed(&a[4], &a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if networkLessKeyed(&a[8], &a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if networkLessKeyed(&a[3], &a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[6], &a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[9], &a[0]) {
+		a[0], a[9] = a[9], a[0]
+	}
+	if networkLessKeyed(&a[5], &a[2]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if networkLessKeyed(&a[7], &a[0]) {
+		a[0], a[7] = a[7], a[0]
+	}
+	if networkLessKeyed(&a[9], &a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if networkLessKeyed(&a[6], &a[3]) {
+		a[3], a[6] = a[6], a[3]
+	}
+	if networkLessKeyed(&a[9], &a[4]) {
+		a[4], a[9] = a[9], a[4]
+	}
+	if networkLessKeyed(&a[1], &a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[2], &a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[9], &a[6]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if networkLessKeyed(&a[5], &a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if networkLessKeyed(&a[7], &a[4]) {
+		a[4], a[7] = a[7], a[4]
+	}
+	if networkLessKeyed(&a[8], &a[1]) {
+		a[1], a[8] = a[8], a[1]
+	}
+	if networkLessKeyed(&a[4], &a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[8], &a[5]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if networkLessKeyed(&a[7], &a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[2], &a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[8], &a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if networkLessKeyed(&a[3], &a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[5], &a[2]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if networkLessKeyed(&a[6], &a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if networkLessKeyed(&a[3], &a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[7], &a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[5], &a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if networkLessKeyed(&a[4], &a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[6], &a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+// NetworkMerge10xKeyed merges 10 runs, each of which must already be sorted
+// in increasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge10xKeyed(dst []Keyed, r0, r1, r2, r3, r4, r5, r6, r7, r8, r9 []Keyed) []Keyed {
+	var v0, v1, v2, v3, v4, v5, v6, v7, v8, v9, vm0, vm1, vm2, vm3, vm4, vm5, vm6, vm7, vm8 Keyed
+	var wm0, wm1, wm2, wm3, wm4, wm5, wm6, wm7, wm8 int
+	var okm0, okm1, okm2, okm3, okm4, okm5, okm6, okm7, okm8 bool
+	ok0, ok1, ok2, ok3, ok4, ok5, ok6, ok7, ok8, ok9 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0, len(r5) > 0, len(r6) > 0, len(r7) > 0, len(r8) > 0, len(r9) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	if ok5 {
+		v5 = r5[0]
+	}
+	if ok6 {
+		v6 = r6[0]
+	}
+	if ok7 {
+		v7 = r7[0]
+	}
+	if ok8 {
+		v8 = r8[0]
+	}
+	if ok9 {
+		v9 = r9[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = 4, v4, ok4
+	if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+		wm2, vm2, okm2 = 5, v5, true
+	}
+	wm3, vm3, okm3 = 6, v6, ok6
+	if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+		wm3, vm3, okm3 = 7, v7, true
+	}
+	wm4, vm4, okm4 = 8, v8, ok8
+	if ok9 && (!ok8 || networkLessKeyed(&v9, &v8)) {
+		wm4, vm4, okm4 = 9, v9, true
+	}
+	wm5, vm5, okm5 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+		wm5, vm5, okm5 = wm1, vm1, true
+	}
+	wm6, vm6, okm6 = wm2, vm2, okm2
+	if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+		wm6, vm6, okm6 = wm3, vm3, true
+	}
+	wm7, vm7, okm7 = wm5, vm5, okm5
+	if okm6 && (!okm5 || networkLessKeyed(&vm6, &vm5)) {
+		wm7, vm7, okm7 = wm6, vm6, true
+	}
+	wm8, vm8, okm8 = wm7, vm7, okm7
+	if okm4 && (!okm7 || networkLessKeyed(&vm4, &vm7)) {
+		wm8, vm8, okm8 = wm4, vm4, true
+	}
+
+	for okm8 {
+		dst = append(dst, vm8)
+		switch wm8 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm5, vm5, okm5 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm5, vm5, okm5 = wm1, vm1, true
+			}
+			wm7, vm7, okm7 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm6, &vm5)) {
+				wm7, vm7, okm7 = wm6, vm6, true
+			}
+			wm8, vm8, okm8 = wm7, vm7, okm7
+			if okm4 && (!okm7 || networkLessKeyed(&vm4, &vm7)) {
+				wm8, vm8, okm8 = wm4, vm4, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm5, vm5, okm5 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm5, vm5, okm5 = wm1, vm1, true
+			}
+			wm7, vm7, okm7 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm6, &vm5)) {
+				wm7, vm7, okm7 = wm6, vm6, true
+			}
+			wm8, vm8, okm8 = wm7, vm7, okm7
+			if okm4 && (!okm7 || networkLessKeyed(&vm4, &vm7)) {
+				wm8, vm8, okm8 = wm4, vm4, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm5, vm5, okm5 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm5, vm5, okm5 = wm1, vm1, true
+			}
+			wm7, vm7, okm7 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm6, &vm5)) {
+				wm7, vm7, okm7 = wm6, vm6, true
+			}
+			wm8, vm8, okm8 = wm7, vm7, okm7
+			if okm4 && (!okm7 || networkLessKeyed(&vm4, &vm7)) {
+				wm8, vm8, okm8 = wm4, vm4, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm5, vm5, okm5 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm5, vm5, okm5 = wm1, vm1, true
+			}
+			wm7, vm7, okm7 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm6, &vm5)) {
+				wm7, vm7, okm7 = wm6, vm6, true
+			}
+			wm8, vm8, okm8 = wm7, vm7, okm7
+			if okm4 && (!okm7 || networkLessKeyed(&vm4, &vm7)) {
+				wm8, vm8, okm8 = wm4, vm4, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm6, vm6, okm6 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm6, vm6, okm6 = wm3, vm3, true
+			}
+			wm7, vm7, okm7 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm6, &vm5)) {
+				wm7, vm7, okm7 = wm6, vm6, true
+			}
+			wm8, vm8, okm8 = wm7, vm7, okm7
+			if okm4 && (!okm7 || networkLessKeyed(&vm4, &vm7)) {
+				wm8, vm8, okm8 = wm4, vm4, true
+			}
+		case 5:
+			r5 = r5[1:]
+			if ok5 = len(r5) > 0; ok5 {
+				v5 = r5[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm6, vm6, okm6 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm6, vm6, okm6 = wm3, vm3, true
+			}
+			wm7, vm7, okm7 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm6, &vm5)) {
+				wm7, vm7, okm7 = wm6, vm6, true
+			}
+			wm8, vm8, okm8 = wm7, vm7, okm7
+			if okm4 && (!okm7 || networkLessKeyed(&vm4, &vm7)) {
+				wm8, vm8, okm8 = wm4, vm4, true
+			}
+		case 6:
+			r6 = r6[1:]
+			if ok6 = len(r6) > 0; ok6 {
+				v6 = r6[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm6, vm6, okm6 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm6, vm6, okm6 = wm3, vm3, true
+			}
+			wm7, vm7, okm7 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm6, &vm5)) {
+				wm7, vm7, okm7 = wm6, vm6, true
+			}
+			wm8, vm8, okm8 = wm7, vm7, okm7
+			if okm4 && (!okm7 || networkLessKeyed(&vm4, &vm7)) {
+				wm8, vm8, okm8 = wm4, vm4, true
+			}
+		case 7:
+			r7 = r7[1:]
+			if ok7 = len(r7) > 0; ok7 {
+				v7 = r7[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm6, vm6, okm6 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm6, vm6, okm6 = wm3, vm3, true
+			}
+			wm7, vm7, okm7 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm6, &vm5)) {
+				wm7, vm7, okm7 = wm6, vm6, true
+			}
+			wm8, vm8, okm8 = wm7, vm7, okm7
+			if okm4 && (!okm7 || networkLessKeyed(&vm4, &vm7)) {
+				wm8, vm8, okm8 = wm4, vm4, true
+			}
+		case 8:
+			r8 = r8[1:]
+			if ok8 = len(r8) > 0; ok8 {
+				v8 = r8[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v9, &v8)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm8, vm8, okm8 = wm7, vm7, okm7
+			if okm4 && (!okm7 || networkLessKeyed(&vm4, &vm7)) {
+				wm8, vm8, okm8 = wm4, vm4, true
+			}
+		case 9:
+			r9 = r9[1:]
+			if ok9 = len(r9) > 0; ok9 {
+				v9 = r9[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v9, &v8)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm8, vm8, okm8 = wm7, vm7, okm7
+			if okm4 && (!okm7 || networkLessKeyed(&vm4, &vm7)) {
+				wm8, vm8, okm8 = wm4, vm4, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort10xKeyedReverse(a []Keyed) {
+	_ = a[9]
+	if networkLessKeyed(&a[1], &a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if networkLessKeyed(&a[7], &a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if networkLessKeyed(&a[2], &a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[5], &a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[0], &a[9]) {
+		a[0], a[9] = a[9], a[0]
+	}
+	if networkLessKeyed(&a[2], &a[5]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if networkLessKeyed(&a[0], &a[7]) {
+		a[0], a[7] = a[7], a[0]
+	}
+	if networkLessKeyed(&a[8], &a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if networkLessKeyed(&a[3], &a[6]) {
+		a[3], a[6] = a[6], a[3]
+	}
+	if networkLessKeyed(&a[4], &a[9]) {
+		a[4], a[9] = a[9], a[4]
+	}
+	if networkLessKeyed(&a[0], &a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[0], &a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[6], &a[9]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if networkLessKeyed(&a[3], &a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if networkLessKeyed(&a[4], &a[7]) {
+		a[4], a[7] = a[7], a[4]
+	}
+	if networkLessKeyed(&a[1], &a[8]) {
+		a[1], a[8] = a[8], a[1]
+	}
+	if networkLessKeyed(&a[3], &a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[5], &a[8]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if networkLessKeyed(&a[6], &a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[1], &a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[7], &a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if networkLessKeyed(&a[1], &a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[2], &a[5]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if networkLessKeyed(&a[4], &a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if networkLessKeyed(&a[2], &a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[6], &a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[4], &a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if networkLessKeyed(&a[3], &a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[5], &a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+// NetworkMerge10xKeyedReverse merges 10 runs, each of which must already be sorted
+// in decreasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge10xKeyedReverse(dst []Keyed, r0, r1, r2, r3, r4, r5, r6, r7, r8, r9 []Keyed) []Keyed {
+	var v0, v1, v2, v3, v4, v5, v6, v7, v8, v9, vm0, vm1, vm2, vm3, vm4, vm5, vm6, vm7, vm8 Keyed
+	var wm0, wm1, wm2, wm3, wm4, wm5, wm6, wm7, wm8 int
+	var okm0, okm1, okm2, okm3, okm4, okm5, okm6, okm7, okm8 bool
+	ok0, ok1, ok2, ok3, ok4, ok5, ok6, ok7, ok8, ok9 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0, len(r5) > 0, len(r6) > 0, len(r7) > 0, len(r8) > 0, len(r9) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	if ok5 {
+		v5 = r5[0]
+	}
+	if ok6 {
+		v6 = r6[0]
+	}
+	if ok7 {
+		v7 = r7[0]
+	}
+	if ok8 {
+		v8 = r8[0]
+	}
+	if ok9 {
+		v9 = r9[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = 4, v4, ok4
+	if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+		wm2, vm2, okm2 = 5, v5, true
+	}
+	wm3, vm3, okm3 = 6, v6, ok6
+	if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+		wm3, vm3, okm3 = 7, v7, true
+	}
+	wm4, vm4, okm4 = 8, v8, ok8
+	if ok9 && (!ok8 || networkLessKeyed(&v8, &v9)) {
+		wm4, vm4, okm4 = 9, v9, true
+	}
+	wm5, vm5, okm5 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+		wm5, vm5, okm5 = wm1, vm1, true
+	}
+	wm6, vm6, okm6 = wm2, vm2, okm2
+	if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+		wm6, vm6, okm6 = wm3, vm3, true
+	}
+	wm7, vm7, okm7 = wm5, vm5, okm5
+	if okm6 && (!okm5 || networkLessKeyed(&vm5, &vm6)) {
+		wm7, vm7, okm7 = wm6, vm6, true
+	}
+	wm8, vm8, okm8 = wm7, vm7, okm7
+	if okm4 && (!okm7 || networkLessKeyed(&vm7, &vm4)) {
+		wm8, vm8, okm8 = wm4, vm4, true
+	}
+
+	for okm8 {
+		dst = append(dst, vm8)
+		switch wm8 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm5, vm5, okm5 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm5, vm5, okm5 = wm1, vm1, true
+			}
+			wm7, vm7, okm7 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm5, &vm6)) {
+				wm7, vm7, okm7 = wm6, vm6, true
+			}
+			wm8, vm8, okm8 = wm7, vm7, okm7
+			if okm4 && (!okm7 || networkLessKeyed(&vm7, &vm4)) {
+				wm8, vm8, okm8 = wm4, vm4, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm5, vm5, okm5 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm5, vm5, okm5 = wm1, vm1, true
+			}
+			wm7, vm7, okm7 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm5, &vm6)) {
+				wm7, vm7, okm7 = wm6, vm6, true
+			}
+			wm8, vm8, okm8 = wm7, vm7, okm7
+			if okm4 && (!okm7 || networkLessKeyed(&vm7, &vm4)) {
+				wm8, vm8, okm8 = wm4, vm4, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm5, vm5, okm5 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm5, vm5, okm5 = wm1, vm1, true
+			}
+			wm7, vm7, okm7 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm5, &vm6)) {
+				wm7, vm7, okm7 = wm6, vm6, true
+			}
+			wm8, vm8, okm8 = wm7, vm7, okm7
+			if okm4 && (!okm7 || networkLessKeyed(&vm7, &vm4)) {
+				wm8, vm8, okm8 = wm4, vm4, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm5, vm5, okm5 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm5, vm5, okm5 = wm1, vm1, true
+			}
+			wm7, vm7, okm7 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm5, &vm6)) {
+				wm7, vm7, okm7 = wm6, vm6, true
+			}
+			wm8, vm8, okm8 = wm7, vm7, okm7
+			if okm4 && (!okm7 || networkLessKeyed(&vm7, &vm4)) {
+				wm8, vm8, okm8 = wm4, vm4, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm6, vm6, okm6 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm6, vm6, okm6 = wm3, vm3, true
+			}
+			wm7, vm7, okm7 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm5, &vm6)) {
+				wm7, vm7, okm7 = wm6, vm6, true
+			}
+			wm8, vm8, okm8 = wm7, vm7, okm7
+			if okm4 && (!okm7 || networkLessKeyed(&vm7, &vm4)) {
+				wm8, vm8, okm8 = wm4, vm4, true
+			}
+		case 5:
+			r5 = r5[1:]
+			if ok5 = len(r5) > 0; ok5 {
+				v5 = r5[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm6, vm6, okm6 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm6, vm6, okm6 = wm3, vm3, true
+			}
+			wm7, vm7, okm7 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm5, &vm6)) {
+				wm7, vm7, okm7 = wm6, vm6, true
+			}
+			wm8, vm8, okm8 = wm7, vm7, okm7
+			if okm4 && (!okm7 || networkLessKeyed(&vm7, &vm4)) {
+				wm8, vm8, okm8 = wm4, vm4, true
+			}
+		case 6:
+			r6 = r6[1:]
+			if ok6 = len(r6) > 0; ok6 {
+				v6 = r6[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm6, vm6, okm6 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm6, vm6, okm6 = wm3, vm3, true
+			}
+			wm7, vm7, okm7 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm5, &vm6)) {
+				wm7, vm7, okm7 = wm6, vm6, true
+			}
+			wm8, vm8, okm8 = wm7, vm7, okm7
+			if okm4 && (!okm7 || networkLessKeyed(&vm7, &vm4)) {
+				wm8, vm8, okm8 = wm4, vm4, true
+			}
+		case 7:
+			r7 = r7[1:]
+			if ok7 = len(r7) > 0; ok7 {
+				v7 = r7[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm6, vm6, okm6 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm6, vm6, okm6 = wm3, vm3, true
+			}
+			wm7, vm7, okm7 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm5, &vm6)) {
+				wm7, vm7, okm7 = wm6, vm6, true
+			}
+			wm8, vm8, okm8 = wm7, vm7, okm7
+			if okm4 && (!okm7 || networkLessKeyed(&vm7, &vm4)) {
+				wm8, vm8, okm8 = wm4, vm4, true
+			}
+		case 8:
+			r8 = r8[1:]
+			if ok8 = len(r8) > 0; ok8 {
+				v8 = r8[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v8, &v9)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm8, vm8, okm8 = wm7, vm7, okm7
+			if okm4 && (!okm7 || networkLessKeyed(&vm7, &vm4)) {
+				wm8, vm8, okm8 = wm4, vm4, true
+			}
+		case 9:
+			r9 = r9[1:]
+			if ok9 = len(r9) > 0; ok9 {
+				v9 = r9[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v8, &v9)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm8, vm8, okm8 = wm7, vm7, okm7
+			if okm4 && (!okm7 || networkLessKeyed(&vm7, &vm4)) {
+				wm8, vm8, okm8 = wm4, vm4, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort11xKeyed(a []Keyed) {
+	_ = a[10]
+	if networkLessKeyed(&a[1], &a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[3], &a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[5], &a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if networkLessKeyed(&a[7], &a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[9], &a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if networkLessKeyed(&a[3], &a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[7], &a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if networkLessKeyed(&a[2], &a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[6], &a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if networkLessKeyed(&a[10], &a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if networkLessKeyed(&a[2], &a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[6], &a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[10], &a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if networkLessKeyed(&a[5], &a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if networkLessKeyed(&a[10], &a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if networkLessKeyed(&a[9], &a[5]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if networkLessKeyed(&a[6], &a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if networkLessKeyed(&a[5], &a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if networkLessKeyed(&a[10], &a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if networkLessKeyed(&a[4], &a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if networkLessKeyed(&a[7], &a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if networkLessKeyed(&a[8], &a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if networkLessKeyed(&a[4], &a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if networkLessKeyed(&a[4], &a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if networkLessKeyed(&a[10], &a[7]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if networkLessKeyed(&a[8], &a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if networkLessKeyed(&a[3], &a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[9], &a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if networkLessKeyed(&a[4], &a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if networkLessKeyed(&a[9], &a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if networkLessKeyed(&a[5], &a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if networkLessKeyed(&a[8], &a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if networkLessKeyed(&a[4], &a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[6], &a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[8], &a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+// NetworkMerge11xKeyed merges 11 runs, each of which must already be sorted
+// in increasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge11xKeyed(dst []Keyed, r0, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10 []Keyed) []Keyed {
+	var v0, v1, v2, v3, v4, v5, v6, v7, v8, v9, v10, vm0, vm1, vm2, vm3, vm4, vm5, vm6, vm7, vm8, vm9 Keyed
+	var wm0, wm1, wm2, wm3, wm4, wm5, wm6, wm7, wm8, wm9 int
+	var okm0, okm1, okm2, okm3, okm4, okm5, okm6, okm7, okm8, okm9 bool
+	ok0, ok1, ok2, ok3, ok4, ok5, ok6, ok7, ok8, ok9, ok10 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0, len(r5) > 0, len(r6) > 0, len(r7) > 0, len(r8) > 0, len(r9) > 0, len(r10) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	if ok5 {
+		v5 = r5[0]
+	}
+	if ok6 {
+		v6 = r6[0]
+	}
+	if ok7 {
+		v7 = r7[0]
+	}
+	if ok8 {
+		v8 = r8[0]
+	}
+	if ok9 {
+		v9 = r9[0]
+	}
+	if ok10 {
+		v10 = r10[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = 4, v4, ok4
+	if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+		wm2, vm2, okm2 = 5, v5, true
+	}
+	wm3, vm3, okm3 = 6, v6, ok6
+	if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+		wm3, vm3, okm3 = 7, v7, true
+	}
+	wm4, vm4, okm4 = 8, v8, ok8
+	if ok9 && (!ok8 || networkLessKeyed(&v9, &v8)) {
+		wm4, vm4, okm4 = 9, v9, true
+	}
+	wm5, vm5, okm5 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+		wm5, vm5, okm5 = wm1, vm1, true
+	}
+	wm6, vm6, okm6 = wm2, vm2, okm2
+	if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+		wm6, vm6, okm6 = wm3, vm3, true
+	}
+	wm7, vm7, okm7 = wm4, vm4, okm4
+	if ok10 && (!okm4 || networkLessKeyed(&v10, &vm4)) {
+		wm7, vm7, okm7 = 10, v10, true
+	}
+	wm8, vm8, okm8 = wm5, vm5, okm5
+	if okm6 && (!okm5 || networkLessKeyed(&vm6, &vm5)) {
+		wm8, vm8, okm8 = wm6, vm6, true
+	}
+	wm9, vm9, okm9 = wm8, vm8, okm8
+	if okm7 && (!okm8 || networkLessKeyed(&vm7, &vm8)) {
+		wm9, vm9, okm9 = wm7, vm7, true
+	}
+
+	for okm9 {
+		dst = append(dst, vm9)
+		switch wm9 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm5, vm5, okm5 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm5, vm5, okm5 = wm1, vm1, true
+			}
+			wm8, vm8, okm8 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm6, &vm5)) {
+				wm8, vm8, okm8 = wm6, vm6, true
+			}
+			wm9, vm9, okm9 = wm8, vm8, okm8
+			if okm7 && (!okm8 || networkLessKeyed(&vm7, &vm8)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm5, vm5, okm5 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm5, vm5, okm5 = wm1, vm1, true
+			}
+			wm8, vm8, okm8 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm6, &vm5)) {
+				wm8, vm8, okm8 = wm6, vm6, true
+			}
+			wm9, vm9, okm9 = wm8, vm8, okm8
+			if okm7 && (!okm8 || networkLessKeyed(&vm7, &vm8)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm5, vm5, okm5 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm5, vm5, okm5 = wm1, vm1, true
+			}
+			wm8, vm8, okm8 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm6, &vm5)) {
+				wm8, vm8, okm8 = wm6, vm6, true
+			}
+			wm9, vm9, okm9 = wm8, vm8, okm8
+			if okm7 && (!okm8 || networkLessKeyed(&vm7, &vm8)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm5, vm5, okm5 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm5, vm5, okm5 = wm1, vm1, true
+			}
+			wm8, vm8, okm8 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm6, &vm5)) {
+				wm8, vm8, okm8 = wm6, vm6, true
+			}
+			wm9, vm9, okm9 = wm8, vm8, okm8
+			if okm7 && (!okm8 || networkLessKeyed(&vm7, &vm8)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm6, vm6, okm6 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm6, vm6, okm6 = wm3, vm3, true
+			}
+			wm8, vm8, okm8 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm6, &vm5)) {
+				wm8, vm8, okm8 = wm6, vm6, true
+			}
+			wm9, vm9, okm9 = wm8, vm8, okm8
+			if okm7 && (!okm8 || networkLessKeyed(&vm7, &vm8)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+		case 5:
+			r5 = r5[1:]
+			if ok5 = len(r5) > 0; ok5 {
+				v5 = r5[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm6, vm6, okm6 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm6, vm6, okm6 = wm3, vm3, true
+			}
+			wm8, vm8, okm8 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm6, &vm5)) {
+				wm8, vm8, okm8 = wm6, vm6, true
+			}
+			wm9, vm9, okm9 = wm8, vm8, okm8
+			if okm7 && (!okm8 || networkLessKeyed(&vm7, &vm8)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+		case 6:
+			r6 = r6[1:]
+			if ok6 = len(r6) > 0; ok6 {
+				v6 = r6[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm6, vm6, okm6 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm6, vm6, okm6 = wm3, vm3, true
+			}
+			wm8, vm8, okm8 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm6, &vm5)) {
+				wm8, vm8, okm8 = wm6, vm6, true
+			}
+			wm9, vm9, okm9 = wm8, vm8, okm8
+			if okm7 && (!okm8 || networkLessKeyed(&vm7, &vm8)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+		case 7:
+			r7 = r7[1:]
+			if ok7 = len(r7) > 0; ok7 {
+				v7 = r7[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm6, vm6, okm6 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm6, vm6, okm6 = wm3, vm3, true
+			}
+			wm8, vm8, okm8 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm6, &vm5)) {
+				wm8, vm8, okm8 = wm6, vm6, true
+			}
+			wm9, vm9, okm9 = wm8, vm8, okm8
+			if okm7 && (!okm8 || networkLessKeyed(&vm7, &vm8)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+		case 8:
+			r8 = r8[1:]
+			if ok8 = len(r8) > 0; ok8 {
+				v8 = r8[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v9, &v8)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm7, vm7, okm7 = wm4, vm4, okm4
+			if ok10 && (!okm4 || networkLessKeyed(&v10, &vm4)) {
+				wm7, vm7, okm7 = 10, v10, true
+			}
+			wm9, vm9, okm9 = wm8, vm8, okm8
+			if okm7 && (!okm8 || networkLessKeyed(&vm7, &vm8)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+		case 9:
+			r9 = r9[1:]
+			if ok9 = len(r9) > 0; ok9 {
+				v9 = r9[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v9, &v8)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm7, vm7, okm7 = wm4, vm4, okm4
+			if ok10 && (!okm4 || networkLessKeyed(&v10, &vm4)) {
+				wm7, vm7, okm7 = 10, v10, true
+			}
+			wm9, vm9, okm9 = wm8, vm8, okm8
+			if okm7 && (!okm8 || networkLessKeyed(&vm7, &vm8)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+		case 10:
+			r10 = r10[1:]
+			if ok10 = len(r10) > 0; ok10 {
+				v10 = r10[0]
+			}
+			wm7, vm7, okm7 = wm4, vm4, okm4
+			if ok10 && (!okm4 || networkLessKeyed(&v10, &vm4)) {
+				wm7, vm7, okm7 = 10, v10, true
+			}
+			wm9, vm9, okm9 = wm8, vm8, okm8
+			if okm7 && (!okm8 || networkLessKeyed(&vm7, &vm8)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort11xKeyedReverse(a []Keyed) {
+	_ = a[10]
+	if networkLessKeyed(&a[0], &a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[2], &a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[4], &a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if networkLessKeyed(&a[6], &a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[8], &a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if networkLessKeyed(&a[1], &a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[5], &a[7]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if networkLessKeyed(&a[0], &a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[4], &a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if networkLessKeyed(&a[8], &a[10]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if networkLessKeyed(&a[1], &a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[5], &a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[9], &a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if networkLessKeyed(&a[1], &a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if networkLessKeyed(&a[6], &a[10]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if networkLessKeyed(&a[5], &a[9]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if networkLessKeyed(&a[2], &a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if networkLessKeyed(&a[1], &a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if networkLessKeyed(&a[6], &a[10]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if networkLessKeyed(&a[0], &a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if networkLessKeyed(&a[3], &a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if networkLessKeyed(&a[4], &a[8]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if networkLessKeyed(&a[0], &a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if networkLessKeyed(&a[1], &a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if networkLessKeyed(&a[7], &a[10]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if networkLessKeyed(&a[3], &a[8]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if networkLessKeyed(&a[2], &a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[8], &a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if networkLessKeyed(&a[2], &a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if networkLessKeyed(&a[7], &a[9]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if networkLessKeyed(&a[3], &a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if networkLessKeyed(&a[6], &a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if networkLessKeyed(&a[3], &a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[5], &a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[7], &a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+// NetworkMerge11xKeyedReverse merges 11 runs, each of which must already be sorted
+// in decreasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge11xKeyedReverse(dst []Keyed, r0, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10 []Keyed) []Keyed {
+	var v0, v1, v2, v3, v4, v5, v6, v7, v8, v9, v10, vm0, vm1, vm2, vm3, vm4, vm5, vm6, vm7, vm8, vm9 Keyed
+	var wm0, wm1, wm2, wm3, wm4, wm5, wm6, wm7, wm8, wm9 int
+	var okm0, okm1, okm2, okm3, okm4, okm5, okm6, okm7, okm8, okm9 bool
+	ok0, ok1, ok2, ok3, ok4, ok5, ok6, ok7, ok8, ok9, ok10 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0, len(r5) > 0, len(r6) > 0, len(r7) > 0, len(r8) > 0, len(r9) > 0, len(r10) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	if ok5 {
+		v5 = r5[0]
+	}
+	if ok6 {
+		v6 = r6[0]
+	}
+	if ok7 {
+		v7 = r7[0]
+	}
+	if ok8 {
+		v8 = r8[0]
+	}
+	if ok9 {
+		v9 = r9[0]
+	}
+	if ok10 {
+		v10 = r10[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = 4, v4, ok4
+	if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+		wm2, vm2, okm2 = 5, v5, true
+	}
+	wm3, vm3, okm3 = 6, v6, ok6
+	if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+		wm3, vm3, okm3 = 7, v7, true
+	}
+	wm4, vm4, okm4 = 8, v8, ok8
+	if ok9 && (!ok8 || networkLessKeyed(&v8, &v9)) {
+		wm4, vm4, okm4 = 9, v9, true
+	}
+	wm5, vm5, okm5 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+		wm5, vm5, okm5 = wm1, vm1, true
+	}
+	wm6, vm6, okm6 = wm2, vm2, okm2
+	if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+		wm6, vm6, okm6 = wm3, vm3, true
+	}
+	wm7, vm7, okm7 = wm4, vm4, okm4
+	if ok10 && (!okm4 || networkLessKeyed(&vm4, &v10)) {
+		wm7, vm7, okm7 = 10, v10, true
+	}
+	wm8, vm8, okm8 = wm5, vm5, okm5
+	if okm6 && (!okm5 || networkLessKeyed(&vm5, &vm6)) {
+		wm8, vm8, okm8 = wm6, vm6, true
+	}
+	wm9, vm9, okm9 = wm8, vm8, okm8
+	if okm7 && (!okm8 || networkLessKeyed(&vm8, &vm7)) {
+		wm9, vm9, okm9 = wm7, vm7, true
+	}
+
+	for okm9 {
+		dst = append(dst, vm9)
+		switch wm9 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm5, vm5, okm5 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm5, vm5, okm5 = wm1, vm1, true
+			}
+			wm8, vm8, okm8 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm5, &vm6)) {
+				wm8, vm8, okm8 = wm6, vm6, true
+			}
+			wm9, vm9, okm9 = wm8, vm8, okm8
+			if okm7 && (!okm8 || networkLessKeyed(&vm8, &vm7)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm5, vm5, okm5 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm5, vm5, okm5 = wm1, vm1, true
+			}
+			wm8, vm8, okm8 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm5, &vm6)) {
+				wm8, vm8, okm8 = wm6, vm6, true
+			}
+			wm9, vm9, okm9 = wm8, vm8, okm8
+			if okm7 && (!okm8 || networkLessKeyed(&vm8, &vm7)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm5, vm5, okm5 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm5, vm5, okm5 = wm1, vm1, true
+			}
+			wm8, vm8, okm8 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm5, &vm6)) {
+				wm8, vm8, okm8 = wm6, vm6, true
+			}
+			wm9, vm9, okm9 = wm8, vm8, okm8
+			if okm7 && (!okm8 || networkLessKeyed(&vm8, &vm7)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm5, vm5, okm5 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm5, vm5, okm5 = wm1, vm1, true
+			}
+			wm8, vm8, okm8 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm5, &vm6)) {
+				wm8, vm8, okm8 = wm6, vm6, true
+			}
+			wm9, vm9, okm9 = wm8, vm8, okm8
+			if okm7 && (!okm8 || networkLessKeyed(&vm8, &vm7)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm6, vm6, okm6 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm6, vm6, okm6 = wm3, vm3, true
+			}
+			wm8, vm8, okm8 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm5, &vm6)) {
+				wm8, vm8, okm8 = wm6, vm6, true
+			}
+			wm9, vm9, okm9 = wm8, vm8, okm8
+			if okm7 && (!okm8 || networkLessKeyed(&vm8, &vm7)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+		case 5:
+			r5 = r5[1:]
+			if ok5 = len(r5) > 0; ok5 {
+				v5 = r5[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm6, vm6, okm6 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm6, vm6, okm6 = wm3, vm3, true
+			}
+			wm8, vm8, okm8 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm5, &vm6)) {
+				wm8, vm8, okm8 = wm6, vm6, true
+			}
+			wm9, vm9, okm9 = wm8, vm8, okm8
+			if okm7 && (!okm8 || networkLessKeyed(&vm8, &vm7)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+		case 6:
+			r6 = r6[1:]
+			if ok6 = len(r6) > 0; ok6 {
+				v6 = r6[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm6, vm6, okm6 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm6, vm6, okm6 = wm3, vm3, true
+			}
+			wm8, vm8, okm8 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm5, &vm6)) {
+				wm8, vm8, okm8 = wm6, vm6, true
+			}
+			wm9, vm9, okm9 = wm8, vm8, okm8
+			if okm7 && (!okm8 || networkLessKeyed(&vm8, &vm7)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+		case 7:
+			r7 = r7[1:]
+			if ok7 = len(r7) > 0; ok7 {
+				v7 = r7[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm6, vm6, okm6 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm6, vm6, okm6 = wm3, vm3, true
+			}
+			wm8, vm8, okm8 = wm5, vm5, okm5
+			if okm6 && (!okm5 || networkLessKeyed(&vm5, &vm6)) {
+				wm8, vm8, okm8 = wm6, vm6, true
+			}
+			wm9, vm9, okm9 = wm8, vm8, okm8
+			if okm7 && (!okm8 || networkLessKeyed(&vm8, &vm7)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+		case 8:
+			r8 = r8[1:]
+			if ok8 = len(r8) > 0; ok8 {
+				v8 = r8[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v8, &v9)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm7, vm7, okm7 = wm4, vm4, okm4
+			if ok10 && (!okm4 || networkLessKeyed(&vm4, &v10)) {
+				wm7, vm7, okm7 = 10, v10, true
+			}
+			wm9, vm9, okm9 = wm8, vm8, okm8
+			if okm7 && (!okm8 || networkLessKeyed(&vm8, &vm7)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+		case 9:
+			r9 = r9[1:]
+			if ok9 = len(r9) > 0; ok9 {
+				v9 = r9[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v8, &v9)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm7, vm7, okm7 = wm4, vm4, okm4
+			if ok10 && (!okm4 || networkLessKeyed(&vm4, &v10)) {
+				wm7, vm7, okm7 = 10, v10, true
+			}
+			wm9, vm9, okm9 = wm8, vm8, okm8
+			if okm7 && (!okm8 || networkLessKeyed(&vm8, &vm7)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+		case 10:
+			r10 = r10[1:]
+			if ok10 = len(r10) > 0; ok10 {
+				v10 = r10[0]
+			}
+			wm7, vm7, okm7 = wm4, vm4, okm4
+			if ok10 && (!okm4 || networkLessKeyed(&vm4, &v10)) {
+				wm7, vm7, okm7 = 10, v10, true
+			}
+			wm9, vm9, okm9 = wm8, vm8, okm8
+			if okm7 && (!okm8 || networkLessKeyed(&vm8, &vm7)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort12xKeyed(a []Keyed) {
+	_ = a[11]
+	if networkLessKeyed(&a[1], &a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[3], &a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[5], &a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if networkLessKeyed(&a[7], &a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[9], &a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if networkLessKeyed(&a[11], &a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if networkLessKeyed(&a[3], &a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[7], &a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if networkLessKeyed(&a[11], &a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if networkLessKeyed(&a[2], &a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[6], &a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if networkLessKeyed(&a[10], &a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if networkLessKeyed(&a[2], &a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[6], &a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[10], &a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if networkLessKeyed(&a[5], &a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if networkLessKeyed(&a[10], &a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if networkLessKeyed(&a[9], &a[5]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if networkLessKeyed(&a[6], &a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if networkLessKeyed(&a[5], &a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if networkLessKeyed(&a[10], &a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if networkLessKeyed(&a[4], &a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if networkLessKeyed(&a[11], &a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if networkLessKeyed(&a[7], &a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if networkLessKeyed(&a[8], &a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if networkLessKeyed(&a[4], &a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if networkLessKeyed(&a[11], &a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if networkLessKeyed(&a[4], &a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if networkLessKeyed(&a[10], &a[7]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if networkLessKeyed(&a[8], &a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if networkLessKeyed(&a[3], &a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[9], &a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if networkLessKeyed(&a[4], &a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if networkLessKeyed(&a[9], &a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if networkLessKeyed(&a[5], &a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if networkLessKeyed(&a[8], &a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if networkLessKeyed(&a[4], &a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[6], &a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[8], &a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+// NetworkMerge12xKeyed merges 12 runs, each of which must already be sorted
+// in increasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge12xKeyed(dst []Keyed, r0, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11 []Keyed) []Keyed {
+	var v0, v1, v2, v3, v4, v5, v6, v7, v8, v9, v10, v11, vm0, vm1, vm2, vm3, vm4, vm5, vm6, vm7, vm8, vm9, vm10 Keyed
+	var wm0, wm1, wm2, wm3, wm4, wm5, wm6, wm7, wm8, wm9, wm10 int
+	var okm0, okm1, okm2, okm3, okm4, okm5, okm6, okm7, okm8, okm9, okm10 bool
+	ok0, ok1, ok2, ok3, ok4, ok5, ok6, ok7, ok8, ok9, ok10, ok11 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0, len(r5) > 0, len(r6) > 0, len(r7) > 0, len(r8) > 0, len(r9) > 0, len(r10) > 0, len(r11) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	if ok5 {
+		v5 = r5[0]
+	}
+	if ok6 {
+		v6 = r6[0]
+	}
+	if ok7 {
+		v7 = r7[0]
+	}
+	if ok8 {
+		v8 = r8[0]
+	}
+	if ok9 {
+		v9 = r9[0]
+	}
+	if ok10 {
+		v10 = r10[0]
+	}
+	if ok11 {
+		v11 = r11[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = 4, v4, ok4
+	if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+		wm2, vm2, okm2 = 5, v5, true
+	}
+	wm3, vm3, okm3 = 6, v6, ok6
+	if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+		wm3, vm3, okm3 = 7, v7, true
+	}
+	wm4, vm4, okm4 = 8, v8, ok8
+	if ok9 && (!ok8 || networkLessKeyed(&v9, &v8)) {
+		wm4, vm4, okm4 = 9, v9, true
+	}
+	wm5, vm5, okm5 = 10, v10, ok10
+	if ok11 && (!ok10 || networkLessKeyed(&v11, &v10)) {
+		wm5, vm5, okm5 = 11, v11, true
+	}
+	wm6, vm6, okm6 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+		wm6, vm6, okm6 = wm1, vm1, true
+	}
+	wm7, vm7, okm7 = wm2, vm2, okm2
+	if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+		wm7, vm7, okm7 = wm3, vm3, true
+	}
+	wm8, vm8, okm8 = wm4, vm4, okm4
+	if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+		wm8, vm8, okm8 = wm5, vm5, true
+	}
+	wm9, vm9, okm9 = wm6, vm6, okm6
+	if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+		wm9, vm9, okm9 = wm7, vm7, true
+	}
+	wm10, vm10, okm10 = wm9, vm9, okm9
+	if okm8 && (!okm9 || networkLessKeyed(&vm8, &vm9)) {
+		wm10, vm10, okm10 = wm8, vm8, true
+	}
+
+	for okm10 {
+		dst = append(dst, vm10)
+		switch wm10 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm6, vm6, okm6 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm6, vm6, okm6 = wm1, vm1, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm10, vm10, okm10 = wm9, vm9, okm9
+			if okm8 && (!okm9 || networkLessKeyed(&vm8, &vm9)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm6, vm6, okm6 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm6, vm6, okm6 = wm1, vm1, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm10, vm10, okm10 = wm9, vm9, okm9
+			if okm8 && (!okm9 || networkLessKeyed(&vm8, &vm9)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm6, vm6, okm6 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm6, vm6, okm6 = wm1, vm1, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm10, vm10, okm10 = wm9, vm9, okm9
+			if okm8 && (!okm9 || networkLessKeyed(&vm8, &vm9)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm6, vm6, okm6 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm6, vm6, okm6 = wm1, vm1, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm10, vm10, okm10 = wm9, vm9, okm9
+			if okm8 && (!okm9 || networkLessKeyed(&vm8, &vm9)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm7, vm7, okm7 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm7, vm7, okm7 = wm3, vm3, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm10, vm10, okm10 = wm9, vm9, okm9
+			if okm8 && (!okm9 || networkLessKeyed(&vm8, &vm9)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+		case 5:
+			r5 = r5[1:]
+			if ok5 = len(r5) > 0; ok5 {
+				v5 = r5[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm7, vm7, okm7 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm7, vm7, okm7 = wm3, vm3, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm10, vm10, okm10 = wm9, vm9, okm9
+			if okm8 && (!okm9 || networkLessKeyed(&vm8, &vm9)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+		case 6:
+			r6 = r6[1:]
+			if ok6 = len(r6) > 0; ok6 {
+				v6 = r6[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm7, vm7, okm7 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm7, vm7, okm7 = wm3, vm3, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm10, vm10, okm10 = wm9, vm9, okm9
+			if okm8 && (!okm9 || networkLessKeyed(&vm8, &vm9)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+		case 7:
+			r7 = r7[1:]
+			if ok7 = len(r7) > 0; ok7 {
+				v7 = r7[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm7, vm7, okm7 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm7, vm7, okm7 = wm3, vm3, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm10, vm10, okm10 = wm9, vm9, okm9
+			if okm8 && (!okm9 || networkLessKeyed(&vm8, &vm9)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+		case 8:
+			r8 = r8[1:]
+			if ok8 = len(r8) > 0; ok8 {
+				v8 = r8[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v9, &v8)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm8, vm8, okm8 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm8, vm8, okm8 = wm5, vm5, true
+			}
+			wm10, vm10, okm10 = wm9, vm9, okm9
+			if okm8 && (!okm9 || networkLessKeyed(&vm8, &vm9)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+		case 9:
+			r9 = r9[1:]
+			if ok9 = len(r9) > 0; ok9 {
+				v9 = r9[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v9, &v8)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm8, vm8, okm8 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm8, vm8, okm8 = wm5, vm5, true
+			}
+			wm10, vm10, okm10 = wm9, vm9, okm9
+			if okm8 && (!okm9 || networkLessKeyed(&vm8, &vm9)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+		case 10:
+			r10 = r10[1:]
+			if ok10 = len(r10) > 0; ok10 {
+				v10 = r10[0]
+			}
+			wm5, vm5, okm5 = 10, v10, ok10
+			if ok11 && (!ok10 || networkLessKeyed(&v11, &v10)) {
+				wm5, vm5, okm5 = 11, v11, true
+			}
+			wm8, vm8, okm8 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm8, vm8, okm8 = wm5, vm5, true
+			}
+			wm10, vm10, okm10 = wm9, vm9, okm9
+			if okm8 && (!okm9 || networkLessKeyed(&vm8, &vm9)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+		case 11:
+			r11 = r11[1:]
+			if ok11 = len(r11) > 0; ok11 {
+				v11 = r11[0]
+			}
+			wm5, vm5, okm5 = 10, v10, ok10
+			if ok11 && (!ok10 || networkLessKeyed(&v11, &v10)) {
+				wm5, vm5, okm5 = 11, v11, true
+			}
+			wm8, vm8, okm8 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm8, vm8, okm8 = wm5, vm5, true
+			}
+			wm10, vm10, okm10 = wm9, vm9, okm9
+			if okm8 && (!okm9 || networkLessKeyed(&vm8, &vm9)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort12xKeyedReverse(a []Keyed) {
+	_ = a[11]
+	if networkLessKeyed(&a[0], &a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[2], &a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[4], &a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if networkLessKeyed(&a[6], &a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[8], &a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if networkLessKeyed(&a[10], &a[11]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if networkLessKeyed(&a[1], &a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[5], &a[7]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if networkLessKeyed(&a[9], &a[11]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if networkLessKeyed(&a[0], &a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[4], &a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if networkLessKeyed(&a[8], &a[10]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if networkLessKeyed(&a[1], &a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[5], &a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[9], &a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if networkLessKeyed(&a[1], &a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if networkLessKeyed(&a[6], &a[10]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if networkLessKeyed(&a[5], &a[9]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if networkLessKeyed(&a[2], &a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if networkLessKeyed(&a[1], &a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if networkLessKeyed(&a[6], &a[10]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if networkLessKeyed(&a[0], &a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if networkLessKeyed(&a[7], &a[11]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if networkLessKeyed(&a[3], &a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if networkLessKeyed(&a[4], &a[8]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if networkLessKeyed(&a[0], &a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if networkLessKeyed(&a[7], &a[11]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if networkLessKeyed(&a[1], &a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if networkLessKeyed(&a[7], &a[10]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if networkLessKeyed(&a[3], &a[8]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if networkLessKeyed(&a[2], &a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[8], &a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if networkLessKeyed(&a[2], &a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if networkLessKeyed(&a[7], &a[9]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if networkLessKeyed(&a[3], &a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if networkLessKeyed(&a[6], &a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if networkLessKeyed(&a[3], &a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[5], &a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[7], &a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+// NetworkMerge12xKeyedReverse merges 12 runs, each of which must already be sorted
+// in decreasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge12xKeyedReverse(dst []Keyed, r0, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11 []Keyed) []Keyed {
+	var v0, v1, v2, v3, v4, v5, v6, v7, v8, v9, v10, v11, vm0, vm1, vm2, vm3, vm4, vm5, vm6, vm7, vm8, vm9, vm10 Keyed
+	var wm0, wm1, wm2, wm3, wm4, wm5, wm6, wm7, wm8, wm9, wm10 int
+	var okm0, okm1, okm2, okm3, okm4, okm5, okm6, okm7, okm8, okm9, okm10 bool
+	ok0, ok1, ok2, ok3, ok4, ok5, ok6, ok7, ok8, ok9, ok10, ok11 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0, len(r5) > 0, len(r6) > 0, len(r7) > 0, len(r8) > 0, len(r9) > 0, len(r10) > 0, len(r11) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	if ok5 {
+		v5 = r5[0]
+	}
+	if ok6 {
+		v6 = r6[0]
+	}
+	if ok7 {
+		v7 = r7[0]
+	}
+	if ok8 {
+		v8 = r8[0]
+	}
+	if ok9 {
+		v9 = r9[0]
+	}
+	if ok10 {
+		v10 = r10[0]
+	}
+	if ok11 {
+		v11 = r11[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = 4, v4, ok4
+	if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+		wm2, vm2, okm2 = 5, v5, true
+	}
+	wm3, vm3, okm3 = 6, v6, ok6
+	if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+		wm3, vm3, okm3 = 7, v7, true
+	}
+	wm4, vm4, okm4 = 8, v8, ok8
+	if ok9 && (!ok8 || networkLessKeyed(&v8, &v9)) {
+		wm4, vm4, okm4 = 9, v9, true
+	}
+	wm5, vm5, okm5 = 10, v10, ok10
+	if ok11 && (!ok10 || networkLessKeyed(&v10, &v11)) {
+		wm5, vm5, okm5 = 11, v11, true
+	}
+	wm6, vm6, okm6 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+		wm6, vm6, okm6 = wm1, vm1, true
+	}
+	wm7, vm7, okm7 = wm2, vm2, okm2
+	if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+		wm7, vm7, okm7 = wm3, vm3, true
+	}
+	wm8, vm8, okm8 = wm4, vm4, okm4
+	if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+		wm8, vm8, okm8 = wm5, vm5, true
+	}
+	wm9, vm9, okm9 = wm6, vm6, okm6
+	if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+		wm9, vm9, okm9 = wm7, vm7, true
+	}
+	wm10, vm10, okm10 = wm9, vm9, okm9
+	if okm8 && (!okm9 || networkLessKeyed(&vm9, &vm8)) {
+		wm10, vm10, okm10 = wm8, vm8, true
+	}
+
+	for okm10 {
+		dst = append(dst, vm10)
+		switch wm10 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm6, vm6, okm6 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm6, vm6, okm6 = wm1, vm1, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm10, vm10, okm10 = wm9, vm9, okm9
+			if okm8 && (!okm9 || networkLessKeyed(&vm9, &vm8)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm6, vm6, okm6 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm6, vm6, okm6 = wm1, vm1, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm10, vm10, okm10 = wm9, vm9, okm9
+			if okm8 && (!okm9 || networkLessKeyed(&vm9, &vm8)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm6, vm6, okm6 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm6, vm6, okm6 = wm1, vm1, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm10, vm10, okm10 = wm9, vm9, okm9
+			if okm8 && (!okm9 || networkLessKeyed(&vm9, &vm8)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm6, vm6, okm6 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm6, vm6, okm6 = wm1, vm1, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm10, vm10, okm10 = wm9, vm9, okm9
+			if okm8 && (!okm9 || networkLessKeyed(&vm9, &vm8)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm7, vm7, okm7 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm7, vm7, okm7 = wm3, vm3, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm10, vm10, okm10 = wm9, vm9, okm9
+			if okm8 && (!okm9 || networkLessKeyed(&vm9, &vm8)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+		case 5:
+			r5 = r5[1:]
+			if ok5 = len(r5) > 0; ok5 {
+				v5 = r5[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm7, vm7, okm7 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm7, vm7, okm7 = wm3, vm3, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm10, vm10, okm10 = wm9, vm9, okm9
+			if okm8 && (!okm9 || networkLessKeyed(&vm9, &vm8)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+		case 6:
+			r6 = r6[1:]
+			if ok6 = len(r6) > 0; ok6 {
+				v6 = r6[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm7, vm7, okm7 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm7, vm7, okm7 = wm3, vm3, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm10, vm10, okm10 = wm9, vm9, okm9
+			if okm8 && (!okm9 || networkLessKeyed(&vm9, &vm8)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+		case 7:
+			r7 = r7[1:]
+			if ok7 = len(r7) > 0; ok7 {
+				v7 = r7[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm7, vm7, okm7 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm7, vm7, okm7 = wm3, vm3, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm10, vm10, okm10 = wm9, vm9, okm9
+			if okm8 && (!okm9 || networkLessKeyed(&vm9, &vm8)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+		case 8:
+			r8 = r8[1:]
+			if ok8 = len(r8) > 0; ok8 {
+				v8 = r8[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v8, &v9)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm8, vm8, okm8 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm8, vm8, okm8 = wm5, vm5, true
+			}
+			wm10, vm10, okm10 = wm9, vm9, okm9
+			if okm8 && (!okm9 || networkLessKeyed(&vm9, &vm8)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+		case 9:
+			r9 = r9[1:]
+			if ok9 = len(r9) > 0; ok9 {
+				v9 = r9[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v8, &v9)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm8, vm8, okm8 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm8, vm8, okm8 = wm5, vm5, true
+			}
+			wm10, vm10, okm10 = wm9, vm9, okm9
+			if okm8 && (!okm9 || networkLessKeyed(&vm9, &vm8)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+		case 10:
+			r10 = r10[1:]
+			if ok10 = len(r10) > 0; ok10 {
+				v10 = r10[0]
+			}
+			wm5, vm5, okm5 = 10, v10, ok10
+			if ok11 && (!ok10 || networkLessKeyed(&v10, &v11)) {
+				wm5, vm5, okm5 = 11, v11, true
+			}
+			wm8, vm8, okm8 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm8, vm8, okm8 = wm5, vm5, true
+			}
+			wm10, vm10, okm10 = wm9, vm9, okm9
+			if okm8 && (!okm9 || networkLessKeyed(&vm9, &vm8)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+		case 11:
+			r11 = r11[1:]
+			if ok11 = len(r11) > 0; ok11 {
+				v11 = r11[0]
+			}
+			wm5, vm5, okm5 = 10, v10, ok10
+			if ok11 && (!ok10 || networkLessKeyed(&v10, &v11)) {
+				wm5, vm5, okm5 = 11, v11, true
+			}
+			wm8, vm8, okm8 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm8, vm8, okm8 = wm5, vm5, true
+			}
+			wm10, vm10, okm10 = wm9, vm9, okm9
+			if okm8 && (!okm9 || networkLessKeyed(&vm9, &vm8)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort13xKeyed(a []Keyed) {
+	_ = a[12]
+	if networkLessKeyed(&a[7], &a[1]) {
+		a[1], a[7] = a[7], a[1]
+	}
+	if networkLessKeyed(&a[11], &a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if networkLessKeyed(&a[4], &a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[8], &a[5]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if networkLessKeyed(&a[12], &a[0]) {
+		a[0], a[12] = a[12], a[0]
+	}
+	if networkLessKeyed(&a[6], &a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if networkLessKeyed(&a[1], &a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[3], &a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[6], &a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if networkLessKeyed(&a[11], &a[8]) {
+		a[8], a[11] = a[11], a[8]
+	}
+	if networkLessKeyed(&a[12], &a[7]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if networkLessKeyed(&a[9], &a[5]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if networkLessKeyed(&a[2], &a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[7], &a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if networkLessKeyed(&a[11], &a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if networkLessKeyed(&a[4], &a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if networkLessKeyed(&a[12], &a[6]) {
+		a[6], a[12] = a[12], a[6]
+	}
+	if networkLessKeyed(&a[8], &a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if networkLessKeyed(&a[12], &a[11]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if networkLessKeyed(&a[9], &a[4]) {
+		a[4], a[9] = a[9], a[4]
+	}
+	if networkLessKeyed(&a[10], &a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if networkLessKeyed(&a[4], &a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[6], &a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[9], &a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if networkLessKeyed(&a[11], &a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if networkLessKeyed(&a[7], &a[1]) {
+		a[1], a[7] = a[7], a[1]
+	}
+	if networkLessKeyed(&a[6], &a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if networkLessKeyed(&a[11], &a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if networkLessKeyed(&a[3], &a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[7], &a[4]) {
+		a[4], a[7] = a[7], a[4]
+	}
+	if networkLessKeyed(&a[10], &a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if networkLessKeyed(&a[5], &a[0]) {
+		a[0], a[5] = a[5], a[0]
+	}
+	if networkLessKeyed(&a[5], &a[2]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if networkLessKeyed(&a[8], &a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if networkLessKeyed(&a[10], &a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if networkLessKeyed(&a[2], &a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[5], &a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if networkLessKeyed(&a[8], &a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if networkLessKeyed(&a[6], &a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if networkLessKeyed(&a[3], &a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[5], &a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if networkLessKeyed(&a[7], &a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[9], &a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if networkLessKeyed(&a[4], &a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[6], &a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+// NetworkMerge13xKeyed merges 13 runs, each of which must already be sorted
+// in increasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge13xKeyed(dst []Keyed, r0, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12 []Keyed) []Keyed {
+	var v0, v1, v2, v3, v4, v5, v6, v7, v8, v9, v10, v11, v12, vm0, vm1, vm2, vm3, vm4, vm5, vm6, vm7, vm8, vm9, vm10, vm11 Keyed
+	var wm0, wm1, wm2, wm3, wm4, wm5, wm6, wm7, wm8, wm9, wm10, wm11 int
+	var okm0, okm1, okm2, okm3, okm4, okm5, okm6, okm7, okm8, okm9, okm10, okm11 bool
+	ok0, ok1, ok2, ok3, ok4, ok5, ok6, ok7, ok8, ok9, ok10, ok11, ok12 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0, len(r5) > 0, len(r6) > 0, len(r7) > 0, len(r8) > 0, len(r9) > 0, len(r10) > 0, len(r11) > 0, len(r12) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	if ok5 {
+		v5 = r5[0]
+	}
+	if ok6 {
+		v6 = r6[0]
+	}
+	if ok7 {
+		v7 = r7[0]
+	}
+	if ok8 {
+		v8 = r8[0]
+	}
+	if ok9 {
+		v9 = r9[0]
+	}
+	if ok10 {
+		v10 = r10[0]
+	}
+	if ok11 {
+		v11 = r11[0]
+	}
+	if ok12 {
+		v12 = r12[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = 4, v4, ok4
+	if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+		wm2, vm2, okm2 = 5, v5, true
+	}
+	wm3, vm3, okm3 = 6, v6, ok6
+	if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+		wm3, vm3, okm3 = 7, v7, true
+	}
+	wm4, vm4, okm4 = 8, v8, ok8
+	if ok9 && (!ok8 || networkLessKeyed(&v9, &v8)) {
+		wm4, vm4, okm4 = 9, v9, true
+	}
+	wm5, vm5, okm5 = 10, v10, ok10
+	if ok11 && (!ok10 || networkLessKeyed(&v11, &v10)) {
+		wm5, vm5, okm5 = 11, v11, true
+	}
+	wm6, vm6, okm6 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+		wm6, vm6, okm6 = wm1, vm1, true
+	}
+	wm7, vm7, okm7 = wm2, vm2, okm2
+	if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+		wm7, vm7, okm7 = wm3, vm3, true
+	}
+	wm8, vm8, okm8 = wm4, vm4, okm4
+	if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+		wm8, vm8, okm8 = wm5, vm5, true
+	}
+	wm9, vm9, okm9 = wm6, vm6, okm6
+	if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+		wm9, vm9, okm9 = wm7, vm7, true
+	}
+	wm10, vm10, okm10 = wm8, vm8, okm8
+	if ok12 && (!okm8 || networkLessKeyed(&v12, &vm8)) {
+		wm10, vm10, okm10 = 12, v12, true
+	}
+	wm11, vm11, okm11 = wm9, vm9, okm9
+	if okm10 && (!okm9 || networkLessKeyed(&vm10, &vm9)) {
+		wm11, vm11, okm11 = wm10, vm10, true
+	}
+
+	for okm11 {
+		dst = append(dst, vm11)
+		switch wm11 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm6, vm6, okm6 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm6, vm6, okm6 = wm1, vm1, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm10, &vm9)) {
+				wm11, vm11, okm11 = wm10, vm10, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm6, vm6, okm6 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm6, vm6, okm6 = wm1, vm1, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm10, &vm9)) {
+				wm11, vm11, okm11 = wm10, vm10, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm6, vm6, okm6 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm6, vm6, okm6 = wm1, vm1, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm10, &vm9)) {
+				wm11, vm11, okm11 = wm10, vm10, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm6, vm6, okm6 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm6, vm6, okm6 = wm1, vm1, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm10, &vm9)) {
+				wm11, vm11, okm11 = wm10, vm10, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm7, vm7, okm7 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm7, vm7, okm7 = wm3, vm3, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm10, &vm9)) {
+				wm11, vm11, okm11 = wm10, vm10, true
+			}
+		case 5:
+			r5 = r5[1:]
+			if ok5 = len(r5) > 0; ok5 {
+				v5 = r5[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm7, vm7, okm7 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm7, vm7, okm7 = wm3, vm3, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm10, &vm9)) {
+				wm11, vm11, okm11 = wm10, vm10, true
+			}
+		case 6:
+			r6 = r6[1:]
+			if ok6 = len(r6) > 0; ok6 {
+				v6 = r6[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm7, vm7, okm7 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm7, vm7, okm7 = wm3, vm3, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm10, &vm9)) {
+				wm11, vm11, okm11 = wm10, vm10, true
+			}
+		case 7:
+			r7 = r7[1:]
+			if ok7 = len(r7) > 0; ok7 {
+				v7 = r7[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm7, vm7, okm7 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm7, vm7, okm7 = wm3, vm3, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm10, &vm9)) {
+				wm11, vm11, okm11 = wm10, vm10, true
+			}
+		case 8:
+			r8 = r8[1:]
+			if ok8 = len(r8) > 0; ok8 {
+				v8 = r8[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v9, &v8)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm8, vm8, okm8 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm8, vm8, okm8 = wm5, vm5, true
+			}
+			wm10, vm10, okm10 = wm8, vm8, okm8
+			if ok12 && (!okm8 || networkLessKeyed(&v12, &vm8)) {
+				wm10, vm10, okm10 = 12, v12, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm10, &vm9)) {
+				wm11, vm11, okm11 = wm10, vm10, true
+			}
+		case 9:
+			r9 = r9[1:]
+			if ok9 = len(r9) > 0; ok9 {
+				v9 = r9[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v9, &v8)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm8, vm8, okm8 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm8, vm8, okm8 = wm5, vm5, true
+			}
+			wm10, vm10, okm10 = wm8, vm8, okm8
+			if ok12 && (!okm8 || networkLessKeyed(&v12, &vm8)) {
+				wm10, vm10, okm10 = 12, v12, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm10, &vm9)) {
+				wm11, vm11, okm11 = wm10, vm10, true
+			}
+		case 10:
+			r10 = r10[1:]
+			if ok10 = len(r10) > 0; ok10 {
+				v10 = r10[0]
+			}
+			wm5, vm5, okm5 = 10, v10, ok10
+			if ok11 && (!ok10 || networkLessKeyed(&v11, &v10)) {
+				wm5, vm5, okm5 = 11, v11, true
+			}
+			wm8, vm8, okm8 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm8, vm8, okm8 = wm5, vm5, true
+			}
+			wm10, vm10, okm10 = wm8, vm8, okm8
+			if ok12 && (!okm8 || networkLessKeyed(&v12, &vm8)) {
+				wm10, vm10, okm10 = 12, v12, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm10, &vm9)) {
+				wm11, vm11, okm11 = wm10, vm10, true
+			}
+		case 11:
+			r11 = r11[1:]
+			if ok11 = len(r11) > 0; ok11 {
+				v11 = r11[0]
+			}
+			wm5, vm5, okm5 = 10, v10, ok10
+			if ok11 && (!ok10 || networkLessKeyed(&v11, &v10)) {
+				wm5, vm5, okm5 = 11, v11, true
+			}
+			wm8, vm8, okm8 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm8, vm8, okm8 = wm5, vm5, true
+			}
+			wm10, vm10, okm10 = wm8, vm8, okm8
+			if ok12 && (!okm8 || networkLessKeyed(&v12, &vm8)) {
+				wm10, vm10, okm10 = 12, v12, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm10, &vm9)) {
+				wm11, vm11, okm11 = wm10, vm10, true
+			}
+		case 12:
+			r12 = r12[1:]
+			if ok12 = len(r12) > 0; ok12 {
+				v12 = r12[0]
+			}
+			wm10, vm10, okm10 = wm8, vm8, okm8
+			if ok12 && (!okm8 || networkLessKeyed(&v12, &vm8)) {
+				wm10, vm10, okm10 = 12, v12, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm10, &vm9)) {
+				wm11, vm11, okm11 = wm10, vm10, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort13xKeyedReverse(a []Keyed) {
+	_ = a[12]
+	if networkLessKeyed(&a[1], &a[7]) {
+		a[1], a[7] = a[7], a[1]
+	}
+	if networkLessKeyed(&a[9], &a[11]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if networkLessKeyed(&a[3], &a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[5], &a[8]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if networkLessKeyed(&a[0], &a[12]) {
+		a[0], a[12] = a[12], a[0]
+	}
+	if networkLessKeyed(&a[2], &a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if networkLessKeyed(&a[0], &a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[2], &a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[4], &a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if networkLessKeyed(&a[8], &a[11]) {
+		a[8], a[11] = a[11], a[8]
+	}
+	if networkLessKeyed(&a[7], &a[12]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if networkLessKeyed(&a[5], &a[9]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if networkLessKeyed(&a[0], &a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[3], &a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if networkLessKeyed(&a[10], &a[11]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if networkLessKeyed(&a[1], &a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if networkLessKeyed(&a[6], &a[12]) {
+		a[6], a[12] = a[12], a[6]
+	}
+	if networkLessKeyed(&a[7], &a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if networkLessKeyed(&a[11], &a[12]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if networkLessKeyed(&a[4], &a[9]) {
+		a[4], a[9] = a[9], a[4]
+	}
+	if networkLessKeyed(&a[6], &a[10]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if networkLessKeyed(&a[3], &a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[5], &a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[8], &a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if networkLessKeyed(&a[10], &a[11]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if networkLessKeyed(&a[1], &a[7]) {
+		a[1], a[7] = a[7], a[1]
+	}
+	if networkLessKeyed(&a[2], &a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if networkLessKeyed(&a[9], &a[11]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if networkLessKeyed(&a[1], &a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[4], &a[7]) {
+		a[4], a[7] = a[7], a[4]
+	}
+	if networkLessKeyed(&a[8], &a[10]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if networkLessKeyed(&a[0], &a[5]) {
+		a[0], a[5] = a[5], a[0]
+	}
+	if networkLessKeyed(&a[2], &a[5]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if networkLessKeyed(&a[6], &a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if networkLessKeyed(&a[9], &a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if networkLessKeyed(&a[1], &a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[3], &a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if networkLessKeyed(&a[7], &a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if networkLessKeyed(&a[4], &a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if networkLessKeyed(&a[2], &a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[4], &a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if networkLessKeyed(&a[6], &a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[8], &a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if networkLessKeyed(&a[3], &a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[5], &a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+// NetworkMerge13xKeyedReverse merges 13 runs, each of which must already be sorted
+// in decreasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge13xKeyedReverse(dst []Keyed, r0, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12 []Keyed) []Keyed {
+	var v0, v1, v2, v3, v4, v5, v6, v7, v8, v9, v10, v11, v12, vm0, vm1, vm2, vm3, vm4, vm5, vm6, vm7, vm8, vm9, vm10, vm11 Keyed
+	var wm0, wm1, wm2, wm3, wm4, wm5, wm6, wm7, wm8, wm9, wm10, wm11 int
+	var okm0, okm1, okm2, okm3, okm4, okm5, okm6, okm7, okm8, okm9, okm10, okm11 bool
+	ok0, ok1, ok2, ok3, ok4, ok5, ok6, ok7, ok8, ok9, ok10, ok11, ok12 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0, len(r5) > 0, len(r6) > 0, len(r7) > 0, len(r8) > 0, len(r9) > 0, len(r10) > 0, len(r11) > 0, len(r12) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	if ok5 {
+		v5 = r5[0]
+	}
+	if ok6 {
+		v6 = r6[0]
+	}
+	if ok7 {
+		v7 = r7[0]
+	}
+	if ok8 {
+		v8 = r8[0]
+	}
+	if ok9 {
+		v9 = r9[0]
+	}
+	if ok10 {
+		v10 = r10[0]
+	}
+	if ok11 {
+		v11 = r11[0]
+	}
+	if ok12 {
+		v12 = r12[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = 4, v4, ok4
+	if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+		wm2, vm2, okm2 = 5, v5, true
+	}
+	wm3, vm3, okm3 = 6, v6, ok6
+	if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+		wm3, vm3, okm3 = 7, v7, true
+	}
+	wm4, vm4, okm4 = 8, v8, ok8
+	if ok9 && (!ok8 || networkLessKeyed(&v8, &v9)) {
+		wm4, vm4, okm4 = 9, v9, true
+	}
+	wm5, vm5, okm5 = 10, v10, ok10
+	if ok11 && (!ok10 || networkLessKeyed(&v10, &v11)) {
+		wm5, vm5, okm5 = 11, v11, true
+	}
+	wm6, vm6, okm6 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+		wm6, vm6, okm6 = wm1, vm1, true
+	}
+	wm7, vm7, okm7 = wm2, vm2, okm2
+	if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+		wm7, vm7, okm7 = wm3, vm3, true
+	}
+	wm8, vm8, okm8 = wm4, vm4, okm4
+	if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+		wm8, vm8, okm8 = wm5, vm5, true
+	}
+	wm9, vm9, okm9 = wm6, vm6, okm6
+	if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+		wm9, vm9, okm9 = wm7, vm7, true
+	}
+	wm10, vm10, okm10 = wm8, vm8, okm8
+	if ok12 && (!okm8 || networkLessKeyed(&vm8, &v12)) {
+		wm10, vm10, okm10 = 12, v12, true
+	}
+	wm11, vm11, okm11 = wm9, vm9, okm9
+	if okm10 && (!okm9 || networkLessKeyed(&vm9, &vm10)) {
+		wm11, vm11, okm11 = wm10, vm10, true
+	}
+
+	for okm11 {
+		dst = append(dst, vm11)
+		switch wm11 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm6, vm6, okm6 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm6, vm6, okm6 = wm1, vm1, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm9, &vm10)) {
+				wm11, vm11, okm11 = wm10, vm10, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm6, vm6, okm6 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm6, vm6, okm6 = wm1, vm1, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm9, &vm10)) {
+				wm11, vm11, okm11 = wm10, vm10, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm6, vm6, okm6 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm6, vm6, okm6 = wm1, vm1, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm9, &vm10)) {
+				wm11, vm11, okm11 = wm10, vm10, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm6, vm6, okm6 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm6, vm6, okm6 = wm1, vm1, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm9, &vm10)) {
+				wm11, vm11, okm11 = wm10, vm10, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm7, vm7, okm7 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm7, vm7, okm7 = wm3, vm3, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm9, &vm10)) {
+				wm11, vm11, okm11 = wm10, vm10, true
+			}
+		case 5:
+			r5 = r5[1:]
+			if ok5 = len(r5) > 0; ok5 {
+				v5 = r5[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm7, vm7, okm7 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm7, vm7, okm7 = wm3, vm3, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm9, &vm10)) {
+				wm11, vm11, okm11 = wm10, vm10, true
+			}
+		case 6:
+			r6 = r6[1:]
+			if ok6 = len(r6) > 0; ok6 {
+				v6 = r6[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm7, vm7, okm7 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm7, vm7, okm7 = wm3, vm3, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm9, &vm10)) {
+				wm11, vm11, okm11 = wm10, vm10, true
+			}
+		case 7:
+			r7 = r7[1:]
+			if ok7 = len(r7) > 0; ok7 {
+				v7 = r7[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm7, vm7, okm7 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm7, vm7, okm7 = wm3, vm3, true
+			}
+			wm9, vm9, okm9 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+				wm9, vm9, okm9 = wm7, vm7, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm9, &vm10)) {
+				wm11, vm11, okm11 = wm10, vm10, true
+			}
+		case 8:
+			r8 = r8[1:]
+			if ok8 = len(r8) > 0; ok8 {
+				v8 = r8[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v8, &v9)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm8, vm8, okm8 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm8, vm8, okm8 = wm5, vm5, true
+			}
+			wm10, vm10, okm10 = wm8, vm8, okm8
+			if ok12 && (!okm8 || networkLessKeyed(&vm8, &v12)) {
+				wm10, vm10, okm10 = 12, v12, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm9, &vm10)) {
+				wm11, vm11, okm11 = wm10, vm10, true
+			}
+		case 9:
+			r9 = r9[1:]
+			if ok9 = len(r9) > 0; ok9 {
+				v9 = r9[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v8, &v9)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm8, vm8, okm8 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm8, vm8, okm8 = wm5, vm5, true
+			}
+			wm10, vm10, okm10 = wm8, vm8, okm8
+			if ok12 && (!okm8 || networkLessKeyed(&vm8, &v12)) {
+				wm10, vm10, okm10 = 12, v12, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm9, &vm10)) {
+				wm11, vm11, okm11 = wm10, vm10, true
+			}
+		case 10:
+			r10 = r10[1:]
+			if ok10 = len(r10) > 0; ok10 {
+				v10 = r10[0]
+			}
+			wm5, vm5, okm5 = 10, v10, ok10
+			if ok11 && (!ok10 || networkLessKeyed(&v10, &v11)) {
+				wm5, vm5, okm5 = 11, v11, true
+			}
+			wm8, vm8, okm8 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm8, vm8, okm8 = wm5, vm5, true
+			}
+			wm10, vm10, okm10 = wm8, vm8, okm8
+			if ok12 && (!okm8 || networkLessKeyed(&vm8, &v12)) {
+				wm10, vm10, okm10 = 12, v12, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm9, &vm10)) {
+				wm11, vm11, okm11 = wm10, vm10, true
+			}
+		case 11:
+			r11 = r11[1:]
+			if ok11 = len(r11) > 0; ok11 {
+				v11 = r11[0]
+			}
+			wm5, vm5, okm5 = 10, v10, ok10
+			if ok11 && (!ok10 || networkLessKeyed(&v10, &v11)) {
+				wm5, vm5, okm5 = 11, v11, true
+			}
+			wm8, vm8, okm8 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm8, vm8, okm8 = wm5, vm5, true
+			}
+			wm10, vm10, okm10 = wm8, vm8, okm8
+			if ok12 && (!okm8 || networkLessKeyed(&vm8, &v12)) {
+				wm10, vm10, okm10 = 12, v12, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm9, &vm10)) {
+				wm11, vm11, okm11 = wm10, vm10, true
+			}
+		case 12:
+			r12 = r12[1:]
+			if ok12 = len(r12) > 0; ok12 {
+				v12 = r12[0]
+			}
+			wm10, vm10, okm10 = wm8, vm8, okm8
+			if ok12 && (!okm8 || networkLessKeyed(&vm8, &v12)) {
+				wm10, vm10, okm10 = 12, v12, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm9, &vm10)) {
+				wm11, vm11, okm11 = wm10, vm10, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort14xKeyed(a []Keyed) {
+	_ = a[13]
+	if networkLessKeyed(&a[1], &a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[3], &a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[5], &a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if networkLessKeyed(&a[7], &a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[9], &a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if networkLessKeyed(&a[11], &a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if networkLessKeyed(&a[13], &a[12]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if networkLessKeyed(&a[2], &a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[6], &a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if networkLessKeyed(&a[10], &a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if networkLessKeyed(&a[3], &a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[7], &a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if networkLessKeyed(&a[11], &a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if networkLessKeyed(&a[4], &a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if networkLessKeyed(&a[12], &a[8]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if networkLessKeyed(&a[5], &a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if networkLessKeyed(&a[13], &a[9]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if networkLessKeyed(&a[6], &a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if networkLessKeyed(&a[7], &a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if networkLessKeyed(&a[8], &a[0]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if networkLessKeyed(&a[9], &a[1]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if networkLessKeyed(&a[10], &a[2]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if networkLessKeyed(&a[11], &a[3]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if networkLessKeyed(&a[12], &a[4]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if networkLessKeyed(&a[13], &a[5]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if networkLessKeyed(&a[10], &a[5]) {
+		a[5], a[10] = a[10], a[5]
+	}
+	if networkLessKeyed(&a[9], &a[6]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if networkLessKeyed(&a[12], &a[3]) {
+		a[3], a[12] = a[12], a[3]
+	}
+	if networkLessKeyed(&a[11], &a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if networkLessKeyed(&a[2], &a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[8], &a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if networkLessKeyed(&a[4], &a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if networkLessKeyed(&a[13], &a[7]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if networkLessKeyed(&a[8], &a[2]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if networkLessKeyed(&a[4], &a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if networkLessKeyed(&a[6], &a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[10], &a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if networkLessKeyed(&a[13], &a[11]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if networkLessKeyed(&a[8], &a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if networkLessKeyed(&a[12], &a[7]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if networkLessKeyed(&a[8], &a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if networkLessKeyed(&a[12], &a[10]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if networkLessKeyed(&a[5], &a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if networkLessKeyed(&a[9], &a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if networkLessKeyed(&a[4], &a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[6], &a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[8], &a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if networkLessKeyed(&a[10], &a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if networkLessKeyed(&a[12], &a[11]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if networkLessKeyed(&a[7], &a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[9], &a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+// NetworkMerge14xKeyed merges 14 runs, each of which must already be sorted
+// in increasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge14xKeyed(dst []Keyed, r0, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13 []Keyed) []Keyed {
+	var v0, v1, v2, v3, v4, v5, v6, v7, v8, v9, v10, v11, v12, v13, vm0, vm1, vm2, vm3, vm4, vm5, vm6, vm7, vm8, vm9, vm10, vm11, vm12 Keyed
+	var wm0, wm1, wm2, wm3, wm4, wm5, wm6, wm7, wm8, wm9, wm10, wm11, wm12 int
+	var okm0, okm1, okm2, okm3, okm4, okm5, okm6, okm7, okm8, okm9, okm10, okm11, okm12 bool
+	ok0, ok1, ok2, ok3, ok4, ok5, ok6, ok7, ok8, ok9, ok10, ok11, ok12, ok13 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0, len(r5) > 0, len(r6) > 0, len(r7) > 0, len(r8) > 0, len(r9) > 0, len(r10) > 0, len(r11) > 0, len(r12) > 0, len(r13) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	if ok5 {
+		v5 = r5[0]
+	}
+	if ok6 {
+		v6 = r6[0]
+	}
+	if ok7 {
+		v7 = r7[0]
+	}
+	if ok8 {
+		v8 = r8[0]
+	}
+	if ok9 {
+		v9 = r9[0]
+	}
+	if ok10 {
+		v10 = r10[0]
+	}
+	if ok11 {
+		v11 = r11[0]
+	}
+	if ok12 {
+		v12 = r12[0]
+	}
+	if ok13 {
+		v13 = r13[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = 4, v4, ok4
+	if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+		wm2, vm2, okm2 = 5, v5, true
+	}
+	wm3, vm3, okm3 = 6, v6, ok6
+	if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+		wm3, vm3, okm3 = 7, v7, true
+	}
+	wm4, vm4, okm4 = 8, v8, ok8
+	if ok9 && (!ok8 || networkLessKeyed(&v9, &v8)) {
+		wm4, vm4, okm4 = 9, v9, true
+	}
+	wm5, vm5, okm5 = 10, v10, ok10
+	if ok11 && (!ok10 || networkLessKeyed(&v11, &v10)) {
+		wm5, vm5, okm5 = 11, v11, true
+	}
+	wm6, vm6, okm6 = 12, v12, ok12
+	if ok13 && (!ok12 || networkLessKeyed(&v13, &v12)) {
+		wm6, vm6, okm6 = 13, v13, true
+	}
+	wm7, vm7, okm7 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+		wm7, vm7, okm7 = wm1, vm1, true
+	}
+	wm8, vm8, okm8 = wm2, vm2, okm2
+	if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+		wm8, vm8, okm8 = wm3, vm3, true
+	}
+	wm9, vm9, okm9 = wm4, vm4, okm4
+	if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+		wm9, vm9, okm9 = wm5, vm5, true
+	}
+	wm10, vm10, okm10 = wm7, vm7, okm7
+	if okm8 && (!okm7 || networkLessKeyed(&vm8, &vm7)) {
+		wm10, vm10, okm10 = wm8, vm8, true
+	}
+	wm11, vm11, okm11 = wm9, vm9, okm9
+	if okm6 && (!okm9 || networkLessKeyed(&vm6, &vm9)) {
+		wm11, vm11, okm11 = wm6, vm6, true
+	}
+	wm12, vm12, okm12 = wm10, vm10, okm10
+	if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+		wm12, vm12, okm12 = wm11, vm11, true
+	}
+
+	for okm12 {
+		dst = append(dst, vm12)
+		switch wm12 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm7, vm7, okm7 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm7, vm7, okm7 = wm1, vm1, true
+			}
+			wm10, vm10, okm10 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm8, &vm7)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+			wm12, vm12, okm12 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm12, vm12, okm12 = wm11, vm11, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm7, vm7, okm7 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm7, vm7, okm7 = wm1, vm1, true
+			}
+			wm10, vm10, okm10 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm8, &vm7)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+			wm12, vm12, okm12 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm12, vm12, okm12 = wm11, vm11, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm7, vm7, okm7 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm7, vm7, okm7 = wm1, vm1, true
+			}
+			wm10, vm10, okm10 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm8, &vm7)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+			wm12, vm12, okm12 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm12, vm12, okm12 = wm11, vm11, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm7, vm7, okm7 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm7, vm7, okm7 = wm1, vm1, true
+			}
+			wm10, vm10, okm10 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm8, &vm7)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+			wm12, vm12, okm12 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm12, vm12, okm12 = wm11, vm11, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm8, vm8, okm8 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm8, vm8, okm8 = wm3, vm3, true
+			}
+			wm10, vm10, okm10 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm8, &vm7)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+			wm12, vm12, okm12 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm12, vm12, okm12 = wm11, vm11, true
+			}
+		case 5:
+			r5 = r5[1:]
+			if ok5 = len(r5) > 0; ok5 {
+				v5 = r5[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm8, vm8, okm8 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm8, vm8, okm8 = wm3, vm3, true
+			}
+			wm10, vm10, okm10 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm8, &vm7)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+			wm12, vm12, okm12 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm12, vm12, okm12 = wm11, vm11, true
+			}
+		case 6:
+			r6 = r6[1:]
+			if ok6 = len(r6) > 0; ok6 {
+				v6 = r6[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm8, vm8, okm8 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm8, vm8, okm8 = wm3, vm3, true
+			}
+			wm10, vm10, okm10 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm8, &vm7)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+			wm12, vm12, okm12 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm12, vm12, okm12 = wm11, vm11, true
+			}
+		case 7:
+			r7 = r7[1:]
+			if ok7 = len(r7) > 0; ok7 {
+				v7 = r7[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm8, vm8, okm8 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm8, vm8, okm8 = wm3, vm3, true
+			}
+			wm10, vm10, okm10 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm8, &vm7)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+			wm12, vm12, okm12 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm12, vm12, okm12 = wm11, vm11, true
+			}
+		case 8:
+			r8 = r8[1:]
+			if ok8 = len(r8) > 0; ok8 {
+				v8 = r8[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v9, &v8)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm9, vm9, okm9 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm9, vm9, okm9 = wm5, vm5, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm6 && (!okm9 || networkLessKeyed(&vm6, &vm9)) {
+				wm11, vm11, okm11 = wm6, vm6, true
+			}
+			wm12, vm12, okm12 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm12, vm12, okm12 = wm11, vm11, true
+			}
+		case 9:
+			r9 = r9[1:]
+			if ok9 = len(r9) > 0; ok9 {
+				v9 = r9[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v9, &v8)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm9, vm9, okm9 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm9, vm9, okm9 = wm5, vm5, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm6 && (!okm9 || networkLessKeyed(&vm6, &vm9)) {
+				wm11, vm11, okm11 = wm6, vm6, true
+			}
+			wm12, vm12, okm12 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm12, vm12, okm12 = wm11, vm11, true
+			}
+		case 10:
+			r10 = r10[1:]
+			if ok10 = len(r10) > 0; ok10 {
+				v10 = r10[0]
+			}
+			wm5, vm5, okm5 = 10, v10, ok10
+			if ok11 && (!ok10 || networkLessKeyed(&v11, &v10)) {
+				wm5, vm5, okm5 = 11, v11, true
+			}
+			wm9, vm9, okm9 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm9, vm9, okm9 = wm5, vm5, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm6 && (!okm9 || networkLessKeyed(&vm6, &vm9)) {
+				wm11, vm11, okm11 = wm6, vm6, true
+			}
+			wm12, vm12, okm12 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm12, vm12, okm12 = wm11, vm11, true
+			}
+		case 11:
+			r11 = r11[1:]
+			if ok11 = len(r11) > 0; ok11 {
+				v11 = r11[0]
+			}
+			wm5, vm5, okm5 = 10, v10, ok10
+			if ok11 && (!ok10 || networkLessKeyed(&v11, &v10)) {
+				wm5, vm5, okm5 = 11, v11, true
+			}
+			wm9, vm9, okm9 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm9, vm9, okm9 = wm5, vm5, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm6 && (!okm9 || networkLessKeyed(&vm6, &vm9)) {
+				wm11, vm11, okm11 = wm6, vm6, true
+			}
+			wm12, vm12, okm12 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm12, vm12, okm12 = wm11, vm11, true
+			}
+		case 12:
+			r12 = r12[1:]
+			if ok12 = len(r12) > 0; ok12 {
+				v12 = r12[0]
+			}
+			wm6, vm6, okm6 = 12, v12, ok12
+			if ok13 && (!ok12 || networkLessKeyed(&v13, &v12)) {
+				wm6, vm6, okm6 = 13, v13, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm6 && (!okm9 || networkLessKeyed(&vm6, &vm9)) {
+				wm11, vm11, okm11 = wm6, vm6, true
+			}
+			wm12, vm12, okm12 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm12, vm12, okm12 = wm11, vm11, true
+			}
+		case 13:
+			r13 = r13[1:]
+			if ok13 = len(r13) > 0; ok13 {
+				v13 = r13[0]
+			}
+			wm6, vm6, okm6 = 12, v12, ok12
+			if ok13 && (!ok12 || networkLessKeyed(&v13, &v12)) {
+				wm6, vm6, okm6 = 13, v13, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm6 && (!okm9 || networkLessKeyed(&vm6, &vm9)) {
+				wm11, vm11, okm11 = wm6, vm6, true
+			}
+			wm12, vm12, okm12 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm12, vm12, okm12 = wm11, vm11, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort14xKeyedReverse(a []Keyed) {
+	_ = a[13]
+	if networkLessKeyed(&a[0], &a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[2], &a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[4], &a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if networkLessKeyed(&a[6], &a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[8], &a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if networkLessKeyed(&a[10], &a[11]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if networkLessKeyed(&a[12], &a[13]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if networkLessKeyed(&a[0], &a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[4], &a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if networkLessKeyed(&a[8], &a[10]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if networkLessKeyed(&a[1], &a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[5], &a[7]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if networkLessKeyed(&a[9], &a[11]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if networkLessKeyed(&a[0], &a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if networkLessKeyed(&a[8], &a[12]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if networkLessKeyed(&a[1], &a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if networkLessKeyed(&a[9], &a[13]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if networkLessKeyed(&a[2], &a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if networkLessKeyed(&a[3], &a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if networkLessKeyed(&a[0], &a[8]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if networkLessKeyed(&a[1], &a[9]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if networkLessKeyed(&a[2], &a[10]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if networkLessKeyed(&a[3], &a[11]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if networkLessKeyed(&a[4], &a[12]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if networkLessKeyed(&a[5], &a[13]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if networkLessKeyed(&a[5], &a[10]) {
+		a[5], a[10] = a[10], a[5]
+	}
+	if networkLessKeyed(&a[6], &a[9]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if networkLessKeyed(&a[3], &a[12]) {
+		a[3], a[12] = a[12], a[3]
+	}
+	if networkLessKeyed(&a[7], &a[11]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if networkLessKeyed(&a[1], &a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[4], &a[8]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if networkLessKeyed(&a[1], &a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if networkLessKeyed(&a[7], &a[13]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if networkLessKeyed(&a[2], &a[8]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if networkLessKeyed(&a[2], &a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if networkLessKeyed(&a[5], &a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[9], &a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if networkLessKeyed(&a[11], &a[13]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if networkLessKeyed(&a[3], &a[8]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if networkLessKeyed(&a[7], &a[12]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if networkLessKeyed(&a[6], &a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if networkLessKeyed(&a[10], &a[12]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if networkLessKeyed(&a[3], &a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if networkLessKeyed(&a[7], &a[9]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if networkLessKeyed(&a[3], &a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[5], &a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[7], &a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if networkLessKeyed(&a[9], &a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if networkLessKeyed(&a[11], &a[12]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if networkLessKeyed(&a[6], &a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[8], &a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+// NetworkMerge14xKeyedReverse merges 14 runs, each of which must already be sorted
+// in decreasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge14xKeyedReverse(dst []Keyed, r0, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13 []Keyed) []Keyed {
+	var v0, v1, v2, v3, v4, v5, v6, v7, v8, v9, v10, v11, v12, v13, vm0, vm1, vm2, vm3, vm4, vm5, vm6, vm7, vm8, vm9, vm10, vm11, vm12 Keyed
+	var wm0, wm1, wm2, wm3, wm4, wm5, wm6, wm7, wm8, wm9, wm10, wm11, wm12 int
+	var okm0, okm1, okm2, okm3, okm4, okm5, okm6, okm7, okm8, okm9, okm10, okm11, okm12 bool
+	ok0, ok1, ok2, ok3, ok4, ok5, ok6, ok7, ok8, ok9, ok10, ok11, ok12, ok13 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0, len(r5) > 0, len(r6) > 0, len(r7) > 0, len(r8) > 0, len(r9) > 0, len(r10) > 0, len(r11) > 0, len(r12) > 0, len(r13) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	if ok5 {
+		v5 = r5[0]
+	}
+	if ok6 {
+		v6 = r6[0]
+	}
+	if ok7 {
+		v7 = r7[0]
+	}
+	if ok8 {
+		v8 = r8[0]
+	}
+	if ok9 {
+		v9 = r9[0]
+	}
+	if ok10 {
+		v10 = r10[0]
+	}
+	if ok11 {
+		v11 = r11[0]
+	}
+	if ok12 {
+		v12 = r12[0]
+	}
+	if ok13 {
+		v13 = r13[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = 4, v4, ok4
+	if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+		wm2, vm2, okm2 = 5, v5, true
+	}
+	wm3, vm3, okm3 = 6, v6, ok6
+	if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+		wm3, vm3, okm3 = 7, v7, true
+	}
+	wm4, vm4, okm4 = 8, v8, ok8
+	if ok9 && (!ok8 || networkLessKeyed(&v8, &v9)) {
+		wm4, vm4, okm4 = 9, v9, true
+	}
+	wm5, vm5, okm5 = 10, v10, ok10
+	if ok11 && (!ok10 || networkLessKeyed(&v10, &v11)) {
+		wm5, vm5, okm5 = 11, v11, true
+	}
+	wm6, vm6, okm6 = 12, v12, ok12
+	if ok13 && (!ok12 || networkLessKeyed(&v12, &v13)) {
+		wm6, vm6, okm6 = 13, v13, true
+	}
+	wm7, vm7, okm7 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+		wm7, vm7, okm7 = wm1, vm1, true
+	}
+	wm8, vm8, okm8 = wm2, vm2, okm2
+	if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+		wm8, vm8, okm8 = wm3, vm3, true
+	}
+	wm9, vm9, okm9 = wm4, vm4, okm4
+	if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+		wm9, vm9, okm9 = wm5, vm5, true
+	}
+	wm10, vm10, okm10 = wm7, vm7, okm7
+	if okm8 && (!okm7 || networkLessKeyed(&vm7, &vm8)) {
+		wm10, vm10, okm10 = wm8, vm8, true
+	}
+	wm11, vm11, okm11 = wm9, vm9, okm9
+	if okm6 && (!okm9 || networkLessKeyed(&vm9, &vm6)) {
+		wm11, vm11, okm11 = wm6, vm6, true
+	}
+	wm12, vm12, okm12 = wm10, vm10, okm10
+	if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+		wm12, vm12, okm12 = wm11, vm11, true
+	}
+
+	for okm12 {
+		dst = append(dst, vm12)
+		switch wm12 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm7, vm7, okm7 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm7, vm7, okm7 = wm1, vm1, true
+			}
+			wm10, vm10, okm10 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm7, &vm8)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+			wm12, vm12, okm12 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm12, vm12, okm12 = wm11, vm11, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm7, vm7, okm7 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm7, vm7, okm7 = wm1, vm1, true
+			}
+			wm10, vm10, okm10 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm7, &vm8)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+			wm12, vm12, okm12 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm12, vm12, okm12 = wm11, vm11, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm7, vm7, okm7 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm7, vm7, okm7 = wm1, vm1, true
+			}
+			wm10, vm10, okm10 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm7, &vm8)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+			wm12, vm12, okm12 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm12, vm12, okm12 = wm11, vm11, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm7, vm7, okm7 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm7, vm7, okm7 = wm1, vm1, true
+			}
+			wm10, vm10, okm10 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm7, &vm8)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+			wm12, vm12, okm12 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm12, vm12, okm12 = wm11, vm11, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm8, vm8, okm8 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm8, vm8, okm8 = wm3, vm3, true
+			}
+			wm10, vm10, okm10 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm7, &vm8)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+			wm12, vm12, okm12 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm12, vm12, okm12 = wm11, vm11, true
+			}
+		case 5:
+			r5 = r5[1:]
+			if ok5 = len(r5) > 0; ok5 {
+				v5 = r5[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm8, vm8, okm8 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm8, vm8, okm8 = wm3, vm3, true
+			}
+			wm10, vm10, okm10 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm7, &vm8)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+			wm12, vm12, okm12 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm12, vm12, okm12 = wm11, vm11, true
+			}
+		case 6:
+			r6 = r6[1:]
+			if ok6 = len(r6) > 0; ok6 {
+				v6 = r6[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm8, vm8, okm8 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm8, vm8, okm8 = wm3, vm3, true
+			}
+			wm10, vm10, okm10 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm7, &vm8)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+			wm12, vm12, okm12 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm12, vm12, okm12 = wm11, vm11, true
+			}
+		case 7:
+			r7 = r7[1:]
+			if ok7 = len(r7) > 0; ok7 {
+				v7 = r7[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm8, vm8, okm8 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm8, vm8, okm8 = wm3, vm3, true
+			}
+			wm10, vm10, okm10 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm7, &vm8)) {
+				wm10, vm10, okm10 = wm8, vm8, true
+			}
+			wm12, vm12, okm12 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm12, vm12, okm12 = wm11, vm11, true
+			}
+		case 8:
+			r8 = r8[1:]
+			if ok8 = len(r8) > 0; ok8 {
+				v8 = r8[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v8, &v9)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm9, vm9, okm9 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm9, vm9, okm9 = wm5, vm5, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm6 && (!okm9 || networkLessKeyed(&vm9, &vm6)) {
+				wm11, vm11, okm11 = wm6, vm6, true
+			}
+			wm12, vm12, okm12 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm12, vm12, okm12 = wm11, vm11, true
+			}
+		case 9:
+			r9 = r9[1:]
+			if ok9 = len(r9) > 0; ok9 {
+				v9 = r9[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v8, &v9)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm9, vm9, okm9 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm9, vm9, okm9 = wm5, vm5, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm6 && (!okm9 || networkLessKeyed(&vm9, &vm6)) {
+				wm11, vm11, okm11 = wm6, vm6, true
+			}
+			wm12, vm12, okm12 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm12, vm12, okm12 = wm11, vm11, true
+			}
+		case 10:
+			r10 = r10[1:]
+			if ok10 = len(r10) > 0; ok10 {
+				v10 = r10[0]
+			}
+			wm5, vm5, okm5 = 10, v10, ok10
+			if ok11 && (!ok10 || networkLessKeyed(&v10, &v11)) {
+				wm5, vm5, okm5 = 11, v11, true
+			}
+			wm9, vm9, okm9 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm9, vm9, okm9 = wm5, vm5, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm6 && (!okm9 || networkLessKeyed(&vm9, &vm6)) {
+				wm11, vm11, okm11 = wm6, vm6, true
+			}
+			wm12, vm12, okm12 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm12, vm12, okm12 = wm11, vm11, true
+			}
+		case 11:
+			r11 = r11[1:]
+			if ok11 = len(r11) > 0; ok11 {
+				v11 = r11[0]
+			}
+			wm5, vm5, okm5 = 10, v10, ok10
+			if ok11 && (!ok10 || networkLessKeyed(&v10, &v11)) {
+				wm5, vm5, okm5 = 11, v11, true
+			}
+			wm9, vm9, okm9 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm9, vm9, okm9 = wm5, vm5, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm6 && (!okm9 || networkLessKeyed(&vm9, &vm6)) {
+				wm11, vm11, okm11 = wm6, vm6, true
+			}
+			wm12, vm12, okm12 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm12, vm12, okm12 = wm11, vm11, true
+			}
+		case 12:
+			r12 = r12[1:]
+			if ok12 = len(r12) > 0; ok12 {
+				v12 = r12[0]
+			}
+			wm6, vm6, okm6 = 12, v12, ok12
+			if ok13 && (!ok12 || networkLessKeyed(&v12, &v13)) {
+				wm6, vm6, okm6 = 13, v13, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm6 && (!okm9 || networkLessKeyed(&vm9, &vm6)) {
+				wm11, vm11, okm11 = wm6, vm6, true
+			}
+			wm12, vm12, okm12 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm12, vm12, okm12 = wm11, vm11, true
+			}
+		case 13:
+			r13 = r13[1:]
+			if ok13 = len(r13) > 0; ok13 {
+				v13 = r13[0]
+			}
+			wm6, vm6, okm6 = 12, v12, ok12
+			if ok13 && (!ok12 || networkLessKeyed(&v12, &v13)) {
+				wm6, vm6, okm6 = 13, v13, true
+			}
+			wm11, vm11, okm11 = wm9, vm9, okm9
+			if okm6 && (!okm9 || networkLessKeyed(&vm9, &vm6)) {
+				wm11, vm11, okm11 = wm6, vm6, true
+			}
+			wm12, vm12, okm12 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm12, vm12, okm12 = wm11, vm11, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort15xKeyed(a []Keyed) {
+	_ = a[14]
+	if networkLessKeyed(&a[1], &a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[3], &a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[5], &a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if networkLessKeyed(&a[7], &a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[9], &a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if networkLessKeyed(&a[11], &a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if networkLessKeyed(&a[13], &a[12]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if networkLessKeyed(&a[2], &a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[6], &a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if networkLessKeyed(&a[10], &a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if networkLessKeyed(&a[14], &a[12]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if networkLessKeyed(&a[3], &a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[7], &a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if networkLessKeyed(&a[11], &a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if networkLessKeyed(&a[4], &a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if networkLessKeyed(&a[12], &a[8]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if networkLessKeyed(&a[5], &a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if networkLessKeyed(&a[13], &a[9]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if networkLessKeyed(&a[6], &a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if networkLessKeyed(&a[14], &a[10]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if networkLessKeyed(&a[7], &a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if networkLessKeyed(&a[8], &a[0]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if networkLessKeyed(&a[9], &a[1]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if networkLessKeyed(&a[10], &a[2]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if networkLessKeyed(&a[11], &a[3]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if networkLessKeyed(&a[12], &a[4]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if networkLessKeyed(&a[13], &a[5]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if networkLessKeyed(&a[14], &a[6]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if networkLessKeyed(&a[10], &a[5]) {
+		a[5], a[10] = a[10], a[5]
+	}
+	if networkLessKeyed(&a[9], &a[6]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if networkLessKeyed(&a[12], &a[3]) {
+		a[3], a[12] = a[12], a[3]
+	}
+	if networkLessKeyed(&a[14], &a[13]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if networkLessKeyed(&a[11], &a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if networkLessKeyed(&a[2], &a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[8], &a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if networkLessKeyed(&a[4], &a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if networkLessKeyed(&a[13], &a[7]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if networkLessKeyed(&a[8], &a[2]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if networkLessKeyed(&a[14], &a[11]) {
+		a[11], a[14] = a[14], a[11]
+	}
+	if networkLessKeyed(&a[4], &a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if networkLessKeyed(&a[6], &a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[10], &a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if networkLessKeyed(&a[13], &a[11]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if networkLessKeyed(&a[8], &a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if networkLessKeyed(&a[12], &a[7]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if networkLessKeyed(&a[8], &a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if networkLessKeyed(&a[12], &a[10]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if networkLessKeyed(&a[5], &a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if networkLessKeyed(&a[9], &a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if networkLessKeyed(&a[4], &a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[6], &a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[8], &a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if networkLessKeyed(&a[10], &a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if networkLessKeyed(&a[12], &a[11]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if networkLessKeyed(&a[7], &a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[9], &a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+// NetworkMerge15xKeyed merges 15 runs, each of which must already be sorted
+// in increasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge15xKeyed(dst []Keyed, r0, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14 []Keyed) []Keyed {
+	var v0, v1, v2, v3, v4, v5, v6, v7, v8, v9, v10, v11, v12, v13, v14, vm0, vm1, vm2, vm3, vm4, vm5, vm6, vm7, vm8, vm9, vm10, vm11, vm12, vm13 Keyed
+	var wm0, wm1, wm2, wm3, wm4, wm5, wm6, wm7, wm8, wm9, wm10, wm11, wm12, wm13 int
+	var okm0, okm1, okm2, okm3, okm4, okm5, okm6, okm7, okm8, okm9, okm10, okm11, okm12, okm13 bool
+	ok0, ok1, ok2, ok3, ok4, ok5, ok6, ok7, ok8, ok9, ok10, ok11, ok12, ok13, ok14 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0, len(r5) > 0, len(r6) > 0, len(r7) > 0, len(r8) > 0, len(r9) > 0, len(r10) > 0, len(r11) > 0, len(r12) > 0, len(r13) > 0, len(r14) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	if ok5 {
+		v5 = r5[0]
+	}
+	if ok6 {
+		v6 = r6[0]
+	}
+	if ok7 {
+		v7 = r7[0]
+	}
+	if ok8 {
+		v8 = r8[0]
+	}
+	if ok9 {
+		v9 = r9[0]
+	}
+	if ok10 {
+		v10 = r10[0]
+	}
+	if ok11 {
+		v11 = r11[0]
+	}
+	if ok12 {
+		v12 = r12[0]
+	}
+	if ok13 {
+		v13 = r13[0]
+	}
+	if ok14 {
+		v14 = r14[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = 4, v4, ok4
+	if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+		wm2, vm2, okm2 = 5, v5, true
+	}
+	wm3, vm3, okm3 = 6, v6, ok6
+	if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+		wm3, vm3, okm3 = 7, v7, true
+	}
+	wm4, vm4, okm4 = 8, v8, ok8
+	if ok9 && (!ok8 || networkLessKeyed(&v9, &v8)) {
+		wm4, vm4, okm4 = 9, v9, true
+	}
+	wm5, vm5, okm5 = 10, v10, ok10
+	if ok11 && (!ok10 || networkLessKeyed(&v11, &v10)) {
+		wm5, vm5, okm5 = 11, v11, true
+	}
+	wm6, vm6, okm6 = 12, v12, ok12
+	if ok13 && (!ok12 || networkLessKeyed(&v13, &v12)) {
+		wm6, vm6, okm6 = 13, v13, true
+	}
+	wm7, vm7, okm7 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+		wm7, vm7, okm7 = wm1, vm1, true
+	}
+	wm8, vm8, okm8 = wm2, vm2, okm2
+	if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+		wm8, vm8, okm8 = wm3, vm3, true
+	}
+	wm9, vm9, okm9 = wm4, vm4, okm4
+	if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+		wm9, vm9, okm9 = wm5, vm5, true
+	}
+	wm10, vm10, okm10 = wm6, vm6, okm6
+	if ok14 && (!okm6 || networkLessKeyed(&v14, &vm6)) {
+		wm10, vm10, okm10 = 14, v14, true
+	}
+	wm11, vm11, okm11 = wm7, vm7, okm7
+	if okm8 && (!okm7 || networkLessKeyed(&vm8, &vm7)) {
+		wm11, vm11, okm11 = wm8, vm8, true
+	}
+	wm12, vm12, okm12 = wm9, vm9, okm9
+	if okm10 && (!okm9 || networkLessKeyed(&vm10, &vm9)) {
+		wm12, vm12, okm12 = wm10, vm10, true
+	}
+	wm13, vm13, okm13 = wm11, vm11, okm11
+	if okm12 && (!okm11 || networkLessKeyed(&vm12, &vm11)) {
+		wm13, vm13, okm13 = wm12, vm12, true
+	}
+
+	for okm13 {
+		dst = append(dst, vm13)
+		switch wm13 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm7, vm7, okm7 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm7, vm7, okm7 = wm1, vm1, true
+			}
+			wm11, vm11, okm11 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm8, &vm7)) {
+				wm11, vm11, okm11 = wm8, vm8, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm12, &vm11)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm7, vm7, okm7 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm7, vm7, okm7 = wm1, vm1, true
+			}
+			wm11, vm11, okm11 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm8, &vm7)) {
+				wm11, vm11, okm11 = wm8, vm8, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm12, &vm11)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm7, vm7, okm7 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm7, vm7, okm7 = wm1, vm1, true
+			}
+			wm11, vm11, okm11 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm8, &vm7)) {
+				wm11, vm11, okm11 = wm8, vm8, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm12, &vm11)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm7, vm7, okm7 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm7, vm7, okm7 = wm1, vm1, true
+			}
+			wm11, vm11, okm11 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm8, &vm7)) {
+				wm11, vm11, okm11 = wm8, vm8, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm12, &vm11)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm8, vm8, okm8 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm8, vm8, okm8 = wm3, vm3, true
+			}
+			wm11, vm11, okm11 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm8, &vm7)) {
+				wm11, vm11, okm11 = wm8, vm8, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm12, &vm11)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		case 5:
+			r5 = r5[1:]
+			if ok5 = len(r5) > 0; ok5 {
+				v5 = r5[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm8, vm8, okm8 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm8, vm8, okm8 = wm3, vm3, true
+			}
+			wm11, vm11, okm11 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm8, &vm7)) {
+				wm11, vm11, okm11 = wm8, vm8, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm12, &vm11)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		case 6:
+			r6 = r6[1:]
+			if ok6 = len(r6) > 0; ok6 {
+				v6 = r6[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm8, vm8, okm8 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm8, vm8, okm8 = wm3, vm3, true
+			}
+			wm11, vm11, okm11 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm8, &vm7)) {
+				wm11, vm11, okm11 = wm8, vm8, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm12, &vm11)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		case 7:
+			r7 = r7[1:]
+			if ok7 = len(r7) > 0; ok7 {
+				v7 = r7[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm8, vm8, okm8 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm8, vm8, okm8 = wm3, vm3, true
+			}
+			wm11, vm11, okm11 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm8, &vm7)) {
+				wm11, vm11, okm11 = wm8, vm8, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm12, &vm11)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		case 8:
+			r8 = r8[1:]
+			if ok8 = len(r8) > 0; ok8 {
+				v8 = r8[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v9, &v8)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm9, vm9, okm9 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm9, vm9, okm9 = wm5, vm5, true
+			}
+			wm12, vm12, okm12 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm10, &vm9)) {
+				wm12, vm12, okm12 = wm10, vm10, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm12, &vm11)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		case 9:
+			r9 = r9[1:]
+			if ok9 = len(r9) > 0; ok9 {
+				v9 = r9[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v9, &v8)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm9, vm9, okm9 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm9, vm9, okm9 = wm5, vm5, true
+			}
+			wm12, vm12, okm12 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm10, &vm9)) {
+				wm12, vm12, okm12 = wm10, vm10, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm12, &vm11)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		case 10:
+			r10 = r10[1:]
+			if ok10 = len(r10) > 0; ok10 {
+				v10 = r10[0]
+			}
+			wm5, vm5, okm5 = 10, v10, ok10
+			if ok11 && (!ok10 || networkLessKeyed(&v11, &v10)) {
+				wm5, vm5, okm5 = 11, v11, true
+			}
+			wm9, vm9, okm9 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm9, vm9, okm9 = wm5, vm5, true
+			}
+			wm12, vm12, okm12 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm10, &vm9)) {
+				wm12, vm12, okm12 = wm10, vm10, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm12, &vm11)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		case 11:
+			r11 = r11[1:]
+			if ok11 = len(r11) > 0; ok11 {
+				v11 = r11[0]
+			}
+			wm5, vm5, okm5 = 10, v10, ok10
+			if ok11 && (!ok10 || networkLessKeyed(&v11, &v10)) {
+				wm5, vm5, okm5 = 11, v11, true
+			}
+			wm9, vm9, okm9 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm9, vm9, okm9 = wm5, vm5, true
+			}
+			wm12, vm12, okm12 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm10, &vm9)) {
+				wm12, vm12, okm12 = wm10, vm10, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm12, &vm11)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		case 12:
+			r12 = r12[1:]
+			if ok12 = len(r12) > 0; ok12 {
+				v12 = r12[0]
+			}
+			wm6, vm6, okm6 = 12, v12, ok12
+			if ok13 && (!ok12 || networkLessKeyed(&v13, &v12)) {
+				wm6, vm6, okm6 = 13, v13, true
+			}
+			wm10, vm10, okm10 = wm6, vm6, okm6
+			if ok14 && (!okm6 || networkLessKeyed(&v14, &vm6)) {
+				wm10, vm10, okm10 = 14, v14, true
+			}
+			wm12, vm12, okm12 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm10, &vm9)) {
+				wm12, vm12, okm12 = wm10, vm10, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm12, &vm11)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		case 13:
+			r13 = r13[1:]
+			if ok13 = len(r13) > 0; ok13 {
+				v13 = r13[0]
+			}
+			wm6, vm6, okm6 = 12, v12, ok12
+			if ok13 && (!ok12 || networkLessKeyed(&v13, &v12)) {
+				wm6, vm6, okm6 = 13, v13, true
+			}
+			wm10, vm10, okm10 = wm6, vm6, okm6
+			if ok14 && (!okm6 || networkLessKeyed(&v14, &vm6)) {
+				wm10, vm10, okm10 = 14, v14, true
+			}
+			wm12, vm12, okm12 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm10, &vm9)) {
+				wm12, vm12, okm12 = wm10, vm10, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm12, &vm11)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		case 14:
+			r14 = r14[1:]
+			if ok14 = len(r14) > 0; ok14 {
+				v14 = r14[0]
+			}
+			wm10, vm10, okm10 = wm6, vm6, okm6
+			if ok14 && (!okm6 || networkLessKeyed(&v14, &vm6)) {
+				wm10, vm10, okm10 = 14, v14, true
+			}
+			wm12, vm12, okm12 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm10, &vm9)) {
+				wm12, vm12, okm12 = wm10, vm10, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm12, &vm11)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort15xKeyedReverse(a []Keyed) {
+	_ = a[14]
+	if networkLessKeyed(&a[0], &a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[2], &a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[4], &a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if networkLessKeyed(&a[6], &a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[8], &a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if networkLessKeyed(&a[10], &a[11]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if networkLessKeyed(&a[12], &a[13]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if networkLessKeyed(&a[0], &a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[4], &a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if networkLessKeyed(&a[8], &a[10]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if networkLessKeyed(&a[12], &a[14]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if networkLessKeyed(&a[1], &a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[5], &a[7]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if networkLessKeyed(&a[9], &a[11]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if networkLessKeyed(&a[0], &a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if networkLessKeyed(&a[8], &a[12]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if networkLessKeyed(&a[1], &a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if networkLessKeyed(&a[9], &a[13]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if networkLessKeyed(&a[2], &a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if networkLessKeyed(&a[10], &a[14]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if networkLessKeyed(&a[3], &a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if networkLessKeyed(&a[0], &a[8]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if networkLessKeyed(&a[1], &a[9]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if networkLessKeyed(&a[2], &a[10]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if networkLessKeyed(&a[3], &a[11]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if networkLessKeyed(&a[4], &a[12]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if networkLessKeyed(&a[5], &a[13]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if networkLessKeyed(&a[6], &a[14]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if networkLessKeyed(&a[5], &a[10]) {
+		a[5], a[10] = a[10], a[5]
+	}
+	if networkLessKeyed(&a[6], &a[9]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if networkLessKeyed(&a[3], &a[12]) {
+		a[3], a[12] = a[12], a[3]
+	}
+	if networkLessKeyed(&a[13], &a[14]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if networkLessKeyed(&a[7], &a[11]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if networkLessKeyed(&a[1], &a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[4], &a[8]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if networkLessKeyed(&a[1], &a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if networkLessKeyed(&a[7], &a[13]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if networkLessKeyed(&a[2], &a[8]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if networkLessKeyed(&a[11], &a[14]) {
+		a[11], a[14] = a[14], a[11]
+	}
+	if networkLessKeyed(&a[2], &a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if networkLessKeyed(&a[5], &a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[9], &a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if networkLessKeyed(&a[11], &a[13]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if networkLessKeyed(&a[3], &a[8]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if networkLessKeyed(&a[7], &a[12]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if networkLessKeyed(&a[6], &a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if networkLessKeyed(&a[10], &a[12]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if networkLessKeyed(&a[3], &a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if networkLessKeyed(&a[7], &a[9]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if networkLessKeyed(&a[3], &a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[5], &a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[7], &a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if networkLessKeyed(&a[9], &a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if networkLessKeyed(&a[11], &a[12]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if networkLessKeyed(&a[6], &a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[8], &a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+// NetworkMerge15xKeyedReverse merges 15 runs, each of which must already be sorted
+// in decreasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge15xKeyedReverse(dst []Keyed, r0, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14 []Keyed) []Keyed {
+	var v0, v1, v2, v3, v4, v5, v6, v7, v8, v9, v10, v11, v12, v13, v14, vm0, vm1, vm2, vm3, vm4, vm5, vm6, vm7, vm8, vm9, vm10, vm11, vm12, vm13 Keyed
+	var wm0, wm1, wm2, wm3, wm4, wm5, wm6, wm7, wm8, wm9, wm10, wm11, wm12, wm13 int
+	var okm0, okm1, okm2, okm3, okm4, okm5, okm6, okm7, okm8, okm9, okm10, okm11, okm12, okm13 bool
+	ok0, ok1, ok2, ok3, ok4, ok5, ok6, ok7, ok8, ok9, ok10, ok11, ok12, ok13, ok14 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0, len(r5) > 0, len(r6) > 0, len(r7) > 0, len(r8) > 0, len(r9) > 0, len(r10) > 0, len(r11) > 0, len(r12) > 0, len(r13) > 0, len(r14) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	if ok5 {
+		v5 = r5[0]
+	}
+	if ok6 {
+		v6 = r6[0]
+	}
+	if ok7 {
+		v7 = r7[0]
+	}
+	if ok8 {
+		v8 = r8[0]
+	}
+	if ok9 {
+		v9 = r9[0]
+	}
+	if ok10 {
+		v10 = r10[0]
+	}
+	if ok11 {
+		v11 = r11[0]
+	}
+	if ok12 {
+		v12 = r12[0]
+	}
+	if ok13 {
+		v13 = r13[0]
+	}
+	if ok14 {
+		v14 = r14[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = 4, v4, ok4
+	if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+		wm2, vm2, okm2 = 5, v5, true
+	}
+	wm3, vm3, okm3 = 6, v6, ok6
+	if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+		wm3, vm3, okm3 = 7, v7, true
+	}
+	wm4, vm4, okm4 = 8, v8, ok8
+	if ok9 && (!ok8 || networkLessKeyed(&v8, &v9)) {
+		wm4, vm4, okm4 = 9, v9, true
+	}
+	wm5, vm5, okm5 = 10, v10, ok10
+	if ok11 && (!ok10 || networkLessKeyed(&v10, &v11)) {
+		wm5, vm5, okm5 = 11, v11, true
+	}
+	wm6, vm6, okm6 = 12, v12, ok12
+	if ok13 && (!ok12 || networkLessKeyed(&v12, &v13)) {
+		wm6, vm6, okm6 = 13, v13, true
+	}
+	wm7, vm7, okm7 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+		wm7, vm7, okm7 = wm1, vm1, true
+	}
+	wm8, vm8, okm8 = wm2, vm2, okm2
+	if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+		wm8, vm8, okm8 = wm3, vm3, true
+	}
+	wm9, vm9, okm9 = wm4, vm4, okm4
+	if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+		wm9, vm9, okm9 = wm5, vm5, true
+	}
+	wm10, vm10, okm10 = wm6, vm6, okm6
+	if ok14 && (!okm6 || networkLessKeyed(&vm6, &v14)) {
+		wm10, vm10, okm10 = 14, v14, true
+	}
+	wm11, vm11, okm11 = wm7, vm7, okm7
+	if okm8 && (!okm7 || networkLessKeyed(&vm7, &vm8)) {
+		wm11, vm11, okm11 = wm8, vm8, true
+	}
+	wm12, vm12, okm12 = wm9, vm9, okm9
+	if okm10 && (!okm9 || networkLessKeyed(&vm9, &vm10)) {
+		wm12, vm12, okm12 = wm10, vm10, true
+	}
+	wm13, vm13, okm13 = wm11, vm11, okm11
+	if okm12 && (!okm11 || networkLessKeyed(&vm11, &vm12)) {
+		wm13, vm13, okm13 = wm12, vm12, true
+	}
+
+	for okm13 {
+		dst = append(dst, vm13)
+		switch wm13 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm7, vm7, okm7 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm7, vm7, okm7 = wm1, vm1, true
+			}
+			wm11, vm11, okm11 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm7, &vm8)) {
+				wm11, vm11, okm11 = wm8, vm8, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm11, &vm12)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm7, vm7, okm7 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm7, vm7, okm7 = wm1, vm1, true
+			}
+			wm11, vm11, okm11 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm7, &vm8)) {
+				wm11, vm11, okm11 = wm8, vm8, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm11, &vm12)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm7, vm7, okm7 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm7, vm7, okm7 = wm1, vm1, true
+			}
+			wm11, vm11, okm11 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm7, &vm8)) {
+				wm11, vm11, okm11 = wm8, vm8, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm11, &vm12)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm7, vm7, okm7 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm7, vm7, okm7 = wm1, vm1, true
+			}
+			wm11, vm11, okm11 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm7, &vm8)) {
+				wm11, vm11, okm11 = wm8, vm8, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm11, &vm12)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm8, vm8, okm8 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm8, vm8, okm8 = wm3, vm3, true
+			}
+			wm11, vm11, okm11 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm7, &vm8)) {
+				wm11, vm11, okm11 = wm8, vm8, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm11, &vm12)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		case 5:
+			r5 = r5[1:]
+			if ok5 = len(r5) > 0; ok5 {
+				v5 = r5[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm8, vm8, okm8 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm8, vm8, okm8 = wm3, vm3, true
+			}
+			wm11, vm11, okm11 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm7, &vm8)) {
+				wm11, vm11, okm11 = wm8, vm8, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm11, &vm12)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		case 6:
+			r6 = r6[1:]
+			if ok6 = len(r6) > 0; ok6 {
+				v6 = r6[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm8, vm8, okm8 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm8, vm8, okm8 = wm3, vm3, true
+			}
+			wm11, vm11, okm11 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm7, &vm8)) {
+				wm11, vm11, okm11 = wm8, vm8, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm11, &vm12)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		case 7:
+			r7 = r7[1:]
+			if ok7 = len(r7) > 0; ok7 {
+				v7 = r7[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm8, vm8, okm8 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm8, vm8, okm8 = wm3, vm3, true
+			}
+			wm11, vm11, okm11 = wm7, vm7, okm7
+			if okm8 && (!okm7 || networkLessKeyed(&vm7, &vm8)) {
+				wm11, vm11, okm11 = wm8, vm8, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm11, &vm12)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		case 8:
+			r8 = r8[1:]
+			if ok8 = len(r8) > 0; ok8 {
+				v8 = r8[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v8, &v9)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm9, vm9, okm9 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm9, vm9, okm9 = wm5, vm5, true
+			}
+			wm12, vm12, okm12 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm9, &vm10)) {
+				wm12, vm12, okm12 = wm10, vm10, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm11, &vm12)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		case 9:
+			r9 = r9[1:]
+			if ok9 = len(r9) > 0; ok9 {
+				v9 = r9[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v8, &v9)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm9, vm9, okm9 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm9, vm9, okm9 = wm5, vm5, true
+			}
+			wm12, vm12, okm12 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm9, &vm10)) {
+				wm12, vm12, okm12 = wm10, vm10, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm11, &vm12)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		case 10:
+			r10 = r10[1:]
+			if ok10 = len(r10) > 0; ok10 {
+				v10 = r10[0]
+			}
+			wm5, vm5, okm5 = 10, v10, ok10
+			if ok11 && (!ok10 || networkLessKeyed(&v10, &v11)) {
+				wm5, vm5, okm5 = 11, v11, true
+			}
+			wm9, vm9, okm9 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm9, vm9, okm9 = wm5, vm5, true
+			}
+			wm12, vm12, okm12 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm9, &vm10)) {
+				wm12, vm12, okm12 = wm10, vm10, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm11, &vm12)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		case 11:
+			r11 = r11[1:]
+			if ok11 = len(r11) > 0; ok11 {
+				v11 = r11[0]
+			}
+			wm5, vm5, okm5 = 10, v10, ok10
+			if ok11 && (!ok10 || networkLessKeyed(&v10, &v11)) {
+				wm5, vm5, okm5 = 11, v11, true
+			}
+			wm9, vm9, okm9 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm9, vm9, okm9 = wm5, vm5, true
+			}
+			wm12, vm12, okm12 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm9, &vm10)) {
+				wm12, vm12, okm12 = wm10, vm10, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm11, &vm12)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		case 12:
+			r12 = r12[1:]
+			if ok12 = len(r12) > 0; ok12 {
+				v12 = r12[0]
+			}
+			wm6, vm6, okm6 = 12, v12, ok12
+			if ok13 && (!ok12 || networkLessKeyed(&v12, &v13)) {
+				wm6, vm6, okm6 = 13, v13, true
+			}
+			wm10, vm10, okm10 = wm6, vm6, okm6
+			if ok14 && (!okm6 || networkLessKeyed(&vm6, &v14)) {
+				wm10, vm10, okm10 = 14, v14, true
+			}
+			wm12, vm12, okm12 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm9, &vm10)) {
+				wm12, vm12, okm12 = wm10, vm10, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm11, &vm12)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		case 13:
+			r13 = r13[1:]
+			if ok13 = len(r13) > 0; ok13 {
+				v13 = r13[0]
+			}
+			wm6, vm6, okm6 = 12, v12, ok12
+			if ok13 && (!ok12 || networkLessKeyed(&v12, &v13)) {
+				wm6, vm6, okm6 = 13, v13, true
+			}
+			wm10, vm10, okm10 = wm6, vm6, okm6
+			if ok14 && (!okm6 || networkLessKeyed(&vm6, &v14)) {
+				wm10, vm10, okm10 = 14, v14, true
+			}
+			wm12, vm12, okm12 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm9, &vm10)) {
+				wm12, vm12, okm12 = wm10, vm10, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm11, &vm12)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		case 14:
+			r14 = r14[1:]
+			if ok14 = len(r14) > 0; ok14 {
+				v14 = r14[0]
+			}
+			wm10, vm10, okm10 = wm6, vm6, okm6
+			if ok14 && (!okm6 || networkLessKeyed(&vm6, &v14)) {
+				wm10, vm10, okm10 = 14, v14, true
+			}
+			wm12, vm12, okm12 = wm9, vm9, okm9
+			if okm10 && (!okm9 || networkLessKeyed(&vm9, &vm10)) {
+				wm12, vm12, okm12 = wm10, vm10, true
+			}
+			wm13, vm13, okm13 = wm11, vm11, okm11
+			if okm12 && (!okm11 || networkLessKeyed(&vm11, &vm12)) {
+				wm13, vm13, okm13 = wm12, vm12, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort16xKeyed(a []Keyed) {
+	_ = a[15]
+	if networkLessKeyed(&a[1], &a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[3], &a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[5], &a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if networkLessKeyed(&a[7], &a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[9], &a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if networkLessKeyed(&a[11], &a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if networkLessKeyed(&a[13], &a[12]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if networkLessKeyed(&a[15], &a[14]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if networkLessKeyed(&a[2], &a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[6], &a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if networkLessKeyed(&a[10], &a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if networkLessKeyed(&a[14], &a[12]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if networkLessKeyed(&a[3], &a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[7], &a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if networkLessKeyed(&a[11], &a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if networkLessKeyed(&a[15], &a[13]) {
+		a[13], a[15] = a[15], a[13]
+	}
+	if networkLessKeyed(&a[4], &a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if networkLessKeyed(&a[12], &a[8]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if networkLessKeyed(&a[5], &a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if networkLessKeyed(&a[13], &a[9]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if networkLessKeyed(&a[6], &a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if networkLessKeyed(&a[14], &a[10]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if networkLessKeyed(&a[7], &a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if networkLessKeyed(&a[15], &a[11]) {
+		a[11], a[15] = a[15], a[11]
+	}
+	if networkLessKeyed(&a[8], &a[0]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if networkLessKeyed(&a[9], &a[1]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if networkLessKeyed(&a[10], &a[2]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if networkLessKeyed(&a[11], &a[3]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if networkLessKeyed(&a[12], &a[4]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if networkLessKeyed(&a[13], &a[5]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if networkLessKeyed(&a[14], &a[6]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if networkLessKeyed(&a[15], &a[7]) {
+		a[7], a[15] = a[15], a[7]
+	}
+	if networkLessKeyed(&a[10], &a[5]) {
+		a[5], a[10] = a[10], a[5]
+	}
+	if networkLessKeyed(&a[9], &a[6]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if networkLessKeyed(&a[12], &a[3]) {
+		a[3], a[12] = a[12], a[3]
+	}
+	if networkLessKeyed(&a[14], &a[13]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if networkLessKeyed(&a[11], &a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if networkLessKeyed(&a[2], &a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[8], &a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if networkLessKeyed(&a[4], &a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if networkLessKeyed(&a[13], &a[7]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if networkLessKeyed(&a[8], &a[2]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if networkLessKeyed(&a[14], &a[11]) {
+		a[11], a[14] = a[14], a[11]
+	}
+	if networkLessKeyed(&a[4], &a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if networkLessKeyed(&a[6], &a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[10], &a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if networkLessKeyed(&a[13], &a[11]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if networkLessKeyed(&a[8], &a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if networkLessKeyed(&a[12], &a[7]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if networkLessKeyed(&a[8], &a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if networkLessKeyed(&a[12], &a[10]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if networkLessKeyed(&a[5], &a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if networkLessKeyed(&a[9], &a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if networkLessKeyed(&a[4], &a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[6], &a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[8], &a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if networkLessKeyed(&a[10], &a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if networkLessKeyed(&a[12], &a[11]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if networkLessKeyed(&a[7], &a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[9], &a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+// NetworkMerge16xKeyed merges 16 runs, each of which must already be sorted
+// in increasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge16xKeyed(dst []Keyed, r0, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, r15 []Keyed) []Keyed {
+	var v0, v1, v2, v3, v4, v5, v6, v7, v8, v9, v10, v11, v12, v13, v14, v15, vm0, vm1, vm2, vm3, vm4, vm5, vm6, vm7, vm8, vm9, vm10, vm11, vm12, vm13, vm14 Keyed
+	var wm0, wm1, wm2, wm3, wm4, wm5, wm6, wm7, wm8, wm9, wm10, wm11, wm12, wm13, wm14 int
+	var okm0, okm1, okm2, okm3, okm4, okm5, okm6, okm7, okm8, okm9, okm10, okm11, okm12, okm13, okm14 bool
+	ok0, ok1, ok2, ok3, ok4, ok5, ok6, ok7, ok8, ok9, ok10, ok11, ok12, ok13, ok14, ok15 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0, len(r5) > 0, len(r6) > 0, len(r7) > 0, len(r8) > 0, len(r9) > 0, len(r10) > 0, len(r11) > 0, len(r12) > 0, len(r13) > 0, len(r14) > 0, len(r15) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	if ok5 {
+		v5 = r5[0]
+	}
+	if ok6 {
+		v6 = r6[0]
+	}
+	if ok7 {
+		v7 = r7[0]
+	}
+	if ok8 {
+		v8 = r8[0]
+	}
+	if ok9 {
+		v9 = r9[0]
+	}
+	if ok10 {
+		v10 = r10[0]
+	}
+	if ok11 {
+		v11 = r11[0]
+	}
+	if ok12 {
+		v12 = r12[0]
+	}
+	if ok13 {
+		v13 = r13[0]
+	}
+	if ok14 {
+		v14 = r14[0]
+	}
+	if ok15 {
+		v15 = r15[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = 4, v4, ok4
+	if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+		wm2, vm2, okm2 = 5, v5, true
+	}
+	wm3, vm3, okm3 = 6, v6, ok6
+	if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+		wm3, vm3, okm3 = 7, v7, true
+	}
+	wm4, vm4, okm4 = 8, v8, ok8
+	if ok9 && (!ok8 || networkLessKeyed(&v9, &v8)) {
+		wm4, vm4, okm4 = 9, v9, true
+	}
+	wm5, vm5, okm5 = 10, v10, ok10
+	if ok11 && (!ok10 || networkLessKeyed(&v11, &v10)) {
+		wm5, vm5, okm5 = 11, v11, true
+	}
+	wm6, vm6, okm6 = 12, v12, ok12
+	if ok13 && (!ok12 || networkLessKeyed(&v13, &v12)) {
+		wm6, vm6, okm6 = 13, v13, true
+	}
+	wm7, vm7, okm7 = 14, v14, ok14
+	if ok15 && (!ok14 || networkLessKeyed(&v15, &v14)) {
+		wm7, vm7, okm7 = 15, v15, true
+	}
+	wm8, vm8, okm8 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+		wm8, vm8, okm8 = wm1, vm1, true
+	}
+	wm9, vm9, okm9 = wm2, vm2, okm2
+	if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+		wm9, vm9, okm9 = wm3, vm3, true
+	}
+	wm10, vm10, okm10 = wm4, vm4, okm4
+	if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+		wm10, vm10, okm10 = wm5, vm5, true
+	}
+	wm11, vm11, okm11 = wm6, vm6, okm6
+	if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+		wm11, vm11, okm11 = wm7, vm7, true
+	}
+	wm12, vm12, okm12 = wm8, vm8, okm8
+	if okm9 && (!okm8 || networkLessKeyed(&vm9, &vm8)) {
+		wm12, vm12, okm12 = wm9, vm9, true
+	}
+	wm13, vm13, okm13 = wm10, vm10, okm10
+	if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+		wm13, vm13, okm13 = wm11, vm11, true
+	}
+	wm14, vm14, okm14 = wm12, vm12, okm12
+	if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+		wm14, vm14, okm14 = wm13, vm13, true
+	}
+
+	for okm14 {
+		dst = append(dst, vm14)
+		switch wm14 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm8, vm8, okm8 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm8, vm8, okm8 = wm1, vm1, true
+			}
+			wm12, vm12, okm12 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm9, &vm8)) {
+				wm12, vm12, okm12 = wm9, vm9, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm8, vm8, okm8 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm8, vm8, okm8 = wm1, vm1, true
+			}
+			wm12, vm12, okm12 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm9, &vm8)) {
+				wm12, vm12, okm12 = wm9, vm9, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm8, vm8, okm8 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm8, vm8, okm8 = wm1, vm1, true
+			}
+			wm12, vm12, okm12 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm9, &vm8)) {
+				wm12, vm12, okm12 = wm9, vm9, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm8, vm8, okm8 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm8, vm8, okm8 = wm1, vm1, true
+			}
+			wm12, vm12, okm12 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm9, &vm8)) {
+				wm12, vm12, okm12 = wm9, vm9, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm9, vm9, okm9 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm9, vm9, okm9 = wm3, vm3, true
+			}
+			wm12, vm12, okm12 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm9, &vm8)) {
+				wm12, vm12, okm12 = wm9, vm9, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 5:
+			r5 = r5[1:]
+			if ok5 = len(r5) > 0; ok5 {
+				v5 = r5[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm9, vm9, okm9 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm9, vm9, okm9 = wm3, vm3, true
+			}
+			wm12, vm12, okm12 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm9, &vm8)) {
+				wm12, vm12, okm12 = wm9, vm9, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 6:
+			r6 = r6[1:]
+			if ok6 = len(r6) > 0; ok6 {
+				v6 = r6[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm9, vm9, okm9 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm9, vm9, okm9 = wm3, vm3, true
+			}
+			wm12, vm12, okm12 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm9, &vm8)) {
+				wm12, vm12, okm12 = wm9, vm9, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 7:
+			r7 = r7[1:]
+			if ok7 = len(r7) > 0; ok7 {
+				v7 = r7[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm9, vm9, okm9 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm9, vm9, okm9 = wm3, vm3, true
+			}
+			wm12, vm12, okm12 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm9, &vm8)) {
+				wm12, vm12, okm12 = wm9, vm9, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 8:
+			r8 = r8[1:]
+			if ok8 = len(r8) > 0; ok8 {
+				v8 = r8[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v9, &v8)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm10, vm10, okm10 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm10, vm10, okm10 = wm5, vm5, true
+			}
+			wm13, vm13, okm13 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm13, vm13, okm13 = wm11, vm11, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 9:
+			r9 = r9[1:]
+			if ok9 = len(r9) > 0; ok9 {
+				v9 = r9[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v9, &v8)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm10, vm10, okm10 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm10, vm10, okm10 = wm5, vm5, true
+			}
+			wm13, vm13, okm13 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm13, vm13, okm13 = wm11, vm11, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 10:
+			r10 = r10[1:]
+			if ok10 = len(r10) > 0; ok10 {
+				v10 = r10[0]
+			}
+			wm5, vm5, okm5 = 10, v10, ok10
+			if ok11 && (!ok10 || networkLessKeyed(&v11, &v10)) {
+				wm5, vm5, okm5 = 11, v11, true
+			}
+			wm10, vm10, okm10 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm10, vm10, okm10 = wm5, vm5, true
+			}
+			wm13, vm13, okm13 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm13, vm13, okm13 = wm11, vm11, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 11:
+			r11 = r11[1:]
+			if ok11 = len(r11) > 0; ok11 {
+				v11 = r11[0]
+			}
+			wm5, vm5, okm5 = 10, v10, ok10
+			if ok11 && (!ok10 || networkLessKeyed(&v11, &v10)) {
+				wm5, vm5, okm5 = 11, v11, true
+			}
+			wm10, vm10, okm10 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm10, vm10, okm10 = wm5, vm5, true
+			}
+			wm13, vm13, okm13 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm13, vm13, okm13 = wm11, vm11, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 12:
+			r12 = r12[1:]
+			if ok12 = len(r12) > 0; ok12 {
+				v12 = r12[0]
+			}
+			wm6, vm6, okm6 = 12, v12, ok12
+			if ok13 && (!ok12 || networkLessKeyed(&v13, &v12)) {
+				wm6, vm6, okm6 = 13, v13, true
+			}
+			wm11, vm11, okm11 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+				wm11, vm11, okm11 = wm7, vm7, true
+			}
+			wm13, vm13, okm13 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm13, vm13, okm13 = wm11, vm11, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 13:
+			r13 = r13[1:]
+			if ok13 = len(r13) > 0; ok13 {
+				v13 = r13[0]
+			}
+			wm6, vm6, okm6 = 12, v12, ok12
+			if ok13 && (!ok12 || networkLessKeyed(&v13, &v12)) {
+				wm6, vm6, okm6 = 13, v13, true
+			}
+			wm11, vm11, okm11 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+				wm11, vm11, okm11 = wm7, vm7, true
+			}
+			wm13, vm13, okm13 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm13, vm13, okm13 = wm11, vm11, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 14:
+			r14 = r14[1:]
+			if ok14 = len(r14) > 0; ok14 {
+				v14 = r14[0]
+			}
+			wm7, vm7, okm7 = 14, v14, ok14
+			if ok15 && (!ok14 || networkLessKeyed(&v15, &v14)) {
+				wm7, vm7, okm7 = 15, v15, true
+			}
+			wm11, vm11, okm11 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+				wm11, vm11, okm11 = wm7, vm7, true
+			}
+			wm13, vm13, okm13 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm13, vm13, okm13 = wm11, vm11, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 15:
+			r15 = r15[1:]
+			if ok15 = len(r15) > 0; ok15 {
+				v15 = r15[0]
+			}
+			wm7, vm7, okm7 = 14, v14, ok14
+			if ok15 && (!ok14 || networkLessKeyed(&v15, &v14)) {
+				wm7, vm7, okm7 = 15, v15, true
+			}
+			wm11, vm11, okm11 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+				wm11, vm11, okm11 = wm7, vm7, true
+			}
+			wm13, vm13, okm13 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm13, vm13, okm13 = wm11, vm11, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort16xKeyedReverse(a []Keyed) {
+	_ = a[15]
+	if networkLessKeyed(&a[0], &a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[2], &a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[4], &a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if networkLessKeyed(&a[6], &a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[8], &a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if networkLessKeyed(&a[10], &a[11]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if networkLessKeyed(&a[12], &a[13]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if networkLessKeyed(&a[14], &a[15]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if networkLessKeyed(&a[0], &a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[4], &a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if networkLessKeyed(&a[8], &a[10]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if networkLessKeyed(&a[12], &a[14]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if networkLessKeyed(&a[1], &a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[5], &a[7]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if networkLessKeyed(&a[9], &a[11]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if networkLessKeyed(&a[13], &a[15]) {
+		a[13], a[15] = a[15], a[13]
+	}
+	if networkLessKeyed(&a[0], &a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if networkLessKeyed(&a[8], &a[12]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if networkLessKeyed(&a[1], &a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if networkLessKeyed(&a[9], &a[13]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if networkLessKeyed(&a[2], &a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if networkLessKeyed(&a[10], &a[14]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if networkLessKeyed(&a[3], &a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if networkLessKeyed(&a[11], &a[15]) {
+		a[11], a[15] = a[15], a[11]
+	}
+	if networkLessKeyed(&a[0], &a[8]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if networkLessKeyed(&a[1], &a[9]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if networkLessKeyed(&a[2], &a[10]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if networkLessKeyed(&a[3], &a[11]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if networkLessKeyed(&a[4], &a[12]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if networkLessKeyed(&a[5], &a[13]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if networkLessKeyed(&a[6], &a[14]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if networkLessKeyed(&a[7], &a[15]) {
+		a[7], a[15] = a[15], a[7]
+	}
+	if networkLessKeyed(&a[5], &a[10]) {
+		a[5], a[10] = a[10], a[5]
+	}
+	if networkLessKeyed(&a[6], &a[9]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if networkLessKeyed(&a[3], &a[12]) {
+		a[3], a[12] = a[12], a[3]
+	}
+	if networkLessKeyed(&a[13], &a[14]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if networkLessKeyed(&a[7], &a[11]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if networkLessKeyed(&a[1], &a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[4], &a[8]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if networkLessKeyed(&a[1], &a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if networkLessKeyed(&a[7], &a[13]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if networkLessKeyed(&a[2], &a[8]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if networkLessKeyed(&a[11], &a[14]) {
+		a[11], a[14] = a[14], a[11]
+	}
+	if networkLessKeyed(&a[2], &a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if networkLessKeyed(&a[5], &a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[9], &a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if networkLessKeyed(&a[11], &a[13]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if networkLessKeyed(&a[3], &a[8]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if networkLessKeyed(&a[7], &a[12]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if networkLessKeyed(&a[6], &a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if networkLessKeyed(&a[10], &a[12]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if networkLessKeyed(&a[3], &a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if networkLessKeyed(&a[7], &a[9]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if networkLessKeyed(&a[3], &a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[5], &a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[7], &a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if networkLessKeyed(&a[9], &a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if networkLessKeyed(&a[11], &a[12]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if networkLessKeyed(&a[6], &a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[8], &a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+// NetworkMerge16xKeyedReverse merges 16 runs, each of which must already be sorted
+// in decreasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge16xKeyedReverse(dst []Keyed, r0, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, r15 []Keyed) []Keyed {
+	var v0, v1, v2, v3, v4, v5, v6, v7, v8, v9, v10, v11, v12, v13, v14, v15, vm0, vm1, vm2, vm3, vm4, vm5, vm6, vm7, vm8, vm9, vm10, vm11, vm12, vm13, vm14 Keyed
+	var wm0, wm1, wm2, wm3, wm4, wm5, wm6, wm7, wm8, wm9, wm10, wm11, wm12, wm13, wm14 int
+	var okm0, okm1, okm2, okm3, okm4, okm5, okm6, okm7, okm8, okm9, okm10, okm11, okm12, okm13, okm14 bool
+	ok0, ok1, ok2, ok3, ok4, ok5, ok6, ok7, ok8, ok9, ok10, ok11, ok12, ok13, ok14, ok15 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0, len(r5) > 0, len(r6) > 0, len(r7) > 0, len(r8) > 0, len(r9) > 0, len(r10) > 0, len(r11) > 0, len(r12) > 0, len(r13) > 0, len(r14) > 0, len(r15) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	if ok5 {
+		v5 = r5[0]
+	}
+	if ok6 {
+		v6 = r6[0]
+	}
+	if ok7 {
+		v7 = r7[0]
+	}
+	if ok8 {
+		v8 = r8[0]
+	}
+	if ok9 {
+		v9 = r9[0]
+	}
+	if ok10 {
+		v10 = r10[0]
+	}
+	if ok11 {
+		v11 = r11[0]
+	}
+	if ok12 {
+		v12 = r12[0]
+	}
+	if ok13 {
+		v13 = r13[0]
+	}
+	if ok14 {
+		v14 = r14[0]
+	}
+	if ok15 {
+		v15 = r15[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = 4, v4, ok4
+	if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+		wm2, vm2, okm2 = 5, v5, true
+	}
+	wm3, vm3, okm3 = 6, v6, ok6
+	if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+		wm3, vm3, okm3 = 7, v7, true
+	}
+	wm4, vm4, okm4 = 8, v8, ok8
+	if ok9 && (!ok8 || networkLessKeyed(&v8, &v9)) {
+		wm4, vm4, okm4 = 9, v9, true
+	}
+	wm5, vm5, okm5 = 10, v10, ok10
+	if ok11 && (!ok10 || networkLessKeyed(&v10, &v11)) {
+		wm5, vm5, okm5 = 11, v11, true
+	}
+	wm6, vm6, okm6 = 12, v12, ok12
+	if ok13 && (!ok12 || networkLessKeyed(&v12, &v13)) {
+		wm6, vm6, okm6 = 13, v13, true
+	}
+	wm7, vm7, okm7 = 14, v14, ok14
+	if ok15 && (!ok14 || networkLessKeyed(&v14, &v15)) {
+		wm7, vm7, okm7 = 15, v15, true
+	}
+	wm8, vm8, okm8 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+		wm8, vm8, okm8 = wm1, vm1, true
+	}
+	wm9, vm9, okm9 = wm2, vm2, okm2
+	if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+		wm9, vm9, okm9 = wm3, vm3, true
+	}
+	wm10, vm10, okm10 = wm4, vm4, okm4
+	if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+		wm10, vm10, okm10 = wm5, vm5, true
+	}
+	wm11, vm11, okm11 = wm6, vm6, okm6
+	if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+		wm11, vm11, okm11 = wm7, vm7, true
+	}
+	wm12, vm12, okm12 = wm8, vm8, okm8
+	if okm9 && (!okm8 || networkLessKeyed(&vm8, &vm9)) {
+		wm12, vm12, okm12 = wm9, vm9, true
+	}
+	wm13, vm13, okm13 = wm10, vm10, okm10
+	if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+		wm13, vm13, okm13 = wm11, vm11, true
+	}
+	wm14, vm14, okm14 = wm12, vm12, okm12
+	if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+		wm14, vm14, okm14 = wm13, vm13, true
+	}
+
+	for okm14 {
+		dst = append(dst, vm14)
+		switch wm14 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm8, vm8, okm8 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm8, vm8, okm8 = wm1, vm1, true
+			}
+			wm12, vm12, okm12 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm8, &vm9)) {
+				wm12, vm12, okm12 = wm9, vm9, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm8, vm8, okm8 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm8, vm8, okm8 = wm1, vm1, true
+			}
+			wm12, vm12, okm12 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm8, &vm9)) {
+				wm12, vm12, okm12 = wm9, vm9, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm8, vm8, okm8 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm8, vm8, okm8 = wm1, vm1, true
+			}
+			wm12, vm12, okm12 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm8, &vm9)) {
+				wm12, vm12, okm12 = wm9, vm9, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm8, vm8, okm8 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm8, vm8, okm8 = wm1, vm1, true
+			}
+			wm12, vm12, okm12 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm8, &vm9)) {
+				wm12, vm12, okm12 = wm9, vm9, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm9, vm9, okm9 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm9, vm9, okm9 = wm3, vm3, true
+			}
+			wm12, vm12, okm12 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm8, &vm9)) {
+				wm12, vm12, okm12 = wm9, vm9, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 5:
+			r5 = r5[1:]
+			if ok5 = len(r5) > 0; ok5 {
+				v5 = r5[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm9, vm9, okm9 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm9, vm9, okm9 = wm3, vm3, true
+			}
+			wm12, vm12, okm12 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm8, &vm9)) {
+				wm12, vm12, okm12 = wm9, vm9, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 6:
+			r6 = r6[1:]
+			if ok6 = len(r6) > 0; ok6 {
+				v6 = r6[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm9, vm9, okm9 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm9, vm9, okm9 = wm3, vm3, true
+			}
+			wm12, vm12, okm12 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm8, &vm9)) {
+				wm12, vm12, okm12 = wm9, vm9, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 7:
+			r7 = r7[1:]
+			if ok7 = len(r7) > 0; ok7 {
+				v7 = r7[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm9, vm9, okm9 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm9, vm9, okm9 = wm3, vm3, true
+			}
+			wm12, vm12, okm12 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm8, &vm9)) {
+				wm12, vm12, okm12 = wm9, vm9, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 8:
+			r8 = r8[1:]
+			if ok8 = len(r8) > 0; ok8 {
+				v8 = r8[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v8, &v9)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm10, vm10, okm10 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm10, vm10, okm10 = wm5, vm5, true
+			}
+			wm13, vm13, okm13 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm13, vm13, okm13 = wm11, vm11, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 9:
+			r9 = r9[1:]
+			if ok9 = len(r9) > 0; ok9 {
+				v9 = r9[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v8, &v9)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm10, vm10, okm10 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm10, vm10, okm10 = wm5, vm5, true
+			}
+			wm13, vm13, okm13 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm13, vm13, okm13 = wm11, vm11, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 10:
+			r10 = r10[1:]
+			if ok10 = len(r10) > 0; ok10 {
+				v10 = r10[0]
+			}
+			wm5, vm5, okm5 = 10, v10, ok10
+			if ok11 && (!ok10 || networkLessKeyed(&v10, &v11)) {
+				wm5, vm5, okm5 = 11, v11, true
+			}
+			wm10, vm10, okm10 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm10, vm10, okm10 = wm5, vm5, true
+			}
+			wm13, vm13, okm13 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm13, vm13, okm13 = wm11, vm11, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 11:
+			r11 = r11[1:]
+			if ok11 = len(r11) > 0; ok11 {
+				v11 = r11[0]
+			}
+			wm5, vm5, okm5 = 10, v10, ok10
+			if ok11 && (!ok10 || networkLessKeyed(&v10, &v11)) {
+				wm5, vm5, okm5 = 11, v11, true
+			}
+			wm10, vm10, okm10 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm10, vm10, okm10 = wm5, vm5, true
+			}
+			wm13, vm13, okm13 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm13, vm13, okm13 = wm11, vm11, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 12:
+			r12 = r12[1:]
+			if ok12 = len(r12) > 0; ok12 {
+				v12 = r12[0]
+			}
+			wm6, vm6, okm6 = 12, v12, ok12
+			if ok13 && (!ok12 || networkLessKeyed(&v12, &v13)) {
+				wm6, vm6, okm6 = 13, v13, true
+			}
+			wm11, vm11, okm11 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+				wm11, vm11, okm11 = wm7, vm7, true
+			}
+			wm13, vm13, okm13 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm13, vm13, okm13 = wm11, vm11, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 13:
+			r13 = r13[1:]
+			if ok13 = len(r13) > 0; ok13 {
+				v13 = r13[0]
+			}
+			wm6, vm6, okm6 = 12, v12, ok12
+			if ok13 && (!ok12 || networkLessKeyed(&v12, &v13)) {
+				wm6, vm6, okm6 = 13, v13, true
+			}
+			wm11, vm11, okm11 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+				wm11, vm11, okm11 = wm7, vm7, true
+			}
+			wm13, vm13, okm13 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm13, vm13, okm13 = wm11, vm11, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 14:
+			r14 = r14[1:]
+			if ok14 = len(r14) > 0; ok14 {
+				v14 = r14[0]
+			}
+			wm7, vm7, okm7 = 14, v14, ok14
+			if ok15 && (!ok14 || networkLessKeyed(&v14, &v15)) {
+				wm7, vm7, okm7 = 15, v15, true
+			}
+			wm11, vm11, okm11 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+				wm11, vm11, okm11 = wm7, vm7, true
+			}
+			wm13, vm13, okm13 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm13, vm13, okm13 = wm11, vm11, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		case 15:
+			r15 = r15[1:]
+			if ok15 = len(r15) > 0; ok15 {
+				v15 = r15[0]
+			}
+			wm7, vm7, okm7 = 14, v14, ok14
+			if ok15 && (!ok14 || networkLessKeyed(&v14, &v15)) {
+				wm7, vm7, okm7 = 15, v15, true
+			}
+			wm11, vm11, okm11 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+				wm11, vm11, okm11 = wm7, vm7, true
+			}
+			wm13, vm13, okm13 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm13, vm13, okm13 = wm11, vm11, true
+			}
+			wm14, vm14, okm14 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+				wm14, vm14, okm14 = wm13, vm13, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort24xKeyed(a []Keyed) {
+	_ = a[23]
+	if networkLessKeyed(&a[1], &a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[3], &a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[5], &a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if networkLessKeyed(&a[7], &a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[9], &a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if networkLessKeyed(&a[11], &a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if networkLessKeyed(&a[13], &a[12]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if networkLessKeyed(&a[15], &a[14]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if networkLessKeyed(&a[17], &a[16]) {
+		a[16], a[17] = a[17], a[16]
+	}
+	if networkLessKeyed(&a[19], &a[18]) {
+		a[18], a[19] = a[19], a[18]
+	}
+	if networkLessKeyed(&a[21], &a[20]) {
+		a[20], a[21] = a[21], a[20]
+	}
+	if networkLessKeyed(&a[23], &a[22]) {
+		a[22], a[23] = a[23], a[22]
+	}
+	if networkLessKeyed(&a[3], &a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[7], &a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if networkLessKeyed(&a[11], &a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if networkLessKeyed(&a[2], &a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[6], &a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if networkLessKeyed(&a[10], &a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if networkLessKeyed(&a[15], &a[13]) {
+		a[13], a[15] = a[15], a[13]
+	}
+	if networkLessKeyed(&a[19], &a[17]) {
+		a[17], a[19] = a[19], a[17]
+	}
+	if networkLessKeyed(&a[23], &a[21]) {
+		a[21], a[23] = a[23], a[21]
+	}
+	if networkLessKeyed(&a[14], &a[12]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if networkLessKeyed(&a[18], &a[16]) {
+		a[16], a[18] = a[18], a[16]
+	}
+	if networkLessKeyed(&a[22], &a[20]) {
+		a[20], a[22] = a[22], a[20]
+	}
+	if networkLessKeyed(&a[2], &a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[6], &a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[10], &a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if networkLessKeyed(&a[14], &a[13]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if networkLessKeyed(&a[18], &a[17]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if networkLessKeyed(&a[22], &a[21]) {
+		a[21], a[22] = a[22], a[21]
+	}
+	if networkLessKeyed(&a[5], &a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if networkLessKeyed(&a[10], &a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if networkLessKeyed(&a[17], &a[13]) {
+		a[13], a[17] = a[17], a[13]
+	}
+	if networkLessKeyed(&a[22], &a[18]) {
+		a[18], a[22] = a[22], a[18]
+	}
+	if networkLessKeyed(&a[9], &a[5]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if networkLessKeyed(&a[6], &a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if networkLessKeyed(&a[21], &a[17]) {
+		a[17], a[21] = a[21], a[17]
+	}
+	if networkLessKeyed(&a[18], &a[14]) {
+		a[14], a[18] = a[18], a[14]
+	}
+	if networkLessKeyed(&a[5], &a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if networkLessKeyed(&a[10], &a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if networkLessKeyed(&a[4], &a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if networkLessKeyed(&a[11], &a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if networkLessKeyed(&a[17], &a[13]) {
+		a[13], a[17] = a[17], a[13]
+	}
+	if networkLessKeyed(&a[22], &a[18]) {
+		a[18], a[22] = a[22], a[18]
+	}
+	if networkLessKeyed(&a[16], &a[12]) {
+		a[12], a[16] = a[16], a[12]
+	}
+	if networkLessKeyed(&a[23], &a[19]) {
+		a[19], a[23] = a[23], a[19]
+	}
+	if networkLessKeyed(&a[7], &a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if networkLessKeyed(&a[8], &a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if networkLessKeyed(&a[19], &a[15]) {
+		a[15], a[19] = a[19], a[15]
+	}
+	if networkLessKeyed(&a[20], &a[16]) {
+		a[16], a[20] = a[20], a[16]
+	}
+	if networkLessKeyed(&a[4], &a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if networkLessKeyed(&a[11], &a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if networkLessKeyed(&a[16], &a[12]) {
+		a[12], a[16] = a[16], a[12]
+	}
+	if networkLessKeyed(&a[23], &a[19]) {
+		a[19], a[23] = a[23], a[19]
+	}
+	if networkLessKeyed(&a[4], &a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if networkLessKeyed(&a[10], &a[7]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if networkLessKeyed(&a[8], &a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if networkLessKeyed(&a[16], &a[13]) {
+		a[13], a[16] = a[16], a[13]
+	}
+	if networkLessKeyed(&a[22], &a[19]) {
+		a[19], a[22] = a[22], a[19]
+	}
+	if networkLessKeyed(&a[20], &a[15]) {
+		a[15], a[20] = a[20], a[15]
+	}
+	if networkLessKeyed(&a[3], &a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[9], &a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if networkLessKeyed(&a[15], &a[14]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if networkLessKeyed(&a[21], &a[20]) {
+		a[20], a[21] = a[21], a[20]
+	}
+	if networkLessKeyed(&a[4], &a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if networkLessKeyed(&a[9], &a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if networkLessKeyed(&a[5], &a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if networkLessKeyed(&a[8], &a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if networkLessKeyed(&a[16], &a[14]) {
+		a[14], a[16] = a[16], a[14]
+	}
+	if networkLessKeyed(&a[21], &a[19]) {
+		a[19], a[21] = a[21], a[19]
+	}
+	if networkLessKeyed(&a[17], &a[15]) {
+		a[15], a[17] = a[17], a[15]
+	}
+	if networkLessKeyed(&a[20], &a[18]) {
+		a[18], a[20] = a[20], a[18]
+	}
+	if networkLessKeyed(&a[4], &a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[6], &a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[8], &a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if networkLessKeyed(&a[16], &a[15]) {
+		a[15], a[16] = a[16], a[15]
+	}
+	if networkLessKeyed(&a[18], &a[17]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if networkLessKeyed(&a[20], &a[19]) {
+		a[19], a[20] = a[20], a[19]
+	}
+	if networkLessKeyed(&a[12], &a[0]) {
+		a[0], a[12] = a[12], a[0]
+	}
+	if networkLessKeyed(&a[13], &a[1]) {
+		a[1], a[13] = a[13], a[1]
+	}
+	if networkLessKeyed(&a[14], &a[2]) {
+		a[2], a[14] = a[14], a[2]
+	}
+	if networkLessKeyed(&a[15], &a[3]) {
+		a[3], a[15] = a[15], a[3]
+	}
+	if networkLessKeyed(&a[16], &a[4]) {
+		a[4], a[16] = a[16], a[4]
+	}
+	if networkLessKeyed(&a[17], &a[5]) {
+		a[5], a[17] = a[17], a[5]
+	}
+	if networkLessKeyed(&a[18], &a[6]) {
+		a[6], a[18] = a[18], a[6]
+	}
+	if networkLessKeyed(&a[19], &a[7]) {
+		a[7], a[19] = a[19], a[7]
+	}
+	if networkLessKeyed(&a[20], &a[8]) {
+		a[8], a[20] = a[20], a[8]
+	}
+	if networkLessKeyed(&a[21], &a[9]) {
+		a[9], a[21] = a[21], a[9]
+	}
+	if networkLessKeyed(&a[22], &a[10]) {
+		a[10], a[22] = a[22], a[10]
+	}
+	if networkLessKeyed(&a[23], &a[11]) {
+		a[11], a[23] = a[23], a[11]
+	}
+	if networkLessKeyed(&a[12], &a[2]) {
+		a[2], a[12] = a[12], a[2]
+	}
+	if networkLessKeyed(&a[13], &a[3]) {
+		a[3], a[13] = a[13], a[3]
+	}
+	if networkLessKeyed(&a[20], &a[10]) {
+		a[10], a[20] = a[20], a[10]
+	}
+	if networkLessKeyed(&a[21], &a[11]) {
+		a[11], a[21] = a[21], a[11]
+	}
+	if networkLessKeyed(&a[12], &a[4]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if networkLessKeyed(&a[13], &a[5]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if networkLessKeyed(&a[14], &a[6]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if networkLessKeyed(&a[15], &a[7]) {
+		a[7], a[15] = a[15], a[7]
+	}
+	if networkLessKeyed(&a[16], &a[8]) {
+		a[8], a[16] = a[16], a[8]
+	}
+	if networkLessKeyed(&a[17], &a[9]) {
+		a[9], a[17] = a[17], a[9]
+	}
+	if networkLessKeyed(&a[18], &a[10]) {
+		a[10], a[18] = a[18], a[10]
+	}
+	if networkLessKeyed(&a[19], &a[11]) {
+		a[11], a[19] = a[19], a[11]
+	}
+	if networkLessKeyed(&a[12], &a[8]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if networkLessKeyed(&a[13], &a[9]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if networkLessKeyed(&a[14], &a[10]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if networkLessKeyed(&a[15], &a[11]) {
+		a[11], a[15] = a[15], a[11]
+	}
+	if networkLessKeyed(&a[8], &a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if networkLessKeyed(&a[12], &a[10]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if networkLessKeyed(&a[16], &a[14]) {
+		a[14], a[16] = a[16], a[14]
+	}
+	if networkLessKeyed(&a[9], &a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if networkLessKeyed(&a[13], &a[11]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if networkLessKeyed(&a[17], &a[15]) {
+		a[15], a[17] = a[17], a[15]
+	}
+	if networkLessKeyed(&a[2], &a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[4], &a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[6], &a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[8], &a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if networkLessKeyed(&a[10], &a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if networkLessKeyed(&a[12], &a[11]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if networkLessKeyed(&a[14], &a[13]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if networkLessKeyed(&a[16], &a[15]) {
+		a[15], a[16] = a[16], a[15]
+	}
+	if networkLessKeyed(&a[18], &a[17]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if networkLessKeyed(&a[20], &a[19]) {
+		a[19], a[20] = a[20], a[19]
+	}
+	if networkLessKeyed(&a[22], &a[21]) {
+		a[21], a[22] = a[22], a[21]
+	}
+}
+
+// NetworkMerge24xKeyed merges 24 runs, each of which must already be sorted
+// in increasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge24xKeyed(dst []Keyed, r0, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, r15, r16, r17, r18, r19, r20, r21, r22, r23 []Keyed) []Keyed {
+	var v0, v1, v2, v3, v4, v5, v6, v7, v8, v9, v10, v11, v12, v13, v14, v15, v16, v17, v18, v19, v20, v21, v22, v23, vm0, vm1, vm2, vm3, vm4, vm5, vm6, vm7, vm8, vm9, vm10, vm11, vm12, vm13, vm14, vm15, vm16, vm17, vm18, vm19, vm20, vm21, vm22 Keyed
+	var wm0, wm1, wm2, wm3, wm4, wm5, wm6, wm7, wm8, wm9, wm10, wm11, wm12, wm13, wm14, wm15, wm16, wm17, wm18, wm19, wm20, wm21, wm22 int
+	var okm0, okm1, okm2, okm3, okm4, okm5, okm6, okm7, okm8, okm9, okm10, okm11, okm12, okm13, okm14, okm15, okm16, okm17, okm18, okm19, okm20, okm21, okm22 bool
+	ok0, ok1, ok2, ok3, ok4, ok5, ok6, ok7, ok8, ok9, ok10, ok11, ok12, ok13, ok14, ok15, ok16, ok17, ok18, ok19, ok20, ok21, ok22, ok23 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0, len(r5) > 0, len(r6) > 0, len(r7) > 0, len(r8) > 0, len(r9) > 0, len(r10) > 0, len(r11) > 0, len(r12) > 0, len(r13) > 0, len(r14) > 0, len(r15) > 0, len(r16) > 0, len(r17) > 0, len(r18) > 0, len(r19) > 0, len(r20) > 0, len(r21) > 0, len(r22) > 0, len(r23) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	if ok5 {
+		v5 = r5[0]
+	}
+	if ok6 {
+		v6 = r6[0]
+	}
+	if ok7 {
+		v7 = r7[0]
+	}
+	if ok8 {
+		v8 = r8[0]
+	}
+	if ok9 {
+		v9 = r9[0]
+	}
+	if ok10 {
+		v10 = r10[0]
+	}
+	if ok11 {
+		v11 = r11[0]
+	}
+	if ok12 {
+		v12 = r12[0]
+	}
+	if ok13 {
+		v13 = r13[0]
+	}
+	if ok14 {
+		v14 = r14[0]
+	}
+	if ok15 {
+		v15 = r15[0]
+	}
+	if ok16 {
+		v16 = r16[0]
+	}
+	if ok17 {
+		v17 = r17[0]
+	}
+	if ok18 {
+		v18 = r18[0]
+	}
+	if ok19 {
+		v19 = r19[0]
+	}
+	if ok20 {
+		v20 = r20[0]
+	}
+	if ok21 {
+		v21 = r21[0]
+	}
+	if ok22 {
+		v22 = r22[0]
+	}
+	if ok23 {
+		v23 = r23[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = 4, v4, ok4
+	if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+		wm2, vm2, okm2 = 5, v5, true
+	}
+	wm3, vm3, okm3 = 6, v6, ok6
+	if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+		wm3, vm3, okm3 = 7, v7, true
+	}
+	wm4, vm4, okm4 = 8, v8, ok8
+	if ok9 && (!ok8 || networkLessKeyed(&v9, &v8)) {
+		wm4, vm4, okm4 = 9, v9, true
+	}
+	wm5, vm5, okm5 = 10, v10, ok10
+	if ok11 && (!ok10 || networkLessKeyed(&v11, &v10)) {
+		wm5, vm5, okm5 = 11, v11, true
+	}
+	wm6, vm6, okm6 = 12, v12, ok12
+	if ok13 && (!ok12 || networkLessKeyed(&v13, &v12)) {
+		wm6, vm6, okm6 = 13, v13, true
+	}
+	wm7, vm7, okm7 = 14, v14, ok14
+	if ok15 && (!ok14 || networkLessKeyed(&v15, &v14)) {
+		wm7, vm7, okm7 = 15, v15, true
+	}
+	wm8, vm8, okm8 = 16, v16, ok16
+	if ok17 && (!ok16 || networkLessKeyed(&v17, &v16)) {
+		wm8, vm8, okm8 = 17, v17, true
+	}
+	wm9, vm9, okm9 = 18, v18, ok18
+	if ok19 && (!ok18 || networkLessKeyed(&v19, &v18)) {
+		wm9, vm9, okm9 = 19, v19, true
+	}
+	wm10, vm10, okm10 = 20, v20, ok20
+	if ok21 && (!ok20 || networkLessKeyed(&v21, &v20)) {
+		wm10, vm10, okm10 = 21, v21, true
+	}
+	wm11, vm11, okm11 = 22, v22, ok22
+	if ok23 && (!ok22 || networkLessKeyed(&v23, &v22)) {
+		wm11, vm11, okm11 = 23, v23, true
+	}
+	wm12, vm12, okm12 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+		wm12, vm12, okm12 = wm1, vm1, true
+	}
+	wm13, vm13, okm13 = wm2, vm2, okm2
+	if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+		wm13, vm13, okm13 = wm3, vm3, true
+	}
+	wm14, vm14, okm14 = wm4, vm4, okm4
+	if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+		wm14, vm14, okm14 = wm5, vm5, true
+	}
+	wm15, vm15, okm15 = wm6, vm6, okm6
+	if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+		wm15, vm15, okm15 = wm7, vm7, true
+	}
+	wm16, vm16, okm16 = wm8, vm8, okm8
+	if okm9 && (!okm8 || networkLessKeyed(&vm9, &vm8)) {
+		wm16, vm16, okm16 = wm9, vm9, true
+	}
+	wm17, vm17, okm17 = wm10, vm10, okm10
+	if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+		wm17, vm17, okm17 = wm11, vm11, true
+	}
+	wm18, vm18, okm18 = wm12, vm12, okm12
+	if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+		wm18, vm18, okm18 = wm13, vm13, true
+	}
+	wm19, vm19, okm19 = wm14, vm14, okm14
+	if okm15 && (!okm14 || networkLessKeyed(&vm15, &vm14)) {
+		wm19, vm19, okm19 = wm15, vm15, true
+	}
+	wm20, vm20, okm20 = wm16, vm16, okm16
+	if okm17 && (!okm16 || networkLessKeyed(&vm17, &vm16)) {
+		wm20, vm20, okm20 = wm17, vm17, true
+	}
+	wm21, vm21, okm21 = wm18, vm18, okm18
+	if okm19 && (!okm18 || networkLessKeyed(&vm19, &vm18)) {
+		wm21, vm21, okm21 = wm19, vm19, true
+	}
+	wm22, vm22, okm22 = wm21, vm21, okm21
+	if okm20 && (!okm21 || networkLessKeyed(&vm20, &vm21)) {
+		wm22, vm22, okm22 = wm20, vm20, true
+	}
+
+	for okm22 {
+		dst = append(dst, vm22)
+		switch wm22 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm12, vm12, okm12 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm12, vm12, okm12 = wm1, vm1, true
+			}
+			wm18, vm18, okm18 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+				wm18, vm18, okm18 = wm13, vm13, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm19, &vm18)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm20, &vm21)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm12, vm12, okm12 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm12, vm12, okm12 = wm1, vm1, true
+			}
+			wm18, vm18, okm18 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+				wm18, vm18, okm18 = wm13, vm13, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm19, &vm18)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm20, &vm21)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm12, vm12, okm12 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm12, vm12, okm12 = wm1, vm1, true
+			}
+			wm18, vm18, okm18 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+				wm18, vm18, okm18 = wm13, vm13, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm19, &vm18)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm20, &vm21)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm12, vm12, okm12 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm12, vm12, okm12 = wm1, vm1, true
+			}
+			wm18, vm18, okm18 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+				wm18, vm18, okm18 = wm13, vm13, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm19, &vm18)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm20, &vm21)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm13, vm13, okm13 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm13, vm13, okm13 = wm3, vm3, true
+			}
+			wm18, vm18, okm18 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+				wm18, vm18, okm18 = wm13, vm13, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm19, &vm18)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm20, &vm21)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 5:
+			r5 = r5[1:]
+			if ok5 = len(r5) > 0; ok5 {
+				v5 = r5[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm13, vm13, okm13 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm13, vm13, okm13 = wm3, vm3, true
+			}
+			wm18, vm18, okm18 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+				wm18, vm18, okm18 = wm13, vm13, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm19, &vm18)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm20, &vm21)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 6:
+			r6 = r6[1:]
+			if ok6 = len(r6) > 0; ok6 {
+				v6 = r6[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm13, vm13, okm13 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm13, vm13, okm13 = wm3, vm3, true
+			}
+			wm18, vm18, okm18 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+				wm18, vm18, okm18 = wm13, vm13, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm19, &vm18)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm20, &vm21)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 7:
+			r7 = r7[1:]
+			if ok7 = len(r7) > 0; ok7 {
+				v7 = r7[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm13, vm13, okm13 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm13, vm13, okm13 = wm3, vm3, true
+			}
+			wm18, vm18, okm18 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+				wm18, vm18, okm18 = wm13, vm13, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm19, &vm18)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm20, &vm21)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 8:
+			r8 = r8[1:]
+			if ok8 = len(r8) > 0; ok8 {
+				v8 = r8[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v9, &v8)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm14, vm14, okm14 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm14, vm14, okm14 = wm5, vm5, true
+			}
+			wm19, vm19, okm19 = wm14, vm14, okm14
+			if okm15 && (!okm14 || networkLessKeyed(&vm15, &vm14)) {
+				wm19, vm19, okm19 = wm15, vm15, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm19, &vm18)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm20, &vm21)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 9:
+			r9 = r9[1:]
+			if ok9 = len(r9) > 0; ok9 {
+				v9 = r9[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v9, &v8)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm14, vm14, okm14 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm14, vm14, okm14 = wm5, vm5, true
+			}
+			wm19, vm19, okm19 = wm14, vm14, okm14
+			if okm15 && (!okm14 || networkLessKeyed(&vm15, &vm14)) {
+				wm19, vm19, okm19 = wm15, vm15, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm19, &vm18)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm20, &vm21)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 10:
+			r10 = r10[1:]
+			if ok10 = len(r10) > 0; ok10 {
+				v10 = r10[0]
+			}
+			wm5, vm5, okm5 = 10, v10, ok10
+			if ok11 && (!ok10 || networkLessKeyed(&v11, &v10)) {
+				wm5, vm5, okm5 = 11, v11, true
+			}
+			wm14, vm14, okm14 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm14, vm14, okm14 = wm5, vm5, true
+			}
+			wm19, vm19, okm19 = wm14, vm14, okm14
+			if okm15 && (!okm14 || networkLessKeyed(&vm15, &vm14)) {
+				wm19, vm19, okm19 = wm15, vm15, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm19, &vm18)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm20, &vm21)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 11:
+			r11 = r11[1:]
+			if ok11 = len(r11) > 0; ok11 {
+				v11 = r11[0]
+			}
+			wm5, vm5, okm5 = 10, v10, ok10
+			if ok11 && (!ok10 || networkLessKeyed(&v11, &v10)) {
+				wm5, vm5, okm5 = 11, v11, true
+			}
+			wm14, vm14, okm14 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm14, vm14, okm14 = wm5, vm5, true
+			}
+			wm19, vm19, okm19 = wm14, vm14, okm14
+			if okm15 && (!okm14 || networkLessKeyed(&vm15, &vm14)) {
+				wm19, vm19, okm19 = wm15, vm15, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm19, &vm18)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm20, &vm21)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 12:
+			r12 = r12[1:]
+			if ok12 = len(r12) > 0; ok12 {
+				v12 = r12[0]
+			}
+			wm6, vm6, okm6 = 12, v12, ok12
+			if ok13 && (!ok12 || networkLessKeyed(&v13, &v12)) {
+				wm6, vm6, okm6 = 13, v13, true
+			}
+			wm15, vm15, okm15 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+				wm15, vm15, okm15 = wm7, vm7, true
+			}
+			wm19, vm19, okm19 = wm14, vm14, okm14
+			if okm15 && (!okm14 || networkLessKeyed(&vm15, &vm14)) {
+				wm19, vm19, okm19 = wm15, vm15, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm19, &vm18)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm20, &vm21)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 13:
+			r13 = r13[1:]
+			if ok13 = len(r13) > 0; ok13 {
+				v13 = r13[0]
+			}
+			wm6, vm6, okm6 = 12, v12, ok12
+			if ok13 && (!ok12 || networkLessKeyed(&v13, &v12)) {
+				wm6, vm6, okm6 = 13, v13, true
+			}
+			wm15, vm15, okm15 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+				wm15, vm15, okm15 = wm7, vm7, true
+			}
+			wm19, vm19, okm19 = wm14, vm14, okm14
+			if okm15 && (!okm14 || networkLessKeyed(&vm15, &vm14)) {
+				wm19, vm19, okm19 = wm15, vm15, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm19, &vm18)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm20, &vm21)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 14:
+			r14 = r14[1:]
+			if ok14 = len(r14) > 0; ok14 {
+				v14 = r14[0]
+			}
+			wm7, vm7, okm7 = 14, v14, ok14
+			if ok15 && (!ok14 || networkLessKeyed(&v15, &v14)) {
+				wm7, vm7, okm7 = 15, v15, true
+			}
+			wm15, vm15, okm15 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+				wm15, vm15, okm15 = wm7, vm7, true
+			}
+			wm19, vm19, okm19 = wm14, vm14, okm14
+			if okm15 && (!okm14 || networkLessKeyed(&vm15, &vm14)) {
+				wm19, vm19, okm19 = wm15, vm15, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm19, &vm18)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm20, &vm21)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 15:
+			r15 = r15[1:]
+			if ok15 = len(r15) > 0; ok15 {
+				v15 = r15[0]
+			}
+			wm7, vm7, okm7 = 14, v14, ok14
+			if ok15 && (!ok14 || networkLessKeyed(&v15, &v14)) {
+				wm7, vm7, okm7 = 15, v15, true
+			}
+			wm15, vm15, okm15 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+				wm15, vm15, okm15 = wm7, vm7, true
+			}
+			wm19, vm19, okm19 = wm14, vm14, okm14
+			if okm15 && (!okm14 || networkLessKeyed(&vm15, &vm14)) {
+				wm19, vm19, okm19 = wm15, vm15, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm19, &vm18)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm20, &vm21)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 16:
+			r16 = r16[1:]
+			if ok16 = len(r16) > 0; ok16 {
+				v16 = r16[0]
+			}
+			wm8, vm8, okm8 = 16, v16, ok16
+			if ok17 && (!ok16 || networkLessKeyed(&v17, &v16)) {
+				wm8, vm8, okm8 = 17, v17, true
+			}
+			wm16, vm16, okm16 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm9, &vm8)) {
+				wm16, vm16, okm16 = wm9, vm9, true
+			}
+			wm20, vm20, okm20 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm17, &vm16)) {
+				wm20, vm20, okm20 = wm17, vm17, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm20, &vm21)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 17:
+			r17 = r17[1:]
+			if ok17 = len(r17) > 0; ok17 {
+				v17 = r17[0]
+			}
+			wm8, vm8, okm8 = 16, v16, ok16
+			if ok17 && (!ok16 || networkLessKeyed(&v17, &v16)) {
+				wm8, vm8, okm8 = 17, v17, true
+			}
+			wm16, vm16, okm16 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm9, &vm8)) {
+				wm16, vm16, okm16 = wm9, vm9, true
+			}
+			wm20, vm20, okm20 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm17, &vm16)) {
+				wm20, vm20, okm20 = wm17, vm17, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm20, &vm21)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 18:
+			r18 = r18[1:]
+			if ok18 = len(r18) > 0; ok18 {
+				v18 = r18[0]
+			}
+			wm9, vm9, okm9 = 18, v18, ok18
+			if ok19 && (!ok18 || networkLessKeyed(&v19, &v18)) {
+				wm9, vm9, okm9 = 19, v19, true
+			}
+			wm16, vm16, okm16 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm9, &vm8)) {
+				wm16, vm16, okm16 = wm9, vm9, true
+			}
+			wm20, vm20, okm20 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm17, &vm16)) {
+				wm20, vm20, okm20 = wm17, vm17, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm20, &vm21)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 19:
+			r19 = r19[1:]
+			if ok19 = len(r19) > 0; ok19 {
+				v19 = r19[0]
+			}
+			wm9, vm9, okm9 = 18, v18, ok18
+			if ok19 && (!ok18 || networkLessKeyed(&v19, &v18)) {
+				wm9, vm9, okm9 = 19, v19, true
+			}
+			wm16, vm16, okm16 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm9, &vm8)) {
+				wm16, vm16, okm16 = wm9, vm9, true
+			}
+			wm20, vm20, okm20 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm17, &vm16)) {
+				wm20, vm20, okm20 = wm17, vm17, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm20, &vm21)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 20:
+			r20 = r20[1:]
+			if ok20 = len(r20) > 0; ok20 {
+				v20 = r20[0]
+			}
+			wm10, vm10, okm10 = 20, v20, ok20
+			if ok21 && (!ok20 || networkLessKeyed(&v21, &v20)) {
+				wm10, vm10, okm10 = 21, v21, true
+			}
+			wm17, vm17, okm17 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm17, vm17, okm17 = wm11, vm11, true
+			}
+			wm20, vm20, okm20 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm17, &vm16)) {
+				wm20, vm20, okm20 = wm17, vm17, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm20, &vm21)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 21:
+			r21 = r21[1:]
+			if ok21 = len(r21) > 0; ok21 {
+				v21 = r21[0]
+			}
+			wm10, vm10, okm10 = 20, v20, ok20
+			if ok21 && (!ok20 || networkLessKeyed(&v21, &v20)) {
+				wm10, vm10, okm10 = 21, v21, true
+			}
+			wm17, vm17, okm17 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm17, vm17, okm17 = wm11, vm11, true
+			}
+			wm20, vm20, okm20 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm17, &vm16)) {
+				wm20, vm20, okm20 = wm17, vm17, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm20, &vm21)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 22:
+			r22 = r22[1:]
+			if ok22 = len(r22) > 0; ok22 {
+				v22 = r22[0]
+			}
+			wm11, vm11, okm11 = 22, v22, ok22
+			if ok23 && (!ok22 || networkLessKeyed(&v23, &v22)) {
+				wm11, vm11, okm11 = 23, v23, true
+			}
+			wm17, vm17, okm17 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm17, vm17, okm17 = wm11, vm11, true
+			}
+			wm20, vm20, okm20 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm17, &vm16)) {
+				wm20, vm20, okm20 = wm17, vm17, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm20, &vm21)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 23:
+			r23 = r23[1:]
+			if ok23 = len(r23) > 0; ok23 {
+				v23 = r23[0]
+			}
+			wm11, vm11, okm11 = 22, v22, ok22
+			if ok23 && (!ok22 || networkLessKeyed(&v23, &v22)) {
+				wm11, vm11, okm11 = 23, v23, true
+			}
+			wm17, vm17, okm17 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm17, vm17, okm17 = wm11, vm11, true
+			}
+			wm20, vm20, okm20 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm17, &vm16)) {
+				wm20, vm20, okm20 = wm17, vm17, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm20, &vm21)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort24xKeyedReverse(a []Keyed) {
+	_ = a[23]
+	if networkLessKeyed(&a[0], &a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[2], &a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[4], &a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if networkLessKeyed(&a[6], &a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[8], &a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if networkLessKeyed(&a[10], &a[11]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if networkLessKeyed(&a[12], &a[13]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if networkLessKeyed(&a[14], &a[15]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if networkLessKeyed(&a[16], &a[17]) {
+		a[16], a[17] = a[17], a[16]
+	}
+	if networkLessKeyed(&a[18], &a[19]) {
+		a[18], a[19] = a[19], a[18]
+	}
+	if networkLessKeyed(&a[20], &a[21]) {
+		a[20], a[21] = a[21], a[20]
+	}
+	if networkLessKeyed(&a[22], &a[23]) {
+		a[22], a[23] = a[23], a[22]
+	}
+	if networkLessKeyed(&a[1], &a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[5], &a[7]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if networkLessKeyed(&a[9], &a[11]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if networkLessKeyed(&a[0], &a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[4], &a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if networkLessKeyed(&a[8], &a[10]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if networkLessKeyed(&a[13], &a[15]) {
+		a[13], a[15] = a[15], a[13]
+	}
+	if networkLessKeyed(&a[17], &a[19]) {
+		a[17], a[19] = a[19], a[17]
+	}
+	if networkLessKeyed(&a[21], &a[23]) {
+		a[21], a[23] = a[23], a[21]
+	}
+	if networkLessKeyed(&a[12], &a[14]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if networkLessKeyed(&a[16], &a[18]) {
+		a[16], a[18] = a[18], a[16]
+	}
+	if networkLessKeyed(&a[20], &a[22]) {
+		a[20], a[22] = a[22], a[20]
+	}
+	if networkLessKeyed(&a[1], &a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[5], &a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[9], &a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if networkLessKeyed(&a[13], &a[14]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if networkLessKeyed(&a[17], &a[18]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if networkLessKeyed(&a[21], &a[22]) {
+		a[21], a[22] = a[22], a[21]
+	}
+	if networkLessKeyed(&a[1], &a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if networkLessKeyed(&a[6], &a[10]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if networkLessKeyed(&a[13], &a[17]) {
+		a[13], a[17] = a[17], a[13]
+	}
+	if networkLessKeyed(&a[18], &a[22]) {
+		a[18], a[22] = a[22], a[18]
+	}
+	if networkLessKeyed(&a[5], &a[9]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if networkLessKeyed(&a[2], &a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if networkLessKeyed(&a[17], &a[21]) {
+		a[17], a[21] = a[21], a[17]
+	}
+	if networkLessKeyed(&a[14], &a[18]) {
+		a[14], a[18] = a[18], a[14]
+	}
+	if networkLessKeyed(&a[1], &a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if networkLessKeyed(&a[6], &a[10]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if networkLessKeyed(&a[0], &a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if networkLessKeyed(&a[7], &a[11]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if networkLessKeyed(&a[13], &a[17]) {
+		a[13], a[17] = a[17], a[13]
+	}
+	if networkLessKeyed(&a[18], &a[22]) {
+		a[18], a[22] = a[22], a[18]
+	}
+	if networkLessKeyed(&a[12], &a[16]) {
+		a[12], a[16] = a[16], a[12]
+	}
+	if networkLessKeyed(&a[19], &a[23]) {
+		a[19], a[23] = a[23], a[19]
+	}
+	if networkLessKeyed(&a[3], &a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if networkLessKeyed(&a[4], &a[8]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if networkLessKeyed(&a[15], &a[19]) {
+		a[15], a[19] = a[19], a[15]
+	}
+	if networkLessKeyed(&a[16], &a[20]) {
+		a[16], a[20] = a[20], a[16]
+	}
+	if networkLessKeyed(&a[0], &a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if networkLessKeyed(&a[7], &a[11]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if networkLessKeyed(&a[12], &a[16]) {
+		a[12], a[16] = a[16], a[12]
+	}
+	if networkLessKeyed(&a[19], &a[23]) {
+		a[19], a[23] = a[23], a[19]
+	}
+	if networkLessKeyed(&a[1], &a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if networkLessKeyed(&a[7], &a[10]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if networkLessKeyed(&a[3], &a[8]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if networkLessKeyed(&a[13], &a[16]) {
+		a[13], a[16] = a[16], a[13]
+	}
+	if networkLessKeyed(&a[19], &a[22]) {
+		a[19], a[22] = a[22], a[19]
+	}
+	if networkLessKeyed(&a[15], &a[20]) {
+		a[15], a[20] = a[20], a[15]
+	}
+	if networkLessKeyed(&a[2], &a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[8], &a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if networkLessKeyed(&a[14], &a[15]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if networkLessKeyed(&a[20], &a[21]) {
+		a[20], a[21] = a[21], a[20]
+	}
+	if networkLessKeyed(&a[2], &a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if networkLessKeyed(&a[7], &a[9]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if networkLessKeyed(&a[3], &a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if networkLessKeyed(&a[6], &a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if networkLessKeyed(&a[14], &a[16]) {
+		a[14], a[16] = a[16], a[14]
+	}
+	if networkLessKeyed(&a[19], &a[21]) {
+		a[19], a[21] = a[21], a[19]
+	}
+	if networkLessKeyed(&a[15], &a[17]) {
+		a[15], a[17] = a[17], a[15]
+	}
+	if networkLessKeyed(&a[18], &a[20]) {
+		a[18], a[20] = a[20], a[18]
+	}
+	if networkLessKeyed(&a[3], &a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[5], &a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[7], &a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if networkLessKeyed(&a[15], &a[16]) {
+		a[15], a[16] = a[16], a[15]
+	}
+	if networkLessKeyed(&a[17], &a[18]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if networkLessKeyed(&a[19], &a[20]) {
+		a[19], a[20] = a[20], a[19]
+	}
+	if networkLessKeyed(&a[0], &a[12]) {
+		a[0], a[12] = a[12], a[0]
+	}
+	if networkLessKeyed(&a[1], &a[13]) {
+		a[1], a[13] = a[13], a[1]
+	}
+	if networkLessKeyed(&a[2], &a[14]) {
+		a[2], a[14] = a[14], a[2]
+	}
+	if networkLessKeyed(&a[3], &a[15]) {
+		a[3], a[15] = a[15], a[3]
+	}
+	if networkLessKeyed(&a[4], &a[16]) {
+		a[4], a[16] = a[16], a[4]
+	}
+	if networkLessKeyed(&a[5], &a[17]) {
+		a[5], a[17] = a[17], a[5]
+	}
+	if networkLessKeyed(&a[6], &a[18]) {
+		a[6], a[18] = a[18], a[6]
+	}
+	if networkLessKeyed(&a[7], &a[19]) {
+		a[7], a[19] = a[19], a[7]
+	}
+	if networkLessKeyed(&a[8], &a[20]) {
+		a[8], a[20] = a[20], a[8]
+	}
+	if networkLessKeyed(&a[9], &a[21]) {
+		a[9], a[21] = a[21], a[9]
+	}
+	if networkLessKeyed(&a[10], &a[22]) {
+		a[10], a[22] = a[22], a[10]
+	}
+	if networkLessKeyed(&a[11], &a[23]) {
+		a[11], a[23] = a[23], a[11]
+	}
+	if networkLessKeyed(&a[2], &a[12]) {
+		a[2], a[12] = a[12], a[2]
+	}
+	if networkLessKeyed(&a[3], &a[13]) {
+		a[3], a[13] = a[13], a[3]
+	}
+	if networkLessKeyed(&a[10], &a[20]) {
+		a[10], a[20] = a[20], a[10]
+	}
+	if networkLessKeyed(&a[11], &a[21]) {
+		a[11], a[21] = a[21], a[11]
+	}
+	if networkLessKeyed(&a[4], &a[12]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if networkLessKeyed(&a[5], &a[13]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if networkLessKeyed(&a[6], &a[14]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if networkLessKeyed(&a[7], &a[15]) {
+		a[7], a[15] = a[15], a[7]
+	}
+	if networkLessKeyed(&a[8], &a[16]) {
+		a[8], a[16] = a[16], a[8]
+	}
+	if networkLessKeyed(&a[9], &a[17]) {
+		a[9], a[17] = a[17], a[9]
+	}
+	if networkLessKeyed(&a[10], &a[18]) {
+		a[10], a[18] = a[18], a[10]
+	}
+	if networkLessKeyed(&a[11], &a[19]) {
+		a[11], a[19] = a[19], a[11]
+	}
+	if networkLessKeyed(&a[8], &a[12]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if networkLessKeyed(&a[9], &a[13]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if networkLessKeyed(&a[10], &a[14]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if networkLessKeyed(&a[11], &a[15]) {
+		a[11], a[15] = a[15], a[11]
+	}
+	if networkLessKeyed(&a[6], &a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if networkLessKeyed(&a[10], &a[12]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if networkLessKeyed(&a[14], &a[16]) {
+		a[14], a[16] = a[16], a[14]
+	}
+	if networkLessKeyed(&a[7], &a[9]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if networkLessKeyed(&a[11], &a[13]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if networkLessKeyed(&a[15], &a[17]) {
+		a[15], a[17] = a[17], a[15]
+	}
+	if networkLessKeyed(&a[1], &a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[3], &a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[5], &a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[7], &a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if networkLessKeyed(&a[9], &a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if networkLessKeyed(&a[11], &a[12]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if networkLessKeyed(&a[13], &a[14]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if networkLessKeyed(&a[15], &a[16]) {
+		a[15], a[16] = a[16], a[15]
+	}
+	if networkLessKeyed(&a[17], &a[18]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if networkLessKeyed(&a[19], &a[20]) {
+		a[19], a[20] = a[20], a[19]
+	}
+	if networkLessKeyed(&a[21], &a[22]) {
+		a[21], a[22] = a[22], a[21]
+	}
+}
+
+// NetworkMerge24xKeyedReverse merges 24 runs, each of which must already be sorted
+// in decreasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge24xKeyedReverse(dst []Keyed, r0, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, r15, r16, r17, r18, r19, r20, r21, r22, r23 []Keyed) []Keyed {
+	var v0, v1, v2, v3, v4, v5, v6, v7, v8, v9, v10, v11, v12, v13, v14, v15, v16, v17, v18, v19, v20, v21, v22, v23, vm0, vm1, vm2, vm3, vm4, vm5, vm6, vm7, vm8, vm9, vm10, vm11, vm12, vm13, vm14, vm15, vm16, vm17, vm18, vm19, vm20, vm21, vm22 Keyed
+	var wm0, wm1, wm2, wm3, wm4, wm5, wm6, wm7, wm8, wm9, wm10, wm11, wm12, wm13, wm14, wm15, wm16, wm17, wm18, wm19, wm20, wm21, wm22 int
+	var okm0, okm1, okm2, okm3, okm4, okm5, okm6, okm7, okm8, okm9, okm10, okm11, okm12, okm13, okm14, okm15, okm16, okm17, okm18, okm19, okm20, okm21, okm22 bool
+	ok0, ok1, ok2, ok3, ok4, ok5, ok6, ok7, ok8, ok9, ok10, ok11, ok12, ok13, ok14, ok15, ok16, ok17, ok18, ok19, ok20, ok21, ok22, ok23 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0, len(r5) > 0, len(r6) > 0, len(r7) > 0, len(r8) > 0, len(r9) > 0, len(r10) > 0, len(r11) > 0, len(r12) > 0, len(r13) > 0, len(r14) > 0, len(r15) > 0, len(r16) > 0, len(r17) > 0, len(r18) > 0, len(r19) > 0, len(r20) > 0, len(r21) > 0, len(r22) > 0, len(r23) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	if ok5 {
+		v5 = r5[0]
+	}
+	if ok6 {
+		v6 = r6[0]
+	}
+	if ok7 {
+		v7 = r7[0]
+	}
+	if ok8 {
+		v8 = r8[0]
+	}
+	if ok9 {
+		v9 = r9[0]
+	}
+	if ok10 {
+		v10 = r10[0]
+	}
+	if ok11 {
+		v11 = r11[0]
+	}
+	if ok12 {
+		v12 = r12[0]
+	}
+	if ok13 {
+		v13 = r13[0]
+	}
+	if ok14 {
+		v14 = r14[0]
+	}
+	if ok15 {
+		v15 = r15[0]
+	}
+	if ok16 {
+		v16 = r16[0]
+	}
+	if ok17 {
+		v17 = r17[0]
+	}
+	if ok18 {
+		v18 = r18[0]
+	}
+	if ok19 {
+		v19 = r19[0]
+	}
+	if ok20 {
+		v20 = r20[0]
+	}
+	if ok21 {
+		v21 = r21[0]
+	}
+	if ok22 {
+		v22 = r22[0]
+	}
+	if ok23 {
+		v23 = r23[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = 4, v4, ok4
+	if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+		wm2, vm2, okm2 = 5, v5, true
+	}
+	wm3, vm3, okm3 = 6, v6, ok6
+	if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+		wm3, vm3, okm3 = 7, v7, true
+	}
+	wm4, vm4, okm4 = 8, v8, ok8
+	if ok9 && (!ok8 || networkLessKeyed(&v8, &v9)) {
+		wm4, vm4, okm4 = 9, v9, true
+	}
+	wm5, vm5, okm5 = 10, v10, ok10
+	if ok11 && (!ok10 || networkLessKeyed(&v10, &v11)) {
+		wm5, vm5, okm5 = 11, v11, true
+	}
+	wm6, vm6, okm6 = 12, v12, ok12
+	if ok13 && (!ok12 || networkLessKeyed(&v12, &v13)) {
+		wm6, vm6, okm6 = 13, v13, true
+	}
+	wm7, vm7, okm7 = 14, v14, ok14
+	if ok15 && (!ok14 || networkLessKeyed(&v14, &v15)) {
+		wm7, vm7, okm7 = 15, v15, true
+	}
+	wm8, vm8, okm8 = 16, v16, ok16
+	if ok17 && (!ok16 || networkLessKeyed(&v16, &v17)) {
+		wm8, vm8, okm8 = 17, v17, true
+	}
+	wm9, vm9, okm9 = 18, v18, ok18
+	if ok19 && (!ok18 || networkLessKeyed(&v18, &v19)) {
+		wm9, vm9, okm9 = 19, v19, true
+	}
+	wm10, vm10, okm10 = 20, v20, ok20
+	if ok21 && (!ok20 || networkLessKeyed(&v20, &v21)) {
+		wm10, vm10, okm10 = 21, v21, true
+	}
+	wm11, vm11, okm11 = 22, v22, ok22
+	if ok23 && (!ok22 || networkLessKeyed(&v22, &v23)) {
+		wm11, vm11, okm11 = 23, v23, true
+	}
+	wm12, vm12, okm12 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+		wm12, vm12, okm12 = wm1, vm1, true
+	}
+	wm13, vm13, okm13 = wm2, vm2, okm2
+	if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+		wm13, vm13, okm13 = wm3, vm3, true
+	}
+	wm14, vm14, okm14 = wm4, vm4, okm4
+	if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+		wm14, vm14, okm14 = wm5, vm5, true
+	}
+	wm15, vm15, okm15 = wm6, vm6, okm6
+	if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+		wm15, vm15, okm15 = wm7, vm7, true
+	}
+	wm16, vm16, okm16 = wm8, vm8, okm8
+	if okm9 && (!okm8 || networkLessKeyed(&vm8, &vm9)) {
+		wm16, vm16, okm16 = wm9, vm9, true
+	}
+	wm17, vm17, okm17 = wm10, vm10, okm10
+	if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+		wm17, vm17, okm17 = wm11, vm11, true
+	}
+	wm18, vm18, okm18 = wm12, vm12, okm12
+	if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+		wm18, vm18, okm18 = wm13, vm13, true
+	}
+	wm19, vm19, okm19 = wm14, vm14, okm14
+	if okm15 && (!okm14 || networkLessKeyed(&vm14, &vm15)) {
+		wm19, vm19, okm19 = wm15, vm15, true
+	}
+	wm20, vm20, okm20 = wm16, vm16, okm16
+	if okm17 && (!okm16 || networkLessKeyed(&vm16, &vm17)) {
+		wm20, vm20, okm20 = wm17, vm17, true
+	}
+	wm21, vm21, okm21 = wm18, vm18, okm18
+	if okm19 && (!okm18 || networkLessKeyed(&vm18, &vm19)) {
+		wm21, vm21, okm21 = wm19, vm19, true
+	}
+	wm22, vm22, okm22 = wm21, vm21, okm21
+	if okm20 && (!okm21 || networkLessKeyed(&vm21, &vm20)) {
+		wm22, vm22, okm22 = wm20, vm20, true
+	}
+
+	for okm22 {
+		dst = append(dst, vm22)
+		switch wm22 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm12, vm12, okm12 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm12, vm12, okm12 = wm1, vm1, true
+			}
+			wm18, vm18, okm18 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+				wm18, vm18, okm18 = wm13, vm13, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm18, &vm19)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm21, &vm20)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm12, vm12, okm12 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm12, vm12, okm12 = wm1, vm1, true
+			}
+			wm18, vm18, okm18 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+				wm18, vm18, okm18 = wm13, vm13, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm18, &vm19)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm21, &vm20)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm12, vm12, okm12 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm12, vm12, okm12 = wm1, vm1, true
+			}
+			wm18, vm18, okm18 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+				wm18, vm18, okm18 = wm13, vm13, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm18, &vm19)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm21, &vm20)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm12, vm12, okm12 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm12, vm12, okm12 = wm1, vm1, true
+			}
+			wm18, vm18, okm18 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+				wm18, vm18, okm18 = wm13, vm13, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm18, &vm19)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm21, &vm20)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm13, vm13, okm13 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm13, vm13, okm13 = wm3, vm3, true
+			}
+			wm18, vm18, okm18 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+				wm18, vm18, okm18 = wm13, vm13, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm18, &vm19)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm21, &vm20)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 5:
+			r5 = r5[1:]
+			if ok5 = len(r5) > 0; ok5 {
+				v5 = r5[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm13, vm13, okm13 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm13, vm13, okm13 = wm3, vm3, true
+			}
+			wm18, vm18, okm18 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+				wm18, vm18, okm18 = wm13, vm13, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm18, &vm19)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm21, &vm20)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 6:
+			r6 = r6[1:]
+			if ok6 = len(r6) > 0; ok6 {
+				v6 = r6[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm13, vm13, okm13 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm13, vm13, okm13 = wm3, vm3, true
+			}
+			wm18, vm18, okm18 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+				wm18, vm18, okm18 = wm13, vm13, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm18, &vm19)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm21, &vm20)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 7:
+			r7 = r7[1:]
+			if ok7 = len(r7) > 0; ok7 {
+				v7 = r7[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm13, vm13, okm13 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm13, vm13, okm13 = wm3, vm3, true
+			}
+			wm18, vm18, okm18 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+				wm18, vm18, okm18 = wm13, vm13, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm18, &vm19)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm21, &vm20)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 8:
+			r8 = r8[1:]
+			if ok8 = len(r8) > 0; ok8 {
+				v8 = r8[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v8, &v9)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm14, vm14, okm14 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm14, vm14, okm14 = wm5, vm5, true
+			}
+			wm19, vm19, okm19 = wm14, vm14, okm14
+			if okm15 && (!okm14 || networkLessKeyed(&vm14, &vm15)) {
+				wm19, vm19, okm19 = wm15, vm15, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm18, &vm19)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm21, &vm20)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 9:
+			r9 = r9[1:]
+			if ok9 = len(r9) > 0; ok9 {
+				v9 = r9[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v8, &v9)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm14, vm14, okm14 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm14, vm14, okm14 = wm5, vm5, true
+			}
+			wm19, vm19, okm19 = wm14, vm14, okm14
+			if okm15 && (!okm14 || networkLessKeyed(&vm14, &vm15)) {
+				wm19, vm19, okm19 = wm15, vm15, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm18, &vm19)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm21, &vm20)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 10:
+			r10 = r10[1:]
+			if ok10 = len(r10) > 0; ok10 {
+				v10 = r10[0]
+			}
+			wm5, vm5, okm5 = 10, v10, ok10
+			if ok11 && (!ok10 || networkLessKeyed(&v10, &v11)) {
+				wm5, vm5, okm5 = 11, v11, true
+			}
+			wm14, vm14, okm14 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm14, vm14, okm14 = wm5, vm5, true
+			}
+			wm19, vm19, okm19 = wm14, vm14, okm14
+			if okm15 && (!okm14 || networkLessKeyed(&vm14, &vm15)) {
+				wm19, vm19, okm19 = wm15, vm15, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm18, &vm19)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm21, &vm20)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 11:
+			r11 = r11[1:]
+			if ok11 = len(r11) > 0; ok11 {
+				v11 = r11[0]
+			}
+			wm5, vm5, okm5 = 10, v10, ok10
+			if ok11 && (!ok10 || networkLessKeyed(&v10, &v11)) {
+				wm5, vm5, okm5 = 11, v11, true
+			}
+			wm14, vm14, okm14 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm14, vm14, okm14 = wm5, vm5, true
+			}
+			wm19, vm19, okm19 = wm14, vm14, okm14
+			if okm15 && (!okm14 || networkLessKeyed(&vm14, &vm15)) {
+				wm19, vm19, okm19 = wm15, vm15, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm18, &vm19)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm21, &vm20)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 12:
+			r12 = r12[1:]
+			if ok12 = len(r12) > 0; ok12 {
+				v12 = r12[0]
+			}
+			wm6, vm6, okm6 = 12, v12, ok12
+			if ok13 && (!ok12 || networkLessKeyed(&v12, &v13)) {
+				wm6, vm6, okm6 = 13, v13, true
+			}
+			wm15, vm15, okm15 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+				wm15, vm15, okm15 = wm7, vm7, true
+			}
+			wm19, vm19, okm19 = wm14, vm14, okm14
+			if okm15 && (!okm14 || networkLessKeyed(&vm14, &vm15)) {
+				wm19, vm19, okm19 = wm15, vm15, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm18, &vm19)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm21, &vm20)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 13:
+			r13 = r13[1:]
+			if ok13 = len(r13) > 0; ok13 {
+				v13 = r13[0]
+			}
+			wm6, vm6, okm6 = 12, v12, ok12
+			if ok13 && (!ok12 || networkLessKeyed(&v12, &v13)) {
+				wm6, vm6, okm6 = 13, v13, true
+			}
+			wm15, vm15, okm15 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+				wm15, vm15, okm15 = wm7, vm7, true
+			}
+			wm19, vm19, okm19 = wm14, vm14, okm14
+			if okm15 && (!okm14 || networkLessKeyed(&vm14, &vm15)) {
+				wm19, vm19, okm19 = wm15, vm15, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm18, &vm19)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm21, &vm20)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 14:
+			r14 = r14[1:]
+			if ok14 = len(r14) > 0; ok14 {
+				v14 = r14[0]
+			}
+			wm7, vm7, okm7 = 14, v14, ok14
+			if ok15 && (!ok14 || networkLessKeyed(&v14, &v15)) {
+				wm7, vm7, okm7 = 15, v15, true
+			}
+			wm15, vm15, okm15 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+				wm15, vm15, okm15 = wm7, vm7, true
+			}
+			wm19, vm19, okm19 = wm14, vm14, okm14
+			if okm15 && (!okm14 || networkLessKeyed(&vm14, &vm15)) {
+				wm19, vm19, okm19 = wm15, vm15, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm18, &vm19)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm21, &vm20)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 15:
+			r15 = r15[1:]
+			if ok15 = len(r15) > 0; ok15 {
+				v15 = r15[0]
+			}
+			wm7, vm7, okm7 = 14, v14, ok14
+			if ok15 && (!ok14 || networkLessKeyed(&v14, &v15)) {
+				wm7, vm7, okm7 = 15, v15, true
+			}
+			wm15, vm15, okm15 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+				wm15, vm15, okm15 = wm7, vm7, true
+			}
+			wm19, vm19, okm19 = wm14, vm14, okm14
+			if okm15 && (!okm14 || networkLessKeyed(&vm14, &vm15)) {
+				wm19, vm19, okm19 = wm15, vm15, true
+			}
+			wm21, vm21, okm21 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm18, &vm19)) {
+				wm21, vm21, okm21 = wm19, vm19, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm21, &vm20)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 16:
+			r16 = r16[1:]
+			if ok16 = len(r16) > 0; ok16 {
+				v16 = r16[0]
+			}
+			wm8, vm8, okm8 = 16, v16, ok16
+			if ok17 && (!ok16 || networkLessKeyed(&v16, &v17)) {
+				wm8, vm8, okm8 = 17, v17, true
+			}
+			wm16, vm16, okm16 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm8, &vm9)) {
+				wm16, vm16, okm16 = wm9, vm9, true
+			}
+			wm20, vm20, okm20 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm16, &vm17)) {
+				wm20, vm20, okm20 = wm17, vm17, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm21, &vm20)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 17:
+			r17 = r17[1:]
+			if ok17 = len(r17) > 0; ok17 {
+				v17 = r17[0]
+			}
+			wm8, vm8, okm8 = 16, v16, ok16
+			if ok17 && (!ok16 || networkLessKeyed(&v16, &v17)) {
+				wm8, vm8, okm8 = 17, v17, true
+			}
+			wm16, vm16, okm16 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm8, &vm9)) {
+				wm16, vm16, okm16 = wm9, vm9, true
+			}
+			wm20, vm20, okm20 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm16, &vm17)) {
+				wm20, vm20, okm20 = wm17, vm17, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm21, &vm20)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 18:
+			r18 = r18[1:]
+			if ok18 = len(r18) > 0; ok18 {
+				v18 = r18[0]
+			}
+			wm9, vm9, okm9 = 18, v18, ok18
+			if ok19 && (!ok18 || networkLessKeyed(&v18, &v19)) {
+				wm9, vm9, okm9 = 19, v19, true
+			}
+			wm16, vm16, okm16 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm8, &vm9)) {
+				wm16, vm16, okm16 = wm9, vm9, true
+			}
+			wm20, vm20, okm20 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm16, &vm17)) {
+				wm20, vm20, okm20 = wm17, vm17, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm21, &vm20)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 19:
+			r19 = r19[1:]
+			if ok19 = len(r19) > 0; ok19 {
+				v19 = r19[0]
+			}
+			wm9, vm9, okm9 = 18, v18, ok18
+			if ok19 && (!ok18 || networkLessKeyed(&v18, &v19)) {
+				wm9, vm9, okm9 = 19, v19, true
+			}
+			wm16, vm16, okm16 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm8, &vm9)) {
+				wm16, vm16, okm16 = wm9, vm9, true
+			}
+			wm20, vm20, okm20 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm16, &vm17)) {
+				wm20, vm20, okm20 = wm17, vm17, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm21, &vm20)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 20:
+			r20 = r20[1:]
+			if ok20 = len(r20) > 0; ok20 {
+				v20 = r20[0]
+			}
+			wm10, vm10, okm10 = 20, v20, ok20
+			if ok21 && (!ok20 || networkLessKeyed(&v20, &v21)) {
+				wm10, vm10, okm10 = 21, v21, true
+			}
+			wm17, vm17, okm17 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm17, vm17, okm17 = wm11, vm11, true
+			}
+			wm20, vm20, okm20 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm16, &vm17)) {
+				wm20, vm20, okm20 = wm17, vm17, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm21, &vm20)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 21:
+			r21 = r21[1:]
+			if ok21 = len(r21) > 0; ok21 {
+				v21 = r21[0]
+			}
+			wm10, vm10, okm10 = 20, v20, ok20
+			if ok21 && (!ok20 || networkLessKeyed(&v20, &v21)) {
+				wm10, vm10, okm10 = 21, v21, true
+			}
+			wm17, vm17, okm17 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm17, vm17, okm17 = wm11, vm11, true
+			}
+			wm20, vm20, okm20 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm16, &vm17)) {
+				wm20, vm20, okm20 = wm17, vm17, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm21, &vm20)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 22:
+			r22 = r22[1:]
+			if ok22 = len(r22) > 0; ok22 {
+				v22 = r22[0]
+			}
+			wm11, vm11, okm11 = 22, v22, ok22
+			if ok23 && (!ok22 || networkLessKeyed(&v22, &v23)) {
+				wm11, vm11, okm11 = 23, v23, true
+			}
+			wm17, vm17, okm17 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm17, vm17, okm17 = wm11, vm11, true
+			}
+			wm20, vm20, okm20 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm16, &vm17)) {
+				wm20, vm20, okm20 = wm17, vm17, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm21, &vm20)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		case 23:
+			r23 = r23[1:]
+			if ok23 = len(r23) > 0; ok23 {
+				v23 = r23[0]
+			}
+			wm11, vm11, okm11 = 22, v22, ok22
+			if ok23 && (!ok22 || networkLessKeyed(&v22, &v23)) {
+				wm11, vm11, okm11 = 23, v23, true
+			}
+			wm17, vm17, okm17 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm17, vm17, okm17 = wm11, vm11, true
+			}
+			wm20, vm20, okm20 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm16, &vm17)) {
+				wm20, vm20, okm20 = wm17, vm17, true
+			}
+			wm22, vm22, okm22 = wm21, vm21, okm21
+			if okm20 && (!okm21 || networkLessKeyed(&vm21, &vm20)) {
+				wm22, vm22, okm22 = wm20, vm20, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort32xKeyed(a []Keyed) {
+	_ = a[31]
+	if networkLessKeyed(&a[1], &a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[3], &a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[2], &a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[3], &a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[2], &a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[5], &a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if networkLessKeyed(&a[7], &a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[6], &a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if networkLessKeyed(&a[7], &a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if networkLessKeyed(&a[6], &a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[4], &a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if networkLessKeyed(&a[5], &a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if networkLessKeyed(&a[4], &a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if networkLessKeyed(&a[6], &a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if networkLessKeyed(&a[7], &a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if networkLessKeyed(&a[6], &a[3]) {
+		a[3], a[6] = a[6], a[3]
+	}
+	if networkLessKeyed(&a[4], &a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if networkLessKeyed(&a[5], &a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if networkLessKeyed(&a[4], &a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[9], &a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if networkLessKeyed(&a[11], &a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if networkLessKeyed(&a[10], &a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if networkLessKeyed(&a[11], &a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if networkLessKeyed(&a[10], &a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if networkLessKeyed(&a[13], &a[12]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if networkLessKeyed(&a[15], &a[14]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if networkLessKeyed(&a[14], &a[12]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if networkLessKeyed(&a[15], &a[13]) {
+		a[13], a[15] = a[15], a[13]
+	}
+	if networkLessKeyed(&a[14], &a[13]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if networkLessKeyed(&a[12], &a[8]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if networkLessKeyed(&a[13], &a[9]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if networkLessKeyed(&a[12], &a[9]) {
+		a[9], a[12] = a[12], a[9]
+	}
+	if networkLessKeyed(&a[14], &a[10]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if networkLessKeyed(&a[15], &a[11]) {
+		a[11], a[15] = a[15], a[11]
+	}
+	if networkLessKeyed(&a[14], &a[11]) {
+		a[11], a[14] = a[14], a[11]
+	}
+	if networkLessKeyed(&a[12], &a[10]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if networkLessKeyed(&a[13], &a[11]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if networkLessKeyed(&a[12], &a[11]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if networkLessKeyed(&a[8], &a[0]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if networkLessKeyed(&a[9], &a[1]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if networkLessKeyed(&a[8], &a[1]) {
+		a[1], a[8] = a[8], a[1]
+	}
+	if networkLessKeyed(&a[10], &a[2]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if networkLessKeyed(&a[11], &a[3]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if networkLessKeyed(&a[10], &a[3]) {
+		a[3], a[10] = a[10], a[3]
+	}
+	if networkLessKeyed(&a[8], &a[2]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if networkLessKeyed(&a[9], &a[3]) {
+		a[3], a[9] = a[9], a[3]
+	}
+	if networkLessKeyed(&a[8], &a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if networkLessKeyed(&a[12], &a[4]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if networkLessKeyed(&a[13], &a[5]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if networkLessKeyed(&a[12], &a[5]) {
+		a[5], a[12] = a[12], a[5]
+	}
+	if networkLessKeyed(&a[14], &a[6]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if networkLessKeyed(&a[15], &a[7]) {
+		a[7], a[15] = a[15], a[7]
+	}
+	if networkLessKeyed(&a[14], &a[7]) {
+		a[7], a[14] = a[14], a[7]
+	}
+	if networkLessKeyed(&a[12], &a[6]) {
+		a[6], a[12] = a[12], a[6]
+	}
+	if networkLessKeyed(&a[13], &a[7]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if networkLessKeyed(&a[12], &a[7]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if networkLessKeyed(&a[8], &a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if networkLessKeyed(&a[9], &a[5]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if networkLessKeyed(&a[8], &a[5]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if networkLessKeyed(&a[10], &a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if networkLessKeyed(&a[11], &a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if networkLessKeyed(&a[10], &a[7]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if networkLessKeyed(&a[8], &a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if networkLessKeyed(&a[9], &a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if networkLessKeyed(&a[8], &a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if networkLessKeyed(&a[17], &a[16]) {
+		a[16], a[17] = a[17], a[16]
+	}
+	if networkLessKeyed(&a[19], &a[18]) {
+		a[18], a[19] = a[19], a[18]
+	}
+	if networkLessKeyed(&a[18], &a[16]) {
+		a[16], a[18] = a[18], a[16]
+	}
+	if networkLessKeyed(&a[19], &a[17]) {
+		a[17], a[19] = a[19], a[17]
+	}
+	if networkLessKeyed(&a[18], &a[17]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if networkLessKeyed(&a[21], &a[20]) {
+		a[20], a[21] = a[21], a[20]
+	}
+	if networkLessKeyed(&a[23], &a[22]) {
+		a[22], a[23] = a[23], a[22]
+	}
+	if networkLessKeyed(&a[22], &a[20]) {
+		a[20], a[22] = a[22], a[20]
+	}
+	if networkLessKeyed(&a[23], &a[21]) {
+		a[21], a[23] = a[23], a[21]
+	}
+	if networkLessKeyed(&a[22], &a[21]) {
+		a[21], a[22] = a[22], a[21]
+	}
+	if networkLessKeyed(&a[20], &a[16]) {
+		a[16], a[20] = a[20], a[16]
+	}
+	if networkLessKeyed(&a[21], &a[17]) {
+		a[17], a[21] = a[21], a[17]
+	}
+	if networkLessKeyed(&a[20], &a[17]) {
+		a[17], a[20] = a[20], a[17]
+	}
+	if networkLessKeyed(&a[22], &a[18]) {
+		a[18], a[22] = a[22], a[18]
+	}
+	if networkLessKeyed(&a[23], &a[19]) {
+		a[19], a[23] = a[23], a[19]
+	}
+	if networkLessKeyed(&a[22], &a[19]) {
+		a[19], a[22] = a[22], a[19]
+	}
+	if networkLessKeyed(&a[20], &a[18]) {
+		a[18], a[20] = a[20], a[18]
+	}
+	if networkLessKeyed(&a[21], &a[19]) {
+		a[19], a[21] = a[21], a[19]
+	}
+	if networkLessKeyed(&a[20], &a[19]) {
+		a[19], a[20] = a[20], a[19]
+	}
+	if networkLessKeyed(&a[25], &a[24]) {
+		a[24], a[25] = a[25], a[24]
+	}
+	if networkLessKeyed(&a[27], &a[26]) {
+		a[26], a[27] = a[27], a[26]
+	}
+	if networkLessKeyed(&a[26], &a[24]) {
+		a[24], a[26] = a[26], a[24]
+	}
+	if networkLessKeyed(&a[27], &a[25]) {
+		a[25], a[27] = a[27], a[25]
+	}
+	if networkLessKeyed(&a[26], &a[25]) {
+		a[25], a[26] = a[26], a[25]
+	}
+	if networkLessKeyed(&a[29], &a[28]) {
+		a[28], a[29] = a[29], a[28]
+	}
+	if networkLessKeyed(&a[31], &a[30]) {
+		a[30], a[31] = a[31], a[30]
+	}
+	if networkLessKeyed(&a[30], &a[28]) {
+		a[28], a[30] = a[30], a[28]
+	}
+	if networkLessKeyed(&a[31], &a[29]) {
+		a[29], a[31] = a[31], a[29]
+	}
+	if networkLessKeyed(&a[30], &a[29]) {
+		a[29], a[30] = a[30], a[29]
+	}
+	if networkLessKeyed(&a[28], &a[24]) {
+		a[24], a[28] = a[28], a[24]
+	}
+	if networkLessKeyed(&a[29], &a[25]) {
+		a[25], a[29] = a[29], a[25]
+	}
+	if networkLessKeyed(&a[28], &a[25]) {
+		a[25], a[28] = a[28], a[25]
+	}
+	if networkLessKeyed(&a[30], &a[26]) {
+		a[26], a[30] = a[30], a[26]
+	}
+	if networkLessKeyed(&a[31], &a[27]) {
+		a[27], a[31] = a[31], a[27]
+	}
+	if networkLessKeyed(&a[30], &a[27]) {
+		a[27], a[30] = a[30], a[27]
+	}
+	if networkLessKeyed(&a[28], &a[26]) {
+		a[26], a[28] = a[28], a[26]
+	}
+	if networkLessKeyed(&a[29], &a[27]) {
+		a[27], a[29] = a[29], a[27]
+	}
+	if networkLessKeyed(&a[28], &a[27]) {
+		a[27], a[28] = a[28], a[27]
+	}
+	if networkLessKeyed(&a[24], &a[16]) {
+		a[16], a[24] = a[24], a[16]
+	}
+	if networkLessKeyed(&a[25], &a[17]) {
+		a[17], a[25] = a[25], a[17]
+	}
+	if networkLessKeyed(&a[24], &a[17]) {
+		a[17], a[24] = a[24], a[17]
+	}
+	if networkLessKeyed(&a[26], &a[18]) {
+		a[18], a[26] = a[26], a[18]
+	}
+	if networkLessKeyed(&a[27], &a[19]) {
+		a[19], a[27] = a[27], a[19]
+	}
+	if networkLessKeyed(&a[26], &a[19]) {
+		a[19], a[26] = a[26], a[19]
+	}
+	if networkLessKeyed(&a[24], &a[18]) {
+		a[18], a[24] = a[24], a[18]
+	}
+	if networkLessKeyed(&a[25], &a[19]) {
+		a[19], a[25] = a[25], a[19]
+	}
+	if networkLessKeyed(&a[24], &a[19]) {
+		a[19], a[24] = a[24], a[19]
+	}
+	if networkLessKeyed(&a[28], &a[20]) {
+		a[20], a[28] = a[28], a[20]
+	}
+	if networkLessKeyed(&a[29], &a[21]) {
+		a[21], a[29] = a[29], a[21]
+	}
+	if networkLessKeyed(&a[28], &a[21]) {
+		a[21], a[28] = a[28], a[21]
+	}
+	if networkLessKeyed(&a[30], &a[22]) {
+		a[22], a[30] = a[30], a[22]
+	}
+	if networkLessKeyed(&a[31], &a[23]) {
+		a[23], a[31] = a[31], a[23]
+	}
+	if networkLessKeyed(&a[30], &a[23]) {
+		a[23], a[30] = a[30], a[23]
+	}
+	if networkLessKeyed(&a[28], &a[22]) {
+		a[22], a[28] = a[28], a[22]
+	}
+	if networkLessKeyed(&a[29], &a[23]) {
+		a[23], a[29] = a[29], a[23]
+	}
+	if networkLessKeyed(&a[28], &a[23]) {
+		a[23], a[28] = a[28], a[23]
+	}
+	if networkLessKeyed(&a[24], &a[20]) {
+		a[20], a[24] = a[24], a[20]
+	}
+	if networkLessKeyed(&a[25], &a[21]) {
+		a[21], a[25] = a[25], a[21]
+	}
+	if networkLessKeyed(&a[24], &a[21]) {
+		a[21], a[24] = a[24], a[21]
+	}
+	if networkLessKeyed(&a[26], &a[22]) {
+		a[22], a[26] = a[26], a[22]
+	}
+	if networkLessKeyed(&a[27], &a[23]) {
+		a[23], a[27] = a[27], a[23]
+	}
+	if networkLessKeyed(&a[26], &a[23]) {
+		a[23], a[26] = a[26], a[23]
+	}
+	if networkLessKeyed(&a[24], &a[22]) {
+		a[22], a[24] = a[24], a[22]
+	}
+	if networkLessKeyed(&a[25], &a[23]) {
+		a[23], a[25] = a[25], a[23]
+	}
+	if networkLessKeyed(&a[24], &a[23]) {
+		a[23], a[24] = a[24], a[23]
+	}
+	if networkLessKeyed(&a[16], &a[0]) {
+		a[0], a[16] = a[16], a[0]
+	}
+	if networkLessKeyed(&a[17], &a[1]) {
+		a[1], a[17] = a[17], a[1]
+	}
+	if networkLessKeyed(&a[16], &a[1]) {
+		a[1], a[16] = a[16], a[1]
+	}
+	if networkLessKeyed(&a[18], &a[2]) {
+		a[2], a[18] = a[18], a[2]
+	}
+	if networkLessKeyed(&a[19], &a[3]) {
+		a[3], a[19] = a[19], a[3]
+	}
+	if networkLessKeyed(&a[18], &a[3]) {
+		a[3], a[18] = a[18], a[3]
+	}
+	if networkLessKeyed(&a[16], &a[2]) {
+		a[2], a[16] = a[16], a[2]
+	}
+	if networkLessKeyed(&a[17], &a[3]) {
+		a[3], a[17] = a[17], a[3]
+	}
+	if networkLessKeyed(&a[16], &a[3]) {
+		a[3], a[16] = a[16], a[3]
+	}
+	if networkLessKeyed(&a[20], &a[4]) {
+		a[4], a[20] = a[20], a[4]
+	}
+	if networkLessKeyed(&a[21], &a[5]) {
+		a[5], a[21] = a[21], a[5]
+	}
+	if networkLessKeyed(&a[20], &a[5]) {
+		a[5], a[20] = a[20], a[5]
+	}
+	if networkLessKeyed(&a[22], &a[6]) {
+		a[6], a[22] = a[22], a[6]
+	}
+	if networkLessKeyed(&a[23], &a[7]) {
+		a[7], a[23] = a[23], a[7]
+	}
+	if networkLessKeyed(&a[22], &a[7]) {
+		a[7], a[22] = a[22], a[7]
+	}
+	if networkLessKeyed(&a[20], &a[6]) {
+		a[6], a[20] = a[20], a[6]
+	}
+	if networkLessKeyed(&a[21], &a[7]) {
+		a[7], a[21] = a[21], a[7]
+	}
+	if networkLessKeyed(&a[20], &a[7]) {
+		a[7], a[20] = a[20], a[7]
+	}
+	if networkLessKeyed(&a[16], &a[4]) {
+		a[4], a[16] = a[16], a[4]
+	}
+	if networkLessKeyed(&a[17], &a[5]) {
+		a[5], a[17] = a[17], a[5]
+	}
+	if networkLessKeyed(&a[16], &a[5]) {
+		a[5], a[16] = a[16], a[5]
+	}
+	if networkLessKeyed(&a[18], &a[6]) {
+		a[6], a[18] = a[18], a[6]
+	}
+	if networkLessKeyed(&a[19], &a[7]) {
+		a[7], a[19] = a[19], a[7]
+	}
+	if networkLessKeyed(&a[18], &a[7]) {
+		a[7], a[18] = a[18], a[7]
+	}
+	if networkLessKeyed(&a[16], &a[6]) {
+		a[6], a[16] = a[16], a[6]
+	}
+	if networkLessKeyed(&a[17], &a[7]) {
+		a[7], a[17] = a[17], a[7]
+	}
+	if networkLessKeyed(&a[16], &a[7]) {
+		a[7], a[16] = a[16], a[7]
+	}
+	if networkLessKeyed(&a[24], &a[8]) {
+		a[8], a[24] = a[24], a[8]
+	}
+	if networkLessKeyed(&a[25], &a[9]) {
+		a[9], a[25] = a[25], a[9]
+	}
+	if networkLessKeyed(&a[24], &a[9]) {
+		a[9], a[24] = a[24], a[9]
+	}
+	if networkLessKeyed(&a[26], &a[10]) {
+		a[10], a[26] = a[26], a[10]
+	}
+	if networkLessKeyed(&a[27], &a[11]) {
+		a[11], a[27] = a[27], a[11]
+	}
+	if networkLessKeyed(&a[26], &a[11]) {
+		a[11], a[26] = a[26], a[11]
+	}
+	if networkLessKeyed(&a[24], &a[10]) {
+		a[10], a[24] = a[24], a[10]
+	}
+	if networkLessKeyed(&a[25], &a[11]) {
+		a[11], a[25] = a[25], a[11]
+	}
+	if networkLessKeyed(&a[24], &a[11]) {
+		a[11], a[24] = a[24], a[11]
+	}
+	if networkLessKeyed(&a[28], &a[12]) {
+		a[12], a[28] = a[28], a[12]
+	}
+	if networkLessKeyed(&a[29], &a[13]) {
+		a[13], a[29] = a[29], a[13]
+	}
+	if networkLessKeyed(&a[28], &a[13]) {
+		a[13], a[28] = a[28], a[13]
+	}
+	if networkLessKeyed(&a[30], &a[14]) {
+		a[14], a[30] = a[30], a[14]
+	}
+	if networkLessKeyed(&a[31], &a[15]) {
+		a[15], a[31] = a[31], a[15]
+	}
+	if networkLessKeyed(&a[30], &a[15]) {
+		a[15], a[30] = a[30], a[15]
+	}
+	if networkLessKeyed(&a[28], &a[14]) {
+		a[14], a[28] = a[28], a[14]
+	}
+	if networkLessKeyed(&a[29], &a[15]) {
+		a[15], a[29] = a[29], a[15]
+	}
+	if networkLessKeyed(&a[28], &a[15]) {
+		a[15], a[28] = a[28], a[15]
+	}
+	if networkLessKeyed(&a[24], &a[12]) {
+		a[12], a[24] = a[24], a[12]
+	}
+	if networkLessKeyed(&a[25], &a[13]) {
+		a[13], a[25] = a[25], a[13]
+	}
+	if networkLessKeyed(&a[24], &a[13]) {
+		a[13], a[24] = a[24], a[13]
+	}
+	if networkLessKeyed(&a[26], &a[14]) {
+		a[14], a[26] = a[26], a[14]
+	}
+	if networkLessKeyed(&a[27], &a[15]) {
+		a[15], a[27] = a[27], a[15]
+	}
+	if networkLessKeyed(&a[26], &a[15]) {
+		a[15], a[26] = a[26], a[15]
+	}
+	if networkLessKeyed(&a[24], &a[14]) {
+		a[14], a[24] = a[24], a[14]
+	}
+	if networkLessKeyed(&a[25], &a[15]) {
+		a[15], a[25] = a[25], a[15]
+	}
+	if networkLessKeyed(&a[24], &a[15]) {
+		a[15], a[24] = a[24], a[15]
+	}
+	if networkLessKeyed(&a[16], &a[8]) {
+		a[8], a[16] = a[16], a[8]
+	}
+	if networkLessKeyed(&a[17], &a[9]) {
+		a[9], a[17] = a[17], a[9]
+	}
+	if networkLessKeyed(&a[16], &a[9]) {
+		a[9], a[16] = a[16], a[9]
+	}
+	if networkLessKeyed(&a[18], &a[10]) {
+		a[10], a[18] = a[18], a[10]
+	}
+	if networkLessKeyed(&a[19], &a[11]) {
+		a[11], a[19] = a[19], a[11]
+	}
+	if networkLessKeyed(&a[18], &a[11]) {
+		a[11], a[18] = a[18], a[11]
+	}
+	if networkLessKeyed(&a[16], &a[10]) {
+		a[10], a[16] = a[16], a[10]
+	}
+	if networkLessKeyed(&a[17], &a[11]) {
+		a[11], a[17] = a[17], a[11]
+	}
+	if networkLessKeyed(&a[16], &a[11]) {
+		a[11], a[16] = a[16], a[11]
+	}
+	if networkLessKeyed(&a[20], &a[12]) {
+		a[12], a[20] = a[20], a[12]
+	}
+	if networkLessKeyed(&a[21], &a[13]) {
+		a[13], a[21] = a[21], a[13]
+	}
+	if networkLessKeyed(&a[20], &a[13]) {
+		a[13], a[20] = a[20], a[13]
+	}
+	if networkLessKeyed(&a[22], &a[14]) {
+		a[14], a[22] = a[22], a[14]
+	}
+	if networkLessKeyed(&a[23], &a[15]) {
+		a[15], a[23] = a[23], a[15]
+	}
+	if networkLessKeyed(&a[22], &a[15]) {
+		a[15], a[22] = a[22], a[15]
+	}
+	if networkLessKeyed(&a[20], &a[14]) {
+		a[14], a[20] = a[20], a[14]
+	}
+	if networkLessKeyed(&a[21], &a[15]) {
+		a[15], a[21] = a[21], a[15]
+	}
+	if networkLessKeyed(&a[20], &a[15]) {
+		a[15], a[20] = a[20], a[15]
+	}
+	if networkLessKeyed(&a[16], &a[12]) {
+		a[12], a[16] = a[16], a[12]
+	}
+	if networkLessKeyed(&a[17], &a[13]) {
+		a[13], a[17] = a[17], a[13]
+	}
+	if networkLessKeyed(&a[16], &a[13]) {
+		a[13], a[16] = a[16], a[13]
+	}
+	if networkLessKeyed(&a[18], &a[14]) {
+		a[14], a[18] = a[18], a[14]
+	}
+	if networkLessKeyed(&a[19], &a[15]) {
+		a[15], a[19] = a[19], a[15]
+	}
+	if networkLessKeyed(&a[18], &a[15]) {
+		a[15], a[18] = a[18], a[15]
+	}
+	if networkLessKeyed(&a[16], &a[14]) {
+		a[14], a[16] = a[16], a[14]
+	}
+	if networkLessKeyed(&a[17], &a[15]) {
+		a[15], a[17] = a[17], a[15]
+	}
+	if networkLessKeyed(&a[16], &a[15]) {
+		a[15], a[16] = a[16], a[15]
+	}
+}
+
+// NetworkMerge32xKeyed merges 32 runs, each of which must already be sorted
+// in increasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge32xKeyed(dst []Keyed, r0, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, r15, r16, r17, r18, r19, r20, r21, r22, r23, r24, r25, r26, r27, r28, r29, r30, r31 []Keyed) []Keyed {
+	var v0, v1, v2, v3, v4, v5, v6, v7, v8, v9, v10, v11, v12, v13, v14, v15, v16, v17, v18, v19, v20, v21, v22, v23, v24, v25, v26, v27, v28, v29, v30, v31, vm0, vm1, vm2, vm3, vm4, vm5, vm6, vm7, vm8, vm9, vm10, vm11, vm12, vm13, vm14, vm15, vm16, vm17, vm18, vm19, vm20, vm21, vm22, vm23, vm24, vm25, vm26, vm27, vm28, vm29, vm30 Keyed
+	var wm0, wm1, wm2, wm3, wm4, wm5, wm6, wm7, wm8, wm9, wm10, wm11, wm12, wm13, wm14, wm15, wm16, wm17, wm18, wm19, wm20, wm21, wm22, wm23, wm24, wm25, wm26, wm27, wm28, wm29, wm30 int
+	var okm0, okm1, okm2, okm3, okm4, okm5, okm6, okm7, okm8, okm9, okm10, okm11, okm12, okm13, okm14, okm15, okm16, okm17, okm18, okm19, okm20, okm21, okm22, okm23, okm24, okm25, okm26, okm27, okm28, okm29, okm30 bool
+	ok0, ok1, ok2, ok3, ok4, ok5, ok6, ok7, ok8, ok9, ok10, ok11, ok12, ok13, ok14, ok15, ok16, ok17, ok18, ok19, ok20, ok21, ok22, ok23, ok24, ok25, ok26, ok27, ok28, ok29, ok30, ok31 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0, len(r5) > 0, len(r6) > 0, len(r7) > 0, len(r8) > 0, len(r9) > 0, len(r10) > 0, len(r11) > 0, len(r12) > 0, len(r13) > 0, len(r14) > 0, len(r15) > 0, len(r16) > 0, len(r17) > 0, len(r18) > 0, len(r19) > 0, len(r20) > 0, len(r21) > 0, len(r22) > 0, len(r23) > 0, len(r24) > 0, len(r25) > 0, len(r26) > 0, len(r27) > 0, len(r28) > 0, len(r29) > 0, len(r30) > 0, len(r31) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	if ok5 {
+		v5 = r5[0]
+	}
+	if ok6 {
+		v6 = r6[0]
+	}
+	if ok7 {
+		v7 = r7[0]
+	}
+	if ok8 {
+		v8 = r8[0]
+	}
+	if ok9 {
+		v9 = r9[0]
+	}
+	if ok10 {
+		v10 = r10[0]
+	}
+	if ok11 {
+		v11 = r11[0]
+	}
+	if ok12 {
+		v12 = r12[0]
+	}
+	if ok13 {
+		v13 = r13[0]
+	}
+	if ok14 {
+		v14 = r14[0]
+	}
+	if ok15 {
+		v15 = r15[0]
+	}
+	if ok16 {
+		v16 = r16[0]
+	}
+	if ok17 {
+		v17 = r17[0]
+	}
+	if ok18 {
+		v18 = r18[0]
+	}
+	if ok19 {
+		v19 = r19[0]
+	}
+	if ok20 {
+		v20 = r20[0]
+	}
+	if ok21 {
+		v21 = r21[0]
+	}
+	if ok22 {
+		v22 = r22[0]
+	}
+	if ok23 {
+		v23 = r23[0]
+	}
+	if ok24 {
+		v24 = r24[0]
+	}
+	if ok25 {
+		v25 = r25[0]
+	}
+	if ok26 {
+		v26 = r26[0]
+	}
+	if ok27 {
+		v27 = r27[0]
+	}
+	if ok28 {
+		v28 = r28[0]
+	}
+	if ok29 {
+		v29 = r29[0]
+	}
+	if ok30 {
+		v30 = r30[0]
+	}
+	if ok31 {
+		v31 = r31[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = 4, v4, ok4
+	if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+		wm2, vm2, okm2 = 5, v5, true
+	}
+	wm3, vm3, okm3 = 6, v6, ok6
+	if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+		wm3, vm3, okm3 = 7, v7, true
+	}
+	wm4, vm4, okm4 = 8, v8, ok8
+	if ok9 && (!ok8 || networkLessKeyed(&v9, &v8)) {
+		wm4, vm4, okm4 = 9, v9, true
+	}
+	wm5, vm5, okm5 = 10, v10, ok10
+	if ok11 && (!ok10 || networkLessKeyed(&v11, &v10)) {
+		wm5, vm5, okm5 = 11, v11, true
+	}
+	wm6, vm6, okm6 = 12, v12, ok12
+	if ok13 && (!ok12 || networkLessKeyed(&v13, &v12)) {
+		wm6, vm6, okm6 = 13, v13, true
+	}
+	wm7, vm7, okm7 = 14, v14, ok14
+	if ok15 && (!ok14 || networkLessKeyed(&v15, &v14)) {
+		wm7, vm7, okm7 = 15, v15, true
+	}
+	wm8, vm8, okm8 = 16, v16, ok16
+	if ok17 && (!ok16 || networkLessKeyed(&v17, &v16)) {
+		wm8, vm8, okm8 = 17, v17, true
+	}
+	wm9, vm9, okm9 = 18, v18, ok18
+	if ok19 && (!ok18 || networkLessKeyed(&v19, &v18)) {
+		wm9, vm9, okm9 = 19, v19, true
+	}
+	wm10, vm10, okm10 = 20, v20, ok20
+	if ok21 && (!ok20 || networkLessKeyed(&v21, &v20)) {
+		wm10, vm10, okm10 = 21, v21, true
+	}
+	wm11, vm11, okm11 = 22, v22, ok22
+	if ok23 && (!ok22 || networkLessKeyed(&v23, &v22)) {
+		wm11, vm11, okm11 = 23, v23, true
+	}
+	wm12, vm12, okm12 = 24, v24, ok24
+	if ok25 && (!ok24 || networkLessKeyed(&v25, &v24)) {
+		wm12, vm12, okm12 = 25, v25, true
+	}
+	wm13, vm13, okm13 = 26, v26, ok26
+	if ok27 && (!ok26 || networkLessKeyed(&v27, &v26)) {
+		wm13, vm13, okm13 = 27, v27, true
+	}
+	wm14, vm14, okm14 = 28, v28, ok28
+	if ok29 && (!ok28 || networkLessKeyed(&v29, &v28)) {
+		wm14, vm14, okm14 = 29, v29, true
+	}
+	wm15, vm15, okm15 = 30, v30, ok30
+	if ok31 && (!ok30 || networkLessKeyed(&v31, &v30)) {
+		wm15, vm15, okm15 = 31, v31, true
+	}
+	wm16, vm16, okm16 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+		wm16, vm16, okm16 = wm1, vm1, true
+	}
+	wm17, vm17, okm17 = wm2, vm2, okm2
+	if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+		wm17, vm17, okm17 = wm3, vm3, true
+	}
+	wm18, vm18, okm18 = wm4, vm4, okm4
+	if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+		wm18, vm18, okm18 = wm5, vm5, true
+	}
+	wm19, vm19, okm19 = wm6, vm6, okm6
+	if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+		wm19, vm19, okm19 = wm7, vm7, true
+	}
+	wm20, vm20, okm20 = wm8, vm8, okm8
+	if okm9 && (!okm8 || networkLessKeyed(&vm9, &vm8)) {
+		wm20, vm20, okm20 = wm9, vm9, true
+	}
+	wm21, vm21, okm21 = wm10, vm10, okm10
+	if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+		wm21, vm21, okm21 = wm11, vm11, true
+	}
+	wm22, vm22, okm22 = wm12, vm12, okm12
+	if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+		wm22, vm22, okm22 = wm13, vm13, true
+	}
+	wm23, vm23, okm23 = wm14, vm14, okm14
+	if okm15 && (!okm14 || networkLessKeyed(&vm15, &vm14)) {
+		wm23, vm23, okm23 = wm15, vm15, true
+	}
+	wm24, vm24, okm24 = wm16, vm16, okm16
+	if okm17 && (!okm16 || networkLessKeyed(&vm17, &vm16)) {
+		wm24, vm24, okm24 = wm17, vm17, true
+	}
+	wm25, vm25, okm25 = wm18, vm18, okm18
+	if okm19 && (!okm18 || networkLessKeyed(&vm19, &vm18)) {
+		wm25, vm25, okm25 = wm19, vm19, true
+	}
+	wm26, vm26, okm26 = wm20, vm20, okm20
+	if okm21 && (!okm20 || networkLessKeyed(&vm21, &vm20)) {
+		wm26, vm26, okm26 = wm21, vm21, true
+	}
+	wm27, vm27, okm27 = wm22, vm22, okm22
+	if okm23 && (!okm22 || networkLessKeyed(&vm23, &vm22)) {
+		wm27, vm27, okm27 = wm23, vm23, true
+	}
+	wm28, vm28, okm28 = wm24, vm24, okm24
+	if okm25 && (!okm24 || networkLessKeyed(&vm25, &vm24)) {
+		wm28, vm28, okm28 = wm25, vm25, true
+	}
+	wm29, vm29, okm29 = wm26, vm26, okm26
+	if okm27 && (!okm26 || networkLessKeyed(&vm27, &vm26)) {
+		wm29, vm29, okm29 = wm27, vm27, true
+	}
+	wm30, vm30, okm30 = wm28, vm28, okm28
+	if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+		wm30, vm30, okm30 = wm29, vm29, true
+	}
+
+	for okm30 {
+		dst = append(dst, vm30)
+		switch wm30 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm16, vm16, okm16 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm16, vm16, okm16 = wm1, vm1, true
+			}
+			wm24, vm24, okm24 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm17, &vm16)) {
+				wm24, vm24, okm24 = wm17, vm17, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm25, &vm24)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v1, &v0)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm16, vm16, okm16 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm16, vm16, okm16 = wm1, vm1, true
+			}
+			wm24, vm24, okm24 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm17, &vm16)) {
+				wm24, vm24, okm24 = wm17, vm17, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm25, &vm24)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm16, vm16, okm16 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm16, vm16, okm16 = wm1, vm1, true
+			}
+			wm24, vm24, okm24 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm17, &vm16)) {
+				wm24, vm24, okm24 = wm17, vm17, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm25, &vm24)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v3, &v2)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm16, vm16, okm16 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm1, &vm0)) {
+				wm16, vm16, okm16 = wm1, vm1, true
+			}
+			wm24, vm24, okm24 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm17, &vm16)) {
+				wm24, vm24, okm24 = wm17, vm17, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm25, &vm24)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm17, vm17, okm17 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm17, vm17, okm17 = wm3, vm3, true
+			}
+			wm24, vm24, okm24 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm17, &vm16)) {
+				wm24, vm24, okm24 = wm17, vm17, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm25, &vm24)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 5:
+			r5 = r5[1:]
+			if ok5 = len(r5) > 0; ok5 {
+				v5 = r5[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v5, &v4)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm17, vm17, okm17 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm17, vm17, okm17 = wm3, vm3, true
+			}
+			wm24, vm24, okm24 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm17, &vm16)) {
+				wm24, vm24, okm24 = wm17, vm17, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm25, &vm24)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 6:
+			r6 = r6[1:]
+			if ok6 = len(r6) > 0; ok6 {
+				v6 = r6[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm17, vm17, okm17 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm17, vm17, okm17 = wm3, vm3, true
+			}
+			wm24, vm24, okm24 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm17, &vm16)) {
+				wm24, vm24, okm24 = wm17, vm17, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm25, &vm24)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 7:
+			r7 = r7[1:]
+			if ok7 = len(r7) > 0; ok7 {
+				v7 = r7[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v7, &v6)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm17, vm17, okm17 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm3, &vm2)) {
+				wm17, vm17, okm17 = wm3, vm3, true
+			}
+			wm24, vm24, okm24 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm17, &vm16)) {
+				wm24, vm24, okm24 = wm17, vm17, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm25, &vm24)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 8:
+			r8 = r8[1:]
+			if ok8 = len(r8) > 0; ok8 {
+				v8 = r8[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v9, &v8)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm18, vm18, okm18 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm18, vm18, okm18 = wm5, vm5, true
+			}
+			wm25, vm25, okm25 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm19, &vm18)) {
+				wm25, vm25, okm25 = wm19, vm19, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm25, &vm24)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 9:
+			r9 = r9[1:]
+			if ok9 = len(r9) > 0; ok9 {
+				v9 = r9[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v9, &v8)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm18, vm18, okm18 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm18, vm18, okm18 = wm5, vm5, true
+			}
+			wm25, vm25, okm25 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm19, &vm18)) {
+				wm25, vm25, okm25 = wm19, vm19, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm25, &vm24)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 10:
+			r10 = r10[1:]
+			if ok10 = len(r10) > 0; ok10 {
+				v10 = r10[0]
+			}
+			wm5, vm5, okm5 = 10, v10, ok10
+			if ok11 && (!ok10 || networkLessKeyed(&v11, &v10)) {
+				wm5, vm5, okm5 = 11, v11, true
+			}
+			wm18, vm18, okm18 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm18, vm18, okm18 = wm5, vm5, true
+			}
+			wm25, vm25, okm25 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm19, &vm18)) {
+				wm25, vm25, okm25 = wm19, vm19, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm25, &vm24)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 11:
+			r11 = r11[1:]
+			if ok11 = len(r11) > 0; ok11 {
+				v11 = r11[0]
+			}
+			wm5, vm5, okm5 = 10, v10, ok10
+			if ok11 && (!ok10 || networkLessKeyed(&v11, &v10)) {
+				wm5, vm5, okm5 = 11, v11, true
+			}
+			wm18, vm18, okm18 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm5, &vm4)) {
+				wm18, vm18, okm18 = wm5, vm5, true
+			}
+			wm25, vm25, okm25 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm19, &vm18)) {
+				wm25, vm25, okm25 = wm19, vm19, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm25, &vm24)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 12:
+			r12 = r12[1:]
+			if ok12 = len(r12) > 0; ok12 {
+				v12 = r12[0]
+			}
+			wm6, vm6, okm6 = 12, v12, ok12
+			if ok13 && (!ok12 || networkLessKeyed(&v13, &v12)) {
+				wm6, vm6, okm6 = 13, v13, true
+			}
+			wm19, vm19, okm19 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+				wm19, vm19, okm19 = wm7, vm7, true
+			}
+			wm25, vm25, okm25 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm19, &vm18)) {
+				wm25, vm25, okm25 = wm19, vm19, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm25, &vm24)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 13:
+			r13 = r13[1:]
+			if ok13 = len(r13) > 0; ok13 {
+				v13 = r13[0]
+			}
+			wm6, vm6, okm6 = 12, v12, ok12
+			if ok13 && (!ok12 || networkLessKeyed(&v13, &v12)) {
+				wm6, vm6, okm6 = 13, v13, true
+			}
+			wm19, vm19, okm19 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+				wm19, vm19, okm19 = wm7, vm7, true
+			}
+			wm25, vm25, okm25 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm19, &vm18)) {
+				wm25, vm25, okm25 = wm19, vm19, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm25, &vm24)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 14:
+			r14 = r14[1:]
+			if ok14 = len(r14) > 0; ok14 {
+				v14 = r14[0]
+			}
+			wm7, vm7, okm7 = 14, v14, ok14
+			if ok15 && (!ok14 || networkLessKeyed(&v15, &v14)) {
+				wm7, vm7, okm7 = 15, v15, true
+			}
+			wm19, vm19, okm19 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+				wm19, vm19, okm19 = wm7, vm7, true
+			}
+			wm25, vm25, okm25 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm19, &vm18)) {
+				wm25, vm25, okm25 = wm19, vm19, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm25, &vm24)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 15:
+			r15 = r15[1:]
+			if ok15 = len(r15) > 0; ok15 {
+				v15 = r15[0]
+			}
+			wm7, vm7, okm7 = 14, v14, ok14
+			if ok15 && (!ok14 || networkLessKeyed(&v15, &v14)) {
+				wm7, vm7, okm7 = 15, v15, true
+			}
+			wm19, vm19, okm19 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm7, &vm6)) {
+				wm19, vm19, okm19 = wm7, vm7, true
+			}
+			wm25, vm25, okm25 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm19, &vm18)) {
+				wm25, vm25, okm25 = wm19, vm19, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm25, &vm24)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 16:
+			r16 = r16[1:]
+			if ok16 = len(r16) > 0; ok16 {
+				v16 = r16[0]
+			}
+			wm8, vm8, okm8 = 16, v16, ok16
+			if ok17 && (!ok16 || networkLessKeyed(&v17, &v16)) {
+				wm8, vm8, okm8 = 17, v17, true
+			}
+			wm20, vm20, okm20 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm9, &vm8)) {
+				wm20, vm20, okm20 = wm9, vm9, true
+			}
+			wm26, vm26, okm26 = wm20, vm20, okm20
+			if okm21 && (!okm20 || networkLessKeyed(&vm21, &vm20)) {
+				wm26, vm26, okm26 = wm21, vm21, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm27, &vm26)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 17:
+			r17 = r17[1:]
+			if ok17 = len(r17) > 0; ok17 {
+				v17 = r17[0]
+			}
+			wm8, vm8, okm8 = 16, v16, ok16
+			if ok17 && (!ok16 || networkLessKeyed(&v17, &v16)) {
+				wm8, vm8, okm8 = 17, v17, true
+			}
+			wm20, vm20, okm20 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm9, &vm8)) {
+				wm20, vm20, okm20 = wm9, vm9, true
+			}
+			wm26, vm26, okm26 = wm20, vm20, okm20
+			if okm21 && (!okm20 || networkLessKeyed(&vm21, &vm20)) {
+				wm26, vm26, okm26 = wm21, vm21, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm27, &vm26)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 18:
+			r18 = r18[1:]
+			if ok18 = len(r18) > 0; ok18 {
+				v18 = r18[0]
+			}
+			wm9, vm9, okm9 = 18, v18, ok18
+			if ok19 && (!ok18 || networkLessKeyed(&v19, &v18)) {
+				wm9, vm9, okm9 = 19, v19, true
+			}
+			wm20, vm20, okm20 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm9, &vm8)) {
+				wm20, vm20, okm20 = wm9, vm9, true
+			}
+			wm26, vm26, okm26 = wm20, vm20, okm20
+			if okm21 && (!okm20 || networkLessKeyed(&vm21, &vm20)) {
+				wm26, vm26, okm26 = wm21, vm21, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm27, &vm26)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 19:
+			r19 = r19[1:]
+			if ok19 = len(r19) > 0; ok19 {
+				v19 = r19[0]
+			}
+			wm9, vm9, okm9 = 18, v18, ok18
+			if ok19 && (!ok18 || networkLessKeyed(&v19, &v18)) {
+				wm9, vm9, okm9 = 19, v19, true
+			}
+			wm20, vm20, okm20 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm9, &vm8)) {
+				wm20, vm20, okm20 = wm9, vm9, true
+			}
+			wm26, vm26, okm26 = wm20, vm20, okm20
+			if okm21 && (!okm20 || networkLessKeyed(&vm21, &vm20)) {
+				wm26, vm26, okm26 = wm21, vm21, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm27, &vm26)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 20:
+			r20 = r20[1:]
+			if ok20 = len(r20) > 0; ok20 {
+				v20 = r20[0]
+			}
+			wm10, vm10, okm10 = 20, v20, ok20
+			if ok21 && (!ok20 || networkLessKeyed(&v21, &v20)) {
+				wm10, vm10, okm10 = 21, v21, true
+			}
+			wm21, vm21, okm21 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm21, vm21, okm21 = wm11, vm11, true
+			}
+			wm26, vm26, okm26 = wm20, vm20, okm20
+			if okm21 && (!okm20 || networkLessKeyed(&vm21, &vm20)) {
+				wm26, vm26, okm26 = wm21, vm21, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm27, &vm26)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 21:
+			r21 = r21[1:]
+			if ok21 = len(r21) > 0; ok21 {
+				v21 = r21[0]
+			}
+			wm10, vm10, okm10 = 20, v20, ok20
+			if ok21 && (!ok20 || networkLessKeyed(&v21, &v20)) {
+				wm10, vm10, okm10 = 21, v21, true
+			}
+			wm21, vm21, okm21 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm21, vm21, okm21 = wm11, vm11, true
+			}
+			wm26, vm26, okm26 = wm20, vm20, okm20
+			if okm21 && (!okm20 || networkLessKeyed(&vm21, &vm20)) {
+				wm26, vm26, okm26 = wm21, vm21, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm27, &vm26)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 22:
+			r22 = r22[1:]
+			if ok22 = len(r22) > 0; ok22 {
+				v22 = r22[0]
+			}
+			wm11, vm11, okm11 = 22, v22, ok22
+			if ok23 && (!ok22 || networkLessKeyed(&v23, &v22)) {
+				wm11, vm11, okm11 = 23, v23, true
+			}
+			wm21, vm21, okm21 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm21, vm21, okm21 = wm11, vm11, true
+			}
+			wm26, vm26, okm26 = wm20, vm20, okm20
+			if okm21 && (!okm20 || networkLessKeyed(&vm21, &vm20)) {
+				wm26, vm26, okm26 = wm21, vm21, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm27, &vm26)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 23:
+			r23 = r23[1:]
+			if ok23 = len(r23) > 0; ok23 {
+				v23 = r23[0]
+			}
+			wm11, vm11, okm11 = 22, v22, ok22
+			if ok23 && (!ok22 || networkLessKeyed(&v23, &v22)) {
+				wm11, vm11, okm11 = 23, v23, true
+			}
+			wm21, vm21, okm21 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm11, &vm10)) {
+				wm21, vm21, okm21 = wm11, vm11, true
+			}
+			wm26, vm26, okm26 = wm20, vm20, okm20
+			if okm21 && (!okm20 || networkLessKeyed(&vm21, &vm20)) {
+				wm26, vm26, okm26 = wm21, vm21, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm27, &vm26)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 24:
+			r24 = r24[1:]
+			if ok24 = len(r24) > 0; ok24 {
+				v24 = r24[0]
+			}
+			wm12, vm12, okm12 = 24, v24, ok24
+			if ok25 && (!ok24 || networkLessKeyed(&v25, &v24)) {
+				wm12, vm12, okm12 = 25, v25, true
+			}
+			wm22, vm22, okm22 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+				wm22, vm22, okm22 = wm13, vm13, true
+			}
+			wm27, vm27, okm27 = wm22, vm22, okm22
+			if okm23 && (!okm22 || networkLessKeyed(&vm23, &vm22)) {
+				wm27, vm27, okm27 = wm23, vm23, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm27, &vm26)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 25:
+			r25 = r25[1:]
+			if ok25 = len(r25) > 0; ok25 {
+				v25 = r25[0]
+			}
+			wm12, vm12, okm12 = 24, v24, ok24
+			if ok25 && (!ok24 || networkLessKeyed(&v25, &v24)) {
+				wm12, vm12, okm12 = 25, v25, true
+			}
+			wm22, vm22, okm22 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+				wm22, vm22, okm22 = wm13, vm13, true
+			}
+			wm27, vm27, okm27 = wm22, vm22, okm22
+			if okm23 && (!okm22 || networkLessKeyed(&vm23, &vm22)) {
+				wm27, vm27, okm27 = wm23, vm23, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm27, &vm26)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 26:
+			r26 = r26[1:]
+			if ok26 = len(r26) > 0; ok26 {
+				v26 = r26[0]
+			}
+			wm13, vm13, okm13 = 26, v26, ok26
+			if ok27 && (!ok26 || networkLessKeyed(&v27, &v26)) {
+				wm13, vm13, okm13 = 27, v27, true
+			}
+			wm22, vm22, okm22 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+				wm22, vm22, okm22 = wm13, vm13, true
+			}
+			wm27, vm27, okm27 = wm22, vm22, okm22
+			if okm23 && (!okm22 || networkLessKeyed(&vm23, &vm22)) {
+				wm27, vm27, okm27 = wm23, vm23, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm27, &vm26)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 27:
+			r27 = r27[1:]
+			if ok27 = len(r27) > 0; ok27 {
+				v27 = r27[0]
+			}
+			wm13, vm13, okm13 = 26, v26, ok26
+			if ok27 && (!ok26 || networkLessKeyed(&v27, &v26)) {
+				wm13, vm13, okm13 = 27, v27, true
+			}
+			wm22, vm22, okm22 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm13, &vm12)) {
+				wm22, vm22, okm22 = wm13, vm13, true
+			}
+			wm27, vm27, okm27 = wm22, vm22, okm22
+			if okm23 && (!okm22 || networkLessKeyed(&vm23, &vm22)) {
+				wm27, vm27, okm27 = wm23, vm23, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm27, &vm26)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 28:
+			r28 = r28[1:]
+			if ok28 = len(r28) > 0; ok28 {
+				v28 = r28[0]
+			}
+			wm14, vm14, okm14 = 28, v28, ok28
+			if ok29 && (!ok28 || networkLessKeyed(&v29, &v28)) {
+				wm14, vm14, okm14 = 29, v29, true
+			}
+			wm23, vm23, okm23 = wm14, vm14, okm14
+			if okm15 && (!okm14 || networkLessKeyed(&vm15, &vm14)) {
+				wm23, vm23, okm23 = wm15, vm15, true
+			}
+			wm27, vm27, okm27 = wm22, vm22, okm22
+			if okm23 && (!okm22 || networkLessKeyed(&vm23, &vm22)) {
+				wm27, vm27, okm27 = wm23, vm23, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm27, &vm26)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 29:
+			r29 = r29[1:]
+			if ok29 = len(r29) > 0; ok29 {
+				v29 = r29[0]
+			}
+			wm14, vm14, okm14 = 28, v28, ok28
+			if ok29 && (!ok28 || networkLessKeyed(&v29, &v28)) {
+				wm14, vm14, okm14 = 29, v29, true
+			}
+			wm23, vm23, okm23 = wm14, vm14, okm14
+			if okm15 && (!okm14 || networkLessKeyed(&vm15, &vm14)) {
+				wm23, vm23, okm23 = wm15, vm15, true
+			}
+			wm27, vm27, okm27 = wm22, vm22, okm22
+			if okm23 && (!okm22 || networkLessKeyed(&vm23, &vm22)) {
+				wm27, vm27, okm27 = wm23, vm23, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm27, &vm26)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 30:
+			r30 = r30[1:]
+			if ok30 = len(r30) > 0; ok30 {
+				v30 = r30[0]
+			}
+			wm15, vm15, okm15 = 30, v30, ok30
+			if ok31 && (!ok30 || networkLessKeyed(&v31, &v30)) {
+				wm15, vm15, okm15 = 31, v31, true
+			}
+			wm23, vm23, okm23 = wm14, vm14, okm14
+			if okm15 && (!okm14 || networkLessKeyed(&vm15, &vm14)) {
+				wm23, vm23, okm23 = wm15, vm15, true
+			}
+			wm27, vm27, okm27 = wm22, vm22, okm22
+			if okm23 && (!okm22 || networkLessKeyed(&vm23, &vm22)) {
+				wm27, vm27, okm27 = wm23, vm23, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm27, &vm26)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 31:
+			r31 = r31[1:]
+			if ok31 = len(r31) > 0; ok31 {
+				v31 = r31[0]
+			}
+			wm15, vm15, okm15 = 30, v30, ok30
+			if ok31 && (!ok30 || networkLessKeyed(&v31, &v30)) {
+				wm15, vm15, okm15 = 31, v31, true
+			}
+			wm23, vm23, okm23 = wm14, vm14, okm14
+			if okm15 && (!okm14 || networkLessKeyed(&vm15, &vm14)) {
+				wm23, vm23, okm23 = wm15, vm15, true
+			}
+			wm27, vm27, okm27 = wm22, vm22, okm22
+			if okm23 && (!okm22 || networkLessKeyed(&vm23, &vm22)) {
+				wm27, vm27, okm27 = wm23, vm23, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm27, &vm26)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm29, &vm28)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		}
+	}
+	return dst
+}
+
+func NetworkSort32xKeyedReverse(a []Keyed) {
+	_ = a[31]
+	if networkLessKeyed(&a[0], &a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if networkLessKeyed(&a[2], &a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if networkLessKeyed(&a[0], &a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if networkLessKeyed(&a[1], &a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if networkLessKeyed(&a[1], &a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if networkLessKeyed(&a[4], &a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if networkLessKeyed(&a[6], &a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if networkLessKeyed(&a[4], &a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if networkLessKeyed(&a[5], &a[7]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if networkLessKeyed(&a[5], &a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if networkLessKeyed(&a[0], &a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if networkLessKeyed(&a[1], &a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if networkLessKeyed(&a[1], &a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if networkLessKeyed(&a[2], &a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if networkLessKeyed(&a[3], &a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if networkLessKeyed(&a[3], &a[6]) {
+		a[3], a[6] = a[6], a[3]
+	}
+	if networkLessKeyed(&a[2], &a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if networkLessKeyed(&a[3], &a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if networkLessKeyed(&a[3], &a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if networkLessKeyed(&a[8], &a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if networkLessKeyed(&a[10], &a[11]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if networkLessKeyed(&a[8], &a[10]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if networkLessKeyed(&a[9], &a[11]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if networkLessKeyed(&a[9], &a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if networkLessKeyed(&a[12], &a[13]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if networkLessKeyed(&a[14], &a[15]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if networkLessKeyed(&a[12], &a[14]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if networkLessKeyed(&a[13], &a[15]) {
+		a[13], a[15] = a[15], a[13]
+	}
+	if networkLessKeyed(&a[13], &a[14]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if networkLessKeyed(&a[8], &a[12]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if networkLessKeyed(&a[9], &a[13]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if networkLessKeyed(&a[9], &a[12]) {
+		a[9], a[12] = a[12], a[9]
+	}
+	if networkLessKeyed(&a[10], &a[14]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if networkLessKeyed(&a[11], &a[15]) {
+		a[11], a[15] = a[15], a[11]
+	}
+	if networkLessKeyed(&a[11], &a[14]) {
+		a[11], a[14] = a[14], a[11]
+	}
+	if networkLessKeyed(&a[10], &a[12]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if networkLessKeyed(&a[11], &a[13]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if networkLessKeyed(&a[11], &a[12]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if networkLessKeyed(&a[0], &a[8]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if networkLessKeyed(&a[1], &a[9]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if networkLessKeyed(&a[1], &a[8]) {
+		a[1], a[8] = a[8], a[1]
+	}
+	if networkLessKeyed(&a[2], &a[10]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if networkLessKeyed(&a[3], &a[11]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if networkLessKeyed(&a[3], &a[10]) {
+		a[3], a[10] = a[10], a[3]
+	}
+	if networkLessKeyed(&a[2], &a[8]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if networkLessKeyed(&a[3], &a[9]) {
+		a[3], a[9] = a[9], a[3]
+	}
+	if networkLessKeyed(&a[3], &a[8]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if networkLessKeyed(&a[4], &a[12]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if networkLessKeyed(&a[5], &a[13]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if networkLessKeyed(&a[5], &a[12]) {
+		a[5], a[12] = a[12], a[5]
+	}
+	if networkLessKeyed(&a[6], &a[14]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if networkLessKeyed(&a[7], &a[15]) {
+		a[7], a[15] = a[15], a[7]
+	}
+	if networkLessKeyed(&a[7], &a[14]) {
+		a[7], a[14] = a[14], a[7]
+	}
+	if networkLessKeyed(&a[6], &a[12]) {
+		a[6], a[12] = a[12], a[6]
+	}
+	if networkLessKeyed(&a[7], &a[13]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if networkLessKeyed(&a[7], &a[12]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if networkLessKeyed(&a[4], &a[8]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if networkLessKeyed(&a[5], &a[9]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if networkLessKeyed(&a[5], &a[8]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if networkLessKeyed(&a[6], &a[10]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if networkLessKeyed(&a[7], &a[11]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if networkLessKeyed(&a[7], &a[10]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if networkLessKeyed(&a[6], &a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if networkLessKeyed(&a[7], &a[9]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if networkLessKeyed(&a[7], &a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if networkLessKeyed(&a[16], &a[17]) {
+		a[16], a[17] = a[17], a[16]
+	}
+	if networkLessKeyed(&a[18], &a[19]) {
+		a[18], a[19] = a[19], a[18]
+	}
+	if networkLessKeyed(&a[16], &a[18]) {
+		a[16], a[18] = a[18], a[16]
+	}
+	if networkLessKeyed(&a[17], &a[19]) {
+		a[17], a[19] = a[19], a[17]
+	}
+	if networkLessKeyed(&a[17], &a[18]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if networkLessKeyed(&a[20], &a[21]) {
+		a[20], a[21] = a[21], a[20]
+	}
+	if networkLessKeyed(&a[22], &a[23]) {
+		a[22], a[23] = a[23], a[22]
+	}
+	if networkLessKeyed(&a[20], &a[22]) {
+		a[20], a[22] = a[22], a[20]
+	}
+	if networkLessKeyed(&a[21], &a[23]) {
+		a[21], a[23] = a[23], a[21]
+	}
+	if networkLessKeyed(&a[21], &a[22]) {
+		a[21], a[22] = a[22], a[21]
+	}
+	if networkLessKeyed(&a[16], &a[20]) {
+		a[16], a[20] = a[20], a[16]
+	}
+	if networkLessKeyed(&a[17], &a[21]) {
+		a[17], a[21] = a[21], a[17]
+	}
+	if networkLessKeyed(&a[17], &a[20]) {
+		a[17], a[20] = a[20], a[17]
+	}
+	if networkLessKeyed(&a[18], &a[22]) {
+		a[18], a[22] = a[22], a[18]
+	}
+	if networkLessKeyed(&a[19], &a[23]) {
+		a[19], a[23] = a[23], a[19]
+	}
+	if networkLessKeyed(&a[19], &a[22]) {
+		a[19], a[22] = a[22], a[19]
+	}
+	if networkLessKeyed(&a[18], &a[20]) {
+		a[18], a[20] = a[20], a[18]
+	}
+	if networkLessKeyed(&a[19], &a[21]) {
+		a[19], a[21] = a[21], a[19]
+	}
+	if networkLessKeyed(&a[19], &a[20]) {
+		a[19], a[20] = a[20], a[19]
+	}
+	if networkLessKeyed(&a[24], &a[25]) {
+		a[24], a[25] = a[25], a[24]
+	}
+	if networkLessKeyed(&a[26], &a[27]) {
+		a[26], a[27] = a[27], a[26]
+	}
+	if networkLessKeyed(&a[24], &a[26]) {
+		a[24], a[26] = a[26], a[24]
+	}
+	if networkLessKeyed(&a[25], &a[27]) {
+		a[25], a[27] = a[27], a[25]
+	}
+	if networkLessKeyed(&a[25], &a[26]) {
+		a[25], a[26] = a[26], a[25]
+	}
+	if networkLessKeyed(&a[28], &a[29]) {
+		a[28], a[29] = a[29], a[28]
+	}
+	if networkLessKeyed(&a[30], &a[31]) {
+		a[30], a[31] = a[31], a[30]
+	}
+	if networkLessKeyed(&a[28], &a[30]) {
+		a[28], a[30] = a[30], a[28]
+	}
+	if networkLessKeyed(&a[29], &a[31]) {
+		a[29], a[31] = a[31], a[29]
+	}
+	if networkLessKeyed(&a[29], &a[30]) {
+		a[29], a[30] = a[30], a[29]
+	}
+	if networkLessKeyed(&a[24], &a[28]) {
+		a[24], a[28] = a[28], a[24]
+	}
+	if networkLessKeyed(&a[25], &a[29]) {
+		a[25], a[29] = a[29], a[25]
+	}
+	if networkLessKeyed(&a[25], &a[28]) {
+		a[25], a[28] = a[28], a[25]
+	}
+	if networkLessKeyed(&a[26], &a[30]) {
+		a[26], a[30] = a[30], a[26]
+	}
+	if networkLessKeyed(&a[27], &a[31]) {
+		a[27], a[31] = a[31], a[27]
+	}
+	if networkLessKeyed(&a[27], &a[30]) {
+		a[27], a[30] = a[30], a[27]
+	}
+	if networkLessKeyed(&a[26], &a[28]) {
+		a[26], a[28] = a[28], a[26]
+	}
+	if networkLessKeyed(&a[27], &a[29]) {
+		a[27], a[29] = a[29], a[27]
+	}
+	if networkLessKeyed(&a[27], &a[28]) {
+		a[27], a[28] = a[28], a[27]
+	}
+	if networkLessKeyed(&a[16], &a[24]) {
+		a[16], a[24] = a[24], a[16]
+	}
+	if networkLessKeyed(&a[17], &a[25]) {
+		a[17], a[25] = a[25], a[17]
+	}
+	if networkLessKeyed(&a[17], &a[24]) {
+		a[17], a[24] = a[24], a[17]
+	}
+	if networkLessKeyed(&a[18], &a[26]) {
+		a[18], a[26] = a[26], a[18]
+	}
+	if networkLessKeyed(&a[19], &a[27]) {
+		a[19], a[27] = a[27], a[19]
+	}
+	if networkLessKeyed(&a[19], &a[26]) {
+		a[19], a[26] = a[26], a[19]
+	}
+	if networkLessKeyed(&a[18], &a[24]) {
+		a[18], a[24] = a[24], a[18]
+	}
+	if networkLessKeyed(&a[19], &a[25]) {
+		a[19], a[25] = a[25], a[19]
+	}
+	if networkLessKeyed(&a[19], &a[24]) {
+		a[19], a[24] = a[24], a[19]
+	}
+	if networkLessKeyed(&a[20], &a[28]) {
+		a[20], a[28] = a[28], a[20]
+	}
+	if networkLessKeyed(&a[21], &a[29]) {
+		a[21], a[29] = a[29], a[21]
+	}
+	if networkLessKeyed(&a[21], &a[28]) {
+		a[21], a[28] = a[28], a[21]
+	}
+	if networkLessKeyed(&a[22], &a[30]) {
+		a[22], a[30] = a[30], a[22]
+	}
+	if networkLessKeyed(&a[23], &a[31]) {
+		a[23], a[31] = a[31], a[23]
+	}
+	if networkLessKeyed(&a[23], &a[30]) {
+		a[23], a[30] = a[30], a[23]
+	}
+	if networkLessKeyed(&a[22], &a[28]) {
+		a[22], a[28] = a[28], a[22]
+	}
+	if networkLessKeyed(&a[23], &a[29]) {
+		a[23], a[29] = a[29], a[23]
+	}
+	if networkLessKeyed(&a[23], &a[28]) {
+		a[23], a[28] = a[28], a[23]
+	}
+	if networkLessKeyed(&a[20], &a[24]) {
+		a[20], a[24] = a[24], a[20]
+	}
+	if networkLessKeyed(&a[21], &a[25]) {
+		a[21], a[25] = a[25], a[21]
+	}
+	if networkLessKeyed(&a[21], &a[24]) {
+		a[21], a[24] = a[24], a[21]
+	}
+	if networkLessKeyed(&a[22], &a[26]) {
+		a[22], a[26] = a[26], a[22]
+	}
+	if networkLessKeyed(&a[23], &a[27]) {
+		a[23], a[27] = a[27], a[23]
+	}
+	if networkLessKeyed(&a[23], &a[26]) {
+		a[23], a[26] = a[26], a[23]
+	}
+	if networkLessKeyed(&a[22], &a[24]) {
+		a[22], a[24] = a[24], a[22]
+	}
+	if networkLessKeyed(&a[23], &a[25]) {
+		a[23], a[25] = a[25], a[23]
+	}
+	if networkLessKeyed(&a[23], &a[24]) {
+		a[23], a[24] = a[24], a[23]
+	}
+	if networkLessKeyed(&a[0], &a[16]) {
+		a[0], a[16] = a[16], a[0]
+	}
+	if networkLessKeyed(&a[1], &a[17]) {
+		a[1], a[17] = a[17], a[1]
+	}
+	if networkLessKeyed(&a[1], &a[16]) {
+		a[1], a[16] = a[16], a[1]
+	}
+	if networkLessKeyed(&a[2], &a[18]) {
+		a[2], a[18] = a[18], a[2]
+	}
+	if networkLessKeyed(&a[3], &a[19]) {
+		a[3], a[19] = a[19], a[3]
+	}
+	if networkLessKeyed(&a[3], &a[18]) {
+		a[3], a[18] = a[18], a[3]
+	}
+	if networkLessKeyed(&a[2], &a[16]) {
+		a[2], a[16] = a[16], a[2]
+	}
+	if networkLessKeyed(&a[3], &a[17]) {
+		a[3], a[17] = a[17], a[3]
+	}
+	if networkLessKeyed(&a[3], &a[16]) {
+		a[3], a[16] = a[16], a[3]
+	}
+	if networkLessKeyed(&a[4], &a[20]) {
+		a[4], a[20] = a[20], a[4]
+	}
+	if networkLessKeyed(&a[5], &a[21]) {
+		a[5], a[21] = a[21], a[5]
+	}
+	if networkLessKeyed(&a[5], &a[20]) {
+		a[5], a[20] = a[20], a[5]
+	}
+	if networkLessKeyed(&a[6], &a[22]) {
+		a[6], a[22] = a[22], a[6]
+	}
+	if networkLessKeyed(&a[7], &a[23]) {
+		a[7], a[23] = a[23], a[7]
+	}
+	if networkLessKeyed(&a[7], &a[22]) {
+		a[7], a[22] = a[22], a[7]
+	}
+	if networkLessKeyed(&a[6], &a[20]) {
+		a[6], a[20] = a[20], a[6]
+	}
+	if networkLessKeyed(&a[7], &a[21]) {
+		a[7], a[21] = a[21], a[7]
+	}
+	if networkLessKeyed(&a[7], &a[20]) {
+		a[7], a[20] = a[20], a[7]
+	}
+	if networkLessKeyed(&a[4], &a[16]) {
+		a[4], a[16] = a[16], a[4]
+	}
+	if networkLessKeyed(&a[5], &a[17]) {
+		a[5], a[17] = a[17], a[5]
+	}
+	if networkLessKeyed(&a[5], &a[16]) {
+		a[5], a[16] = a[16], a[5]
+	}
+	if networkLessKeyed(&a[6], &a[18]) {
+		a[6], a[18] = a[18], a[6]
+	}
+	if networkLessKeyed(&a[7], &a[19]) {
+		a[7], a[19] = a[19], a[7]
+	}
+	if networkLessKeyed(&a[7], &a[18]) {
+		a[7], a[18] = a[18], a[7]
+	}
+	if networkLessKeyed(&a[6], &a[16]) {
+		a[6], a[16] = a[16], a[6]
+	}
+	if networkLessKeyed(&a[7], &a[17]) {
+		a[7], a[17] = a[17], a[7]
+	}
+	if networkLessKeyed(&a[7], &a[16]) {
+		a[7], a[16] = a[16], a[7]
+	}
+	if networkLessKeyed(&a[8], &a[24]) {
+		a[8], a[24] = a[24], a[8]
+	}
+	if networkLessKeyed(&a[9], &a[25]) {
+		a[9], a[25] = a[25], a[9]
+	}
+	if networkLessKeyed(&a[9], &a[24]) {
+		a[9], a[24] = a[24], a[9]
+	}
+	if networkLessKeyed(&a[10], &a[26]) {
+		a[10], a[26] = a[26], a[10]
+	}
+	if networkLessKeyed(&a[11], &a[27]) {
+		a[11], a[27] = a[27], a[11]
+	}
+	if networkLessKeyed(&a[11], &a[26]) {
+		a[11], a[26] = a[26], a[11]
+	}
+	if networkLessKeyed(&a[10], &a[24]) {
+		a[10], a[24] = a[24], a[10]
+	}
+	if networkLessKeyed(&a[11], &a[25]) {
+		a[11], a[25] = a[25], a[11]
+	}
+	if networkLessKeyed(&a[11], &a[24]) {
+		a[11], a[24] = a[24], a[11]
+	}
+	if networkLessKeyed(&a[12], &a[28]) {
+		a[12], a[28] = a[28], a[12]
+	}
+	if networkLessKeyed(&a[13], &a[29]) {
+		a[13], a[29] = a[29], a[13]
+	}
+	if networkLessKeyed(&a[13], &a[28]) {
+		a[13], a[28] = a[28], a[13]
+	}
+	if networkLessKeyed(&a[14], &a[30]) {
+		a[14], a[30] = a[30], a[14]
+	}
+	if networkLessKeyed(&a[15], &a[31]) {
+		a[15], a[31] = a[31], a[15]
+	}
+	if networkLessKeyed(&a[15], &a[30]) {
+		a[15], a[30] = a[30], a[15]
+	}
+	if networkLessKeyed(&a[14], &a[28]) {
+		a[14], a[28] = a[28], a[14]
+	}
+	if networkLessKeyed(&a[15], &a[29]) {
+		a[15], a[29] = a[29], a[15]
+	}
+	if networkLessKeyed(&a[15], &a[28]) {
+		a[15], a[28] = a[28], a[15]
+	}
+	if networkLessKeyed(&a[12], &a[24]) {
+		a[12], a[24] = a[24], a[12]
+	}
+	if networkLessKeyed(&a[13], &a[25]) {
+		a[13], a[25] = a[25], a[13]
+	}
+	if networkLessKeyed(&a[13], &a[24]) {
+		a[13], a[24] = a[24], a[13]
+	}
+	if networkLessKeyed(&a[14], &a[26]) {
+		a[14], a[26] = a[26], a[14]
+	}
+	if networkLessKeyed(&a[15], &a[27]) {
+		a[15], a[27] = a[27], a[15]
+	}
+	if networkLessKeyed(&a[15], &a[26]) {
+		a[15], a[26] = a[26], a[15]
+	}
+	if networkLessKeyed(&a[14], &a[24]) {
+		a[14], a[24] = a[24], a[14]
+	}
+	if networkLessKeyed(&a[15], &a[25]) {
+		a[15], a[25] = a[25], a[15]
+	}
+	if networkLessKeyed(&a[15], &a[24]) {
+		a[15], a[24] = a[24], a[15]
+	}
+	if networkLessKeyed(&a[8], &a[16]) {
+		a[8], a[16] = a[16], a[8]
+	}
+	if networkLessKeyed(&a[9], &a[17]) {
+		a[9], a[17] = a[17], a[9]
+	}
+	if networkLessKeyed(&a[9], &a[16]) {
+		a[9], a[16] = a[16], a[9]
+	}
+	if networkLessKeyed(&a[10], &a[18]) {
+		a[10], a[18] = a[18], a[10]
+	}
+	if networkLessKeyed(&a[11], &a[19]) {
+		a[11], a[19] = a[19], a[11]
+	}
+	if networkLessKeyed(&a[11], &a[18]) {
+		a[11], a[18] = a[18], a[11]
+	}
+	if networkLessKeyed(&a[10], &a[16]) {
+		a[10], a[16] = a[16], a[10]
+	}
+	if networkLessKeyed(&a[11], &a[17]) {
+		a[11], a[17] = a[17], a[11]
+	}
+	if networkLessKeyed(&a[11], &a[16]) {
+		a[11], a[16] = a[16], a[11]
+	}
+	if networkLessKeyed(&a[12], &a[20]) {
+		a[12], a[20] = a[20], a[12]
+	}
+	if networkLessKeyed(&a[13], &a[21]) {
+		a[13], a[21] = a[21], a[13]
+	}
+	if networkLessKeyed(&a[13], &a[20]) {
+		a[13], a[20] = a[20], a[13]
+	}
+	if networkLessKeyed(&a[14], &a[22]) {
+		a[14], a[22] = a[22], a[14]
+	}
+	if networkLessKeyed(&a[15], &a[23]) {
+		a[15], a[23] = a[23], a[15]
+	}
+	if networkLessKeyed(&a[15], &a[22]) {
+		a[15], a[22] = a[22], a[15]
+	}
+	if networkLessKeyed(&a[14], &a[20]) {
+		a[14], a[20] = a[20], a[14]
+	}
+	if networkLessKeyed(&a[15], &a[21]) {
+		a[15], a[21] = a[21], a[15]
+	}
+	if networkLessKeyed(&a[15], &a[20]) {
+		a[15], a[20] = a[20], a[15]
+	}
+	if networkLessKeyed(&a[12], &a[16]) {
+		a[12], a[16] = a[16], a[12]
+	}
+	if networkLessKeyed(&a[13], &a[17]) {
+		a[13], a[17] = a[17], a[13]
+	}
+	if networkLessKeyed(&a[13], &a[16]) {
+		a[13], a[16] = a[16], a[13]
+	}
+	if networkLessKeyed(&a[14], &a[18]) {
+		a[14], a[18] = a[18], a[14]
+	}
+	if networkLessKeyed(&a[15], &a[19]) {
+		a[15], a[19] = a[19], a[15]
+	}
+	if networkLessKeyed(&a[15], &a[18]) {
+		a[15], a[18] = a[18], a[15]
+	}
+	if networkLessKeyed(&a[14], &a[16]) {
+		a[14], a[16] = a[16], a[14]
+	}
+	if networkLessKeyed(&a[15], &a[17]) {
+		a[15], a[17] = a[17], a[15]
+	}
+	if networkLessKeyed(&a[15], &a[16]) {
+		a[15], a[16] = a[16], a[15]
+	}
+}
+
+// NetworkMerge32xKeyedReverse merges 32 runs, each of which must already be sorted
+// in decreasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge32xKeyedReverse(dst []Keyed, r0, r1, r2, r3, r4, r5, r6, r7, r8, r9, r10, r11, r12, r13, r14, r15, r16, r17, r18, r19, r20, r21, r22, r23, r24, r25, r26, r27, r28, r29, r30, r31 []Keyed) []Keyed {
+	var v0, v1, v2, v3, v4, v5, v6, v7, v8, v9, v10, v11, v12, v13, v14, v15, v16, v17, v18, v19, v20, v21, v22, v23, v24, v25, v26, v27, v28, v29, v30, v31, vm0, vm1, vm2, vm3, vm4, vm5, vm6, vm7, vm8, vm9, vm10, vm11, vm12, vm13, vm14, vm15, vm16, vm17, vm18, vm19, vm20, vm21, vm22, vm23, vm24, vm25, vm26, vm27, vm28, vm29, vm30 Keyed
+	var wm0, wm1, wm2, wm3, wm4, wm5, wm6, wm7, wm8, wm9, wm10, wm11, wm12, wm13, wm14, wm15, wm16, wm17, wm18, wm19, wm20, wm21, wm22, wm23, wm24, wm25, wm26, wm27, wm28, wm29, wm30 int
+	var okm0, okm1, okm2, okm3, okm4, okm5, okm6, okm7, okm8, okm9, okm10, okm11, okm12, okm13, okm14, okm15, okm16, okm17, okm18, okm19, okm20, okm21, okm22, okm23, okm24, okm25, okm26, okm27, okm28, okm29, okm30 bool
+	ok0, ok1, ok2, ok3, ok4, ok5, ok6, ok7, ok8, ok9, ok10, ok11, ok12, ok13, ok14, ok15, ok16, ok17, ok18, ok19, ok20, ok21, ok22, ok23, ok24, ok25, ok26, ok27, ok28, ok29, ok30, ok31 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0, len(r5) > 0, len(r6) > 0, len(r7) > 0, len(r8) > 0, len(r9) > 0, len(r10) > 0, len(r11) > 0, len(r12) > 0, len(r13) > 0, len(r14) > 0, len(r15) > 0, len(r16) > 0, len(r17) > 0, len(r18) > 0, len(r19) > 0, len(r20) > 0, len(r21) > 0, len(r22) > 0, len(r23) > 0, len(r24) > 0, len(r25) > 0, len(r26) > 0, len(r27) > 0, len(r28) > 0, len(r29) > 0, len(r30) > 0, len(r31) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	if ok5 {
+		v5 = r5[0]
+	}
+	if ok6 {
+		v6 = r6[0]
+	}
+	if ok7 {
+		v7 = r7[0]
+	}
+	if ok8 {
+		v8 = r8[0]
+	}
+	if ok9 {
+		v9 = r9[0]
+	}
+	if ok10 {
+		v10 = r10[0]
+	}
+	if ok11 {
+		v11 = r11[0]
+	}
+	if ok12 {
+		v12 = r12[0]
+	}
+	if ok13 {
+		v13 = r13[0]
+	}
+	if ok14 {
+		v14 = r14[0]
+	}
+	if ok15 {
+		v15 = r15[0]
+	}
+	if ok16 {
+		v16 = r16[0]
+	}
+	if ok17 {
+		v17 = r17[0]
+	}
+	if ok18 {
+		v18 = r18[0]
+	}
+	if ok19 {
+		v19 = r19[0]
+	}
+	if ok20 {
+		v20 = r20[0]
+	}
+	if ok21 {
+		v21 = r21[0]
+	}
+	if ok22 {
+		v22 = r22[0]
+	}
+	if ok23 {
+		v23 = r23[0]
+	}
+	if ok24 {
+		v24 = r24[0]
+	}
+	if ok25 {
+		v25 = r25[0]
+	}
+	if ok26 {
+		v26 = r26[0]
+	}
+	if ok27 {
+		v27 = r27[0]
+	}
+	if ok28 {
+		v28 = r28[0]
+	}
+	if ok29 {
+		v29 = r29[0]
+	}
+	if ok30 {
+		v30 = r30[0]
+	}
+	if ok31 {
+		v31 = r31[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = 4, v4, ok4
+	if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+		wm2, vm2, okm2 = 5, v5, true
+	}
+	wm3, vm3, okm3 = 6, v6, ok6
+	if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+		wm3, vm3, okm3 = 7, v7, true
+	}
+	wm4, vm4, okm4 = 8, v8, ok8
+	if ok9 && (!ok8 || networkLessKeyed(&v8, &v9)) {
+		wm4, vm4, okm4 = 9, v9, true
+	}
+	wm5, vm5, okm5 = 10, v10, ok10
+	if ok11 && (!ok10 || networkLessKeyed(&v10, &v11)) {
+		wm5, vm5, okm5 = 11, v11, true
+	}
+	wm6, vm6, okm6 = 12, v12, ok12
+	if ok13 && (!ok12 || networkLessKeyed(&v12, &v13)) {
+		wm6, vm6, okm6 = 13, v13, true
+	}
+	wm7, vm7, okm7 = 14, v14, ok14
+	if ok15 && (!ok14 || networkLessKeyed(&v14, &v15)) {
+		wm7, vm7, okm7 = 15, v15, true
+	}
+	wm8, vm8, okm8 = 16, v16, ok16
+	if ok17 && (!ok16 || networkLessKeyed(&v16, &v17)) {
+		wm8, vm8, okm8 = 17, v17, true
+	}
+	wm9, vm9, okm9 = 18, v18, ok18
+	if ok19 && (!ok18 || networkLessKeyed(&v18, &v19)) {
+		wm9, vm9, okm9 = 19, v19, true
+	}
+	wm10, vm10, okm10 = 20, v20, ok20
+	if ok21 && (!ok20 || networkLessKeyed(&v20, &v21)) {
+		wm10, vm10, okm10 = 21, v21, true
+	}
+	wm11, vm11, okm11 = 22, v22, ok22
+	if ok23 && (!ok22 || networkLessKeyed(&v22, &v23)) {
+		wm11, vm11, okm11 = 23, v23, true
+	}
+	wm12, vm12, okm12 = 24, v24, ok24
+	if ok25 && (!ok24 || networkLessKeyed(&v24, &v25)) {
+		wm12, vm12, okm12 = 25, v25, true
+	}
+	wm13, vm13, okm13 = 26, v26, ok26
+	if ok27 && (!ok26 || networkLessKeyed(&v26, &v27)) {
+		wm13, vm13, okm13 = 27, v27, true
+	}
+	wm14, vm14, okm14 = 28, v28, ok28
+	if ok29 && (!ok28 || networkLessKeyed(&v28, &v29)) {
+		wm14, vm14, okm14 = 29, v29, true
+	}
+	wm15, vm15, okm15 = 30, v30, ok30
+	if ok31 && (!ok30 || networkLessKeyed(&v30, &v31)) {
+		wm15, vm15, okm15 = 31, v31, true
+	}
+	wm16, vm16, okm16 = wm0, vm0, okm0
+	if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+		wm16, vm16, okm16 = wm1, vm1, true
+	}
+	wm17, vm17, okm17 = wm2, vm2, okm2
+	if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+		wm17, vm17, okm17 = wm3, vm3, true
+	}
+	wm18, vm18, okm18 = wm4, vm4, okm4
+	if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+		wm18, vm18, okm18 = wm5, vm5, true
+	}
+	wm19, vm19, okm19 = wm6, vm6, okm6
+	if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+		wm19, vm19, okm19 = wm7, vm7, true
+	}
+	wm20, vm20, okm20 = wm8, vm8, okm8
+	if okm9 && (!okm8 || networkLessKeyed(&vm8, &vm9)) {
+		wm20, vm20, okm20 = wm9, vm9, true
+	}
+	wm21, vm21, okm21 = wm10, vm10, okm10
+	if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+		wm21, vm21, okm21 = wm11, vm11, true
+	}
+	wm22, vm22, okm22 = wm12, vm12, okm12
+	if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+		wm22, vm22, okm22 = wm13, vm13, true
+	}
+	wm23, vm23, okm23 = wm14, vm14, okm14
+	if okm15 && (!okm14 || networkLessKeyed(&vm14, &vm15)) {
+		wm23, vm23, okm23 = wm15, vm15, true
+	}
+	wm24, vm24, okm24 = wm16, vm16, okm16
+	if okm17 && (!okm16 || networkLessKeyed(&vm16, &vm17)) {
+		wm24, vm24, okm24 = wm17, vm17, true
+	}
+	wm25, vm25, okm25 = wm18, vm18, okm18
+	if okm19 && (!okm18 || networkLessKeyed(&vm18, &vm19)) {
+		wm25, vm25, okm25 = wm19, vm19, true
+	}
+	wm26, vm26, okm26 = wm20, vm20, okm20
+	if okm21 && (!okm20 || networkLessKeyed(&vm20, &vm21)) {
+		wm26, vm26, okm26 = wm21, vm21, true
+	}
+	wm27, vm27, okm27 = wm22, vm22, okm22
+	if okm23 && (!okm22 || networkLessKeyed(&vm22, &vm23)) {
+		wm27, vm27, okm27 = wm23, vm23, true
+	}
+	wm28, vm28, okm28 = wm24, vm24, okm24
+	if okm25 && (!okm24 || networkLessKeyed(&vm24, &vm25)) {
+		wm28, vm28, okm28 = wm25, vm25, true
+	}
+	wm29, vm29, okm29 = wm26, vm26, okm26
+	if okm27 && (!okm26 || networkLessKeyed(&vm26, &vm27)) {
+		wm29, vm29, okm29 = wm27, vm27, true
+	}
+	wm30, vm30, okm30 = wm28, vm28, okm28
+	if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+		wm30, vm30, okm30 = wm29, vm29, true
+	}
+
+	for okm30 {
+		dst = append(dst, vm30)
+		switch wm30 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm16, vm16, okm16 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm16, vm16, okm16 = wm1, vm1, true
+			}
+			wm24, vm24, okm24 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm16, &vm17)) {
+				wm24, vm24, okm24 = wm17, vm17, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm24, &vm25)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || networkLessKeyed(&v0, &v1)) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm16, vm16, okm16 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm16, vm16, okm16 = wm1, vm1, true
+			}
+			wm24, vm24, okm24 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm16, &vm17)) {
+				wm24, vm24, okm24 = wm17, vm17, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm24, &vm25)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm16, vm16, okm16 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm16, vm16, okm16 = wm1, vm1, true
+			}
+			wm24, vm24, okm24 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm16, &vm17)) {
+				wm24, vm24, okm24 = wm17, vm17, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm24, &vm25)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || networkLessKeyed(&v2, &v3)) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm16, vm16, okm16 = wm0, vm0, okm0
+			if okm1 && (!okm0 || networkLessKeyed(&vm0, &vm1)) {
+				wm16, vm16, okm16 = wm1, vm1, true
+			}
+			wm24, vm24, okm24 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm16, &vm17)) {
+				wm24, vm24, okm24 = wm17, vm17, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm24, &vm25)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm17, vm17, okm17 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm17, vm17, okm17 = wm3, vm3, true
+			}
+			wm24, vm24, okm24 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm16, &vm17)) {
+				wm24, vm24, okm24 = wm17, vm17, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm24, &vm25)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 5:
+			r5 = r5[1:]
+			if ok5 = len(r5) > 0; ok5 {
+				v5 = r5[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || networkLessKeyed(&v4, &v5)) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm17, vm17, okm17 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm17, vm17, okm17 = wm3, vm3, true
+			}
+			wm24, vm24, okm24 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm16, &vm17)) {
+				wm24, vm24, okm24 = wm17, vm17, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm24, &vm25)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 6:
+			r6 = r6[1:]
+			if ok6 = len(r6) > 0; ok6 {
+				v6 = r6[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm17, vm17, okm17 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm17, vm17, okm17 = wm3, vm3, true
+			}
+			wm24, vm24, okm24 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm16, &vm17)) {
+				wm24, vm24, okm24 = wm17, vm17, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm24, &vm25)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 7:
+			r7 = r7[1:]
+			if ok7 = len(r7) > 0; ok7 {
+				v7 = r7[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || networkLessKeyed(&v6, &v7)) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm17, vm17, okm17 = wm2, vm2, okm2
+			if okm3 && (!okm2 || networkLessKeyed(&vm2, &vm3)) {
+				wm17, vm17, okm17 = wm3, vm3, true
+			}
+			wm24, vm24, okm24 = wm16, vm16, okm16
+			if okm17 && (!okm16 || networkLessKeyed(&vm16, &vm17)) {
+				wm24, vm24, okm24 = wm17, vm17, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm24, &vm25)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 8:
+			r8 = r8[1:]
+			if ok8 = len(r8) > 0; ok8 {
+				v8 = r8[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v8, &v9)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm18, vm18, okm18 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm18, vm18, okm18 = wm5, vm5, true
+			}
+			wm25, vm25, okm25 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm18, &vm19)) {
+				wm25, vm25, okm25 = wm19, vm19, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm24, &vm25)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 9:
+			r9 = r9[1:]
+			if ok9 = len(r9) > 0; ok9 {
+				v9 = r9[0]
+			}
+			wm4, vm4, okm4 = 8, v8, ok8
+			if ok9 && (!ok8 || networkLessKeyed(&v8, &v9)) {
+				wm4, vm4, okm4 = 9, v9, true
+			}
+			wm18, vm18, okm18 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm18, vm18, okm18 = wm5, vm5, true
+			}
+			wm25, vm25, okm25 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm18, &vm19)) {
+				wm25, vm25, okm25 = wm19, vm19, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm24, &vm25)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 10:
+			r10 = r10[1:]
+			if ok10 = len(r10) > 0; ok10 {
+				v10 = r10[0]
+			}
+			wm5, vm5, okm5 = 10, v10, ok10
+			if ok11 && (!ok10 || networkLessKeyed(&v10, &v11)) {
+				wm5, vm5, okm5 = 11, v11, true
+			}
+			wm18, vm18, okm18 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm18, vm18, okm18 = wm5, vm5, true
+			}
+			wm25, vm25, okm25 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm18, &vm19)) {
+				wm25, vm25, okm25 = wm19, vm19, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm24, &vm25)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 11:
+			r11 = r11[1:]
+			if ok11 = len(r11) > 0; ok11 {
+				v11 = r11[0]
+			}
+			wm5, vm5, okm5 = 10, v10, ok10
+			if ok11 && (!ok10 || networkLessKeyed(&v10, &v11)) {
+				wm5, vm5, okm5 = 11, v11, true
+			}
+			wm18, vm18, okm18 = wm4, vm4, okm4
+			if okm5 && (!okm4 || networkLessKeyed(&vm4, &vm5)) {
+				wm18, vm18, okm18 = wm5, vm5, true
+			}
+			wm25, vm25, okm25 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm18, &vm19)) {
+				wm25, vm25, okm25 = wm19, vm19, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm24, &vm25)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 12:
+			r12 = r12[1:]
+			if ok12 = len(r12) > 0; ok12 {
+				v12 = r12[0]
+			}
+			wm6, vm6, okm6 = 12, v12, ok12
+			if ok13 && (!ok12 || networkLessKeyed(&v12, &v13)) {
+				wm6, vm6, okm6 = 13, v13, true
+			}
+			wm19, vm19, okm19 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+				wm19, vm19, okm19 = wm7, vm7, true
+			}
+			wm25, vm25, okm25 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm18, &vm19)) {
+				wm25, vm25, okm25 = wm19, vm19, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm24, &vm25)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 13:
+			r13 = r13[1:]
+			if ok13 = len(r13) > 0; ok13 {
+				v13 = r13[0]
+			}
+			wm6, vm6, okm6 = 12, v12, ok12
+			if ok13 && (!ok12 || networkLessKeyed(&v12, &v13)) {
+				wm6, vm6, okm6 = 13, v13, true
+			}
+			wm19, vm19, okm19 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+				wm19, vm19, okm19 = wm7, vm7, true
+			}
+			wm25, vm25, okm25 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm18, &vm19)) {
+				wm25, vm25, okm25 = wm19, vm19, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm24, &vm25)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 14:
+			r14 = r14[1:]
+			if ok14 = len(r14) > 0; ok14 {
+				v14 = r14[0]
+			}
+			wm7, vm7, okm7 = 14, v14, ok14
+			if ok15 && (!ok14 || networkLessKeyed(&v14, &v15)) {
+				wm7, vm7, okm7 = 15, v15, true
+			}
+			wm19, vm19, okm19 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+				wm19, vm19, okm19 = wm7, vm7, true
+			}
+			wm25, vm25, okm25 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm18, &vm19)) {
+				wm25, vm25, okm25 = wm19, vm19, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm24, &vm25)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 15:
+			r15 = r15[1:]
+			if ok15 = len(r15) > 0; ok15 {
+				v15 = r15[0]
+			}
+			wm7, vm7, okm7 = 14, v14, ok14
+			if ok15 && (!ok14 || networkLessKeyed(&v14, &v15)) {
+				wm7, vm7, okm7 = 15, v15, true
+			}
+			wm19, vm19, okm19 = wm6, vm6, okm6
+			if okm7 && (!okm6 || networkLessKeyed(&vm6, &vm7)) {
+				wm19, vm19, okm19 = wm7, vm7, true
+			}
+			wm25, vm25, okm25 = wm18, vm18, okm18
+			if okm19 && (!okm18 || networkLessKeyed(&vm18, &vm19)) {
+				wm25, vm25, okm25 = wm19, vm19, true
+			}
+			wm28, vm28, okm28 = wm24, vm24, okm24
+			if okm25 && (!okm24 || networkLessKeyed(&vm24, &vm25)) {
+				wm28, vm28, okm28 = wm25, vm25, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 16:
+			r16 = r16[1:]
+			if ok16 = len(r16) > 0; ok16 {
+				v16 = r16[0]
+			}
+			wm8, vm8, okm8 = 16, v16, ok16
+			if ok17 && (!ok16 || networkLessKeyed(&v16, &v17)) {
+				wm8, vm8, okm8 = 17, v17, true
+			}
+			wm20, vm20, okm20 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm8, &vm9)) {
+				wm20, vm20, okm20 = wm9, vm9, true
+			}
+			wm26, vm26, okm26 = wm20, vm20, okm20
+			if okm21 && (!okm20 || networkLessKeyed(&vm20, &vm21)) {
+				wm26, vm26, okm26 = wm21, vm21, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm26, &vm27)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 17:
+			r17 = r17[1:]
+			if ok17 = len(r17) > 0; ok17 {
+				v17 = r17[0]
+			}
+			wm8, vm8, okm8 = 16, v16, ok16
+			if ok17 && (!ok16 || networkLessKeyed(&v16, &v17)) {
+				wm8, vm8, okm8 = 17, v17, true
+			}
+			wm20, vm20, okm20 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm8, &vm9)) {
+				wm20, vm20, okm20 = wm9, vm9, true
+			}
+			wm26, vm26, okm26 = wm20, vm20, okm20
+			if okm21 && (!okm20 || networkLessKeyed(&vm20, &vm21)) {
+				wm26, vm26, okm26 = wm21, vm21, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm26, &vm27)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 18:
+			r18 = r18[1:]
+			if ok18 = len(r18) > 0; ok18 {
+				v18 = r18[0]
+			}
+			wm9, vm9, okm9 = 18, v18, ok18
+			if ok19 && (!ok18 || networkLessKeyed(&v18, &v19)) {
+				wm9, vm9, okm9 = 19, v19, true
+			}
+			wm20, vm20, okm20 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm8, &vm9)) {
+				wm20, vm20, okm20 = wm9, vm9, true
+			}
+			wm26, vm26, okm26 = wm20, vm20, okm20
+			if okm21 && (!okm20 || networkLessKeyed(&vm20, &vm21)) {
+				wm26, vm26, okm26 = wm21, vm21, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm26, &vm27)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 19:
+			r19 = r19[1:]
+			if ok19 = len(r19) > 0; ok19 {
+				v19 = r19[0]
+			}
+			wm9, vm9, okm9 = 18, v18, ok18
+			if ok19 && (!ok18 || networkLessKeyed(&v18, &v19)) {
+				wm9, vm9, okm9 = 19, v19, true
+			}
+			wm20, vm20, okm20 = wm8, vm8, okm8
+			if okm9 && (!okm8 || networkLessKeyed(&vm8, &vm9)) {
+				wm20, vm20, okm20 = wm9, vm9, true
+			}
+			wm26, vm26, okm26 = wm20, vm20, okm20
+			if okm21 && (!okm20 || networkLessKeyed(&vm20, &vm21)) {
+				wm26, vm26, okm26 = wm21, vm21, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm26, &vm27)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 20:
+			r20 = r20[1:]
+			if ok20 = len(r20) > 0; ok20 {
+				v20 = r20[0]
+			}
+			wm10, vm10, okm10 = 20, v20, ok20
+			if ok21 && (!ok20 || networkLessKeyed(&v20, &v21)) {
+				wm10, vm10, okm10 = 21, v21, true
+			}
+			wm21, vm21, okm21 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm21, vm21, okm21 = wm11, vm11, true
+			}
+			wm26, vm26, okm26 = wm20, vm20, okm20
+			if okm21 && (!okm20 || networkLessKeyed(&vm20, &vm21)) {
+				wm26, vm26, okm26 = wm21, vm21, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm26, &vm27)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 21:
+			r21 = r21[1:]
+			if ok21 = len(r21) > 0; ok21 {
+				v21 = r21[0]
+			}
+			wm10, vm10, okm10 = 20, v20, ok20
+			if ok21 && (!ok20 || networkLessKeyed(&v20, &v21)) {
+				wm10, vm10, okm10 = 21, v21, true
+			}
+			wm21, vm21, okm21 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm21, vm21, okm21 = wm11, vm11, true
+			}
+			wm26, vm26, okm26 = wm20, vm20, okm20
+			if okm21 && (!okm20 || networkLessKeyed(&vm20, &vm21)) {
+				wm26, vm26, okm26 = wm21, vm21, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm26, &vm27)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 22:
+			r22 = r22[1:]
+			if ok22 = len(r22) > 0; ok22 {
+				v22 = r22[0]
+			}
+			wm11, vm11, okm11 = 22, v22, ok22
+			if ok23 && (!ok22 || networkLessKeyed(&v22, &v23)) {
+				wm11, vm11, okm11 = 23, v23, true
+			}
+			wm21, vm21, okm21 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm21, vm21, okm21 = wm11, vm11, true
+			}
+			wm26, vm26, okm26 = wm20, vm20, okm20
+			if okm21 && (!okm20 || networkLessKeyed(&vm20, &vm21)) {
+				wm26, vm26, okm26 = wm21, vm21, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm26, &vm27)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 23:
+			r23 = r23[1:]
+			if ok23 = len(r23) > 0; ok23 {
+				v23 = r23[0]
+			}
+			wm11, vm11, okm11 = 22, v22, ok22
+			if ok23 && (!ok22 || networkLessKeyed(&v22, &v23)) {
+				wm11, vm11, okm11 = 23, v23, true
+			}
+			wm21, vm21, okm21 = wm10, vm10, okm10
+			if okm11 && (!okm10 || networkLessKeyed(&vm10, &vm11)) {
+				wm21, vm21, okm21 = wm11, vm11, true
+			}
+			wm26, vm26, okm26 = wm20, vm20, okm20
+			if okm21 && (!okm20 || networkLessKeyed(&vm20, &vm21)) {
+				wm26, vm26, okm26 = wm21, vm21, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm26, &vm27)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 24:
+			r24 = r24[1:]
+			if ok24 = len(r24) > 0; ok24 {
+				v24 = r24[0]
+			}
+			wm12, vm12, okm12 = 24, v24, ok24
+			if ok25 && (!ok24 || networkLessKeyed(&v24, &v25)) {
+				wm12, vm12, okm12 = 25, v25, true
+			}
+			wm22, vm22, okm22 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+				wm22, vm22, okm22 = wm13, vm13, true
+			}
+			wm27, vm27, okm27 = wm22, vm22, okm22
+			if okm23 && (!okm22 || networkLessKeyed(&vm22, &vm23)) {
+				wm27, vm27, okm27 = wm23, vm23, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm26, &vm27)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 25:
+			r25 = r25[1:]
+			if ok25 = len(r25) > 0; ok25 {
+				v25 = r25[0]
+			}
+			wm12, vm12, okm12 = 24, v24, ok24
+			if ok25 && (!ok24 || networkLessKeyed(&v24, &v25)) {
+				wm12, vm12, okm12 = 25, v25, true
+			}
+			wm22, vm22, okm22 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+				wm22, vm22, okm22 = wm13, vm13, true
+			}
+			wm27, vm27, okm27 = wm22, vm22, okm22
+			if okm23 && (!okm22 || networkLessKeyed(&vm22, &vm23)) {
+				wm27, vm27, okm27 = wm23, vm23, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm26, &vm27)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 26:
+			r26 = r26[1:]
+			if ok26 = len(r26) > 0; ok26 {
+				v26 = r26[0]
+			}
+			wm13, vm13, okm13 = 26, v26, ok26
+			if ok27 && (!ok26 || networkLessKeyed(&v26, &v27)) {
+				wm13, vm13, okm13 = 27, v27, true
+			}
+			wm22, vm22, okm22 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+				wm22, vm22, okm22 = wm13, vm13, true
+			}
+			wm27, vm27, okm27 = wm22, vm22, okm22
+			if okm23 && (!okm22 || networkLessKeyed(&vm22, &vm23)) {
+				wm27, vm27, okm27 = wm23, vm23, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm26, &vm27)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 27:
+			r27 = r27[1:]
+			if ok27 = len(r27) > 0; ok27 {
+				v27 = r27[0]
+			}
+			wm13, vm13, okm13 = 26, v26, ok26
+			if ok27 && (!ok26 || networkLessKeyed(&v26, &v27)) {
+				wm13, vm13, okm13 = 27, v27, true
+			}
+			wm22, vm22, okm22 = wm12, vm12, okm12
+			if okm13 && (!okm12 || networkLessKeyed(&vm12, &vm13)) {
+				wm22, vm22, okm22 = wm13, vm13, true
+			}
+			wm27, vm27, okm27 = wm22, vm22, okm22
+			if okm23 && (!okm22 || networkLessKeyed(&vm22, &vm23)) {
+				wm27, vm27, okm27 = wm23, vm23, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm26, &vm27)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 28:
+			r28 = r28[1:]
+			if ok28 = len(r28) > 0; ok28 {
+				v28 = r28[0]
+			}
+			wm14, vm14, okm14 = 28, v28, ok28
+			if ok29 && (!ok28 || networkLessKeyed(&v28, &v29)) {
+				wm14, vm14, okm14 = 29, v29, true
+			}
+			wm23, vm23, okm23 = wm14, vm14, okm14
+			if okm15 && (!okm14 || networkLessKeyed(&vm14, &vm15)) {
+				wm23, vm23, okm23 = wm15, vm15, true
+			}
+			wm27, vm27, okm27 = wm22, vm22, okm22
+			if okm23 && (!okm22 || networkLessKeyed(&vm22, &vm23)) {
+				wm27, vm27, okm27 = wm23, vm23, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm26, &vm27)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 29:
+			r29 = r29[1:]
+			if ok29 = len(r29) > 0; ok29 {
+				v29 = r29[0]
+			}
+			wm14, vm14, okm14 = 28, v28, ok28
+			if ok29 && (!ok28 || networkLessKeyed(&v28, &v29)) {
+				wm14, vm14, okm14 = 29, v29, true
+			}
+			wm23, vm23, okm23 = wm14, vm14, okm14
+			if okm15 && (!okm14 || networkLessKeyed(&vm14, &vm15)) {
+				wm23, vm23, okm23 = wm15, vm15, true
+			}
+			wm27, vm27, okm27 = wm22, vm22, okm22
+			if okm23 && (!okm22 || networkLessKeyed(&vm22, &vm23)) {
+				wm27, vm27, okm27 = wm23, vm23, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm26, &vm27)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 30:
+			r30 = r30[1:]
+			if ok30 = len(r30) > 0; ok30 {
+				v30 = r30[0]
+			}
+			wm15, vm15, okm15 = 30, v30, ok30
+			if ok31 && (!ok30 || networkLessKeyed(&v30, &v31)) {
+				wm15, vm15, okm15 = 31, v31, true
+			}
+			wm23, vm23, okm23 = wm14, vm14, okm14
+			if okm15 && (!okm14 || networkLessKeyed(&vm14, &vm15)) {
+				wm23, vm23, okm23 = wm15, vm15, true
+			}
+			wm27, vm27, okm27 = wm22, vm22, okm22
+			if okm23 && (!okm22 || networkLessKeyed(&vm22, &vm23)) {
+				wm27, vm27, okm27 = wm23, vm23, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm26, &vm27)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		case 31:
+			r31 = r31[1:]
+			if ok31 = len(r31) > 0; ok31 {
+				v31 = r31[0]
+			}
+			wm15, vm15, okm15 = 30, v30, ok30
+			if ok31 && (!ok30 || networkLessKeyed(&v30, &v31)) {
+				wm15, vm15, okm15 = 31, v31, true
+			}
+			wm23, vm23, okm23 = wm14, vm14, okm14
+			if okm15 && (!okm14 || networkLessKeyed(&vm14, &vm15)) {
+				wm23, vm23, okm23 = wm15, vm15, true
+			}
+			wm27, vm27, okm27 = wm22, vm22, okm22
+			if okm23 && (!okm22 || networkLessKeyed(&vm22, &vm23)) {
+				wm27, vm27, okm27 = wm23, vm23, true
+			}
+			wm29, vm29, okm29 = wm26, vm26, okm26
+			if okm27 && (!okm26 || networkLessKeyed(&vm26, &vm27)) {
+				wm29, vm29, okm29 = wm27, vm27, true
+			}
+			wm30, vm30, okm30 = wm28, vm28, okm28
+			if okm29 && (!okm28 || networkLessKeyed(&vm28, &vm29)) {
+				wm30, vm30, okm30 = wm29, vm29, true
+			}
+		}
+	}
+	return dst
+}
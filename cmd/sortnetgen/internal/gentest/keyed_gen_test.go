@@ -0,0 +1,426 @@
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
+
+package gentest
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/shabbyrobe/sortnet/sortnettest"
+)
+
+// testKeyedGenCase is a function generated by sortnetgen, adapted to be checked by
+// sortnettest. Either sort or merge is set.
+type testKeyedGenCase[T any] struct {
+	name  string
+	size  int
+	sort  func(a []T)
+	merge func(dst []T, runs [][]T) []T
+}
+
+// testKeyedGenCasesNetworkSortKeyed returns the functions generated for Keyed
+// in increasing order, along with how to make random items and how they are ordered.
+func testKeyedGenCasesNetworkSortKeyed() (gen func(*rand.Rand) Keyed, before func(a, b Keyed) bool, cases []testKeyedGenCase[Keyed]) {
+	gen = func(rng *rand.Rand) Keyed {
+		return Keyed{Name: strconv.Itoa(rng.Intn(32)), Info: KeyedInfo{Score: float64(rng.Intn(32)-16) / 4, When: time.Unix(rng.Int63n(32), 0)}}
+	}
+	less := func(a, b Keyed) bool { return networkLessKeyed(&a, &b) }
+	before = less
+	cases = []testKeyedGenCase[Keyed]{
+		{name: "NetworkSort2xKeyed", size: 2, sort: func(a []Keyed) { NetworkSort2xKeyed(a) }},
+		{name: "NetworkMerge2xKeyed", size: 2, merge: func(dst []Keyed, runs [][]Keyed) []Keyed { return NetworkMerge2xKeyed(dst, runs[0], runs[1]) }},
+		{name: "NetworkSort3xKeyed", size: 3, sort: func(a []Keyed) { NetworkSort3xKeyed(a) }},
+		{name: "NetworkMerge3xKeyed", size: 3, merge: func(dst []Keyed, runs [][]Keyed) []Keyed { return NetworkMerge3xKeyed(dst, runs[0], runs[1], runs[2]) }},
+		{name: "NetworkSort4xKeyed", size: 4, sort: func(a []Keyed) { NetworkSort4xKeyed(a) }},
+		{name: "NetworkMerge4xKeyed", size: 4, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge4xKeyed(dst, runs[0], runs[1], runs[2], runs[3])
+		}},
+		{name: "NetworkSort5xKeyed", size: 5, sort: func(a []Keyed) { NetworkSort5xKeyed(a) }},
+		{name: "NetworkMerge5xKeyed", size: 5, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge5xKeyed(dst, runs[0], runs[1], runs[2], runs[3], runs[4])
+		}},
+		{name: "NetworkSort6xKeyed", size: 6, sort: func(a []Keyed) { NetworkSort6xKeyed(a) }},
+		{name: "NetworkMerge6xKeyed", size: 6, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge6xKeyed(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5])
+		}},
+		{name: "NetworkSort7xKeyed", size: 7, sort: func(a []Keyed) { NetworkSort7xKeyed(a) }},
+		{name: "NetworkMerge7xKeyed", size: 7, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge7xKeyed(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5], runs[6])
+		}},
+		{name: "NetworkSort8xKeyed", size: 8, sort: func(a []Keyed) { NetworkSort8xKeyed(a) }},
+		{name: "NetworkMerge8xKeyed", size: 8, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge8xKeyed(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5], runs[6], runs[7])
+		}},
+		{name: "NetworkSort9xKeyed", size: 9, sort: func(a []Keyed) { NetworkSort9xKeyed(a) }},
+		{name: "NetworkMerge9xKeyed", size: 9, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge9xKeyed(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5], runs[6], runs[7], runs[8])
+		}},
+		{name: "NetworkSort10xKeyed", size: 10, sort: func(a []Keyed) { NetworkSort10xKeyed(a) }},
+		{name: "NetworkMerge10xKeyed", size: 10, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge10xKeyed(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5], runs[6], runs[7], runs[8], runs[9])
+		}},
+		{name: "NetworkSort11xKeyed", size: 11, sort: func(a []Keyed) { NetworkSort11xKeyed(a) }},
+		{name: "NetworkMerge11xKeyed", size: 11, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge11xKeyed(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5], runs[6], runs[7], runs[8], runs[9], runs[10])
+		}},
+		{name: "NetworkSort12xKeyed", size: 12, sort: func(a []Keyed) { NetworkSort12xKeyed(a) }},
+		{name: "NetworkMerge12xKeyed", size: 12, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge12xKeyed(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5], runs[6], runs[7], runs[8], runs[9], runs[10], runs[11])
+		}},
+		{name: "NetworkSort13xKeyed", size: 13, sort: func(a []Keyed) { NetworkSort13xKeyed(a) }},
+		{name: "NetworkMerge13xKeyed", size: 13, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge13xKeyed(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5], runs[6], runs[7], runs[8], runs[9], runs[10], runs[11], runs[12])
+		}},
+		{name: "NetworkSort14xKeyed", size: 14, sort: func(a []Keyed) { NetworkSort14xKeyed(a) }},
+		{name: "NetworkMerge14xKeyed", size: 14, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge14xKeyed(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5], runs[6], runs[7], runs[8], runs[9], runs[10], runs[11], runs[12], runs[13])
+		}},
+		{name: "NetworkSort15xKeyed", size: 15, sort: func(a []Keyed) { NetworkSort15xKeyed(a) }},
+		{name: "NetworkMerge15xKeyed", size: 15, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge15xKeyed(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5], runs[6], runs[7], runs[8], runs[9], runs[10], runs[11], runs[12], runs[13], runs[14])
+		}},
+		{name: "NetworkSort16xKeyed", size: 16, sort: func(a []Keyed) { NetworkSort16xKeyed(a) }},
+		{name: "NetworkMerge16xKeyed", size: 16, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge16xKeyed(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5], runs[6], runs[7], runs[8], runs[9], runs[10], runs[11], runs[12], runs[13], runs[14], runs[15])
+		}},
+		{name: "NetworkSort24xKeyed", size: 24, sort: func(a []Keyed) { NetworkSort24xKeyed(a) }},
+		{name: "NetworkMerge24xKeyed", size: 24, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge24xKeyed(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5], runs[6], runs[7], runs[8], runs[9], runs[10], runs[11], runs[12], runs[13], runs[14], runs[15], runs[16], runs[17], runs[18], runs[19], runs[20], runs[21], runs[22], runs[23])
+		}},
+		{name: "NetworkSort32xKeyed", size: 32, sort: func(a []Keyed) { NetworkSort32xKeyed(a) }},
+		{name: "NetworkMerge32xKeyed", size: 32, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge32xKeyed(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5], runs[6], runs[7], runs[8], runs[9], runs[10], runs[11], runs[12], runs[13], runs[14], runs[15], runs[16], runs[17], runs[18], runs[19], runs[20], runs[21], runs[22], runs[23], runs[24], runs[25], runs[26], runs[27], runs[28], runs[29], runs[30], runs[31])
+		}},
+		{name: "NetworkSortKeyed-2", size: 2, sort: func(a []Keyed) {
+			if !NetworkSortKeyed(a, len(a)) {
+				panic("NetworkSortKeyed: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortKeyed-3", size: 3, sort: func(a []Keyed) {
+			if !NetworkSortKeyed(a, len(a)) {
+				panic("NetworkSortKeyed: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortKeyed-4", size: 4, sort: func(a []Keyed) {
+			if !NetworkSortKeyed(a, len(a)) {
+				panic("NetworkSortKeyed: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortKeyed-5", size: 5, sort: func(a []Keyed) {
+			if !NetworkSortKeyed(a, len(a)) {
+				panic("NetworkSortKeyed: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortKeyed-6", size: 6, sort: func(a []Keyed) {
+			if !NetworkSortKeyed(a, len(a)) {
+				panic("NetworkSortKeyed: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortKeyed-7", size: 7, sort: func(a []Keyed) {
+			if !NetworkSortKeyed(a, len(a)) {
+				panic("NetworkSortKeyed: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortKeyed-8", size: 8, sort: func(a []Keyed) {
+			if !NetworkSortKeyed(a, len(a)) {
+				panic("NetworkSortKeyed: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortKeyed-9", size: 9, sort: func(a []Keyed) {
+			if !NetworkSortKeyed(a, len(a)) {
+				panic("NetworkSortKeyed: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortKeyed-10", size: 10, sort: func(a []Keyed) {
+			if !NetworkSortKeyed(a, len(a)) {
+				panic("NetworkSortKeyed: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortKeyed-11", size: 11, sort: func(a []Keyed) {
+			if !NetworkSortKeyed(a, len(a)) {
+				panic("NetworkSortKeyed: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortKeyed-12", size: 12, sort: func(a []Keyed) {
+			if !NetworkSortKeyed(a, len(a)) {
+				panic("NetworkSortKeyed: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortKeyed-13", size: 13, sort: func(a []Keyed) {
+			if !NetworkSortKeyed(a, len(a)) {
+				panic("NetworkSortKeyed: no sorter for size 13")
+			}
+		}},
+		{name: "NetworkSortKeyed-14", size: 14, sort: func(a []Keyed) {
+			if !NetworkSortKeyed(a, len(a)) {
+				panic("NetworkSortKeyed: no sorter for size 14")
+			}
+		}},
+		{name: "NetworkSortKeyed-15", size: 15, sort: func(a []Keyed) {
+			if !NetworkSortKeyed(a, len(a)) {
+				panic("NetworkSortKeyed: no sorter for size 15")
+			}
+		}},
+		{name: "NetworkSortKeyed-16", size: 16, sort: func(a []Keyed) {
+			if !NetworkSortKeyed(a, len(a)) {
+				panic("NetworkSortKeyed: no sorter for size 16")
+			}
+		}},
+		{name: "NetworkSortKeyed-24", size: 24, sort: func(a []Keyed) {
+			if !NetworkSortKeyed(a, len(a)) {
+				panic("NetworkSortKeyed: no sorter for size 24")
+			}
+		}},
+		{name: "NetworkSortKeyed-32", size: 32, sort: func(a []Keyed) {
+			if !NetworkSortKeyed(a, len(a)) {
+				panic("NetworkSortKeyed: no sorter for size 32")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestKeyedGenNetworkSortKeyed(t *testing.T) {
+	gen, before, cases := testKeyedGenCasesNetworkSortKeyed()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzKeyedGenNetworkSortKeyed(f *testing.F) {
+	gen, before, cases := testKeyedGenCasesNetworkSortKeyed()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]Keyed, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkKeyedGenNetworkSortKeyed(b *testing.B) {
+	gen, before, cases := testKeyedGenCasesNetworkSortKeyed()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testKeyedGenCasesNetworkSortKeyedReverse returns the functions generated for Keyed
+// in decreasing order, along with how to make random items and how they are ordered.
+func testKeyedGenCasesNetworkSortKeyedReverse() (gen func(*rand.Rand) Keyed, before func(a, b Keyed) bool, cases []testKeyedGenCase[Keyed]) {
+	gen = func(rng *rand.Rand) Keyed {
+		return Keyed{Name: strconv.Itoa(rng.Intn(32)), Info: KeyedInfo{Score: float64(rng.Intn(32)-16) / 4, When: time.Unix(rng.Int63n(32), 0)}}
+	}
+	less := func(a, b Keyed) bool { return networkLessKeyed(&a, &b) }
+	before = func(a, b Keyed) bool { return less(b, a) }
+	cases = []testKeyedGenCase[Keyed]{
+		{name: "NetworkSort2xKeyedReverse", size: 2, sort: func(a []Keyed) { NetworkSort2xKeyedReverse(a) }},
+		{name: "NetworkMerge2xKeyedReverse", size: 2, merge: func(dst []Keyed, runs [][]Keyed) []Keyed { return NetworkMerge2xKeyedReverse(dst, runs[0], runs[1]) }},
+		{name: "NetworkSort3xKeyedReverse", size: 3, sort: func(a []Keyed) { NetworkSort3xKeyedReverse(a) }},
+		{name: "NetworkMerge3xKeyedReverse", size: 3, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge3xKeyedReverse(dst, runs[0], runs[1], runs[2])
+		}},
+		{name: "NetworkSort4xKeyedReverse", size: 4, sort: func(a []Keyed) { NetworkSort4xKeyedReverse(a) }},
+		{name: "NetworkMerge4xKeyedReverse", size: 4, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge4xKeyedReverse(dst, runs[0], runs[1], runs[2], runs[3])
+		}},
+		{name: "NetworkSort5xKeyedReverse", size: 5, sort: func(a []Keyed) { NetworkSort5xKeyedReverse(a) }},
+		{name: "NetworkMerge5xKeyedReverse", size: 5, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge5xKeyedReverse(dst, runs[0], runs[1], runs[2], runs[3], runs[4])
+		}},
+		{name: "NetworkSort6xKeyedReverse", size: 6, sort: func(a []Keyed) { NetworkSort6xKeyedReverse(a) }},
+		{name: "NetworkMerge6xKeyedReverse", size: 6, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge6xKeyedReverse(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5])
+		}},
+		{name: "NetworkSort7xKeyedReverse", size: 7, sort: func(a []Keyed) { NetworkSort7xKeyedReverse(a) }},
+		{name: "NetworkMerge7xKeyedReverse", size: 7, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge7xKeyedReverse(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5], runs[6])
+		}},
+		{name: "NetworkSort8xKeyedReverse", size: 8, sort: func(a []Keyed) { NetworkSort8xKeyedReverse(a) }},
+		{name: "NetworkMerge8xKeyedReverse", size: 8, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge8xKeyedReverse(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5], runs[6], runs[7])
+		}},
+		{name: "NetworkSort9xKeyedReverse", size: 9, sort: func(a []Keyed) { NetworkSort9xKeyedReverse(a) }},
+		{name: "NetworkMerge9xKeyedReverse", size: 9, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge9xKeyedReverse(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5], runs[6], runs[7], runs[8])
+		}},
+		{name: "NetworkSort10xKeyedReverse", size: 10, sort: func(a []Keyed) { NetworkSort10xKeyedReverse(a) }},
+		{name: "NetworkMerge10xKeyedReverse", size: 10, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge10xKeyedReverse(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5], runs[6], runs[7], runs[8], runs[9])
+		}},
+		{name: "NetworkSort11xKeyedReverse", size: 11, sort: func(a []Keyed) { NetworkSort11xKeyedReverse(a) }},
+		{name: "NetworkMerge11xKeyedReverse", size: 11, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge11xKeyedReverse(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5], runs[6], runs[7], runs[8], runs[9], runs[10])
+		}},
+		{name: "NetworkSort12xKeyedReverse", size: 12, sort: func(a []Keyed) { NetworkSort12xKeyedReverse(a) }},
+		{name: "NetworkMerge12xKeyedReverse", size: 12, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge12xKeyedReverse(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5], runs[6], runs[7], runs[8], runs[9], runs[10], runs[11])
+		}},
+		{name: "NetworkSort13xKeyedReverse", size: 13, sort: func(a []Keyed) { NetworkSort13xKeyedReverse(a) }},
+		{name: "NetworkMerge13xKeyedReverse", size: 13, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge13xKeyedReverse(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5], runs[6], runs[7], runs[8], runs[9], runs[10], runs[11], runs[12])
+		}},
+		{name: "NetworkSort14xKeyedReverse", size: 14, sort: func(a []Keyed) { NetworkSort14xKeyedReverse(a) }},
+		{name: "NetworkMerge14xKeyedReverse", size: 14, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge14xKeyedReverse(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5], runs[6], runs[7], runs[8], runs[9], runs[10], runs[11], runs[12], runs[13])
+		}},
+		{name: "NetworkSort15xKeyedReverse", size: 15, sort: func(a []Keyed) { NetworkSort15xKeyedReverse(a) }},
+		{name: "NetworkMerge15xKeyedReverse", size: 15, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge15xKeyedReverse(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5], runs[6], runs[7], runs[8], runs[9], runs[10], runs[11], runs[12], runs[13], runs[14])
+		}},
+		{name: "NetworkSort16xKeyedReverse", size: 16, sort: func(a []Keyed) { NetworkSort16xKeyedReverse(a) }},
+		{name: "NetworkMerge16xKeyedReverse", size: 16, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge16xKeyedReverse(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5], runs[6], runs[7], runs[8], runs[9], runs[10], runs[11], runs[12], runs[13], runs[14], runs[15])
+		}},
+		{name: "NetworkSort24xKeyedReverse", size: 24, sort: func(a []Keyed) { NetworkSort24xKeyedReverse(a) }},
+		{name: "NetworkMerge24xKeyedReverse", size: 24, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge24xKeyedReverse(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5], runs[6], runs[7], runs[8], runs[9], runs[10], runs[11], runs[12], runs[13], runs[14], runs[15], runs[16], runs[17], runs[18], runs[19], runs[20], runs[21], runs[22], runs[23])
+		}},
+		{name: "NetworkSort32xKeyedReverse", size: 32, sort: func(a []Keyed) { NetworkSort32xKeyedReverse(a) }},
+		{name: "NetworkMerge32xKeyedReverse", size: 32, merge: func(dst []Keyed, runs [][]Keyed) []Keyed {
+			return NetworkMerge32xKeyedReverse(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5], runs[6], runs[7], runs[8], runs[9], runs[10], runs[11], runs[12], runs[13], runs[14], runs[15], runs[16], runs[17], runs[18], runs[19], runs[20], runs[21], runs[22], runs[23], runs[24], runs[25], runs[26], runs[27], runs[28], runs[29], runs[30], runs[31])
+		}},
+		{name: "NetworkSortKeyedReverse-2", size: 2, sort: func(a []Keyed) {
+			if !NetworkSortKeyedReverse(a, len(a)) {
+				panic("NetworkSortKeyedReverse: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortKeyedReverse-3", size: 3, sort: func(a []Keyed) {
+			if !NetworkSortKeyedReverse(a, len(a)) {
+				panic("NetworkSortKeyedReverse: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortKeyedReverse-4", size: 4, sort: func(a []Keyed) {
+			if !NetworkSortKeyedReverse(a, len(a)) {
+				panic("NetworkSortKeyedReverse: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortKeyedReverse-5", size: 5, sort: func(a []Keyed) {
+			if !NetworkSortKeyedReverse(a, len(a)) {
+				panic("NetworkSortKeyedReverse: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortKeyedReverse-6", size: 6, sort: func(a []Keyed) {
+			if !NetworkSortKeyedReverse(a, len(a)) {
+				panic("NetworkSortKeyedReverse: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortKeyedReverse-7", size: 7, sort: func(a []Keyed) {
+			if !NetworkSortKeyedReverse(a, len(a)) {
+				panic("NetworkSortKeyedReverse: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortKeyedReverse-8", size: 8, sort: func(a []Keyed) {
+			if !NetworkSortKeyedReverse(a, len(a)) {
+				panic("NetworkSortKeyedReverse: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortKeyedReverse-9", size: 9, sort: func(a []Keyed) {
+			if !NetworkSortKeyedReverse(a, len(a)) {
+				panic("NetworkSortKeyedReverse: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortKeyedReverse-10", size: 10, sort: func(a []Keyed) {
+			if !NetworkSortKeyedReverse(a, len(a)) {
+				panic("NetworkSortKeyedReverse: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortKeyedReverse-11", size: 11, sort: func(a []Keyed) {
+			if !NetworkSortKeyedReverse(a, len(a)) {
+				panic("NetworkSortKeyedReverse: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortKeyedReverse-12", size: 12, sort: func(a []Keyed) {
+			if !NetworkSortKeyedReverse(a, len(a)) {
+				panic("NetworkSortKeyedReverse: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortKeyedReverse-13", size: 13, sort: func(a []Keyed) {
+			if !NetworkSortKeyedReverse(a, len(a)) {
+				panic("NetworkSortKeyedReverse: no sorter for size 13")
+			}
+		}},
+		{name: "NetworkSortKeyedReverse-14", size: 14, sort: func(a []Keyed) {
+			if !NetworkSortKeyedReverse(a, len(a)) {
+				panic("NetworkSortKeyedReverse: no sorter for size 14")
+			}
+		}},
+		{name: "NetworkSortKeyedReverse-15", size: 15, sort: func(a []Keyed) {
+			if !NetworkSortKeyedReverse(a, len(a)) {
+				panic("NetworkSortKeyedReverse: no sorter for size 15")
+			}
+		}},
+		{name: "NetworkSortKeyedReverse-16", size: 16, sort: func(a []Keyed) {
+			if !NetworkSortKeyedReverse(a, len(a)) {
+				panic("NetworkSortKeyedReverse: no sorter for size 16")
+			}
+		}},
+		{name: "NetworkSortKeyedReverse-24", size: 24, sort: func(a []Keyed) {
+			if !NetworkSortKeyedReverse(a, len(a)) {
+				panic("NetworkSortKeyedReverse: no sorter for size 24")
+			}
+		}},
+		{name: "NetworkSortKeyedReverse-32", size: 32, sort: func(a []Keyed) {
+			if !NetworkSortKeyedReverse(a, len(a)) {
+				panic("NetworkSortKeyedReverse: no sorter for size 32")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestKeyedGenNetworkSortKeyedReverse(t *testing.T) {
+	gen, before, cases := testKeyedGenCasesNetworkSortKeyedReverse()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzKeyedGenNetworkSortKeyedReverse(f *testing.F) {
+	gen, before, cases := testKeyedGenCasesNetworkSortKeyedReverse()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]Keyed, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkKeyedGenNetworkSortKeyedReverse(b *testing.B) {
+	gen, before, cases := testKeyedGenCasesNetworkSortKeyedReverse()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package gentest
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/shabbyrobe/sortnet/sortnettest"
+)
+
+// randKeyed generates Keyed values with only a few values for each field, so that
+// items often differ only by the later fields in the -key.
+func randKeyed(rng *rand.Rand) Keyed {
+	base := time.Date(2019, 10, 13, 0, 0, 0, 0, time.UTC)
+	return Keyed{
+		Name: fmt.Sprint(rng.Intn(3)),
+		Info: KeyedInfo{
+			Score: float64(rng.Intn(3)) / 2,
+			When:  base.Add(time.Duration(rng.Intn(3)) * time.Second),
+		},
+	}
+}
+
+// lessKeyed is the ordering given to -key for Keyed: '-Info.Score,Info.When,Name'.
+func lessKeyed(a, b Keyed) bool {
+	if a.Info.Score != b.Info.Score {
+		return a.Info.Score > b.Info.Score
+	}
+	if !a.Info.When.Equal(b.Info.When) {
+		return a.Info.When.Before(b.Info.When)
+	}
+	return a.Name < b.Name
+}
+
+func TestSortNetKeyedCheck(t *testing.T) {
+	greater := func(a, b Keyed) bool { return lessKeyed(b, a) }
+	for _, sz := range []int{2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 24, 32} {
+		t.Run(fmt.Sprint(sz), func(t *testing.T) {
+			sortnettest.CheckSorter(t, sz, wrapped(NetworkSortKeyed, sz), randKeyed, lessKeyed)
+			sortnettest.CheckSorter(t, sz, wrapped(NetworkSortKeyedReverse, sz), randKeyed, greater)
+		})
+	}
+}
+
+func TestMergeKeyed(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+	greater := func(a, b Keyed) bool { return lessKeyed(b, a) }
+
+	for _, fwd := range []bool{true, false} {
+		less := lessKeyed
+		if !fwd {
+			less = greater
+		}
+
+		for i := 0; i < 100; i++ {
+			var runs [3][]Keyed
+			var exp []Keyed
+			for r := range runs {
+				runs[r] = make([]Keyed, rng.Intn(10))
+				for j := range runs[r] {
+					runs[r][j] = randKeyed(rng)
+				}
+				sort.SliceStable(runs[r], func(i, j int) bool { return less(runs[r][i], runs[r][j]) })
+				exp = append(exp, runs[r]...)
+			}
+			sort.SliceStable(exp, func(i, j int) bool { return less(exp[i], exp[j]) })
+
+			var result []Keyed
+			if fwd {
+				result = NetworkMerge3xKeyed(nil, runs[0], runs[1], runs[2])
+			} else {
+				result = NetworkMerge3xKeyedReverse(nil, runs[0], runs[1], runs[2])
+			}
+			if !reflect.DeepEqual(exp, result) {
+				t.Fatalf("fwd %v: merge of %v:\nexp %v\ngot %v", fwd, runs, exp, result)
+			}
+		}
+	}
+}
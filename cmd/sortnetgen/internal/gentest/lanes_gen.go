@@ -0,0 +1,471 @@
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
+
+package gentest
+
+// Sorting networks used in this file:
+//
+//   - Bose-Nelson, 3 inputs: 3 comparators (proven optimal), depth 3 (proven optimal)
+//     Fingerprint f995edafd93d50ea
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Senso9, 9 inputs: 25 comparators (proven optimal), depth 8 (not optimal)
+//     Fingerprint 5463bfb95d0dca98
+//     V. K. Valsalam and R. Miikkulainen (2013)
+//     V. K. Valsalam and R. Miikkulainen, Using Symmetry and Evolutionary Search to Minimize Sorting Networks, Journal of Machine Learning Research 14, 2013
+
+// NetworkSort3xIntLanes sorts 'lanes' independent arrays of 3 items, which
+// are stored in lane-major order: item i of array l is at a[i*lanes+l].
+func NetworkSort3xIntLanes(a []int, lanes int) {
+	if lanes <= 0 {
+		return
+	}
+	_ = a[3*lanes-1]
+	i0 := 0
+	i1 := 1 * lanes
+	i2 := 2 * lanes
+
+	{
+		from, to := a[i1:i1+lanes], a[i2:i2+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = min(x, y), max(x, y)
+		}
+	}
+	{
+		from, to := a[i0:i0+lanes], a[i2:i2+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = min(x, y), max(x, y)
+		}
+	}
+	{
+		from, to := a[i0:i0+lanes], a[i1:i1+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = min(x, y), max(x, y)
+		}
+	}
+}
+
+// NetworkSort3xIntLanesReverse sorts 'lanes' independent arrays of 3 items, which
+// are stored in lane-major order: item i of array l is at a[i*lanes+l].
+func NetworkSort3xIntLanesReverse(a []int, lanes int) {
+	if lanes <= 0 {
+		return
+	}
+	_ = a[3*lanes-1]
+	i0 := 0
+	i1 := 1 * lanes
+	i2 := 2 * lanes
+
+	{
+		from, to := a[i1:i1+lanes], a[i2:i2+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = max(x, y), min(x, y)
+		}
+	}
+	{
+		from, to := a[i0:i0+lanes], a[i2:i2+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = max(x, y), min(x, y)
+		}
+	}
+	{
+		from, to := a[i0:i0+lanes], a[i1:i1+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = max(x, y), min(x, y)
+		}
+	}
+}
+
+// NetworkSort9xIntLanes sorts 'lanes' independent arrays of 9 items, which
+// are stored in lane-major order: item i of array l is at a[i*lanes+l].
+func NetworkSort9xIntLanes(a []int, lanes int) {
+	if lanes <= 0 {
+		return
+	}
+	_ = a[9*lanes-1]
+	i0 := 0
+	i1 := 1 * lanes
+	i2 := 2 * lanes
+	i3 := 3 * lanes
+	i4 := 4 * lanes
+	i5 := 5 * lanes
+	i6 := 6 * lanes
+	i7 := 7 * lanes
+	i8 := 8 * lanes
+
+	{
+		from, to := a[i2:i2+lanes], a[i6:i6+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = min(x, y), max(x, y)
+		}
+	}
+	{
+		from, to := a[i0:i0+lanes], a[i5:i5+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = min(x, y), max(x, y)
+		}
+	}
+	{
+		from, to := a[i1:i1+lanes], a[i4:i4+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = min(x, y), max(x, y)
+		}
+	}
+	{
+		from, to := a[i7:i7+lanes], a[i8:i8+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = min(x, y), max(x, y)
+		}
+	}
+	{
+		from, to := a[i0:i0+lanes], a[i7:i7+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = min(x, y), max(x, y)
+		}
+	}
+	{
+		from, to := a[i1:i1+lanes], a[i2:i2+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = min(x, y), max(x, y)
+		}
+	}
+	{
+		from, to := a[i3:i3+lanes], a[i5:i5+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = min(x, y), max(x, y)
+		}
+	}
+	{
+		from, to := a[i4:i4+lanes], a[i6:i6+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = min(x, y), max(x, y)
+		}
+	}
+	{
+		from, to := a[i5:i5+lanes], a[i8:i8+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = min(x, y), max(x, y)
+		}
+	}
+	{
+		from, to := a[i1:i1+lanes], a[i3:i3+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = min(x, y), max(x, y)
+		}
+	}
+	{
+		from, to := a[i6:i6+lanes], a[i8:i8+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = min(x, y), max(x, y)
+		}
+	}
+	{
+		from, to := a[i0:i0+lanes], a[i1:i1+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = min(x, y), max(x, y)
+		}
+	}
+	{
+		from, to := a[i4:i4+lanes], a[i5:i5+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = min(x, y), max(x, y)
+		}
+	}
+	{
+		from, to := a[i2:i2+lanes], a[i7:i7+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = min(x, y), max(x, y)
+		}
+	}
+	{
+		from, to := a[i3:i3+lanes], a[i7:i7+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = min(x, y), max(x, y)
+		}
+	}
+	{
+		from, to := a[i3:i3+lanes], a[i4:i4+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = min(x, y), max(x, y)
+		}
+	}
+	{
+		from, to := a[i5:i5+lanes], a[i6:i6+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = min(x, y), max(x, y)
+		}
+	}
+	{
+		from, to := a[i1:i1+lanes], a[i2:i2+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = min(x, y), max(x, y)
+		}
+	}
+	{
+		from, to := a[i1:i1+lanes], a[i3:i3+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = min(x, y), max(x, y)
+		}
+	}
+	{
+		from, to := a[i6:i6+lanes], a[i7:i7+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = min(x, y), max(x, y)
+		}
+	}
+	{
+		from, to := a[i4:i4+lanes], a[i5:i5+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = min(x, y), max(x, y)
+		}
+	}
+	{
+		from, to := a[i2:i2+lanes], a[i4:i4+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = min(x, y), max(x, y)
+		}
+	}
+	{
+		from, to := a[i5:i5+lanes], a[i6:i6+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = min(x, y), max(x, y)
+		}
+	}
+	{
+		from, to := a[i2:i2+lanes], a[i3:i3+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = min(x, y), max(x, y)
+		}
+	}
+	{
+		from, to := a[i4:i4+lanes], a[i5:i5+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = min(x, y), max(x, y)
+		}
+	}
+}
+
+// NetworkSort9xIntLanesReverse sorts 'lanes' independent arrays of 9 items, which
+// are stored in lane-major order: item i of array l is at a[i*lanes+l].
+func NetworkSort9xIntLanesReverse(a []int, lanes int) {
+	if lanes <= 0 {
+		return
+	}
+	_ = a[9*lanes-1]
+	i0 := 0
+	i1 := 1 * lanes
+	i2 := 2 * lanes
+	i3 := 3 * lanes
+	i4 := 4 * lanes
+	i5 := 5 * lanes
+	i6 := 6 * lanes
+	i7 := 7 * lanes
+	i8 := 8 * lanes
+
+	{
+		from, to := a[i2:i2+lanes], a[i6:i6+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = max(x, y), min(x, y)
+		}
+	}
+	{
+		from, to := a[i0:i0+lanes], a[i5:i5+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = max(x, y), min(x, y)
+		}
+	}
+	{
+		from, to := a[i1:i1+lanes], a[i4:i4+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = max(x, y), min(x, y)
+		}
+	}
+	{
+		from, to := a[i7:i7+lanes], a[i8:i8+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = max(x, y), min(x, y)
+		}
+	}
+	{
+		from, to := a[i0:i0+lanes], a[i7:i7+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = max(x, y), min(x, y)
+		}
+	}
+	{
+		from, to := a[i1:i1+lanes], a[i2:i2+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = max(x, y), min(x, y)
+		}
+	}
+	{
+		from, to := a[i3:i3+lanes], a[i5:i5+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = max(x, y), min(x, y)
+		}
+	}
+	{
+		from, to := a[i4:i4+lanes], a[i6:i6+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = max(x, y), min(x, y)
+		}
+	}
+	{
+		from, to := a[i5:i5+lanes], a[i8:i8+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = max(x, y), min(x, y)
+		}
+	}
+	{
+		from, to := a[i1:i1+lanes], a[i3:i3+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = max(x, y), min(x, y)
+		}
+	}
+	{
+		from, to := a[i6:i6+lanes], a[i8:i8+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = max(x, y), min(x, y)
+		}
+	}
+	{
+		from, to := a[i0:i0+lanes], a[i1:i1+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = max(x, y), min(x, y)
+		}
+	}
+	{
+		from, to := a[i4:i4+lanes], a[i5:i5+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = max(x, y), min(x, y)
+		}
+	}
+	{
+		from, to := a[i2:i2+lanes], a[i7:i7+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = max(x, y), min(x, y)
+		}
+	}
+	{
+		from, to := a[i3:i3+lanes], a[i7:i7+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = max(x, y), min(x, y)
+		}
+	}
+	{
+		from, to := a[i3:i3+lanes], a[i4:i4+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = max(x, y), min(x, y)
+		}
+	}
+	{
+		from, to := a[i5:i5+lanes], a[i6:i6+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = max(x, y), min(x, y)
+		}
+	}
+	{
+		from, to := a[i1:i1+lanes], a[i2:i2+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = max(x, y), min(x, y)
+		}
+	}
+	{
+		from, to := a[i1:i1+lanes], a[i3:i3+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = max(x, y), min(x, y)
+		}
+	}
+	{
+		from, to := a[i6:i6+lanes], a[i7:i7+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = max(x, y), min(x, y)
+		}
+	}
+	{
+		from, to := a[i4:i4+lanes], a[i5:i5+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = max(x, y), min(x, y)
+		}
+	}
+	{
+		from, to := a[i2:i2+lanes], a[i4:i4+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = max(x, y), min(x, y)
+		}
+	}
+	{
+		from, to := a[i5:i5+lanes], a[i6:i6+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = max(x, y), min(x, y)
+		}
+	}
+	{
+		from, to := a[i2:i2+lanes], a[i3:i3+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = max(x, y), min(x, y)
+		}
+	}
+	{
+		from, to := a[i4:i4+lanes], a[i5:i5+lanes]
+		for l, x := range from {
+			y := to[l]
+			from[l], to[l] = max(x, y), min(x, y)
+		}
+	}
+}
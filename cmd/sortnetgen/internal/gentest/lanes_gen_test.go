@@ -0,0 +1,106 @@
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
+
+package gentest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/shabbyrobe/sortnet/sortnettest"
+)
+
+// testLanesGenCase is a function generated by sortnetgen, adapted to be checked by
+// sortnettest. Either sort or merge is set.
+type testLanesGenCase[T any] struct {
+	name  string
+	size  int
+	sort  func(a []T)
+	merge func(dst []T, runs [][]T) []T
+}
+
+// testLanesGenCasesNetworkSortInt returns the functions generated for int
+// in increasing order, along with how to make random items and how they are ordered.
+func testLanesGenCasesNetworkSortInt() (gen func(*rand.Rand) int, before func(a, b int) bool, cases []testLanesGenCase[int]) {
+	gen = func(rng *rand.Rand) int { return rng.Intn(32) - 16 }
+	less := func(a, b int) bool { return a < b }
+	before = less
+	cases = []testLanesGenCase[int]{
+		{name: "NetworkSort3xIntLanes", size: 3, sort: func(a []int) { NetworkSort3xIntLanes(a, 1) }},
+		{name: "NetworkSort9xIntLanes", size: 9, sort: func(a []int) { NetworkSort9xIntLanes(a, 1) }},
+	}
+	return gen, before, cases
+}
+
+func TestLanesGenNetworkSortInt(t *testing.T) {
+	gen, before, cases := testLanesGenCasesNetworkSortInt()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzLanesGenNetworkSortInt(f *testing.F) {
+	gen, before, cases := testLanesGenCasesNetworkSortInt()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]int, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+// testLanesGenCasesNetworkSortIntReverse returns the functions generated for int
+// in decreasing order, along with how to make random items and how they are ordered.
+func testLanesGenCasesNetworkSortIntReverse() (gen func(*rand.Rand) int, before func(a, b int) bool, cases []testLanesGenCase[int]) {
+	gen = func(rng *rand.Rand) int { return rng.Intn(32) - 16 }
+	less := func(a, b int) bool { return a < b }
+	before = func(a, b int) bool { return less(b, a) }
+	cases = []testLanesGenCase[int]{
+		{name: "NetworkSort3xIntLanesReverse", size: 3, sort: func(a []int) { NetworkSort3xIntLanesReverse(a, 1) }},
+		{name: "NetworkSort9xIntLanesReverse", size: 9, sort: func(a []int) { NetworkSort9xIntLanesReverse(a, 1) }},
+	}
+	return gen, before, cases
+}
+
+func TestLanesGenNetworkSortIntReverse(t *testing.T) {
+	gen, before, cases := testLanesGenCasesNetworkSortIntReverse()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzLanesGenNetworkSortIntReverse(f *testing.F) {
+	gen, before, cases := testLanesGenCasesNetworkSortIntReverse()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]int, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
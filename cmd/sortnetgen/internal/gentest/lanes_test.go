@@ -0,0 +1,95 @@
+package gentest
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/shabbyrobe/sortnet"
+)
+
+func TestSortNetIntLanes(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+
+	for _, tc := range []struct {
+		sz     int
+		fwd    bool
+		sorter func(a []int, lanes int)
+	}{
+		{3, true, NetworkSort3xIntLanes},
+		{9, true, NetworkSort9xIntLanes},
+		{3, false, NetworkSort3xIntLanesReverse},
+		{9, false, NetworkSort9xIntLanesReverse},
+	} {
+		for _, lanes := range []int{0, 1, 5, 100} {
+			t.Run(fmt.Sprintf("%d/%v/%d", tc.sz, tc.fwd, lanes), func(t *testing.T) {
+				vs := make([]int, tc.sz*lanes)
+				for i := range vs {
+					vs[i] = rng.Intn(64)
+				}
+
+				exp := make([][]int, lanes)
+				for l := range exp {
+					for i := 0; i < tc.sz; i++ {
+						exp[l] = append(exp[l], vs[i*lanes+l])
+					}
+					if tc.fwd {
+						sort.Ints(exp[l])
+					} else {
+						sort.Sort(sort.Reverse(sort.IntSlice(exp[l])))
+					}
+				}
+
+				tc.sorter(vs, lanes)
+				for l := range exp {
+					for i := 0; i < tc.sz; i++ {
+						if vs[i*lanes+l] != exp[l][i] {
+							t.Fatalf("lane %d not sorted", l)
+						}
+					}
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkSortNetIntLanes(b *testing.B) {
+	const sz, lanes = 9, 4096
+	rng := rand.New(rand.NewSource(0))
+	src := make([]int, sz*lanes)
+	for i := range src {
+		src[i] = rng.Intn(1024)
+	}
+	vs := make([]int, len(src))
+
+	b.Run(fmt.Sprintf("network-lanes-%d", sz), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			copy(vs, src)
+			b.StartTimer()
+			NetworkSort9xIntLanes(vs, lanes)
+		}
+	})
+
+	b.Run(fmt.Sprintf("network-direct-lanes-%d", sz), func(b *testing.B) {
+		net := sortnet.New(sz)
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			copy(vs, src)
+			b.StartTimer()
+			sortnet.SortLanes(net, vs, lanes)
+		}
+	})
+
+	b.Run(fmt.Sprintf("network-%d", sz), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			copy(vs, src)
+			b.StartTimer()
+			for j := 0; j < len(vs); j += sz {
+				NetworkSort9xInt(vs[j : j+sz])
+			}
+		}
+	})
+}
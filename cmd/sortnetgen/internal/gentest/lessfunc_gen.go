@@ -0,0 +1,9407 @@
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
+
+package gentest
+
+// Sorting networks used in this file:
+//
+//   - Bose-Nelson, 2 inputs: 1 comparator (proven optimal), depth 1 (proven optimal)
+//     Fingerprint 9463926d4640a53a
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 3 inputs: 3 comparators (proven optimal), depth 3 (proven optimal)
+//     Fingerprint f995edafd93d50ea
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 4 inputs: 5 comparators (proven optimal), depth 3 (proven optimal)
+//     Fingerprint 0119fc1a905673cd
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 5 inputs: 9 comparators (proven optimal), depth 6 (not optimal)
+//     Fingerprint d54da66c984b8b07
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 6 inputs: 13 comparators (not optimal), depth 7 (not optimal)
+//     Fingerprint 1cc32b1cf5a405a2
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 7 inputs: 16 comparators (proven optimal), depth 7 (not optimal)
+//     Fingerprint 4c56e9c432030c54
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 8 inputs: 19 comparators (proven optimal), depth 7 (not optimal)
+//     Fingerprint 72b14580972c6d18
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Senso9, 9 inputs: 25 comparators (proven optimal), depth 8 (not optimal)
+//     Fingerprint 5463bfb95d0dca98
+//     V. K. Valsalam and R. Miikkulainen (2013)
+//     V. K. Valsalam and R. Miikkulainen, Using Symmetry and Evolutionary Search to Minimize Sorting Networks, Journal of Machine Learning Research 14, 2013
+//   - Senso10, 10 inputs: 29 comparators (proven optimal), depth 8 (not optimal)
+//     Fingerprint 9688ccf2613970b6
+//     V. K. Valsalam and R. Miikkulainen (2013)
+//     V. K. Valsalam and R. Miikkulainen, Using Symmetry and Evolutionary Search to Minimize Sorting Networks, Journal of Machine Learning Research 14, 2013
+//   - ShapiroGreen11, 11 inputs: 35 comparators (proven optimal), depth 9 (not optimal)
+//     Fingerprint a0cd3d71b97545e1
+//     G. Shapiro and M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - ShapiroGreen12, 12 inputs: 39 comparators (proven optimal), depth 9 (not optimal)
+//     Fingerprint 4520ec02bac38318
+//     G. Shapiro and M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - End13, 13 inputs: 45 comparators (best known), depth 10 (not optimal)
+//     Fingerprint 8fb515590c6989d6
+//     H. Juillé (1995)
+//     H. Juillé, Evolution of Non-Deterministic Incremental Algorithms as a New Approach for Search in State Spaces, Proceedings of the 6th International Conference on Genetic Algorithms, 1995
+//   - Green14, 14 inputs: 51 comparators (best known), depth 10 (not optimal)
+//     Fingerprint b78dfa7f24d12c0c
+//     M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - Green15, 15 inputs: 56 comparators (best known), depth 10 (not optimal)
+//     Fingerprint 360c873bdb44ac3e
+//     M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - Green16, 16 inputs: 60 comparators (best known), depth 10 (not optimal)
+//     Fingerprint 196d3d09b3f4a0e7
+//     M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - Morwenn24, 24 inputs: 123 comparators (not optimal), depth 15 (not optimal)
+//     Fingerprint a82fea0eb88f2605
+//     Morwenn
+//     https://github.com/Morwenn/cpp-sort
+//   - Bose-Nelson, 32 inputs: 211 comparators (unknown), depth 31 (unknown)
+//     Fingerprint 947ea8bcb4cc1a7d
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+
+// NetworkSortNamed sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortNamed(a []Named, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xNamed(a)
+	case 3:
+		NetworkSort3xNamed(a)
+	case 4:
+		NetworkSort4xNamed(a)
+	case 5:
+		NetworkSort5xNamed(a)
+	case 6:
+		NetworkSort6xNamed(a)
+	case 7:
+		NetworkSort7xNamed(a)
+	case 8:
+		NetworkSort8xNamed(a)
+	case 9:
+		NetworkSort9xNamed(a)
+	case 10:
+		NetworkSort10xNamed(a)
+	case 11:
+		NetworkSort11xNamed(a)
+	case 12:
+		NetworkSort12xNamed(a)
+	case 13:
+		NetworkSort13xNamed(a)
+	case 14:
+		NetworkSort14xNamed(a)
+	case 15:
+		NetworkSort15xNamed(a)
+	case 16:
+		NetworkSort16xNamed(a)
+	case 24:
+		NetworkSort24xNamed(a)
+	case 32:
+		NetworkSort32xNamed(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortNamedReverse sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortNamedReverse(a []Named, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xNamedReverse(a)
+	case 3:
+		NetworkSort3xNamedReverse(a)
+	case 4:
+		NetworkSort4xNamedReverse(a)
+	case 5:
+		NetworkSort5xNamedReverse(a)
+	case 6:
+		NetworkSort6xNamedReverse(a)
+	case 7:
+		NetworkSort7xNamedReverse(a)
+	case 8:
+		NetworkSort8xNamedReverse(a)
+	case 9:
+		NetworkSort9xNamedReverse(a)
+	case 10:
+		NetworkSort10xNamedReverse(a)
+	case 11:
+		NetworkSort11xNamedReverse(a)
+	case 12:
+		NetworkSort12xNamedReverse(a)
+	case 13:
+		NetworkSort13xNamedReverse(a)
+	case 14:
+		NetworkSort14xNamedReverse(a)
+	case 15:
+		NetworkSort15xNamedReverse(a)
+	case 16:
+		NetworkSort16xNamedReverse(a)
+	case 24:
+		NetworkSort24xNamedReverse(a)
+	case 32:
+		NetworkSort32xNamedReverse(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortPoint sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortPoint(a []Point, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xPoint(a)
+	case 3:
+		NetworkSort3xPoint(a)
+	case 4:
+		NetworkSort4xPoint(a)
+	case 5:
+		NetworkSort5xPoint(a)
+	case 6:
+		NetworkSort6xPoint(a)
+	case 7:
+		NetworkSort7xPoint(a)
+	case 8:
+		NetworkSort8xPoint(a)
+	case 9:
+		NetworkSort9xPoint(a)
+	case 10:
+		NetworkSort10xPoint(a)
+	case 11:
+		NetworkSort11xPoint(a)
+	case 12:
+		NetworkSort12xPoint(a)
+	case 13:
+		NetworkSort13xPoint(a)
+	case 14:
+		NetworkSort14xPoint(a)
+	case 15:
+		NetworkSort15xPoint(a)
+	case 16:
+		NetworkSort16xPoint(a)
+	case 24:
+		NetworkSort24xPoint(a)
+	case 32:
+		NetworkSort32xPoint(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortPointReverse sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortPointReverse(a []Point, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xPointReverse(a)
+	case 3:
+		NetworkSort3xPointReverse(a)
+	case 4:
+		NetworkSort4xPointReverse(a)
+	case 5:
+		NetworkSort5xPointReverse(a)
+	case 6:
+		NetworkSort6xPointReverse(a)
+	case 7:
+		NetworkSort7xPointReverse(a)
+	case 8:
+		NetworkSort8xPointReverse(a)
+	case 9:
+		NetworkSort9xPointReverse(a)
+	case 10:
+		NetworkSort10xPointReverse(a)
+	case 11:
+		NetworkSort11xPointReverse(a)
+	case 12:
+		NetworkSort12xPointReverse(a)
+	case 13:
+		NetworkSort13xPointReverse(a)
+	case 14:
+		NetworkSort14xPointReverse(a)
+	case 15:
+		NetworkSort15xPointReverse(a)
+	case 16:
+		NetworkSort16xPointReverse(a)
+	case 24:
+		NetworkSort24xPointReverse(a)
+	case 32:
+		NetworkSort32xPointReverse(a)
+	default:
+		return false
+	}
+	return true
+}
+
+func NetworkSort2xNamed(a []Named) {
+	_ = a[1]
+	if a[1].Name < a[0].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort2xNamedReverse(a []Named) {
+	_ = a[1]
+	if a[0].Name < a[1].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3xNamed(a []Named) {
+	_ = a[2]
+	if a[2].Name < a[1].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[2].Name < a[0].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Name < a[0].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3xNamedReverse(a []Named) {
+	_ = a[2]
+	if a[1].Name < a[2].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0].Name < a[2].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0].Name < a[1].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort4xNamed(a []Named) {
+	_ = a[3]
+	if a[1].Name < a[0].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Name < a[2].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[2].Name < a[0].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[3].Name < a[1].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Name < a[1].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort4xNamedReverse(a []Named) {
+	_ = a[3]
+	if a[0].Name < a[1].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Name < a[3].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0].Name < a[2].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Name < a[3].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1].Name < a[2].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5xNamed(a []Named) {
+	_ = a[4]
+	if a[1].Name < a[0].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4].Name < a[3].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[4].Name < a[2].Name {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Name < a[2].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[3].Name < a[0].Name {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[2].Name < a[0].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Name < a[1].Name {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[3].Name < a[1].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Name < a[1].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5xNamedReverse(a []Named) {
+	_ = a[4]
+	if a[0].Name < a[1].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Name < a[4].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[2].Name < a[4].Name {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2].Name < a[3].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0].Name < a[3].Name {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[0].Name < a[2].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Name < a[4].Name {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[1].Name < a[3].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1].Name < a[2].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort6xNamed(a []Named) {
+	_ = a[5]
+	if a[2].Name < a[1].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[2].Name < a[0].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Name < a[0].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[5].Name < a[4].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[5].Name < a[3].Name {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Name < a[3].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[4].Name < a[0].Name {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3].Name < a[0].Name {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[5].Name < a[1].Name {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[5].Name < a[2].Name {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[3].Name < a[1].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[4].Name < a[2].Name {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Name < a[2].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort6xNamedReverse(a []Named) {
+	_ = a[5]
+	if a[1].Name < a[2].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0].Name < a[2].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0].Name < a[1].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4].Name < a[5].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3].Name < a[5].Name {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3].Name < a[4].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0].Name < a[4].Name {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0].Name < a[3].Name {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1].Name < a[5].Name {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2].Name < a[5].Name {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1].Name < a[3].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Name < a[4].Name {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2].Name < a[3].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7xNamed(a []Named) {
+	_ = a[6]
+	if a[2].Name < a[1].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[2].Name < a[0].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Name < a[0].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4].Name < a[3].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Name < a[5].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[5].Name < a[3].Name {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Name < a[4].Name {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5].Name < a[4].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[4].Name < a[0].Name {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3].Name < a[0].Name {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[5].Name < a[1].Name {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Name < a[2].Name {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[5].Name < a[2].Name {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[3].Name < a[1].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[4].Name < a[2].Name {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Name < a[2].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7xNamedReverse(a []Named) {
+	_ = a[6]
+	if a[1].Name < a[2].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0].Name < a[2].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0].Name < a[1].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Name < a[4].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Name < a[6].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3].Name < a[5].Name {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Name < a[6].Name {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4].Name < a[5].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[0].Name < a[4].Name {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0].Name < a[3].Name {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1].Name < a[5].Name {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2].Name < a[6].Name {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2].Name < a[5].Name {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1].Name < a[3].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Name < a[4].Name {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2].Name < a[3].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort8xNamed(a []Named) {
+	_ = a[7]
+	if a[1].Name < a[0].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Name < a[2].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[2].Name < a[0].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[3].Name < a[1].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Name < a[1].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Name < a[4].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Name < a[6].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[6].Name < a[4].Name {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[7].Name < a[5].Name {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[6].Name < a[5].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[4].Name < a[0].Name {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[5].Name < a[1].Name {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[4].Name < a[1].Name {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[6].Name < a[2].Name {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[7].Name < a[3].Name {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[6].Name < a[3].Name {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4].Name < a[2].Name {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Name < a[3].Name {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Name < a[3].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort8xNamedReverse(a []Named) {
+	_ = a[7]
+	if a[0].Name < a[1].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Name < a[3].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0].Name < a[2].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Name < a[3].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1].Name < a[2].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4].Name < a[5].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Name < a[7].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4].Name < a[6].Name {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5].Name < a[7].Name {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5].Name < a[6].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0].Name < a[4].Name {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1].Name < a[5].Name {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1].Name < a[4].Name {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2].Name < a[6].Name {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3].Name < a[7].Name {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3].Name < a[6].Name {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2].Name < a[4].Name {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Name < a[5].Name {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3].Name < a[4].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort9xNamed(a []Named) {
+	_ = a[8]
+	if a[6].Name < a[2].Name {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[5].Name < a[0].Name {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[4].Name < a[1].Name {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[8].Name < a[7].Name {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[7].Name < a[0].Name {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[2].Name < a[1].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Name < a[3].Name {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Name < a[4].Name {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Name < a[5].Name {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[3].Name < a[1].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[8].Name < a[6].Name {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[1].Name < a[0].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[5].Name < a[4].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Name < a[2].Name {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[7].Name < a[3].Name {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4].Name < a[3].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Name < a[5].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[2].Name < a[1].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3].Name < a[1].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Name < a[6].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[5].Name < a[4].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[4].Name < a[2].Name {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[6].Name < a[5].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3].Name < a[2].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Name < a[4].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort9xNamedReverse(a []Named) {
+	_ = a[8]
+	if a[2].Name < a[6].Name {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[0].Name < a[5].Name {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[1].Name < a[4].Name {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Name < a[8].Name {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[0].Name < a[7].Name {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[1].Name < a[2].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3].Name < a[5].Name {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Name < a[6].Name {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5].Name < a[8].Name {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[1].Name < a[3].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6].Name < a[8].Name {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[0].Name < a[1].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4].Name < a[5].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2].Name < a[7].Name {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[3].Name < a[7].Name {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3].Name < a[4].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Name < a[6].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[1].Name < a[2].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[1].Name < a[3].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6].Name < a[7].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4].Name < a[5].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2].Name < a[4].Name {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Name < a[6].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[2].Name < a[3].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Name < a[5].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort10xNamed(a []Named) {
+	_ = a[9]
+	if a[4].Name < a[1].Name {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[8].Name < a[7].Name {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[3].Name < a[2].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[6].Name < a[5].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Name < a[0].Name {
+		a[0], a[9] = a[9], a[0]
+	}
+	if a[5].Name < a[2].Name {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[7].Name < a[0].Name {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[9].Name < a[8].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[6].Name < a[3].Name {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[9].Name < a[4].Name {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[1].Name < a[0].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Name < a[0].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[9].Name < a[6].Name {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[5].Name < a[3].Name {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7].Name < a[4].Name {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[8].Name < a[1].Name {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[4].Name < a[3].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[8].Name < a[5].Name {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[7].Name < a[6].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[2].Name < a[1].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[8].Name < a[7].Name {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[3].Name < a[1].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Name < a[2].Name {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[6].Name < a[4].Name {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[3].Name < a[2].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[7].Name < a[6].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[5].Name < a[4].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[4].Name < a[3].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Name < a[5].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort10xNamedReverse(a []Named) {
+	_ = a[9]
+	if a[1].Name < a[4].Name {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Name < a[8].Name {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[2].Name < a[3].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Name < a[6].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0].Name < a[9].Name {
+		a[0], a[9] = a[9], a[0]
+	}
+	if a[2].Name < a[5].Name {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[0].Name < a[7].Name {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[8].Name < a[9].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3].Name < a[6].Name {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4].Name < a[9].Name {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[0].Name < a[1].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[0].Name < a[2].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Name < a[9].Name {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3].Name < a[5].Name {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Name < a[7].Name {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[1].Name < a[8].Name {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[3].Name < a[4].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Name < a[8].Name {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6].Name < a[7].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[1].Name < a[2].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[7].Name < a[8].Name {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[1].Name < a[3].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Name < a[5].Name {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[4].Name < a[6].Name {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[2].Name < a[3].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[6].Name < a[7].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4].Name < a[5].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3].Name < a[4].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Name < a[6].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort11xNamed(a []Named) {
+	_ = a[10]
+	if a[1].Name < a[0].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Name < a[2].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Name < a[4].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Name < a[6].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Name < a[8].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3].Name < a[1].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Name < a[5].Name {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[2].Name < a[0].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Name < a[4].Name {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Name < a[8].Name {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[2].Name < a[1].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[6].Name < a[5].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Name < a[9].Name {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[5].Name < a[1].Name {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Name < a[6].Name {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[9].Name < a[5].Name {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[6].Name < a[2].Name {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[5].Name < a[1].Name {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Name < a[6].Name {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[4].Name < a[0].Name {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7].Name < a[3].Name {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[8].Name < a[4].Name {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4].Name < a[0].Name {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[4].Name < a[1].Name {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[10].Name < a[7].Name {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[8].Name < a[3].Name {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[3].Name < a[2].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[9].Name < a[8].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[4].Name < a[2].Name {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[9].Name < a[7].Name {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[5].Name < a[3].Name {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[8].Name < a[6].Name {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[4].Name < a[3].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Name < a[5].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Name < a[7].Name {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort11xNamedReverse(a []Named) {
+	_ = a[10]
+	if a[0].Name < a[1].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Name < a[3].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Name < a[5].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Name < a[7].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Name < a[9].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[1].Name < a[3].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Name < a[7].Name {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[0].Name < a[2].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Name < a[6].Name {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Name < a[10].Name {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1].Name < a[2].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Name < a[6].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Name < a[10].Name {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1].Name < a[5].Name {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Name < a[10].Name {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5].Name < a[9].Name {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2].Name < a[6].Name {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1].Name < a[5].Name {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Name < a[10].Name {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0].Name < a[4].Name {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3].Name < a[7].Name {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4].Name < a[8].Name {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0].Name < a[4].Name {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1].Name < a[4].Name {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Name < a[10].Name {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3].Name < a[8].Name {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2].Name < a[3].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8].Name < a[9].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2].Name < a[4].Name {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7].Name < a[9].Name {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Name < a[5].Name {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Name < a[8].Name {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3].Name < a[4].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Name < a[6].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Name < a[8].Name {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort12xNamed(a []Named) {
+	_ = a[11]
+	if a[1].Name < a[0].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Name < a[2].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Name < a[4].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Name < a[6].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Name < a[8].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Name < a[10].Name {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[3].Name < a[1].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Name < a[5].Name {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[11].Name < a[9].Name {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[2].Name < a[0].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Name < a[4].Name {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Name < a[8].Name {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[2].Name < a[1].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[6].Name < a[5].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Name < a[9].Name {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[5].Name < a[1].Name {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Name < a[6].Name {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[9].Name < a[5].Name {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[6].Name < a[2].Name {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[5].Name < a[1].Name {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Name < a[6].Name {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[4].Name < a[0].Name {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[11].Name < a[7].Name {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[7].Name < a[3].Name {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[8].Name < a[4].Name {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4].Name < a[0].Name {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[11].Name < a[7].Name {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[4].Name < a[1].Name {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[10].Name < a[7].Name {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[8].Name < a[3].Name {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[3].Name < a[2].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[9].Name < a[8].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[4].Name < a[2].Name {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[9].Name < a[7].Name {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[5].Name < a[3].Name {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[8].Name < a[6].Name {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[4].Name < a[3].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Name < a[5].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Name < a[7].Name {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort12xNamedReverse(a []Named) {
+	_ = a[11]
+	if a[0].Name < a[1].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Name < a[3].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Name < a[5].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Name < a[7].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Name < a[9].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Name < a[11].Name {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1].Name < a[3].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Name < a[7].Name {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9].Name < a[11].Name {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0].Name < a[2].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Name < a[6].Name {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Name < a[10].Name {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1].Name < a[2].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Name < a[6].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Name < a[10].Name {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1].Name < a[5].Name {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Name < a[10].Name {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5].Name < a[9].Name {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2].Name < a[6].Name {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1].Name < a[5].Name {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Name < a[10].Name {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0].Name < a[4].Name {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7].Name < a[11].Name {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[3].Name < a[7].Name {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4].Name < a[8].Name {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0].Name < a[4].Name {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7].Name < a[11].Name {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1].Name < a[4].Name {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Name < a[10].Name {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3].Name < a[8].Name {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2].Name < a[3].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8].Name < a[9].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2].Name < a[4].Name {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7].Name < a[9].Name {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Name < a[5].Name {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Name < a[8].Name {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3].Name < a[4].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Name < a[6].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Name < a[8].Name {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort13xNamed(a []Named) {
+	_ = a[12]
+	if a[7].Name < a[1].Name {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[11].Name < a[9].Name {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[4].Name < a[3].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[8].Name < a[5].Name {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[12].Name < a[0].Name {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[6].Name < a[2].Name {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1].Name < a[0].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Name < a[2].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[6].Name < a[4].Name {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[11].Name < a[8].Name {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[12].Name < a[7].Name {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[9].Name < a[5].Name {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2].Name < a[0].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[7].Name < a[3].Name {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[11].Name < a[10].Name {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[4].Name < a[1].Name {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[12].Name < a[6].Name {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[8].Name < a[7].Name {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[12].Name < a[11].Name {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[9].Name < a[4].Name {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[10].Name < a[6].Name {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[4].Name < a[3].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Name < a[5].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Name < a[8].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Name < a[10].Name {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[7].Name < a[1].Name {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[6].Name < a[2].Name {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[11].Name < a[9].Name {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[3].Name < a[1].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Name < a[4].Name {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[10].Name < a[8].Name {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[5].Name < a[0].Name {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[5].Name < a[2].Name {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[8].Name < a[6].Name {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10].Name < a[9].Name {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[2].Name < a[1].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Name < a[3].Name {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[8].Name < a[7].Name {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[6].Name < a[4].Name {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[3].Name < a[2].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Name < a[4].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Name < a[6].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Name < a[8].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[4].Name < a[3].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Name < a[5].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort13xNamedReverse(a []Named) {
+	_ = a[12]
+	if a[1].Name < a[7].Name {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[9].Name < a[11].Name {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[3].Name < a[4].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Name < a[8].Name {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[0].Name < a[12].Name {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[2].Name < a[6].Name {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[0].Name < a[1].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Name < a[3].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Name < a[6].Name {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Name < a[11].Name {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[7].Name < a[12].Name {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[5].Name < a[9].Name {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[0].Name < a[2].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[3].Name < a[7].Name {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[10].Name < a[11].Name {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1].Name < a[4].Name {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[6].Name < a[12].Name {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[7].Name < a[8].Name {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[11].Name < a[12].Name {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[4].Name < a[9].Name {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[6].Name < a[10].Name {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[3].Name < a[4].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Name < a[6].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Name < a[9].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Name < a[11].Name {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1].Name < a[7].Name {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[2].Name < a[6].Name {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[9].Name < a[11].Name {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[1].Name < a[3].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[4].Name < a[7].Name {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[8].Name < a[10].Name {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[0].Name < a[5].Name {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[2].Name < a[5].Name {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[6].Name < a[8].Name {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[9].Name < a[10].Name {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1].Name < a[2].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3].Name < a[5].Name {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7].Name < a[8].Name {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[4].Name < a[6].Name {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[2].Name < a[3].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Name < a[5].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Name < a[7].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Name < a[9].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3].Name < a[4].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Name < a[6].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort14xNamed(a []Named) {
+	_ = a[13]
+	if a[1].Name < a[0].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Name < a[2].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Name < a[4].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Name < a[6].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Name < a[8].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Name < a[10].Name {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[13].Name < a[12].Name {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[2].Name < a[0].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Name < a[4].Name {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Name < a[8].Name {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[3].Name < a[1].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Name < a[5].Name {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[11].Name < a[9].Name {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[4].Name < a[0].Name {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[12].Name < a[8].Name {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[5].Name < a[1].Name {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[13].Name < a[9].Name {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[6].Name < a[2].Name {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[7].Name < a[3].Name {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[8].Name < a[0].Name {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[9].Name < a[1].Name {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[10].Name < a[2].Name {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[11].Name < a[3].Name {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[12].Name < a[4].Name {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[13].Name < a[5].Name {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[10].Name < a[5].Name {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[9].Name < a[6].Name {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[12].Name < a[3].Name {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[11].Name < a[7].Name {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[2].Name < a[1].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[8].Name < a[4].Name {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4].Name < a[1].Name {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[13].Name < a[7].Name {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[8].Name < a[2].Name {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[4].Name < a[2].Name {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[6].Name < a[5].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Name < a[9].Name {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[13].Name < a[11].Name {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[8].Name < a[3].Name {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[12].Name < a[7].Name {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[8].Name < a[6].Name {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[12].Name < a[10].Name {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[5].Name < a[3].Name {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[9].Name < a[7].Name {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[4].Name < a[3].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Name < a[5].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Name < a[7].Name {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[10].Name < a[9].Name {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12].Name < a[11].Name {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[7].Name < a[6].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Name < a[8].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort14xNamedReverse(a []Named) {
+	_ = a[13]
+	if a[0].Name < a[1].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Name < a[3].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Name < a[5].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Name < a[7].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Name < a[9].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Name < a[11].Name {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12].Name < a[13].Name {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[0].Name < a[2].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Name < a[6].Name {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Name < a[10].Name {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1].Name < a[3].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Name < a[7].Name {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9].Name < a[11].Name {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0].Name < a[4].Name {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8].Name < a[12].Name {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1].Name < a[5].Name {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9].Name < a[13].Name {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2].Name < a[6].Name {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3].Name < a[7].Name {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[0].Name < a[8].Name {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1].Name < a[9].Name {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2].Name < a[10].Name {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3].Name < a[11].Name {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4].Name < a[12].Name {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5].Name < a[13].Name {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[5].Name < a[10].Name {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6].Name < a[9].Name {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3].Name < a[12].Name {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[7].Name < a[11].Name {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1].Name < a[2].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4].Name < a[8].Name {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1].Name < a[4].Name {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Name < a[13].Name {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2].Name < a[8].Name {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[2].Name < a[4].Name {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Name < a[6].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Name < a[10].Name {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Name < a[13].Name {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3].Name < a[8].Name {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7].Name < a[12].Name {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6].Name < a[8].Name {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10].Name < a[12].Name {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3].Name < a[5].Name {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7].Name < a[9].Name {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Name < a[4].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Name < a[6].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Name < a[8].Name {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9].Name < a[10].Name {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Name < a[12].Name {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6].Name < a[7].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Name < a[9].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort15xNamed(a []Named) {
+	_ = a[14]
+	if a[1].Name < a[0].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Name < a[2].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Name < a[4].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Name < a[6].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Name < a[8].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Name < a[10].Name {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[13].Name < a[12].Name {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[2].Name < a[0].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Name < a[4].Name {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Name < a[8].Name {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[14].Name < a[12].Name {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[3].Name < a[1].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Name < a[5].Name {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[11].Name < a[9].Name {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[4].Name < a[0].Name {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[12].Name < a[8].Name {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[5].Name < a[1].Name {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[13].Name < a[9].Name {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[6].Name < a[2].Name {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[14].Name < a[10].Name {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[7].Name < a[3].Name {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[8].Name < a[0].Name {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[9].Name < a[1].Name {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[10].Name < a[2].Name {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[11].Name < a[3].Name {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[12].Name < a[4].Name {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[13].Name < a[5].Name {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[14].Name < a[6].Name {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[10].Name < a[5].Name {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[9].Name < a[6].Name {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[12].Name < a[3].Name {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[14].Name < a[13].Name {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[11].Name < a[7].Name {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[2].Name < a[1].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[8].Name < a[4].Name {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4].Name < a[1].Name {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[13].Name < a[7].Name {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[8].Name < a[2].Name {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[14].Name < a[11].Name {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[4].Name < a[2].Name {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[6].Name < a[5].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Name < a[9].Name {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[13].Name < a[11].Name {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[8].Name < a[3].Name {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[12].Name < a[7].Name {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[8].Name < a[6].Name {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[12].Name < a[10].Name {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[5].Name < a[3].Name {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[9].Name < a[7].Name {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[4].Name < a[3].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Name < a[5].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Name < a[7].Name {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[10].Name < a[9].Name {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12].Name < a[11].Name {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[7].Name < a[6].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Name < a[8].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort15xNamedReverse(a []Named) {
+	_ = a[14]
+	if a[0].Name < a[1].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Name < a[3].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Name < a[5].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Name < a[7].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Name < a[9].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Name < a[11].Name {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12].Name < a[13].Name {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[0].Name < a[2].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Name < a[6].Name {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Name < a[10].Name {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[12].Name < a[14].Name {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[1].Name < a[3].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Name < a[7].Name {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9].Name < a[11].Name {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0].Name < a[4].Name {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8].Name < a[12].Name {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1].Name < a[5].Name {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9].Name < a[13].Name {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2].Name < a[6].Name {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[10].Name < a[14].Name {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[3].Name < a[7].Name {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[0].Name < a[8].Name {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1].Name < a[9].Name {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2].Name < a[10].Name {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3].Name < a[11].Name {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4].Name < a[12].Name {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5].Name < a[13].Name {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6].Name < a[14].Name {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[5].Name < a[10].Name {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6].Name < a[9].Name {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3].Name < a[12].Name {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[13].Name < a[14].Name {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[7].Name < a[11].Name {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1].Name < a[2].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4].Name < a[8].Name {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1].Name < a[4].Name {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Name < a[13].Name {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2].Name < a[8].Name {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[11].Name < a[14].Name {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[2].Name < a[4].Name {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Name < a[6].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Name < a[10].Name {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Name < a[13].Name {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3].Name < a[8].Name {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7].Name < a[12].Name {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6].Name < a[8].Name {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10].Name < a[12].Name {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3].Name < a[5].Name {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7].Name < a[9].Name {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Name < a[4].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Name < a[6].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Name < a[8].Name {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9].Name < a[10].Name {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Name < a[12].Name {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6].Name < a[7].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Name < a[9].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort16xNamed(a []Named) {
+	_ = a[15]
+	if a[1].Name < a[0].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Name < a[2].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Name < a[4].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Name < a[6].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Name < a[8].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Name < a[10].Name {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[13].Name < a[12].Name {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[15].Name < a[14].Name {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[2].Name < a[0].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Name < a[4].Name {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Name < a[8].Name {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[14].Name < a[12].Name {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[3].Name < a[1].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Name < a[5].Name {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[11].Name < a[9].Name {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[15].Name < a[13].Name {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[4].Name < a[0].Name {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[12].Name < a[8].Name {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[5].Name < a[1].Name {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[13].Name < a[9].Name {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[6].Name < a[2].Name {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[14].Name < a[10].Name {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[7].Name < a[3].Name {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[15].Name < a[11].Name {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[8].Name < a[0].Name {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[9].Name < a[1].Name {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[10].Name < a[2].Name {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[11].Name < a[3].Name {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[12].Name < a[4].Name {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[13].Name < a[5].Name {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[14].Name < a[6].Name {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[15].Name < a[7].Name {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[10].Name < a[5].Name {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[9].Name < a[6].Name {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[12].Name < a[3].Name {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[14].Name < a[13].Name {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[11].Name < a[7].Name {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[2].Name < a[1].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[8].Name < a[4].Name {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4].Name < a[1].Name {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[13].Name < a[7].Name {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[8].Name < a[2].Name {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[14].Name < a[11].Name {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[4].Name < a[2].Name {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[6].Name < a[5].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Name < a[9].Name {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[13].Name < a[11].Name {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[8].Name < a[3].Name {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[12].Name < a[7].Name {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[8].Name < a[6].Name {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[12].Name < a[10].Name {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[5].Name < a[3].Name {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[9].Name < a[7].Name {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[4].Name < a[3].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Name < a[5].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Name < a[7].Name {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[10].Name < a[9].Name {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12].Name < a[11].Name {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[7].Name < a[6].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Name < a[8].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort16xNamedReverse(a []Named) {
+	_ = a[15]
+	if a[0].Name < a[1].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Name < a[3].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Name < a[5].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Name < a[7].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Name < a[9].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Name < a[11].Name {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12].Name < a[13].Name {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14].Name < a[15].Name {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[0].Name < a[2].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Name < a[6].Name {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Name < a[10].Name {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[12].Name < a[14].Name {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[1].Name < a[3].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Name < a[7].Name {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9].Name < a[11].Name {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[13].Name < a[15].Name {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[0].Name < a[4].Name {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8].Name < a[12].Name {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1].Name < a[5].Name {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9].Name < a[13].Name {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2].Name < a[6].Name {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[10].Name < a[14].Name {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[3].Name < a[7].Name {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[11].Name < a[15].Name {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[0].Name < a[8].Name {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1].Name < a[9].Name {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2].Name < a[10].Name {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3].Name < a[11].Name {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4].Name < a[12].Name {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5].Name < a[13].Name {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6].Name < a[14].Name {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7].Name < a[15].Name {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[5].Name < a[10].Name {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6].Name < a[9].Name {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3].Name < a[12].Name {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[13].Name < a[14].Name {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[7].Name < a[11].Name {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1].Name < a[2].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4].Name < a[8].Name {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1].Name < a[4].Name {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Name < a[13].Name {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2].Name < a[8].Name {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[11].Name < a[14].Name {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[2].Name < a[4].Name {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Name < a[6].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Name < a[10].Name {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Name < a[13].Name {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3].Name < a[8].Name {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7].Name < a[12].Name {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6].Name < a[8].Name {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10].Name < a[12].Name {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3].Name < a[5].Name {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7].Name < a[9].Name {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Name < a[4].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Name < a[6].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Name < a[8].Name {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9].Name < a[10].Name {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Name < a[12].Name {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6].Name < a[7].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Name < a[9].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort24xNamed(a []Named) {
+	_ = a[23]
+	if a[1].Name < a[0].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Name < a[2].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Name < a[4].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Name < a[6].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Name < a[8].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Name < a[10].Name {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[13].Name < a[12].Name {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[15].Name < a[14].Name {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[17].Name < a[16].Name {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[19].Name < a[18].Name {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[21].Name < a[20].Name {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[23].Name < a[22].Name {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[3].Name < a[1].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Name < a[5].Name {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[11].Name < a[9].Name {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[2].Name < a[0].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Name < a[4].Name {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Name < a[8].Name {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[15].Name < a[13].Name {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[19].Name < a[17].Name {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[23].Name < a[21].Name {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[14].Name < a[12].Name {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[18].Name < a[16].Name {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[22].Name < a[20].Name {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[2].Name < a[1].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[6].Name < a[5].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Name < a[9].Name {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[14].Name < a[13].Name {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[18].Name < a[17].Name {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[22].Name < a[21].Name {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[5].Name < a[1].Name {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Name < a[6].Name {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[17].Name < a[13].Name {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[22].Name < a[18].Name {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[9].Name < a[5].Name {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[6].Name < a[2].Name {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[21].Name < a[17].Name {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[18].Name < a[14].Name {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[5].Name < a[1].Name {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Name < a[6].Name {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[4].Name < a[0].Name {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[11].Name < a[7].Name {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[17].Name < a[13].Name {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[22].Name < a[18].Name {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[16].Name < a[12].Name {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[23].Name < a[19].Name {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[7].Name < a[3].Name {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[8].Name < a[4].Name {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[19].Name < a[15].Name {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[20].Name < a[16].Name {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[4].Name < a[0].Name {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[11].Name < a[7].Name {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[16].Name < a[12].Name {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[23].Name < a[19].Name {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[4].Name < a[1].Name {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[10].Name < a[7].Name {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[8].Name < a[3].Name {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[16].Name < a[13].Name {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[22].Name < a[19].Name {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[20].Name < a[15].Name {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[3].Name < a[2].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[9].Name < a[8].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[15].Name < a[14].Name {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[21].Name < a[20].Name {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[4].Name < a[2].Name {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[9].Name < a[7].Name {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[5].Name < a[3].Name {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[8].Name < a[6].Name {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[16].Name < a[14].Name {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[21].Name < a[19].Name {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[17].Name < a[15].Name {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[20].Name < a[18].Name {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[4].Name < a[3].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Name < a[5].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Name < a[7].Name {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[16].Name < a[15].Name {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[18].Name < a[17].Name {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[20].Name < a[19].Name {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[12].Name < a[0].Name {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[13].Name < a[1].Name {
+		a[1], a[13] = a[13], a[1]
+	}
+	if a[14].Name < a[2].Name {
+		a[2], a[14] = a[14], a[2]
+	}
+	if a[15].Name < a[3].Name {
+		a[3], a[15] = a[15], a[3]
+	}
+	if a[16].Name < a[4].Name {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[17].Name < a[5].Name {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[18].Name < a[6].Name {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[19].Name < a[7].Name {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[20].Name < a[8].Name {
+		a[8], a[20] = a[20], a[8]
+	}
+	if a[21].Name < a[9].Name {
+		a[9], a[21] = a[21], a[9]
+	}
+	if a[22].Name < a[10].Name {
+		a[10], a[22] = a[22], a[10]
+	}
+	if a[23].Name < a[11].Name {
+		a[11], a[23] = a[23], a[11]
+	}
+	if a[12].Name < a[2].Name {
+		a[2], a[12] = a[12], a[2]
+	}
+	if a[13].Name < a[3].Name {
+		a[3], a[13] = a[13], a[3]
+	}
+	if a[20].Name < a[10].Name {
+		a[10], a[20] = a[20], a[10]
+	}
+	if a[21].Name < a[11].Name {
+		a[11], a[21] = a[21], a[11]
+	}
+	if a[12].Name < a[4].Name {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[13].Name < a[5].Name {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[14].Name < a[6].Name {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[15].Name < a[7].Name {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[16].Name < a[8].Name {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[17].Name < a[9].Name {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[18].Name < a[10].Name {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[19].Name < a[11].Name {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[12].Name < a[8].Name {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[13].Name < a[9].Name {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[14].Name < a[10].Name {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[15].Name < a[11].Name {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[8].Name < a[6].Name {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[12].Name < a[10].Name {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[16].Name < a[14].Name {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[9].Name < a[7].Name {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[13].Name < a[11].Name {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[17].Name < a[15].Name {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[2].Name < a[1].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4].Name < a[3].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Name < a[5].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Name < a[7].Name {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[10].Name < a[9].Name {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12].Name < a[11].Name {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[14].Name < a[13].Name {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[16].Name < a[15].Name {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[18].Name < a[17].Name {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[20].Name < a[19].Name {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[22].Name < a[21].Name {
+		a[21], a[22] = a[22], a[21]
+	}
+}
+
+func NetworkSort24xNamedReverse(a []Named) {
+	_ = a[23]
+	if a[0].Name < a[1].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Name < a[3].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Name < a[5].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Name < a[7].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Name < a[9].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Name < a[11].Name {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12].Name < a[13].Name {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14].Name < a[15].Name {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[16].Name < a[17].Name {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[18].Name < a[19].Name {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[20].Name < a[21].Name {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[22].Name < a[23].Name {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[1].Name < a[3].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Name < a[7].Name {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9].Name < a[11].Name {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0].Name < a[2].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Name < a[6].Name {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Name < a[10].Name {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[13].Name < a[15].Name {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[17].Name < a[19].Name {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[21].Name < a[23].Name {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[12].Name < a[14].Name {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[16].Name < a[18].Name {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[20].Name < a[22].Name {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[1].Name < a[2].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Name < a[6].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Name < a[10].Name {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[13].Name < a[14].Name {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[17].Name < a[18].Name {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[21].Name < a[22].Name {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[1].Name < a[5].Name {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Name < a[10].Name {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[13].Name < a[17].Name {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[18].Name < a[22].Name {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[5].Name < a[9].Name {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2].Name < a[6].Name {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[17].Name < a[21].Name {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[14].Name < a[18].Name {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[1].Name < a[5].Name {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Name < a[10].Name {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0].Name < a[4].Name {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7].Name < a[11].Name {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[13].Name < a[17].Name {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[18].Name < a[22].Name {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[12].Name < a[16].Name {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[19].Name < a[23].Name {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[3].Name < a[7].Name {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4].Name < a[8].Name {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[15].Name < a[19].Name {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[16].Name < a[20].Name {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[0].Name < a[4].Name {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7].Name < a[11].Name {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[12].Name < a[16].Name {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[19].Name < a[23].Name {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[1].Name < a[4].Name {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Name < a[10].Name {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3].Name < a[8].Name {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[13].Name < a[16].Name {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[19].Name < a[22].Name {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[15].Name < a[20].Name {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[2].Name < a[3].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8].Name < a[9].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[14].Name < a[15].Name {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[20].Name < a[21].Name {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[2].Name < a[4].Name {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7].Name < a[9].Name {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Name < a[5].Name {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Name < a[8].Name {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[14].Name < a[16].Name {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[19].Name < a[21].Name {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[15].Name < a[17].Name {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[18].Name < a[20].Name {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[3].Name < a[4].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Name < a[6].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Name < a[8].Name {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[15].Name < a[16].Name {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[17].Name < a[18].Name {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[19].Name < a[20].Name {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[0].Name < a[12].Name {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[1].Name < a[13].Name {
+		a[1], a[13] = a[13], a[1]
+	}
+	if a[2].Name < a[14].Name {
+		a[2], a[14] = a[14], a[2]
+	}
+	if a[3].Name < a[15].Name {
+		a[3], a[15] = a[15], a[3]
+	}
+	if a[4].Name < a[16].Name {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[5].Name < a[17].Name {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[6].Name < a[18].Name {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[7].Name < a[19].Name {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[8].Name < a[20].Name {
+		a[8], a[20] = a[20], a[8]
+	}
+	if a[9].Name < a[21].Name {
+		a[9], a[21] = a[21], a[9]
+	}
+	if a[10].Name < a[22].Name {
+		a[10], a[22] = a[22], a[10]
+	}
+	if a[11].Name < a[23].Name {
+		a[11], a[23] = a[23], a[11]
+	}
+	if a[2].Name < a[12].Name {
+		a[2], a[12] = a[12], a[2]
+	}
+	if a[3].Name < a[13].Name {
+		a[3], a[13] = a[13], a[3]
+	}
+	if a[10].Name < a[20].Name {
+		a[10], a[20] = a[20], a[10]
+	}
+	if a[11].Name < a[21].Name {
+		a[11], a[21] = a[21], a[11]
+	}
+	if a[4].Name < a[12].Name {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5].Name < a[13].Name {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6].Name < a[14].Name {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7].Name < a[15].Name {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[8].Name < a[16].Name {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[9].Name < a[17].Name {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[10].Name < a[18].Name {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[11].Name < a[19].Name {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[8].Name < a[12].Name {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9].Name < a[13].Name {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[10].Name < a[14].Name {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11].Name < a[15].Name {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[6].Name < a[8].Name {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10].Name < a[12].Name {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[14].Name < a[16].Name {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[7].Name < a[9].Name {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[11].Name < a[13].Name {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[15].Name < a[17].Name {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[1].Name < a[2].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3].Name < a[4].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Name < a[6].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Name < a[8].Name {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9].Name < a[10].Name {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Name < a[12].Name {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[13].Name < a[14].Name {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[15].Name < a[16].Name {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[17].Name < a[18].Name {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[19].Name < a[20].Name {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[21].Name < a[22].Name {
+		a[21], a[22] = a[22], a[21]
+	}
+}
+
+func NetworkSort32xNamed(a []Named) {
+	_ = a[31]
+	if a[1].Name < a[0].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Name < a[2].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[2].Name < a[0].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[3].Name < a[1].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Name < a[1].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Name < a[4].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Name < a[6].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[6].Name < a[4].Name {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[7].Name < a[5].Name {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[6].Name < a[5].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[4].Name < a[0].Name {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[5].Name < a[1].Name {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[4].Name < a[1].Name {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[6].Name < a[2].Name {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[7].Name < a[3].Name {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[6].Name < a[3].Name {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4].Name < a[2].Name {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Name < a[3].Name {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Name < a[3].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[9].Name < a[8].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Name < a[10].Name {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[10].Name < a[8].Name {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[11].Name < a[9].Name {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[10].Name < a[9].Name {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[13].Name < a[12].Name {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[15].Name < a[14].Name {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[14].Name < a[12].Name {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[15].Name < a[13].Name {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[14].Name < a[13].Name {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[12].Name < a[8].Name {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[13].Name < a[9].Name {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[12].Name < a[9].Name {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[14].Name < a[10].Name {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[15].Name < a[11].Name {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[14].Name < a[11].Name {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[12].Name < a[10].Name {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[13].Name < a[11].Name {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[12].Name < a[11].Name {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[8].Name < a[0].Name {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[9].Name < a[1].Name {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[8].Name < a[1].Name {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[10].Name < a[2].Name {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[11].Name < a[3].Name {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[10].Name < a[3].Name {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[8].Name < a[2].Name {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[9].Name < a[3].Name {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[8].Name < a[3].Name {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[12].Name < a[4].Name {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[13].Name < a[5].Name {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[12].Name < a[5].Name {
+		a[5], a[12] = a[12], a[5]
+	}
+	if a[14].Name < a[6].Name {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[15].Name < a[7].Name {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[14].Name < a[7].Name {
+		a[7], a[14] = a[14], a[7]
+	}
+	if a[12].Name < a[6].Name {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[13].Name < a[7].Name {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[12].Name < a[7].Name {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[8].Name < a[4].Name {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[9].Name < a[5].Name {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[8].Name < a[5].Name {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[10].Name < a[6].Name {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[11].Name < a[7].Name {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[10].Name < a[7].Name {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[8].Name < a[6].Name {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[9].Name < a[7].Name {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[8].Name < a[7].Name {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[17].Name < a[16].Name {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[19].Name < a[18].Name {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[18].Name < a[16].Name {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[19].Name < a[17].Name {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[18].Name < a[17].Name {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[21].Name < a[20].Name {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[23].Name < a[22].Name {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[22].Name < a[20].Name {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[23].Name < a[21].Name {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[22].Name < a[21].Name {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[20].Name < a[16].Name {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[21].Name < a[17].Name {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[20].Name < a[17].Name {
+		a[17], a[20] = a[20], a[17]
+	}
+	if a[22].Name < a[18].Name {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[23].Name < a[19].Name {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[22].Name < a[19].Name {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[20].Name < a[18].Name {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[21].Name < a[19].Name {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[20].Name < a[19].Name {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[25].Name < a[24].Name {
+		a[24], a[25] = a[25], a[24]
+	}
+	if a[27].Name < a[26].Name {
+		a[26], a[27] = a[27], a[26]
+	}
+	if a[26].Name < a[24].Name {
+		a[24], a[26] = a[26], a[24]
+	}
+	if a[27].Name < a[25].Name {
+		a[25], a[27] = a[27], a[25]
+	}
+	if a[26].Name < a[25].Name {
+		a[25], a[26] = a[26], a[25]
+	}
+	if a[29].Name < a[28].Name {
+		a[28], a[29] = a[29], a[28]
+	}
+	if a[31].Name < a[30].Name {
+		a[30], a[31] = a[31], a[30]
+	}
+	if a[30].Name < a[28].Name {
+		a[28], a[30] = a[30], a[28]
+	}
+	if a[31].Name < a[29].Name {
+		a[29], a[31] = a[31], a[29]
+	}
+	if a[30].Name < a[29].Name {
+		a[29], a[30] = a[30], a[29]
+	}
+	if a[28].Name < a[24].Name {
+		a[24], a[28] = a[28], a[24]
+	}
+	if a[29].Name < a[25].Name {
+		a[25], a[29] = a[29], a[25]
+	}
+	if a[28].Name < a[25].Name {
+		a[25], a[28] = a[28], a[25]
+	}
+	if a[30].Name < a[26].Name {
+		a[26], a[30] = a[30], a[26]
+	}
+	if a[31].Name < a[27].Name {
+		a[27], a[31] = a[31], a[27]
+	}
+	if a[30].Name < a[27].Name {
+		a[27], a[30] = a[30], a[27]
+	}
+	if a[28].Name < a[26].Name {
+		a[26], a[28] = a[28], a[26]
+	}
+	if a[29].Name < a[27].Name {
+		a[27], a[29] = a[29], a[27]
+	}
+	if a[28].Name < a[27].Name {
+		a[27], a[28] = a[28], a[27]
+	}
+	if a[24].Name < a[16].Name {
+		a[16], a[24] = a[24], a[16]
+	}
+	if a[25].Name < a[17].Name {
+		a[17], a[25] = a[25], a[17]
+	}
+	if a[24].Name < a[17].Name {
+		a[17], a[24] = a[24], a[17]
+	}
+	if a[26].Name < a[18].Name {
+		a[18], a[26] = a[26], a[18]
+	}
+	if a[27].Name < a[19].Name {
+		a[19], a[27] = a[27], a[19]
+	}
+	if a[26].Name < a[19].Name {
+		a[19], a[26] = a[26], a[19]
+	}
+	if a[24].Name < a[18].Name {
+		a[18], a[24] = a[24], a[18]
+	}
+	if a[25].Name < a[19].Name {
+		a[19], a[25] = a[25], a[19]
+	}
+	if a[24].Name < a[19].Name {
+		a[19], a[24] = a[24], a[19]
+	}
+	if a[28].Name < a[20].Name {
+		a[20], a[28] = a[28], a[20]
+	}
+	if a[29].Name < a[21].Name {
+		a[21], a[29] = a[29], a[21]
+	}
+	if a[28].Name < a[21].Name {
+		a[21], a[28] = a[28], a[21]
+	}
+	if a[30].Name < a[22].Name {
+		a[22], a[30] = a[30], a[22]
+	}
+	if a[31].Name < a[23].Name {
+		a[23], a[31] = a[31], a[23]
+	}
+	if a[30].Name < a[23].Name {
+		a[23], a[30] = a[30], a[23]
+	}
+	if a[28].Name < a[22].Name {
+		a[22], a[28] = a[28], a[22]
+	}
+	if a[29].Name < a[23].Name {
+		a[23], a[29] = a[29], a[23]
+	}
+	if a[28].Name < a[23].Name {
+		a[23], a[28] = a[28], a[23]
+	}
+	if a[24].Name < a[20].Name {
+		a[20], a[24] = a[24], a[20]
+	}
+	if a[25].Name < a[21].Name {
+		a[21], a[25] = a[25], a[21]
+	}
+	if a[24].Name < a[21].Name {
+		a[21], a[24] = a[24], a[21]
+	}
+	if a[26].Name < a[22].Name {
+		a[22], a[26] = a[26], a[22]
+	}
+	if a[27].Name < a[23].Name {
+		a[23], a[27] = a[27], a[23]
+	}
+	if a[26].Name < a[23].Name {
+		a[23], a[26] = a[26], a[23]
+	}
+	if a[24].Name < a[22].Name {
+		a[22], a[24] = a[24], a[22]
+	}
+	if a[25].Name < a[23].Name {
+		a[23], a[25] = a[25], a[23]
+	}
+	if a[24].Name < a[23].Name {
+		a[23], a[24] = a[24], a[23]
+	}
+	if a[16].Name < a[0].Name {
+		a[0], a[16] = a[16], a[0]
+	}
+	if a[17].Name < a[1].Name {
+		a[1], a[17] = a[17], a[1]
+	}
+	if a[16].Name < a[1].Name {
+		a[1], a[16] = a[16], a[1]
+	}
+	if a[18].Name < a[2].Name {
+		a[2], a[18] = a[18], a[2]
+	}
+	if a[19].Name < a[3].Name {
+		a[3], a[19] = a[19], a[3]
+	}
+	if a[18].Name < a[3].Name {
+		a[3], a[18] = a[18], a[3]
+	}
+	if a[16].Name < a[2].Name {
+		a[2], a[16] = a[16], a[2]
+	}
+	if a[17].Name < a[3].Name {
+		a[3], a[17] = a[17], a[3]
+	}
+	if a[16].Name < a[3].Name {
+		a[3], a[16] = a[16], a[3]
+	}
+	if a[20].Name < a[4].Name {
+		a[4], a[20] = a[20], a[4]
+	}
+	if a[21].Name < a[5].Name {
+		a[5], a[21] = a[21], a[5]
+	}
+	if a[20].Name < a[5].Name {
+		a[5], a[20] = a[20], a[5]
+	}
+	if a[22].Name < a[6].Name {
+		a[6], a[22] = a[22], a[6]
+	}
+	if a[23].Name < a[7].Name {
+		a[7], a[23] = a[23], a[7]
+	}
+	if a[22].Name < a[7].Name {
+		a[7], a[22] = a[22], a[7]
+	}
+	if a[20].Name < a[6].Name {
+		a[6], a[20] = a[20], a[6]
+	}
+	if a[21].Name < a[7].Name {
+		a[7], a[21] = a[21], a[7]
+	}
+	if a[20].Name < a[7].Name {
+		a[7], a[20] = a[20], a[7]
+	}
+	if a[16].Name < a[4].Name {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[17].Name < a[5].Name {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[16].Name < a[5].Name {
+		a[5], a[16] = a[16], a[5]
+	}
+	if a[18].Name < a[6].Name {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[19].Name < a[7].Name {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[18].Name < a[7].Name {
+		a[7], a[18] = a[18], a[7]
+	}
+	if a[16].Name < a[6].Name {
+		a[6], a[16] = a[16], a[6]
+	}
+	if a[17].Name < a[7].Name {
+		a[7], a[17] = a[17], a[7]
+	}
+	if a[16].Name < a[7].Name {
+		a[7], a[16] = a[16], a[7]
+	}
+	if a[24].Name < a[8].Name {
+		a[8], a[24] = a[24], a[8]
+	}
+	if a[25].Name < a[9].Name {
+		a[9], a[25] = a[25], a[9]
+	}
+	if a[24].Name < a[9].Name {
+		a[9], a[24] = a[24], a[9]
+	}
+	if a[26].Name < a[10].Name {
+		a[10], a[26] = a[26], a[10]
+	}
+	if a[27].Name < a[11].Name {
+		a[11], a[27] = a[27], a[11]
+	}
+	if a[26].Name < a[11].Name {
+		a[11], a[26] = a[26], a[11]
+	}
+	if a[24].Name < a[10].Name {
+		a[10], a[24] = a[24], a[10]
+	}
+	if a[25].Name < a[11].Name {
+		a[11], a[25] = a[25], a[11]
+	}
+	if a[24].Name < a[11].Name {
+		a[11], a[24] = a[24], a[11]
+	}
+	if a[28].Name < a[12].Name {
+		a[12], a[28] = a[28], a[12]
+	}
+	if a[29].Name < a[13].Name {
+		a[13], a[29] = a[29], a[13]
+	}
+	if a[28].Name < a[13].Name {
+		a[13], a[28] = a[28], a[13]
+	}
+	if a[30].Name < a[14].Name {
+		a[14], a[30] = a[30], a[14]
+	}
+	if a[31].Name < a[15].Name {
+		a[15], a[31] = a[31], a[15]
+	}
+	if a[30].Name < a[15].Name {
+		a[15], a[30] = a[30], a[15]
+	}
+	if a[28].Name < a[14].Name {
+		a[14], a[28] = a[28], a[14]
+	}
+	if a[29].Name < a[15].Name {
+		a[15], a[29] = a[29], a[15]
+	}
+	if a[28].Name < a[15].Name {
+		a[15], a[28] = a[28], a[15]
+	}
+	if a[24].Name < a[12].Name {
+		a[12], a[24] = a[24], a[12]
+	}
+	if a[25].Name < a[13].Name {
+		a[13], a[25] = a[25], a[13]
+	}
+	if a[24].Name < a[13].Name {
+		a[13], a[24] = a[24], a[13]
+	}
+	if a[26].Name < a[14].Name {
+		a[14], a[26] = a[26], a[14]
+	}
+	if a[27].Name < a[15].Name {
+		a[15], a[27] = a[27], a[15]
+	}
+	if a[26].Name < a[15].Name {
+		a[15], a[26] = a[26], a[15]
+	}
+	if a[24].Name < a[14].Name {
+		a[14], a[24] = a[24], a[14]
+	}
+	if a[25].Name < a[15].Name {
+		a[15], a[25] = a[25], a[15]
+	}
+	if a[24].Name < a[15].Name {
+		a[15], a[24] = a[24], a[15]
+	}
+	if a[16].Name < a[8].Name {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[17].Name < a[9].Name {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[16].Name < a[9].Name {
+		a[9], a[16] = a[16], a[9]
+	}
+	if a[18].Name < a[10].Name {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[19].Name < a[11].Name {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[18].Name < a[11].Name {
+		a[11], a[18] = a[18], a[11]
+	}
+	if a[16].Name < a[10].Name {
+		a[10], a[16] = a[16], a[10]
+	}
+	if a[17].Name < a[11].Name {
+		a[11], a[17] = a[17], a[11]
+	}
+	if a[16].Name < a[11].Name {
+		a[11], a[16] = a[16], a[11]
+	}
+	if a[20].Name < a[12].Name {
+		a[12], a[20] = a[20], a[12]
+	}
+	if a[21].Name < a[13].Name {
+		a[13], a[21] = a[21], a[13]
+	}
+	if a[20].Name < a[13].Name {
+		a[13], a[20] = a[20], a[13]
+	}
+	if a[22].Name < a[14].Name {
+		a[14], a[22] = a[22], a[14]
+	}
+	if a[23].Name < a[15].Name {
+		a[15], a[23] = a[23], a[15]
+	}
+	if a[22].Name < a[15].Name {
+		a[15], a[22] = a[22], a[15]
+	}
+	if a[20].Name < a[14].Name {
+		a[14], a[20] = a[20], a[14]
+	}
+	if a[21].Name < a[15].Name {
+		a[15], a[21] = a[21], a[15]
+	}
+	if a[20].Name < a[15].Name {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[16].Name < a[12].Name {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[17].Name < a[13].Name {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[16].Name < a[13].Name {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[18].Name < a[14].Name {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[19].Name < a[15].Name {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[18].Name < a[15].Name {
+		a[15], a[18] = a[18], a[15]
+	}
+	if a[16].Name < a[14].Name {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[17].Name < a[15].Name {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[16].Name < a[15].Name {
+		a[15], a[16] = a[16], a[15]
+	}
+}
+
+func NetworkSort32xNamedReverse(a []Named) {
+	_ = a[31]
+	if a[0].Name < a[1].Name {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Name < a[3].Name {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0].Name < a[2].Name {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Name < a[3].Name {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1].Name < a[2].Name {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4].Name < a[5].Name {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Name < a[7].Name {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4].Name < a[6].Name {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5].Name < a[7].Name {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5].Name < a[6].Name {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0].Name < a[4].Name {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1].Name < a[5].Name {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1].Name < a[4].Name {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2].Name < a[6].Name {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3].Name < a[7].Name {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3].Name < a[6].Name {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2].Name < a[4].Name {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Name < a[5].Name {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3].Name < a[4].Name {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[8].Name < a[9].Name {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Name < a[11].Name {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[8].Name < a[10].Name {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[9].Name < a[11].Name {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[9].Name < a[10].Name {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12].Name < a[13].Name {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14].Name < a[15].Name {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[12].Name < a[14].Name {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[13].Name < a[15].Name {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[13].Name < a[14].Name {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[8].Name < a[12].Name {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9].Name < a[13].Name {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[9].Name < a[12].Name {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[10].Name < a[14].Name {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11].Name < a[15].Name {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[11].Name < a[14].Name {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[10].Name < a[12].Name {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[11].Name < a[13].Name {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[11].Name < a[12].Name {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[0].Name < a[8].Name {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1].Name < a[9].Name {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[1].Name < a[8].Name {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[2].Name < a[10].Name {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3].Name < a[11].Name {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[3].Name < a[10].Name {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[2].Name < a[8].Name {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[3].Name < a[9].Name {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[3].Name < a[8].Name {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[4].Name < a[12].Name {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5].Name < a[13].Name {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[5].Name < a[12].Name {
+		a[5], a[12] = a[12], a[5]
+	}
+	if a[6].Name < a[14].Name {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7].Name < a[15].Name {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[7].Name < a[14].Name {
+		a[7], a[14] = a[14], a[7]
+	}
+	if a[6].Name < a[12].Name {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[7].Name < a[13].Name {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[7].Name < a[12].Name {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[4].Name < a[8].Name {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[5].Name < a[9].Name {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[5].Name < a[8].Name {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6].Name < a[10].Name {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[7].Name < a[11].Name {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[7].Name < a[10].Name {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[6].Name < a[8].Name {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[7].Name < a[9].Name {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[7].Name < a[8].Name {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[16].Name < a[17].Name {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[18].Name < a[19].Name {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[16].Name < a[18].Name {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[17].Name < a[19].Name {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[17].Name < a[18].Name {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[20].Name < a[21].Name {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[22].Name < a[23].Name {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[20].Name < a[22].Name {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[21].Name < a[23].Name {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[21].Name < a[22].Name {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[16].Name < a[20].Name {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[17].Name < a[21].Name {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[17].Name < a[20].Name {
+		a[17], a[20] = a[20], a[17]
+	}
+	if a[18].Name < a[22].Name {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[19].Name < a[23].Name {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[19].Name < a[22].Name {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[18].Name < a[20].Name {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[19].Name < a[21].Name {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[19].Name < a[20].Name {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[24].Name < a[25].Name {
+		a[24], a[25] = a[25], a[24]
+	}
+	if a[26].Name < a[27].Name {
+		a[26], a[27] = a[27], a[26]
+	}
+	if a[24].Name < a[26].Name {
+		a[24], a[26] = a[26], a[24]
+	}
+	if a[25].Name < a[27].Name {
+		a[25], a[27] = a[27], a[25]
+	}
+	if a[25].Name < a[26].Name {
+		a[25], a[26] = a[26], a[25]
+	}
+	if a[28].Name < a[29].Name {
+		a[28], a[29] = a[29], a[28]
+	}
+	if a[30].Name < a[31].Name {
+		a[30], a[31] = a[31], a[30]
+	}
+	if a[28].Name < a[30].Name {
+		a[28], a[30] = a[30], a[28]
+	}
+	if a[29].Name < a[31].Name {
+		a[29], a[31] = a[31], a[29]
+	}
+	if a[29].Name < a[30].Name {
+		a[29], a[30] = a[30], a[29]
+	}
+	if a[24].Name < a[28].Name {
+		a[24], a[28] = a[28], a[24]
+	}
+	if a[25].Name < a[29].Name {
+		a[25], a[29] = a[29], a[25]
+	}
+	if a[25].Name < a[28].Name {
+		a[25], a[28] = a[28], a[25]
+	}
+	if a[26].Name < a[30].Name {
+		a[26], a[30] = a[30], a[26]
+	}
+	if a[27].Name < a[31].Name {
+		a[27], a[31] = a[31], a[27]
+	}
+	if a[27].Name < a[30].Name {
+		a[27], a[30] = a[30], a[27]
+	}
+	if a[26].Name < a[28].Name {
+		a[26], a[28] = a[28], a[26]
+	}
+	if a[27].Name < a[29].Name {
+		a[27], a[29] = a[29], a[27]
+	}
+	if a[27].Name < a[28].Name {
+		a[27], a[28] = a[28], a[27]
+	}
+	if a[16].Name < a[24].Name {
+		a[16], a[24] = a[24], a[16]
+	}
+	if a[17].Name < a[25].Name {
+		a[17], a[25] = a[25], a[17]
+	}
+	if a[17].Name < a[24].Name {
+		a[17], a[24] = a[24], a[17]
+	}
+	if a[18].Name < a[26].Name {
+		a[18], a[26] = a[26], a[18]
+	}
+	if a[19].Name < a[27].Name {
+		a[19], a[27] = a[27], a[19]
+	}
+	if a[19].Name < a[26].Name {
+		a[19], a[26] = a[26], a[19]
+	}
+	if a[18].Name < a[24].Name {
+		a[18], a[24] = a[24], a[18]
+	}
+	if a[19].Name < a[25].Name {
+		a[19], a[25] = a[25], a[19]
+	}
+	if a[19].Name < a[24].Name {
+		a[19], a[24] = a[24], a[19]
+	}
+	if a[20].Name < a[28].Name {
+		a[20], a[28] = a[28], a[20]
+	}
+	if a[21].Name < a[29].Name {
+		a[21], a[29] = a[29], a[21]
+	}
+	if a[21].Name < a[28].Name {
+		a[21], a[28] = a[28], a[21]
+	}
+	if a[22].Name < a[30].Name {
+		a[22], a[30] = a[30], a[22]
+	}
+	if a[23].Name < a[31].Name {
+		a[23], a[31] = a[31], a[23]
+	}
+	if a[23].Name < a[30].Name {
+		a[23], a[30] = a[30], a[23]
+	}
+	if a[22].Name < a[28].Name {
+		a[22], a[28] = a[28], a[22]
+	}
+	if a[23].Name < a[29].Name {
+		a[23], a[29] = a[29], a[23]
+	}
+	if a[23].Name < a[28].Name {
+		a[23], a[28] = a[28], a[23]
+	}
+	if a[20].Name < a[24].Name {
+		a[20], a[24] = a[24], a[20]
+	}
+	if a[21].Name < a[25].Name {
+		a[21], a[25] = a[25], a[21]
+	}
+	if a[21].Name < a[24].Name {
+		a[21], a[24] = a[24], a[21]
+	}
+	if a[22].Name < a[26].Name {
+		a[22], a[26] = a[26], a[22]
+	}
+	if a[23].Name < a[27].Name {
+		a[23], a[27] = a[27], a[23]
+	}
+	if a[23].Name < a[26].Name {
+		a[23], a[26] = a[26], a[23]
+	}
+	if a[22].Name < a[24].Name {
+		a[22], a[24] = a[24], a[22]
+	}
+	if a[23].Name < a[25].Name {
+		a[23], a[25] = a[25], a[23]
+	}
+	if a[23].Name < a[24].Name {
+		a[23], a[24] = a[24], a[23]
+	}
+	if a[0].Name < a[16].Name {
+		a[0], a[16] = a[16], a[0]
+	}
+	if a[1].Name < a[17].Name {
+		a[1], a[17] = a[17], a[1]
+	}
+	if a[1].Name < a[16].Name {
+		a[1], a[16] = a[16], a[1]
+	}
+	if a[2].Name < a[18].Name {
+		a[2], a[18] = a[18], a[2]
+	}
+	if a[3].Name < a[19].Name {
+		a[3], a[19] = a[19], a[3]
+	}
+	if a[3].Name < a[18].Name {
+		a[3], a[18] = a[18], a[3]
+	}
+	if a[2].Name < a[16].Name {
+		a[2], a[16] = a[16], a[2]
+	}
+	if a[3].Name < a[17].Name {
+		a[3], a[17] = a[17], a[3]
+	}
+	if a[3].Name < a[16].Name {
+		a[3], a[16] = a[16], a[3]
+	}
+	if a[4].Name < a[20].Name {
+		a[4], a[20] = a[20], a[4]
+	}
+	if a[5].Name < a[21].Name {
+		a[5], a[21] = a[21], a[5]
+	}
+	if a[5].Name < a[20].Name {
+		a[5], a[20] = a[20], a[5]
+	}
+	if a[6].Name < a[22].Name {
+		a[6], a[22] = a[22], a[6]
+	}
+	if a[7].Name < a[23].Name {
+		a[7], a[23] = a[23], a[7]
+	}
+	if a[7].Name < a[22].Name {
+		a[7], a[22] = a[22], a[7]
+	}
+	if a[6].Name < a[20].Name {
+		a[6], a[20] = a[20], a[6]
+	}
+	if a[7].Name < a[21].Name {
+		a[7], a[21] = a[21], a[7]
+	}
+	if a[7].Name < a[20].Name {
+		a[7], a[20] = a[20], a[7]
+	}
+	if a[4].Name < a[16].Name {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[5].Name < a[17].Name {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[5].Name < a[16].Name {
+		a[5], a[16] = a[16], a[5]
+	}
+	if a[6].Name < a[18].Name {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[7].Name < a[19].Name {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[7].Name < a[18].Name {
+		a[7], a[18] = a[18], a[7]
+	}
+	if a[6].Name < a[16].Name {
+		a[6], a[16] = a[16], a[6]
+	}
+	if a[7].Name < a[17].Name {
+		a[7], a[17] = a[17], a[7]
+	}
+	if a[7].Name < a[16].Name {
+		a[7], a[16] = a[16], a[7]
+	}
+	if a[8].Name < a[24].Name {
+		a[8], a[24] = a[24], a[8]
+	}
+	if a[9].Name < a[25].Name {
+		a[9], a[25] = a[25], a[9]
+	}
+	if a[9].Name < a[24].Name {
+		a[9], a[24] = a[24], a[9]
+	}
+	if a[10].Name < a[26].Name {
+		a[10], a[26] = a[26], a[10]
+	}
+	if a[11].Name < a[27].Name {
+		a[11], a[27] = a[27], a[11]
+	}
+	if a[11].Name < a[26].Name {
+		a[11], a[26] = a[26], a[11]
+	}
+	if a[10].Name < a[24].Name {
+		a[10], a[24] = a[24], a[10]
+	}
+	if a[11].Name < a[25].Name {
+		a[11], a[25] = a[25], a[11]
+	}
+	if a[11].Name < a[24].Name {
+		a[11], a[24] = a[24], a[11]
+	}
+	if a[12].Name < a[28].Name {
+		a[12], a[28] = a[28], a[12]
+	}
+	if a[13].Name < a[29].Name {
+		a[13], a[29] = a[29], a[13]
+	}
+	if a[13].Name < a[28].Name {
+		a[13], a[28] = a[28], a[13]
+	}
+	if a[14].Name < a[30].Name {
+		a[14], a[30] = a[30], a[14]
+	}
+	if a[15].Name < a[31].Name {
+		a[15], a[31] = a[31], a[15]
+	}
+	if a[15].Name < a[30].Name {
+		a[15], a[30] = a[30], a[15]
+	}
+	if a[14].Name < a[28].Name {
+		a[14], a[28] = a[28], a[14]
+	}
+	if a[15].Name < a[29].Name {
+		a[15], a[29] = a[29], a[15]
+	}
+	if a[15].Name < a[28].Name {
+		a[15], a[28] = a[28], a[15]
+	}
+	if a[12].Name < a[24].Name {
+		a[12], a[24] = a[24], a[12]
+	}
+	if a[13].Name < a[25].Name {
+		a[13], a[25] = a[25], a[13]
+	}
+	if a[13].Name < a[24].Name {
+		a[13], a[24] = a[24], a[13]
+	}
+	if a[14].Name < a[26].Name {
+		a[14], a[26] = a[26], a[14]
+	}
+	if a[15].Name < a[27].Name {
+		a[15], a[27] = a[27], a[15]
+	}
+	if a[15].Name < a[26].Name {
+		a[15], a[26] = a[26], a[15]
+	}
+	if a[14].Name < a[24].Name {
+		a[14], a[24] = a[24], a[14]
+	}
+	if a[15].Name < a[25].Name {
+		a[15], a[25] = a[25], a[15]
+	}
+	if a[15].Name < a[24].Name {
+		a[15], a[24] = a[24], a[15]
+	}
+	if a[8].Name < a[16].Name {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[9].Name < a[17].Name {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[9].Name < a[16].Name {
+		a[9], a[16] = a[16], a[9]
+	}
+	if a[10].Name < a[18].Name {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[11].Name < a[19].Name {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[11].Name < a[18].Name {
+		a[11], a[18] = a[18], a[11]
+	}
+	if a[10].Name < a[16].Name {
+		a[10], a[16] = a[16], a[10]
+	}
+	if a[11].Name < a[17].Name {
+		a[11], a[17] = a[17], a[11]
+	}
+	if a[11].Name < a[16].Name {
+		a[11], a[16] = a[16], a[11]
+	}
+	if a[12].Name < a[20].Name {
+		a[12], a[20] = a[20], a[12]
+	}
+	if a[13].Name < a[21].Name {
+		a[13], a[21] = a[21], a[13]
+	}
+	if a[13].Name < a[20].Name {
+		a[13], a[20] = a[20], a[13]
+	}
+	if a[14].Name < a[22].Name {
+		a[14], a[22] = a[22], a[14]
+	}
+	if a[15].Name < a[23].Name {
+		a[15], a[23] = a[23], a[15]
+	}
+	if a[15].Name < a[22].Name {
+		a[15], a[22] = a[22], a[15]
+	}
+	if a[14].Name < a[20].Name {
+		a[14], a[20] = a[20], a[14]
+	}
+	if a[15].Name < a[21].Name {
+		a[15], a[21] = a[21], a[15]
+	}
+	if a[15].Name < a[20].Name {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[12].Name < a[16].Name {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[13].Name < a[17].Name {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[13].Name < a[16].Name {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[14].Name < a[18].Name {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[15].Name < a[19].Name {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[15].Name < a[18].Name {
+		a[15], a[18] = a[18], a[15]
+	}
+	if a[14].Name < a[16].Name {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[15].Name < a[17].Name {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[15].Name < a[16].Name {
+		a[15], a[16] = a[16], a[15]
+	}
+}
+
+func NetworkSort2xPoint(a []Point) {
+	_ = a[1]
+	if PointLess(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort2xPointReverse(a []Point) {
+	_ = a[1]
+	if PointLess(a[0], a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3xPoint(a []Point) {
+	_ = a[2]
+	if PointLess(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3xPointReverse(a []Point) {
+	_ = a[2]
+	if PointLess(a[1], a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[0], a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[0], a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort4xPoint(a []Point) {
+	_ = a[3]
+	if PointLess(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort4xPointReverse(a []Point) {
+	_ = a[3]
+	if PointLess(a[0], a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[2], a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[0], a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[1], a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[1], a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5xPoint(a []Point) {
+	_ = a[4]
+	if PointLess(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[4], a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if PointLess(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[3], a[0]) {
+		a[0], a[3] = a[3], a[0]
+	}
+	if PointLess(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[4], a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if PointLess(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5xPointReverse(a []Point) {
+	_ = a[4]
+	if PointLess(a[0], a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[3], a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[2], a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if PointLess(a[2], a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[0], a[3]) {
+		a[0], a[3] = a[3], a[0]
+	}
+	if PointLess(a[0], a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[1], a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if PointLess(a[1], a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[1], a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort6xPoint(a []Point) {
+	_ = a[5]
+	if PointLess(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if PointLess(a[5], a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if PointLess(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[4], a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if PointLess(a[3], a[0]) {
+		a[0], a[3] = a[3], a[0]
+	}
+	if PointLess(a[5], a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if PointLess(a[5], a[2]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if PointLess(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[4], a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if PointLess(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort6xPointReverse(a []Point) {
+	_ = a[5]
+	if PointLess(a[1], a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[0], a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[0], a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[4], a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if PointLess(a[3], a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if PointLess(a[3], a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[0], a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if PointLess(a[0], a[3]) {
+		a[0], a[3] = a[3], a[0]
+	}
+	if PointLess(a[1], a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if PointLess(a[2], a[5]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if PointLess(a[1], a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[2], a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if PointLess(a[2], a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7xPoint(a []Point) {
+	_ = a[6]
+	if PointLess(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[5], a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if PointLess(a[6], a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if PointLess(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if PointLess(a[4], a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if PointLess(a[3], a[0]) {
+		a[0], a[3] = a[3], a[0]
+	}
+	if PointLess(a[5], a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if PointLess(a[6], a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if PointLess(a[5], a[2]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if PointLess(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[4], a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if PointLess(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7xPointReverse(a []Point) {
+	_ = a[6]
+	if PointLess(a[1], a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[0], a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[0], a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[3], a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[5], a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[3], a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if PointLess(a[4], a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if PointLess(a[4], a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if PointLess(a[0], a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if PointLess(a[0], a[3]) {
+		a[0], a[3] = a[3], a[0]
+	}
+	if PointLess(a[1], a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if PointLess(a[2], a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if PointLess(a[2], a[5]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if PointLess(a[1], a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[2], a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if PointLess(a[2], a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort8xPoint(a []Point) {
+	_ = a[7]
+	if PointLess(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if PointLess(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[6], a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if PointLess(a[7], a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if PointLess(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[4], a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if PointLess(a[5], a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if PointLess(a[4], a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if PointLess(a[6], a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if PointLess(a[7], a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if PointLess(a[6], a[3]) {
+		a[3], a[6] = a[6], a[3]
+	}
+	if PointLess(a[4], a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if PointLess(a[5], a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if PointLess(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort8xPointReverse(a []Point) {
+	_ = a[7]
+	if PointLess(a[0], a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[2], a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[0], a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[1], a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[1], a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[4], a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if PointLess(a[6], a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[4], a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if PointLess(a[5], a[7]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if PointLess(a[5], a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[0], a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if PointLess(a[1], a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if PointLess(a[1], a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if PointLess(a[2], a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if PointLess(a[3], a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if PointLess(a[3], a[6]) {
+		a[3], a[6] = a[6], a[3]
+	}
+	if PointLess(a[2], a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if PointLess(a[3], a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if PointLess(a[3], a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort9xPoint(a []Point) {
+	_ = a[8]
+	if PointLess(a[6], a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if PointLess(a[5], a[0]) {
+		a[0], a[5] = a[5], a[0]
+	}
+	if PointLess(a[4], a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if PointLess(a[8], a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if PointLess(a[7], a[0]) {
+		a[0], a[7] = a[7], a[0]
+	}
+	if PointLess(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[5], a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if PointLess(a[6], a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if PointLess(a[8], a[5]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if PointLess(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[8], a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if PointLess(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if PointLess(a[7], a[2]) {
+		a[2], a[7] = a[7], a[2]
+	}
+	if PointLess(a[7], a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if PointLess(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if PointLess(a[4], a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if PointLess(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort9xPointReverse(a []Point) {
+	_ = a[8]
+	if PointLess(a[2], a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if PointLess(a[0], a[5]) {
+		a[0], a[5] = a[5], a[0]
+	}
+	if PointLess(a[1], a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if PointLess(a[7], a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if PointLess(a[0], a[7]) {
+		a[0], a[7] = a[7], a[0]
+	}
+	if PointLess(a[1], a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[3], a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if PointLess(a[4], a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if PointLess(a[5], a[8]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if PointLess(a[1], a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[6], a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if PointLess(a[0], a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[4], a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if PointLess(a[2], a[7]) {
+		a[2], a[7] = a[7], a[2]
+	}
+	if PointLess(a[3], a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if PointLess(a[3], a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[5], a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[1], a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[1], a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[6], a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[4], a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if PointLess(a[2], a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if PointLess(a[5], a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[2], a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[4], a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort10xPoint(a []Point) {
+	_ = a[9]
+	if PointLess(a[4], a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if PointLess(a[8], a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if PointLess(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[9], a[0]) {
+		a[0], a[9] = a[9], a[0]
+	}
+	if PointLess(a[5], a[2]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if PointLess(a[7], a[0]) {
+		a[0], a[7] = a[7], a[0]
+	}
+	if PointLess(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if PointLess(a[6], a[3]) {
+		a[3], a[6] = a[6], a[3]
+	}
+	if PointLess(a[9], a[4]) {
+		a[4], a[9] = a[9], a[4]
+	}
+	if PointLess(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[9], a[6]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if PointLess(a[5], a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if PointLess(a[7], a[4]) {
+		a[4], a[7] = a[7], a[4]
+	}
+	if PointLess(a[8], a[1]) {
+		a[1], a[8] = a[8], a[1]
+	}
+	if PointLess(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[8], a[5]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if PointLess(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[8], a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if PointLess(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[5], a[2]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if PointLess(a[6], a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if PointLess(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if PointLess(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort10xPointReverse(a []Point) {
+	_ = a[9]
+	if PointLess(a[1], a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if PointLess(a[7], a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if PointLess(a[2], a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[5], a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[0], a[9]) {
+		a[0], a[9] = a[9], a[0]
+	}
+	if PointLess(a[2], a[5]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if PointLess(a[0], a[7]) {
+		a[0], a[7] = a[7], a[0]
+	}
+	if PointLess(a[8], a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if PointLess(a[3], a[6]) {
+		a[3], a[6] = a[6], a[3]
+	}
+	if PointLess(a[4], a[9]) {
+		a[4], a[9] = a[9], a[4]
+	}
+	if PointLess(a[0], a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[0], a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[6], a[9]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if PointLess(a[3], a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if PointLess(a[4], a[7]) {
+		a[4], a[7] = a[7], a[4]
+	}
+	if PointLess(a[1], a[8]) {
+		a[1], a[8] = a[8], a[1]
+	}
+	if PointLess(a[3], a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[5], a[8]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if PointLess(a[6], a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[1], a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[7], a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if PointLess(a[1], a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[2], a[5]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if PointLess(a[4], a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if PointLess(a[2], a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[6], a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[4], a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if PointLess(a[3], a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[5], a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort11xPoint(a []Point) {
+	_ = a[10]
+	if PointLess(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if PointLess(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if PointLess(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[7], a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if PointLess(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[6], a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if PointLess(a[10], a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if PointLess(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[10], a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if PointLess(a[5], a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if PointLess(a[10], a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if PointLess(a[9], a[5]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if PointLess(a[6], a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if PointLess(a[5], a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if PointLess(a[10], a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if PointLess(a[4], a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if PointLess(a[7], a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if PointLess(a[8], a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if PointLess(a[4], a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if PointLess(a[4], a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if PointLess(a[10], a[7]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if PointLess(a[8], a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if PointLess(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if PointLess(a[4], a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if PointLess(a[9], a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if PointLess(a[5], a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if PointLess(a[8], a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if PointLess(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[8], a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort11xPointReverse(a []Point) {
+	_ = a[10]
+	if PointLess(a[0], a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[2], a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[4], a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if PointLess(a[6], a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[8], a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if PointLess(a[1], a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[5], a[7]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if PointLess(a[0], a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[4], a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if PointLess(a[8], a[10]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if PointLess(a[1], a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[5], a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[9], a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if PointLess(a[1], a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if PointLess(a[6], a[10]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if PointLess(a[5], a[9]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if PointLess(a[2], a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if PointLess(a[1], a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if PointLess(a[6], a[10]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if PointLess(a[0], a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if PointLess(a[3], a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if PointLess(a[4], a[8]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if PointLess(a[0], a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if PointLess(a[1], a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if PointLess(a[7], a[10]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if PointLess(a[3], a[8]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if PointLess(a[2], a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[8], a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if PointLess(a[2], a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if PointLess(a[7], a[9]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if PointLess(a[3], a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if PointLess(a[6], a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if PointLess(a[3], a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[5], a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[7], a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort12xPoint(a []Point) {
+	_ = a[11]
+	if PointLess(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if PointLess(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if PointLess(a[11], a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if PointLess(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[7], a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if PointLess(a[11], a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if PointLess(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[6], a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if PointLess(a[10], a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if PointLess(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[10], a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if PointLess(a[5], a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if PointLess(a[10], a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if PointLess(a[9], a[5]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if PointLess(a[6], a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if PointLess(a[5], a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if PointLess(a[10], a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if PointLess(a[4], a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if PointLess(a[11], a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if PointLess(a[7], a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if PointLess(a[8], a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if PointLess(a[4], a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if PointLess(a[11], a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if PointLess(a[4], a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if PointLess(a[10], a[7]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if PointLess(a[8], a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if PointLess(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if PointLess(a[4], a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if PointLess(a[9], a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if PointLess(a[5], a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if PointLess(a[8], a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if PointLess(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[8], a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort12xPointReverse(a []Point) {
+	_ = a[11]
+	if PointLess(a[0], a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[2], a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[4], a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if PointLess(a[6], a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[8], a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if PointLess(a[10], a[11]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if PointLess(a[1], a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[5], a[7]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if PointLess(a[9], a[11]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if PointLess(a[0], a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[4], a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if PointLess(a[8], a[10]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if PointLess(a[1], a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[5], a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[9], a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if PointLess(a[1], a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if PointLess(a[6], a[10]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if PointLess(a[5], a[9]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if PointLess(a[2], a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if PointLess(a[1], a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if PointLess(a[6], a[10]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if PointLess(a[0], a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if PointLess(a[7], a[11]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if PointLess(a[3], a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if PointLess(a[4], a[8]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if PointLess(a[0], a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if PointLess(a[7], a[11]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if PointLess(a[1], a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if PointLess(a[7], a[10]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if PointLess(a[3], a[8]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if PointLess(a[2], a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[8], a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if PointLess(a[2], a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if PointLess(a[7], a[9]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if PointLess(a[3], a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if PointLess(a[6], a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if PointLess(a[3], a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[5], a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[7], a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort13xPoint(a []Point) {
+	_ = a[12]
+	if PointLess(a[7], a[1]) {
+		a[1], a[7] = a[7], a[1]
+	}
+	if PointLess(a[11], a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if PointLess(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[8], a[5]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if PointLess(a[12], a[0]) {
+		a[0], a[12] = a[12], a[0]
+	}
+	if PointLess(a[6], a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if PointLess(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[6], a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if PointLess(a[11], a[8]) {
+		a[8], a[11] = a[11], a[8]
+	}
+	if PointLess(a[12], a[7]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if PointLess(a[9], a[5]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if PointLess(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[7], a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if PointLess(a[11], a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if PointLess(a[4], a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if PointLess(a[12], a[6]) {
+		a[6], a[12] = a[12], a[6]
+	}
+	if PointLess(a[8], a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if PointLess(a[12], a[11]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if PointLess(a[9], a[4]) {
+		a[4], a[9] = a[9], a[4]
+	}
+	if PointLess(a[10], a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if PointLess(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if PointLess(a[11], a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if PointLess(a[7], a[1]) {
+		a[1], a[7] = a[7], a[1]
+	}
+	if PointLess(a[6], a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if PointLess(a[11], a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if PointLess(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[7], a[4]) {
+		a[4], a[7] = a[7], a[4]
+	}
+	if PointLess(a[10], a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if PointLess(a[5], a[0]) {
+		a[0], a[5] = a[5], a[0]
+	}
+	if PointLess(a[5], a[2]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if PointLess(a[8], a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if PointLess(a[10], a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if PointLess(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[5], a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if PointLess(a[8], a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if PointLess(a[6], a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if PointLess(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if PointLess(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if PointLess(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort13xPointReverse(a []Point) {
+	_ = a[12]
+	if PointLess(a[1], a[7]) {
+		a[1], a[7] = a[7], a[1]
+	}
+	if PointLess(a[9], a[11]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if PointLess(a[3], a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[5], a[8]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if PointLess(a[0], a[12]) {
+		a[0], a[12] = a[12], a[0]
+	}
+	if PointLess(a[2], a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if PointLess(a[0], a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[2], a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[4], a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if PointLess(a[8], a[11]) {
+		a[8], a[11] = a[11], a[8]
+	}
+	if PointLess(a[7], a[12]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if PointLess(a[5], a[9]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if PointLess(a[0], a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[3], a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if PointLess(a[10], a[11]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if PointLess(a[1], a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if PointLess(a[6], a[12]) {
+		a[6], a[12] = a[12], a[6]
+	}
+	if PointLess(a[7], a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if PointLess(a[11], a[12]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if PointLess(a[4], a[9]) {
+		a[4], a[9] = a[9], a[4]
+	}
+	if PointLess(a[6], a[10]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if PointLess(a[3], a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[5], a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[8], a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if PointLess(a[10], a[11]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if PointLess(a[1], a[7]) {
+		a[1], a[7] = a[7], a[1]
+	}
+	if PointLess(a[2], a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if PointLess(a[9], a[11]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if PointLess(a[1], a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[4], a[7]) {
+		a[4], a[7] = a[7], a[4]
+	}
+	if PointLess(a[8], a[10]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if PointLess(a[0], a[5]) {
+		a[0], a[5] = a[5], a[0]
+	}
+	if PointLess(a[2], a[5]) {
+		a[2], a[5] = a[5], a[2]
+	}
+	if PointLess(a[6], a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if PointLess(a[9], a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if PointLess(a[1], a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[3], a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if PointLess(a[7], a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if PointLess(a[4], a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if PointLess(a[2], a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[4], a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if PointLess(a[6], a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[8], a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if PointLess(a[3], a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[5], a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort14xPoint(a []Point) {
+	_ = a[13]
+	if PointLess(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if PointLess(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if PointLess(a[11], a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if PointLess(a[13], a[12]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if PointLess(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[6], a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if PointLess(a[10], a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if PointLess(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[7], a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if PointLess(a[11], a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if PointLess(a[4], a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if PointLess(a[12], a[8]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if PointLess(a[5], a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if PointLess(a[13], a[9]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if PointLess(a[6], a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if PointLess(a[7], a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if PointLess(a[8], a[0]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if PointLess(a[9], a[1]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if PointLess(a[10], a[2]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if PointLess(a[11], a[3]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if PointLess(a[12], a[4]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if PointLess(a[13], a[5]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if PointLess(a[10], a[5]) {
+		a[5], a[10] = a[10], a[5]
+	}
+	if PointLess(a[9], a[6]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if PointLess(a[12], a[3]) {
+		a[3], a[12] = a[12], a[3]
+	}
+	if PointLess(a[11], a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if PointLess(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[8], a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if PointLess(a[4], a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if PointLess(a[13], a[7]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if PointLess(a[8], a[2]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if PointLess(a[4], a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if PointLess(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[10], a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if PointLess(a[13], a[11]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if PointLess(a[8], a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if PointLess(a[12], a[7]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if PointLess(a[8], a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if PointLess(a[12], a[10]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if PointLess(a[5], a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if PointLess(a[9], a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if PointLess(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[8], a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if PointLess(a[10], a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if PointLess(a[12], a[11]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if PointLess(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort14xPointReverse(a []Point) {
+	_ = a[13]
+	if PointLess(a[0], a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[2], a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[4], a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if PointLess(a[6], a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[8], a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if PointLess(a[10], a[11]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if PointLess(a[12], a[13]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if PointLess(a[0], a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[4], a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if PointLess(a[8], a[10]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if PointLess(a[1], a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[5], a[7]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if PointLess(a[9], a[11]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if PointLess(a[0], a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if PointLess(a[8], a[12]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if PointLess(a[1], a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if PointLess(a[9], a[13]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if PointLess(a[2], a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if PointLess(a[3], a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if PointLess(a[0], a[8]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if PointLess(a[1], a[9]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if PointLess(a[2], a[10]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if PointLess(a[3], a[11]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if PointLess(a[4], a[12]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if PointLess(a[5], a[13]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if PointLess(a[5], a[10]) {
+		a[5], a[10] = a[10], a[5]
+	}
+	if PointLess(a[6], a[9]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if PointLess(a[3], a[12]) {
+		a[3], a[12] = a[12], a[3]
+	}
+	if PointLess(a[7], a[11]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if PointLess(a[1], a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[4], a[8]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if PointLess(a[1], a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if PointLess(a[7], a[13]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if PointLess(a[2], a[8]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if PointLess(a[2], a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if PointLess(a[5], a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[9], a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if PointLess(a[11], a[13]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if PointLess(a[3], a[8]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if PointLess(a[7], a[12]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if PointLess(a[6], a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if PointLess(a[10], a[12]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if PointLess(a[3], a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if PointLess(a[7], a[9]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if PointLess(a[3], a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[5], a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[7], a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if PointLess(a[9], a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if PointLess(a[11], a[12]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if PointLess(a[6], a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[8], a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort15xPoint(a []Point) {
+	_ = a[14]
+	if PointLess(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if PointLess(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if PointLess(a[11], a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if PointLess(a[13], a[12]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if PointLess(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[6], a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if PointLess(a[10], a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if PointLess(a[14], a[12]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if PointLess(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[7], a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if PointLess(a[11], a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if PointLess(a[4], a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if PointLess(a[12], a[8]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if PointLess(a[5], a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if PointLess(a[13], a[9]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if PointLess(a[6], a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if PointLess(a[14], a[10]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if PointLess(a[7], a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if PointLess(a[8], a[0]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if PointLess(a[9], a[1]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if PointLess(a[10], a[2]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if PointLess(a[11], a[3]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if PointLess(a[12], a[4]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if PointLess(a[13], a[5]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if PointLess(a[14], a[6]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if PointLess(a[10], a[5]) {
+		a[5], a[10] = a[10], a[5]
+	}
+	if PointLess(a[9], a[6]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if PointLess(a[12], a[3]) {
+		a[3], a[12] = a[12], a[3]
+	}
+	if PointLess(a[14], a[13]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if PointLess(a[11], a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if PointLess(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[8], a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if PointLess(a[4], a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if PointLess(a[13], a[7]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if PointLess(a[8], a[2]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if PointLess(a[14], a[11]) {
+		a[11], a[14] = a[14], a[11]
+	}
+	if PointLess(a[4], a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if PointLess(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[10], a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if PointLess(a[13], a[11]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if PointLess(a[8], a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if PointLess(a[12], a[7]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if PointLess(a[8], a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if PointLess(a[12], a[10]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if PointLess(a[5], a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if PointLess(a[9], a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if PointLess(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[8], a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if PointLess(a[10], a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if PointLess(a[12], a[11]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if PointLess(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort15xPointReverse(a []Point) {
+	_ = a[14]
+	if PointLess(a[0], a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[2], a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[4], a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if PointLess(a[6], a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[8], a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if PointLess(a[10], a[11]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if PointLess(a[12], a[13]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if PointLess(a[0], a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[4], a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if PointLess(a[8], a[10]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if PointLess(a[12], a[14]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if PointLess(a[1], a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[5], a[7]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if PointLess(a[9], a[11]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if PointLess(a[0], a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if PointLess(a[8], a[12]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if PointLess(a[1], a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if PointLess(a[9], a[13]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if PointLess(a[2], a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if PointLess(a[10], a[14]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if PointLess(a[3], a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if PointLess(a[0], a[8]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if PointLess(a[1], a[9]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if PointLess(a[2], a[10]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if PointLess(a[3], a[11]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if PointLess(a[4], a[12]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if PointLess(a[5], a[13]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if PointLess(a[6], a[14]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if PointLess(a[5], a[10]) {
+		a[5], a[10] = a[10], a[5]
+	}
+	if PointLess(a[6], a[9]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if PointLess(a[3], a[12]) {
+		a[3], a[12] = a[12], a[3]
+	}
+	if PointLess(a[13], a[14]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if PointLess(a[7], a[11]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if PointLess(a[1], a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[4], a[8]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if PointLess(a[1], a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if PointLess(a[7], a[13]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if PointLess(a[2], a[8]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if PointLess(a[11], a[14]) {
+		a[11], a[14] = a[14], a[11]
+	}
+	if PointLess(a[2], a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if PointLess(a[5], a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[9], a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if PointLess(a[11], a[13]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if PointLess(a[3], a[8]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if PointLess(a[7], a[12]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if PointLess(a[6], a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if PointLess(a[10], a[12]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if PointLess(a[3], a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if PointLess(a[7], a[9]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if PointLess(a[3], a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[5], a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[7], a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if PointLess(a[9], a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if PointLess(a[11], a[12]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if PointLess(a[6], a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[8], a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort16xPoint(a []Point) {
+	_ = a[15]
+	if PointLess(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if PointLess(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if PointLess(a[11], a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if PointLess(a[13], a[12]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if PointLess(a[15], a[14]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if PointLess(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[6], a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if PointLess(a[10], a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if PointLess(a[14], a[12]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if PointLess(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[7], a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if PointLess(a[11], a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if PointLess(a[15], a[13]) {
+		a[13], a[15] = a[15], a[13]
+	}
+	if PointLess(a[4], a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if PointLess(a[12], a[8]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if PointLess(a[5], a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if PointLess(a[13], a[9]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if PointLess(a[6], a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if PointLess(a[14], a[10]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if PointLess(a[7], a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if PointLess(a[15], a[11]) {
+		a[11], a[15] = a[15], a[11]
+	}
+	if PointLess(a[8], a[0]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if PointLess(a[9], a[1]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if PointLess(a[10], a[2]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if PointLess(a[11], a[3]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if PointLess(a[12], a[4]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if PointLess(a[13], a[5]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if PointLess(a[14], a[6]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if PointLess(a[15], a[7]) {
+		a[7], a[15] = a[15], a[7]
+	}
+	if PointLess(a[10], a[5]) {
+		a[5], a[10] = a[10], a[5]
+	}
+	if PointLess(a[9], a[6]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if PointLess(a[12], a[3]) {
+		a[3], a[12] = a[12], a[3]
+	}
+	if PointLess(a[14], a[13]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if PointLess(a[11], a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if PointLess(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[8], a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if PointLess(a[4], a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if PointLess(a[13], a[7]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if PointLess(a[8], a[2]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if PointLess(a[14], a[11]) {
+		a[11], a[14] = a[14], a[11]
+	}
+	if PointLess(a[4], a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if PointLess(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[10], a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if PointLess(a[13], a[11]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if PointLess(a[8], a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if PointLess(a[12], a[7]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if PointLess(a[8], a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if PointLess(a[12], a[10]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if PointLess(a[5], a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if PointLess(a[9], a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if PointLess(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[8], a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if PointLess(a[10], a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if PointLess(a[12], a[11]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if PointLess(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort16xPointReverse(a []Point) {
+	_ = a[15]
+	if PointLess(a[0], a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[2], a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[4], a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if PointLess(a[6], a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[8], a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if PointLess(a[10], a[11]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if PointLess(a[12], a[13]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if PointLess(a[14], a[15]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if PointLess(a[0], a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[4], a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if PointLess(a[8], a[10]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if PointLess(a[12], a[14]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if PointLess(a[1], a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[5], a[7]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if PointLess(a[9], a[11]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if PointLess(a[13], a[15]) {
+		a[13], a[15] = a[15], a[13]
+	}
+	if PointLess(a[0], a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if PointLess(a[8], a[12]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if PointLess(a[1], a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if PointLess(a[9], a[13]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if PointLess(a[2], a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if PointLess(a[10], a[14]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if PointLess(a[3], a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if PointLess(a[11], a[15]) {
+		a[11], a[15] = a[15], a[11]
+	}
+	if PointLess(a[0], a[8]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if PointLess(a[1], a[9]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if PointLess(a[2], a[10]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if PointLess(a[3], a[11]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if PointLess(a[4], a[12]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if PointLess(a[5], a[13]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if PointLess(a[6], a[14]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if PointLess(a[7], a[15]) {
+		a[7], a[15] = a[15], a[7]
+	}
+	if PointLess(a[5], a[10]) {
+		a[5], a[10] = a[10], a[5]
+	}
+	if PointLess(a[6], a[9]) {
+		a[6], a[9] = a[9], a[6]
+	}
+	if PointLess(a[3], a[12]) {
+		a[3], a[12] = a[12], a[3]
+	}
+	if PointLess(a[13], a[14]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if PointLess(a[7], a[11]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if PointLess(a[1], a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[4], a[8]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if PointLess(a[1], a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if PointLess(a[7], a[13]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if PointLess(a[2], a[8]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if PointLess(a[11], a[14]) {
+		a[11], a[14] = a[14], a[11]
+	}
+	if PointLess(a[2], a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if PointLess(a[5], a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[9], a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if PointLess(a[11], a[13]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if PointLess(a[3], a[8]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if PointLess(a[7], a[12]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if PointLess(a[6], a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if PointLess(a[10], a[12]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if PointLess(a[3], a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if PointLess(a[7], a[9]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if PointLess(a[3], a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[5], a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[7], a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if PointLess(a[9], a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if PointLess(a[11], a[12]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if PointLess(a[6], a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[8], a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort24xPoint(a []Point) {
+	_ = a[23]
+	if PointLess(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if PointLess(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if PointLess(a[11], a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if PointLess(a[13], a[12]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if PointLess(a[15], a[14]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if PointLess(a[17], a[16]) {
+		a[16], a[17] = a[17], a[16]
+	}
+	if PointLess(a[19], a[18]) {
+		a[18], a[19] = a[19], a[18]
+	}
+	if PointLess(a[21], a[20]) {
+		a[20], a[21] = a[21], a[20]
+	}
+	if PointLess(a[23], a[22]) {
+		a[22], a[23] = a[23], a[22]
+	}
+	if PointLess(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[7], a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if PointLess(a[11], a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if PointLess(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[6], a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if PointLess(a[10], a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if PointLess(a[15], a[13]) {
+		a[13], a[15] = a[15], a[13]
+	}
+	if PointLess(a[19], a[17]) {
+		a[17], a[19] = a[19], a[17]
+	}
+	if PointLess(a[23], a[21]) {
+		a[21], a[23] = a[23], a[21]
+	}
+	if PointLess(a[14], a[12]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if PointLess(a[18], a[16]) {
+		a[16], a[18] = a[18], a[16]
+	}
+	if PointLess(a[22], a[20]) {
+		a[20], a[22] = a[22], a[20]
+	}
+	if PointLess(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[10], a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if PointLess(a[14], a[13]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if PointLess(a[18], a[17]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if PointLess(a[22], a[21]) {
+		a[21], a[22] = a[22], a[21]
+	}
+	if PointLess(a[5], a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if PointLess(a[10], a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if PointLess(a[17], a[13]) {
+		a[13], a[17] = a[17], a[13]
+	}
+	if PointLess(a[22], a[18]) {
+		a[18], a[22] = a[22], a[18]
+	}
+	if PointLess(a[9], a[5]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if PointLess(a[6], a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if PointLess(a[21], a[17]) {
+		a[17], a[21] = a[21], a[17]
+	}
+	if PointLess(a[18], a[14]) {
+		a[14], a[18] = a[18], a[14]
+	}
+	if PointLess(a[5], a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if PointLess(a[10], a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if PointLess(a[4], a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if PointLess(a[11], a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if PointLess(a[17], a[13]) {
+		a[13], a[17] = a[17], a[13]
+	}
+	if PointLess(a[22], a[18]) {
+		a[18], a[22] = a[22], a[18]
+	}
+	if PointLess(a[16], a[12]) {
+		a[12], a[16] = a[16], a[12]
+	}
+	if PointLess(a[23], a[19]) {
+		a[19], a[23] = a[23], a[19]
+	}
+	if PointLess(a[7], a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if PointLess(a[8], a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if PointLess(a[19], a[15]) {
+		a[15], a[19] = a[19], a[15]
+	}
+	if PointLess(a[20], a[16]) {
+		a[16], a[20] = a[20], a[16]
+	}
+	if PointLess(a[4], a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if PointLess(a[11], a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if PointLess(a[16], a[12]) {
+		a[12], a[16] = a[16], a[12]
+	}
+	if PointLess(a[23], a[19]) {
+		a[19], a[23] = a[23], a[19]
+	}
+	if PointLess(a[4], a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if PointLess(a[10], a[7]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if PointLess(a[8], a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if PointLess(a[16], a[13]) {
+		a[13], a[16] = a[16], a[13]
+	}
+	if PointLess(a[22], a[19]) {
+		a[19], a[22] = a[22], a[19]
+	}
+	if PointLess(a[20], a[15]) {
+		a[15], a[20] = a[20], a[15]
+	}
+	if PointLess(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if PointLess(a[15], a[14]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if PointLess(a[21], a[20]) {
+		a[20], a[21] = a[21], a[20]
+	}
+	if PointLess(a[4], a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if PointLess(a[9], a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if PointLess(a[5], a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if PointLess(a[8], a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if PointLess(a[16], a[14]) {
+		a[14], a[16] = a[16], a[14]
+	}
+	if PointLess(a[21], a[19]) {
+		a[19], a[21] = a[21], a[19]
+	}
+	if PointLess(a[17], a[15]) {
+		a[15], a[17] = a[17], a[15]
+	}
+	if PointLess(a[20], a[18]) {
+		a[18], a[20] = a[20], a[18]
+	}
+	if PointLess(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[8], a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if PointLess(a[16], a[15]) {
+		a[15], a[16] = a[16], a[15]
+	}
+	if PointLess(a[18], a[17]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if PointLess(a[20], a[19]) {
+		a[19], a[20] = a[20], a[19]
+	}
+	if PointLess(a[12], a[0]) {
+		a[0], a[12] = a[12], a[0]
+	}
+	if PointLess(a[13], a[1]) {
+		a[1], a[13] = a[13], a[1]
+	}
+	if PointLess(a[14], a[2]) {
+		a[2], a[14] = a[14], a[2]
+	}
+	if PointLess(a[15], a[3]) {
+		a[3], a[15] = a[15], a[3]
+	}
+	if PointLess(a[16], a[4]) {
+		a[4], a[16] = a[16], a[4]
+	}
+	if PointLess(a[17], a[5]) {
+		a[5], a[17] = a[17], a[5]
+	}
+	if PointLess(a[18], a[6]) {
+		a[6], a[18] = a[18], a[6]
+	}
+	if PointLess(a[19], a[7]) {
+		a[7], a[19] = a[19], a[7]
+	}
+	if PointLess(a[20], a[8]) {
+		a[8], a[20] = a[20], a[8]
+	}
+	if PointLess(a[21], a[9]) {
+		a[9], a[21] = a[21], a[9]
+	}
+	if PointLess(a[22], a[10]) {
+		a[10], a[22] = a[22], a[10]
+	}
+	if PointLess(a[23], a[11]) {
+		a[11], a[23] = a[23], a[11]
+	}
+	if PointLess(a[12], a[2]) {
+		a[2], a[12] = a[12], a[2]
+	}
+	if PointLess(a[13], a[3]) {
+		a[3], a[13] = a[13], a[3]
+	}
+	if PointLess(a[20], a[10]) {
+		a[10], a[20] = a[20], a[10]
+	}
+	if PointLess(a[21], a[11]) {
+		a[11], a[21] = a[21], a[11]
+	}
+	if PointLess(a[12], a[4]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if PointLess(a[13], a[5]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if PointLess(a[14], a[6]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if PointLess(a[15], a[7]) {
+		a[7], a[15] = a[15], a[7]
+	}
+	if PointLess(a[16], a[8]) {
+		a[8], a[16] = a[16], a[8]
+	}
+	if PointLess(a[17], a[9]) {
+		a[9], a[17] = a[17], a[9]
+	}
+	if PointLess(a[18], a[10]) {
+		a[10], a[18] = a[18], a[10]
+	}
+	if PointLess(a[19], a[11]) {
+		a[11], a[19] = a[19], a[11]
+	}
+	if PointLess(a[12], a[8]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if PointLess(a[13], a[9]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if PointLess(a[14], a[10]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if PointLess(a[15], a[11]) {
+		a[11], a[15] = a[15], a[11]
+	}
+	if PointLess(a[8], a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if PointLess(a[12], a[10]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if PointLess(a[16], a[14]) {
+		a[14], a[16] = a[16], a[14]
+	}
+	if PointLess(a[9], a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if PointLess(a[13], a[11]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if PointLess(a[17], a[15]) {
+		a[15], a[17] = a[17], a[15]
+	}
+	if PointLess(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[8], a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if PointLess(a[10], a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if PointLess(a[12], a[11]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if PointLess(a[14], a[13]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if PointLess(a[16], a[15]) {
+		a[15], a[16] = a[16], a[15]
+	}
+	if PointLess(a[18], a[17]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if PointLess(a[20], a[19]) {
+		a[19], a[20] = a[20], a[19]
+	}
+	if PointLess(a[22], a[21]) {
+		a[21], a[22] = a[22], a[21]
+	}
+}
+
+func NetworkSort24xPointReverse(a []Point) {
+	_ = a[23]
+	if PointLess(a[0], a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[2], a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[4], a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if PointLess(a[6], a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[8], a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if PointLess(a[10], a[11]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if PointLess(a[12], a[13]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if PointLess(a[14], a[15]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if PointLess(a[16], a[17]) {
+		a[16], a[17] = a[17], a[16]
+	}
+	if PointLess(a[18], a[19]) {
+		a[18], a[19] = a[19], a[18]
+	}
+	if PointLess(a[20], a[21]) {
+		a[20], a[21] = a[21], a[20]
+	}
+	if PointLess(a[22], a[23]) {
+		a[22], a[23] = a[23], a[22]
+	}
+	if PointLess(a[1], a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[5], a[7]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if PointLess(a[9], a[11]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if PointLess(a[0], a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[4], a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if PointLess(a[8], a[10]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if PointLess(a[13], a[15]) {
+		a[13], a[15] = a[15], a[13]
+	}
+	if PointLess(a[17], a[19]) {
+		a[17], a[19] = a[19], a[17]
+	}
+	if PointLess(a[21], a[23]) {
+		a[21], a[23] = a[23], a[21]
+	}
+	if PointLess(a[12], a[14]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if PointLess(a[16], a[18]) {
+		a[16], a[18] = a[18], a[16]
+	}
+	if PointLess(a[20], a[22]) {
+		a[20], a[22] = a[22], a[20]
+	}
+	if PointLess(a[1], a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[5], a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[9], a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if PointLess(a[13], a[14]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if PointLess(a[17], a[18]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if PointLess(a[21], a[22]) {
+		a[21], a[22] = a[22], a[21]
+	}
+	if PointLess(a[1], a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if PointLess(a[6], a[10]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if PointLess(a[13], a[17]) {
+		a[13], a[17] = a[17], a[13]
+	}
+	if PointLess(a[18], a[22]) {
+		a[18], a[22] = a[22], a[18]
+	}
+	if PointLess(a[5], a[9]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if PointLess(a[2], a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if PointLess(a[17], a[21]) {
+		a[17], a[21] = a[21], a[17]
+	}
+	if PointLess(a[14], a[18]) {
+		a[14], a[18] = a[18], a[14]
+	}
+	if PointLess(a[1], a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if PointLess(a[6], a[10]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if PointLess(a[0], a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if PointLess(a[7], a[11]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if PointLess(a[13], a[17]) {
+		a[13], a[17] = a[17], a[13]
+	}
+	if PointLess(a[18], a[22]) {
+		a[18], a[22] = a[22], a[18]
+	}
+	if PointLess(a[12], a[16]) {
+		a[12], a[16] = a[16], a[12]
+	}
+	if PointLess(a[19], a[23]) {
+		a[19], a[23] = a[23], a[19]
+	}
+	if PointLess(a[3], a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if PointLess(a[4], a[8]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if PointLess(a[15], a[19]) {
+		a[15], a[19] = a[19], a[15]
+	}
+	if PointLess(a[16], a[20]) {
+		a[16], a[20] = a[20], a[16]
+	}
+	if PointLess(a[0], a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if PointLess(a[7], a[11]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if PointLess(a[12], a[16]) {
+		a[12], a[16] = a[16], a[12]
+	}
+	if PointLess(a[19], a[23]) {
+		a[19], a[23] = a[23], a[19]
+	}
+	if PointLess(a[1], a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if PointLess(a[7], a[10]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if PointLess(a[3], a[8]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if PointLess(a[13], a[16]) {
+		a[13], a[16] = a[16], a[13]
+	}
+	if PointLess(a[19], a[22]) {
+		a[19], a[22] = a[22], a[19]
+	}
+	if PointLess(a[15], a[20]) {
+		a[15], a[20] = a[20], a[15]
+	}
+	if PointLess(a[2], a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[8], a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if PointLess(a[14], a[15]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if PointLess(a[20], a[21]) {
+		a[20], a[21] = a[21], a[20]
+	}
+	if PointLess(a[2], a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if PointLess(a[7], a[9]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if PointLess(a[3], a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if PointLess(a[6], a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if PointLess(a[14], a[16]) {
+		a[14], a[16] = a[16], a[14]
+	}
+	if PointLess(a[19], a[21]) {
+		a[19], a[21] = a[21], a[19]
+	}
+	if PointLess(a[15], a[17]) {
+		a[15], a[17] = a[17], a[15]
+	}
+	if PointLess(a[18], a[20]) {
+		a[18], a[20] = a[20], a[18]
+	}
+	if PointLess(a[3], a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[5], a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[7], a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if PointLess(a[15], a[16]) {
+		a[15], a[16] = a[16], a[15]
+	}
+	if PointLess(a[17], a[18]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if PointLess(a[19], a[20]) {
+		a[19], a[20] = a[20], a[19]
+	}
+	if PointLess(a[0], a[12]) {
+		a[0], a[12] = a[12], a[0]
+	}
+	if PointLess(a[1], a[13]) {
+		a[1], a[13] = a[13], a[1]
+	}
+	if PointLess(a[2], a[14]) {
+		a[2], a[14] = a[14], a[2]
+	}
+	if PointLess(a[3], a[15]) {
+		a[3], a[15] = a[15], a[3]
+	}
+	if PointLess(a[4], a[16]) {
+		a[4], a[16] = a[16], a[4]
+	}
+	if PointLess(a[5], a[17]) {
+		a[5], a[17] = a[17], a[5]
+	}
+	if PointLess(a[6], a[18]) {
+		a[6], a[18] = a[18], a[6]
+	}
+	if PointLess(a[7], a[19]) {
+		a[7], a[19] = a[19], a[7]
+	}
+	if PointLess(a[8], a[20]) {
+		a[8], a[20] = a[20], a[8]
+	}
+	if PointLess(a[9], a[21]) {
+		a[9], a[21] = a[21], a[9]
+	}
+	if PointLess(a[10], a[22]) {
+		a[10], a[22] = a[22], a[10]
+	}
+	if PointLess(a[11], a[23]) {
+		a[11], a[23] = a[23], a[11]
+	}
+	if PointLess(a[2], a[12]) {
+		a[2], a[12] = a[12], a[2]
+	}
+	if PointLess(a[3], a[13]) {
+		a[3], a[13] = a[13], a[3]
+	}
+	if PointLess(a[10], a[20]) {
+		a[10], a[20] = a[20], a[10]
+	}
+	if PointLess(a[11], a[21]) {
+		a[11], a[21] = a[21], a[11]
+	}
+	if PointLess(a[4], a[12]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if PointLess(a[5], a[13]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if PointLess(a[6], a[14]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if PointLess(a[7], a[15]) {
+		a[7], a[15] = a[15], a[7]
+	}
+	if PointLess(a[8], a[16]) {
+		a[8], a[16] = a[16], a[8]
+	}
+	if PointLess(a[9], a[17]) {
+		a[9], a[17] = a[17], a[9]
+	}
+	if PointLess(a[10], a[18]) {
+		a[10], a[18] = a[18], a[10]
+	}
+	if PointLess(a[11], a[19]) {
+		a[11], a[19] = a[19], a[11]
+	}
+	if PointLess(a[8], a[12]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if PointLess(a[9], a[13]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if PointLess(a[10], a[14]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if PointLess(a[11], a[15]) {
+		a[11], a[15] = a[15], a[11]
+	}
+	if PointLess(a[6], a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if PointLess(a[10], a[12]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if PointLess(a[14], a[16]) {
+		a[14], a[16] = a[16], a[14]
+	}
+	if PointLess(a[7], a[9]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if PointLess(a[11], a[13]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if PointLess(a[15], a[17]) {
+		a[15], a[17] = a[17], a[15]
+	}
+	if PointLess(a[1], a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[3], a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[5], a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[7], a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if PointLess(a[9], a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if PointLess(a[11], a[12]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if PointLess(a[13], a[14]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if PointLess(a[15], a[16]) {
+		a[15], a[16] = a[16], a[15]
+	}
+	if PointLess(a[17], a[18]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if PointLess(a[19], a[20]) {
+		a[19], a[20] = a[20], a[19]
+	}
+	if PointLess(a[21], a[22]) {
+		a[21], a[22] = a[22], a[21]
+	}
+}
+
+func NetworkSort32xPoint(a []Point) {
+	_ = a[31]
+	if PointLess(a[1], a[0]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[3], a[2]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[2], a[0]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[3], a[1]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[2], a[1]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[5], a[4]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if PointLess(a[7], a[6]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[6], a[4]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if PointLess(a[7], a[5]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if PointLess(a[6], a[5]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[4], a[0]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if PointLess(a[5], a[1]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if PointLess(a[4], a[1]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if PointLess(a[6], a[2]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if PointLess(a[7], a[3]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if PointLess(a[6], a[3]) {
+		a[3], a[6] = a[6], a[3]
+	}
+	if PointLess(a[4], a[2]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if PointLess(a[5], a[3]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if PointLess(a[4], a[3]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[9], a[8]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if PointLess(a[11], a[10]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if PointLess(a[10], a[8]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if PointLess(a[11], a[9]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if PointLess(a[10], a[9]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if PointLess(a[13], a[12]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if PointLess(a[15], a[14]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if PointLess(a[14], a[12]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if PointLess(a[15], a[13]) {
+		a[13], a[15] = a[15], a[13]
+	}
+	if PointLess(a[14], a[13]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if PointLess(a[12], a[8]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if PointLess(a[13], a[9]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if PointLess(a[12], a[9]) {
+		a[9], a[12] = a[12], a[9]
+	}
+	if PointLess(a[14], a[10]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if PointLess(a[15], a[11]) {
+		a[11], a[15] = a[15], a[11]
+	}
+	if PointLess(a[14], a[11]) {
+		a[11], a[14] = a[14], a[11]
+	}
+	if PointLess(a[12], a[10]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if PointLess(a[13], a[11]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if PointLess(a[12], a[11]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if PointLess(a[8], a[0]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if PointLess(a[9], a[1]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if PointLess(a[8], a[1]) {
+		a[1], a[8] = a[8], a[1]
+	}
+	if PointLess(a[10], a[2]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if PointLess(a[11], a[3]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if PointLess(a[10], a[3]) {
+		a[3], a[10] = a[10], a[3]
+	}
+	if PointLess(a[8], a[2]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if PointLess(a[9], a[3]) {
+		a[3], a[9] = a[9], a[3]
+	}
+	if PointLess(a[8], a[3]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if PointLess(a[12], a[4]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if PointLess(a[13], a[5]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if PointLess(a[12], a[5]) {
+		a[5], a[12] = a[12], a[5]
+	}
+	if PointLess(a[14], a[6]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if PointLess(a[15], a[7]) {
+		a[7], a[15] = a[15], a[7]
+	}
+	if PointLess(a[14], a[7]) {
+		a[7], a[14] = a[14], a[7]
+	}
+	if PointLess(a[12], a[6]) {
+		a[6], a[12] = a[12], a[6]
+	}
+	if PointLess(a[13], a[7]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if PointLess(a[12], a[7]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if PointLess(a[8], a[4]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if PointLess(a[9], a[5]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if PointLess(a[8], a[5]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if PointLess(a[10], a[6]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if PointLess(a[11], a[7]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if PointLess(a[10], a[7]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if PointLess(a[8], a[6]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if PointLess(a[9], a[7]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if PointLess(a[8], a[7]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if PointLess(a[17], a[16]) {
+		a[16], a[17] = a[17], a[16]
+	}
+	if PointLess(a[19], a[18]) {
+		a[18], a[19] = a[19], a[18]
+	}
+	if PointLess(a[18], a[16]) {
+		a[16], a[18] = a[18], a[16]
+	}
+	if PointLess(a[19], a[17]) {
+		a[17], a[19] = a[19], a[17]
+	}
+	if PointLess(a[18], a[17]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if PointLess(a[21], a[20]) {
+		a[20], a[21] = a[21], a[20]
+	}
+	if PointLess(a[23], a[22]) {
+		a[22], a[23] = a[23], a[22]
+	}
+	if PointLess(a[22], a[20]) {
+		a[20], a[22] = a[22], a[20]
+	}
+	if PointLess(a[23], a[21]) {
+		a[21], a[23] = a[23], a[21]
+	}
+	if PointLess(a[22], a[21]) {
+		a[21], a[22] = a[22], a[21]
+	}
+	if PointLess(a[20], a[16]) {
+		a[16], a[20] = a[20], a[16]
+	}
+	if PointLess(a[21], a[17]) {
+		a[17], a[21] = a[21], a[17]
+	}
+	if PointLess(a[20], a[17]) {
+		a[17], a[20] = a[20], a[17]
+	}
+	if PointLess(a[22], a[18]) {
+		a[18], a[22] = a[22], a[18]
+	}
+	if PointLess(a[23], a[19]) {
+		a[19], a[23] = a[23], a[19]
+	}
+	if PointLess(a[22], a[19]) {
+		a[19], a[22] = a[22], a[19]
+	}
+	if PointLess(a[20], a[18]) {
+		a[18], a[20] = a[20], a[18]
+	}
+	if PointLess(a[21], a[19]) {
+		a[19], a[21] = a[21], a[19]
+	}
+	if PointLess(a[20], a[19]) {
+		a[19], a[20] = a[20], a[19]
+	}
+	if PointLess(a[25], a[24]) {
+		a[24], a[25] = a[25], a[24]
+	}
+	if PointLess(a[27], a[26]) {
+		a[26], a[27] = a[27], a[26]
+	}
+	if PointLess(a[26], a[24]) {
+		a[24], a[26] = a[26], a[24]
+	}
+	if PointLess(a[27], a[25]) {
+		a[25], a[27] = a[27], a[25]
+	}
+	if PointLess(a[26], a[25]) {
+		a[25], a[26] = a[26], a[25]
+	}
+	if PointLess(a[29], a[28]) {
+		a[28], a[29] = a[29], a[28]
+	}
+	if PointLess(a[31], a[30]) {
+		a[30], a[31] = a[31], a[30]
+	}
+	if PointLess(a[30], a[28]) {
+		a[28], a[30] = a[30], a[28]
+	}
+	if PointLess(a[31], a[29]) {
+		a[29], a[31] = a[31], a[29]
+	}
+	if PointLess(a[30], a[29]) {
+		a[29], a[30] = a[30], a[29]
+	}
+	if PointLess(a[28], a[24]) {
+		a[24], a[28] = a[28], a[24]
+	}
+	if PointLess(a[29], a[25]) {
+		a[25], a[29] = a[29], a[25]
+	}
+	if PointLess(a[28], a[25]) {
+		a[25], a[28] = a[28], a[25]
+	}
+	if PointLess(a[30], a[26]) {
+		a[26], a[30] = a[30], a[26]
+	}
+	if PointLess(a[31], a[27]) {
+		a[27], a[31] = a[31], a[27]
+	}
+	if PointLess(a[30], a[27]) {
+		a[27], a[30] = a[30], a[27]
+	}
+	if PointLess(a[28], a[26]) {
+		a[26], a[28] = a[28], a[26]
+	}
+	if PointLess(a[29], a[27]) {
+		a[27], a[29] = a[29], a[27]
+	}
+	if PointLess(a[28], a[27]) {
+		a[27], a[28] = a[28], a[27]
+	}
+	if PointLess(a[24], a[16]) {
+		a[16], a[24] = a[24], a[16]
+	}
+	if PointLess(a[25], a[17]) {
+		a[17], a[25] = a[25], a[17]
+	}
+	if PointLess(a[24], a[17]) {
+		a[17], a[24] = a[24], a[17]
+	}
+	if PointLess(a[26], a[18]) {
+		a[18], a[26] = a[26], a[18]
+	}
+	if PointLess(a[27], a[19]) {
+		a[19], a[27] = a[27], a[19]
+	}
+	if PointLess(a[26], a[19]) {
+		a[19], a[26] = a[26], a[19]
+	}
+	if PointLess(a[24], a[18]) {
+		a[18], a[24] = a[24], a[18]
+	}
+	if PointLess(a[25], a[19]) {
+		a[19], a[25] = a[25], a[19]
+	}
+	if PointLess(a[24], a[19]) {
+		a[19], a[24] = a[24], a[19]
+	}
+	if PointLess(a[28], a[20]) {
+		a[20], a[28] = a[28], a[20]
+	}
+	if PointLess(a[29], a[21]) {
+		a[21], a[29] = a[29], a[21]
+	}
+	if PointLess(a[28], a[21]) {
+		a[21], a[28] = a[28], a[21]
+	}
+	if PointLess(a[30], a[22]) {
+		a[22], a[30] = a[30], a[22]
+	}
+	if PointLess(a[31], a[23]) {
+		a[23], a[31] = a[31], a[23]
+	}
+	if PointLess(a[30], a[23]) {
+		a[23], a[30] = a[30], a[23]
+	}
+	if PointLess(a[28], a[22]) {
+		a[22], a[28] = a[28], a[22]
+	}
+	if PointLess(a[29], a[23]) {
+		a[23], a[29] = a[29], a[23]
+	}
+	if PointLess(a[28], a[23]) {
+		a[23], a[28] = a[28], a[23]
+	}
+	if PointLess(a[24], a[20]) {
+		a[20], a[24] = a[24], a[20]
+	}
+	if PointLess(a[25], a[21]) {
+		a[21], a[25] = a[25], a[21]
+	}
+	if PointLess(a[24], a[21]) {
+		a[21], a[24] = a[24], a[21]
+	}
+	if PointLess(a[26], a[22]) {
+		a[22], a[26] = a[26], a[22]
+	}
+	if PointLess(a[27], a[23]) {
+		a[23], a[27] = a[27], a[23]
+	}
+	if PointLess(a[26], a[23]) {
+		a[23], a[26] = a[26], a[23]
+	}
+	if PointLess(a[24], a[22]) {
+		a[22], a[24] = a[24], a[22]
+	}
+	if PointLess(a[25], a[23]) {
+		a[23], a[25] = a[25], a[23]
+	}
+	if PointLess(a[24], a[23]) {
+		a[23], a[24] = a[24], a[23]
+	}
+	if PointLess(a[16], a[0]) {
+		a[0], a[16] = a[16], a[0]
+	}
+	if PointLess(a[17], a[1]) {
+		a[1], a[17] = a[17], a[1]
+	}
+	if PointLess(a[16], a[1]) {
+		a[1], a[16] = a[16], a[1]
+	}
+	if PointLess(a[18], a[2]) {
+		a[2], a[18] = a[18], a[2]
+	}
+	if PointLess(a[19], a[3]) {
+		a[3], a[19] = a[19], a[3]
+	}
+	if PointLess(a[18], a[3]) {
+		a[3], a[18] = a[18], a[3]
+	}
+	if PointLess(a[16], a[2]) {
+		a[2], a[16] = a[16], a[2]
+	}
+	if PointLess(a[17], a[3]) {
+		a[3], a[17] = a[17], a[3]
+	}
+	if PointLess(a[16], a[3]) {
+		a[3], a[16] = a[16], a[3]
+	}
+	if PointLess(a[20], a[4]) {
+		a[4], a[20] = a[20], a[4]
+	}
+	if PointLess(a[21], a[5]) {
+		a[5], a[21] = a[21], a[5]
+	}
+	if PointLess(a[20], a[5]) {
+		a[5], a[20] = a[20], a[5]
+	}
+	if PointLess(a[22], a[6]) {
+		a[6], a[22] = a[22], a[6]
+	}
+	if PointLess(a[23], a[7]) {
+		a[7], a[23] = a[23], a[7]
+	}
+	if PointLess(a[22], a[7]) {
+		a[7], a[22] = a[22], a[7]
+	}
+	if PointLess(a[20], a[6]) {
+		a[6], a[20] = a[20], a[6]
+	}
+	if PointLess(a[21], a[7]) {
+		a[7], a[21] = a[21], a[7]
+	}
+	if PointLess(a[20], a[7]) {
+		a[7], a[20] = a[20], a[7]
+	}
+	if PointLess(a[16], a[4]) {
+		a[4], a[16] = a[16], a[4]
+	}
+	if PointLess(a[17], a[5]) {
+		a[5], a[17] = a[17], a[5]
+	}
+	if PointLess(a[16], a[5]) {
+		a[5], a[16] = a[16], a[5]
+	}
+	if PointLess(a[18], a[6]) {
+		a[6], a[18] = a[18], a[6]
+	}
+	if PointLess(a[19], a[7]) {
+		a[7], a[19] = a[19], a[7]
+	}
+	if PointLess(a[18], a[7]) {
+		a[7], a[18] = a[18], a[7]
+	}
+	if PointLess(a[16], a[6]) {
+		a[6], a[16] = a[16], a[6]
+	}
+	if PointLess(a[17], a[7]) {
+		a[7], a[17] = a[17], a[7]
+	}
+	if PointLess(a[16], a[7]) {
+		a[7], a[16] = a[16], a[7]
+	}
+	if PointLess(a[24], a[8]) {
+		a[8], a[24] = a[24], a[8]
+	}
+	if PointLess(a[25], a[9]) {
+		a[9], a[25] = a[25], a[9]
+	}
+	if PointLess(a[24], a[9]) {
+		a[9], a[24] = a[24], a[9]
+	}
+	if PointLess(a[26], a[10]) {
+		a[10], a[26] = a[26], a[10]
+	}
+	if PointLess(a[27], a[11]) {
+		a[11], a[27] = a[27], a[11]
+	}
+	if PointLess(a[26], a[11]) {
+		a[11], a[26] = a[26], a[11]
+	}
+	if PointLess(a[24], a[10]) {
+		a[10], a[24] = a[24], a[10]
+	}
+	if PointLess(a[25], a[11]) {
+		a[11], a[25] = a[25], a[11]
+	}
+	if PointLess(a[24], a[11]) {
+		a[11], a[24] = a[24], a[11]
+	}
+	if PointLess(a[28], a[12]) {
+		a[12], a[28] = a[28], a[12]
+	}
+	if PointLess(a[29], a[13]) {
+		a[13], a[29] = a[29], a[13]
+	}
+	if PointLess(a[28], a[13]) {
+		a[13], a[28] = a[28], a[13]
+	}
+	if PointLess(a[30], a[14]) {
+		a[14], a[30] = a[30], a[14]
+	}
+	if PointLess(a[31], a[15]) {
+		a[15], a[31] = a[31], a[15]
+	}
+	if PointLess(a[30], a[15]) {
+		a[15], a[30] = a[30], a[15]
+	}
+	if PointLess(a[28], a[14]) {
+		a[14], a[28] = a[28], a[14]
+	}
+	if PointLess(a[29], a[15]) {
+		a[15], a[29] = a[29], a[15]
+	}
+	if PointLess(a[28], a[15]) {
+		a[15], a[28] = a[28], a[15]
+	}
+	if PointLess(a[24], a[12]) {
+		a[12], a[24] = a[24], a[12]
+	}
+	if PointLess(a[25], a[13]) {
+		a[13], a[25] = a[25], a[13]
+	}
+	if PointLess(a[24], a[13]) {
+		a[13], a[24] = a[24], a[13]
+	}
+	if PointLess(a[26], a[14]) {
+		a[14], a[26] = a[26], a[14]
+	}
+	if PointLess(a[27], a[15]) {
+		a[15], a[27] = a[27], a[15]
+	}
+	if PointLess(a[26], a[15]) {
+		a[15], a[26] = a[26], a[15]
+	}
+	if PointLess(a[24], a[14]) {
+		a[14], a[24] = a[24], a[14]
+	}
+	if PointLess(a[25], a[15]) {
+		a[15], a[25] = a[25], a[15]
+	}
+	if PointLess(a[24], a[15]) {
+		a[15], a[24] = a[24], a[15]
+	}
+	if PointLess(a[16], a[8]) {
+		a[8], a[16] = a[16], a[8]
+	}
+	if PointLess(a[17], a[9]) {
+		a[9], a[17] = a[17], a[9]
+	}
+	if PointLess(a[16], a[9]) {
+		a[9], a[16] = a[16], a[9]
+	}
+	if PointLess(a[18], a[10]) {
+		a[10], a[18] = a[18], a[10]
+	}
+	if PointLess(a[19], a[11]) {
+		a[11], a[19] = a[19], a[11]
+	}
+	if PointLess(a[18], a[11]) {
+		a[11], a[18] = a[18], a[11]
+	}
+	if PointLess(a[16], a[10]) {
+		a[10], a[16] = a[16], a[10]
+	}
+	if PointLess(a[17], a[11]) {
+		a[11], a[17] = a[17], a[11]
+	}
+	if PointLess(a[16], a[11]) {
+		a[11], a[16] = a[16], a[11]
+	}
+	if PointLess(a[20], a[12]) {
+		a[12], a[20] = a[20], a[12]
+	}
+	if PointLess(a[21], a[13]) {
+		a[13], a[21] = a[21], a[13]
+	}
+	if PointLess(a[20], a[13]) {
+		a[13], a[20] = a[20], a[13]
+	}
+	if PointLess(a[22], a[14]) {
+		a[14], a[22] = a[22], a[14]
+	}
+	if PointLess(a[23], a[15]) {
+		a[15], a[23] = a[23], a[15]
+	}
+	if PointLess(a[22], a[15]) {
+		a[15], a[22] = a[22], a[15]
+	}
+	if PointLess(a[20], a[14]) {
+		a[14], a[20] = a[20], a[14]
+	}
+	if PointLess(a[21], a[15]) {
+		a[15], a[21] = a[21], a[15]
+	}
+	if PointLess(a[20], a[15]) {
+		a[15], a[20] = a[20], a[15]
+	}
+	if PointLess(a[16], a[12]) {
+		a[12], a[16] = a[16], a[12]
+	}
+	if PointLess(a[17], a[13]) {
+		a[13], a[17] = a[17], a[13]
+	}
+	if PointLess(a[16], a[13]) {
+		a[13], a[16] = a[16], a[13]
+	}
+	if PointLess(a[18], a[14]) {
+		a[14], a[18] = a[18], a[14]
+	}
+	if PointLess(a[19], a[15]) {
+		a[15], a[19] = a[19], a[15]
+	}
+	if PointLess(a[18], a[15]) {
+		a[15], a[18] = a[18], a[15]
+	}
+	if PointLess(a[16], a[14]) {
+		a[14], a[16] = a[16], a[14]
+	}
+	if PointLess(a[17], a[15]) {
+		a[15], a[17] = a[17], a[15]
+	}
+	if PointLess(a[16], a[15]) {
+		a[15], a[16] = a[16], a[15]
+	}
+}
+
+func NetworkSort32xPointReverse(a []Point) {
+	_ = a[31]
+	if PointLess(a[0], a[1]) {
+		a[0], a[1] = a[1], a[0]
+	}
+	if PointLess(a[2], a[3]) {
+		a[2], a[3] = a[3], a[2]
+	}
+	if PointLess(a[0], a[2]) {
+		a[0], a[2] = a[2], a[0]
+	}
+	if PointLess(a[1], a[3]) {
+		a[1], a[3] = a[3], a[1]
+	}
+	if PointLess(a[1], a[2]) {
+		a[1], a[2] = a[2], a[1]
+	}
+	if PointLess(a[4], a[5]) {
+		a[4], a[5] = a[5], a[4]
+	}
+	if PointLess(a[6], a[7]) {
+		a[6], a[7] = a[7], a[6]
+	}
+	if PointLess(a[4], a[6]) {
+		a[4], a[6] = a[6], a[4]
+	}
+	if PointLess(a[5], a[7]) {
+		a[5], a[7] = a[7], a[5]
+	}
+	if PointLess(a[5], a[6]) {
+		a[5], a[6] = a[6], a[5]
+	}
+	if PointLess(a[0], a[4]) {
+		a[0], a[4] = a[4], a[0]
+	}
+	if PointLess(a[1], a[5]) {
+		a[1], a[5] = a[5], a[1]
+	}
+	if PointLess(a[1], a[4]) {
+		a[1], a[4] = a[4], a[1]
+	}
+	if PointLess(a[2], a[6]) {
+		a[2], a[6] = a[6], a[2]
+	}
+	if PointLess(a[3], a[7]) {
+		a[3], a[7] = a[7], a[3]
+	}
+	if PointLess(a[3], a[6]) {
+		a[3], a[6] = a[6], a[3]
+	}
+	if PointLess(a[2], a[4]) {
+		a[2], a[4] = a[4], a[2]
+	}
+	if PointLess(a[3], a[5]) {
+		a[3], a[5] = a[5], a[3]
+	}
+	if PointLess(a[3], a[4]) {
+		a[3], a[4] = a[4], a[3]
+	}
+	if PointLess(a[8], a[9]) {
+		a[8], a[9] = a[9], a[8]
+	}
+	if PointLess(a[10], a[11]) {
+		a[10], a[11] = a[11], a[10]
+	}
+	if PointLess(a[8], a[10]) {
+		a[8], a[10] = a[10], a[8]
+	}
+	if PointLess(a[9], a[11]) {
+		a[9], a[11] = a[11], a[9]
+	}
+	if PointLess(a[9], a[10]) {
+		a[9], a[10] = a[10], a[9]
+	}
+	if PointLess(a[12], a[13]) {
+		a[12], a[13] = a[13], a[12]
+	}
+	if PointLess(a[14], a[15]) {
+		a[14], a[15] = a[15], a[14]
+	}
+	if PointLess(a[12], a[14]) {
+		a[12], a[14] = a[14], a[12]
+	}
+	if PointLess(a[13], a[15]) {
+		a[13], a[15] = a[15], a[13]
+	}
+	if PointLess(a[13], a[14]) {
+		a[13], a[14] = a[14], a[13]
+	}
+	if PointLess(a[8], a[12]) {
+		a[8], a[12] = a[12], a[8]
+	}
+	if PointLess(a[9], a[13]) {
+		a[9], a[13] = a[13], a[9]
+	}
+	if PointLess(a[9], a[12]) {
+		a[9], a[12] = a[12], a[9]
+	}
+	if PointLess(a[10], a[14]) {
+		a[10], a[14] = a[14], a[10]
+	}
+	if PointLess(a[11], a[15]) {
+		a[11], a[15] = a[15], a[11]
+	}
+	if PointLess(a[11], a[14]) {
+		a[11], a[14] = a[14], a[11]
+	}
+	if PointLess(a[10], a[12]) {
+		a[10], a[12] = a[12], a[10]
+	}
+	if PointLess(a[11], a[13]) {
+		a[11], a[13] = a[13], a[11]
+	}
+	if PointLess(a[11], a[12]) {
+		a[11], a[12] = a[12], a[11]
+	}
+	if PointLess(a[0], a[8]) {
+		a[0], a[8] = a[8], a[0]
+	}
+	if PointLess(a[1], a[9]) {
+		a[1], a[9] = a[9], a[1]
+	}
+	if PointLess(a[1], a[8]) {
+		a[1], a[8] = a[8], a[1]
+	}
+	if PointLess(a[2], a[10]) {
+		a[2], a[10] = a[10], a[2]
+	}
+	if PointLess(a[3], a[11]) {
+		a[3], a[11] = a[11], a[3]
+	}
+	if PointLess(a[3], a[10]) {
+		a[3], a[10] = a[10], a[3]
+	}
+	if PointLess(a[2], a[8]) {
+		a[2], a[8] = a[8], a[2]
+	}
+	if PointLess(a[3], a[9]) {
+		a[3], a[9] = a[9], a[3]
+	}
+	if PointLess(a[3], a[8]) {
+		a[3], a[8] = a[8], a[3]
+	}
+	if PointLess(a[4], a[12]) {
+		a[4], a[12] = a[12], a[4]
+	}
+	if PointLess(a[5], a[13]) {
+		a[5], a[13] = a[13], a[5]
+	}
+	if PointLess(a[5], a[12]) {
+		a[5], a[12] = a[12], a[5]
+	}
+	if PointLess(a[6], a[14]) {
+		a[6], a[14] = a[14], a[6]
+	}
+	if PointLess(a[7], a[15]) {
+		a[7], a[15] = a[15], a[7]
+	}
+	if PointLess(a[7], a[14]) {
+		a[7], a[14] = a[14], a[7]
+	}
+	if PointLess(a[6], a[12]) {
+		a[6], a[12] = a[12], a[6]
+	}
+	if PointLess(a[7], a[13]) {
+		a[7], a[13] = a[13], a[7]
+	}
+	if PointLess(a[7], a[12]) {
+		a[7], a[12] = a[12], a[7]
+	}
+	if PointLess(a[4], a[8]) {
+		a[4], a[8] = a[8], a[4]
+	}
+	if PointLess(a[5], a[9]) {
+		a[5], a[9] = a[9], a[5]
+	}
+	if PointLess(a[5], a[8]) {
+		a[5], a[8] = a[8], a[5]
+	}
+	if PointLess(a[6], a[10]) {
+		a[6], a[10] = a[10], a[6]
+	}
+	if PointLess(a[7], a[11]) {
+		a[7], a[11] = a[11], a[7]
+	}
+	if PointLess(a[7], a[10]) {
+		a[7], a[10] = a[10], a[7]
+	}
+	if PointLess(a[6], a[8]) {
+		a[6], a[8] = a[8], a[6]
+	}
+	if PointLess(a[7], a[9]) {
+		a[7], a[9] = a[9], a[7]
+	}
+	if PointLess(a[7], a[8]) {
+		a[7], a[8] = a[8], a[7]
+	}
+	if PointLess(a[16], a[17]) {
+		a[16], a[17] = a[17], a[16]
+	}
+	if PointLess(a[18], a[19]) {
+		a[18], a[19] = a[19], a[18]
+	}
+	if PointLess(a[16], a[18]) {
+		a[16], a[18] = a[18], a[16]
+	}
+	if PointLess(a[17], a[19]) {
+		a[17], a[19] = a[19], a[17]
+	}
+	if PointLess(a[17], a[18]) {
+		a[17], a[18] = a[18], a[17]
+	}
+	if PointLess(a[20], a[21]) {
+		a[20], a[21] = a[21], a[20]
+	}
+	if PointLess(a[22], a[23]) {
+		a[22], a[23] = a[23], a[22]
+	}
+	if PointLess(a[20], a[22]) {
+		a[20], a[22] = a[22], a[20]
+	}
+	if PointLess(a[21], a[23]) {
+		a[21], a[23] = a[23], a[21]
+	}
+	if PointLess(a[21], a[22]) {
+		a[21], a[22] = a[22], a[21]
+	}
+	if PointLess(a[16], a[20]) {
+		a[16], a[20] = a[20], a[16]
+	}
+	if PointLess(a[17], a[21]) {
+		a[17], a[21] = a[21], a[17]
+	}
+	if PointLess(a[17], a[20]) {
+		a[17], a[20] = a[20], a[17]
+	}
+	if PointLess(a[18], a[22]) {
+		a[18], a[22] = a[22], a[18]
+	}
+	if PointLess(a[19], a[23]) {
+		a[19], a[23] = a[23], a[19]
+	}
+	if PointLess(a[19], a[22]) {
+		a[19], a[22] = a[22], a[19]
+	}
+	if PointLess(a[18], a[20]) {
+		a[18], a[20] = a[20], a[18]
+	}
+	if PointLess(a[19], a[21]) {
+		a[19], a[21] = a[21], a[19]
+	}
+	if PointLess(a[19], a[20]) {
+		a[19], a[20] = a[20], a[19]
+	}
+	if PointLess(a[24], a[25]) {
+		a[24], a[25] = a[25], a[24]
+	}
+	if PointLess(a[26], a[27]) {
+		a[26], a[27] = a[27], a[26]
+	}
+	if PointLess(a[24], a[26]) {
+		a[24], a[26] = a[26], a[24]
+	}
+	if PointLess(a[25], a[27]) {
+		a[25], a[27] = a[27], a[25]
+	}
+	if PointLess(a[25], a[26]) {
+		a[25], a[26] = a[26], a[25]
+	}
+	if PointLess(a[28], a[29]) {
+		a[28], a[29] = a[29], a[28]
+	}
+	if PointLess(a[30], a[31]) {
+		a[30], a[31] = a[31], a[30]
+	}
+	if PointLess(a[28], a[30]) {
+		a[28], a[30] = a[30], a[28]
+	}
+	if PointLess(a[29], a[31]) {
+		a[29], a[31] = a[31], a[29]
+	}
+	if PointLess(a[29], a[30]) {
+		a[29], a[30] = a[30], a[29]
+	}
+	if PointLess(a[24], a[28]) {
+		a[24], a[28] = a[28], a[24]
+	}
+	if PointLess(a[25], a[29]) {
+		a[25], a[29] = a[29], a[25]
+	}
+	if PointLess(a[25], a[28]) {
+		a[25], a[28] = a[28], a[25]
+	}
+	if PointLess(a[26], a[30]) {
+		a[26], a[30] = a[30], a[26]
+	}
+	if PointLess(a[27], a[31]) {
+		a[27], a[31] = a[31], a[27]
+	}
+	if PointLess(a[27], a[30]) {
+		a[27], a[30] = a[30], a[27]
+	}
+	if PointLess(a[26], a[28]) {
+		a[26], a[28] = a[28], a[26]
+	}
+	if PointLess(a[27], a[29]) {
+		a[27], a[29] = a[29], a[27]
+	}
+	if PointLess(a[27], a[28]) {
+		a[27], a[28] = a[28], a[27]
+	}
+	if PointLess(a[16], a[24]) {
+		a[16], a[24] = a[24], a[16]
+	}
+	if PointLess(a[17], a[25]) {
+		a[17], a[25] = a[25], a[17]
+	}
+	if PointLess(a[17], a[24]) {
+		a[17], a[24] = a[24], a[17]
+	}
+	if PointLess(a[18], a[26]) {
+		a[18], a[26] = a[26], a[18]
+	}
+	if PointLess(a[19], a[27]) {
+		a[19], a[27] = a[27], a[19]
+	}
+	if PointLess(a[19], a[26]) {
+		a[19], a[26] = a[26], a[19]
+	}
+	if PointLess(a[18], a[24]) {
+		a[18], a[24] = a[24], a[18]
+	}
+	if PointLess(a[19], a[25]) {
+		a[19], a[25] = a[25], a[19]
+	}
+	if PointLess(a[19], a[24]) {
+		a[19], a[24] = a[24], a[19]
+	}
+	if PointLess(a[20], a[28]) {
+		a[20], a[28] = a[28], a[20]
+	}
+	if PointLess(a[21], a[29]) {
+		a[21], a[29] = a[29], a[21]
+	}
+	if PointLess(a[21], a[28]) {
+		a[21], a[28] = a[28], a[21]
+	}
+	if PointLess(a[22], a[30]) {
+		a[22], a[30] = a[30], a[22]
+	}
+	if PointLess(a[23], a[31]) {
+		a[23], a[31] = a[31], a[23]
+	}
+	if PointLess(a[23], a[30]) {
+		a[23], a[30] = a[30], a[23]
+	}
+	if PointLess(a[22], a[28]) {
+		a[22], a[28] = a[28], a[22]
+	}
+	if PointLess(a[23], a[29]) {
+		a[23], a[29] = a[29], a[23]
+	}
+	if PointLess(a[23], a[28]) {
+		a[23], a[28] = a[28], a[23]
+	}
+	if PointLess(a[20], a[24]) {
+		a[20], a[24] = a[24], a[20]
+	}
+	if PointLess(a[21], a[25]) {
+		a[21], a[25] = a[25], a[21]
+	}
+	if PointLess(a[21], a[24]) {
+		a[21], a[24] = a[24], a[21]
+	}
+	if PointLess(a[22], a[26]) {
+		a[22], a[26] = a[26], a[22]
+	}
+	if PointLess(a[23], a[27]) {
+		a[23], a[27] = a[27], a[23]
+	}
+	if PointLess(a[23], a[26]) {
+		a[23], a[26] = a[26], a[23]
+	}
+	if PointLess(a[22], a[24]) {
+		a[22], a[24] = a[24], a[22]
+	}
+	if PointLess(a[23], a[25]) {
+		a[23], a[25] = a[25], a[23]
+	}
+	if PointLess(a[23], a[24]) {
+		a[23], a[24] = a[24], a[23]
+	}
+	if PointLess(a[0], a[16]) {
+		a[0], a[16] = a[16], a[0]
+	}
+	if PointLess(a[1], a[17]) {
+		a[1], a[17] = a[17], a[1]
+	}
+	if PointLess(a[1], a[16]) {
+		a[1], a[16] = a[16], a[1]
+	}
+	if PointLess(a[2], a[18]) {
+		a[2], a[18] = a[18], a[2]
+	}
+	if PointLess(a[3], a[19]) {
+		a[3], a[19] = a[19], a[3]
+	}
+	if PointLess(a[3], a[18]) {
+		a[3], a[18] = a[18], a[3]
+	}
+	if PointLess(a[2], a[16]) {
+		a[2], a[16] = a[16], a[2]
+	}
+	if PointLess(a[3], a[17]) {
+		a[3], a[17] = a[17], a[3]
+	}
+	if PointLess(a[3], a[16]) {
+		a[3], a[16] = a[16], a[3]
+	}
+	if PointLess(a[4], a[20]) {
+		a[4], a[20] = a[20], a[4]
+	}
+	if PointLess(a[5], a[21]) {
+		a[5], a[21] = a[21], a[5]
+	}
+	if PointLess(a[5], a[20]) {
+		a[5], a[20] = a[20], a[5]
+	}
+	if PointLess(a[6], a[22]) {
+		a[6], a[22] = a[22], a[6]
+	}
+	if PointLess(a[7], a[23]) {
+		a[7], a[23] = a[23], a[7]
+	}
+	if PointLess(a[7], a[22]) {
+		a[7], a[22] = a[22], a[7]
+	}
+	if PointLess(a[6], a[20]) {
+		a[6], a[20] = a[20], a[6]
+	}
+	if PointLess(a[7], a[21]) {
+		a[7], a[21] = a[21], a[7]
+	}
+	if PointLess(a[7], a[20]) {
+		a[7], a[20] = a[20], a[7]
+	}
+	if PointLess(a[4], a[16]) {
+		a[4], a[16] = a[16], a[4]
+	}
+	if PointLess(a[5], a[17]) {
+		a[5], a[17] = a[17], a[5]
+	}
+	if PointLess(a[5], a[16]) {
+		a[5], a[16] = a[16], a[5]
+	}
+	if PointLess(a[6], a[18]) {
+		a[6], a[18] = a[18], a[6]
+	}
+	if PointLess(a[7], a[19]) {
+		a[7], a[19] = a[19], a[7]
+	}
+	if PointLess(a[7], a[18]) {
+		a[7], a[18] = a[18], a[7]
+	}
+	if PointLess(a[6], a[16]) {
+		a[6], a[16] = a[16], a[6]
+	}
+	if PointLess(a[7], a[17]) {
+		a[7], a[17] = a[17], a[7]
+	}
+	if PointLess(a[7], a[16]) {
+		a[7], a[16] = a[16], a[7]
+	}
+	if PointLess(a[8], a[24]) {
+		a[8], a[24] = a[24], a[8]
+	}
+	if PointLess(a[9], a[25]) {
+		a[9], a[25] = a[25], a[9]
+	}
+	if PointLess(a[9], a[24]) {
+		a[9], a[24] = a[24], a[9]
+	}
+	if PointLess(a[10], a[26]) {
+		a[10], a[26] = a[26], a[10]
+	}
+	if PointLess(a[11], a[27]) {
+		a[11], a[27] = a[27], a[11]
+	}
+	if PointLess(a[11], a[26]) {
+		a[11], a[26] = a[26], a[11]
+	}
+	if PointLess(a[10], a[24]) {
+		a[10], a[24] = a[24], a[10]
+	}
+	if PointLess(a[11], a[25]) {
+		a[11], a[25] = a[25], a[11]
+	}
+	if PointLess(a[11], a[24]) {
+		a[11], a[24] = a[24], a[11]
+	}
+	if PointLess(a[12], a[28]) {
+		a[12], a[28] = a[28], a[12]
+	}
+	if PointLess(a[13], a[29]) {
+		a[13], a[29] = a[29], a[13]
+	}
+	if PointLess(a[13], a[28]) {
+		a[13], a[28] = a[28], a[13]
+	}
+	if PointLess(a[14], a[30]) {
+		a[14], a[30] = a[30], a[14]
+	}
+	if PointLess(a[15], a[31]) {
+		a[15], a[31] = a[31], a[15]
+	}
+	if PointLess(a[15], a[30]) {
+		a[15], a[30] = a[30], a[15]
+	}
+	if PointLess(a[14], a[28]) {
+		a[14], a[28] = a[28], a[14]
+	}
+	if PointLess(a[15], a[29]) {
+		a[15], a[29] = a[29], a[15]
+	}
+	if PointLess(a[15], a[28]) {
+		a[15], a[28] = a[28], a[15]
+	}
+	if PointLess(a[12], a[24]) {
+		a[12], a[24] = a[24], a[12]
+	}
+	if PointLess(a[13], a[25]) {
+		a[13], a[25] = a[25], a[13]
+	}
+	if PointLess(a[13], a[24]) {
+		a[13], a[24] = a[24], a[13]
+	}
+	if PointLess(a[14], a[26]) {
+		a[14], a[26] = a[26], a[14]
+	}
+	if PointLess(a[15], a[27]) {
+		a[15], a[27] = a[27], a[15]
+	}
+	if PointLess(a[15], a[26]) {
+		a[15], a[26] = a[26], a[15]
+	}
+	if PointLess(a[14], a[24]) {
+		a[14], a[24] = a[24], a[14]
+	}
+	if PointLess(a[15], a[25]) {
+		a[15], a[25] = a[25], a[15]
+	}
+	if PointLess(a[15], a[24]) {
+		a[15], a[24] = a[24], a[15]
+	}
+	if PointLess(a[8], a[16]) {
+		a[8], a[16] = a[16], a[8]
+	}
+	if PointLess(a[9], a[17]) {
+		a[9], a[17] = a[17], a[9]
+	}
+	if PointLess(a[9], a[16]) {
+		a[9], a[16] = a[16], a[9]
+	}
+	if PointLess(a[10], a[18]) {
+		a[10], a[18] = a[18], a[10]
+	}
+	if PointLess(a[11], a[19]) {
+		a[11], a[19] = a[19], a[11]
+	}
+	if PointLess(a[11], a[18]) {
+		a[11], a[18] = a[18], a[11]
+	}
+	if PointLess(a[10], a[16]) {
+		a[10], a[16] = a[16], a[10]
+	}
+	if PointLess(a[11], a[17]) {
+		a[11], a[17] = a[17], a[11]
+	}
+	if PointLess(a[11], a[16]) {
+		a[11], a[16] = a[16], a[11]
+	}
+	if PointLess(a[12], a[20]) {
+		a[12], a[20] = a[20], a[12]
+	}
+	if PointLess(a[13], a[21]) {
+		a[13], a[21] = a[21], a[13]
+	}
+	if PointLess(a[13], a[20]) {
+		a[13], a[20] = a[20], a[13]
+	}
+	if PointLess(a[14], a[22]) {
+		a[14], a[22] = a[22], a[14]
+	}
+	if PointLess(a[15], a[23]) {
+		a[15], a[23] = a[23], a[15]
+	}
+	if PointLess(a[15], a[22]) {
+		a[15], a[22] = a[22], a[15]
+	}
+	if PointLess(a[14], a[20]) {
+		a[14], a[20] = a[20], a[14]
+	}
+	if PointLess(a[15], a[21]) {
+		a[15], a[21] = a[21], a[15]
+	}
+	if PointLess(a[15], a[20]) {
+		a[15], a[20] = a[20], a[15]
+	}
+	if PointLess(a[12], a[16]) {
+		a[12], a[16] = a[16], a[12]
+	}
+	if PointLess(a[13], a[17]) {
+		a[13], a[17] = a[17], a[13]
+	}
+	if PointLess(a[13], a[16]) {
+		a[13], a[16] = a[16], a[13]
+	}
+	if PointLess(a[14], a[18]) {
+		a[14], a[18] = a[18], a[14]
+	}
+	if PointLess(a[15], a[19]) {
+		a[15], a[19] = a[19], a[15]
+	}
+	if PointLess(a[15], a[18]) {
+		a[15], a[18] = a[18], a[15]
+	}
+	if PointLess(a[14], a[16]) {
+		a[14], a[16] = a[16], a[14]
+	}
+	if PointLess(a[15], a[17]) {
+		a[15], a[17] = a[17], a[15]
+	}
+	if PointLess(a[15], a[16]) {
+		a[15], a[16] = a[16], a[15]
+	}
+}
@@ -0,0 +1,629 @@
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
+
+package gentest
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/shabbyrobe/sortnet/sortnettest"
+)
+
+// testLessfuncGenCase is a function generated by sortnetgen, adapted to be checked by
+// sortnettest. Either sort or merge is set.
+type testLessfuncGenCase[T any] struct {
+	name  string
+	size  int
+	sort  func(a []T)
+	merge func(dst []T, runs [][]T) []T
+}
+
+// testLessfuncGenCasesNetworkSortPoint returns the functions generated for Point
+// in increasing order, along with how to make random items and how they are ordered.
+func testLessfuncGenCasesNetworkSortPoint() (gen func(*rand.Rand) Point, before func(a, b Point) bool, cases []testLessfuncGenCase[Point]) {
+	gen = func(rng *rand.Rand) Point { return Point{X: rng.Intn(32) - 16, Y: rng.Intn(32) - 16} }
+	less := func(a, b Point) bool { return PointLess(a, b) }
+	before = less
+	cases = []testLessfuncGenCase[Point]{
+		{name: "NetworkSort2xPoint", size: 2, sort: func(a []Point) { NetworkSort2xPoint(a) }},
+		{name: "NetworkSort3xPoint", size: 3, sort: func(a []Point) { NetworkSort3xPoint(a) }},
+		{name: "NetworkSort4xPoint", size: 4, sort: func(a []Point) { NetworkSort4xPoint(a) }},
+		{name: "NetworkSort5xPoint", size: 5, sort: func(a []Point) { NetworkSort5xPoint(a) }},
+		{name: "NetworkSort6xPoint", size: 6, sort: func(a []Point) { NetworkSort6xPoint(a) }},
+		{name: "NetworkSort7xPoint", size: 7, sort: func(a []Point) { NetworkSort7xPoint(a) }},
+		{name: "NetworkSort8xPoint", size: 8, sort: func(a []Point) { NetworkSort8xPoint(a) }},
+		{name: "NetworkSort9xPoint", size: 9, sort: func(a []Point) { NetworkSort9xPoint(a) }},
+		{name: "NetworkSort10xPoint", size: 10, sort: func(a []Point) { NetworkSort10xPoint(a) }},
+		{name: "NetworkSort11xPoint", size: 11, sort: func(a []Point) { NetworkSort11xPoint(a) }},
+		{name: "NetworkSort12xPoint", size: 12, sort: func(a []Point) { NetworkSort12xPoint(a) }},
+		{name: "NetworkSort13xPoint", size: 13, sort: func(a []Point) { NetworkSort13xPoint(a) }},
+		{name: "NetworkSort14xPoint", size: 14, sort: func(a []Point) { NetworkSort14xPoint(a) }},
+		{name: "NetworkSort15xPoint", size: 15, sort: func(a []Point) { NetworkSort15xPoint(a) }},
+		{name: "NetworkSort16xPoint", size: 16, sort: func(a []Point) { NetworkSort16xPoint(a) }},
+		{name: "NetworkSort24xPoint", size: 24, sort: func(a []Point) { NetworkSort24xPoint(a) }},
+		{name: "NetworkSort32xPoint", size: 32, sort: func(a []Point) { NetworkSort32xPoint(a) }},
+		{name: "NetworkSortPoint-2", size: 2, sort: func(a []Point) {
+			if !NetworkSortPoint(a, len(a)) {
+				panic("NetworkSortPoint: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortPoint-3", size: 3, sort: func(a []Point) {
+			if !NetworkSortPoint(a, len(a)) {
+				panic("NetworkSortPoint: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortPoint-4", size: 4, sort: func(a []Point) {
+			if !NetworkSortPoint(a, len(a)) {
+				panic("NetworkSortPoint: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortPoint-5", size: 5, sort: func(a []Point) {
+			if !NetworkSortPoint(a, len(a)) {
+				panic("NetworkSortPoint: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortPoint-6", size: 6, sort: func(a []Point) {
+			if !NetworkSortPoint(a, len(a)) {
+				panic("NetworkSortPoint: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortPoint-7", size: 7, sort: func(a []Point) {
+			if !NetworkSortPoint(a, len(a)) {
+				panic("NetworkSortPoint: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortPoint-8", size: 8, sort: func(a []Point) {
+			if !NetworkSortPoint(a, len(a)) {
+				panic("NetworkSortPoint: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortPoint-9", size: 9, sort: func(a []Point) {
+			if !NetworkSortPoint(a, len(a)) {
+				panic("NetworkSortPoint: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortPoint-10", size: 10, sort: func(a []Point) {
+			if !NetworkSortPoint(a, len(a)) {
+				panic("NetworkSortPoint: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortPoint-11", size: 11, sort: func(a []Point) {
+			if !NetworkSortPoint(a, len(a)) {
+				panic("NetworkSortPoint: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortPoint-12", size: 12, sort: func(a []Point) {
+			if !NetworkSortPoint(a, len(a)) {
+				panic("NetworkSortPoint: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortPoint-13", size: 13, sort: func(a []Point) {
+			if !NetworkSortPoint(a, len(a)) {
+				panic("NetworkSortPoint: no sorter for size 13")
+			}
+		}},
+		{name: "NetworkSortPoint-14", size: 14, sort: func(a []Point) {
+			if !NetworkSortPoint(a, len(a)) {
+				panic("NetworkSortPoint: no sorter for size 14")
+			}
+		}},
+		{name: "NetworkSortPoint-15", size: 15, sort: func(a []Point) {
+			if !NetworkSortPoint(a, len(a)) {
+				panic("NetworkSortPoint: no sorter for size 15")
+			}
+		}},
+		{name: "NetworkSortPoint-16", size: 16, sort: func(a []Point) {
+			if !NetworkSortPoint(a, len(a)) {
+				panic("NetworkSortPoint: no sorter for size 16")
+			}
+		}},
+		{name: "NetworkSortPoint-24", size: 24, sort: func(a []Point) {
+			if !NetworkSortPoint(a, len(a)) {
+				panic("NetworkSortPoint: no sorter for size 24")
+			}
+		}},
+		{name: "NetworkSortPoint-32", size: 32, sort: func(a []Point) {
+			if !NetworkSortPoint(a, len(a)) {
+				panic("NetworkSortPoint: no sorter for size 32")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestLessfuncGenNetworkSortPoint(t *testing.T) {
+	gen, before, cases := testLessfuncGenCasesNetworkSortPoint()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzLessfuncGenNetworkSortPoint(f *testing.F) {
+	gen, before, cases := testLessfuncGenCasesNetworkSortPoint()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]Point, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkLessfuncGenNetworkSortPoint(b *testing.B) {
+	gen, before, cases := testLessfuncGenCasesNetworkSortPoint()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testLessfuncGenCasesNetworkSortPointReverse returns the functions generated for Point
+// in decreasing order, along with how to make random items and how they are ordered.
+func testLessfuncGenCasesNetworkSortPointReverse() (gen func(*rand.Rand) Point, before func(a, b Point) bool, cases []testLessfuncGenCase[Point]) {
+	gen = func(rng *rand.Rand) Point { return Point{X: rng.Intn(32) - 16, Y: rng.Intn(32) - 16} }
+	less := func(a, b Point) bool { return PointLess(a, b) }
+	before = func(a, b Point) bool { return less(b, a) }
+	cases = []testLessfuncGenCase[Point]{
+		{name: "NetworkSort2xPointReverse", size: 2, sort: func(a []Point) { NetworkSort2xPointReverse(a) }},
+		{name: "NetworkSort3xPointReverse", size: 3, sort: func(a []Point) { NetworkSort3xPointReverse(a) }},
+		{name: "NetworkSort4xPointReverse", size: 4, sort: func(a []Point) { NetworkSort4xPointReverse(a) }},
+		{name: "NetworkSort5xPointReverse", size: 5, sort: func(a []Point) { NetworkSort5xPointReverse(a) }},
+		{name: "NetworkSort6xPointReverse", size: 6, sort: func(a []Point) { NetworkSort6xPointReverse(a) }},
+		{name: "NetworkSort7xPointReverse", size: 7, sort: func(a []Point) { NetworkSort7xPointReverse(a) }},
+		{name: "NetworkSort8xPointReverse", size: 8, sort: func(a []Point) { NetworkSort8xPointReverse(a) }},
+		{name: "NetworkSort9xPointReverse", size: 9, sort: func(a []Point) { NetworkSort9xPointReverse(a) }},
+		{name: "NetworkSort10xPointReverse", size: 10, sort: func(a []Point) { NetworkSort10xPointReverse(a) }},
+		{name: "NetworkSort11xPointReverse", size: 11, sort: func(a []Point) { NetworkSort11xPointReverse(a) }},
+		{name: "NetworkSort12xPointReverse", size: 12, sort: func(a []Point) { NetworkSort12xPointReverse(a) }},
+		{name: "NetworkSort13xPointReverse", size: 13, sort: func(a []Point) { NetworkSort13xPointReverse(a) }},
+		{name: "NetworkSort14xPointReverse", size: 14, sort: func(a []Point) { NetworkSort14xPointReverse(a) }},
+		{name: "NetworkSort15xPointReverse", size: 15, sort: func(a []Point) { NetworkSort15xPointReverse(a) }},
+		{name: "NetworkSort16xPointReverse", size: 16, sort: func(a []Point) { NetworkSort16xPointReverse(a) }},
+		{name: "NetworkSort24xPointReverse", size: 24, sort: func(a []Point) { NetworkSort24xPointReverse(a) }},
+		{name: "NetworkSort32xPointReverse", size: 32, sort: func(a []Point) { NetworkSort32xPointReverse(a) }},
+		{name: "NetworkSortPointReverse-2", size: 2, sort: func(a []Point) {
+			if !NetworkSortPointReverse(a, len(a)) {
+				panic("NetworkSortPointReverse: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortPointReverse-3", size: 3, sort: func(a []Point) {
+			if !NetworkSortPointReverse(a, len(a)) {
+				panic("NetworkSortPointReverse: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortPointReverse-4", size: 4, sort: func(a []Point) {
+			if !NetworkSortPointReverse(a, len(a)) {
+				panic("NetworkSortPointReverse: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortPointReverse-5", size: 5, sort: func(a []Point) {
+			if !NetworkSortPointReverse(a, len(a)) {
+				panic("NetworkSortPointReverse: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortPointReverse-6", size: 6, sort: func(a []Point) {
+			if !NetworkSortPointReverse(a, len(a)) {
+				panic("NetworkSortPointReverse: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortPointReverse-7", size: 7, sort: func(a []Point) {
+			if !NetworkSortPointReverse(a, len(a)) {
+				panic("NetworkSortPointReverse: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortPointReverse-8", size: 8, sort: func(a []Point) {
+			if !NetworkSortPointReverse(a, len(a)) {
+				panic("NetworkSortPointReverse: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortPointReverse-9", size: 9, sort: func(a []Point) {
+			if !NetworkSortPointReverse(a, len(a)) {
+				panic("NetworkSortPointReverse: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortPointReverse-10", size: 10, sort: func(a []Point) {
+			if !NetworkSortPointReverse(a, len(a)) {
+				panic("NetworkSortPointReverse: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortPointReverse-11", size: 11, sort: func(a []Point) {
+			if !NetworkSortPointReverse(a, len(a)) {
+				panic("NetworkSortPointReverse: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortPointReverse-12", size: 12, sort: func(a []Point) {
+			if !NetworkSortPointReverse(a, len(a)) {
+				panic("NetworkSortPointReverse: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortPointReverse-13", size: 13, sort: func(a []Point) {
+			if !NetworkSortPointReverse(a, len(a)) {
+				panic("NetworkSortPointReverse: no sorter for size 13")
+			}
+		}},
+		{name: "NetworkSortPointReverse-14", size: 14, sort: func(a []Point) {
+			if !NetworkSortPointReverse(a, len(a)) {
+				panic("NetworkSortPointReverse: no sorter for size 14")
+			}
+		}},
+		{name: "NetworkSortPointReverse-15", size: 15, sort: func(a []Point) {
+			if !NetworkSortPointReverse(a, len(a)) {
+				panic("NetworkSortPointReverse: no sorter for size 15")
+			}
+		}},
+		{name: "NetworkSortPointReverse-16", size: 16, sort: func(a []Point) {
+			if !NetworkSortPointReverse(a, len(a)) {
+				panic("NetworkSortPointReverse: no sorter for size 16")
+			}
+		}},
+		{name: "NetworkSortPointReverse-24", size: 24, sort: func(a []Point) {
+			if !NetworkSortPointReverse(a, len(a)) {
+				panic("NetworkSortPointReverse: no sorter for size 24")
+			}
+		}},
+		{name: "NetworkSortPointReverse-32", size: 32, sort: func(a []Point) {
+			if !NetworkSortPointReverse(a, len(a)) {
+				panic("NetworkSortPointReverse: no sorter for size 32")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestLessfuncGenNetworkSortPointReverse(t *testing.T) {
+	gen, before, cases := testLessfuncGenCasesNetworkSortPointReverse()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzLessfuncGenNetworkSortPointReverse(f *testing.F) {
+	gen, before, cases := testLessfuncGenCasesNetworkSortPointReverse()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]Point, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkLessfuncGenNetworkSortPointReverse(b *testing.B) {
+	gen, before, cases := testLessfuncGenCasesNetworkSortPointReverse()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testLessfuncGenCasesNetworkSortNamed returns the functions generated for Named
+// in increasing order, along with how to make random items and how they are ordered.
+func testLessfuncGenCasesNetworkSortNamed() (gen func(*rand.Rand) Named, before func(a, b Named) bool, cases []testLessfuncGenCase[Named]) {
+	gen = func(rng *rand.Rand) Named { return Named{Name: strconv.Itoa(rng.Intn(32))} }
+	less := func(a, b Named) bool { return a.Name < b.Name }
+	before = less
+	cases = []testLessfuncGenCase[Named]{
+		{name: "NetworkSort2xNamed", size: 2, sort: func(a []Named) { NetworkSort2xNamed(a) }},
+		{name: "NetworkSort3xNamed", size: 3, sort: func(a []Named) { NetworkSort3xNamed(a) }},
+		{name: "NetworkSort4xNamed", size: 4, sort: func(a []Named) { NetworkSort4xNamed(a) }},
+		{name: "NetworkSort5xNamed", size: 5, sort: func(a []Named) { NetworkSort5xNamed(a) }},
+		{name: "NetworkSort6xNamed", size: 6, sort: func(a []Named) { NetworkSort6xNamed(a) }},
+		{name: "NetworkSort7xNamed", size: 7, sort: func(a []Named) { NetworkSort7xNamed(a) }},
+		{name: "NetworkSort8xNamed", size: 8, sort: func(a []Named) { NetworkSort8xNamed(a) }},
+		{name: "NetworkSort9xNamed", size: 9, sort: func(a []Named) { NetworkSort9xNamed(a) }},
+		{name: "NetworkSort10xNamed", size: 10, sort: func(a []Named) { NetworkSort10xNamed(a) }},
+		{name: "NetworkSort11xNamed", size: 11, sort: func(a []Named) { NetworkSort11xNamed(a) }},
+		{name: "NetworkSort12xNamed", size: 12, sort: func(a []Named) { NetworkSort12xNamed(a) }},
+		{name: "NetworkSort13xNamed", size: 13, sort: func(a []Named) { NetworkSort13xNamed(a) }},
+		{name: "NetworkSort14xNamed", size: 14, sort: func(a []Named) { NetworkSort14xNamed(a) }},
+		{name: "NetworkSort15xNamed", size: 15, sort: func(a []Named) { NetworkSort15xNamed(a) }},
+		{name: "NetworkSort16xNamed", size: 16, sort: func(a []Named) { NetworkSort16xNamed(a) }},
+		{name: "NetworkSort24xNamed", size: 24, sort: func(a []Named) { NetworkSort24xNamed(a) }},
+		{name: "NetworkSort32xNamed", size: 32, sort: func(a []Named) { NetworkSort32xNamed(a) }},
+		{name: "NetworkSortNamed-2", size: 2, sort: func(a []Named) {
+			if !NetworkSortNamed(a, len(a)) {
+				panic("NetworkSortNamed: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortNamed-3", size: 3, sort: func(a []Named) {
+			if !NetworkSortNamed(a, len(a)) {
+				panic("NetworkSortNamed: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortNamed-4", size: 4, sort: func(a []Named) {
+			if !NetworkSortNamed(a, len(a)) {
+				panic("NetworkSortNamed: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortNamed-5", size: 5, sort: func(a []Named) {
+			if !NetworkSortNamed(a, len(a)) {
+				panic("NetworkSortNamed: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortNamed-6", size: 6, sort: func(a []Named) {
+			if !NetworkSortNamed(a, len(a)) {
+				panic("NetworkSortNamed: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortNamed-7", size: 7, sort: func(a []Named) {
+			if !NetworkSortNamed(a, len(a)) {
+				panic("NetworkSortNamed: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortNamed-8", size: 8, sort: func(a []Named) {
+			if !NetworkSortNamed(a, len(a)) {
+				panic("NetworkSortNamed: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortNamed-9", size: 9, sort: func(a []Named) {
+			if !NetworkSortNamed(a, len(a)) {
+				panic("NetworkSortNamed: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortNamed-10", size: 10, sort: func(a []Named) {
+			if !NetworkSortNamed(a, len(a)) {
+				panic("NetworkSortNamed: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortNamed-11", size: 11, sort: func(a []Named) {
+			if !NetworkSortNamed(a, len(a)) {
+				panic("NetworkSortNamed: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortNamed-12", size: 12, sort: func(a []Named) {
+			if !NetworkSortNamed(a, len(a)) {
+				panic("NetworkSortNamed: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortNamed-13", size: 13, sort: func(a []Named) {
+			if !NetworkSortNamed(a, len(a)) {
+				panic("NetworkSortNamed: no sorter for size 13")
+			}
+		}},
+		{name: "NetworkSortNamed-14", size: 14, sort: func(a []Named) {
+			if !NetworkSortNamed(a, len(a)) {
+				panic("NetworkSortNamed: no sorter for size 14")
+			}
+		}},
+		{name: "NetworkSortNamed-15", size: 15, sort: func(a []Named) {
+			if !NetworkSortNamed(a, len(a)) {
+				panic("NetworkSortNamed: no sorter for size 15")
+			}
+		}},
+		{name: "NetworkSortNamed-16", size: 16, sort: func(a []Named) {
+			if !NetworkSortNamed(a, len(a)) {
+				panic("NetworkSortNamed: no sorter for size 16")
+			}
+		}},
+		{name: "NetworkSortNamed-24", size: 24, sort: func(a []Named) {
+			if !NetworkSortNamed(a, len(a)) {
+				panic("NetworkSortNamed: no sorter for size 24")
+			}
+		}},
+		{name: "NetworkSortNamed-32", size: 32, sort: func(a []Named) {
+			if !NetworkSortNamed(a, len(a)) {
+				panic("NetworkSortNamed: no sorter for size 32")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestLessfuncGenNetworkSortNamed(t *testing.T) {
+	gen, before, cases := testLessfuncGenCasesNetworkSortNamed()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzLessfuncGenNetworkSortNamed(f *testing.F) {
+	gen, before, cases := testLessfuncGenCasesNetworkSortNamed()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]Named, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkLessfuncGenNetworkSortNamed(b *testing.B) {
+	gen, before, cases := testLessfuncGenCasesNetworkSortNamed()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testLessfuncGenCasesNetworkSortNamedReverse returns the functions generated for Named
+// in decreasing order, along with how to make random items and how they are ordered.
+func testLessfuncGenCasesNetworkSortNamedReverse() (gen func(*rand.Rand) Named, before func(a, b Named) bool, cases []testLessfuncGenCase[Named]) {
+	gen = func(rng *rand.Rand) Named { return Named{Name: strconv.Itoa(rng.Intn(32))} }
+	less := func(a, b Named) bool { return a.Name < b.Name }
+	before = func(a, b Named) bool { return less(b, a) }
+	cases = []testLessfuncGenCase[Named]{
+		{name: "NetworkSort2xNamedReverse", size: 2, sort: func(a []Named) { NetworkSort2xNamedReverse(a) }},
+		{name: "NetworkSort3xNamedReverse", size: 3, sort: func(a []Named) { NetworkSort3xNamedReverse(a) }},
+		{name: "NetworkSort4xNamedReverse", size: 4, sort: func(a []Named) { NetworkSort4xNamedReverse(a) }},
+		{name: "NetworkSort5xNamedReverse", size: 5, sort: func(a []Named) { NetworkSort5xNamedReverse(a) }},
+		{name: "NetworkSort6xNamedReverse", size: 6, sort: func(a []Named) { NetworkSort6xNamedReverse(a) }},
+		{name: "NetworkSort7xNamedReverse", size: 7, sort: func(a []Named) { NetworkSort7xNamedReverse(a) }},
+		{name: "NetworkSort8xNamedReverse", size: 8, sort: func(a []Named) { NetworkSort8xNamedReverse(a) }},
+		{name: "NetworkSort9xNamedReverse", size: 9, sort: func(a []Named) { NetworkSort9xNamedReverse(a) }},
+		{name: "NetworkSort10xNamedReverse", size: 10, sort: func(a []Named) { NetworkSort10xNamedReverse(a) }},
+		{name: "NetworkSort11xNamedReverse", size: 11, sort: func(a []Named) { NetworkSort11xNamedReverse(a) }},
+		{name: "NetworkSort12xNamedReverse", size: 12, sort: func(a []Named) { NetworkSort12xNamedReverse(a) }},
+		{name: "NetworkSort13xNamedReverse", size: 13, sort: func(a []Named) { NetworkSort13xNamedReverse(a) }},
+		{name: "NetworkSort14xNamedReverse", size: 14, sort: func(a []Named) { NetworkSort14xNamedReverse(a) }},
+		{name: "NetworkSort15xNamedReverse", size: 15, sort: func(a []Named) { NetworkSort15xNamedReverse(a) }},
+		{name: "NetworkSort16xNamedReverse", size: 16, sort: func(a []Named) { NetworkSort16xNamedReverse(a) }},
+		{name: "NetworkSort24xNamedReverse", size: 24, sort: func(a []Named) { NetworkSort24xNamedReverse(a) }},
+		{name: "NetworkSort32xNamedReverse", size: 32, sort: func(a []Named) { NetworkSort32xNamedReverse(a) }},
+		{name: "NetworkSortNamedReverse-2", size: 2, sort: func(a []Named) {
+			if !NetworkSortNamedReverse(a, len(a)) {
+				panic("NetworkSortNamedReverse: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortNamedReverse-3", size: 3, sort: func(a []Named) {
+			if !NetworkSortNamedReverse(a, len(a)) {
+				panic("NetworkSortNamedReverse: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortNamedReverse-4", size: 4, sort: func(a []Named) {
+			if !NetworkSortNamedReverse(a, len(a)) {
+				panic("NetworkSortNamedReverse: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortNamedReverse-5", size: 5, sort: func(a []Named) {
+			if !NetworkSortNamedReverse(a, len(a)) {
+				panic("NetworkSortNamedReverse: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortNamedReverse-6", size: 6, sort: func(a []Named) {
+			if !NetworkSortNamedReverse(a, len(a)) {
+				panic("NetworkSortNamedReverse: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortNamedReverse-7", size: 7, sort: func(a []Named) {
+			if !NetworkSortNamedReverse(a, len(a)) {
+				panic("NetworkSortNamedReverse: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortNamedReverse-8", size: 8, sort: func(a []Named) {
+			if !NetworkSortNamedReverse(a, len(a)) {
+				panic("NetworkSortNamedReverse: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortNamedReverse-9", size: 9, sort: func(a []Named) {
+			if !NetworkSortNamedReverse(a, len(a)) {
+				panic("NetworkSortNamedReverse: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortNamedReverse-10", size: 10, sort: func(a []Named) {
+			if !NetworkSortNamedReverse(a, len(a)) {
+				panic("NetworkSortNamedReverse: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortNamedReverse-11", size: 11, sort: func(a []Named) {
+			if !NetworkSortNamedReverse(a, len(a)) {
+				panic("NetworkSortNamedReverse: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortNamedReverse-12", size: 12, sort: func(a []Named) {
+			if !NetworkSortNamedReverse(a, len(a)) {
+				panic("NetworkSortNamedReverse: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortNamedReverse-13", size: 13, sort: func(a []Named) {
+			if !NetworkSortNamedReverse(a, len(a)) {
+				panic("NetworkSortNamedReverse: no sorter for size 13")
+			}
+		}},
+		{name: "NetworkSortNamedReverse-14", size: 14, sort: func(a []Named) {
+			if !NetworkSortNamedReverse(a, len(a)) {
+				panic("NetworkSortNamedReverse: no sorter for size 14")
+			}
+		}},
+		{name: "NetworkSortNamedReverse-15", size: 15, sort: func(a []Named) {
+			if !NetworkSortNamedReverse(a, len(a)) {
+				panic("NetworkSortNamedReverse: no sorter for size 15")
+			}
+		}},
+		{name: "NetworkSortNamedReverse-16", size: 16, sort: func(a []Named) {
+			if !NetworkSortNamedReverse(a, len(a)) {
+				panic("NetworkSortNamedReverse: no sorter for size 16")
+			}
+		}},
+		{name: "NetworkSortNamedReverse-24", size: 24, sort: func(a []Named) {
+			if !NetworkSortNamedReverse(a, len(a)) {
+				panic("NetworkSortNamedReverse: no sorter for size 24")
+			}
+		}},
+		{name: "NetworkSortNamedReverse-32", size: 32, sort: func(a []Named) {
+			if !NetworkSortNamedReverse(a, len(a)) {
+				panic("NetworkSortNamedReverse: no sorter for size 32")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestLessfuncGenNetworkSortNamedReverse(t *testing.T) {
+	gen, before, cases := testLessfuncGenCasesNetworkSortNamedReverse()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzLessfuncGenNetworkSortNamedReverse(f *testing.F) {
+	gen, before, cases := testLessfuncGenCasesNetworkSortNamedReverse()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]Named, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkLessfuncGenNetworkSortNamedReverse(b *testing.B) {
+	gen, before, cases := testLessfuncGenCasesNetworkSortNamedReverse()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
@@ -0,0 +1,844 @@
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
+
+package gentest
+
+// NetworkMerge2xInt merges 2 runs, each of which must already be sorted
+// in increasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge2xInt(dst []int, r0, r1 []int) []int {
+	var v0, v1, vm0 int
+	var wm0 int
+	var okm0 bool
+	ok0, ok1 := len(r0) > 0, len(r1) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || v1 < v0) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+
+	for okm0 {
+		dst = append(dst, vm0)
+		switch wm0 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || v1 < v0) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || v1 < v0) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+		}
+	}
+	return dst
+}
+
+// NetworkMerge2xIntReverse merges 2 runs, each of which must already be sorted
+// in decreasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge2xIntReverse(dst []int, r0, r1 []int) []int {
+	var v0, v1, vm0 int
+	var wm0 int
+	var okm0 bool
+	ok0, ok1 := len(r0) > 0, len(r1) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || v1 > v0) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+
+	for okm0 {
+		dst = append(dst, vm0)
+		switch wm0 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || v1 > v0) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || v1 > v0) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+		}
+	}
+	return dst
+}
+
+// NetworkMerge3xInt merges 3 runs, each of which must already be sorted
+// in increasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge3xInt(dst []int, r0, r1, r2 []int) []int {
+	var v0, v1, v2, vm0, vm1 int
+	var wm0, wm1 int
+	var okm0, okm1 bool
+	ok0, ok1, ok2 := len(r0) > 0, len(r1) > 0, len(r2) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || v1 < v0) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = wm0, vm0, okm0
+	if ok2 && (!okm0 || v2 < vm0) {
+		wm1, vm1, okm1 = 2, v2, true
+	}
+
+	for okm1 {
+		dst = append(dst, vm1)
+		switch wm1 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || v1 < v0) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm1, vm1, okm1 = wm0, vm0, okm0
+			if ok2 && (!okm0 || v2 < vm0) {
+				wm1, vm1, okm1 = 2, v2, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || v1 < v0) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm1, vm1, okm1 = wm0, vm0, okm0
+			if ok2 && (!okm0 || v2 < vm0) {
+				wm1, vm1, okm1 = 2, v2, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = wm0, vm0, okm0
+			if ok2 && (!okm0 || v2 < vm0) {
+				wm1, vm1, okm1 = 2, v2, true
+			}
+		}
+	}
+	return dst
+}
+
+// NetworkMerge3xIntReverse merges 3 runs, each of which must already be sorted
+// in decreasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge3xIntReverse(dst []int, r0, r1, r2 []int) []int {
+	var v0, v1, v2, vm0, vm1 int
+	var wm0, wm1 int
+	var okm0, okm1 bool
+	ok0, ok1, ok2 := len(r0) > 0, len(r1) > 0, len(r2) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || v1 > v0) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = wm0, vm0, okm0
+	if ok2 && (!okm0 || v2 > vm0) {
+		wm1, vm1, okm1 = 2, v2, true
+	}
+
+	for okm1 {
+		dst = append(dst, vm1)
+		switch wm1 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || v1 > v0) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm1, vm1, okm1 = wm0, vm0, okm0
+			if ok2 && (!okm0 || v2 > vm0) {
+				wm1, vm1, okm1 = 2, v2, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || v1 > v0) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm1, vm1, okm1 = wm0, vm0, okm0
+			if ok2 && (!okm0 || v2 > vm0) {
+				wm1, vm1, okm1 = 2, v2, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = wm0, vm0, okm0
+			if ok2 && (!okm0 || v2 > vm0) {
+				wm1, vm1, okm1 = 2, v2, true
+			}
+		}
+	}
+	return dst
+}
+
+// NetworkMerge4xInt merges 4 runs, each of which must already be sorted
+// in increasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge4xInt(dst []int, r0, r1, r2, r3 []int) []int {
+	var v0, v1, v2, v3, vm0, vm1, vm2 int
+	var wm0, wm1, wm2 int
+	var okm0, okm1, okm2 bool
+	ok0, ok1, ok2, ok3 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || v1 < v0) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || v3 < v2) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = wm0, vm0, okm0
+	if okm1 && (!okm0 || vm1 < vm0) {
+		wm2, vm2, okm2 = wm1, vm1, true
+	}
+
+	for okm2 {
+		dst = append(dst, vm2)
+		switch wm2 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || v1 < v0) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm2, vm2, okm2 = wm0, vm0, okm0
+			if okm1 && (!okm0 || vm1 < vm0) {
+				wm2, vm2, okm2 = wm1, vm1, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || v1 < v0) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm2, vm2, okm2 = wm0, vm0, okm0
+			if okm1 && (!okm0 || vm1 < vm0) {
+				wm2, vm2, okm2 = wm1, vm1, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || v3 < v2) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm2, vm2, okm2 = wm0, vm0, okm0
+			if okm1 && (!okm0 || vm1 < vm0) {
+				wm2, vm2, okm2 = wm1, vm1, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || v3 < v2) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm2, vm2, okm2 = wm0, vm0, okm0
+			if okm1 && (!okm0 || vm1 < vm0) {
+				wm2, vm2, okm2 = wm1, vm1, true
+			}
+		}
+	}
+	return dst
+}
+
+// NetworkMerge4xIntReverse merges 4 runs, each of which must already be sorted
+// in decreasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge4xIntReverse(dst []int, r0, r1, r2, r3 []int) []int {
+	var v0, v1, v2, v3, vm0, vm1, vm2 int
+	var wm0, wm1, wm2 int
+	var okm0, okm1, okm2 bool
+	ok0, ok1, ok2, ok3 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || v1 > v0) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || v3 > v2) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = wm0, vm0, okm0
+	if okm1 && (!okm0 || vm1 > vm0) {
+		wm2, vm2, okm2 = wm1, vm1, true
+	}
+
+	for okm2 {
+		dst = append(dst, vm2)
+		switch wm2 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || v1 > v0) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm2, vm2, okm2 = wm0, vm0, okm0
+			if okm1 && (!okm0 || vm1 > vm0) {
+				wm2, vm2, okm2 = wm1, vm1, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || v1 > v0) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm2, vm2, okm2 = wm0, vm0, okm0
+			if okm1 && (!okm0 || vm1 > vm0) {
+				wm2, vm2, okm2 = wm1, vm1, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || v3 > v2) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm2, vm2, okm2 = wm0, vm0, okm0
+			if okm1 && (!okm0 || vm1 > vm0) {
+				wm2, vm2, okm2 = wm1, vm1, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || v3 > v2) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm2, vm2, okm2 = wm0, vm0, okm0
+			if okm1 && (!okm0 || vm1 > vm0) {
+				wm2, vm2, okm2 = wm1, vm1, true
+			}
+		}
+	}
+	return dst
+}
+
+// NetworkMerge8xInt merges 8 runs, each of which must already be sorted
+// in increasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge8xInt(dst []int, r0, r1, r2, r3, r4, r5, r6, r7 []int) []int {
+	var v0, v1, v2, v3, v4, v5, v6, v7, vm0, vm1, vm2, vm3, vm4, vm5, vm6 int
+	var wm0, wm1, wm2, wm3, wm4, wm5, wm6 int
+	var okm0, okm1, okm2, okm3, okm4, okm5, okm6 bool
+	ok0, ok1, ok2, ok3, ok4, ok5, ok6, ok7 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0, len(r5) > 0, len(r6) > 0, len(r7) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	if ok5 {
+		v5 = r5[0]
+	}
+	if ok6 {
+		v6 = r6[0]
+	}
+	if ok7 {
+		v7 = r7[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || v1 < v0) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || v3 < v2) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = 4, v4, ok4
+	if ok5 && (!ok4 || v5 < v4) {
+		wm2, vm2, okm2 = 5, v5, true
+	}
+	wm3, vm3, okm3 = 6, v6, ok6
+	if ok7 && (!ok6 || v7 < v6) {
+		wm3, vm3, okm3 = 7, v7, true
+	}
+	wm4, vm4, okm4 = wm0, vm0, okm0
+	if okm1 && (!okm0 || vm1 < vm0) {
+		wm4, vm4, okm4 = wm1, vm1, true
+	}
+	wm5, vm5, okm5 = wm2, vm2, okm2
+	if okm3 && (!okm2 || vm3 < vm2) {
+		wm5, vm5, okm5 = wm3, vm3, true
+	}
+	wm6, vm6, okm6 = wm4, vm4, okm4
+	if okm5 && (!okm4 || vm5 < vm4) {
+		wm6, vm6, okm6 = wm5, vm5, true
+	}
+
+	for okm6 {
+		dst = append(dst, vm6)
+		switch wm6 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || v1 < v0) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm4, vm4, okm4 = wm0, vm0, okm0
+			if okm1 && (!okm0 || vm1 < vm0) {
+				wm4, vm4, okm4 = wm1, vm1, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || vm5 < vm4) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || v1 < v0) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm4, vm4, okm4 = wm0, vm0, okm0
+			if okm1 && (!okm0 || vm1 < vm0) {
+				wm4, vm4, okm4 = wm1, vm1, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || vm5 < vm4) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || v3 < v2) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm4, vm4, okm4 = wm0, vm0, okm0
+			if okm1 && (!okm0 || vm1 < vm0) {
+				wm4, vm4, okm4 = wm1, vm1, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || vm5 < vm4) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || v3 < v2) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm4, vm4, okm4 = wm0, vm0, okm0
+			if okm1 && (!okm0 || vm1 < vm0) {
+				wm4, vm4, okm4 = wm1, vm1, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || vm5 < vm4) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || v5 < v4) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm5, vm5, okm5 = wm2, vm2, okm2
+			if okm3 && (!okm2 || vm3 < vm2) {
+				wm5, vm5, okm5 = wm3, vm3, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || vm5 < vm4) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		case 5:
+			r5 = r5[1:]
+			if ok5 = len(r5) > 0; ok5 {
+				v5 = r5[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || v5 < v4) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm5, vm5, okm5 = wm2, vm2, okm2
+			if okm3 && (!okm2 || vm3 < vm2) {
+				wm5, vm5, okm5 = wm3, vm3, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || vm5 < vm4) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		case 6:
+			r6 = r6[1:]
+			if ok6 = len(r6) > 0; ok6 {
+				v6 = r6[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || v7 < v6) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm5, vm5, okm5 = wm2, vm2, okm2
+			if okm3 && (!okm2 || vm3 < vm2) {
+				wm5, vm5, okm5 = wm3, vm3, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || vm5 < vm4) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		case 7:
+			r7 = r7[1:]
+			if ok7 = len(r7) > 0; ok7 {
+				v7 = r7[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || v7 < v6) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm5, vm5, okm5 = wm2, vm2, okm2
+			if okm3 && (!okm2 || vm3 < vm2) {
+				wm5, vm5, okm5 = wm3, vm3, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || vm5 < vm4) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		}
+	}
+	return dst
+}
+
+// NetworkMerge8xIntReverse merges 8 runs, each of which must already be sorted
+// in decreasing order, appending the result to dst and returning the
+// extended slice. Equal items keep the order of the runs they came from. The heads of
+// the runs play a tournament, and only the matches on the winner's path are replayed
+// after each item is taken.
+func NetworkMerge8xIntReverse(dst []int, r0, r1, r2, r3, r4, r5, r6, r7 []int) []int {
+	var v0, v1, v2, v3, v4, v5, v6, v7, vm0, vm1, vm2, vm3, vm4, vm5, vm6 int
+	var wm0, wm1, wm2, wm3, wm4, wm5, wm6 int
+	var okm0, okm1, okm2, okm3, okm4, okm5, okm6 bool
+	ok0, ok1, ok2, ok3, ok4, ok5, ok6, ok7 := len(r0) > 0, len(r1) > 0, len(r2) > 0, len(r3) > 0, len(r4) > 0, len(r5) > 0, len(r6) > 0, len(r7) > 0
+	if ok0 {
+		v0 = r0[0]
+	}
+	if ok1 {
+		v1 = r1[0]
+	}
+	if ok2 {
+		v2 = r2[0]
+	}
+	if ok3 {
+		v3 = r3[0]
+	}
+	if ok4 {
+		v4 = r4[0]
+	}
+	if ok5 {
+		v5 = r5[0]
+	}
+	if ok6 {
+		v6 = r6[0]
+	}
+	if ok7 {
+		v7 = r7[0]
+	}
+	wm0, vm0, okm0 = 0, v0, ok0
+	if ok1 && (!ok0 || v1 > v0) {
+		wm0, vm0, okm0 = 1, v1, true
+	}
+	wm1, vm1, okm1 = 2, v2, ok2
+	if ok3 && (!ok2 || v3 > v2) {
+		wm1, vm1, okm1 = 3, v3, true
+	}
+	wm2, vm2, okm2 = 4, v4, ok4
+	if ok5 && (!ok4 || v5 > v4) {
+		wm2, vm2, okm2 = 5, v5, true
+	}
+	wm3, vm3, okm3 = 6, v6, ok6
+	if ok7 && (!ok6 || v7 > v6) {
+		wm3, vm3, okm3 = 7, v7, true
+	}
+	wm4, vm4, okm4 = wm0, vm0, okm0
+	if okm1 && (!okm0 || vm1 > vm0) {
+		wm4, vm4, okm4 = wm1, vm1, true
+	}
+	wm5, vm5, okm5 = wm2, vm2, okm2
+	if okm3 && (!okm2 || vm3 > vm2) {
+		wm5, vm5, okm5 = wm3, vm3, true
+	}
+	wm6, vm6, okm6 = wm4, vm4, okm4
+	if okm5 && (!okm4 || vm5 > vm4) {
+		wm6, vm6, okm6 = wm5, vm5, true
+	}
+
+	for okm6 {
+		dst = append(dst, vm6)
+		switch wm6 {
+		case 0:
+			r0 = r0[1:]
+			if ok0 = len(r0) > 0; ok0 {
+				v0 = r0[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || v1 > v0) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm4, vm4, okm4 = wm0, vm0, okm0
+			if okm1 && (!okm0 || vm1 > vm0) {
+				wm4, vm4, okm4 = wm1, vm1, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || vm5 > vm4) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		case 1:
+			r1 = r1[1:]
+			if ok1 = len(r1) > 0; ok1 {
+				v1 = r1[0]
+			}
+			wm0, vm0, okm0 = 0, v0, ok0
+			if ok1 && (!ok0 || v1 > v0) {
+				wm0, vm0, okm0 = 1, v1, true
+			}
+			wm4, vm4, okm4 = wm0, vm0, okm0
+			if okm1 && (!okm0 || vm1 > vm0) {
+				wm4, vm4, okm4 = wm1, vm1, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || vm5 > vm4) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		case 2:
+			r2 = r2[1:]
+			if ok2 = len(r2) > 0; ok2 {
+				v2 = r2[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || v3 > v2) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm4, vm4, okm4 = wm0, vm0, okm0
+			if okm1 && (!okm0 || vm1 > vm0) {
+				wm4, vm4, okm4 = wm1, vm1, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || vm5 > vm4) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		case 3:
+			r3 = r3[1:]
+			if ok3 = len(r3) > 0; ok3 {
+				v3 = r3[0]
+			}
+			wm1, vm1, okm1 = 2, v2, ok2
+			if ok3 && (!ok2 || v3 > v2) {
+				wm1, vm1, okm1 = 3, v3, true
+			}
+			wm4, vm4, okm4 = wm0, vm0, okm0
+			if okm1 && (!okm0 || vm1 > vm0) {
+				wm4, vm4, okm4 = wm1, vm1, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || vm5 > vm4) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		case 4:
+			r4 = r4[1:]
+			if ok4 = len(r4) > 0; ok4 {
+				v4 = r4[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || v5 > v4) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm5, vm5, okm5 = wm2, vm2, okm2
+			if okm3 && (!okm2 || vm3 > vm2) {
+				wm5, vm5, okm5 = wm3, vm3, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || vm5 > vm4) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		case 5:
+			r5 = r5[1:]
+			if ok5 = len(r5) > 0; ok5 {
+				v5 = r5[0]
+			}
+			wm2, vm2, okm2 = 4, v4, ok4
+			if ok5 && (!ok4 || v5 > v4) {
+				wm2, vm2, okm2 = 5, v5, true
+			}
+			wm5, vm5, okm5 = wm2, vm2, okm2
+			if okm3 && (!okm2 || vm3 > vm2) {
+				wm5, vm5, okm5 = wm3, vm3, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || vm5 > vm4) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		case 6:
+			r6 = r6[1:]
+			if ok6 = len(r6) > 0; ok6 {
+				v6 = r6[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || v7 > v6) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm5, vm5, okm5 = wm2, vm2, okm2
+			if okm3 && (!okm2 || vm3 > vm2) {
+				wm5, vm5, okm5 = wm3, vm3, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || vm5 > vm4) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		case 7:
+			r7 = r7[1:]
+			if ok7 = len(r7) > 0; ok7 {
+				v7 = r7[0]
+			}
+			wm3, vm3, okm3 = 6, v6, ok6
+			if ok7 && (!ok6 || v7 > v6) {
+				wm3, vm3, okm3 = 7, v7, true
+			}
+			wm5, vm5, okm5 = wm2, vm2, okm2
+			if okm3 && (!okm2 || vm3 > vm2) {
+				wm5, vm5, okm5 = wm3, vm3, true
+			}
+			wm6, vm6, okm6 = wm4, vm4, okm4
+			if okm5 && (!okm4 || vm5 > vm4) {
+				wm6, vm6, okm6 = wm5, vm5, true
+			}
+		}
+	}
+	return dst
+}
@@ -0,0 +1,116 @@
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
+
+package gentest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/shabbyrobe/sortnet/sortnettest"
+)
+
+// testMergeGenCase is a function generated by sortnetgen, adapted to be checked by
+// sortnettest. Either sort or merge is set.
+type testMergeGenCase[T any] struct {
+	name  string
+	size  int
+	sort  func(a []T)
+	merge func(dst []T, runs [][]T) []T
+}
+
+// testMergeGenCasesNetworkSortInt returns the functions generated for int
+// in increasing order, along with how to make random items and how they are ordered.
+func testMergeGenCasesNetworkSortInt() (gen func(*rand.Rand) int, before func(a, b int) bool, cases []testMergeGenCase[int]) {
+	gen = func(rng *rand.Rand) int { return rng.Intn(32) - 16 }
+	less := func(a, b int) bool { return a < b }
+	before = less
+	cases = []testMergeGenCase[int]{
+		{name: "NetworkMerge2xInt", size: 2, merge: func(dst []int, runs [][]int) []int { return NetworkMerge2xInt(dst, runs[0], runs[1]) }},
+		{name: "NetworkMerge3xInt", size: 3, merge: func(dst []int, runs [][]int) []int { return NetworkMerge3xInt(dst, runs[0], runs[1], runs[2]) }},
+		{name: "NetworkMerge4xInt", size: 4, merge: func(dst []int, runs [][]int) []int { return NetworkMerge4xInt(dst, runs[0], runs[1], runs[2], runs[3]) }},
+		{name: "NetworkMerge8xInt", size: 8, merge: func(dst []int, runs [][]int) []int {
+			return NetworkMerge8xInt(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5], runs[6], runs[7])
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestMergeGenNetworkSortInt(t *testing.T) {
+	gen, before, cases := testMergeGenCasesNetworkSortInt()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzMergeGenNetworkSortInt(f *testing.F) {
+	gen, before, cases := testMergeGenCasesNetworkSortInt()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]int, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+// testMergeGenCasesNetworkSortIntReverse returns the functions generated for int
+// in decreasing order, along with how to make random items and how they are ordered.
+func testMergeGenCasesNetworkSortIntReverse() (gen func(*rand.Rand) int, before func(a, b int) bool, cases []testMergeGenCase[int]) {
+	gen = func(rng *rand.Rand) int { return rng.Intn(32) - 16 }
+	less := func(a, b int) bool { return a < b }
+	before = func(a, b int) bool { return less(b, a) }
+	cases = []testMergeGenCase[int]{
+		{name: "NetworkMerge2xIntReverse", size: 2, merge: func(dst []int, runs [][]int) []int { return NetworkMerge2xIntReverse(dst, runs[0], runs[1]) }},
+		{name: "NetworkMerge3xIntReverse", size: 3, merge: func(dst []int, runs [][]int) []int { return NetworkMerge3xIntReverse(dst, runs[0], runs[1], runs[2]) }},
+		{name: "NetworkMerge4xIntReverse", size: 4, merge: func(dst []int, runs [][]int) []int {
+			return NetworkMerge4xIntReverse(dst, runs[0], runs[1], runs[2], runs[3])
+		}},
+		{name: "NetworkMerge8xIntReverse", size: 8, merge: func(dst []int, runs [][]int) []int {
+			return NetworkMerge8xIntReverse(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5], runs[6], runs[7])
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestMergeGenNetworkSortIntReverse(t *testing.T) {
+	gen, before, cases := testMergeGenCasesNetworkSortIntReverse()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzMergeGenNetworkSortIntReverse(f *testing.F) {
+	gen, before, cases := testMergeGenCasesNetworkSortIntReverse()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]int, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
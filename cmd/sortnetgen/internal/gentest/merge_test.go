@@ -0,0 +1,101 @@
+package gentest
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/shabbyrobe/sortnet"
+)
+
+func randIntRuns(rng *rand.Rand, k, maxLen int, fwd bool) (runs [][]int, exp []int) {
+	for i := 0; i < k; i++ {
+		run := make([]int, rng.Intn(maxLen+1))
+		for j := range run {
+			run[j] = rng.Intn(64)
+		}
+		if fwd {
+			sort.Ints(run)
+		} else {
+			sort.Sort(sort.Reverse(sort.IntSlice(run)))
+		}
+		runs = append(runs, run)
+		exp = append(exp, run...)
+	}
+	if fwd {
+		sort.Ints(exp)
+	} else {
+		sort.Sort(sort.Reverse(sort.IntSlice(exp)))
+	}
+	return runs, exp
+}
+
+// mergeInts calls the generated merge for len(runs) runs.
+func mergeInts(dst []int, runs [][]int, fwd bool) []int {
+	switch len(runs) {
+	case 2:
+		if fwd {
+			return NetworkMerge2xInt(dst, runs[0], runs[1])
+		}
+		return NetworkMerge2xIntReverse(dst, runs[0], runs[1])
+	case 3:
+		if fwd {
+			return NetworkMerge3xInt(dst, runs[0], runs[1], runs[2])
+		}
+		return NetworkMerge3xIntReverse(dst, runs[0], runs[1], runs[2])
+	case 4:
+		if fwd {
+			return NetworkMerge4xInt(dst, runs[0], runs[1], runs[2], runs[3])
+		}
+		return NetworkMerge4xIntReverse(dst, runs[0], runs[1], runs[2], runs[3])
+	case 8:
+		if fwd {
+			return NetworkMerge8xInt(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5], runs[6], runs[7])
+		}
+		return NetworkMerge8xIntReverse(dst, runs[0], runs[1], runs[2], runs[3], runs[4], runs[5], runs[6], runs[7])
+	}
+	panic("no merge for this many runs")
+}
+
+func TestMergeInt(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+
+	for _, k := range []int{2, 3, 4, 8} {
+		for _, fwd := range []bool{true, false} {
+			for _, maxLen := range []int{0, 1, 5, 100} {
+				t.Run(fmt.Sprintf("%d/%v/%d", k, fwd, maxLen), func(t *testing.T) {
+					for i := 0; i < 20; i++ {
+						runs, exp := randIntRuns(rng, k, maxLen, fwd)
+						out := mergeInts([]int{-1}, runs, fwd)
+						if !reflect.DeepEqual(append([]int{-1}, exp...), out) {
+							t.Fatalf("\nexp: %v\nout: %v", exp, out)
+						}
+					}
+				})
+			}
+		}
+	}
+}
+
+func BenchmarkMergeInt(b *testing.B) {
+	rng := rand.New(rand.NewSource(0))
+
+	for _, k := range []int{4, 8} {
+		runs, exp := randIntRuns(rng, k, 10000, true)
+		dst := make([]int, 0, len(exp))
+
+		b.Run(fmt.Sprintf("network-%d", k), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				dst = mergeInts(dst[:0], runs, true)
+			}
+		})
+
+		b.Run(fmt.Sprintf("network-direct-%d", k), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				dst = sortnet.MergeRuns(dst[:0], runs...)
+			}
+		})
+	}
+}
@@ -0,0 +1,252 @@
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
+
+package gentest
+
+// Sorting networks used in this file:
+//
+//   - Custom4, 4 inputs: 5 comparators (proven optimal), depth 3 (proven optimal)
+//     Fingerprint 617de147926cbcc1
+//     network.json
+//   - networks-1, 5 inputs: 9 comparators (proven optimal), depth 5 (proven optimal)
+//     Fingerprint 3f7e27fdc6971268
+//     networks.txt
+//   - networks-2, 6 inputs: 12 comparators (proven optimal), depth 5 (proven optimal)
+//     Fingerprint a8d3bb8cd475e4ca
+//     networks.txt
+
+// NetworkSortInt64 sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortInt64(a []int64, sz int) (ok bool) {
+	switch sz {
+	case 5:
+		NetworkSort5xInt64(a)
+	case 6:
+		NetworkSort6xInt64(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortInt64Reverse sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortInt64Reverse(a []int64, sz int) (ok bool) {
+	switch sz {
+	case 5:
+		NetworkSort5xInt64Reverse(a)
+	case 6:
+		NetworkSort6xInt64Reverse(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortUint32 sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortUint32(a []uint32, sz int) (ok bool) {
+	switch sz {
+	case 4:
+		NetworkSort4xUint32(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortUint32Reverse sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortUint32Reverse(a []uint32, sz int) (ok bool) {
+	switch sz {
+	case 4:
+		NetworkSort4xUint32Reverse(a)
+	default:
+		return false
+	}
+	return true
+}
+
+func NetworkSort5xInt64(a []int64) {
+	_ = a[4]
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort5xInt64Reverse(a []int64) {
+	_ = a[4]
+	if a[0] < a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort6xInt64(a []int64) {
+	_ = a[5]
+	if a[0] > a[5] {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort6xInt64Reverse(a []int64) {
+	_ = a[5]
+	if a[0] < a[5] {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0] < a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[2] < a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort4xUint32(a []uint32) {
+	_ = a[3]
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort4xUint32Reverse(a []uint32) {
+	_ = a[3]
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+}
@@ -0,0 +1,256 @@
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
+
+package gentest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/shabbyrobe/sortnet/sortnettest"
+)
+
+// testNetworkGenCase is a function generated by sortnetgen, adapted to be checked by
+// sortnettest. Either sort or merge is set.
+type testNetworkGenCase[T any] struct {
+	name  string
+	size  int
+	sort  func(a []T)
+	merge func(dst []T, runs [][]T) []T
+}
+
+// testNetworkGenCasesNetworkSortInt64 returns the functions generated for int64
+// in increasing order, along with how to make random items and how they are ordered.
+func testNetworkGenCasesNetworkSortInt64() (gen func(*rand.Rand) int64, before func(a, b int64) bool, cases []testNetworkGenCase[int64]) {
+	gen = func(rng *rand.Rand) int64 { return int64(rng.Intn(32) - 16) }
+	less := func(a, b int64) bool { return a < b }
+	before = less
+	cases = []testNetworkGenCase[int64]{
+		{name: "NetworkSort5xInt64", size: 5, sort: func(a []int64) { NetworkSort5xInt64(a) }},
+		{name: "NetworkSort6xInt64", size: 6, sort: func(a []int64) { NetworkSort6xInt64(a) }},
+		{name: "NetworkSortInt64-5", size: 5, sort: func(a []int64) {
+			if !NetworkSortInt64(a, len(a)) {
+				panic("NetworkSortInt64: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortInt64-6", size: 6, sort: func(a []int64) {
+			if !NetworkSortInt64(a, len(a)) {
+				panic("NetworkSortInt64: no sorter for size 6")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestNetworkGenNetworkSortInt64(t *testing.T) {
+	gen, before, cases := testNetworkGenCasesNetworkSortInt64()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzNetworkGenNetworkSortInt64(f *testing.F) {
+	gen, before, cases := testNetworkGenCasesNetworkSortInt64()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]int64, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkNetworkGenNetworkSortInt64(b *testing.B) {
+	gen, before, cases := testNetworkGenCasesNetworkSortInt64()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testNetworkGenCasesNetworkSortInt64Reverse returns the functions generated for int64
+// in decreasing order, along with how to make random items and how they are ordered.
+func testNetworkGenCasesNetworkSortInt64Reverse() (gen func(*rand.Rand) int64, before func(a, b int64) bool, cases []testNetworkGenCase[int64]) {
+	gen = func(rng *rand.Rand) int64 { return int64(rng.Intn(32) - 16) }
+	less := func(a, b int64) bool { return a < b }
+	before = func(a, b int64) bool { return less(b, a) }
+	cases = []testNetworkGenCase[int64]{
+		{name: "NetworkSort5xInt64Reverse", size: 5, sort: func(a []int64) { NetworkSort5xInt64Reverse(a) }},
+		{name: "NetworkSort6xInt64Reverse", size: 6, sort: func(a []int64) { NetworkSort6xInt64Reverse(a) }},
+		{name: "NetworkSortInt64Reverse-5", size: 5, sort: func(a []int64) {
+			if !NetworkSortInt64Reverse(a, len(a)) {
+				panic("NetworkSortInt64Reverse: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortInt64Reverse-6", size: 6, sort: func(a []int64) {
+			if !NetworkSortInt64Reverse(a, len(a)) {
+				panic("NetworkSortInt64Reverse: no sorter for size 6")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestNetworkGenNetworkSortInt64Reverse(t *testing.T) {
+	gen, before, cases := testNetworkGenCasesNetworkSortInt64Reverse()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzNetworkGenNetworkSortInt64Reverse(f *testing.F) {
+	gen, before, cases := testNetworkGenCasesNetworkSortInt64Reverse()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]int64, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkNetworkGenNetworkSortInt64Reverse(b *testing.B) {
+	gen, before, cases := testNetworkGenCasesNetworkSortInt64Reverse()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testNetworkGenCasesNetworkSortUint32 returns the functions generated for uint32
+// in increasing order, along with how to make random items and how they are ordered.
+func testNetworkGenCasesNetworkSortUint32() (gen func(*rand.Rand) uint32, before func(a, b uint32) bool, cases []testNetworkGenCase[uint32]) {
+	gen = func(rng *rand.Rand) uint32 { return uint32(rng.Intn(32)) }
+	less := func(a, b uint32) bool { return a < b }
+	before = less
+	cases = []testNetworkGenCase[uint32]{
+		{name: "NetworkSort4xUint32", size: 4, sort: func(a []uint32) { NetworkSort4xUint32(a) }},
+		{name: "NetworkSortUint32-4", size: 4, sort: func(a []uint32) {
+			if !NetworkSortUint32(a, len(a)) {
+				panic("NetworkSortUint32: no sorter for size 4")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestNetworkGenNetworkSortUint32(t *testing.T) {
+	gen, before, cases := testNetworkGenCasesNetworkSortUint32()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzNetworkGenNetworkSortUint32(f *testing.F) {
+	gen, before, cases := testNetworkGenCasesNetworkSortUint32()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]uint32, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkNetworkGenNetworkSortUint32(b *testing.B) {
+	gen, before, cases := testNetworkGenCasesNetworkSortUint32()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testNetworkGenCasesNetworkSortUint32Reverse returns the functions generated for uint32
+// in decreasing order, along with how to make random items and how they are ordered.
+func testNetworkGenCasesNetworkSortUint32Reverse() (gen func(*rand.Rand) uint32, before func(a, b uint32) bool, cases []testNetworkGenCase[uint32]) {
+	gen = func(rng *rand.Rand) uint32 { return uint32(rng.Intn(32)) }
+	less := func(a, b uint32) bool { return a < b }
+	before = func(a, b uint32) bool { return less(b, a) }
+	cases = []testNetworkGenCase[uint32]{
+		{name: "NetworkSort4xUint32Reverse", size: 4, sort: func(a []uint32) { NetworkSort4xUint32Reverse(a) }},
+		{name: "NetworkSortUint32Reverse-4", size: 4, sort: func(a []uint32) {
+			if !NetworkSortUint32Reverse(a, len(a)) {
+				panic("NetworkSortUint32Reverse: no sorter for size 4")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestNetworkGenNetworkSortUint32Reverse(t *testing.T) {
+	gen, before, cases := testNetworkGenCasesNetworkSortUint32Reverse()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzNetworkGenNetworkSortUint32Reverse(f *testing.F) {
+	gen, before, cases := testNetworkGenCasesNetworkSortUint32Reverse()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]uint32, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkNetworkGenNetworkSortUint32Reverse(b *testing.B) {
+	gen, before, cases := testNetworkGenCasesNetworkSortUint32Reverse()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
@@ -0,0 +1,677 @@
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
+
+package gentest
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/shabbyrobe/sortnet/sortnettest"
+)
+
+// testPrimitiveGenCase is a function generated by sortnetgen, adapted to be checked by
+// sortnettest. Either sort or merge is set.
+type testPrimitiveGenCase[T any] struct {
+	name  string
+	size  int
+	sort  func(a []T)
+	merge func(dst []T, runs [][]T) []T
+}
+
+// testPrimitiveGenCasesNetworkSortString returns the functions generated for string
+// in increasing order, along with how to make random items and how they are ordered.
+func testPrimitiveGenCasesNetworkSortString() (gen func(*rand.Rand) string, before func(a, b string) bool, cases []testPrimitiveGenCase[string]) {
+	gen = func(rng *rand.Rand) string { return strconv.Itoa(rng.Intn(32)) }
+	less := func(a, b string) bool { return a < b }
+	before = less
+	cases = []testPrimitiveGenCase[string]{
+		{name: "NetworkSort2xString", size: 2, sort: func(a []string) { NetworkSort2xString(a) }},
+		{name: "NetworkSort3xString", size: 3, sort: func(a []string) { NetworkSort3xString(a) }},
+		{name: "NetworkSort4xString", size: 4, sort: func(a []string) { NetworkSort4xString(a) }},
+		{name: "NetworkSort5xString", size: 5, sort: func(a []string) { NetworkSort5xString(a) }},
+		{name: "NetworkSort6xString", size: 6, sort: func(a []string) { NetworkSort6xString(a) }},
+		{name: "NetworkSort7xString", size: 7, sort: func(a []string) { NetworkSort7xString(a) }},
+		{name: "NetworkSort8xString", size: 8, sort: func(a []string) { NetworkSort8xString(a) }},
+		{name: "NetworkSort9xString", size: 9, sort: func(a []string) { NetworkSort9xString(a) }},
+		{name: "NetworkSort10xString", size: 10, sort: func(a []string) { NetworkSort10xString(a) }},
+		{name: "NetworkSort11xString", size: 11, sort: func(a []string) { NetworkSort11xString(a) }},
+		{name: "NetworkSort12xString", size: 12, sort: func(a []string) { NetworkSort12xString(a) }},
+		{name: "NetworkSort13xString", size: 13, sort: func(a []string) { NetworkSort13xString(a) }},
+		{name: "NetworkSort14xString", size: 14, sort: func(a []string) { NetworkSort14xString(a) }},
+		{name: "NetworkSort15xString", size: 15, sort: func(a []string) { NetworkSort15xString(a) }},
+		{name: "NetworkSort16xString", size: 16, sort: func(a []string) { NetworkSort16xString(a) }},
+		{name: "NetworkSort24xString", size: 24, sort: func(a []string) { NetworkSort24xString(a) }},
+		{name: "NetworkSort32xString", size: 32, sort: func(a []string) { NetworkSort32xString(a) }},
+		{name: "NetworkSort48xString", size: 48, sort: func(a []string) { NetworkSort48xString(a) }},
+		{name: "NetworkSort64xString", size: 64, sort: func(a []string) { NetworkSort64xString(a) }},
+		{name: "NetworkSortString-2", size: 2, sort: func(a []string) {
+			if !NetworkSortString(a, len(a)) {
+				panic("NetworkSortString: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortString-3", size: 3, sort: func(a []string) {
+			if !NetworkSortString(a, len(a)) {
+				panic("NetworkSortString: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortString-4", size: 4, sort: func(a []string) {
+			if !NetworkSortString(a, len(a)) {
+				panic("NetworkSortString: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortString-5", size: 5, sort: func(a []string) {
+			if !NetworkSortString(a, len(a)) {
+				panic("NetworkSortString: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortString-6", size: 6, sort: func(a []string) {
+			if !NetworkSortString(a, len(a)) {
+				panic("NetworkSortString: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortString-7", size: 7, sort: func(a []string) {
+			if !NetworkSortString(a, len(a)) {
+				panic("NetworkSortString: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortString-8", size: 8, sort: func(a []string) {
+			if !NetworkSortString(a, len(a)) {
+				panic("NetworkSortString: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortString-9", size: 9, sort: func(a []string) {
+			if !NetworkSortString(a, len(a)) {
+				panic("NetworkSortString: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortString-10", size: 10, sort: func(a []string) {
+			if !NetworkSortString(a, len(a)) {
+				panic("NetworkSortString: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortString-11", size: 11, sort: func(a []string) {
+			if !NetworkSortString(a, len(a)) {
+				panic("NetworkSortString: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortString-12", size: 12, sort: func(a []string) {
+			if !NetworkSortString(a, len(a)) {
+				panic("NetworkSortString: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortString-13", size: 13, sort: func(a []string) {
+			if !NetworkSortString(a, len(a)) {
+				panic("NetworkSortString: no sorter for size 13")
+			}
+		}},
+		{name: "NetworkSortString-14", size: 14, sort: func(a []string) {
+			if !NetworkSortString(a, len(a)) {
+				panic("NetworkSortString: no sorter for size 14")
+			}
+		}},
+		{name: "NetworkSortString-15", size: 15, sort: func(a []string) {
+			if !NetworkSortString(a, len(a)) {
+				panic("NetworkSortString: no sorter for size 15")
+			}
+		}},
+		{name: "NetworkSortString-16", size: 16, sort: func(a []string) {
+			if !NetworkSortString(a, len(a)) {
+				panic("NetworkSortString: no sorter for size 16")
+			}
+		}},
+		{name: "NetworkSortString-24", size: 24, sort: func(a []string) {
+			if !NetworkSortString(a, len(a)) {
+				panic("NetworkSortString: no sorter for size 24")
+			}
+		}},
+		{name: "NetworkSortString-32", size: 32, sort: func(a []string) {
+			if !NetworkSortString(a, len(a)) {
+				panic("NetworkSortString: no sorter for size 32")
+			}
+		}},
+		{name: "NetworkSortString-48", size: 48, sort: func(a []string) {
+			if !NetworkSortString(a, len(a)) {
+				panic("NetworkSortString: no sorter for size 48")
+			}
+		}},
+		{name: "NetworkSortString-64", size: 64, sort: func(a []string) {
+			if !NetworkSortString(a, len(a)) {
+				panic("NetworkSortString: no sorter for size 64")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestPrimitiveGenNetworkSortString(t *testing.T) {
+	gen, before, cases := testPrimitiveGenCasesNetworkSortString()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzPrimitiveGenNetworkSortString(f *testing.F) {
+	gen, before, cases := testPrimitiveGenCasesNetworkSortString()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]string, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkPrimitiveGenNetworkSortString(b *testing.B) {
+	gen, before, cases := testPrimitiveGenCasesNetworkSortString()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testPrimitiveGenCasesNetworkSortStringReverse returns the functions generated for string
+// in decreasing order, along with how to make random items and how they are ordered.
+func testPrimitiveGenCasesNetworkSortStringReverse() (gen func(*rand.Rand) string, before func(a, b string) bool, cases []testPrimitiveGenCase[string]) {
+	gen = func(rng *rand.Rand) string { return strconv.Itoa(rng.Intn(32)) }
+	less := func(a, b string) bool { return a < b }
+	before = func(a, b string) bool { return less(b, a) }
+	cases = []testPrimitiveGenCase[string]{
+		{name: "NetworkSort2xStringReverse", size: 2, sort: func(a []string) { NetworkSort2xStringReverse(a) }},
+		{name: "NetworkSort3xStringReverse", size: 3, sort: func(a []string) { NetworkSort3xStringReverse(a) }},
+		{name: "NetworkSort4xStringReverse", size: 4, sort: func(a []string) { NetworkSort4xStringReverse(a) }},
+		{name: "NetworkSort5xStringReverse", size: 5, sort: func(a []string) { NetworkSort5xStringReverse(a) }},
+		{name: "NetworkSort6xStringReverse", size: 6, sort: func(a []string) { NetworkSort6xStringReverse(a) }},
+		{name: "NetworkSort7xStringReverse", size: 7, sort: func(a []string) { NetworkSort7xStringReverse(a) }},
+		{name: "NetworkSort8xStringReverse", size: 8, sort: func(a []string) { NetworkSort8xStringReverse(a) }},
+		{name: "NetworkSort9xStringReverse", size: 9, sort: func(a []string) { NetworkSort9xStringReverse(a) }},
+		{name: "NetworkSort10xStringReverse", size: 10, sort: func(a []string) { NetworkSort10xStringReverse(a) }},
+		{name: "NetworkSort11xStringReverse", size: 11, sort: func(a []string) { NetworkSort11xStringReverse(a) }},
+		{name: "NetworkSort12xStringReverse", size: 12, sort: func(a []string) { NetworkSort12xStringReverse(a) }},
+		{name: "NetworkSort13xStringReverse", size: 13, sort: func(a []string) { NetworkSort13xStringReverse(a) }},
+		{name: "NetworkSort14xStringReverse", size: 14, sort: func(a []string) { NetworkSort14xStringReverse(a) }},
+		{name: "NetworkSort15xStringReverse", size: 15, sort: func(a []string) { NetworkSort15xStringReverse(a) }},
+		{name: "NetworkSort16xStringReverse", size: 16, sort: func(a []string) { NetworkSort16xStringReverse(a) }},
+		{name: "NetworkSort24xStringReverse", size: 24, sort: func(a []string) { NetworkSort24xStringReverse(a) }},
+		{name: "NetworkSort32xStringReverse", size: 32, sort: func(a []string) { NetworkSort32xStringReverse(a) }},
+		{name: "NetworkSort48xStringReverse", size: 48, sort: func(a []string) { NetworkSort48xStringReverse(a) }},
+		{name: "NetworkSort64xStringReverse", size: 64, sort: func(a []string) { NetworkSort64xStringReverse(a) }},
+		{name: "NetworkSortStringReverse-2", size: 2, sort: func(a []string) {
+			if !NetworkSortStringReverse(a, len(a)) {
+				panic("NetworkSortStringReverse: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortStringReverse-3", size: 3, sort: func(a []string) {
+			if !NetworkSortStringReverse(a, len(a)) {
+				panic("NetworkSortStringReverse: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortStringReverse-4", size: 4, sort: func(a []string) {
+			if !NetworkSortStringReverse(a, len(a)) {
+				panic("NetworkSortStringReverse: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortStringReverse-5", size: 5, sort: func(a []string) {
+			if !NetworkSortStringReverse(a, len(a)) {
+				panic("NetworkSortStringReverse: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortStringReverse-6", size: 6, sort: func(a []string) {
+			if !NetworkSortStringReverse(a, len(a)) {
+				panic("NetworkSortStringReverse: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortStringReverse-7", size: 7, sort: func(a []string) {
+			if !NetworkSortStringReverse(a, len(a)) {
+				panic("NetworkSortStringReverse: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortStringReverse-8", size: 8, sort: func(a []string) {
+			if !NetworkSortStringReverse(a, len(a)) {
+				panic("NetworkSortStringReverse: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortStringReverse-9", size: 9, sort: func(a []string) {
+			if !NetworkSortStringReverse(a, len(a)) {
+				panic("NetworkSortStringReverse: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortStringReverse-10", size: 10, sort: func(a []string) {
+			if !NetworkSortStringReverse(a, len(a)) {
+				panic("NetworkSortStringReverse: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortStringReverse-11", size: 11, sort: func(a []string) {
+			if !NetworkSortStringReverse(a, len(a)) {
+				panic("NetworkSortStringReverse: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortStringReverse-12", size: 12, sort: func(a []string) {
+			if !NetworkSortStringReverse(a, len(a)) {
+				panic("NetworkSortStringReverse: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortStringReverse-13", size: 13, sort: func(a []string) {
+			if !NetworkSortStringReverse(a, len(a)) {
+				panic("NetworkSortStringReverse: no sorter for size 13")
+			}
+		}},
+		{name: "NetworkSortStringReverse-14", size: 14, sort: func(a []string) {
+			if !NetworkSortStringReverse(a, len(a)) {
+				panic("NetworkSortStringReverse: no sorter for size 14")
+			}
+		}},
+		{name: "NetworkSortStringReverse-15", size: 15, sort: func(a []string) {
+			if !NetworkSortStringReverse(a, len(a)) {
+				panic("NetworkSortStringReverse: no sorter for size 15")
+			}
+		}},
+		{name: "NetworkSortStringReverse-16", size: 16, sort: func(a []string) {
+			if !NetworkSortStringReverse(a, len(a)) {
+				panic("NetworkSortStringReverse: no sorter for size 16")
+			}
+		}},
+		{name: "NetworkSortStringReverse-24", size: 24, sort: func(a []string) {
+			if !NetworkSortStringReverse(a, len(a)) {
+				panic("NetworkSortStringReverse: no sorter for size 24")
+			}
+		}},
+		{name: "NetworkSortStringReverse-32", size: 32, sort: func(a []string) {
+			if !NetworkSortStringReverse(a, len(a)) {
+				panic("NetworkSortStringReverse: no sorter for size 32")
+			}
+		}},
+		{name: "NetworkSortStringReverse-48", size: 48, sort: func(a []string) {
+			if !NetworkSortStringReverse(a, len(a)) {
+				panic("NetworkSortStringReverse: no sorter for size 48")
+			}
+		}},
+		{name: "NetworkSortStringReverse-64", size: 64, sort: func(a []string) {
+			if !NetworkSortStringReverse(a, len(a)) {
+				panic("NetworkSortStringReverse: no sorter for size 64")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestPrimitiveGenNetworkSortStringReverse(t *testing.T) {
+	gen, before, cases := testPrimitiveGenCasesNetworkSortStringReverse()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzPrimitiveGenNetworkSortStringReverse(f *testing.F) {
+	gen, before, cases := testPrimitiveGenCasesNetworkSortStringReverse()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]string, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkPrimitiveGenNetworkSortStringReverse(b *testing.B) {
+	gen, before, cases := testPrimitiveGenCasesNetworkSortStringReverse()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testPrimitiveGenCasesNetworkSortInt returns the functions generated for int
+// in increasing order, along with how to make random items and how they are ordered.
+func testPrimitiveGenCasesNetworkSortInt() (gen func(*rand.Rand) int, before func(a, b int) bool, cases []testPrimitiveGenCase[int]) {
+	gen = func(rng *rand.Rand) int { return rng.Intn(32) - 16 }
+	less := func(a, b int) bool { return a < b }
+	before = less
+	cases = []testPrimitiveGenCase[int]{
+		{name: "NetworkSort2xInt", size: 2, sort: func(a []int) { NetworkSort2xInt(a) }},
+		{name: "NetworkSort3xInt", size: 3, sort: func(a []int) { NetworkSort3xInt(a) }},
+		{name: "NetworkSort4xInt", size: 4, sort: func(a []int) { NetworkSort4xInt(a) }},
+		{name: "NetworkSort5xInt", size: 5, sort: func(a []int) { NetworkSort5xInt(a) }},
+		{name: "NetworkSort6xInt", size: 6, sort: func(a []int) { NetworkSort6xInt(a) }},
+		{name: "NetworkSort7xInt", size: 7, sort: func(a []int) { NetworkSort7xInt(a) }},
+		{name: "NetworkSort8xInt", size: 8, sort: func(a []int) { NetworkSort8xInt(a) }},
+		{name: "NetworkSort9xInt", size: 9, sort: func(a []int) { NetworkSort9xInt(a) }},
+		{name: "NetworkSort10xInt", size: 10, sort: func(a []int) { NetworkSort10xInt(a) }},
+		{name: "NetworkSort11xInt", size: 11, sort: func(a []int) { NetworkSort11xInt(a) }},
+		{name: "NetworkSort12xInt", size: 12, sort: func(a []int) { NetworkSort12xInt(a) }},
+		{name: "NetworkSort13xInt", size: 13, sort: func(a []int) { NetworkSort13xInt(a) }},
+		{name: "NetworkSort14xInt", size: 14, sort: func(a []int) { NetworkSort14xInt(a) }},
+		{name: "NetworkSort15xInt", size: 15, sort: func(a []int) { NetworkSort15xInt(a) }},
+		{name: "NetworkSort16xInt", size: 16, sort: func(a []int) { NetworkSort16xInt(a) }},
+		{name: "NetworkSort24xInt", size: 24, sort: func(a []int) { NetworkSort24xInt(a) }},
+		{name: "NetworkSort32xInt", size: 32, sort: func(a []int) { NetworkSort32xInt(a) }},
+		{name: "NetworkSort48xInt", size: 48, sort: func(a []int) { NetworkSort48xInt(a) }},
+		{name: "NetworkSort64xInt", size: 64, sort: func(a []int) { NetworkSort64xInt(a) }},
+		{name: "NetworkSortInt-2", size: 2, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortInt-3", size: 3, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortInt-4", size: 4, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortInt-5", size: 5, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortInt-6", size: 6, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortInt-7", size: 7, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortInt-8", size: 8, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortInt-9", size: 9, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortInt-10", size: 10, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortInt-11", size: 11, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortInt-12", size: 12, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortInt-13", size: 13, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 13")
+			}
+		}},
+		{name: "NetworkSortInt-14", size: 14, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 14")
+			}
+		}},
+		{name: "NetworkSortInt-15", size: 15, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 15")
+			}
+		}},
+		{name: "NetworkSortInt-16", size: 16, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 16")
+			}
+		}},
+		{name: "NetworkSortInt-24", size: 24, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 24")
+			}
+		}},
+		{name: "NetworkSortInt-32", size: 32, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 32")
+			}
+		}},
+		{name: "NetworkSortInt-48", size: 48, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 48")
+			}
+		}},
+		{name: "NetworkSortInt-64", size: 64, sort: func(a []int) {
+			if !NetworkSortInt(a, len(a)) {
+				panic("NetworkSortInt: no sorter for size 64")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestPrimitiveGenNetworkSortInt(t *testing.T) {
+	gen, before, cases := testPrimitiveGenCasesNetworkSortInt()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzPrimitiveGenNetworkSortInt(f *testing.F) {
+	gen, before, cases := testPrimitiveGenCasesNetworkSortInt()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]int, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkPrimitiveGenNetworkSortInt(b *testing.B) {
+	gen, before, cases := testPrimitiveGenCasesNetworkSortInt()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testPrimitiveGenCasesNetworkSortIntReverse returns the functions generated for int
+// in decreasing order, along with how to make random items and how they are ordered.
+func testPrimitiveGenCasesNetworkSortIntReverse() (gen func(*rand.Rand) int, before func(a, b int) bool, cases []testPrimitiveGenCase[int]) {
+	gen = func(rng *rand.Rand) int { return rng.Intn(32) - 16 }
+	less := func(a, b int) bool { return a < b }
+	before = func(a, b int) bool { return less(b, a) }
+	cases = []testPrimitiveGenCase[int]{
+		{name: "NetworkSort2xIntReverse", size: 2, sort: func(a []int) { NetworkSort2xIntReverse(a) }},
+		{name: "NetworkSort3xIntReverse", size: 3, sort: func(a []int) { NetworkSort3xIntReverse(a) }},
+		{name: "NetworkSort4xIntReverse", size: 4, sort: func(a []int) { NetworkSort4xIntReverse(a) }},
+		{name: "NetworkSort5xIntReverse", size: 5, sort: func(a []int) { NetworkSort5xIntReverse(a) }},
+		{name: "NetworkSort6xIntReverse", size: 6, sort: func(a []int) { NetworkSort6xIntReverse(a) }},
+		{name: "NetworkSort7xIntReverse", size: 7, sort: func(a []int) { NetworkSort7xIntReverse(a) }},
+		{name: "NetworkSort8xIntReverse", size: 8, sort: func(a []int) { NetworkSort8xIntReverse(a) }},
+		{name: "NetworkSort9xIntReverse", size: 9, sort: func(a []int) { NetworkSort9xIntReverse(a) }},
+		{name: "NetworkSort10xIntReverse", size: 10, sort: func(a []int) { NetworkSort10xIntReverse(a) }},
+		{name: "NetworkSort11xIntReverse", size: 11, sort: func(a []int) { NetworkSort11xIntReverse(a) }},
+		{name: "NetworkSort12xIntReverse", size: 12, sort: func(a []int) { NetworkSort12xIntReverse(a) }},
+		{name: "NetworkSort13xIntReverse", size: 13, sort: func(a []int) { NetworkSort13xIntReverse(a) }},
+		{name: "NetworkSort14xIntReverse", size: 14, sort: func(a []int) { NetworkSort14xIntReverse(a) }},
+		{name: "NetworkSort15xIntReverse", size: 15, sort: func(a []int) { NetworkSort15xIntReverse(a) }},
+		{name: "NetworkSort16xIntReverse", size: 16, sort: func(a []int) { NetworkSort16xIntReverse(a) }},
+		{name: "NetworkSort24xIntReverse", size: 24, sort: func(a []int) { NetworkSort24xIntReverse(a) }},
+		{name: "NetworkSort32xIntReverse", size: 32, sort: func(a []int) { NetworkSort32xIntReverse(a) }},
+		{name: "NetworkSort48xIntReverse", size: 48, sort: func(a []int) { NetworkSort48xIntReverse(a) }},
+		{name: "NetworkSort64xIntReverse", size: 64, sort: func(a []int) { NetworkSort64xIntReverse(a) }},
+		{name: "NetworkSortIntReverse-2", size: 2, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortIntReverse-3", size: 3, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortIntReverse-4", size: 4, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortIntReverse-5", size: 5, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortIntReverse-6", size: 6, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortIntReverse-7", size: 7, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortIntReverse-8", size: 8, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortIntReverse-9", size: 9, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortIntReverse-10", size: 10, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortIntReverse-11", size: 11, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortIntReverse-12", size: 12, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortIntReverse-13", size: 13, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 13")
+			}
+		}},
+		{name: "NetworkSortIntReverse-14", size: 14, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 14")
+			}
+		}},
+		{name: "NetworkSortIntReverse-15", size: 15, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 15")
+			}
+		}},
+		{name: "NetworkSortIntReverse-16", size: 16, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 16")
+			}
+		}},
+		{name: "NetworkSortIntReverse-24", size: 24, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 24")
+			}
+		}},
+		{name: "NetworkSortIntReverse-32", size: 32, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 32")
+			}
+		}},
+		{name: "NetworkSortIntReverse-48", size: 48, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 48")
+			}
+		}},
+		{name: "NetworkSortIntReverse-64", size: 64, sort: func(a []int) {
+			if !NetworkSortIntReverse(a, len(a)) {
+				panic("NetworkSortIntReverse: no sorter for size 64")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestPrimitiveGenNetworkSortIntReverse(t *testing.T) {
+	gen, before, cases := testPrimitiveGenCasesNetworkSortIntReverse()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzPrimitiveGenNetworkSortIntReverse(f *testing.F) {
+	gen, before, cases := testPrimitiveGenCasesNetworkSortIntReverse()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]int, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkPrimitiveGenNetworkSortIntReverse(b *testing.B) {
+	gen, before, cases := testPrimitiveGenCasesNetworkSortIntReverse()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
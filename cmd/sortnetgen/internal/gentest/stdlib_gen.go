@@ -0,0 +1,10202 @@
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
+
+package gentest
+
+// Sorting networks used in this file:
+//
+//   - Bose-Nelson, 2 inputs: 1 comparator (proven optimal), depth 1 (proven optimal)
+//     Fingerprint 9463926d4640a53a
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 3 inputs: 3 comparators (proven optimal), depth 3 (proven optimal)
+//     Fingerprint f995edafd93d50ea
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 4 inputs: 5 comparators (proven optimal), depth 3 (proven optimal)
+//     Fingerprint 0119fc1a905673cd
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 5 inputs: 9 comparators (proven optimal), depth 6 (not optimal)
+//     Fingerprint d54da66c984b8b07
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 6 inputs: 13 comparators (not optimal), depth 7 (not optimal)
+//     Fingerprint 1cc32b1cf5a405a2
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 7 inputs: 16 comparators (proven optimal), depth 7 (not optimal)
+//     Fingerprint 4c56e9c432030c54
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 8 inputs: 19 comparators (proven optimal), depth 7 (not optimal)
+//     Fingerprint 72b14580972c6d18
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Senso9, 9 inputs: 25 comparators (proven optimal), depth 8 (not optimal)
+//     Fingerprint 5463bfb95d0dca98
+//     V. K. Valsalam and R. Miikkulainen (2013)
+//     V. K. Valsalam and R. Miikkulainen, Using Symmetry and Evolutionary Search to Minimize Sorting Networks, Journal of Machine Learning Research 14, 2013
+//   - Senso10, 10 inputs: 29 comparators (proven optimal), depth 8 (not optimal)
+//     Fingerprint 9688ccf2613970b6
+//     V. K. Valsalam and R. Miikkulainen (2013)
+//     V. K. Valsalam and R. Miikkulainen, Using Symmetry and Evolutionary Search to Minimize Sorting Networks, Journal of Machine Learning Research 14, 2013
+//   - ShapiroGreen11, 11 inputs: 35 comparators (proven optimal), depth 9 (not optimal)
+//     Fingerprint a0cd3d71b97545e1
+//     G. Shapiro and M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - ShapiroGreen12, 12 inputs: 39 comparators (proven optimal), depth 9 (not optimal)
+//     Fingerprint 4520ec02bac38318
+//     G. Shapiro and M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+//   - Green16, 16 inputs: 60 comparators (best known), depth 10 (not optimal)
+//     Fingerprint 196d3d09b3f4a0e7
+//     M. W. Green (1969)
+//     D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, 2nd ed., section 5.3.4, Addison-Wesley, 1998
+
+import (
+	"bytes"
+	"math/big"
+	"net/netip"
+	"time"
+)
+
+// NetworkSortBytes sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortBytes(a [][]byte, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xBytes(a)
+	case 3:
+		NetworkSort3xBytes(a)
+	case 4:
+		NetworkSort4xBytes(a)
+	case 5:
+		NetworkSort5xBytes(a)
+	case 6:
+		NetworkSort6xBytes(a)
+	case 7:
+		NetworkSort7xBytes(a)
+	case 8:
+		NetworkSort8xBytes(a)
+	case 9:
+		NetworkSort9xBytes(a)
+	case 10:
+		NetworkSort10xBytes(a)
+	case 11:
+		NetworkSort11xBytes(a)
+	case 12:
+		NetworkSort12xBytes(a)
+	case 16:
+		NetworkSort16xBytes(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortBytesReverse sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortBytesReverse(a [][]byte, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xBytesReverse(a)
+	case 3:
+		NetworkSort3xBytesReverse(a)
+	case 4:
+		NetworkSort4xBytesReverse(a)
+	case 5:
+		NetworkSort5xBytesReverse(a)
+	case 6:
+		NetworkSort6xBytesReverse(a)
+	case 7:
+		NetworkSort7xBytesReverse(a)
+	case 8:
+		NetworkSort8xBytesReverse(a)
+	case 9:
+		NetworkSort9xBytesReverse(a)
+	case 10:
+		NetworkSort10xBytesReverse(a)
+	case 11:
+		NetworkSort11xBytesReverse(a)
+	case 12:
+		NetworkSort12xBytesReverse(a)
+	case 16:
+		NetworkSort16xBytesReverse(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortBigFloat sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortBigFloat(a []*big.Float, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xBigFloat(a)
+	case 3:
+		NetworkSort3xBigFloat(a)
+	case 4:
+		NetworkSort4xBigFloat(a)
+	case 5:
+		NetworkSort5xBigFloat(a)
+	case 6:
+		NetworkSort6xBigFloat(a)
+	case 7:
+		NetworkSort7xBigFloat(a)
+	case 8:
+		NetworkSort8xBigFloat(a)
+	case 9:
+		NetworkSort9xBigFloat(a)
+	case 10:
+		NetworkSort10xBigFloat(a)
+	case 11:
+		NetworkSort11xBigFloat(a)
+	case 12:
+		NetworkSort12xBigFloat(a)
+	case 16:
+		NetworkSort16xBigFloat(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortBigFloatReverse sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortBigFloatReverse(a []*big.Float, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xBigFloatReverse(a)
+	case 3:
+		NetworkSort3xBigFloatReverse(a)
+	case 4:
+		NetworkSort4xBigFloatReverse(a)
+	case 5:
+		NetworkSort5xBigFloatReverse(a)
+	case 6:
+		NetworkSort6xBigFloatReverse(a)
+	case 7:
+		NetworkSort7xBigFloatReverse(a)
+	case 8:
+		NetworkSort8xBigFloatReverse(a)
+	case 9:
+		NetworkSort9xBigFloatReverse(a)
+	case 10:
+		NetworkSort10xBigFloatReverse(a)
+	case 11:
+		NetworkSort11xBigFloatReverse(a)
+	case 12:
+		NetworkSort12xBigFloatReverse(a)
+	case 16:
+		NetworkSort16xBigFloatReverse(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortBigInt sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortBigInt(a []*big.Int, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xBigInt(a)
+	case 3:
+		NetworkSort3xBigInt(a)
+	case 4:
+		NetworkSort4xBigInt(a)
+	case 5:
+		NetworkSort5xBigInt(a)
+	case 6:
+		NetworkSort6xBigInt(a)
+	case 7:
+		NetworkSort7xBigInt(a)
+	case 8:
+		NetworkSort8xBigInt(a)
+	case 9:
+		NetworkSort9xBigInt(a)
+	case 10:
+		NetworkSort10xBigInt(a)
+	case 11:
+		NetworkSort11xBigInt(a)
+	case 12:
+		NetworkSort12xBigInt(a)
+	case 16:
+		NetworkSort16xBigInt(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortBigIntReverse sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortBigIntReverse(a []*big.Int, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xBigIntReverse(a)
+	case 3:
+		NetworkSort3xBigIntReverse(a)
+	case 4:
+		NetworkSort4xBigIntReverse(a)
+	case 5:
+		NetworkSort5xBigIntReverse(a)
+	case 6:
+		NetworkSort6xBigIntReverse(a)
+	case 7:
+		NetworkSort7xBigIntReverse(a)
+	case 8:
+		NetworkSort8xBigIntReverse(a)
+	case 9:
+		NetworkSort9xBigIntReverse(a)
+	case 10:
+		NetworkSort10xBigIntReverse(a)
+	case 11:
+		NetworkSort11xBigIntReverse(a)
+	case 12:
+		NetworkSort12xBigIntReverse(a)
+	case 16:
+		NetworkSort16xBigIntReverse(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortAddr sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortAddr(a []netip.Addr, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xAddr(a)
+	case 3:
+		NetworkSort3xAddr(a)
+	case 4:
+		NetworkSort4xAddr(a)
+	case 5:
+		NetworkSort5xAddr(a)
+	case 6:
+		NetworkSort6xAddr(a)
+	case 7:
+		NetworkSort7xAddr(a)
+	case 8:
+		NetworkSort8xAddr(a)
+	case 9:
+		NetworkSort9xAddr(a)
+	case 10:
+		NetworkSort10xAddr(a)
+	case 11:
+		NetworkSort11xAddr(a)
+	case 12:
+		NetworkSort12xAddr(a)
+	case 16:
+		NetworkSort16xAddr(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortAddrReverse sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortAddrReverse(a []netip.Addr, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xAddrReverse(a)
+	case 3:
+		NetworkSort3xAddrReverse(a)
+	case 4:
+		NetworkSort4xAddrReverse(a)
+	case 5:
+		NetworkSort5xAddrReverse(a)
+	case 6:
+		NetworkSort6xAddrReverse(a)
+	case 7:
+		NetworkSort7xAddrReverse(a)
+	case 8:
+		NetworkSort8xAddrReverse(a)
+	case 9:
+		NetworkSort9xAddrReverse(a)
+	case 10:
+		NetworkSort10xAddrReverse(a)
+	case 11:
+		NetworkSort11xAddrReverse(a)
+	case 12:
+		NetworkSort12xAddrReverse(a)
+	case 16:
+		NetworkSort16xAddrReverse(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortDuration sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortDuration(a []time.Duration, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xDuration(a)
+	case 3:
+		NetworkSort3xDuration(a)
+	case 4:
+		NetworkSort4xDuration(a)
+	case 5:
+		NetworkSort5xDuration(a)
+	case 6:
+		NetworkSort6xDuration(a)
+	case 7:
+		NetworkSort7xDuration(a)
+	case 8:
+		NetworkSort8xDuration(a)
+	case 9:
+		NetworkSort9xDuration(a)
+	case 10:
+		NetworkSort10xDuration(a)
+	case 11:
+		NetworkSort11xDuration(a)
+	case 12:
+		NetworkSort12xDuration(a)
+	case 16:
+		NetworkSort16xDuration(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortDurationReverse sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortDurationReverse(a []time.Duration, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xDurationReverse(a)
+	case 3:
+		NetworkSort3xDurationReverse(a)
+	case 4:
+		NetworkSort4xDurationReverse(a)
+	case 5:
+		NetworkSort5xDurationReverse(a)
+	case 6:
+		NetworkSort6xDurationReverse(a)
+	case 7:
+		NetworkSort7xDurationReverse(a)
+	case 8:
+		NetworkSort8xDurationReverse(a)
+	case 9:
+		NetworkSort9xDurationReverse(a)
+	case 10:
+		NetworkSort10xDurationReverse(a)
+	case 11:
+		NetworkSort11xDurationReverse(a)
+	case 12:
+		NetworkSort12xDurationReverse(a)
+	case 16:
+		NetworkSort16xDurationReverse(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortTime sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortTime(a []time.Time, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xTime(a)
+	case 3:
+		NetworkSort3xTime(a)
+	case 4:
+		NetworkSort4xTime(a)
+	case 5:
+		NetworkSort5xTime(a)
+	case 6:
+		NetworkSort6xTime(a)
+	case 7:
+		NetworkSort7xTime(a)
+	case 8:
+		NetworkSort8xTime(a)
+	case 9:
+		NetworkSort9xTime(a)
+	case 10:
+		NetworkSort10xTime(a)
+	case 11:
+		NetworkSort11xTime(a)
+	case 12:
+		NetworkSort12xTime(a)
+	case 16:
+		NetworkSort16xTime(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortTimeReverse sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortTimeReverse(a []time.Time, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xTimeReverse(a)
+	case 3:
+		NetworkSort3xTimeReverse(a)
+	case 4:
+		NetworkSort4xTimeReverse(a)
+	case 5:
+		NetworkSort5xTimeReverse(a)
+	case 6:
+		NetworkSort6xTimeReverse(a)
+	case 7:
+		NetworkSort7xTimeReverse(a)
+	case 8:
+		NetworkSort8xTimeReverse(a)
+	case 9:
+		NetworkSort9xTimeReverse(a)
+	case 10:
+		NetworkSort10xTimeReverse(a)
+	case 11:
+		NetworkSort11xTimeReverse(a)
+	case 12:
+		NetworkSort12xTimeReverse(a)
+	case 16:
+		NetworkSort16xTimeReverse(a)
+	default:
+		return false
+	}
+	return true
+}
+
+func NetworkSort2xBytes(a [][]byte) {
+	_ = a[1]
+	if bytes.Compare(a[1], a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort2xBytesReverse(a [][]byte) {
+	_ = a[1]
+	if bytes.Compare(a[0], a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3xBytes(a [][]byte) {
+	_ = a[2]
+	if bytes.Compare(a[2], a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if bytes.Compare(a[2], a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if bytes.Compare(a[1], a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3xBytesReverse(a [][]byte) {
+	_ = a[2]
+	if bytes.Compare(a[1], a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if bytes.Compare(a[0], a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if bytes.Compare(a[0], a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort4xBytes(a [][]byte) {
+	_ = a[3]
+	if bytes.Compare(a[1], a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if bytes.Compare(a[3], a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if bytes.Compare(a[2], a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if bytes.Compare(a[3], a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if bytes.Compare(a[2], a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort4xBytesReverse(a [][]byte) {
+	_ = a[3]
+	if bytes.Compare(a[0], a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if bytes.Compare(a[2], a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if bytes.Compare(a[0], a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if bytes.Compare(a[1], a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if bytes.Compare(a[1], a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5xBytes(a [][]byte) {
+	_ = a[4]
+	if bytes.Compare(a[1], a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if bytes.Compare(a[4], a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if bytes.Compare(a[4], a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if bytes.Compare(a[3], a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if bytes.Compare(a[3], a[0]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if bytes.Compare(a[2], a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if bytes.Compare(a[4], a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if bytes.Compare(a[3], a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if bytes.Compare(a[2], a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5xBytesReverse(a [][]byte) {
+	_ = a[4]
+	if bytes.Compare(a[0], a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if bytes.Compare(a[3], a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if bytes.Compare(a[2], a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if bytes.Compare(a[2], a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if bytes.Compare(a[0], a[3]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if bytes.Compare(a[0], a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if bytes.Compare(a[1], a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if bytes.Compare(a[1], a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if bytes.Compare(a[1], a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort6xBytes(a [][]byte) {
+	_ = a[5]
+	if bytes.Compare(a[2], a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if bytes.Compare(a[2], a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if bytes.Compare(a[1], a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if bytes.Compare(a[5], a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if bytes.Compare(a[5], a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if bytes.Compare(a[4], a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if bytes.Compare(a[4], a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if bytes.Compare(a[3], a[0]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if bytes.Compare(a[5], a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if bytes.Compare(a[5], a[2]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if bytes.Compare(a[3], a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if bytes.Compare(a[4], a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if bytes.Compare(a[3], a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort6xBytesReverse(a [][]byte) {
+	_ = a[5]
+	if bytes.Compare(a[1], a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if bytes.Compare(a[0], a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if bytes.Compare(a[0], a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if bytes.Compare(a[4], a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if bytes.Compare(a[3], a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if bytes.Compare(a[3], a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if bytes.Compare(a[0], a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if bytes.Compare(a[0], a[3]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if bytes.Compare(a[1], a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if bytes.Compare(a[2], a[5]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if bytes.Compare(a[1], a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if bytes.Compare(a[2], a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if bytes.Compare(a[2], a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7xBytes(a [][]byte) {
+	_ = a[6]
+	if bytes.Compare(a[2], a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if bytes.Compare(a[2], a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if bytes.Compare(a[1], a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if bytes.Compare(a[4], a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if bytes.Compare(a[6], a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if bytes.Compare(a[5], a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if bytes.Compare(a[6], a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if bytes.Compare(a[5], a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if bytes.Compare(a[4], a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if bytes.Compare(a[3], a[0]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if bytes.Compare(a[5], a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if bytes.Compare(a[6], a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if bytes.Compare(a[5], a[2]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if bytes.Compare(a[3], a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if bytes.Compare(a[4], a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if bytes.Compare(a[3], a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7xBytesReverse(a [][]byte) {
+	_ = a[6]
+	if bytes.Compare(a[1], a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if bytes.Compare(a[0], a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if bytes.Compare(a[0], a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if bytes.Compare(a[3], a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if bytes.Compare(a[5], a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if bytes.Compare(a[3], a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if bytes.Compare(a[4], a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if bytes.Compare(a[4], a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if bytes.Compare(a[0], a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if bytes.Compare(a[0], a[3]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if bytes.Compare(a[1], a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if bytes.Compare(a[2], a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if bytes.Compare(a[2], a[5]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if bytes.Compare(a[1], a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if bytes.Compare(a[2], a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if bytes.Compare(a[2], a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort8xBytes(a [][]byte) {
+	_ = a[7]
+	if bytes.Compare(a[1], a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if bytes.Compare(a[3], a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if bytes.Compare(a[2], a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if bytes.Compare(a[3], a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if bytes.Compare(a[2], a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if bytes.Compare(a[5], a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if bytes.Compare(a[7], a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if bytes.Compare(a[6], a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if bytes.Compare(a[7], a[5]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if bytes.Compare(a[6], a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if bytes.Compare(a[4], a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if bytes.Compare(a[5], a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if bytes.Compare(a[4], a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if bytes.Compare(a[6], a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if bytes.Compare(a[7], a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if bytes.Compare(a[6], a[3]) < 0 {
+		a[3], a[6] = a[6], a[3]
+	}
+	if bytes.Compare(a[4], a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if bytes.Compare(a[5], a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if bytes.Compare(a[4], a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort8xBytesReverse(a [][]byte) {
+	_ = a[7]
+	if bytes.Compare(a[0], a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if bytes.Compare(a[2], a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if bytes.Compare(a[0], a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if bytes.Compare(a[1], a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if bytes.Compare(a[1], a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if bytes.Compare(a[4], a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if bytes.Compare(a[6], a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if bytes.Compare(a[4], a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if bytes.Compare(a[5], a[7]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if bytes.Compare(a[5], a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if bytes.Compare(a[0], a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if bytes.Compare(a[1], a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if bytes.Compare(a[1], a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if bytes.Compare(a[2], a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if bytes.Compare(a[3], a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if bytes.Compare(a[3], a[6]) < 0 {
+		a[3], a[6] = a[6], a[3]
+	}
+	if bytes.Compare(a[2], a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if bytes.Compare(a[3], a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if bytes.Compare(a[3], a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort9xBytes(a [][]byte) {
+	_ = a[8]
+	if bytes.Compare(a[6], a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if bytes.Compare(a[5], a[0]) < 0 {
+		a[0], a[5] = a[5], a[0]
+	}
+	if bytes.Compare(a[4], a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if bytes.Compare(a[8], a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if bytes.Compare(a[7], a[0]) < 0 {
+		a[0], a[7] = a[7], a[0]
+	}
+	if bytes.Compare(a[2], a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if bytes.Compare(a[5], a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if bytes.Compare(a[6], a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if bytes.Compare(a[8], a[5]) < 0 {
+		a[5], a[8] = a[8], a[5]
+	}
+	if bytes.Compare(a[3], a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if bytes.Compare(a[8], a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if bytes.Compare(a[1], a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if bytes.Compare(a[5], a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if bytes.Compare(a[7], a[2]) < 0 {
+		a[2], a[7] = a[7], a[2]
+	}
+	if bytes.Compare(a[7], a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if bytes.Compare(a[4], a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if bytes.Compare(a[6], a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if bytes.Compare(a[2], a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if bytes.Compare(a[3], a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if bytes.Compare(a[7], a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if bytes.Compare(a[5], a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if bytes.Compare(a[4], a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if bytes.Compare(a[6], a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if bytes.Compare(a[3], a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if bytes.Compare(a[5], a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort9xBytesReverse(a [][]byte) {
+	_ = a[8]
+	if bytes.Compare(a[2], a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if bytes.Compare(a[0], a[5]) < 0 {
+		a[0], a[5] = a[5], a[0]
+	}
+	if bytes.Compare(a[1], a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if bytes.Compare(a[7], a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if bytes.Compare(a[0], a[7]) < 0 {
+		a[0], a[7] = a[7], a[0]
+	}
+	if bytes.Compare(a[1], a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if bytes.Compare(a[3], a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if bytes.Compare(a[4], a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if bytes.Compare(a[5], a[8]) < 0 {
+		a[5], a[8] = a[8], a[5]
+	}
+	if bytes.Compare(a[1], a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if bytes.Compare(a[6], a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if bytes.Compare(a[0], a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if bytes.Compare(a[4], a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if bytes.Compare(a[2], a[7]) < 0 {
+		a[2], a[7] = a[7], a[2]
+	}
+	if bytes.Compare(a[3], a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if bytes.Compare(a[3], a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if bytes.Compare(a[5], a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if bytes.Compare(a[1], a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if bytes.Compare(a[1], a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if bytes.Compare(a[6], a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if bytes.Compare(a[4], a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if bytes.Compare(a[2], a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if bytes.Compare(a[5], a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if bytes.Compare(a[2], a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if bytes.Compare(a[4], a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort10xBytes(a [][]byte) {
+	_ = a[9]
+	if bytes.Compare(a[4], a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if bytes.Compare(a[8], a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if bytes.Compare(a[3], a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if bytes.Compare(a[6], a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if bytes.Compare(a[9], a[0]) < 0 {
+		a[0], a[9] = a[9], a[0]
+	}
+	if bytes.Compare(a[5], a[2]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if bytes.Compare(a[7], a[0]) < 0 {
+		a[0], a[7] = a[7], a[0]
+	}
+	if bytes.Compare(a[9], a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if bytes.Compare(a[6], a[3]) < 0 {
+		a[3], a[6] = a[6], a[3]
+	}
+	if bytes.Compare(a[9], a[4]) < 0 {
+		a[4], a[9] = a[9], a[4]
+	}
+	if bytes.Compare(a[1], a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if bytes.Compare(a[2], a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if bytes.Compare(a[9], a[6]) < 0 {
+		a[6], a[9] = a[9], a[6]
+	}
+	if bytes.Compare(a[5], a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if bytes.Compare(a[7], a[4]) < 0 {
+		a[4], a[7] = a[7], a[4]
+	}
+	if bytes.Compare(a[8], a[1]) < 0 {
+		a[1], a[8] = a[8], a[1]
+	}
+	if bytes.Compare(a[4], a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if bytes.Compare(a[8], a[5]) < 0 {
+		a[5], a[8] = a[8], a[5]
+	}
+	if bytes.Compare(a[7], a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if bytes.Compare(a[2], a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if bytes.Compare(a[8], a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if bytes.Compare(a[3], a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if bytes.Compare(a[5], a[2]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if bytes.Compare(a[6], a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if bytes.Compare(a[3], a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if bytes.Compare(a[7], a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if bytes.Compare(a[5], a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if bytes.Compare(a[4], a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if bytes.Compare(a[6], a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort10xBytesReverse(a [][]byte) {
+	_ = a[9]
+	if bytes.Compare(a[1], a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if bytes.Compare(a[7], a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if bytes.Compare(a[2], a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if bytes.Compare(a[5], a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if bytes.Compare(a[0], a[9]) < 0 {
+		a[0], a[9] = a[9], a[0]
+	}
+	if bytes.Compare(a[2], a[5]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if bytes.Compare(a[0], a[7]) < 0 {
+		a[0], a[7] = a[7], a[0]
+	}
+	if bytes.Compare(a[8], a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if bytes.Compare(a[3], a[6]) < 0 {
+		a[3], a[6] = a[6], a[3]
+	}
+	if bytes.Compare(a[4], a[9]) < 0 {
+		a[4], a[9] = a[9], a[4]
+	}
+	if bytes.Compare(a[0], a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if bytes.Compare(a[0], a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if bytes.Compare(a[6], a[9]) < 0 {
+		a[6], a[9] = a[9], a[6]
+	}
+	if bytes.Compare(a[3], a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if bytes.Compare(a[4], a[7]) < 0 {
+		a[4], a[7] = a[7], a[4]
+	}
+	if bytes.Compare(a[1], a[8]) < 0 {
+		a[1], a[8] = a[8], a[1]
+	}
+	if bytes.Compare(a[3], a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if bytes.Compare(a[5], a[8]) < 0 {
+		a[5], a[8] = a[8], a[5]
+	}
+	if bytes.Compare(a[6], a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if bytes.Compare(a[1], a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if bytes.Compare(a[7], a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if bytes.Compare(a[1], a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if bytes.Compare(a[2], a[5]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if bytes.Compare(a[4], a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if bytes.Compare(a[2], a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if bytes.Compare(a[6], a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if bytes.Compare(a[4], a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if bytes.Compare(a[3], a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if bytes.Compare(a[5], a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort11xBytes(a [][]byte) {
+	_ = a[10]
+	if bytes.Compare(a[1], a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if bytes.Compare(a[3], a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if bytes.Compare(a[5], a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if bytes.Compare(a[7], a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if bytes.Compare(a[9], a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if bytes.Compare(a[3], a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if bytes.Compare(a[7], a[5]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if bytes.Compare(a[2], a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if bytes.Compare(a[6], a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if bytes.Compare(a[10], a[8]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if bytes.Compare(a[2], a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if bytes.Compare(a[6], a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if bytes.Compare(a[10], a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if bytes.Compare(a[5], a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if bytes.Compare(a[10], a[6]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if bytes.Compare(a[9], a[5]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if bytes.Compare(a[6], a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if bytes.Compare(a[5], a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if bytes.Compare(a[10], a[6]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if bytes.Compare(a[4], a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if bytes.Compare(a[7], a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if bytes.Compare(a[8], a[4]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if bytes.Compare(a[4], a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if bytes.Compare(a[4], a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if bytes.Compare(a[10], a[7]) < 0 {
+		a[7], a[10] = a[10], a[7]
+	}
+	if bytes.Compare(a[8], a[3]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if bytes.Compare(a[3], a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if bytes.Compare(a[9], a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if bytes.Compare(a[4], a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if bytes.Compare(a[9], a[7]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if bytes.Compare(a[5], a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if bytes.Compare(a[8], a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if bytes.Compare(a[4], a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if bytes.Compare(a[6], a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if bytes.Compare(a[8], a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort11xBytesReverse(a [][]byte) {
+	_ = a[10]
+	if bytes.Compare(a[0], a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if bytes.Compare(a[2], a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if bytes.Compare(a[4], a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if bytes.Compare(a[6], a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if bytes.Compare(a[8], a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if bytes.Compare(a[1], a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if bytes.Compare(a[5], a[7]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if bytes.Compare(a[0], a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if bytes.Compare(a[4], a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if bytes.Compare(a[8], a[10]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if bytes.Compare(a[1], a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if bytes.Compare(a[5], a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if bytes.Compare(a[9], a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if bytes.Compare(a[1], a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if bytes.Compare(a[6], a[10]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if bytes.Compare(a[5], a[9]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if bytes.Compare(a[2], a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if bytes.Compare(a[1], a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if bytes.Compare(a[6], a[10]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if bytes.Compare(a[0], a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if bytes.Compare(a[3], a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if bytes.Compare(a[4], a[8]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if bytes.Compare(a[0], a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if bytes.Compare(a[1], a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if bytes.Compare(a[7], a[10]) < 0 {
+		a[7], a[10] = a[10], a[7]
+	}
+	if bytes.Compare(a[3], a[8]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if bytes.Compare(a[2], a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if bytes.Compare(a[8], a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if bytes.Compare(a[2], a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if bytes.Compare(a[7], a[9]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if bytes.Compare(a[3], a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if bytes.Compare(a[6], a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if bytes.Compare(a[3], a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if bytes.Compare(a[5], a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if bytes.Compare(a[7], a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort12xBytes(a [][]byte) {
+	_ = a[11]
+	if bytes.Compare(a[1], a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if bytes.Compare(a[3], a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if bytes.Compare(a[5], a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if bytes.Compare(a[7], a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if bytes.Compare(a[9], a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if bytes.Compare(a[11], a[10]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if bytes.Compare(a[3], a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if bytes.Compare(a[7], a[5]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if bytes.Compare(a[11], a[9]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if bytes.Compare(a[2], a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if bytes.Compare(a[6], a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if bytes.Compare(a[10], a[8]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if bytes.Compare(a[2], a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if bytes.Compare(a[6], a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if bytes.Compare(a[10], a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if bytes.Compare(a[5], a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if bytes.Compare(a[10], a[6]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if bytes.Compare(a[9], a[5]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if bytes.Compare(a[6], a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if bytes.Compare(a[5], a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if bytes.Compare(a[10], a[6]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if bytes.Compare(a[4], a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if bytes.Compare(a[11], a[7]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if bytes.Compare(a[7], a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if bytes.Compare(a[8], a[4]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if bytes.Compare(a[4], a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if bytes.Compare(a[11], a[7]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if bytes.Compare(a[4], a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if bytes.Compare(a[10], a[7]) < 0 {
+		a[7], a[10] = a[10], a[7]
+	}
+	if bytes.Compare(a[8], a[3]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if bytes.Compare(a[3], a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if bytes.Compare(a[9], a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if bytes.Compare(a[4], a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if bytes.Compare(a[9], a[7]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if bytes.Compare(a[5], a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if bytes.Compare(a[8], a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if bytes.Compare(a[4], a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if bytes.Compare(a[6], a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if bytes.Compare(a[8], a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort12xBytesReverse(a [][]byte) {
+	_ = a[11]
+	if bytes.Compare(a[0], a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if bytes.Compare(a[2], a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if bytes.Compare(a[4], a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if bytes.Compare(a[6], a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if bytes.Compare(a[8], a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if bytes.Compare(a[10], a[11]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if bytes.Compare(a[1], a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if bytes.Compare(a[5], a[7]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if bytes.Compare(a[9], a[11]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if bytes.Compare(a[0], a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if bytes.Compare(a[4], a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if bytes.Compare(a[8], a[10]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if bytes.Compare(a[1], a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if bytes.Compare(a[5], a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if bytes.Compare(a[9], a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if bytes.Compare(a[1], a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if bytes.Compare(a[6], a[10]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if bytes.Compare(a[5], a[9]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if bytes.Compare(a[2], a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if bytes.Compare(a[1], a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if bytes.Compare(a[6], a[10]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if bytes.Compare(a[0], a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if bytes.Compare(a[7], a[11]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if bytes.Compare(a[3], a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if bytes.Compare(a[4], a[8]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if bytes.Compare(a[0], a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if bytes.Compare(a[7], a[11]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if bytes.Compare(a[1], a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if bytes.Compare(a[7], a[10]) < 0 {
+		a[7], a[10] = a[10], a[7]
+	}
+	if bytes.Compare(a[3], a[8]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if bytes.Compare(a[2], a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if bytes.Compare(a[8], a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if bytes.Compare(a[2], a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if bytes.Compare(a[7], a[9]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if bytes.Compare(a[3], a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if bytes.Compare(a[6], a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if bytes.Compare(a[3], a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if bytes.Compare(a[5], a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if bytes.Compare(a[7], a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort16xBytes(a [][]byte) {
+	_ = a[15]
+	if bytes.Compare(a[1], a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if bytes.Compare(a[3], a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if bytes.Compare(a[5], a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if bytes.Compare(a[7], a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if bytes.Compare(a[9], a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if bytes.Compare(a[11], a[10]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if bytes.Compare(a[13], a[12]) < 0 {
+		a[12], a[13] = a[13], a[12]
+	}
+	if bytes.Compare(a[15], a[14]) < 0 {
+		a[14], a[15] = a[15], a[14]
+	}
+	if bytes.Compare(a[2], a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if bytes.Compare(a[6], a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if bytes.Compare(a[10], a[8]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if bytes.Compare(a[14], a[12]) < 0 {
+		a[12], a[14] = a[14], a[12]
+	}
+	if bytes.Compare(a[3], a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if bytes.Compare(a[7], a[5]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if bytes.Compare(a[11], a[9]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if bytes.Compare(a[15], a[13]) < 0 {
+		a[13], a[15] = a[15], a[13]
+	}
+	if bytes.Compare(a[4], a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if bytes.Compare(a[12], a[8]) < 0 {
+		a[8], a[12] = a[12], a[8]
+	}
+	if bytes.Compare(a[5], a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if bytes.Compare(a[13], a[9]) < 0 {
+		a[9], a[13] = a[13], a[9]
+	}
+	if bytes.Compare(a[6], a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if bytes.Compare(a[14], a[10]) < 0 {
+		a[10], a[14] = a[14], a[10]
+	}
+	if bytes.Compare(a[7], a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if bytes.Compare(a[15], a[11]) < 0 {
+		a[11], a[15] = a[15], a[11]
+	}
+	if bytes.Compare(a[8], a[0]) < 0 {
+		a[0], a[8] = a[8], a[0]
+	}
+	if bytes.Compare(a[9], a[1]) < 0 {
+		a[1], a[9] = a[9], a[1]
+	}
+	if bytes.Compare(a[10], a[2]) < 0 {
+		a[2], a[10] = a[10], a[2]
+	}
+	if bytes.Compare(a[11], a[3]) < 0 {
+		a[3], a[11] = a[11], a[3]
+	}
+	if bytes.Compare(a[12], a[4]) < 0 {
+		a[4], a[12] = a[12], a[4]
+	}
+	if bytes.Compare(a[13], a[5]) < 0 {
+		a[5], a[13] = a[13], a[5]
+	}
+	if bytes.Compare(a[14], a[6]) < 0 {
+		a[6], a[14] = a[14], a[6]
+	}
+	if bytes.Compare(a[15], a[7]) < 0 {
+		a[7], a[15] = a[15], a[7]
+	}
+	if bytes.Compare(a[10], a[5]) < 0 {
+		a[5], a[10] = a[10], a[5]
+	}
+	if bytes.Compare(a[9], a[6]) < 0 {
+		a[6], a[9] = a[9], a[6]
+	}
+	if bytes.Compare(a[12], a[3]) < 0 {
+		a[3], a[12] = a[12], a[3]
+	}
+	if bytes.Compare(a[14], a[13]) < 0 {
+		a[13], a[14] = a[14], a[13]
+	}
+	if bytes.Compare(a[11], a[7]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if bytes.Compare(a[2], a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if bytes.Compare(a[8], a[4]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if bytes.Compare(a[4], a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if bytes.Compare(a[13], a[7]) < 0 {
+		a[7], a[13] = a[13], a[7]
+	}
+	if bytes.Compare(a[8], a[2]) < 0 {
+		a[2], a[8] = a[8], a[2]
+	}
+	if bytes.Compare(a[14], a[11]) < 0 {
+		a[11], a[14] = a[14], a[11]
+	}
+	if bytes.Compare(a[4], a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if bytes.Compare(a[6], a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if bytes.Compare(a[10], a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if bytes.Compare(a[13], a[11]) < 0 {
+		a[11], a[13] = a[13], a[11]
+	}
+	if bytes.Compare(a[8], a[3]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if bytes.Compare(a[12], a[7]) < 0 {
+		a[7], a[12] = a[12], a[7]
+	}
+	if bytes.Compare(a[8], a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if bytes.Compare(a[12], a[10]) < 0 {
+		a[10], a[12] = a[12], a[10]
+	}
+	if bytes.Compare(a[5], a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if bytes.Compare(a[9], a[7]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if bytes.Compare(a[4], a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if bytes.Compare(a[6], a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if bytes.Compare(a[8], a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if bytes.Compare(a[10], a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if bytes.Compare(a[12], a[11]) < 0 {
+		a[11], a[12] = a[12], a[11]
+	}
+	if bytes.Compare(a[7], a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if bytes.Compare(a[9], a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort16xBytesReverse(a [][]byte) {
+	_ = a[15]
+	if bytes.Compare(a[0], a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if bytes.Compare(a[2], a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if bytes.Compare(a[4], a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if bytes.Compare(a[6], a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if bytes.Compare(a[8], a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if bytes.Compare(a[10], a[11]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if bytes.Compare(a[12], a[13]) < 0 {
+		a[12], a[13] = a[13], a[12]
+	}
+	if bytes.Compare(a[14], a[15]) < 0 {
+		a[14], a[15] = a[15], a[14]
+	}
+	if bytes.Compare(a[0], a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if bytes.Compare(a[4], a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if bytes.Compare(a[8], a[10]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if bytes.Compare(a[12], a[14]) < 0 {
+		a[12], a[14] = a[14], a[12]
+	}
+	if bytes.Compare(a[1], a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if bytes.Compare(a[5], a[7]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if bytes.Compare(a[9], a[11]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if bytes.Compare(a[13], a[15]) < 0 {
+		a[13], a[15] = a[15], a[13]
+	}
+	if bytes.Compare(a[0], a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if bytes.Compare(a[8], a[12]) < 0 {
+		a[8], a[12] = a[12], a[8]
+	}
+	if bytes.Compare(a[1], a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if bytes.Compare(a[9], a[13]) < 0 {
+		a[9], a[13] = a[13], a[9]
+	}
+	if bytes.Compare(a[2], a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if bytes.Compare(a[10], a[14]) < 0 {
+		a[10], a[14] = a[14], a[10]
+	}
+	if bytes.Compare(a[3], a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if bytes.Compare(a[11], a[15]) < 0 {
+		a[11], a[15] = a[15], a[11]
+	}
+	if bytes.Compare(a[0], a[8]) < 0 {
+		a[0], a[8] = a[8], a[0]
+	}
+	if bytes.Compare(a[1], a[9]) < 0 {
+		a[1], a[9] = a[9], a[1]
+	}
+	if bytes.Compare(a[2], a[10]) < 0 {
+		a[2], a[10] = a[10], a[2]
+	}
+	if bytes.Compare(a[3], a[11]) < 0 {
+		a[3], a[11] = a[11], a[3]
+	}
+	if bytes.Compare(a[4], a[12]) < 0 {
+		a[4], a[12] = a[12], a[4]
+	}
+	if bytes.Compare(a[5], a[13]) < 0 {
+		a[5], a[13] = a[13], a[5]
+	}
+	if bytes.Compare(a[6], a[14]) < 0 {
+		a[6], a[14] = a[14], a[6]
+	}
+	if bytes.Compare(a[7], a[15]) < 0 {
+		a[7], a[15] = a[15], a[7]
+	}
+	if bytes.Compare(a[5], a[10]) < 0 {
+		a[5], a[10] = a[10], a[5]
+	}
+	if bytes.Compare(a[6], a[9]) < 0 {
+		a[6], a[9] = a[9], a[6]
+	}
+	if bytes.Compare(a[3], a[12]) < 0 {
+		a[3], a[12] = a[12], a[3]
+	}
+	if bytes.Compare(a[13], a[14]) < 0 {
+		a[13], a[14] = a[14], a[13]
+	}
+	if bytes.Compare(a[7], a[11]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if bytes.Compare(a[1], a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if bytes.Compare(a[4], a[8]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if bytes.Compare(a[1], a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if bytes.Compare(a[7], a[13]) < 0 {
+		a[7], a[13] = a[13], a[7]
+	}
+	if bytes.Compare(a[2], a[8]) < 0 {
+		a[2], a[8] = a[8], a[2]
+	}
+	if bytes.Compare(a[11], a[14]) < 0 {
+		a[11], a[14] = a[14], a[11]
+	}
+	if bytes.Compare(a[2], a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if bytes.Compare(a[5], a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if bytes.Compare(a[9], a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if bytes.Compare(a[11], a[13]) < 0 {
+		a[11], a[13] = a[13], a[11]
+	}
+	if bytes.Compare(a[3], a[8]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if bytes.Compare(a[7], a[12]) < 0 {
+		a[7], a[12] = a[12], a[7]
+	}
+	if bytes.Compare(a[6], a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if bytes.Compare(a[10], a[12]) < 0 {
+		a[10], a[12] = a[12], a[10]
+	}
+	if bytes.Compare(a[3], a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if bytes.Compare(a[7], a[9]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if bytes.Compare(a[3], a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if bytes.Compare(a[5], a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if bytes.Compare(a[7], a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if bytes.Compare(a[9], a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if bytes.Compare(a[11], a[12]) < 0 {
+		a[11], a[12] = a[12], a[11]
+	}
+	if bytes.Compare(a[6], a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if bytes.Compare(a[8], a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort2xBigFloat(a []*big.Float) {
+	_ = a[1]
+	if a[1].Cmp(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort2xBigFloatReverse(a []*big.Float) {
+	_ = a[1]
+	if a[0].Cmp(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3xBigFloat(a []*big.Float) {
+	_ = a[2]
+	if a[2].Cmp(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[2].Cmp(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Cmp(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3xBigFloatReverse(a []*big.Float) {
+	_ = a[2]
+	if a[1].Cmp(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0].Cmp(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0].Cmp(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort4xBigFloat(a []*big.Float) {
+	_ = a[3]
+	if a[1].Cmp(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Cmp(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[2].Cmp(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[3].Cmp(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Cmp(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort4xBigFloatReverse(a []*big.Float) {
+	_ = a[3]
+	if a[0].Cmp(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Cmp(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0].Cmp(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Cmp(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1].Cmp(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5xBigFloat(a []*big.Float) {
+	_ = a[4]
+	if a[1].Cmp(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4].Cmp(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[4].Cmp(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Cmp(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[3].Cmp(a[0]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[2].Cmp(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Cmp(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[3].Cmp(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Cmp(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5xBigFloatReverse(a []*big.Float) {
+	_ = a[4]
+	if a[0].Cmp(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Cmp(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[2].Cmp(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2].Cmp(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0].Cmp(a[3]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[0].Cmp(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Cmp(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[1].Cmp(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1].Cmp(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort6xBigFloat(a []*big.Float) {
+	_ = a[5]
+	if a[2].Cmp(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[2].Cmp(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Cmp(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[5].Cmp(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[5].Cmp(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Cmp(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[4].Cmp(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3].Cmp(a[0]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[5].Cmp(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[5].Cmp(a[2]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[3].Cmp(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[4].Cmp(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Cmp(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort6xBigFloatReverse(a []*big.Float) {
+	_ = a[5]
+	if a[1].Cmp(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0].Cmp(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0].Cmp(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4].Cmp(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3].Cmp(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3].Cmp(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0].Cmp(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0].Cmp(a[3]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1].Cmp(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2].Cmp(a[5]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1].Cmp(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Cmp(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2].Cmp(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7xBigFloat(a []*big.Float) {
+	_ = a[6]
+	if a[2].Cmp(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[2].Cmp(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Cmp(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4].Cmp(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Cmp(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[5].Cmp(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Cmp(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5].Cmp(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[4].Cmp(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3].Cmp(a[0]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[5].Cmp(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Cmp(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[5].Cmp(a[2]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[3].Cmp(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[4].Cmp(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Cmp(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7xBigFloatReverse(a []*big.Float) {
+	_ = a[6]
+	if a[1].Cmp(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0].Cmp(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0].Cmp(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Cmp(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Cmp(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3].Cmp(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Cmp(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4].Cmp(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[0].Cmp(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0].Cmp(a[3]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1].Cmp(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2].Cmp(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2].Cmp(a[5]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1].Cmp(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Cmp(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2].Cmp(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort8xBigFloat(a []*big.Float) {
+	_ = a[7]
+	if a[1].Cmp(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Cmp(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[2].Cmp(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[3].Cmp(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Cmp(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Cmp(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Cmp(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[6].Cmp(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[7].Cmp(a[5]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[6].Cmp(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[4].Cmp(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[5].Cmp(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[4].Cmp(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[6].Cmp(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[7].Cmp(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[6].Cmp(a[3]) < 0 {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4].Cmp(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Cmp(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Cmp(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort8xBigFloatReverse(a []*big.Float) {
+	_ = a[7]
+	if a[0].Cmp(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Cmp(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0].Cmp(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Cmp(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1].Cmp(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4].Cmp(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Cmp(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4].Cmp(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5].Cmp(a[7]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5].Cmp(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0].Cmp(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1].Cmp(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1].Cmp(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2].Cmp(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3].Cmp(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3].Cmp(a[6]) < 0 {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2].Cmp(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Cmp(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3].Cmp(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort9xBigFloat(a []*big.Float) {
+	_ = a[8]
+	if a[6].Cmp(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[5].Cmp(a[0]) < 0 {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[4].Cmp(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[8].Cmp(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[7].Cmp(a[0]) < 0 {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[2].Cmp(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Cmp(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Cmp(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Cmp(a[5]) < 0 {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[3].Cmp(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[8].Cmp(a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[1].Cmp(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[5].Cmp(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Cmp(a[2]) < 0 {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[7].Cmp(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4].Cmp(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Cmp(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[2].Cmp(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3].Cmp(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Cmp(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[5].Cmp(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[4].Cmp(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[6].Cmp(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3].Cmp(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Cmp(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort9xBigFloatReverse(a []*big.Float) {
+	_ = a[8]
+	if a[2].Cmp(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[0].Cmp(a[5]) < 0 {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[1].Cmp(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Cmp(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[0].Cmp(a[7]) < 0 {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[1].Cmp(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3].Cmp(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Cmp(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5].Cmp(a[8]) < 0 {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[1].Cmp(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6].Cmp(a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[0].Cmp(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4].Cmp(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2].Cmp(a[7]) < 0 {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[3].Cmp(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3].Cmp(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Cmp(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[1].Cmp(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[1].Cmp(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6].Cmp(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4].Cmp(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2].Cmp(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Cmp(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[2].Cmp(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Cmp(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort10xBigFloat(a []*big.Float) {
+	_ = a[9]
+	if a[4].Cmp(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[8].Cmp(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[3].Cmp(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[6].Cmp(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Cmp(a[0]) < 0 {
+		a[0], a[9] = a[9], a[0]
+	}
+	if a[5].Cmp(a[2]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[7].Cmp(a[0]) < 0 {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[9].Cmp(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[6].Cmp(a[3]) < 0 {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[9].Cmp(a[4]) < 0 {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[1].Cmp(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Cmp(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[9].Cmp(a[6]) < 0 {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[5].Cmp(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7].Cmp(a[4]) < 0 {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[8].Cmp(a[1]) < 0 {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[4].Cmp(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[8].Cmp(a[5]) < 0 {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[7].Cmp(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[2].Cmp(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[8].Cmp(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[3].Cmp(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Cmp(a[2]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[6].Cmp(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[3].Cmp(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[7].Cmp(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[5].Cmp(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[4].Cmp(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Cmp(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort10xBigFloatReverse(a []*big.Float) {
+	_ = a[9]
+	if a[1].Cmp(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Cmp(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[2].Cmp(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Cmp(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0].Cmp(a[9]) < 0 {
+		a[0], a[9] = a[9], a[0]
+	}
+	if a[2].Cmp(a[5]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[0].Cmp(a[7]) < 0 {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[8].Cmp(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3].Cmp(a[6]) < 0 {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4].Cmp(a[9]) < 0 {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[0].Cmp(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[0].Cmp(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Cmp(a[9]) < 0 {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3].Cmp(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Cmp(a[7]) < 0 {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[1].Cmp(a[8]) < 0 {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[3].Cmp(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Cmp(a[8]) < 0 {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6].Cmp(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[1].Cmp(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[7].Cmp(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[1].Cmp(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Cmp(a[5]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[4].Cmp(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[2].Cmp(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[6].Cmp(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4].Cmp(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3].Cmp(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Cmp(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort11xBigFloat(a []*big.Float) {
+	_ = a[10]
+	if a[1].Cmp(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Cmp(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Cmp(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Cmp(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Cmp(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3].Cmp(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Cmp(a[5]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[2].Cmp(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Cmp(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Cmp(a[8]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[2].Cmp(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[6].Cmp(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Cmp(a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[5].Cmp(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Cmp(a[6]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[9].Cmp(a[5]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[6].Cmp(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[5].Cmp(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Cmp(a[6]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[4].Cmp(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7].Cmp(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[8].Cmp(a[4]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4].Cmp(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[4].Cmp(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[10].Cmp(a[7]) < 0 {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[8].Cmp(a[3]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[3].Cmp(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[9].Cmp(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[4].Cmp(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[9].Cmp(a[7]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[5].Cmp(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[8].Cmp(a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[4].Cmp(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Cmp(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Cmp(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort11xBigFloatReverse(a []*big.Float) {
+	_ = a[10]
+	if a[0].Cmp(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Cmp(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Cmp(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Cmp(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Cmp(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[1].Cmp(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Cmp(a[7]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[0].Cmp(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Cmp(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Cmp(a[10]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1].Cmp(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Cmp(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Cmp(a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1].Cmp(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Cmp(a[10]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5].Cmp(a[9]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2].Cmp(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1].Cmp(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Cmp(a[10]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0].Cmp(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3].Cmp(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4].Cmp(a[8]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0].Cmp(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1].Cmp(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Cmp(a[10]) < 0 {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3].Cmp(a[8]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2].Cmp(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8].Cmp(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2].Cmp(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7].Cmp(a[9]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Cmp(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Cmp(a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3].Cmp(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Cmp(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Cmp(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort12xBigFloat(a []*big.Float) {
+	_ = a[11]
+	if a[1].Cmp(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Cmp(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Cmp(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Cmp(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Cmp(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Cmp(a[10]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[3].Cmp(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Cmp(a[5]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[11].Cmp(a[9]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[2].Cmp(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Cmp(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Cmp(a[8]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[2].Cmp(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[6].Cmp(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Cmp(a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[5].Cmp(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Cmp(a[6]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[9].Cmp(a[5]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[6].Cmp(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[5].Cmp(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Cmp(a[6]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[4].Cmp(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[11].Cmp(a[7]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[7].Cmp(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[8].Cmp(a[4]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4].Cmp(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[11].Cmp(a[7]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[4].Cmp(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[10].Cmp(a[7]) < 0 {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[8].Cmp(a[3]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[3].Cmp(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[9].Cmp(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[4].Cmp(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[9].Cmp(a[7]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[5].Cmp(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[8].Cmp(a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[4].Cmp(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Cmp(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Cmp(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort12xBigFloatReverse(a []*big.Float) {
+	_ = a[11]
+	if a[0].Cmp(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Cmp(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Cmp(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Cmp(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Cmp(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Cmp(a[11]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1].Cmp(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Cmp(a[7]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9].Cmp(a[11]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0].Cmp(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Cmp(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Cmp(a[10]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1].Cmp(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Cmp(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Cmp(a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1].Cmp(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Cmp(a[10]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5].Cmp(a[9]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2].Cmp(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1].Cmp(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Cmp(a[10]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0].Cmp(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7].Cmp(a[11]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[3].Cmp(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4].Cmp(a[8]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0].Cmp(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7].Cmp(a[11]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1].Cmp(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Cmp(a[10]) < 0 {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3].Cmp(a[8]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2].Cmp(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8].Cmp(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2].Cmp(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7].Cmp(a[9]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Cmp(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Cmp(a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3].Cmp(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Cmp(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Cmp(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort16xBigFloat(a []*big.Float) {
+	_ = a[15]
+	if a[1].Cmp(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Cmp(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Cmp(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Cmp(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Cmp(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Cmp(a[10]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[13].Cmp(a[12]) < 0 {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[15].Cmp(a[14]) < 0 {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[2].Cmp(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Cmp(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Cmp(a[8]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[14].Cmp(a[12]) < 0 {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[3].Cmp(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Cmp(a[5]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[11].Cmp(a[9]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[15].Cmp(a[13]) < 0 {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[4].Cmp(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[12].Cmp(a[8]) < 0 {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[5].Cmp(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[13].Cmp(a[9]) < 0 {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[6].Cmp(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[14].Cmp(a[10]) < 0 {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[7].Cmp(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[15].Cmp(a[11]) < 0 {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[8].Cmp(a[0]) < 0 {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[9].Cmp(a[1]) < 0 {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[10].Cmp(a[2]) < 0 {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[11].Cmp(a[3]) < 0 {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[12].Cmp(a[4]) < 0 {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[13].Cmp(a[5]) < 0 {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[14].Cmp(a[6]) < 0 {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[15].Cmp(a[7]) < 0 {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[10].Cmp(a[5]) < 0 {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[9].Cmp(a[6]) < 0 {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[12].Cmp(a[3]) < 0 {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[14].Cmp(a[13]) < 0 {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[11].Cmp(a[7]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[2].Cmp(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[8].Cmp(a[4]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4].Cmp(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[13].Cmp(a[7]) < 0 {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[8].Cmp(a[2]) < 0 {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[14].Cmp(a[11]) < 0 {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[4].Cmp(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[6].Cmp(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Cmp(a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[13].Cmp(a[11]) < 0 {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[8].Cmp(a[3]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[12].Cmp(a[7]) < 0 {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[8].Cmp(a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[12].Cmp(a[10]) < 0 {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[5].Cmp(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[9].Cmp(a[7]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[4].Cmp(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Cmp(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Cmp(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[10].Cmp(a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12].Cmp(a[11]) < 0 {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[7].Cmp(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Cmp(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort16xBigFloatReverse(a []*big.Float) {
+	_ = a[15]
+	if a[0].Cmp(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Cmp(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Cmp(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Cmp(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Cmp(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Cmp(a[11]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12].Cmp(a[13]) < 0 {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14].Cmp(a[15]) < 0 {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[0].Cmp(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Cmp(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Cmp(a[10]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[12].Cmp(a[14]) < 0 {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[1].Cmp(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Cmp(a[7]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9].Cmp(a[11]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[13].Cmp(a[15]) < 0 {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[0].Cmp(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8].Cmp(a[12]) < 0 {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1].Cmp(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9].Cmp(a[13]) < 0 {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2].Cmp(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[10].Cmp(a[14]) < 0 {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[3].Cmp(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[11].Cmp(a[15]) < 0 {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[0].Cmp(a[8]) < 0 {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1].Cmp(a[9]) < 0 {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2].Cmp(a[10]) < 0 {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3].Cmp(a[11]) < 0 {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4].Cmp(a[12]) < 0 {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5].Cmp(a[13]) < 0 {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6].Cmp(a[14]) < 0 {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7].Cmp(a[15]) < 0 {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[5].Cmp(a[10]) < 0 {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6].Cmp(a[9]) < 0 {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3].Cmp(a[12]) < 0 {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[13].Cmp(a[14]) < 0 {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[7].Cmp(a[11]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1].Cmp(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4].Cmp(a[8]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1].Cmp(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Cmp(a[13]) < 0 {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2].Cmp(a[8]) < 0 {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[11].Cmp(a[14]) < 0 {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[2].Cmp(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Cmp(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Cmp(a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Cmp(a[13]) < 0 {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3].Cmp(a[8]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7].Cmp(a[12]) < 0 {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6].Cmp(a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10].Cmp(a[12]) < 0 {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3].Cmp(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7].Cmp(a[9]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Cmp(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Cmp(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Cmp(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9].Cmp(a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Cmp(a[12]) < 0 {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6].Cmp(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Cmp(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort2xBigInt(a []*big.Int) {
+	_ = a[1]
+	if a[1].Cmp(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort2xBigIntReverse(a []*big.Int) {
+	_ = a[1]
+	if a[0].Cmp(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3xBigInt(a []*big.Int) {
+	_ = a[2]
+	if a[2].Cmp(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[2].Cmp(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Cmp(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3xBigIntReverse(a []*big.Int) {
+	_ = a[2]
+	if a[1].Cmp(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0].Cmp(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0].Cmp(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort4xBigInt(a []*big.Int) {
+	_ = a[3]
+	if a[1].Cmp(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Cmp(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[2].Cmp(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[3].Cmp(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Cmp(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort4xBigIntReverse(a []*big.Int) {
+	_ = a[3]
+	if a[0].Cmp(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Cmp(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0].Cmp(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Cmp(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1].Cmp(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5xBigInt(a []*big.Int) {
+	_ = a[4]
+	if a[1].Cmp(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4].Cmp(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[4].Cmp(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Cmp(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[3].Cmp(a[0]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[2].Cmp(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Cmp(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[3].Cmp(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Cmp(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5xBigIntReverse(a []*big.Int) {
+	_ = a[4]
+	if a[0].Cmp(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Cmp(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[2].Cmp(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2].Cmp(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0].Cmp(a[3]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[0].Cmp(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Cmp(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[1].Cmp(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1].Cmp(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort6xBigInt(a []*big.Int) {
+	_ = a[5]
+	if a[2].Cmp(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[2].Cmp(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Cmp(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[5].Cmp(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[5].Cmp(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Cmp(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[4].Cmp(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3].Cmp(a[0]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[5].Cmp(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[5].Cmp(a[2]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[3].Cmp(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[4].Cmp(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Cmp(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort6xBigIntReverse(a []*big.Int) {
+	_ = a[5]
+	if a[1].Cmp(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0].Cmp(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0].Cmp(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4].Cmp(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3].Cmp(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3].Cmp(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0].Cmp(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0].Cmp(a[3]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1].Cmp(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2].Cmp(a[5]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1].Cmp(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Cmp(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2].Cmp(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7xBigInt(a []*big.Int) {
+	_ = a[6]
+	if a[2].Cmp(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[2].Cmp(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Cmp(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4].Cmp(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Cmp(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[5].Cmp(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Cmp(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5].Cmp(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[4].Cmp(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3].Cmp(a[0]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[5].Cmp(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Cmp(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[5].Cmp(a[2]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[3].Cmp(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[4].Cmp(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Cmp(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7xBigIntReverse(a []*big.Int) {
+	_ = a[6]
+	if a[1].Cmp(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0].Cmp(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0].Cmp(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Cmp(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Cmp(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3].Cmp(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Cmp(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4].Cmp(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[0].Cmp(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0].Cmp(a[3]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1].Cmp(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2].Cmp(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2].Cmp(a[5]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1].Cmp(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Cmp(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2].Cmp(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort8xBigInt(a []*big.Int) {
+	_ = a[7]
+	if a[1].Cmp(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Cmp(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[2].Cmp(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[3].Cmp(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Cmp(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Cmp(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Cmp(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[6].Cmp(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[7].Cmp(a[5]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[6].Cmp(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[4].Cmp(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[5].Cmp(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[4].Cmp(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[6].Cmp(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[7].Cmp(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[6].Cmp(a[3]) < 0 {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4].Cmp(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Cmp(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Cmp(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort8xBigIntReverse(a []*big.Int) {
+	_ = a[7]
+	if a[0].Cmp(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Cmp(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0].Cmp(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Cmp(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1].Cmp(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4].Cmp(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Cmp(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4].Cmp(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5].Cmp(a[7]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5].Cmp(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0].Cmp(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1].Cmp(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1].Cmp(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2].Cmp(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3].Cmp(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3].Cmp(a[6]) < 0 {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2].Cmp(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Cmp(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3].Cmp(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort9xBigInt(a []*big.Int) {
+	_ = a[8]
+	if a[6].Cmp(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[5].Cmp(a[0]) < 0 {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[4].Cmp(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[8].Cmp(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[7].Cmp(a[0]) < 0 {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[2].Cmp(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Cmp(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Cmp(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Cmp(a[5]) < 0 {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[3].Cmp(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[8].Cmp(a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[1].Cmp(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[5].Cmp(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Cmp(a[2]) < 0 {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[7].Cmp(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4].Cmp(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Cmp(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[2].Cmp(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3].Cmp(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Cmp(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[5].Cmp(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[4].Cmp(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[6].Cmp(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3].Cmp(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Cmp(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort9xBigIntReverse(a []*big.Int) {
+	_ = a[8]
+	if a[2].Cmp(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[0].Cmp(a[5]) < 0 {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[1].Cmp(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Cmp(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[0].Cmp(a[7]) < 0 {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[1].Cmp(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3].Cmp(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Cmp(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5].Cmp(a[8]) < 0 {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[1].Cmp(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6].Cmp(a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[0].Cmp(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4].Cmp(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2].Cmp(a[7]) < 0 {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[3].Cmp(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3].Cmp(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Cmp(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[1].Cmp(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[1].Cmp(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6].Cmp(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4].Cmp(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2].Cmp(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Cmp(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[2].Cmp(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Cmp(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort10xBigInt(a []*big.Int) {
+	_ = a[9]
+	if a[4].Cmp(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[8].Cmp(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[3].Cmp(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[6].Cmp(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Cmp(a[0]) < 0 {
+		a[0], a[9] = a[9], a[0]
+	}
+	if a[5].Cmp(a[2]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[7].Cmp(a[0]) < 0 {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[9].Cmp(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[6].Cmp(a[3]) < 0 {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[9].Cmp(a[4]) < 0 {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[1].Cmp(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Cmp(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[9].Cmp(a[6]) < 0 {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[5].Cmp(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7].Cmp(a[4]) < 0 {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[8].Cmp(a[1]) < 0 {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[4].Cmp(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[8].Cmp(a[5]) < 0 {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[7].Cmp(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[2].Cmp(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[8].Cmp(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[3].Cmp(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Cmp(a[2]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[6].Cmp(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[3].Cmp(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[7].Cmp(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[5].Cmp(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[4].Cmp(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Cmp(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort10xBigIntReverse(a []*big.Int) {
+	_ = a[9]
+	if a[1].Cmp(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Cmp(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[2].Cmp(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Cmp(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0].Cmp(a[9]) < 0 {
+		a[0], a[9] = a[9], a[0]
+	}
+	if a[2].Cmp(a[5]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[0].Cmp(a[7]) < 0 {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[8].Cmp(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3].Cmp(a[6]) < 0 {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4].Cmp(a[9]) < 0 {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[0].Cmp(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[0].Cmp(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Cmp(a[9]) < 0 {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3].Cmp(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Cmp(a[7]) < 0 {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[1].Cmp(a[8]) < 0 {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[3].Cmp(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Cmp(a[8]) < 0 {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6].Cmp(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[1].Cmp(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[7].Cmp(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[1].Cmp(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Cmp(a[5]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[4].Cmp(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[2].Cmp(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[6].Cmp(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4].Cmp(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3].Cmp(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Cmp(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort11xBigInt(a []*big.Int) {
+	_ = a[10]
+	if a[1].Cmp(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Cmp(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Cmp(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Cmp(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Cmp(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3].Cmp(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Cmp(a[5]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[2].Cmp(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Cmp(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Cmp(a[8]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[2].Cmp(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[6].Cmp(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Cmp(a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[5].Cmp(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Cmp(a[6]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[9].Cmp(a[5]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[6].Cmp(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[5].Cmp(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Cmp(a[6]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[4].Cmp(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7].Cmp(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[8].Cmp(a[4]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4].Cmp(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[4].Cmp(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[10].Cmp(a[7]) < 0 {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[8].Cmp(a[3]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[3].Cmp(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[9].Cmp(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[4].Cmp(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[9].Cmp(a[7]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[5].Cmp(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[8].Cmp(a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[4].Cmp(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Cmp(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Cmp(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort11xBigIntReverse(a []*big.Int) {
+	_ = a[10]
+	if a[0].Cmp(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Cmp(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Cmp(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Cmp(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Cmp(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[1].Cmp(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Cmp(a[7]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[0].Cmp(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Cmp(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Cmp(a[10]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1].Cmp(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Cmp(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Cmp(a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1].Cmp(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Cmp(a[10]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5].Cmp(a[9]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2].Cmp(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1].Cmp(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Cmp(a[10]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0].Cmp(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3].Cmp(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4].Cmp(a[8]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0].Cmp(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1].Cmp(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Cmp(a[10]) < 0 {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3].Cmp(a[8]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2].Cmp(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8].Cmp(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2].Cmp(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7].Cmp(a[9]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Cmp(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Cmp(a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3].Cmp(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Cmp(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Cmp(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort12xBigInt(a []*big.Int) {
+	_ = a[11]
+	if a[1].Cmp(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Cmp(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Cmp(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Cmp(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Cmp(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Cmp(a[10]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[3].Cmp(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Cmp(a[5]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[11].Cmp(a[9]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[2].Cmp(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Cmp(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Cmp(a[8]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[2].Cmp(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[6].Cmp(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Cmp(a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[5].Cmp(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Cmp(a[6]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[9].Cmp(a[5]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[6].Cmp(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[5].Cmp(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Cmp(a[6]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[4].Cmp(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[11].Cmp(a[7]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[7].Cmp(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[8].Cmp(a[4]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4].Cmp(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[11].Cmp(a[7]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[4].Cmp(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[10].Cmp(a[7]) < 0 {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[8].Cmp(a[3]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[3].Cmp(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[9].Cmp(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[4].Cmp(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[9].Cmp(a[7]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[5].Cmp(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[8].Cmp(a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[4].Cmp(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Cmp(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Cmp(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort12xBigIntReverse(a []*big.Int) {
+	_ = a[11]
+	if a[0].Cmp(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Cmp(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Cmp(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Cmp(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Cmp(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Cmp(a[11]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1].Cmp(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Cmp(a[7]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9].Cmp(a[11]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0].Cmp(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Cmp(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Cmp(a[10]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1].Cmp(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Cmp(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Cmp(a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1].Cmp(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Cmp(a[10]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5].Cmp(a[9]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2].Cmp(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1].Cmp(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Cmp(a[10]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0].Cmp(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7].Cmp(a[11]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[3].Cmp(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4].Cmp(a[8]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0].Cmp(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7].Cmp(a[11]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1].Cmp(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Cmp(a[10]) < 0 {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3].Cmp(a[8]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2].Cmp(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8].Cmp(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2].Cmp(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7].Cmp(a[9]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Cmp(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Cmp(a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3].Cmp(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Cmp(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Cmp(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort16xBigInt(a []*big.Int) {
+	_ = a[15]
+	if a[1].Cmp(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Cmp(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Cmp(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Cmp(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Cmp(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Cmp(a[10]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[13].Cmp(a[12]) < 0 {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[15].Cmp(a[14]) < 0 {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[2].Cmp(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Cmp(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Cmp(a[8]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[14].Cmp(a[12]) < 0 {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[3].Cmp(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Cmp(a[5]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[11].Cmp(a[9]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[15].Cmp(a[13]) < 0 {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[4].Cmp(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[12].Cmp(a[8]) < 0 {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[5].Cmp(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[13].Cmp(a[9]) < 0 {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[6].Cmp(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[14].Cmp(a[10]) < 0 {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[7].Cmp(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[15].Cmp(a[11]) < 0 {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[8].Cmp(a[0]) < 0 {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[9].Cmp(a[1]) < 0 {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[10].Cmp(a[2]) < 0 {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[11].Cmp(a[3]) < 0 {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[12].Cmp(a[4]) < 0 {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[13].Cmp(a[5]) < 0 {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[14].Cmp(a[6]) < 0 {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[15].Cmp(a[7]) < 0 {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[10].Cmp(a[5]) < 0 {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[9].Cmp(a[6]) < 0 {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[12].Cmp(a[3]) < 0 {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[14].Cmp(a[13]) < 0 {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[11].Cmp(a[7]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[2].Cmp(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[8].Cmp(a[4]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4].Cmp(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[13].Cmp(a[7]) < 0 {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[8].Cmp(a[2]) < 0 {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[14].Cmp(a[11]) < 0 {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[4].Cmp(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[6].Cmp(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Cmp(a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[13].Cmp(a[11]) < 0 {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[8].Cmp(a[3]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[12].Cmp(a[7]) < 0 {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[8].Cmp(a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[12].Cmp(a[10]) < 0 {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[5].Cmp(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[9].Cmp(a[7]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[4].Cmp(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Cmp(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Cmp(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[10].Cmp(a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12].Cmp(a[11]) < 0 {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[7].Cmp(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Cmp(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort16xBigIntReverse(a []*big.Int) {
+	_ = a[15]
+	if a[0].Cmp(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Cmp(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Cmp(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Cmp(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Cmp(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Cmp(a[11]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12].Cmp(a[13]) < 0 {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14].Cmp(a[15]) < 0 {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[0].Cmp(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Cmp(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Cmp(a[10]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[12].Cmp(a[14]) < 0 {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[1].Cmp(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Cmp(a[7]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9].Cmp(a[11]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[13].Cmp(a[15]) < 0 {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[0].Cmp(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8].Cmp(a[12]) < 0 {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1].Cmp(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9].Cmp(a[13]) < 0 {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2].Cmp(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[10].Cmp(a[14]) < 0 {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[3].Cmp(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[11].Cmp(a[15]) < 0 {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[0].Cmp(a[8]) < 0 {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1].Cmp(a[9]) < 0 {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2].Cmp(a[10]) < 0 {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3].Cmp(a[11]) < 0 {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4].Cmp(a[12]) < 0 {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5].Cmp(a[13]) < 0 {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6].Cmp(a[14]) < 0 {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7].Cmp(a[15]) < 0 {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[5].Cmp(a[10]) < 0 {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6].Cmp(a[9]) < 0 {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3].Cmp(a[12]) < 0 {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[13].Cmp(a[14]) < 0 {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[7].Cmp(a[11]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1].Cmp(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4].Cmp(a[8]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1].Cmp(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Cmp(a[13]) < 0 {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2].Cmp(a[8]) < 0 {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[11].Cmp(a[14]) < 0 {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[2].Cmp(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Cmp(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Cmp(a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Cmp(a[13]) < 0 {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3].Cmp(a[8]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7].Cmp(a[12]) < 0 {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6].Cmp(a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10].Cmp(a[12]) < 0 {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3].Cmp(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7].Cmp(a[9]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Cmp(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Cmp(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Cmp(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9].Cmp(a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Cmp(a[12]) < 0 {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6].Cmp(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Cmp(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort2xAddr(a []netip.Addr) {
+	_ = a[1]
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort2xAddrReverse(a []netip.Addr) {
+	_ = a[1]
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3xAddr(a []netip.Addr) {
+	_ = a[2]
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3xAddrReverse(a []netip.Addr) {
+	_ = a[2]
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort4xAddr(a []netip.Addr) {
+	_ = a[3]
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort4xAddrReverse(a []netip.Addr) {
+	_ = a[3]
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5xAddr(a []netip.Addr) {
+	_ = a[4]
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[4].Compare(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[3].Compare(a[0]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Compare(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5xAddrReverse(a []netip.Addr) {
+	_ = a[4]
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[2].Compare(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0].Compare(a[3]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Compare(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort6xAddr(a []netip.Addr) {
+	_ = a[5]
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3].Compare(a[0]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[5].Compare(a[2]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[4].Compare(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort6xAddrReverse(a []netip.Addr) {
+	_ = a[5]
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0].Compare(a[3]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2].Compare(a[5]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Compare(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7xAddr(a []netip.Addr) {
+	_ = a[6]
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Compare(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3].Compare(a[0]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Compare(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[5].Compare(a[2]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[4].Compare(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7xAddrReverse(a []netip.Addr) {
+	_ = a[6]
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Compare(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0].Compare(a[3]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2].Compare(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2].Compare(a[5]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Compare(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort8xAddr(a []netip.Addr) {
+	_ = a[7]
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[6].Compare(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[7].Compare(a[5]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[4].Compare(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[6].Compare(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[7].Compare(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[6].Compare(a[3]) < 0 {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4].Compare(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort8xAddrReverse(a []netip.Addr) {
+	_ = a[7]
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4].Compare(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5].Compare(a[7]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1].Compare(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2].Compare(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3].Compare(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3].Compare(a[6]) < 0 {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2].Compare(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort9xAddr(a []netip.Addr) {
+	_ = a[8]
+	if a[6].Compare(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[5].Compare(a[0]) < 0 {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[4].Compare(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[8].Compare(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[7].Compare(a[0]) < 0 {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Compare(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Compare(a[5]) < 0 {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[8].Compare(a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Compare(a[2]) < 0 {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[7].Compare(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[4].Compare(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort9xAddrReverse(a []netip.Addr) {
+	_ = a[8]
+	if a[2].Compare(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[0].Compare(a[5]) < 0 {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[1].Compare(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Compare(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[0].Compare(a[7]) < 0 {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Compare(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5].Compare(a[8]) < 0 {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6].Compare(a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2].Compare(a[7]) < 0 {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[3].Compare(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2].Compare(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort10xAddr(a []netip.Addr) {
+	_ = a[9]
+	if a[4].Compare(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[8].Compare(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Compare(a[0]) < 0 {
+		a[0], a[9] = a[9], a[0]
+	}
+	if a[5].Compare(a[2]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[7].Compare(a[0]) < 0 {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[6].Compare(a[3]) < 0 {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[9].Compare(a[4]) < 0 {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[9].Compare(a[6]) < 0 {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7].Compare(a[4]) < 0 {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[8].Compare(a[1]) < 0 {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[8].Compare(a[5]) < 0 {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[8].Compare(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Compare(a[2]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[6].Compare(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort10xAddrReverse(a []netip.Addr) {
+	_ = a[9]
+	if a[1].Compare(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Compare(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0].Compare(a[9]) < 0 {
+		a[0], a[9] = a[9], a[0]
+	}
+	if a[2].Compare(a[5]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[0].Compare(a[7]) < 0 {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3].Compare(a[6]) < 0 {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4].Compare(a[9]) < 0 {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Compare(a[9]) < 0 {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Compare(a[7]) < 0 {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[1].Compare(a[8]) < 0 {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Compare(a[8]) < 0 {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[7].Compare(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Compare(a[5]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[4].Compare(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort11xAddr(a []netip.Addr) {
+	_ = a[10]
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Compare(a[5]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Compare(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Compare(a[8]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Compare(a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Compare(a[6]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[9].Compare(a[5]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[6].Compare(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Compare(a[6]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7].Compare(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[8].Compare(a[4]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[4].Compare(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[10].Compare(a[7]) < 0 {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[8].Compare(a[3]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[4].Compare(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[9].Compare(a[7]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[8].Compare(a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Compare(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort11xAddrReverse(a []netip.Addr) {
+	_ = a[10]
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Compare(a[7]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Compare(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Compare(a[10]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Compare(a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Compare(a[10]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5].Compare(a[9]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2].Compare(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Compare(a[10]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3].Compare(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4].Compare(a[8]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1].Compare(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Compare(a[10]) < 0 {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3].Compare(a[8]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2].Compare(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7].Compare(a[9]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Compare(a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Compare(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort12xAddr(a []netip.Addr) {
+	_ = a[11]
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Compare(a[10]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Compare(a[5]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[11].Compare(a[9]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Compare(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Compare(a[8]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Compare(a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Compare(a[6]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[9].Compare(a[5]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[6].Compare(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Compare(a[6]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[11].Compare(a[7]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[7].Compare(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[8].Compare(a[4]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[11].Compare(a[7]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[4].Compare(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[10].Compare(a[7]) < 0 {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[8].Compare(a[3]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[4].Compare(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[9].Compare(a[7]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[8].Compare(a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Compare(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort12xAddrReverse(a []netip.Addr) {
+	_ = a[11]
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Compare(a[11]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Compare(a[7]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9].Compare(a[11]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Compare(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Compare(a[10]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Compare(a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Compare(a[10]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5].Compare(a[9]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2].Compare(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Compare(a[10]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7].Compare(a[11]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[3].Compare(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4].Compare(a[8]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7].Compare(a[11]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1].Compare(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Compare(a[10]) < 0 {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3].Compare(a[8]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2].Compare(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7].Compare(a[9]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Compare(a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Compare(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort16xAddr(a []netip.Addr) {
+	_ = a[15]
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Compare(a[10]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[13].Compare(a[12]) < 0 {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[15].Compare(a[14]) < 0 {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Compare(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Compare(a[8]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[14].Compare(a[12]) < 0 {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Compare(a[5]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[11].Compare(a[9]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[15].Compare(a[13]) < 0 {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[12].Compare(a[8]) < 0 {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[13].Compare(a[9]) < 0 {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[6].Compare(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[14].Compare(a[10]) < 0 {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[7].Compare(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[15].Compare(a[11]) < 0 {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[8].Compare(a[0]) < 0 {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[9].Compare(a[1]) < 0 {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[10].Compare(a[2]) < 0 {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[11].Compare(a[3]) < 0 {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[12].Compare(a[4]) < 0 {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[13].Compare(a[5]) < 0 {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[14].Compare(a[6]) < 0 {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[15].Compare(a[7]) < 0 {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[10].Compare(a[5]) < 0 {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[9].Compare(a[6]) < 0 {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[12].Compare(a[3]) < 0 {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[14].Compare(a[13]) < 0 {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[11].Compare(a[7]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[8].Compare(a[4]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4].Compare(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[13].Compare(a[7]) < 0 {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[8].Compare(a[2]) < 0 {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[14].Compare(a[11]) < 0 {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[4].Compare(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Compare(a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[13].Compare(a[11]) < 0 {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[8].Compare(a[3]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[12].Compare(a[7]) < 0 {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[8].Compare(a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[12].Compare(a[10]) < 0 {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[9].Compare(a[7]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Compare(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[10].Compare(a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12].Compare(a[11]) < 0 {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort16xAddrReverse(a []netip.Addr) {
+	_ = a[15]
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Compare(a[11]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12].Compare(a[13]) < 0 {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14].Compare(a[15]) < 0 {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Compare(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Compare(a[10]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[12].Compare(a[14]) < 0 {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Compare(a[7]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9].Compare(a[11]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[13].Compare(a[15]) < 0 {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8].Compare(a[12]) < 0 {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9].Compare(a[13]) < 0 {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2].Compare(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[10].Compare(a[14]) < 0 {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[3].Compare(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[11].Compare(a[15]) < 0 {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[0].Compare(a[8]) < 0 {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1].Compare(a[9]) < 0 {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2].Compare(a[10]) < 0 {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3].Compare(a[11]) < 0 {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4].Compare(a[12]) < 0 {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5].Compare(a[13]) < 0 {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6].Compare(a[14]) < 0 {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7].Compare(a[15]) < 0 {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[5].Compare(a[10]) < 0 {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6].Compare(a[9]) < 0 {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3].Compare(a[12]) < 0 {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[13].Compare(a[14]) < 0 {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[7].Compare(a[11]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4].Compare(a[8]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1].Compare(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Compare(a[13]) < 0 {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2].Compare(a[8]) < 0 {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[11].Compare(a[14]) < 0 {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[2].Compare(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Compare(a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Compare(a[13]) < 0 {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3].Compare(a[8]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7].Compare(a[12]) < 0 {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6].Compare(a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10].Compare(a[12]) < 0 {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7].Compare(a[9]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Compare(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9].Compare(a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Compare(a[12]) < 0 {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort2xDuration(a []time.Duration) {
+	_ = a[1]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort2xDurationReverse(a []time.Duration) {
+	_ = a[1]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3xDuration(a []time.Duration) {
+	_ = a[2]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3xDurationReverse(a []time.Duration) {
+	_ = a[2]
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort4xDuration(a []time.Duration) {
+	_ = a[3]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort4xDurationReverse(a []time.Duration) {
+	_ = a[3]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5xDuration(a []time.Duration) {
+	_ = a[4]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5xDurationReverse(a []time.Duration) {
+	_ = a[4]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] < a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort6xDuration(a []time.Duration) {
+	_ = a[5]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort6xDurationReverse(a []time.Duration) {
+	_ = a[5]
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] < a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] < a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7xDuration(a []time.Duration) {
+	_ = a[6]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7xDurationReverse(a []time.Duration) {
+	_ = a[6]
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] < a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2] < a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort8xDuration(a []time.Duration) {
+	_ = a[7]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort8xDurationReverse(a []time.Duration) {
+	_ = a[7]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] < a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort9xDuration(a []time.Duration) {
+	_ = a[8]
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[0] > a[5] {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[0] > a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2] > a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort9xDurationReverse(a []time.Duration) {
+	_ = a[8]
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[0] < a[5] {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[0] < a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] < a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2] < a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort10xDuration(a []time.Duration) {
+	_ = a[9]
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] > a[9] {
+		a[0], a[9] = a[9], a[0]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[0] > a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4] > a[9] {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] > a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[1] > a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort10xDurationReverse(a []time.Duration) {
+	_ = a[9]
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] < a[9] {
+		a[0], a[9] = a[9], a[0]
+	}
+	if a[2] < a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[0] < a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3] < a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4] < a[9] {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6] < a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] < a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[1] < a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] < a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort11xDuration(a []time.Duration) {
+	_ = a[10]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort11xDurationReverse(a []time.Duration) {
+	_ = a[10]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] < a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5] < a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] < a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] < a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3] < a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort12xDuration(a []time.Duration) {
+	_ = a[11]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort12xDurationReverse(a []time.Duration) {
+	_ = a[11]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] < a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] < a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] < a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5] < a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] < a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] < a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] < a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] < a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] < a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3] < a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort16xDuration(a []time.Duration) {
+	_ = a[15]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[0] > a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] > a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2] > a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7] > a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[5] > a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3] > a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[11] > a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7] > a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort16xDurationReverse(a []time.Duration) {
+	_ = a[15]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] < a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] < a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14] < a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] < a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[12] < a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] < a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[13] < a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8] < a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9] < a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[10] < a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[11] < a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[0] < a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] < a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2] < a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] < a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4] < a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] < a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] < a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7] < a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[5] < a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6] < a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3] < a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[13] < a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[7] < a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] < a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] < a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2] < a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[11] < a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] < a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3] < a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7] < a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6] < a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] < a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7] < a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] < a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] < a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] < a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] < a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort2xTime(a []time.Time) {
+	_ = a[1]
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort2xTimeReverse(a []time.Time) {
+	_ = a[1]
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3xTime(a []time.Time) {
+	_ = a[2]
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3xTimeReverse(a []time.Time) {
+	_ = a[2]
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort4xTime(a []time.Time) {
+	_ = a[3]
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort4xTimeReverse(a []time.Time) {
+	_ = a[3]
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5xTime(a []time.Time) {
+	_ = a[4]
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[4].Compare(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[3].Compare(a[0]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Compare(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5xTimeReverse(a []time.Time) {
+	_ = a[4]
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[2].Compare(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0].Compare(a[3]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Compare(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort6xTime(a []time.Time) {
+	_ = a[5]
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3].Compare(a[0]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[5].Compare(a[2]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[4].Compare(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort6xTimeReverse(a []time.Time) {
+	_ = a[5]
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0].Compare(a[3]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2].Compare(a[5]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Compare(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7xTime(a []time.Time) {
+	_ = a[6]
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Compare(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3].Compare(a[0]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Compare(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[5].Compare(a[2]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[4].Compare(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7xTimeReverse(a []time.Time) {
+	_ = a[6]
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Compare(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0].Compare(a[3]) < 0 {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2].Compare(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2].Compare(a[5]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Compare(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort8xTime(a []time.Time) {
+	_ = a[7]
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[6].Compare(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[7].Compare(a[5]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[4].Compare(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[6].Compare(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[7].Compare(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[6].Compare(a[3]) < 0 {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4].Compare(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort8xTimeReverse(a []time.Time) {
+	_ = a[7]
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4].Compare(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5].Compare(a[7]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1].Compare(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2].Compare(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3].Compare(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3].Compare(a[6]) < 0 {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2].Compare(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort9xTime(a []time.Time) {
+	_ = a[8]
+	if a[6].Compare(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[5].Compare(a[0]) < 0 {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[4].Compare(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[8].Compare(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[7].Compare(a[0]) < 0 {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Compare(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Compare(a[5]) < 0 {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[8].Compare(a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Compare(a[2]) < 0 {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[7].Compare(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[4].Compare(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort9xTimeReverse(a []time.Time) {
+	_ = a[8]
+	if a[2].Compare(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[0].Compare(a[5]) < 0 {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[1].Compare(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Compare(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[0].Compare(a[7]) < 0 {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Compare(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5].Compare(a[8]) < 0 {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6].Compare(a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2].Compare(a[7]) < 0 {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[3].Compare(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2].Compare(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+func NetworkSort10xTime(a []time.Time) {
+	_ = a[9]
+	if a[4].Compare(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[8].Compare(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Compare(a[0]) < 0 {
+		a[0], a[9] = a[9], a[0]
+	}
+	if a[5].Compare(a[2]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[7].Compare(a[0]) < 0 {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[6].Compare(a[3]) < 0 {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[9].Compare(a[4]) < 0 {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[9].Compare(a[6]) < 0 {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7].Compare(a[4]) < 0 {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[8].Compare(a[1]) < 0 {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[8].Compare(a[5]) < 0 {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[8].Compare(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Compare(a[2]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[6].Compare(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort10xTimeReverse(a []time.Time) {
+	_ = a[9]
+	if a[1].Compare(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Compare(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0].Compare(a[9]) < 0 {
+		a[0], a[9] = a[9], a[0]
+	}
+	if a[2].Compare(a[5]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[0].Compare(a[7]) < 0 {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3].Compare(a[6]) < 0 {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4].Compare(a[9]) < 0 {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Compare(a[9]) < 0 {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4].Compare(a[7]) < 0 {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[1].Compare(a[8]) < 0 {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Compare(a[8]) < 0 {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[7].Compare(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2].Compare(a[5]) < 0 {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[4].Compare(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+func NetworkSort11xTime(a []time.Time) {
+	_ = a[10]
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Compare(a[5]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Compare(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Compare(a[8]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Compare(a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Compare(a[6]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[9].Compare(a[5]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[6].Compare(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Compare(a[6]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7].Compare(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[8].Compare(a[4]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[4].Compare(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[10].Compare(a[7]) < 0 {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[8].Compare(a[3]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[4].Compare(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[9].Compare(a[7]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[8].Compare(a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Compare(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort11xTimeReverse(a []time.Time) {
+	_ = a[10]
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Compare(a[7]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Compare(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Compare(a[10]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Compare(a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Compare(a[10]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5].Compare(a[9]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2].Compare(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Compare(a[10]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3].Compare(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4].Compare(a[8]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1].Compare(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Compare(a[10]) < 0 {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3].Compare(a[8]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2].Compare(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7].Compare(a[9]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Compare(a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Compare(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort12xTime(a []time.Time) {
+	_ = a[11]
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Compare(a[10]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Compare(a[5]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[11].Compare(a[9]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Compare(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Compare(a[8]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Compare(a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Compare(a[6]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[9].Compare(a[5]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[6].Compare(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[10].Compare(a[6]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[11].Compare(a[7]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[7].Compare(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[8].Compare(a[4]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[11].Compare(a[7]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[4].Compare(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[10].Compare(a[7]) < 0 {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[8].Compare(a[3]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[4].Compare(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[9].Compare(a[7]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[8].Compare(a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Compare(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort12xTimeReverse(a []time.Time) {
+	_ = a[11]
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Compare(a[11]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Compare(a[7]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9].Compare(a[11]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Compare(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Compare(a[10]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Compare(a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Compare(a[10]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5].Compare(a[9]) < 0 {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2].Compare(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6].Compare(a[10]) < 0 {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7].Compare(a[11]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[3].Compare(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4].Compare(a[8]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7].Compare(a[11]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1].Compare(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Compare(a[10]) < 0 {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3].Compare(a[8]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2].Compare(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7].Compare(a[9]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6].Compare(a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Compare(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+func NetworkSort16xTime(a []time.Time) {
+	_ = a[15]
+	if a[1].Compare(a[0]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3].Compare(a[2]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5].Compare(a[4]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11].Compare(a[10]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[13].Compare(a[12]) < 0 {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[15].Compare(a[14]) < 0 {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[2].Compare(a[0]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6].Compare(a[4]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10].Compare(a[8]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[14].Compare(a[12]) < 0 {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[3].Compare(a[1]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[7].Compare(a[5]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[11].Compare(a[9]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[15].Compare(a[13]) < 0 {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[4].Compare(a[0]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[12].Compare(a[8]) < 0 {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[5].Compare(a[1]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[13].Compare(a[9]) < 0 {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[6].Compare(a[2]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[14].Compare(a[10]) < 0 {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[7].Compare(a[3]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[15].Compare(a[11]) < 0 {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[8].Compare(a[0]) < 0 {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[9].Compare(a[1]) < 0 {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[10].Compare(a[2]) < 0 {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[11].Compare(a[3]) < 0 {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[12].Compare(a[4]) < 0 {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[13].Compare(a[5]) < 0 {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[14].Compare(a[6]) < 0 {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[15].Compare(a[7]) < 0 {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[10].Compare(a[5]) < 0 {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[9].Compare(a[6]) < 0 {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[12].Compare(a[3]) < 0 {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[14].Compare(a[13]) < 0 {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[11].Compare(a[7]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[2].Compare(a[1]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[8].Compare(a[4]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4].Compare(a[1]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[13].Compare(a[7]) < 0 {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[8].Compare(a[2]) < 0 {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[14].Compare(a[11]) < 0 {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[4].Compare(a[2]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10].Compare(a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[13].Compare(a[11]) < 0 {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[8].Compare(a[3]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[12].Compare(a[7]) < 0 {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[8].Compare(a[6]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[12].Compare(a[10]) < 0 {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[5].Compare(a[3]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[9].Compare(a[7]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[4].Compare(a[3]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6].Compare(a[5]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8].Compare(a[7]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[10].Compare(a[9]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12].Compare(a[11]) < 0 {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[7].Compare(a[6]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9].Compare(a[8]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+func NetworkSort16xTimeReverse(a []time.Time) {
+	_ = a[15]
+	if a[0].Compare(a[1]) < 0 {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2].Compare(a[3]) < 0 {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4].Compare(a[5]) < 0 {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10].Compare(a[11]) < 0 {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12].Compare(a[13]) < 0 {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14].Compare(a[15]) < 0 {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[0].Compare(a[2]) < 0 {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4].Compare(a[6]) < 0 {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8].Compare(a[10]) < 0 {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[12].Compare(a[14]) < 0 {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[1].Compare(a[3]) < 0 {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5].Compare(a[7]) < 0 {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9].Compare(a[11]) < 0 {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[13].Compare(a[15]) < 0 {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[0].Compare(a[4]) < 0 {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8].Compare(a[12]) < 0 {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1].Compare(a[5]) < 0 {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9].Compare(a[13]) < 0 {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2].Compare(a[6]) < 0 {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[10].Compare(a[14]) < 0 {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[3].Compare(a[7]) < 0 {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[11].Compare(a[15]) < 0 {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[0].Compare(a[8]) < 0 {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1].Compare(a[9]) < 0 {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2].Compare(a[10]) < 0 {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3].Compare(a[11]) < 0 {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4].Compare(a[12]) < 0 {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5].Compare(a[13]) < 0 {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6].Compare(a[14]) < 0 {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7].Compare(a[15]) < 0 {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[5].Compare(a[10]) < 0 {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6].Compare(a[9]) < 0 {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3].Compare(a[12]) < 0 {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[13].Compare(a[14]) < 0 {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[7].Compare(a[11]) < 0 {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1].Compare(a[2]) < 0 {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4].Compare(a[8]) < 0 {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1].Compare(a[4]) < 0 {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7].Compare(a[13]) < 0 {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2].Compare(a[8]) < 0 {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[11].Compare(a[14]) < 0 {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[2].Compare(a[4]) < 0 {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9].Compare(a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Compare(a[13]) < 0 {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3].Compare(a[8]) < 0 {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7].Compare(a[12]) < 0 {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6].Compare(a[8]) < 0 {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10].Compare(a[12]) < 0 {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3].Compare(a[5]) < 0 {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7].Compare(a[9]) < 0 {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3].Compare(a[4]) < 0 {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5].Compare(a[6]) < 0 {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7].Compare(a[8]) < 0 {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9].Compare(a[10]) < 0 {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11].Compare(a[12]) < 0 {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6].Compare(a[7]) < 0 {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8].Compare(a[9]) < 0 {
+		a[8], a[9] = a[9], a[8]
+	}
+}
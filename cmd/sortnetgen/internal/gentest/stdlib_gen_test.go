@@ -0,0 +1,1489 @@
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
+
+package gentest
+
+import (
+	"bytes"
+	"math/big"
+	"math/rand"
+	"net/netip"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/shabbyrobe/sortnet/sortnettest"
+)
+
+// testStdlibGenCase is a function generated by sortnetgen, adapted to be checked by
+// sortnettest. Either sort or merge is set.
+type testStdlibGenCase[T any] struct {
+	name  string
+	size  int
+	sort  func(a []T)
+	merge func(dst []T, runs [][]T) []T
+}
+
+// testStdlibGenCasesNetworkSortTime returns the functions generated for time.Time
+// in increasing order, along with how to make random items and how they are ordered.
+func testStdlibGenCasesNetworkSortTime() (gen func(*rand.Rand) time.Time, before func(a, b time.Time) bool, cases []testStdlibGenCase[time.Time]) {
+	gen = func(rng *rand.Rand) time.Time { return time.Unix(rng.Int63n(32), 0) }
+	less := func(a, b time.Time) bool { return a.Compare(b) < 0 }
+	before = less
+	cases = []testStdlibGenCase[time.Time]{
+		{name: "NetworkSort2xTime", size: 2, sort: func(a []time.Time) { NetworkSort2xTime(a) }},
+		{name: "NetworkSort3xTime", size: 3, sort: func(a []time.Time) { NetworkSort3xTime(a) }},
+		{name: "NetworkSort4xTime", size: 4, sort: func(a []time.Time) { NetworkSort4xTime(a) }},
+		{name: "NetworkSort5xTime", size: 5, sort: func(a []time.Time) { NetworkSort5xTime(a) }},
+		{name: "NetworkSort6xTime", size: 6, sort: func(a []time.Time) { NetworkSort6xTime(a) }},
+		{name: "NetworkSort7xTime", size: 7, sort: func(a []time.Time) { NetworkSort7xTime(a) }},
+		{name: "NetworkSort8xTime", size: 8, sort: func(a []time.Time) { NetworkSort8xTime(a) }},
+		{name: "NetworkSort9xTime", size: 9, sort: func(a []time.Time) { NetworkSort9xTime(a) }},
+		{name: "NetworkSort10xTime", size: 10, sort: func(a []time.Time) { NetworkSort10xTime(a) }},
+		{name: "NetworkSort11xTime", size: 11, sort: func(a []time.Time) { NetworkSort11xTime(a) }},
+		{name: "NetworkSort12xTime", size: 12, sort: func(a []time.Time) { NetworkSort12xTime(a) }},
+		{name: "NetworkSort16xTime", size: 16, sort: func(a []time.Time) { NetworkSort16xTime(a) }},
+		{name: "NetworkSortTime-2", size: 2, sort: func(a []time.Time) {
+			if !NetworkSortTime(a, len(a)) {
+				panic("NetworkSortTime: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortTime-3", size: 3, sort: func(a []time.Time) {
+			if !NetworkSortTime(a, len(a)) {
+				panic("NetworkSortTime: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortTime-4", size: 4, sort: func(a []time.Time) {
+			if !NetworkSortTime(a, len(a)) {
+				panic("NetworkSortTime: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortTime-5", size: 5, sort: func(a []time.Time) {
+			if !NetworkSortTime(a, len(a)) {
+				panic("NetworkSortTime: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortTime-6", size: 6, sort: func(a []time.Time) {
+			if !NetworkSortTime(a, len(a)) {
+				panic("NetworkSortTime: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortTime-7", size: 7, sort: func(a []time.Time) {
+			if !NetworkSortTime(a, len(a)) {
+				panic("NetworkSortTime: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortTime-8", size: 8, sort: func(a []time.Time) {
+			if !NetworkSortTime(a, len(a)) {
+				panic("NetworkSortTime: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortTime-9", size: 9, sort: func(a []time.Time) {
+			if !NetworkSortTime(a, len(a)) {
+				panic("NetworkSortTime: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortTime-10", size: 10, sort: func(a []time.Time) {
+			if !NetworkSortTime(a, len(a)) {
+				panic("NetworkSortTime: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortTime-11", size: 11, sort: func(a []time.Time) {
+			if !NetworkSortTime(a, len(a)) {
+				panic("NetworkSortTime: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortTime-12", size: 12, sort: func(a []time.Time) {
+			if !NetworkSortTime(a, len(a)) {
+				panic("NetworkSortTime: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortTime-16", size: 16, sort: func(a []time.Time) {
+			if !NetworkSortTime(a, len(a)) {
+				panic("NetworkSortTime: no sorter for size 16")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestStdlibGenNetworkSortTime(t *testing.T) {
+	gen, before, cases := testStdlibGenCasesNetworkSortTime()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzStdlibGenNetworkSortTime(f *testing.F) {
+	gen, before, cases := testStdlibGenCasesNetworkSortTime()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]time.Time, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkStdlibGenNetworkSortTime(b *testing.B) {
+	gen, before, cases := testStdlibGenCasesNetworkSortTime()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testStdlibGenCasesNetworkSortTimeReverse returns the functions generated for time.Time
+// in decreasing order, along with how to make random items and how they are ordered.
+func testStdlibGenCasesNetworkSortTimeReverse() (gen func(*rand.Rand) time.Time, before func(a, b time.Time) bool, cases []testStdlibGenCase[time.Time]) {
+	gen = func(rng *rand.Rand) time.Time { return time.Unix(rng.Int63n(32), 0) }
+	less := func(a, b time.Time) bool { return a.Compare(b) < 0 }
+	before = func(a, b time.Time) bool { return less(b, a) }
+	cases = []testStdlibGenCase[time.Time]{
+		{name: "NetworkSort2xTimeReverse", size: 2, sort: func(a []time.Time) { NetworkSort2xTimeReverse(a) }},
+		{name: "NetworkSort3xTimeReverse", size: 3, sort: func(a []time.Time) { NetworkSort3xTimeReverse(a) }},
+		{name: "NetworkSort4xTimeReverse", size: 4, sort: func(a []time.Time) { NetworkSort4xTimeReverse(a) }},
+		{name: "NetworkSort5xTimeReverse", size: 5, sort: func(a []time.Time) { NetworkSort5xTimeReverse(a) }},
+		{name: "NetworkSort6xTimeReverse", size: 6, sort: func(a []time.Time) { NetworkSort6xTimeReverse(a) }},
+		{name: "NetworkSort7xTimeReverse", size: 7, sort: func(a []time.Time) { NetworkSort7xTimeReverse(a) }},
+		{name: "NetworkSort8xTimeReverse", size: 8, sort: func(a []time.Time) { NetworkSort8xTimeReverse(a) }},
+		{name: "NetworkSort9xTimeReverse", size: 9, sort: func(a []time.Time) { NetworkSort9xTimeReverse(a) }},
+		{name: "NetworkSort10xTimeReverse", size: 10, sort: func(a []time.Time) { NetworkSort10xTimeReverse(a) }},
+		{name: "NetworkSort11xTimeReverse", size: 11, sort: func(a []time.Time) { NetworkSort11xTimeReverse(a) }},
+		{name: "NetworkSort12xTimeReverse", size: 12, sort: func(a []time.Time) { NetworkSort12xTimeReverse(a) }},
+		{name: "NetworkSort16xTimeReverse", size: 16, sort: func(a []time.Time) { NetworkSort16xTimeReverse(a) }},
+		{name: "NetworkSortTimeReverse-2", size: 2, sort: func(a []time.Time) {
+			if !NetworkSortTimeReverse(a, len(a)) {
+				panic("NetworkSortTimeReverse: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortTimeReverse-3", size: 3, sort: func(a []time.Time) {
+			if !NetworkSortTimeReverse(a, len(a)) {
+				panic("NetworkSortTimeReverse: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortTimeReverse-4", size: 4, sort: func(a []time.Time) {
+			if !NetworkSortTimeReverse(a, len(a)) {
+				panic("NetworkSortTimeReverse: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortTimeReverse-5", size: 5, sort: func(a []time.Time) {
+			if !NetworkSortTimeReverse(a, len(a)) {
+				panic("NetworkSortTimeReverse: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortTimeReverse-6", size: 6, sort: func(a []time.Time) {
+			if !NetworkSortTimeReverse(a, len(a)) {
+				panic("NetworkSortTimeReverse: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortTimeReverse-7", size: 7, sort: func(a []time.Time) {
+			if !NetworkSortTimeReverse(a, len(a)) {
+				panic("NetworkSortTimeReverse: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortTimeReverse-8", size: 8, sort: func(a []time.Time) {
+			if !NetworkSortTimeReverse(a, len(a)) {
+				panic("NetworkSortTimeReverse: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortTimeReverse-9", size: 9, sort: func(a []time.Time) {
+			if !NetworkSortTimeReverse(a, len(a)) {
+				panic("NetworkSortTimeReverse: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortTimeReverse-10", size: 10, sort: func(a []time.Time) {
+			if !NetworkSortTimeReverse(a, len(a)) {
+				panic("NetworkSortTimeReverse: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortTimeReverse-11", size: 11, sort: func(a []time.Time) {
+			if !NetworkSortTimeReverse(a, len(a)) {
+				panic("NetworkSortTimeReverse: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortTimeReverse-12", size: 12, sort: func(a []time.Time) {
+			if !NetworkSortTimeReverse(a, len(a)) {
+				panic("NetworkSortTimeReverse: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortTimeReverse-16", size: 16, sort: func(a []time.Time) {
+			if !NetworkSortTimeReverse(a, len(a)) {
+				panic("NetworkSortTimeReverse: no sorter for size 16")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestStdlibGenNetworkSortTimeReverse(t *testing.T) {
+	gen, before, cases := testStdlibGenCasesNetworkSortTimeReverse()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzStdlibGenNetworkSortTimeReverse(f *testing.F) {
+	gen, before, cases := testStdlibGenCasesNetworkSortTimeReverse()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]time.Time, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkStdlibGenNetworkSortTimeReverse(b *testing.B) {
+	gen, before, cases := testStdlibGenCasesNetworkSortTimeReverse()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testStdlibGenCasesNetworkSortDuration returns the functions generated for time.Duration
+// in increasing order, along with how to make random items and how they are ordered.
+func testStdlibGenCasesNetworkSortDuration() (gen func(*rand.Rand) time.Duration, before func(a, b time.Duration) bool, cases []testStdlibGenCase[time.Duration]) {
+	gen = func(rng *rand.Rand) time.Duration { return time.Duration(rng.Intn(32) - 16) }
+	less := func(a, b time.Duration) bool { return a < b }
+	before = less
+	cases = []testStdlibGenCase[time.Duration]{
+		{name: "NetworkSort2xDuration", size: 2, sort: func(a []time.Duration) { NetworkSort2xDuration(a) }},
+		{name: "NetworkSort3xDuration", size: 3, sort: func(a []time.Duration) { NetworkSort3xDuration(a) }},
+		{name: "NetworkSort4xDuration", size: 4, sort: func(a []time.Duration) { NetworkSort4xDuration(a) }},
+		{name: "NetworkSort5xDuration", size: 5, sort: func(a []time.Duration) { NetworkSort5xDuration(a) }},
+		{name: "NetworkSort6xDuration", size: 6, sort: func(a []time.Duration) { NetworkSort6xDuration(a) }},
+		{name: "NetworkSort7xDuration", size: 7, sort: func(a []time.Duration) { NetworkSort7xDuration(a) }},
+		{name: "NetworkSort8xDuration", size: 8, sort: func(a []time.Duration) { NetworkSort8xDuration(a) }},
+		{name: "NetworkSort9xDuration", size: 9, sort: func(a []time.Duration) { NetworkSort9xDuration(a) }},
+		{name: "NetworkSort10xDuration", size: 10, sort: func(a []time.Duration) { NetworkSort10xDuration(a) }},
+		{name: "NetworkSort11xDuration", size: 11, sort: func(a []time.Duration) { NetworkSort11xDuration(a) }},
+		{name: "NetworkSort12xDuration", size: 12, sort: func(a []time.Duration) { NetworkSort12xDuration(a) }},
+		{name: "NetworkSort16xDuration", size: 16, sort: func(a []time.Duration) { NetworkSort16xDuration(a) }},
+		{name: "NetworkSortDuration-2", size: 2, sort: func(a []time.Duration) {
+			if !NetworkSortDuration(a, len(a)) {
+				panic("NetworkSortDuration: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortDuration-3", size: 3, sort: func(a []time.Duration) {
+			if !NetworkSortDuration(a, len(a)) {
+				panic("NetworkSortDuration: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortDuration-4", size: 4, sort: func(a []time.Duration) {
+			if !NetworkSortDuration(a, len(a)) {
+				panic("NetworkSortDuration: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortDuration-5", size: 5, sort: func(a []time.Duration) {
+			if !NetworkSortDuration(a, len(a)) {
+				panic("NetworkSortDuration: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortDuration-6", size: 6, sort: func(a []time.Duration) {
+			if !NetworkSortDuration(a, len(a)) {
+				panic("NetworkSortDuration: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortDuration-7", size: 7, sort: func(a []time.Duration) {
+			if !NetworkSortDuration(a, len(a)) {
+				panic("NetworkSortDuration: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortDuration-8", size: 8, sort: func(a []time.Duration) {
+			if !NetworkSortDuration(a, len(a)) {
+				panic("NetworkSortDuration: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortDuration-9", size: 9, sort: func(a []time.Duration) {
+			if !NetworkSortDuration(a, len(a)) {
+				panic("NetworkSortDuration: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortDuration-10", size: 10, sort: func(a []time.Duration) {
+			if !NetworkSortDuration(a, len(a)) {
+				panic("NetworkSortDuration: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortDuration-11", size: 11, sort: func(a []time.Duration) {
+			if !NetworkSortDuration(a, len(a)) {
+				panic("NetworkSortDuration: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortDuration-12", size: 12, sort: func(a []time.Duration) {
+			if !NetworkSortDuration(a, len(a)) {
+				panic("NetworkSortDuration: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortDuration-16", size: 16, sort: func(a []time.Duration) {
+			if !NetworkSortDuration(a, len(a)) {
+				panic("NetworkSortDuration: no sorter for size 16")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestStdlibGenNetworkSortDuration(t *testing.T) {
+	gen, before, cases := testStdlibGenCasesNetworkSortDuration()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzStdlibGenNetworkSortDuration(f *testing.F) {
+	gen, before, cases := testStdlibGenCasesNetworkSortDuration()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]time.Duration, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkStdlibGenNetworkSortDuration(b *testing.B) {
+	gen, before, cases := testStdlibGenCasesNetworkSortDuration()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testStdlibGenCasesNetworkSortDurationReverse returns the functions generated for time.Duration
+// in decreasing order, along with how to make random items and how they are ordered.
+func testStdlibGenCasesNetworkSortDurationReverse() (gen func(*rand.Rand) time.Duration, before func(a, b time.Duration) bool, cases []testStdlibGenCase[time.Duration]) {
+	gen = func(rng *rand.Rand) time.Duration { return time.Duration(rng.Intn(32) - 16) }
+	less := func(a, b time.Duration) bool { return a < b }
+	before = func(a, b time.Duration) bool { return less(b, a) }
+	cases = []testStdlibGenCase[time.Duration]{
+		{name: "NetworkSort2xDurationReverse", size: 2, sort: func(a []time.Duration) { NetworkSort2xDurationReverse(a) }},
+		{name: "NetworkSort3xDurationReverse", size: 3, sort: func(a []time.Duration) { NetworkSort3xDurationReverse(a) }},
+		{name: "NetworkSort4xDurationReverse", size: 4, sort: func(a []time.Duration) { NetworkSort4xDurationReverse(a) }},
+		{name: "NetworkSort5xDurationReverse", size: 5, sort: func(a []time.Duration) { NetworkSort5xDurationReverse(a) }},
+		{name: "NetworkSort6xDurationReverse", size: 6, sort: func(a []time.Duration) { NetworkSort6xDurationReverse(a) }},
+		{name: "NetworkSort7xDurationReverse", size: 7, sort: func(a []time.Duration) { NetworkSort7xDurationReverse(a) }},
+		{name: "NetworkSort8xDurationReverse", size: 8, sort: func(a []time.Duration) { NetworkSort8xDurationReverse(a) }},
+		{name: "NetworkSort9xDurationReverse", size: 9, sort: func(a []time.Duration) { NetworkSort9xDurationReverse(a) }},
+		{name: "NetworkSort10xDurationReverse", size: 10, sort: func(a []time.Duration) { NetworkSort10xDurationReverse(a) }},
+		{name: "NetworkSort11xDurationReverse", size: 11, sort: func(a []time.Duration) { NetworkSort11xDurationReverse(a) }},
+		{name: "NetworkSort12xDurationReverse", size: 12, sort: func(a []time.Duration) { NetworkSort12xDurationReverse(a) }},
+		{name: "NetworkSort16xDurationReverse", size: 16, sort: func(a []time.Duration) { NetworkSort16xDurationReverse(a) }},
+		{name: "NetworkSortDurationReverse-2", size: 2, sort: func(a []time.Duration) {
+			if !NetworkSortDurationReverse(a, len(a)) {
+				panic("NetworkSortDurationReverse: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortDurationReverse-3", size: 3, sort: func(a []time.Duration) {
+			if !NetworkSortDurationReverse(a, len(a)) {
+				panic("NetworkSortDurationReverse: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortDurationReverse-4", size: 4, sort: func(a []time.Duration) {
+			if !NetworkSortDurationReverse(a, len(a)) {
+				panic("NetworkSortDurationReverse: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortDurationReverse-5", size: 5, sort: func(a []time.Duration) {
+			if !NetworkSortDurationReverse(a, len(a)) {
+				panic("NetworkSortDurationReverse: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortDurationReverse-6", size: 6, sort: func(a []time.Duration) {
+			if !NetworkSortDurationReverse(a, len(a)) {
+				panic("NetworkSortDurationReverse: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortDurationReverse-7", size: 7, sort: func(a []time.Duration) {
+			if !NetworkSortDurationReverse(a, len(a)) {
+				panic("NetworkSortDurationReverse: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortDurationReverse-8", size: 8, sort: func(a []time.Duration) {
+			if !NetworkSortDurationReverse(a, len(a)) {
+				panic("NetworkSortDurationReverse: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortDurationReverse-9", size: 9, sort: func(a []time.Duration) {
+			if !NetworkSortDurationReverse(a, len(a)) {
+				panic("NetworkSortDurationReverse: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortDurationReverse-10", size: 10, sort: func(a []time.Duration) {
+			if !NetworkSortDurationReverse(a, len(a)) {
+				panic("NetworkSortDurationReverse: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortDurationReverse-11", size: 11, sort: func(a []time.Duration) {
+			if !NetworkSortDurationReverse(a, len(a)) {
+				panic("NetworkSortDurationReverse: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortDurationReverse-12", size: 12, sort: func(a []time.Duration) {
+			if !NetworkSortDurationReverse(a, len(a)) {
+				panic("NetworkSortDurationReverse: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortDurationReverse-16", size: 16, sort: func(a []time.Duration) {
+			if !NetworkSortDurationReverse(a, len(a)) {
+				panic("NetworkSortDurationReverse: no sorter for size 16")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestStdlibGenNetworkSortDurationReverse(t *testing.T) {
+	gen, before, cases := testStdlibGenCasesNetworkSortDurationReverse()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzStdlibGenNetworkSortDurationReverse(f *testing.F) {
+	gen, before, cases := testStdlibGenCasesNetworkSortDurationReverse()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]time.Duration, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkStdlibGenNetworkSortDurationReverse(b *testing.B) {
+	gen, before, cases := testStdlibGenCasesNetworkSortDurationReverse()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testStdlibGenCasesNetworkSortAddr returns the functions generated for netip.Addr
+// in increasing order, along with how to make random items and how they are ordered.
+func testStdlibGenCasesNetworkSortAddr() (gen func(*rand.Rand) netip.Addr, before func(a, b netip.Addr) bool, cases []testStdlibGenCase[netip.Addr]) {
+	gen = func(rng *rand.Rand) netip.Addr { return netip.AddrFrom4([4]byte{10, 0, 0, byte(rng.Intn(32))}) }
+	less := func(a, b netip.Addr) bool { return a.Compare(b) < 0 }
+	before = less
+	cases = []testStdlibGenCase[netip.Addr]{
+		{name: "NetworkSort2xAddr", size: 2, sort: func(a []netip.Addr) { NetworkSort2xAddr(a) }},
+		{name: "NetworkSort3xAddr", size: 3, sort: func(a []netip.Addr) { NetworkSort3xAddr(a) }},
+		{name: "NetworkSort4xAddr", size: 4, sort: func(a []netip.Addr) { NetworkSort4xAddr(a) }},
+		{name: "NetworkSort5xAddr", size: 5, sort: func(a []netip.Addr) { NetworkSort5xAddr(a) }},
+		{name: "NetworkSort6xAddr", size: 6, sort: func(a []netip.Addr) { NetworkSort6xAddr(a) }},
+		{name: "NetworkSort7xAddr", size: 7, sort: func(a []netip.Addr) { NetworkSort7xAddr(a) }},
+		{name: "NetworkSort8xAddr", size: 8, sort: func(a []netip.Addr) { NetworkSort8xAddr(a) }},
+		{name: "NetworkSort9xAddr", size: 9, sort: func(a []netip.Addr) { NetworkSort9xAddr(a) }},
+		{name: "NetworkSort10xAddr", size: 10, sort: func(a []netip.Addr) { NetworkSort10xAddr(a) }},
+		{name: "NetworkSort11xAddr", size: 11, sort: func(a []netip.Addr) { NetworkSort11xAddr(a) }},
+		{name: "NetworkSort12xAddr", size: 12, sort: func(a []netip.Addr) { NetworkSort12xAddr(a) }},
+		{name: "NetworkSort16xAddr", size: 16, sort: func(a []netip.Addr) { NetworkSort16xAddr(a) }},
+		{name: "NetworkSortAddr-2", size: 2, sort: func(a []netip.Addr) {
+			if !NetworkSortAddr(a, len(a)) {
+				panic("NetworkSortAddr: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortAddr-3", size: 3, sort: func(a []netip.Addr) {
+			if !NetworkSortAddr(a, len(a)) {
+				panic("NetworkSortAddr: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortAddr-4", size: 4, sort: func(a []netip.Addr) {
+			if !NetworkSortAddr(a, len(a)) {
+				panic("NetworkSortAddr: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortAddr-5", size: 5, sort: func(a []netip.Addr) {
+			if !NetworkSortAddr(a, len(a)) {
+				panic("NetworkSortAddr: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortAddr-6", size: 6, sort: func(a []netip.Addr) {
+			if !NetworkSortAddr(a, len(a)) {
+				panic("NetworkSortAddr: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortAddr-7", size: 7, sort: func(a []netip.Addr) {
+			if !NetworkSortAddr(a, len(a)) {
+				panic("NetworkSortAddr: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortAddr-8", size: 8, sort: func(a []netip.Addr) {
+			if !NetworkSortAddr(a, len(a)) {
+				panic("NetworkSortAddr: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortAddr-9", size: 9, sort: func(a []netip.Addr) {
+			if !NetworkSortAddr(a, len(a)) {
+				panic("NetworkSortAddr: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortAddr-10", size: 10, sort: func(a []netip.Addr) {
+			if !NetworkSortAddr(a, len(a)) {
+				panic("NetworkSortAddr: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortAddr-11", size: 11, sort: func(a []netip.Addr) {
+			if !NetworkSortAddr(a, len(a)) {
+				panic("NetworkSortAddr: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortAddr-12", size: 12, sort: func(a []netip.Addr) {
+			if !NetworkSortAddr(a, len(a)) {
+				panic("NetworkSortAddr: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortAddr-16", size: 16, sort: func(a []netip.Addr) {
+			if !NetworkSortAddr(a, len(a)) {
+				panic("NetworkSortAddr: no sorter for size 16")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestStdlibGenNetworkSortAddr(t *testing.T) {
+	gen, before, cases := testStdlibGenCasesNetworkSortAddr()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzStdlibGenNetworkSortAddr(f *testing.F) {
+	gen, before, cases := testStdlibGenCasesNetworkSortAddr()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]netip.Addr, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkStdlibGenNetworkSortAddr(b *testing.B) {
+	gen, before, cases := testStdlibGenCasesNetworkSortAddr()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testStdlibGenCasesNetworkSortAddrReverse returns the functions generated for netip.Addr
+// in decreasing order, along with how to make random items and how they are ordered.
+func testStdlibGenCasesNetworkSortAddrReverse() (gen func(*rand.Rand) netip.Addr, before func(a, b netip.Addr) bool, cases []testStdlibGenCase[netip.Addr]) {
+	gen = func(rng *rand.Rand) netip.Addr { return netip.AddrFrom4([4]byte{10, 0, 0, byte(rng.Intn(32))}) }
+	less := func(a, b netip.Addr) bool { return a.Compare(b) < 0 }
+	before = func(a, b netip.Addr) bool { return less(b, a) }
+	cases = []testStdlibGenCase[netip.Addr]{
+		{name: "NetworkSort2xAddrReverse", size: 2, sort: func(a []netip.Addr) { NetworkSort2xAddrReverse(a) }},
+		{name: "NetworkSort3xAddrReverse", size: 3, sort: func(a []netip.Addr) { NetworkSort3xAddrReverse(a) }},
+		{name: "NetworkSort4xAddrReverse", size: 4, sort: func(a []netip.Addr) { NetworkSort4xAddrReverse(a) }},
+		{name: "NetworkSort5xAddrReverse", size: 5, sort: func(a []netip.Addr) { NetworkSort5xAddrReverse(a) }},
+		{name: "NetworkSort6xAddrReverse", size: 6, sort: func(a []netip.Addr) { NetworkSort6xAddrReverse(a) }},
+		{name: "NetworkSort7xAddrReverse", size: 7, sort: func(a []netip.Addr) { NetworkSort7xAddrReverse(a) }},
+		{name: "NetworkSort8xAddrReverse", size: 8, sort: func(a []netip.Addr) { NetworkSort8xAddrReverse(a) }},
+		{name: "NetworkSort9xAddrReverse", size: 9, sort: func(a []netip.Addr) { NetworkSort9xAddrReverse(a) }},
+		{name: "NetworkSort10xAddrReverse", size: 10, sort: func(a []netip.Addr) { NetworkSort10xAddrReverse(a) }},
+		{name: "NetworkSort11xAddrReverse", size: 11, sort: func(a []netip.Addr) { NetworkSort11xAddrReverse(a) }},
+		{name: "NetworkSort12xAddrReverse", size: 12, sort: func(a []netip.Addr) { NetworkSort12xAddrReverse(a) }},
+		{name: "NetworkSort16xAddrReverse", size: 16, sort: func(a []netip.Addr) { NetworkSort16xAddrReverse(a) }},
+		{name: "NetworkSortAddrReverse-2", size: 2, sort: func(a []netip.Addr) {
+			if !NetworkSortAddrReverse(a, len(a)) {
+				panic("NetworkSortAddrReverse: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortAddrReverse-3", size: 3, sort: func(a []netip.Addr) {
+			if !NetworkSortAddrReverse(a, len(a)) {
+				panic("NetworkSortAddrReverse: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortAddrReverse-4", size: 4, sort: func(a []netip.Addr) {
+			if !NetworkSortAddrReverse(a, len(a)) {
+				panic("NetworkSortAddrReverse: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortAddrReverse-5", size: 5, sort: func(a []netip.Addr) {
+			if !NetworkSortAddrReverse(a, len(a)) {
+				panic("NetworkSortAddrReverse: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortAddrReverse-6", size: 6, sort: func(a []netip.Addr) {
+			if !NetworkSortAddrReverse(a, len(a)) {
+				panic("NetworkSortAddrReverse: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortAddrReverse-7", size: 7, sort: func(a []netip.Addr) {
+			if !NetworkSortAddrReverse(a, len(a)) {
+				panic("NetworkSortAddrReverse: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortAddrReverse-8", size: 8, sort: func(a []netip.Addr) {
+			if !NetworkSortAddrReverse(a, len(a)) {
+				panic("NetworkSortAddrReverse: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortAddrReverse-9", size: 9, sort: func(a []netip.Addr) {
+			if !NetworkSortAddrReverse(a, len(a)) {
+				panic("NetworkSortAddrReverse: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortAddrReverse-10", size: 10, sort: func(a []netip.Addr) {
+			if !NetworkSortAddrReverse(a, len(a)) {
+				panic("NetworkSortAddrReverse: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortAddrReverse-11", size: 11, sort: func(a []netip.Addr) {
+			if !NetworkSortAddrReverse(a, len(a)) {
+				panic("NetworkSortAddrReverse: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortAddrReverse-12", size: 12, sort: func(a []netip.Addr) {
+			if !NetworkSortAddrReverse(a, len(a)) {
+				panic("NetworkSortAddrReverse: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortAddrReverse-16", size: 16, sort: func(a []netip.Addr) {
+			if !NetworkSortAddrReverse(a, len(a)) {
+				panic("NetworkSortAddrReverse: no sorter for size 16")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestStdlibGenNetworkSortAddrReverse(t *testing.T) {
+	gen, before, cases := testStdlibGenCasesNetworkSortAddrReverse()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzStdlibGenNetworkSortAddrReverse(f *testing.F) {
+	gen, before, cases := testStdlibGenCasesNetworkSortAddrReverse()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]netip.Addr, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkStdlibGenNetworkSortAddrReverse(b *testing.B) {
+	gen, before, cases := testStdlibGenCasesNetworkSortAddrReverse()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testStdlibGenCasesNetworkSortBigInt returns the functions generated for *big.Int
+// in increasing order, along with how to make random items and how they are ordered.
+func testStdlibGenCasesNetworkSortBigInt() (gen func(*rand.Rand) *big.Int, before func(a, b *big.Int) bool, cases []testStdlibGenCase[*big.Int]) {
+	gen = func(rng *rand.Rand) *big.Int { return big.NewInt(rng.Int63n(32) - 16) }
+	less := func(a, b *big.Int) bool { return a.Cmp(b) < 0 }
+	before = less
+	cases = []testStdlibGenCase[*big.Int]{
+		{name: "NetworkSort2xBigInt", size: 2, sort: func(a []*big.Int) { NetworkSort2xBigInt(a) }},
+		{name: "NetworkSort3xBigInt", size: 3, sort: func(a []*big.Int) { NetworkSort3xBigInt(a) }},
+		{name: "NetworkSort4xBigInt", size: 4, sort: func(a []*big.Int) { NetworkSort4xBigInt(a) }},
+		{name: "NetworkSort5xBigInt", size: 5, sort: func(a []*big.Int) { NetworkSort5xBigInt(a) }},
+		{name: "NetworkSort6xBigInt", size: 6, sort: func(a []*big.Int) { NetworkSort6xBigInt(a) }},
+		{name: "NetworkSort7xBigInt", size: 7, sort: func(a []*big.Int) { NetworkSort7xBigInt(a) }},
+		{name: "NetworkSort8xBigInt", size: 8, sort: func(a []*big.Int) { NetworkSort8xBigInt(a) }},
+		{name: "NetworkSort9xBigInt", size: 9, sort: func(a []*big.Int) { NetworkSort9xBigInt(a) }},
+		{name: "NetworkSort10xBigInt", size: 10, sort: func(a []*big.Int) { NetworkSort10xBigInt(a) }},
+		{name: "NetworkSort11xBigInt", size: 11, sort: func(a []*big.Int) { NetworkSort11xBigInt(a) }},
+		{name: "NetworkSort12xBigInt", size: 12, sort: func(a []*big.Int) { NetworkSort12xBigInt(a) }},
+		{name: "NetworkSort16xBigInt", size: 16, sort: func(a []*big.Int) { NetworkSort16xBigInt(a) }},
+		{name: "NetworkSortBigInt-2", size: 2, sort: func(a []*big.Int) {
+			if !NetworkSortBigInt(a, len(a)) {
+				panic("NetworkSortBigInt: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortBigInt-3", size: 3, sort: func(a []*big.Int) {
+			if !NetworkSortBigInt(a, len(a)) {
+				panic("NetworkSortBigInt: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortBigInt-4", size: 4, sort: func(a []*big.Int) {
+			if !NetworkSortBigInt(a, len(a)) {
+				panic("NetworkSortBigInt: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortBigInt-5", size: 5, sort: func(a []*big.Int) {
+			if !NetworkSortBigInt(a, len(a)) {
+				panic("NetworkSortBigInt: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortBigInt-6", size: 6, sort: func(a []*big.Int) {
+			if !NetworkSortBigInt(a, len(a)) {
+				panic("NetworkSortBigInt: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortBigInt-7", size: 7, sort: func(a []*big.Int) {
+			if !NetworkSortBigInt(a, len(a)) {
+				panic("NetworkSortBigInt: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortBigInt-8", size: 8, sort: func(a []*big.Int) {
+			if !NetworkSortBigInt(a, len(a)) {
+				panic("NetworkSortBigInt: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortBigInt-9", size: 9, sort: func(a []*big.Int) {
+			if !NetworkSortBigInt(a, len(a)) {
+				panic("NetworkSortBigInt: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortBigInt-10", size: 10, sort: func(a []*big.Int) {
+			if !NetworkSortBigInt(a, len(a)) {
+				panic("NetworkSortBigInt: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortBigInt-11", size: 11, sort: func(a []*big.Int) {
+			if !NetworkSortBigInt(a, len(a)) {
+				panic("NetworkSortBigInt: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortBigInt-12", size: 12, sort: func(a []*big.Int) {
+			if !NetworkSortBigInt(a, len(a)) {
+				panic("NetworkSortBigInt: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortBigInt-16", size: 16, sort: func(a []*big.Int) {
+			if !NetworkSortBigInt(a, len(a)) {
+				panic("NetworkSortBigInt: no sorter for size 16")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestStdlibGenNetworkSortBigInt(t *testing.T) {
+	gen, before, cases := testStdlibGenCasesNetworkSortBigInt()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzStdlibGenNetworkSortBigInt(f *testing.F) {
+	gen, before, cases := testStdlibGenCasesNetworkSortBigInt()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]*big.Int, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkStdlibGenNetworkSortBigInt(b *testing.B) {
+	gen, before, cases := testStdlibGenCasesNetworkSortBigInt()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testStdlibGenCasesNetworkSortBigIntReverse returns the functions generated for *big.Int
+// in decreasing order, along with how to make random items and how they are ordered.
+func testStdlibGenCasesNetworkSortBigIntReverse() (gen func(*rand.Rand) *big.Int, before func(a, b *big.Int) bool, cases []testStdlibGenCase[*big.Int]) {
+	gen = func(rng *rand.Rand) *big.Int { return big.NewInt(rng.Int63n(32) - 16) }
+	less := func(a, b *big.Int) bool { return a.Cmp(b) < 0 }
+	before = func(a, b *big.Int) bool { return less(b, a) }
+	cases = []testStdlibGenCase[*big.Int]{
+		{name: "NetworkSort2xBigIntReverse", size: 2, sort: func(a []*big.Int) { NetworkSort2xBigIntReverse(a) }},
+		{name: "NetworkSort3xBigIntReverse", size: 3, sort: func(a []*big.Int) { NetworkSort3xBigIntReverse(a) }},
+		{name: "NetworkSort4xBigIntReverse", size: 4, sort: func(a []*big.Int) { NetworkSort4xBigIntReverse(a) }},
+		{name: "NetworkSort5xBigIntReverse", size: 5, sort: func(a []*big.Int) { NetworkSort5xBigIntReverse(a) }},
+		{name: "NetworkSort6xBigIntReverse", size: 6, sort: func(a []*big.Int) { NetworkSort6xBigIntReverse(a) }},
+		{name: "NetworkSort7xBigIntReverse", size: 7, sort: func(a []*big.Int) { NetworkSort7xBigIntReverse(a) }},
+		{name: "NetworkSort8xBigIntReverse", size: 8, sort: func(a []*big.Int) { NetworkSort8xBigIntReverse(a) }},
+		{name: "NetworkSort9xBigIntReverse", size: 9, sort: func(a []*big.Int) { NetworkSort9xBigIntReverse(a) }},
+		{name: "NetworkSort10xBigIntReverse", size: 10, sort: func(a []*big.Int) { NetworkSort10xBigIntReverse(a) }},
+		{name: "NetworkSort11xBigIntReverse", size: 11, sort: func(a []*big.Int) { NetworkSort11xBigIntReverse(a) }},
+		{name: "NetworkSort12xBigIntReverse", size: 12, sort: func(a []*big.Int) { NetworkSort12xBigIntReverse(a) }},
+		{name: "NetworkSort16xBigIntReverse", size: 16, sort: func(a []*big.Int) { NetworkSort16xBigIntReverse(a) }},
+		{name: "NetworkSortBigIntReverse-2", size: 2, sort: func(a []*big.Int) {
+			if !NetworkSortBigIntReverse(a, len(a)) {
+				panic("NetworkSortBigIntReverse: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortBigIntReverse-3", size: 3, sort: func(a []*big.Int) {
+			if !NetworkSortBigIntReverse(a, len(a)) {
+				panic("NetworkSortBigIntReverse: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortBigIntReverse-4", size: 4, sort: func(a []*big.Int) {
+			if !NetworkSortBigIntReverse(a, len(a)) {
+				panic("NetworkSortBigIntReverse: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortBigIntReverse-5", size: 5, sort: func(a []*big.Int) {
+			if !NetworkSortBigIntReverse(a, len(a)) {
+				panic("NetworkSortBigIntReverse: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortBigIntReverse-6", size: 6, sort: func(a []*big.Int) {
+			if !NetworkSortBigIntReverse(a, len(a)) {
+				panic("NetworkSortBigIntReverse: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortBigIntReverse-7", size: 7, sort: func(a []*big.Int) {
+			if !NetworkSortBigIntReverse(a, len(a)) {
+				panic("NetworkSortBigIntReverse: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortBigIntReverse-8", size: 8, sort: func(a []*big.Int) {
+			if !NetworkSortBigIntReverse(a, len(a)) {
+				panic("NetworkSortBigIntReverse: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortBigIntReverse-9", size: 9, sort: func(a []*big.Int) {
+			if !NetworkSortBigIntReverse(a, len(a)) {
+				panic("NetworkSortBigIntReverse: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortBigIntReverse-10", size: 10, sort: func(a []*big.Int) {
+			if !NetworkSortBigIntReverse(a, len(a)) {
+				panic("NetworkSortBigIntReverse: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortBigIntReverse-11", size: 11, sort: func(a []*big.Int) {
+			if !NetworkSortBigIntReverse(a, len(a)) {
+				panic("NetworkSortBigIntReverse: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortBigIntReverse-12", size: 12, sort: func(a []*big.Int) {
+			if !NetworkSortBigIntReverse(a, len(a)) {
+				panic("NetworkSortBigIntReverse: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortBigIntReverse-16", size: 16, sort: func(a []*big.Int) {
+			if !NetworkSortBigIntReverse(a, len(a)) {
+				panic("NetworkSortBigIntReverse: no sorter for size 16")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestStdlibGenNetworkSortBigIntReverse(t *testing.T) {
+	gen, before, cases := testStdlibGenCasesNetworkSortBigIntReverse()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzStdlibGenNetworkSortBigIntReverse(f *testing.F) {
+	gen, before, cases := testStdlibGenCasesNetworkSortBigIntReverse()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]*big.Int, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkStdlibGenNetworkSortBigIntReverse(b *testing.B) {
+	gen, before, cases := testStdlibGenCasesNetworkSortBigIntReverse()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testStdlibGenCasesNetworkSortBigFloat returns the functions generated for *big.Float
+// in increasing order, along with how to make random items and how they are ordered.
+func testStdlibGenCasesNetworkSortBigFloat() (gen func(*rand.Rand) *big.Float, before func(a, b *big.Float) bool, cases []testStdlibGenCase[*big.Float]) {
+	gen = func(rng *rand.Rand) *big.Float { return big.NewFloat(float64(rng.Intn(32)-16) / 4) }
+	less := func(a, b *big.Float) bool { return a.Cmp(b) < 0 }
+	before = less
+	cases = []testStdlibGenCase[*big.Float]{
+		{name: "NetworkSort2xBigFloat", size: 2, sort: func(a []*big.Float) { NetworkSort2xBigFloat(a) }},
+		{name: "NetworkSort3xBigFloat", size: 3, sort: func(a []*big.Float) { NetworkSort3xBigFloat(a) }},
+		{name: "NetworkSort4xBigFloat", size: 4, sort: func(a []*big.Float) { NetworkSort4xBigFloat(a) }},
+		{name: "NetworkSort5xBigFloat", size: 5, sort: func(a []*big.Float) { NetworkSort5xBigFloat(a) }},
+		{name: "NetworkSort6xBigFloat", size: 6, sort: func(a []*big.Float) { NetworkSort6xBigFloat(a) }},
+		{name: "NetworkSort7xBigFloat", size: 7, sort: func(a []*big.Float) { NetworkSort7xBigFloat(a) }},
+		{name: "NetworkSort8xBigFloat", size: 8, sort: func(a []*big.Float) { NetworkSort8xBigFloat(a) }},
+		{name: "NetworkSort9xBigFloat", size: 9, sort: func(a []*big.Float) { NetworkSort9xBigFloat(a) }},
+		{name: "NetworkSort10xBigFloat", size: 10, sort: func(a []*big.Float) { NetworkSort10xBigFloat(a) }},
+		{name: "NetworkSort11xBigFloat", size: 11, sort: func(a []*big.Float) { NetworkSort11xBigFloat(a) }},
+		{name: "NetworkSort12xBigFloat", size: 12, sort: func(a []*big.Float) { NetworkSort12xBigFloat(a) }},
+		{name: "NetworkSort16xBigFloat", size: 16, sort: func(a []*big.Float) { NetworkSort16xBigFloat(a) }},
+		{name: "NetworkSortBigFloat-2", size: 2, sort: func(a []*big.Float) {
+			if !NetworkSortBigFloat(a, len(a)) {
+				panic("NetworkSortBigFloat: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortBigFloat-3", size: 3, sort: func(a []*big.Float) {
+			if !NetworkSortBigFloat(a, len(a)) {
+				panic("NetworkSortBigFloat: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortBigFloat-4", size: 4, sort: func(a []*big.Float) {
+			if !NetworkSortBigFloat(a, len(a)) {
+				panic("NetworkSortBigFloat: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortBigFloat-5", size: 5, sort: func(a []*big.Float) {
+			if !NetworkSortBigFloat(a, len(a)) {
+				panic("NetworkSortBigFloat: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortBigFloat-6", size: 6, sort: func(a []*big.Float) {
+			if !NetworkSortBigFloat(a, len(a)) {
+				panic("NetworkSortBigFloat: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortBigFloat-7", size: 7, sort: func(a []*big.Float) {
+			if !NetworkSortBigFloat(a, len(a)) {
+				panic("NetworkSortBigFloat: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortBigFloat-8", size: 8, sort: func(a []*big.Float) {
+			if !NetworkSortBigFloat(a, len(a)) {
+				panic("NetworkSortBigFloat: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortBigFloat-9", size: 9, sort: func(a []*big.Float) {
+			if !NetworkSortBigFloat(a, len(a)) {
+				panic("NetworkSortBigFloat: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortBigFloat-10", size: 10, sort: func(a []*big.Float) {
+			if !NetworkSortBigFloat(a, len(a)) {
+				panic("NetworkSortBigFloat: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortBigFloat-11", size: 11, sort: func(a []*big.Float) {
+			if !NetworkSortBigFloat(a, len(a)) {
+				panic("NetworkSortBigFloat: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortBigFloat-12", size: 12, sort: func(a []*big.Float) {
+			if !NetworkSortBigFloat(a, len(a)) {
+				panic("NetworkSortBigFloat: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortBigFloat-16", size: 16, sort: func(a []*big.Float) {
+			if !NetworkSortBigFloat(a, len(a)) {
+				panic("NetworkSortBigFloat: no sorter for size 16")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestStdlibGenNetworkSortBigFloat(t *testing.T) {
+	gen, before, cases := testStdlibGenCasesNetworkSortBigFloat()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzStdlibGenNetworkSortBigFloat(f *testing.F) {
+	gen, before, cases := testStdlibGenCasesNetworkSortBigFloat()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]*big.Float, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkStdlibGenNetworkSortBigFloat(b *testing.B) {
+	gen, before, cases := testStdlibGenCasesNetworkSortBigFloat()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testStdlibGenCasesNetworkSortBigFloatReverse returns the functions generated for *big.Float
+// in decreasing order, along with how to make random items and how they are ordered.
+func testStdlibGenCasesNetworkSortBigFloatReverse() (gen func(*rand.Rand) *big.Float, before func(a, b *big.Float) bool, cases []testStdlibGenCase[*big.Float]) {
+	gen = func(rng *rand.Rand) *big.Float { return big.NewFloat(float64(rng.Intn(32)-16) / 4) }
+	less := func(a, b *big.Float) bool { return a.Cmp(b) < 0 }
+	before = func(a, b *big.Float) bool { return less(b, a) }
+	cases = []testStdlibGenCase[*big.Float]{
+		{name: "NetworkSort2xBigFloatReverse", size: 2, sort: func(a []*big.Float) { NetworkSort2xBigFloatReverse(a) }},
+		{name: "NetworkSort3xBigFloatReverse", size: 3, sort: func(a []*big.Float) { NetworkSort3xBigFloatReverse(a) }},
+		{name: "NetworkSort4xBigFloatReverse", size: 4, sort: func(a []*big.Float) { NetworkSort4xBigFloatReverse(a) }},
+		{name: "NetworkSort5xBigFloatReverse", size: 5, sort: func(a []*big.Float) { NetworkSort5xBigFloatReverse(a) }},
+		{name: "NetworkSort6xBigFloatReverse", size: 6, sort: func(a []*big.Float) { NetworkSort6xBigFloatReverse(a) }},
+		{name: "NetworkSort7xBigFloatReverse", size: 7, sort: func(a []*big.Float) { NetworkSort7xBigFloatReverse(a) }},
+		{name: "NetworkSort8xBigFloatReverse", size: 8, sort: func(a []*big.Float) { NetworkSort8xBigFloatReverse(a) }},
+		{name: "NetworkSort9xBigFloatReverse", size: 9, sort: func(a []*big.Float) { NetworkSort9xBigFloatReverse(a) }},
+		{name: "NetworkSort10xBigFloatReverse", size: 10, sort: func(a []*big.Float) { NetworkSort10xBigFloatReverse(a) }},
+		{name: "NetworkSort11xBigFloatReverse", size: 11, sort: func(a []*big.Float) { NetworkSort11xBigFloatReverse(a) }},
+		{name: "NetworkSort12xBigFloatReverse", size: 12, sort: func(a []*big.Float) { NetworkSort12xBigFloatReverse(a) }},
+		{name: "NetworkSort16xBigFloatReverse", size: 16, sort: func(a []*big.Float) { NetworkSort16xBigFloatReverse(a) }},
+		{name: "NetworkSortBigFloatReverse-2", size: 2, sort: func(a []*big.Float) {
+			if !NetworkSortBigFloatReverse(a, len(a)) {
+				panic("NetworkSortBigFloatReverse: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortBigFloatReverse-3", size: 3, sort: func(a []*big.Float) {
+			if !NetworkSortBigFloatReverse(a, len(a)) {
+				panic("NetworkSortBigFloatReverse: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortBigFloatReverse-4", size: 4, sort: func(a []*big.Float) {
+			if !NetworkSortBigFloatReverse(a, len(a)) {
+				panic("NetworkSortBigFloatReverse: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortBigFloatReverse-5", size: 5, sort: func(a []*big.Float) {
+			if !NetworkSortBigFloatReverse(a, len(a)) {
+				panic("NetworkSortBigFloatReverse: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortBigFloatReverse-6", size: 6, sort: func(a []*big.Float) {
+			if !NetworkSortBigFloatReverse(a, len(a)) {
+				panic("NetworkSortBigFloatReverse: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortBigFloatReverse-7", size: 7, sort: func(a []*big.Float) {
+			if !NetworkSortBigFloatReverse(a, len(a)) {
+				panic("NetworkSortBigFloatReverse: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortBigFloatReverse-8", size: 8, sort: func(a []*big.Float) {
+			if !NetworkSortBigFloatReverse(a, len(a)) {
+				panic("NetworkSortBigFloatReverse: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortBigFloatReverse-9", size: 9, sort: func(a []*big.Float) {
+			if !NetworkSortBigFloatReverse(a, len(a)) {
+				panic("NetworkSortBigFloatReverse: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortBigFloatReverse-10", size: 10, sort: func(a []*big.Float) {
+			if !NetworkSortBigFloatReverse(a, len(a)) {
+				panic("NetworkSortBigFloatReverse: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortBigFloatReverse-11", size: 11, sort: func(a []*big.Float) {
+			if !NetworkSortBigFloatReverse(a, len(a)) {
+				panic("NetworkSortBigFloatReverse: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortBigFloatReverse-12", size: 12, sort: func(a []*big.Float) {
+			if !NetworkSortBigFloatReverse(a, len(a)) {
+				panic("NetworkSortBigFloatReverse: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortBigFloatReverse-16", size: 16, sort: func(a []*big.Float) {
+			if !NetworkSortBigFloatReverse(a, len(a)) {
+				panic("NetworkSortBigFloatReverse: no sorter for size 16")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestStdlibGenNetworkSortBigFloatReverse(t *testing.T) {
+	gen, before, cases := testStdlibGenCasesNetworkSortBigFloatReverse()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzStdlibGenNetworkSortBigFloatReverse(f *testing.F) {
+	gen, before, cases := testStdlibGenCasesNetworkSortBigFloatReverse()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]*big.Float, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkStdlibGenNetworkSortBigFloatReverse(b *testing.B) {
+	gen, before, cases := testStdlibGenCasesNetworkSortBigFloatReverse()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testStdlibGenCasesNetworkSortBytes returns the functions generated for []byte
+// in increasing order, along with how to make random items and how they are ordered.
+func testStdlibGenCasesNetworkSortBytes() (gen func(*rand.Rand) []byte, before func(a, b []byte) bool, cases []testStdlibGenCase[[]byte]) {
+	gen = func(rng *rand.Rand) []byte { return []byte(strconv.Itoa(rng.Intn(32))) }
+	less := func(a, b []byte) bool { return bytes.Compare(a, b) < 0 }
+	before = less
+	cases = []testStdlibGenCase[[]byte]{
+		{name: "NetworkSort2xBytes", size: 2, sort: func(a [][]byte) { NetworkSort2xBytes(a) }},
+		{name: "NetworkSort3xBytes", size: 3, sort: func(a [][]byte) { NetworkSort3xBytes(a) }},
+		{name: "NetworkSort4xBytes", size: 4, sort: func(a [][]byte) { NetworkSort4xBytes(a) }},
+		{name: "NetworkSort5xBytes", size: 5, sort: func(a [][]byte) { NetworkSort5xBytes(a) }},
+		{name: "NetworkSort6xBytes", size: 6, sort: func(a [][]byte) { NetworkSort6xBytes(a) }},
+		{name: "NetworkSort7xBytes", size: 7, sort: func(a [][]byte) { NetworkSort7xBytes(a) }},
+		{name: "NetworkSort8xBytes", size: 8, sort: func(a [][]byte) { NetworkSort8xBytes(a) }},
+		{name: "NetworkSort9xBytes", size: 9, sort: func(a [][]byte) { NetworkSort9xBytes(a) }},
+		{name: "NetworkSort10xBytes", size: 10, sort: func(a [][]byte) { NetworkSort10xBytes(a) }},
+		{name: "NetworkSort11xBytes", size: 11, sort: func(a [][]byte) { NetworkSort11xBytes(a) }},
+		{name: "NetworkSort12xBytes", size: 12, sort: func(a [][]byte) { NetworkSort12xBytes(a) }},
+		{name: "NetworkSort16xBytes", size: 16, sort: func(a [][]byte) { NetworkSort16xBytes(a) }},
+		{name: "NetworkSortBytes-2", size: 2, sort: func(a [][]byte) {
+			if !NetworkSortBytes(a, len(a)) {
+				panic("NetworkSortBytes: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortBytes-3", size: 3, sort: func(a [][]byte) {
+			if !NetworkSortBytes(a, len(a)) {
+				panic("NetworkSortBytes: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortBytes-4", size: 4, sort: func(a [][]byte) {
+			if !NetworkSortBytes(a, len(a)) {
+				panic("NetworkSortBytes: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortBytes-5", size: 5, sort: func(a [][]byte) {
+			if !NetworkSortBytes(a, len(a)) {
+				panic("NetworkSortBytes: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortBytes-6", size: 6, sort: func(a [][]byte) {
+			if !NetworkSortBytes(a, len(a)) {
+				panic("NetworkSortBytes: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortBytes-7", size: 7, sort: func(a [][]byte) {
+			if !NetworkSortBytes(a, len(a)) {
+				panic("NetworkSortBytes: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortBytes-8", size: 8, sort: func(a [][]byte) {
+			if !NetworkSortBytes(a, len(a)) {
+				panic("NetworkSortBytes: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortBytes-9", size: 9, sort: func(a [][]byte) {
+			if !NetworkSortBytes(a, len(a)) {
+				panic("NetworkSortBytes: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortBytes-10", size: 10, sort: func(a [][]byte) {
+			if !NetworkSortBytes(a, len(a)) {
+				panic("NetworkSortBytes: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortBytes-11", size: 11, sort: func(a [][]byte) {
+			if !NetworkSortBytes(a, len(a)) {
+				panic("NetworkSortBytes: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortBytes-12", size: 12, sort: func(a [][]byte) {
+			if !NetworkSortBytes(a, len(a)) {
+				panic("NetworkSortBytes: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortBytes-16", size: 16, sort: func(a [][]byte) {
+			if !NetworkSortBytes(a, len(a)) {
+				panic("NetworkSortBytes: no sorter for size 16")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestStdlibGenNetworkSortBytes(t *testing.T) {
+	gen, before, cases := testStdlibGenCasesNetworkSortBytes()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzStdlibGenNetworkSortBytes(f *testing.F) {
+	gen, before, cases := testStdlibGenCasesNetworkSortBytes()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([][]byte, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkStdlibGenNetworkSortBytes(b *testing.B) {
+	gen, before, cases := testStdlibGenCasesNetworkSortBytes()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testStdlibGenCasesNetworkSortBytesReverse returns the functions generated for []byte
+// in decreasing order, along with how to make random items and how they are ordered.
+func testStdlibGenCasesNetworkSortBytesReverse() (gen func(*rand.Rand) []byte, before func(a, b []byte) bool, cases []testStdlibGenCase[[]byte]) {
+	gen = func(rng *rand.Rand) []byte { return []byte(strconv.Itoa(rng.Intn(32))) }
+	less := func(a, b []byte) bool { return bytes.Compare(a, b) < 0 }
+	before = func(a, b []byte) bool { return less(b, a) }
+	cases = []testStdlibGenCase[[]byte]{
+		{name: "NetworkSort2xBytesReverse", size: 2, sort: func(a [][]byte) { NetworkSort2xBytesReverse(a) }},
+		{name: "NetworkSort3xBytesReverse", size: 3, sort: func(a [][]byte) { NetworkSort3xBytesReverse(a) }},
+		{name: "NetworkSort4xBytesReverse", size: 4, sort: func(a [][]byte) { NetworkSort4xBytesReverse(a) }},
+		{name: "NetworkSort5xBytesReverse", size: 5, sort: func(a [][]byte) { NetworkSort5xBytesReverse(a) }},
+		{name: "NetworkSort6xBytesReverse", size: 6, sort: func(a [][]byte) { NetworkSort6xBytesReverse(a) }},
+		{name: "NetworkSort7xBytesReverse", size: 7, sort: func(a [][]byte) { NetworkSort7xBytesReverse(a) }},
+		{name: "NetworkSort8xBytesReverse", size: 8, sort: func(a [][]byte) { NetworkSort8xBytesReverse(a) }},
+		{name: "NetworkSort9xBytesReverse", size: 9, sort: func(a [][]byte) { NetworkSort9xBytesReverse(a) }},
+		{name: "NetworkSort10xBytesReverse", size: 10, sort: func(a [][]byte) { NetworkSort10xBytesReverse(a) }},
+		{name: "NetworkSort11xBytesReverse", size: 11, sort: func(a [][]byte) { NetworkSort11xBytesReverse(a) }},
+		{name: "NetworkSort12xBytesReverse", size: 12, sort: func(a [][]byte) { NetworkSort12xBytesReverse(a) }},
+		{name: "NetworkSort16xBytesReverse", size: 16, sort: func(a [][]byte) { NetworkSort16xBytesReverse(a) }},
+		{name: "NetworkSortBytesReverse-2", size: 2, sort: func(a [][]byte) {
+			if !NetworkSortBytesReverse(a, len(a)) {
+				panic("NetworkSortBytesReverse: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortBytesReverse-3", size: 3, sort: func(a [][]byte) {
+			if !NetworkSortBytesReverse(a, len(a)) {
+				panic("NetworkSortBytesReverse: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortBytesReverse-4", size: 4, sort: func(a [][]byte) {
+			if !NetworkSortBytesReverse(a, len(a)) {
+				panic("NetworkSortBytesReverse: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortBytesReverse-5", size: 5, sort: func(a [][]byte) {
+			if !NetworkSortBytesReverse(a, len(a)) {
+				panic("NetworkSortBytesReverse: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortBytesReverse-6", size: 6, sort: func(a [][]byte) {
+			if !NetworkSortBytesReverse(a, len(a)) {
+				panic("NetworkSortBytesReverse: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortBytesReverse-7", size: 7, sort: func(a [][]byte) {
+			if !NetworkSortBytesReverse(a, len(a)) {
+				panic("NetworkSortBytesReverse: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortBytesReverse-8", size: 8, sort: func(a [][]byte) {
+			if !NetworkSortBytesReverse(a, len(a)) {
+				panic("NetworkSortBytesReverse: no sorter for size 8")
+			}
+		}},
+		{name: "NetworkSortBytesReverse-9", size: 9, sort: func(a [][]byte) {
+			if !NetworkSortBytesReverse(a, len(a)) {
+				panic("NetworkSortBytesReverse: no sorter for size 9")
+			}
+		}},
+		{name: "NetworkSortBytesReverse-10", size: 10, sort: func(a [][]byte) {
+			if !NetworkSortBytesReverse(a, len(a)) {
+				panic("NetworkSortBytesReverse: no sorter for size 10")
+			}
+		}},
+		{name: "NetworkSortBytesReverse-11", size: 11, sort: func(a [][]byte) {
+			if !NetworkSortBytesReverse(a, len(a)) {
+				panic("NetworkSortBytesReverse: no sorter for size 11")
+			}
+		}},
+		{name: "NetworkSortBytesReverse-12", size: 12, sort: func(a [][]byte) {
+			if !NetworkSortBytesReverse(a, len(a)) {
+				panic("NetworkSortBytesReverse: no sorter for size 12")
+			}
+		}},
+		{name: "NetworkSortBytesReverse-16", size: 16, sort: func(a [][]byte) {
+			if !NetworkSortBytesReverse(a, len(a)) {
+				panic("NetworkSortBytesReverse: no sorter for size 16")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestStdlibGenNetworkSortBytesReverse(t *testing.T) {
+	gen, before, cases := testStdlibGenCasesNetworkSortBytesReverse()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzStdlibGenNetworkSortBytesReverse(f *testing.F) {
+	gen, before, cases := testStdlibGenCasesNetworkSortBytesReverse()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([][]byte, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkStdlibGenNetworkSortBytesReverse(b *testing.B) {
+	gen, before, cases := testStdlibGenCasesNetworkSortBytesReverse()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package gentest
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/shabbyrobe/sortnet/sortnettest"
+)
+
+// wrapped adapts a generated wrapper function to a sorter of a single size.
+func wrapped[T any](wrapper func(a []T, sz int) bool, sz int) func([]T) {
+	return func(a []T) {
+		if !wrapper(a, sz) {
+			panic(fmt.Errorf("no sorter for size %d", sz))
+		}
+	}
+}
+
+// TestSortNetTimeZonesCheck checks the time.Time sorters with the same instants in
+// different locations, which must still be treated as equal. The generated tests only
+// use times in one location.
+func TestSortNetTimeZonesCheck(t *testing.T) {
+	base := time.Date(2019, 10, 13, 0, 0, 0, 0, time.UTC)
+	genTime := func(rng *rand.Rand) time.Time {
+		tm := base.Add(time.Duration(rng.Intn(100)) * time.Minute)
+		if rng.Intn(2) == 0 {
+			tm = tm.In(time.FixedZone("", 3600))
+		}
+		return tm
+	}
+	before := func(a, b time.Time) bool { return a.Before(b) }
+	after := func(a, b time.Time) bool { return a.After(b) }
+
+	for _, sz := range []int{2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 16} {
+		t.Run(fmt.Sprint(sz), func(t *testing.T) {
+			sortnettest.CheckSorter(t, sz, wrapped(NetworkSortTime, sz), genTime, before)
+			sortnettest.CheckSorter(t, sz, wrapped(NetworkSortTimeReverse, sz), genTime, after)
+		})
+	}
+}
@@ -0,0 +1,279 @@
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
+
+package gentest
+
+// Sorting networks used in this file:
+//
+//   - Bose-Nelson, 3 inputs: 3 comparators (proven optimal), depth 3 (proven optimal)
+//     Fingerprint f995edafd93d50ea
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 4 inputs: 5 comparators (proven optimal), depth 3 (proven optimal)
+//     Fingerprint 0119fc1a905673cd
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Senso9, 9 inputs: 25 comparators (proven optimal), depth 8 (not optimal)
+//     Fingerprint 5463bfb95d0dca98
+//     V. K. Valsalam and R. Miikkulainen (2013)
+//     V. K. Valsalam and R. Miikkulainen, Using Symmetry and Evolutionary Search to Minimize Sorting Networks, Journal of Machine Learning Research 14, 2013
+
+// NetworkSort3xUint8Strided sorts the 3 items of a that start at index 'off'
+// and are spaced 'stride' items apart, leaving the items in between untouched.
+func NetworkSort3xUint8Strided(a []uint8, off, stride int) {
+	i0 := off
+	i1 := off + 1*stride
+	i2 := off + 2*stride
+	if a[i1] > a[i2] {
+		a[i1], a[i2] = a[i2], a[i1]
+	}
+	if a[i0] > a[i2] {
+		a[i0], a[i2] = a[i2], a[i0]
+	}
+	if a[i0] > a[i1] {
+		a[i0], a[i1] = a[i1], a[i0]
+	}
+}
+
+// NetworkSort3xUint8StridedReverse sorts the 3 items of a that start at index 'off'
+// and are spaced 'stride' items apart, leaving the items in between untouched.
+func NetworkSort3xUint8StridedReverse(a []uint8, off, stride int) {
+	i0 := off
+	i1 := off + 1*stride
+	i2 := off + 2*stride
+	if a[i1] < a[i2] {
+		a[i1], a[i2] = a[i2], a[i1]
+	}
+	if a[i0] < a[i2] {
+		a[i0], a[i2] = a[i2], a[i0]
+	}
+	if a[i0] < a[i1] {
+		a[i0], a[i1] = a[i1], a[i0]
+	}
+}
+
+// NetworkSort4xUint8Strided sorts the 4 items of a that start at index 'off'
+// and are spaced 'stride' items apart, leaving the items in between untouched.
+func NetworkSort4xUint8Strided(a []uint8, off, stride int) {
+	i0 := off
+	i1 := off + 1*stride
+	i2 := off + 2*stride
+	i3 := off + 3*stride
+	if a[i0] > a[i1] {
+		a[i0], a[i1] = a[i1], a[i0]
+	}
+	if a[i2] > a[i3] {
+		a[i2], a[i3] = a[i3], a[i2]
+	}
+	if a[i0] > a[i2] {
+		a[i0], a[i2] = a[i2], a[i0]
+	}
+	if a[i1] > a[i3] {
+		a[i1], a[i3] = a[i3], a[i1]
+	}
+	if a[i1] > a[i2] {
+		a[i1], a[i2] = a[i2], a[i1]
+	}
+}
+
+// NetworkSort4xUint8StridedReverse sorts the 4 items of a that start at index 'off'
+// and are spaced 'stride' items apart, leaving the items in between untouched.
+func NetworkSort4xUint8StridedReverse(a []uint8, off, stride int) {
+	i0 := off
+	i1 := off + 1*stride
+	i2 := off + 2*stride
+	i3 := off + 3*stride
+	if a[i0] < a[i1] {
+		a[i0], a[i1] = a[i1], a[i0]
+	}
+	if a[i2] < a[i3] {
+		a[i2], a[i3] = a[i3], a[i2]
+	}
+	if a[i0] < a[i2] {
+		a[i0], a[i2] = a[i2], a[i0]
+	}
+	if a[i1] < a[i3] {
+		a[i1], a[i3] = a[i3], a[i1]
+	}
+	if a[i1] < a[i2] {
+		a[i1], a[i2] = a[i2], a[i1]
+	}
+}
+
+// NetworkSort9xUint8Strided sorts the 9 items of a that start at index 'off'
+// and are spaced 'stride' items apart, leaving the items in between untouched.
+func NetworkSort9xUint8Strided(a []uint8, off, stride int) {
+	i0 := off
+	i1 := off + 1*stride
+	i2 := off + 2*stride
+	i3 := off + 3*stride
+	i4 := off + 4*stride
+	i5 := off + 5*stride
+	i6 := off + 6*stride
+	i7 := off + 7*stride
+	i8 := off + 8*stride
+	if a[i2] > a[i6] {
+		a[i2], a[i6] = a[i6], a[i2]
+	}
+	if a[i0] > a[i5] {
+		a[i0], a[i5] = a[i5], a[i0]
+	}
+	if a[i1] > a[i4] {
+		a[i1], a[i4] = a[i4], a[i1]
+	}
+	if a[i7] > a[i8] {
+		a[i7], a[i8] = a[i8], a[i7]
+	}
+	if a[i0] > a[i7] {
+		a[i0], a[i7] = a[i7], a[i0]
+	}
+	if a[i1] > a[i2] {
+		a[i1], a[i2] = a[i2], a[i1]
+	}
+	if a[i3] > a[i5] {
+		a[i3], a[i5] = a[i5], a[i3]
+	}
+	if a[i4] > a[i6] {
+		a[i4], a[i6] = a[i6], a[i4]
+	}
+	if a[i5] > a[i8] {
+		a[i5], a[i8] = a[i8], a[i5]
+	}
+	if a[i1] > a[i3] {
+		a[i1], a[i3] = a[i3], a[i1]
+	}
+	if a[i6] > a[i8] {
+		a[i6], a[i8] = a[i8], a[i6]
+	}
+	if a[i0] > a[i1] {
+		a[i0], a[i1] = a[i1], a[i0]
+	}
+	if a[i4] > a[i5] {
+		a[i4], a[i5] = a[i5], a[i4]
+	}
+	if a[i2] > a[i7] {
+		a[i2], a[i7] = a[i7], a[i2]
+	}
+	if a[i3] > a[i7] {
+		a[i3], a[i7] = a[i7], a[i3]
+	}
+	if a[i3] > a[i4] {
+		a[i3], a[i4] = a[i4], a[i3]
+	}
+	if a[i5] > a[i6] {
+		a[i5], a[i6] = a[i6], a[i5]
+	}
+	if a[i1] > a[i2] {
+		a[i1], a[i2] = a[i2], a[i1]
+	}
+	if a[i1] > a[i3] {
+		a[i1], a[i3] = a[i3], a[i1]
+	}
+	if a[i6] > a[i7] {
+		a[i6], a[i7] = a[i7], a[i6]
+	}
+	if a[i4] > a[i5] {
+		a[i4], a[i5] = a[i5], a[i4]
+	}
+	if a[i2] > a[i4] {
+		a[i2], a[i4] = a[i4], a[i2]
+	}
+	if a[i5] > a[i6] {
+		a[i5], a[i6] = a[i6], a[i5]
+	}
+	if a[i2] > a[i3] {
+		a[i2], a[i3] = a[i3], a[i2]
+	}
+	if a[i4] > a[i5] {
+		a[i4], a[i5] = a[i5], a[i4]
+	}
+}
+
+// NetworkSort9xUint8StridedReverse sorts the 9 items of a that start at index 'off'
+// and are spaced 'stride' items apart, leaving the items in between untouched.
+func NetworkSort9xUint8StridedReverse(a []uint8, off, stride int) {
+	i0 := off
+	i1 := off + 1*stride
+	i2 := off + 2*stride
+	i3 := off + 3*stride
+	i4 := off + 4*stride
+	i5 := off + 5*stride
+	i6 := off + 6*stride
+	i7 := off + 7*stride
+	i8 := off + 8*stride
+	if a[i2] < a[i6] {
+		a[i2], a[i6] = a[i6], a[i2]
+	}
+	if a[i0] < a[i5] {
+		a[i0], a[i5] = a[i5], a[i0]
+	}
+	if a[i1] < a[i4] {
+		a[i1], a[i4] = a[i4], a[i1]
+	}
+	if a[i7] < a[i8] {
+		a[i7], a[i8] = a[i8], a[i7]
+	}
+	if a[i0] < a[i7] {
+		a[i0], a[i7] = a[i7], a[i0]
+	}
+	if a[i1] < a[i2] {
+		a[i1], a[i2] = a[i2], a[i1]
+	}
+	if a[i3] < a[i5] {
+		a[i3], a[i5] = a[i5], a[i3]
+	}
+	if a[i4] < a[i6] {
+		a[i4], a[i6] = a[i6], a[i4]
+	}
+	if a[i5] < a[i8] {
+		a[i5], a[i8] = a[i8], a[i5]
+	}
+	if a[i1] < a[i3] {
+		a[i1], a[i3] = a[i3], a[i1]
+	}
+	if a[i6] < a[i8] {
+		a[i6], a[i8] = a[i8], a[i6]
+	}
+	if a[i0] < a[i1] {
+		a[i0], a[i1] = a[i1], a[i0]
+	}
+	if a[i4] < a[i5] {
+		a[i4], a[i5] = a[i5], a[i4]
+	}
+	if a[i2] < a[i7] {
+		a[i2], a[i7] = a[i7], a[i2]
+	}
+	if a[i3] < a[i7] {
+		a[i3], a[i7] = a[i7], a[i3]
+	}
+	if a[i3] < a[i4] {
+		a[i3], a[i4] = a[i4], a[i3]
+	}
+	if a[i5] < a[i6] {
+		a[i5], a[i6] = a[i6], a[i5]
+	}
+	if a[i1] < a[i2] {
+		a[i1], a[i2] = a[i2], a[i1]
+	}
+	if a[i1] < a[i3] {
+		a[i1], a[i3] = a[i3], a[i1]
+	}
+	if a[i6] < a[i7] {
+		a[i6], a[i7] = a[i7], a[i6]
+	}
+	if a[i4] < a[i5] {
+		a[i4], a[i5] = a[i5], a[i4]
+	}
+	if a[i2] < a[i4] {
+		a[i2], a[i4] = a[i4], a[i2]
+	}
+	if a[i5] < a[i6] {
+		a[i5], a[i6] = a[i6], a[i5]
+	}
+	if a[i2] < a[i3] {
+		a[i2], a[i3] = a[i3], a[i2]
+	}
+	if a[i4] < a[i5] {
+		a[i4], a[i5] = a[i5], a[i4]
+	}
+}
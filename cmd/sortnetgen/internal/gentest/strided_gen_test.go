@@ -0,0 +1,108 @@
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
+
+package gentest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/shabbyrobe/sortnet/sortnettest"
+)
+
+// testStridedGenCase is a function generated by sortnetgen, adapted to be checked by
+// sortnettest. Either sort or merge is set.
+type testStridedGenCase[T any] struct {
+	name  string
+	size  int
+	sort  func(a []T)
+	merge func(dst []T, runs [][]T) []T
+}
+
+// testStridedGenCasesNetworkSortUint8 returns the functions generated for uint8
+// in increasing order, along with how to make random items and how they are ordered.
+func testStridedGenCasesNetworkSortUint8() (gen func(*rand.Rand) uint8, before func(a, b uint8) bool, cases []testStridedGenCase[uint8]) {
+	gen = func(rng *rand.Rand) uint8 { return uint8(rng.Intn(32)) }
+	less := func(a, b uint8) bool { return a < b }
+	before = less
+	cases = []testStridedGenCase[uint8]{
+		{name: "NetworkSort3xUint8Strided", size: 3, sort: func(a []uint8) { NetworkSort3xUint8Strided(a, 0, 1) }},
+		{name: "NetworkSort4xUint8Strided", size: 4, sort: func(a []uint8) { NetworkSort4xUint8Strided(a, 0, 1) }},
+		{name: "NetworkSort9xUint8Strided", size: 9, sort: func(a []uint8) { NetworkSort9xUint8Strided(a, 0, 1) }},
+	}
+	return gen, before, cases
+}
+
+func TestStridedGenNetworkSortUint8(t *testing.T) {
+	gen, before, cases := testStridedGenCasesNetworkSortUint8()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzStridedGenNetworkSortUint8(f *testing.F) {
+	gen, before, cases := testStridedGenCasesNetworkSortUint8()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]uint8, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+// testStridedGenCasesNetworkSortUint8Reverse returns the functions generated for uint8
+// in decreasing order, along with how to make random items and how they are ordered.
+func testStridedGenCasesNetworkSortUint8Reverse() (gen func(*rand.Rand) uint8, before func(a, b uint8) bool, cases []testStridedGenCase[uint8]) {
+	gen = func(rng *rand.Rand) uint8 { return uint8(rng.Intn(32)) }
+	less := func(a, b uint8) bool { return a < b }
+	before = func(a, b uint8) bool { return less(b, a) }
+	cases = []testStridedGenCase[uint8]{
+		{name: "NetworkSort3xUint8StridedReverse", size: 3, sort: func(a []uint8) { NetworkSort3xUint8StridedReverse(a, 0, 1) }},
+		{name: "NetworkSort4xUint8StridedReverse", size: 4, sort: func(a []uint8) { NetworkSort4xUint8StridedReverse(a, 0, 1) }},
+		{name: "NetworkSort9xUint8StridedReverse", size: 9, sort: func(a []uint8) { NetworkSort9xUint8StridedReverse(a, 0, 1) }},
+	}
+	return gen, before, cases
+}
+
+func TestStridedGenNetworkSortUint8Reverse(t *testing.T) {
+	gen, before, cases := testStridedGenCasesNetworkSortUint8Reverse()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzStridedGenNetworkSortUint8Reverse(f *testing.F) {
+	gen, before, cases := testStridedGenCasesNetworkSortUint8Reverse()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]uint8, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
@@ -0,0 +1,58 @@
+package gentest
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestSortNetUint8Strided(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+
+	for _, tc := range []struct {
+		sz     int
+		fwd    bool
+		sorter func(a []uint8, off, stride int)
+	}{
+		{3, true, NetworkSort3xUint8Strided},
+		{4, true, NetworkSort4xUint8Strided},
+		{9, true, NetworkSort9xUint8Strided},
+		{3, false, NetworkSort3xUint8StridedReverse},
+		{4, false, NetworkSort4xUint8StridedReverse},
+		{9, false, NetworkSort9xUint8StridedReverse},
+	} {
+		// Sort each channel of some interleaved RGBA pixels:
+		const stride = 4
+		for off := 0; off < stride; off++ {
+			t.Run(fmt.Sprintf("%d/%v/%d", tc.sz, tc.fwd, off), func(t *testing.T) {
+				vs := make([]uint8, tc.sz*stride)
+				for i := range vs {
+					vs[i] = uint8(rng.Intn(256))
+				}
+
+				exp := make([]uint8, len(vs))
+				copy(exp, vs)
+				gathered := make([]uint8, tc.sz)
+				for i := range gathered {
+					gathered[i] = exp[off+i*stride]
+				}
+				sort.Slice(gathered, func(i, j int) bool {
+					if tc.fwd {
+						return gathered[i] < gathered[j]
+					}
+					return gathered[i] > gathered[j]
+				})
+				for i, v := range gathered {
+					exp[off+i*stride] = v
+				}
+
+				tc.sorter(vs, off, stride)
+				if !reflect.DeepEqual(exp, vs) {
+					t.Fatal("strided items not sorted")
+				}
+			})
+		}
+	}
+}
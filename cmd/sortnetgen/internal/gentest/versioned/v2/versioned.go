@@ -0,0 +1,6 @@
+// Package versioned is imported by gentest from a path ending in '/v2', so the
+// generated code must use the name it is declared with rather than the last element
+// of its import path.
+package versioned
+
+type Level int
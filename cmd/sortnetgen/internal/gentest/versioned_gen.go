@@ -0,0 +1,539 @@
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
+
+package gentest
+
+// Sorting networks used in this file:
+//
+//   - Bose-Nelson, 2 inputs: 1 comparator (proven optimal), depth 1 (proven optimal)
+//     Fingerprint 9463926d4640a53a
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 3 inputs: 3 comparators (proven optimal), depth 3 (proven optimal)
+//     Fingerprint f995edafd93d50ea
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 4 inputs: 5 comparators (proven optimal), depth 3 (proven optimal)
+//     Fingerprint 0119fc1a905673cd
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 5 inputs: 9 comparators (proven optimal), depth 6 (not optimal)
+//     Fingerprint d54da66c984b8b07
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 6 inputs: 13 comparators (not optimal), depth 7 (not optimal)
+//     Fingerprint 1cc32b1cf5a405a2
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 7 inputs: 16 comparators (proven optimal), depth 7 (not optimal)
+//     Fingerprint 4c56e9c432030c54
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+//   - Bose-Nelson, 8 inputs: 19 comparators (proven optimal), depth 7 (not optimal)
+//     Fingerprint 72b14580972c6d18
+//     R. C. Bose and R. J. Nelson (1962)
+//     R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM 9(2), 1962
+
+import "github.com/shabbyrobe/sortnet/cmd/sortnetgen/internal/gentest/versioned/v2"
+
+// NetworkSortLevel sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortLevel(a []versioned.Level, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xLevel(a)
+	case 3:
+		NetworkSort3xLevel(a)
+	case 4:
+		NetworkSort4xLevel(a)
+	case 5:
+		NetworkSort5xLevel(a)
+	case 6:
+		NetworkSort6xLevel(a)
+	case 7:
+		NetworkSort7xLevel(a)
+	case 8:
+		NetworkSort8xLevel(a)
+	default:
+		return false
+	}
+	return true
+}
+
+// NetworkSortLevelReverse sorts the input according to its length ('sz') using a sorting network, if
+// one is available. If the sort was applied, 'ok' is true, otherwise it is false to allow
+// you to perform your own sort as a fallback.
+func NetworkSortLevelReverse(a []versioned.Level, sz int) (ok bool) {
+	switch sz {
+	case 2:
+		NetworkSort2xLevelReverse(a)
+	case 3:
+		NetworkSort3xLevelReverse(a)
+	case 4:
+		NetworkSort4xLevelReverse(a)
+	case 5:
+		NetworkSort5xLevelReverse(a)
+	case 6:
+		NetworkSort6xLevelReverse(a)
+	case 7:
+		NetworkSort7xLevelReverse(a)
+	case 8:
+		NetworkSort8xLevelReverse(a)
+	default:
+		return false
+	}
+	return true
+}
+
+func NetworkSort2xLevel(a []versioned.Level) {
+	_ = a[1]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort2xLevelReverse(a []versioned.Level) {
+	_ = a[1]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3xLevel(a []versioned.Level) {
+	_ = a[2]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort3xLevelReverse(a []versioned.Level) {
+	_ = a[2]
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+func NetworkSort4xLevel(a []versioned.Level) {
+	_ = a[3]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort4xLevelReverse(a []versioned.Level) {
+	_ = a[3]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5xLevel(a []versioned.Level) {
+	_ = a[4]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort5xLevelReverse(a []versioned.Level) {
+	_ = a[4]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] < a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+func NetworkSort6xLevel(a []versioned.Level) {
+	_ = a[5]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort6xLevelReverse(a []versioned.Level) {
+	_ = a[5]
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] < a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] < a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7xLevel(a []versioned.Level) {
+	_ = a[6]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort7xLevelReverse(a []versioned.Level) {
+	_ = a[6]
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] < a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2] < a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+func NetworkSort8xLevel(a []versioned.Level) {
+	_ = a[7]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+func NetworkSort8xLevelReverse(a []versioned.Level) {
+	_ = a[7]
+	if a[0] < a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] < a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] < a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] < a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] < a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] < a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] < a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] < a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] < a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] < a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] < a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] < a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1] < a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2] < a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] < a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] < a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2] < a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3] < a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] < a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+}
@@ -0,0 +1,205 @@
+// Code generated by 'github.com/shabbyrobe/sortnet/cmd/sortnetgen'. DO NOT EDIT.
+// sortnetgen version 0.1.0
+
+package gentest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/shabbyrobe/sortnet/cmd/sortnetgen/internal/gentest/versioned/v2"
+	"github.com/shabbyrobe/sortnet/sortnettest"
+)
+
+// testVersionedGenCase is a function generated by sortnetgen, adapted to be checked by
+// sortnettest. Either sort or merge is set.
+type testVersionedGenCase[T any] struct {
+	name  string
+	size  int
+	sort  func(a []T)
+	merge func(dst []T, runs [][]T) []T
+}
+
+// testVersionedGenCasesNetworkSortLevel returns the functions generated for versioned.Level
+// in increasing order, along with how to make random items and how they are ordered.
+func testVersionedGenCasesNetworkSortLevel() (gen func(*rand.Rand) versioned.Level, before func(a, b versioned.Level) bool, cases []testVersionedGenCase[versioned.Level]) {
+	gen = func(rng *rand.Rand) versioned.Level { return versioned.Level(rng.Intn(32) - 16) }
+	less := func(a, b versioned.Level) bool { return a < b }
+	before = less
+	cases = []testVersionedGenCase[versioned.Level]{
+		{name: "NetworkSort2xLevel", size: 2, sort: func(a []versioned.Level) { NetworkSort2xLevel(a) }},
+		{name: "NetworkSort3xLevel", size: 3, sort: func(a []versioned.Level) { NetworkSort3xLevel(a) }},
+		{name: "NetworkSort4xLevel", size: 4, sort: func(a []versioned.Level) { NetworkSort4xLevel(a) }},
+		{name: "NetworkSort5xLevel", size: 5, sort: func(a []versioned.Level) { NetworkSort5xLevel(a) }},
+		{name: "NetworkSort6xLevel", size: 6, sort: func(a []versioned.Level) { NetworkSort6xLevel(a) }},
+		{name: "NetworkSort7xLevel", size: 7, sort: func(a []versioned.Level) { NetworkSort7xLevel(a) }},
+		{name: "NetworkSort8xLevel", size: 8, sort: func(a []versioned.Level) { NetworkSort8xLevel(a) }},
+		{name: "NetworkSortLevel-2", size: 2, sort: func(a []versioned.Level) {
+			if !NetworkSortLevel(a, len(a)) {
+				panic("NetworkSortLevel: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortLevel-3", size: 3, sort: func(a []versioned.Level) {
+			if !NetworkSortLevel(a, len(a)) {
+				panic("NetworkSortLevel: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortLevel-4", size: 4, sort: func(a []versioned.Level) {
+			if !NetworkSortLevel(a, len(a)) {
+				panic("NetworkSortLevel: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortLevel-5", size: 5, sort: func(a []versioned.Level) {
+			if !NetworkSortLevel(a, len(a)) {
+				panic("NetworkSortLevel: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortLevel-6", size: 6, sort: func(a []versioned.Level) {
+			if !NetworkSortLevel(a, len(a)) {
+				panic("NetworkSortLevel: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortLevel-7", size: 7, sort: func(a []versioned.Level) {
+			if !NetworkSortLevel(a, len(a)) {
+				panic("NetworkSortLevel: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortLevel-8", size: 8, sort: func(a []versioned.Level) {
+			if !NetworkSortLevel(a, len(a)) {
+				panic("NetworkSortLevel: no sorter for size 8")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestVersionedGenNetworkSortLevel(t *testing.T) {
+	gen, before, cases := testVersionedGenCasesNetworkSortLevel()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzVersionedGenNetworkSortLevel(f *testing.F) {
+	gen, before, cases := testVersionedGenCasesNetworkSortLevel()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]versioned.Level, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkVersionedGenNetworkSortLevel(b *testing.B) {
+	gen, before, cases := testVersionedGenCasesNetworkSortLevel()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+
+// testVersionedGenCasesNetworkSortLevelReverse returns the functions generated for versioned.Level
+// in decreasing order, along with how to make random items and how they are ordered.
+func testVersionedGenCasesNetworkSortLevelReverse() (gen func(*rand.Rand) versioned.Level, before func(a, b versioned.Level) bool, cases []testVersionedGenCase[versioned.Level]) {
+	gen = func(rng *rand.Rand) versioned.Level { return versioned.Level(rng.Intn(32) - 16) }
+	less := func(a, b versioned.Level) bool { return a < b }
+	before = func(a, b versioned.Level) bool { return less(b, a) }
+	cases = []testVersionedGenCase[versioned.Level]{
+		{name: "NetworkSort2xLevelReverse", size: 2, sort: func(a []versioned.Level) { NetworkSort2xLevelReverse(a) }},
+		{name: "NetworkSort3xLevelReverse", size: 3, sort: func(a []versioned.Level) { NetworkSort3xLevelReverse(a) }},
+		{name: "NetworkSort4xLevelReverse", size: 4, sort: func(a []versioned.Level) { NetworkSort4xLevelReverse(a) }},
+		{name: "NetworkSort5xLevelReverse", size: 5, sort: func(a []versioned.Level) { NetworkSort5xLevelReverse(a) }},
+		{name: "NetworkSort6xLevelReverse", size: 6, sort: func(a []versioned.Level) { NetworkSort6xLevelReverse(a) }},
+		{name: "NetworkSort7xLevelReverse", size: 7, sort: func(a []versioned.Level) { NetworkSort7xLevelReverse(a) }},
+		{name: "NetworkSort8xLevelReverse", size: 8, sort: func(a []versioned.Level) { NetworkSort8xLevelReverse(a) }},
+		{name: "NetworkSortLevelReverse-2", size: 2, sort: func(a []versioned.Level) {
+			if !NetworkSortLevelReverse(a, len(a)) {
+				panic("NetworkSortLevelReverse: no sorter for size 2")
+			}
+		}},
+		{name: "NetworkSortLevelReverse-3", size: 3, sort: func(a []versioned.Level) {
+			if !NetworkSortLevelReverse(a, len(a)) {
+				panic("NetworkSortLevelReverse: no sorter for size 3")
+			}
+		}},
+		{name: "NetworkSortLevelReverse-4", size: 4, sort: func(a []versioned.Level) {
+			if !NetworkSortLevelReverse(a, len(a)) {
+				panic("NetworkSortLevelReverse: no sorter for size 4")
+			}
+		}},
+		{name: "NetworkSortLevelReverse-5", size: 5, sort: func(a []versioned.Level) {
+			if !NetworkSortLevelReverse(a, len(a)) {
+				panic("NetworkSortLevelReverse: no sorter for size 5")
+			}
+		}},
+		{name: "NetworkSortLevelReverse-6", size: 6, sort: func(a []versioned.Level) {
+			if !NetworkSortLevelReverse(a, len(a)) {
+				panic("NetworkSortLevelReverse: no sorter for size 6")
+			}
+		}},
+		{name: "NetworkSortLevelReverse-7", size: 7, sort: func(a []versioned.Level) {
+			if !NetworkSortLevelReverse(a, len(a)) {
+				panic("NetworkSortLevelReverse: no sorter for size 7")
+			}
+		}},
+		{name: "NetworkSortLevelReverse-8", size: 8, sort: func(a []versioned.Level) {
+			if !NetworkSortLevelReverse(a, len(a)) {
+				panic("NetworkSortLevelReverse: no sorter for size 8")
+			}
+		}},
+	}
+	return gen, before, cases
+}
+
+func TestVersionedGenNetworkSortLevelReverse(t *testing.T) {
+	gen, before, cases := testVersionedGenCasesNetworkSortLevelReverse()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func FuzzVersionedGenNetworkSortLevelReverse(f *testing.F) {
+	gen, before, cases := testVersionedGenCasesNetworkSortLevelReverse()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]versioned.Level, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+
+func BenchmarkVersionedGenNetworkSortLevelReverse(b *testing.B) {
+	gen, before, cases := testVersionedGenCasesNetworkSortLevelReverse()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
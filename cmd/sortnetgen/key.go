@@ -0,0 +1,132 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/token"
+	"go/types"
+	"strings"
+	"text/template"
+)
+
+// keyField is one of the comma-separated field paths passed to -key, like '-Bar.Baz'.
+type keyField struct {
+	path []string
+	desc bool
+}
+
+func parseKey(spec string) ([]keyField, error) {
+	var fields []keyField
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		var field keyField
+		if strings.HasPrefix(part, "-") {
+			field.desc = true
+			part = part[1:]
+		} else if strings.HasPrefix(part, "+") {
+			part = part[1:]
+		}
+		field.path = strings.Split(part, ".")
+		for _, name := range field.path {
+			if !token.IsIdentifier(name) {
+				return nil, fmt.Errorf("-key %q: invalid field path %q", spec, part)
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// keyFuncName is the name of the function generated for an input's -key.
+func (in *Input) keyFuncName() string {
+	return "networkLess" + in.typeName()
+}
+
+// resolveKey looks up the types of the fields in the input's Key, then sets KeyFunc to
+// a function that compares two values by those fields, and LessPredicate to a call to
+// it.
+func (in *Input) resolveKey() error {
+	if in.Prefix != "" {
+		return fmt.Errorf("-key can't be used with %s, only with a named type", in.TypeExpr())
+	}
+	fields, err := parseKey(in.Key)
+	if err != nil {
+		return err
+	}
+	typ, pkg, err := in.resolveType()
+	if err != nil {
+		return err
+	}
+
+	name := in.keyFuncName()
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// %s reports whether a sorts before b, ordering by -key '%s'.\n", name, in.Key)
+	fmt.Fprintf(&buf, "func %s(a, b *%s) bool {\n", name, in.TypeExpr())
+
+	for _, field := range fields {
+		cur := typ
+		for _, part := range field.path {
+			obj, _, _ := types.LookupFieldOrMethod(cur, true, pkg, part)
+			v, ok := obj.(*types.Var)
+			if !ok || !v.IsField() {
+				return fmt.Errorf("-key: %s has no field %q", cur, part)
+			}
+			if in.Package != "" && !v.Exported() {
+				return fmt.Errorf("-key: field %q of %s is not exported", part, cur)
+			}
+			cur = v.Type()
+		}
+
+		order, err := orderingOf(cur)
+		if err != nil {
+			return fmt.Errorf("-key: field %s: %w", strings.Join(field.path, "."), err)
+		}
+		if order.kind == orderBytes {
+			in.Imports = append(in.Imports, "bytes")
+		}
+		path := strings.Join(field.path, ".")
+		buf.WriteString(order.keyStmts("a."+path, "b."+path, field.desc))
+	}
+	buf.WriteString("return false\n}\n")
+
+	in.KeyFunc = buf.String()
+	in.LessPredicate = template.Must(template.New("").Parse(name + "(&{{.A}}, &{{.B}})"))
+	return nil
+}
+
+// keyStmts renders the statements for one field of a -key function, which return
+// whether the function's 'a' sorts before its 'b' if x and y are not equal.
+func (o ordering) keyStmts(x, y string, desc bool) string {
+	switch o.kind {
+	case orderOperator:
+		op := "<"
+		if desc {
+			op = ">"
+		}
+		return fmt.Sprintf("if %s != %s {\nreturn %s %s %s\n}\n", x, y, x, op, y)
+
+	case orderCompare:
+		op := "<"
+		if desc {
+			op = ">"
+		}
+		return fmt.Sprintf("if c := %s.%s(%s); c != 0 {\nreturn c %s 0\n}\n", x, o.method, y, op)
+
+	case orderLess:
+		if desc {
+			x, y = y, x
+		}
+		return fmt.Sprintf("if %s.%s(%s) {\nreturn true\n}\nif %s.%s(%s) {\nreturn false\n}\n",
+			x, o.method, y, y, o.method, x)
+
+	case orderBytes:
+		op := "<"
+		if desc {
+			op = ">"
+		}
+		return fmt.Sprintf("if c := bytes.Compare(%s, %s); c != 0 {\nreturn c %s 0\n}\n", x, y, op)
+
+	default:
+		panic(fmt.Errorf("unexpected order kind %d", o.kind))
+	}
+}
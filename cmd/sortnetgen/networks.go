@@ -0,0 +1,221 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/shabbyrobe/sortnet"
+)
+
+// chooseNetworks picks the network behind the sorters for each of the input's sizes,
+// according to its Alg and Prefer.
+//
+// Alg is either "best", which considers every network for the size, the Family of the
+// networks to consider (like "bosenelson" or "senso"), or the name of a network in
+// the registry (like "VanVoorhis16"), which then must be the only size. Of the networks
+// considered, the one with the fewest comparators is chosen if Prefer is "size", and
+// the one with the smallest depth if it is "depth", using the other as a tie-break.
+func (in *Input) chooseNetworks() error {
+	var preferDepth bool
+	switch in.Prefer {
+	case "", "size":
+	case "depth":
+		preferDepth = true
+	default:
+		return fmt.Errorf("-prefer must be 'size' or 'depth', found %q", in.Prefer)
+	}
+
+	alg := strings.ToLower(in.Alg)
+	if alg == "" {
+		alg = "best"
+	}
+	isFamily := alg == "best" || alg == "bosenelson"
+	for _, net := range sortnet.All() {
+		isFamily = isFamily || net.Meta.Family == alg
+	}
+
+	in.Networks = map[int]sortnet.Network{}
+
+	if !isFamily {
+		net, ok := sortnet.Lookup(in.Alg)
+		if !ok {
+			return fmt.Errorf("-alg %q is not 'best', a family of networks, or the name of a network", in.Alg)
+		}
+		for _, sz := range in.Sizes {
+			if sz != net.Size {
+				return fmt.Errorf("-alg %q is a network of size %d, so -size must be %d", in.Alg, net.Size, net.Size)
+			}
+		}
+		in.Networks[net.Size] = net
+		return nil
+	}
+
+	for _, sz := range in.Sizes {
+		var best sortnet.Network
+		var found bool
+		// Bose-Nelson goes first so it wins ties, as it does for sortnet.New:
+		for _, net := range append([]sortnet.Network{sortnet.BoseNelson(sz)}, sortnet.BySize(sz)...) {
+			if alg != "best" && net.Meta.Family != alg {
+				continue
+			}
+			if !found || preferred(net, best, preferDepth) {
+				best, found = net, true
+			}
+		}
+		if !found {
+			return fmt.Errorf("-alg %q has no network of size %d", in.Alg, sz)
+		}
+		in.Networks[sz] = best
+	}
+	return nil
+}
+
+// useNetworks sets the input's Networks to the ones in the file at path (see
+// loadNetworks), and its Sizes to theirs.
+func (in *Input) useNetworks(path string) error {
+	nets, err := loadNetworks(path)
+	if err != nil {
+		return err
+	}
+	in.Networks = map[int]sortnet.Network{}
+	in.Sizes = nil
+	for _, net := range nets {
+		if other, ok := in.Networks[net.Size]; ok {
+			return fmt.Errorf("-network %q has more than one network of size %d: %s and %s", path, net.Size, other.Kind, net.Kind)
+		}
+		in.Networks[net.Size] = net
+		in.Sizes = append(in.Sizes, net.Size)
+	}
+	sort.Ints(in.Sizes)
+	return nil
+}
+
+// preferred reports whether a should be chosen over b.
+func preferred(a, b sortnet.Network, preferDepth bool) bool {
+	if preferDepth {
+		return a.Depth < b.Depth || (a.Depth == b.Depth && len(a.Ops) < len(b.Ops))
+	}
+	return len(a.Ops) < len(b.Ops) || (len(a.Ops) == len(b.Ops) && a.Depth < b.Depth)
+}
+
+// network returns the network chosen for size sz. Inputs that only generate -merge
+// functions don't need the comparators, just the size.
+func (in *Input) network(sz int) sortnet.Network {
+	if net, ok := in.Networks[sz]; ok {
+		return net
+	}
+	return sortnet.New(sz)
+}
+
+// loadedNetworks caches the networks read by loadNetworks, by path, as checking large
+// networks takes a while.
+var loadedNetworks = map[string][]sortnet.Network{}
+
+// loadNetworks reads the networks in the file at path, which is JSON if the name ends
+// in '.json', otherwise text (see parseNetworksText). The networks are checked with
+// sortnet.Verify, which makes sure they sort every input and calculates their depth,
+// but they are not registered, so they are only used by the inputs that ask for them.
+//
+// JSON files hold a single sortnet.Network, or an array of them, for example:
+//
+//	{"Kind": "Mine4", "Ops": [{"From": 0, "To": 1}, {"From": 2, "To": 3}, ...]}
+//
+// Networks without a Kind are named after the file, and the Size is worked out from
+// the comparators if it is missing.
+func loadNetworks(path string) ([]sortnet.Network, error) {
+	if nets, ok := loadedNetworks[path]; ok {
+		return nets, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var nets []sortnet.Network
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		data = bytes.TrimSpace(data)
+		if len(data) > 0 && data[0] == '{' {
+			nets = make([]sortnet.Network, 1)
+			err = json.Unmarshal(data, &nets[0])
+		} else {
+			err = json.Unmarshal(data, &nets)
+		}
+	} else {
+		nets, err = parseNetworksText(string(data))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("-network %q: %w", path, err)
+	}
+	if len(nets) == 0 {
+		return nil, fmt.Errorf("-network %q: no networks found", path)
+	}
+
+	base := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	for i := range nets {
+		net := &nets[i]
+		if net.Kind == "" {
+			net.Kind = base
+			if len(nets) > 1 {
+				net.Kind += fmt.Sprintf("-%d", i+1)
+			}
+		}
+		if net.Size == 0 {
+			for _, op := range net.Ops {
+				net.Size = max(net.Size, op.From+1, op.To+1)
+			}
+		}
+		if net.Meta.Reference == "" {
+			net.Meta.Reference = filepath.ToSlash(path)
+		}
+		if *net, err = sortnet.Verify(*net); err != nil {
+			return nil, fmt.Errorf("-network %q: %w", path, err)
+		}
+	}
+
+	loadedNetworks[path] = nets
+	return nets, nil
+}
+
+var comparatorPattern = regexp.MustCompile(`[(\[]\s*(\d+)\s*,\s*(\d+)\s*[)\]]`)
+
+// parseNetworksText reads networks written as lists of comparators, where each
+// comparator is a pair of indexes like '(0,1)' or '[0,1]', as they are usually
+// published. Blank lines separate networks, so each network's comparators may be
+// spread over several lines, for example one for each layer. Lines starting with '#'
+// are ignored.
+func parseNetworksText(text string) (nets []sortnet.Network, err error) {
+	var cur *sortnet.Network
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			cur = nil
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		matches := comparatorPattern.FindAllStringSubmatch(line, -1)
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("no comparators found in line %q", line)
+		}
+		if cur == nil {
+			nets = append(nets, sortnet.Network{})
+			cur = &nets[len(nets)-1]
+		}
+		for _, match := range matches {
+			from, _ := strconv.Atoi(match[1])
+			to, _ := strconv.Atoi(match[2])
+			cur.Ops = append(cur.Ops, sortnet.CompareAndSwap{From: from, To: to})
+		}
+	}
+	return nets, nil
+}
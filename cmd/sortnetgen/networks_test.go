@@ -0,0 +1,32 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shabbyrobe/sortnet"
+)
+
+func TestLoadNetworksDoesNotRegister(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "TestLoad4.txt")
+	if err := os.WriteFile(path, []byte("[(0,1),(2,3)]\n[(0,2),(1,3)]\n[(1,2)]\n"), 0o666); err != nil {
+		t.Fatal(err)
+	}
+	nets, err := loadNetworks(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nets) != 1 || nets[0].Kind != "TestLoad4" || nets[0].Size != 4 || nets[0].Depth != 3 {
+		t.Fatal(nets)
+	}
+
+	// Otherwise, inputs after the one with -network could choose it:
+	if _, ok := sortnet.Lookup("TestLoad4"); ok {
+		t.Fatal("network should not be registered")
+	}
+	in := Input{Sizes: []int{4}, Alg: "TestLoad4"}
+	if err := in.chooseNetworks(); err == nil {
+		t.Fatal("expected error")
+	}
+}
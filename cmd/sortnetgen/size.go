@@ -31,7 +31,10 @@ func (sz sizeSpec) String() string {
 
 func (sz *sizeSpec) Set(s string) error {
 	sz.orig = s
-	sz.items = sz.items[:0]
+
+	// Inputs that were read before this flag was set again keep the old items, so they
+	// must not be overwritten:
+	sz.items = nil
 
 	sizeSet := make(map[int]bool)
 
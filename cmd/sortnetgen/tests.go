@@ -0,0 +1,366 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/types"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/shabbyrobe/sortnet"
+)
+
+// testsFileName is the name of the file written by -tests for the output file out.
+func testsFileName(out string) string {
+	return strings.TrimSuffix(out, ".go") + "_test.go"
+}
+
+// testsPrefix returns the prefix for the helpers in the file written by -tests, based
+// on the name of the output file, so that more than one of them can be generated in
+// the same package. 'primitive_gen.go' gives 'testPrimitiveGen'.
+func testsPrefix(out string) string {
+	base := strings.TrimSuffix(filepath.Base(out), ".go")
+	words := strings.FieldsFunc(base, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	prefix := "test"
+	for _, word := range words {
+		prefix += ucfirst(word)
+	}
+	return prefix
+}
+
+// testsGen holds the tests generated by -tests for one direction of an input.
+type testsGen struct {
+	Prefix string
+	Name   string // The name of the wrapper, which the tests are named after
+	Type   string
+	Rand   string // Expression for a random value, using 'rng'
+	Less   string // Function literal that reports whether a sorts before b
+	Fwd    bool
+	Cases  []string
+	Bench  bool
+}
+
+// CasesName is the name of the function that returns the cases for the tests.
+func (t testsGen) CasesName() string {
+	return t.Prefix + "Cases" + t.Name
+}
+
+// TestName is the name shared by the Test, Fuzz and Benchmark functions, after their
+// prefix.
+func (t testsGen) TestName() string {
+	return strings.TrimPrefix(t.Prefix, "test") + t.Name
+}
+
+// generateTests renders the file written by -tests, which checks every function
+// generated for inputs with sortnettest, ordering items the same way as the generated
+// functions, and benchmarks the sorters against sort.Slice. out is the name of the
+// file the functions were written to.
+func generateTests(pkg, out string, inputs []Input) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(generatedPreamble)
+	buf.WriteString("\n\n")
+	buf.WriteString(fmt.Sprintf("package %s\n\n", pkg))
+
+	prefix := testsPrefix(out)
+	imported := map[string]bool{"math/rand": true, "testing": true, "github.com/shabbyrobe/sortnet/sortnettest": true}
+	var body bytes.Buffer
+
+	if err := testsHelpersTpl.Execute(&body, prefix); err != nil {
+		return nil, err
+	}
+
+	for _, in := range inputs {
+		rnd, less, imports, err := in.testValues()
+		if err != nil {
+			return nil, err
+		}
+		for _, pkg := range imports {
+			imported[pkg] = true
+		}
+
+		for _, fwd := range []bool{true, false} {
+			if (fwd && !in.Forward) || (!fwd && !in.Reverse) {
+				continue
+			}
+			wg := wrapperGen{Input: in, Forwards: fwd, Exported: in.isExported()}
+			tg := testsGen{
+				Prefix: prefix,
+				Name:   ucfirst(wg.Name()),
+				Type:   in.TypeExpr(),
+				Rand:   rnd,
+				Less:   less,
+				Fwd:    fwd,
+				Bench:  in.Slice || in.Array,
+			}
+			if in.Generic {
+				tg.Type = "int"
+			}
+			for _, sz := range in.Sizes {
+				g := gen{Input: in, Network: in.network(sz), Exported: in.isExported(), Forwards: fwd}
+				tg.Cases = append(tg.Cases, testCases(g, tg.Type)...)
+			}
+			if in.Wrap {
+				for _, sz := range in.Sizes {
+					tg.Cases = append(tg.Cases, fmt.Sprintf(
+						`{name: "%[1]s-%[2]d", size: %[2]d, sort: func(a []%[3]s) { if !%[1]s(a, len(a)%[4]s) { panic("%[1]s: no sorter for size %[2]d") } }}`,
+						wg.Name(), sz, tg.Type, in.Args()))
+				}
+			}
+			if err := testsTpl.Execute(&body, tg); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var imports []string
+	for pkg := range imported {
+		imports = append(imports, pkg)
+	}
+	sort.Strings(imports)
+	for _, pkg := range imports {
+		buf.WriteString(fmt.Sprintf("import %q\n", pkg))
+	}
+
+	buf.Write(body.Bytes())
+	return buf.Bytes(), nil
+}
+
+// testCases renders a case for the tests for each function generated for g, as an
+// element of a composite literal. The sorters for more than one set of items are given
+// a single set: one chunk for -chunks, a stride of 1 for -strided and one lane for
+// -lanes. The way they find their items is covered by gentest.
+func testCases(g gen, typ string) (out []string) {
+	in := g.Input
+	sz := g.Network.Size
+	add := func(name, field, format string, args ...interface{}) {
+		out = append(out, fmt.Sprintf(`{name: %q, size: %d, %s: %s}`, name, sz, field, fmt.Sprintf(format, args...)))
+	}
+
+	if in.Slice {
+		add(g.SliceName(), "sort", "func(a []%s) { %s(a%s) }", typ, g.SliceName(), in.Args())
+	}
+	if in.Array {
+		add(g.ArrayName(), "sort", "func(a []%[1]s) { %[2]s((*[%[3]d]%[1]s)(a)%[4]s) }", typ, g.ArrayName(), sz, in.Args())
+	}
+	if in.Chunks {
+		add(g.ChunksName(), "sort", "func(a []%s) { %s(a%s) }", typ, g.ChunksName(), in.Args())
+	}
+	if in.Strided {
+		add(g.StridedName(), "sort", "func(a []%s) { %s(a, 0, 1%s) }", typ, g.StridedName(), in.Args())
+	}
+	if in.Lanes {
+		add(g.LanesName(), "sort", "func(a []%s) { %s(a, 1%s) }", typ, g.LanesName(), in.Args())
+	}
+	if in.Merge {
+		add(g.MergeName(), "merge", "func(dst []%[1]s, runs [][]%[1]s) []%[1]s { return %[2]s(dst, %[3]s%[4]s) }",
+			typ, g.MergeName(), joinf(sz, "runs[%d]"), in.Args())
+	}
+	return out
+}
+
+// testValues returns the expression the tests for the input use to make a random
+// value, using 'rng', and a function literal that reports whether a sorts before b,
+// along with the packages they need.
+//
+// The comparison uses the input's LessPredicate if it has one, otherwise '<'. If only
+// -less or -greater were given, it compares by sorting an array of b and a with the
+// compare-and-swap, which swaps them if a sorts first.
+func (in *Input) testValues() (rnd, less string, imports []string, err error) {
+	if in.Generic {
+		return "rng.Intn(32) - 16", "func(a, b int) bool { return a < b }", nil, nil
+	}
+
+	typ, pkg, err := in.resolveType()
+	if err != nil {
+		return "", "", nil, err
+	}
+	if in.Package != "" {
+		pkg = nil
+	}
+	r := randExprs{local: pkg, imports: map[string]bool{}}
+	rnd = r.expr(typ, 0)
+	for imp := range r.imports {
+		imports = append(imports, imp)
+	}
+	if in.Package != "" {
+		imports = append(imports, in.Package)
+	}
+
+	switch {
+	case in.LessPredicate != nil:
+		less = fmt.Sprintf("func(a, b %s) bool { return %s }", in.TypeExpr(), predicate(in.LessPredicate, "a", "b"))
+		imports = append(imports, in.Imports...)
+
+	case in.Ordered && (in.GreaterTemplate == defaultCASGreaterTpl || in.GreaterTemplate == branchlessCASGreaterTpl):
+		less = fmt.Sprintf("func(a, b %s) bool { return a < b }", in.TypeExpr())
+
+	default:
+		g := gen{Input: *in, Forwards: true}
+		less = fmt.Sprintf("func(x, y %[1]s) bool {\na := [2]%[1]s{y, x}\n%[2]sreturn !reflect.DeepEqual(a[0], y)\n}",
+			in.TypeExpr(), casf(g, "%d", sortnet.CompareAndSwap{From: 0, To: 1}))
+		imports = append(imports, "reflect")
+	}
+	return rnd, less, imports, nil
+}
+
+// randExprs builds expressions that make random values of a type for the tests
+// generated by -tests. The values are small, so that equal items are common.
+type randExprs struct {
+	local   *types.Package // The package the tests are generated in, if it was loaded
+	imports map[string]bool
+}
+
+func (r *randExprs) qualifier(pkg *types.Package) string {
+	if r.local != nil && pkg.Path() == r.local.Path() {
+		return ""
+	}
+	r.imports[pkg.Path()] = true
+	return pkg.Name()
+}
+
+// expr returns an expression for a random value of t. Types it can't make values of,
+// including structs with fields from other packages that aren't exported, get the
+// zero value for those parts.
+func (r *randExprs) expr(t types.Type, depth int) string {
+	name := types.TypeString(t, r.qualifier)
+
+	switch t.String() {
+	case "time.Time":
+		return "time.Unix(rng.Int63n(32), 0)"
+	case "net/netip.Addr":
+		return "netip.AddrFrom4([4]byte{10, 0, 0, byte(rng.Intn(32))})"
+	case "*math/big.Int":
+		return "big.NewInt(rng.Int63n(32) - 16)"
+	case "*math/big.Float":
+		return "big.NewFloat(float64(rng.Intn(32)-16) / 4)"
+	}
+	if depth > 4 {
+		return "*new(" + name + ")"
+	}
+
+	// conv converts expr, of type kind, to t if it needs to be:
+	conv := func(expr string, kind types.BasicKind) string {
+		if types.Identical(t, types.Typ[kind]) {
+			return expr
+		}
+		return name + "(" + expr + ")"
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		info := u.Info()
+		switch {
+		case info&types.IsUnsigned != 0:
+			return conv("rng.Intn(32)", types.Int)
+		case info&types.IsInteger != 0:
+			return conv("rng.Intn(32) - 16", types.Int)
+		case info&types.IsFloat != 0:
+			return conv("float64(rng.Intn(32)-16) / 4", types.Float64)
+		case info&types.IsString != 0:
+			r.imports["strconv"] = true
+			return conv("strconv.Itoa(rng.Intn(32))", types.String)
+		case info&types.IsBoolean != 0:
+			return conv("rng.Intn(2) == 0", types.Bool)
+		}
+
+	case *types.Slice:
+		if types.Identical(u.Elem(), types.Typ[types.Byte]) {
+			r.imports["strconv"] = true
+			return name + "(strconv.Itoa(rng.Intn(32)))"
+		}
+
+	case *types.Struct:
+		var fields []string
+		for i := 0; i < u.NumFields(); i++ {
+			field := u.Field(i)
+			if !field.Exported() && (r.local == nil || field.Pkg() != r.local) {
+				continue
+			}
+			fields = append(fields, field.Name()+": "+r.expr(field.Type(), depth+1))
+		}
+		return name + "{" + strings.Join(fields, ", ") + "}"
+
+	case *types.Pointer:
+		if _, ok := u.Elem().Underlying().(*types.Struct); ok {
+			return "&" + r.expr(u.Elem(), depth+1)
+		}
+		return fmt.Sprintf("func() %s { v := %s; return &v }()", name, r.expr(u.Elem(), depth+1))
+	}
+
+	return "*new(" + name + ")"
+}
+
+var testsHelpersTpl = template.Must(template.New("").Parse(`
+// {{.}}Case is a function generated by sortnetgen, adapted to be checked by
+// sortnettest. Either sort or merge is set.
+type {{.}}Case[T any] struct {
+	name  string
+	size  int
+	sort  func(a []T)
+	merge func(dst []T, runs [][]T) []T
+}
+`))
+
+var testsTpl = template.Must(template.New("").Parse(`
+// {{.CasesName}} returns the functions generated for {{.Type}}
+// in {{ if .Fwd }}increasing{{ else }}decreasing{{ end }} order, along with how to make random items and how they are ordered.
+func {{.CasesName}}() (gen func(*rand.Rand) {{.Type}}, before func(a, b {{.Type}}) bool, cases []{{.Prefix}}Case[{{.Type}}]) {
+	gen = func(rng *rand.Rand) {{.Type}} { return {{.Rand}} }
+	less := {{.Less}}
+	{{- if .Fwd }}
+	before = less
+	{{- else }}
+	before = func(a, b {{.Type}}) bool { return less(b, a) }
+	{{- end }}
+	cases = []{{.Prefix}}Case[{{.Type}}]{
+		{{- range .Cases }}
+		{{.}},
+		{{- end }}
+	}
+	return gen, before, cases
+}
+
+func Test{{.TestName}}(t *testing.T) {
+	gen, before, cases := {{.CasesName}}()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.merge != nil {
+				sortnettest.CheckMerger(t, c.size, c.merge, gen, before)
+			} else {
+				sortnettest.CheckSorter(t, c.size, c.sort, gen, before)
+			}
+		})
+	}
+}
+
+func Fuzz{{.TestName}}(f *testing.F) {
+	gen, before, cases := {{.CasesName}}()
+	f.Add(int64(0))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		rng := rand.New(rand.NewSource(seed))
+		for _, c := range cases {
+			if c.sort != nil {
+				in := make([]{{.Type}}, c.size)
+				for i := range in {
+					in[i] = gen(rng)
+				}
+				sortnettest.CheckInput(t, c.sort, in, before)
+			}
+		}
+	})
+}
+{{ if .Bench }}
+func Benchmark{{.TestName}}(b *testing.B) {
+	gen, before, cases := {{.CasesName}}()
+	for _, c := range cases {
+		if c.sort != nil {
+			b.Run(c.name, func(b *testing.B) { sortnettest.BenchmarkSorter(b, c.size, c.sort, gen, before) })
+		}
+	}
+}
+{{ end }}`))
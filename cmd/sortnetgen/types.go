@@ -0,0 +1,192 @@
+package main
+
+import (
+	"fmt"
+	"go/types"
+	"text/template"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// loadedPackages caches the packages loaded by loadPackage, by import path, as loading
+// a package runs the go command.
+var loadedPackages = map[string]*packages.Package{}
+
+// loadPackage loads and type-checks the package with the given import path, or the
+// package in the current directory if path is empty (which is where 'go generate'
+// runs sortnetgen). Errors in the package are ignored if its types could still be
+// checked, as the package may not compile until the file being generated is written.
+func loadPackage(path string) (*packages.Package, error) {
+	if pkg, ok := loadedPackages[path]; ok {
+		return pkg, nil
+	}
+
+	pattern := path
+	if pattern == "" {
+		pattern = "."
+	}
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedImports | packages.NeedTypes | packages.NeedSyntax | packages.NeedTypesInfo,
+	}
+	pkgs, err := packages.Load(cfg, pattern)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("expected 1 package for %q, found %d", pattern, len(pkgs))
+	}
+
+	pkg := pkgs[0]
+	if pkg.Types == nil || pkg.Types.Scope() == nil {
+		if len(pkg.Errors) > 0 {
+			return nil, fmt.Errorf("could not load package %q: %v", pattern, pkg.Errors[0])
+		}
+		return nil, fmt.Errorf("could not load package %q", pattern)
+	}
+	loadedPackages[path] = pkg
+	return pkg, nil
+}
+
+// lookupType finds the type named 'name' declared in the package with the given import
+// path (see loadPackage).
+func lookupType(path, name string) (types.Type, *types.Package, error) {
+	pkg, err := loadPackage(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	obj, ok := pkg.Types.Scope().Lookup(name).(*types.TypeName)
+	if !ok {
+		return nil, nil, fmt.Errorf("type %q not found in package %q", name, pkg.PkgPath)
+	}
+	return obj.Type(), pkg.Types, nil
+}
+
+// discoverOrdering looks up the input's type, and configures the input to compare
+// values of it in the way found by orderingOf: with '<' if its underlying type is
+// ordered, otherwise with one of its comparison methods.
+func (in *Input) discoverOrdering() error {
+	typ, _, err := in.resolveType()
+	if err != nil {
+		return err
+	}
+	order, err := orderingOf(typ)
+	if err != nil {
+		return fmt.Errorf("%w; provide -less/-greater, -lessfunc or -key", err)
+	}
+
+	switch order.kind {
+	case orderOperator:
+		in.Ordered = true
+		info := typ.Underlying().(*types.Basic).Info()
+		in.Numeric = info&(types.IsInteger|types.IsFloat) != 0
+		in.Float = info&types.IsFloat != 0
+	case orderCompare:
+		in.LessPredicate = template.Must(template.New("").Parse("{{.A}}." + order.method + "({{.B}}) < 0"))
+	case orderLess:
+		in.LessPredicate = template.Must(template.New("").Parse("{{.A}}." + order.method + "({{.B}})"))
+	case orderBytes:
+		in.LessPredicate = template.Must(template.New("").Parse("bytes.Compare({{.A}}, {{.B}}) < 0"))
+		in.Imports = append(in.Imports, "bytes")
+	}
+	return nil
+}
+
+// resolveType finds the input's type: a type declared in its Package (see
+// loadPackage), or a predeclared type like 'byte' if it has none, with its Prefix
+// applied.
+func (in *Input) resolveType() (typ types.Type, pkg *types.Package, err error) {
+	if obj, ok := types.Universe.Lookup(in.Type).(*types.TypeName); ok && in.Package == "" {
+		typ = obj.Type()
+	} else {
+		typ, pkg, err = lookupType(in.Package, in.Type)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	switch in.Prefix {
+	case "*":
+		typ = types.NewPointer(typ)
+	case "[]":
+		typ = types.NewSlice(typ)
+	}
+	return typ, pkg, nil
+}
+
+// orderKind is the way values of a type are compared to order them.
+type orderKind int
+
+const (
+	orderNone orderKind = iota
+
+	// The type can be compared with the builtin '<' and '>' operators.
+	orderOperator
+
+	// The type has a three-way comparison method, like 'Compare(T) int', which returns
+	// a negative number if the receiver sorts first.
+	orderCompare
+
+	// The type has a method like 'Less(T) bool', which reports whether the receiver
+	// sorts first.
+	orderLess
+
+	// The type is a []byte, which is compared with bytes.Compare.
+	orderBytes
+)
+
+// ordering describes how to order values of a type, as found by orderingOf.
+type ordering struct {
+	kind   orderKind
+	method string // The method for orderCompare and orderLess
+}
+
+// orderMethods are the comparison methods orderingOf looks for, in order of preference.
+var orderMethods = []struct {
+	name   string
+	result types.BasicKind
+	kind   orderKind
+}{
+	{"Compare", types.Int, orderCompare},
+	{"Cmp", types.Int, orderCompare},
+	{"Less", types.Bool, orderLess},
+	{"Before", types.Bool, orderLess},
+}
+
+// orderingOf works out how values of type t can be ordered. Types with an ordered
+// underlying type use '<', and []byte uses bytes.Compare. Otherwise t must have one of
+// the methods in orderMethods, which must take another t. Methods with pointer
+// receivers are accepted, as the generated code always calls them on addressable
+// values.
+func orderingOf(t types.Type) (ordering, error) {
+	if basic, ok := t.Underlying().(*types.Basic); ok && basic.Info()&types.IsOrdered != 0 {
+		return ordering{kind: orderOperator}, nil
+	}
+	if slice, ok := t.(*types.Slice); ok && types.Identical(slice.Elem(), types.Typ[types.Byte]) {
+		return ordering{kind: orderBytes}, nil
+	}
+
+	mset := t
+	if _, ok := t.(*types.Pointer); !ok {
+		mset = types.NewPointer(t)
+	}
+	for _, m := range orderMethods {
+		obj, _, _ := types.LookupFieldOrMethod(mset, false, nil, m.name)
+		fn, ok := obj.(*types.Func)
+		if !ok {
+			continue
+		}
+		sig := fn.Type().(*types.Signature)
+		if sig.Params().Len() != 1 || sig.Results().Len() != 1 || sig.Variadic() {
+			continue
+		}
+		if !types.Identical(sig.Params().At(0).Type(), t) {
+			continue
+		}
+		if res, ok := sig.Results().At(0).Type().(*types.Basic); !ok || res.Kind() != m.result {
+			continue
+		}
+		return ordering{kind: m.kind, method: m.name}, nil
+	}
+
+	return ordering{}, fmt.Errorf("type %s can't be compared with '<', and has no Compare, Cmp, Less or Before method", t)
+}
@@ -0,0 +1,58 @@
+package sortnet
+
+import "cmp"
+
+//go:generate go run gencompiled.go
+
+// Compile converts net into a function that sorts a slice of exactly net.Size items
+// in place.
+//
+// Only the networks returned by New() for sizes 2 to 32 are specialised: for those,
+// Compile returns an unrolled sorter equivalent to the code generated by `sortnetgen`.
+// Go can't generate code at runtime, so any other network gets a sorter that walks a
+// flattened copy of the comparators, which performs about the same as
+// Network.SortInts. Generate code for those networks with `sortnetgen -network`
+// instead if their speed matters.
+//
+// The returned function panics if the input is shorter than net.Size.
+func Compile[T cmp.Ordered](net Network) func([]T) {
+	if net.Size < len(compiledOps) && opsEqual(compiledOps[net.Size], net.Ops) {
+		if fn := compiledSorter[T](net.Size); fn != nil {
+			return fn
+		}
+	}
+
+	size := net.Size
+	if size <= 1 {
+		return func([]T) {}
+	}
+
+	// Flattening the comparators into pairs of indexes avoids loading each
+	// CompareAndSwap struct from the network on every call:
+	idx := make([]int, 0, len(net.Ops)*2)
+	for _, op := range net.Ops {
+		idx = append(idx, op.From, op.To)
+	}
+
+	return func(vs []T) {
+		vs = vs[:size:size]
+		for i := 0; i+1 < len(idx); i += 2 {
+			from, to := idx[i], idx[i+1]
+			if vs[from] > vs[to] {
+				vs[from], vs[to] = vs[to], vs[from]
+			}
+		}
+	}
+}
+
+func opsEqual(a, b []CompareAndSwap) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,70 @@
+package sortnet
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestCompile(t *testing.T) {
+	var networks []Network
+	for i := 1; i < 32; i++ {
+		networks = append(networks, New(i))
+	}
+	networks = append(networks, Optimized...)
+
+	rng := rand.New(rand.NewSource(0))
+
+	for _, net := range networks {
+		t.Run(fmt.Sprintf("%s(%d)", net.Kind, net.Size), func(t *testing.T) {
+			sorter := Compile[int](net)
+			for repeat := 0; repeat < 1000; repeat++ {
+				vs := make([]int, net.Size)
+				for i := range vs {
+					vs[i] = rng.Intn(1024)
+				}
+				exp := make([]int, net.Size)
+				copy(exp, vs)
+				sort.Ints(exp)
+
+				sorter(vs)
+				if !reflect.DeepEqual(exp, vs) {
+					t.Fatal(sortDiffMsg(net, exp, vs))
+				}
+			}
+		})
+	}
+}
+
+func TestCompiledUpToDate(t *testing.T) {
+	for sz := 2; sz < len(compiledOps); sz++ {
+		if !opsEqual(compiledOps[sz], New(sz).Ops) {
+			t.Fatalf("compiled_gen.go is out of date for size %d; run 'go generate'", sz)
+		}
+	}
+}
+
+func BenchmarkCompile(b *testing.B) {
+	rng := rand.New(rand.NewSource(0))
+	ints := newRandInts(rng, 10000000, 1024)
+
+	for _, net := range []Network{New(9), New(16), VanVoorhis16, New(32)} {
+		name := fmt.Sprintf("%s(%d)", net.Kind, net.Size)
+		b.Run("compiled-"+name, func(b *testing.B) {
+			sorter := Compile[int](net)
+			ints.Reset(b)
+			for i := 0; i < b.N; i++ {
+				sorter(ints.Take(b, net.Size))
+			}
+		})
+
+		b.Run("direct-"+name, func(b *testing.B) {
+			ints.Reset(b)
+			for i := 0; i < b.N; i++ {
+				net.SortInts(ints.Take(b, net.Size))
+			}
+		})
+	}
+}
@@ -0,0 +1,8774 @@
+// Code generated by 'go run gencompiled.go'. DO NOT EDIT.
+
+package sortnet
+
+import "cmp"
+
+// compiledOps contains the comparators each of the unrolled sorters in this file
+// was generated from, indexed by size.
+var compiledOps = [...][]CompareAndSwap{
+	2: {
+		{0, 1},
+	},
+	3: {
+		{1, 2}, {0, 2}, {0, 1},
+	},
+	4: {
+		{0, 1}, {2, 3}, {0, 2}, {1, 3}, {1, 2},
+	},
+	5: {
+		{0, 1}, {3, 4}, {2, 4}, {2, 3}, {0, 3}, {0, 2}, {1, 4}, {1, 3},
+		{1, 2},
+	},
+	6: {
+		{1, 2}, {0, 2}, {0, 1}, {4, 5}, {3, 5}, {3, 4}, {0, 4}, {0, 3},
+		{1, 5}, {2, 5}, {1, 3}, {2, 4}, {2, 3},
+	},
+	7: {
+		{1, 2}, {0, 2}, {0, 1}, {3, 4}, {5, 6}, {3, 5}, {4, 6}, {4, 5},
+		{0, 4}, {0, 3}, {1, 5}, {2, 6}, {2, 5}, {1, 3}, {2, 4}, {2, 3},
+	},
+	8: {
+		{0, 1}, {2, 3}, {0, 2}, {1, 3}, {1, 2}, {4, 5}, {6, 7}, {4, 6},
+		{5, 7}, {5, 6}, {0, 4}, {1, 5}, {1, 4}, {2, 6}, {3, 7}, {3, 6},
+		{2, 4}, {3, 5}, {3, 4},
+	},
+	9: {
+		{2, 6}, {0, 5}, {1, 4}, {7, 8}, {0, 7}, {1, 2}, {3, 5}, {4, 6},
+		{5, 8}, {1, 3}, {6, 8}, {0, 1}, {4, 5}, {2, 7}, {3, 7}, {3, 4},
+		{5, 6}, {1, 2}, {1, 3}, {6, 7}, {4, 5}, {2, 4}, {5, 6}, {2, 3},
+		{4, 5},
+	},
+	10: {
+		{1, 4}, {7, 8}, {2, 3}, {5, 6}, {0, 9}, {2, 5}, {0, 7}, {8, 9},
+		{3, 6}, {4, 9}, {0, 1}, {0, 2}, {6, 9}, {3, 5}, {4, 7}, {1, 8},
+		{3, 4}, {5, 8}, {6, 7}, {1, 2}, {7, 8}, {1, 3}, {2, 5}, {4, 6},
+		{2, 3}, {6, 7}, {4, 5}, {3, 4}, {5, 6},
+	},
+	11: {
+		{0, 1}, {2, 3}, {4, 5}, {6, 7}, {8, 9}, {1, 3}, {5, 7}, {0, 2},
+		{4, 6}, {8, 10}, {1, 2}, {5, 6}, {9, 10}, {1, 5}, {6, 10}, {5, 9},
+		{2, 6}, {1, 5}, {6, 10}, {0, 4}, {3, 7}, {4, 8}, {0, 4}, {1, 4},
+		{7, 10}, {3, 8}, {2, 3}, {8, 9}, {2, 4}, {7, 9}, {3, 5}, {6, 8},
+		{3, 4}, {5, 6}, {7, 8},
+	},
+	12: {
+		{0, 1}, {2, 3}, {4, 5}, {6, 7}, {8, 9}, {10, 11}, {1, 3}, {5, 7},
+		{9, 11}, {0, 2}, {4, 6}, {8, 10}, {1, 2}, {5, 6}, {9, 10}, {1, 5},
+		{6, 10}, {5, 9}, {2, 6}, {1, 5}, {6, 10}, {0, 4}, {7, 11}, {3, 7},
+		{4, 8}, {0, 4}, {7, 11}, {1, 4}, {7, 10}, {3, 8}, {2, 3}, {8, 9},
+		{2, 4}, {7, 9}, {3, 5}, {6, 8}, {3, 4}, {5, 6}, {7, 8},
+	},
+	13: {
+		{1, 7}, {9, 11}, {3, 4}, {5, 8}, {0, 12}, {2, 6}, {0, 1}, {2, 3},
+		{4, 6}, {8, 11}, {7, 12}, {5, 9}, {0, 2}, {3, 7}, {10, 11}, {1, 4},
+		{6, 12}, {7, 8}, {11, 12}, {4, 9}, {6, 10}, {3, 4}, {5, 6}, {8, 9},
+		{10, 11}, {1, 7}, {2, 6}, {9, 11}, {1, 3}, {4, 7}, {8, 10}, {0, 5},
+		{2, 5}, {6, 8}, {9, 10}, {1, 2}, {3, 5}, {7, 8}, {4, 6}, {2, 3},
+		{4, 5}, {6, 7}, {8, 9}, {3, 4}, {5, 6},
+	},
+	14: {
+		{0, 1}, {2, 3}, {4, 5}, {6, 7}, {8, 9}, {10, 11}, {12, 13}, {0, 2},
+		{4, 6}, {8, 10}, {1, 3}, {5, 7}, {9, 11}, {0, 4}, {8, 12}, {1, 5},
+		{9, 13}, {2, 6}, {3, 7}, {0, 8}, {1, 9}, {2, 10}, {3, 11}, {4, 12},
+		{5, 13}, {5, 10}, {6, 9}, {3, 12}, {7, 11}, {1, 2}, {4, 8}, {1, 4},
+		{7, 13}, {2, 8}, {2, 4}, {5, 6}, {9, 10}, {11, 13}, {3, 8}, {7, 12},
+		{6, 8}, {10, 12}, {3, 5}, {7, 9}, {3, 4}, {5, 6}, {7, 8}, {9, 10},
+		{11, 12}, {6, 7}, {8, 9},
+	},
+	15: {
+		{0, 1}, {2, 3}, {4, 5}, {6, 7}, {8, 9}, {10, 11}, {12, 13}, {0, 2},
+		{4, 6}, {8, 10}, {12, 14}, {1, 3}, {5, 7}, {9, 11}, {0, 4}, {8, 12},
+		{1, 5}, {9, 13}, {2, 6}, {10, 14}, {3, 7}, {0, 8}, {1, 9}, {2, 10},
+		{3, 11}, {4, 12}, {5, 13}, {6, 14}, {5, 10}, {6, 9}, {3, 12}, {13, 14},
+		{7, 11}, {1, 2}, {4, 8}, {1, 4}, {7, 13}, {2, 8}, {11, 14}, {2, 4},
+		{5, 6}, {9, 10}, {11, 13}, {3, 8}, {7, 12}, {6, 8}, {10, 12}, {3, 5},
+		{7, 9}, {3, 4}, {5, 6}, {7, 8}, {9, 10}, {11, 12}, {6, 7}, {8, 9},
+	},
+	16: {
+		{0, 1}, {2, 3}, {4, 5}, {6, 7}, {8, 9}, {10, 11}, {12, 13}, {14, 15},
+		{0, 2}, {4, 6}, {8, 10}, {12, 14}, {1, 3}, {5, 7}, {9, 11}, {13, 15},
+		{0, 4}, {8, 12}, {1, 5}, {9, 13}, {2, 6}, {10, 14}, {3, 7}, {11, 15},
+		{0, 8}, {1, 9}, {2, 10}, {3, 11}, {4, 12}, {5, 13}, {6, 14}, {7, 15},
+		{5, 10}, {6, 9}, {3, 12}, {13, 14}, {7, 11}, {1, 2}, {4, 8}, {1, 4},
+		{7, 13}, {2, 8}, {11, 14}, {2, 4}, {5, 6}, {9, 10}, {11, 13}, {3, 8},
+		{7, 12}, {6, 8}, {10, 12}, {3, 5}, {7, 9}, {3, 4}, {5, 6}, {7, 8},
+		{9, 10}, {11, 12}, {6, 7}, {8, 9},
+	},
+	17: {
+		{5, 11}, {4, 9}, {7, 12}, {0, 14}, {2, 16}, {1, 15}, {3, 8}, {6, 13},
+		{3, 10}, {8, 13}, {4, 7}, {9, 12}, {0, 2}, {14, 16}, {1, 6}, {10, 15},
+		{3, 5}, {11, 13}, {0, 4}, {12, 16}, {1, 3}, {13, 15}, {0, 1}, {15, 16},
+		{2, 9}, {7, 14}, {5, 10}, {6, 11}, {5, 7}, {6, 8}, {8, 10}, {2, 3},
+		{8, 14}, {9, 11}, {12, 13}, {4, 6}, {10, 14}, {4, 5}, {7, 9}, {11, 13},
+		{1, 2}, {14, 15}, {1, 8}, {13, 15}, {1, 4}, {2, 5}, {11, 14}, {13, 14},
+		{2, 4}, {6, 12}, {9, 12}, {3, 10}, {3, 8}, {6, 7}, {10, 12}, {3, 6},
+		{3, 4}, {12, 13}, {10, 11}, {5, 6}, {11, 12}, {4, 5}, {7, 8}, {8, 9},
+		{6, 8}, {9, 11}, {5, 7}, {6, 7}, {9, 10}, {8, 9}, {7, 8},
+	},
+	18: {
+		{4, 12}, {5, 13}, {0, 7}, {10, 17}, {2, 3}, {14, 15}, {6, 8}, {9, 11},
+		{1, 16}, {2, 6}, {11, 15}, {1, 9}, {8, 16}, {4, 10}, {7, 13}, {3, 12},
+		{5, 14}, {0, 2}, {15, 17}, {1, 4}, {13, 16}, {0, 5}, {12, 17}, {0, 1},
+		{16, 17}, {3, 7}, {10, 14}, {6, 9}, {8, 11}, {2, 15}, {3, 8}, {9, 14},
+		{4, 5}, {12, 13}, {6, 10}, {2, 6}, {7, 11}, {1, 4}, {13, 16}, {14, 15},
+		{2, 3}, {11, 15}, {15, 16}, {1, 2}, {11, 14}, {3, 6}, {13, 14}, {3, 4},
+		{14, 15}, {2, 3}, {5, 6}, {11, 12}, {7, 9}, {8, 10}, {9, 10}, {7, 8},
+		{5, 11}, {6, 12}, {10, 12}, {5, 7}, {12, 14}, {3, 5}, {10, 13}, {4, 7},
+		{12, 13}, {4, 5}, {8, 9}, {6, 9}, {8, 11}, {9, 12}, {5, 8}, {6, 7},
+		{10, 11}, {6, 8}, {9, 11}, {7, 10}, {9, 10}, {7, 8},
+	},
+	19: {
+		{4, 10}, {3, 12}, {0, 16}, {7, 14}, {8, 11}, {6, 13}, {15, 17}, {1, 5},
+		{9, 18}, {2, 5}, {11, 16}, {7, 9}, {1, 2}, {6, 15}, {10, 12}, {3, 4},
+		{13, 17}, {0, 8}, {14, 18}, {5, 16}, {3, 7}, {17, 18}, {1, 6}, {4, 15},
+		{0, 1}, {12, 16}, {0, 3}, {16, 18}, {2, 11}, {9, 10}, {13, 14}, {6, 8},
+		{7, 13}, {2, 9}, {11, 15}, {1, 7}, {5, 10}, {12, 17}, {8, 14}, {4, 6},
+		{10, 14}, {3, 4}, {15, 16}, {1, 2}, {14, 17}, {1, 3}, {16, 17}, {5, 7},
+		{6, 13}, {5, 6}, {10, 15}, {2, 4}, {14, 15}, {2, 5}, {11, 12}, {15, 16},
+		{2, 3}, {8, 9}, {7, 13}, {9, 12}, {8, 11}, {9, 10}, {13, 14}, {5, 8},
+		{12, 14}, {14, 15}, {3, 5}, {4, 6}, {10, 13}, {4, 8}, {4, 5}, {13, 14},
+		{7, 11}, {6, 11}, {6, 9}, {7, 8}, {11, 12}, {6, 7}, {12, 13}, {5, 6},
+		{9, 10}, {10, 11}, {11, 12}, {8, 9}, {7, 8}, {9, 10},
+	},
+	20: {
+		{2, 11}, {8, 17}, {0, 10}, {9, 19}, {4, 5}, {14, 15}, {3, 6}, {13, 16},
+		{1, 12}, {7, 18}, {3, 14}, {5, 16}, {0, 1}, {18, 19}, {4, 13}, {6, 15},
+		{7, 9}, {10, 12}, {2, 8}, {11, 17}, {4, 7}, {12, 15}, {0, 3}, {16, 19},
+		{0, 2}, {17, 19}, {0, 4}, {15, 19}, {1, 14}, {5, 18}, {8, 10}, {9, 11},
+		{6, 13}, {5, 9}, {10, 14}, {1, 3}, {16, 18}, {6, 8}, {11, 13}, {2, 7},
+		{12, 17}, {1, 5}, {1, 2}, {14, 18}, {4, 6}, {13, 15}, {17, 18}, {15, 18},
+		{1, 4}, {3, 9}, {10, 16}, {2, 3}, {16, 17}, {13, 17}, {2, 6}, {15, 17},
+		{2, 4}, {7, 8}, {11, 12}, {5, 10}, {9, 14}, {8, 12}, {7, 11}, {3, 7},
+		{12, 16}, {3, 5}, {14, 16}, {15, 16}, {3, 4}, {5, 6}, {13, 14}, {14, 15},
+		{4, 5}, {10, 11}, {8, 9}, {11, 12}, {7, 8}, {7, 10}, {9, 12}, {5, 7},
+		{12, 14}, {9, 13}, {6, 10}, {6, 7}, {10, 11}, {12, 13}, {8, 9}, {9, 11},
+		{11, 12}, {8, 10}, {7, 8}, {9, 10},
+	},
+	21: {
+		{5, 9}, {11, 15}, {1, 19}, {2, 14}, {6, 18}, {0, 17}, {3, 20}, {4, 8},
+		{12, 16}, {7, 13}, {1, 7}, {13, 19}, {2, 11}, {9, 18}, {4, 12}, {8, 16},
+		{3, 5}, {15, 17}, {0, 10}, {10, 20}, {0, 6}, {14, 20}, {2, 3}, {17, 18},
+		{1, 4}, {16, 19}, {0, 1}, {19, 20}, {0, 2}, {18, 20}, {7, 8}, {12, 13},
+		{9, 10}, {4, 11}, {5, 6}, {14, 15}, {10, 11}, {5, 12}, {8, 15}, {6, 13},
+		{7, 14}, {16, 17}, {1, 3}, {4, 9}, {5, 7}, {13, 15}, {11, 18}, {17, 19},
+		{1, 2}, {18, 19}, {4, 5}, {1, 4}, {15, 19}, {13, 17}, {2, 7}, {11, 17},
+		{9, 14}, {4, 5}, {15, 18}, {17, 18}, {2, 4}, {6, 10}, {8, 16}, {3, 12},
+		{10, 14}, {12, 16}, {3, 8}, {6, 9}, {14, 16}, {8, 12}, {3, 6}, {4, 5},
+		{15, 16}, {16, 17}, {3, 4}, {11, 13}, {5, 7}, {13, 15}, {6, 7}, {15, 16},
+		{4, 5}, {10, 11}, {9, 11}, {8, 9}, {11, 12}, {12, 14}, {8, 10}, {6, 8},
+		{14, 15}, {5, 6}, {12, 13}, {13, 14}, {6, 8}, {7, 9}, {10, 11}, {7, 10},
+		{7, 8}, {9, 13}, {11, 12}, {9, 12}, {9, 11}, {9, 10},
+	},
+	22: {
+		{10, 11}, {2, 8}, {13, 19}, {3, 15}, {6, 18}, {1, 16}, {5, 20}, {0, 17},
+		{4, 21}, {7, 9}, {12, 14}, {0, 4}, {17, 21}, {3, 12}, {9, 18}, {1, 2},
+		{19, 20}, {7, 13}, {8, 14}, {5, 6}, {15, 16}, {5, 7}, {14, 16}, {1, 10},
+		{11, 20}, {0, 3}, {18, 21}, {0, 5}, {16, 21}, {0, 1}, {20, 21}, {6, 8},
+		{13, 15}, {2, 4}, {17, 19}, {9, 11}, {10, 12}, {2, 7}, {14, 19}, {3, 9},
+		{12, 18}, {6, 13}, {8, 15}, {4, 11}, {10, 17}, {5, 10}, {11, 16}, {3, 6},
+		{15, 18}, {1, 2}, {19, 20}, {1, 3}, {18, 20}, {1, 5}, {16, 20}, {2, 6},
+		{15, 19}, {11, 18}, {2, 5}, {16, 19}, {3, 10}, {2, 3}, {18, 19}, {9, 12},
+		{4, 14}, {7, 17}, {8, 13}, {12, 17}, {4, 9}, {13, 14}, {7, 8}, {4, 7},
+		{14, 17}, {4, 5}, {16, 17}, {17, 18}, {3, 4}, {6, 10}, {11, 15}, {5, 6},
+		{15, 16}, {4, 5}, {16, 17}, {9, 12}, {8, 13}, {10, 13}, {8, 11}, {7, 9},
+		{12, 14}, {7, 8}, {13, 14}, {14, 16}, {5, 7}, {9, 10}, {11, 12}, {6, 9},
+		{12, 15}, {14, 15}, {6, 7}, {8, 11}, {10, 13}, {8, 9}, {12, 13}, {7, 8},
+		{13, 14}, {10, 11}, {11, 12}, {9, 10},
+	},
+	23: {
+		{0, 1}, {2, 3}, {4, 5}, {6, 7}, {8, 9}, {10, 11}, {12, 13}, {14, 15},
+		{16, 17}, {18, 19}, {20, 21}, {1, 3}, {5, 7}, {9, 11}, {0, 2}, {4, 6},
+		{8, 10}, {13, 15}, {17, 19}, {12, 14}, {16, 18}, {20, 22}, {1, 2}, {5, 6},
+		{9, 10}, {13, 14}, {17, 18}, {21, 22}, {1, 5}, {6, 10}, {13, 17}, {18, 22},
+		{5, 9}, {2, 6}, {17, 21}, {14, 18}, {1, 5}, {6, 10}, {0, 4}, {7, 11},
+		{13, 17}, {18, 22}, {12, 16}, {3, 7}, {4, 8}, {15, 19}, {16, 20}, {0, 4},
+		{7, 11}, {12, 16}, {1, 4}, {7, 10}, {3, 8}, {13, 16}, {19, 22}, {15, 20},
+		{2, 3}, {8, 9}, {14, 15}, {20, 21}, {2, 4}, {7, 9}, {3, 5}, {6, 8},
+		{14, 16}, {19, 21}, {15, 17}, {18, 20}, {3, 4}, {5, 6}, {7, 8}, {15, 16},
+		{17, 18}, {19, 20}, {0, 12}, {1, 13}, {2, 14}, {3, 15}, {4, 16}, {5, 17},
+		{6, 18}, {7, 19}, {8, 20}, {9, 21}, {10, 22}, {2, 12}, {3, 13}, {10, 20},
+		{11, 21}, {4, 12}, {5, 13}, {6, 14}, {7, 15}, {8, 16}, {9, 17}, {10, 18},
+		{11, 19}, {8, 12}, {9, 13}, {10, 14}, {11, 15}, {6, 8}, {10, 12}, {14, 16},
+		{7, 9}, {11, 13}, {15, 17}, {1, 2}, {3, 4}, {5, 6}, {7, 8}, {9, 10},
+		{11, 12}, {13, 14}, {15, 16}, {17, 18}, {19, 20}, {21, 22},
+	},
+	24: {
+		{0, 1}, {2, 3}, {4, 5}, {6, 7}, {8, 9}, {10, 11}, {12, 13}, {14, 15},
+		{16, 17}, {18, 19}, {20, 21}, {22, 23}, {1, 3}, {5, 7}, {9, 11}, {0, 2},
+		{4, 6}, {8, 10}, {13, 15}, {17, 19}, {21, 23}, {12, 14}, {16, 18}, {20, 22},
+		{1, 2}, {5, 6}, {9, 10}, {13, 14}, {17, 18}, {21, 22}, {1, 5}, {6, 10},
+		{13, 17}, {18, 22}, {5, 9}, {2, 6}, {17, 21}, {14, 18}, {1, 5}, {6, 10},
+		{0, 4}, {7, 11}, {13, 17}, {18, 22}, {12, 16}, {19, 23}, {3, 7}, {4, 8},
+		{15, 19}, {16, 20}, {0, 4}, {7, 11}, {12, 16}, {19, 23}, {1, 4}, {7, 10},
+		{3, 8}, {13, 16}, {19, 22}, {15, 20}, {2, 3}, {8, 9}, {14, 15}, {20, 21},
+		{2, 4}, {7, 9}, {3, 5}, {6, 8}, {14, 16}, {19, 21}, {15, 17}, {18, 20},
+		{3, 4}, {5, 6}, {7, 8}, {15, 16}, {17, 18}, {19, 20}, {0, 12}, {1, 13},
+		{2, 14}, {3, 15}, {4, 16}, {5, 17}, {6, 18}, {7, 19}, {8, 20}, {9, 21},
+		{10, 22}, {11, 23}, {2, 12}, {3, 13}, {10, 20}, {11, 21}, {4, 12}, {5, 13},
+		{6, 14}, {7, 15}, {8, 16}, {9, 17}, {10, 18}, {11, 19}, {8, 12}, {9, 13},
+		{10, 14}, {11, 15}, {6, 8}, {10, 12}, {14, 16}, {7, 9}, {11, 13}, {15, 17},
+		{1, 2}, {3, 4}, {5, 6}, {7, 8}, {9, 10}, {11, 12}, {13, 14}, {15, 16},
+		{17, 18}, {19, 20}, {21, 22},
+	},
+	25: {
+		{1, 2}, {0, 2}, {0, 1}, {4, 5}, {3, 5}, {3, 4}, {0, 4}, {0, 3},
+		{1, 5}, {2, 5}, {1, 3}, {2, 4}, {2, 3}, {7, 8}, {6, 8}, {6, 7},
+		{10, 11}, {9, 11}, {9, 10}, {6, 10}, {6, 9}, {7, 11}, {8, 11}, {7, 9},
+		{8, 10}, {8, 9}, {0, 7}, {0, 6}, {1, 8}, {2, 8}, {1, 6}, {2, 7},
+		{2, 6}, {3, 10}, {3, 9}, {4, 11}, {5, 11}, {4, 9}, {5, 10}, {5, 9},
+		{3, 7}, {3, 6}, {4, 8}, {5, 8}, {4, 6}, {5, 7}, {5, 6}, {13, 14},
+		{12, 14}, {12, 13}, {16, 17}, {15, 17}, {15, 16}, {12, 16}, {12, 15}, {13, 17},
+		{14, 17}, {13, 15}, {14, 16}, {14, 15}, {19, 20}, {18, 20}, {18, 19}, {21, 22},
+		{23, 24}, {21, 23}, {22, 24}, {22, 23}, {18, 22}, {18, 21}, {19, 23}, {20, 24},
+		{20, 23}, {19, 21}, {20, 22}, {20, 21}, {12, 19}, {12, 18}, {13, 20}, {14, 21},
+		{14, 20}, {13, 18}, {14, 19}, {14, 18}, {15, 23}, {15, 22}, {16, 24}, {17, 24},
+		{16, 22}, {17, 23}, {17, 22}, {15, 19}, {15, 18}, {16, 20}, {17, 21}, {17, 20},
+		{16, 18}, {17, 19}, {17, 18}, {0, 13}, {0, 12}, {1, 14}, {2, 15}, {2, 14},
+		{1, 12}, {2, 13}, {2, 12}, {3, 17}, {3, 16}, {4, 18}, {5, 18}, {4, 16},
+		{5, 17}, {5, 16}, {3, 13}, {3, 12}, {4, 14}, {5, 15}, {5, 14}, {4, 12},
+		{5, 13}, {5, 12}, {6, 20}, {6, 19}, {7, 21}, {8, 21}, {7, 19}, {8, 20},
+		{8, 19}, {9, 23}, {9, 22}, {10, 24}, {11, 24}, {10, 22}, {11, 23}, {11, 22},
+		{9, 20}, {9, 19}, {10, 21}, {11, 21}, {10, 19}, {11, 20}, {11, 19}, {6, 13},
+		{6, 12}, {7, 14}, {8, 15}, {8, 14}, {7, 12}, {8, 13}, {8, 12}, {9, 17},
+		{9, 16}, {10, 18}, {11, 18}, {10, 16}, {11, 17}, {11, 16}, {9, 13}, {9, 12},
+		{10, 14}, {11, 15}, {11, 14}, {10, 12}, {11, 13}, {11, 12},
+	},
+	26: {
+		{1, 2}, {0, 2}, {0, 1}, {4, 5}, {3, 5}, {3, 4}, {0, 4}, {0, 3},
+		{1, 5}, {2, 5}, {1, 3}, {2, 4}, {2, 3}, {7, 8}, {6, 8}, {6, 7},
+		{9, 10}, {11, 12}, {9, 11}, {10, 12}, {10, 11}, {6, 10}, {6, 9}, {7, 11},
+		{8, 12}, {8, 11}, {7, 9}, {8, 10}, {8, 9}, {0, 7}, {0, 6}, {1, 8},
+		{2, 9}, {2, 8}, {1, 6}, {2, 7}, {2, 6}, {3, 11}, {3, 10}, {4, 12},
+		{5, 12}, {4, 10}, {5, 11}, {5, 10}, {3, 7}, {3, 6}, {4, 8}, {5, 9},
+		{5, 8}, {4, 6}, {5, 7}, {5, 6}, {14, 15}, {13, 15}, {13, 14}, {17, 18},
+		{16, 18}, {16, 17}, {13, 17}, {13, 16}, {14, 18}, {15, 18}, {14, 16}, {15, 17},
+		{15, 16}, {20, 21}, {19, 21}, {19, 20}, {22, 23}, {24, 25}, {22, 24}, {23, 25},
+		{23, 24}, {19, 23}, {19, 22}, {20, 24}, {21, 25}, {21, 24}, {20, 22}, {21, 23},
+		{21, 22}, {13, 20}, {13, 19}, {14, 21}, {15, 22}, {15, 21}, {14, 19}, {15, 20},
+		{15, 19}, {16, 24}, {16, 23}, {17, 25}, {18, 25}, {17, 23}, {18, 24}, {18, 23},
+		{16, 20}, {16, 19}, {17, 21}, {18, 22}, {18, 21}, {17, 19}, {18, 20}, {18, 19},
+		{0, 14}, {0, 13}, {1, 15}, {2, 16}, {2, 15}, {1, 13}, {2, 14}, {2, 13},
+		{3, 18}, {3, 17}, {4, 19}, {5, 19}, {4, 17}, {5, 18}, {5, 17}, {3, 14},
+		{3, 13}, {4, 15}, {5, 16}, {5, 15}, {4, 13}, {5, 14}, {5, 13}, {6, 21},
+		{6, 20}, {7, 22}, {8, 22}, {7, 20}, {8, 21}, {8, 20}, {9, 23}, {10, 24},
+		{10, 23}, {11, 25}, {12, 25}, {11, 23}, {12, 24}, {12, 23}, {9, 20}, {10, 21},
+		{10, 20}, {11, 22}, {12, 22}, {11, 20}, {12, 21}, {12, 20}, {6, 14}, {6, 13},
+		{7, 15}, {8, 16}, {8, 15}, {7, 13}, {8, 14}, {8, 13}, {9, 17}, {10, 18},
+		{10, 17}, {11, 19}, {12, 19}, {11, 17}, {12, 18}, {12, 17}, {9, 13}, {10, 14},
+		{10, 13}, {11, 15}, {12, 16}, {12, 15}, {11, 13}, {12, 14}, {12, 13},
+	},
+	27: {
+		{1, 2}, {0, 2}, {0, 1}, {4, 5}, {3, 5}, {3, 4}, {0, 4}, {0, 3},
+		{1, 5}, {2, 5}, {1, 3}, {2, 4}, {2, 3}, {7, 8}, {6, 8}, {6, 7},
+		{9, 10}, {11, 12}, {9, 11}, {10, 12}, {10, 11}, {6, 10}, {6, 9}, {7, 11},
+		{8, 12}, {8, 11}, {7, 9}, {8, 10}, {8, 9}, {0, 7}, {0, 6}, {1, 8},
+		{2, 9}, {2, 8}, {1, 6}, {2, 7}, {2, 6}, {3, 11}, {3, 10}, {4, 12},
+		{5, 12}, {4, 10}, {5, 11}, {5, 10}, {3, 7}, {3, 6}, {4, 8}, {5, 9},
+		{5, 8}, {4, 6}, {5, 7}, {5, 6}, {14, 15}, {13, 15}, {13, 14}, {16, 17},
+		{18, 19}, {16, 18}, {17, 19}, {17, 18}, {13, 17}, {13, 16}, {14, 18}, {15, 19},
+		{15, 18}, {14, 16}, {15, 17}, {15, 16}, {21, 22}, {20, 22}, {20, 21}, {23, 24},
+		{25, 26}, {23, 25}, {24, 26}, {24, 25}, {20, 24}, {20, 23}, {21, 25}, {22, 26},
+		{22, 25}, {21, 23}, {22, 24}, {22, 23}, {13, 21}, {13, 20}, {14, 22}, {15, 23},
+		{15, 22}, {14, 20}, {15, 21}, {15, 20}, {16, 24}, {17, 25}, {17, 24}, {18, 26},
+		{19, 26}, {18, 24}, {19, 25}, {19, 24}, {16, 20}, {17, 21}, {17, 20}, {18, 22},
+		{19, 23}, {19, 22}, {18, 20}, {19, 21}, {19, 20}, {0, 14}, {0, 13}, {1, 15},
+		{2, 16}, {2, 15}, {1, 13}, {2, 14}, {2, 13}, {3, 18}, {3, 17}, {4, 19},
+		{5, 19}, {4, 17}, {5, 18}, {5, 17}, {3, 14}, {3, 13}, {4, 15}, {5, 16},
+		{5, 15}, {4, 13}, {5, 14}, {5, 13}, {6, 21}, {6, 20}, {7, 22}, {8, 23},
+		{8, 22}, {7, 20}, {8, 21}, {8, 20}, {9, 24}, {10, 25}, {10, 24}, {11, 26},
+		{12, 26}, {11, 24}, {12, 25}, {12, 24}, {9, 20}, {10, 21}, {10, 20}, {11, 22},
+		{12, 23}, {12, 22}, {11, 20}, {12, 21}, {12, 20}, {6, 14}, {6, 13}, {7, 15},
+		{8, 16}, {8, 15}, {7, 13}, {8, 14}, {8, 13}, {9, 17}, {10, 18}, {10, 17},
+		{11, 19}, {12, 19}, {11, 17}, {12, 18}, {12, 17}, {9, 13}, {10, 14}, {10, 13},
+		{11, 15}, {12, 16}, {12, 15}, {11, 13}, {12, 14}, {12, 13},
+	},
+	28: {
+		{1, 2}, {0, 2}, {0, 1}, {3, 4}, {5, 6}, {3, 5}, {4, 6}, {4, 5},
+		{0, 4}, {0, 3}, {1, 5}, {2, 6}, {2, 5}, {1, 3}, {2, 4}, {2, 3},
+		{8, 9}, {7, 9}, {7, 8}, {10, 11}, {12, 13}, {10, 12}, {11, 13}, {11, 12},
+		{7, 11}, {7, 10}, {8, 12}, {9, 13}, {9, 12}, {8, 10}, {9, 11}, {9, 10},
+		{0, 8}, {0, 7}, {1, 9}, {2, 10}, {2, 9}, {1, 7}, {2, 8}, {2, 7},
+		{3, 11}, {4, 12}, {4, 11}, {5, 13}, {6, 13}, {5, 11}, {6, 12}, {6, 11},
+		{3, 7}, {4, 8}, {4, 7}, {5, 9}, {6, 10}, {6, 9}, {5, 7}, {6, 8},
+		{6, 7}, {15, 16}, {14, 16}, {14, 15}, {17, 18}, {19, 20}, {17, 19}, {18, 20},
+		{18, 19}, {14, 18}, {14, 17}, {15, 19}, {16, 20}, {16, 19}, {15, 17}, {16, 18},
+		{16, 17}, {22, 23}, {21, 23}, {21, 22}, {24, 25}, {26, 27}, {24, 26}, {25, 27},
+		{25, 26}, {21, 25}, {21, 24}, {22, 26}, {23, 27}, {23, 26}, {22, 24}, {23, 25},
+		{23, 24}, {14, 22}, {14, 21}, {15, 23}, {16, 24}, {16, 23}, {15, 21}, {16, 22},
+		{16, 21}, {17, 25}, {18, 26}, {18, 25}, {19, 27}, {20, 27}, {19, 25}, {20, 26},
+		{20, 25}, {17, 21}, {18, 22}, {18, 21}, {19, 23}, {20, 24}, {20, 23}, {19, 21},
+		{20, 22}, {20, 21}, {0, 15}, {0, 14}, {1, 16}, {2, 17}, {2, 16}, {1, 14},
+		{2, 15}, {2, 14}, {3, 18}, {4, 19}, {4, 18}, {5, 20}, {6, 20}, {5, 18},
+		{6, 19}, {6, 18}, {3, 14}, {4, 15}, {4, 14}, {5, 16}, {6, 17}, {6, 16},
+		{5, 14}, {6, 15}, {6, 14}, {7, 22}, {7, 21}, {8, 23}, {9, 24}, {9, 23},
+		{8, 21}, {9, 22}, {9, 21}, {10, 25}, {11, 26}, {11, 25}, {12, 27}, {13, 27},
+		{12, 25}, {13, 26}, {13, 25}, {10, 21}, {11, 22}, {11, 21}, {12, 23}, {13, 24},
+		{13, 23}, {12, 21}, {13, 22}, {13, 21}, {7, 15}, {7, 14}, {8, 16}, {9, 17},
+		{9, 16}, {8, 14}, {9, 15}, {9, 14}, {10, 18}, {11, 19}, {11, 18}, {12, 20},
+		{13, 20}, {12, 18}, {13, 19}, {13, 18}, {10, 14}, {11, 15}, {11, 14}, {12, 16},
+		{13, 17}, {13, 16}, {12, 14}, {13, 15}, {13, 14},
+	},
+	29: {
+		{1, 2}, {0, 2}, {0, 1}, {3, 4}, {5, 6}, {3, 5}, {4, 6}, {4, 5},
+		{0, 4}, {0, 3}, {1, 5}, {2, 6}, {2, 5}, {1, 3}, {2, 4}, {2, 3},
+		{8, 9}, {7, 9}, {7, 8}, {10, 11}, {12, 13}, {10, 12}, {11, 13}, {11, 12},
+		{7, 11}, {7, 10}, {8, 12}, {9, 13}, {9, 12}, {8, 10}, {9, 11}, {9, 10},
+		{0, 8}, {0, 7}, {1, 9}, {2, 10}, {2, 9}, {1, 7}, {2, 8}, {2, 7},
+		{3, 11}, {4, 12}, {4, 11}, {5, 13}, {6, 13}, {5, 11}, {6, 12}, {6, 11},
+		{3, 7}, {4, 8}, {4, 7}, {5, 9}, {6, 10}, {6, 9}, {5, 7}, {6, 8},
+		{6, 7}, {15, 16}, {14, 16}, {14, 15}, {17, 18}, {19, 20}, {17, 19}, {18, 20},
+		{18, 19}, {14, 18}, {14, 17}, {15, 19}, {16, 20}, {16, 19}, {15, 17}, {16, 18},
+		{16, 17}, {21, 22}, {23, 24}, {21, 23}, {22, 24}, {22, 23}, {25, 26}, {27, 28},
+		{25, 27}, {26, 28}, {26, 27}, {21, 25}, {22, 26}, {22, 25}, {23, 27}, {24, 28},
+		{24, 27}, {23, 25}, {24, 26}, {24, 25}, {14, 22}, {14, 21}, {15, 23}, {16, 24},
+		{16, 23}, {15, 21}, {16, 22}, {16, 21}, {17, 25}, {18, 26}, {18, 25}, {19, 27},
+		{20, 28}, {20, 27}, {19, 25}, {20, 26}, {20, 25}, {17, 21}, {18, 22}, {18, 21},
+		{19, 23}, {20, 24}, {20, 23}, {19, 21}, {20, 22}, {20, 21}, {0, 15}, {0, 14},
+		{1, 16}, {2, 17}, {2, 16}, {1, 14}, {2, 15}, {2, 14}, {3, 18}, {4, 19},
+		{4, 18}, {5, 20}, {6, 21}, {6, 20}, {5, 18}, {6, 19}, {6, 18}, {3, 14},
+		{4, 15}, {4, 14}, {5, 16}, {6, 17}, {6, 16}, {5, 14}, {6, 15}, {6, 14},
+		{7, 23}, {7, 22}, {8, 24}, {9, 25}, {9, 24}, {8, 22}, {9, 23}, {9, 22},
+		{10, 26}, {11, 27}, {11, 26}, {12, 28}, {13, 28}, {12, 26}, {13, 27}, {13, 26},
+		{10, 22}, {11, 23}, {11, 22}, {12, 24}, {13, 25}, {13, 24}, {12, 22}, {13, 23},
+		{13, 22}, {7, 15}, {7, 14}, {8, 16}, {9, 17}, {9, 16}, {8, 14}, {9, 15},
+		{9, 14}, {10, 18}, {11, 19}, {11, 18}, {12, 20}, {13, 21}, {13, 20}, {12, 18},
+		{13, 19}, {13, 18}, {10, 14}, {11, 15}, {11, 14}, {12, 16}, {13, 17}, {13, 16},
+		{12, 14}, {13, 15}, {13, 14},
+	},
+	30: {
+		{1, 2}, {0, 2}, {0, 1}, {3, 4}, {5, 6}, {3, 5}, {4, 6}, {4, 5},
+		{0, 4}, {0, 3}, {1, 5}, {2, 6}, {2, 5}, {1, 3}, {2, 4}, {2, 3},
+		{7, 8}, {9, 10}, {7, 9}, {8, 10}, {8, 9}, {11, 12}, {13, 14}, {11, 13},
+		{12, 14}, {12, 13}, {7, 11}, {8, 12}, {8, 11}, {9, 13}, {10, 14}, {10, 13},
+		{9, 11}, {10, 12}, {10, 11}, {0, 8}, {0, 7}, {1, 9}, {2, 10}, {2, 9},
+		{1, 7}, {2, 8}, {2, 7}, {3, 11}, {4, 12}, {4, 11}, {5, 13}, {6, 14},
+		{6, 13}, {5, 11}, {6, 12}, {6, 11}, {3, 7}, {4, 8}, {4, 7}, {5, 9},
+		{6, 10}, {6, 9}, {5, 7}, {6, 8}, {6, 7}, {16, 17}, {15, 17}, {15, 16},
+		{18, 19}, {20, 21}, {18, 20}, {19, 21}, {19, 20}, {15, 19}, {15, 18}, {16, 20},
+		{17, 21}, {17, 20}, {16, 18}, {17, 19}, {17, 18}, {22, 23}, {24, 25}, {22, 24},
+		{23, 25}, {23, 24}, {26, 27}, {28, 29}, {26, 28}, {27, 29}, {27, 28}, {22, 26},
+		{23, 27}, {23, 26}, {24, 28}, {25, 29}, {25, 28}, {24, 26}, {25, 27}, {25, 26},
+		{15, 23}, {15, 22}, {16, 24}, {17, 25}, {17, 24}, {16, 22}, {17, 23}, {17, 22},
+		{18, 26}, {19, 27}, {19, 26}, {20, 28}, {21, 29}, {21, 28}, {20, 26}, {21, 27},
+		{21, 26}, {18, 22}, {19, 23}, {19, 22}, {20, 24}, {21, 25}, {21, 24}, {20, 22},
+		{21, 23}, {21, 22}, {0, 16}, {0, 15}, {1, 17}, {2, 18}, {2, 17}, {1, 15},
+		{2, 16}, {2, 15}, {3, 19}, {4, 20}, {4, 19}, {5, 21}, {6, 22}, {6, 21},
+		{5, 19}, {6, 20}, {6, 19}, {3, 15}, {4, 16}, {4, 15}, {5, 17}, {6, 18},
+		{6, 17}, {5, 15}, {6, 16}, {6, 15}, {7, 23}, {8, 24}, {8, 23}, {9, 25},
+		{10, 26}, {10, 25}, {9, 23}, {10, 24}, {10, 23}, {11, 27}, {12, 28}, {12, 27},
+		{13, 29}, {14, 29}, {13, 27}, {14, 28}, {14, 27}, {11, 23}, {12, 24}, {12, 23},
+		{13, 25}, {14, 26}, {14, 25}, {13, 23}, {14, 24}, {14, 23}, {7, 15}, {8, 16},
+		{8, 15}, {9, 17}, {10, 18}, {10, 17}, {9, 15}, {10, 16}, {10, 15}, {11, 19},
+		{12, 20}, {12, 19}, {13, 21}, {14, 22}, {14, 21}, {13, 19}, {14, 20}, {14, 19},
+		{11, 15}, {12, 16}, {12, 15}, {13, 17}, {14, 18}, {14, 17}, {13, 15}, {14, 16},
+		{14, 15},
+	},
+	31: {
+		{1, 2}, {0, 2}, {0, 1}, {3, 4}, {5, 6}, {3, 5}, {4, 6}, {4, 5},
+		{0, 4}, {0, 3}, {1, 5}, {2, 6}, {2, 5}, {1, 3}, {2, 4}, {2, 3},
+		{7, 8}, {9, 10}, {7, 9}, {8, 10}, {8, 9}, {11, 12}, {13, 14}, {11, 13},
+		{12, 14}, {12, 13}, {7, 11}, {8, 12}, {8, 11}, {9, 13}, {10, 14}, {10, 13},
+		{9, 11}, {10, 12}, {10, 11}, {0, 8}, {0, 7}, {1, 9}, {2, 10}, {2, 9},
+		{1, 7}, {2, 8}, {2, 7}, {3, 11}, {4, 12}, {4, 11}, {5, 13}, {6, 14},
+		{6, 13}, {5, 11}, {6, 12}, {6, 11}, {3, 7}, {4, 8}, {4, 7}, {5, 9},
+		{6, 10}, {6, 9}, {5, 7}, {6, 8}, {6, 7}, {15, 16}, {17, 18}, {15, 17},
+		{16, 18}, {16, 17}, {19, 20}, {21, 22}, {19, 21}, {20, 22}, {20, 21}, {15, 19},
+		{16, 20}, {16, 19}, {17, 21}, {18, 22}, {18, 21}, {17, 19}, {18, 20}, {18, 19},
+		{23, 24}, {25, 26}, {23, 25}, {24, 26}, {24, 25}, {27, 28}, {29, 30}, {27, 29},
+		{28, 30}, {28, 29}, {23, 27}, {24, 28}, {24, 27}, {25, 29}, {26, 30}, {26, 29},
+		{25, 27}, {26, 28}, {26, 27}, {15, 23}, {16, 24}, {16, 23}, {17, 25}, {18, 26},
+		{18, 25}, {17, 23}, {18, 24}, {18, 23}, {19, 27}, {20, 28}, {20, 27}, {21, 29},
+		{22, 30}, {22, 29}, {21, 27}, {22, 28}, {22, 27}, {19, 23}, {20, 24}, {20, 23},
+		{21, 25}, {22, 26}, {22, 25}, {21, 23}, {22, 24}, {22, 23}, {0, 16}, {0, 15},
+		{1, 17}, {2, 18}, {2, 17}, {1, 15}, {2, 16}, {2, 15}, {3, 19}, {4, 20},
+		{4, 19}, {5, 21}, {6, 22}, {6, 21}, {5, 19}, {6, 20}, {6, 19}, {3, 15},
+		{4, 16}, {4, 15}, {5, 17}, {6, 18}, {6, 17}, {5, 15}, {6, 16}, {6, 15},
+		{7, 23}, {8, 24}, {8, 23}, {9, 25}, {10, 26}, {10, 25}, {9, 23}, {10, 24},
+		{10, 23}, {11, 27}, {12, 28}, {12, 27}, {13, 29}, {14, 30}, {14, 29}, {13, 27},
+		{14, 28}, {14, 27}, {11, 23}, {12, 24}, {12, 23}, {13, 25}, {14, 26}, {14, 25},
+		{13, 23}, {14, 24}, {14, 23}, {7, 15}, {8, 16}, {8, 15}, {9, 17}, {10, 18},
+		{10, 17}, {9, 15}, {10, 16}, {10, 15}, {11, 19}, {12, 20}, {12, 19}, {13, 21},
+		{14, 22}, {14, 21}, {13, 19}, {14, 20}, {14, 19}, {11, 15}, {12, 16}, {12, 15},
+		{13, 17}, {14, 18}, {14, 17}, {13, 15}, {14, 16}, {14, 15},
+	},
+	32: {
+		{0, 1}, {2, 3}, {0, 2}, {1, 3}, {1, 2}, {4, 5}, {6, 7}, {4, 6},
+		{5, 7}, {5, 6}, {0, 4}, {1, 5}, {1, 4}, {2, 6}, {3, 7}, {3, 6},
+		{2, 4}, {3, 5}, {3, 4}, {8, 9}, {10, 11}, {8, 10}, {9, 11}, {9, 10},
+		{12, 13}, {14, 15}, {12, 14}, {13, 15}, {13, 14}, {8, 12}, {9, 13}, {9, 12},
+		{10, 14}, {11, 15}, {11, 14}, {10, 12}, {11, 13}, {11, 12}, {0, 8}, {1, 9},
+		{1, 8}, {2, 10}, {3, 11}, {3, 10}, {2, 8}, {3, 9}, {3, 8}, {4, 12},
+		{5, 13}, {5, 12}, {6, 14}, {7, 15}, {7, 14}, {6, 12}, {7, 13}, {7, 12},
+		{4, 8}, {5, 9}, {5, 8}, {6, 10}, {7, 11}, {7, 10}, {6, 8}, {7, 9},
+		{7, 8}, {16, 17}, {18, 19}, {16, 18}, {17, 19}, {17, 18}, {20, 21}, {22, 23},
+		{20, 22}, {21, 23}, {21, 22}, {16, 20}, {17, 21}, {17, 20}, {18, 22}, {19, 23},
+		{19, 22}, {18, 20}, {19, 21}, {19, 20}, {24, 25}, {26, 27}, {24, 26}, {25, 27},
+		{25, 26}, {28, 29}, {30, 31}, {28, 30}, {29, 31}, {29, 30}, {24, 28}, {25, 29},
+		{25, 28}, {26, 30}, {27, 31}, {27, 30}, {26, 28}, {27, 29}, {27, 28}, {16, 24},
+		{17, 25}, {17, 24}, {18, 26}, {19, 27}, {19, 26}, {18, 24}, {19, 25}, {19, 24},
+		{20, 28}, {21, 29}, {21, 28}, {22, 30}, {23, 31}, {23, 30}, {22, 28}, {23, 29},
+		{23, 28}, {20, 24}, {21, 25}, {21, 24}, {22, 26}, {23, 27}, {23, 26}, {22, 24},
+		{23, 25}, {23, 24}, {0, 16}, {1, 17}, {1, 16}, {2, 18}, {3, 19}, {3, 18},
+		{2, 16}, {3, 17}, {3, 16}, {4, 20}, {5, 21}, {5, 20}, {6, 22}, {7, 23},
+		{7, 22}, {6, 20}, {7, 21}, {7, 20}, {4, 16}, {5, 17}, {5, 16}, {6, 18},
+		{7, 19}, {7, 18}, {6, 16}, {7, 17}, {7, 16}, {8, 24}, {9, 25}, {9, 24},
+		{10, 26}, {11, 27}, {11, 26}, {10, 24}, {11, 25}, {11, 24}, {12, 28}, {13, 29},
+		{13, 28}, {14, 30}, {15, 31}, {15, 30}, {14, 28}, {15, 29}, {15, 28}, {12, 24},
+		{13, 25}, {13, 24}, {14, 26}, {15, 27}, {15, 26}, {14, 24}, {15, 25}, {15, 24},
+		{8, 16}, {9, 17}, {9, 16}, {10, 18}, {11, 19}, {11, 18}, {10, 16}, {11, 17},
+		{11, 16}, {12, 20}, {13, 21}, {13, 20}, {14, 22}, {15, 23}, {15, 22}, {14, 20},
+		{15, 21}, {15, 20}, {12, 16}, {13, 17}, {13, 16}, {14, 18}, {15, 19}, {15, 18},
+		{14, 16}, {15, 17}, {15, 16},
+	},
+}
+
+func compiledSorter[T cmp.Ordered](size int) func([]T) {
+	switch size {
+	case 2:
+		return compiledSort2[T]
+	case 3:
+		return compiledSort3[T]
+	case 4:
+		return compiledSort4[T]
+	case 5:
+		return compiledSort5[T]
+	case 6:
+		return compiledSort6[T]
+	case 7:
+		return compiledSort7[T]
+	case 8:
+		return compiledSort8[T]
+	case 9:
+		return compiledSort9[T]
+	case 10:
+		return compiledSort10[T]
+	case 11:
+		return compiledSort11[T]
+	case 12:
+		return compiledSort12[T]
+	case 13:
+		return compiledSort13[T]
+	case 14:
+		return compiledSort14[T]
+	case 15:
+		return compiledSort15[T]
+	case 16:
+		return compiledSort16[T]
+	case 17:
+		return compiledSort17[T]
+	case 18:
+		return compiledSort18[T]
+	case 19:
+		return compiledSort19[T]
+	case 20:
+		return compiledSort20[T]
+	case 21:
+		return compiledSort21[T]
+	case 22:
+		return compiledSort22[T]
+	case 23:
+		return compiledSort23[T]
+	case 24:
+		return compiledSort24[T]
+	case 25:
+		return compiledSort25[T]
+	case 26:
+		return compiledSort26[T]
+	case 27:
+		return compiledSort27[T]
+	case 28:
+		return compiledSort28[T]
+	case 29:
+		return compiledSort29[T]
+	case 30:
+		return compiledSort30[T]
+	case 31:
+		return compiledSort31[T]
+	case 32:
+		return compiledSort32[T]
+	}
+	return nil
+}
+
+// compiledSort2 is an unrolled Bose-Nelson network.
+func compiledSort2[T cmp.Ordered](a []T) {
+	_ = a[1]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+// compiledSort3 is an unrolled Bose-Nelson network.
+func compiledSort3[T cmp.Ordered](a []T) {
+	_ = a[2]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+}
+
+// compiledSort4 is an unrolled Bose-Nelson network.
+func compiledSort4[T cmp.Ordered](a []T) {
+	_ = a[3]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+// compiledSort5 is an unrolled Bose-Nelson network.
+func compiledSort5[T cmp.Ordered](a []T) {
+	_ = a[4]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+}
+
+// compiledSort6 is an unrolled Bose-Nelson network.
+func compiledSort6[T cmp.Ordered](a []T) {
+	_ = a[5]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+// compiledSort7 is an unrolled Bose-Nelson network.
+func compiledSort7[T cmp.Ordered](a []T) {
+	_ = a[6]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+}
+
+// compiledSort8 is an unrolled Bose-Nelson network.
+func compiledSort8[T cmp.Ordered](a []T) {
+	_ = a[7]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+}
+
+// compiledSort9 is an unrolled Senso9 network.
+func compiledSort9[T cmp.Ordered](a []T) {
+	_ = a[8]
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[0] > a[5] {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[0] > a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2] > a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+}
+
+// compiledSort10 is an unrolled Senso10 network.
+func compiledSort10[T cmp.Ordered](a []T) {
+	_ = a[9]
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] > a[9] {
+		a[0], a[9] = a[9], a[0]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[0] > a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4] > a[9] {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] > a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[1] > a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+// compiledSort11 is an unrolled ShapiroGreen11 network.
+func compiledSort11[T cmp.Ordered](a []T) {
+	_ = a[10]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+// compiledSort12 is an unrolled ShapiroGreen12 network.
+func compiledSort12[T cmp.Ordered](a []T) {
+	_ = a[11]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+// compiledSort13 is an unrolled End13 network.
+func compiledSort13[T cmp.Ordered](a []T) {
+	_ = a[12]
+	if a[1] > a[7] {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[0] > a[12] {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[11] {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[7] > a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[6] > a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[4] > a[9] {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[1] > a[7] {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[4] > a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[0] > a[5] {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+}
+
+// compiledSort14 is an unrolled Green14 network.
+func compiledSort14[T cmp.Ordered](a []T) {
+	_ = a[13]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[0] > a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] > a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2] > a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[5] > a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3] > a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7] > a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+// compiledSort15 is an unrolled Green15 network.
+func compiledSort15[T cmp.Ordered](a []T) {
+	_ = a[14]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[0] > a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] > a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2] > a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[5] > a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3] > a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[11] > a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7] > a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+// compiledSort16 is an unrolled Green16 network.
+func compiledSort16[T cmp.Ordered](a []T) {
+	_ = a[15]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[0] > a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] > a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2] > a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7] > a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[5] > a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[3] > a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[11] > a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[7] > a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+}
+
+// compiledSort17 is an unrolled Senso17 network.
+func compiledSort17[T cmp.Ordered](a []T) {
+	_ = a[16]
+	if a[5] > a[11] {
+		a[5], a[11] = a[11], a[5]
+	}
+	if a[4] > a[9] {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[7] > a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[0] > a[14] {
+		a[0], a[14] = a[14], a[0]
+	}
+	if a[2] > a[16] {
+		a[2], a[16] = a[16], a[2]
+	}
+	if a[1] > a[15] {
+		a[1], a[15] = a[15], a[1]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[6] > a[13] {
+		a[6], a[13] = a[13], a[6]
+	}
+	if a[3] > a[10] {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[8] > a[13] {
+		a[8], a[13] = a[13], a[8]
+	}
+	if a[4] > a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[9] > a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[1] > a[6] {
+		a[1], a[6] = a[6], a[1]
+	}
+	if a[10] > a[15] {
+		a[10], a[15] = a[15], a[10]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[2] > a[9] {
+		a[2], a[9] = a[9], a[2]
+	}
+	if a[7] > a[14] {
+		a[7], a[14] = a[14], a[7]
+	}
+	if a[5] > a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[6] > a[11] {
+		a[6], a[11] = a[11], a[6]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] > a[14] {
+		a[8], a[14] = a[14], a[8]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[1] > a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[11] > a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[6] > a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[9] > a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[3] > a[10] {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+// compiledSort18 is an unrolled Senso18 network.
+func compiledSort18[T cmp.Ordered](a []T) {
+	_ = a[17]
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[0] > a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[10] > a[17] {
+		a[10], a[17] = a[17], a[10]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[1] > a[16] {
+		a[1], a[16] = a[16], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[1] > a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[8] > a[16] {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[4] > a[10] {
+		a[4], a[10] = a[10], a[4]
+	}
+	if a[7] > a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[3] > a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[5] > a[14] {
+		a[5], a[14] = a[14], a[5]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[13] > a[16] {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[0] > a[5] {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[12] > a[17] {
+		a[12], a[17] = a[17], a[12]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[16] > a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[8] > a[11] {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[2] > a[15] {
+		a[2], a[15] = a[15], a[2]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[9] > a[14] {
+		a[9], a[14] = a[14], a[9]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[13] > a[16] {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[11] > a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[5] > a[11] {
+		a[5], a[11] = a[11], a[5]
+	}
+	if a[6] > a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[10] > a[13] {
+		a[10], a[13] = a[13], a[10]
+	}
+	if a[4] > a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[8] > a[11] {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[9] > a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+}
+
+// compiledSort19 is an unrolled Senso19 network.
+func compiledSort19[T cmp.Ordered](a []T) {
+	_ = a[18]
+	if a[4] > a[10] {
+		a[4], a[10] = a[10], a[4]
+	}
+	if a[3] > a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[0] > a[16] {
+		a[0], a[16] = a[16], a[0]
+	}
+	if a[7] > a[14] {
+		a[7], a[14] = a[14], a[7]
+	}
+	if a[8] > a[11] {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[6] > a[13] {
+		a[6], a[13] = a[13], a[6]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[9] > a[18] {
+		a[9], a[18] = a[18], a[9]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[11] > a[16] {
+		a[11], a[16] = a[16], a[11]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[6] > a[15] {
+		a[6], a[15] = a[15], a[6]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[13] > a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[0] > a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[14] > a[18] {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[5] > a[16] {
+		a[5], a[16] = a[16], a[5]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[1] > a[6] {
+		a[1], a[6] = a[6], a[1]
+	}
+	if a[4] > a[15] {
+		a[4], a[15] = a[15], a[4]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[16] > a[18] {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[2] > a[11] {
+		a[2], a[11] = a[11], a[2]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[7] > a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[2] > a[9] {
+		a[2], a[9] = a[9], a[2]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[1] > a[7] {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[5] > a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[12] > a[17] {
+		a[12], a[17] = a[17], a[12]
+	}
+	if a[8] > a[14] {
+		a[8], a[14] = a[14], a[8]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[14] > a[17] {
+		a[14], a[17] = a[17], a[14]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[16] > a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[6] > a[13] {
+		a[6], a[13] = a[13], a[6]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[10] > a[15] {
+		a[10], a[15] = a[15], a[10]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[7] > a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[9] > a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[8] > a[11] {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[10] > a[13] {
+		a[10], a[13] = a[13], a[10]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[6] > a[11] {
+		a[6], a[11] = a[11], a[6]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+}
+
+// compiledSort20 is an unrolled Senso20 network.
+func compiledSort20[T cmp.Ordered](a []T) {
+	_ = a[19]
+	if a[2] > a[11] {
+		a[2], a[11] = a[11], a[2]
+	}
+	if a[8] > a[17] {
+		a[8], a[17] = a[17], a[8]
+	}
+	if a[0] > a[10] {
+		a[0], a[10] = a[10], a[0]
+	}
+	if a[9] > a[19] {
+		a[9], a[19] = a[19], a[9]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[13] > a[16] {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[1] > a[12] {
+		a[1], a[12] = a[12], a[1]
+	}
+	if a[7] > a[18] {
+		a[7], a[18] = a[18], a[7]
+	}
+	if a[3] > a[14] {
+		a[3], a[14] = a[14], a[3]
+	}
+	if a[5] > a[16] {
+		a[5], a[16] = a[16], a[5]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[18] > a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[4] > a[13] {
+		a[4], a[13] = a[13], a[4]
+	}
+	if a[6] > a[15] {
+		a[6], a[15] = a[15], a[6]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[11] > a[17] {
+		a[11], a[17] = a[17], a[11]
+	}
+	if a[4] > a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[12] > a[15] {
+		a[12], a[15] = a[15], a[12]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[16] > a[19] {
+		a[16], a[19] = a[19], a[16]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[17] > a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[15] > a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[1] > a[14] {
+		a[1], a[14] = a[14], a[1]
+	}
+	if a[5] > a[18] {
+		a[5], a[18] = a[18], a[5]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[6] > a[13] {
+		a[6], a[13] = a[13], a[6]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[16] > a[18] {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[2] > a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[12] > a[17] {
+		a[12], a[17] = a[17], a[12]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[14] > a[18] {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[15] > a[18] {
+		a[15], a[18] = a[18], a[15]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[3] > a[9] {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[10] > a[16] {
+		a[10], a[16] = a[16], a[10]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[16] > a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[13] > a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[5] > a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[9] > a[14] {
+		a[9], a[14] = a[14], a[9]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[9] > a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+}
+
+// compiledSort21 is an unrolled Senso21 network.
+func compiledSort21[T cmp.Ordered](a []T) {
+	_ = a[20]
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[1] > a[19] {
+		a[1], a[19] = a[19], a[1]
+	}
+	if a[2] > a[14] {
+		a[2], a[14] = a[14], a[2]
+	}
+	if a[6] > a[18] {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[0] > a[17] {
+		a[0], a[17] = a[17], a[0]
+	}
+	if a[3] > a[20] {
+		a[3], a[20] = a[20], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[7] > a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[1] > a[7] {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[13] > a[19] {
+		a[13], a[19] = a[19], a[13]
+	}
+	if a[2] > a[11] {
+		a[2], a[11] = a[11], a[2]
+	}
+	if a[9] > a[18] {
+		a[9], a[18] = a[18], a[9]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[8] > a[16] {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[0] > a[10] {
+		a[0], a[10] = a[10], a[0]
+	}
+	if a[10] > a[20] {
+		a[10], a[20] = a[20], a[10]
+	}
+	if a[0] > a[6] {
+		a[0], a[6] = a[6], a[0]
+	}
+	if a[14] > a[20] {
+		a[14], a[20] = a[20], a[14]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[16] > a[19] {
+		a[16], a[19] = a[19], a[16]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[19] > a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[18] > a[20] {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[4] > a[11] {
+		a[4], a[11] = a[11], a[4]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[5] > a[12] {
+		a[5], a[12] = a[12], a[5]
+	}
+	if a[8] > a[15] {
+		a[8], a[15] = a[15], a[8]
+	}
+	if a[6] > a[13] {
+		a[6], a[13] = a[13], a[6]
+	}
+	if a[7] > a[14] {
+		a[7], a[14] = a[14], a[7]
+	}
+	if a[16] > a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[4] > a[9] {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[11] > a[18] {
+		a[11], a[18] = a[18], a[11]
+	}
+	if a[17] > a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[18] > a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[15] > a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[13] > a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[2] > a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[11] > a[17] {
+		a[11], a[17] = a[17], a[11]
+	}
+	if a[9] > a[14] {
+		a[9], a[14] = a[14], a[9]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[15] > a[18] {
+		a[15], a[18] = a[18], a[15]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[8] > a[16] {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[3] > a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[16] > a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[9] > a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+}
+
+// compiledSort22 is an unrolled Senso22 network.
+func compiledSort22[T cmp.Ordered](a []T) {
+	_ = a[21]
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[13] > a[19] {
+		a[13], a[19] = a[19], a[13]
+	}
+	if a[3] > a[15] {
+		a[3], a[15] = a[15], a[3]
+	}
+	if a[6] > a[18] {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[1] > a[16] {
+		a[1], a[16] = a[16], a[1]
+	}
+	if a[5] > a[20] {
+		a[5], a[20] = a[20], a[5]
+	}
+	if a[0] > a[17] {
+		a[0], a[17] = a[17], a[0]
+	}
+	if a[4] > a[21] {
+		a[4], a[21] = a[21], a[4]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[17] > a[21] {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[3] > a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[9] > a[18] {
+		a[9], a[18] = a[18], a[9]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[19] > a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[7] > a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[8] > a[14] {
+		a[8], a[14] = a[14], a[8]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[1] > a[10] {
+		a[1], a[10] = a[10], a[1]
+	}
+	if a[11] > a[20] {
+		a[11], a[20] = a[20], a[11]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[18] > a[21] {
+		a[18], a[21] = a[21], a[18]
+	}
+	if a[0] > a[5] {
+		a[0], a[5] = a[5], a[0]
+	}
+	if a[16] > a[21] {
+		a[16], a[21] = a[21], a[16]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[20] > a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[17] > a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[2] > a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[14] > a[19] {
+		a[14], a[19] = a[19], a[14]
+	}
+	if a[3] > a[9] {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[12] > a[18] {
+		a[12], a[18] = a[18], a[12]
+	}
+	if a[6] > a[13] {
+		a[6], a[13] = a[13], a[6]
+	}
+	if a[8] > a[15] {
+		a[8], a[15] = a[15], a[8]
+	}
+	if a[4] > a[11] {
+		a[4], a[11] = a[11], a[4]
+	}
+	if a[10] > a[17] {
+		a[10], a[17] = a[17], a[10]
+	}
+	if a[5] > a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[11] > a[16] {
+		a[11], a[16] = a[16], a[11]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[15] > a[18] {
+		a[15], a[18] = a[18], a[15]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[19] > a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[18] > a[20] {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[16] > a[20] {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[15] > a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[11] > a[18] {
+		a[11], a[18] = a[18], a[11]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[16] > a[19] {
+		a[16], a[19] = a[19], a[16]
+	}
+	if a[3] > a[10] {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[18] > a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[9] > a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[4] > a[14] {
+		a[4], a[14] = a[14], a[4]
+	}
+	if a[7] > a[17] {
+		a[7], a[17] = a[17], a[7]
+	}
+	if a[8] > a[13] {
+		a[8], a[13] = a[13], a[8]
+	}
+	if a[12] > a[17] {
+		a[12], a[17] = a[17], a[12]
+	}
+	if a[4] > a[9] {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[4] > a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[14] > a[17] {
+		a[14], a[17] = a[17], a[14]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[16] > a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[16] > a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[9] > a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[8] > a[13] {
+		a[8], a[13] = a[13], a[8]
+	}
+	if a[10] > a[13] {
+		a[10], a[13] = a[13], a[10]
+	}
+	if a[8] > a[11] {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[12] > a[15] {
+		a[12], a[15] = a[15], a[12]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[11] {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[10] > a[13] {
+		a[10], a[13] = a[13], a[10]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+}
+
+// compiledSort23 is an unrolled Morwenn23 network.
+func compiledSort23[T cmp.Ordered](a []T) {
+	_ = a[22]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[16] > a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[18] > a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[20] > a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[17] > a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[16] > a[18] {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[20] > a[22] {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[21] > a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[13] > a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[18] > a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[17] > a[21] {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[14] > a[18] {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[13] > a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[18] > a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[15] > a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[16] > a[20] {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[13] > a[16] {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[19] > a[22] {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[15] > a[20] {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[20] > a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[19] > a[21] {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[18] > a[20] {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[19] > a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[0] > a[12] {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[1] > a[13] {
+		a[1], a[13] = a[13], a[1]
+	}
+	if a[2] > a[14] {
+		a[2], a[14] = a[14], a[2]
+	}
+	if a[3] > a[15] {
+		a[3], a[15] = a[15], a[3]
+	}
+	if a[4] > a[16] {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[5] > a[17] {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[6] > a[18] {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[7] > a[19] {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[8] > a[20] {
+		a[8], a[20] = a[20], a[8]
+	}
+	if a[9] > a[21] {
+		a[9], a[21] = a[21], a[9]
+	}
+	if a[10] > a[22] {
+		a[10], a[22] = a[22], a[10]
+	}
+	if a[2] > a[12] {
+		a[2], a[12] = a[12], a[2]
+	}
+	if a[3] > a[13] {
+		a[3], a[13] = a[13], a[3]
+	}
+	if a[10] > a[20] {
+		a[10], a[20] = a[20], a[10]
+	}
+	if a[11] > a[21] {
+		a[11], a[21] = a[21], a[11]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7] > a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[8] > a[16] {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[9] > a[17] {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[10] > a[18] {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[11] > a[19] {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[19] > a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[21] > a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+}
+
+// compiledSort24 is an unrolled Morwenn24 network.
+func compiledSort24[T cmp.Ordered](a []T) {
+	_ = a[23]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[16] > a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[18] > a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[20] > a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[22] > a[23] {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[17] > a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[21] > a[23] {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[16] > a[18] {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[20] > a[22] {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[21] > a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[13] > a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[18] > a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[17] > a[21] {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[14] > a[18] {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[13] > a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[18] > a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[19] > a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[15] > a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[16] > a[20] {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[19] > a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[13] > a[16] {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[19] > a[22] {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[15] > a[20] {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[20] > a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[19] > a[21] {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[18] > a[20] {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[19] > a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[0] > a[12] {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[1] > a[13] {
+		a[1], a[13] = a[13], a[1]
+	}
+	if a[2] > a[14] {
+		a[2], a[14] = a[14], a[2]
+	}
+	if a[3] > a[15] {
+		a[3], a[15] = a[15], a[3]
+	}
+	if a[4] > a[16] {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[5] > a[17] {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[6] > a[18] {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[7] > a[19] {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[8] > a[20] {
+		a[8], a[20] = a[20], a[8]
+	}
+	if a[9] > a[21] {
+		a[9], a[21] = a[21], a[9]
+	}
+	if a[10] > a[22] {
+		a[10], a[22] = a[22], a[10]
+	}
+	if a[11] > a[23] {
+		a[11], a[23] = a[23], a[11]
+	}
+	if a[2] > a[12] {
+		a[2], a[12] = a[12], a[2]
+	}
+	if a[3] > a[13] {
+		a[3], a[13] = a[13], a[3]
+	}
+	if a[10] > a[20] {
+		a[10], a[20] = a[20], a[10]
+	}
+	if a[11] > a[21] {
+		a[11], a[21] = a[21], a[11]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7] > a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[8] > a[16] {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[9] > a[17] {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[10] > a[18] {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[11] > a[19] {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[19] > a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[21] > a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+}
+
+// compiledSort25 is an unrolled Bose-Nelson network.
+func compiledSort25[T cmp.Ordered](a []T) {
+	_ = a[24]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[8] > a[11] {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[0] > a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[0] > a[6] {
+		a[0], a[6] = a[6], a[0]
+	}
+	if a[1] > a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[1] > a[6] {
+		a[1], a[6] = a[6], a[1]
+	}
+	if a[2] > a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] > a[10] {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[3] > a[9] {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[4] > a[11] {
+		a[4], a[11] = a[11], a[4]
+	}
+	if a[5] > a[11] {
+		a[5], a[11] = a[11], a[5]
+	}
+	if a[4] > a[9] {
+		a[4], a[9] = a[9], a[4]
+	}
+	if a[5] > a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[16] > a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[12] > a[15] {
+		a[12], a[15] = a[15], a[12]
+	}
+	if a[13] > a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[14] > a[17] {
+		a[14], a[17] = a[17], a[14]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[19] > a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[18] > a[20] {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[18] > a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[21] > a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[23] > a[24] {
+		a[23], a[24] = a[24], a[23]
+	}
+	if a[21] > a[23] {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[22] > a[24] {
+		a[22], a[24] = a[24], a[22]
+	}
+	if a[22] > a[23] {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[18] > a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[18] > a[21] {
+		a[18], a[21] = a[21], a[18]
+	}
+	if a[19] > a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[20] > a[24] {
+		a[20], a[24] = a[24], a[20]
+	}
+	if a[20] > a[23] {
+		a[20], a[23] = a[23], a[20]
+	}
+	if a[19] > a[21] {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[20] > a[22] {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[20] > a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[12] > a[19] {
+		a[12], a[19] = a[19], a[12]
+	}
+	if a[12] > a[18] {
+		a[12], a[18] = a[18], a[12]
+	}
+	if a[13] > a[20] {
+		a[13], a[20] = a[20], a[13]
+	}
+	if a[14] > a[21] {
+		a[14], a[21] = a[21], a[14]
+	}
+	if a[14] > a[20] {
+		a[14], a[20] = a[20], a[14]
+	}
+	if a[13] > a[18] {
+		a[13], a[18] = a[18], a[13]
+	}
+	if a[14] > a[19] {
+		a[14], a[19] = a[19], a[14]
+	}
+	if a[14] > a[18] {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[15] > a[23] {
+		a[15], a[23] = a[23], a[15]
+	}
+	if a[15] > a[22] {
+		a[15], a[22] = a[22], a[15]
+	}
+	if a[16] > a[24] {
+		a[16], a[24] = a[24], a[16]
+	}
+	if a[17] > a[24] {
+		a[17], a[24] = a[24], a[17]
+	}
+	if a[16] > a[22] {
+		a[16], a[22] = a[22], a[16]
+	}
+	if a[17] > a[23] {
+		a[17], a[23] = a[23], a[17]
+	}
+	if a[17] > a[22] {
+		a[17], a[22] = a[22], a[17]
+	}
+	if a[15] > a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[15] > a[18] {
+		a[15], a[18] = a[18], a[15]
+	}
+	if a[16] > a[20] {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[17] > a[21] {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[17] > a[20] {
+		a[17], a[20] = a[20], a[17]
+	}
+	if a[16] > a[18] {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[17] > a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[0] > a[13] {
+		a[0], a[13] = a[13], a[0]
+	}
+	if a[0] > a[12] {
+		a[0], a[12] = a[12], a[0]
+	}
+	if a[1] > a[14] {
+		a[1], a[14] = a[14], a[1]
+	}
+	if a[2] > a[15] {
+		a[2], a[15] = a[15], a[2]
+	}
+	if a[2] > a[14] {
+		a[2], a[14] = a[14], a[2]
+	}
+	if a[1] > a[12] {
+		a[1], a[12] = a[12], a[1]
+	}
+	if a[2] > a[13] {
+		a[2], a[13] = a[13], a[2]
+	}
+	if a[2] > a[12] {
+		a[2], a[12] = a[12], a[2]
+	}
+	if a[3] > a[17] {
+		a[3], a[17] = a[17], a[3]
+	}
+	if a[3] > a[16] {
+		a[3], a[16] = a[16], a[3]
+	}
+	if a[4] > a[18] {
+		a[4], a[18] = a[18], a[4]
+	}
+	if a[5] > a[18] {
+		a[5], a[18] = a[18], a[5]
+	}
+	if a[4] > a[16] {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[5] > a[17] {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[5] > a[16] {
+		a[5], a[16] = a[16], a[5]
+	}
+	if a[3] > a[13] {
+		a[3], a[13] = a[13], a[3]
+	}
+	if a[3] > a[12] {
+		a[3], a[12] = a[12], a[3]
+	}
+	if a[4] > a[14] {
+		a[4], a[14] = a[14], a[4]
+	}
+	if a[5] > a[15] {
+		a[5], a[15] = a[15], a[5]
+	}
+	if a[5] > a[14] {
+		a[5], a[14] = a[14], a[5]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[5] > a[12] {
+		a[5], a[12] = a[12], a[5]
+	}
+	if a[6] > a[20] {
+		a[6], a[20] = a[20], a[6]
+	}
+	if a[6] > a[19] {
+		a[6], a[19] = a[19], a[6]
+	}
+	if a[7] > a[21] {
+		a[7], a[21] = a[21], a[7]
+	}
+	if a[8] > a[21] {
+		a[8], a[21] = a[21], a[8]
+	}
+	if a[7] > a[19] {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[8] > a[20] {
+		a[8], a[20] = a[20], a[8]
+	}
+	if a[8] > a[19] {
+		a[8], a[19] = a[19], a[8]
+	}
+	if a[9] > a[23] {
+		a[9], a[23] = a[23], a[9]
+	}
+	if a[9] > a[22] {
+		a[9], a[22] = a[22], a[9]
+	}
+	if a[10] > a[24] {
+		a[10], a[24] = a[24], a[10]
+	}
+	if a[11] > a[24] {
+		a[11], a[24] = a[24], a[11]
+	}
+	if a[10] > a[22] {
+		a[10], a[22] = a[22], a[10]
+	}
+	if a[11] > a[23] {
+		a[11], a[23] = a[23], a[11]
+	}
+	if a[11] > a[22] {
+		a[11], a[22] = a[22], a[11]
+	}
+	if a[9] > a[20] {
+		a[9], a[20] = a[20], a[9]
+	}
+	if a[9] > a[19] {
+		a[9], a[19] = a[19], a[9]
+	}
+	if a[10] > a[21] {
+		a[10], a[21] = a[21], a[10]
+	}
+	if a[11] > a[21] {
+		a[11], a[21] = a[21], a[11]
+	}
+	if a[10] > a[19] {
+		a[10], a[19] = a[19], a[10]
+	}
+	if a[11] > a[20] {
+		a[11], a[20] = a[20], a[11]
+	}
+	if a[11] > a[19] {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[6] > a[13] {
+		a[6], a[13] = a[13], a[6]
+	}
+	if a[6] > a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[7] > a[14] {
+		a[7], a[14] = a[14], a[7]
+	}
+	if a[8] > a[15] {
+		a[8], a[15] = a[15], a[8]
+	}
+	if a[8] > a[14] {
+		a[8], a[14] = a[14], a[8]
+	}
+	if a[7] > a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[8] > a[13] {
+		a[8], a[13] = a[13], a[8]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9] > a[17] {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[9] > a[16] {
+		a[9], a[16] = a[16], a[9]
+	}
+	if a[10] > a[18] {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[11] > a[18] {
+		a[11], a[18] = a[18], a[11]
+	}
+	if a[10] > a[16] {
+		a[10], a[16] = a[16], a[10]
+	}
+	if a[11] > a[17] {
+		a[11], a[17] = a[17], a[11]
+	}
+	if a[11] > a[16] {
+		a[11], a[16] = a[16], a[11]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[9] > a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[11] > a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+}
+
+// compiledSort26 is an unrolled Bose-Nelson network.
+func compiledSort26[T cmp.Ordered](a []T) {
+	_ = a[25]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[8] > a[11] {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[0] > a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[0] > a[6] {
+		a[0], a[6] = a[6], a[0]
+	}
+	if a[1] > a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[2] > a[9] {
+		a[2], a[9] = a[9], a[2]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[1] > a[6] {
+		a[1], a[6] = a[6], a[1]
+	}
+	if a[2] > a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[3] > a[10] {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[12] {
+		a[5], a[12] = a[12], a[5]
+	}
+	if a[4] > a[10] {
+		a[4], a[10] = a[10], a[4]
+	}
+	if a[5] > a[11] {
+		a[5], a[11] = a[11], a[5]
+	}
+	if a[5] > a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[16] > a[18] {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[16] > a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[13] > a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[13] > a[16] {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[14] > a[18] {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[15] > a[18] {
+		a[15], a[18] = a[18], a[15]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[20] > a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[19] > a[21] {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[19] > a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[22] > a[23] {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[24] > a[25] {
+		a[24], a[25] = a[25], a[24]
+	}
+	if a[22] > a[24] {
+		a[22], a[24] = a[24], a[22]
+	}
+	if a[23] > a[25] {
+		a[23], a[25] = a[25], a[23]
+	}
+	if a[23] > a[24] {
+		a[23], a[24] = a[24], a[23]
+	}
+	if a[19] > a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[19] > a[22] {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[20] > a[24] {
+		a[20], a[24] = a[24], a[20]
+	}
+	if a[21] > a[25] {
+		a[21], a[25] = a[25], a[21]
+	}
+	if a[21] > a[24] {
+		a[21], a[24] = a[24], a[21]
+	}
+	if a[20] > a[22] {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[21] > a[23] {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[21] > a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[13] > a[20] {
+		a[13], a[20] = a[20], a[13]
+	}
+	if a[13] > a[19] {
+		a[13], a[19] = a[19], a[13]
+	}
+	if a[14] > a[21] {
+		a[14], a[21] = a[21], a[14]
+	}
+	if a[15] > a[22] {
+		a[15], a[22] = a[22], a[15]
+	}
+	if a[15] > a[21] {
+		a[15], a[21] = a[21], a[15]
+	}
+	if a[14] > a[19] {
+		a[14], a[19] = a[19], a[14]
+	}
+	if a[15] > a[20] {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[15] > a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[16] > a[24] {
+		a[16], a[24] = a[24], a[16]
+	}
+	if a[16] > a[23] {
+		a[16], a[23] = a[23], a[16]
+	}
+	if a[17] > a[25] {
+		a[17], a[25] = a[25], a[17]
+	}
+	if a[18] > a[25] {
+		a[18], a[25] = a[25], a[18]
+	}
+	if a[17] > a[23] {
+		a[17], a[23] = a[23], a[17]
+	}
+	if a[18] > a[24] {
+		a[18], a[24] = a[24], a[18]
+	}
+	if a[18] > a[23] {
+		a[18], a[23] = a[23], a[18]
+	}
+	if a[16] > a[20] {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[16] > a[19] {
+		a[16], a[19] = a[19], a[16]
+	}
+	if a[17] > a[21] {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[18] > a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[18] > a[21] {
+		a[18], a[21] = a[21], a[18]
+	}
+	if a[17] > a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[18] > a[20] {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[18] > a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[0] > a[14] {
+		a[0], a[14] = a[14], a[0]
+	}
+	if a[0] > a[13] {
+		a[0], a[13] = a[13], a[0]
+	}
+	if a[1] > a[15] {
+		a[1], a[15] = a[15], a[1]
+	}
+	if a[2] > a[16] {
+		a[2], a[16] = a[16], a[2]
+	}
+	if a[2] > a[15] {
+		a[2], a[15] = a[15], a[2]
+	}
+	if a[1] > a[13] {
+		a[1], a[13] = a[13], a[1]
+	}
+	if a[2] > a[14] {
+		a[2], a[14] = a[14], a[2]
+	}
+	if a[2] > a[13] {
+		a[2], a[13] = a[13], a[2]
+	}
+	if a[3] > a[18] {
+		a[3], a[18] = a[18], a[3]
+	}
+	if a[3] > a[17] {
+		a[3], a[17] = a[17], a[3]
+	}
+	if a[4] > a[19] {
+		a[4], a[19] = a[19], a[4]
+	}
+	if a[5] > a[19] {
+		a[5], a[19] = a[19], a[5]
+	}
+	if a[4] > a[17] {
+		a[4], a[17] = a[17], a[4]
+	}
+	if a[5] > a[18] {
+		a[5], a[18] = a[18], a[5]
+	}
+	if a[5] > a[17] {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[3] > a[14] {
+		a[3], a[14] = a[14], a[3]
+	}
+	if a[3] > a[13] {
+		a[3], a[13] = a[13], a[3]
+	}
+	if a[4] > a[15] {
+		a[4], a[15] = a[15], a[4]
+	}
+	if a[5] > a[16] {
+		a[5], a[16] = a[16], a[5]
+	}
+	if a[5] > a[15] {
+		a[5], a[15] = a[15], a[5]
+	}
+	if a[4] > a[13] {
+		a[4], a[13] = a[13], a[4]
+	}
+	if a[5] > a[14] {
+		a[5], a[14] = a[14], a[5]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] > a[21] {
+		a[6], a[21] = a[21], a[6]
+	}
+	if a[6] > a[20] {
+		a[6], a[20] = a[20], a[6]
+	}
+	if a[7] > a[22] {
+		a[7], a[22] = a[22], a[7]
+	}
+	if a[8] > a[22] {
+		a[8], a[22] = a[22], a[8]
+	}
+	if a[7] > a[20] {
+		a[7], a[20] = a[20], a[7]
+	}
+	if a[8] > a[21] {
+		a[8], a[21] = a[21], a[8]
+	}
+	if a[8] > a[20] {
+		a[8], a[20] = a[20], a[8]
+	}
+	if a[9] > a[23] {
+		a[9], a[23] = a[23], a[9]
+	}
+	if a[10] > a[24] {
+		a[10], a[24] = a[24], a[10]
+	}
+	if a[10] > a[23] {
+		a[10], a[23] = a[23], a[10]
+	}
+	if a[11] > a[25] {
+		a[11], a[25] = a[25], a[11]
+	}
+	if a[12] > a[25] {
+		a[12], a[25] = a[25], a[12]
+	}
+	if a[11] > a[23] {
+		a[11], a[23] = a[23], a[11]
+	}
+	if a[12] > a[24] {
+		a[12], a[24] = a[24], a[12]
+	}
+	if a[12] > a[23] {
+		a[12], a[23] = a[23], a[12]
+	}
+	if a[9] > a[20] {
+		a[9], a[20] = a[20], a[9]
+	}
+	if a[10] > a[21] {
+		a[10], a[21] = a[21], a[10]
+	}
+	if a[10] > a[20] {
+		a[10], a[20] = a[20], a[10]
+	}
+	if a[11] > a[22] {
+		a[11], a[22] = a[22], a[11]
+	}
+	if a[12] > a[22] {
+		a[12], a[22] = a[22], a[12]
+	}
+	if a[11] > a[20] {
+		a[11], a[20] = a[20], a[11]
+	}
+	if a[12] > a[21] {
+		a[12], a[21] = a[21], a[12]
+	}
+	if a[12] > a[20] {
+		a[12], a[20] = a[20], a[12]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[6] > a[13] {
+		a[6], a[13] = a[13], a[6]
+	}
+	if a[7] > a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[8] > a[16] {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[8] > a[15] {
+		a[8], a[15] = a[15], a[8]
+	}
+	if a[7] > a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[8] > a[14] {
+		a[8], a[14] = a[14], a[8]
+	}
+	if a[8] > a[13] {
+		a[8], a[13] = a[13], a[8]
+	}
+	if a[9] > a[17] {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[10] > a[18] {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[10] > a[17] {
+		a[10], a[17] = a[17], a[10]
+	}
+	if a[11] > a[19] {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[12] > a[19] {
+		a[12], a[19] = a[19], a[12]
+	}
+	if a[11] > a[17] {
+		a[11], a[17] = a[17], a[11]
+	}
+	if a[12] > a[18] {
+		a[12], a[18] = a[18], a[12]
+	}
+	if a[12] > a[17] {
+		a[12], a[17] = a[17], a[12]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[10] > a[13] {
+		a[10], a[13] = a[13], a[10]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[12] > a[15] {
+		a[12], a[15] = a[15], a[12]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+}
+
+// compiledSort27 is an unrolled Bose-Nelson network.
+func compiledSort27[T cmp.Ordered](a []T) {
+	_ = a[26]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[8] > a[11] {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[0] > a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[0] > a[6] {
+		a[0], a[6] = a[6], a[0]
+	}
+	if a[1] > a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[2] > a[9] {
+		a[2], a[9] = a[9], a[2]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[1] > a[6] {
+		a[1], a[6] = a[6], a[1]
+	}
+	if a[2] > a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[3] > a[10] {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[12] {
+		a[5], a[12] = a[12], a[5]
+	}
+	if a[4] > a[10] {
+		a[4], a[10] = a[10], a[4]
+	}
+	if a[5] > a[11] {
+		a[5], a[11] = a[11], a[5]
+	}
+	if a[5] > a[10] {
+		a[5], a[10] = a[10], a[5]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[16] > a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[18] > a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[16] > a[18] {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[17] > a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[13] > a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[13] > a[16] {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[14] > a[18] {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[15] > a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[15] > a[18] {
+		a[15], a[18] = a[18], a[15]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[21] > a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[20] > a[22] {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[20] > a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[23] > a[24] {
+		a[23], a[24] = a[24], a[23]
+	}
+	if a[25] > a[26] {
+		a[25], a[26] = a[26], a[25]
+	}
+	if a[23] > a[25] {
+		a[23], a[25] = a[25], a[23]
+	}
+	if a[24] > a[26] {
+		a[24], a[26] = a[26], a[24]
+	}
+	if a[24] > a[25] {
+		a[24], a[25] = a[25], a[24]
+	}
+	if a[20] > a[24] {
+		a[20], a[24] = a[24], a[20]
+	}
+	if a[20] > a[23] {
+		a[20], a[23] = a[23], a[20]
+	}
+	if a[21] > a[25] {
+		a[21], a[25] = a[25], a[21]
+	}
+	if a[22] > a[26] {
+		a[22], a[26] = a[26], a[22]
+	}
+	if a[22] > a[25] {
+		a[22], a[25] = a[25], a[22]
+	}
+	if a[21] > a[23] {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[22] > a[24] {
+		a[22], a[24] = a[24], a[22]
+	}
+	if a[22] > a[23] {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[13] > a[21] {
+		a[13], a[21] = a[21], a[13]
+	}
+	if a[13] > a[20] {
+		a[13], a[20] = a[20], a[13]
+	}
+	if a[14] > a[22] {
+		a[14], a[22] = a[22], a[14]
+	}
+	if a[15] > a[23] {
+		a[15], a[23] = a[23], a[15]
+	}
+	if a[15] > a[22] {
+		a[15], a[22] = a[22], a[15]
+	}
+	if a[14] > a[20] {
+		a[14], a[20] = a[20], a[14]
+	}
+	if a[15] > a[21] {
+		a[15], a[21] = a[21], a[15]
+	}
+	if a[15] > a[20] {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[16] > a[24] {
+		a[16], a[24] = a[24], a[16]
+	}
+	if a[17] > a[25] {
+		a[17], a[25] = a[25], a[17]
+	}
+	if a[17] > a[24] {
+		a[17], a[24] = a[24], a[17]
+	}
+	if a[18] > a[26] {
+		a[18], a[26] = a[26], a[18]
+	}
+	if a[19] > a[26] {
+		a[19], a[26] = a[26], a[19]
+	}
+	if a[18] > a[24] {
+		a[18], a[24] = a[24], a[18]
+	}
+	if a[19] > a[25] {
+		a[19], a[25] = a[25], a[19]
+	}
+	if a[19] > a[24] {
+		a[19], a[24] = a[24], a[19]
+	}
+	if a[16] > a[20] {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[17] > a[21] {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[17] > a[20] {
+		a[17], a[20] = a[20], a[17]
+	}
+	if a[18] > a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[19] > a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[19] > a[22] {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[18] > a[20] {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[19] > a[21] {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[19] > a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[0] > a[14] {
+		a[0], a[14] = a[14], a[0]
+	}
+	if a[0] > a[13] {
+		a[0], a[13] = a[13], a[0]
+	}
+	if a[1] > a[15] {
+		a[1], a[15] = a[15], a[1]
+	}
+	if a[2] > a[16] {
+		a[2], a[16] = a[16], a[2]
+	}
+	if a[2] > a[15] {
+		a[2], a[15] = a[15], a[2]
+	}
+	if a[1] > a[13] {
+		a[1], a[13] = a[13], a[1]
+	}
+	if a[2] > a[14] {
+		a[2], a[14] = a[14], a[2]
+	}
+	if a[2] > a[13] {
+		a[2], a[13] = a[13], a[2]
+	}
+	if a[3] > a[18] {
+		a[3], a[18] = a[18], a[3]
+	}
+	if a[3] > a[17] {
+		a[3], a[17] = a[17], a[3]
+	}
+	if a[4] > a[19] {
+		a[4], a[19] = a[19], a[4]
+	}
+	if a[5] > a[19] {
+		a[5], a[19] = a[19], a[5]
+	}
+	if a[4] > a[17] {
+		a[4], a[17] = a[17], a[4]
+	}
+	if a[5] > a[18] {
+		a[5], a[18] = a[18], a[5]
+	}
+	if a[5] > a[17] {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[3] > a[14] {
+		a[3], a[14] = a[14], a[3]
+	}
+	if a[3] > a[13] {
+		a[3], a[13] = a[13], a[3]
+	}
+	if a[4] > a[15] {
+		a[4], a[15] = a[15], a[4]
+	}
+	if a[5] > a[16] {
+		a[5], a[16] = a[16], a[5]
+	}
+	if a[5] > a[15] {
+		a[5], a[15] = a[15], a[5]
+	}
+	if a[4] > a[13] {
+		a[4], a[13] = a[13], a[4]
+	}
+	if a[5] > a[14] {
+		a[5], a[14] = a[14], a[5]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] > a[21] {
+		a[6], a[21] = a[21], a[6]
+	}
+	if a[6] > a[20] {
+		a[6], a[20] = a[20], a[6]
+	}
+	if a[7] > a[22] {
+		a[7], a[22] = a[22], a[7]
+	}
+	if a[8] > a[23] {
+		a[8], a[23] = a[23], a[8]
+	}
+	if a[8] > a[22] {
+		a[8], a[22] = a[22], a[8]
+	}
+	if a[7] > a[20] {
+		a[7], a[20] = a[20], a[7]
+	}
+	if a[8] > a[21] {
+		a[8], a[21] = a[21], a[8]
+	}
+	if a[8] > a[20] {
+		a[8], a[20] = a[20], a[8]
+	}
+	if a[9] > a[24] {
+		a[9], a[24] = a[24], a[9]
+	}
+	if a[10] > a[25] {
+		a[10], a[25] = a[25], a[10]
+	}
+	if a[10] > a[24] {
+		a[10], a[24] = a[24], a[10]
+	}
+	if a[11] > a[26] {
+		a[11], a[26] = a[26], a[11]
+	}
+	if a[12] > a[26] {
+		a[12], a[26] = a[26], a[12]
+	}
+	if a[11] > a[24] {
+		a[11], a[24] = a[24], a[11]
+	}
+	if a[12] > a[25] {
+		a[12], a[25] = a[25], a[12]
+	}
+	if a[12] > a[24] {
+		a[12], a[24] = a[24], a[12]
+	}
+	if a[9] > a[20] {
+		a[9], a[20] = a[20], a[9]
+	}
+	if a[10] > a[21] {
+		a[10], a[21] = a[21], a[10]
+	}
+	if a[10] > a[20] {
+		a[10], a[20] = a[20], a[10]
+	}
+	if a[11] > a[22] {
+		a[11], a[22] = a[22], a[11]
+	}
+	if a[12] > a[23] {
+		a[12], a[23] = a[23], a[12]
+	}
+	if a[12] > a[22] {
+		a[12], a[22] = a[22], a[12]
+	}
+	if a[11] > a[20] {
+		a[11], a[20] = a[20], a[11]
+	}
+	if a[12] > a[21] {
+		a[12], a[21] = a[21], a[12]
+	}
+	if a[12] > a[20] {
+		a[12], a[20] = a[20], a[12]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[6] > a[13] {
+		a[6], a[13] = a[13], a[6]
+	}
+	if a[7] > a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[8] > a[16] {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[8] > a[15] {
+		a[8], a[15] = a[15], a[8]
+	}
+	if a[7] > a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[8] > a[14] {
+		a[8], a[14] = a[14], a[8]
+	}
+	if a[8] > a[13] {
+		a[8], a[13] = a[13], a[8]
+	}
+	if a[9] > a[17] {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[10] > a[18] {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[10] > a[17] {
+		a[10], a[17] = a[17], a[10]
+	}
+	if a[11] > a[19] {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[12] > a[19] {
+		a[12], a[19] = a[19], a[12]
+	}
+	if a[11] > a[17] {
+		a[11], a[17] = a[17], a[11]
+	}
+	if a[12] > a[18] {
+		a[12], a[18] = a[18], a[12]
+	}
+	if a[12] > a[17] {
+		a[12], a[17] = a[17], a[12]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[10] > a[13] {
+		a[10], a[13] = a[13], a[10]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[12] > a[15] {
+		a[12], a[15] = a[15], a[12]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+}
+
+// compiledSort28 is an unrolled Bose-Nelson network.
+func compiledSort28[T cmp.Ordered](a []T) {
+	_ = a[27]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[9] > a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[0] > a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[0] > a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[1] > a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2] > a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[2] > a[9] {
+		a[2], a[9] = a[9], a[2]
+	}
+	if a[1] > a[7] {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[2] > a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[4] > a[11] {
+		a[4], a[11] = a[11], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] > a[13] {
+		a[6], a[13] = a[13], a[6]
+	}
+	if a[5] > a[11] {
+		a[5], a[11] = a[11], a[5]
+	}
+	if a[6] > a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[6] > a[11] {
+		a[6], a[11] = a[11], a[6]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4] > a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[19] > a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[17] > a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[18] > a[20] {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[18] > a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[14] > a[18] {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[14] > a[17] {
+		a[14], a[17] = a[17], a[14]
+	}
+	if a[15] > a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[16] > a[20] {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[16] > a[19] {
+		a[16], a[19] = a[19], a[16]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[16] > a[18] {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[16] > a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[22] > a[23] {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[21] > a[23] {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[21] > a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[24] > a[25] {
+		a[24], a[25] = a[25], a[24]
+	}
+	if a[26] > a[27] {
+		a[26], a[27] = a[27], a[26]
+	}
+	if a[24] > a[26] {
+		a[24], a[26] = a[26], a[24]
+	}
+	if a[25] > a[27] {
+		a[25], a[27] = a[27], a[25]
+	}
+	if a[25] > a[26] {
+		a[25], a[26] = a[26], a[25]
+	}
+	if a[21] > a[25] {
+		a[21], a[25] = a[25], a[21]
+	}
+	if a[21] > a[24] {
+		a[21], a[24] = a[24], a[21]
+	}
+	if a[22] > a[26] {
+		a[22], a[26] = a[26], a[22]
+	}
+	if a[23] > a[27] {
+		a[23], a[27] = a[27], a[23]
+	}
+	if a[23] > a[26] {
+		a[23], a[26] = a[26], a[23]
+	}
+	if a[22] > a[24] {
+		a[22], a[24] = a[24], a[22]
+	}
+	if a[23] > a[25] {
+		a[23], a[25] = a[25], a[23]
+	}
+	if a[23] > a[24] {
+		a[23], a[24] = a[24], a[23]
+	}
+	if a[14] > a[22] {
+		a[14], a[22] = a[22], a[14]
+	}
+	if a[14] > a[21] {
+		a[14], a[21] = a[21], a[14]
+	}
+	if a[15] > a[23] {
+		a[15], a[23] = a[23], a[15]
+	}
+	if a[16] > a[24] {
+		a[16], a[24] = a[24], a[16]
+	}
+	if a[16] > a[23] {
+		a[16], a[23] = a[23], a[16]
+	}
+	if a[15] > a[21] {
+		a[15], a[21] = a[21], a[15]
+	}
+	if a[16] > a[22] {
+		a[16], a[22] = a[22], a[16]
+	}
+	if a[16] > a[21] {
+		a[16], a[21] = a[21], a[16]
+	}
+	if a[17] > a[25] {
+		a[17], a[25] = a[25], a[17]
+	}
+	if a[18] > a[26] {
+		a[18], a[26] = a[26], a[18]
+	}
+	if a[18] > a[25] {
+		a[18], a[25] = a[25], a[18]
+	}
+	if a[19] > a[27] {
+		a[19], a[27] = a[27], a[19]
+	}
+	if a[20] > a[27] {
+		a[20], a[27] = a[27], a[20]
+	}
+	if a[19] > a[25] {
+		a[19], a[25] = a[25], a[19]
+	}
+	if a[20] > a[26] {
+		a[20], a[26] = a[26], a[20]
+	}
+	if a[20] > a[25] {
+		a[20], a[25] = a[25], a[20]
+	}
+	if a[17] > a[21] {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[18] > a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[18] > a[21] {
+		a[18], a[21] = a[21], a[18]
+	}
+	if a[19] > a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[20] > a[24] {
+		a[20], a[24] = a[24], a[20]
+	}
+	if a[20] > a[23] {
+		a[20], a[23] = a[23], a[20]
+	}
+	if a[19] > a[21] {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[20] > a[22] {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[20] > a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[0] > a[15] {
+		a[0], a[15] = a[15], a[0]
+	}
+	if a[0] > a[14] {
+		a[0], a[14] = a[14], a[0]
+	}
+	if a[1] > a[16] {
+		a[1], a[16] = a[16], a[1]
+	}
+	if a[2] > a[17] {
+		a[2], a[17] = a[17], a[2]
+	}
+	if a[2] > a[16] {
+		a[2], a[16] = a[16], a[2]
+	}
+	if a[1] > a[14] {
+		a[1], a[14] = a[14], a[1]
+	}
+	if a[2] > a[15] {
+		a[2], a[15] = a[15], a[2]
+	}
+	if a[2] > a[14] {
+		a[2], a[14] = a[14], a[2]
+	}
+	if a[3] > a[18] {
+		a[3], a[18] = a[18], a[3]
+	}
+	if a[4] > a[19] {
+		a[4], a[19] = a[19], a[4]
+	}
+	if a[4] > a[18] {
+		a[4], a[18] = a[18], a[4]
+	}
+	if a[5] > a[20] {
+		a[5], a[20] = a[20], a[5]
+	}
+	if a[6] > a[20] {
+		a[6], a[20] = a[20], a[6]
+	}
+	if a[5] > a[18] {
+		a[5], a[18] = a[18], a[5]
+	}
+	if a[6] > a[19] {
+		a[6], a[19] = a[19], a[6]
+	}
+	if a[6] > a[18] {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[3] > a[14] {
+		a[3], a[14] = a[14], a[3]
+	}
+	if a[4] > a[15] {
+		a[4], a[15] = a[15], a[4]
+	}
+	if a[4] > a[14] {
+		a[4], a[14] = a[14], a[4]
+	}
+	if a[5] > a[16] {
+		a[5], a[16] = a[16], a[5]
+	}
+	if a[6] > a[17] {
+		a[6], a[17] = a[17], a[6]
+	}
+	if a[6] > a[16] {
+		a[6], a[16] = a[16], a[6]
+	}
+	if a[5] > a[14] {
+		a[5], a[14] = a[14], a[5]
+	}
+	if a[6] > a[15] {
+		a[6], a[15] = a[15], a[6]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7] > a[22] {
+		a[7], a[22] = a[22], a[7]
+	}
+	if a[7] > a[21] {
+		a[7], a[21] = a[21], a[7]
+	}
+	if a[8] > a[23] {
+		a[8], a[23] = a[23], a[8]
+	}
+	if a[9] > a[24] {
+		a[9], a[24] = a[24], a[9]
+	}
+	if a[9] > a[23] {
+		a[9], a[23] = a[23], a[9]
+	}
+	if a[8] > a[21] {
+		a[8], a[21] = a[21], a[8]
+	}
+	if a[9] > a[22] {
+		a[9], a[22] = a[22], a[9]
+	}
+	if a[9] > a[21] {
+		a[9], a[21] = a[21], a[9]
+	}
+	if a[10] > a[25] {
+		a[10], a[25] = a[25], a[10]
+	}
+	if a[11] > a[26] {
+		a[11], a[26] = a[26], a[11]
+	}
+	if a[11] > a[25] {
+		a[11], a[25] = a[25], a[11]
+	}
+	if a[12] > a[27] {
+		a[12], a[27] = a[27], a[12]
+	}
+	if a[13] > a[27] {
+		a[13], a[27] = a[27], a[13]
+	}
+	if a[12] > a[25] {
+		a[12], a[25] = a[25], a[12]
+	}
+	if a[13] > a[26] {
+		a[13], a[26] = a[26], a[13]
+	}
+	if a[13] > a[25] {
+		a[13], a[25] = a[25], a[13]
+	}
+	if a[10] > a[21] {
+		a[10], a[21] = a[21], a[10]
+	}
+	if a[11] > a[22] {
+		a[11], a[22] = a[22], a[11]
+	}
+	if a[11] > a[21] {
+		a[11], a[21] = a[21], a[11]
+	}
+	if a[12] > a[23] {
+		a[12], a[23] = a[23], a[12]
+	}
+	if a[13] > a[24] {
+		a[13], a[24] = a[24], a[13]
+	}
+	if a[13] > a[23] {
+		a[13], a[23] = a[23], a[13]
+	}
+	if a[12] > a[21] {
+		a[12], a[21] = a[21], a[12]
+	}
+	if a[13] > a[22] {
+		a[13], a[22] = a[22], a[13]
+	}
+	if a[13] > a[21] {
+		a[13], a[21] = a[21], a[13]
+	}
+	if a[7] > a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[7] > a[14] {
+		a[7], a[14] = a[14], a[7]
+	}
+	if a[8] > a[16] {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[9] > a[17] {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[9] > a[16] {
+		a[9], a[16] = a[16], a[9]
+	}
+	if a[8] > a[14] {
+		a[8], a[14] = a[14], a[8]
+	}
+	if a[9] > a[15] {
+		a[9], a[15] = a[15], a[9]
+	}
+	if a[9] > a[14] {
+		a[9], a[14] = a[14], a[9]
+	}
+	if a[10] > a[18] {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[11] > a[19] {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[11] > a[18] {
+		a[11], a[18] = a[18], a[11]
+	}
+	if a[12] > a[20] {
+		a[12], a[20] = a[20], a[12]
+	}
+	if a[13] > a[20] {
+		a[13], a[20] = a[20], a[13]
+	}
+	if a[12] > a[18] {
+		a[12], a[18] = a[18], a[12]
+	}
+	if a[13] > a[19] {
+		a[13], a[19] = a[19], a[13]
+	}
+	if a[13] > a[18] {
+		a[13], a[18] = a[18], a[13]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[11] > a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[13] > a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[13] > a[16] {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+}
+
+// compiledSort29 is an unrolled Bose-Nelson network.
+func compiledSort29[T cmp.Ordered](a []T) {
+	_ = a[28]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[9] > a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[0] > a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[0] > a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[1] > a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2] > a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[2] > a[9] {
+		a[2], a[9] = a[9], a[2]
+	}
+	if a[1] > a[7] {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[2] > a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[4] > a[11] {
+		a[4], a[11] = a[11], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] > a[13] {
+		a[6], a[13] = a[13], a[6]
+	}
+	if a[5] > a[11] {
+		a[5], a[11] = a[11], a[5]
+	}
+	if a[6] > a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[6] > a[11] {
+		a[6], a[11] = a[11], a[6]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4] > a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[19] > a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[17] > a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[18] > a[20] {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[18] > a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[14] > a[18] {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[14] > a[17] {
+		a[14], a[17] = a[17], a[14]
+	}
+	if a[15] > a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[16] > a[20] {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[16] > a[19] {
+		a[16], a[19] = a[19], a[16]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[16] > a[18] {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[16] > a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[21] > a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[23] > a[24] {
+		a[23], a[24] = a[24], a[23]
+	}
+	if a[21] > a[23] {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[22] > a[24] {
+		a[22], a[24] = a[24], a[22]
+	}
+	if a[22] > a[23] {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[25] > a[26] {
+		a[25], a[26] = a[26], a[25]
+	}
+	if a[27] > a[28] {
+		a[27], a[28] = a[28], a[27]
+	}
+	if a[25] > a[27] {
+		a[25], a[27] = a[27], a[25]
+	}
+	if a[26] > a[28] {
+		a[26], a[28] = a[28], a[26]
+	}
+	if a[26] > a[27] {
+		a[26], a[27] = a[27], a[26]
+	}
+	if a[21] > a[25] {
+		a[21], a[25] = a[25], a[21]
+	}
+	if a[22] > a[26] {
+		a[22], a[26] = a[26], a[22]
+	}
+	if a[22] > a[25] {
+		a[22], a[25] = a[25], a[22]
+	}
+	if a[23] > a[27] {
+		a[23], a[27] = a[27], a[23]
+	}
+	if a[24] > a[28] {
+		a[24], a[28] = a[28], a[24]
+	}
+	if a[24] > a[27] {
+		a[24], a[27] = a[27], a[24]
+	}
+	if a[23] > a[25] {
+		a[23], a[25] = a[25], a[23]
+	}
+	if a[24] > a[26] {
+		a[24], a[26] = a[26], a[24]
+	}
+	if a[24] > a[25] {
+		a[24], a[25] = a[25], a[24]
+	}
+	if a[14] > a[22] {
+		a[14], a[22] = a[22], a[14]
+	}
+	if a[14] > a[21] {
+		a[14], a[21] = a[21], a[14]
+	}
+	if a[15] > a[23] {
+		a[15], a[23] = a[23], a[15]
+	}
+	if a[16] > a[24] {
+		a[16], a[24] = a[24], a[16]
+	}
+	if a[16] > a[23] {
+		a[16], a[23] = a[23], a[16]
+	}
+	if a[15] > a[21] {
+		a[15], a[21] = a[21], a[15]
+	}
+	if a[16] > a[22] {
+		a[16], a[22] = a[22], a[16]
+	}
+	if a[16] > a[21] {
+		a[16], a[21] = a[21], a[16]
+	}
+	if a[17] > a[25] {
+		a[17], a[25] = a[25], a[17]
+	}
+	if a[18] > a[26] {
+		a[18], a[26] = a[26], a[18]
+	}
+	if a[18] > a[25] {
+		a[18], a[25] = a[25], a[18]
+	}
+	if a[19] > a[27] {
+		a[19], a[27] = a[27], a[19]
+	}
+	if a[20] > a[28] {
+		a[20], a[28] = a[28], a[20]
+	}
+	if a[20] > a[27] {
+		a[20], a[27] = a[27], a[20]
+	}
+	if a[19] > a[25] {
+		a[19], a[25] = a[25], a[19]
+	}
+	if a[20] > a[26] {
+		a[20], a[26] = a[26], a[20]
+	}
+	if a[20] > a[25] {
+		a[20], a[25] = a[25], a[20]
+	}
+	if a[17] > a[21] {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[18] > a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[18] > a[21] {
+		a[18], a[21] = a[21], a[18]
+	}
+	if a[19] > a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[20] > a[24] {
+		a[20], a[24] = a[24], a[20]
+	}
+	if a[20] > a[23] {
+		a[20], a[23] = a[23], a[20]
+	}
+	if a[19] > a[21] {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[20] > a[22] {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[20] > a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[0] > a[15] {
+		a[0], a[15] = a[15], a[0]
+	}
+	if a[0] > a[14] {
+		a[0], a[14] = a[14], a[0]
+	}
+	if a[1] > a[16] {
+		a[1], a[16] = a[16], a[1]
+	}
+	if a[2] > a[17] {
+		a[2], a[17] = a[17], a[2]
+	}
+	if a[2] > a[16] {
+		a[2], a[16] = a[16], a[2]
+	}
+	if a[1] > a[14] {
+		a[1], a[14] = a[14], a[1]
+	}
+	if a[2] > a[15] {
+		a[2], a[15] = a[15], a[2]
+	}
+	if a[2] > a[14] {
+		a[2], a[14] = a[14], a[2]
+	}
+	if a[3] > a[18] {
+		a[3], a[18] = a[18], a[3]
+	}
+	if a[4] > a[19] {
+		a[4], a[19] = a[19], a[4]
+	}
+	if a[4] > a[18] {
+		a[4], a[18] = a[18], a[4]
+	}
+	if a[5] > a[20] {
+		a[5], a[20] = a[20], a[5]
+	}
+	if a[6] > a[21] {
+		a[6], a[21] = a[21], a[6]
+	}
+	if a[6] > a[20] {
+		a[6], a[20] = a[20], a[6]
+	}
+	if a[5] > a[18] {
+		a[5], a[18] = a[18], a[5]
+	}
+	if a[6] > a[19] {
+		a[6], a[19] = a[19], a[6]
+	}
+	if a[6] > a[18] {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[3] > a[14] {
+		a[3], a[14] = a[14], a[3]
+	}
+	if a[4] > a[15] {
+		a[4], a[15] = a[15], a[4]
+	}
+	if a[4] > a[14] {
+		a[4], a[14] = a[14], a[4]
+	}
+	if a[5] > a[16] {
+		a[5], a[16] = a[16], a[5]
+	}
+	if a[6] > a[17] {
+		a[6], a[17] = a[17], a[6]
+	}
+	if a[6] > a[16] {
+		a[6], a[16] = a[16], a[6]
+	}
+	if a[5] > a[14] {
+		a[5], a[14] = a[14], a[5]
+	}
+	if a[6] > a[15] {
+		a[6], a[15] = a[15], a[6]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7] > a[23] {
+		a[7], a[23] = a[23], a[7]
+	}
+	if a[7] > a[22] {
+		a[7], a[22] = a[22], a[7]
+	}
+	if a[8] > a[24] {
+		a[8], a[24] = a[24], a[8]
+	}
+	if a[9] > a[25] {
+		a[9], a[25] = a[25], a[9]
+	}
+	if a[9] > a[24] {
+		a[9], a[24] = a[24], a[9]
+	}
+	if a[8] > a[22] {
+		a[8], a[22] = a[22], a[8]
+	}
+	if a[9] > a[23] {
+		a[9], a[23] = a[23], a[9]
+	}
+	if a[9] > a[22] {
+		a[9], a[22] = a[22], a[9]
+	}
+	if a[10] > a[26] {
+		a[10], a[26] = a[26], a[10]
+	}
+	if a[11] > a[27] {
+		a[11], a[27] = a[27], a[11]
+	}
+	if a[11] > a[26] {
+		a[11], a[26] = a[26], a[11]
+	}
+	if a[12] > a[28] {
+		a[12], a[28] = a[28], a[12]
+	}
+	if a[13] > a[28] {
+		a[13], a[28] = a[28], a[13]
+	}
+	if a[12] > a[26] {
+		a[12], a[26] = a[26], a[12]
+	}
+	if a[13] > a[27] {
+		a[13], a[27] = a[27], a[13]
+	}
+	if a[13] > a[26] {
+		a[13], a[26] = a[26], a[13]
+	}
+	if a[10] > a[22] {
+		a[10], a[22] = a[22], a[10]
+	}
+	if a[11] > a[23] {
+		a[11], a[23] = a[23], a[11]
+	}
+	if a[11] > a[22] {
+		a[11], a[22] = a[22], a[11]
+	}
+	if a[12] > a[24] {
+		a[12], a[24] = a[24], a[12]
+	}
+	if a[13] > a[25] {
+		a[13], a[25] = a[25], a[13]
+	}
+	if a[13] > a[24] {
+		a[13], a[24] = a[24], a[13]
+	}
+	if a[12] > a[22] {
+		a[12], a[22] = a[22], a[12]
+	}
+	if a[13] > a[23] {
+		a[13], a[23] = a[23], a[13]
+	}
+	if a[13] > a[22] {
+		a[13], a[22] = a[22], a[13]
+	}
+	if a[7] > a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[7] > a[14] {
+		a[7], a[14] = a[14], a[7]
+	}
+	if a[8] > a[16] {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[9] > a[17] {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[9] > a[16] {
+		a[9], a[16] = a[16], a[9]
+	}
+	if a[8] > a[14] {
+		a[8], a[14] = a[14], a[8]
+	}
+	if a[9] > a[15] {
+		a[9], a[15] = a[15], a[9]
+	}
+	if a[9] > a[14] {
+		a[9], a[14] = a[14], a[9]
+	}
+	if a[10] > a[18] {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[11] > a[19] {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[11] > a[18] {
+		a[11], a[18] = a[18], a[11]
+	}
+	if a[12] > a[20] {
+		a[12], a[20] = a[20], a[12]
+	}
+	if a[13] > a[21] {
+		a[13], a[21] = a[21], a[13]
+	}
+	if a[13] > a[20] {
+		a[13], a[20] = a[20], a[13]
+	}
+	if a[12] > a[18] {
+		a[12], a[18] = a[18], a[12]
+	}
+	if a[13] > a[19] {
+		a[13], a[19] = a[19], a[13]
+	}
+	if a[13] > a[18] {
+		a[13], a[18] = a[18], a[13]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[11] > a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[13] > a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[13] > a[16] {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+}
+
+// compiledSort30 is an unrolled Bose-Nelson network.
+func compiledSort30[T cmp.Ordered](a []T) {
+	_ = a[29]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[8] > a[11] {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[10] > a[13] {
+		a[10], a[13] = a[13], a[10]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[0] > a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[0] > a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[1] > a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2] > a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[2] > a[9] {
+		a[2], a[9] = a[9], a[2]
+	}
+	if a[1] > a[7] {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[2] > a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[4] > a[11] {
+		a[4], a[11] = a[11], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[6] > a[13] {
+		a[6], a[13] = a[13], a[6]
+	}
+	if a[5] > a[11] {
+		a[5], a[11] = a[11], a[5]
+	}
+	if a[6] > a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[6] > a[11] {
+		a[6], a[11] = a[11], a[6]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4] > a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[16] > a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[18] > a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[20] > a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[18] > a[20] {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[19] > a[21] {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[19] > a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[15] > a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[15] > a[18] {
+		a[15], a[18] = a[18], a[15]
+	}
+	if a[16] > a[20] {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[17] > a[21] {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[17] > a[20] {
+		a[17], a[20] = a[20], a[17]
+	}
+	if a[16] > a[18] {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[17] > a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[22] > a[23] {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[24] > a[25] {
+		a[24], a[25] = a[25], a[24]
+	}
+	if a[22] > a[24] {
+		a[22], a[24] = a[24], a[22]
+	}
+	if a[23] > a[25] {
+		a[23], a[25] = a[25], a[23]
+	}
+	if a[23] > a[24] {
+		a[23], a[24] = a[24], a[23]
+	}
+	if a[26] > a[27] {
+		a[26], a[27] = a[27], a[26]
+	}
+	if a[28] > a[29] {
+		a[28], a[29] = a[29], a[28]
+	}
+	if a[26] > a[28] {
+		a[26], a[28] = a[28], a[26]
+	}
+	if a[27] > a[29] {
+		a[27], a[29] = a[29], a[27]
+	}
+	if a[27] > a[28] {
+		a[27], a[28] = a[28], a[27]
+	}
+	if a[22] > a[26] {
+		a[22], a[26] = a[26], a[22]
+	}
+	if a[23] > a[27] {
+		a[23], a[27] = a[27], a[23]
+	}
+	if a[23] > a[26] {
+		a[23], a[26] = a[26], a[23]
+	}
+	if a[24] > a[28] {
+		a[24], a[28] = a[28], a[24]
+	}
+	if a[25] > a[29] {
+		a[25], a[29] = a[29], a[25]
+	}
+	if a[25] > a[28] {
+		a[25], a[28] = a[28], a[25]
+	}
+	if a[24] > a[26] {
+		a[24], a[26] = a[26], a[24]
+	}
+	if a[25] > a[27] {
+		a[25], a[27] = a[27], a[25]
+	}
+	if a[25] > a[26] {
+		a[25], a[26] = a[26], a[25]
+	}
+	if a[15] > a[23] {
+		a[15], a[23] = a[23], a[15]
+	}
+	if a[15] > a[22] {
+		a[15], a[22] = a[22], a[15]
+	}
+	if a[16] > a[24] {
+		a[16], a[24] = a[24], a[16]
+	}
+	if a[17] > a[25] {
+		a[17], a[25] = a[25], a[17]
+	}
+	if a[17] > a[24] {
+		a[17], a[24] = a[24], a[17]
+	}
+	if a[16] > a[22] {
+		a[16], a[22] = a[22], a[16]
+	}
+	if a[17] > a[23] {
+		a[17], a[23] = a[23], a[17]
+	}
+	if a[17] > a[22] {
+		a[17], a[22] = a[22], a[17]
+	}
+	if a[18] > a[26] {
+		a[18], a[26] = a[26], a[18]
+	}
+	if a[19] > a[27] {
+		a[19], a[27] = a[27], a[19]
+	}
+	if a[19] > a[26] {
+		a[19], a[26] = a[26], a[19]
+	}
+	if a[20] > a[28] {
+		a[20], a[28] = a[28], a[20]
+	}
+	if a[21] > a[29] {
+		a[21], a[29] = a[29], a[21]
+	}
+	if a[21] > a[28] {
+		a[21], a[28] = a[28], a[21]
+	}
+	if a[20] > a[26] {
+		a[20], a[26] = a[26], a[20]
+	}
+	if a[21] > a[27] {
+		a[21], a[27] = a[27], a[21]
+	}
+	if a[21] > a[26] {
+		a[21], a[26] = a[26], a[21]
+	}
+	if a[18] > a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[19] > a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[19] > a[22] {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[20] > a[24] {
+		a[20], a[24] = a[24], a[20]
+	}
+	if a[21] > a[25] {
+		a[21], a[25] = a[25], a[21]
+	}
+	if a[21] > a[24] {
+		a[21], a[24] = a[24], a[21]
+	}
+	if a[20] > a[22] {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[21] > a[23] {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[21] > a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[0] > a[16] {
+		a[0], a[16] = a[16], a[0]
+	}
+	if a[0] > a[15] {
+		a[0], a[15] = a[15], a[0]
+	}
+	if a[1] > a[17] {
+		a[1], a[17] = a[17], a[1]
+	}
+	if a[2] > a[18] {
+		a[2], a[18] = a[18], a[2]
+	}
+	if a[2] > a[17] {
+		a[2], a[17] = a[17], a[2]
+	}
+	if a[1] > a[15] {
+		a[1], a[15] = a[15], a[1]
+	}
+	if a[2] > a[16] {
+		a[2], a[16] = a[16], a[2]
+	}
+	if a[2] > a[15] {
+		a[2], a[15] = a[15], a[2]
+	}
+	if a[3] > a[19] {
+		a[3], a[19] = a[19], a[3]
+	}
+	if a[4] > a[20] {
+		a[4], a[20] = a[20], a[4]
+	}
+	if a[4] > a[19] {
+		a[4], a[19] = a[19], a[4]
+	}
+	if a[5] > a[21] {
+		a[5], a[21] = a[21], a[5]
+	}
+	if a[6] > a[22] {
+		a[6], a[22] = a[22], a[6]
+	}
+	if a[6] > a[21] {
+		a[6], a[21] = a[21], a[6]
+	}
+	if a[5] > a[19] {
+		a[5], a[19] = a[19], a[5]
+	}
+	if a[6] > a[20] {
+		a[6], a[20] = a[20], a[6]
+	}
+	if a[6] > a[19] {
+		a[6], a[19] = a[19], a[6]
+	}
+	if a[3] > a[15] {
+		a[3], a[15] = a[15], a[3]
+	}
+	if a[4] > a[16] {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[4] > a[15] {
+		a[4], a[15] = a[15], a[4]
+	}
+	if a[5] > a[17] {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[6] > a[18] {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[6] > a[17] {
+		a[6], a[17] = a[17], a[6]
+	}
+	if a[5] > a[15] {
+		a[5], a[15] = a[15], a[5]
+	}
+	if a[6] > a[16] {
+		a[6], a[16] = a[16], a[6]
+	}
+	if a[6] > a[15] {
+		a[6], a[15] = a[15], a[6]
+	}
+	if a[7] > a[23] {
+		a[7], a[23] = a[23], a[7]
+	}
+	if a[8] > a[24] {
+		a[8], a[24] = a[24], a[8]
+	}
+	if a[8] > a[23] {
+		a[8], a[23] = a[23], a[8]
+	}
+	if a[9] > a[25] {
+		a[9], a[25] = a[25], a[9]
+	}
+	if a[10] > a[26] {
+		a[10], a[26] = a[26], a[10]
+	}
+	if a[10] > a[25] {
+		a[10], a[25] = a[25], a[10]
+	}
+	if a[9] > a[23] {
+		a[9], a[23] = a[23], a[9]
+	}
+	if a[10] > a[24] {
+		a[10], a[24] = a[24], a[10]
+	}
+	if a[10] > a[23] {
+		a[10], a[23] = a[23], a[10]
+	}
+	if a[11] > a[27] {
+		a[11], a[27] = a[27], a[11]
+	}
+	if a[12] > a[28] {
+		a[12], a[28] = a[28], a[12]
+	}
+	if a[12] > a[27] {
+		a[12], a[27] = a[27], a[12]
+	}
+	if a[13] > a[29] {
+		a[13], a[29] = a[29], a[13]
+	}
+	if a[14] > a[29] {
+		a[14], a[29] = a[29], a[14]
+	}
+	if a[13] > a[27] {
+		a[13], a[27] = a[27], a[13]
+	}
+	if a[14] > a[28] {
+		a[14], a[28] = a[28], a[14]
+	}
+	if a[14] > a[27] {
+		a[14], a[27] = a[27], a[14]
+	}
+	if a[11] > a[23] {
+		a[11], a[23] = a[23], a[11]
+	}
+	if a[12] > a[24] {
+		a[12], a[24] = a[24], a[12]
+	}
+	if a[12] > a[23] {
+		a[12], a[23] = a[23], a[12]
+	}
+	if a[13] > a[25] {
+		a[13], a[25] = a[25], a[13]
+	}
+	if a[14] > a[26] {
+		a[14], a[26] = a[26], a[14]
+	}
+	if a[14] > a[25] {
+		a[14], a[25] = a[25], a[14]
+	}
+	if a[13] > a[23] {
+		a[13], a[23] = a[23], a[13]
+	}
+	if a[14] > a[24] {
+		a[14], a[24] = a[24], a[14]
+	}
+	if a[14] > a[23] {
+		a[14], a[23] = a[23], a[14]
+	}
+	if a[7] > a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[8] > a[16] {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[8] > a[15] {
+		a[8], a[15] = a[15], a[8]
+	}
+	if a[9] > a[17] {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[10] > a[18] {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[10] > a[17] {
+		a[10], a[17] = a[17], a[10]
+	}
+	if a[9] > a[15] {
+		a[9], a[15] = a[15], a[9]
+	}
+	if a[10] > a[16] {
+		a[10], a[16] = a[16], a[10]
+	}
+	if a[10] > a[15] {
+		a[10], a[15] = a[15], a[10]
+	}
+	if a[11] > a[19] {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[12] > a[20] {
+		a[12], a[20] = a[20], a[12]
+	}
+	if a[12] > a[19] {
+		a[12], a[19] = a[19], a[12]
+	}
+	if a[13] > a[21] {
+		a[13], a[21] = a[21], a[13]
+	}
+	if a[14] > a[22] {
+		a[14], a[22] = a[22], a[14]
+	}
+	if a[14] > a[21] {
+		a[14], a[21] = a[21], a[14]
+	}
+	if a[13] > a[19] {
+		a[13], a[19] = a[19], a[13]
+	}
+	if a[14] > a[20] {
+		a[14], a[20] = a[20], a[14]
+	}
+	if a[14] > a[19] {
+		a[14], a[19] = a[19], a[14]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[12] > a[15] {
+		a[12], a[15] = a[15], a[12]
+	}
+	if a[13] > a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[14] > a[18] {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[14] > a[17] {
+		a[14], a[17] = a[17], a[14]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+}
+
+// compiledSort31 is an unrolled Bose-Nelson network.
+func compiledSort31[T cmp.Ordered](a []T) {
+	_ = a[30]
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[0] > a[3] {
+		a[0], a[3] = a[3], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[2] > a[5] {
+		a[2], a[5] = a[5], a[2]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[8] > a[11] {
+		a[8], a[11] = a[11], a[8]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[10] > a[13] {
+		a[10], a[13] = a[13], a[10]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[0] > a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[0] > a[7] {
+		a[0], a[7] = a[7], a[0]
+	}
+	if a[1] > a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[2] > a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[2] > a[9] {
+		a[2], a[9] = a[9], a[2]
+	}
+	if a[1] > a[7] {
+		a[1], a[7] = a[7], a[1]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[2] > a[7] {
+		a[2], a[7] = a[7], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[4] > a[11] {
+		a[4], a[11] = a[11], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[6] > a[13] {
+		a[6], a[13] = a[13], a[6]
+	}
+	if a[5] > a[11] {
+		a[5], a[11] = a[11], a[5]
+	}
+	if a[6] > a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[6] > a[11] {
+		a[6], a[11] = a[11], a[6]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[4] > a[7] {
+		a[4], a[7] = a[7], a[4]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[6] > a[9] {
+		a[6], a[9] = a[9], a[6]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[16] > a[18] {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[16] > a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[19] > a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[21] > a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[19] > a[21] {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[20] > a[22] {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[20] > a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[15] > a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[16] > a[20] {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[16] > a[19] {
+		a[16], a[19] = a[19], a[16]
+	}
+	if a[17] > a[21] {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[18] > a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[18] > a[21] {
+		a[18], a[21] = a[21], a[18]
+	}
+	if a[17] > a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[18] > a[20] {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[18] > a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[23] > a[24] {
+		a[23], a[24] = a[24], a[23]
+	}
+	if a[25] > a[26] {
+		a[25], a[26] = a[26], a[25]
+	}
+	if a[23] > a[25] {
+		a[23], a[25] = a[25], a[23]
+	}
+	if a[24] > a[26] {
+		a[24], a[26] = a[26], a[24]
+	}
+	if a[24] > a[25] {
+		a[24], a[25] = a[25], a[24]
+	}
+	if a[27] > a[28] {
+		a[27], a[28] = a[28], a[27]
+	}
+	if a[29] > a[30] {
+		a[29], a[30] = a[30], a[29]
+	}
+	if a[27] > a[29] {
+		a[27], a[29] = a[29], a[27]
+	}
+	if a[28] > a[30] {
+		a[28], a[30] = a[30], a[28]
+	}
+	if a[28] > a[29] {
+		a[28], a[29] = a[29], a[28]
+	}
+	if a[23] > a[27] {
+		a[23], a[27] = a[27], a[23]
+	}
+	if a[24] > a[28] {
+		a[24], a[28] = a[28], a[24]
+	}
+	if a[24] > a[27] {
+		a[24], a[27] = a[27], a[24]
+	}
+	if a[25] > a[29] {
+		a[25], a[29] = a[29], a[25]
+	}
+	if a[26] > a[30] {
+		a[26], a[30] = a[30], a[26]
+	}
+	if a[26] > a[29] {
+		a[26], a[29] = a[29], a[26]
+	}
+	if a[25] > a[27] {
+		a[25], a[27] = a[27], a[25]
+	}
+	if a[26] > a[28] {
+		a[26], a[28] = a[28], a[26]
+	}
+	if a[26] > a[27] {
+		a[26], a[27] = a[27], a[26]
+	}
+	if a[15] > a[23] {
+		a[15], a[23] = a[23], a[15]
+	}
+	if a[16] > a[24] {
+		a[16], a[24] = a[24], a[16]
+	}
+	if a[16] > a[23] {
+		a[16], a[23] = a[23], a[16]
+	}
+	if a[17] > a[25] {
+		a[17], a[25] = a[25], a[17]
+	}
+	if a[18] > a[26] {
+		a[18], a[26] = a[26], a[18]
+	}
+	if a[18] > a[25] {
+		a[18], a[25] = a[25], a[18]
+	}
+	if a[17] > a[23] {
+		a[17], a[23] = a[23], a[17]
+	}
+	if a[18] > a[24] {
+		a[18], a[24] = a[24], a[18]
+	}
+	if a[18] > a[23] {
+		a[18], a[23] = a[23], a[18]
+	}
+	if a[19] > a[27] {
+		a[19], a[27] = a[27], a[19]
+	}
+	if a[20] > a[28] {
+		a[20], a[28] = a[28], a[20]
+	}
+	if a[20] > a[27] {
+		a[20], a[27] = a[27], a[20]
+	}
+	if a[21] > a[29] {
+		a[21], a[29] = a[29], a[21]
+	}
+	if a[22] > a[30] {
+		a[22], a[30] = a[30], a[22]
+	}
+	if a[22] > a[29] {
+		a[22], a[29] = a[29], a[22]
+	}
+	if a[21] > a[27] {
+		a[21], a[27] = a[27], a[21]
+	}
+	if a[22] > a[28] {
+		a[22], a[28] = a[28], a[22]
+	}
+	if a[22] > a[27] {
+		a[22], a[27] = a[27], a[22]
+	}
+	if a[19] > a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[20] > a[24] {
+		a[20], a[24] = a[24], a[20]
+	}
+	if a[20] > a[23] {
+		a[20], a[23] = a[23], a[20]
+	}
+	if a[21] > a[25] {
+		a[21], a[25] = a[25], a[21]
+	}
+	if a[22] > a[26] {
+		a[22], a[26] = a[26], a[22]
+	}
+	if a[22] > a[25] {
+		a[22], a[25] = a[25], a[22]
+	}
+	if a[21] > a[23] {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[22] > a[24] {
+		a[22], a[24] = a[24], a[22]
+	}
+	if a[22] > a[23] {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[0] > a[16] {
+		a[0], a[16] = a[16], a[0]
+	}
+	if a[0] > a[15] {
+		a[0], a[15] = a[15], a[0]
+	}
+	if a[1] > a[17] {
+		a[1], a[17] = a[17], a[1]
+	}
+	if a[2] > a[18] {
+		a[2], a[18] = a[18], a[2]
+	}
+	if a[2] > a[17] {
+		a[2], a[17] = a[17], a[2]
+	}
+	if a[1] > a[15] {
+		a[1], a[15] = a[15], a[1]
+	}
+	if a[2] > a[16] {
+		a[2], a[16] = a[16], a[2]
+	}
+	if a[2] > a[15] {
+		a[2], a[15] = a[15], a[2]
+	}
+	if a[3] > a[19] {
+		a[3], a[19] = a[19], a[3]
+	}
+	if a[4] > a[20] {
+		a[4], a[20] = a[20], a[4]
+	}
+	if a[4] > a[19] {
+		a[4], a[19] = a[19], a[4]
+	}
+	if a[5] > a[21] {
+		a[5], a[21] = a[21], a[5]
+	}
+	if a[6] > a[22] {
+		a[6], a[22] = a[22], a[6]
+	}
+	if a[6] > a[21] {
+		a[6], a[21] = a[21], a[6]
+	}
+	if a[5] > a[19] {
+		a[5], a[19] = a[19], a[5]
+	}
+	if a[6] > a[20] {
+		a[6], a[20] = a[20], a[6]
+	}
+	if a[6] > a[19] {
+		a[6], a[19] = a[19], a[6]
+	}
+	if a[3] > a[15] {
+		a[3], a[15] = a[15], a[3]
+	}
+	if a[4] > a[16] {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[4] > a[15] {
+		a[4], a[15] = a[15], a[4]
+	}
+	if a[5] > a[17] {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[6] > a[18] {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[6] > a[17] {
+		a[6], a[17] = a[17], a[6]
+	}
+	if a[5] > a[15] {
+		a[5], a[15] = a[15], a[5]
+	}
+	if a[6] > a[16] {
+		a[6], a[16] = a[16], a[6]
+	}
+	if a[6] > a[15] {
+		a[6], a[15] = a[15], a[6]
+	}
+	if a[7] > a[23] {
+		a[7], a[23] = a[23], a[7]
+	}
+	if a[8] > a[24] {
+		a[8], a[24] = a[24], a[8]
+	}
+	if a[8] > a[23] {
+		a[8], a[23] = a[23], a[8]
+	}
+	if a[9] > a[25] {
+		a[9], a[25] = a[25], a[9]
+	}
+	if a[10] > a[26] {
+		a[10], a[26] = a[26], a[10]
+	}
+	if a[10] > a[25] {
+		a[10], a[25] = a[25], a[10]
+	}
+	if a[9] > a[23] {
+		a[9], a[23] = a[23], a[9]
+	}
+	if a[10] > a[24] {
+		a[10], a[24] = a[24], a[10]
+	}
+	if a[10] > a[23] {
+		a[10], a[23] = a[23], a[10]
+	}
+	if a[11] > a[27] {
+		a[11], a[27] = a[27], a[11]
+	}
+	if a[12] > a[28] {
+		a[12], a[28] = a[28], a[12]
+	}
+	if a[12] > a[27] {
+		a[12], a[27] = a[27], a[12]
+	}
+	if a[13] > a[29] {
+		a[13], a[29] = a[29], a[13]
+	}
+	if a[14] > a[30] {
+		a[14], a[30] = a[30], a[14]
+	}
+	if a[14] > a[29] {
+		a[14], a[29] = a[29], a[14]
+	}
+	if a[13] > a[27] {
+		a[13], a[27] = a[27], a[13]
+	}
+	if a[14] > a[28] {
+		a[14], a[28] = a[28], a[14]
+	}
+	if a[14] > a[27] {
+		a[14], a[27] = a[27], a[14]
+	}
+	if a[11] > a[23] {
+		a[11], a[23] = a[23], a[11]
+	}
+	if a[12] > a[24] {
+		a[12], a[24] = a[24], a[12]
+	}
+	if a[12] > a[23] {
+		a[12], a[23] = a[23], a[12]
+	}
+	if a[13] > a[25] {
+		a[13], a[25] = a[25], a[13]
+	}
+	if a[14] > a[26] {
+		a[14], a[26] = a[26], a[14]
+	}
+	if a[14] > a[25] {
+		a[14], a[25] = a[25], a[14]
+	}
+	if a[13] > a[23] {
+		a[13], a[23] = a[23], a[13]
+	}
+	if a[14] > a[24] {
+		a[14], a[24] = a[24], a[14]
+	}
+	if a[14] > a[23] {
+		a[14], a[23] = a[23], a[14]
+	}
+	if a[7] > a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[8] > a[16] {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[8] > a[15] {
+		a[8], a[15] = a[15], a[8]
+	}
+	if a[9] > a[17] {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[10] > a[18] {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[10] > a[17] {
+		a[10], a[17] = a[17], a[10]
+	}
+	if a[9] > a[15] {
+		a[9], a[15] = a[15], a[9]
+	}
+	if a[10] > a[16] {
+		a[10], a[16] = a[16], a[10]
+	}
+	if a[10] > a[15] {
+		a[10], a[15] = a[15], a[10]
+	}
+	if a[11] > a[19] {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[12] > a[20] {
+		a[12], a[20] = a[20], a[12]
+	}
+	if a[12] > a[19] {
+		a[12], a[19] = a[19], a[12]
+	}
+	if a[13] > a[21] {
+		a[13], a[21] = a[21], a[13]
+	}
+	if a[14] > a[22] {
+		a[14], a[22] = a[22], a[14]
+	}
+	if a[14] > a[21] {
+		a[14], a[21] = a[21], a[14]
+	}
+	if a[13] > a[19] {
+		a[13], a[19] = a[19], a[13]
+	}
+	if a[14] > a[20] {
+		a[14], a[20] = a[20], a[14]
+	}
+	if a[14] > a[19] {
+		a[14], a[19] = a[19], a[14]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[12] > a[15] {
+		a[12], a[15] = a[15], a[12]
+	}
+	if a[13] > a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[14] > a[18] {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[14] > a[17] {
+		a[14], a[17] = a[17], a[14]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+}
+
+// compiledSort32 is an unrolled Bose-Nelson network.
+func compiledSort32[T cmp.Ordered](a []T) {
+	_ = a[31]
+	if a[0] > a[1] {
+		a[0], a[1] = a[1], a[0]
+	}
+	if a[2] > a[3] {
+		a[2], a[3] = a[3], a[2]
+	}
+	if a[0] > a[2] {
+		a[0], a[2] = a[2], a[0]
+	}
+	if a[1] > a[3] {
+		a[1], a[3] = a[3], a[1]
+	}
+	if a[1] > a[2] {
+		a[1], a[2] = a[2], a[1]
+	}
+	if a[4] > a[5] {
+		a[4], a[5] = a[5], a[4]
+	}
+	if a[6] > a[7] {
+		a[6], a[7] = a[7], a[6]
+	}
+	if a[4] > a[6] {
+		a[4], a[6] = a[6], a[4]
+	}
+	if a[5] > a[7] {
+		a[5], a[7] = a[7], a[5]
+	}
+	if a[5] > a[6] {
+		a[5], a[6] = a[6], a[5]
+	}
+	if a[0] > a[4] {
+		a[0], a[4] = a[4], a[0]
+	}
+	if a[1] > a[5] {
+		a[1], a[5] = a[5], a[1]
+	}
+	if a[1] > a[4] {
+		a[1], a[4] = a[4], a[1]
+	}
+	if a[2] > a[6] {
+		a[2], a[6] = a[6], a[2]
+	}
+	if a[3] > a[7] {
+		a[3], a[7] = a[7], a[3]
+	}
+	if a[3] > a[6] {
+		a[3], a[6] = a[6], a[3]
+	}
+	if a[2] > a[4] {
+		a[2], a[4] = a[4], a[2]
+	}
+	if a[3] > a[5] {
+		a[3], a[5] = a[5], a[3]
+	}
+	if a[3] > a[4] {
+		a[3], a[4] = a[4], a[3]
+	}
+	if a[8] > a[9] {
+		a[8], a[9] = a[9], a[8]
+	}
+	if a[10] > a[11] {
+		a[10], a[11] = a[11], a[10]
+	}
+	if a[8] > a[10] {
+		a[8], a[10] = a[10], a[8]
+	}
+	if a[9] > a[11] {
+		a[9], a[11] = a[11], a[9]
+	}
+	if a[9] > a[10] {
+		a[9], a[10] = a[10], a[9]
+	}
+	if a[12] > a[13] {
+		a[12], a[13] = a[13], a[12]
+	}
+	if a[14] > a[15] {
+		a[14], a[15] = a[15], a[14]
+	}
+	if a[12] > a[14] {
+		a[12], a[14] = a[14], a[12]
+	}
+	if a[13] > a[15] {
+		a[13], a[15] = a[15], a[13]
+	}
+	if a[13] > a[14] {
+		a[13], a[14] = a[14], a[13]
+	}
+	if a[8] > a[12] {
+		a[8], a[12] = a[12], a[8]
+	}
+	if a[9] > a[13] {
+		a[9], a[13] = a[13], a[9]
+	}
+	if a[9] > a[12] {
+		a[9], a[12] = a[12], a[9]
+	}
+	if a[10] > a[14] {
+		a[10], a[14] = a[14], a[10]
+	}
+	if a[11] > a[15] {
+		a[11], a[15] = a[15], a[11]
+	}
+	if a[11] > a[14] {
+		a[11], a[14] = a[14], a[11]
+	}
+	if a[10] > a[12] {
+		a[10], a[12] = a[12], a[10]
+	}
+	if a[11] > a[13] {
+		a[11], a[13] = a[13], a[11]
+	}
+	if a[11] > a[12] {
+		a[11], a[12] = a[12], a[11]
+	}
+	if a[0] > a[8] {
+		a[0], a[8] = a[8], a[0]
+	}
+	if a[1] > a[9] {
+		a[1], a[9] = a[9], a[1]
+	}
+	if a[1] > a[8] {
+		a[1], a[8] = a[8], a[1]
+	}
+	if a[2] > a[10] {
+		a[2], a[10] = a[10], a[2]
+	}
+	if a[3] > a[11] {
+		a[3], a[11] = a[11], a[3]
+	}
+	if a[3] > a[10] {
+		a[3], a[10] = a[10], a[3]
+	}
+	if a[2] > a[8] {
+		a[2], a[8] = a[8], a[2]
+	}
+	if a[3] > a[9] {
+		a[3], a[9] = a[9], a[3]
+	}
+	if a[3] > a[8] {
+		a[3], a[8] = a[8], a[3]
+	}
+	if a[4] > a[12] {
+		a[4], a[12] = a[12], a[4]
+	}
+	if a[5] > a[13] {
+		a[5], a[13] = a[13], a[5]
+	}
+	if a[5] > a[12] {
+		a[5], a[12] = a[12], a[5]
+	}
+	if a[6] > a[14] {
+		a[6], a[14] = a[14], a[6]
+	}
+	if a[7] > a[15] {
+		a[7], a[15] = a[15], a[7]
+	}
+	if a[7] > a[14] {
+		a[7], a[14] = a[14], a[7]
+	}
+	if a[6] > a[12] {
+		a[6], a[12] = a[12], a[6]
+	}
+	if a[7] > a[13] {
+		a[7], a[13] = a[13], a[7]
+	}
+	if a[7] > a[12] {
+		a[7], a[12] = a[12], a[7]
+	}
+	if a[4] > a[8] {
+		a[4], a[8] = a[8], a[4]
+	}
+	if a[5] > a[9] {
+		a[5], a[9] = a[9], a[5]
+	}
+	if a[5] > a[8] {
+		a[5], a[8] = a[8], a[5]
+	}
+	if a[6] > a[10] {
+		a[6], a[10] = a[10], a[6]
+	}
+	if a[7] > a[11] {
+		a[7], a[11] = a[11], a[7]
+	}
+	if a[7] > a[10] {
+		a[7], a[10] = a[10], a[7]
+	}
+	if a[6] > a[8] {
+		a[6], a[8] = a[8], a[6]
+	}
+	if a[7] > a[9] {
+		a[7], a[9] = a[9], a[7]
+	}
+	if a[7] > a[8] {
+		a[7], a[8] = a[8], a[7]
+	}
+	if a[16] > a[17] {
+		a[16], a[17] = a[17], a[16]
+	}
+	if a[18] > a[19] {
+		a[18], a[19] = a[19], a[18]
+	}
+	if a[16] > a[18] {
+		a[16], a[18] = a[18], a[16]
+	}
+	if a[17] > a[19] {
+		a[17], a[19] = a[19], a[17]
+	}
+	if a[17] > a[18] {
+		a[17], a[18] = a[18], a[17]
+	}
+	if a[20] > a[21] {
+		a[20], a[21] = a[21], a[20]
+	}
+	if a[22] > a[23] {
+		a[22], a[23] = a[23], a[22]
+	}
+	if a[20] > a[22] {
+		a[20], a[22] = a[22], a[20]
+	}
+	if a[21] > a[23] {
+		a[21], a[23] = a[23], a[21]
+	}
+	if a[21] > a[22] {
+		a[21], a[22] = a[22], a[21]
+	}
+	if a[16] > a[20] {
+		a[16], a[20] = a[20], a[16]
+	}
+	if a[17] > a[21] {
+		a[17], a[21] = a[21], a[17]
+	}
+	if a[17] > a[20] {
+		a[17], a[20] = a[20], a[17]
+	}
+	if a[18] > a[22] {
+		a[18], a[22] = a[22], a[18]
+	}
+	if a[19] > a[23] {
+		a[19], a[23] = a[23], a[19]
+	}
+	if a[19] > a[22] {
+		a[19], a[22] = a[22], a[19]
+	}
+	if a[18] > a[20] {
+		a[18], a[20] = a[20], a[18]
+	}
+	if a[19] > a[21] {
+		a[19], a[21] = a[21], a[19]
+	}
+	if a[19] > a[20] {
+		a[19], a[20] = a[20], a[19]
+	}
+	if a[24] > a[25] {
+		a[24], a[25] = a[25], a[24]
+	}
+	if a[26] > a[27] {
+		a[26], a[27] = a[27], a[26]
+	}
+	if a[24] > a[26] {
+		a[24], a[26] = a[26], a[24]
+	}
+	if a[25] > a[27] {
+		a[25], a[27] = a[27], a[25]
+	}
+	if a[25] > a[26] {
+		a[25], a[26] = a[26], a[25]
+	}
+	if a[28] > a[29] {
+		a[28], a[29] = a[29], a[28]
+	}
+	if a[30] > a[31] {
+		a[30], a[31] = a[31], a[30]
+	}
+	if a[28] > a[30] {
+		a[28], a[30] = a[30], a[28]
+	}
+	if a[29] > a[31] {
+		a[29], a[31] = a[31], a[29]
+	}
+	if a[29] > a[30] {
+		a[29], a[30] = a[30], a[29]
+	}
+	if a[24] > a[28] {
+		a[24], a[28] = a[28], a[24]
+	}
+	if a[25] > a[29] {
+		a[25], a[29] = a[29], a[25]
+	}
+	if a[25] > a[28] {
+		a[25], a[28] = a[28], a[25]
+	}
+	if a[26] > a[30] {
+		a[26], a[30] = a[30], a[26]
+	}
+	if a[27] > a[31] {
+		a[27], a[31] = a[31], a[27]
+	}
+	if a[27] > a[30] {
+		a[27], a[30] = a[30], a[27]
+	}
+	if a[26] > a[28] {
+		a[26], a[28] = a[28], a[26]
+	}
+	if a[27] > a[29] {
+		a[27], a[29] = a[29], a[27]
+	}
+	if a[27] > a[28] {
+		a[27], a[28] = a[28], a[27]
+	}
+	if a[16] > a[24] {
+		a[16], a[24] = a[24], a[16]
+	}
+	if a[17] > a[25] {
+		a[17], a[25] = a[25], a[17]
+	}
+	if a[17] > a[24] {
+		a[17], a[24] = a[24], a[17]
+	}
+	if a[18] > a[26] {
+		a[18], a[26] = a[26], a[18]
+	}
+	if a[19] > a[27] {
+		a[19], a[27] = a[27], a[19]
+	}
+	if a[19] > a[26] {
+		a[19], a[26] = a[26], a[19]
+	}
+	if a[18] > a[24] {
+		a[18], a[24] = a[24], a[18]
+	}
+	if a[19] > a[25] {
+		a[19], a[25] = a[25], a[19]
+	}
+	if a[19] > a[24] {
+		a[19], a[24] = a[24], a[19]
+	}
+	if a[20] > a[28] {
+		a[20], a[28] = a[28], a[20]
+	}
+	if a[21] > a[29] {
+		a[21], a[29] = a[29], a[21]
+	}
+	if a[21] > a[28] {
+		a[21], a[28] = a[28], a[21]
+	}
+	if a[22] > a[30] {
+		a[22], a[30] = a[30], a[22]
+	}
+	if a[23] > a[31] {
+		a[23], a[31] = a[31], a[23]
+	}
+	if a[23] > a[30] {
+		a[23], a[30] = a[30], a[23]
+	}
+	if a[22] > a[28] {
+		a[22], a[28] = a[28], a[22]
+	}
+	if a[23] > a[29] {
+		a[23], a[29] = a[29], a[23]
+	}
+	if a[23] > a[28] {
+		a[23], a[28] = a[28], a[23]
+	}
+	if a[20] > a[24] {
+		a[20], a[24] = a[24], a[20]
+	}
+	if a[21] > a[25] {
+		a[21], a[25] = a[25], a[21]
+	}
+	if a[21] > a[24] {
+		a[21], a[24] = a[24], a[21]
+	}
+	if a[22] > a[26] {
+		a[22], a[26] = a[26], a[22]
+	}
+	if a[23] > a[27] {
+		a[23], a[27] = a[27], a[23]
+	}
+	if a[23] > a[26] {
+		a[23], a[26] = a[26], a[23]
+	}
+	if a[22] > a[24] {
+		a[22], a[24] = a[24], a[22]
+	}
+	if a[23] > a[25] {
+		a[23], a[25] = a[25], a[23]
+	}
+	if a[23] > a[24] {
+		a[23], a[24] = a[24], a[23]
+	}
+	if a[0] > a[16] {
+		a[0], a[16] = a[16], a[0]
+	}
+	if a[1] > a[17] {
+		a[1], a[17] = a[17], a[1]
+	}
+	if a[1] > a[16] {
+		a[1], a[16] = a[16], a[1]
+	}
+	if a[2] > a[18] {
+		a[2], a[18] = a[18], a[2]
+	}
+	if a[3] > a[19] {
+		a[3], a[19] = a[19], a[3]
+	}
+	if a[3] > a[18] {
+		a[3], a[18] = a[18], a[3]
+	}
+	if a[2] > a[16] {
+		a[2], a[16] = a[16], a[2]
+	}
+	if a[3] > a[17] {
+		a[3], a[17] = a[17], a[3]
+	}
+	if a[3] > a[16] {
+		a[3], a[16] = a[16], a[3]
+	}
+	if a[4] > a[20] {
+		a[4], a[20] = a[20], a[4]
+	}
+	if a[5] > a[21] {
+		a[5], a[21] = a[21], a[5]
+	}
+	if a[5] > a[20] {
+		a[5], a[20] = a[20], a[5]
+	}
+	if a[6] > a[22] {
+		a[6], a[22] = a[22], a[6]
+	}
+	if a[7] > a[23] {
+		a[7], a[23] = a[23], a[7]
+	}
+	if a[7] > a[22] {
+		a[7], a[22] = a[22], a[7]
+	}
+	if a[6] > a[20] {
+		a[6], a[20] = a[20], a[6]
+	}
+	if a[7] > a[21] {
+		a[7], a[21] = a[21], a[7]
+	}
+	if a[7] > a[20] {
+		a[7], a[20] = a[20], a[7]
+	}
+	if a[4] > a[16] {
+		a[4], a[16] = a[16], a[4]
+	}
+	if a[5] > a[17] {
+		a[5], a[17] = a[17], a[5]
+	}
+	if a[5] > a[16] {
+		a[5], a[16] = a[16], a[5]
+	}
+	if a[6] > a[18] {
+		a[6], a[18] = a[18], a[6]
+	}
+	if a[7] > a[19] {
+		a[7], a[19] = a[19], a[7]
+	}
+	if a[7] > a[18] {
+		a[7], a[18] = a[18], a[7]
+	}
+	if a[6] > a[16] {
+		a[6], a[16] = a[16], a[6]
+	}
+	if a[7] > a[17] {
+		a[7], a[17] = a[17], a[7]
+	}
+	if a[7] > a[16] {
+		a[7], a[16] = a[16], a[7]
+	}
+	if a[8] > a[24] {
+		a[8], a[24] = a[24], a[8]
+	}
+	if a[9] > a[25] {
+		a[9], a[25] = a[25], a[9]
+	}
+	if a[9] > a[24] {
+		a[9], a[24] = a[24], a[9]
+	}
+	if a[10] > a[26] {
+		a[10], a[26] = a[26], a[10]
+	}
+	if a[11] > a[27] {
+		a[11], a[27] = a[27], a[11]
+	}
+	if a[11] > a[26] {
+		a[11], a[26] = a[26], a[11]
+	}
+	if a[10] > a[24] {
+		a[10], a[24] = a[24], a[10]
+	}
+	if a[11] > a[25] {
+		a[11], a[25] = a[25], a[11]
+	}
+	if a[11] > a[24] {
+		a[11], a[24] = a[24], a[11]
+	}
+	if a[12] > a[28] {
+		a[12], a[28] = a[28], a[12]
+	}
+	if a[13] > a[29] {
+		a[13], a[29] = a[29], a[13]
+	}
+	if a[13] > a[28] {
+		a[13], a[28] = a[28], a[13]
+	}
+	if a[14] > a[30] {
+		a[14], a[30] = a[30], a[14]
+	}
+	if a[15] > a[31] {
+		a[15], a[31] = a[31], a[15]
+	}
+	if a[15] > a[30] {
+		a[15], a[30] = a[30], a[15]
+	}
+	if a[14] > a[28] {
+		a[14], a[28] = a[28], a[14]
+	}
+	if a[15] > a[29] {
+		a[15], a[29] = a[29], a[15]
+	}
+	if a[15] > a[28] {
+		a[15], a[28] = a[28], a[15]
+	}
+	if a[12] > a[24] {
+		a[12], a[24] = a[24], a[12]
+	}
+	if a[13] > a[25] {
+		a[13], a[25] = a[25], a[13]
+	}
+	if a[13] > a[24] {
+		a[13], a[24] = a[24], a[13]
+	}
+	if a[14] > a[26] {
+		a[14], a[26] = a[26], a[14]
+	}
+	if a[15] > a[27] {
+		a[15], a[27] = a[27], a[15]
+	}
+	if a[15] > a[26] {
+		a[15], a[26] = a[26], a[15]
+	}
+	if a[14] > a[24] {
+		a[14], a[24] = a[24], a[14]
+	}
+	if a[15] > a[25] {
+		a[15], a[25] = a[25], a[15]
+	}
+	if a[15] > a[24] {
+		a[15], a[24] = a[24], a[15]
+	}
+	if a[8] > a[16] {
+		a[8], a[16] = a[16], a[8]
+	}
+	if a[9] > a[17] {
+		a[9], a[17] = a[17], a[9]
+	}
+	if a[9] > a[16] {
+		a[9], a[16] = a[16], a[9]
+	}
+	if a[10] > a[18] {
+		a[10], a[18] = a[18], a[10]
+	}
+	if a[11] > a[19] {
+		a[11], a[19] = a[19], a[11]
+	}
+	if a[11] > a[18] {
+		a[11], a[18] = a[18], a[11]
+	}
+	if a[10] > a[16] {
+		a[10], a[16] = a[16], a[10]
+	}
+	if a[11] > a[17] {
+		a[11], a[17] = a[17], a[11]
+	}
+	if a[11] > a[16] {
+		a[11], a[16] = a[16], a[11]
+	}
+	if a[12] > a[20] {
+		a[12], a[20] = a[20], a[12]
+	}
+	if a[13] > a[21] {
+		a[13], a[21] = a[21], a[13]
+	}
+	if a[13] > a[20] {
+		a[13], a[20] = a[20], a[13]
+	}
+	if a[14] > a[22] {
+		a[14], a[22] = a[22], a[14]
+	}
+	if a[15] > a[23] {
+		a[15], a[23] = a[23], a[15]
+	}
+	if a[15] > a[22] {
+		a[15], a[22] = a[22], a[15]
+	}
+	if a[14] > a[20] {
+		a[14], a[20] = a[20], a[14]
+	}
+	if a[15] > a[21] {
+		a[15], a[21] = a[21], a[15]
+	}
+	if a[15] > a[20] {
+		a[15], a[20] = a[20], a[15]
+	}
+	if a[12] > a[16] {
+		a[12], a[16] = a[16], a[12]
+	}
+	if a[13] > a[17] {
+		a[13], a[17] = a[17], a[13]
+	}
+	if a[13] > a[16] {
+		a[13], a[16] = a[16], a[13]
+	}
+	if a[14] > a[18] {
+		a[14], a[18] = a[18], a[14]
+	}
+	if a[15] > a[19] {
+		a[15], a[19] = a[19], a[15]
+	}
+	if a[15] > a[18] {
+		a[15], a[18] = a[18], a[15]
+	}
+	if a[14] > a[16] {
+		a[14], a[16] = a[16], a[14]
+	}
+	if a[15] > a[17] {
+		a[15], a[17] = a[17], a[15]
+	}
+	if a[15] > a[16] {
+		a[15], a[16] = a[16], a[15]
+	}
+}
@@ -0,0 +1,201 @@
+// Package filter provides sliding-window rank filters for 1-D signals, such as median
+// filters, built on selection networks.
+//
+// Each output value is taken from the 'window' items of the input centred on the same
+// index. For even windows, there is one more item before the centre than after it.
+// The input is extended past each end by repeating the first or last item.
+//
+// Not-a-number values in floating point inputs give unspecified results.
+package filter
+
+import (
+	"cmp"
+	"math/bits"
+	"slices"
+
+	"github.com/shabbyrobe/sortnet"
+)
+
+// Median writes the median of each window of src to dst. For even windows, this is
+// the upper of the two middle items.
+//
+// Median panics if len(dst) != len(src) or window < 1.
+func Median[T cmp.Ordered](dst, src []T, window int) {
+	Rank(dst, src, window, window/2)
+}
+
+// Min writes the smallest item in each window of src to dst.
+//
+// Min panics if len(dst) != len(src) or window < 1.
+func Min[T cmp.Ordered](dst, src []T, window int) {
+	Rank(dst, src, window, 0)
+}
+
+// Max writes the largest item in each window of src to dst.
+//
+// Max panics if len(dst) != len(src) or window < 1.
+func Max[T cmp.Ordered](dst, src []T, window int) {
+	Rank(dst, src, window, window-1)
+}
+
+// Rank writes the k-th smallest item (counting from 0) in each window of src to dst.
+//
+// Rank panics if len(dst) != len(src), window < 1 or k is not in [0, window).
+func Rank[T cmp.Ordered](dst, src []T, window, k int) {
+	if len(dst) != len(src) {
+		panic("filter: len(dst) != len(src)")
+	}
+	if window < 1 {
+		panic("filter: window must be >= 1")
+	}
+	if k < 0 || k >= window {
+		panic("filter: k must be >= 0 and < window")
+	}
+	if len(src) == 0 {
+		return
+	}
+
+	ops := SelectionNetwork(window, k).Ops
+	before := window / 2
+	after := window - before - 1
+	scratch := make([]T, window)
+
+	for i := range src {
+		if i >= before && i+after < len(src) {
+			copy(scratch, src[i-before:i+after+1])
+		} else {
+			for j := range scratch {
+				scratch[j] = src[clamp(i-before+j, len(src))]
+			}
+		}
+
+		// Using min and max avoids a branch that would be mispredicted about half the
+		// time on noisy signals:
+		for _, c := range ops {
+			a, b := scratch[c.From], scratch[c.To]
+			scratch[c.From], scratch[c.To] = min(a, b), max(a, b)
+		}
+		dst[i] = scratch[k]
+	}
+}
+
+// SelectionNetwork returns a selection network that puts the k-th smallest of 'size'
+// items at position k.
+//
+// The minimum and maximum are found with a tournament of size-1 comparators. Any other
+// rank is found by stripping all of the comparators from sortnet.New(size) that
+// can't affect position k. Up to 16 items, each comparator that is left is then
+// dropped if position k still gets the right item without it, which is checked with
+// every input of 0s and 1s.
+func SelectionNetwork(size, k int) sortnet.Network {
+	switch {
+	case size <= 1:
+		return sortnet.Network{Kind: "Select", Size: size}
+
+	case k == 0:
+		net := sortnet.Network{Kind: "Min", Size: size, Depth: size - 1}
+		for i := 1; i < size; i++ {
+			net.Ops = append(net.Ops, sortnet.CompareAndSwap{From: 0, To: i})
+		}
+		return net
+
+	case k == size-1:
+		net := sortnet.Network{Kind: "Max", Size: size, Depth: size - 1}
+		for i := 0; i < size-1; i++ {
+			net.Ops = append(net.Ops, sortnet.CompareAndSwap{From: i, To: size - 1})
+		}
+		return net
+
+	default:
+		net := sortnet.New(size).Select(k)
+		if size <= maxPruneSize {
+			// Dropping comparators can leave others that no longer affect position k,
+			// so select again, which also recalculates the depth:
+			net.Ops = prune(size, k, net.Ops)
+			net = net.Select(k)
+		}
+		return net
+	}
+}
+
+// maxPruneSize is the largest network that SelectionNetwork prunes. Checking each
+// comparator takes 2^size inputs, so the cost doubles with every item.
+const maxPruneSize = 16
+
+// prune removes each comparator in ops, from the last to the first, if the k-th
+// smallest of size items still ends up at position k without it.
+func prune(size, k int, ops []sortnet.CompareAndSwap) []sortnet.CompareAndSwap {
+	ops = slices.Clone(ops)
+	for i := len(ops) - 1; i >= 0; i-- {
+		without := slices.Delete(slices.Clone(ops), i, i+1)
+		if selects(size, k, without) {
+			ops = without
+		}
+	}
+	return ops
+}
+
+// lanePatterns[i] has bit b set if bit i of b is set. Used as items 0 to 5, they make
+// the bits of a word 64 different inputs of 0s and 1s.
+var lanePatterns = [6]uint64{
+	0xaaaaaaaaaaaaaaaa, 0xcccccccccccccccc, 0xf0f0f0f0f0f0f0f0,
+	0xff00ff00ff00ff00, 0xffff0000ffff0000, 0xffffffff00000000,
+}
+
+// selects reports whether ops put the k-th smallest of size items at position k for
+// every input of 0s and 1s, which means they do for every input. Items 0 to 5 come
+// from lanePatterns and the rest from the bits of the block number, so each
+// comparator is applied to 64 inputs at once.
+func selects(size, k int, ops []sortnet.CompareAndSwap) bool {
+	low := min(size, len(lanePatterns))
+	valid := uint64(1)<<(1<<low) - 1 // All ones when low is 6
+
+	// atLeast[n] has bit b set if b has at least n bits set:
+	var atLeast [len(lanePatterns) + 1]uint64
+	for b := 0; b < 64; b++ {
+		for n := 0; n <= bits.OnesCount(uint(b)); n++ {
+			atLeast[n] |= 1 << b
+		}
+	}
+
+	items := make([]uint64, size)
+	for block := 0; block < 1<<(size-low); block++ {
+		for i := range items {
+			switch {
+			case i < low:
+				items[i] = lanePatterns[i]
+			case block>>(i-low)&1 == 1:
+				items[i] = ^uint64(0)
+			default:
+				items[i] = 0
+			}
+		}
+		for _, op := range ops {
+			a, b := items[op.From], items[op.To]
+			items[op.From], items[op.To] = a&b, a|b
+		}
+
+		// Sorted, position k holds a 1 if there are at least size-k ones, some of
+		// which come from the block number:
+		var exp uint64
+		switch need := size - k - bits.OnesCount(uint(block)); {
+		case need <= 0:
+			exp = ^uint64(0)
+		case need < len(atLeast):
+			exp = atLeast[need]
+		}
+		if (items[k]^exp)&valid != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func clamp(i, n int) int {
+	if i < 0 {
+		return 0
+	} else if i >= n {
+		return n - 1
+	}
+	return i
+}
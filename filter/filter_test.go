@@ -0,0 +1,143 @@
+package filter
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/shabbyrobe/sortnet"
+)
+
+// naiveRank is the reference implementation: pad and sort every window.
+func naiveRank(src []int, window, k int) []int {
+	out := make([]int, len(src))
+	scratch := make([]int, window)
+	for i := range src {
+		for j := range scratch {
+			scratch[j] = src[clamp(i-window/2+j, len(src))]
+		}
+		sort.Ints(scratch)
+		out[i] = scratch[k]
+	}
+	return out
+}
+
+func TestRank(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+
+	for _, window := range []int{1, 2, 3, 4, 5, 7, 9, 16, 25} {
+		for k := 0; k < window; k++ {
+			for _, n := range []int{0, 1, 2, window - 1, window, 100} {
+				t.Run(fmt.Sprintf("%d/%d/%d", window, k, n), func(t *testing.T) {
+					if n < 0 {
+						n = 0
+					}
+					src := make([]int, n)
+					for i := range src {
+						src[i] = rng.Intn(50)
+					}
+					dst := make([]int, n)
+					Rank(dst, src, window, k)
+
+					exp := naiveRank(src, window, k)
+					if !reflect.DeepEqual(exp, dst) {
+						t.Fatalf("\nexp: %v\nout: %v", exp, dst)
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestMedianMinMax(t *testing.T) {
+	src := []int{5, 1, 9, 3, 3, 8, 2, 7}
+	dst := make([]int, len(src))
+
+	Median(dst, src, 3)
+	if exp := []int{5, 5, 3, 3, 3, 3, 7, 7}; !reflect.DeepEqual(exp, dst) {
+		t.Fatal("median", dst)
+	}
+	Min(dst, src, 3)
+	if exp := []int{1, 1, 1, 3, 3, 2, 2, 2}; !reflect.DeepEqual(exp, dst) {
+		t.Fatal("min", dst)
+	}
+	Max(dst, src, 3)
+	if exp := []int{5, 9, 9, 9, 8, 8, 8, 7}; !reflect.DeepEqual(exp, dst) {
+		t.Fatal("max", dst)
+	}
+}
+
+func TestSelectionNetworkSize(t *testing.T) {
+	for _, window := range []int{3, 5, 7, 9, 11} {
+		full := len(sortnet.New(window).Ops)
+		for k := 0; k < window; k++ {
+			net := SelectionNetwork(window, k)
+			if net.Size != window {
+				t.Fatalf("rank %d of %d: size %d", k, window, net.Size)
+			}
+			// The median of 3 needs every comparator in New(3); any other rank can
+			// do without some of them:
+			if len(net.Ops) > full || (len(net.Ops) == full && window != 3) {
+				t.Fatalf("rank %d of %d should take fewer than %d comparators, found %d", k, window, full, len(net.Ops))
+			}
+		}
+		if ops := len(SelectionNetwork(window, 0).Ops); ops != window-1 {
+			t.Fatalf("min of %d should take %d comparators, found %d", window, window-1, ops)
+		}
+	}
+}
+
+// TestSelectionNetworkSelects checks every rank of each window size with every input
+// of 0s and 1s. A comparator network puts the right item at position k for every
+// input if it does for all of these.
+func TestSelectionNetworkSelects(t *testing.T) {
+	for _, window := range []int{3, 5, 7, 9, 11} {
+		for k := 0; k < window; k++ {
+			net := SelectionNetwork(window, k)
+			vs := make([]int, window)
+			for bits := 0; bits < 1<<window; bits++ {
+				ones := 0
+				for i := range vs {
+					vs[i] = (bits >> i) & 1
+					ones += vs[i]
+				}
+				net.SortInts(vs)
+
+				// Sorted, the zeros come first, so position k holds a one only if
+				// there are more than window-k-1 ones:
+				exp := 0
+				if k >= window-ones {
+					exp = 1
+				}
+				if vs[k] != exp {
+					t.Fatalf("rank %d of %d: input %0*b gave %d at position %d", k, window, window, bits, vs[k], k)
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkMedian(b *testing.B) {
+	rng := rand.New(rand.NewSource(0))
+	src := make([]int, 100000)
+	for i := range src {
+		src[i] = rng.Intn(1024)
+	}
+	dst := make([]int, len(src))
+
+	for _, window := range []int{3, 5, 7, 9} {
+		b.Run(fmt.Sprintf("network-%d", window), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				Median(dst, src, window)
+			}
+		})
+
+		b.Run(fmt.Sprintf("naive-%d", window), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				naiveRank(src, window, window/2)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+//go:build ignore
+
+// This program generates compiled_gen.go, which contains unrolled sorters for the
+// networks returned by New() for the sizes that Compile() can specialise. Run it using
+// 'go generate' whenever the networks returned by New() change.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"text/template"
+
+	"github.com/shabbyrobe/sortnet"
+)
+
+const (
+	minSize = 2
+	maxSize = 32
+)
+
+func main() {
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run() error {
+	var nets []sortnet.Network
+	for sz := minSize; sz <= maxSize; sz++ {
+		nets = append(nets, sortnet.New(sz))
+	}
+
+	var buf bytes.Buffer
+	if err := compiledTpl.Execute(&buf, nets); err != nil {
+		return err
+	}
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile("compiled_gen.go", out, 0644)
+}
+
+var compiledTpl = template.Must(template.New("").Funcs(template.FuncMap{
+	"last": func(net sortnet.Network) int { return net.Size - 1 },
+	"ops": func(net sortnet.Network) string {
+		var buf bytes.Buffer
+		for i, op := range net.Ops {
+			if i%8 == 0 {
+				buf.WriteString("\n")
+			}
+			fmt.Fprintf(&buf, "{%d, %d}, ", op.From, op.To)
+		}
+		return buf.String()
+	},
+}).Parse(`// Code generated by 'go run gencompiled.go'. DO NOT EDIT.
+
+package sortnet
+
+import "cmp"
+
+// compiledOps contains the comparators each of the unrolled sorters in this file
+// was generated from, indexed by size.
+var compiledOps = [...][]CompareAndSwap{
+	{{- range . }}
+	{{.Size}}: { {{- ops . }}
+	},
+	{{- end }}
+}
+
+func compiledSorter[T cmp.Ordered](size int) func([]T) {
+	switch size {
+	{{- range . }}
+	case {{.Size}}:
+		return compiledSort{{.Size}}[T]
+	{{- end }}
+	}
+	return nil
+}
+
+{{ range . }}
+// compiledSort{{.Size}} is an unrolled {{.Kind}} network.
+func compiledSort{{.Size}}[T cmp.Ordered](a []T) {
+	_ = a[{{ last . }}]
+	{{- range .Ops }}
+	if a[{{.From}}] > a[{{.To}}] {
+		a[{{.From}}], a[{{.To}}] = a[{{.To}}], a[{{.From}}]
+	}
+	{{- end }}
+}
+{{ end }}
+`))
@@ -0,0 +1,183 @@
+// Package hybrid provides general purpose sorts for slices of any length, using an
+// introsort-style partitioner that finishes off each small partition with the sorting
+// network returned by sortnet.New() for that partition's exact length.
+//
+// Sorting networks are several times faster than the stdlib for short slices, and
+// that advantage carries over to the base case of a quicksort.
+package hybrid
+
+import (
+	"cmp"
+	"math/bits"
+	"sync"
+
+	"github.com/shabbyrobe/sortnet"
+)
+
+// maxNetworkSize is the largest partition that will be sorted with a network rather
+// than partitioned further.
+const maxNetworkSize = 24
+
+// Ints sorts a slice of ints in increasing order.
+func Ints(a []int) { Slice(a) }
+
+// Float64s sorts a slice of float64s in increasing order. Not-a-number values are
+// ordered before other values, as they are by sort.Float64s.
+func Float64s(a []float64) { Slice(a) }
+
+// Strings sorts a slice of strings in increasing order.
+func Strings(a []string) { Slice(a) }
+
+// Slice sorts a slice of any ordered type in increasing order. For floating point
+// types, not-a-number values are ordered before other values, as they are by
+// slices.Sort.
+//
+// Slice is not stable.
+func Slice[T cmp.Ordered](a []T) {
+	if len(a) < 2 {
+		return
+	}
+	a = a[nansFirst(a):]
+
+	nets := networks[T]()
+	if len(a) <= maxNetworkSize {
+		nets[len(a)](a)
+		return
+	}
+	introsort(a, nets, bits.Len(uint(len(a)))*2)
+}
+
+type networkSorters[T cmp.Ordered] [maxNetworkSize + 1]func([]T)
+
+// Compiling the networks is cheap, but not so cheap that it should happen on every
+// call, so the compiled sorters are cached for each type.
+var networkCache sync.Map
+
+func networks[T cmp.Ordered]() *networkSorters[T] {
+	var key *T
+	if nets, ok := networkCache.Load(key); ok {
+		return nets.(*networkSorters[T])
+	}
+
+	var nets networkSorters[T]
+	for sz := range nets {
+		nets[sz] = sortnet.Compile[T](sortnet.New(sz))
+	}
+	cached, _ := networkCache.LoadOrStore(key, &nets)
+	return cached.(*networkSorters[T])
+}
+
+func introsort[T cmp.Ordered](a []T, nets *networkSorters[T], limit int) {
+	for len(a) > maxNetworkSize {
+		if limit == 0 {
+			heapSort(a)
+			return
+		}
+		limit--
+
+		mid := partition(a, choosePivot(a))
+
+		// Recurse into the smaller side and loop on the larger one, which keeps the
+		// stack depth logarithmic:
+		if mid < len(a)-mid {
+			introsort(a[:mid], nets, limit)
+			a = a[mid+1:]
+		} else {
+			introsort(a[mid+1:], nets, limit)
+			a = a[:mid]
+		}
+	}
+	nets[len(a)](a)
+}
+
+// nansFirst moves any not-a-number values to the front of a, returning how many were
+// found. For types other than floats, 'v != v' is always false.
+func nansFirst[T cmp.Ordered](a []T) (n int) {
+	for i := range a {
+		if a[i] != a[i] {
+			a[n], a[i] = a[i], a[n]
+			n++
+		}
+	}
+	return n
+}
+
+// choosePivot returns the index of the median of three items, or of the median of
+// three medians-of-three (Tukey's ninther) for larger slices.
+func choosePivot[T cmp.Ordered](a []T) int {
+	l := len(a)
+	i, j, k := l/4, l/2, l/4*3
+	if l >= 128 {
+		i = median(a, i-1, i, i+1)
+		j = median(a, j-1, j, j+1)
+		k = median(a, k-1, k, k+1)
+	}
+	return median(a, i, j, k)
+}
+
+func median[T cmp.Ordered](a []T, i, j, k int) int {
+	if a[j] < a[i] {
+		i, j = j, i
+	}
+	if a[k] < a[j] {
+		j = k
+		if a[j] < a[i] {
+			j = i
+		}
+	}
+	return j
+}
+
+// partition moves the pivot to its final position, with no greater items before it
+// and no lesser items after it, and returns that position. Items equal to the pivot
+// are swapped to both sides, which keeps the partitions balanced when there are lots
+// of duplicates.
+func partition[T cmp.Ordered](a []T, pivot int) int {
+	a[0], a[pivot] = a[pivot], a[0]
+	p := a[0]
+
+	i, j := 1, len(a)-1
+	for {
+		for i <= j && a[i] < p {
+			i++
+		}
+		for i <= j && a[j] > p {
+			j--
+		}
+		if i > j {
+			break
+		}
+		a[i], a[j] = a[j], a[i]
+		i++
+		j--
+	}
+	a[0], a[j] = a[j], a[0]
+	return j
+}
+
+func heapSort[T cmp.Ordered](a []T) {
+	for i := len(a)/2 - 1; i >= 0; i-- {
+		siftDown(a, i, len(a))
+	}
+	for end := len(a) - 1; end > 0; end-- {
+		a[0], a[end] = a[end], a[0]
+		siftDown(a, 0, end)
+	}
+}
+
+func siftDown[T cmp.Ordered](a []T, root, end int) {
+	for {
+		child := 2*root + 1
+		if child >= end {
+			return
+		}
+		if child+1 < end && a[child] < a[child+1] {
+			child++
+		}
+		if !(a[root] < a[child]) {
+			return
+		}
+		a[root], a[child] = a[child], a[root]
+		root = child
+	}
+}
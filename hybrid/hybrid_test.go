@@ -0,0 +1,155 @@
+package hybrid
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+func testInputs(rng *rand.Rand, n int) map[string][]int {
+	inputs := map[string][]int{}
+
+	random := make([]int, n)
+	dupes := make([]int, n)
+	sorted := make([]int, n)
+	reversed := make([]int, n)
+	organPipe := make([]int, n)
+	for i := 0; i < n; i++ {
+		random[i] = rng.Int()
+		dupes[i] = rng.Intn(4)
+		sorted[i] = i
+		reversed[i] = n - i
+		organPipe[i] = i
+		if i > n/2 {
+			organPipe[i] = n - i
+		}
+	}
+
+	inputs["random"] = random
+	inputs["dupes"] = dupes
+	inputs["sorted"] = sorted
+	inputs["reversed"] = reversed
+	inputs["organpipe"] = organPipe
+	return inputs
+}
+
+func TestInts(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+
+	var sizes []int
+	for i := 0; i <= 100; i++ {
+		sizes = append(sizes, i)
+	}
+	sizes = append(sizes, 1000, 10000, 100000)
+
+	for _, n := range sizes {
+		for name, input := range testInputs(rng, n) {
+			t.Run(fmt.Sprintf("%s/%d", name, n), func(t *testing.T) {
+				exp := slices.Clone(input)
+				sort.Ints(exp)
+				Ints(input)
+				if !reflect.DeepEqual(exp, input) {
+					t.Fatal("ints not sorted")
+				}
+			})
+		}
+	}
+}
+
+func TestHeapSort(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+	for name, input := range testInputs(rng, 1000) {
+		t.Run(name, func(t *testing.T) {
+			exp := slices.Clone(input)
+			sort.Ints(exp)
+			heapSort(input)
+			if !reflect.DeepEqual(exp, input) {
+				t.Fatal("ints not sorted")
+			}
+		})
+	}
+}
+
+func TestFloat64s(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+
+	for _, n := range []int{0, 1, 2, 5, 24, 25, 100, 10000} {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			input := make([]float64, n)
+			for i := range input {
+				switch rng.Intn(20) {
+				case 0:
+					input[i] = math.NaN()
+				case 1:
+					input[i] = math.Inf(-1)
+				default:
+					input[i] = rng.NormFloat64()
+				}
+			}
+
+			exp := slices.Clone(input)
+			sort.Float64s(exp)
+			Float64s(input)
+
+			for i := range exp {
+				if exp[i] != input[i] && !(math.IsNaN(exp[i]) && math.IsNaN(input[i])) {
+					t.Fatalf("float64s not sorted at index %d: %v != %v", i, exp[i], input[i])
+				}
+			}
+		})
+	}
+}
+
+func TestStrings(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+
+	for _, n := range []int{0, 1, 2, 5, 24, 25, 100, 10000} {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			input := make([]string, n)
+			for i := range input {
+				input[i] = strconv.Itoa(rng.Intn(n + 1))
+			}
+			exp := slices.Clone(input)
+			sort.Strings(exp)
+			Strings(input)
+			if !reflect.DeepEqual(exp, input) {
+				t.Fatal("strings not sorted")
+			}
+		})
+	}
+}
+
+func BenchmarkInts(b *testing.B) {
+	rng := rand.New(rand.NewSource(0))
+
+	for _, n := range []int{100, 1000, 100000} {
+		src := make([]int, n)
+		for i := range src {
+			src[i] = rng.Int()
+		}
+		vs := make([]int, n)
+
+		for _, tc := range []struct {
+			name   string
+			sorter func([]int)
+		}{
+			{"hybrid", Ints},
+			{"std", sort.Ints},
+			{"slices", slices.Sort[[]int]},
+		} {
+			b.Run(fmt.Sprintf("%s-%d", tc.name, n), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					b.StopTimer()
+					copy(vs, src)
+					b.StartTimer()
+					tc.sorter(vs)
+				}
+			})
+		}
+	}
+}
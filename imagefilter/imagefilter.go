@@ -0,0 +1,157 @@
+// Package imagefilter provides median, min, max and rank filters for images, built on
+// selection networks.
+//
+// Each channel of each pixel is replaced by the k-th smallest value of that channel in
+// the size×size neighbourhood centred on the pixel. Channels are filtered
+// independently, including alpha. Pixels beyond the edges of the image are treated as
+// copies of the nearest edge pixel.
+//
+// *image.Gray, *image.RGBA and *image.NRGBA are supported. The destination must be the
+// same type and have the same bounds as the source, and must not share its pixels.
+package imagefilter
+
+import (
+	"fmt"
+	"image"
+	"runtime"
+	"sync"
+
+	"github.com/shabbyrobe/sortnet"
+	"github.com/shabbyrobe/sortnet/filter"
+)
+
+// Median replaces each pixel with the median of its neighbourhood.
+func Median(dst, src image.Image, size int, workers int) error {
+	return Rank(dst, src, size, size*size/2, workers)
+}
+
+// Min replaces each pixel with the minimum of its neighbourhood (an erosion).
+func Min(dst, src image.Image, size int, workers int) error {
+	return Rank(dst, src, size, 0, workers)
+}
+
+// Max replaces each pixel with the maximum of its neighbourhood (a dilation).
+func Max(dst, src image.Image, size int, workers int) error {
+	return Rank(dst, src, size, size*size-1, workers)
+}
+
+// Rank replaces each pixel with the k-th smallest value (counting from 0) in its
+// size×size neighbourhood. size must be odd, for example 3 or 5.
+//
+// The image is split into horizontal bands, which are filtered by up to 'workers'
+// goroutines. If workers is <= 0, runtime.GOMAXPROCS(0) is used.
+func Rank(dst, src image.Image, size, k int, workers int) error {
+	if size < 1 || size%2 == 0 {
+		return fmt.Errorf("imagefilter: size must be odd and >= 1, found %d", size)
+	}
+	if k < 0 || k >= size*size {
+		return fmt.Errorf("imagefilter: k must be >= 0 and < %d, found %d", size*size, k)
+	}
+	if dst.Bounds() != src.Bounds() {
+		return fmt.Errorf("imagefilter: dst bounds %v do not match src bounds %v", dst.Bounds(), src.Bounds())
+	}
+
+	var p planes
+	switch src := src.(type) {
+	case *image.Gray:
+		d, ok := dst.(*image.Gray)
+		if !ok {
+			return fmt.Errorf("imagefilter: dst %T does not match src %T", dst, src)
+		}
+		p = planes{src: src.Pix, srcStride: src.Stride, dst: d.Pix, dstStride: d.Stride, channels: 1}
+
+	case *image.RGBA:
+		d, ok := dst.(*image.RGBA)
+		if !ok {
+			return fmt.Errorf("imagefilter: dst %T does not match src %T", dst, src)
+		}
+		p = planes{src: src.Pix, srcStride: src.Stride, dst: d.Pix, dstStride: d.Stride, channels: 4}
+
+	case *image.NRGBA:
+		d, ok := dst.(*image.NRGBA)
+		if !ok {
+			return fmt.Errorf("imagefilter: dst %T does not match src %T", dst, src)
+		}
+		p = planes{src: src.Pix, srcStride: src.Stride, dst: d.Pix, dstStride: d.Stride, channels: 4}
+
+	default:
+		return fmt.Errorf("imagefilter: unsupported image type %T", src)
+	}
+
+	bounds := src.Bounds()
+	p.width, p.height = bounds.Dx(), bounds.Dy()
+	p.size, p.k = size, k
+	p.ops = filter.SelectionNetwork(size*size, k).Ops
+	if p.width == 0 || p.height == 0 {
+		return nil
+	}
+
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > p.height {
+		workers = p.height
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		y0, y1 := p.height*w/workers, p.height*(w+1)/workers
+		go func() {
+			defer wg.Done()
+			p.filterRows(y0, y1)
+		}()
+	}
+	wg.Wait()
+	return nil
+}
+
+// planes describes the interleaved 8-bit channels of a source and destination image.
+type planes struct {
+	src, dst             []uint8
+	srcStride, dstStride int
+	width, height        int
+	channels             int
+	size, k              int
+	ops                  []sortnet.CompareAndSwap
+}
+
+func (p *planes) filterRows(y0, y1 int) {
+	r := p.size / 2
+	ops := p.ops
+
+	// Running the selection network over ints rather than bytes is about twice as
+	// quick, at least on amd64:
+	scratch := make([]int, p.size*p.size)
+
+	for y := y0; y < y1; y++ {
+		dstRow := p.dst[y*p.dstStride:]
+		for x := 0; x < p.width; x++ {
+			for c := 0; c < p.channels; c++ {
+				i := 0
+				for dy := -r; dy <= r; dy++ {
+					row := p.src[clamp(y+dy, p.height)*p.srcStride:]
+					for dx := -r; dx <= r; dx++ {
+						scratch[i] = int(row[clamp(x+dx, p.width)*p.channels+c])
+						i++
+					}
+				}
+
+				for _, op := range ops {
+					a, b := scratch[op.From], scratch[op.To]
+					scratch[op.From], scratch[op.To] = min(a, b), max(a, b)
+				}
+				dstRow[x*p.channels+c] = uint8(scratch[p.k])
+			}
+		}
+	}
+}
+
+func clamp(i, n int) int {
+	if i < 0 {
+		return 0
+	} else if i >= n {
+		return n - 1
+	}
+	return i
+}
@@ -0,0 +1,147 @@
+package imagefilter
+
+import (
+	"fmt"
+	"image"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func randomPix(rng *rand.Rand, pix []uint8) {
+	for i := range pix {
+		pix[i] = uint8(rng.Intn(256))
+	}
+}
+
+// naiveRank is the reference implementation: sort every neighbourhood of every channel.
+func naiveRank(pix []uint8, stride, width, height, channels, size, k int) []uint8 {
+	out := make([]uint8, len(pix))
+	r := size / 2
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			for c := 0; c < channels; c++ {
+				var vs []int
+				for dy := -r; dy <= r; dy++ {
+					for dx := -r; dx <= r; dx++ {
+						sy, sx := clamp(y+dy, height), clamp(x+dx, width)
+						vs = append(vs, int(pix[sy*stride+sx*channels+c]))
+					}
+				}
+				sort.Ints(vs)
+				out[y*stride+x*channels+c] = uint8(vs[k])
+			}
+		}
+	}
+	return out
+}
+
+func TestRank(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+	rect := image.Rect(3, 5, 40, 29)
+
+	for _, size := range []int{1, 3, 5} {
+		for _, k := range []int{0, size * size / 2, size*size - 1} {
+			for _, workers := range []int{1, 4} {
+				name := fmt.Sprintf("%d/%d/%d", size, k, workers)
+
+				t.Run("gray/"+name, func(t *testing.T) {
+					src, dst := image.NewGray(rect), image.NewGray(rect)
+					randomPix(rng, src.Pix)
+					if err := Rank(dst, src, size, k, workers); err != nil {
+						t.Fatal(err)
+					}
+					exp := naiveRank(src.Pix, src.Stride, rect.Dx(), rect.Dy(), 1, size, k)
+					if !reflect.DeepEqual(exp, dst.Pix) {
+						t.Fatal("gray image does not match reference")
+					}
+				})
+
+				t.Run("rgba/"+name, func(t *testing.T) {
+					src, dst := image.NewRGBA(rect), image.NewRGBA(rect)
+					randomPix(rng, src.Pix)
+					if err := Rank(dst, src, size, k, workers); err != nil {
+						t.Fatal(err)
+					}
+					exp := naiveRank(src.Pix, src.Stride, rect.Dx(), rect.Dy(), 4, size, k)
+					if !reflect.DeepEqual(exp, dst.Pix) {
+						t.Fatal("rgba image does not match reference")
+					}
+				})
+
+				t.Run("nrgba/"+name, func(t *testing.T) {
+					src, dst := image.NewNRGBA(rect), image.NewNRGBA(rect)
+					randomPix(rng, src.Pix)
+					if err := Rank(dst, src, size, k, workers); err != nil {
+						t.Fatal(err)
+					}
+					exp := naiveRank(src.Pix, src.Stride, rect.Dx(), rect.Dy(), 4, size, k)
+					if !reflect.DeepEqual(exp, dst.Pix) {
+						t.Fatal("nrgba image does not match reference")
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestSubImage(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+	full := image.NewRGBA(image.Rect(0, 0, 20, 20))
+	randomPix(rng, full.Pix)
+
+	src := full.SubImage(image.Rect(4, 4, 12, 15)).(*image.RGBA)
+	dst := image.NewRGBA(src.Bounds())
+	if err := Median(dst, src, 3, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	compact := image.NewRGBA(src.Bounds())
+	for y := src.Rect.Min.Y; y < src.Rect.Max.Y; y++ {
+		for x := src.Rect.Min.X; x < src.Rect.Max.X; x++ {
+			compact.Set(x, y, src.At(x, y))
+		}
+	}
+	exp := naiveRank(compact.Pix, compact.Stride, 8, 11, 4, 3, 4)
+	if !reflect.DeepEqual(exp, dst.Pix) {
+		t.Fatal("sub image does not match reference")
+	}
+}
+
+func TestRankErrors(t *testing.T) {
+	rect := image.Rect(0, 0, 4, 4)
+	gray, rgba := image.NewGray(rect), image.NewRGBA(rect)
+
+	for idx, tc := range []struct {
+		dst, src image.Image
+		size, k  int
+	}{
+		{gray, gray, 2, 0},
+		{gray, gray, 3, 9},
+		{rgba, gray, 3, 4},
+		{image.NewGray(image.Rect(0, 0, 3, 3)), gray, 3, 4},
+		{image.NewGray16(rect), image.NewGray16(rect), 3, 4},
+	} {
+		if err := Rank(tc.dst, tc.src, tc.size, tc.k, 1); err == nil {
+			t.Fatal(idx, "expected error")
+		}
+	}
+}
+
+func BenchmarkMedian(b *testing.B) {
+	rng := rand.New(rand.NewSource(0))
+	rect := image.Rect(0, 0, 1000, 1000)
+	src, dst := image.NewRGBA(rect), image.NewRGBA(rect)
+	randomPix(rng, src.Pix)
+
+	for _, size := range []int{3, 5} {
+		b.Run(fmt.Sprint(size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if err := Median(dst, src, size, 0); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
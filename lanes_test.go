@@ -0,0 +1,69 @@
+package sortnet
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestSortLanes(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+
+	for _, sz := range []int{1, 2, 3, 9, 16, 25} {
+		for _, lanes := range []int{0, 1, 7, 64} {
+			t.Run(fmt.Sprintf("%d/%d", sz, lanes), func(t *testing.T) {
+				vs := make([]int, sz*lanes)
+				for i := range vs {
+					vs[i] = rng.Intn(100)
+				}
+
+				exp := make([][]int, lanes)
+				for l := range exp {
+					for i := 0; i < sz; i++ {
+						exp[l] = append(exp[l], vs[i*lanes+l])
+					}
+					sort.Ints(exp[l])
+				}
+
+				SortLanes(New(sz), vs, lanes)
+				for l := range exp {
+					for i := 0; i < sz; i++ {
+						if vs[i*lanes+l] != exp[l][i] {
+							t.Fatalf("lane %d not sorted", l)
+						}
+					}
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkSortLanes(b *testing.B) {
+	const sz, lanes = 9, 4096
+	rng := rand.New(rand.NewSource(0))
+	src := make([]int, sz*lanes)
+	for i := range src {
+		src[i] = rng.Intn(1024)
+	}
+	vs := make([]int, len(src))
+	net := New(sz)
+
+	b.Run("lanes", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			copy(vs, src)
+			b.StartTimer()
+			SortLanes(net, vs, lanes)
+		}
+	})
+
+	b.Run("chunks", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			copy(vs, src)
+			b.StartTimer()
+			SortChunks(net, vs, 1)
+		}
+	})
+}
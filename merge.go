@@ -0,0 +1,179 @@
+package sortnet
+
+import "cmp"
+
+// maxStackRuns is the largest number of runs MergeRuns can merge without allocating.
+const maxStackRuns = 32
+
+// mergeLeafRuns is the number of runs kept in order by each leaf of MergeRuns'
+// tournament.
+const mergeLeafRuns = 4
+
+// MergeRuns merges runs, each of which must already be sorted in increasing order,
+// appending the result to dst and returning the extended slice. Equal items keep the
+// order of the runs they came from. For example, the blocks sorted by SortChunks can
+// be combined into a single ordering with:
+//
+//	runs := make([][]T, 0, len(vs)/net.Size)
+//	for i := 0; i < len(vs); i += net.Size {
+//		runs = append(runs, vs[i:i+net.Size])
+//	}
+//	out = MergeRuns(out[:0], runs...)
+//
+// The runs are merged using a tournament. Each leaf of the tournament holds a group of
+// up to 4 runs (the last group may have fewer), and keeps the heads of its runs in
+// sorted order by insertion: when the smallest head is taken, the run's next item is
+// swapped past each head that should come before it, stopping at the first that
+// shouldn't. The leaves' smallest heads then play each other in a loser tree, and
+// after each item is taken only the matches along its leaf's path back to the final
+// are replayed, so each item costs at most 3 comparisons in its leaf and about
+// log2(len(runs)/4) in the tree.
+//
+// MergeRuns does not allocate if dst has enough capacity for all of the runs, and
+// there are no more than 32 runs.
+func MergeRuns[T cmp.Ordered](dst []T, runs ...[]T) []T {
+	switch len(runs) {
+	case 0:
+		return dst
+	case 1:
+		return append(dst, runs[0]...)
+	case 2:
+		return merge2(dst, runs[0], runs[1])
+	}
+
+	total := 0
+	for _, run := range runs {
+		total += len(run)
+	}
+	if cap(dst)-len(dst) < total {
+		grown := make([]T, len(dst), len(dst)+total)
+		copy(grown, dst)
+		dst = grown
+	}
+
+	groups := (len(runs) + mergeLeafRuns - 1) / mergeLeafRuns
+	leaves := 1
+	for leaves < groups {
+		leaves <<= 1
+	}
+
+	var posBuf [maxStackRuns]int
+	var headBuf [maxStackRuns]T
+	var treeBuf [maxStackRuns / mergeLeafRuns]int
+	var windowBuf [maxStackRuns / mergeLeafRuns][mergeLeafRuns]int
+	var pos, tree []int
+	var heads []T
+	var window [][mergeLeafRuns]int
+	if len(runs) <= maxStackRuns {
+		pos, heads = posBuf[:len(runs)], headBuf[:len(runs)]
+		tree, window = treeBuf[:leaves], windowBuf[:groups]
+	} else {
+		pos, heads = make([]int, len(runs)), make([]T, len(runs))
+		tree, window = make([]int, leaves), make([][mergeLeafRuns]int, groups)
+	}
+
+	// pos[i] is the index of the head of run i, or -1 once the run is empty:
+	for i := range pos {
+		pos[i] = -1
+		if len(runs[i]) > 0 {
+			pos[i], heads[i] = 0, runs[i][0]
+		}
+	}
+
+	// beats reports whether the head of run a should come before the head of run b.
+	// Empty runs, and the missing runs of the padding leaves (-1), come last. Ties go
+	// to the earlier run, which keeps the merge stable.
+	beats := func(a, b int) bool {
+		if b < 0 || pos[b] < 0 {
+			return true
+		}
+		if a < 0 || pos[a] < 0 {
+			return false
+		}
+		return heads[a] < heads[b] || (a < b && !(heads[b] < heads[a]))
+	}
+
+	// window[g] holds the runs of group g, ordered by their heads. insert moves the run
+	// at window[g][0] past each of the n-1 runs after it whose head should come first,
+	// stopping at the first that shouldn't, which puts the window back in order:
+	groupSize := func(g int) int {
+		return min(mergeLeafRuns, len(runs)-g*mergeLeafRuns)
+	}
+	insert := func(g, n int) {
+		win := &window[g]
+		for i := 0; i+1 < n && beats(win[i+1], win[i]); i++ {
+			win[i], win[i+1] = win[i+1], win[i]
+		}
+	}
+	for g := range window {
+		for i := 0; i < groupSize(g); i++ {
+			// Each run joins the front of the window, then is merged into the runs
+			// already there:
+			copy(window[g][1:i+1], window[g][:i])
+			window[g][0] = g*mergeLeafRuns + i
+			insert(g, i+1)
+		}
+	}
+
+	// leafRun is the run at the head of leaf g's window, or -1 for padding leaves.
+	leafRun := func(g int) int {
+		if g < groups {
+			return window[g][0]
+		}
+		return -1
+	}
+
+	// The tree is a loser tree: each internal node holds the leaf that lost the match
+	// played there, and tree[0] holds the overall winner. Replaying a match only needs
+	// the new head and the loser stored at each node on the way back to the final.
+	// While building it, each subtree's winner is passed back up to play the other
+	// subtree's winner:
+	var build func(node int) int
+	build = func(node int) int {
+		if node >= leaves {
+			return node - leaves
+		}
+		l, r := build(node*2), build(node*2+1)
+		if beats(leafRun(r), leafRun(l)) {
+			l, r = r, l
+		}
+		tree[node] = r
+		return l
+	}
+	tree[0] = build(1)
+
+	for n := 0; n < total; n++ {
+		w := tree[0]
+		r := window[w][0]
+		dst = append(dst, heads[r])
+		if p := pos[r] + 1; p < len(runs[r]) {
+			pos[r], heads[r] = p, runs[r][p]
+		} else {
+			pos[r] = -1
+		}
+		insert(w, groupSize(w))
+
+		for node := (leaves + w) / 2; node >= 1; node /= 2 {
+			if beats(leafRun(tree[node]), leafRun(w)) {
+				tree[node], w = w, tree[node]
+			}
+		}
+		tree[0] = w
+	}
+
+	return dst
+}
+
+func merge2[T cmp.Ordered](dst, a, b []T) []T {
+	for len(a) > 0 && len(b) > 0 {
+		if b[0] < a[0] {
+			dst = append(dst, b[0])
+			b = b[1:]
+		} else {
+			dst = append(dst, a[0])
+			a = a[1:]
+		}
+	}
+	dst = append(dst, a...)
+	return append(dst, b...)
+}
@@ -0,0 +1,95 @@
+package sortnet
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func randRuns(rng *rand.Rand, k, maxLen int) (runs [][]int, all []int) {
+	for i := 0; i < k; i++ {
+		run := make([]int, rng.Intn(maxLen+1))
+		for j := range run {
+			run[j] = rng.Intn(100)
+		}
+		sort.Ints(run)
+		runs = append(runs, run)
+		all = append(all, run...)
+	}
+	sort.Ints(all)
+	return runs, all
+}
+
+func TestMergeRuns(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+
+	for _, k := range []int{0, 1, 2, 3, 4, 5, 8, 9, 31, 32, 33, 100} {
+		for _, maxLen := range []int{0, 1, 10, 100} {
+			t.Run(fmt.Sprintf("%d/%d", k, maxLen), func(t *testing.T) {
+				runs, exp := randRuns(rng, k, maxLen)
+				prefix := []int{-1, -2}
+				out := MergeRuns(append([]int{}, prefix...), runs...)
+				if !reflect.DeepEqual(append(prefix, exp...), out) {
+					t.Fatalf("\nexp: %v\nout: %v", exp, out)
+				}
+			})
+		}
+	}
+}
+
+func TestMergeRunsStable(t *testing.T) {
+	// Negative and positive zero compare equal, but can be told apart afterwards:
+	neg := math.Copysign(0, -1)
+	runs := [][]float64{{-1, 0, 1}, {neg, neg}, {0, 1}, {neg}}
+
+	out := MergeRuns(nil, runs...)
+	signs := make([]bool, len(out))
+	for i, v := range out {
+		signs[i] = math.Signbit(v)
+	}
+	expSigns := []bool{true, false, true, true, false, true, false, false}
+	if !reflect.DeepEqual(expSigns, signs) {
+		t.Fatal(out)
+	}
+}
+
+func TestMergeRunsAllocs(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+	for _, k := range []int{2, 7, 32} {
+		runs, all := randRuns(rng, k, 50)
+		dst := make([]int, 0, len(all))
+		allocs := testing.AllocsPerRun(100, func() {
+			dst = MergeRuns(dst[:0], runs...)
+		})
+		if allocs != 0 {
+			t.Fatalf("expected no allocations for %d runs, found %f", k, allocs)
+		}
+	}
+}
+
+func BenchmarkMergeRuns(b *testing.B) {
+	rng := rand.New(rand.NewSource(0))
+	for _, k := range []int{4, 16, 64} {
+		runs, all := randRuns(rng, k, 10000)
+		dst := make([]int, 0, len(all))
+
+		b.Run(fmt.Sprintf("merge-%d", k), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				dst = MergeRuns(dst[:0], runs...)
+			}
+		})
+
+		b.Run(fmt.Sprintf("sort-%d", k), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				dst = dst[:0]
+				for _, run := range runs {
+					dst = append(dst, run...)
+				}
+				sort.Ints(dst)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package sortnet
+
+// Metadata describes where a network came from, and how it compares with the best
+// networks known for its size.
+type Metadata struct {
+	// Family is a short lowercase identifier for the method or author that produced the
+	// network, for example "bosenelson", "senso" or "green".
+	Family string
+
+	// Discoverer credits the people who found or constructed the network.
+	Discoverer string
+
+	// Year is the year the network was published, or 0 if it is not known.
+	Year int
+
+	// Reference is a citation or URL for the network's source.
+	Reference string
+
+	// SizeOptimality describes whether no network for this many inputs can use fewer
+	// comparators.
+	SizeOptimality Optimality
+
+	// DepthOptimality describes whether no network for this many inputs can have a
+	// smaller depth.
+	DepthOptimality Optimality
+}
+
+// Optimality describes how a network's size or depth compares with the best possible
+// for its number of inputs.
+type Optimality int
+
+const (
+	// UnknownOptimality means there is nothing to compare the network's size or depth
+	// with: no optimum has been proven, and no best known network has been recorded
+	// for its number of inputs.
+	UnknownOptimality Optimality = iota
+
+	// NotOptimal means networks with a better size or depth are known.
+	NotOptimal
+
+	// BestKnown means no network with a better size or depth is known, but it has not
+	// been proven that one can't exist, so a better network might still be found.
+	BestKnown
+
+	// ProvenOptimal means it has been proven that no network can do better.
+	ProvenOptimal
+)
+
+func (o Optimality) String() string {
+	switch o {
+	case NotOptimal:
+		return "not optimal"
+	case BestKnown:
+		return "best known"
+	case ProvenOptimal:
+		return "proven optimal"
+	default:
+		return "unknown"
+	}
+}
+
+// optimalSizes holds the proven minimum number of comparators for each number of
+// inputs, as far as it is known. Sizes 9 and 10 were proven by Codish, Cruz-Filipe,
+// Frank and Schneider-Kamp (2014), and 11 and 12 by Harder (2020).
+var optimalSizes = []int{0, 0, 1, 3, 5, 9, 12, 16, 19, 25, 29, 35, 39}
+
+// optimalDepths holds the proven minimum depth for each number of inputs, as far as it
+// is known. Depths up to 16 inputs were proven by Bundala and Závodný (2014), and 17
+// by Codish, Cruz-Filipe, Ehlers, Müller and Schneider-Kamp (2016).
+var optimalDepths = []int{0, 0, 1, 3, 3, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 9, 9, 10}
+
+// bestKnownSizes and bestKnownDepths hold the smallest number of comparators and depth
+// of any network known for 13 to 24 inputs, where they have not been proven optimal.
+var (
+	bestKnownSizes  = map[int]int{13: 45, 14: 51, 15: 56, 16: 60, 17: 71, 18: 77, 19: 85, 20: 91, 23: 114, 24: 120}
+	bestKnownDepths = map[int]int{18: 11, 19: 11, 20: 11, 21: 12, 22: 12, 23: 12, 24: 12}
+)
+
+// checkedOptimality compares v, the size or depth of a network for n inputs, with the
+// proven optimum in optimal, or if there isn't one, the best known in bestKnown. It
+// returns UnknownOptimality if neither is recorded for n.
+func checkedOptimality(optimal []int, bestKnown map[int]int, n, v int) Optimality {
+	if n < len(optimal) {
+		if v == optimal[n] {
+			return ProvenOptimal
+		}
+		return NotOptimal
+	}
+	if best, ok := bestKnown[n]; ok {
+		if v <= best {
+			return BestKnown
+		}
+		return NotOptimal
+	}
+	return UnknownOptimality
+}
+
+// References for the networks in Optimized. John Gamble's Networksort package, from
+// which most of these networks were collected, gives further details for each one:
+// https://metacpan.org/release/Algorithm-Networksort
+const (
+	refKnuth = "D. E. Knuth, The Art of Computer Programming, Vol. 3: Sorting and Searching, " +
+		"2nd ed., section 5.3.4, Addison-Wesley, 1998"
+
+	refSenso = "V. K. Valsalam and R. Miikkulainen, Using Symmetry and Evolutionary Search " +
+		"to Minimize Sorting Networks, Journal of Machine Learning Research 14, 2013"
+
+	refEnd = "H. Juillé, Evolution of Non-Deterministic Incremental Algorithms as a New " +
+		"Approach for Search in State Spaces, Proceedings of the 6th International " +
+		"Conference on Genetic Algorithms, 1995"
+
+	refSat = "M. Codish, L. Cruz-Filipe, T. Ehlers, M. Müller and P. Schneider-Kamp, " +
+		"Sorting Networks: To the End and Back Again, Journal of Computer and System " +
+		"Sciences, 2016"
+
+	refAlHajBaddar = "S. W. Al-Haj Baddar, Finding Better Sorting Networks, PhD thesis, " +
+		"Kent State University, 2009"
+
+	refMorwenn = "https://github.com/Morwenn/cpp-sort"
+
+	refBoseNelson = "R. C. Bose and R. J. Nelson, A Sorting Problem, Journal of the ACM " +
+		"9(2), 1962"
+)
+
+func sensoMeta(size, depth Optimality) Metadata {
+	return Metadata{
+		Family:          "senso",
+		Discoverer:      "V. K. Valsalam and R. Miikkulainen",
+		Year:            2013,
+		Reference:       refSenso,
+		SizeOptimality:  size,
+		DepthOptimality: depth,
+	}
+}
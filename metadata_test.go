@@ -0,0 +1,57 @@
+package sortnet
+
+import "testing"
+
+func checkOptimality(t *testing.T, what string, claimed Optimality, optimal []int, bestKnown map[int]int, n, v int) {
+	t.Helper()
+
+	if n < len(optimal) && v < optimal[n] {
+		t.Fatalf("%s %d is better than the proven optimum %d", what, v, optimal[n])
+	}
+	if best, ok := bestKnown[n]; ok && n >= len(optimal) && v < best {
+		t.Fatalf("%s %d is better than the best known %d", what, v, best)
+	}
+	exp := checkedOptimality(optimal, bestKnown, n, v)
+	if exp == UnknownOptimality {
+		t.Skipf("no best known %s for %d inputs to check against", what, n)
+	}
+	if claimed != exp {
+		t.Fatalf("%s %d claimed to be %s, expected %s", what, v, claimed, exp)
+	}
+}
+
+func TestOptimizedMetadata(t *testing.T) {
+	for _, net := range Optimized {
+		t.Run(net.Kind, func(t *testing.T) {
+			if net.Meta.Family == "" || net.Meta.Discoverer == "" || net.Meta.Reference == "" {
+				t.Fatalf("missing metadata: %+v", net.Meta)
+			}
+			checkOptimality(t, "size", net.Meta.SizeOptimality, optimalSizes, bestKnownSizes, net.Size, len(net.Ops))
+			checkOptimality(t, "depth", net.Meta.DepthOptimality, optimalDepths, bestKnownDepths, net.Size, net.Depth)
+		})
+	}
+}
+
+func TestBoseNelsonMetadata(t *testing.T) {
+	for _, tc := range []struct {
+		n           int
+		size, depth Optimality
+	}{
+		{2, ProvenOptimal, ProvenOptimal},
+		{4, ProvenOptimal, ProvenOptimal},
+		{6, NotOptimal, NotOptimal},
+		{8, ProvenOptimal, NotOptimal},
+		{40, UnknownOptimality, UnknownOptimality},
+	} {
+		net := BoseNelson(tc.n)
+		if net.Meta.Family != "bosenelson" {
+			t.Fatal(net.Meta)
+		}
+		if net.Depth != opsDepth(net.Size, net.Ops) {
+			t.Fatal(tc.n, net.Depth)
+		}
+		if net.Meta.SizeOptimality != tc.size || net.Meta.DepthOptimality != tc.depth {
+			t.Fatalf("%d: %s, %s", tc.n, net.Meta.SizeOptimality, net.Meta.DepthOptimality)
+		}
+	}
+}
@@ -1,6 +1,11 @@
 package sortnet
 
-import "reflect"
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"reflect"
+)
 
 type CompareAndSwap struct {
 	From int
@@ -24,6 +29,25 @@ type Network struct {
 	// value can encounter on its way through the network.
 	// Depth may not be calculated for certain networks, so it may be 0.
 	Depth int
+
+	// Meta describes where the network came from. It is filled in for the networks in
+	// Optimized and those built by BoseNelson, and may be empty for others.
+	Meta Metadata
+}
+
+// Fingerprint identifies the network by its size and comparators, so networks with the
+// same comparators in the same order have the same fingerprint whatever their Kind,
+// and any change to a network's comparators changes it. It is the first 16 hex digits
+// of a SHA-256 hash.
+func (n Network) Fingerprint() string {
+	buf := make([]byte, 0, binary.MaxVarintLen64*(1+2*len(n.Ops)))
+	buf = binary.AppendUvarint(buf, uint64(n.Size))
+	for _, op := range n.Ops {
+		buf = binary.AppendUvarint(buf, uint64(op.From))
+		buf = binary.AppendUvarint(buf, uint64(op.To))
+	}
+	sum := sha256.Sum256(buf)
+	return hex.EncodeToString(sum[:8])
 }
 
 // SortInts is a convenience that sorts a list of ints in place.
@@ -20,6 +20,14 @@ func maxNetSize(nets []Network) int {
 	return max
 }
 
+func TestOptimizedDepth(t *testing.T) {
+	for _, net := range Optimized {
+		if depth := opsDepth(net.Size, net.Ops); depth != net.Depth {
+			t.Fatalf("%s: recorded depth %d, calculated %d", net.Kind, net.Depth, depth)
+		}
+	}
+}
+
 func TestNetworks(t *testing.T) {
 	var networks []Network
 
@@ -158,3 +166,34 @@ func (r *randInts) Take(b *testing.B, n int) []int {
 	r.next += n
 	return out
 }
+
+func TestFingerprint(t *testing.T) {
+	// Fingerprints are recorded in generated code, so changing how they are calculated
+	// makes every generated file look out of date:
+	if fp := BoseNelson(4).Fingerprint(); fp != "0119fc1a905673cd" {
+		t.Fatal("unexpected fingerprint for BoseNelson(4):", fp)
+	}
+
+	net := BoseNelson(9)
+	renamed := net
+	renamed.Kind = "Renamed"
+	if net.Fingerprint() != renamed.Fingerprint() {
+		t.Fatal("fingerprint should not depend on Kind")
+	}
+
+	changed := net
+	changed.Ops = append([]CompareAndSwap(nil), net.Ops...)
+	changed.Ops[0], changed.Ops[1] = changed.Ops[1], changed.Ops[0]
+	if net.Fingerprint() == changed.Fingerprint() {
+		t.Fatal("fingerprint should depend on the order of comparators")
+	}
+
+	seen := map[string]string{}
+	for _, net := range Optimized {
+		fp := net.Fingerprint()
+		if other, ok := seen[fp]; ok {
+			t.Fatalf("%s has the same fingerprint as %s", net.Kind, other)
+		}
+		seen[fp] = net.Kind
+	}
+}
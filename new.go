@@ -1,32 +1,20 @@
 package sortnet
 
-var (
-	bySize         = [32][]Network{}
-	simplestBySize = [32]Network{}
-	noNetwork      Network
-)
-
-func init() {
-	for _, net := range Optimized {
-		bySize[net.Size] = append(bySize[net.Size], net)
-
-		simplest := simplestBySize[net.Size]
-		if simplest.Size == 0 ||
-			len(net.Ops) < len(simplest.Ops) ||
-			(len(net.Ops) == len(simplest.Ops) && net.Depth < simplest.Depth) {
-
-			simplestBySize[net.Size] = net
-		}
-	}
-}
-
+// New returns the simplest network known for the given size: the one with the fewest
+// comparators, then the smallest depth. This is the best registered network for the
+// size (see Register), or one built by BoseNelson if that is simpler or there isn't
+// one.
 func New(size int) (net Network) {
-	if size < 32 {
-		optimized := simplestBySize[size]
-		if optimized.Size > 0 {
-			return optimized
-		}
+	registry.mu.RLock()
+	net, ok := registry.simplest[size]
+	registry.mu.RUnlock()
+	if ok {
+		return net
 	}
-
 	return BoseNelson(size)
 }
+
+// simpler reports whether a should be preferred over b by New.
+func simpler(a, b Network) bool {
+	return len(a.Ops) < len(b.Ops) || (len(a.Ops) == len(b.Ops) && a.Depth < b.Depth)
+}
@@ -46,6 +46,9 @@ var (
 		{6, 7}, {0, 3}, {3, 6}, {0, 3}, {1, 4}, {4, 7}, {1, 4}, {2, 5},
 		{5, 8}, {2, 5}, {1, 3}, {5, 7}, {2, 6}, {4, 6}, {2, 4}, {2, 3},
 		{5, 6},
+	}, Meta: Metadata{
+		Family: "floyd", Discoverer: "R. W. Floyd", Year: 1964, Reference: refKnuth,
+		SizeOptimality: ProvenOptimal, DepthOptimality: NotOptimal,
 	}}
 
 	// 9-Input Network via SENSO by V. K. Valsalam and R. Miikkulainen
@@ -54,13 +57,16 @@ var (
 		{5, 8}, {1, 3}, {6, 8}, {0, 1}, {4, 5}, {2, 7}, {3, 7}, {3, 4},
 		{5, 6}, {1, 2}, {1, 3}, {6, 7}, {4, 5}, {2, 4}, {5, 6}, {2, 3},
 		{4, 5},
-	}}
+	}, Meta: sensoMeta(ProvenOptimal, NotOptimal)}
 
 	Waksman10 = Network{Kind: "Waksman10", Size: 10, Depth: 9, Ops: []CompareAndSwap{
 		{4, 9}, {3, 8}, {2, 7}, {1, 6}, {0, 5}, {1, 4}, {6, 9}, {0, 3},
 		{5, 8}, {0, 2}, {3, 6}, {7, 9}, {0, 1}, {2, 4}, {5, 7}, {8, 9},
 		{1, 2}, {4, 6}, {7, 8}, {3, 5}, {2, 5}, {6, 8}, {1, 3}, {4, 7},
 		{2, 3}, {6, 7}, {3, 4}, {5, 6}, {4, 5},
+	}, Meta: Metadata{
+		Family: "waksman", Discoverer: "A. Waksman", Year: 1969, Reference: refKnuth,
+		SizeOptimality: ProvenOptimal, DepthOptimality: NotOptimal,
 	}}
 
 	// 10-Input Network via SENSO by V. K. Valsalam and R. Miikkulainen
@@ -69,7 +75,7 @@ var (
 		{3, 6}, {4, 9}, {0, 1}, {0, 2}, {6, 9}, {3, 5}, {4, 7}, {1, 8},
 		{3, 4}, {5, 8}, {6, 7}, {1, 2}, {7, 8}, {1, 3}, {2, 5}, {4, 6},
 		{2, 3}, {6, 7}, {4, 5}, {3, 4}, {5, 6},
-	}}
+	}, Meta: sensoMeta(ProvenOptimal, NotOptimal)}
 
 	// 11-Input by G. Shapiro and M. W. Green
 	ShapiroGreen11 = Network{Kind: "ShapiroGreen11", Size: 11, Depth: 9, Ops: []CompareAndSwap{
@@ -78,6 +84,9 @@ var (
 		{2, 6}, {1, 5}, {6, 10}, {0, 4}, {3, 7}, {4, 8}, {0, 4}, {1, 4},
 		{7, 10}, {3, 8}, {2, 3}, {8, 9}, {2, 4}, {7, 9}, {3, 5}, {6, 8},
 		{3, 4}, {5, 6}, {7, 8},
+	}, Meta: Metadata{
+		Family: "shapirogreen", Discoverer: "G. Shapiro and M. W. Green", Year: 1969, Reference: refKnuth,
+		SizeOptimality: ProvenOptimal, DepthOptimality: NotOptimal,
 	}}
 
 	// 11-Input Network via SENSO by V. K. Valsalam and R. Miikkulainen
@@ -87,7 +96,7 @@ var (
 		{3, 4}, {6, 8}, {2, 6}, {1, 5}, {7, 8}, {4, 9}, {2, 3}, {8, 9},
 		{1, 2}, {4, 6}, {3, 5}, {6, 7}, {7, 8}, {2, 3}, {4, 6}, {5, 6},
 		{3, 4}, {6, 7}, {4, 5},
-	}}
+	}, Meta: sensoMeta(ProvenOptimal, NotOptimal)}
 
 	// 12-Input by G. Shapiro and M. W. Green
 	ShapiroGreen12 = Network{Kind: "ShapiroGreen12", Size: 12, Depth: 9, Ops: []CompareAndSwap{
@@ -96,6 +105,9 @@ var (
 		{6, 10}, {5, 9}, {2, 6}, {1, 5}, {6, 10}, {0, 4}, {7, 11}, {3, 7},
 		{4, 8}, {0, 4}, {7, 11}, {1, 4}, {7, 10}, {3, 8}, {2, 3}, {8, 9},
 		{2, 4}, {7, 9}, {3, 5}, {6, 8}, {3, 4}, {5, 6}, {7, 8},
+	}, Meta: Metadata{
+		Family: "shapirogreen", Discoverer: "G. Shapiro and M. W. Green", Year: 1969, Reference: refKnuth,
+		SizeOptimality: ProvenOptimal, DepthOptimality: NotOptimal,
 	}}
 
 	// 12-Input Network via SENSO by V. K. Valsalam and R. Miikkulainen
@@ -105,7 +117,7 @@ var (
 		{0, 1}, {4, 8}, {5, 8}, {1, 4}, {3, 7}, {2, 5}, {7, 10}, {6, 9},
 		{2, 3}, {4, 6}, {8, 10}, {1, 2}, {9, 10}, {6, 8}, {3, 4}, {8, 9},
 		{2, 3}, {5, 7}, {4, 5}, {6, 7}, {7, 8}, {5, 6}, {3, 4},
-	}}
+	}, Meta: sensoMeta(ProvenOptimal, NotOptimal)}
 
 	// 13-Input Network Generated by the END algorithm, by Hugues Juillé
 	End13 = Network{Kind: "End13", Size: 13, Depth: 10, Ops: []CompareAndSwap{
@@ -115,6 +127,9 @@ var (
 		{10, 11}, {1, 7}, {2, 6}, {9, 11}, {1, 3}, {4, 7}, {8, 10}, {0, 5},
 		{2, 5}, {6, 8}, {9, 10}, {1, 2}, {3, 5}, {7, 8}, {4, 6}, {2, 3},
 		{4, 5}, {6, 7}, {8, 9}, {3, 4}, {5, 6},
+	}, Meta: Metadata{
+		Family: "end", Discoverer: "H. Juillé", Year: 1995, Reference: refEnd,
+		SizeOptimality: BestKnown, DepthOptimality: NotOptimal,
 	}}
 
 	// 13-Input Network via SENSO by V. K. Valsalam and R. Miikkulainen
@@ -125,7 +140,7 @@ var (
 		{4, 6}, {4, 7}, {10, 11}, {6, 9}, {3, 4}, {1, 2}, {9, 11}, {1, 3},
 		{6, 10}, {2, 4}, {2, 3}, {9, 10}, {6, 8}, {5, 7}, {5, 6}, {7, 8},
 		{3, 5}, {8, 9}, {4, 5}, {6, 7}, {5, 6},
-	}}
+	}, Meta: sensoMeta(BestKnown, NotOptimal)}
 
 	// 14-Input Network by M. W. Green
 	Green14 = Network{Kind: "Green14", Size: 14, Depth: 10, Ops: []CompareAndSwap{
@@ -136,6 +151,9 @@ var (
 		{7, 13}, {2, 8}, {2, 4}, {5, 6}, {9, 10}, {11, 13}, {3, 8}, {7, 12},
 		{6, 8}, {10, 12}, {3, 5}, {7, 9}, {3, 4}, {5, 6}, {7, 8}, {9, 10},
 		{11, 12}, {6, 7}, {8, 9},
+	}, Meta: Metadata{
+		Family: "green", Discoverer: "M. W. Green", Year: 1969, Reference: refKnuth,
+		SizeOptimality: BestKnown, DepthOptimality: NotOptimal,
 	}}
 
 	// 14-Input Network via SENSO by V. K. Valsalam and R. Miikkulainen'
@@ -147,7 +165,7 @@ var (
 		{11, 12}, {1, 2}, {8, 10}, {3, 9}, {3, 4}, {2, 3}, {10, 11}, {5, 7},
 		{7, 8}, {6, 9}, {5, 6}, {4, 5}, {8, 9}, {6, 7}, {9, 10}, {3, 4},
 		{5, 6}, {7, 8}, {6, 7},
-	}}
+	}, Meta: sensoMeta(BestKnown, NotOptimal)}
 
 	// 15-Input Network by M. W. Green'
 	// Created by taking the 16-input network of M. W. Green and removing the 16th input.
@@ -159,6 +177,9 @@ var (
 		{7, 11}, {1, 2}, {4, 8}, {1, 4}, {7, 13}, {2, 8}, {11, 14}, {2, 4},
 		{5, 6}, {9, 10}, {11, 13}, {3, 8}, {7, 12}, {6, 8}, {10, 12}, {3, 5},
 		{7, 9}, {3, 4}, {5, 6}, {7, 8}, {9, 10}, {11, 12}, {6, 7}, {8, 9},
+	}, Meta: Metadata{
+		Family: "green", Discoverer: "M. W. Green", Year: 1969, Reference: refKnuth,
+		SizeOptimality: BestKnown, DepthOptimality: NotOptimal,
 	}}
 
 	// 15-Input Network via SENSO by V. K. Valsalam and R. Miikkulainen'
@@ -170,7 +191,7 @@ var (
 		{13, 14}, {1, 2}, {3, 5}, {10, 12}, {12, 13}, {2, 3}, {8, 11}, {4, 9},
 		{10, 11}, {6, 7}, {5, 6}, {4, 8}, {7, 9}, {4, 5}, {9, 11}, {11, 12},
 		{3, 4}, {6, 8}, {7, 10}, {9, 10}, {5, 6}, {7, 8}, {8, 9}, {6, 7},
-	}}
+	}, Meta: sensoMeta(BestKnown, NotOptimal)}
 
 	// 16-Input Network by M. W. Green'
 	Green16 = Network{Kind: "Green16", Size: 16, Depth: 10, Ops: []CompareAndSwap{
@@ -182,6 +203,9 @@ var (
 		{7, 13}, {2, 8}, {11, 14}, {2, 4}, {5, 6}, {9, 10}, {11, 13}, {3, 8},
 		{7, 12}, {6, 8}, {10, 12}, {3, 5}, {7, 9}, {3, 4}, {5, 6}, {7, 8},
 		{9, 10}, {11, 12}, {6, 7}, {8, 9},
+	}, Meta: Metadata{
+		Family: "green", Discoverer: "M. W. Green", Year: 1969, Reference: refKnuth,
+		SizeOptimality: BestKnown, DepthOptimality: NotOptimal,
 	}}
 
 	// 16-Input Network via SENSO by V. K. Valsalam and R. Miikkulainen'
@@ -194,7 +218,7 @@ var (
 		{12, 13}, {2, 3}, {8, 11}, {4, 9}, {10, 11}, {6, 7}, {5, 6}, {4, 8},
 		{7, 9}, {4, 5}, {9, 11}, {11, 12}, {3, 4}, {6, 8}, {7, 10}, {9, 10},
 		{5, 6}, {7, 8}, {8, 9}, {6, 7},
-	}}
+	}, Meta: sensoMeta(BestKnown, NotOptimal)}
 
 	// 16-Input Network by David C. Van Voorhis'
 	VanVoorhis16 = Network{Kind: "VanVoorhis16", Size: 16, Depth: 9, Ops: []CompareAndSwap{
@@ -206,6 +230,9 @@ var (
 		{7, 13}, {3, 9}, {5, 12}, {1, 4}, {6, 10}, {11, 14}, {2, 4}, {6, 8},
 		{10, 12}, {3, 5}, {7, 9}, {11, 13}, {3, 6}, {7, 10}, {5, 8}, {9, 12},
 		{3, 4}, {5, 6}, {7, 8}, {9, 10}, {11, 12},
+	}, Meta: Metadata{
+		Family: "vanvoorhis", Discoverer: "D. Van Voorhis", Year: 1972, Reference: refKnuth,
+		SizeOptimality: NotOptimal, DepthOptimality: ProvenOptimal,
 	}}
 
 	// 17-Input Network via SENSO by V. K. Valsalam and R. Miikkulainen'
@@ -219,7 +246,7 @@ var (
 		{2, 4}, {6, 12}, {9, 12}, {3, 10}, {3, 8}, {6, 7}, {10, 12}, {3, 6},
 		{3, 4}, {12, 13}, {10, 11}, {5, 6}, {11, 12}, {4, 5}, {7, 8}, {8, 9},
 		{6, 8}, {9, 11}, {5, 7}, {6, 7}, {9, 10}, {8, 9}, {7, 8},
-	}}
+	}, Meta: sensoMeta(BestKnown, NotOptimal)}
 
 	// 17-Input Network by M. Codish, L. Cruz-Filipe, T. Ehlers, M. Müller, P.
 	// Schneider-Kamp'
@@ -234,6 +261,9 @@ var (
 		{4, 6}, {7, 9}, {10, 11}, {13, 15}, {0, 2}, {5, 8}, {12, 14}, {0, 1},
 		{2, 3}, {4, 5}, {6, 8}, {9, 11}, {12, 13}, {14, 15}, {7, 10}, {1, 2},
 		{3, 4}, {5, 6}, {7, 8}, {9, 10}, {11, 12}, {13, 14}, {15, 16},
+	}, Meta: Metadata{
+		Family: "sat", Discoverer: "M. Codish, L. Cruz-Filipe, T. Ehlers, M. Müller and P. Schneider-Kamp", Year: 2016, Reference: refSat,
+		SizeOptimality: NotOptimal, DepthOptimality: ProvenOptimal,
 	}}
 
 	// 18-Input Network by Sherenaz Waleed Al-Haj Baddar'
@@ -249,6 +279,9 @@ var (
 		{6, 7}, {9, 10}, {7, 9}, {3, 5}, {12, 14}, {2, 4}, {13, 15}, {6, 8},
 		{10, 11}, {13, 14}, {11, 12}, {9, 10}, {7, 8}, {5, 6}, {3, 4}, {12, 13},
 		{10, 11}, {8, 9}, {6, 7}, {4, 5},
+	}, Meta: Metadata{
+		Family: "alhajbaddar", Discoverer: "S. W. Al-Haj Baddar", Year: 2009, Reference: refAlHajBaddar,
+		SizeOptimality: NotOptimal, DepthOptimality: BestKnown,
 	}}
 
 	// 18-Input Network via SENSO by V. K. Valsalam and R. Miikkulainen'
@@ -263,7 +296,7 @@ var (
 		{5, 11}, {6, 12}, {10, 12}, {5, 7}, {12, 14}, {3, 5}, {10, 13}, {4, 7},
 		{12, 13}, {4, 5}, {8, 9}, {6, 9}, {8, 11}, {9, 12}, {5, 8}, {6, 7},
 		{10, 11}, {6, 8}, {9, 11}, {7, 10}, {9, 10}, {7, 8},
-	}}
+	}, Meta: sensoMeta(NotOptimal, NotOptimal)}
 
 	// 19-Input Network via SENSO by V. K. Valsalam and R. Miikkulainen'
 	Senso19 = Network{Kind: "Senso19", Size: 19, Depth: 15, Ops: []CompareAndSwap{
@@ -278,7 +311,7 @@ var (
 		{12, 14}, {14, 15}, {3, 5}, {4, 6}, {10, 13}, {4, 8}, {4, 5}, {13, 14},
 		{7, 11}, {6, 11}, {6, 9}, {7, 8}, {11, 12}, {6, 7}, {12, 13}, {5, 6},
 		{9, 10}, {10, 11}, {11, 12}, {8, 9}, {7, 8}, {9, 10},
-	}}
+	}, Meta: sensoMeta(NotOptimal, NotOptimal)}
 
 	// 20-Input Network via SENSO by V. K. Valsalam and R. Miikkulainen'
 	Senso20 = Network{Kind: "Senso20", Size: 20, Depth: 14, Ops: []CompareAndSwap{
@@ -294,7 +327,7 @@ var (
 		{4, 5}, {10, 11}, {8, 9}, {11, 12}, {7, 8}, {7, 10}, {9, 12}, {5, 7},
 		{12, 14}, {9, 13}, {6, 10}, {6, 7}, {10, 11}, {12, 13}, {8, 9}, {9, 11},
 		{11, 12}, {8, 10}, {7, 8}, {9, 10},
-	}}
+	}, Meta: sensoMeta(NotOptimal, NotOptimal)}
 
 	// 20-Input Network by M. Codish, L. Cruz-Filipe, T. Ehlers, M. Müller, P.
 	// Schneider-Kamp'
@@ -312,6 +345,9 @@ var (
 		{1, 17}, {5, 6}, {7, 10}, {13, 14}, {1, 3}, {4, 5}, {7, 9}, {10, 11},
 		{12, 13}, {14, 15}, {16, 17}, {18, 19}, {0, 2}, {6, 8}, {1, 2}, {3, 4},
 		{5, 6}, {7, 8}, {9, 10}, {11, 12}, {13, 14}, {15, 16},
+	}, Meta: Metadata{
+		Family: "sat", Discoverer: "M. Codish, L. Cruz-Filipe, T. Ehlers, M. Müller and P. Schneider-Kamp", Year: 2016, Reference: refSat,
+		SizeOptimality: NotOptimal, DepthOptimality: BestKnown,
 	}}
 
 	// 21-Input Network via SENSO by V. K. Valsalam and R. Miikkulainen'
@@ -329,7 +365,7 @@ var (
 		{4, 5}, {10, 11}, {9, 11}, {8, 9}, {11, 12}, {12, 14}, {8, 10}, {6, 8},
 		{14, 15}, {5, 6}, {12, 13}, {13, 14}, {6, 8}, {7, 9}, {10, 11}, {7, 10},
 		{7, 8}, {9, 13}, {11, 12}, {9, 12}, {9, 11}, {9, 10},
-	}}
+	}, Meta: sensoMeta(NotOptimal, NotOptimal)}
 
 	// 22-Input Network by Sherenaz Waleed Al-Haj Baddar'
 	AlHajBaddar22 = Network{Kind: "AlHajBaddar22", Size: 22, Depth: 12, Ops: []CompareAndSwap{
@@ -348,6 +384,9 @@ var (
 		{9, 11}, {8, 10}, {5, 7}, {3, 6}, {2, 4}, {17, 18}, {15, 16}, {13, 14},
 		{11, 12}, {9, 10}, {7, 8}, {5, 6}, {3, 4}, {16, 17}, {14, 15}, {12, 13},
 		{10, 11}, {8, 9}, {6, 7}, {4, 5},
+	}, Meta: Metadata{
+		Family: "alhajbaddar", Discoverer: "S. W. Al-Haj Baddar", Year: 2009, Reference: refAlHajBaddar,
+		SizeOptimality: NotOptimal, DepthOptimality: BestKnown,
 	}}
 
 	// 22-Input Network via SENSO by V. K. Valsalam and R. Miikkulainen'
@@ -366,10 +405,10 @@ var (
 		{12, 14}, {7, 8}, {13, 14}, {14, 16}, {5, 7}, {9, 10}, {11, 12}, {6, 9},
 		{12, 15}, {14, 15}, {6, 7}, {8, 11}, {10, 13}, {8, 9}, {12, 13}, {7, 8},
 		{13, 14}, {10, 11}, {11, 12}, {9, 10},
-	}}
+	}, Meta: sensoMeta(NotOptimal, NotOptimal)}
 
 	// 23-Input Network by Morwenn'
-	Morwenn23 = Network{Kind: "Morwenn23", Size: 23, Depth: 18, Ops: []CompareAndSwap{
+	Morwenn23 = Network{Kind: "Morwenn23", Size: 23, Depth: 15, Ops: []CompareAndSwap{
 		{0, 1}, {2, 3}, {4, 5}, {6, 7}, {8, 9}, {10, 11}, {12, 13}, {14, 15},
 		{16, 17}, {18, 19}, {20, 21}, {1, 3}, {5, 7}, {9, 11}, {0, 2}, {4, 6},
 		{8, 10}, {13, 15}, {17, 19}, {12, 14}, {16, 18}, {20, 22}, {1, 2}, {5, 6},
@@ -385,6 +424,9 @@ var (
 		{11, 19}, {8, 12}, {9, 13}, {10, 14}, {11, 15}, {6, 8}, {10, 12}, {14, 16},
 		{7, 9}, {11, 13}, {15, 17}, {1, 2}, {3, 4}, {5, 6}, {7, 8}, {9, 10},
 		{11, 12}, {13, 14}, {15, 16}, {17, 18}, {19, 20}, {21, 22},
+	}, Meta: Metadata{
+		Family: "morwenn", Discoverer: "Morwenn", Year: 0, Reference: refMorwenn,
+		SizeOptimality: NotOptimal, DepthOptimality: NotOptimal,
 	}}
 
 	// 23-Input Network via SENSO by V. K. Valsalam and R. Miikkulainen'
@@ -404,10 +446,10 @@ var (
 		{15, 17}, {5, 7}, {9, 10}, {10, 14}, {6, 11}, {14, 16}, {15, 16}, {6, 7},
 		{10, 11}, {9, 12}, {11, 13}, {13, 14}, {8, 9}, {7, 8}, {14, 15}, {9, 10},
 		{8, 9}, {12, 14}, {11, 12}, {12, 13}, {10, 11}, {11, 12},
-	}}
+	}, Meta: sensoMeta(NotOptimal, NotOptimal)}
 
 	// 24-Input Network by Morwenn'
-	Morwenn24 = Network{Kind: "Morwenn24", Size: 24, Depth: 18, Ops: []CompareAndSwap{
+	Morwenn24 = Network{Kind: "Morwenn24", Size: 24, Depth: 15, Ops: []CompareAndSwap{
 		{0, 1}, {2, 3}, {4, 5}, {6, 7}, {8, 9}, {10, 11}, {12, 13}, {14, 15},
 		{16, 17}, {18, 19}, {20, 21}, {22, 23}, {1, 3}, {5, 7}, {9, 11}, {0, 2},
 		{4, 6}, {8, 10}, {13, 15}, {17, 19}, {21, 23}, {12, 14}, {16, 18}, {20, 22},
@@ -424,5 +466,8 @@ var (
 		{10, 14}, {11, 15}, {6, 8}, {10, 12}, {14, 16}, {7, 9}, {11, 13}, {15, 17},
 		{1, 2}, {3, 4}, {5, 6}, {7, 8}, {9, 10}, {11, 12}, {13, 14}, {15, 16},
 		{17, 18}, {19, 20}, {21, 22},
+	}, Meta: Metadata{
+		Family: "morwenn", Discoverer: "Morwenn", Year: 0, Reference: refMorwenn,
+		SizeOptimality: NotOptimal, DepthOptimality: NotOptimal,
 	}}
 )
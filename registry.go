@@ -0,0 +1,175 @@
+package sortnet
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// maxVerifySize is the largest network Register will accept. Every network is checked
+// against all 2^Size inputs of zeros and ones, which takes too long beyond this.
+const maxVerifySize = 28
+
+var registry = struct {
+	mu       sync.RWMutex
+	all      []Network
+	byName   map[string]Network // Keys are lowercase
+	bySize   map[int][]Network
+	simplest map[int]Network
+}{
+	byName:   map[string]Network{},
+	bySize:   map[int][]Network{},
+	simplest: map[int]Network{},
+}
+
+func init() {
+	// Checking every network that sorts takes long enough to be noticeable at startup,
+	// so the built in networks are checked by the tests instead:
+	for _, net := range Optimized {
+		if err := register(net, false); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// Register adds a network to the registry, which makes it available to Lookup, All
+// and BySize, and to New if it is the simplest network for its size.
+//
+// The network's Kind is used as its name, which must not already be registered
+// (ignoring case). Register checks that the network sorts every input before adding
+// it, and recalculates its Depth. The SizeOptimality and DepthOptimality in its Meta
+// are replaced by comparing its size and depth with the proven optima and best known
+// networks recorded for its number of inputs, or UnknownOptimality if there are none,
+// so a claim that can't be checked is never kept. Networks of more than 28 inputs
+// can't be checked, so they are not accepted.
+//
+// Register is safe for concurrent use, but networks should be registered before they
+// are needed: packages like hybrid cache the result of New for each size.
+func Register(net Network) error {
+	return register(net, true)
+}
+
+// Verify checks a network in the same way as Register, and returns it with its Depth
+// and Meta updated as Register would, but without adding it to the registry.
+func Verify(net Network) (Network, error) {
+	return verify(net, true)
+}
+
+func verify(net Network, sortsAll bool) (Network, error) {
+	if net.Size < 1 || net.Size > maxVerifySize {
+		return net, fmt.Errorf("sortnet: network %q has %d inputs, must be between 1 and %d",
+			net.Kind, net.Size, maxVerifySize)
+	}
+	for _, op := range net.Ops {
+		if op.From < 0 || op.From >= op.To || op.To >= net.Size {
+			return net, fmt.Errorf("sortnet: network %q has invalid comparator %v", net.Kind, op)
+		}
+	}
+	if sortsAll && !sorts(net) {
+		return net, fmt.Errorf("sortnet: network %q does not sort all inputs", net.Kind)
+	}
+	net.Depth = opsDepth(net.Size, net.Ops)
+	if sortsAll {
+		// Only the built in networks are trusted to say they are the best known:
+		net.Meta.SizeOptimality = checkedOptimality(optimalSizes, bestKnownSizes, net.Size, len(net.Ops))
+		net.Meta.DepthOptimality = checkedOptimality(optimalDepths, bestKnownDepths, net.Size, net.Depth)
+	}
+	return net, nil
+}
+
+func register(net Network, sortsAll bool) error {
+	if net.Kind == "" {
+		return fmt.Errorf("sortnet: network has no Kind to register it by")
+	}
+	net, err := verify(net, sortsAll)
+	if err != nil {
+		return err
+	}
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	name := strings.ToLower(net.Kind)
+	if _, ok := registry.byName[name]; ok {
+		return fmt.Errorf("sortnet: network %q is already registered", net.Kind)
+	}
+	registry.byName[name] = net
+	registry.all = append(registry.all, net)
+	registry.bySize[net.Size] = append(registry.bySize[net.Size], net)
+
+	simplest, ok := registry.simplest[net.Size]
+	if !ok {
+		simplest = BoseNelson(net.Size)
+	}
+	if simpler(net, simplest) {
+		registry.simplest[net.Size] = net
+	}
+	return nil
+}
+
+// Lookup returns the registered network with the given name, ignoring case, for
+// example "VanVoorhis16".
+func Lookup(name string) (net Network, ok bool) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+	net, ok = registry.byName[strings.ToLower(name)]
+	return net, ok
+}
+
+// All returns every registered network, in the order they were registered.
+func All() []Network {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+	return append([]Network(nil), registry.all...)
+}
+
+// BySize returns every registered network with the given number of inputs, in the
+// order they were registered.
+func BySize(n int) []Network {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+	return append([]Network(nil), registry.bySize[n]...)
+}
+
+// sorts checks net against every input of zeros and ones, which by the 0-1 principle
+// means it sorts every input. Each bit of a uint64 holds a different input, so 64
+// inputs are checked at once.
+func sorts(net Network) bool {
+	// The lowest 6 wires take the same pattern in every batch of 64 inputs, so that
+	// together they count from 0 to 63 across the bits:
+	lowWires := [6]uint64{
+		0xAAAAAAAAAAAAAAAA, 0xCCCCCCCCCCCCCCCC, 0xF0F0F0F0F0F0F0F0,
+		0xFF00FF00FF00FF00, 0xFFFF0000FFFF0000, 0xFFFFFFFF00000000,
+	}
+
+	wires := make([]uint64, net.Size)
+	batches := uint64(1)
+	if net.Size > 6 {
+		batches = 1 << (net.Size - 6)
+	}
+
+	for batch := uint64(0); batch < batches; batch++ {
+		for i := range wires {
+			if i < 6 {
+				wires[i] = lowWires[i]
+			} else if batch&(1<<(i-6)) != 0 {
+				wires[i] = ^uint64(0)
+			} else {
+				wires[i] = 0
+			}
+		}
+
+		for _, op := range net.Ops {
+			a, b := wires[op.From], wires[op.To]
+			wires[op.From], wires[op.To] = a&b, a|b
+		}
+
+		// A sorted input of zeros and ones never has a one before a zero:
+		for i := 1; i < len(wires); i++ {
+			if wires[i-1]&^wires[i] != 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,181 @@
+package sortnet
+
+import (
+	"maps"
+	"strings"
+	"testing"
+)
+
+func TestOptimizedSorts(t *testing.T) {
+	for _, net := range Optimized {
+		if !sorts(net) {
+			t.Fatalf("%s does not sort", net.Kind)
+		}
+	}
+}
+
+func TestSortsRejects(t *testing.T) {
+	for _, net := range Optimized {
+		broken := net
+		broken.Ops = net.Ops[:len(net.Ops)-1]
+		if sorts(broken) {
+			t.Fatalf("%s without its last comparator still sorts", net.Kind)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	for _, name := range []string{"VanVoorhis16", "vanvoorhis16", "SENSO9"} {
+		net, ok := Lookup(name)
+		if !ok || !strings.EqualFold(net.Kind, name) {
+			t.Fatal(name, net.Kind)
+		}
+	}
+	if _, ok := Lookup("Bose-Nelson"); ok {
+		t.Fatal("Bose-Nelson networks are built on demand, not registered")
+	}
+}
+
+func TestAllAndBySize(t *testing.T) {
+	if len(All()) < len(Optimized) {
+		t.Fatal(len(All()))
+	}
+	var sixteen []string
+	for _, net := range BySize(16) {
+		sixteen = append(sixteen, net.Kind)
+	}
+	if strings.Join(sixteen, ",") != "Green16,Senso16,VanVoorhis16" {
+		t.Fatal(sixteen)
+	}
+	if len(BySize(5)) != 0 {
+		t.Fatal(BySize(5))
+	}
+}
+
+// restoreRegistry puts the registry back the way it is now when t finishes, so that
+// tests can register networks without affecting other tests, or themselves when run
+// with -count.
+func restoreRegistry(t testing.TB) {
+	registry.mu.RLock()
+	all := registry.all
+	byName := maps.Clone(registry.byName)
+	bySize := maps.Clone(registry.bySize)
+	simplest := maps.Clone(registry.simplest)
+	registry.mu.RUnlock()
+
+	t.Cleanup(func() {
+		registry.mu.Lock()
+		defer registry.mu.Unlock()
+		registry.all, registry.byName, registry.bySize, registry.simplest = all, byName, bySize, simplest
+	})
+}
+
+func TestRegister(t *testing.T) {
+	restoreRegistry(t)
+
+	// A correct, but not simple, network for 5 inputs: Bose-Nelson's network with a
+	// redundant comparator at the end.
+	net := BoseNelson(5)
+	net.Kind = "TestRegisterRedundant5"
+	net.Ops = append(net.Ops[:len(net.Ops):len(net.Ops)], CompareAndSwap{0, 1})
+	if err := Register(net); err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := Lookup(net.Kind); !ok || got.Depth != opsDepth(5, net.Ops) {
+		t.Fatal(got)
+	}
+	if New(5).Kind != "Bose-Nelson" {
+		t.Fatal("New should prefer the simpler Bose-Nelson network", New(5).Kind)
+	}
+	if err := Register(net); err == nil {
+		t.Fatal("expected duplicate error")
+	}
+
+	// Networks that are only as simple as the current choice don't replace it:
+	net = Network{Kind: "TestRegisterTie4", Size: 4, Ops: []CompareAndSwap{
+		{0, 1}, {2, 3}, {0, 2}, {1, 3}, {1, 2},
+	}}
+	if err := Register(net); err != nil {
+		t.Fatal(err)
+	}
+	if New(4).Kind != "Bose-Nelson" {
+		t.Fatal(New(4).Kind)
+	}
+
+	// The tie's size and depth are both proven optimal, which Register fills in:
+	if got, _ := Lookup(net.Kind); got.Meta.SizeOptimality != ProvenOptimal || got.Meta.DepthOptimality != ProvenOptimal {
+		t.Fatal(got.Meta)
+	}
+}
+
+func TestRegisterRedundant5(t *testing.T) {
+	restoreRegistry(t)
+
+	// A network with a redundant comparator that claims the optimality of the network
+	// it was copied from:
+	net := BoseNelson(5)
+	if net.Meta.SizeOptimality != ProvenOptimal {
+		t.Fatal(net.Meta)
+	}
+	net.Kind = "TestRegisterRedundant5"
+	net.Ops = append(net.Ops[:len(net.Ops):len(net.Ops)], CompareAndSwap{3, 4})
+	if err := Register(net); err != nil {
+		t.Fatal(err)
+	}
+	got, _ := Lookup(net.Kind)
+	if got.Meta.SizeOptimality != NotOptimal {
+		t.Fatal("10 comparators for 5 inputs is not optimal", got.Meta)
+	}
+	if got.Meta.DepthOptimality != checkedOptimality(optimalDepths, bestKnownDepths, 5, got.Depth) {
+		t.Fatal(got.Depth, got.Meta)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	net := Network{Kind: "TestVerify4", Size: 4, Ops: []CompareAndSwap{
+		{0, 1}, {2, 3}, {0, 2}, {1, 3}, {1, 2},
+	}}
+	got, err := Verify(net)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Depth != 3 || got.Meta.SizeOptimality != ProvenOptimal || got.Meta.DepthOptimality != ProvenOptimal {
+		t.Fatal(got)
+	}
+	if _, ok := Lookup(net.Kind); ok {
+		t.Fatal("Verify should not register the network")
+	}
+
+	net.Ops = net.Ops[:4]
+	if _, err := Verify(net); err == nil || !strings.Contains(err.Error(), "does not sort") {
+		t.Fatal(err)
+	}
+}
+
+func TestRegisterInvalid(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		net  Network
+		err  string
+	}{
+		{"no-kind", Network{Size: 2, Ops: []CompareAndSwap{{0, 1}}}, "no Kind"},
+		{"too-big", Network{Kind: "TestTooBig", Size: 29}, "between 1 and 28"},
+		{"out-of-range", Network{Kind: "TestOutOfRange", Size: 2, Ops: []CompareAndSwap{{0, 2}}}, "invalid comparator"},
+		{"reversed", Network{Kind: "TestReversed", Size: 2, Ops: []CompareAndSwap{{1, 0}}}, "invalid comparator"},
+		{"unsorted", Network{Kind: "TestUnsorted", Size: 3, Ops: []CompareAndSwap{{0, 1}, {1, 2}}}, "does not sort"},
+		{"duplicate", Senso9, "already registered"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Register(tc.net)
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func BenchmarkSorts(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		sorts(Morwenn24)
+	}
+}
@@ -0,0 +1,58 @@
+package sortnet
+
+// Select returns a copy of the network containing only the comparators that can
+// affect the items that end up at the given positions, which makes a selection network.
+// For example, New(9).Select(4) finds the median of 9 items with fewer comparators
+// than it takes to sort them. The remaining positions are left in an unspecified
+// order.
+//
+// The copy's Depth is recalculated for the comparators that remain. Its Meta is kept,
+// except that the optimality of its size and depth is unknown, as the proven optima
+// and best known networks are for sorting, not selection.
+func (n Network) Select(positions ...int) Network {
+	needed := make([]bool, n.Size)
+	for _, p := range positions {
+		needed[p] = true
+	}
+
+	// Walk backwards from the outputs, keeping any comparator that touches an item
+	// we care about; everything that feeds into a kept comparator is needed too.
+	keep := make([]bool, len(n.Ops))
+	kept := 0
+	for i := len(n.Ops) - 1; i >= 0; i-- {
+		op := n.Ops[i]
+		if needed[op.From] || needed[op.To] {
+			needed[op.From], needed[op.To] = true, true
+			keep[i] = true
+			kept++
+		}
+	}
+
+	out := Network{Kind: n.Kind, Size: n.Size, Meta: n.Meta, Ops: make([]CompareAndSwap, 0, kept)}
+	out.Meta.SizeOptimality, out.Meta.DepthOptimality = UnknownOptimality, UnknownOptimality
+	for i, op := range n.Ops {
+		if keep[i] {
+			out.Ops = append(out.Ops, op)
+		}
+	}
+	out.Depth = opsDepth(out.Size, out.Ops)
+	return out
+}
+
+// opsDepth calculates the depth of a network by placing each comparator in the
+// layer after the most recent layer that used either of its items.
+func opsDepth(size int, ops []CompareAndSwap) (depth int) {
+	layers := make([]int, size)
+	for _, op := range ops {
+		layer := layers[op.From]
+		if layers[op.To] > layer {
+			layer = layers[op.To]
+		}
+		layer++
+		layers[op.From], layers[op.To] = layer, layer
+		if layer > depth {
+			depth = layer
+		}
+	}
+	return depth
+}
@@ -0,0 +1,54 @@
+package sortnet
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestSelect(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+
+	for _, sz := range []int{1, 2, 3, 5, 7, 9, 16, 25} {
+		for pos := 0; pos < sz; pos++ {
+			t.Run(fmt.Sprintf("%d/%d", sz, pos), func(t *testing.T) {
+				net := New(sz)
+				sel := net.Select(pos)
+				if len(sel.Ops) > len(net.Ops) {
+					t.Fatal("selection network larger than sorting network")
+				}
+
+				for repeat := 0; repeat < 200; repeat++ {
+					vs := make([]int, sz)
+					for i := range vs {
+						vs[i] = rng.Intn(32)
+					}
+					exp := append([]int{}, vs...)
+					sort.Ints(exp)
+
+					sel.SortInts(vs)
+					if vs[pos] != exp[pos] {
+						t.Fatalf("expected %d at position %d, found %d", exp[pos], pos, vs[pos])
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestSelectDepth(t *testing.T) {
+	// Selecting every position keeps every comparator, so the depth should be the same
+	// as the original network's:
+	for _, net := range []Network{Senso9, Green16, VanVoorhis16, Sat20} {
+		all := make([]int, net.Size)
+		for i := range all {
+			all[i] = i
+		}
+		sel := net.Select(all...)
+		if len(sel.Ops) != len(net.Ops) || sel.Depth != net.Depth {
+			t.Fatalf("%s: expected %d ops at depth %d, found %d at depth %d",
+				net.Kind, len(net.Ops), net.Depth, len(sel.Ops), sel.Depth)
+		}
+	}
+}
@@ -0,0 +1,272 @@
+// Package sortnettest checks sorters, such as the ones produced by `sortnetgen`,
+// against a reference sort.
+//
+// It is most useful for sorters of custom types that use hand-written -less and
+// -greater templates, where a comparison in the wrong direction or of the wrong field
+// is easy to write and easy to miss:
+//
+//	func TestNetworkSort9xCustom(t *testing.T) {
+//		sortnettest.CheckSorter(t, 9, NetworkSort9xCustom,
+//			func(rng *rand.Rand) Custom { return Custom{Foo: rng.Intn(100)} },
+//			func(a, b Custom) bool { return a.Foo < b.Foo })
+//	}
+//
+// CheckMerger does the same for -merge functions, CheckInput checks a single input for
+// fuzz targets, and BenchmarkSorter compares a sorter with sort.Slice. The tests that
+// sortnetgen writes with -tests use all of them.
+package sortnettest
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+const (
+	// maxExhaustive is the largest size for which every 0-1 input is checked; above
+	// this, a random sample of them is checked instead.
+	maxExhaustive = 16
+
+	iterations = 2000
+)
+
+// CheckSorter fails t if sorter does not sort every slice of 'size' items into the
+// order given by less. less reports whether a must sort before b, like the 'less'
+// function passed to sort.Slice; to check a reverse sorter, pass a less that reports
+// whether a > b. For types with a natural order, cmp.Less can be used.
+//
+// gen returns a random value of T. It doesn't need to return every possible value,
+// but should be able to return at least two values that are not equal (according to
+// less) for the checks to mean anything.
+//
+// These inputs are checked:
+//
+//   - Every input that contains only the two smallest distinct values from gen, if
+//     size is small enough. By the 0-1 principle, a sorting network that sorts all of
+//     these sorts everything. Larger sizes use a random sample of these inputs.
+//   - Random inputs built from gen.
+//   - Random inputs built from only a few distinct values from gen, with lots of
+//     duplicates.
+//
+// The output of the sorter is compared with the input sorted using sort.SliceStable.
+// Items that are equal according to less may come out in any order, but the output
+// must be a permutation of the input: every item in it must match a different item in
+// the input according to reflect.DeepEqual, so a sorter that loses, duplicates or
+// changes items fails even if the result is in order.
+func CheckSorter[T any](t testing.TB, size int, sorter func([]T), gen func(*rand.Rand) T, less func(a, b T) bool) {
+	t.Helper()
+
+	c := newChecker(t, size, sorter, less)
+	rng := rand.New(rand.NewSource(0))
+
+	if lo, hi, ok := distinct(rng, gen, less); ok {
+		if size <= maxExhaustive {
+			for bits := 0; bits < 1<<size; bits++ {
+				c.check("0-1", func(i int) T {
+					if bits&(1<<i) != 0 {
+						return hi
+					}
+					return lo
+				})
+			}
+		} else {
+			for n := 0; n < iterations; n++ {
+				c.check("0-1", func(int) T {
+					if rng.Intn(2) == 1 {
+						return hi
+					}
+					return lo
+				})
+			}
+		}
+	} else {
+		t.Logf("sortnettest: gen did not return two distinct values, 0-1 inputs not checked")
+	}
+
+	for n := 0; n < iterations; n++ {
+		c.check("random", func(int) T { return gen(rng) })
+	}
+
+	for n := 0; n < iterations; n++ {
+		pool := []T{gen(rng), gen(rng), gen(rng)}[:1+rng.Intn(3)]
+		c.check("duplicate-heavy", func(int) T { return pool[rng.Intn(len(pool))] })
+	}
+}
+
+// CheckInput fails t if sorter does not sort a copy of in into the order given by less,
+// checking the output in the same way as CheckSorter. It is meant for fuzz targets,
+// which choose their own inputs.
+func CheckInput[T any](t testing.TB, sorter func([]T), in []T, less func(a, b T) bool) {
+	t.Helper()
+	c := newChecker(t, len(in), sorter, less)
+	c.check("given", func(i int) T { return in[i] })
+}
+
+// CheckMerger fails t if merge, which merges 'runs' runs that are already sorted by
+// less, does not append them to dst in the order given by less, with equal items kept
+// in the order of the runs they came from. It is checked with random runs of up to 7
+// items from gen, including empty ones, which should be the same as the runs joined
+// together and sorted using sort.SliceStable.
+func CheckMerger[T any](t testing.TB, runs int, merge func(dst []T, runs [][]T) []T, gen func(*rand.Rand) T, less func(a, b T) bool) {
+	t.Helper()
+
+	rng := rand.New(rand.NewSource(0))
+	rs := make([][]T, runs)
+	for n := 0; n < iterations; n++ {
+		var exp []T
+		for r := range rs {
+			rs[r] = make([]T, rng.Intn(8))
+			for i := range rs[r] {
+				rs[r][i] = gen(rng)
+			}
+			sort.SliceStable(rs[r], func(i, j int) bool { return less(rs[r][i], rs[r][j]) })
+			exp = append(exp, rs[r]...)
+		}
+		sort.SliceStable(exp, func(i, j int) bool { return less(exp[i], exp[j]) })
+
+		// The result is appended to an item that must be left alone:
+		dst := []T{gen(rng)}
+		out := merge(dst[:1:1], rs)
+		ok := len(out) == len(exp)+1 && reflect.DeepEqual(out[0], dst[0])
+		for i := 0; ok && i < len(exp); i++ {
+			ok = reflect.DeepEqual(out[i+1], exp[i])
+		}
+		if !ok {
+			t.Fatalf("sortnettest: %d run merger failed\n"+
+				"dst:      %v\nruns:     %v\noutput:   %v\nexpected: %v",
+				runs, dst, rs, out, append(dst, exp...))
+		}
+	}
+}
+
+// BenchmarkSorter benchmarks sorter on random inputs of 'size' items from gen, in the
+// sub-benchmark "network", along with sort.Slice on the same inputs, ordered by less,
+// in the sub-benchmark "sort.Slice".
+func BenchmarkSorter[T any](b *testing.B, size int, sorter func([]T), gen func(*rand.Rand) T, less func(a, b T) bool) {
+	const inputs = 64
+
+	rng := rand.New(rand.NewSource(0))
+	items := make([]T, size*inputs)
+	for i := range items {
+		items[i] = gen(rng)
+	}
+	cur := make([]T, size)
+
+	b.Run("network", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			copy(cur, items[i%inputs*size:])
+			sorter(cur)
+		}
+	})
+	b.Run("sort.Slice", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			copy(cur, items[i%inputs*size:])
+			sort.Slice(cur, func(i, j int) bool { return less(cur[i], cur[j]) })
+		}
+	})
+}
+
+type checker[T any] struct {
+	t      testing.TB
+	size   int
+	sorter func([]T)
+	less   func(a, b T) bool
+
+	in, out, exp []T
+	used         []bool
+}
+
+func newChecker[T any](t testing.TB, size int, sorter func([]T), less func(a, b T) bool) *checker[T] {
+	return &checker[T]{
+		t:      t,
+		size:   size,
+		sorter: sorter,
+		less:   less,
+		in:     make([]T, size),
+		out:    make([]T, size),
+		exp:    make([]T, size),
+		used:   make([]bool, size),
+	}
+}
+
+func (c *checker[T]) check(kind string, value func(i int) T) {
+	c.t.Helper()
+
+	for i := range c.in {
+		c.in[i] = value(i)
+	}
+	copy(c.out, c.in)
+	copy(c.exp, c.in)
+
+	sort.SliceStable(c.exp, func(i, j int) bool { return c.less(c.exp[i], c.exp[j]) })
+	c.sorter(c.out)
+
+	for i := range c.exp {
+		if c.less(c.exp[i], c.out[i]) || c.less(c.out[i], c.exp[i]) {
+			c.fail(kind, "out of order", i)
+		}
+	}
+
+	// The output is in order, so each run of equal items in it must hold the same
+	// items as the matching run of the expected output, in any order:
+	for start := 0; start < len(c.exp); {
+		end := start + 1
+		for end < len(c.exp) && !c.less(c.exp[start], c.exp[end]) {
+			end++
+		}
+		if i := unmatched(c.out[start:end], c.exp[start:end], c.used[:end-start]); i >= 0 {
+			c.fail(kind, "not a permutation of the input", start+i)
+		}
+		start = end
+	}
+}
+
+func (c *checker[T]) fail(kind, reason string, index int) {
+	c.t.Helper()
+	c.t.Fatalf("sortnettest: size %d sorter failed on %s input: %s at index %d\n"+
+		"input:    %v\noutput:   %v\nexpected: %v",
+		c.size, kind, reason, index, c.in, c.out, c.exp)
+}
+
+// unmatched returns the index of the first item in out that doesn't have its own
+// deeply equal item in exp, or -1 if out is a permutation of exp. used must be as long
+// as exp.
+func unmatched[T any](out, exp []T, used []bool) int {
+	clear(used)
+next:
+	for i := range out {
+		// Most items are still in the same place, so that is checked first:
+		if !used[i] && reflect.DeepEqual(out[i], exp[i]) {
+			used[i] = true
+			continue
+		}
+		for j := range exp {
+			if !used[j] && reflect.DeepEqual(out[i], exp[j]) {
+				used[j] = true
+				continue next
+			}
+		}
+		return i
+	}
+	return -1
+}
+
+// distinct looks for two values from gen where lo sorts before hi, preferring the
+// smallest values found.
+func distinct[T any](rng *rand.Rand, gen func(*rand.Rand) T, less func(a, b T) bool) (lo, hi T, ok bool) {
+	const tries = 100
+
+	vs := make([]T, tries)
+	for i := range vs {
+		vs[i] = gen(rng)
+	}
+	sort.SliceStable(vs, func(i, j int) bool { return less(vs[i], vs[j]) })
+
+	for i := 1; i < len(vs); i++ {
+		if less(vs[0], vs[i]) {
+			return vs[0], vs[i], true
+		}
+	}
+	return lo, hi, false
+}
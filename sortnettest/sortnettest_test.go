@@ -0,0 +1,221 @@
+package sortnettest
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/shabbyrobe/sortnet"
+)
+
+// failureTB records the first failure instead of stopping the test.
+type failureTB struct {
+	testing.TB
+	failed string
+}
+
+type stopCheck struct{}
+
+func (f *failureTB) Helper()                         {}
+func (f *failureTB) Logf(format string, args ...any) {}
+func (f *failureTB) Fatalf(format string, args ...any) {
+	f.failed = fmt.Sprintf(format, args...)
+	panic(stopCheck{})
+}
+
+// failure returns the first failure from check, or an empty string if it passes.
+func failure(check func(tb testing.TB)) (failed string) {
+	tb := &failureTB{}
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(stopCheck); !ok {
+				panic(r)
+			}
+			failed = tb.failed
+		}
+	}()
+	check(tb)
+	return ""
+}
+
+func runCheck[T any](size int, sorter func([]T), gen func(*rand.Rand) T, less func(a, b T) bool) (failed string) {
+	return failure(func(tb testing.TB) { CheckSorter(tb, size, sorter, gen, less) })
+}
+
+type item struct {
+	Key, Other int
+}
+
+func genItem(rng *rand.Rand) item { return item{rng.Intn(50), rng.Intn(50)} }
+
+func itemLess(a, b item) bool { return a.Key < b.Key }
+
+func itemSorter(size int, cas func(a, b *item)) func([]item) {
+	net := sortnet.New(size)
+	return func(vs []item) {
+		for _, op := range net.Ops {
+			cas(&vs[op.From], &vs[op.To])
+		}
+	}
+}
+
+func TestCheckSorter(t *testing.T) {
+	for _, size := range []int{0, 1, 2, 5, 16, 20} {
+		t.Run(fmt.Sprint(size), func(t *testing.T) {
+			CheckSorter(t, size, sortnet.Compile[int](sortnet.New(size)),
+				func(rng *rand.Rand) int { return rng.Intn(100) }, cmp.Less[int])
+
+			CheckSorter(t, size, itemSorter(size, func(a, b *item) {
+				if a.Key > b.Key {
+					*a, *b = *b, *a
+				}
+			}), genItem, itemLess)
+		})
+	}
+}
+
+func TestCheckSorterReverse(t *testing.T) {
+	CheckSorter(t, 6, itemSorter(6, func(a, b *item) {
+		if a.Key < b.Key {
+			*a, *b = *b, *a
+		}
+	}), genItem, func(a, b item) bool { return a.Key > b.Key })
+}
+
+func TestCheckSorterFails(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		size   int
+		sorter func([]item)
+		failed string
+	}{
+		{"reversed", 4, itemSorter(4, func(a, b *item) {
+			if a.Key < b.Key {
+				*a, *b = *b, *a
+			}
+		}), "0-1 input: out of order"},
+
+		{"wrong-field", 4, itemSorter(4, func(a, b *item) {
+			if a.Other > b.Other {
+				*a, *b = *b, *a
+			}
+		}), "0-1 input: out of order"},
+
+		{"no-swap", 4, itemSorter(4, func(a, b *item) {
+			if a.Key > b.Key {
+				*a = *b
+			}
+		}), "0-1 input: out of order"},
+
+		// In order, but the rest of each item is left behind:
+		{"key-only", 4, itemSorter(4, func(a, b *item) {
+			if a.Key > b.Key {
+				a.Key, b.Key = b.Key, a.Key
+			}
+		}), "0-1 input: not a permutation of the input"},
+
+		// In order, but one item is copied over another one with the same key:
+		{"duplicated", 4, func(vs []item) {
+			itemSorter(4, func(a, b *item) {
+				if a.Key > b.Key {
+					*a, *b = *b, *a
+				}
+			})(vs)
+			if vs[0].Key == vs[1].Key {
+				vs[1] = vs[0]
+			}
+		}, "not a permutation of the input at index 1"},
+
+		// Too big to check every 0-1 input, and the sample of them misses this:
+		{"missing-comparator", 24, func(vs []item) {
+			net := sortnet.New(24)
+			for _, op := range net.Ops[:len(net.Ops)-1] {
+				if vs[op.From].Key > vs[op.To].Key {
+					vs[op.From], vs[op.To] = vs[op.To], vs[op.From]
+				}
+			}
+		}, "random input: out of order"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			failed := runCheck(tc.size, tc.sorter, genItem, itemLess)
+			if failed == "" {
+				t.Fatal("expected failure")
+			}
+			if !strings.Contains(failed, tc.failed) {
+				t.Fatalf("unexpected failure: %s", failed)
+			}
+		})
+	}
+}
+
+func TestCheckSorterNoDistinctValues(t *testing.T) {
+	// With only one value to work with, nothing can be out of order:
+	failed := runCheck(4, func([]int) {}, func(*rand.Rand) int { return 1 }, cmp.Less[int])
+	if failed != "" {
+		t.Fatal(failed)
+	}
+}
+
+func TestCheckInput(t *testing.T) {
+	sorter := itemSorter(3, func(a, b *item) {
+		if a.Key > b.Key {
+			*a, *b = *b, *a
+		}
+	})
+	in := []item{{2, 0}, {1, 5}, {1, 6}}
+	CheckInput(t, sorter, in, itemLess)
+
+	failed := failure(func(tb testing.TB) {
+		CheckInput(tb, func(vs []item) { sorter(vs); vs[1] = vs[0] }, in, itemLess)
+	})
+	if !strings.Contains(failed, "given input: not a permutation of the input at index 1") {
+		t.Fatal(failed)
+	}
+}
+
+func TestCheckMerger(t *testing.T) {
+	for runs := 1; runs <= 5; runs++ {
+		CheckMerger(t, runs, func(dst []item, runs [][]item) []item {
+			for _, run := range runs {
+				dst = append(dst, run...)
+			}
+			sort.SliceStable(dst[1:], func(i, j int) bool { return itemLess(dst[1+i], dst[1+j]) })
+			return dst
+		}, genItem, itemLess)
+	}
+}
+
+func TestCheckMergerFails(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		merge func(dst []item, runs [][]item) []item
+	}{
+		// Equal items from the last run come first:
+		{"unstable", func(dst []item, runs [][]item) []item {
+			for i := len(runs) - 1; i >= 0; i-- {
+				dst = append(dst, runs[i]...)
+			}
+			sort.SliceStable(dst[1:], func(i, j int) bool { return itemLess(dst[1+i], dst[1+j]) })
+			return dst
+		}},
+
+		{"overwrites-dst", func(dst []item, runs [][]item) []item {
+			var out []item
+			for _, run := range runs {
+				out = append(out, run...)
+			}
+			sort.SliceStable(out, func(i, j int) bool { return itemLess(out[i], out[j]) })
+			return append(dst[:0], append([]item{{}}, out...)...)
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			failed := failure(func(tb testing.TB) { CheckMerger(tb, 3, tc.merge, genItem, itemLess) })
+			if !strings.Contains(failed, "3 run merger failed") {
+				t.Fatal(failed)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package sortnet
+
+import "cmp"
+
+// SortStrided sorts the net.Size items of vs that start at index 'off' and are spaced
+// 'stride' items apart, in place, leaving the items in between untouched. For example,
+// the red channel of 9 consecutive pixels in an image.RGBA's Pix slice can be sorted
+// with:
+//
+//	SortStrided(New(9), im.Pix, 0, 4)
+//
+// SortStrided will panic if any of the strided indexes are out of range.
+func SortStrided[T cmp.Ordered](net Network, vs []T, off, stride int) {
+	for _, c := range net.Ops {
+		from, to := off+c.From*stride, off+c.To*stride
+		if vs[from] > vs[to] {
+			vs[from], vs[to] = vs[to], vs[from]
+		}
+	}
+}
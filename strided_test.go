@@ -0,0 +1,42 @@
+package sortnet
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestSortStrided(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+
+	for _, sz := range []int{1, 2, 3, 9, 16, 25} {
+		for _, stride := range []int{1, 3, 4} {
+			for off := 0; off < stride; off++ {
+				t.Run(fmt.Sprintf("%d/%d/%d", sz, stride, off), func(t *testing.T) {
+					vs := make([]uint8, sz*stride)
+					for i := range vs {
+						vs[i] = uint8(rng.Intn(256))
+					}
+
+					exp := make([]uint8, len(vs))
+					copy(exp, vs)
+					gathered := make([]int, sz)
+					for i := range gathered {
+						gathered[i] = int(exp[off+i*stride])
+					}
+					sort.Ints(gathered)
+					for i, v := range gathered {
+						exp[off+i*stride] = uint8(v)
+					}
+
+					SortStrided(New(sz), vs, off, stride)
+					if !reflect.DeepEqual(exp, vs) {
+						t.Fatal("strided items not sorted")
+					}
+				})
+			}
+		}
+	}
+}
@@ -0,0 +1,89 @@
+//go:build ignore
+
+// This program generates swar_gen.go, which contains unrolled sorters for each number
+// of 8-bit and 16-bit lanes that fit in a uint64. Run it using 'go generate' whenever
+// the networks returned by sortnet.New() change.
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"log"
+	"os"
+	"text/template"
+
+	"github.com/shabbyrobe/sortnet"
+	"github.com/shabbyrobe/sortnet/swar"
+)
+
+type sorter struct {
+	Lanes    int
+	LaneBits uint
+	Network  sortnet.Network
+	Layers   []swar.Layer
+	High     uint64
+}
+
+func main() {
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run() error {
+	var sorters []sorter
+	for _, laneBits := range []uint{8, 16} {
+		var high uint64
+		for i := laneBits - 1; i < 64; i += laneBits {
+			high |= 1 << i
+		}
+
+		for lanes := 2; lanes <= int(64/laneBits); lanes++ {
+			net := sortnet.New(lanes)
+			layers, err := swar.Layers(net, laneBits)
+			if err != nil {
+				return err
+			}
+			sorters = append(sorters, sorter{
+				Lanes:    lanes,
+				LaneBits: laneBits,
+				Network:  net,
+				Layers:   layers,
+				High:     high,
+			})
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := swarTpl.Execute(&buf, sorters); err != nil {
+		return err
+	}
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile("swar_gen.go", out, 0644)
+}
+
+var swarTpl = template.Must(template.New("").Funcs(template.FuncMap{
+	"bitor": func(a, b uint64) uint64 { return a | b },
+}).Parse(`// Code generated by 'go run genswar.go'. DO NOT EDIT.
+
+package swar
+
+{{ range . }}
+// Sort{{.Lanes}}xUint{{.LaneBits}} sorts the lowest {{.Lanes}} {{.LaneBits}}-bit lanes of x using a
+// {{.Network.Kind}} network, leaving the remaining lanes as they are.
+func Sort{{.Lanes}}xUint{{.LaneBits}}(x uint64) uint64 {
+	var p, lo, hi uint64
+	{{- $ := . }}
+	{{- range .Layers }}
+
+	p = {{ range $i, $s := .Shifts }}{{ if $i }} | {{ end }}x>>{{.Bits}}&{{printf "%#016x" .Down}} | x<<{{.Bits}}&{{printf "%#016x" .Up}}{{ end }}
+	lo, hi = minMax(x, p, {{printf "%#016x" $.High}}, {{$.LaneBits}})
+	x = lo&{{printf "%#016x" .Min}} | hi&{{printf "%#016x" .Max}} | x&^{{printf "%#016x" (bitor .Min .Max)}}
+	{{- end }}
+	return x
+}
+{{ end }}
+`))
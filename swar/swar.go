@@ -0,0 +1,159 @@
+// Package swar sorts small unsigned integers packed into the lanes of a uint64, using
+// SIMD-within-a-register ("SWAR") arithmetic.
+//
+// Lane 0 is the least significant lane. Sorted words have the smallest value in lane
+// 0, so the 8 bytes of a pixel neighbourhood can be sorted in a single word, without
+// unpacking them.
+//
+// The comparators of each layer of a sorting network are independent of each other,
+// so all of them are applied at once: a copy of the word is shuffled so each lane
+// lines up with its partner, then a branch-free lane-wise min and max are combined
+// back into a single word.
+//
+// Unrolled sorters for each lane count are generated in swar_gen.go, for example
+// Sort8xUint8 and Sort4xUint16. SortUint8s and SortUint16s do the same thing for a
+// lane count that is chosen at runtime.
+package swar
+
+import (
+	"fmt"
+
+	"github.com/shabbyrobe/sortnet"
+)
+
+//go:generate go run genswar.go
+
+// Shift moves the lanes selected by Down towards lane 0 by Bits, and the lanes
+// selected by Up away from lane 0 by Bits, to line up the lanes of a layer's
+// comparators that are the same distance apart. Down and Up are masks over the
+// destination lanes.
+type Shift struct {
+	Bits uint
+	Down uint64
+	Up   uint64
+}
+
+// Layer is a set of comparators that don't share any lanes.
+type Layer struct {
+	Shifts []Shift
+
+	// Min selects the lanes that receive the smaller of the two values in each
+	// comparator, Max the lanes that receive the larger. All other lanes are kept.
+	Min, Max uint64
+}
+
+// Layers groups the comparators of net into layers that can be applied to a word
+// with 'laneBits' bits per lane. The network must fit into 64 bits.
+func Layers(net sortnet.Network, laneBits uint) ([]Layer, error) {
+	if laneBits == 0 || uint(net.Size)*laneBits > 64 {
+		return nil, fmt.Errorf("swar: network of size %d does not fit in %d-bit lanes", net.Size, laneBits)
+	}
+
+	lane := func(i int) uint64 {
+		return ((1 << laneBits) - 1) << (uint(i) * laneBits)
+	}
+
+	var layers []Layer
+	var lastLayer = make([]int, net.Size)
+
+	for _, op := range net.Ops {
+		idx := lastLayer[op.From]
+		if lastLayer[op.To] > idx {
+			idx = lastLayer[op.To]
+		}
+		lastLayer[op.From], lastLayer[op.To] = idx+1, idx+1
+		if idx >= len(layers) {
+			layers = append(layers, Layer{})
+		}
+		layer := &layers[idx]
+
+		lo, hi := op.From, op.To
+		if lo > hi {
+			lo, hi = hi, lo
+		}
+		bits := uint(hi-lo) * laneBits
+
+		var shift *Shift
+		for i := range layer.Shifts {
+			if layer.Shifts[i].Bits == bits {
+				shift = &layer.Shifts[i]
+			}
+		}
+		if shift == nil {
+			layer.Shifts = append(layer.Shifts, Shift{Bits: bits})
+			shift = &layer.Shifts[len(layer.Shifts)-1]
+		}
+		shift.Down |= lane(lo)
+		shift.Up |= lane(hi)
+
+		layer.Min |= lane(op.From)
+		layer.Max |= lane(op.To)
+	}
+
+	return layers, nil
+}
+
+// Apply runs the layers over x, which has 'laneBits' bits per lane.
+func Apply(x uint64, layers []Layer, laneBits uint) uint64 {
+	high := highBits(laneBits)
+	for _, layer := range layers {
+		var partner uint64
+		for _, s := range layer.Shifts {
+			partner |= (x>>s.Bits)&s.Down | (x<<s.Bits)&s.Up
+		}
+		lo, hi := minMax(x, partner, high, laneBits)
+		x = lo&layer.Min | hi&layer.Max | x&^(layer.Min|layer.Max)
+	}
+	return x
+}
+
+// highBits returns a mask of the highest bit of each lane.
+func highBits(laneBits uint) (high uint64) {
+	for i := laneBits - 1; i < 64; i += laneBits {
+		high |= 1 << i
+	}
+	return high
+}
+
+// minMax returns the lane-wise minimum and maximum of a and b, without branching.
+func minMax(a, b, high uint64, laneBits uint) (lo, hi uint64) {
+	// Setting the high bit of each lane of a and clearing it in b stops subtraction
+	// from borrowing across lanes. The high bit of each lane of the result is then set
+	// if the rest of a's lane is >= the rest of b's lane:
+	diff := (a | high) - (b &^ high)
+
+	// If the high bits differ, the lane with the high bit set is larger, otherwise
+	// the rest of the lane decides:
+	ge := (a&^b | ^(a^b)&diff) & high
+
+	// Spread the high bit to fill each lane that has a >= b:
+	mask := (ge >> (laneBits - 1)) * ((1 << laneBits) - 1)
+
+	return b&mask | a&^mask, a&mask | b&^mask
+}
+
+var (
+	uint8Layers  [9][]Layer
+	uint16Layers [5][]Layer
+)
+
+func init() {
+	for n := 2; n < len(uint8Layers); n++ {
+		uint8Layers[n], _ = Layers(sortnet.New(n), 8)
+	}
+	for n := 2; n < len(uint16Layers); n++ {
+		uint16Layers[n], _ = Layers(sortnet.New(n), 16)
+	}
+}
+
+// SortUint8s sorts the lowest n 8-bit lanes of x, leaving the remaining lanes as they
+// are. SortUint8s panics if n > 8.
+func SortUint8s(x uint64, n int) uint64 {
+	return Apply(x, uint8Layers[n], 8)
+}
+
+// SortUint16s sorts the lowest n 16-bit lanes of x, leaving the remaining lanes as they
+// are. SortUint16s panics if n > 4.
+func SortUint16s(x uint64, n int) uint64 {
+	return Apply(x, uint16Layers[n], 16)
+}
@@ -0,0 +1,237 @@
+// Code generated by 'go run genswar.go'. DO NOT EDIT.
+
+package swar
+
+// Sort2xUint8 sorts the lowest 2 8-bit lanes of x using a
+// Bose-Nelson network, leaving the remaining lanes as they are.
+func Sort2xUint8(x uint64) uint64 {
+	var p, lo, hi uint64
+
+	p = x>>8&0x00000000000000ff | x<<8&0x000000000000ff00
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x00000000000000ff | hi&0x000000000000ff00 | x&^0x000000000000ffff
+	return x
+}
+
+// Sort3xUint8 sorts the lowest 3 8-bit lanes of x using a
+// Bose-Nelson network, leaving the remaining lanes as they are.
+func Sort3xUint8(x uint64) uint64 {
+	var p, lo, hi uint64
+
+	p = x>>8&0x000000000000ff00 | x<<8&0x0000000000ff0000
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x000000000000ff00 | hi&0x0000000000ff0000 | x&^0x0000000000ffff00
+
+	p = x>>16&0x00000000000000ff | x<<16&0x0000000000ff0000
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x00000000000000ff | hi&0x0000000000ff0000 | x&^0x0000000000ff00ff
+
+	p = x>>8&0x00000000000000ff | x<<8&0x000000000000ff00
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x00000000000000ff | hi&0x000000000000ff00 | x&^0x000000000000ffff
+	return x
+}
+
+// Sort4xUint8 sorts the lowest 4 8-bit lanes of x using a
+// Bose-Nelson network, leaving the remaining lanes as they are.
+func Sort4xUint8(x uint64) uint64 {
+	var p, lo, hi uint64
+
+	p = x>>8&0x0000000000ff00ff | x<<8&0x00000000ff00ff00
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x0000000000ff00ff | hi&0x00000000ff00ff00 | x&^0x00000000ffffffff
+
+	p = x>>16&0x000000000000ffff | x<<16&0x00000000ffff0000
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x000000000000ffff | hi&0x00000000ffff0000 | x&^0x00000000ffffffff
+
+	p = x>>8&0x000000000000ff00 | x<<8&0x0000000000ff0000
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x000000000000ff00 | hi&0x0000000000ff0000 | x&^0x0000000000ffff00
+	return x
+}
+
+// Sort5xUint8 sorts the lowest 5 8-bit lanes of x using a
+// Bose-Nelson network, leaving the remaining lanes as they are.
+func Sort5xUint8(x uint64) uint64 {
+	var p, lo, hi uint64
+
+	p = x>>8&0x00000000ff0000ff | x<<8&0x000000ff0000ff00
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x00000000ff0000ff | hi&0x000000ff0000ff00 | x&^0x000000ffff00ffff
+
+	p = x>>16&0x0000000000ff0000 | x<<16&0x000000ff00000000
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x0000000000ff0000 | hi&0x000000ff00000000 | x&^0x000000ff00ff0000
+
+	p = x>>8&0x0000000000ff0000 | x<<8&0x00000000ff000000 | x>>24&0x000000000000ff00 | x<<24&0x000000ff00000000
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x0000000000ffff00 | hi&0x000000ffff000000 | x&^0x000000ffffffff00
+
+	p = x>>24&0x00000000000000ff | x<<24&0x00000000ff000000
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x00000000000000ff | hi&0x00000000ff000000 | x&^0x00000000ff0000ff
+
+	p = x>>16&0x000000000000ffff | x<<16&0x00000000ffff0000
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x000000000000ffff | hi&0x00000000ffff0000 | x&^0x00000000ffffffff
+
+	p = x>>8&0x000000000000ff00 | x<<8&0x0000000000ff0000
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x000000000000ff00 | hi&0x0000000000ff0000 | x&^0x0000000000ffff00
+	return x
+}
+
+// Sort6xUint8 sorts the lowest 6 8-bit lanes of x using a
+// Bose-Nelson network, leaving the remaining lanes as they are.
+func Sort6xUint8(x uint64) uint64 {
+	var p, lo, hi uint64
+
+	p = x>>8&0x000000ff0000ff00 | x<<8&0x0000ff0000ff0000
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x000000ff0000ff00 | hi&0x0000ff0000ff0000 | x&^0x0000ffff00ffff00
+
+	p = x>>16&0x00000000ff0000ff | x<<16&0x0000ff0000ff0000
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x00000000ff0000ff | hi&0x0000ff0000ff0000 | x&^0x0000ff00ffff00ff
+
+	p = x>>8&0x00000000ff0000ff | x<<8&0x000000ff0000ff00
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x00000000ff0000ff | hi&0x000000ff0000ff00 | x&^0x000000ffff00ffff
+
+	p = x>>32&0x000000000000ffff | x<<32&0x0000ffff00000000
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x000000000000ffff | hi&0x0000ffff00000000 | x&^0x0000ffff0000ffff
+
+	p = x>>24&0x0000000000ff00ff | x<<24&0x0000ff00ff000000
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x0000000000ff00ff | hi&0x0000ff00ff000000 | x&^0x0000ff00ffff00ff
+
+	p = x>>16&0x0000000000ffff00 | x<<16&0x000000ffff000000
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x0000000000ffff00 | hi&0x000000ffff000000 | x&^0x000000ffffffff00
+
+	p = x>>8&0x0000000000ff0000 | x<<8&0x00000000ff000000
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x0000000000ff0000 | hi&0x00000000ff000000 | x&^0x00000000ffff0000
+	return x
+}
+
+// Sort7xUint8 sorts the lowest 7 8-bit lanes of x using a
+// Bose-Nelson network, leaving the remaining lanes as they are.
+func Sort7xUint8(x uint64) uint64 {
+	var p, lo, hi uint64
+
+	p = x>>8&0x0000ff00ff00ff00 | x<<8&0x00ff00ff00ff0000
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x0000ff00ff00ff00 | hi&0x00ff00ff00ff0000 | x&^0x00ffffffffffff00
+
+	p = x>>16&0x000000ffff0000ff | x<<16&0x00ffff0000ff0000
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x000000ffff0000ff | hi&0x00ffff0000ff0000 | x&^0x00ffffffffff00ff
+
+	p = x>>8&0x000000ff000000ff | x<<8&0x0000ff000000ff00 | x>>32&0x0000000000ff0000 | x<<32&0x00ff000000000000
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x000000ff00ff00ff | hi&0x00ffff000000ff00 | x&^0x00ffffff00ffffff
+
+	p = x>>32&0x000000000000ffff | x<<32&0x0000ffff00000000
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x000000000000ffff | hi&0x0000ffff00000000 | x&^0x0000ffff0000ffff
+
+	p = x>>24&0x0000000000ff00ff | x<<24&0x0000ff00ff000000
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x0000000000ff00ff | hi&0x0000ff00ff000000 | x&^0x0000ff00ffff00ff
+
+	p = x>>16&0x0000000000ffff00 | x<<16&0x000000ffff000000
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x0000000000ffff00 | hi&0x000000ffff000000 | x&^0x000000ffffffff00
+
+	p = x>>8&0x0000000000ff0000 | x<<8&0x00000000ff000000
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x0000000000ff0000 | hi&0x00000000ff000000 | x&^0x00000000ffff0000
+	return x
+}
+
+// Sort8xUint8 sorts the lowest 8 8-bit lanes of x using a
+// Bose-Nelson network, leaving the remaining lanes as they are.
+func Sort8xUint8(x uint64) uint64 {
+	var p, lo, hi uint64
+
+	p = x>>8&0x00ff00ff00ff00ff | x<<8&0xff00ff00ff00ff00
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x00ff00ff00ff00ff | hi&0xff00ff00ff00ff00 | x&^0xffffffffffffffff
+
+	p = x>>16&0x0000ffff0000ffff | x<<16&0xffff0000ffff0000
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x0000ffff0000ffff | hi&0xffff0000ffff0000 | x&^0xffffffffffffffff
+
+	p = x>>8&0x0000ff000000ff00 | x<<8&0x00ff000000ff0000 | x>>32&0x00000000ff0000ff | x<<32&0xff0000ff00000000
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x0000ff00ff00ffff | hi&0xffff00ff00ff0000 | x&^0xffffffffffffffff
+
+	p = x>>32&0x0000000000ffff00 | x<<32&0x00ffff0000000000
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x0000000000ffff00 | hi&0x00ffff0000000000 | x&^0x00ffff0000ffff00
+
+	p = x>>24&0x00000000ff00ff00 | x<<24&0x00ff00ff00000000
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x00000000ff00ff00 | hi&0x00ff00ff00000000 | x&^0x00ff00ffff00ff00
+
+	p = x>>16&0x00000000ffff0000 | x<<16&0x0000ffff00000000
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x00000000ffff0000 | hi&0x0000ffff00000000 | x&^0x0000ffffffff0000
+
+	p = x>>8&0x00000000ff000000 | x<<8&0x000000ff00000000
+	lo, hi = minMax(x, p, 0x8080808080808080, 8)
+	x = lo&0x00000000ff000000 | hi&0x000000ff00000000 | x&^0x000000ffff000000
+	return x
+}
+
+// Sort2xUint16 sorts the lowest 2 16-bit lanes of x using a
+// Bose-Nelson network, leaving the remaining lanes as they are.
+func Sort2xUint16(x uint64) uint64 {
+	var p, lo, hi uint64
+
+	p = x>>16&0x000000000000ffff | x<<16&0x00000000ffff0000
+	lo, hi = minMax(x, p, 0x8000800080008000, 16)
+	x = lo&0x000000000000ffff | hi&0x00000000ffff0000 | x&^0x00000000ffffffff
+	return x
+}
+
+// Sort3xUint16 sorts the lowest 3 16-bit lanes of x using a
+// Bose-Nelson network, leaving the remaining lanes as they are.
+func Sort3xUint16(x uint64) uint64 {
+	var p, lo, hi uint64
+
+	p = x>>16&0x00000000ffff0000 | x<<16&0x0000ffff00000000
+	lo, hi = minMax(x, p, 0x8000800080008000, 16)
+	x = lo&0x00000000ffff0000 | hi&0x0000ffff00000000 | x&^0x0000ffffffff0000
+
+	p = x>>32&0x000000000000ffff | x<<32&0x0000ffff00000000
+	lo, hi = minMax(x, p, 0x8000800080008000, 16)
+	x = lo&0x000000000000ffff | hi&0x0000ffff00000000 | x&^0x0000ffff0000ffff
+
+	p = x>>16&0x000000000000ffff | x<<16&0x00000000ffff0000
+	lo, hi = minMax(x, p, 0x8000800080008000, 16)
+	x = lo&0x000000000000ffff | hi&0x00000000ffff0000 | x&^0x00000000ffffffff
+	return x
+}
+
+// Sort4xUint16 sorts the lowest 4 16-bit lanes of x using a
+// Bose-Nelson network, leaving the remaining lanes as they are.
+func Sort4xUint16(x uint64) uint64 {
+	var p, lo, hi uint64
+
+	p = x>>16&0x0000ffff0000ffff | x<<16&0xffff0000ffff0000
+	lo, hi = minMax(x, p, 0x8000800080008000, 16)
+	x = lo&0x0000ffff0000ffff | hi&0xffff0000ffff0000 | x&^0xffffffffffffffff
+
+	p = x>>32&0x00000000ffffffff | x<<32&0xffffffff00000000
+	lo, hi = minMax(x, p, 0x8000800080008000, 16)
+	x = lo&0x00000000ffffffff | hi&0xffffffff00000000 | x&^0xffffffffffffffff
+
+	p = x>>16&0x00000000ffff0000 | x<<16&0x0000ffff00000000
+	lo, hi = minMax(x, p, 0x8000800080008000, 16)
+	x = lo&0x00000000ffff0000 | hi&0x0000ffff00000000 | x&^0x0000ffffffff0000
+	return x
+}
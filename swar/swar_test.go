@@ -0,0 +1,150 @@
+package swar
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/shabbyrobe/sortnet"
+)
+
+var (
+	uint8Sorters = [...]func(uint64) uint64{
+		2: Sort2xUint8, 3: Sort3xUint8, 4: Sort4xUint8, 5: Sort5xUint8,
+		6: Sort6xUint8, 7: Sort7xUint8, 8: Sort8xUint8,
+	}
+	uint16Sorters = [...]func(uint64) uint64{
+		2: Sort2xUint16, 3: Sort3xUint16, 4: Sort4xUint16,
+	}
+)
+
+// scalarSort unpacks the lowest n lanes of x, sorts them one by one, and packs them
+// back into x.
+func scalarSort(x uint64, n int, laneBits uint) uint64 {
+	mask := uint64(1)<<laneBits - 1
+	lanes := make([]uint64, n)
+	for i := range lanes {
+		lanes[i] = x >> (uint(i) * laneBits) & mask
+	}
+	sort.Slice(lanes, func(i, j int) bool { return lanes[i] < lanes[j] })
+	for i, v := range lanes {
+		shift := uint(i) * laneBits
+		x = x&^(mask<<shift) | v<<shift
+	}
+	return x
+}
+
+func checkSorters(t *testing.T, x uint64, n int, laneBits uint, generated func(uint64) uint64) {
+	t.Helper()
+	exp := scalarSort(x, n, laneBits)
+	if out := generated(x); out != exp {
+		t.Fatalf("generated: input %016x, expected %016x, found %016x", x, exp, out)
+	}
+
+	runtime := SortUint8s
+	if laneBits == 16 {
+		runtime = SortUint16s
+	}
+	if out := runtime(x, n); out != exp {
+		t.Fatalf("runtime: input %016x, expected %016x, found %016x", x, exp, out)
+	}
+}
+
+// TestZeroOne checks every input made of two distinct lane values, which is enough to
+// prove a network sorts (the 0-1 principle). Pairs of values that straddle the high bit
+// of the lanes are included, as that's where the lane-wise comparison is most likely
+// to go wrong.
+func TestZeroOne(t *testing.T) {
+	for _, tc := range []struct {
+		laneBits uint
+		sorters  []func(uint64) uint64
+		pairs    [][2]uint64
+	}{
+		{8, uint8Sorters[:], [][2]uint64{{0, 1}, {0, 0xff}, {0x7f, 0x80}, {0x80, 0x81}}},
+		{16, uint16Sorters[:], [][2]uint64{{0, 1}, {0, 0xffff}, {0x7fff, 0x8000}, {0x00ff, 0x0100}}},
+	} {
+		for n, sorter := range tc.sorters {
+			if sorter == nil {
+				continue
+			}
+			for _, pair := range tc.pairs {
+				t.Run(fmt.Sprintf("%dx%d/%x-%x", n, tc.laneBits, pair[0], pair[1]), func(t *testing.T) {
+					for bits := 0; bits < 1<<n; bits++ {
+						// Fill the lanes that aren't being sorted with junk, which
+						// should be left alone:
+						x := uint64(0xa5a5a5a5a5a5a5a5)
+						for i := 0; i < n; i++ {
+							shift := uint(i) * tc.laneBits
+							v := pair[(bits>>i)&1]
+							x = x&^((1<<tc.laneBits-1)<<shift) | v<<shift
+						}
+						checkSorters(t, x, n, tc.laneBits, sorter)
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+	for i := 0; i < 100000; i++ {
+		x := rng.Uint64()
+		for n, sorter := range uint8Sorters {
+			if sorter != nil {
+				checkSorters(t, x, n, 8, sorter)
+			}
+		}
+		for n, sorter := range uint16Sorters {
+			if sorter != nil {
+				checkSorters(t, x, n, 16, sorter)
+			}
+		}
+	}
+}
+
+func TestLayersTooBig(t *testing.T) {
+	if _, err := Layers(sortnet.New(9), 8); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+var BenchResult uint64
+
+func BenchmarkSort8xUint8(b *testing.B) {
+	rng := rand.New(rand.NewSource(0))
+	xs := make([]uint64, 1024)
+	for i := range xs {
+		xs[i] = rng.Uint64()
+	}
+
+	b.Run("generated", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			BenchResult = Sort8xUint8(xs[i%len(xs)])
+		}
+	})
+
+	b.Run("runtime", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			BenchResult = SortUint8s(xs[i%len(xs)], 8)
+		}
+	})
+
+	b.Run("scalar", func(b *testing.B) {
+		sorter := sortnet.Compile[uint8](sortnet.New(8))
+		var lanes [8]uint8
+		for i := 0; i < b.N; i++ {
+			x := xs[i%len(xs)]
+			for j := range lanes {
+				lanes[j] = uint8(x >> (j * 8))
+			}
+			sorter(lanes[:])
+			x = 0
+			for j := range lanes {
+				x |= uint64(lanes[j]) << (j * 8)
+			}
+			BenchResult = x
+		}
+	})
+}
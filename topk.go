@@ -0,0 +1,211 @@
+package sortnet
+
+import "cmp"
+
+// TopK accumulates the k smallest (or largest) values pushed into it, without
+// allocating after it is created.
+//
+// Incoming values are collected into a batch, which is sorted with a sorting network
+// once it is full, then combined with the values kept so far using a bitonic merge
+// network. Values that can't make it into the top k are discarded as they arrive.
+//
+// Not-a-number values are ignored. TopK is not safe for concurrent use.
+type TopK[T cmp.Ordered] struct {
+	k       int
+	largest bool
+
+	// kept holds the best 'len(kept)' values seen so far, sorted so the best values
+	// come first. len(kept) is k rounded up to the next power of two, which is the
+	// largest size a bitonic merge network can combine.
+	kept   []T
+	filled int
+
+	// Once kept is full, threshold is the k-th best value; anything that isn't better
+	// than that can be discarded straight away.
+	full      bool
+	threshold T
+
+	batch     []T
+	batched   int
+	sortBatch func([]T)
+	merge     []CompareAndSwap
+}
+
+// NewTopK creates a TopK that keeps the k smallest values if largest is false, or the
+// k largest values if largest is true. NewTopK panics if k < 1.
+func NewTopK[T cmp.Ordered](k int, largest bool) *TopK[T] {
+	if k < 1 {
+		panic("sortnet: TopK requires k >= 1")
+	}
+
+	sz := 1
+	for sz < k {
+		sz <<= 1
+	}
+
+	return &TopK[T]{
+		k:         k,
+		largest:   largest,
+		kept:      make([]T, sz),
+		batch:     make([]T, sz),
+		sortBatch: Compile[T](New(sz)),
+		merge:     bitonicMergeOps(sz),
+	}
+}
+
+// K returns the number of values the TopK keeps.
+func (t *TopK[T]) K() int { return t.k }
+
+// Reset discards all values, so the TopK can be reused.
+func (t *TopK[T]) Reset() {
+	t.filled = 0
+	t.batched = 0
+	t.full = false
+}
+
+// Push offers a value to the TopK.
+func (t *TopK[T]) Push(v T) {
+	// This check rejects most values once the TopK has seen enough of them, so it is
+	// kept small enough to be inlined:
+	if t.full && !t.better(v, t.threshold) {
+		return
+	}
+	t.push(v)
+}
+
+func (t *TopK[T]) push(v T) {
+	if v != v {
+		return
+	}
+
+	if t.filled < len(t.kept) {
+		t.insert(v)
+		return
+	}
+
+	t.batch[t.batched] = v
+	t.batched++
+	if t.batched == len(t.batch) {
+		t.flush()
+	}
+}
+
+// PushAll offers every value in vs to the TopK. This is quicker than calling Push
+// for each value.
+func (t *TopK[T]) PushAll(vs []T) {
+	for len(vs) > 0 && !t.full {
+		t.push(vs[0])
+		vs = vs[1:]
+	}
+
+	if t.largest {
+		for _, v := range vs {
+			if v > t.threshold {
+				t.push(v)
+			}
+		}
+	} else {
+		for _, v := range vs {
+			if v < t.threshold {
+				t.push(v)
+			}
+		}
+	}
+}
+
+// Result appends the best values seen so far to dst, best first, and returns the
+// extended slice. If fewer than k values have been pushed, all of them are appended.
+func (t *TopK[T]) Result(dst []T) []T {
+	if t.filled < len(t.kept) {
+		n := t.filled
+		if n > t.k {
+			n = t.k
+		}
+		return append(dst, t.kept[:n]...)
+	}
+
+	if t.batched > 0 {
+		// Fill the rest of the batch with copies of the worst value kept, which can't
+		// displace anything better:
+		worst := t.kept[len(t.kept)-1]
+		for i := t.batched; i < len(t.batch); i++ {
+			t.batch[i] = worst
+		}
+		t.flush()
+	}
+	return append(dst, t.kept[:t.k]...)
+}
+
+func (t *TopK[T]) better(a, b T) bool {
+	if t.largest {
+		return a > b
+	}
+	return a < b
+}
+
+// insert adds v to kept using an insertion sort, which is only used until kept
+// fills up for the first time.
+func (t *TopK[T]) insert(v T) {
+	i := t.filled
+	for i > 0 && t.better(v, t.kept[i-1]) {
+		t.kept[i] = t.kept[i-1]
+		i--
+	}
+	t.kept[i] = v
+	t.filled++
+	if t.filled == len(t.kept) {
+		t.full = true
+		t.threshold = t.kept[t.k-1]
+	}
+}
+
+func (t *TopK[T]) flush() {
+	t.sortBatch(t.batch)
+	t.batched = 0
+
+	kept, batch, last := t.kept, t.batch[:len(t.kept)], len(t.kept)-1
+
+	// Pairing the best kept values with the worst batch values (and vice versa), then
+	// keeping the better of each pair, leaves the best len(kept) values of both in
+	// kept as a bitonic sequence, which the merge network can then sort.
+	if t.largest {
+		// kept is descending and the batch is ascending:
+		for i := range kept {
+			if batch[i] > kept[i] {
+				kept[i] = batch[i]
+			}
+		}
+		for _, c := range t.merge {
+			if kept[c.From] < kept[c.To] {
+				kept[c.From], kept[c.To] = kept[c.To], kept[c.From]
+			}
+		}
+
+	} else {
+		// kept and the batch are both ascending:
+		for i := range kept {
+			if batch[last-i] < kept[i] {
+				kept[i] = batch[last-i]
+			}
+		}
+		for _, c := range t.merge {
+			if kept[c.From] > kept[c.To] {
+				kept[c.From], kept[c.To] = kept[c.To], kept[c.From]
+			}
+		}
+	}
+	t.threshold = kept[t.k-1]
+}
+
+// bitonicMergeOps returns the comparators of a network that sorts any bitonic sequence
+// of n items, where n is a power of two.
+func bitonicMergeOps(n int) (ops []CompareAndSwap) {
+	for d := n / 2; d >= 1; d /= 2 {
+		for block := 0; block < n; block += d * 2 {
+			for i := block; i < block+d; i++ {
+				ops = append(ops, CompareAndSwap{i, i + d})
+			}
+		}
+	}
+	return ops
+}
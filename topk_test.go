@@ -0,0 +1,181 @@
+package sortnet
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestTopK(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+
+	for _, k := range []int{1, 2, 3, 8, 16, 17} {
+		for _, largest := range []bool{false, true} {
+			for _, n := range []int{0, 1, k - 1, k, k + 1, 100, 1000} {
+				t.Run(fmt.Sprintf("%d/%v/%d", k, largest, n), func(t *testing.T) {
+					topk := NewTopK[int](k, largest)
+					var all []int
+					for i := 0; i < n; i++ {
+						v := rng.Intn(200)
+						all = append(all, v)
+						topk.Push(v)
+
+						// Check the intermediate results every so often:
+						if i%37 == 0 || i == n-1 {
+							checkTopK(t, topk, all, largest)
+						}
+					}
+					checkTopK(t, topk, all, largest)
+				})
+			}
+		}
+	}
+}
+
+func checkTopK(t *testing.T, topk *TopK[int], all []int, largest bool) {
+	t.Helper()
+
+	exp := append([]int{}, all...)
+	if largest {
+		sort.Sort(sort.Reverse(sort.IntSlice(exp)))
+	} else {
+		sort.Ints(exp)
+	}
+	if len(exp) > topk.K() {
+		exp = exp[:topk.K()]
+	}
+
+	result := topk.Result(nil)
+	if len(exp) == 0 && len(result) == 0 {
+		return
+	}
+	if !reflect.DeepEqual(exp, result) {
+		t.Fatalf("\nexp: %v\nout: %v", exp, result)
+	}
+}
+
+func TestTopKPushAll(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+
+	for _, k := range []int{1, 5, 16} {
+		for _, largest := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%d/%v", k, largest), func(t *testing.T) {
+				topk := NewTopK[int](k, largest)
+				var all []int
+				for i := 0; i < 20; i++ {
+					vs := make([]int, rng.Intn(50))
+					for j := range vs {
+						vs[j] = rng.Intn(1000)
+					}
+					all = append(all, vs...)
+					topk.PushAll(vs)
+					checkTopK(t, topk, all, largest)
+				}
+			})
+		}
+	}
+}
+
+func TestTopKNaN(t *testing.T) {
+	topk := NewTopK[float64](2, false)
+	topk.Push(math.NaN())
+	topk.Push(2)
+	topk.Push(math.NaN())
+	topk.Push(1)
+	topk.Push(3)
+	if result := topk.Result(nil); !reflect.DeepEqual(result, []float64{1, 2}) {
+		t.Fatal(result)
+	}
+}
+
+func TestTopKReset(t *testing.T) {
+	topk := NewTopK[int](2, true)
+	for i := 0; i < 100; i++ {
+		topk.Push(i)
+	}
+	topk.Reset()
+	topk.Push(5)
+	if result := topk.Result(nil); !reflect.DeepEqual(result, []int{5}) {
+		t.Fatal(result)
+	}
+}
+
+func TestTopKAllocs(t *testing.T) {
+	rng := rand.New(rand.NewSource(0))
+	topk := NewTopK[int](16, false)
+	dst := make([]int, 0, 16)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		topk.Reset()
+		for i := 0; i < 1000; i++ {
+			topk.Push(rng.Int())
+		}
+		dst = topk.Result(dst[:0])
+	})
+	if allocs != 0 {
+		t.Fatal("expected no allocations, found", allocs)
+	}
+}
+
+type intMaxHeap []int
+
+func (h intMaxHeap) Len() int            { return len(h) }
+func (h intMaxHeap) Less(i, j int) bool  { return h[i] > h[j] }
+func (h intMaxHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *intMaxHeap) Push(x interface{}) { *h = append(*h, x.(int)) }
+func (h *intMaxHeap) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+func BenchmarkTopK(b *testing.B) {
+	const k = 16
+	rng := rand.New(rand.NewSource(0))
+	vs := make([]int, 1000000)
+	for i := range vs {
+		vs[i] = rng.Int()
+	}
+
+	b.Run("topk", func(b *testing.B) {
+		topk := NewTopK[int](k, false)
+		dst := make([]int, 0, k)
+		for i := 0; i < b.N; i++ {
+			topk.Reset()
+			for _, v := range vs {
+				topk.Push(v)
+			}
+			dst = topk.Result(dst[:0])
+		}
+	})
+
+	b.Run("topk-all", func(b *testing.B) {
+		topk := NewTopK[int](k, false)
+		dst := make([]int, 0, k)
+		for i := 0; i < b.N; i++ {
+			topk.Reset()
+			topk.PushAll(vs)
+			dst = topk.Result(dst[:0])
+		}
+	})
+
+	b.Run("heap", func(b *testing.B) {
+		h := make(intMaxHeap, 0, k+1)
+		for i := 0; i < b.N; i++ {
+			h = h[:0]
+			for _, v := range vs {
+				if len(h) < k {
+					heap.Push(&h, v)
+				} else if v < h[0] {
+					h[0] = v
+					heap.Fix(&h, 0)
+				}
+			}
+		}
+	})
+}